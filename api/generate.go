@@ -0,0 +1,5 @@
+// Package api holds the protobuf definitions of the public APIs, the Go
+// code is generated next to them with buf.
+package api
+
+//go:generate buf generate
@@ -0,0 +1,123 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/alonsoF100/authorization-service/internal/audit"
+	"github.com/alonsoF100/authorization-service/internal/service"
+	"github.com/spf13/cobra"
+)
+
+func newVerifyAuditCommand(a *app) *cobra.Command {
+	var bundlePath string
+	cmd := &cobra.Command{
+		Use:   "verify-audit",
+		Short: "Check the audit trail hash chain and checkpoints, report the first broken link",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var (
+				report audit.Report
+				err    error
+			)
+			if bundlePath != "" {
+				report, err = verifyBundle(bundlePath)
+			} else {
+				err = a.withAuditService(cmd.Context(), func(auditService *service.AuditService) (err error) {
+					report, err = auditService.VerifyTrail(cmd.Context())
+					return err
+				})
+			}
+			if err != nil {
+				return err
+			}
+
+			out := cmd.OutOrStdout()
+			fmt.Fprintf(out, "Audit trail intact: %d chained events, %d signed checkpoints\n", report.Events, report.Checkpoints)
+			if report.Unchained > 0 {
+				fmt.Fprintf(out, "%d events were recorded before the hash chain, only their order is checked\n", report.Unchained)
+			}
+			if report.Head != "" {
+				fmt.Fprintf(out, "Head: event %d, hash %s\n", report.LastEventID, report.Head)
+			}
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&bundlePath, "bundle", "", "verify an exported bundle instead of the database")
+
+	return cmd
+}
+
+func newExportAuditCommand(a *app) *cobra.Command {
+	var output string
+	cmd := &cobra.Command{
+		Use:   "export-audit",
+		Short: "Sign the newest audit event and export the trail as a self-verifying bundle",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return a.withAuditService(cmd.Context(), func(auditService *service.AuditService) error {
+				bundle, err := auditService.Export(cmd.Context())
+				if err != nil {
+					return err
+				}
+
+				var w io.Writer = cmd.OutOrStdout()
+				if output != "" {
+					// the trail holds addresses and emails
+					file, err := os.OpenFile(output, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o600)
+					if err != nil {
+						return err
+					}
+					defer file.Close()
+					w = file
+				}
+
+				encoder := json.NewEncoder(w)
+				encoder.SetIndent("", "  ")
+				if err := encoder.Encode(bundle); err != nil {
+					return err
+				}
+
+				if len(bundle.Checkpoints) == 0 {
+					fmt.Fprintln(cmd.ErrOrStderr(), "No checkpoints, the bundle proves the order of the events but not their origin. Run keys rotate to sign them.")
+				}
+				return nil
+			})
+		},
+	}
+	cmd.Flags().StringVarP(&output, "output", "o", "", "write the bundle to a file instead of stdout")
+
+	return cmd
+}
+
+func (a *app) withAuditService(ctx context.Context, fn func(auditService *service.AuditService) error) error {
+	dataBase, closeDB, err := a.repository(ctx)
+	if err != nil {
+		return err
+	}
+	defer closeDB()
+
+	keyService := service.NewKeyService(dataBase)
+	if err := keyService.Load(ctx); err != nil {
+		return err
+	}
+
+	return fn(service.NewAuditService(dataBase, keyService))
+}
+
+func verifyBundle(path string) (audit.Report, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return audit.Report{}, err
+	}
+
+	var bundle audit.Bundle
+	if err := json.Unmarshal(data, &bundle); err != nil {
+		return audit.Report{}, fmt.Errorf("failed to decode bundle: %w", err)
+	}
+
+	return bundle.Verify()
+}
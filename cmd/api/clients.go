@@ -0,0 +1,62 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/alonsoF100/authorization-service/internal/service"
+	"github.com/spf13/cobra"
+)
+
+func newClientsCommand(a *app) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "clients",
+		Short: "Manage OAuth clients",
+	}
+
+	var (
+		name                   string
+		redirectURIs           []string
+		postLogoutRedirectURIs []string
+		grantTypes             []string
+		scopes                 []string
+	)
+	create := &cobra.Command{
+		Use:   "create",
+		Short: "Register an OAuth client and print its credentials",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			dataBase, closeDB, err := a.repository(cmd.Context())
+			if err != nil {
+				return err
+			}
+			defer closeDB()
+
+			auditService := service.NewAuditService(dataBase, nil)
+			client, secret, err := service.NewOAuthService(dataBase, nil, nil, auditService, a.cfg).CreateClient(
+				cmd.Context(),
+				name,
+				redirectURIs,
+				postLogoutRedirectURIs,
+				grantTypes,
+				scopes,
+			)
+			if err != nil {
+				return err
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "Client ID:     %s\nClient secret: %s\n", client.ID, secret)
+			fmt.Fprintln(cmd.OutOrStdout(), "The secret is not stored, save it now.")
+			return nil
+		},
+	}
+	create.Flags().StringVar(&name, "name", "", "client name")
+	create.Flags().StringSliceVar(&redirectURIs, "redirect-uri", nil, "allowed redirect uri, repeatable")
+	create.Flags().StringSliceVar(&postLogoutRedirectURIs, "post-logout-redirect-uri", nil, "allowed redirect uri after logout, repeatable")
+	create.Flags().StringSliceVar(&grantTypes, "grant", nil, fmt.Sprintf("allowed grant type, repeatable, one of %v", service.SupportedGrants))
+	create.Flags().StringSliceVar(&scopes, "scope", nil, "scope granted by the client_credentials grant, repeatable")
+	_ = create.MarkFlagRequired("name")
+
+	cmd.AddCommand(create)
+
+	return cmd
+}
@@ -0,0 +1,29 @@
+package main
+
+import (
+	"github.com/spf13/cobra"
+)
+
+func newConfigCommand(a *app) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "config",
+		Short: "Inspect the configuration",
+	}
+
+	cmd.AddCommand(&cobra.Command{
+		Use:   "print",
+		Short: "Print the effective config with secrets redacted",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			out, err := a.cfg.Redacted().YAML()
+			if err != nil {
+				return err
+			}
+
+			_, err = cmd.OutOrStdout().Write(out)
+			return err
+		},
+	})
+
+	return cmd
+}
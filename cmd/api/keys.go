@@ -0,0 +1,80 @@
+package main
+
+import (
+	"fmt"
+	"text/tabwriter"
+	"time"
+
+	"github.com/alonsoF100/authorization-service/internal/service"
+	"github.com/spf13/cobra"
+)
+
+func newKeysCommand(a *app) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "keys",
+		Short: "Manage JWT signing keys",
+	}
+
+	cmd.AddCommand(
+		&cobra.Command{
+			Use:   "rotate",
+			Short: "Create a new active signing key and retire all but the previous one",
+			Args:  cobra.NoArgs,
+			RunE: func(cmd *cobra.Command, args []string) error {
+				dataBase, closeDB, err := a.repository(cmd.Context())
+				if err != nil {
+					return err
+				}
+				defer closeDB()
+
+				key, err := service.NewKeyService(dataBase).Rotate(cmd.Context())
+				if err != nil {
+					return err
+				}
+
+				fmt.Fprintf(cmd.OutOrStdout(), "New active key: %s (%s)\n", key.ID, key.Algorithm)
+				return nil
+			},
+		},
+		&cobra.Command{
+			Use:   "list",
+			Short: "List signing keys",
+			Args:  cobra.NoArgs,
+			RunE: func(cmd *cobra.Command, args []string) error {
+				dataBase, closeDB, err := a.repository(cmd.Context())
+				if err != nil {
+					return err
+				}
+				defer closeDB()
+
+				keyService := service.NewKeyService(dataBase)
+				if err := keyService.Load(cmd.Context()); err != nil {
+					return err
+				}
+				keys, err := keyService.List(cmd.Context())
+				if err != nil {
+					return err
+				}
+
+				active := keyService.ActiveKey()
+
+				w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0)
+				fmt.Fprintln(w, "KID\tALGORITHM\tCREATED\tSTATUS")
+				for _, key := range keys {
+					status := "valid"
+					switch {
+					case key.RetiredAt != nil:
+						status = "retired " + key.RetiredAt.Format(time.RFC3339)
+					case active != nil && active.ID == key.ID:
+						status = "active"
+					}
+					fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", key.ID, key.Algorithm, key.CreatedAt.Format(time.RFC3339), status)
+				}
+
+				return w.Flush()
+			},
+		},
+	)
+
+	return cmd
+}
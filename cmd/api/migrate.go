@@ -0,0 +1,35 @@
+package main
+
+import (
+	"github.com/alonsoF100/authorization-service/internal/repository"
+	"github.com/alonsoF100/authorization-service/internal/repository/postgres"
+	"github.com/spf13/cobra"
+)
+
+func newMigrateCommand(a *app) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate",
+		Short: "Manage database migrations",
+	}
+
+	short := map[string]string{
+		"up":      "Apply all pending migrations",
+		"down":    "Roll back the latest migration",
+		"status":  "Print the status of all migrations",
+		"redo":    "Roll back and re-apply the latest migration",
+		"version": "Print the current migration version",
+	}
+
+	for _, command := range postgres.MigrateCommands {
+		cmd.AddCommand(&cobra.Command{
+			Use:   command,
+			Short: short[command],
+			Args:  cobra.NoArgs,
+			RunE: func(cmd *cobra.Command, args []string) error {
+				return repository.Migrate(cmd.Context(), a.cfg, command)
+			},
+		})
+	}
+
+	return cmd
+}
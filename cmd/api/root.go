@@ -0,0 +1,82 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os/user"
+
+	"github.com/alonsoF100/authorization-service/internal/audit"
+	"github.com/alonsoF100/authorization-service/internal/config"
+	"github.com/alonsoF100/authorization-service/internal/logger"
+	"github.com/alonsoF100/authorization-service/internal/models"
+	"github.com/alonsoF100/authorization-service/internal/repository"
+	"github.com/spf13/cobra"
+)
+
+type app struct {
+	configPath string
+	cfg        *config.Config
+	logger     *slog.Logger
+}
+
+func newRootCommand() *cobra.Command {
+	a := &app{}
+
+	root := &cobra.Command{
+		Use:          "auth-service",
+		Short:        "Authorization service",
+		SilenceUsage: true,
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SetContext(audit.WithSource(cmd.Context(), cliSource()))
+			return a.load()
+		},
+	}
+
+	root.PersistentFlags().StringVar(&a.configPath, "config", "", "path to config file (default config.yaml)")
+
+	root.AddCommand(
+		newServeCommand(a),
+		newMigrateCommand(a),
+		newUserCommand(a),
+		newKeysCommand(a),
+		newClientsCommand(a),
+		newConfigCommand(a),
+		newVerifyAuditCommand(a),
+		newExportAuditCommand(a),
+	)
+
+	return root
+}
+
+func (a *app) load() error {
+	cfg, err := config.Load(a.configPath)
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+
+	a.cfg = cfg
+	a.logger = logger.Setup(cfg)
+
+	return nil
+}
+
+func (a *app) repository(ctx context.Context) (repository.Repository, func(), error) {
+	dataBase, closeDB, err := repository.New(ctx, a.cfg)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to open database: %w", err)
+	}
+
+	return dataBase, closeDB, nil
+}
+
+// cliSource makes the operating system user the actor of audit events
+// caused by commands
+func cliSource() audit.Source {
+	source := audit.Source{ActorType: models.ActorCLI}
+	if current, err := user.Current(); err == nil {
+		source.ActorID = current.Username
+	}
+
+	return source
+}
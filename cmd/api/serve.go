@@ -0,0 +1,191 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"os"
+	"os/signal"
+	"sync"
+	"sync/atomic"
+	"syscall"
+	"time"
+
+	"github.com/alonsoF100/authorization-service/internal/config"
+	"github.com/alonsoF100/authorization-service/internal/ipfilter"
+	"github.com/alonsoF100/authorization-service/internal/logger"
+	"github.com/alonsoF100/authorization-service/internal/publisher"
+	"github.com/alonsoF100/authorization-service/internal/ratelimit"
+	"github.com/alonsoF100/authorization-service/internal/service"
+	"github.com/alonsoF100/authorization-service/internal/transport/grpc/extauthz"
+	grpchandlers "github.com/alonsoF100/authorization-service/internal/transport/grpc/handlers"
+	grpcserver "github.com/alonsoF100/authorization-service/internal/transport/grpc/server"
+	"github.com/alonsoF100/authorization-service/internal/transport/http/handlers"
+	"github.com/alonsoF100/authorization-service/internal/transport/http/server"
+	"github.com/spf13/cobra"
+)
+
+const (
+	keysRefreshInterval    = time.Minute
+	oauthPurgeInterval     = time.Hour
+	rateLimitPurgeInterval = time.Minute
+)
+
+func newServeCommand(a *app) *cobra.Command {
+	return &cobra.Command{
+		Use:   "serve",
+		Short: "Start the HTTP and gRPC servers",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return a.serve(cmd.Context())
+		},
+	}
+}
+
+func (a *app) serve(ctx context.Context) error {
+	ctx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	reloader := config.NewReloader(a.configPath, a.cfg)
+	reloader.Subscribe(func(cfg *config.Config) {
+		logger.SetLevel(cfg.Logger.Level)
+	})
+	reloader.Start()
+
+	dataBase, closeDB, err := a.repository(ctx)
+	if err != nil {
+		return err
+	}
+	defer closeDB()
+	slog.Info("Database opened successfully",
+		"driver", a.cfg.Database.Driver)
+
+	keyService := service.NewKeyService(dataBase)
+	if err := keyService.Load(ctx); err != nil {
+		return fmt.Errorf("failed to load signing keys: %w", err)
+	}
+	go keyService.Refresh(ctx, keysRefreshInterval)
+	if keyService.ActiveKey() == nil {
+		slog.Warn("No signing key, tokens are signed with jwt.secret_key and OpenID Connect ID tokens are unavailable until keys rotate is run")
+	}
+
+	auditService := service.NewAuditService(dataBase, keyService)
+	if a.cfg.Audit.CheckpointInterval > 0 {
+		go auditService.Checkpoints(ctx, a.cfg.Audit.CheckpointInterval)
+	}
+	authService := service.NewAuthService(
+		dataBase,
+		keyService,
+		auditService,
+		a.cfg,
+	)
+	userService := service.NewUserService(dataBase, auditService)
+	passwords := service.NewPasswordPolicy(a.cfg.Password)
+	reloader.Subscribe(func(cfg *config.Config) {
+		passwords.Update(cfg.Password)
+	})
+	authService.Passwords = passwords
+	userService.Passwords = passwords
+	oauthService := service.NewOAuthService(dataBase, authService, authService, auditService, a.cfg)
+	go oauthService.PurgeExpired(ctx, oauthPurgeInterval)
+	serviceAccountService := service.NewServiceAccountService(dataBase, auditService)
+
+	eventPublisher, err := publisher.New(a.cfg)
+	if err != nil {
+		return err
+	}
+	go service.NewOutboxRelay(dataBase, eventPublisher, a.cfg.Outbox).Run(ctx)
+
+	tokenCache := service.NewTokenCache(
+		authService,
+		a.cfg.ForwardAuth.Cache.TTL,
+		a.cfg.ForwardAuth.Cache.Size,
+	)
+
+	ipFilter, err := ipfilter.New(a.cfg.IPFilter)
+	if err != nil {
+		return fmt.Errorf("failed to load ip filter: %w", err)
+	}
+	reloader.Subscribe(func(cfg *config.Config) {
+		if err := ipFilter.Update(cfg.IPFilter); err != nil {
+			slog.Error("IP filter reload rejected, the previous rules stay",
+				"error", err)
+		}
+	})
+
+	httpHandlers := handlers.New(authService, userService, oauthService, serviceAccountService, tokenCache, a.cfg)
+	httpHandlers.IPFilter = ipFilter
+	liveConfig := &atomic.Pointer[config.Config]{}
+	liveConfig.Store(a.cfg)
+	reloader.Subscribe(func(cfg *config.Config) {
+		liveConfig.Store(cfg)
+	})
+	httpHandlers.LiveConfig = liveConfig
+	httpHandlers.AuditService = auditService
+	if a.cfg.RateLimit.Enabled {
+		rateLimitStore, closeStore, err := ratelimit.New(a.cfg)
+		if err != nil {
+			return err
+		}
+		defer closeStore()
+		if memoryStore, ok := rateLimitStore.(*ratelimit.MemoryStore); ok {
+			go memoryStore.PurgeExpired(ctx, rateLimitPurgeInterval)
+		}
+		httpHandlers.RateLimitStore = rateLimitStore
+	}
+
+	httpServer := server.New(a.cfg, httpHandlers, a.logger)
+
+	var extAuthz *extauthz.Server
+	if a.cfg.ExtAuthz.Enabled {
+		extAuthz = extauthz.New(tokenCache, a.cfg)
+		extAuthz.Addresses = tokenCache
+	}
+	grpcHandlers := grpchandlers.New(authService, userService)
+	grpcHandlers.LiveConfig = liveConfig
+	grpcHandlers.RateLimitStore = httpHandlers.RateLimitStore
+	grpcServer := grpcserver.New(a.cfg, grpcHandlers, extAuthz)
+
+	errs := make(chan error, 2)
+	go func() {
+		if err := httpServer.Start(); !errors.Is(err, http.ErrServerClosed) {
+			errs <- fmt.Errorf("http server: %w", err)
+		}
+	}()
+	go func() {
+		if err := grpcServer.Start(); err != nil {
+			errs <- fmt.Errorf("grpc server: %w", err)
+		}
+	}()
+
+	var serveErr error
+	select {
+	case <-ctx.Done():
+		slog.Info("Shutting down servers",
+			"timeout", a.cfg.Server.ShutdownTimeout)
+	case serveErr = <-errs:
+		slog.Error("Failed to start server",
+			"error", serveErr)
+	}
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), a.cfg.Server.ShutdownTimeout)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	var httpErr, grpcErr error
+	wg.Go(func() { httpErr = httpServer.Shutdown(shutdownCtx) })
+	wg.Go(func() { grpcErr = grpcServer.Shutdown(shutdownCtx) })
+	wg.Wait()
+
+	if err := errors.Join(httpErr, grpcErr); err != nil {
+		slog.Error("Failed to shut down servers gracefully",
+			"error", err)
+		return errors.Join(serveErr, err)
+	}
+
+	slog.Info("Servers stopped")
+
+	return serveErr
+}
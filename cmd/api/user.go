@@ -0,0 +1,169 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/alonsoF100/authorization-service/internal/models"
+	"github.com/alonsoF100/authorization-service/internal/service"
+	"github.com/alonsoF100/authorization-service/internal/transport/http/dto"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+)
+
+func newUserCommand(a *app) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "user",
+		Short: "Manage users",
+	}
+
+	var nickname, email, password string
+	create := &cobra.Command{
+		Use:   "create",
+		Short: "Create a user, the password is read from stdin when not passed",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if password == "" {
+				var err error
+				if password, err = readPassword(cmd); err != nil {
+					return err
+				}
+			}
+
+			req := dto.SignUpRequest{Nickname: nickname, Email: email, Password: password}
+			if err := validator.New().Struct(req); err != nil {
+				return err
+			}
+
+			return a.withServices(cmd.Context(), func(authService *service.AuthService, _ *service.UserService) error {
+				user, err := authService.SignUp(cmd.Context(), req.Nickname, req.Email, req.Password)
+				if err != nil {
+					return err
+				}
+
+				fmt.Fprintf(cmd.OutOrStdout(), "User created: %s\n", user.ID)
+				return nil
+			})
+		},
+	}
+	create.Flags().StringVar(&nickname, "nickname", "", "user nickname")
+	create.Flags().StringVar(&email, "email", "", "user email")
+	create.Flags().StringVar(&password, "password", "", "user password")
+	_ = create.MarkFlagRequired("nickname")
+	_ = create.MarkFlagRequired("email")
+
+	disable := &cobra.Command{
+		Use:   "disable <id|email>",
+		Short: "Disable a user so it can no longer sign in",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return a.withUser(cmd.Context(), args[0], func(userService *service.UserService, user *models.User) error {
+				if err := userService.DisableUser(cmd.Context(), user.ID); err != nil {
+					return err
+				}
+
+				fmt.Fprintf(cmd.OutOrStdout(), "User disabled: %s\n", user.ID)
+				return nil
+			})
+		},
+	}
+
+	setPassword := &cobra.Command{
+		Use:   "set-password <id|email>",
+		Short: "Set a new password read from stdin",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			password, err := readPassword(cmd)
+			if err != nil {
+				return err
+			}
+			return a.withUser(cmd.Context(), args[0], func(userService *service.UserService, user *models.User) error {
+				if err := userService.SetPassword(cmd.Context(), user.ID, password); err != nil {
+					return err
+				}
+
+				fmt.Fprintf(cmd.OutOrStdout(), "Password changed: %s\n", user.ID)
+				return nil
+			})
+		},
+	}
+
+	grantRole := &cobra.Command{
+		Use:   "grant-role <id|email> <role>",
+		Short: "Grant a role to a user",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			role := strings.TrimSpace(args[1])
+			if role == "" {
+				return errors.New("role must not be empty")
+			}
+
+			return a.withUser(cmd.Context(), args[0], func(userService *service.UserService, user *models.User) error {
+				if err := userService.GrantRole(cmd.Context(), user.ID, role); err != nil {
+					return err
+				}
+
+				fmt.Fprintf(cmd.OutOrStdout(), "Role %q granted: %s\n", role, user.ID)
+				return nil
+			})
+		},
+	}
+
+	cmd.AddCommand(create, disable, setPassword, grantRole)
+
+	return cmd
+}
+
+func (a *app) withServices(ctx context.Context, fn func(authService *service.AuthService, userService *service.UserService) error) error {
+	dataBase, closeDB, err := a.repository(ctx)
+	if err != nil {
+		return err
+	}
+	defer closeDB()
+
+	auditService := service.NewAuditService(dataBase, nil)
+
+	passwords := service.NewPasswordPolicy(a.cfg.Password)
+	authService := service.NewAuthService(dataBase, nil, auditService, a.cfg)
+	authService.Passwords = passwords
+	userService := service.NewUserService(dataBase, auditService)
+	userService.Passwords = passwords
+
+	return fn(authService, userService)
+}
+
+// withUser resolves the user by id or, if the argument is not a UUID, by email
+func (a *app) withUser(ctx context.Context, idOrEmail string, fn func(userService *service.UserService, user *models.User) error) error {
+	return a.withServices(ctx, func(_ *service.AuthService, userService *service.UserService) error {
+		var (
+			user *models.User
+			err  error
+		)
+		if _, parseErr := uuid.Parse(idOrEmail); parseErr == nil {
+			user, err = userService.GetUser(ctx, idOrEmail)
+		} else {
+			user, err = userService.GetUserByEmail(ctx, idOrEmail)
+		}
+		if err != nil {
+			return err
+		}
+
+		return fn(userService, user)
+	})
+}
+
+func readPassword(cmd *cobra.Command) (string, error) {
+	fmt.Fprint(cmd.ErrOrStderr(), "Password: ")
+
+	password, err := bufio.NewReader(cmd.InOrStdin()).ReadString('\n')
+	if err != nil && !errors.Is(err, io.EOF) {
+		return "", err
+	}
+
+	return strings.TrimRight(password, "\r\n"), nil
+}
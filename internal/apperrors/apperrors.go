@@ -3,13 +3,39 @@ package apperrors
 import "errors"
 
 var (
-	ErrUserExist          = errors.New("user with this nickname already exists")
-	ErrEmailExist         = errors.New("user with this email already exists")
-	ErrUserNotFoundByID   = errors.New("failed to find user by id")
-	ErrInvalidCredentials = errors.New("invalid email or password")
-	ErrInvalidToken       = errors.New("invalid token")
-	ErrUnauthorized       = errors.New("user authorized")
-	ErrFailedToDecode     = errors.New("failed to decode JSON")
-	ErrFailedToValidate   = errors.New("failed to validate request")
-	ErrServer             = errors.New("damn, the server gaz up for nothing")
+	ErrUserExist              = errors.New("user with this nickname already exists")
+	ErrEmailExist             = errors.New("user with this email already exists")
+	ErrUserNotFoundByID       = errors.New("failed to find user by id")
+	ErrUserNotFoundByMail     = errors.New("failed to find user by email")
+	ErrUserDisabled           = errors.New("user account is disabled")
+	ErrInvalidCredentials     = errors.New("invalid email or password")
+	ErrInvalidToken           = errors.New("invalid token")
+	ErrUnauthorized           = errors.New("user authorized")
+	ErrMissingRole            = errors.New("user lacks a required role")
+	ErrMissingScope           = errors.New("token lacks a required scope")
+	ErrInvalidClient          = errors.New("invalid client credentials")
+	ErrInvalidGrant           = errors.New("invalid or expired authorization grant")
+	ErrUnauthorizedClient     = errors.New("client is not allowed to use this grant type")
+	ErrInvalidRedirectURI     = errors.New("redirect uri is not registered for the client")
+	ErrInvalidScope           = errors.New("requested scope is not granted to the client")
+	ErrClientPrincipal        = errors.New("endpoint is only available to users, not clients or service accounts")
+	ErrSessionRequired        = errors.New("endpoint needs a sign in token, not a scoped or personal access token")
+	ErrTokenNotFound          = errors.New("personal access token not found")
+	ErrMalformedScope         = errors.New("scopes must not be empty or contain whitespace, quotes or backslashes")
+	ErrServiceAccountNotFound = errors.New("service account not found")
+	ErrServiceAccountExist    = errors.New("service account with this name already exists")
+	ErrAPIKeyNotFound         = errors.New("api key not found")
+	ErrSessionNotFound        = errors.New("session not found")
+	ErrCookieModeDisabled     = errors.New("cookie mode is disabled")
+	ErrInvalidCSRFToken       = errors.New("missing or invalid csrf token")
+	ErrTooManyRequests        = errors.New("too many requests, retry later")
+	ErrIPNotAllowed           = errors.New("requests from this address are not allowed")
+	ErrInvalidIPRange         = errors.New("allowed ips must be ip addresses or CIDR ranges")
+	ErrIPLockout              = errors.New("allowed ips must include the address of this request")
+	ErrWeakPassword           = errors.New("password length is outside the password policy")
+	ErrNoSigningKey           = errors.New("no asymmetric signing key, run keys rotate")
+	ErrAuditChainConflict     = errors.New("audit chain head was taken by another event")
+	ErrFailedToDecode         = errors.New("failed to decode JSON")
+	ErrFailedToValidate       = errors.New("failed to validate request")
+	ErrServer                 = errors.New("damn, the server gaz up for nothing")
 )
@@ -0,0 +1,38 @@
+// Package audit carries the source of a request to the services recording
+// audit events, so the caller does not have to be threaded through every
+// service method.
+package audit
+
+import "context"
+
+// Source tells who made a request and from where. Transports attach it to
+// the context, an authenticated caller adds the actor.
+type Source struct {
+	ActorType string
+	ActorID   string
+	IP        string
+	UserAgent string
+	RequestID string
+}
+
+type contextKey struct{}
+
+func WithSource(ctx context.Context, source Source) context.Context {
+	return context.WithValue(ctx, contextKey{}, source)
+}
+
+// WithActor keeps the rest of the source, a request is authenticated after
+// its address is known
+func WithActor(ctx context.Context, actorType, actorID string) context.Context {
+	source := SourceFrom(ctx)
+	source.ActorType = actorType
+	source.ActorID = actorID
+
+	return WithSource(ctx, source)
+}
+
+// SourceFrom returns the zero Source when none was attached
+func SourceFrom(ctx context.Context) Source {
+	source, _ := ctx.Value(contextKey{}).(Source)
+	return source
+}
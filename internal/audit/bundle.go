@@ -0,0 +1,177 @@
+package audit
+
+import (
+	"crypto/ecdsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/alonsoF100/authorization-service/internal/models"
+)
+
+const BundleVersion = 1
+
+// Bundle is an exported audit trail that verifies without the database:
+// the events carry their hashes and the public keys of the checkpoints
+// come along. The keys should still be compared with the published JWKS.
+type Bundle struct {
+	Version     int                `json:"version"`
+	ExportedAt  time.Time          `json:"exported_at"`
+	Keys        []BundleKey        `json:"keys"`
+	Checkpoints []BundleCheckpoint `json:"checkpoints"`
+	Events      []BundleEvent      `json:"events"`
+}
+
+// BundleKey holds a PEM encoded public key
+type BundleKey struct {
+	ID        string `json:"kid"`
+	Algorithm string `json:"alg"`
+	PublicKey string `json:"public_key"`
+}
+
+type BundleCheckpoint struct {
+	EventID   int64     `json:"event_id"`
+	Hash      string    `json:"hash"`
+	KeyID     string    `json:"kid"`
+	Signature string    `json:"signature"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type BundleEvent struct {
+	ID        int64             `json:"id"`
+	Type      string            `json:"type"`
+	Outcome   string            `json:"outcome"`
+	ActorType string            `json:"actor_type"`
+	ActorID   string            `json:"actor_id"`
+	TargetID  string            `json:"target_id"`
+	IP        string            `json:"ip"`
+	UserAgent string            `json:"user_agent"`
+	RequestID string            `json:"request_id"`
+	Details   map[string]string `json:"details,omitempty"`
+	CreatedAt time.Time         `json:"created_at"`
+	PrevHash  string            `json:"prev_hash"`
+	Hash      string            `json:"hash"`
+}
+
+// NewBundle packs the trail in id order with the keys its checkpoints were
+// signed with
+func NewBundle(events []models.AuditEvent, checkpoints []models.AuditCheckpoint, keys []models.PublicKey, exportedAt time.Time) (*Bundle, error) {
+	bundle := &Bundle{
+		Version:     BundleVersion,
+		ExportedAt:  exportedAt.UTC(),
+		Keys:        []BundleKey{},
+		Checkpoints: make([]BundleCheckpoint, 0, len(checkpoints)),
+		Events:      make([]BundleEvent, 0, len(events)),
+	}
+
+	used := make(map[string]bool)
+	for _, checkpoint := range checkpoints {
+		used[checkpoint.KeyID] = true
+		bundle.Checkpoints = append(bundle.Checkpoints, BundleCheckpoint{
+			EventID:   checkpoint.EventID,
+			Hash:      checkpoint.Hash,
+			KeyID:     checkpoint.KeyID,
+			Signature: checkpoint.Signature,
+			CreatedAt: checkpoint.CreatedAt.UTC(),
+		})
+	}
+
+	for _, key := range keys {
+		if !used[key.ID] {
+			continue
+		}
+
+		der, err := x509.MarshalPKIXPublicKey(key.Key)
+		if err != nil {
+			return nil, fmt.Errorf("key %s: %w", key.ID, err)
+		}
+		bundle.Keys = append(bundle.Keys, BundleKey{
+			ID:        key.ID,
+			Algorithm: key.Algorithm,
+			PublicKey: string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})),
+		})
+	}
+
+	for _, event := range events {
+		bundle.Events = append(bundle.Events, BundleEvent{
+			ID:        event.ID,
+			Type:      event.Type,
+			Outcome:   event.Outcome,
+			ActorType: event.ActorType,
+			ActorID:   event.ActorID,
+			TargetID:  event.TargetID,
+			IP:        event.IP,
+			UserAgent: event.UserAgent,
+			RequestID: event.RequestID,
+			Details:   event.Details,
+			CreatedAt: event.CreatedAt.UTC(),
+			PrevHash:  event.PrevHash,
+			Hash:      event.Hash,
+		})
+	}
+
+	return bundle, nil
+}
+
+// Verify checks the bundle like the trail in the database, a missing key
+// fails the checkpoints signed with it
+func (b *Bundle) Verify() (Report, error) {
+	if b.Version != BundleVersion {
+		return Report{}, fmt.Errorf("unsupported bundle version %d", b.Version)
+	}
+
+	keys := make([]models.PublicKey, 0, len(b.Keys))
+	for _, key := range b.Keys {
+		block, _ := pem.Decode([]byte(key.PublicKey))
+		if block == nil {
+			return Report{}, fmt.Errorf("key %s is not PEM encoded", key.ID)
+		}
+		parsed, err := x509.ParsePKIXPublicKey(block.Bytes)
+		if err != nil {
+			return Report{}, fmt.Errorf("key %s: %w", key.ID, err)
+		}
+		publicKey, ok := parsed.(*ecdsa.PublicKey)
+		if !ok {
+			return Report{}, errors.New("key " + key.ID + " is not an ECDSA key")
+		}
+
+		keys = append(keys, models.PublicKey{ID: key.ID, Algorithm: key.Algorithm, Key: publicKey})
+	}
+
+	checkpoints := make([]models.AuditCheckpoint, 0, len(b.Checkpoints))
+	for _, checkpoint := range b.Checkpoints {
+		checkpoints = append(checkpoints, models.AuditCheckpoint{
+			EventID:   checkpoint.EventID,
+			Hash:      checkpoint.Hash,
+			KeyID:     checkpoint.KeyID,
+			Signature: checkpoint.Signature,
+			CreatedAt: checkpoint.CreatedAt,
+		})
+	}
+
+	verifier := NewVerifier(keys, checkpoints)
+	for _, event := range b.Events {
+		err := verifier.Add(models.AuditEvent{
+			ID:        event.ID,
+			Type:      event.Type,
+			Outcome:   event.Outcome,
+			ActorType: event.ActorType,
+			ActorID:   event.ActorID,
+			TargetID:  event.TargetID,
+			IP:        event.IP,
+			UserAgent: event.UserAgent,
+			RequestID: event.RequestID,
+			Details:   event.Details,
+			CreatedAt: event.CreatedAt,
+			PrevHash:  event.PrevHash,
+			Hash:      event.Hash,
+		})
+		if err != nil {
+			return Report{}, err
+		}
+	}
+
+	return verifier.Finish()
+}
@@ -0,0 +1,78 @@
+package audit_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/alonsoF100/authorization-service/internal/audit"
+	"github.com/alonsoF100/authorization-service/internal/models"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBundle(t *testing.T) {
+	key := newSigningKey(t)
+	unusedKey := newSigningKey(t)
+
+	events := trail(1, 4)
+	checkpoint, err := audit.SignCheckpoint(key, events[4].ID, events[4].Hash, time.Now())
+	require.NoError(t, err)
+
+	bundle, err := audit.NewBundle(events, []models.AuditCheckpoint{*checkpoint}, publicKeys(key, unusedKey), time.Now())
+	require.NoError(t, err)
+	require.Len(t, bundle.Keys, 1)
+	require.Equal(t, key.ID, bundle.Keys[0].ID)
+
+	// the bundle verifies after a round trip through JSON
+	encoded, err := json.Marshal(bundle)
+	require.NoError(t, err)
+
+	decode := func(t *testing.T) *audit.Bundle {
+		var decoded audit.Bundle
+		require.NoError(t, json.Unmarshal(encoded, &decoded))
+		return &decoded
+	}
+
+	report, err := decode(t).Verify()
+	require.NoError(t, err)
+	require.Equal(t, 4, report.Events)
+	require.Equal(t, 1, report.Unchained)
+	require.Equal(t, 1, report.Checkpoints)
+
+	tests := []struct {
+		name          string
+		modify        func(bundle *audit.Bundle)
+		expectedError string
+	}{
+		{
+			name:          "altered event",
+			modify:        func(bundle *audit.Bundle) { bundle.Events[2].ActorID = "someone" },
+			expectedError: "audit trail broken at event 3",
+		},
+		{
+			name:          "dropped key",
+			modify:        func(bundle *audit.Bundle) { bundle.Keys = nil },
+			expectedError: "unknown signing key",
+		},
+		{
+			name:          "replaced key",
+			modify:        func(bundle *audit.Bundle) { bundle.Keys[0].PublicKey = "not a key" },
+			expectedError: "not PEM encoded",
+		},
+		{
+			name:          "unknown version",
+			modify:        func(bundle *audit.Bundle) { bundle.Version = 2 },
+			expectedError: "unsupported bundle version 2",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bundle := decode(t)
+			tt.modify(bundle)
+
+			_, err := bundle.Verify()
+			require.ErrorContains(t, err, tt.expectedError)
+		})
+	}
+}
@@ -0,0 +1,154 @@
+package audit
+
+import (
+	"cmp"
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/alonsoF100/authorization-service/internal/models"
+)
+
+// GenesisHash is the previous hash of the first chained event
+var GenesisHash = strings.Repeat("0", sha256.Size*2)
+
+// hashedEvent fixes the field order of the hashed content, so the hash
+// does not depend on how the event was stored
+type hashedEvent struct {
+	Type      string            `json:"type"`
+	Outcome   string            `json:"outcome"`
+	ActorType string            `json:"actor_type"`
+	ActorID   string            `json:"actor_id"`
+	TargetID  string            `json:"target_id"`
+	IP        string            `json:"ip"`
+	UserAgent string            `json:"user_agent"`
+	RequestID string            `json:"request_id"`
+	Details   map[string]string `json:"details,omitempty"`
+	CreatedAt string            `json:"created_at"`
+	PrevHash  string            `json:"prev_hash"`
+}
+
+// Hash is the hex SHA-256 of the event content and its PrevHash. The id is
+// left out, the database assigns it after the hash is known, the previous
+// hash orders the chain instead.
+func Hash(event models.AuditEvent) string {
+	// a struct of strings always marshals
+	content, _ := json.Marshal(hashedEvent{
+		Type:      event.Type,
+		Outcome:   event.Outcome,
+		ActorType: event.ActorType,
+		ActorID:   event.ActorID,
+		TargetID:  event.TargetID,
+		IP:        event.IP,
+		UserAgent: event.UserAgent,
+		RequestID: event.RequestID,
+		Details:   event.Details,
+		CreatedAt: event.CreatedAt.UTC().Format(time.RFC3339Nano),
+		PrevHash:  event.PrevHash,
+	})
+	sum := sha256.Sum256(content)
+
+	return hex.EncodeToString(sum[:])
+}
+
+// BrokenLinkError is the first event at which the trail stops verifying
+type BrokenLinkError struct {
+	EventID int64
+	Reason  string
+}
+
+func (e *BrokenLinkError) Error() string {
+	return fmt.Sprintf("audit trail broken at event %d: %s", e.EventID, e.Reason)
+}
+
+// Report sums up a verified trail. Unchained events were recorded before
+// the hash chain existed, only their order is checked.
+type Report struct {
+	Events      int
+	Unchained   int
+	Checkpoints int
+	LastEventID int64
+	Head        string
+}
+
+// Verifier walks the trail in id order and checks every link and the
+// signed checkpoints on the way
+type Verifier struct {
+	keys        map[string]*ecdsa.PublicKey
+	checkpoints []models.AuditCheckpoint
+	next        int
+	report      Report
+}
+
+// NewVerifier checks checkpoints with the given keys, retired keys have to
+// be among them for older checkpoints
+func NewVerifier(keys []models.PublicKey, checkpoints []models.AuditCheckpoint) *Verifier {
+	byID := make(map[string]*ecdsa.PublicKey, len(keys))
+	for _, key := range keys {
+		byID[key.ID] = key.Key
+	}
+
+	checkpoints = slices.Clone(checkpoints)
+	slices.SortStableFunc(checkpoints, func(a, b models.AuditCheckpoint) int {
+		return cmp.Compare(a.EventID, b.EventID)
+	})
+
+	return &Verifier{keys: byID, checkpoints: checkpoints}
+}
+
+// Add checks the next event of the trail
+func (v *Verifier) Add(event models.AuditEvent) error {
+	if event.ID <= v.report.LastEventID {
+		return &BrokenLinkError{EventID: event.ID, Reason: "event is out of order"}
+	}
+
+	if event.Hash == "" && event.PrevHash == "" {
+		if v.report.Head != "" {
+			return &BrokenLinkError{EventID: event.ID, Reason: "event is missing from the hash chain"}
+		}
+		v.report.Unchained++
+	} else {
+		previous := v.report.Head
+		if previous == "" {
+			previous = GenesisHash
+		}
+		if event.PrevHash != previous {
+			return &BrokenLinkError{EventID: event.ID, Reason: "previous hash does not match the event before"}
+		}
+		if Hash(event) != event.Hash {
+			return &BrokenLinkError{EventID: event.ID, Reason: "content does not match its hash"}
+		}
+
+		v.report.Events++
+		v.report.Head = event.Hash
+	}
+	v.report.LastEventID = event.ID
+
+	for ; v.next < len(v.checkpoints) && v.checkpoints[v.next].EventID <= event.ID; v.next++ {
+		checkpoint := v.checkpoints[v.next]
+		if checkpoint.EventID < event.ID {
+			return &BrokenLinkError{EventID: checkpoint.EventID, Reason: "checkpointed event is missing"}
+		}
+		if err := v.verifyCheckpoint(checkpoint, event.Hash); err != nil {
+			return &BrokenLinkError{EventID: event.ID, Reason: err.Error()}
+		}
+		v.report.Checkpoints++
+	}
+
+	return nil
+}
+
+// Finish reports checkpoints past the last event, the tail of the trail
+// was cut off
+func (v *Verifier) Finish() (Report, error) {
+	if v.next < len(v.checkpoints) {
+		return Report{}, &BrokenLinkError{EventID: v.checkpoints[v.next].EventID, Reason: "checkpointed event is missing"}
+	}
+
+	return v.report, nil
+}
@@ -0,0 +1,247 @@
+package audit_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"testing"
+	"time"
+
+	"github.com/alonsoF100/authorization-service/internal/audit"
+	"github.com/alonsoF100/authorization-service/internal/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func newSigningKey(t *testing.T) *models.SigningKey {
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	return &models.SigningKey{ID: uuid.New().String(), Algorithm: "ES256", PrivateKey: privateKey}
+}
+
+func publicKeys(keys ...*models.SigningKey) []models.PublicKey {
+	var public []models.PublicKey
+	for _, key := range keys {
+		public = append(public, models.PublicKey{ID: key.ID, Algorithm: key.Algorithm, Key: &key.PrivateKey.PublicKey})
+	}
+
+	return public
+}
+
+// trail returns unchained events recorded before the chain followed by
+// chained ones
+func trail(unchained, chained int) []models.AuditEvent {
+	var events []models.AuditEvent
+	now := time.Now().UTC()
+	for i := range unchained {
+		events = append(events, models.AuditEvent{ID: int64(i + 1), Type: models.AuditLogin, Outcome: models.AuditSuccess, CreatedAt: now})
+	}
+
+	prevHash := audit.GenesisHash
+	for i := range chained {
+		event := models.AuditEvent{
+			ID:        int64(unchained + i + 1),
+			Type:      models.AuditRoleGranted,
+			Outcome:   models.AuditSuccess,
+			ActorType: models.ActorCLI,
+			ActorID:   "root",
+			TargetID:  "user123",
+			Details:   map[string]string{"role": "admin"},
+			CreatedAt: now.Add(time.Duration(i) * time.Second),
+			PrevHash:  prevHash,
+		}
+		event.Hash = audit.Hash(event)
+		prevHash = event.Hash
+
+		events = append(events, event)
+	}
+
+	return events
+}
+
+func TestHash(t *testing.T) {
+	event := trail(0, 1)[0]
+	require.Len(t, event.Hash, 64)
+
+	// storage details don't change the hash
+	stored := event
+	stored.ID = 99
+	stored.Details = map[string]string{"role": "admin"}
+	stored.CreatedAt = event.CreatedAt.In(time.FixedZone("UTC+3", 3*60*60))
+	require.Equal(t, event.Hash, audit.Hash(stored))
+
+	empty := event
+	empty.Details = nil
+	withEmptyDetails := empty
+	withEmptyDetails.Details = map[string]string{}
+	require.Equal(t, audit.Hash(empty), audit.Hash(withEmptyDetails))
+
+	for name, modify := range map[string]func(e *models.AuditEvent){
+		"content":       func(e *models.AuditEvent) { e.TargetID = "user456" },
+		"details":       func(e *models.AuditEvent) { e.Details = map[string]string{"role": "user"} },
+		"time":          func(e *models.AuditEvent) { e.CreatedAt = e.CreatedAt.Add(time.Microsecond) },
+		"previous hash": func(e *models.AuditEvent) { e.PrevHash = e.Hash },
+	} {
+		t.Run(name, func(t *testing.T) {
+			changed := event
+			modify(&changed)
+			require.NotEqual(t, event.Hash, audit.Hash(changed))
+		})
+	}
+}
+
+func TestVerifier(t *testing.T) {
+	key := newSigningKey(t)
+	otherKey := newSigningKey(t)
+
+	checkpointAt := func(events []models.AuditEvent, i int, key *models.SigningKey) models.AuditCheckpoint {
+		checkpoint, err := audit.SignCheckpoint(key, events[i].ID, events[i].Hash, time.Now())
+		require.NoError(t, err)
+		return *checkpoint
+	}
+
+	tests := []struct {
+		name          string
+		events        func() []models.AuditEvent
+		checkpoints   func(events []models.AuditEvent) []models.AuditCheckpoint
+		expectedEvent int64
+		expectedError string
+	}{
+		{
+			name:   "intact trail",
+			events: func() []models.AuditEvent { return trail(2, 5) },
+			checkpoints: func(events []models.AuditEvent) []models.AuditCheckpoint {
+				return []models.AuditCheckpoint{checkpointAt(events, 6, otherKey), checkpointAt(events, 3, key)}
+			},
+		},
+		{
+			name: "altered content",
+			events: func() []models.AuditEvent {
+				events := trail(0, 5)
+				events[2].Details["role"] = "user"
+				return events
+			},
+			expectedEvent: 3,
+			expectedError: "content does not match its hash",
+		},
+		{
+			name: "rehashed event",
+			events: func() []models.AuditEvent {
+				events := trail(0, 5)
+				events[2].Outcome = models.AuditFailure
+				events[2].Hash = audit.Hash(events[2])
+				return events
+			},
+			expectedEvent: 4,
+			expectedError: "previous hash does not match",
+		},
+		{
+			name: "deleted event",
+			events: func() []models.AuditEvent {
+				events := trail(0, 5)
+				return append(events[:2], events[3:]...)
+			},
+			expectedEvent: 4,
+			expectedError: "previous hash does not match",
+		},
+		{
+			name: "event outside the chain",
+			events: func() []models.AuditEvent {
+				events := trail(0, 3)
+				return append(events, models.AuditEvent{ID: 4, Type: models.AuditLogin, CreatedAt: time.Now()})
+			},
+			expectedEvent: 4,
+			expectedError: "missing from the hash chain",
+		},
+		{
+			name: "reordered events",
+			events: func() []models.AuditEvent {
+				events := trail(0, 3)
+				events[1].ID, events[2].ID = events[2].ID, events[1].ID
+				return events
+			},
+			expectedEvent: 2,
+			expectedError: "out of order",
+		},
+		{
+			name:   "rewritten trail fails its checkpoint",
+			events: func() []models.AuditEvent { return trail(0, 3) },
+			checkpoints: func(events []models.AuditEvent) []models.AuditCheckpoint {
+				original := trail(0, 3)
+				original[1].IP = "192.0.2.1"
+				original[1].Hash = audit.Hash(original[1])
+				return []models.AuditCheckpoint{checkpointAt(original, 1, key)}
+			},
+			expectedEvent: 2,
+			expectedError: "hash does not match the signed checkpoint",
+		},
+		{
+			name:   "forged checkpoint",
+			events: func() []models.AuditEvent { return trail(0, 3) },
+			checkpoints: func(events []models.AuditEvent) []models.AuditCheckpoint {
+				checkpoint := checkpointAt(events, 1, key)
+				checkpoint.Hash = events[2].Hash
+				checkpoint.EventID = events[2].ID
+				return []models.AuditCheckpoint{checkpoint}
+			},
+			expectedEvent: 3,
+			expectedError: "checkpoint does not match its signature",
+		},
+		{
+			name:   "unknown key",
+			events: func() []models.AuditEvent { return trail(0, 3) },
+			checkpoints: func(events []models.AuditEvent) []models.AuditCheckpoint {
+				return []models.AuditCheckpoint{checkpointAt(events, 1, newSigningKey(t))}
+			},
+			expectedEvent: 2,
+			expectedError: "unknown signing key",
+		},
+		{
+			name:   "truncated tail",
+			events: func() []models.AuditEvent { return trail(0, 3) },
+			checkpoints: func(events []models.AuditEvent) []models.AuditCheckpoint {
+				return []models.AuditCheckpoint{checkpointAt(trail(0, 5), 4, key)}
+			},
+			expectedEvent: 5,
+			expectedError: "checkpointed event is missing",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			events := tt.events()
+			var checkpoints []models.AuditCheckpoint
+			if tt.checkpoints != nil {
+				checkpoints = tt.checkpoints(events)
+			}
+
+			verifier := audit.NewVerifier(publicKeys(key, otherKey), checkpoints)
+			var err error
+			for _, event := range events {
+				if err = verifier.Add(event); err != nil {
+					break
+				}
+			}
+			var report audit.Report
+			if err == nil {
+				report, err = verifier.Finish()
+			}
+
+			if tt.expectedError == "" {
+				require.NoError(t, err)
+				require.Equal(t, 5, report.Events)
+				require.Equal(t, 2, report.Unchained)
+				require.Equal(t, 2, report.Checkpoints)
+				require.Equal(t, events[len(events)-1].ID, report.LastEventID)
+				require.Equal(t, events[len(events)-1].Hash, report.Head)
+				return
+			}
+
+			var broken *audit.BrokenLinkError
+			require.ErrorAs(t, err, &broken)
+			require.Equal(t, tt.expectedEvent, broken.EventID)
+			require.Contains(t, broken.Reason, tt.expectedError)
+		})
+	}
+}
@@ -0,0 +1,68 @@
+package audit
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/alonsoF100/authorization-service/internal/models"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// CheckpointClaims are signed by a checkpoint, anyone with the public key
+// from the JWKS can check them with a stock JWT library
+type CheckpointClaims struct {
+	EventID int64  `json:"event_id"`
+	Hash    string `json:"hash"`
+	jwt.RegisteredClaims
+}
+
+// SignCheckpoint vouches for the trail up to the event with the given hash
+func SignCheckpoint(key *models.SigningKey, eventID int64, hash string, signedAt time.Time) (*models.AuditCheckpoint, error) {
+	token := jwt.NewWithClaims(jwt.SigningMethodES256, CheckpointClaims{
+		EventID: eventID,
+		Hash:    hash,
+		RegisteredClaims: jwt.RegisteredClaims{
+			IssuedAt: jwt.NewNumericDate(signedAt),
+		},
+	})
+	token.Header["kid"] = key.ID
+
+	signature, err := token.SignedString(key.PrivateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.AuditCheckpoint{
+		EventID:   eventID,
+		Hash:      hash,
+		KeyID:     key.ID,
+		Signature: signature,
+		CreatedAt: signedAt,
+	}, nil
+}
+
+func (v *Verifier) verifyCheckpoint(checkpoint models.AuditCheckpoint, hash string) error {
+	var claims CheckpointClaims
+	_, err := jwt.ParseWithClaims(checkpoint.Signature, &claims, func(token *jwt.Token) (any, error) {
+		kid, _ := token.Header["kid"].(string)
+		key := v.keys[kid]
+		if kid != checkpoint.KeyID || key == nil {
+			return nil, errors.New("unknown signing key " + checkpoint.KeyID)
+		}
+
+		return key, nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodES256.Alg()}))
+	if err != nil {
+		return fmt.Errorf("checkpoint signature is invalid: %w", err)
+	}
+
+	if claims.EventID != checkpoint.EventID || claims.Hash != checkpoint.Hash {
+		return errors.New("checkpoint does not match its signature")
+	}
+	if checkpoint.Hash != hash {
+		return errors.New("hash does not match the signed checkpoint")
+	}
+
+	return nil
+}
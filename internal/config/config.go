@@ -3,32 +3,81 @@ package config
 import "time"
 
 type Config struct {
-	Server    ServerConfig     `mapstructure:"server"`
-	Database  DatabaseConfig   `mapstructure:"database"`
-	Logger    LoggerConfig     `mapstructure:"logger"`
-	Migration MigrationsConfig `mapstructure:"migrations"`
-	JWT       JWTConfig        `mapstructure:"jwt"`
+	Server      ServerConfig      `mapstructure:"server"`
+	GRPC        GRPCConfig        `mapstructure:"grpc"`
+	Database    DatabaseConfig    `mapstructure:"database"`
+	Logger      LoggerConfig      `mapstructure:"logger"`
+	Migration   MigrationsConfig  `mapstructure:"migrations"`
+	JWT         JWTConfig         `mapstructure:"jwt"`
+	Password    PasswordConfig    `mapstructure:"password"`
+	ForwardAuth ForwardAuthConfig `mapstructure:"forward_auth"`
+	ExtAuthz    ExtAuthzConfig    `mapstructure:"ext_authz"`
+	OAuth       OAuthConfig       `mapstructure:"oauth"`
+	Cookie      CookieConfig      `mapstructure:"cookie"`
+	CORS        CORSConfig        `mapstructure:"cors"`
+	Security    SecurityConfig    `mapstructure:"security_headers"`
+	RateLimit   RateLimitConfig   `mapstructure:"rate_limit"`
+	IPFilter    IPFilterConfig    `mapstructure:"ip_filter"`
+	Audit       AuditConfig       `mapstructure:"audit"`
+	Outbox      OutboxConfig      `mapstructure:"outbox"`
 }
 
 type DatabaseConfig struct {
-	Host     string `mapstructure:"host"`
-	Port     string `mapstructure:"port"`
-	User     string `mapstructure:"user"`
-	Password string `mapstructure:"password"`
-	Name     string `mapstructure:"name"`
-	SSLMode  string `mapstructure:"ssl_mode"`
+	Driver           string        `mapstructure:"driver"`
+	Host             string        `mapstructure:"host"`
+	Port             string        `mapstructure:"port"`
+	User             string        `mapstructure:"user"`
+	Password         string        `mapstructure:"password"`
+	Name             string        `mapstructure:"name"`
+	SSLMode          string        `mapstructure:"ssl_mode"`
+	Path             string        `mapstructure:"path"`
+	StatementTimeout time.Duration `mapstructure:"statement_timeout"`
+	QueryTimeout     time.Duration `mapstructure:"query_timeout"`
+	Pool             PoolConfig    `mapstructure:"pool"`
+	Retry            RetryConfig   `mapstructure:"retry"`
+}
+
+type PoolConfig struct {
+	MaxConns          int32         `mapstructure:"max_conns"`
+	MinConns          int32         `mapstructure:"min_conns"`
+	MaxConnLifetime   time.Duration `mapstructure:"max_conn_lifetime"`
+	MaxConnIdleTime   time.Duration `mapstructure:"max_conn_idle_time"`
+	HealthCheckPeriod time.Duration `mapstructure:"health_check_period"`
+}
+
+type RetryConfig struct {
+	ConnectAttempts   int           `mapstructure:"connect_attempts"`
+	ConnectBackoff    time.Duration `mapstructure:"connect_backoff"`
+	ConnectMaxBackoff time.Duration `mapstructure:"connect_max_backoff"`
+	QueryAttempts     int           `mapstructure:"query_attempts"`
+	QueryBackoff      time.Duration `mapstructure:"query_backoff"`
 }
 
 type ServerConfig struct {
-	Port         int           `mapstructure:"port"`
-	ReadTimeout  time.Duration `mapstructure:"read_timeout"`
-	WriteTimeout time.Duration `mapstructure:"write_timeout"`
-	IdleTimeout  time.Duration `mapstructure:"idle_timeout"`
+	Port            int           `mapstructure:"port"`
+	ReadTimeout     time.Duration `mapstructure:"read_timeout"`
+	WriteTimeout    time.Duration `mapstructure:"write_timeout"`
+	IdleTimeout     time.Duration `mapstructure:"idle_timeout"`
+	ShutdownTimeout time.Duration `mapstructure:"shutdown_timeout"`
+	// TrustedProxies are the CIDRs whose forwarding headers name the client
+	TrustedProxies []string `mapstructure:"trusted_proxies"`
+}
+
+type GRPCConfig struct {
+	Port       int  `mapstructure:"port"`
+	Reflection bool `mapstructure:"reflection"`
 }
 
 type LoggerConfig struct {
-	Level string `mapstructure:"level"`
-	JSON  bool   `mapstructure:"json"`
+	Level     string          `mapstructure:"level"`
+	JSON      bool            `mapstructure:"json"`
+	Redaction RedactionConfig `mapstructure:"redaction"`
+}
+
+type RedactionConfig struct {
+	Mode    string   `mapstructure:"mode"`
+	Keys    []string `mapstructure:"keys"`
+	HMACKey string   `mapstructure:"hmac_key"`
 }
 
 type MigrationsConfig struct {
@@ -38,4 +87,207 @@ type MigrationsConfig struct {
 type JWTConfig struct {
 	SecretKey string        `mapstructure:"secret_key"`
 	Expiry    time.Duration `mapstructure:"expiry"`
+	// KeyEncryptionKey encrypts the ES256 signing keys in the database
+	KeyEncryptionKey string `mapstructure:"key_encryption_key"`
+	// AcceptLegacyHS256 keeps HS256 tokens valid after an ES256 key is active
+	AcceptLegacyHS256 bool `mapstructure:"accept_legacy_hs256"`
+}
+
+// ForwardAuthConfig configures GET /auth/verify used by nginx auth_request
+// and Traefik ForwardAuth. An empty header name disables that header.
+// PasswordConfig is the policy for new passwords, it reloads with the
+// config file. Existing passwords still sign in after it changes. Lengths
+// count characters, bcrypt hashes at most MaxPasswordBytes bytes.
+type PasswordConfig struct {
+	MinLength int `mapstructure:"min_length"`
+	MaxLength int `mapstructure:"max_length"`
+}
+
+type ForwardAuthConfig struct {
+	Headers             ForwardAuthHeaders `mapstructure:"headers"`
+	RequiredRolesHeader string             `mapstructure:"required_roles_header"`
+	CookieName          string             `mapstructure:"cookie_name"`
+	Cache               CacheConfig        `mapstructure:"cache"`
+}
+
+type ForwardAuthHeaders struct {
+	UserID   string `mapstructure:"user_id"`
+	Email    string `mapstructure:"email"`
+	Nickname string `mapstructure:"nickname"`
+	Roles    string `mapstructure:"roles"`
+}
+
+// CacheConfig limits a cache by entry lifetime and count, zero disables it
+type CacheConfig struct {
+	TTL  time.Duration `mapstructure:"ttl"`
+	Size int           `mapstructure:"size"`
+}
+
+// ExtAuthzConfig configures the Envoy ext_authz Check API served on the
+// gRPC port. Identity headers use the forward_auth header names.
+type ExtAuthzConfig struct {
+	Enabled bool        `mapstructure:"enabled"`
+	Rules   []RouteRule `mapstructure:"rules"`
+}
+
+// RouteRule applies to Prefix and the paths below it, the longest matching
+// prefix wins. Paths without a rule only need a valid token.
+type RouteRule struct {
+	Prefix string   `mapstructure:"prefix"`
+	Public bool     `mapstructure:"public"`
+	Roles  []string `mapstructure:"roles"`
+	Scopes []string `mapstructure:"scopes"`
+}
+
+// OAuthConfig configures the authorization server. The session cookie keeps
+// the user signed in on the authorize page between requests, the CSRF cookie
+// holds the token of the last rendered form. Issuer is the public base URL,
+// the OpenID discovery document is built from it.
+type OAuthConfig struct {
+	Issuer        string        `mapstructure:"issuer"`
+	CodeTTL       time.Duration `mapstructure:"code_ttl"`
+	SessionCookie string        `mapstructure:"session_cookie"`
+	CSRFCookie    string        `mapstructure:"csrf_cookie"`
+	SecureCookie  bool          `mapstructure:"secure_cookie"`
+}
+
+// CookieConfig configures the cookie mode of /auth/login for browser apps.
+// The token goes into an HttpOnly cookie instead of the response body, and
+// requests authenticated by the cookie that change state must echo the
+// CSRF token in CSRFHeader.
+type CookieConfig struct {
+	Enabled    bool   `mapstructure:"enabled"`
+	Name       string `mapstructure:"name"`
+	Domain     string `mapstructure:"domain"`
+	Path       string `mapstructure:"path"`
+	SameSite   string `mapstructure:"same_site"`
+	Secure     bool   `mapstructure:"secure"`
+	CSRFCookie string `mapstructure:"csrf_cookie"`
+	CSRFHeader string `mapstructure:"csrf_header"`
+}
+
+// CORSConfig configures cross-origin requests of browser apps. A route
+// replaces the top level policy for its prefix and the paths below it, the
+// longest prefix wins. A policy without allowed origins disables CORS. The
+// policies reload with the config file.
+type CORSConfig struct {
+	CORSPolicy `mapstructure:",squash"`
+	Routes     []CORSRoute `mapstructure:"routes"`
+}
+
+type CORSRoute struct {
+	Prefix     string `mapstructure:"prefix"`
+	CORSPolicy `mapstructure:",squash"`
+}
+
+// CORSPolicy lists what cross-origin requests may use. An origin can start
+// with a wildcard subdomain, like https://*.example.com.
+type CORSPolicy struct {
+	AllowedOrigins   []string      `mapstructure:"allowed_origins"`
+	AllowedMethods   []string      `mapstructure:"allowed_methods"`
+	AllowedHeaders   []string      `mapstructure:"allowed_headers"`
+	ExposedHeaders   []string      `mapstructure:"exposed_headers"`
+	AllowCredentials bool          `mapstructure:"allow_credentials"`
+	MaxAge           time.Duration `mapstructure:"max_age"`
+}
+
+// SecurityConfig configures the security headers of every HTTP response,
+// routes work like the CORS ones
+type SecurityConfig struct {
+	SecurityPolicy `mapstructure:",squash"`
+	Routes         []SecurityRoute `mapstructure:"routes"`
+}
+
+type SecurityRoute struct {
+	Prefix         string `mapstructure:"prefix"`
+	SecurityPolicy `mapstructure:",squash"`
+}
+
+// SecurityPolicy is the set of headers sent, a zero value omits the header.
+// NoStore doesn't override a Cache-Control set by the handler.
+type SecurityPolicy struct {
+	HSTSMaxAge            time.Duration `mapstructure:"hsts_max_age"`
+	HSTSIncludeSubdomains bool          `mapstructure:"hsts_include_subdomains"`
+	NoSniff               bool          `mapstructure:"no_sniff"`
+	ReferrerPolicy        string        `mapstructure:"referrer_policy"`
+	FrameAncestors        string        `mapstructure:"frame_ancestors"`
+	NoStore               bool          `mapstructure:"no_store"`
+}
+
+// RateLimitConfig configures the token bucket limits. Register and Login
+// count per client IP over HTTP and gRPC, API per principal and Token per
+// client IP and OAuth client. The memory store keeps the buckets of each instance apart, redis
+// shares them. The limits reload with the config file, the store does not.
+type RateLimitConfig struct {
+	Enabled  bool        `mapstructure:"enabled"`
+	Store    string      `mapstructure:"store"`
+	Shards   int         `mapstructure:"shards"`
+	Redis    RedisConfig `mapstructure:"redis"`
+	Register RateLimit   `mapstructure:"register"`
+	Login    RateLimit   `mapstructure:"login"`
+	API      RateLimit   `mapstructure:"api"`
+	Token    RateLimit   `mapstructure:"token"`
+}
+
+// RateLimit refills Requests tokens every Period up to Burst, which
+// defaults to Requests. Zero requests turn the limit off.
+type RateLimit struct {
+	Requests int           `mapstructure:"requests"`
+	Period   time.Duration `mapstructure:"period"`
+	Burst    int           `mapstructure:"burst"`
+}
+
+type RedisConfig struct {
+	Addr      string `mapstructure:"addr"`
+	Password  string `mapstructure:"password"`
+	DB        int    `mapstructure:"db"`
+	KeyPrefix string `mapstructure:"key_prefix"`
+}
+
+// IPFilterConfig restricts paths by client address and country. Unlike
+// route overrides every rule matching the path applies, so a deny list on
+// "/" still holds under "/admin". The rules reload with the config file.
+type IPFilterConfig struct {
+	GeoIPDatabase string   `mapstructure:"geoip_database"`
+	Rules         []IPRule `mapstructure:"rules"`
+}
+
+// IPRule rejects denied addresses and countries, with an allow list only
+// listed ones get through. Countries are ISO 3166 codes looked up in the
+// MaxMind database.
+type IPRule struct {
+	Prefix         string   `mapstructure:"prefix"`
+	Allow          []string `mapstructure:"allow"`
+	Deny           []string `mapstructure:"deny"`
+	AllowCountries []string `mapstructure:"allow_countries"`
+	DenyCountries  []string `mapstructure:"deny_countries"`
+}
+
+// AuditConfig controls the signed checkpoints of the audit trail, they need
+// an asymmetric signing key. Zero interval turns them off.
+type AuditConfig struct {
+	CheckpointInterval time.Duration `mapstructure:"checkpoint_interval"`
+}
+
+// OutboxConfig configures the relay publishing domain events. A failed
+// delivery is retried after MinBackoff, doubling up to MaxBackoff. A
+// claimed event is delivered again when the relay doesn't report back
+// within Lease. Published events are kept for Retention, zero keeps them.
+type OutboxConfig struct {
+	Publisher    string        `mapstructure:"publisher"`
+	PollInterval time.Duration `mapstructure:"poll_interval"`
+	BatchSize    int           `mapstructure:"batch_size"`
+	Lease        time.Duration `mapstructure:"lease"`
+	MinBackoff   time.Duration `mapstructure:"min_backoff"`
+	MaxBackoff   time.Duration `mapstructure:"max_backoff"`
+	Retention    time.Duration `mapstructure:"retention"`
+	Webhook      WebhookConfig `mapstructure:"webhook"`
+}
+
+// WebhookConfig posts every event to URL, the body is signed with Secret
+// when it is set
+type WebhookConfig struct {
+	URL     string        `mapstructure:"url"`
+	Secret  string        `mapstructure:"secret"`
+	Timeout time.Duration `mapstructure:"timeout"`
 }
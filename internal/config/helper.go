@@ -1,6 +1,10 @@
 package config
 
-import "fmt"
+import (
+	"fmt"
+	"net/netip"
+	"strings"
+)
 
 func (cfg *DatabaseConfig) ConStr() string {
 	return fmt.Sprintf(
@@ -17,3 +21,68 @@ func (cfg *DatabaseConfig) ConStr() string {
 func (cfg *ServerConfig) PortStr() string {
 	return fmt.Sprintf(":%d", cfg.Port)
 }
+
+func (cfg *GRPCConfig) PortStr() string {
+	return fmt.Sprintf(":%d", cfg.Port)
+}
+
+// ParsePrefix reads a CIDR, a single IP is a prefix of its full length
+func ParsePrefix(value string) (netip.Prefix, error) {
+	if !strings.Contains(value, "/") {
+		addr, err := netip.ParseAddr(value)
+		if err != nil {
+			return netip.Prefix{}, err
+		}
+		return netip.PrefixFrom(addr, addr.BitLen()), nil
+	}
+
+	prefix, err := netip.ParsePrefix(value)
+	if err != nil {
+		return netip.Prefix{}, err
+	}
+
+	return prefix.Masked(), nil
+}
+
+// PathHasPrefix matches the prefixes of the route and ip rules on whole
+// path segments, /admin covers /admin and /admin/users but not /administrator
+func PathHasPrefix(path, prefix string) bool {
+	if strings.HasSuffix(prefix, "/") {
+		return strings.HasPrefix(path, prefix)
+	}
+
+	return path == prefix || strings.HasPrefix(path, prefix+"/")
+}
+
+// TrustedPrefixes parses the validated trusted proxies
+func (cfg *ServerConfig) TrustedPrefixes() []netip.Prefix {
+	prefixes := make([]netip.Prefix, 0, len(cfg.TrustedProxies))
+	for _, proxy := range cfg.TrustedProxies {
+		if prefix, err := ParsePrefix(proxy); err == nil {
+			prefixes = append(prefixes, prefix)
+		}
+	}
+
+	return prefixes
+}
+
+// Limit returns the limit of a route by its name, the zero limit turns it
+// off when rate limiting is disabled or the name is unknown
+func (cfg *RateLimitConfig) Limit(name string) RateLimit {
+	if !cfg.Enabled {
+		return RateLimit{}
+	}
+
+	switch name {
+	case "register":
+		return cfg.Register
+	case "login":
+		return cfg.Login
+	case "api":
+		return cfg.API
+	case "token":
+		return cfg.Token
+	}
+
+	return RateLimit{}
+}
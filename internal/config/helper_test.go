@@ -1,6 +1,7 @@
 package config_test
 
 import (
+	"net/netip"
 	"testing"
 
 	"github.com/alonsoF100/authorization-service/internal/config"
@@ -123,3 +124,36 @@ func TestServerConfig_PortStr(t *testing.T) {
 		})
 	}
 }
+
+func TestServerConfig_TrustedPrefixes(t *testing.T) {
+	cfg := &config.ServerConfig{TrustedProxies: []string{"10.1.2.3/8", "192.0.2.1", "2001:db8::1", "fd00::/8"}}
+
+	assert.Equal(t, []netip.Prefix{
+		netip.MustParsePrefix("10.0.0.0/8"),
+		netip.MustParsePrefix("192.0.2.1/32"),
+		netip.MustParsePrefix("2001:db8::1/128"),
+		netip.MustParsePrefix("fd00::/8"),
+	}, cfg.TrustedPrefixes())
+}
+
+func TestPathHasPrefix(t *testing.T) {
+	tests := []struct {
+		path     string
+		prefix   string
+		expected bool
+	}{
+		{"/admin", "/admin", true},
+		{"/admin/users", "/admin", true},
+		{"/administrator", "/admin", false},
+		{"/adm", "/admin", false},
+		{"/admin/users", "/admin/", true},
+		{"/admin", "/admin/", false},
+		{"/health", "/", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path+" "+tt.prefix, func(t *testing.T) {
+			assert.Equal(t, tt.expected, config.PathHasPrefix(tt.path, tt.prefix))
+		})
+	}
+}
@@ -1,33 +1,249 @@
 package config
 
 import (
+	"errors"
+	"fmt"
+	"io/fs"
 	"log"
-	"os"
+	"reflect"
+	"strings"
 
 	"github.com/joho/godotenv"
 	"github.com/spf13/viper"
 )
 
-func Load() *Config {
-	if err := godotenv.Load(); err != nil {
-		log.Fatal(".env file not found", err)
+const (
+	DefaultPath = "config.yaml"
+	EnvPrefix   = "AUTH"
+)
+
+// legacyEnv keeps the variable names used before the prefixed mapping working
+var legacyEnv = map[string]string{
+	"database.host":             "DB_HOST",
+	"database.port":             "DB_PORT",
+	"database.user":             "DB_USER",
+	"database.password":         "DB_PASSWORD",
+	"database.name":             "DB_NAME",
+	"database.ssl_mode":         "DB_SSL_MODE",
+	"jwt.secret_key":            "SECRET_KEY",
+	"logger.redaction.hmac_key": "LOG_HMAC_KEY",
+}
+
+func Load(path string) (*Config, error) {
+	const op = "config/loader.go/Load"
+
+	if err := godotenv.Load(); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("%s: failed to load .env file: %w", op, err)
 	}
 
-	viper.SetConfigFile("config.yaml")
+	v := newViper(path)
+	if err := readConfig(v, path); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
 
-	if err := viper.ReadInConfig(); err != nil {
-		log.Fatal("Error reading config file:", err)
+	config, err := decode(v)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
 	}
 
+	log.Println("Config loaded successfully")
+	return config, nil
+}
+
+func newViper(path string) *viper.Viper {
+	v := viper.New()
+
+	if path == "" {
+		path = DefaultPath
+	}
+	v.SetConfigFile(path)
+
+	setDefaults(v)
+
+	v.SetEnvPrefix(EnvPrefix)
+	v.SetEnvKeyReplacer(strings.NewReplacer(".", "_"))
+	v.AutomaticEnv()
+	bindEnv(v, reflect.TypeOf(Config{}), "")
+
+	return v
+}
+
+// readConfig reads the config file. The default file is optional so the
+// service can be configured through the environment only, an explicitly
+// passed path must exist.
+func readConfig(v *viper.Viper, path string) error {
+	err := v.ReadInConfig()
+	if err == nil {
+		return nil
+	}
+
+	if path == "" && errors.Is(err, fs.ErrNotExist) {
+		log.Println("Config file not found, using defaults and environment")
+		return nil
+	}
+
+	return fmt.Errorf("failed to read config file: %w", err)
+}
+
+func decode(v *viper.Viper) (*Config, error) {
 	var config Config
-	if err := viper.Unmarshal(&config); err != nil {
-		log.Fatal("Unable to decode config:", err)
+	if err := v.Unmarshal(&config); err != nil {
+		return nil, fmt.Errorf("failed to decode config: %w", err)
 	}
 
-	config.Database.User = os.Getenv("DB_USER")
-	config.Database.Password = os.Getenv("DB_PASSWORD")
-	config.JWT.SecretKey = os.Getenv("SECRET_KEY")
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
 
-	log.Println("Config loaded successfully")
-	return &config
+	return &config, nil
+}
+
+func setDefaults(v *viper.Viper) {
+	v.SetDefault("server.port", 8080)
+	v.SetDefault("server.read_timeout", "5s")
+	v.SetDefault("server.write_timeout", "10s")
+	v.SetDefault("server.idle_timeout", "10s")
+	v.SetDefault("server.shutdown_timeout", "10s")
+	v.SetDefault("server.trusted_proxies", []string{})
+
+	v.SetDefault("grpc.port", 50051)
+	v.SetDefault("grpc.reflection", true)
+
+	v.SetDefault("database.driver", DriverPostgres)
+	v.SetDefault("database.host", "localhost")
+	v.SetDefault("database.port", "5432")
+	v.SetDefault("database.name", "auth")
+	v.SetDefault("database.ssl_mode", "disable")
+	v.SetDefault("database.path", "auth.db")
+	v.SetDefault("database.statement_timeout", "5s")
+	v.SetDefault("database.query_timeout", "5s")
+	v.SetDefault("database.pool.max_conns", 10)
+	v.SetDefault("database.pool.min_conns", 2)
+	v.SetDefault("database.pool.max_conn_lifetime", "1h")
+	v.SetDefault("database.pool.max_conn_idle_time", "30m")
+	v.SetDefault("database.pool.health_check_period", "1m")
+	v.SetDefault("database.retry.connect_attempts", 10)
+	v.SetDefault("database.retry.connect_backoff", "500ms")
+	v.SetDefault("database.retry.connect_max_backoff", "10s")
+	v.SetDefault("database.retry.query_attempts", 3)
+	v.SetDefault("database.retry.query_backoff", "50ms")
+
+	v.SetDefault("logger.level", "info")
+	v.SetDefault("logger.json", false)
+	v.SetDefault("logger.redaction.mode", "partial")
+	v.SetDefault("logger.redaction.keys", []string{"email", "nickname", "ip", "token"})
+
+	v.SetDefault("migrations.dir", "migrations/postgres")
+
+	v.SetDefault("jwt.expiry", "24h")
+	v.SetDefault("jwt.accept_legacy_hs256", false)
+
+	v.SetDefault("forward_auth.headers.user_id", "X-User-Id")
+	v.SetDefault("forward_auth.headers.email", "X-User-Email")
+	v.SetDefault("forward_auth.headers.nickname", "X-User-Nickname")
+	v.SetDefault("forward_auth.headers.roles", "X-User-Roles")
+	v.SetDefault("forward_auth.required_roles_header", "X-Required-Roles")
+	v.SetDefault("forward_auth.cookie_name", "access_token")
+	v.SetDefault("forward_auth.cache.ttl", "30s")
+	v.SetDefault("forward_auth.cache.size", 10000)
+
+	v.SetDefault("ext_authz.enabled", false)
+
+	v.SetDefault("password.min_length", 8)
+	v.SetDefault("password.max_length", 72)
+
+	v.SetDefault("oauth.issuer", "http://localhost:8080")
+	v.SetDefault("oauth.code_ttl", "1m")
+	v.SetDefault("oauth.session_cookie", "auth_session")
+	v.SetDefault("oauth.csrf_cookie", "auth_csrf")
+	v.SetDefault("oauth.secure_cookie", true)
+
+	v.SetDefault("cookie.enabled", false)
+	v.SetDefault("cookie.name", "access_token")
+	v.SetDefault("cookie.path", "/")
+	v.SetDefault("cookie.same_site", SameSiteLax)
+	v.SetDefault("cookie.secure", true)
+	v.SetDefault("cookie.csrf_cookie", "csrf_token")
+	v.SetDefault("cookie.csrf_header", "X-CSRF-Token")
+
+	v.SetDefault("cors.allowed_origins", []string{})
+	v.SetDefault("cors.allowed_methods", []string{"GET", "POST", "PUT", "PATCH", "DELETE"})
+	v.SetDefault("cors.allowed_headers", []string{"Authorization", "Content-Type", "X-CSRF-Token"})
+	v.SetDefault("cors.exposed_headers", []string{})
+	v.SetDefault("cors.allow_credentials", false)
+	v.SetDefault("cors.max_age", "10m")
+
+	v.SetDefault("security_headers.hsts_max_age", "8760h")
+	v.SetDefault("security_headers.hsts_include_subdomains", false)
+	v.SetDefault("security_headers.no_sniff", true)
+	v.SetDefault("security_headers.referrer_policy", "no-referrer")
+	v.SetDefault("security_headers.frame_ancestors", "'none'")
+	v.SetDefault("security_headers.no_store", true)
+
+	v.SetDefault("rate_limit.enabled", true)
+	v.SetDefault("rate_limit.store", RateLimitStoreMemory)
+	v.SetDefault("rate_limit.shards", 32)
+	v.SetDefault("rate_limit.redis.addr", "localhost:6379")
+	v.SetDefault("rate_limit.redis.db", 0)
+	v.SetDefault("rate_limit.redis.key_prefix", "ratelimit:")
+	v.SetDefault("rate_limit.register.requests", 5)
+	v.SetDefault("rate_limit.register.period", "1h")
+	v.SetDefault("rate_limit.register.burst", 5)
+	v.SetDefault("rate_limit.login.requests", 10)
+	v.SetDefault("rate_limit.login.period", "1m")
+	v.SetDefault("rate_limit.login.burst", 10)
+	v.SetDefault("rate_limit.api.requests", 100)
+	v.SetDefault("rate_limit.api.period", "1m")
+	v.SetDefault("rate_limit.api.burst", 50)
+	v.SetDefault("rate_limit.token.requests", 60)
+	v.SetDefault("rate_limit.token.period", "1m")
+	v.SetDefault("rate_limit.token.burst", 20)
+
+	v.SetDefault("ip_filter.geoip_database", "")
+
+	v.SetDefault("audit.checkpoint_interval", "1h")
+
+	v.SetDefault("outbox.publisher", OutboxPublisherLog)
+	v.SetDefault("outbox.poll_interval", "1s")
+	v.SetDefault("outbox.batch_size", 100)
+	v.SetDefault("outbox.lease", "30s")
+	v.SetDefault("outbox.min_backoff", "1s")
+	v.SetDefault("outbox.max_backoff", "5m")
+	v.SetDefault("outbox.retention", "168h")
+	v.SetDefault("outbox.webhook.url", "")
+	v.SetDefault("outbox.webhook.secret", "")
+	v.SetDefault("outbox.webhook.timeout", "10s")
+}
+
+// bindEnv binds every leaf field of the config to AUTH_<SECTION>_<KEY>,
+// e.g. database.ssl_mode -> AUTH_DATABASE_SSL_MODE. Squashed structs bind
+// under the key of their parent.
+func bindEnv(v *viper.Viper, t reflect.Type, prefix string) {
+	for i := range t.NumField() {
+		field := t.Field(i)
+
+		key, opts, _ := strings.Cut(field.Tag.Get("mapstructure"), ",")
+		if opts == "squash" {
+			bindEnv(v, field.Type, prefix)
+			continue
+		}
+		if key == "" || key == "-" {
+			continue
+		}
+		if prefix != "" {
+			key = prefix + "." + key
+		}
+
+		if field.Type.Kind() == reflect.Struct {
+			bindEnv(v, field.Type, key)
+			continue
+		}
+
+		envs := []string{key, EnvPrefix + "_" + strings.ToUpper(strings.ReplaceAll(key, ".", "_"))}
+		if legacy, ok := legacyEnv[key]; ok {
+			envs = append(envs, legacy)
+		}
+
+		_ = v.BindEnv(envs...)
+	}
 }
@@ -34,7 +34,7 @@ func TestLoad(t *testing.T) {
 		},
 		JWT: config.JWTConfig{
 			Expiry:    time.Duration(24) * time.Hour,
-			SecretKey: "test-secret-key",
+			SecretKey: "test-secret-key-test-secret-key-test",
 		},
 	}
 
@@ -47,7 +47,7 @@ func TestLoad(t *testing.T) {
 
 	envContent := `DB_USER=testuser
 DB_PASSWORD=testpassword
-SECRET_KEY=test-secret-key`
+SECRET_KEY=test-secret-key-test-secret-key-test`
 
 	require.NoError(t, os.WriteFile(".env", []byte(envContent), 0644))
 
@@ -75,7 +75,8 @@ jwt:
 
 	require.NoError(t, os.WriteFile("config.yaml", []byte(yamlContent), 0644))
 
-	cfg := config.Load()
+	cfg, err := config.Load("")
+	require.NoError(t, err)
 	require.NotNil(t, cfg)
 
 	require.Equal(t, expectedCfg.Server.Port, cfg.Server.Port)
@@ -98,3 +99,83 @@ jwt:
 	require.Equal(t, expectedCfg.JWT.Expiry, expectedCfg.JWT.Expiry)
 	require.Equal(t, expectedCfg.JWT.SecretKey, cfg.JWT.SecretKey)
 }
+
+func TestLoadEnvOverrides(t *testing.T) {
+	t.Chdir(t.TempDir())
+
+	t.Setenv("AUTH_SERVER_PORT", "9090")
+	t.Setenv("AUTH_SERVER_READ_TIMEOUT", "3s")
+	t.Setenv("AUTH_DATABASE_HOST", "db.internal")
+	t.Setenv("AUTH_DATABASE_SSL_MODE", "require")
+	t.Setenv("AUTH_LOGGER_LEVEL", "warn")
+	t.Setenv("AUTH_LOGGER_REDACTION_KEYS", "email,token")
+	t.Setenv("AUTH_MIGRATIONS_DIR", "/migrations")
+	t.Setenv("AUTH_JWT_EXPIRY", "1h")
+	t.Setenv("AUTH_JWT_SECRET_KEY", "env-secret-key-env-secret-key-env")
+	t.Setenv("DB_USER", "legacy-user")
+	t.Setenv("AUTH_CORS_ALLOWED_ORIGINS", "https://app.example.com,https://*.example.com")
+	t.Setenv("AUTH_SECURITY_HEADERS_NO_STORE", "false")
+
+	cfg, err := config.Load("")
+	require.NoError(t, err)
+
+	require.Equal(t, 9090, cfg.Server.Port)
+	require.Equal(t, 3*time.Second, cfg.Server.ReadTimeout)
+	require.Equal(t, 10*time.Second, cfg.Server.WriteTimeout)
+	require.Equal(t, "db.internal", cfg.Database.Host)
+	require.Equal(t, "require", cfg.Database.SSLMode)
+	require.Equal(t, "legacy-user", cfg.Database.User)
+	require.Equal(t, "warn", cfg.Logger.Level)
+	require.Equal(t, []string{"email", "token"}, cfg.Logger.Redaction.Keys)
+	require.Equal(t, "/migrations", cfg.Migration.Dir)
+	require.Equal(t, time.Hour, cfg.JWT.Expiry)
+	require.Equal(t, "env-secret-key-env-secret-key-env", cfg.JWT.SecretKey)
+	require.Equal(t, []string{"https://app.example.com", "https://*.example.com"}, cfg.CORS.AllowedOrigins)
+	require.Equal(t, 10*time.Minute, cfg.CORS.MaxAge)
+	require.False(t, cfg.Security.NoStore)
+	require.True(t, cfg.Security.NoSniff)
+}
+
+func TestLoadPrefixedEnvWinsOverLegacy(t *testing.T) {
+	t.Chdir(t.TempDir())
+
+	t.Setenv("AUTH_JWT_SECRET_KEY", "prefixed-secret-prefixed-secret-prefixed")
+	t.Setenv("SECRET_KEY", "legacy-secret-legacy-secret-legacy-secret")
+
+	cfg, err := config.Load("")
+	require.NoError(t, err)
+	require.Equal(t, "prefixed-secret-prefixed-secret-prefixed", cfg.JWT.SecretKey)
+}
+
+func TestLoadExplicitPath(t *testing.T) {
+	dir := t.TempDir()
+	t.Chdir(dir)
+	t.Setenv("AUTH_JWT_SECRET_KEY", "env-secret-key-env-secret-key-env")
+
+	path := dir + "/custom.yaml"
+	require.NoError(t, os.WriteFile(path, []byte("server:\n  port: 7070\n"), 0644))
+
+	cfg, err := config.Load(path)
+	require.NoError(t, err)
+	require.Equal(t, 7070, cfg.Server.Port)
+
+	_, err = config.Load(dir + "/missing.yaml")
+	require.Error(t, err)
+}
+
+func TestLoadInvalid(t *testing.T) {
+	t.Chdir(t.TempDir())
+
+	t.Setenv("SECRET_KEY", "")
+	t.Setenv("AUTH_JWT_SECRET_KEY", "short")
+	t.Setenv("AUTH_LOGGER_LEVEL", "verbose")
+	t.Setenv("AUTH_SERVER_IDLE_TIMEOUT", "0s")
+
+	cfg, err := config.Load("")
+	require.Error(t, err)
+	require.Nil(t, cfg)
+
+	require.Contains(t, err.Error(), "jwt.secret_key")
+	require.Contains(t, err.Error(), "logger.level")
+	require.Contains(t, err.Error(), "server.idle_timeout")
+}
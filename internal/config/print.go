@@ -0,0 +1,68 @@
+package config
+
+import (
+	"maps"
+	"reflect"
+	"strings"
+	"time"
+
+	"go.yaml.in/yaml/v3"
+)
+
+const redacted = "***"
+
+// Redacted returns a copy of the config with every secret masked
+func (cfg Config) Redacted() Config {
+	mask := func(value *string) {
+		if *value != "" {
+			*value = redacted
+		}
+	}
+
+	mask(&cfg.Database.Password)
+	mask(&cfg.JWT.SecretKey)
+	mask(&cfg.JWT.KeyEncryptionKey)
+	mask(&cfg.Logger.Redaction.HMACKey)
+	mask(&cfg.RateLimit.Redis.Password)
+	mask(&cfg.Outbox.Webhook.Secret)
+
+	return cfg
+}
+
+// YAML renders the config with the same keys it is loaded from
+func (cfg Config) YAML() ([]byte, error) {
+	return yaml.Marshal(toMap(reflect.ValueOf(cfg)))
+}
+
+func toMap(value reflect.Value) map[string]any {
+	result := make(map[string]any, value.NumField())
+
+	for i := range value.NumField() {
+		key, opts, _ := strings.Cut(value.Type().Field(i).Tag.Get("mapstructure"), ",")
+		field := value.Field(i)
+		if opts == "squash" {
+			maps.Copy(result, toMap(field))
+			continue
+		}
+		if key == "" || key == "-" {
+			continue
+		}
+
+		switch {
+		case field.Kind() == reflect.Struct:
+			result[key] = toMap(field)
+		case field.Kind() == reflect.Slice && field.Type().Elem().Kind() == reflect.Struct:
+			items := make([]map[string]any, field.Len())
+			for j := range field.Len() {
+				items[j] = toMap(field.Index(j))
+			}
+			result[key] = items
+		case field.Type() == reflect.TypeOf(time.Duration(0)):
+			result[key] = time.Duration(field.Int()).String()
+		default:
+			result[key] = field.Interface()
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,56 @@
+package config_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alonsoF100/authorization-service/internal/config"
+	"github.com/stretchr/testify/require"
+	"go.yaml.in/yaml/v3"
+)
+
+func TestRedacted(t *testing.T) {
+	cfg := validConfig()
+	cfg.Database.Password = "postgres"
+	cfg.Logger.Redaction.HMACKey = "hmac"
+	cfg.JWT.KeyEncryptionKey = "keyEncryptionKeykeyEncryptionKey"
+
+	redacted := cfg.Redacted()
+
+	require.Equal(t, "***", redacted.Database.Password)
+	require.Equal(t, "***", redacted.JWT.SecretKey)
+	require.Equal(t, "***", redacted.JWT.KeyEncryptionKey)
+	require.Equal(t, "***", redacted.Logger.Redaction.HMACKey)
+	require.Equal(t, cfg.Server, redacted.Server)
+
+	// the original config is not modified
+	require.Equal(t, "postgres", cfg.Database.Password)
+
+	cfg.Logger.Redaction.HMACKey = ""
+	require.Empty(t, cfg.Redacted().Logger.Redaction.HMACKey)
+}
+
+func TestYAML(t *testing.T) {
+	cfg := validConfig()
+	cfg.Database.SSLMode = "disable"
+	cfg.CORS.AllowedOrigins = []string{"https://app.example.com"}
+	cfg.CORS.Routes = []config.CORSRoute{{Prefix: "/oauth", CORSPolicy: config.CORSPolicy{MaxAge: time.Minute}}}
+
+	out, err := cfg.Redacted().YAML()
+	require.NoError(t, err)
+
+	var printed map[string]map[string]any
+	require.NoError(t, yaml.Unmarshal(out, &printed))
+
+	require.Equal(t, 8080, printed["server"]["port"])
+	require.Equal(t, (5 * time.Second).String(), printed["server"]["read_timeout"])
+	require.Equal(t, "disable", printed["database"]["ssl_mode"])
+	require.Equal(t, "***", printed["jwt"]["secret_key"])
+	require.NotContains(t, string(out), cfg.JWT.SecretKey)
+
+	// squashed policies print under the key of their parent
+	require.Equal(t, []any{"https://app.example.com"}, printed["cors"]["allowed_origins"])
+	route := printed["cors"]["routes"].([]any)[0].(map[string]any)
+	require.Equal(t, "/oauth", route["prefix"])
+	require.Equal(t, "1m0s", route["max_age"])
+}
@@ -0,0 +1,133 @@
+package config
+
+import (
+	"fmt"
+	"log/slog"
+	"reflect"
+	"sync"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/spf13/viper"
+)
+
+// Reloader watches the config file and applies the reloadable subset of
+// settings at runtime. Everything else still requires a restart.
+type Reloader struct {
+	v    *viper.Viper
+	path string
+
+	mu          sync.Mutex
+	current     *Config
+	subscribers []func(cfg *Config)
+}
+
+func NewReloader(path string, current *Config) *Reloader {
+	return &Reloader{
+		v:       newViper(path),
+		path:    path,
+		current: current,
+	}
+}
+
+// Subscribe registers fn to be called with the new config after every
+// accepted reload.
+func (r *Reloader) Subscribe(fn func(cfg *Config)) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.subscribers = append(r.subscribers, fn)
+}
+
+func (r *Reloader) Current() *Config {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.current
+}
+
+func (r *Reloader) Start() {
+	const op = "config/reload.go/Start"
+
+	if err := r.v.ReadInConfig(); err != nil {
+		slog.Warn("Config hot reload disabled: config file is not available",
+			slog.String("op", op),
+			slog.String("error", err.Error()),
+		)
+		return
+	}
+
+	r.v.OnConfigChange(func(event fsnotify.Event) {
+		_ = r.Reload()
+	})
+	r.v.WatchConfig()
+
+	slog.Info("Watching config file for changes",
+		slog.String("op", op),
+		slog.String("file", r.v.ConfigFileUsed()),
+	)
+}
+
+// Reload re-reads the config file, validates it and applies the reloadable
+// settings. An invalid file leaves the current config untouched.
+func (r *Reloader) Reload() error {
+	const op = "config/reload.go/Reload"
+
+	if err := r.v.ReadInConfig(); err != nil {
+		slog.Error("Config reload rejected",
+			slog.String("op", op),
+			slog.String("error", err.Error()),
+		)
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	next, err := decode(r.v)
+	if err != nil {
+		slog.Error("Config reload rejected",
+			slog.String("op", op),
+			slog.String("error", err.Error()),
+		)
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	r.mu.Lock()
+	applied := reloadable(r.current, next)
+	if !reflect.DeepEqual(applied, next) {
+		slog.Warn("Config changes outside of reloadable settings are ignored until restart",
+			slog.String("op", op),
+		)
+	}
+	r.current = applied
+	subscribers := append([]func(cfg *Config){}, r.subscribers...)
+	r.mu.Unlock()
+
+	for _, subscriber := range subscribers {
+		subscriber(applied)
+	}
+
+	slog.Info("Config reload applied",
+		slog.String("op", op),
+		slog.String("log_level", applied.Logger.Level),
+		slog.Int("ip_filter_rules", len(applied.IPFilter.Rules)),
+	)
+
+	return nil
+}
+
+// reloadable returns a copy of current with only the settings that are safe
+// to change at runtime taken from next.
+func reloadable(current, next *Config) *Config {
+	applied := *current
+
+	applied.Logger.Level = next.Logger.Level
+	applied.IPFilter = next.IPFilter
+	applied.CORS = next.CORS
+	applied.Password = next.Password
+	// the store is picked at start, only the limits change
+	applied.RateLimit.Enabled = next.RateLimit.Enabled
+	applied.RateLimit.Register = next.RateLimit.Register
+	applied.RateLimit.Login = next.RateLimit.Login
+	applied.RateLimit.API = next.RateLimit.API
+	applied.RateLimit.Token = next.RateLimit.Token
+
+	return &applied
+}
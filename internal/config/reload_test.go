@@ -0,0 +1,168 @@
+package config_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/alonsoF100/authorization-service/internal/config"
+	"github.com/stretchr/testify/require"
+)
+
+const reloadYAML = `server:
+  port: %d
+logger:
+  level: %q
+`
+
+func writeReloadConfig(t *testing.T, path string, port int, level string) {
+	content := fmt.Sprintf(reloadYAML, port, level)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+}
+
+func newReloader(t *testing.T) (*config.Reloader, string) {
+	dir := t.TempDir()
+	t.Chdir(dir)
+	t.Setenv("AUTH_JWT_SECRET_KEY", "env-secret-key-env-secret-key-env")
+
+	path := filepath.Join(dir, "config.yaml")
+	writeReloadConfig(t, path, 8080, "info")
+
+	cfg, err := config.Load(path)
+	require.NoError(t, err)
+
+	return config.NewReloader(path, cfg), path
+}
+
+func TestReloaderAppliesReloadableSettings(t *testing.T) {
+	reloader, path := newReloader(t)
+
+	var received *config.Config
+	reloader.Subscribe(func(cfg *config.Config) {
+		received = cfg
+	})
+
+	writeReloadConfig(t, path, 9090, "debug")
+	require.NoError(t, reloader.Reload())
+
+	require.NotNil(t, received)
+	require.Equal(t, "debug", received.Logger.Level)
+	// port is not reloadable
+	require.Equal(t, 8080, received.Server.Port)
+	require.Equal(t, received, reloader.Current())
+}
+
+func TestReloaderAppliesIPFilter(t *testing.T) {
+	reloader, path := newReloader(t)
+
+	content := fmt.Sprintf(reloadYAML, 8080, "info") + `ip_filter:
+  rules:
+    - prefix: "/admin"
+      allow: ["10.0.0.0/8"]
+`
+	require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+	require.NoError(t, reloader.Reload())
+
+	require.Equal(t, []config.IPRule{{Prefix: "/admin", Allow: []string{"10.0.0.0/8"}}}, reloader.Current().IPFilter.Rules)
+}
+
+func TestReloaderAppliesCORS(t *testing.T) {
+	reloader, path := newReloader(t)
+
+	content := fmt.Sprintf(reloadYAML, 8080, "info") + `cors:
+  allowed_origins: ["https://app.example.com"]
+`
+	require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+	require.NoError(t, reloader.Reload())
+
+	require.Equal(t, []string{"https://app.example.com"}, reloader.Current().CORS.AllowedOrigins)
+}
+
+func TestReloaderAppliesRateLimit(t *testing.T) {
+	reloader, path := newReloader(t)
+
+	content := fmt.Sprintf(reloadYAML, 8080, "info") + `rate_limit:
+  enabled: true
+  store: redis
+  redis:
+    addr: "localhost:6379"
+  login:
+    requests: 3
+    period: 1m
+    burst: 3
+`
+	require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+	require.NoError(t, reloader.Reload())
+
+	limits := reloader.Current().RateLimit
+	require.Equal(t, config.RateLimit{Requests: 3, Period: time.Minute, Burst: 3}, limits.Limit("login"))
+	// the store is picked at start
+	require.Equal(t, "memory", limits.Store)
+}
+
+func TestReloaderAppliesPasswordPolicy(t *testing.T) {
+	reloader, path := newReloader(t)
+	require.Equal(t, config.PasswordConfig{MinLength: 8, MaxLength: 72}, reloader.Current().Password)
+
+	content := fmt.Sprintf(reloadYAML, 8080, "info") + `password:
+  min_length: 12
+  max_length: 64
+`
+	require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+	require.NoError(t, reloader.Reload())
+
+	require.Equal(t, config.PasswordConfig{MinLength: 12, MaxLength: 64}, reloader.Current().Password)
+
+	content = fmt.Sprintf(reloadYAML, 8080, "info") + `password:
+  min_length: 12
+  max_length: 100
+`
+	require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+	require.Error(t, reloader.Reload())
+	require.Equal(t, 64, reloader.Current().Password.MaxLength)
+}
+
+func TestReloaderRejectsInvalidConfig(t *testing.T) {
+	reloader, path := newReloader(t)
+
+	called := false
+	reloader.Subscribe(func(cfg *config.Config) {
+		called = true
+	})
+
+	writeReloadConfig(t, path, 8080, "verbose")
+	require.Error(t, reloader.Reload())
+
+	require.NoError(t, os.WriteFile(path, []byte("server: [port"), 0644))
+	require.Error(t, reloader.Reload())
+
+	require.False(t, called)
+	require.Equal(t, "info", reloader.Current().Logger.Level)
+}
+
+func TestReloaderWatchesFile(t *testing.T) {
+	reloader, path := newReloader(t)
+
+	levels := make(chan string, 10)
+	reloader.Subscribe(func(cfg *config.Config) {
+		levels <- cfg.Logger.Level
+	})
+	reloader.Start()
+
+	writeReloadConfig(t, path, 8080, "warn")
+
+	// a write may be observed as several events, e.g. truncate and write
+	timeout := time.After(5 * time.Second)
+	for {
+		select {
+		case level := <-levels:
+			if level == "warn" {
+				return
+			}
+		case <-timeout:
+			t.Fatal("config change was not picked up")
+		}
+	}
+}
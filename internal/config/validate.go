@@ -0,0 +1,391 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+)
+
+const MinSecretKeyLength = 32
+
+// MaxTokenCacheTTL bounds how long a revoked session or token keeps passing
+// forward auth from the cache
+const MaxTokenCacheTTL = time.Minute
+
+// MaxPasswordBytes is the longest password bcrypt hashes
+const MaxPasswordBytes = 72
+
+const (
+	DriverPostgres = "postgres"
+	DriverMemory   = "memory"
+	DriverSQLite   = "sqlite"
+)
+
+const (
+	RateLimitStoreMemory = "memory"
+	RateLimitStoreRedis  = "redis"
+)
+
+const (
+	OutboxPublisherLog     = "log"
+	OutboxPublisherWebhook = "webhook"
+)
+
+const (
+	SameSiteStrict = "strict"
+	SameSiteLax    = "lax"
+	SameSiteNone   = "none"
+)
+
+var (
+	ReferrerPolicies = []string{
+		"no-referrer", "no-referrer-when-downgrade", "origin", "origin-when-cross-origin",
+		"same-origin", "strict-origin", "strict-origin-when-cross-origin", "unsafe-url",
+	}
+	Drivers          = []string{DriverPostgres, DriverMemory, DriverSQLite}
+	LogLevels        = []string{"debug", "info", "warn", "error"}
+	RedactionModes   = []string{"mask", "partial", "hmac"}
+	SameSiteModes    = []string{SameSiteStrict, SameSiteLax, SameSiteNone}
+	RateLimitStores  = []string{RateLimitStoreMemory, RateLimitStoreRedis}
+	OutboxPublishers = []string{OutboxPublisherLog, OutboxPublisherWebhook}
+)
+
+// Validate checks the whole config and reports every problem at once
+func (cfg *Config) Validate() error {
+	var errs []error
+
+	if cfg.Server.Port <= 0 || cfg.Server.Port > 65535 {
+		errs = append(errs, fmt.Errorf("server.port must be between 1 and 65535, got %d", cfg.Server.Port))
+	}
+	if cfg.Server.ReadTimeout <= 0 {
+		errs = append(errs, errors.New("server.read_timeout must be positive"))
+	}
+	if cfg.Server.WriteTimeout <= 0 {
+		errs = append(errs, errors.New("server.write_timeout must be positive"))
+	}
+	if cfg.Server.IdleTimeout <= 0 {
+		errs = append(errs, errors.New("server.idle_timeout must be positive"))
+	}
+	if cfg.Server.ShutdownTimeout <= 0 {
+		errs = append(errs, errors.New("server.shutdown_timeout must be positive"))
+	}
+
+	for _, proxy := range cfg.Server.TrustedProxies {
+		if _, err := ParsePrefix(proxy); err != nil {
+			errs = append(errs, fmt.Errorf("server.trusted_proxies must hold CIDRs or IPs, got %q", proxy))
+		}
+	}
+
+	if cfg.GRPC.Port <= 0 || cfg.GRPC.Port > 65535 {
+		errs = append(errs, fmt.Errorf("grpc.port must be between 1 and 65535, got %d", cfg.GRPC.Port))
+	} else if cfg.GRPC.Port == cfg.Server.Port {
+		errs = append(errs, fmt.Errorf("grpc.port must differ from server.port %d", cfg.Server.Port))
+	}
+
+	errs = append(errs, cfg.Database.validate()...)
+
+	if !slices.Contains(LogLevels, cfg.Logger.Level) {
+		errs = append(errs, fmt.Errorf("logger.level must be one of %v, got %q", LogLevels, cfg.Logger.Level))
+	}
+	if len(cfg.Logger.Redaction.Keys) > 0 {
+		if !slices.Contains(RedactionModes, cfg.Logger.Redaction.Mode) {
+			errs = append(errs, fmt.Errorf("logger.redaction.mode must be one of %v, got %q", RedactionModes, cfg.Logger.Redaction.Mode))
+		}
+		if cfg.Logger.Redaction.Mode == "hmac" && cfg.Logger.Redaction.HMACKey == "" {
+			errs = append(errs, errors.New("logger.redaction.hmac_key must not be empty in hmac mode"))
+		}
+	}
+
+	if cfg.JWT.SecretKey == "" {
+		errs = append(errs, errors.New("jwt.secret_key must not be empty"))
+	} else if len(cfg.JWT.SecretKey) < MinSecretKeyLength {
+		errs = append(errs, fmt.Errorf("jwt.secret_key must be at least %d characters long", MinSecretKeyLength))
+	}
+	if cfg.JWT.KeyEncryptionKey != "" && len(cfg.JWT.KeyEncryptionKey) < MinSecretKeyLength {
+		errs = append(errs, fmt.Errorf("jwt.key_encryption_key must be at least %d characters long", MinSecretKeyLength))
+	}
+	if cfg.JWT.Expiry <= 0 {
+		errs = append(errs, errors.New("jwt.expiry must be positive"))
+	}
+
+	if cfg.ForwardAuth.Cache.TTL < 0 || cfg.ForwardAuth.Cache.Size < 0 {
+		errs = append(errs, errors.New("forward_auth.cache ttl and size must not be negative"))
+	}
+	if cfg.ForwardAuth.Cache.TTL > MaxTokenCacheTTL {
+		errs = append(errs, fmt.Errorf("forward_auth.cache.ttl must be at most %s", MaxTokenCacheTTL))
+	}
+
+	for i, rule := range cfg.ExtAuthz.Rules {
+		if !strings.HasPrefix(rule.Prefix, "/") {
+			errs = append(errs, fmt.Errorf("ext_authz.rules[%d].prefix must start with /, got %q", i, rule.Prefix))
+		}
+		if rule.Public && (len(rule.Roles) > 0 || len(rule.Scopes) > 0) {
+			errs = append(errs, fmt.Errorf("ext_authz.rules[%d] is public and can't require roles or scopes", i))
+		}
+	}
+
+	errs = append(errs, cfg.Password.validate()...)
+
+	if issuer, err := url.Parse(cfg.OAuth.Issuer); err != nil || (issuer.Scheme != "https" && issuer.Scheme != "http") ||
+		issuer.Host == "" || issuer.RawQuery != "" || issuer.Fragment != "" || strings.HasSuffix(issuer.Path, "/") {
+		errs = append(errs, fmt.Errorf("oauth.issuer must be an http(s) url without query, fragment and trailing slash, got %q", cfg.OAuth.Issuer))
+	}
+	if cfg.OAuth.CodeTTL <= 0 {
+		errs = append(errs, errors.New("oauth.code_ttl must be positive"))
+	}
+	if cfg.OAuth.SessionCookie == "" || cfg.OAuth.CSRFCookie == "" {
+		errs = append(errs, errors.New("oauth.session_cookie and csrf_cookie must not be empty"))
+	} else if cfg.OAuth.SessionCookie == cfg.OAuth.CSRFCookie {
+		errs = append(errs, errors.New("oauth.csrf_cookie must differ from oauth.session_cookie"))
+	}
+
+	if cfg.Cookie.Enabled {
+		errs = append(errs, cfg.Cookie.validate()...)
+	}
+
+	if cfg.RateLimit.Enabled {
+		errs = append(errs, cfg.RateLimit.validate()...)
+	}
+
+	errs = append(errs, cfg.IPFilter.validate()...)
+
+	errs = append(errs, cfg.CORS.validate("cors")...)
+	for i, route := range cfg.CORS.Routes {
+		name := fmt.Sprintf("cors.routes[%d]", i)
+		if !strings.HasPrefix(route.Prefix, "/") {
+			errs = append(errs, fmt.Errorf("%s.prefix must start with /, got %q", name, route.Prefix))
+		}
+		errs = append(errs, route.validate(name)...)
+	}
+
+	errs = append(errs, cfg.Security.validate("security_headers")...)
+	for i, route := range cfg.Security.Routes {
+		name := fmt.Sprintf("security_headers.routes[%d]", i)
+		if !strings.HasPrefix(route.Prefix, "/") {
+			errs = append(errs, fmt.Errorf("%s.prefix must start with /, got %q", name, route.Prefix))
+		}
+		errs = append(errs, route.validate(name)...)
+	}
+
+	if cfg.Audit.CheckpointInterval < 0 {
+		errs = append(errs, errors.New("audit.checkpoint_interval must not be negative"))
+	}
+
+	errs = append(errs, cfg.Outbox.validate()...)
+
+	if len(errs) > 0 {
+		return fmt.Errorf("invalid config:\n%w", errors.Join(errs...))
+	}
+
+	return nil
+}
+
+func (cfg *PasswordConfig) validate() []error {
+	var errs []error
+
+	if cfg.MinLength < 1 {
+		errs = append(errs, errors.New("password.min_length must be positive"))
+	}
+	if cfg.MaxLength < cfg.MinLength {
+		errs = append(errs, errors.New("password.max_length must not be less than password.min_length"))
+	}
+	if cfg.MaxLength > MaxPasswordBytes {
+		errs = append(errs, fmt.Errorf("password.max_length must be at most %d", MaxPasswordBytes))
+	}
+
+	return errs
+}
+
+func (cfg *CookieConfig) validate() []error {
+	var errs []error
+
+	if cfg.Name == "" || cfg.CSRFCookie == "" || cfg.CSRFHeader == "" {
+		errs = append(errs, errors.New("cookie.name, csrf_cookie and csrf_header must not be empty"))
+	} else if cfg.Name == cfg.CSRFCookie {
+		errs = append(errs, errors.New("cookie.csrf_cookie must differ from cookie.name"))
+	}
+	if !strings.HasPrefix(cfg.Path, "/") {
+		errs = append(errs, fmt.Errorf("cookie.path must start with /, got %q", cfg.Path))
+	}
+	if !slices.Contains(SameSiteModes, cfg.SameSite) {
+		errs = append(errs, fmt.Errorf("cookie.same_site must be one of %v, got %q", SameSiteModes, cfg.SameSite))
+	} else if cfg.SameSite == SameSiteNone && !cfg.Secure {
+		errs = append(errs, errors.New("cookie.same_site none requires cookie.secure"))
+	}
+
+	return errs
+}
+
+func (cfg *RateLimitConfig) validate() []error {
+	var errs []error
+
+	if !slices.Contains(RateLimitStores, cfg.Store) {
+		errs = append(errs, fmt.Errorf("rate_limit.store must be one of %v, got %q", RateLimitStores, cfg.Store))
+	}
+	if cfg.Store == RateLimitStoreMemory && cfg.Shards <= 0 {
+		errs = append(errs, errors.New("rate_limit.shards must be positive"))
+	}
+	if cfg.Store == RateLimitStoreRedis && cfg.Redis.Addr == "" {
+		errs = append(errs, errors.New("rate_limit.redis.addr must not be empty for the redis store"))
+	}
+
+	limits := map[string]RateLimit{"register": cfg.Register, "login": cfg.Login, "api": cfg.API, "token": cfg.Token}
+	for _, name := range slices.Sorted(maps.Keys(limits)) {
+		limit := limits[name]
+		if limit.Requests < 0 || limit.Burst < 0 {
+			errs = append(errs, fmt.Errorf("rate_limit.%s requests and burst must not be negative", name))
+		}
+		if limit.Requests > 0 && limit.Period <= 0 {
+			errs = append(errs, fmt.Errorf("rate_limit.%s.period must be positive", name))
+		}
+	}
+
+	return errs
+}
+
+func (cfg *OutboxConfig) validate() []error {
+	var errs []error
+
+	if !slices.Contains(OutboxPublishers, cfg.Publisher) {
+		errs = append(errs, fmt.Errorf("outbox.publisher must be one of %v, got %q", OutboxPublishers, cfg.Publisher))
+	}
+	if cfg.PollInterval <= 0 || cfg.Lease <= 0 || cfg.MinBackoff <= 0 {
+		errs = append(errs, errors.New("outbox.poll_interval, lease and min_backoff must be positive"))
+	}
+	if cfg.BatchSize <= 0 {
+		errs = append(errs, errors.New("outbox.batch_size must be positive"))
+	}
+	if cfg.MaxBackoff < cfg.MinBackoff {
+		errs = append(errs, errors.New("outbox.max_backoff must not be less than min_backoff"))
+	}
+	if cfg.Retention < 0 {
+		errs = append(errs, errors.New("outbox.retention must not be negative"))
+	}
+
+	if cfg.Publisher == OutboxPublisherWebhook {
+		if webhook, err := url.Parse(cfg.Webhook.URL); err != nil || (webhook.Scheme != "https" && webhook.Scheme != "http") || webhook.Host == "" {
+			errs = append(errs, fmt.Errorf("outbox.webhook.url must be an http(s) url, got %q", cfg.Webhook.URL))
+		}
+		// an event still being delivered must not be claimed again
+		if cfg.Webhook.Timeout <= 0 || cfg.Webhook.Timeout >= cfg.Lease {
+			errs = append(errs, errors.New("outbox.webhook.timeout must be positive and less than outbox.lease"))
+		}
+	}
+
+	return errs
+}
+
+func (cfg *IPFilterConfig) validate() []error {
+	var errs []error
+
+	for i, rule := range cfg.Rules {
+		name := fmt.Sprintf("ip_filter.rules[%d]", i)
+		if !strings.HasPrefix(rule.Prefix, "/") {
+			errs = append(errs, fmt.Errorf("%s.prefix must start with /, got %q", name, rule.Prefix))
+		}
+		for _, value := range slices.Concat(rule.Allow, rule.Deny) {
+			if _, err := ParsePrefix(value); err != nil {
+				errs = append(errs, fmt.Errorf("%s allow and deny must hold CIDRs or IPs, got %q", name, value))
+			}
+		}
+		for _, country := range slices.Concat(rule.AllowCountries, rule.DenyCountries) {
+			if len(country) != 2 || strings.ToUpper(country) != country {
+				errs = append(errs, fmt.Errorf("%s countries must be ISO 3166 codes like DE, got %q", name, country))
+			}
+		}
+		if len(rule.AllowCountries)+len(rule.DenyCountries) > 0 && cfg.GeoIPDatabase == "" {
+			errs = append(errs, fmt.Errorf("%s country rules need ip_filter.geoip_database", name))
+		}
+	}
+
+	return errs
+}
+
+func (cfg *CORSPolicy) validate(name string) []error {
+	var errs []error
+
+	for _, origin := range cfg.AllowedOrigins {
+		if origin == "*" {
+			if cfg.AllowCredentials {
+				errs = append(errs, fmt.Errorf("%s.allowed_origins can't be * with allow_credentials", name))
+			}
+			continue
+		}
+		if !validOrigin(origin) {
+			errs = append(errs, fmt.Errorf("%s.allowed_origins must be *, scheme://host[:port] or scheme://*.host[:port], got %q", name, origin))
+		}
+	}
+	if cfg.MaxAge < 0 {
+		errs = append(errs, fmt.Errorf("%s.max_age must not be negative", name))
+	}
+
+	return errs
+}
+
+// validOrigin accepts an origin with an optional wildcard subdomain
+func validOrigin(origin string) bool {
+	origin = strings.Replace(origin, "://*.", "://wildcard.", 1)
+
+	u, err := url.Parse(origin)
+	if err != nil || u.Scheme == "" || u.Host == "" || strings.Contains(u.Host, "*") {
+		return false
+	}
+
+	// anything after the host, like a path or a trailing slash, never
+	// matches the Origin header
+	return strings.EqualFold(u.Scheme+"://"+u.Host, origin)
+}
+
+func (cfg *SecurityPolicy) validate(name string) []error {
+	var errs []error
+
+	if cfg.HSTSMaxAge < 0 {
+		errs = append(errs, fmt.Errorf("%s.hsts_max_age must not be negative", name))
+	}
+	if cfg.ReferrerPolicy != "" && !slices.Contains(ReferrerPolicies, cfg.ReferrerPolicy) {
+		errs = append(errs, fmt.Errorf("%s.referrer_policy must be one of %v, got %q", name, ReferrerPolicies, cfg.ReferrerPolicy))
+	}
+
+	return errs
+}
+
+func (cfg *DatabaseConfig) validate() []error {
+	var errs []error
+
+	if !slices.Contains(Drivers, cfg.Driver) {
+		errs = append(errs, fmt.Errorf("database.driver must be one of %v, got %q", Drivers, cfg.Driver))
+	}
+	if cfg.Driver == DriverSQLite && cfg.Path == "" {
+		errs = append(errs, errors.New("database.path must not be empty for the sqlite driver"))
+	}
+
+	if cfg.StatementTimeout < 0 {
+		errs = append(errs, errors.New("database.statement_timeout must not be negative"))
+	}
+	if cfg.QueryTimeout < 0 {
+		errs = append(errs, errors.New("database.query_timeout must not be negative"))
+	}
+
+	if cfg.Pool.MaxConns < 0 || cfg.Pool.MinConns < 0 {
+		errs = append(errs, errors.New("database.pool connection limits must not be negative"))
+	}
+	if cfg.Pool.MaxConns > 0 && cfg.Pool.MinConns > cfg.Pool.MaxConns {
+		errs = append(errs, fmt.Errorf("database.pool.min_conns (%d) must not exceed max_conns (%d)", cfg.Pool.MinConns, cfg.Pool.MaxConns))
+	}
+	if cfg.Pool.MaxConnLifetime < 0 || cfg.Pool.MaxConnIdleTime < 0 || cfg.Pool.HealthCheckPeriod < 0 {
+		errs = append(errs, errors.New("database.pool durations must not be negative"))
+	}
+
+	if cfg.Retry.ConnectAttempts < 0 || cfg.Retry.QueryAttempts < 0 {
+		errs = append(errs, errors.New("database.retry attempts must not be negative"))
+	}
+	if cfg.Retry.ConnectBackoff < 0 || cfg.Retry.ConnectMaxBackoff < 0 || cfg.Retry.QueryBackoff < 0 {
+		errs = append(errs, errors.New("database.retry backoffs must not be negative"))
+	}
+
+	return errs
+}
@@ -0,0 +1,377 @@
+package config_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alonsoF100/authorization-service/internal/config"
+	"github.com/stretchr/testify/require"
+)
+
+func validConfig() *config.Config {
+	return &config.Config{
+		Server: config.ServerConfig{
+			Port:            8080,
+			ReadTimeout:     5 * time.Second,
+			WriteTimeout:    10 * time.Second,
+			IdleTimeout:     10 * time.Second,
+			ShutdownTimeout: 10 * time.Second,
+		},
+		GRPC: config.GRPCConfig{
+			Port: 50051,
+		},
+		Database: config.DatabaseConfig{
+			Driver: config.DriverPostgres,
+		},
+		Logger: config.LoggerConfig{
+			Level: "info",
+			Redaction: config.RedactionConfig{
+				Mode: "partial",
+				Keys: []string{"email"},
+			},
+		},
+		JWT: config.JWTConfig{
+			SecretKey: "someSecretsomeSecretsomeSecretsomeSecret",
+			Expiry:    24 * time.Hour,
+		},
+		Password: config.PasswordConfig{
+			MinLength: 8,
+			MaxLength: 72,
+		},
+		OAuth: config.OAuthConfig{
+			Issuer:        "https://auth.example.com",
+			CodeTTL:       time.Minute,
+			SessionCookie: "auth_session",
+			CSRFCookie:    "auth_csrf",
+		},
+		Outbox: config.OutboxConfig{
+			Publisher:    config.OutboxPublisherLog,
+			PollInterval: time.Second,
+			BatchSize:    100,
+			Lease:        30 * time.Second,
+			MinBackoff:   time.Second,
+			MaxBackoff:   5 * time.Minute,
+		},
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name           string
+		modify         func(cfg *config.Config)
+		expectedErrors []string
+	}{
+		{
+			name:   "valid",
+			modify: func(cfg *config.Config) {},
+		},
+		{
+			name:           "empty secret",
+			modify:         func(cfg *config.Config) { cfg.JWT.SecretKey = "" },
+			expectedErrors: []string{"jwt.secret_key must not be empty"},
+		},
+		{
+			name:           "short secret",
+			modify:         func(cfg *config.Config) { cfg.JWT.SecretKey = "secret" },
+			expectedErrors: []string{"jwt.secret_key must be at least 32 characters long"},
+		},
+		{
+			name:           "short key encryption key",
+			modify:         func(cfg *config.Config) { cfg.JWT.KeyEncryptionKey = "kek" },
+			expectedErrors: []string{"jwt.key_encryption_key must be at least 32 characters long"},
+		},
+		{
+			name: "non positive timeouts",
+			modify: func(cfg *config.Config) {
+				cfg.Server.ReadTimeout = 0
+				cfg.Server.WriteTimeout = -time.Second
+				cfg.JWT.Expiry = 0
+			},
+			expectedErrors: []string{"server.read_timeout", "server.write_timeout", "jwt.expiry"},
+		},
+		{
+			name:           "unknown log level",
+			modify:         func(cfg *config.Config) { cfg.Logger.Level = "trace" },
+			expectedErrors: []string{"logger.level"},
+		},
+		{
+			name:           "invalid port",
+			modify:         func(cfg *config.Config) { cfg.Server.Port = 70000 },
+			expectedErrors: []string{"server.port"},
+		},
+		{
+			name:           "grpc port clashes with http port",
+			modify:         func(cfg *config.Config) { cfg.GRPC.Port = cfg.Server.Port },
+			expectedErrors: []string{"grpc.port must differ"},
+		},
+		{
+			name: "hmac redaction without key",
+			modify: func(cfg *config.Config) {
+				cfg.Logger.Redaction.Mode = "hmac"
+			},
+			expectedErrors: []string{"logger.redaction.hmac_key"},
+		},
+		{
+			name: "unknown redaction mode",
+			modify: func(cfg *config.Config) {
+				cfg.Logger.Redaction.Mode = "blur"
+			},
+			expectedErrors: []string{"logger.redaction.mode"},
+		},
+		{
+			name: "negative forward auth cache",
+			modify: func(cfg *config.Config) {
+				cfg.ForwardAuth.Cache.TTL = -time.Second
+			},
+			expectedErrors: []string{"forward_auth.cache"},
+		},
+		{
+			name: "forward auth cache ttl too long",
+			modify: func(cfg *config.Config) {
+				cfg.ForwardAuth.Cache.TTL = time.Hour
+			},
+			expectedErrors: []string{"forward_auth.cache.ttl"},
+		},
+		{
+			name: "invalid password policy",
+			modify: func(cfg *config.Config) {
+				cfg.Password.MinLength = 0
+				cfg.Password.MaxLength = 100
+			},
+			expectedErrors: []string{"password.min_length", "password.max_length must be at most 72"},
+		},
+		{
+			name: "password max length below min length",
+			modify: func(cfg *config.Config) {
+				cfg.Password.MinLength = 12
+				cfg.Password.MaxLength = 10
+			},
+			expectedErrors: []string{"password.max_length must not be less than password.min_length"},
+		},
+		{
+			name: "invalid ext authz rules",
+			modify: func(cfg *config.Config) {
+				cfg.ExtAuthz.Rules = []config.RouteRule{
+					{Prefix: "admin", Roles: []string{"admin"}},
+					{Prefix: "/health", Public: true, Scopes: []string{"read"}},
+				}
+			},
+			expectedErrors: []string{"ext_authz.rules[0].prefix", "ext_authz.rules[1] is public"},
+		},
+		{
+			name: "invalid oauth",
+			modify: func(cfg *config.Config) {
+				cfg.OAuth.Issuer = "https://auth.example.com/"
+				cfg.OAuth.CodeTTL = 0
+				cfg.OAuth.SessionCookie = ""
+			},
+			expectedErrors: []string{"oauth.issuer", "oauth.code_ttl", "oauth.session_cookie"},
+		},
+		{
+			name: "same oauth cookies",
+			modify: func(cfg *config.Config) {
+				cfg.OAuth.CSRFCookie = cfg.OAuth.SessionCookie
+			},
+			expectedErrors: []string{"oauth.csrf_cookie must differ"},
+		},
+		{
+			name: "disabled cookie mode is not checked",
+			modify: func(cfg *config.Config) {
+				cfg.Cookie = config.CookieConfig{SameSite: "whatever"}
+			},
+		},
+		{
+			name: "invalid cookie mode",
+			modify: func(cfg *config.Config) {
+				cfg.Cookie = config.CookieConfig{
+					Enabled:    true,
+					Name:       "access_token",
+					CSRFCookie: "access_token",
+					CSRFHeader: "X-CSRF-Token",
+					Path:       "api",
+					SameSite:   config.SameSiteNone,
+				}
+			},
+			expectedErrors: []string{"cookie.csrf_cookie must differ", "cookie.path", "cookie.same_site none requires cookie.secure"},
+		},
+		{
+			name: "valid cors",
+			modify: func(cfg *config.Config) {
+				cfg.CORS.AllowedOrigins = []string{"https://app.example.com", "https://*.example.com", "http://localhost:3000"}
+				cfg.CORS.AllowCredentials = true
+				cfg.CORS.Routes = []config.CORSRoute{
+					{Prefix: "/.well-known", CORSPolicy: config.CORSPolicy{AllowedOrigins: []string{"*"}}},
+				}
+			},
+		},
+		{
+			name: "invalid cors",
+			modify: func(cfg *config.Config) {
+				cfg.CORS.AllowedOrigins = []string{"https://app.example.com/", "app.example.com", "https://a*.example.com"}
+				cfg.CORS.MaxAge = -time.Second
+				cfg.CORS.Routes = []config.CORSRoute{
+					{Prefix: "oauth", CORSPolicy: config.CORSPolicy{AllowedOrigins: []string{"*"}, AllowCredentials: true}},
+				}
+			},
+			expectedErrors: []string{
+				`got "https://app.example.com/"`, `got "app.example.com"`, `got "https://a*.example.com"`,
+				"cors.max_age", "cors.routes[0].prefix", "cors.routes[0].allowed_origins can't be *",
+			},
+		},
+		{
+			name: "invalid security headers",
+			modify: func(cfg *config.Config) {
+				cfg.Security.HSTSMaxAge = -time.Second
+				cfg.Security.Routes = []config.SecurityRoute{
+					{Prefix: "/.well-known", SecurityPolicy: config.SecurityPolicy{ReferrerPolicy: "never"}},
+				}
+			},
+			expectedErrors: []string{"security_headers.hsts_max_age", "security_headers.routes[0].referrer_policy"},
+		},
+		{
+			name: "invalid trusted proxies",
+			modify: func(cfg *config.Config) {
+				cfg.Server.TrustedProxies = []string{"10.0.0.0/8", "192.0.2.1", "proxy.internal"}
+			},
+			expectedErrors: []string{`got "proxy.internal"`},
+		},
+		{
+			name: "disabled rate limiting is not checked",
+			modify: func(cfg *config.Config) {
+				cfg.RateLimit = config.RateLimitConfig{Store: "memcached"}
+			},
+		},
+		{
+			name: "invalid rate limiting",
+			modify: func(cfg *config.Config) {
+				cfg.RateLimit = config.RateLimitConfig{
+					Enabled: true,
+					Store:   config.RateLimitStoreRedis,
+					Login:   config.RateLimit{Requests: 10},
+					API:     config.RateLimit{Requests: -1, Period: time.Minute},
+				}
+			},
+			expectedErrors: []string{"rate_limit.redis.addr", "rate_limit.login.period", "rate_limit.api requests and burst"},
+		},
+		{
+			name: "invalid ip filter",
+			modify: func(cfg *config.Config) {
+				cfg.IPFilter.Rules = []config.IPRule{
+					{Prefix: "/admin", Allow: []string{"10.0.0.0/8", "office"}},
+					{Prefix: "auth", Deny: []string{"192.0.2.0/24"}, DenyCountries: []string{"xx"}},
+				}
+			},
+			expectedErrors: []string{
+				`ip_filter.rules[0] allow and deny must hold CIDRs or IPs, got "office"`,
+				"ip_filter.rules[1].prefix", `got "xx"`, "ip_filter.rules[1] country rules need ip_filter.geoip_database",
+			},
+		},
+		{
+			name:           "negative audit checkpoint interval",
+			modify:         func(cfg *config.Config) { cfg.Audit.CheckpointInterval = -time.Minute },
+			expectedErrors: []string{"audit.checkpoint_interval must not be negative"},
+		},
+		{
+			name: "invalid outbox",
+			modify: func(cfg *config.Config) {
+				cfg.Outbox.BatchSize = 0
+				cfg.Outbox.MaxBackoff = time.Millisecond
+			},
+			expectedErrors: []string{"outbox.batch_size must be positive", "outbox.max_backoff"},
+		},
+		{
+			name: "webhook outbox publisher",
+			modify: func(cfg *config.Config) {
+				cfg.Outbox.Publisher = config.OutboxPublisherWebhook
+				cfg.Outbox.Webhook.URL = "hooks.example.com"
+				cfg.Outbox.Webhook.Timeout = time.Minute
+			},
+			expectedErrors: []string{"outbox.webhook.url", "outbox.webhook.timeout"},
+		},
+		{
+			name: "all errors are reported",
+			modify: func(cfg *config.Config) {
+				cfg.JWT.SecretKey = ""
+				cfg.Logger.Level = ""
+				cfg.Server.IdleTimeout = 0
+			},
+			expectedErrors: []string{"jwt.secret_key", "logger.level", "server.idle_timeout"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := validConfig()
+			tt.modify(cfg)
+
+			err := cfg.Validate()
+			if len(tt.expectedErrors) == 0 {
+				require.NoError(t, err)
+				return
+			}
+
+			require.Error(t, err)
+			for _, expected := range tt.expectedErrors {
+				require.Contains(t, err.Error(), expected)
+			}
+		})
+	}
+}
+
+func TestValidateDatabase(t *testing.T) {
+	tests := []struct {
+		name           string
+		modify         func(cfg *config.DatabaseConfig)
+		expectedErrors []string
+	}{
+		{
+			name: "valid",
+			modify: func(cfg *config.DatabaseConfig) {
+				cfg.Pool = config.PoolConfig{MaxConns: 10, MinConns: 2, MaxConnLifetime: time.Hour}
+				cfg.Retry = config.RetryConfig{ConnectAttempts: 5, ConnectBackoff: time.Second}
+			},
+		},
+		{
+			name:           "unknown driver",
+			modify:         func(cfg *config.DatabaseConfig) { cfg.Driver = "mysql" },
+			expectedErrors: []string{"database.driver"},
+		},
+		{
+			name:           "sqlite without path",
+			modify:         func(cfg *config.DatabaseConfig) { cfg.Driver = config.DriverSQLite; cfg.Path = "" },
+			expectedErrors: []string{"database.path"},
+		},
+		{
+			name:           "min conns exceed max conns",
+			modify:         func(cfg *config.DatabaseConfig) { cfg.Pool = config.PoolConfig{MaxConns: 2, MinConns: 5} },
+			expectedErrors: []string{"database.pool.min_conns"},
+		},
+		{
+			name: "negative values",
+			modify: func(cfg *config.DatabaseConfig) {
+				cfg.QueryTimeout = -time.Second
+				cfg.Pool.MaxConnIdleTime = -time.Second
+				cfg.Retry.QueryAttempts = -1
+			},
+			expectedErrors: []string{"database.query_timeout", "database.pool durations", "database.retry attempts"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := validConfig()
+			tt.modify(&cfg.Database)
+
+			err := cfg.Validate()
+			if len(tt.expectedErrors) == 0 {
+				require.NoError(t, err)
+				return
+			}
+
+			require.Error(t, err)
+			for _, expected := range tt.expectedErrors {
+				require.Contains(t, err.Error(), expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,178 @@
+package ipfilter
+
+import (
+	"fmt"
+	"net/netip"
+	"os"
+	"slices"
+	"sync"
+	"sync/atomic"
+
+	"github.com/alonsoF100/authorization-service/internal/config"
+	"github.com/oschwald/maxminddb-golang/v2"
+)
+
+// Filter checks client addresses against the ip_filter rules. Update swaps
+// the rules while requests are being checked.
+type Filter struct {
+	mu    sync.Mutex
+	state atomic.Pointer[state]
+}
+
+type state struct {
+	rules   []rule
+	geoIP   *maxminddb.Reader
+	geoPath string
+}
+
+type rule struct {
+	prefix         string
+	allow          []netip.Prefix
+	deny           []netip.Prefix
+	allowCountries []string
+	denyCountries  []string
+}
+
+func New(cfg config.IPFilterConfig) (*Filter, error) {
+	f := &Filter{}
+	if err := f.Update(cfg); err != nil {
+		return nil, err
+	}
+
+	return f, nil
+}
+
+// Update applies new rules, the GeoIP database is only read again when its
+// path changes. On error the current rules stay.
+func (f *Filter) Update(cfg config.IPFilterConfig) error {
+	const op = "ipfilter/ipfilter.go/Update"
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	next := &state{geoPath: cfg.GeoIPDatabase}
+	if current := f.state.Load(); current != nil && current.geoPath == cfg.GeoIPDatabase {
+		next.geoIP = current.geoIP
+	} else if cfg.GeoIPDatabase != "" {
+		geoIP, err := openGeoIP(cfg.GeoIPDatabase)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		next.geoIP = geoIP
+	}
+
+	for _, r := range cfg.Rules {
+		allow, err := parsePrefixes(r.Allow)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		deny, err := parsePrefixes(r.Deny)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		next.rules = append(next.rules, rule{
+			prefix:         r.Prefix,
+			allow:          allow,
+			deny:           deny,
+			allowCountries: r.AllowCountries,
+			denyCountries:  r.DenyCountries,
+		})
+	}
+
+	f.state.Store(next)
+
+	return nil
+}
+
+// Check reports whether ip may request path. Every rule matching the path
+// must let it through, country is the looked up country for logging.
+func (f *Filter) Check(path, ip string) (allowed bool, country string) {
+	current := f.state.Load()
+
+	addr, err := netip.ParseAddr(ip)
+	valid := err == nil
+	addr = addr.Unmap()
+
+	looked := false
+	for _, r := range current.rules {
+		if !config.PathHasPrefix(path, r.prefix) {
+			continue
+		}
+
+		if valid && contains(r.deny, addr) {
+			return false, country
+		}
+		if len(r.allow) > 0 && (!valid || !contains(r.allow, addr)) {
+			return false, country
+		}
+
+		if len(r.allowCountries) == 0 && len(r.denyCountries) == 0 {
+			continue
+		}
+		if !looked {
+			country, looked = current.country(addr), true
+		}
+		// an unknown country is never in the allow list
+		if slices.Contains(r.denyCountries, country) {
+			return false, country
+		}
+		if len(r.allowCountries) > 0 && (country == "" || !slices.Contains(r.allowCountries, country)) {
+			return false, country
+		}
+	}
+
+	return true, country
+}
+
+func (s *state) country(addr netip.Addr) string {
+	if s.geoIP == nil || !addr.IsValid() {
+		return ""
+	}
+
+	var country string
+	if err := s.geoIP.Lookup(addr).DecodePath(&country, "country", "iso_code"); err != nil {
+		return ""
+	}
+
+	return country
+}
+
+// openGeoIP reads a MaxMind country or city database into memory, unlike
+// a mapped file it can be dropped while old lookups still use it
+func openGeoIP(path string) (*maxminddb.Reader, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read geoip database: %w", err)
+	}
+
+	reader, err := maxminddb.OpenBytes(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open geoip database %s: %w", path, err)
+	}
+
+	return reader, nil
+}
+
+func parsePrefixes(values []string) ([]netip.Prefix, error) {
+	prefixes := make([]netip.Prefix, 0, len(values))
+	for _, value := range values {
+		prefix, err := config.ParsePrefix(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid ip range %q: %w", value, err)
+		}
+		prefixes = append(prefixes, prefix)
+	}
+
+	return prefixes, nil
+}
+
+func contains(prefixes []netip.Prefix, addr netip.Addr) bool {
+	for _, prefix := range prefixes {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+
+	return false
+}
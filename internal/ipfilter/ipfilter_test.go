@@ -0,0 +1,108 @@
+package ipfilter_test
+
+import (
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/alonsoF100/authorization-service/internal/config"
+	"github.com/alonsoF100/authorization-service/internal/ipfilter"
+	"github.com/maxmind/mmdbwriter"
+	"github.com/maxmind/mmdbwriter/mmdbtype"
+	"github.com/stretchr/testify/require"
+)
+
+// writeGeoIP writes a country database in the MaxMind format
+func writeGeoIP(t *testing.T, countries map[string]string) string {
+	tree, err := mmdbwriter.New(mmdbwriter.Options{
+		DatabaseType:            "GeoLite2-Country",
+		IncludeReservedNetworks: true,
+	})
+	require.NoError(t, err)
+
+	for cidr, country := range countries {
+		_, network, err := net.ParseCIDR(cidr)
+		require.NoError(t, err)
+		require.NoError(t, tree.Insert(network, mmdbtype.Map{
+			"country": mmdbtype.Map{"iso_code": mmdbtype.String(country)},
+		}))
+	}
+
+	path := filepath.Join(t.TempDir(), "GeoLite2-Country.mmdb")
+	file, err := os.Create(path)
+	require.NoError(t, err)
+	defer file.Close()
+
+	_, err = tree.WriteTo(file)
+	require.NoError(t, err)
+
+	return path
+}
+
+func TestFilter(t *testing.T) {
+	geoIP := writeGeoIP(t, map[string]string{
+		"198.51.100.0/24": "DE",
+		"203.0.113.0/24":  "KP",
+	})
+
+	filter, err := ipfilter.New(config.IPFilterConfig{
+		GeoIPDatabase: geoIP,
+		Rules: []config.IPRule{
+			{Prefix: "/", Deny: []string{"192.0.2.66"}},
+			{Prefix: "/admin", Allow: []string{"10.0.0.0/8", "fd00::/8"}},
+			{Prefix: "/auth", DenyCountries: []string{"KP"}},
+			{Prefix: "/api/me/tokens", AllowCountries: []string{"DE"}},
+		},
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name            string
+		path            string
+		ip              string
+		expected        bool
+		expectedCountry string
+	}{
+		{"no rule restricts", "/api/me", "192.0.2.1", true, ""},
+		{"denied everywhere", "/api/me", "192.0.2.66", false, ""},
+		{"deny holds under a longer prefix", "/admin/service-accounts", "192.0.2.66", false, ""},
+		{"allowed office network", "/admin/service-accounts", "10.1.2.3", true, ""},
+		{"ipv4 mapped address", "/admin/service-accounts", "::ffff:10.1.2.3", true, ""},
+		{"ipv6 office network", "/admin/service-accounts", "fd00::1", true, ""},
+		{"outside the office", "/admin/service-accounts", "192.0.2.1", false, ""},
+		{"prefix matches whole segments", "/administrator", "192.0.2.1", true, ""},
+		{"unreadable address", "/admin/service-accounts", "unknown", false, ""},
+		{"denied country", "/auth/login", "203.0.113.5", false, "KP"},
+		{"other country", "/auth/login", "198.51.100.5", true, "DE"},
+		{"allowed country", "/api/me/tokens", "198.51.100.5", true, "DE"},
+		{"unknown country isn't allowed", "/api/me/tokens", "192.0.2.1", false, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			allowed, country := filter.Check(tt.path, tt.ip)
+			require.Equal(t, tt.expected, allowed)
+			require.Equal(t, tt.expectedCountry, country)
+		})
+	}
+}
+
+func TestFilterUpdate(t *testing.T) {
+	filter, err := ipfilter.New(config.IPFilterConfig{})
+	require.NoError(t, err)
+
+	allowed, _ := filter.Check("/admin", "192.0.2.1")
+	require.True(t, allowed)
+
+	require.NoError(t, filter.Update(config.IPFilterConfig{
+		Rules: []config.IPRule{{Prefix: "/admin", Allow: []string{"10.0.0.0/8"}}},
+	}))
+	allowed, _ = filter.Check("/admin", "192.0.2.1")
+	require.False(t, allowed)
+
+	// a broken update keeps the rules
+	require.Error(t, filter.Update(config.IPFilterConfig{GeoIPDatabase: filepath.Join(t.TempDir(), "missing.mmdb")}))
+	allowed, _ = filter.Check("/admin", "192.0.2.1")
+	require.False(t, allowed)
+}
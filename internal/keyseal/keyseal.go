@@ -0,0 +1,91 @@
+// Package keyseal encrypts the JWT signing keys stored in the database with
+// AES-256-GCM under the key encryption key from jwt.key_encryption_key
+package keyseal
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+)
+
+const (
+	// plainType is the PEM type of keys stored before they were encrypted
+	plainType  = "EC PRIVATE KEY"
+	sealedType = "SEALED EC PRIVATE KEY"
+)
+
+var ErrNoKeyEncryptionKey = errors.New("jwt.key_encryption_key is not set")
+
+type Sealer struct {
+	aead cipher.AEAD
+}
+
+// New derives the AES-256 key from the key encryption key. Without a key
+// encryption key it returns nil, a nil Sealer only reads plain keys.
+func New(keyEncryptionKey string) *Sealer {
+	if keyEncryptionKey == "" {
+		return nil
+	}
+
+	key := sha256.Sum256([]byte(keyEncryptionKey))
+	// neither fails for a 32 byte key
+	block, _ := aes.NewCipher(key[:])
+	aead, _ := cipher.NewGCM(block)
+
+	return &Sealer{aead: aead}
+}
+
+// Encode seals the key into a PEM block. The key id is authenticated with
+// it, so a sealed key copied to another row does not open.
+func (s *Sealer) Encode(id string, key *ecdsa.PrivateKey) (string, error) {
+	if s == nil {
+		return "", ErrNoKeyEncryptionKey
+	}
+
+	der, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return "", err
+	}
+
+	nonce := make([]byte, s.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+	sealed := s.aead.Seal(nonce, nonce, der, []byte(id))
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: sealedType, Bytes: sealed})), nil
+}
+
+// Decode opens a key sealed by Encode, plain PEM keys are read as they are
+func (s *Sealer) Decode(id, data string) (*ecdsa.PrivateKey, error) {
+	block, _ := pem.Decode([]byte(data))
+	if block == nil {
+		return nil, errors.New("no PEM block found")
+	}
+
+	switch block.Type {
+	case plainType:
+		return x509.ParseECPrivateKey(block.Bytes)
+	case sealedType:
+		if s == nil {
+			return nil, ErrNoKeyEncryptionKey
+		}
+		nonceSize := s.aead.NonceSize()
+		if len(block.Bytes) < nonceSize {
+			return nil, errors.New("sealed key is too short")
+		}
+		der, err := s.aead.Open(nil, block.Bytes[:nonceSize], block.Bytes[nonceSize:], []byte(id))
+		if err != nil {
+			return nil, errors.New("failed to open sealed key, wrong key encryption key")
+		}
+		return x509.ParseECPrivateKey(der)
+	default:
+		return nil, fmt.Errorf("unexpected PEM block %q", block.Type)
+	}
+}
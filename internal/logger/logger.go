@@ -7,14 +7,23 @@ import (
 	"github.com/alonsoF100/authorization-service/internal/config"
 )
 
+// level is shared by every handler created in Setup so it can be changed at runtime
+var level = new(slog.LevelVar)
+
 func Setup(cfg *config.Config) *slog.Logger {
 	var handler slog.Handler
 
+	level.Set(ParseLevel(cfg.Logger.Level))
+
 	switch cfg.Logger.JSON {
 	case true:
-		handler = slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{Level: ParseLevel(cfg.Logger.Level)})
+		handler = slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{Level: level})
 	default:
-		handler = slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: ParseLevel(cfg.Logger.Level)})
+		handler = slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: level})
+	}
+
+	if len(cfg.Logger.Redaction.Keys) > 0 {
+		handler = NewRedactHandler(handler, cfg.Logger.Redaction)
 	}
 
 	logger := slog.New(handler)
@@ -23,6 +32,10 @@ func Setup(cfg *config.Config) *slog.Logger {
 	return logger
 }
 
+func SetLevel(lvl string) {
+	level.Set(ParseLevel(lvl))
+}
+
 func ParseLevel(level string) slog.Level {
 	switch level {
 	case "debug":
@@ -1,6 +1,7 @@
 package logger_test
 
 import (
+	"context"
 	"log/slog"
 	"testing"
 
@@ -59,6 +60,7 @@ func TestSetup(t *testing.T) {
 		{"Text warn", &config.Config{Logger: config.LoggerConfig{JSON: false, Level: "warn"}}},
 		{"Text error", &config.Config{Logger: config.LoggerConfig{JSON: false, Level: "error"}}},
 		{"Default on invalid", &config.Config{Logger: config.LoggerConfig{JSON: true, Level: "invalid"}}},
+		{"With redaction", &config.Config{Logger: config.LoggerConfig{Level: "info", Redaction: config.RedactionConfig{Mode: "partial", Keys: []string{"email"}}}}},
 	}
 
 	for _, tt := range tests {
@@ -69,3 +71,14 @@ func TestSetup(t *testing.T) {
 		})
 	}
 }
+
+func TestSetLevel(t *testing.T) {
+	log := logger.Setup(&config.Config{Logger: config.LoggerConfig{Level: "info"}})
+	require.False(t, log.Enabled(context.Background(), slog.LevelDebug))
+
+	logger.SetLevel("debug")
+	require.True(t, log.Enabled(context.Background(), slog.LevelDebug))
+
+	logger.SetLevel("error")
+	require.False(t, log.Enabled(context.Background(), slog.LevelWarn))
+}
@@ -0,0 +1,154 @@
+package logger
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"log/slog"
+	"strings"
+
+	"github.com/alonsoF100/authorization-service/internal/config"
+)
+
+const (
+	RedactModeMask    = "mask"
+	RedactModePartial = "partial"
+	RedactModeHMAC    = "hmac"
+)
+
+const redactedValue = "***"
+
+// RedactHandler masks the values of configured attribute keys before
+// passing records to the wrapped handler.
+type RedactHandler struct {
+	handler slog.Handler
+	keys    map[string]struct{}
+	mode    string
+	hmacKey []byte
+}
+
+func NewRedactHandler(handler slog.Handler, cfg config.RedactionConfig) *RedactHandler {
+	keys := make(map[string]struct{}, len(cfg.Keys))
+	for _, key := range cfg.Keys {
+		keys[strings.ToLower(key)] = struct{}{}
+	}
+
+	mode := cfg.Mode
+	switch mode {
+	case RedactModePartial:
+	case RedactModeHMAC:
+		// Pseudonymization without a key is just a plain hash, fall back to full mask
+		if cfg.HMACKey == "" {
+			mode = RedactModeMask
+		}
+	default:
+		mode = RedactModeMask
+	}
+
+	return &RedactHandler{
+		handler: handler,
+		keys:    keys,
+		mode:    mode,
+		hmacKey: []byte(cfg.HMACKey),
+	}
+}
+
+func (h *RedactHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.handler.Enabled(ctx, level)
+}
+
+func (h *RedactHandler) Handle(ctx context.Context, record slog.Record) error {
+	redacted := slog.NewRecord(record.Time, record.Level, record.Message, record.PC)
+	record.Attrs(func(attr slog.Attr) bool {
+		redacted.AddAttrs(h.redactAttr(attr))
+		return true
+	})
+
+	return h.handler.Handle(ctx, redacted)
+}
+
+func (h *RedactHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	redacted := make([]slog.Attr, 0, len(attrs))
+	for _, attr := range attrs {
+		redacted = append(redacted, h.redactAttr(attr))
+	}
+
+	return &RedactHandler{
+		handler: h.handler.WithAttrs(redacted),
+		keys:    h.keys,
+		mode:    h.mode,
+		hmacKey: h.hmacKey,
+	}
+}
+
+func (h *RedactHandler) WithGroup(name string) slog.Handler {
+	return &RedactHandler{
+		handler: h.handler.WithGroup(name),
+		keys:    h.keys,
+		mode:    h.mode,
+		hmacKey: h.hmacKey,
+	}
+}
+
+func (h *RedactHandler) redactAttr(attr slog.Attr) slog.Attr {
+	attr.Value = attr.Value.Resolve()
+
+	if attr.Value.Kind() == slog.KindGroup {
+		group := attr.Value.Group()
+		redacted := make([]slog.Attr, 0, len(group))
+		for _, groupAttr := range group {
+			redacted = append(redacted, h.redactAttr(groupAttr))
+		}
+		return slog.Attr{Key: attr.Key, Value: slog.GroupValue(redacted...)}
+	}
+
+	if _, ok := h.keys[strings.ToLower(attr.Key)]; !ok {
+		return attr
+	}
+
+	return slog.String(attr.Key, h.Redact(attr.Value.String()))
+}
+
+func (h *RedactHandler) Redact(value string) string {
+	if value == "" {
+		return value
+	}
+
+	switch h.mode {
+	case RedactModePartial:
+		return partialMask(value)
+	case RedactModeHMAC:
+		return Pseudonym(h.hmacKey, value)
+	default:
+		return redactedValue
+	}
+}
+
+// Pseudonym is the value as hmac mode logs it, the same value under the same
+// key always gives the same pseudonym
+func Pseudonym(key []byte, value string) string {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(value))
+	return "hmac:" + hex.EncodeToString(mac.Sum(nil))[:16]
+}
+
+// partialMask keeps the first character and, for emails, the domain: b***@x.com
+func partialMask(value string) string {
+	local, domain, isEmail := strings.Cut(value, "@")
+	if !isEmail {
+		local = value
+	}
+
+	runes := []rune(local)
+	masked := redactedValue
+	if len(runes) > 1 {
+		masked = string(runes[0]) + redactedValue
+	}
+
+	if isEmail {
+		return masked + "@" + domain
+	}
+
+	return masked
+}
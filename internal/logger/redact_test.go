@@ -0,0 +1,129 @@
+package logger_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/alonsoF100/authorization-service/internal/config"
+	"github.com/alonsoF100/authorization-service/internal/logger"
+	"github.com/stretchr/testify/require"
+)
+
+func newRedactLogger(cfg config.RedactionConfig) (*slog.Logger, *bytes.Buffer) {
+	buf := &bytes.Buffer{}
+	handler := slog.NewJSONHandler(buf, &slog.HandlerOptions{Level: slog.LevelDebug})
+
+	return slog.New(logger.NewRedactHandler(handler, cfg)), buf
+}
+
+func decodeLine(t *testing.T, buf *bytes.Buffer) map[string]any {
+	var line map[string]any
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &line))
+
+	return line
+}
+
+func TestRedactHandlerModes(t *testing.T) {
+	tests := []struct {
+		name             string
+		cfg              config.RedactionConfig
+		expectedEmail    string
+		expectedNickname string
+	}{
+		{
+			name:             "mask",
+			cfg:              config.RedactionConfig{Mode: "mask", Keys: []string{"email", "nickname"}},
+			expectedEmail:    "***",
+			expectedNickname: "***",
+		},
+		{
+			name:             "partial",
+			cfg:              config.RedactionConfig{Mode: "partial", Keys: []string{"email", "nickname"}},
+			expectedEmail:    "a***@yandex.ru",
+			expectedNickname: "a***",
+		},
+		{
+			name:             "unknown mode falls back to mask",
+			cfg:              config.RedactionConfig{Mode: "gaz", Keys: []string{"email", "nickname"}},
+			expectedEmail:    "***",
+			expectedNickname: "***",
+		},
+		{
+			name:             "hmac without key falls back to mask",
+			cfg:              config.RedactionConfig{Mode: "hmac", Keys: []string{"email", "nickname"}},
+			expectedEmail:    "***",
+			expectedNickname: "***",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			log, buf := newRedactLogger(tt.cfg)
+
+			log.Info("Registration successfull",
+				slog.String("op", "service/auth.go/SignUp"),
+				slog.String("email", "alonso@yandex.ru"),
+				slog.String("nickname", "alonsoF100"),
+			)
+
+			line := decodeLine(t, buf)
+			require.Equal(t, "service/auth.go/SignUp", line["op"])
+			require.Equal(t, tt.expectedEmail, line["email"])
+			require.Equal(t, tt.expectedNickname, line["nickname"])
+		})
+	}
+}
+
+func TestRedactHandlerHMAC(t *testing.T) {
+	cfg := config.RedactionConfig{Mode: "hmac", Keys: []string{"email"}, HMACKey: "key"}
+
+	log, buf := newRedactLogger(cfg)
+	log.Info("first", slog.String("email", "alonso@yandex.ru"))
+	first := decodeLine(t, buf)["email"].(string)
+
+	buf.Reset()
+	log.Info("second", slog.String("email", "alonso@yandex.ru"))
+	second := decodeLine(t, buf)["email"].(string)
+
+	buf.Reset()
+	log.Info("other", slog.String("email", "other@yandex.ru"))
+	other := decodeLine(t, buf)["email"].(string)
+
+	require.True(t, strings.HasPrefix(first, "hmac:"))
+	require.NotContains(t, first, "alonso")
+	require.Equal(t, first, second)
+	require.NotEqual(t, first, other)
+
+	anotherKey, buf := newRedactLogger(config.RedactionConfig{Mode: "hmac", Keys: []string{"email"}, HMACKey: "another"})
+	anotherKey.Info("first", slog.String("email", "alonso@yandex.ru"))
+	require.NotEqual(t, first, decodeLine(t, buf)["email"])
+}
+
+func TestRedactHandlerGroupsAndAttrs(t *testing.T) {
+	log, buf := newRedactLogger(config.RedactionConfig{Mode: "mask", Keys: []string{"Email", "token"}})
+
+	log.With(slog.String("token", "eyJhbGciOi")).
+		WithGroup("request").
+		Info("Request",
+			slog.Group("user", slog.String("email", "alonso@yandex.ru"), slog.String("id", "42")),
+		)
+
+	line := decodeLine(t, buf)
+	require.Equal(t, "***", line["token"])
+
+	user := line["request"].(map[string]any)["user"].(map[string]any)
+	require.Equal(t, "***", user["email"])
+	require.Equal(t, "42", user["id"])
+}
+
+func TestRedactHandlerEnabled(t *testing.T) {
+	handler := slog.NewJSONHandler(&bytes.Buffer{}, &slog.HandlerOptions{Level: slog.LevelWarn})
+	redact := logger.NewRedactHandler(handler, config.RedactionConfig{Keys: []string{"email"}})
+
+	require.False(t, redact.Enabled(context.Background(), slog.LevelInfo))
+	require.True(t, redact.Enabled(context.Background(), slog.LevelError))
+}
@@ -1,23 +1,381 @@
 package models
 
 import (
+	"crypto/ecdsa"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
 )
 
+const RoleAdmin = "admin"
+
+const (
+	GrantAuthorizationCode = "authorization_code"
+	GrantClientCredentials = "client_credentials"
+)
+
+// PrincipalType tells user tokens from machine tokens issued to OAuth
+// clients
+type PrincipalType string
+
+const (
+	PrincipalUser           PrincipalType = "user"
+	PrincipalClient         PrincipalType = "client"
+	PrincipalServiceAccount PrincipalType = "service_account"
+)
+
+// OpenID Connect scopes
+const (
+	ScopeOpenID  = "openid"
+	ScopeProfile = "profile"
+	ScopeEmail   = "email"
+)
+
+// API scopes limit tokens issued to OAuth clients and personal access
+// tokens, sign in tokens are not limited
+const (
+	ScopeRead  = "read"
+	ScopeWrite = "write"
+	ScopeAdmin = "admin"
+)
+
 type User struct {
 	Nickname     string
 	Email        string
 	ID           string
 	PasswordHash string
+	Roles        []string
+	AllowedIPs   []string
+	DisabledAt   *time.Time
 	CreatedAt    time.Time
 	UpdatedAt    time.Time
 }
 
+func (u User) Disabled() bool {
+	return u.DisabledAt != nil
+}
+
 type Claims struct {
-	ID       string `json:"id"`
-	Email    string `json:"email"`
-	Nickname string `json:"nickname"`
+	ID       string   `json:"id"`
+	Email    string   `json:"email"`
+	Nickname string   `json:"nickname"`
+	Roles    []string `json:"roles,omitempty"`
+	Scope    string   `json:"scope,omitempty"`
+	ClientID string   `json:"client_id,omitempty"`
+	// PrincipalType is empty in tokens issued before it was added, those
+	// are all user tokens
+	PrincipalType PrincipalType `json:"principal_type,omitempty"`
+	// SessionID is set in sign in tokens, revoking the session revokes them
+	SessionID string `json:"sid,omitempty"`
+	// TokenUse is empty in tokens issued before it was added, those are
+	// all access tokens
+	TokenUse string `json:"token_use,omitempty"`
 	jwt.RegisteredClaims
 }
+
+// TokenUse tells access tokens from ID tokens and the session tokens of the
+// authorize page, all are signed with the same keys
+const (
+	TokenUseAccess  = "access"
+	TokenUseID      = "id"
+	TokenUseSession = "session"
+)
+
+// Principal returns whether the token was issued to a user or a client
+func (c Claims) Principal() PrincipalType {
+	if c.PrincipalType == "" {
+		return PrincipalUser
+	}
+
+	return c.PrincipalType
+}
+
+// Scopes splits the space separated scope claim
+func (c Claims) Scopes() []string {
+	return strings.Fields(c.Scope)
+}
+
+// Limited tells tokens issued to an OAuth client, those only reach what
+// their scopes allow, from sign in tokens
+func (c Claims) Limited() bool {
+	return c.ClientID != "" || c.Scope != ""
+}
+
+// AllowsScope tells whether the token may be used where the scope is
+// needed, tokens that are not limited allow every scope
+func (c Claims) AllowsScope(scope string) bool {
+	return !c.Limited() || slices.Contains(c.Scopes(), scope)
+}
+
+type SigningKey struct {
+	ID         string
+	Algorithm  string
+	PrivateKey *ecdsa.PrivateKey
+	CreatedAt  time.Time
+	RetiredAt  *time.Time
+}
+
+// Client is an OAuth client, only a hash of its secret is stored
+type Client struct {
+	ID           string
+	Name         string
+	SecretHash   string
+	RedirectURIs []string
+	GrantTypes   []string
+	// PostLogoutRedirectURIs are allowed targets of RP-initiated logout
+	PostLogoutRedirectURIs []string
+	// Scopes the client may request in any grant, the client credentials
+	// grant gets them for the client itself
+	Scopes    []string
+	CreatedAt time.Time
+}
+
+// AllowsScope tells whether the client may ask a user for the scope. The
+// OpenID Connect scopes only release identity claims, every client of the
+// code grant may ask for them.
+func (c Client) AllowsScope(scope string) bool {
+	switch scope {
+	case ScopeOpenID, ScopeProfile, ScopeEmail:
+		return true
+	}
+
+	return slices.Contains(c.Scopes, scope)
+}
+
+func (c Client) AllowsGrant(grantType string) bool {
+	return slices.Contains(c.GrantTypes, grantType)
+}
+
+// AllowsRedirect compares redirect uris exactly, no prefix or wildcard
+// matching
+func (c Client) AllowsRedirect(redirectURI string) bool {
+	return slices.Contains(c.RedirectURIs, redirectURI)
+}
+
+func (c Client) AllowsPostLogoutRedirect(redirectURI string) bool {
+	return slices.Contains(c.PostLogoutRedirectURIs, redirectURI)
+}
+
+// AuthorizationCode is stored by the hash of the code until it is exchanged
+// or expires
+type AuthorizationCode struct {
+	CodeHash      string
+	ClientID      string
+	UserID        string
+	RedirectURI   string
+	Scope         string
+	CodeChallenge string
+	Nonce         string
+	// AuthTime is when the user signed in, it goes into the ID token
+	AuthTime time.Time
+	// SessionID is the sign in session of the user, revoking it revokes the
+	// tokens issued for the code
+	SessionID string
+	ExpiresAt time.Time
+	CreatedAt time.Time
+}
+
+// Consent lists the scopes a user granted to a client
+type Consent struct {
+	UserID    string
+	ClientID  string
+	Scopes    []string
+	GrantedAt time.Time
+}
+
+// Token is the result of a token endpoint grant
+type Token struct {
+	AccessToken string
+	TokenType   string
+	ExpiresIn   time.Duration
+	Scope       string
+	IDToken     string
+}
+
+// IDTokenClaims is an OpenID Connect ID token, user claims are only set for
+// the matching scopes
+type IDTokenClaims struct {
+	Nonce         string           `json:"nonce,omitempty"`
+	AuthTime      *jwt.NumericDate `json:"auth_time,omitempty"`
+	AtHash        string           `json:"at_hash,omitempty"`
+	Nickname      string           `json:"nickname,omitempty"`
+	Email         string           `json:"email,omitempty"`
+	EmailVerified *bool            `json:"email_verified,omitempty"`
+	TokenUse      string           `json:"token_use"`
+	jwt.RegisteredClaims
+}
+
+// UserInfo holds the OpenID Connect claims about a user, fields are only
+// set for the scopes granted to the client
+type UserInfo struct {
+	Subject       string
+	Nickname      string
+	Email         string
+	EmailVerified *bool
+}
+
+// PublicKey is the verification half of a SigningKey, published as a JWK
+type PublicKey struct {
+	ID        string
+	Algorithm string
+	Key       *ecdsa.PublicKey
+}
+
+// PersonalAccessTokenPrefix starts every personal access token so secret
+// scanners can recognise leaked ones
+const PersonalAccessTokenPrefix = "authpat_"
+
+// PersonalAccessToken lets a user authenticate scripts without a password,
+// only a hash of the token is stored
+type PersonalAccessToken struct {
+	ID         string
+	UserID     string
+	Name       string
+	TokenHash  string
+	Scopes     []string
+	ExpiresAt  *time.Time
+	LastUsedAt *time.Time
+	LastUsedIP string
+	CreatedAt  time.Time
+}
+
+func (t PersonalAccessToken) Expired(now time.Time) bool {
+	return t.ExpiresAt != nil && !now.Before(*t.ExpiresAt)
+}
+
+// APIKeyPrefix starts every service account API key, the key id follows so
+// a key can be looked up without comparing hashes of every key
+const APIKeyPrefix = "authsa_"
+
+// ServiceAccount is a principal for automation that can not sign in, it
+// authenticates with API keys instead
+type ServiceAccount struct {
+	ID   string
+	Name string
+	// OwnerID is the admin who created the account, empty once that user is
+	// deleted
+	OwnerID   string
+	Roles     []string
+	CreatedAt time.Time
+}
+
+// APIKey belongs to a service account, only a hash of the key is stored
+type APIKey struct {
+	ID               string
+	ServiceAccountID string
+	KeyHash          string
+	LastUsedAt       *time.Time
+	CreatedAt        time.Time
+}
+
+// Session is created on every sign in, the tokens issued with it carry its id
+type Session struct {
+	ID         string
+	UserID     string
+	DeviceName string
+	UserAgent  string
+	IP         string
+	CreatedAt  time.Time
+	LastSeenAt time.Time
+	ExpiresAt  time.Time
+}
+
+// Audit event types
+const (
+	AuditUserRegistered        = "user.registered"
+	AuditLogin                 = "auth.login"
+	AuditLogout                = "auth.logout"
+	AuditPasswordChanged       = "user.password_changed"
+	AuditRoleGranted           = "user.role_granted"
+	AuditUserDisabled          = "user.disabled"
+	AuditUserDeleted           = "user.deleted"
+	AuditAllowedIPsChanged     = "user.allowed_ips_changed"
+	AuditSessionRevoked        = "session.revoked"
+	AuditTokenCreated          = "personal_access_token.created"
+	AuditTokenDeleted          = "personal_access_token.deleted"
+	AuditServiceAccountCreated = "service_account.created"
+	AuditServiceAccountDeleted = "service_account.deleted"
+	AuditAPIKeyCreated         = "api_key.created"
+	AuditAPIKeyDeleted         = "api_key.deleted"
+	AuditClientCreated         = "oauth_client.created"
+)
+
+// Audit event outcomes
+const (
+	AuditSuccess = "success"
+	AuditFailure = "failure"
+)
+
+// ActorCLI marks events caused by the command line tools, the actor id is
+// the operating system user
+const ActorCLI = "cli"
+
+// AuditEvent is an entry of the append-only audit trail. The actor did the
+// action, the target is the user or object it was done to. Ids grow with
+// every event, so they order the trail. Hash covers the content and
+// PrevHash, the hash of the event before, both are empty for events
+// recorded before the hash chain existed.
+type AuditEvent struct {
+	ID        int64
+	Type      string
+	Outcome   string
+	ActorType string
+	ActorID   string
+	TargetID  string
+	IP        string
+	UserAgent string
+	RequestID string
+	Details   map[string]string
+	CreatedAt time.Time
+	PrevHash  string
+	Hash      string
+}
+
+// AuditCheckpoint vouches for the audit trail up to an event, Signature is
+// a JWS over the event id and hash signed with the JWT signing key KeyID
+type AuditCheckpoint struct {
+	ID        int64
+	EventID   int64
+	Hash      string
+	KeyID     string
+	Signature string
+	CreatedAt time.Time
+}
+
+// AuditFilter narrows an audit query, empty fields match every event. Events
+// come newest first, BeforeID pages through older ones.
+type AuditFilter struct {
+	Type     string
+	Outcome  string
+	ActorID  string
+	TargetID string
+	IP       string
+	Since    *time.Time
+	Until    *time.Time
+	BeforeID int64
+	Limit    int
+}
+
+// Outbox event types
+const (
+	EventUserRegistered      = "user.registered"
+	EventUserDeleted         = "user.deleted"
+	EventUserPasswordChanged = "user.password_changed"
+)
+
+// OutboxEvent is a domain event stored in the transaction of the change it
+// describes and published later by the relay. AggregateID is the user the
+// event is about, events of one aggregate are published in id order.
+type OutboxEvent struct {
+	ID            int64
+	Type          string
+	AggregateID   string
+	Payload       []byte
+	CreatedAt     time.Time
+	Attempts      int
+	NextAttemptAt time.Time
+	LastError     string
+	PublishedAt   *time.Time
+}
@@ -0,0 +1,29 @@
+package publisher
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/alonsoF100/authorization-service/internal/models"
+)
+
+// LogPublisher writes events to the log, for development and setups that
+// don't consume them yet. The payload is left out, it holds personal data.
+type LogPublisher struct{}
+
+func NewLogPublisher() *LogPublisher {
+	return &LogPublisher{}
+}
+
+func (p *LogPublisher) Publish(ctx context.Context, event models.OutboxEvent) error {
+	const op = "publisher/log.go/Publish"
+
+	slog.Info("Event published",
+		slog.String("op", op),
+		slog.Int64("event_id", event.ID),
+		slog.String("type", event.Type),
+		slog.String("user_id", event.AggregateID),
+	)
+
+	return nil
+}
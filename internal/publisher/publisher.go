@@ -0,0 +1,27 @@
+package publisher
+
+import (
+	"fmt"
+
+	"github.com/alonsoF100/authorization-service/internal/config"
+	"github.com/alonsoF100/authorization-service/internal/service"
+)
+
+var (
+	_ service.EventPublisher = (*LogPublisher)(nil)
+	_ service.EventPublisher = (*WebhookPublisher)(nil)
+)
+
+// New creates the publisher selected by outbox.publisher
+func New(cfg *config.Config) (service.EventPublisher, error) {
+	const op = "publisher/publisher.go/New"
+
+	switch cfg.Outbox.Publisher {
+	case config.OutboxPublisherLog:
+		return NewLogPublisher(), nil
+	case config.OutboxPublisherWebhook:
+		return NewWebhookPublisher(cfg.Outbox.Webhook), nil
+	default:
+		return nil, fmt.Errorf("%s: unknown outbox publisher %q", op, cfg.Outbox.Publisher)
+	}
+}
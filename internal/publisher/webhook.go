@@ -0,0 +1,98 @@
+package publisher
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/alonsoF100/authorization-service/internal/config"
+	"github.com/alonsoF100/authorization-service/internal/models"
+)
+
+const (
+	HeaderEventID   = "X-Event-Id"
+	HeaderEventType = "X-Event-Type"
+	HeaderSignature = "X-Signature"
+)
+
+// WebhookPublisher posts every event as JSON. A repeated delivery has the
+// same X-Event-Id. With a secret X-Signature carries "sha256=" and the hex
+// HMAC-SHA256 of the body.
+type WebhookPublisher struct {
+	client *http.Client
+	url    string
+	secret []byte
+}
+
+type webhookEvent struct {
+	ID        int64           `json:"id"`
+	Type      string          `json:"type"`
+	UserID    string          `json:"user_id"`
+	Data      json.RawMessage `json:"data"`
+	CreatedAt time.Time       `json:"created_at"`
+}
+
+func NewWebhookPublisher(cfg config.WebhookConfig) *WebhookPublisher {
+	return &WebhookPublisher{
+		client: &http.Client{Timeout: cfg.Timeout},
+		url:    cfg.URL,
+		secret: []byte(cfg.Secret),
+	}
+}
+
+// Publish fails unless the endpoint answers with a 2xx status
+func (p *WebhookPublisher) Publish(ctx context.Context, event models.OutboxEvent) error {
+	const op = "publisher/webhook.go/Publish"
+
+	body, err := json.Marshal(webhookEvent{
+		ID:        event.ID,
+		Type:      event.Type,
+		UserID:    event.AggregateID,
+		Data:      event.Payload,
+		CreatedAt: event.CreatedAt.UTC(),
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderEventID, strconv.FormatInt(event.ID, 10))
+	req.Header.Set(HeaderEventType, event.Type)
+	if len(p.secret) > 0 {
+		req.Header.Set(HeaderSignature, "sha256="+Sign(p.secret, body))
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer resp.Body.Close()
+	// drain the body so the connection is reused
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("%s: webhook responded with status %d", op, resp.StatusCode)
+	}
+
+	return nil
+}
+
+// Sign returns the hex HMAC-SHA256 of body, receivers compare it with
+// X-Signature
+func Sign(secret, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,95 @@
+package publisher_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/alonsoF100/authorization-service/internal/config"
+	"github.com/alonsoF100/authorization-service/internal/models"
+	"github.com/alonsoF100/authorization-service/internal/publisher"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebhookPublisher(t *testing.T) {
+	event := models.OutboxEvent{
+		ID:          42,
+		Type:        models.EventUserRegistered,
+		AggregateID: "user123",
+		Payload:     []byte(`{"user_id":"user123","email":"user@example.com"}`),
+		CreatedAt:   time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC),
+	}
+
+	tests := []struct {
+		name          string
+		secret        string
+		status        int
+		wantErr       bool
+		wantSignature bool
+	}{
+		{name: "signed", secret: "webhookSecret", status: http.StatusOK, wantSignature: true},
+		{name: "unsigned", status: http.StatusAccepted},
+		{name: "rejected", secret: "webhookSecret", status: http.StatusInternalServerError, wantErr: true, wantSignature: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				received http.Header
+				body     []byte
+			)
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				received = r.Header
+				body, _ = io.ReadAll(r.Body)
+				w.WriteHeader(tt.status)
+			}))
+			defer server.Close()
+
+			p := publisher.NewWebhookPublisher(config.WebhookConfig{
+				URL:     server.URL,
+				Secret:  tt.secret,
+				Timeout: time.Second,
+			})
+
+			err := p.Publish(context.Background(), event)
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Equal(t, "42", received.Get(publisher.HeaderEventID))
+			require.Equal(t, models.EventUserRegistered, received.Get(publisher.HeaderEventType))
+			if tt.wantSignature {
+				require.Equal(t, "sha256="+publisher.Sign([]byte(tt.secret), body), received.Get(publisher.HeaderSignature))
+			} else {
+				require.Empty(t, received.Get(publisher.HeaderSignature))
+			}
+
+			var decoded struct {
+				ID        int64             `json:"id"`
+				Type      string            `json:"type"`
+				UserID    string            `json:"user_id"`
+				Data      map[string]string `json:"data"`
+				CreatedAt time.Time         `json:"created_at"`
+			}
+			require.NoError(t, json.Unmarshal(body, &decoded))
+			require.Equal(t, int64(42), decoded.ID)
+			require.Equal(t, "user123", decoded.UserID)
+			require.Equal(t, "user@example.com", decoded.Data["email"])
+			require.True(t, event.CreatedAt.Equal(decoded.CreatedAt))
+		})
+	}
+}
+
+func TestWebhookPublisherUnreachable(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	server.Close()
+
+	p := publisher.NewWebhookPublisher(config.WebhookConfig{URL: server.URL, Timeout: time.Second})
+	require.Error(t, p.Publish(context.Background(), models.OutboxEvent{ID: 1, Payload: []byte(`{}`)}))
+}
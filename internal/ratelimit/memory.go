@@ -0,0 +1,113 @@
+package ratelimit
+
+import (
+	"context"
+	"hash/maphash"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/alonsoF100/authorization-service/internal/config"
+)
+
+// MemoryStore keeps the buckets of one instance. Keys are spread over
+// shards so requests of different clients rarely wait on the same lock.
+type MemoryStore struct {
+	seed   maphash.Seed
+	shards []*shard
+	now    func() time.Time
+}
+
+type shard struct {
+	mu      sync.Mutex
+	buckets map[string]*bucket
+}
+
+type bucket struct {
+	tokens  float64
+	updated time.Time
+	// full is when the bucket refills, from then on it equals a new one
+	full time.Time
+}
+
+func NewMemoryStore(shards int) *MemoryStore {
+	s := &MemoryStore{
+		seed:   maphash.MakeSeed(),
+		shards: make([]*shard, max(shards, 1)),
+		now:    time.Now,
+	}
+	for i := range s.shards {
+		s.shards[i] = &shard{buckets: make(map[string]*bucket)}
+	}
+
+	return s
+}
+
+func (s *MemoryStore) Take(_ context.Context, key string, limit config.RateLimit) (Result, error) {
+	now := s.now()
+	sh := s.shards[maphash.String(s.seed, key)%uint64(len(s.shards))]
+
+	sh.mu.Lock()
+	defer sh.mu.Unlock()
+
+	b, ok := sh.buckets[key]
+	if !ok {
+		b = &bucket{}
+		sh.buckets[key] = b
+	}
+
+	b.tokens = refill(b.tokens, b.updated, now, limit)
+	b.updated = now
+
+	allowed := b.tokens >= 1
+	if allowed {
+		b.tokens--
+	}
+
+	res := result(allowed, b.tokens, limit)
+	b.full = now.Add(res.Reset)
+
+	return res, nil
+}
+
+// Purge drops the buckets that refilled, they hold nothing a new one
+// wouldn't
+func (s *MemoryStore) Purge() int {
+	now := s.now()
+
+	var purged int
+	for _, sh := range s.shards {
+		sh.mu.Lock()
+		for key, b := range sh.buckets {
+			if !b.full.After(now) {
+				delete(sh.buckets, key)
+				purged++
+			}
+		}
+		sh.mu.Unlock()
+	}
+
+	return purged
+}
+
+// PurgeExpired runs Purge every interval until ctx is done
+func (s *MemoryStore) PurgeExpired(ctx context.Context, interval time.Duration) {
+	const op = "ratelimit/memory.go/PurgeExpired"
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if purged := s.Purge(); purged > 0 {
+				slog.Debug("Rate limit buckets purged",
+					slog.String("op", op),
+					slog.Int("count", purged),
+				)
+			}
+		}
+	}
+}
@@ -0,0 +1,95 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/alonsoF100/authorization-service/internal/config"
+	"github.com/redis/go-redis/v9"
+)
+
+// Store takes tokens from buckets identified by key
+type Store interface {
+	Take(ctx context.Context, key string, limit config.RateLimit) (Result, error)
+}
+
+var (
+	_ Store = (*MemoryStore)(nil)
+	_ Store = (*RedisStore)(nil)
+)
+
+// Result of taking a token. Reset is the time until the bucket is full
+// again, RetryAfter the time until the next token of a denied request.
+type Result struct {
+	Allowed    bool
+	Limit      int
+	Remaining  int
+	Reset      time.Duration
+	RetryAfter time.Duration
+}
+
+// New opens the store selected by rate_limit.store. The returned func
+// releases its resources.
+func New(cfg *config.Config) (Store, func(), error) {
+	const op = "ratelimit/ratelimit.go/New"
+
+	switch cfg.RateLimit.Store {
+	case config.RateLimitStoreMemory:
+		return NewMemoryStore(cfg.RateLimit.Shards), func() {}, nil
+	case config.RateLimitStoreRedis:
+		client := redis.NewClient(&redis.Options{
+			Addr:     cfg.RateLimit.Redis.Addr,
+			Password: cfg.RateLimit.Redis.Password,
+			DB:       cfg.RateLimit.Redis.DB,
+		})
+		return NewRedisStore(client, cfg.RateLimit.Redis.KeyPrefix), func() { client.Close() }, nil
+	default:
+		return nil, nil, fmt.Errorf("%s: unknown rate limit store %q", op, cfg.RateLimit.Store)
+	}
+}
+
+func capacity(limit config.RateLimit) float64 {
+	if limit.Burst > 0 {
+		return float64(limit.Burst)
+	}
+
+	return float64(limit.Requests)
+}
+
+// tokenInterval is the time it takes to refill one token
+func tokenInterval(limit config.RateLimit) time.Duration {
+	return max(limit.Period/time.Duration(limit.Requests), time.Nanosecond)
+}
+
+// refill adds the tokens earned since updated, a new bucket starts full
+func refill(tokens float64, updated, now time.Time, limit config.RateLimit) float64 {
+	if updated.IsZero() {
+		return capacity(limit)
+	}
+
+	elapsed := now.Sub(updated)
+	if elapsed <= 0 {
+		return tokens
+	}
+
+	return min(capacity(limit), tokens+float64(elapsed)/float64(tokenInterval(limit)))
+}
+
+// result describes a bucket left with tokens after a take
+func result(allowed bool, tokens float64, limit config.RateLimit) Result {
+	interval := float64(tokenInterval(limit))
+
+	res := Result{
+		Allowed:   allowed,
+		Limit:     int(capacity(limit)),
+		Remaining: int(math.Floor(tokens)),
+		Reset:     time.Duration(math.Ceil((capacity(limit) - tokens) * interval)),
+	}
+	if !allowed {
+		res.RetryAfter = time.Duration(math.Ceil((1 - tokens) * interval))
+	}
+
+	return res
+}
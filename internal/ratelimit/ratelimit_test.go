@@ -0,0 +1,106 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/alonsoF100/authorization-service/internal/config"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStores(t *testing.T) {
+	stores := map[string]func(t *testing.T, now func() time.Time) Store{
+		"memory": func(t *testing.T, now func() time.Time) Store {
+			s := NewMemoryStore(4)
+			s.now = now
+			return s
+		},
+		"redis": func(t *testing.T, now func() time.Time) Store {
+			client := redis.NewClient(&redis.Options{Addr: miniredis.RunT(t).Addr()})
+			t.Cleanup(func() { client.Close() })
+
+			s := NewRedisStore(client, "ratelimit:")
+			s.now = now
+			return s
+		},
+	}
+
+	for name, newStore := range stores {
+		t.Run(name, func(t *testing.T) {
+			testTake(t, newStore)
+		})
+	}
+}
+
+func testTake(t *testing.T, newStore func(t *testing.T, now func() time.Time) Store) {
+	ctx := context.Background()
+	// 6 requests a minute, one token every 10 seconds, 3 at once
+	limit := config.RateLimit{Requests: 6, Period: time.Minute, Burst: 3}
+
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	store := newStore(t, func() time.Time { return now })
+
+	for i := range 3 {
+		res, err := store.Take(ctx, "login:192.0.2.1", limit)
+		require.NoError(t, err)
+		require.True(t, res.Allowed)
+		require.Equal(t, 3, res.Limit)
+		require.Equal(t, 2-i, res.Remaining)
+		require.Equal(t, time.Duration(i+1)*10*time.Second, res.Reset)
+	}
+
+	res, err := store.Take(ctx, "login:192.0.2.1", limit)
+	require.NoError(t, err)
+	require.False(t, res.Allowed)
+	require.Equal(t, 0, res.Remaining)
+	require.Equal(t, 10*time.Second, res.RetryAfter)
+
+	// other keys have their own bucket
+	res, err = store.Take(ctx, "login:192.0.2.2", limit)
+	require.NoError(t, err)
+	require.True(t, res.Allowed)
+
+	now = now.Add(4 * time.Second)
+	res, err = store.Take(ctx, "login:192.0.2.1", limit)
+	require.NoError(t, err)
+	require.False(t, res.Allowed)
+	require.Equal(t, 6*time.Second, res.RetryAfter)
+
+	now = now.Add(6 * time.Second)
+	res, err = store.Take(ctx, "login:192.0.2.1", limit)
+	require.NoError(t, err)
+	require.True(t, res.Allowed)
+	require.Equal(t, 0, res.Remaining)
+
+	// the bucket doesn't grow beyond the burst
+	now = now.Add(time.Hour)
+	res, err = store.Take(ctx, "login:192.0.2.1", limit)
+	require.NoError(t, err)
+	require.True(t, res.Allowed)
+	require.Equal(t, 2, res.Remaining)
+}
+
+func TestMemoryStorePurge(t *testing.T) {
+	ctx := context.Background()
+	limit := config.RateLimit{Requests: 1, Period: time.Minute}
+
+	now := time.Now()
+	store := NewMemoryStore(2)
+	store.now = func() time.Time { return now }
+
+	_, err := store.Take(ctx, "a", limit)
+	require.NoError(t, err)
+	now = now.Add(30 * time.Second)
+	_, err = store.Take(ctx, "b", limit)
+	require.NoError(t, err)
+
+	now = now.Add(30 * time.Second)
+	require.Equal(t, 1, store.Purge())
+	require.Equal(t, 0, store.Purge())
+
+	now = now.Add(30 * time.Second)
+	require.Equal(t, 1, store.Purge())
+}
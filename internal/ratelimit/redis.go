@@ -0,0 +1,83 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/alonsoF100/authorization-service/internal/config"
+	"github.com/redis/go-redis/v9"
+)
+
+// takeScript refills and takes from a bucket in one step. Times are in
+// microseconds, the caller passes its clock so the bucket math matches the
+// memory store. Lua numbers come back as integers, so tokens is a string.
+var takeScript = redis.NewScript(`
+local capacity = tonumber(ARGV[1])
+local interval = tonumber(ARGV[2])
+local now = tonumber(ARGV[3])
+
+local state = redis.call("HMGET", KEYS[1], "tokens", "updated")
+local tokens = tonumber(state[1])
+local updated = tonumber(state[2])
+
+if tokens == nil then
+	tokens = capacity
+	updated = now
+elseif now > updated then
+	tokens = math.min(capacity, tokens + (now - updated) / interval)
+	updated = now
+end
+
+local allowed = 0
+if tokens >= 1 then
+	tokens = tokens - 1
+	allowed = 1
+end
+
+redis.call("HSET", KEYS[1], "tokens", tostring(tokens), "updated", tostring(updated))
+redis.call("PEXPIRE", KEYS[1], math.ceil((capacity - tokens) * interval / 1000) + 1)
+
+return {allowed, tostring(tokens)}
+`)
+
+// RedisStore shares the buckets between instances
+type RedisStore struct {
+	client redis.Scripter
+	prefix string
+	now    func() time.Time
+}
+
+func NewRedisStore(client redis.Scripter, prefix string) *RedisStore {
+	return &RedisStore{
+		client: client,
+		prefix: prefix,
+		now:    time.Now,
+	}
+}
+
+func (s *RedisStore) Take(ctx context.Context, key string, limit config.RateLimit) (Result, error) {
+	const op = "ratelimit/redis.go/Take"
+
+	reply, err := takeScript.Run(ctx, s.client, []string{s.prefix + key},
+		capacity(limit),
+		max(tokenInterval(limit).Microseconds(), 1),
+		s.now().UnixMicro(),
+	).Slice()
+	if err != nil {
+		return Result{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if len(reply) != 2 {
+		return Result{}, fmt.Errorf("%s: unexpected reply %v", op, reply)
+	}
+	allowed, _ := reply[0].(int64)
+	raw, _ := reply[1].(string)
+	tokens, err := strconv.ParseFloat(raw, 64)
+	if err != nil {
+		return Result{}, fmt.Errorf("%s: unexpected tokens %v: %w", op, reply[1], err)
+	}
+
+	return result(allowed == 1, tokens, limit), nil
+}
@@ -0,0 +1,147 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"maps"
+	"slices"
+
+	"github.com/alonsoF100/authorization-service/internal/apperrors"
+	"github.com/alonsoF100/authorization-service/internal/models"
+)
+
+// CreateAuditEvent returns apperrors.ErrAuditChainConflict when another
+// event already follows the previous hash
+func (r *Repository) CreateAuditEvent(ctx context.Context, event *models.AuditEvent) error {
+	defer r.lock(ctx)()
+
+	if event.PrevHash != "" {
+		for _, existing := range r.auditEvents {
+			if existing.PrevHash == event.PrevHash {
+				return apperrors.ErrAuditChainConflict
+			}
+		}
+	}
+
+	event.ID = int64(len(r.auditEvents)) + 1
+
+	copied := *event
+	copied.Details = maps.Clone(event.Details)
+	r.auditEvents = append(r.auditEvents, copied)
+
+	return nil
+}
+
+// LastAuditEvent returns the newest event, nil when there is none
+func (r *Repository) LastAuditEvent(ctx context.Context) (*models.AuditEvent, error) {
+	defer r.rlock(ctx)()
+
+	if len(r.auditEvents) == 0 {
+		return nil, nil
+	}
+
+	event := r.auditEvents[len(r.auditEvents)-1]
+	event.Details = maps.Clone(event.Details)
+
+	return &event, nil
+}
+
+// LockAuditHead returns the newest event like LastAuditEvent, InTx holds the
+// lock of the repository until the transaction ends
+func (r *Repository) LockAuditHead(ctx context.Context) (*models.AuditEvent, error) {
+	return r.LastAuditEvent(ctx)
+}
+
+// ListAuditEvents returns the newest matching events first
+func (r *Repository) ListAuditEvents(ctx context.Context, filter models.AuditFilter) ([]models.AuditEvent, error) {
+	defer r.rlock(ctx)()
+
+	var events []models.AuditEvent
+	for i := len(r.auditEvents) - 1; i >= 0 && len(events) < filter.Limit; i-- {
+		event := r.auditEvents[i]
+		if !auditMatches(event, filter) {
+			continue
+		}
+
+		event.Details = maps.Clone(event.Details)
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+// ListAuditTrail returns up to limit events after afterID, oldest first
+func (r *Repository) ListAuditTrail(ctx context.Context, afterID int64, limit int) ([]models.AuditEvent, error) {
+	defer r.rlock(ctx)()
+
+	var events []models.AuditEvent
+	for _, event := range r.auditEvents {
+		if len(events) == limit {
+			break
+		}
+		if event.ID <= afterID {
+			continue
+		}
+
+		event.Details = maps.Clone(event.Details)
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+func (r *Repository) CreateAuditCheckpoint(ctx context.Context, checkpoint *models.AuditCheckpoint) error {
+	defer r.lock(ctx)()
+
+	checkpoint.ID = int64(len(r.auditCheckpoints)) + 1
+	r.auditCheckpoints = append(r.auditCheckpoints, *checkpoint)
+
+	return nil
+}
+
+// LastAuditCheckpoint returns the checkpoint of the newest event, nil when
+// there is none
+func (r *Repository) LastAuditCheckpoint(ctx context.Context) (*models.AuditCheckpoint, error) {
+	defer r.rlock(ctx)()
+
+	var last *models.AuditCheckpoint
+	for i := range r.auditCheckpoints {
+		if last == nil || r.auditCheckpoints[i].EventID >= last.EventID {
+			last = &r.auditCheckpoints[i]
+		}
+	}
+	if last == nil {
+		return nil, nil
+	}
+
+	copied := *last
+	return &copied, nil
+}
+
+// ListAuditCheckpoints returns every checkpoint, oldest event first
+func (r *Repository) ListAuditCheckpoints(ctx context.Context) ([]models.AuditCheckpoint, error) {
+	defer r.rlock(ctx)()
+
+	checkpoints := slices.Clone(r.auditCheckpoints)
+	slices.SortStableFunc(checkpoints, func(a, b models.AuditCheckpoint) int {
+		return cmp.Compare(a.EventID, b.EventID)
+	})
+
+	return checkpoints, nil
+}
+
+func auditMatches(event models.AuditEvent, filter models.AuditFilter) bool {
+	switch {
+	case filter.Type != "" && event.Type != filter.Type,
+		filter.Outcome != "" && event.Outcome != filter.Outcome,
+		filter.ActorID != "" && event.ActorID != filter.ActorID,
+		filter.TargetID != "" && event.TargetID != filter.TargetID,
+		filter.IP != "" && event.IP != filter.IP,
+		filter.Since != nil && event.CreatedAt.Before(*filter.Since),
+		filter.Until != nil && !event.CreatedAt.Before(*filter.Until),
+		filter.BeforeID != 0 && event.ID >= filter.BeforeID:
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,66 @@
+package memory
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/alonsoF100/authorization-service/internal/apperrors"
+	"github.com/alonsoF100/authorization-service/internal/models"
+)
+
+func (r *Repository) CreateUser(ctx context.Context, userDB *models.User) (*models.User, error) {
+	const op = "repository/memory/auth.go/CreateUser"
+
+	defer r.lock(ctx, partUsers)()
+
+	for _, user := range r.users {
+		if user.Email == userDB.Email {
+			slog.Debug("Email already exists",
+				slog.String("op", op),
+				slog.String("email", userDB.Email),
+			)
+			return nil, apperrors.ErrEmailExist
+		}
+	}
+	for _, user := range r.users {
+		if user.Nickname == userDB.Nickname {
+			slog.Debug("Nickname already exists",
+				slog.String("op", op),
+				slog.String("nickname", userDB.Nickname),
+			)
+			return nil, apperrors.ErrUserExist
+		}
+	}
+
+	user := *userDB
+	user.Roles = append([]string{}, userDB.Roles...)
+	user.AllowedIPs = append([]string{}, userDB.AllowedIPs...)
+	r.users[user.ID] = &user
+
+	return &models.User{
+		Nickname:  user.Nickname,
+		Email:     user.Email,
+		ID:        user.ID,
+		CreatedAt: user.CreatedAt,
+	}, nil
+}
+
+func (r *Repository) FindByEmail(ctx context.Context, email string) (*models.User, error) {
+	defer r.rlock(ctx)()
+
+	for _, user := range r.users {
+		if user.Email == email {
+			return &models.User{
+				ID:           user.ID,
+				Email:        user.Email,
+				Nickname:     user.Nickname,
+				PasswordHash: user.PasswordHash,
+				Roles:        append([]string{}, user.Roles...),
+				AllowedIPs:   append([]string{}, user.AllowedIPs...),
+				DisabledAt:   copyTime(user.DisabledAt),
+			}, nil
+		}
+	}
+
+	return nil, nil
+}
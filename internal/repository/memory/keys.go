@@ -0,0 +1,54 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/alonsoF100/authorization-service/internal/models"
+)
+
+func (r *Repository) CreateSigningKey(ctx context.Context, key *models.SigningKey) error {
+	const op = "repository/memory/keys.go/CreateSigningKey"
+
+	defer r.lock(ctx, partSigningKeys)()
+
+	if _, ok := r.signingKeys[key.ID]; ok {
+		return fmt.Errorf("%s: signing key %s already exists", op, key.ID)
+	}
+
+	stored := *key
+	r.signingKeys[key.ID] = &stored
+
+	return nil
+}
+
+func (r *Repository) ListSigningKeys(ctx context.Context) ([]models.SigningKey, error) {
+	defer r.rlock(ctx)()
+
+	keys := make([]models.SigningKey, 0, len(r.signingKeys))
+	for _, key := range r.signingKeys {
+		copied := *key
+		copied.RetiredAt = copyTime(key.RetiredAt)
+		keys = append(keys, copied)
+	}
+
+	slices.SortFunc(keys, func(a, b models.SigningKey) int {
+		return b.CreatedAt.Compare(a.CreatedAt)
+	})
+
+	return keys, nil
+}
+
+func (r *Repository) RetireSigningKeys(ctx context.Context, createdBefore, retiredAt time.Time) error {
+	defer r.lock(ctx, partSigningKeys)()
+
+	for _, key := range r.signingKeys {
+		if key.CreatedAt.Before(createdBefore) && key.RetiredAt == nil {
+			key.RetiredAt = &retiredAt
+		}
+	}
+
+	return nil
+}
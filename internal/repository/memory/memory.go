@@ -0,0 +1,57 @@
+package memory
+
+import (
+	"sync"
+	"time"
+
+	"github.com/alonsoF100/authorization-service/internal/models"
+)
+
+// Repository keeps all data in process memory. It is meant for local
+// development and tests, everything is lost on restart.
+type Repository struct {
+	mu sync.RWMutex
+	state
+}
+
+// state is everything InTx restores when the transaction fails
+type state struct {
+	users       map[string]*models.User
+	signingKeys map[string]*models.SigningKey
+	clients     map[string]*models.Client
+	revoked     map[string]time.Time
+	codes       map[string]*models.AuthorizationCode
+	consents    map[consentKey]*models.Consent
+	tokens      map[string]*models.PersonalAccessToken
+	accounts    map[string]*models.ServiceAccount
+	apiKeys     map[string]*models.APIKey
+	sessions    map[string]*models.Session
+	// auditEvents is append-only and ordered by id
+	auditEvents      []models.AuditEvent
+	auditCheckpoints []models.AuditCheckpoint
+	// outboxEvents is ordered by id, published events are purged
+	outboxEvents []models.OutboxEvent
+	lastOutboxID int64
+}
+
+type consentKey struct {
+	userID   string
+	clientID string
+}
+
+func New() *Repository {
+	return &Repository{
+		state: state{
+			users:       make(map[string]*models.User),
+			signingKeys: make(map[string]*models.SigningKey),
+			clients:     make(map[string]*models.Client),
+			revoked:     make(map[string]time.Time),
+			codes:       make(map[string]*models.AuthorizationCode),
+			consents:    make(map[consentKey]*models.Consent),
+			tokens:      make(map[string]*models.PersonalAccessToken),
+			accounts:    make(map[string]*models.ServiceAccount),
+			apiKeys:     make(map[string]*models.APIKey),
+			sessions:    make(map[string]*models.Session),
+		},
+	}
+}
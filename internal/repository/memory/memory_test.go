@@ -0,0 +1,15 @@
+package memory_test
+
+import (
+	"testing"
+
+	"github.com/alonsoF100/authorization-service/internal/repository"
+	"github.com/alonsoF100/authorization-service/internal/repository/memory"
+	"github.com/alonsoF100/authorization-service/internal/repository/repositorytest"
+)
+
+func TestConformance(t *testing.T) {
+	repositorytest.Run(t, func(t *testing.T) repository.Repository {
+		return memory.New()
+	})
+}
@@ -0,0 +1,157 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/alonsoF100/authorization-service/internal/models"
+)
+
+func (r *Repository) CreateClient(ctx context.Context, client *models.Client) error {
+	const op = "repository/memory/oauth.go/CreateClient"
+
+	defer r.lock(ctx, partClients)()
+
+	if _, ok := r.clients[client.ID]; ok {
+		return fmt.Errorf("%s: client %s already exists", op, client.ID)
+	}
+
+	stored := *client
+	stored.RedirectURIs = append([]string{}, client.RedirectURIs...)
+	stored.GrantTypes = append([]string{}, client.GrantTypes...)
+	stored.PostLogoutRedirectURIs = append([]string{}, client.PostLogoutRedirectURIs...)
+	stored.Scopes = append([]string{}, client.Scopes...)
+	r.clients[client.ID] = &stored
+
+	return nil
+}
+
+func (r *Repository) FindClientByID(ctx context.Context, clientID string) (*models.Client, error) {
+	defer r.rlock(ctx)()
+
+	client, ok := r.clients[clientID]
+	if !ok {
+		return nil, nil
+	}
+
+	copied := *client
+	copied.RedirectURIs = append([]string{}, client.RedirectURIs...)
+	copied.GrantTypes = append([]string{}, client.GrantTypes...)
+	copied.PostLogoutRedirectURIs = append([]string{}, client.PostLogoutRedirectURIs...)
+	copied.Scopes = append([]string{}, client.Scopes...)
+	return &copied, nil
+}
+
+func (r *Repository) RevokeToken(ctx context.Context, jti string, expiresAt time.Time) error {
+	defer r.lock(ctx, partRevoked)()
+
+	if _, ok := r.revoked[jti]; !ok {
+		r.revoked[jti] = expiresAt
+	}
+
+	return nil
+}
+
+func (r *Repository) IsTokenRevoked(ctx context.Context, jti string) (bool, error) {
+	defer r.rlock(ctx)()
+
+	_, ok := r.revoked[jti]
+	return ok, nil
+}
+
+func (r *Repository) PurgeRevokedTokens(ctx context.Context, expiredBefore time.Time) (int64, error) {
+	defer r.lock(ctx, partRevoked)()
+
+	var purged int64
+	for jti, expiresAt := range r.revoked {
+		if expiresAt.Before(expiredBefore) {
+			delete(r.revoked, jti)
+			purged++
+		}
+	}
+
+	return purged, nil
+}
+
+func (r *Repository) CreateAuthorizationCode(ctx context.Context, code *models.AuthorizationCode) error {
+	const op = "repository/memory/oauth.go/CreateAuthorizationCode"
+
+	defer r.lock(ctx, partCodes)()
+
+	if _, ok := r.codes[code.CodeHash]; ok {
+		return fmt.Errorf("%s: authorization code already exists", op)
+	}
+	if _, ok := r.users[code.UserID]; !ok {
+		return fmt.Errorf("%s: user %s does not exist", op, code.UserID)
+	}
+	if _, ok := r.clients[code.ClientID]; !ok {
+		return fmt.Errorf("%s: client %s does not exist", op, code.ClientID)
+	}
+
+	stored := *code
+	r.codes[code.CodeHash] = &stored
+
+	return nil
+}
+
+// ConsumeAuthorizationCode deletes the code and returns it, a second call
+// with the same code finds nothing
+func (r *Repository) ConsumeAuthorizationCode(ctx context.Context, codeHash string) (*models.AuthorizationCode, error) {
+	defer r.lock(ctx, partCodes)()
+
+	code, ok := r.codes[codeHash]
+	if !ok {
+		return nil, nil
+	}
+	delete(r.codes, codeHash)
+
+	return code, nil
+}
+
+func (r *Repository) PurgeAuthorizationCodes(ctx context.Context, expiredBefore time.Time) (int64, error) {
+	defer r.lock(ctx, partCodes)()
+
+	var purged int64
+	for hash, code := range r.codes {
+		if code.ExpiresAt.Before(expiredBefore) {
+			delete(r.codes, hash)
+			purged++
+		}
+	}
+
+	return purged, nil
+}
+
+func (r *Repository) FindConsent(ctx context.Context, userID, clientID string) (*models.Consent, error) {
+	defer r.rlock(ctx)()
+
+	consent, ok := r.consents[consentKey{userID: userID, clientID: clientID}]
+	if !ok {
+		return nil, nil
+	}
+
+	copied := *consent
+	copied.Scopes = append([]string{}, consent.Scopes...)
+	return &copied, nil
+}
+
+// SaveConsent creates the consent or replaces its scopes
+func (r *Repository) SaveConsent(ctx context.Context, consent *models.Consent) error {
+	const op = "repository/memory/oauth.go/SaveConsent"
+
+	defer r.lock(ctx, partConsents)()
+
+	if _, ok := r.users[consent.UserID]; !ok {
+		return fmt.Errorf("%s: user %s does not exist", op, consent.UserID)
+	}
+	if _, ok := r.clients[consent.ClientID]; !ok {
+		return fmt.Errorf("%s: client %s does not exist", op, consent.ClientID)
+	}
+
+	stored := *consent
+	stored.Scopes = append([]string{}, consent.Scopes...)
+	r.consents[consentKey{userID: consent.UserID, clientID: consent.ClientID}] = &stored
+
+	return nil
+}
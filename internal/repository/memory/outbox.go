@@ -0,0 +1,104 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"time"
+
+	"github.com/alonsoF100/authorization-service/internal/models"
+)
+
+func (r *Repository) CreateOutboxEvent(ctx context.Context, event *models.OutboxEvent) error {
+	defer r.lock(ctx)()
+
+	r.lastOutboxID++
+	event.ID = r.lastOutboxID
+
+	copied := *event
+	copied.Payload = slices.Clone(event.Payload)
+	r.outboxEvents = append(r.outboxEvents, copied)
+
+	return nil
+}
+
+// ClaimOutboxEvents leases up to limit due events until claimedUntil. Only
+// the oldest pending event of each aggregate is claimed, the next one
+// waits until it is published.
+func (r *Repository) ClaimOutboxEvents(ctx context.Context, now, claimedUntil time.Time, limit int) ([]models.OutboxEvent, error) {
+	defer r.lock(ctx, partOutbox)()
+
+	pending := make(map[string]bool)
+	var events []models.OutboxEvent
+	for i := range r.outboxEvents {
+		if len(events) == limit {
+			break
+		}
+
+		event := &r.outboxEvents[i]
+		if event.PublishedAt != nil || pending[event.AggregateID] {
+			continue
+		}
+		pending[event.AggregateID] = true
+
+		if event.NextAttemptAt.After(now) {
+			continue
+		}
+
+		event.Attempts++
+		event.NextAttemptAt = claimedUntil
+
+		claimed := *event
+		claimed.Payload = slices.Clone(event.Payload)
+		events = append(events, claimed)
+	}
+
+	return events, nil
+}
+
+func (r *Repository) MarkOutboxEventPublished(ctx context.Context, id int64, publishedAt time.Time) error {
+	defer r.lock(ctx, partOutbox)()
+
+	if event := r.findOutboxEvent(id); event != nil {
+		event.PublishedAt = &publishedAt
+		event.LastError = ""
+	}
+
+	return nil
+}
+
+// RetryOutboxEvent records a failed delivery, the event is claimed again
+// at nextAttemptAt
+func (r *Repository) RetryOutboxEvent(ctx context.Context, id int64, nextAttemptAt time.Time, lastError string) error {
+	defer r.lock(ctx, partOutbox)()
+
+	if event := r.findOutboxEvent(id); event != nil {
+		event.NextAttemptAt = nextAttemptAt
+		event.LastError = lastError
+	}
+
+	return nil
+}
+
+// PurgeOutboxEvents deletes events published before the given time
+func (r *Repository) PurgeOutboxEvents(ctx context.Context, publishedBefore time.Time) (int64, error) {
+	defer r.lock(ctx, partOutbox)()
+
+	count := len(r.outboxEvents)
+	r.outboxEvents = slices.DeleteFunc(r.outboxEvents, func(event models.OutboxEvent) bool {
+		return event.PublishedAt != nil && event.PublishedAt.Before(publishedBefore)
+	})
+
+	return int64(count - len(r.outboxEvents)), nil
+}
+
+func (r *Repository) findOutboxEvent(id int64) *models.OutboxEvent {
+	i, found := slices.BinarySearchFunc(r.outboxEvents, id, func(event models.OutboxEvent, id int64) int {
+		return cmp.Compare(event.ID, id)
+	})
+	if !found {
+		return nil
+	}
+
+	return &r.outboxEvents[i]
+}
@@ -0,0 +1,102 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/alonsoF100/authorization-service/internal/apperrors"
+	"github.com/alonsoF100/authorization-service/internal/models"
+)
+
+func (r *Repository) CreatePersonalAccessToken(ctx context.Context, token *models.PersonalAccessToken) error {
+	const op = "repository/memory/personal_access_token.go/CreatePersonalAccessToken"
+
+	defer r.lock(ctx, partTokens)()
+
+	if _, ok := r.tokens[token.ID]; ok {
+		return fmt.Errorf("%s: token %s already exists", op, token.ID)
+	}
+	for _, stored := range r.tokens {
+		if stored.TokenHash == token.TokenHash {
+			return fmt.Errorf("%s: token hash already exists", op)
+		}
+	}
+	if _, ok := r.users[token.UserID]; !ok {
+		return fmt.Errorf("%s: user %s does not exist", op, token.UserID)
+	}
+
+	r.tokens[token.ID] = copyToken(token)
+
+	return nil
+}
+
+// ListPersonalAccessTokens returns the tokens of a user, newest first
+func (r *Repository) ListPersonalAccessTokens(ctx context.Context, userID string) ([]models.PersonalAccessToken, error) {
+	defer r.rlock(ctx)()
+
+	var tokens []models.PersonalAccessToken
+	for _, token := range r.tokens {
+		if token.UserID == userID {
+			tokens = append(tokens, *copyToken(token))
+		}
+	}
+
+	slices.SortFunc(tokens, func(a, b models.PersonalAccessToken) int {
+		if c := b.CreatedAt.Compare(a.CreatedAt); c != 0 {
+			return c
+		}
+		return strings.Compare(a.ID, b.ID)
+	})
+
+	return tokens, nil
+}
+
+func (r *Repository) FindPersonalAccessTokenByHash(ctx context.Context, tokenHash string) (*models.PersonalAccessToken, error) {
+	defer r.rlock(ctx)()
+
+	for _, token := range r.tokens {
+		if token.TokenHash == tokenHash {
+			return copyToken(token), nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (r *Repository) DeletePersonalAccessToken(ctx context.Context, userID, tokenID string) error {
+	defer r.lock(ctx, partTokens)()
+
+	token, ok := r.tokens[tokenID]
+	if !ok || token.UserID != userID {
+		return apperrors.ErrTokenNotFound
+	}
+
+	delete(r.tokens, tokenID)
+
+	return nil
+}
+
+func (r *Repository) TouchPersonalAccessToken(ctx context.Context, tokenID string, usedAt time.Time, ip string) error {
+	defer r.lock(ctx, partTokens)()
+
+	token, ok := r.tokens[tokenID]
+	if !ok {
+		return apperrors.ErrTokenNotFound
+	}
+
+	token.LastUsedAt = &usedAt
+	token.LastUsedIP = ip
+
+	return nil
+}
+
+func copyToken(token *models.PersonalAccessToken) *models.PersonalAccessToken {
+	copied := *token
+	copied.Scopes = append([]string{}, token.Scopes...)
+	copied.ExpiresAt = copyTime(token.ExpiresAt)
+	copied.LastUsedAt = copyTime(token.LastUsedAt)
+	return &copied
+}
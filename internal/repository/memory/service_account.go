@@ -0,0 +1,167 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/alonsoF100/authorization-service/internal/apperrors"
+	"github.com/alonsoF100/authorization-service/internal/models"
+)
+
+func (r *Repository) CreateServiceAccount(ctx context.Context, account *models.ServiceAccount) error {
+	const op = "repository/memory/service_account.go/CreateServiceAccount"
+
+	defer r.lock(ctx, partAccounts)()
+
+	if _, ok := r.accounts[account.ID]; ok {
+		return fmt.Errorf("%s: service account %s already exists", op, account.ID)
+	}
+	for _, stored := range r.accounts {
+		if stored.Name == account.Name {
+			return apperrors.ErrServiceAccountExist
+		}
+	}
+	if _, ok := r.users[account.OwnerID]; !ok && account.OwnerID != "" {
+		return fmt.Errorf("%s: user %s does not exist", op, account.OwnerID)
+	}
+
+	stored := *account
+	stored.Roles = append([]string{}, account.Roles...)
+	r.accounts[account.ID] = &stored
+
+	return nil
+}
+
+// ListServiceAccounts returns every service account ordered by name
+func (r *Repository) ListServiceAccounts(ctx context.Context) ([]models.ServiceAccount, error) {
+	defer r.rlock(ctx)()
+
+	var accounts []models.ServiceAccount
+	for _, account := range r.accounts {
+		copied := *account
+		copied.Roles = append([]string{}, account.Roles...)
+		accounts = append(accounts, copied)
+	}
+
+	slices.SortFunc(accounts, func(a, b models.ServiceAccount) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	return accounts, nil
+}
+
+func (r *Repository) FindServiceAccountByID(ctx context.Context, accountID string) (*models.ServiceAccount, error) {
+	defer r.rlock(ctx)()
+
+	account, ok := r.accounts[accountID]
+	if !ok {
+		return nil, nil
+	}
+
+	copied := *account
+	copied.Roles = append([]string{}, account.Roles...)
+	return &copied, nil
+}
+
+func (r *Repository) DeleteServiceAccount(ctx context.Context, accountID string) error {
+	defer r.lock(ctx, partAccounts, partAPIKeys)()
+
+	if _, ok := r.accounts[accountID]; !ok {
+		return apperrors.ErrServiceAccountNotFound
+	}
+
+	delete(r.accounts, accountID)
+
+	// mirrors ON DELETE CASCADE of the SQL backends
+	for id, key := range r.apiKeys {
+		if key.ServiceAccountID == accountID {
+			delete(r.apiKeys, id)
+		}
+	}
+
+	return nil
+}
+
+func (r *Repository) CreateAPIKey(ctx context.Context, key *models.APIKey) error {
+	const op = "repository/memory/service_account.go/CreateAPIKey"
+
+	defer r.lock(ctx, partAPIKeys)()
+
+	if _, ok := r.apiKeys[key.ID]; ok {
+		return fmt.Errorf("%s: api key %s already exists", op, key.ID)
+	}
+	if _, ok := r.accounts[key.ServiceAccountID]; !ok {
+		return fmt.Errorf("%s: service account %s does not exist", op, key.ServiceAccountID)
+	}
+
+	stored := *key
+	stored.LastUsedAt = copyTime(key.LastUsedAt)
+	r.apiKeys[key.ID] = &stored
+
+	return nil
+}
+
+// ListAPIKeys returns the keys of a service account, newest first
+func (r *Repository) ListAPIKeys(ctx context.Context, accountID string) ([]models.APIKey, error) {
+	defer r.rlock(ctx)()
+
+	var keys []models.APIKey
+	for _, key := range r.apiKeys {
+		if key.ServiceAccountID == accountID {
+			copied := *key
+			copied.LastUsedAt = copyTime(key.LastUsedAt)
+			keys = append(keys, copied)
+		}
+	}
+
+	slices.SortFunc(keys, func(a, b models.APIKey) int {
+		if c := b.CreatedAt.Compare(a.CreatedAt); c != 0 {
+			return c
+		}
+		return strings.Compare(a.ID, b.ID)
+	})
+
+	return keys, nil
+}
+
+func (r *Repository) FindAPIKeyByID(ctx context.Context, keyID string) (*models.APIKey, error) {
+	defer r.rlock(ctx)()
+
+	key, ok := r.apiKeys[keyID]
+	if !ok {
+		return nil, nil
+	}
+
+	copied := *key
+	copied.LastUsedAt = copyTime(key.LastUsedAt)
+	return &copied, nil
+}
+
+func (r *Repository) DeleteAPIKey(ctx context.Context, accountID, keyID string) error {
+	defer r.lock(ctx, partAPIKeys)()
+
+	key, ok := r.apiKeys[keyID]
+	if !ok || key.ServiceAccountID != accountID {
+		return apperrors.ErrAPIKeyNotFound
+	}
+
+	delete(r.apiKeys, keyID)
+
+	return nil
+}
+
+func (r *Repository) TouchAPIKey(ctx context.Context, keyID string, usedAt time.Time) error {
+	defer r.lock(ctx, partAPIKeys)()
+
+	key, ok := r.apiKeys[keyID]
+	if !ok {
+		return apperrors.ErrAPIKeyNotFound
+	}
+
+	key.LastUsedAt = &usedAt
+
+	return nil
+}
@@ -0,0 +1,117 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/alonsoF100/authorization-service/internal/apperrors"
+	"github.com/alonsoF100/authorization-service/internal/models"
+)
+
+func (r *Repository) CreateSession(ctx context.Context, session *models.Session) error {
+	const op = "repository/memory/session.go/CreateSession"
+
+	defer r.lock(ctx, partSessions)()
+
+	if _, ok := r.sessions[session.ID]; ok {
+		return fmt.Errorf("%s: session %s already exists", op, session.ID)
+	}
+	if _, ok := r.users[session.UserID]; !ok {
+		return fmt.Errorf("%s: user %s does not exist", op, session.UserID)
+	}
+
+	copied := *session
+	r.sessions[session.ID] = &copied
+
+	return nil
+}
+
+func (r *Repository) FindSessionByID(ctx context.Context, sessionID string) (*models.Session, error) {
+	defer r.rlock(ctx)()
+
+	session, ok := r.sessions[sessionID]
+	if !ok {
+		return nil, nil
+	}
+
+	copied := *session
+	return &copied, nil
+}
+
+// ListSessions returns the sessions of a user that have not expired at now,
+// newest first
+func (r *Repository) ListSessions(ctx context.Context, userID string, now time.Time) ([]models.Session, error) {
+	defer r.rlock(ctx)()
+
+	var sessions []models.Session
+	for _, session := range r.sessions {
+		if session.UserID == userID && session.ExpiresAt.After(now) {
+			sessions = append(sessions, *session)
+		}
+	}
+
+	slices.SortFunc(sessions, func(a, b models.Session) int {
+		if c := b.CreatedAt.Compare(a.CreatedAt); c != 0 {
+			return c
+		}
+		return strings.Compare(a.ID, b.ID)
+	})
+
+	return sessions, nil
+}
+
+func (r *Repository) DeleteSession(ctx context.Context, userID, sessionID string) error {
+	defer r.lock(ctx, partSessions)()
+
+	session, ok := r.sessions[sessionID]
+	if !ok || session.UserID != userID {
+		return apperrors.ErrSessionNotFound
+	}
+
+	delete(r.sessions, sessionID)
+
+	return nil
+}
+
+// DeleteUserSessions signs the user out everywhere
+func (r *Repository) DeleteUserSessions(ctx context.Context, userID string) error {
+	defer r.lock(ctx, partSessions)()
+
+	for id, session := range r.sessions {
+		if session.UserID == userID {
+			delete(r.sessions, id)
+		}
+	}
+
+	return nil
+}
+
+func (r *Repository) TouchSession(ctx context.Context, sessionID string, seenAt time.Time) error {
+	defer r.lock(ctx, partSessions)()
+
+	session, ok := r.sessions[sessionID]
+	if !ok {
+		return apperrors.ErrSessionNotFound
+	}
+
+	session.LastSeenAt = seenAt
+
+	return nil
+}
+
+func (r *Repository) PurgeSessions(ctx context.Context, expiredBefore time.Time) (int64, error) {
+	defer r.lock(ctx, partSessions)()
+
+	var purged int64
+	for id, session := range r.sessions {
+		if session.ExpiresAt.Before(expiredBefore) {
+			delete(r.sessions, id)
+			purged++
+		}
+	}
+
+	return purged, nil
+}
@@ -0,0 +1,214 @@
+package memory
+
+import (
+	"context"
+	"maps"
+	"slices"
+
+	"github.com/alonsoF100/authorization-service/internal/models"
+)
+
+type txContextKey struct{}
+
+// part is a piece of the state a write changes. A transaction copies each
+// part before its first write to it, the rest is never copied.
+type part int
+
+const (
+	partUsers part = iota
+	partSigningKeys
+	partClients
+	partRevoked
+	partCodes
+	partConsents
+	partTokens
+	partAccounts
+	partAPIKeys
+	partSessions
+	// partOutbox is taken by writes that change or purge outbox events,
+	// appends are undone by length
+	partOutbox
+	partCount
+)
+
+// undo is what a failed transaction restores: the parts it wrote to as they
+// were before and the lengths of the slices it may have appended to
+type undo struct {
+	saved   state
+	touched [partCount]bool
+
+	auditEvents      int
+	auditCheckpoints int
+	outboxEvents     int
+	lastOutboxID     int64
+}
+
+// InTx runs fn holding the repository lock, other callers wait until it
+// returns. The data is restored to where it was when fn fails. A nested
+// call joins the running transaction.
+func (r *Repository) InTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	if inTx(ctx) {
+		return fn(ctx)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	u := &undo{
+		auditEvents:      len(r.auditEvents),
+		auditCheckpoints: len(r.auditCheckpoints),
+		outboxEvents:     len(r.outboxEvents),
+		lastOutboxID:     r.lastOutboxID,
+	}
+	if err := fn(context.WithValue(ctx, txContextKey{}, u)); err != nil {
+		u.rollback(&r.state)
+		return err
+	}
+
+	return nil
+}
+
+func inTx(ctx context.Context) bool {
+	_, ok := ctx.Value(txContextKey{}).(*undo)
+
+	return ok
+}
+
+// lock takes the write lock unless the transaction of ctx already holds it,
+// then it saves the parts the write changes instead. The returned func
+// releases the lock.
+func (r *Repository) lock(ctx context.Context, parts ...part) func() {
+	if u, ok := ctx.Value(txContextKey{}).(*undo); ok {
+		for _, p := range parts {
+			u.save(&r.state, p)
+		}
+		return func() {}
+	}
+
+	r.mu.Lock()
+	return r.mu.Unlock
+}
+
+func (r *Repository) rlock(ctx context.Context) func() {
+	if inTx(ctx) {
+		return func() {}
+	}
+
+	r.mu.RLock()
+	return r.mu.RUnlock
+}
+
+func (u *undo) save(s *state, p part) {
+	if u.touched[p] {
+		return
+	}
+	u.touched[p] = true
+
+	switch p {
+	case partUsers:
+		u.saved.users = cloneValues(s.users, copyUser)
+	case partSigningKeys:
+		u.saved.signingKeys = cloneValues(s.signingKeys, copySigningKey)
+	case partClients:
+		u.saved.clients = cloneValues(s.clients, copyClient)
+	case partRevoked:
+		u.saved.revoked = maps.Clone(s.revoked)
+	case partCodes:
+		u.saved.codes = cloneValues(s.codes, copyValue)
+	case partConsents:
+		u.saved.consents = cloneValues(s.consents, copyConsent)
+	case partTokens:
+		u.saved.tokens = cloneValues(s.tokens, copyToken)
+	case partAccounts:
+		u.saved.accounts = cloneValues(s.accounts, copyServiceAccount)
+	case partAPIKeys:
+		u.saved.apiKeys = cloneValues(s.apiKeys, copyAPIKey)
+	case partSessions:
+		u.saved.sessions = cloneValues(s.sessions, copyValue)
+	case partOutbox:
+		u.saved.outboxEvents = slices.Clone(s.outboxEvents)
+	}
+}
+
+func (u *undo) rollback(s *state) {
+	restore := func(p part, restore func()) {
+		if u.touched[p] {
+			restore()
+		}
+	}
+	restore(partUsers, func() { s.users = u.saved.users })
+	restore(partSigningKeys, func() { s.signingKeys = u.saved.signingKeys })
+	restore(partClients, func() { s.clients = u.saved.clients })
+	restore(partRevoked, func() { s.revoked = u.saved.revoked })
+	restore(partCodes, func() { s.codes = u.saved.codes })
+	restore(partConsents, func() { s.consents = u.saved.consents })
+	restore(partTokens, func() { s.tokens = u.saved.tokens })
+	restore(partAccounts, func() { s.accounts = u.saved.accounts })
+	restore(partAPIKeys, func() { s.apiKeys = u.saved.apiKeys })
+	restore(partSessions, func() { s.sessions = u.saved.sessions })
+	restore(partOutbox, func() { s.outboxEvents = u.saved.outboxEvents })
+
+	// the events before the saved lengths are unchanged, a change to an
+	// outbox event saves the outbox first
+	s.auditEvents = slices.Delete(s.auditEvents, u.auditEvents, len(s.auditEvents))
+	s.auditCheckpoints = slices.Delete(s.auditCheckpoints, u.auditCheckpoints, len(s.auditCheckpoints))
+	s.outboxEvents = slices.Delete(s.outboxEvents, u.outboxEvents, len(s.outboxEvents))
+	s.lastOutboxID = u.lastOutboxID
+}
+
+// cloneValues copies the map and the values it points to, methods update
+// the stored values in place
+func cloneValues[K comparable, V any](m map[K]*V, copyValue func(value *V) *V) map[K]*V {
+	cloned := make(map[K]*V, len(m))
+	for key, value := range m {
+		cloned[key] = copyValue(value)
+	}
+
+	return cloned
+}
+
+func copyValue[V any](value *V) *V {
+	copied := *value
+	return &copied
+}
+
+func copyUser(user *models.User) *models.User {
+	copied := *user
+	copied.Roles = slices.Clone(user.Roles)
+	copied.AllowedIPs = slices.Clone(user.AllowedIPs)
+	copied.DisabledAt = copyTime(user.DisabledAt)
+	return &copied
+}
+
+func copySigningKey(key *models.SigningKey) *models.SigningKey {
+	copied := *key
+	copied.RetiredAt = copyTime(key.RetiredAt)
+	return &copied
+}
+
+func copyClient(client *models.Client) *models.Client {
+	copied := *client
+	copied.RedirectURIs = slices.Clone(client.RedirectURIs)
+	copied.GrantTypes = slices.Clone(client.GrantTypes)
+	copied.PostLogoutRedirectURIs = slices.Clone(client.PostLogoutRedirectURIs)
+	copied.Scopes = slices.Clone(client.Scopes)
+	return &copied
+}
+
+func copyConsent(consent *models.Consent) *models.Consent {
+	copied := *consent
+	copied.Scopes = slices.Clone(consent.Scopes)
+	return &copied
+}
+
+func copyServiceAccount(account *models.ServiceAccount) *models.ServiceAccount {
+	copied := *account
+	copied.Roles = slices.Clone(account.Roles)
+	return &copied
+}
+
+func copyAPIKey(key *models.APIKey) *models.APIKey {
+	copied := *key
+	copied.LastUsedAt = copyTime(key.LastUsedAt)
+	return &copied
+}
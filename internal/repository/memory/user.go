@@ -0,0 +1,120 @@
+package memory
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/alonsoF100/authorization-service/internal/apperrors"
+	"github.com/alonsoF100/authorization-service/internal/models"
+)
+
+func (r *Repository) FindByID(ctx context.Context, userID string) (*models.User, error) {
+	defer r.rlock(ctx)()
+
+	user, ok := r.users[userID]
+	if !ok {
+		return nil, nil
+	}
+
+	return &models.User{
+		ID:         user.ID,
+		Nickname:   user.Nickname,
+		Email:      user.Email,
+		Roles:      append([]string{}, user.Roles...),
+		AllowedIPs: append([]string{}, user.AllowedIPs...),
+		DisabledAt: copyTime(user.DisabledAt),
+	}, nil
+}
+
+func (r *Repository) DeleteUser(ctx context.Context, userID string) error {
+	defer r.lock(ctx, partUsers, partCodes, partConsents, partTokens, partSessions, partAccounts)()
+
+	if _, ok := r.users[userID]; !ok {
+		return apperrors.ErrUserNotFoundByID
+	}
+
+	delete(r.users, userID)
+
+	// mirrors ON DELETE CASCADE of the SQL backends
+	for hash, code := range r.codes {
+		if code.UserID == userID {
+			delete(r.codes, hash)
+		}
+	}
+	for key := range r.consents {
+		if key.userID == userID {
+			delete(r.consents, key)
+		}
+	}
+	for id, token := range r.tokens {
+		if token.UserID == userID {
+			delete(r.tokens, id)
+		}
+	}
+	for id, session := range r.sessions {
+		if session.UserID == userID {
+			delete(r.sessions, id)
+		}
+	}
+	// mirrors ON DELETE SET NULL, service accounts outlive their owner
+	for _, account := range r.accounts {
+		if account.OwnerID == userID {
+			account.OwnerID = ""
+		}
+	}
+
+	return nil
+}
+
+func (r *Repository) UpdatePassword(ctx context.Context, userID, passwordHash string, updatedAt time.Time) error {
+	return r.updateUser(ctx, userID, func(user *models.User) {
+		user.PasswordHash = passwordHash
+		user.UpdatedAt = updatedAt
+	})
+}
+
+func (r *Repository) DisableUser(ctx context.Context, userID string, disabledAt time.Time) error {
+	return r.updateUser(ctx, userID, func(user *models.User) {
+		user.DisabledAt = &disabledAt
+		user.UpdatedAt = disabledAt
+	})
+}
+
+func (r *Repository) AddRole(ctx context.Context, userID, role string, updatedAt time.Time) error {
+	return r.updateUser(ctx, userID, func(user *models.User) {
+		if !slices.Contains(user.Roles, role) {
+			user.Roles = append(user.Roles, role)
+		}
+		user.UpdatedAt = updatedAt
+	})
+}
+
+func (r *Repository) SetAllowedIPs(ctx context.Context, userID string, allowedIPs []string, updatedAt time.Time) error {
+	return r.updateUser(ctx, userID, func(user *models.User) {
+		user.AllowedIPs = append([]string{}, allowedIPs...)
+		user.UpdatedAt = updatedAt
+	})
+}
+
+func (r *Repository) updateUser(ctx context.Context, userID string, update func(user *models.User)) error {
+	defer r.lock(ctx, partUsers)()
+
+	user, ok := r.users[userID]
+	if !ok {
+		return apperrors.ErrUserNotFoundByID
+	}
+
+	update(user)
+
+	return nil
+}
+
+func copyTime(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+
+	copied := *t
+	return &copied
+}
@@ -0,0 +1,409 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/alonsoF100/authorization-service/internal/apperrors"
+	"github.com/alonsoF100/authorization-service/internal/models"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// events recorded before the hash chain have NULL hashes, they don't take
+// part in the unique previous hash
+const auditEventColumns = `id, type, outcome, actor_type, actor_id, target_id, ip, user_agent, request_id, details, created_at,
+	COALESCE(prev_hash, ''), COALESCE(hash, '')`
+
+// CreateAuditEvent returns apperrors.ErrAuditChainConflict when another
+// event already follows the previous hash
+func (r Repository) CreateAuditEvent(ctx context.Context, event *models.AuditEvent) error {
+	const op = "repository/postgres/audit.go/CreateAuditEvent"
+
+	const query = `
+	INSERT INTO audit_events (type, outcome, actor_type, actor_id, target_id, ip, user_agent, request_id, details, created_at, prev_hash, hash)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, NULLIF($11, ''), NULLIF($12, ''))
+	RETURNING id
+	`
+
+	slog.Debug("Query data",
+		slog.String("op", op),
+		slog.String("query_row", query),
+		slog.String("type", event.Type),
+	)
+
+	details := event.Details
+	if details == nil {
+		details = map[string]string{}
+	}
+
+	err := r.retryable(ctx, op, false, func(ctx context.Context) error {
+		return r.conn(ctx).QueryRow(
+			ctx,
+			query,
+			event.Type,
+			event.Outcome,
+			event.ActorType,
+			event.ActorID,
+			event.TargetID,
+			event.IP,
+			event.UserAgent,
+			event.RequestID,
+			details,
+			event.CreatedAt,
+			event.PrevHash,
+			event.Hash,
+		).Scan(&event.ID)
+	})
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" && pgErr.ConstraintName == "unique_audit_events_prev_hash" {
+			slog.Debug("Audit chain head already taken",
+				slog.String("op", op),
+				slog.String("prev_hash", event.PrevHash),
+			)
+			return apperrors.ErrAuditChainConflict
+		}
+
+		slog.Error("Failed to create audit event",
+			slog.String("op", op),
+			slog.String("type", event.Type),
+			slog.String("error", err.Error()),
+		)
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// LastAuditEvent returns the newest event, nil when there is none
+func (r Repository) LastAuditEvent(ctx context.Context) (*models.AuditEvent, error) {
+	const op = "repository/postgres/audit.go/LastAuditEvent"
+
+	const query = `
+	SELECT ` + auditEventColumns + `
+	FROM audit_events
+	ORDER BY id DESC
+	LIMIT 1
+	`
+
+	slog.Debug("Query data",
+		slog.String("op", op),
+		slog.String("query_row", query),
+	)
+
+	var event *models.AuditEvent
+	err := r.retryable(ctx, op, true, func(ctx context.Context) (err error) {
+		event, err = scanAuditEvent(r.conn(ctx).QueryRow(ctx, query))
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+
+		slog.Error("Database error",
+			slog.String("op", op),
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return event, nil
+}
+
+// auditHeadLock is the advisory lock key taken by LockAuditHead
+const auditHeadLock = 7243081
+
+// LockAuditHead returns the newest event like LastAuditEvent. The
+// transaction of ctx holds an advisory lock until it ends, so appends of
+// every instance line up behind each other.
+func (r Repository) LockAuditHead(ctx context.Context) (*models.AuditEvent, error) {
+	const op = "repository/postgres/audit.go/LockAuditHead"
+
+	const query = `SELECT pg_advisory_xact_lock($1)`
+
+	if _, ok := txFrom(ctx); !ok {
+		return nil, fmt.Errorf("%s: the audit head is locked only in a transaction", op)
+	}
+
+	slog.Debug("Query data",
+		slog.String("op", op),
+		slog.String("query_row", query),
+	)
+
+	if _, err := r.conn(ctx).Exec(ctx, query, auditHeadLock); err != nil {
+		slog.Error("Database error",
+			slog.String("op", op),
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return r.LastAuditEvent(ctx)
+}
+
+// ListAuditEvents returns the newest matching events first
+func (r Repository) ListAuditEvents(ctx context.Context, filter models.AuditFilter) ([]models.AuditEvent, error) {
+	const op = "repository/postgres/audit.go/ListAuditEvents"
+
+	const query = `
+	SELECT ` + auditEventColumns + `
+	FROM audit_events
+	WHERE ($1 = '' OR type = $1)
+		AND ($2 = '' OR outcome = $2)
+		AND ($3 = '' OR actor_id = $3)
+		AND ($4 = '' OR target_id = $4)
+		AND ($5 = '' OR ip = $5)
+		AND ($6::timestamp IS NULL OR created_at >= $6)
+		AND ($7::timestamp IS NULL OR created_at < $7)
+		AND ($8 = 0 OR id < $8)
+	ORDER BY id DESC
+	LIMIT $9
+	`
+
+	slog.Debug("Query data",
+		slog.String("op", op),
+		slog.String("query_row", query),
+	)
+
+	events, err := r.queryAuditEvents(ctx, op, query,
+		filter.Type,
+		filter.Outcome,
+		filter.ActorID,
+		filter.TargetID,
+		filter.IP,
+		filter.Since,
+		filter.Until,
+		filter.BeforeID,
+		filter.Limit,
+	)
+	if err != nil {
+		slog.Error("Database error",
+			slog.String("op", op),
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return events, nil
+}
+
+// ListAuditTrail returns up to limit events after afterID, oldest first
+func (r Repository) ListAuditTrail(ctx context.Context, afterID int64, limit int) ([]models.AuditEvent, error) {
+	const op = "repository/postgres/audit.go/ListAuditTrail"
+
+	const query = `
+	SELECT ` + auditEventColumns + `
+	FROM audit_events
+	WHERE id > $1
+	ORDER BY id
+	LIMIT $2
+	`
+
+	slog.Debug("Query data",
+		slog.String("op", op),
+		slog.String("query_row", query),
+		slog.Int64("after_id", afterID),
+	)
+
+	events, err := r.queryAuditEvents(ctx, op, query, afterID, limit)
+	if err != nil {
+		slog.Error("Database error",
+			slog.String("op", op),
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return events, nil
+}
+
+func (r Repository) queryAuditEvents(ctx context.Context, op, query string, args ...any) ([]models.AuditEvent, error) {
+	var events []models.AuditEvent
+	err := r.retryable(ctx, op, true, func(ctx context.Context) error {
+		rows, err := r.conn(ctx).Query(ctx, query, args...)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+
+		events = events[:0]
+		for rows.Next() {
+			event, err := scanAuditEvent(rows)
+			if err != nil {
+				return err
+			}
+
+			events = append(events, *event)
+		}
+
+		return rows.Err()
+	})
+
+	return events, err
+}
+
+func scanAuditEvent(row pgx.Row) (*models.AuditEvent, error) {
+	var event models.AuditEvent
+	err := row.Scan(
+		&event.ID,
+		&event.Type,
+		&event.Outcome,
+		&event.ActorType,
+		&event.ActorID,
+		&event.TargetID,
+		&event.IP,
+		&event.UserAgent,
+		&event.RequestID,
+		&event.Details,
+		&event.CreatedAt,
+		&event.PrevHash,
+		&event.Hash,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &event, nil
+}
+
+func (r Repository) CreateAuditCheckpoint(ctx context.Context, checkpoint *models.AuditCheckpoint) error {
+	const op = "repository/postgres/audit.go/CreateAuditCheckpoint"
+
+	const query = `
+	INSERT INTO audit_checkpoints (event_id, hash, key_id, signature, created_at)
+	VALUES ($1, $2, $3, $4, $5)
+	RETURNING id
+	`
+
+	slog.Debug("Query data",
+		slog.String("op", op),
+		slog.String("query_row", query),
+		slog.Int64("event_id", checkpoint.EventID),
+	)
+
+	err := r.retryable(ctx, op, false, func(ctx context.Context) error {
+		return r.conn(ctx).QueryRow(
+			ctx,
+			query,
+			checkpoint.EventID,
+			checkpoint.Hash,
+			checkpoint.KeyID,
+			checkpoint.Signature,
+			checkpoint.CreatedAt,
+		).Scan(&checkpoint.ID)
+	})
+	if err != nil {
+		slog.Error("Failed to create audit checkpoint",
+			slog.String("op", op),
+			slog.Int64("event_id", checkpoint.EventID),
+			slog.String("error", err.Error()),
+		)
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// LastAuditCheckpoint returns the checkpoint of the newest event, nil when
+// there is none
+func (r Repository) LastAuditCheckpoint(ctx context.Context) (*models.AuditCheckpoint, error) {
+	const op = "repository/postgres/audit.go/LastAuditCheckpoint"
+
+	const query = `
+	SELECT id, event_id, hash, key_id, signature, created_at
+	FROM audit_checkpoints
+	ORDER BY event_id DESC, id DESC
+	LIMIT 1
+	`
+
+	slog.Debug("Query data",
+		slog.String("op", op),
+		slog.String("query_row", query),
+	)
+
+	var checkpoint *models.AuditCheckpoint
+	err := r.retryable(ctx, op, true, func(ctx context.Context) (err error) {
+		checkpoint, err = scanAuditCheckpoint(r.conn(ctx).QueryRow(ctx, query))
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+
+		slog.Error("Database error",
+			slog.String("op", op),
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return checkpoint, nil
+}
+
+// ListAuditCheckpoints returns every checkpoint, oldest event first
+func (r Repository) ListAuditCheckpoints(ctx context.Context) ([]models.AuditCheckpoint, error) {
+	const op = "repository/postgres/audit.go/ListAuditCheckpoints"
+
+	const query = `
+	SELECT id, event_id, hash, key_id, signature, created_at
+	FROM audit_checkpoints
+	ORDER BY event_id, id
+	`
+
+	slog.Debug("Query data",
+		slog.String("op", op),
+		slog.String("query_row", query),
+	)
+
+	var checkpoints []models.AuditCheckpoint
+	err := r.retryable(ctx, op, true, func(ctx context.Context) error {
+		rows, err := r.conn(ctx).Query(ctx, query)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+
+		checkpoints = checkpoints[:0]
+		for rows.Next() {
+			checkpoint, err := scanAuditCheckpoint(rows)
+			if err != nil {
+				return err
+			}
+
+			checkpoints = append(checkpoints, *checkpoint)
+		}
+
+		return rows.Err()
+	})
+	if err != nil {
+		slog.Error("Database error",
+			slog.String("op", op),
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return checkpoints, nil
+}
+
+func scanAuditCheckpoint(row pgx.Row) (*models.AuditCheckpoint, error) {
+	var checkpoint models.AuditCheckpoint
+	err := row.Scan(
+		&checkpoint.ID,
+		&checkpoint.EventID,
+		&checkpoint.Hash,
+		&checkpoint.KeyID,
+		&checkpoint.Signature,
+		&checkpoint.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &checkpoint, nil
+}
@@ -32,21 +32,23 @@ func (r Repository) CreateUser(ctx context.Context, userDB *models.User) (*model
 		slog.Time("updated_at", userDB.UpdatedAt),
 	)
 	var user models.User
-	err := r.pool.QueryRow(
-		ctx,
-		query,
-		userDB.ID,
-		userDB.Nickname,
-		userDB.Email,
-		userDB.PasswordHash,
-		userDB.CreatedAt,
-		userDB.UpdatedAt,
-	).Scan(
-		&user.Nickname,
-		&user.Email,
-		&user.ID,
-		&user.CreatedAt,
-	)
+	err := r.retryable(ctx, op, false, func(ctx context.Context) error {
+		return r.conn(ctx).QueryRow(
+			ctx,
+			query,
+			userDB.ID,
+			userDB.Nickname,
+			userDB.Email,
+			userDB.PasswordHash,
+			userDB.CreatedAt,
+			userDB.UpdatedAt,
+		).Scan(
+			&user.Nickname,
+			&user.Email,
+			&user.ID,
+			&user.CreatedAt,
+		)
+	})
 	if err != nil {
 		var pgErr *pgconn.PgError
 		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
@@ -91,7 +93,7 @@ func (r Repository) FindByEmail(ctx context.Context, email string) (*models.User
 	const op = "repository/postgres/auth.go/FindByEmail"
 
 	const query = `
-	SELECT id, email, nickname, password FROM users 
+	SELECT id, email, nickname, password, roles, allowed_ips, disabled_at FROM users 
 	WHERE email = $1
 	`
 
@@ -101,16 +103,21 @@ func (r Repository) FindByEmail(ctx context.Context, email string) (*models.User
 		slog.String("email", email),
 	)
 	var user models.User
-	err := r.pool.QueryRow(
-		ctx,
-		query,
-		email,
-	).Scan(
-		&user.ID,
-		&user.Email,
-		&user.Nickname,
-		&user.PasswordHash,
-	)
+	err := r.retryable(ctx, op, true, func(ctx context.Context) error {
+		return r.conn(ctx).QueryRow(
+			ctx,
+			query,
+			email,
+		).Scan(
+			&user.ID,
+			&user.Email,
+			&user.Nickname,
+			&user.PasswordHash,
+			&user.Roles,
+			&user.AllowedIPs,
+			&user.DisabledAt,
+		)
+	})
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			slog.Debug("User not found by email",
@@ -2,25 +2,33 @@ package postgres
 
 import (
 	"context"
+	"fmt"
 	"log/slog"
+	"strconv"
+	"time"
 
 	"github.com/alonsoF100/authorization-service/internal/config"
+	"github.com/alonsoF100/authorization-service/internal/keyseal"
 	"github.com/jackc/pgx/v5/pgxpool"
-	"github.com/jackc/pgx/v5/stdlib"
-	"github.com/pressly/goose/v3"
 )
 
 type Repository struct {
-	pool *pgxpool.Pool
+	pool         *pgxpool.Pool
+	queryTimeout time.Duration
+	retry        config.RetryConfig
+	sealer       *keyseal.Sealer
 }
 
-func New(pool *pgxpool.Pool) *Repository {
+func New(pool *pgxpool.Pool, cfg *config.Config) *Repository {
 	return &Repository{
-		pool: pool,
+		pool:         pool,
+		queryTimeout: cfg.Database.QueryTimeout,
+		retry:        cfg.Database.Retry,
+		sealer:       keyseal.New(cfg.JWT.KeyEncryptionKey),
 	}
 }
 
-func NewPool(cfg *config.Config) (*pgxpool.Pool, error) {
+func NewPool(ctx context.Context, cfg *config.Config) (*pgxpool.Pool, error) {
 	const op = "repository/postgres/database.go/NewPool"
 
 	poolConfig, err := pgxpool.ParseConfig(cfg.Database.ConStr())
@@ -32,9 +40,9 @@ func NewPool(cfg *config.Config) (*pgxpool.Pool, error) {
 		return nil, err
 	}
 
-	// TODO добавлять необходимые настройки pool а
+	applyPoolConfig(poolConfig, &cfg.Database)
 
-	pool, err := pgxpool.NewWithConfig(context.Background(), poolConfig)
+	pool, err := pgxpool.NewWithConfig(ctx, poolConfig)
 	if err != nil {
 		slog.Error("Failed to create pgx pool",
 			slog.String("op", op),
@@ -43,24 +51,68 @@ func NewPool(cfg *config.Config) (*pgxpool.Pool, error) {
 		return nil, err
 	}
 
-	err = pool.Ping(context.Background())
-	if err != nil {
+	if err := ping(ctx, pool, cfg.Database.Retry); err != nil {
 		slog.Error("Failed to ping database",
 			slog.String("op", op),
 			slog.String("error", err.Error()),
 		)
+		pool.Close()
 		return nil, err
 	}
 
-	connConfig := poolConfig.ConnConfig
-	db := stdlib.OpenDB(*connConfig)
-	if err := goose.Up(db, cfg.Migration.Dir); err != nil {
-		slog.Error("Failed to complete UP migrations",
+	return pool, nil
+}
+
+func applyPoolConfig(poolConfig *pgxpool.Config, cfg *config.DatabaseConfig) {
+	if cfg.Pool.MaxConns > 0 {
+		poolConfig.MaxConns = cfg.Pool.MaxConns
+	}
+	if cfg.Pool.MinConns > 0 {
+		poolConfig.MinConns = cfg.Pool.MinConns
+	}
+	if cfg.Pool.MaxConnLifetime > 0 {
+		poolConfig.MaxConnLifetime = cfg.Pool.MaxConnLifetime
+	}
+	if cfg.Pool.MaxConnIdleTime > 0 {
+		poolConfig.MaxConnIdleTime = cfg.Pool.MaxConnIdleTime
+	}
+	if cfg.Pool.HealthCheckPeriod > 0 {
+		poolConfig.HealthCheckPeriod = cfg.Pool.HealthCheckPeriod
+	}
+	if cfg.StatementTimeout > 0 {
+		poolConfig.ConnConfig.RuntimeParams["statement_timeout"] = strconv.FormatInt(cfg.StatementTimeout.Milliseconds(), 10)
+	}
+}
+
+// ping waits for the database to become available, retrying with
+// exponential backoff so the service survives a slowly starting Postgres.
+func ping(ctx context.Context, pool *pgxpool.Pool, cfg config.RetryConfig) error {
+	const op = "repository/postgres/database.go/ping"
+
+	attempts := max(cfg.ConnectAttempts, 1)
+
+	var err error
+	for attempt := 1; attempt <= attempts; attempt++ {
+		if err = pool.Ping(ctx); err == nil {
+			return nil
+		}
+
+		if attempt == attempts {
+			break
+		}
+
+		delay := backoff(attempt, cfg.ConnectBackoff, cfg.ConnectMaxBackoff)
+		slog.Warn("Database is not available, retrying",
 			slog.String("op", op),
+			slog.Int("attempt", attempt),
+			slog.Duration("retry_in", delay),
 			slog.String("error", err.Error()),
 		)
-		return nil, err
+
+		if err := sleep(ctx, delay); err != nil {
+			return err
+		}
 	}
 
-	return pool, nil
+	return fmt.Errorf("%s: database is not available after %d attempts: %w", op, attempts, err)
 }
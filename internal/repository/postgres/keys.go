@@ -0,0 +1,146 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/alonsoF100/authorization-service/internal/models"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+func (r Repository) CreateSigningKey(ctx context.Context, key *models.SigningKey) error {
+	const op = "repository/postgres/keys.go/CreateSigningKey"
+
+	const query = `
+	INSERT INTO signing_keys (id, algorithm, private_key, created_at)
+	VALUES ($1, $2, $3, $4)
+	`
+
+	slog.Debug("Query data",
+		slog.String("op", op),
+		slog.String("query_row", query),
+		slog.String("id", key.ID),
+		slog.String("algorithm", key.Algorithm),
+	)
+
+	privateKey, err := r.sealer.Encode(key.ID, key.PrivateKey)
+	if err != nil {
+		slog.Error("Failed to encode private key",
+			slog.String("op", op),
+			slog.String("error", err.Error()),
+		)
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	err = r.retryable(ctx, op, false, func(ctx context.Context) error {
+		_, err := r.conn(ctx).Exec(ctx, query, key.ID, key.Algorithm, privateKey, key.CreatedAt)
+		return err
+	})
+	if err != nil {
+		slog.Error("Failed to create signing key",
+			slog.String("op", op),
+			slog.String("error", err.Error()),
+		)
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	slog.Debug("Signing key created successfully",
+		slog.String("op", op),
+		slog.String("id", key.ID),
+	)
+
+	return nil
+}
+
+func (r Repository) ListSigningKeys(ctx context.Context) ([]models.SigningKey, error) {
+	const op = "repository/postgres/keys.go/ListSigningKeys"
+
+	const query = `
+	SELECT id, algorithm, private_key, created_at, retired_at FROM signing_keys
+	ORDER BY created_at DESC
+	`
+
+	slog.Debug("Query data",
+		slog.String("op", op),
+		slog.String("query_row", query),
+	)
+
+	var keys []models.SigningKey
+	err := r.retryable(ctx, op, true, func(ctx context.Context) error {
+		rows, err := r.conn(ctx).Query(ctx, query)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+
+		keys = keys[:0]
+		for rows.Next() {
+			var (
+				key        models.SigningKey
+				privateKey string
+			)
+			if err := rows.Scan(&key.ID, &key.Algorithm, &privateKey, &key.CreatedAt, &key.RetiredAt); err != nil {
+				return err
+			}
+
+			if key.PrivateKey, err = r.sealer.Decode(key.ID, privateKey); err != nil {
+				return fmt.Errorf("failed to decode private key %s: %w", key.ID, err)
+			}
+
+			keys = append(keys, key)
+		}
+
+		return rows.Err()
+	})
+	if err != nil {
+		slog.Error("Database error",
+			slog.String("op", op),
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	slog.Debug("Signing keys were successfully founded",
+		slog.String("op", op),
+		slog.Int("count", len(keys)),
+	)
+
+	return keys, nil
+}
+
+func (r Repository) RetireSigningKeys(ctx context.Context, createdBefore, retiredAt time.Time) error {
+	const op = "repository/postgres/keys.go/RetireSigningKeys"
+
+	const query = `
+	UPDATE signing_keys SET retired_at = $2
+	WHERE created_at < $1 AND retired_at IS NULL
+	`
+
+	slog.Debug("Query data",
+		slog.String("op", op),
+		slog.String("query_row", query),
+		slog.Time("created_before", createdBefore),
+	)
+
+	var row pgconn.CommandTag
+	err := r.retryable(ctx, op, false, func(ctx context.Context) (err error) {
+		row, err = r.conn(ctx).Exec(ctx, query, createdBefore, retiredAt)
+		return err
+	})
+	if err != nil {
+		slog.Error("Database error",
+			slog.String("op", op),
+			slog.String("error", err.Error()),
+		)
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	slog.Debug("Signing keys were successfully retired",
+		slog.String("op", op),
+		slog.Int64("rows_affected", row.RowsAffected()),
+	)
+
+	return nil
+}
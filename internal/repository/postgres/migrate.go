@@ -0,0 +1,47 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/alonsoF100/authorization-service/internal/config"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/stdlib"
+	"github.com/pressly/goose/v3"
+)
+
+var MigrateCommands = []string{"up", "down", "status", "redo", "version"}
+
+// Migrate runs a goose command against the configured database. Migrations
+// are registered by importing the migrations/postgres package.
+func Migrate(ctx context.Context, cfg *config.Config, command string) error {
+	const op = "repository/postgres/migrate.go/Migrate"
+
+	connConfig, err := pgx.ParseConfig(cfg.Database.ConStr())
+	if err != nil {
+		slog.Error("Failed to parse database config",
+			slog.String("op", op),
+			slog.String("error", err.Error()),
+		)
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	db := stdlib.OpenDB(*connConfig)
+	defer db.Close()
+
+	if err := goose.SetDialect("postgres"); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := goose.RunContext(ctx, command, db, cfg.Migration.Dir); err != nil {
+		slog.Error("Failed to run migrations",
+			slog.String("op", op),
+			slog.String("command", command),
+			slog.String("error", err.Error()),
+		)
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
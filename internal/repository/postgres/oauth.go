@@ -0,0 +1,390 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/alonsoF100/authorization-service/internal/models"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+func (r Repository) CreateClient(ctx context.Context, client *models.Client) error {
+	const op = "repository/postgres/oauth.go/CreateClient"
+
+	const query = `
+	INSERT INTO oauth_clients (id, name, secret_hash, redirect_uris, grant_types, post_logout_redirect_uris, scopes, created_at)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	`
+
+	slog.Debug("Query data",
+		slog.String("op", op),
+		slog.String("query_row", query),
+		slog.String("id", client.ID),
+		slog.String("name", client.Name),
+	)
+
+	err := r.retryable(ctx, op, false, func(ctx context.Context) error {
+		_, err := r.conn(ctx).Exec(
+			ctx,
+			query,
+			client.ID,
+			client.Name,
+			client.SecretHash,
+			nonNil(client.RedirectURIs),
+			nonNil(client.GrantTypes),
+			nonNil(client.PostLogoutRedirectURIs),
+			nonNil(client.Scopes),
+			client.CreatedAt,
+		)
+		return err
+	})
+	if err != nil {
+		slog.Error("Failed to create client",
+			slog.String("op", op),
+			slog.String("error", err.Error()),
+		)
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	slog.Debug("Client created successfully",
+		slog.String("op", op),
+		slog.String("id", client.ID),
+	)
+
+	return nil
+}
+
+func (r Repository) FindClientByID(ctx context.Context, clientID string) (*models.Client, error) {
+	const op = "repository/postgres/oauth.go/FindClientByID"
+
+	const query = `
+	SELECT id, name, secret_hash, redirect_uris, grant_types, post_logout_redirect_uris, scopes, created_at FROM oauth_clients
+	WHERE id = $1
+	`
+
+	slog.Debug("Query data",
+		slog.String("op", op),
+		slog.String("query_row", query),
+		slog.String("id", clientID),
+	)
+
+	var client models.Client
+	err := r.retryable(ctx, op, true, func(ctx context.Context) error {
+		return r.conn(ctx).QueryRow(ctx, query, clientID).Scan(
+			&client.ID,
+			&client.Name,
+			&client.SecretHash,
+			&client.RedirectURIs,
+			&client.GrantTypes,
+			&client.PostLogoutRedirectURIs,
+			&client.Scopes,
+			&client.CreatedAt,
+		)
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			slog.Debug("Client not found by id",
+				slog.String("op", op),
+				slog.String("client_id", clientID),
+			)
+			return nil, nil
+		}
+
+		slog.Error("Database error",
+			slog.String("op", op),
+			slog.String("client_id", clientID),
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &client, nil
+}
+
+func (r Repository) RevokeToken(ctx context.Context, jti string, expiresAt time.Time) error {
+	const op = "repository/postgres/oauth.go/RevokeToken"
+
+	const query = `
+	INSERT INTO revoked_tokens (jti, expires_at)
+	VALUES ($1, $2)
+	ON CONFLICT (jti) DO NOTHING
+	`
+
+	slog.Debug("Query data",
+		slog.String("op", op),
+		slog.String("query_row", query),
+		slog.String("jti", jti),
+	)
+
+	err := r.retryable(ctx, op, false, func(ctx context.Context) error {
+		_, err := r.conn(ctx).Exec(ctx, query, jti, expiresAt)
+		return err
+	})
+	if err != nil {
+		slog.Error("Database error",
+			slog.String("op", op),
+			slog.String("jti", jti),
+			slog.String("error", err.Error()),
+		)
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (r Repository) IsTokenRevoked(ctx context.Context, jti string) (bool, error) {
+	const op = "repository/postgres/oauth.go/IsTokenRevoked"
+
+	const query = `
+	SELECT EXISTS (SELECT 1 FROM revoked_tokens WHERE jti = $1)
+	`
+
+	var revoked bool
+	err := r.retryable(ctx, op, true, func(ctx context.Context) error {
+		return r.conn(ctx).QueryRow(ctx, query, jti).Scan(&revoked)
+	})
+	if err != nil {
+		slog.Error("Database error",
+			slog.String("op", op),
+			slog.String("jti", jti),
+			slog.String("error", err.Error()),
+		)
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return revoked, nil
+}
+
+func (r Repository) PurgeRevokedTokens(ctx context.Context, expiredBefore time.Time) (int64, error) {
+	const op = "repository/postgres/oauth.go/PurgeRevokedTokens"
+
+	const query = `
+	DELETE FROM revoked_tokens
+	WHERE expires_at < $1
+	`
+
+	var row pgconn.CommandTag
+	err := r.retryable(ctx, op, false, func(ctx context.Context) (err error) {
+		row, err = r.conn(ctx).Exec(ctx, query, expiredBefore)
+		return err
+	})
+	if err != nil {
+		slog.Error("Database error",
+			slog.String("op", op),
+			slog.String("error", err.Error()),
+		)
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return row.RowsAffected(), nil
+}
+
+func (r Repository) CreateAuthorizationCode(ctx context.Context, code *models.AuthorizationCode) error {
+	const op = "repository/postgres/oauth.go/CreateAuthorizationCode"
+
+	const query = `
+	INSERT INTO authorization_codes (code_hash, client_id, user_id, redirect_uri, scope, code_challenge, nonce, auth_time, session_id, expires_at, created_at)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+	`
+
+	slog.Debug("Query data",
+		slog.String("op", op),
+		slog.String("query_row", query),
+		slog.String("client_id", code.ClientID),
+		slog.String("user_id", code.UserID),
+	)
+
+	err := r.retryable(ctx, op, false, func(ctx context.Context) error {
+		_, err := r.conn(ctx).Exec(
+			ctx,
+			query,
+			code.CodeHash,
+			code.ClientID,
+			code.UserID,
+			code.RedirectURI,
+			code.Scope,
+			code.CodeChallenge,
+			code.Nonce,
+			code.AuthTime,
+			code.SessionID,
+			code.ExpiresAt,
+			code.CreatedAt,
+		)
+		return err
+	})
+	if err != nil {
+		slog.Error("Failed to create authorization code",
+			slog.String("op", op),
+			slog.String("error", err.Error()),
+		)
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// ConsumeAuthorizationCode deletes the code and returns it, a second call
+// with the same code finds nothing
+func (r Repository) ConsumeAuthorizationCode(ctx context.Context, codeHash string) (*models.AuthorizationCode, error) {
+	const op = "repository/postgres/oauth.go/ConsumeAuthorizationCode"
+
+	const query = `
+	DELETE FROM authorization_codes
+	WHERE code_hash = $1
+	RETURNING code_hash, client_id, user_id, redirect_uri, scope, code_challenge, nonce, auth_time, session_id, expires_at, created_at
+	`
+
+	slog.Debug("Query data",
+		slog.String("op", op),
+		slog.String("query_row", query),
+	)
+
+	var (
+		code     models.AuthorizationCode
+		authTime *time.Time
+	)
+	err := r.retryable(ctx, op, false, func(ctx context.Context) error {
+		return r.conn(ctx).QueryRow(ctx, query, codeHash).Scan(
+			&code.CodeHash,
+			&code.ClientID,
+			&code.UserID,
+			&code.RedirectURI,
+			&code.Scope,
+			&code.CodeChallenge,
+			&code.Nonce,
+			&authTime,
+			&code.SessionID,
+			&code.ExpiresAt,
+			&code.CreatedAt,
+		)
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			slog.Debug("Authorization code not found",
+				slog.String("op", op),
+			)
+			return nil, nil
+		}
+
+		slog.Error("Database error",
+			slog.String("op", op),
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if authTime != nil {
+		code.AuthTime = *authTime
+	}
+
+	return &code, nil
+}
+
+func (r Repository) PurgeAuthorizationCodes(ctx context.Context, expiredBefore time.Time) (int64, error) {
+	const op = "repository/postgres/oauth.go/PurgeAuthorizationCodes"
+
+	const query = `
+	DELETE FROM authorization_codes
+	WHERE expires_at < $1
+	`
+
+	var row pgconn.CommandTag
+	err := r.retryable(ctx, op, false, func(ctx context.Context) (err error) {
+		row, err = r.conn(ctx).Exec(ctx, query, expiredBefore)
+		return err
+	})
+	if err != nil {
+		slog.Error("Database error",
+			slog.String("op", op),
+			slog.String("error", err.Error()),
+		)
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return row.RowsAffected(), nil
+}
+
+func (r Repository) FindConsent(ctx context.Context, userID, clientID string) (*models.Consent, error) {
+	const op = "repository/postgres/oauth.go/FindConsent"
+
+	const query = `
+	SELECT user_id, client_id, scopes, granted_at FROM oauth_consents
+	WHERE user_id = $1 AND client_id = $2
+	`
+
+	slog.Debug("Query data",
+		slog.String("op", op),
+		slog.String("query_row", query),
+		slog.String("user_id", userID),
+		slog.String("client_id", clientID),
+	)
+
+	var consent models.Consent
+	err := r.retryable(ctx, op, true, func(ctx context.Context) error {
+		return r.conn(ctx).QueryRow(ctx, query, userID, clientID).Scan(
+			&consent.UserID,
+			&consent.ClientID,
+			&consent.Scopes,
+			&consent.GrantedAt,
+		)
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+
+		slog.Error("Database error",
+			slog.String("op", op),
+			slog.String("user_id", userID),
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &consent, nil
+}
+
+// SaveConsent creates the consent or replaces its scopes
+func (r Repository) SaveConsent(ctx context.Context, consent *models.Consent) error {
+	const op = "repository/postgres/oauth.go/SaveConsent"
+
+	const query = `
+	INSERT INTO oauth_consents (user_id, client_id, scopes, granted_at)
+	VALUES ($1, $2, $3, $4)
+	ON CONFLICT (user_id, client_id) DO UPDATE
+	SET scopes = EXCLUDED.scopes, granted_at = EXCLUDED.granted_at
+	`
+
+	slog.Debug("Query data",
+		slog.String("op", op),
+		slog.String("query_row", query),
+		slog.String("user_id", consent.UserID),
+		slog.String("client_id", consent.ClientID),
+	)
+
+	err := r.retryable(ctx, op, false, func(ctx context.Context) error {
+		_, err := r.conn(ctx).Exec(ctx, query, consent.UserID, consent.ClientID, nonNil(consent.Scopes), consent.GrantedAt)
+		return err
+	})
+	if err != nil {
+		slog.Error("Failed to save consent",
+			slog.String("op", op),
+			slog.String("user_id", consent.UserID),
+			slog.String("error", err.Error()),
+		)
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// nonNil keeps NOT NULL array columns from receiving NULL
+func nonNil(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
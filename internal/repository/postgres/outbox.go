@@ -0,0 +1,210 @@
+package postgres
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"time"
+
+	"github.com/alonsoF100/authorization-service/internal/models"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+const outboxEventColumns = `id, type, aggregate_id, payload, created_at, attempts, next_attempt_at, last_error, published_at`
+
+func (r Repository) CreateOutboxEvent(ctx context.Context, event *models.OutboxEvent) error {
+	const op = "repository/postgres/outbox.go/CreateOutboxEvent"
+
+	const query = `
+	INSERT INTO outbox_events (type, aggregate_id, payload, created_at, next_attempt_at)
+	VALUES ($1, $2, $3, $4, $5)
+	RETURNING id
+	`
+
+	slog.Debug("Query data",
+		slog.String("op", op),
+		slog.String("query_row", query),
+		slog.String("type", event.Type),
+		slog.String("aggregate_id", event.AggregateID),
+	)
+
+	err := r.retryable(ctx, op, false, func(ctx context.Context) error {
+		return r.conn(ctx).QueryRow(
+			ctx,
+			query,
+			event.Type,
+			event.AggregateID,
+			event.Payload,
+			event.CreatedAt,
+			event.NextAttemptAt,
+		).Scan(&event.ID)
+	})
+	if err != nil {
+		slog.Error("Failed to create outbox event",
+			slog.String("op", op),
+			slog.String("type", event.Type),
+			slog.String("error", err.Error()),
+		)
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// ClaimOutboxEvents leases up to limit due events until claimedUntil. Only
+// the oldest pending event of each aggregate is claimed, the next one
+// waits until it is published. Rows locked by another relay are skipped.
+func (r Repository) ClaimOutboxEvents(ctx context.Context, now, claimedUntil time.Time, limit int) ([]models.OutboxEvent, error) {
+	const op = "repository/postgres/outbox.go/ClaimOutboxEvents"
+
+	const query = `
+	UPDATE outbox_events SET attempts = attempts + 1, next_attempt_at = $2
+	WHERE id IN (
+		SELECT e.id FROM outbox_events e
+		WHERE e.published_at IS NULL
+			AND e.next_attempt_at <= $1
+			AND NOT EXISTS (
+				SELECT 1 FROM outbox_events p
+				WHERE p.aggregate_id = e.aggregate_id AND p.published_at IS NULL AND p.id < e.id
+			)
+		ORDER BY e.id
+		LIMIT $3
+		FOR UPDATE SKIP LOCKED
+	)
+	RETURNING ` + outboxEventColumns
+
+	slog.Debug("Query data",
+		slog.String("op", op),
+		slog.String("query_row", query),
+	)
+
+	var events []models.OutboxEvent
+	err := r.retryable(ctx, op, false, func(ctx context.Context) error {
+		rows, err := r.conn(ctx).Query(ctx, query, now, claimedUntil, limit)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+
+		events = events[:0]
+		for rows.Next() {
+			event, err := scanOutboxEvent(rows)
+			if err != nil {
+				return err
+			}
+
+			events = append(events, *event)
+		}
+
+		return rows.Err()
+	})
+	if err != nil {
+		slog.Error("Database error",
+			slog.String("op", op),
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// RETURNING doesn't keep the order of the subquery
+	slices.SortFunc(events, func(a, b models.OutboxEvent) int {
+		return cmp.Compare(a.ID, b.ID)
+	})
+
+	return events, nil
+}
+
+func (r Repository) MarkOutboxEventPublished(ctx context.Context, id int64, publishedAt time.Time) error {
+	const op = "repository/postgres/outbox.go/MarkOutboxEventPublished"
+
+	const query = `
+	UPDATE outbox_events SET published_at = $2, last_error = ''
+	WHERE id = $1
+	`
+
+	return r.updateOutboxEvent(ctx, op, query, id, publishedAt)
+}
+
+// RetryOutboxEvent records a failed delivery, the event is claimed again
+// at nextAttemptAt
+func (r Repository) RetryOutboxEvent(ctx context.Context, id int64, nextAttemptAt time.Time, lastError string) error {
+	const op = "repository/postgres/outbox.go/RetryOutboxEvent"
+
+	const query = `
+	UPDATE outbox_events SET next_attempt_at = $2, last_error = $3
+	WHERE id = $1
+	`
+
+	return r.updateOutboxEvent(ctx, op, query, id, nextAttemptAt, lastError)
+}
+
+// PurgeOutboxEvents deletes events published before the given time
+func (r Repository) PurgeOutboxEvents(ctx context.Context, publishedBefore time.Time) (int64, error) {
+	const op = "repository/postgres/outbox.go/PurgeOutboxEvents"
+
+	const query = `
+	DELETE FROM outbox_events
+	WHERE published_at IS NOT NULL AND published_at < $1
+	`
+
+	var row pgconn.CommandTag
+	err := r.retryable(ctx, op, false, func(ctx context.Context) (err error) {
+		row, err = r.conn(ctx).Exec(ctx, query, publishedBefore)
+		return err
+	})
+	if err != nil {
+		slog.Error("Database error",
+			slog.String("op", op),
+			slog.String("error", err.Error()),
+		)
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return row.RowsAffected(), nil
+}
+
+func (r Repository) updateOutboxEvent(ctx context.Context, op, query string, id int64, args ...any) error {
+	slog.Debug("Query data",
+		slog.String("op", op),
+		slog.String("query_row", query),
+		slog.Int64("id", id),
+	)
+
+	err := r.retryable(ctx, op, false, func(ctx context.Context) error {
+		_, err := r.conn(ctx).Exec(ctx, query, append([]any{id}, args...)...)
+		return err
+	})
+	if err != nil {
+		slog.Error("Database error",
+			slog.String("op", op),
+			slog.Int64("id", id),
+			slog.String("error", err.Error()),
+		)
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func scanOutboxEvent(row pgx.Row) (*models.OutboxEvent, error) {
+	var event models.OutboxEvent
+	err := row.Scan(
+		&event.ID,
+		&event.Type,
+		&event.AggregateID,
+		&event.Payload,
+		&event.CreatedAt,
+		&event.Attempts,
+		&event.NextAttemptAt,
+		&event.LastError,
+		&event.PublishedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &event, nil
+}
@@ -0,0 +1,216 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/alonsoF100/authorization-service/internal/apperrors"
+	"github.com/alonsoF100/authorization-service/internal/models"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+func (r Repository) CreatePersonalAccessToken(ctx context.Context, token *models.PersonalAccessToken) error {
+	const op = "repository/postgres/personal_access_token.go/CreatePersonalAccessToken"
+
+	const query = `
+	INSERT INTO personal_access_tokens (id, user_id, name, token_hash, scopes, expires_at, created_at)
+	VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`
+
+	slog.Debug("Query data",
+		slog.String("op", op),
+		slog.String("query_row", query),
+		slog.String("id", token.ID),
+		slog.String("user_id", token.UserID),
+	)
+
+	err := r.retryable(ctx, op, false, func(ctx context.Context) error {
+		_, err := r.conn(ctx).Exec(
+			ctx,
+			query,
+			token.ID,
+			token.UserID,
+			token.Name,
+			token.TokenHash,
+			nonNil(token.Scopes),
+			token.ExpiresAt,
+			token.CreatedAt,
+		)
+		return err
+	})
+	if err != nil {
+		slog.Error("Failed to create personal access token",
+			slog.String("op", op),
+			slog.String("user_id", token.UserID),
+			slog.String("error", err.Error()),
+		)
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// ListPersonalAccessTokens returns the tokens of a user, newest first
+func (r Repository) ListPersonalAccessTokens(ctx context.Context, userID string) ([]models.PersonalAccessToken, error) {
+	const op = "repository/postgres/personal_access_token.go/ListPersonalAccessTokens"
+
+	const query = `
+	SELECT id, user_id, name, token_hash, scopes, expires_at, last_used_at, last_used_ip, created_at
+	FROM personal_access_tokens
+	WHERE user_id = $1
+	ORDER BY created_at DESC, id
+	`
+
+	slog.Debug("Query data",
+		slog.String("op", op),
+		slog.String("query_row", query),
+		slog.String("user_id", userID),
+	)
+
+	var tokens []models.PersonalAccessToken
+	err := r.retryable(ctx, op, true, func(ctx context.Context) error {
+		rows, err := r.conn(ctx).Query(ctx, query, userID)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+
+		tokens = tokens[:0]
+		for rows.Next() {
+			token, err := scanPersonalAccessToken(rows)
+			if err != nil {
+				return err
+			}
+
+			tokens = append(tokens, *token)
+		}
+
+		return rows.Err()
+	})
+	if err != nil {
+		slog.Error("Database error",
+			slog.String("op", op),
+			slog.String("user_id", userID),
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return tokens, nil
+}
+
+func (r Repository) FindPersonalAccessTokenByHash(ctx context.Context, tokenHash string) (*models.PersonalAccessToken, error) {
+	const op = "repository/postgres/personal_access_token.go/FindPersonalAccessTokenByHash"
+
+	const query = `
+	SELECT id, user_id, name, token_hash, scopes, expires_at, last_used_at, last_used_ip, created_at
+	FROM personal_access_tokens
+	WHERE token_hash = $1
+	`
+
+	slog.Debug("Query data",
+		slog.String("op", op),
+		slog.String("query_row", query),
+	)
+
+	var token *models.PersonalAccessToken
+	err := r.retryable(ctx, op, true, func(ctx context.Context) (err error) {
+		token, err = scanPersonalAccessToken(r.conn(ctx).QueryRow(ctx, query, tokenHash))
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+
+		slog.Error("Database error",
+			slog.String("op", op),
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return token, nil
+}
+
+// DeletePersonalAccessToken only deletes the token when it belongs to the
+// user
+func (r Repository) DeletePersonalAccessToken(ctx context.Context, userID, tokenID string) error {
+	const op = "repository/postgres/personal_access_token.go/DeletePersonalAccessToken"
+
+	const query = `
+	DELETE FROM personal_access_tokens
+	WHERE id = $1 AND user_id = $2
+	`
+
+	slog.Debug("Query data",
+		slog.String("op", op),
+		slog.String("query_row", query),
+		slog.String("id", tokenID),
+		slog.String("user_id", userID),
+	)
+
+	return r.updatePersonalAccessToken(ctx, op, query, tokenID, userID)
+}
+
+func (r Repository) TouchPersonalAccessToken(ctx context.Context, tokenID string, usedAt time.Time, ip string) error {
+	const op = "repository/postgres/personal_access_token.go/TouchPersonalAccessToken"
+
+	const query = `
+	UPDATE personal_access_tokens SET last_used_at = $2, last_used_ip = $3
+	WHERE id = $1
+	`
+
+	slog.Debug("Query data",
+		slog.String("op", op),
+		slog.String("query_row", query),
+		slog.String("id", tokenID),
+	)
+
+	return r.updatePersonalAccessToken(ctx, op, query, tokenID, usedAt, ip)
+}
+
+func (r Repository) updatePersonalAccessToken(ctx context.Context, op, query string, args ...any) error {
+	var row pgconn.CommandTag
+	err := r.retryable(ctx, op, false, func(ctx context.Context) (err error) {
+		row, err = r.conn(ctx).Exec(ctx, query, args...)
+		return err
+	})
+	if err != nil {
+		slog.Error("Database error",
+			slog.String("op", op),
+			slog.String("error", err.Error()),
+		)
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if row.RowsAffected() == 0 {
+		return apperrors.ErrTokenNotFound
+	}
+
+	return nil
+}
+
+func scanPersonalAccessToken(row pgx.Row) (*models.PersonalAccessToken, error) {
+	var token models.PersonalAccessToken
+	err := row.Scan(
+		&token.ID,
+		&token.UserID,
+		&token.Name,
+		&token.TokenHash,
+		&token.Scopes,
+		&token.ExpiresAt,
+		&token.LastUsedAt,
+		&token.LastUsedIP,
+		&token.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &token, nil
+}
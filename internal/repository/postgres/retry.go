@@ -0,0 +1,124 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"net"
+	"syscall"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+const (
+	codeSerializationFailure = "40001"
+	codeDeadlockDetected     = "40P01"
+	codeCannotConnectNow     = "57P03"
+	classConnectionException = "08"
+)
+
+// retryable runs fn with a per attempt timeout and retries it on transient
+// errors. Writes are only retried when the server is known not to have
+// applied them, reads are also retried after a dropped connection.
+func (r Repository) retryable(ctx context.Context, op string, readOnly bool, fn func(ctx context.Context) error) error {
+	// a failed statement aborts the transaction, InTx retries all of it
+	if _, ok := txFrom(ctx); ok {
+		return r.attempt(ctx, fn)
+	}
+
+	return r.withRetries(ctx, op, readOnly, func(ctx context.Context) error {
+		return r.attempt(ctx, fn)
+	})
+}
+
+func (r Repository) withRetries(ctx context.Context, op string, readOnly bool, fn func(ctx context.Context) error) error {
+	attempts := max(r.retry.QueryAttempts, 1)
+
+	var err error
+	for attempt := 1; attempt <= attempts; attempt++ {
+		err = fn(ctx)
+		if err == nil || !isTransient(err, readOnly) || ctx.Err() != nil || attempt == attempts {
+			return err
+		}
+
+		delay := backoff(attempt, r.retry.QueryBackoff, 0)
+		slog.Warn("Transient database error, retrying",
+			slog.String("op", op),
+			slog.Int("attempt", attempt),
+			slog.Duration("retry_in", delay),
+			slog.String("error", err.Error()),
+		)
+
+		if sleepErr := sleep(ctx, delay); sleepErr != nil {
+			return err
+		}
+	}
+
+	return err
+}
+
+func (r Repository) attempt(ctx context.Context, fn func(ctx context.Context) error) error {
+	if r.queryTimeout <= 0 {
+		return fn(ctx)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, r.queryTimeout)
+	defer cancel()
+
+	return fn(ctx)
+}
+
+func isTransient(err error, readOnly bool) bool {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch {
+		case pgErr.Code == codeSerializationFailure, pgErr.Code == codeDeadlockDetected:
+			// the transaction was rolled back, so it is safe to run it again
+			return true
+		case pgErr.Code == codeCannotConnectNow:
+			return true
+		case len(pgErr.Code) == 5 && pgErr.Code[:2] == classConnectionException:
+			return readOnly
+		}
+		return false
+	}
+
+	// nothing was sent to the server
+	if pgconn.SafeToRetry(err) {
+		return true
+	}
+
+	if !readOnly {
+		return false
+	}
+
+	var netErr net.Error
+	return errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, syscall.EPIPE) ||
+		errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, context.DeadlineExceeded) ||
+		(errors.As(err, &netErr) && netErr.Timeout())
+}
+
+func backoff(attempt int, base, limit time.Duration) time.Duration {
+	delay := base << (attempt - 1)
+	if delay <= 0 || (limit > 0 && delay > limit) {
+		delay = limit
+	}
+
+	return delay
+}
+
+func sleep(ctx context.Context, delay time.Duration) error {
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
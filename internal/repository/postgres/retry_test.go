@@ -0,0 +1,131 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/alonsoF100/authorization-service/internal/config"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsTransient(t *testing.T) {
+	tests := []struct {
+		name           string
+		err            error
+		readTransient  bool
+		writeTransient bool
+	}{
+		{"serialization failure", &pgconn.PgError{Code: "40001"}, true, true},
+		{"deadlock", &pgconn.PgError{Code: "40P01"}, true, true},
+		{"cannot connect now", &pgconn.PgError{Code: "57P03"}, true, true},
+		{"connection failure", &pgconn.PgError{Code: "08006"}, true, false},
+		{"unique violation", &pgconn.PgError{Code: "23505"}, false, false},
+		{"wrapped serialization failure", fmt.Errorf("op: %w", &pgconn.PgError{Code: "40001"}), true, true},
+		{"connection reset", fmt.Errorf("read: %w", syscall.ECONNRESET), true, false},
+		{"unexpected EOF", io.ErrUnexpectedEOF, true, false},
+		{"query timeout", context.DeadlineExceeded, true, false},
+		{"other error", errors.New("gaz"), false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.readTransient, isTransient(tt.err, true))
+			require.Equal(t, tt.writeTransient, isTransient(tt.err, false))
+		})
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	require.Equal(t, 100*time.Millisecond, backoff(1, 100*time.Millisecond, time.Second))
+	require.Equal(t, 200*time.Millisecond, backoff(2, 100*time.Millisecond, time.Second))
+	require.Equal(t, 800*time.Millisecond, backoff(4, 100*time.Millisecond, time.Second))
+	require.Equal(t, time.Second, backoff(5, 100*time.Millisecond, time.Second))
+	require.Equal(t, time.Second, backoff(100, 100*time.Millisecond, time.Second))
+	require.Equal(t, 6400*time.Millisecond, backoff(7, 100*time.Millisecond, 0))
+}
+
+func TestRetryable(t *testing.T) {
+	repo := Repository{
+		queryTimeout: time.Second,
+		retry:        config.RetryConfig{QueryAttempts: 3, QueryBackoff: time.Millisecond},
+	}
+
+	t.Run("retries transient errors", func(t *testing.T) {
+		calls := 0
+		err := repo.retryable(context.Background(), "test", false, func(ctx context.Context) error {
+			calls++
+			if calls < 3 {
+				return &pgconn.PgError{Code: "40001"}
+			}
+			return nil
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, 3, calls)
+	})
+
+	t.Run("gives up after max attempts", func(t *testing.T) {
+		calls := 0
+		err := repo.retryable(context.Background(), "test", true, func(ctx context.Context) error {
+			calls++
+			return io.ErrUnexpectedEOF
+		})
+
+		require.ErrorIs(t, err, io.ErrUnexpectedEOF)
+		require.Equal(t, 3, calls)
+	})
+
+	t.Run("does not retry permanent errors", func(t *testing.T) {
+		calls := 0
+		err := repo.retryable(context.Background(), "test", true, func(ctx context.Context) error {
+			calls++
+			return &pgconn.PgError{Code: "23505"}
+		})
+
+		require.Error(t, err)
+		require.Equal(t, 1, calls)
+	})
+
+	t.Run("does not retry ambiguous writes", func(t *testing.T) {
+		calls := 0
+		err := repo.retryable(context.Background(), "test", false, func(ctx context.Context) error {
+			calls++
+			return syscall.ECONNRESET
+		})
+
+		require.Error(t, err)
+		require.Equal(t, 1, calls)
+	})
+
+	t.Run("applies per query timeout", func(t *testing.T) {
+		repo := Repository{queryTimeout: 10 * time.Millisecond}
+
+		err := repo.retryable(context.Background(), "test", true, func(ctx context.Context) error {
+			deadline, ok := ctx.Deadline()
+			require.True(t, ok)
+			require.WithinDuration(t, time.Now().Add(10*time.Millisecond), deadline, 10*time.Millisecond)
+			return nil
+		})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("stops when context is cancelled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		calls := 0
+		err := repo.retryable(ctx, "test", true, func(ctx context.Context) error {
+			calls++
+			cancel()
+			return io.ErrUnexpectedEOF
+		})
+
+		require.Error(t, err)
+		require.Equal(t, 1, calls)
+	})
+}
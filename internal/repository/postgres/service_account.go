@@ -0,0 +1,363 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/alonsoF100/authorization-service/internal/apperrors"
+	"github.com/alonsoF100/authorization-service/internal/models"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+func (r Repository) CreateServiceAccount(ctx context.Context, account *models.ServiceAccount) error {
+	const op = "repository/postgres/service_account.go/CreateServiceAccount"
+
+	const query = `
+	INSERT INTO service_accounts (id, name, owner_id, roles, created_at)
+	VALUES ($1, $2, NULLIF($3, '')::uuid, $4, $5)
+	`
+
+	slog.Debug("Query data",
+		slog.String("op", op),
+		slog.String("query_row", query),
+		slog.String("id", account.ID),
+		slog.String("name", account.Name),
+		slog.String("owner_id", account.OwnerID),
+	)
+
+	err := r.retryable(ctx, op, false, func(ctx context.Context) error {
+		_, err := r.conn(ctx).Exec(ctx, query, account.ID, account.Name, account.OwnerID, nonNil(account.Roles), account.CreatedAt)
+		return err
+	})
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" && pgErr.ConstraintName == "unique_service_account_name" {
+			slog.Debug("Service account name already exists",
+				slog.String("op", op),
+				slog.String("name", account.Name),
+			)
+			return apperrors.ErrServiceAccountExist
+		}
+
+		slog.Error("Failed to create service account",
+			slog.String("op", op),
+			slog.String("error", err.Error()),
+		)
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// ListServiceAccounts returns every service account ordered by name
+func (r Repository) ListServiceAccounts(ctx context.Context) ([]models.ServiceAccount, error) {
+	const op = "repository/postgres/service_account.go/ListServiceAccounts"
+
+	const query = `
+	SELECT id, name, COALESCE(owner_id::text, ''), roles, created_at FROM service_accounts
+	ORDER BY name
+	`
+
+	slog.Debug("Query data",
+		slog.String("op", op),
+		slog.String("query_row", query),
+	)
+
+	var accounts []models.ServiceAccount
+	err := r.retryable(ctx, op, true, func(ctx context.Context) error {
+		rows, err := r.conn(ctx).Query(ctx, query)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+
+		accounts = accounts[:0]
+		for rows.Next() {
+			account, err := scanServiceAccount(rows)
+			if err != nil {
+				return err
+			}
+
+			accounts = append(accounts, *account)
+		}
+
+		return rows.Err()
+	})
+	if err != nil {
+		slog.Error("Database error",
+			slog.String("op", op),
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return accounts, nil
+}
+
+func (r Repository) FindServiceAccountByID(ctx context.Context, accountID string) (*models.ServiceAccount, error) {
+	const op = "repository/postgres/service_account.go/FindServiceAccountByID"
+
+	const query = `
+	SELECT id, name, COALESCE(owner_id::text, ''), roles, created_at FROM service_accounts
+	WHERE id = $1
+	`
+
+	slog.Debug("Query data",
+		slog.String("op", op),
+		slog.String("query_row", query),
+		slog.String("id", accountID),
+	)
+
+	var account *models.ServiceAccount
+	err := r.retryable(ctx, op, true, func(ctx context.Context) (err error) {
+		account, err = scanServiceAccount(r.conn(ctx).QueryRow(ctx, query, accountID))
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+
+		slog.Error("Database error",
+			slog.String("op", op),
+			slog.String("id", accountID),
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return account, nil
+}
+
+func (r Repository) DeleteServiceAccount(ctx context.Context, accountID string) error {
+	const op = "repository/postgres/service_account.go/DeleteServiceAccount"
+
+	const query = `
+	DELETE FROM service_accounts
+	WHERE id = $1
+	`
+
+	slog.Debug("Query data",
+		slog.String("op", op),
+		slog.String("query_row", query),
+		slog.String("id", accountID),
+	)
+
+	affected, err := r.execServiceAccount(ctx, op, query, accountID)
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return apperrors.ErrServiceAccountNotFound
+	}
+
+	return nil
+}
+
+func (r Repository) CreateAPIKey(ctx context.Context, key *models.APIKey) error {
+	const op = "repository/postgres/service_account.go/CreateAPIKey"
+
+	const query = `
+	INSERT INTO api_keys (id, service_account_id, key_hash, created_at)
+	VALUES ($1, $2, $3, $4)
+	`
+
+	slog.Debug("Query data",
+		slog.String("op", op),
+		slog.String("query_row", query),
+		slog.String("id", key.ID),
+		slog.String("service_account_id", key.ServiceAccountID),
+	)
+
+	err := r.retryable(ctx, op, false, func(ctx context.Context) error {
+		_, err := r.conn(ctx).Exec(ctx, query, key.ID, key.ServiceAccountID, key.KeyHash, key.CreatedAt)
+		return err
+	})
+	if err != nil {
+		slog.Error("Failed to create api key",
+			slog.String("op", op),
+			slog.String("service_account_id", key.ServiceAccountID),
+			slog.String("error", err.Error()),
+		)
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// ListAPIKeys returns the keys of a service account, newest first
+func (r Repository) ListAPIKeys(ctx context.Context, accountID string) ([]models.APIKey, error) {
+	const op = "repository/postgres/service_account.go/ListAPIKeys"
+
+	const query = `
+	SELECT id, service_account_id, key_hash, last_used_at, created_at FROM api_keys
+	WHERE service_account_id = $1
+	ORDER BY created_at DESC, id
+	`
+
+	slog.Debug("Query data",
+		slog.String("op", op),
+		slog.String("query_row", query),
+		slog.String("service_account_id", accountID),
+	)
+
+	var keys []models.APIKey
+	err := r.retryable(ctx, op, true, func(ctx context.Context) error {
+		rows, err := r.conn(ctx).Query(ctx, query, accountID)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+
+		keys = keys[:0]
+		for rows.Next() {
+			var key models.APIKey
+			if err := rows.Scan(&key.ID, &key.ServiceAccountID, &key.KeyHash, &key.LastUsedAt, &key.CreatedAt); err != nil {
+				return err
+			}
+
+			keys = append(keys, key)
+		}
+
+		return rows.Err()
+	})
+	if err != nil {
+		slog.Error("Database error",
+			slog.String("op", op),
+			slog.String("service_account_id", accountID),
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return keys, nil
+}
+
+func (r Repository) FindAPIKeyByID(ctx context.Context, keyID string) (*models.APIKey, error) {
+	const op = "repository/postgres/service_account.go/FindAPIKeyByID"
+
+	const query = `
+	SELECT id, service_account_id, key_hash, last_used_at, created_at FROM api_keys
+	WHERE id = $1
+	`
+
+	slog.Debug("Query data",
+		slog.String("op", op),
+		slog.String("query_row", query),
+		slog.String("id", keyID),
+	)
+
+	var key models.APIKey
+	err := r.retryable(ctx, op, true, func(ctx context.Context) error {
+		return r.conn(ctx).QueryRow(ctx, query, keyID).Scan(
+			&key.ID,
+			&key.ServiceAccountID,
+			&key.KeyHash,
+			&key.LastUsedAt,
+			&key.CreatedAt,
+		)
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+
+		slog.Error("Database error",
+			slog.String("op", op),
+			slog.String("id", keyID),
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &key, nil
+}
+
+// DeleteAPIKey only deletes the key when it belongs to the service account
+func (r Repository) DeleteAPIKey(ctx context.Context, accountID, keyID string) error {
+	const op = "repository/postgres/service_account.go/DeleteAPIKey"
+
+	const query = `
+	DELETE FROM api_keys
+	WHERE id = $1 AND service_account_id = $2
+	`
+
+	slog.Debug("Query data",
+		slog.String("op", op),
+		slog.String("query_row", query),
+		slog.String("id", keyID),
+		slog.String("service_account_id", accountID),
+	)
+
+	affected, err := r.execServiceAccount(ctx, op, query, keyID, accountID)
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return apperrors.ErrAPIKeyNotFound
+	}
+
+	return nil
+}
+
+func (r Repository) TouchAPIKey(ctx context.Context, keyID string, usedAt time.Time) error {
+	const op = "repository/postgres/service_account.go/TouchAPIKey"
+
+	const query = `
+	UPDATE api_keys SET last_used_at = $2
+	WHERE id = $1
+	`
+
+	slog.Debug("Query data",
+		slog.String("op", op),
+		slog.String("query_row", query),
+		slog.String("id", keyID),
+	)
+
+	affected, err := r.execServiceAccount(ctx, op, query, keyID, usedAt)
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return apperrors.ErrAPIKeyNotFound
+	}
+
+	return nil
+}
+
+func (r Repository) execServiceAccount(ctx context.Context, op, query string, args ...any) (int64, error) {
+	var row pgconn.CommandTag
+	err := r.retryable(ctx, op, false, func(ctx context.Context) (err error) {
+		row, err = r.conn(ctx).Exec(ctx, query, args...)
+		return err
+	})
+	if err != nil {
+		slog.Error("Database error",
+			slog.String("op", op),
+			slog.String("error", err.Error()),
+		)
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return row.RowsAffected(), nil
+}
+
+func scanServiceAccount(row pgx.Row) (*models.ServiceAccount, error) {
+	var account models.ServiceAccount
+	err := row.Scan(
+		&account.ID,
+		&account.Name,
+		&account.OwnerID,
+		&account.Roles,
+		&account.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &account, nil
+}
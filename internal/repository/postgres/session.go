@@ -0,0 +1,272 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/alonsoF100/authorization-service/internal/apperrors"
+	"github.com/alonsoF100/authorization-service/internal/models"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+func (r Repository) CreateSession(ctx context.Context, session *models.Session) error {
+	const op = "repository/postgres/session.go/CreateSession"
+
+	const query = `
+	INSERT INTO sessions (id, user_id, device_name, user_agent, ip, created_at, last_seen_at, expires_at)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	`
+
+	slog.Debug("Query data",
+		slog.String("op", op),
+		slog.String("query_row", query),
+		slog.String("id", session.ID),
+		slog.String("user_id", session.UserID),
+	)
+
+	err := r.retryable(ctx, op, false, func(ctx context.Context) error {
+		_, err := r.conn(ctx).Exec(
+			ctx,
+			query,
+			session.ID,
+			session.UserID,
+			session.DeviceName,
+			session.UserAgent,
+			session.IP,
+			session.CreatedAt,
+			session.LastSeenAt,
+			session.ExpiresAt,
+		)
+		return err
+	})
+	if err != nil {
+		slog.Error("Failed to create session",
+			slog.String("op", op),
+			slog.String("user_id", session.UserID),
+			slog.String("error", err.Error()),
+		)
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (r Repository) FindSessionByID(ctx context.Context, sessionID string) (*models.Session, error) {
+	const op = "repository/postgres/session.go/FindSessionByID"
+
+	const query = `
+	SELECT id, user_id, device_name, user_agent, ip, created_at, last_seen_at, expires_at
+	FROM sessions
+	WHERE id = $1
+	`
+
+	slog.Debug("Query data",
+		slog.String("op", op),
+		slog.String("query_row", query),
+		slog.String("id", sessionID),
+	)
+
+	var session *models.Session
+	err := r.retryable(ctx, op, true, func(ctx context.Context) (err error) {
+		session, err = scanSession(r.conn(ctx).QueryRow(ctx, query, sessionID))
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+
+		slog.Error("Database error",
+			slog.String("op", op),
+			slog.String("id", sessionID),
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return session, nil
+}
+
+// ListSessions returns the sessions of a user that have not expired at now,
+// newest first
+func (r Repository) ListSessions(ctx context.Context, userID string, now time.Time) ([]models.Session, error) {
+	const op = "repository/postgres/session.go/ListSessions"
+
+	const query = `
+	SELECT id, user_id, device_name, user_agent, ip, created_at, last_seen_at, expires_at
+	FROM sessions
+	WHERE user_id = $1 AND expires_at > $2
+	ORDER BY created_at DESC, id
+	`
+
+	slog.Debug("Query data",
+		slog.String("op", op),
+		slog.String("query_row", query),
+		slog.String("user_id", userID),
+	)
+
+	var sessions []models.Session
+	err := r.retryable(ctx, op, true, func(ctx context.Context) error {
+		rows, err := r.conn(ctx).Query(ctx, query, userID, now)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+
+		sessions = sessions[:0]
+		for rows.Next() {
+			session, err := scanSession(rows)
+			if err != nil {
+				return err
+			}
+
+			sessions = append(sessions, *session)
+		}
+
+		return rows.Err()
+	})
+	if err != nil {
+		slog.Error("Database error",
+			slog.String("op", op),
+			slog.String("user_id", userID),
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return sessions, nil
+}
+
+// DeleteSession only deletes the session when it belongs to the user
+func (r Repository) DeleteSession(ctx context.Context, userID, sessionID string) error {
+	const op = "repository/postgres/session.go/DeleteSession"
+
+	const query = `
+	DELETE FROM sessions
+	WHERE id = $1 AND user_id = $2
+	`
+
+	slog.Debug("Query data",
+		slog.String("op", op),
+		slog.String("query_row", query),
+		slog.String("id", sessionID),
+		slog.String("user_id", userID),
+	)
+
+	return r.updateSession(ctx, op, query, sessionID, userID)
+}
+
+// DeleteUserSessions signs the user out everywhere
+func (r Repository) DeleteUserSessions(ctx context.Context, userID string) error {
+	const op = "repository/postgres/session.go/DeleteUserSessions"
+
+	const query = `
+	DELETE FROM sessions
+	WHERE user_id = $1
+	`
+
+	slog.Debug("Query data",
+		slog.String("op", op),
+		slog.String("query_row", query),
+		slog.String("user_id", userID),
+	)
+
+	err := r.retryable(ctx, op, false, func(ctx context.Context) error {
+		_, err := r.conn(ctx).Exec(ctx, query, userID)
+		return err
+	})
+	if err != nil {
+		slog.Error("Database error",
+			slog.String("op", op),
+			slog.String("error", err.Error()),
+		)
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (r Repository) TouchSession(ctx context.Context, sessionID string, seenAt time.Time) error {
+	const op = "repository/postgres/session.go/TouchSession"
+
+	const query = `
+	UPDATE sessions SET last_seen_at = $2
+	WHERE id = $1
+	`
+
+	slog.Debug("Query data",
+		slog.String("op", op),
+		slog.String("query_row", query),
+		slog.String("id", sessionID),
+	)
+
+	return r.updateSession(ctx, op, query, sessionID, seenAt)
+}
+
+func (r Repository) PurgeSessions(ctx context.Context, expiredBefore time.Time) (int64, error) {
+	const op = "repository/postgres/session.go/PurgeSessions"
+
+	const query = `
+	DELETE FROM sessions
+	WHERE expires_at < $1
+	`
+
+	var row pgconn.CommandTag
+	err := r.retryable(ctx, op, false, func(ctx context.Context) (err error) {
+		row, err = r.conn(ctx).Exec(ctx, query, expiredBefore)
+		return err
+	})
+	if err != nil {
+		slog.Error("Database error",
+			slog.String("op", op),
+			slog.String("error", err.Error()),
+		)
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return row.RowsAffected(), nil
+}
+
+func (r Repository) updateSession(ctx context.Context, op, query string, args ...any) error {
+	var row pgconn.CommandTag
+	err := r.retryable(ctx, op, false, func(ctx context.Context) (err error) {
+		row, err = r.conn(ctx).Exec(ctx, query, args...)
+		return err
+	})
+	if err != nil {
+		slog.Error("Database error",
+			slog.String("op", op),
+			slog.String("error", err.Error()),
+		)
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if row.RowsAffected() == 0 {
+		return apperrors.ErrSessionNotFound
+	}
+
+	return nil
+}
+
+func scanSession(row pgx.Row) (*models.Session, error) {
+	var session models.Session
+	err := row.Scan(
+		&session.ID,
+		&session.UserID,
+		&session.DeviceName,
+		&session.UserAgent,
+		&session.IP,
+		&session.CreatedAt,
+		&session.LastSeenAt,
+		&session.ExpiresAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &session, nil
+}
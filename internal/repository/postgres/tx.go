@@ -0,0 +1,49 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// querier is implemented by both the pool and a transaction
+type querier interface {
+	Exec(ctx context.Context, sql string, arguments ...any) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+type txContextKey struct{}
+
+// InTx runs fn in a transaction, repository calls made with the ctx passed
+// to fn join it and a nested InTx joins the outer transaction. The whole
+// transaction is retried on serialization failures and deadlocks, so fn
+// may run more than once.
+func (r Repository) InTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	const op = "repository/postgres/tx.go/InTx"
+
+	if _, ok := txFrom(ctx); ok {
+		return fn(ctx)
+	}
+
+	return r.withRetries(ctx, op, false, func(ctx context.Context) error {
+		return pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+			return fn(context.WithValue(ctx, txContextKey{}, tx))
+		})
+	})
+}
+
+// conn returns the transaction of ctx, or the pool outside of one
+func (r Repository) conn(ctx context.Context) querier {
+	if tx, ok := txFrom(ctx); ok {
+		return tx
+	}
+
+	return r.pool
+}
+
+func txFrom(ctx context.Context) (pgx.Tx, bool) {
+	tx, ok := ctx.Value(txContextKey{}).(pgx.Tx)
+	return tx, ok
+}
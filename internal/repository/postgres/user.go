@@ -5,17 +5,19 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"time"
 
 	"github.com/alonsoF100/authorization-service/internal/apperrors"
 	"github.com/alonsoF100/authorization-service/internal/models"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 )
 
 func (r Repository) FindByID(ctx context.Context, userID string) (*models.User, error) {
 	const op = "repository/postgres/user.go/FindByID"
 
 	const query = `
-	SELECT id, nickname, email FROM users 
+	SELECT id, nickname, email, roles, allowed_ips, disabled_at FROM users 
 	WHERE id = $1
 	`
 
@@ -26,15 +28,20 @@ func (r Repository) FindByID(ctx context.Context, userID string) (*models.User,
 	)
 
 	var user models.User
-	err := r.pool.QueryRow(
-		ctx,
-		query,
-		userID,
-	).Scan(
-		&user.ID,
-		&user.Nickname,
-		&user.Email,
-	)
+	err := r.retryable(ctx, op, true, func(ctx context.Context) error {
+		return r.conn(ctx).QueryRow(
+			ctx,
+			query,
+			userID,
+		).Scan(
+			&user.ID,
+			&user.Nickname,
+			&user.Email,
+			&user.Roles,
+			&user.AllowedIPs,
+			&user.DisabledAt,
+		)
+	})
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			slog.Debug("User not found by id",
@@ -76,11 +83,15 @@ func (r Repository) DeleteUser(ctx context.Context, userID string) error {
 		slog.String("id", userID),
 	)
 
-	row, err := r.pool.Exec(
-		ctx,
-		query,
-		userID,
-	)
+	var row pgconn.CommandTag
+	err := r.retryable(ctx, op, false, func(ctx context.Context) (err error) {
+		row, err = r.conn(ctx).Exec(
+			ctx,
+			query,
+			userID,
+		)
+		return err
+	})
 	if err != nil {
 		slog.Error("Database error",
 			slog.String("op", op),
@@ -106,3 +117,108 @@ func (r Repository) DeleteUser(ctx context.Context, userID string) error {
 
 	return nil
 }
+
+func (r Repository) UpdatePassword(ctx context.Context, userID, passwordHash string, updatedAt time.Time) error {
+	const op = "repository/postgres/user.go/UpdatePassword"
+
+	const query = `
+	UPDATE users SET password = $2, updated_at = $3
+	WHERE id = $1
+	`
+
+	slog.Debug("Query data",
+		slog.String("op", op),
+		slog.String("query_row", query),
+		slog.String("id", userID),
+		slog.Int("password_length", len(passwordHash)),
+	)
+
+	return r.updateUser(ctx, op, query, userID, passwordHash, updatedAt)
+}
+
+func (r Repository) DisableUser(ctx context.Context, userID string, disabledAt time.Time) error {
+	const op = "repository/postgres/user.go/DisableUser"
+
+	const query = `
+	UPDATE users SET disabled_at = $2, updated_at = $2
+	WHERE id = $1
+	`
+
+	slog.Debug("Query data",
+		slog.String("op", op),
+		slog.String("query_row", query),
+		slog.String("id", userID),
+		slog.Time("disabled_at", disabledAt),
+	)
+
+	return r.updateUser(ctx, op, query, userID, disabledAt)
+}
+
+func (r Repository) AddRole(ctx context.Context, userID, role string, updatedAt time.Time) error {
+	const op = "repository/postgres/user.go/AddRole"
+
+	const query = `
+	UPDATE users SET 
+		roles = CASE WHEN $2 = ANY(roles) THEN roles ELSE array_append(roles, $2) END,
+		updated_at = $3
+	WHERE id = $1
+	`
+
+	slog.Debug("Query data",
+		slog.String("op", op),
+		slog.String("query_row", query),
+		slog.String("id", userID),
+		slog.String("role", role),
+	)
+
+	return r.updateUser(ctx, op, query, userID, role, updatedAt)
+}
+
+func (r Repository) SetAllowedIPs(ctx context.Context, userID string, allowedIPs []string, updatedAt time.Time) error {
+	const op = "repository/postgres/user.go/SetAllowedIPs"
+
+	const query = `
+	UPDATE users SET 
+		allowed_ips = $2,
+		updated_at = $3
+	WHERE id = $1
+	`
+
+	slog.Debug("Query data",
+		slog.String("op", op),
+		slog.String("query_row", query),
+		slog.String("id", userID),
+		slog.Int("allowed_ips", len(allowedIPs)),
+	)
+
+	return r.updateUser(ctx, op, query, userID, nonNil(allowedIPs), updatedAt)
+}
+
+func (r Repository) updateUser(ctx context.Context, op, query string, args ...any) error {
+	var row pgconn.CommandTag
+	err := r.retryable(ctx, op, false, func(ctx context.Context) (err error) {
+		row, err = r.conn(ctx).Exec(ctx, query, args...)
+		return err
+	})
+	if err != nil {
+		slog.Error("Database error",
+			slog.String("op", op),
+			slog.String("error", err.Error()),
+		)
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if row.RowsAffected() == 0 {
+		slog.Debug("User not found by id",
+			slog.String("op", op),
+		)
+		return apperrors.ErrUserNotFoundByID
+	}
+
+	slog.Debug("User was successfully updated",
+		slog.String("op", op),
+		slog.Int64("rows_affected", row.RowsAffected()),
+	)
+
+	return nil
+}
@@ -0,0 +1,68 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/alonsoF100/authorization-service/internal/config"
+	"github.com/alonsoF100/authorization-service/internal/repository/memory"
+	"github.com/alonsoF100/authorization-service/internal/repository/postgres"
+	"github.com/alonsoF100/authorization-service/internal/repository/sqlite"
+	"github.com/alonsoF100/authorization-service/internal/service"
+)
+
+// Repository is implemented by every storage backend
+type Repository interface {
+	service.AuthRepository
+	service.UserRepository
+	service.KeyRepository
+	service.OAuthRepository
+	service.ServiceAccountRepository
+	service.AuditRepository
+	service.OutboxRepository
+}
+
+var (
+	_ Repository = (*postgres.Repository)(nil)
+	_ Repository = (*memory.Repository)(nil)
+	_ Repository = (*sqlite.Repository)(nil)
+)
+
+// New opens the backend selected by database.driver. The returned func
+// releases its resources.
+func New(ctx context.Context, cfg *config.Config) (Repository, func(), error) {
+	const op = "repository/repository.go/New"
+
+	switch cfg.Database.Driver {
+	case config.DriverMemory:
+		return memory.New(), func() {}, nil
+	case config.DriverPostgres:
+		pool, err := postgres.NewPool(ctx, cfg)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%s: %w", op, err)
+		}
+		return postgres.New(pool, cfg), pool.Close, nil
+	case config.DriverSQLite:
+		db, err := sqlite.Open(ctx, cfg)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%s: %w", op, err)
+		}
+		return sqlite.New(db, cfg), func() { db.Close() }, nil
+	default:
+		return nil, nil, fmt.Errorf("%s: unknown database driver %q", op, cfg.Database.Driver)
+	}
+}
+
+// Migrate runs a migration command for the configured backend
+func Migrate(ctx context.Context, cfg *config.Config, command string) error {
+	const op = "repository/repository.go/Migrate"
+
+	switch cfg.Database.Driver {
+	case config.DriverPostgres:
+		return postgres.Migrate(ctx, cfg, command)
+	case config.DriverSQLite:
+		return sqlite.Migrate(ctx, cfg, command)
+	default:
+		return fmt.Errorf("%s: database driver %q has no migrations", op, cfg.Database.Driver)
+	}
+}
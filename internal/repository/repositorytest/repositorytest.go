@@ -0,0 +1,1098 @@
+// Package repositorytest contains the conformance suite every storage
+// backend has to pass.
+package repositorytest
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"errors"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/alonsoF100/authorization-service/internal/apperrors"
+	"github.com/alonsoF100/authorization-service/internal/audit"
+	"github.com/alonsoF100/authorization-service/internal/models"
+	"github.com/alonsoF100/authorization-service/internal/repository"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+// timePrecision covers backends storing timestamps with microsecond precision
+const timePrecision = time.Millisecond
+
+// Run runs the suite, newRepository must return an empty repository
+func Run(t *testing.T, newRepository func(t *testing.T) repository.Repository) {
+	tests := []struct {
+		name string
+		test func(t *testing.T, repo repository.Repository)
+	}{
+		{"CreateAndFindUser", testCreateAndFindUser},
+		{"CreateUserDuplicates", testCreateUserDuplicates},
+		{"FindMissingUser", testFindMissingUser},
+		{"DeleteUser", testDeleteUser},
+		{"UpdateUser", testUpdateUser},
+		{"UpdateMissingUser", testUpdateMissingUser},
+		{"SigningKeys", testSigningKeys},
+		{"Clients", testClients},
+		{"RevokedTokens", testRevokedTokens},
+		{"AuthorizationCodes", testAuthorizationCodes},
+		{"Consents", testConsents},
+		{"PersonalAccessTokens", testPersonalAccessTokens},
+		{"ServiceAccounts", testServiceAccounts},
+		{"APIKeys", testAPIKeys},
+		{"Sessions", testSessions},
+		{"AuditEvents", testAuditEvents},
+		{"AuditChain", testAuditChain},
+		{"AuditHeadLock", testAuditHeadLock},
+		{"AuditCheckpoints", testAuditCheckpoints},
+		{"Transactions", testTransactions},
+		{"OutboxEvents", testOutboxEvents},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.test(t, newRepository(t))
+		})
+	}
+}
+
+func newUser(nickname string) *models.User {
+	now := time.Now().UTC()
+
+	return &models.User{
+		ID:           uuid.New().String(),
+		Nickname:     nickname,
+		Email:        nickname + "@yandex.ru",
+		PasswordHash: "hash-" + nickname,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}
+}
+
+func testCreateAndFindUser(t *testing.T, repo repository.Repository) {
+	ctx := context.Background()
+	userDB := newUser("alonso")
+
+	created, err := repo.CreateUser(ctx, userDB)
+	require.NoError(t, err)
+	require.Equal(t, userDB.ID, created.ID)
+	require.Equal(t, userDB.Nickname, created.Nickname)
+	require.Equal(t, userDB.Email, created.Email)
+	require.WithinDuration(t, userDB.CreatedAt, created.CreatedAt, timePrecision)
+
+	byEmail, err := repo.FindByEmail(ctx, userDB.Email)
+	require.NoError(t, err)
+	require.Equal(t, userDB.ID, byEmail.ID)
+	require.Equal(t, userDB.Nickname, byEmail.Nickname)
+	require.Equal(t, userDB.PasswordHash, byEmail.PasswordHash)
+	require.Empty(t, byEmail.Roles)
+	require.False(t, byEmail.Disabled())
+
+	byID, err := repo.FindByID(ctx, userDB.ID)
+	require.NoError(t, err)
+	require.Equal(t, userDB.Email, byID.Email)
+	require.Equal(t, userDB.Nickname, byID.Nickname)
+	require.Empty(t, byID.Roles)
+	require.False(t, byID.Disabled())
+}
+
+func testCreateUserDuplicates(t *testing.T, repo repository.Repository) {
+	ctx := context.Background()
+
+	_, err := repo.CreateUser(ctx, newUser("alonso"))
+	require.NoError(t, err)
+
+	sameEmail := newUser("fernando")
+	sameEmail.Email = "alonso@yandex.ru"
+	_, err = repo.CreateUser(ctx, sameEmail)
+	require.ErrorIs(t, err, apperrors.ErrEmailExist)
+
+	sameNickname := newUser("alonso")
+	sameNickname.Email = "fernando@yandex.ru"
+	_, err = repo.CreateUser(ctx, sameNickname)
+	require.ErrorIs(t, err, apperrors.ErrUserExist)
+
+	// nothing was stored by the failed attempts
+	user, err := repo.FindByEmail(ctx, "fernando@yandex.ru")
+	require.NoError(t, err)
+	require.Nil(t, user)
+}
+
+func testFindMissingUser(t *testing.T, repo repository.Repository) {
+	ctx := context.Background()
+
+	user, err := repo.FindByEmail(ctx, "missing@yandex.ru")
+	require.NoError(t, err)
+	require.Nil(t, user)
+
+	user, err = repo.FindByID(ctx, uuid.New().String())
+	require.NoError(t, err)
+	require.Nil(t, user)
+}
+
+func testDeleteUser(t *testing.T, repo repository.Repository) {
+	ctx := context.Background()
+	userDB := newUser("alonso")
+
+	_, err := repo.CreateUser(ctx, userDB)
+	require.NoError(t, err)
+
+	require.NoError(t, repo.DeleteUser(ctx, userDB.ID))
+
+	user, err := repo.FindByID(ctx, userDB.ID)
+	require.NoError(t, err)
+	require.Nil(t, user)
+
+	require.ErrorIs(t, repo.DeleteUser(ctx, userDB.ID), apperrors.ErrUserNotFoundByID)
+
+	// the email and nickname are free again
+	_, err = repo.CreateUser(ctx, newUser("alonso"))
+	require.NoError(t, err)
+}
+
+func testUpdateUser(t *testing.T, repo repository.Repository) {
+	ctx := context.Background()
+	userDB := newUser("alonso")
+	now := time.Now().UTC()
+
+	_, err := repo.CreateUser(ctx, userDB)
+	require.NoError(t, err)
+
+	created, err := repo.FindByID(ctx, userDB.ID)
+	require.NoError(t, err)
+	require.Empty(t, created.AllowedIPs)
+
+	require.NoError(t, repo.UpdatePassword(ctx, userDB.ID, "new-hash", now))
+	require.NoError(t, repo.AddRole(ctx, userDB.ID, models.RoleAdmin, now))
+	require.NoError(t, repo.AddRole(ctx, userDB.ID, models.RoleAdmin, now))
+	require.NoError(t, repo.AddRole(ctx, userDB.ID, "support", now))
+	require.NoError(t, repo.SetAllowedIPs(ctx, userDB.ID, []string{"203.0.113.0/24", "2001:db8::1/128"}, now))
+	require.NoError(t, repo.DisableUser(ctx, userDB.ID, now))
+
+	user, err := repo.FindByEmail(ctx, userDB.Email)
+	require.NoError(t, err)
+	require.Equal(t, "new-hash", user.PasswordHash)
+	require.Equal(t, []string{models.RoleAdmin, "support"}, user.Roles)
+	require.Equal(t, []string{"203.0.113.0/24", "2001:db8::1/128"}, user.AllowedIPs)
+	require.True(t, user.Disabled())
+	require.WithinDuration(t, now, *user.DisabledAt, timePrecision)
+
+	byID, err := repo.FindByID(ctx, userDB.ID)
+	require.NoError(t, err)
+	require.Equal(t, user.Roles, byID.Roles)
+	require.Equal(t, user.AllowedIPs, byID.AllowedIPs)
+	require.True(t, byID.Disabled())
+
+	// clearing the list lifts the restriction
+	require.NoError(t, repo.SetAllowedIPs(ctx, userDB.ID, nil, now))
+	byID, err = repo.FindByID(ctx, userDB.ID)
+	require.NoError(t, err)
+	require.Empty(t, byID.AllowedIPs)
+}
+
+func testUpdateMissingUser(t *testing.T, repo repository.Repository) {
+	ctx := context.Background()
+	userID := uuid.New().String()
+	now := time.Now().UTC()
+
+	require.ErrorIs(t, repo.UpdatePassword(ctx, userID, "hash", now), apperrors.ErrUserNotFoundByID)
+	require.ErrorIs(t, repo.DisableUser(ctx, userID, now), apperrors.ErrUserNotFoundByID)
+	require.ErrorIs(t, repo.AddRole(ctx, userID, models.RoleAdmin, now), apperrors.ErrUserNotFoundByID)
+	require.ErrorIs(t, repo.SetAllowedIPs(ctx, userID, []string{"203.0.113.0/24"}, now), apperrors.ErrUserNotFoundByID)
+}
+
+func testSigningKeys(t *testing.T, repo repository.Repository) {
+	ctx := context.Background()
+	now := time.Now().UTC()
+
+	keys, err := repo.ListSigningKeys(ctx)
+	require.NoError(t, err)
+	require.Empty(t, keys)
+
+	oldest := newSigningKey(t, now.Add(-2*time.Hour))
+	previous := newSigningKey(t, now.Add(-time.Hour))
+	newest := newSigningKey(t, now)
+	for _, key := range []*models.SigningKey{previous, oldest, newest} {
+		require.NoError(t, repo.CreateSigningKey(ctx, key))
+	}
+
+	require.NoError(t, repo.RetireSigningKeys(ctx, previous.CreatedAt, now))
+
+	keys, err = repo.ListSigningKeys(ctx)
+	require.NoError(t, err)
+	require.Len(t, keys, 3)
+
+	require.Equal(t, newest.ID, keys[0].ID)
+	require.Equal(t, newest.Algorithm, keys[0].Algorithm)
+	require.True(t, newest.PrivateKey.Equal(keys[0].PrivateKey))
+	require.Nil(t, keys[0].RetiredAt)
+
+	require.Equal(t, previous.ID, keys[1].ID)
+	require.Nil(t, keys[1].RetiredAt)
+
+	require.Equal(t, oldest.ID, keys[2].ID)
+	require.NotNil(t, keys[2].RetiredAt)
+}
+
+func newSigningKey(t *testing.T, createdAt time.Time) *models.SigningKey {
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	return &models.SigningKey{
+		ID:         uuid.New().String(),
+		Algorithm:  "ES256",
+		PrivateKey: privateKey,
+		CreatedAt:  createdAt,
+	}
+}
+
+func newClient(name string) *models.Client {
+	return &models.Client{
+		ID:                     uuid.New().String(),
+		Name:                   name,
+		SecretHash:             "hash-" + name,
+		RedirectURIs:           []string{"https://" + name + ".example.com/callback"},
+		GrantTypes:             []string{models.GrantAuthorizationCode},
+		PostLogoutRedirectURIs: []string{"https://" + name + ".example.com"},
+		Scopes:                 []string{"orders:read", "orders:write"},
+		CreatedAt:              time.Now().UTC(),
+	}
+}
+
+func testClients(t *testing.T, repo repository.Repository) {
+	ctx := context.Background()
+	clientDB := newClient("gateway")
+
+	require.NoError(t, repo.CreateClient(ctx, clientDB))
+	require.Error(t, repo.CreateClient(ctx, clientDB))
+
+	client, err := repo.FindClientByID(ctx, clientDB.ID)
+	require.NoError(t, err)
+	require.Equal(t, clientDB.Name, client.Name)
+	require.Equal(t, clientDB.SecretHash, client.SecretHash)
+	require.Equal(t, clientDB.RedirectURIs, client.RedirectURIs)
+	require.Equal(t, clientDB.GrantTypes, client.GrantTypes)
+	require.Equal(t, clientDB.PostLogoutRedirectURIs, client.PostLogoutRedirectURIs)
+	require.Equal(t, clientDB.Scopes, client.Scopes)
+	require.WithinDuration(t, clientDB.CreatedAt, client.CreatedAt, timePrecision)
+
+	client, err = repo.FindClientByID(ctx, uuid.New().String())
+	require.NoError(t, err)
+	require.Nil(t, client)
+
+	// a client without redirect uris and grants reads back empty lists
+	bare := &models.Client{ID: uuid.New().String(), Name: "bare", SecretHash: "hash", CreatedAt: time.Now().UTC()}
+	require.NoError(t, repo.CreateClient(ctx, bare))
+
+	client, err = repo.FindClientByID(ctx, bare.ID)
+	require.NoError(t, err)
+	require.Empty(t, client.RedirectURIs)
+	require.Empty(t, client.GrantTypes)
+	require.Empty(t, client.PostLogoutRedirectURIs)
+	require.Empty(t, client.Scopes)
+}
+
+func testRevokedTokens(t *testing.T, repo repository.Repository) {
+	ctx := context.Background()
+	now := time.Now().UTC()
+
+	revoked, err := repo.IsTokenRevoked(ctx, "expired")
+	require.NoError(t, err)
+	require.False(t, revoked)
+
+	require.NoError(t, repo.RevokeToken(ctx, "expired", now.Add(-time.Minute)))
+	require.NoError(t, repo.RevokeToken(ctx, "active", now.Add(time.Hour)))
+	// revoking twice is not an error
+	require.NoError(t, repo.RevokeToken(ctx, "active", now.Add(time.Hour)))
+
+	for _, jti := range []string{"expired", "active"} {
+		revoked, err := repo.IsTokenRevoked(ctx, jti)
+		require.NoError(t, err)
+		require.True(t, revoked)
+	}
+
+	purged, err := repo.PurgeRevokedTokens(ctx, now)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), purged)
+
+	revoked, err = repo.IsTokenRevoked(ctx, "expired")
+	require.NoError(t, err)
+	require.False(t, revoked)
+
+	revoked, err = repo.IsTokenRevoked(ctx, "active")
+	require.NoError(t, err)
+	require.True(t, revoked)
+}
+
+func testAuthorizationCodes(t *testing.T, repo repository.Repository) {
+	ctx := context.Background()
+	now := time.Now().UTC()
+
+	user, err := repo.CreateUser(ctx, newUser("alonso"))
+	require.NoError(t, err)
+	client := newClient("gateway")
+	require.NoError(t, repo.CreateClient(ctx, client))
+
+	newCode := func(hash string, expiresAt time.Time) *models.AuthorizationCode {
+		return &models.AuthorizationCode{
+			CodeHash:      hash,
+			ClientID:      client.ID,
+			UserID:        user.ID,
+			RedirectURI:   client.RedirectURIs[0],
+			Scope:         "profile email",
+			CodeChallenge: "challenge",
+			Nonce:         "nonce",
+			AuthTime:      now.Add(-time.Minute),
+			SessionID:     "session",
+			ExpiresAt:     expiresAt,
+			CreatedAt:     now,
+		}
+	}
+
+	codeDB := newCode("active", now.Add(time.Minute))
+	require.NoError(t, repo.CreateAuthorizationCode(ctx, codeDB))
+	require.NoError(t, repo.CreateAuthorizationCode(ctx, newCode("expired", now.Add(-time.Minute))))
+
+	code, err := repo.ConsumeAuthorizationCode(ctx, "active")
+	require.NoError(t, err)
+	require.Equal(t, codeDB.ClientID, code.ClientID)
+	require.Equal(t, codeDB.UserID, code.UserID)
+	require.Equal(t, codeDB.RedirectURI, code.RedirectURI)
+	require.Equal(t, codeDB.Scope, code.Scope)
+	require.Equal(t, codeDB.CodeChallenge, code.CodeChallenge)
+	require.Equal(t, codeDB.Nonce, code.Nonce)
+	require.WithinDuration(t, codeDB.AuthTime, code.AuthTime, timePrecision)
+	require.Equal(t, codeDB.SessionID, code.SessionID)
+	require.WithinDuration(t, codeDB.ExpiresAt, code.ExpiresAt, timePrecision)
+
+	// codes are single use
+	code, err = repo.ConsumeAuthorizationCode(ctx, "active")
+	require.NoError(t, err)
+	require.Nil(t, code)
+
+	purged, err := repo.PurgeAuthorizationCodes(ctx, now)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), purged)
+
+	code, err = repo.ConsumeAuthorizationCode(ctx, "expired")
+	require.NoError(t, err)
+	require.Nil(t, code)
+
+	// codes go away with their user
+	require.NoError(t, repo.CreateAuthorizationCode(ctx, newCode("orphan", now.Add(time.Minute))))
+	require.NoError(t, repo.DeleteUser(ctx, user.ID))
+
+	code, err = repo.ConsumeAuthorizationCode(ctx, "orphan")
+	require.NoError(t, err)
+	require.Nil(t, code)
+}
+
+func testConsents(t *testing.T, repo repository.Repository) {
+	ctx := context.Background()
+	now := time.Now().UTC()
+
+	user, err := repo.CreateUser(ctx, newUser("alonso"))
+	require.NoError(t, err)
+	client := newClient("gateway")
+	require.NoError(t, repo.CreateClient(ctx, client))
+
+	consent, err := repo.FindConsent(ctx, user.ID, client.ID)
+	require.NoError(t, err)
+	require.Nil(t, consent)
+
+	require.NoError(t, repo.SaveConsent(ctx, &models.Consent{
+		UserID:    user.ID,
+		ClientID:  client.ID,
+		Scopes:    []string{"profile"},
+		GrantedAt: now,
+	}))
+	require.NoError(t, repo.SaveConsent(ctx, &models.Consent{
+		UserID:    user.ID,
+		ClientID:  client.ID,
+		Scopes:    []string{"profile", "email"},
+		GrantedAt: now,
+	}))
+
+	consent, err = repo.FindConsent(ctx, user.ID, client.ID)
+	require.NoError(t, err)
+	require.Equal(t, []string{"profile", "email"}, consent.Scopes)
+	require.WithinDuration(t, now, consent.GrantedAt, timePrecision)
+
+	// consents go away with their user
+	require.NoError(t, repo.DeleteUser(ctx, user.ID))
+
+	consent, err = repo.FindConsent(ctx, user.ID, client.ID)
+	require.NoError(t, err)
+	require.Nil(t, consent)
+}
+
+func testPersonalAccessTokens(t *testing.T, repo repository.Repository) {
+	ctx := context.Background()
+	now := time.Now().UTC()
+	expiresAt := now.Add(time.Hour)
+
+	user, err := repo.CreateUser(ctx, newUser("alonso"))
+	require.NoError(t, err)
+	other, err := repo.CreateUser(ctx, newUser("gleb"))
+	require.NoError(t, err)
+
+	older := &models.PersonalAccessToken{
+		ID:        uuid.New().String(),
+		UserID:    user.ID,
+		Name:      "ci",
+		TokenHash: "hash-ci",
+		Scopes:    []string{"read", "write"},
+		ExpiresAt: &expiresAt,
+		CreatedAt: now.Add(-time.Minute),
+	}
+	newer := &models.PersonalAccessToken{
+		ID:        uuid.New().String(),
+		UserID:    user.ID,
+		Name:      "backup",
+		TokenHash: "hash-backup",
+		CreatedAt: now,
+	}
+	require.NoError(t, repo.CreatePersonalAccessToken(ctx, older))
+	require.NoError(t, repo.CreatePersonalAccessToken(ctx, newer))
+
+	duplicate := *newer
+	duplicate.ID = uuid.New().String()
+	require.Error(t, repo.CreatePersonalAccessToken(ctx, &duplicate))
+
+	tokens, err := repo.ListPersonalAccessTokens(ctx, user.ID)
+	require.NoError(t, err)
+	require.Len(t, tokens, 2)
+	require.Equal(t, newer.ID, tokens[0].ID)
+	require.Empty(t, tokens[0].Scopes)
+	require.Nil(t, tokens[0].ExpiresAt)
+	require.Equal(t, older.ID, tokens[1].ID)
+	require.Equal(t, []string{"read", "write"}, tokens[1].Scopes)
+	require.WithinDuration(t, expiresAt, *tokens[1].ExpiresAt, timePrecision)
+
+	tokens, err = repo.ListPersonalAccessTokens(ctx, other.ID)
+	require.NoError(t, err)
+	require.Empty(t, tokens)
+
+	token, err := repo.FindPersonalAccessTokenByHash(ctx, "hash-ci")
+	require.NoError(t, err)
+	require.Equal(t, older.ID, token.ID)
+	require.Equal(t, user.ID, token.UserID)
+	require.Equal(t, "ci", token.Name)
+	require.Nil(t, token.LastUsedAt)
+	require.WithinDuration(t, older.CreatedAt, token.CreatedAt, timePrecision)
+
+	token, err = repo.FindPersonalAccessTokenByHash(ctx, "missing")
+	require.NoError(t, err)
+	require.Nil(t, token)
+
+	require.NoError(t, repo.TouchPersonalAccessToken(ctx, older.ID, now, "192.0.2.1"))
+	token, err = repo.FindPersonalAccessTokenByHash(ctx, "hash-ci")
+	require.NoError(t, err)
+	require.WithinDuration(t, now, *token.LastUsedAt, timePrecision)
+	require.Equal(t, "192.0.2.1", token.LastUsedIP)
+
+	err = repo.TouchPersonalAccessToken(ctx, uuid.New().String(), now, "192.0.2.1")
+	require.ErrorIs(t, err, apperrors.ErrTokenNotFound)
+
+	// tokens can only be deleted by their owner
+	err = repo.DeletePersonalAccessToken(ctx, other.ID, older.ID)
+	require.ErrorIs(t, err, apperrors.ErrTokenNotFound)
+	require.NoError(t, repo.DeletePersonalAccessToken(ctx, user.ID, older.ID))
+	err = repo.DeletePersonalAccessToken(ctx, user.ID, older.ID)
+	require.ErrorIs(t, err, apperrors.ErrTokenNotFound)
+
+	// tokens go away with their user
+	require.NoError(t, repo.DeleteUser(ctx, user.ID))
+
+	token, err = repo.FindPersonalAccessTokenByHash(ctx, "hash-backup")
+	require.NoError(t, err)
+	require.Nil(t, token)
+}
+
+func testServiceAccounts(t *testing.T, repo repository.Repository) {
+	ctx := context.Background()
+	now := time.Now().UTC()
+
+	owner, err := repo.CreateUser(ctx, newUser("alonso"))
+	require.NoError(t, err)
+
+	deployer := &models.ServiceAccount{
+		ID:        uuid.New().String(),
+		Name:      "deployer",
+		OwnerID:   owner.ID,
+		Roles:     []string{"deploy", "read"},
+		CreatedAt: now,
+	}
+	backup := &models.ServiceAccount{
+		ID:        uuid.New().String(),
+		Name:      "backup",
+		CreatedAt: now,
+	}
+	require.NoError(t, repo.CreateServiceAccount(ctx, deployer))
+	require.NoError(t, repo.CreateServiceAccount(ctx, backup))
+
+	duplicate := *deployer
+	duplicate.ID = uuid.New().String()
+	require.ErrorIs(t, repo.CreateServiceAccount(ctx, &duplicate), apperrors.ErrServiceAccountExist)
+
+	accounts, err := repo.ListServiceAccounts(ctx)
+	require.NoError(t, err)
+	require.Len(t, accounts, 2)
+	require.Equal(t, "backup", accounts[0].Name)
+	require.Empty(t, accounts[0].OwnerID)
+	require.Empty(t, accounts[0].Roles)
+	require.Equal(t, "deployer", accounts[1].Name)
+
+	account, err := repo.FindServiceAccountByID(ctx, deployer.ID)
+	require.NoError(t, err)
+	require.Equal(t, owner.ID, account.OwnerID)
+	require.Equal(t, []string{"deploy", "read"}, account.Roles)
+	require.WithinDuration(t, now, account.CreatedAt, timePrecision)
+
+	account, err = repo.FindServiceAccountByID(ctx, uuid.New().String())
+	require.NoError(t, err)
+	require.Nil(t, account)
+
+	// service accounts outlive their owner
+	require.NoError(t, repo.DeleteUser(ctx, owner.ID))
+
+	account, err = repo.FindServiceAccountByID(ctx, deployer.ID)
+	require.NoError(t, err)
+	require.Empty(t, account.OwnerID)
+
+	require.NoError(t, repo.DeleteServiceAccount(ctx, backup.ID))
+	require.ErrorIs(t, repo.DeleteServiceAccount(ctx, backup.ID), apperrors.ErrServiceAccountNotFound)
+}
+
+func testAPIKeys(t *testing.T, repo repository.Repository) {
+	ctx := context.Background()
+	now := time.Now().UTC()
+
+	account := &models.ServiceAccount{ID: uuid.New().String(), Name: "deployer", CreatedAt: now}
+	require.NoError(t, repo.CreateServiceAccount(ctx, account))
+	other := &models.ServiceAccount{ID: uuid.New().String(), Name: "backup", CreatedAt: now}
+	require.NoError(t, repo.CreateServiceAccount(ctx, other))
+
+	older := &models.APIKey{
+		ID:               uuid.New().String(),
+		ServiceAccountID: account.ID,
+		KeyHash:          "hash-older",
+		CreatedAt:        now.Add(-time.Minute),
+	}
+	newer := &models.APIKey{
+		ID:               uuid.New().String(),
+		ServiceAccountID: account.ID,
+		KeyHash:          "hash-newer",
+		CreatedAt:        now,
+	}
+	require.NoError(t, repo.CreateAPIKey(ctx, older))
+	require.NoError(t, repo.CreateAPIKey(ctx, newer))
+
+	keys, err := repo.ListAPIKeys(ctx, account.ID)
+	require.NoError(t, err)
+	require.Len(t, keys, 2)
+	require.Equal(t, newer.ID, keys[0].ID)
+	require.Equal(t, older.ID, keys[1].ID)
+
+	keys, err = repo.ListAPIKeys(ctx, other.ID)
+	require.NoError(t, err)
+	require.Empty(t, keys)
+
+	key, err := repo.FindAPIKeyByID(ctx, older.ID)
+	require.NoError(t, err)
+	require.Equal(t, account.ID, key.ServiceAccountID)
+	require.Equal(t, "hash-older", key.KeyHash)
+	require.Nil(t, key.LastUsedAt)
+
+	key, err = repo.FindAPIKeyByID(ctx, uuid.New().String())
+	require.NoError(t, err)
+	require.Nil(t, key)
+
+	require.NoError(t, repo.TouchAPIKey(ctx, older.ID, now))
+	key, err = repo.FindAPIKeyByID(ctx, older.ID)
+	require.NoError(t, err)
+	require.WithinDuration(t, now, *key.LastUsedAt, timePrecision)
+
+	// keys can only be deleted through their account
+	require.ErrorIs(t, repo.DeleteAPIKey(ctx, other.ID, older.ID), apperrors.ErrAPIKeyNotFound)
+	require.NoError(t, repo.DeleteAPIKey(ctx, account.ID, older.ID))
+	require.ErrorIs(t, repo.DeleteAPIKey(ctx, account.ID, older.ID), apperrors.ErrAPIKeyNotFound)
+
+	// keys go away with their account
+	require.NoError(t, repo.DeleteServiceAccount(ctx, account.ID))
+
+	key, err = repo.FindAPIKeyByID(ctx, newer.ID)
+	require.NoError(t, err)
+	require.Nil(t, key)
+}
+
+func testSessions(t *testing.T, repo repository.Repository) {
+	ctx := context.Background()
+	now := time.Now().UTC()
+
+	user, err := repo.CreateUser(ctx, newUser("alonso"))
+	require.NoError(t, err)
+	other, err := repo.CreateUser(ctx, newUser("gleb"))
+	require.NoError(t, err)
+
+	newSession := func(userID string, createdAt, expiresAt time.Time) *models.Session {
+		return &models.Session{
+			ID:         uuid.New().String(),
+			UserID:     userID,
+			DeviceName: "Firefox on Linux",
+			UserAgent:  "Mozilla/5.0 (X11; Linux x86_64; rv:128.0) Gecko/20100101 Firefox/128.0",
+			IP:         "192.0.2.1",
+			CreatedAt:  createdAt,
+			LastSeenAt: createdAt,
+			ExpiresAt:  expiresAt,
+		}
+	}
+
+	older := newSession(user.ID, now.Add(-time.Minute), now.Add(time.Hour))
+	newer := newSession(user.ID, now, now.Add(time.Hour))
+	expired := newSession(user.ID, now.Add(-2*time.Hour), now.Add(-time.Hour))
+	for _, session := range []*models.Session{older, newer, expired} {
+		require.NoError(t, repo.CreateSession(ctx, session))
+	}
+	require.Error(t, repo.CreateSession(ctx, older))
+
+	session, err := repo.FindSessionByID(ctx, older.ID)
+	require.NoError(t, err)
+	require.Equal(t, user.ID, session.UserID)
+	require.Equal(t, "Firefox on Linux", session.DeviceName)
+	require.Equal(t, older.UserAgent, session.UserAgent)
+	require.Equal(t, "192.0.2.1", session.IP)
+	require.WithinDuration(t, older.CreatedAt, session.CreatedAt, timePrecision)
+	require.WithinDuration(t, older.ExpiresAt, session.ExpiresAt, timePrecision)
+
+	session, err = repo.FindSessionByID(ctx, uuid.New().String())
+	require.NoError(t, err)
+	require.Nil(t, session)
+
+	// expired sessions are not listed
+	sessions, err := repo.ListSessions(ctx, user.ID, now)
+	require.NoError(t, err)
+	require.Len(t, sessions, 2)
+	require.Equal(t, newer.ID, sessions[0].ID)
+	require.Equal(t, older.ID, sessions[1].ID)
+
+	sessions, err = repo.ListSessions(ctx, other.ID, now)
+	require.NoError(t, err)
+	require.Empty(t, sessions)
+
+	seenAt := now.Add(time.Minute)
+	require.NoError(t, repo.TouchSession(ctx, older.ID, seenAt))
+	session, err = repo.FindSessionByID(ctx, older.ID)
+	require.NoError(t, err)
+	require.WithinDuration(t, seenAt, session.LastSeenAt, timePrecision)
+
+	err = repo.TouchSession(ctx, uuid.New().String(), seenAt)
+	require.ErrorIs(t, err, apperrors.ErrSessionNotFound)
+
+	// sessions can only be deleted by their owner
+	err = repo.DeleteSession(ctx, other.ID, older.ID)
+	require.ErrorIs(t, err, apperrors.ErrSessionNotFound)
+	require.NoError(t, repo.DeleteSession(ctx, user.ID, older.ID))
+	err = repo.DeleteSession(ctx, user.ID, older.ID)
+	require.ErrorIs(t, err, apperrors.ErrSessionNotFound)
+
+	purged, err := repo.PurgeSessions(ctx, now)
+	require.NoError(t, err)
+	require.EqualValues(t, 1, purged)
+
+	session, err = repo.FindSessionByID(ctx, expired.ID)
+	require.NoError(t, err)
+	require.Nil(t, session)
+
+	// signing the user out everywhere leaves other users alone
+	otherSession := newSession(other.ID, now, now.Add(time.Hour))
+	require.NoError(t, repo.CreateSession(ctx, otherSession))
+	require.NoError(t, repo.DeleteUserSessions(ctx, other.ID))
+	session, err = repo.FindSessionByID(ctx, otherSession.ID)
+	require.NoError(t, err)
+	require.Nil(t, session)
+	session, err = repo.FindSessionByID(ctx, newer.ID)
+	require.NoError(t, err)
+	require.NotNil(t, session)
+
+	// sessions go away with their user
+	require.NoError(t, repo.DeleteUser(ctx, user.ID))
+
+	session, err = repo.FindSessionByID(ctx, newer.ID)
+	require.NoError(t, err)
+	require.Nil(t, session)
+}
+
+func testAuditEvents(t *testing.T, repo repository.Repository) {
+	ctx := context.Background()
+	now := time.Now().UTC()
+	userID := uuid.New().String()
+
+	events, err := repo.ListAuditEvents(ctx, models.AuditFilter{Limit: 10})
+	require.NoError(t, err)
+	require.Empty(t, events)
+
+	login := &models.AuditEvent{
+		Type:      models.AuditLogin,
+		Outcome:   models.AuditFailure,
+		TargetID:  userID,
+		IP:        "192.0.2.1",
+		UserAgent: "curl/8.5.0",
+		RequestID: "req-1",
+		Details:   map[string]string{"reason": "invalid password"},
+		CreatedAt: now.Add(-time.Hour),
+	}
+	require.NoError(t, repo.CreateAuditEvent(ctx, login))
+	require.NotZero(t, login.ID)
+
+	created := []*models.AuditEvent{login}
+	for i, eventType := range []string{models.AuditLogin, models.AuditPasswordChanged, models.AuditUserDeleted} {
+		event := &models.AuditEvent{
+			Type:      eventType,
+			Outcome:   models.AuditSuccess,
+			ActorType: string(models.PrincipalUser),
+			ActorID:   userID,
+			TargetID:  userID,
+			IP:        "192.0.2.2",
+			CreatedAt: now.Add(time.Duration(i) * time.Minute),
+		}
+		require.NoError(t, repo.CreateAuditEvent(ctx, event))
+		require.Greater(t, event.ID, created[len(created)-1].ID)
+		created = append(created, event)
+	}
+
+	// newest first
+	events, err = repo.ListAuditEvents(ctx, models.AuditFilter{Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, events, 4)
+	require.Equal(t, created[3].ID, events[0].ID)
+	require.Equal(t, login.ID, events[3].ID)
+	require.Equal(t, login.Details, events[3].Details)
+	require.Equal(t, "curl/8.5.0", events[3].UserAgent)
+	require.Equal(t, "req-1", events[3].RequestID)
+	require.WithinDuration(t, login.CreatedAt, events[3].CreatedAt, timePrecision)
+	require.Empty(t, events[0].Details)
+
+	since := now.Add(-time.Minute)
+	until := now.Add(90 * time.Second)
+	tests := []struct {
+		name   string
+		filter models.AuditFilter
+		want   []int64
+	}{
+		{"type", models.AuditFilter{Type: models.AuditLogin}, []int64{created[1].ID, login.ID}},
+		{"outcome", models.AuditFilter{Outcome: models.AuditFailure}, []int64{login.ID}},
+		{"actor", models.AuditFilter{ActorID: userID}, []int64{created[3].ID, created[2].ID, created[1].ID}},
+		{"target", models.AuditFilter{TargetID: uuid.New().String()}, nil},
+		{"ip", models.AuditFilter{IP: "192.0.2.1"}, []int64{login.ID}},
+		{"time range", models.AuditFilter{Since: &since, Until: &until}, []int64{created[2].ID, created[1].ID}},
+		{"page", models.AuditFilter{BeforeID: created[2].ID, Limit: 1}, []int64{created[1].ID}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.filter.Limit == 0 {
+				tt.filter.Limit = 10
+			}
+
+			events, err := repo.ListAuditEvents(ctx, tt.filter)
+			require.NoError(t, err)
+
+			var ids []int64
+			for _, event := range events {
+				ids = append(ids, event.ID)
+			}
+			require.Equal(t, tt.want, ids)
+		})
+	}
+}
+
+// testAuditHeadLock appends from several goroutines at once, the lock on
+// the head keeps every append on top of the previous one
+func testAuditHeadLock(t *testing.T, repo repository.Repository) {
+	ctx := context.Background()
+	const appends = 10
+
+	var wg sync.WaitGroup
+	errs := make(chan error, appends)
+	for range appends {
+		wg.Go(func() {
+			errs <- repo.InTx(ctx, func(ctx context.Context) error {
+				last, err := repo.LockAuditHead(ctx)
+				if err != nil {
+					return err
+				}
+
+				event := &models.AuditEvent{
+					Type:      models.AuditLogin,
+					Outcome:   models.AuditSuccess,
+					CreatedAt: time.Now().UTC().Truncate(time.Microsecond),
+					PrevHash:  audit.GenesisHash,
+				}
+				if last != nil {
+					event.PrevHash = last.Hash
+				}
+				event.Hash = audit.Hash(*event)
+
+				return repo.CreateAuditEvent(ctx, event)
+			})
+		})
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		require.NoError(t, err)
+	}
+
+	events, err := repo.ListAuditTrail(ctx, 0, appends+1)
+	require.NoError(t, err)
+	require.Len(t, events, appends)
+	for i := 1; i < len(events); i++ {
+		require.Equal(t, events[i-1].Hash, events[i].PrevHash)
+	}
+}
+
+func testAuditChain(t *testing.T, repo repository.Repository) {
+	ctx := context.Background()
+
+	last, err := repo.LastAuditEvent(ctx)
+	require.NoError(t, err)
+	require.Nil(t, last)
+
+	// recorded before the hash chain
+	legacy := &models.AuditEvent{Type: models.AuditLogin, Outcome: models.AuditSuccess, CreatedAt: time.Now().UTC()}
+	require.NoError(t, repo.CreateAuditEvent(ctx, legacy))
+
+	prevHash := audit.GenesisHash
+	var chained []*models.AuditEvent
+	for i := range 3 {
+		event := &models.AuditEvent{
+			Type:      models.AuditLogin,
+			Outcome:   models.AuditSuccess,
+			TargetID:  uuid.New().String(),
+			Details:   map[string]string{"attempt": strconv.Itoa(i)},
+			CreatedAt: time.Now().UTC().Truncate(time.Microsecond),
+			PrevHash:  prevHash,
+		}
+		event.Hash = audit.Hash(*event)
+		require.NoError(t, repo.CreateAuditEvent(ctx, event))
+
+		prevHash = event.Hash
+		chained = append(chained, event)
+	}
+
+	fork := &models.AuditEvent{Type: models.AuditLogout, Outcome: models.AuditSuccess, CreatedAt: time.Now().UTC(), PrevHash: chained[0].Hash}
+	fork.Hash = audit.Hash(*fork)
+	require.ErrorIs(t, repo.CreateAuditEvent(ctx, fork), apperrors.ErrAuditChainConflict)
+
+	last, err = repo.LastAuditEvent(ctx)
+	require.NoError(t, err)
+	require.Equal(t, chained[2].ID, last.ID)
+	require.Equal(t, chained[2].Hash, last.Hash)
+
+	// oldest first, page by page
+	events, err := repo.ListAuditTrail(ctx, 0, 2)
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	require.Equal(t, legacy.ID, events[0].ID)
+	require.Empty(t, events[0].PrevHash)
+	require.Empty(t, events[0].Hash)
+	require.Equal(t, chained[0].ID, events[1].ID)
+
+	events, err = repo.ListAuditTrail(ctx, events[1].ID, 10)
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+
+	// the stored content still matches its hash
+	for i, event := range events {
+		require.Equal(t, chained[i+1].ID, event.ID)
+		require.Equal(t, chained[i+1].PrevHash, event.PrevHash)
+		require.Equal(t, event.Hash, audit.Hash(event))
+	}
+}
+
+func testAuditCheckpoints(t *testing.T, repo repository.Repository) {
+	ctx := context.Background()
+
+	last, err := repo.LastAuditCheckpoint(ctx)
+	require.NoError(t, err)
+	require.Nil(t, last)
+
+	now := time.Now().UTC()
+	for _, eventID := range []int64{7, 3} {
+		checkpoint := &models.AuditCheckpoint{
+			EventID:   eventID,
+			Hash:      audit.GenesisHash,
+			KeyID:     uuid.New().String(),
+			Signature: "header.payload.signature",
+			CreatedAt: now,
+		}
+		require.NoError(t, repo.CreateAuditCheckpoint(ctx, checkpoint))
+		require.NotZero(t, checkpoint.ID)
+	}
+
+	last, err = repo.LastAuditCheckpoint(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(7), last.EventID)
+	require.Equal(t, audit.GenesisHash, last.Hash)
+	require.Equal(t, "header.payload.signature", last.Signature)
+	require.WithinDuration(t, now, last.CreatedAt, timePrecision)
+
+	checkpoints, err := repo.ListAuditCheckpoints(ctx)
+	require.NoError(t, err)
+	require.Len(t, checkpoints, 2)
+	require.Equal(t, int64(3), checkpoints[0].EventID)
+	require.Equal(t, int64(7), checkpoints[1].EventID)
+}
+
+func newOutboxEvent(userID string, createdAt time.Time) *models.OutboxEvent {
+	return &models.OutboxEvent{
+		Type:          models.EventUserRegistered,
+		AggregateID:   userID,
+		Payload:       []byte(`{"user_id":"` + userID + `"}`),
+		CreatedAt:     createdAt,
+		NextAttemptAt: createdAt,
+	}
+}
+
+func testTransactions(t *testing.T, repo repository.Repository) {
+	ctx := context.Background()
+	now := time.Now().UTC()
+
+	committed := newUser("committed")
+	err := repo.InTx(ctx, func(ctx context.Context) error {
+		if _, err := repo.CreateUser(ctx, committed); err != nil {
+			return err
+		}
+		return repo.CreateOutboxEvent(ctx, newOutboxEvent(committed.ID, now))
+	})
+	require.NoError(t, err)
+
+	found, err := repo.FindByID(ctx, committed.ID)
+	require.NoError(t, err)
+	require.NotNil(t, found)
+	require.NoError(t, repo.AddRole(ctx, committed.ID, "admin", now))
+	require.NoError(t, repo.SetAllowedIPs(ctx, committed.ID, []string{"192.0.2.0/24"}, now))
+	lastEvent, err := repo.LastAuditEvent(ctx)
+	require.NoError(t, err)
+
+	failed := newUser("rolledback")
+	someErr := errors.New("later step failed")
+	err = repo.InTx(ctx, func(ctx context.Context) error {
+		if _, err := repo.CreateUser(ctx, failed); err != nil {
+			return err
+		}
+		if err := repo.UpdatePassword(ctx, committed.ID, "changed", now); err != nil {
+			return err
+		}
+		if err := repo.AddRole(ctx, committed.ID, "auditor", now); err != nil {
+			return err
+		}
+		if err := repo.SetAllowedIPs(ctx, committed.ID, []string{"198.51.100.0/24"}, now); err != nil {
+			return err
+		}
+		if err := repo.CreateAuditEvent(ctx, &models.AuditEvent{Type: models.AuditLogin, Outcome: models.AuditSuccess, CreatedAt: now}); err != nil {
+			return err
+		}
+		// a nested transaction joins the outer one
+		err := repo.InTx(ctx, func(ctx context.Context) error {
+			return repo.CreateOutboxEvent(ctx, newOutboxEvent(failed.ID, now))
+		})
+		if err != nil {
+			return err
+		}
+		return someErr
+	})
+	require.ErrorIs(t, err, someErr)
+
+	found, err = repo.FindByID(ctx, failed.ID)
+	require.NoError(t, err)
+	require.Nil(t, found)
+
+	found, err = repo.FindByEmail(ctx, committed.Email)
+	require.NoError(t, err)
+	require.Equal(t, "hash-committed", found.PasswordHash)
+	require.Equal(t, []string{"admin"}, found.Roles)
+	require.Equal(t, []string{"192.0.2.0/24"}, found.AllowedIPs)
+
+	last, err := repo.LastAuditEvent(ctx)
+	require.NoError(t, err)
+	require.Equal(t, lastEvent, last)
+
+	events, err := repo.ClaimOutboxEvents(ctx, now, now.Add(time.Minute), 10)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	require.Equal(t, committed.ID, events[0].AggregateID)
+}
+
+func testOutboxEvents(t *testing.T, repo repository.Repository) {
+	ctx := context.Background()
+	now := time.Now().UTC()
+	lease := now.Add(30 * time.Second)
+	first, second := uuid.New().String(), uuid.New().String()
+
+	created := []*models.OutboxEvent{
+		newOutboxEvent(first, now.Add(-3*time.Second)),
+		newOutboxEvent(first, now.Add(-2*time.Second)),
+		newOutboxEvent(second, now.Add(-time.Second)),
+	}
+	for _, event := range created {
+		require.NoError(t, repo.CreateOutboxEvent(ctx, event))
+		require.NotZero(t, event.ID)
+	}
+
+	// only the oldest pending event of each user is claimed
+	events, err := repo.ClaimOutboxEvents(ctx, now, lease, 10)
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	require.Equal(t, created[0].ID, events[0].ID)
+	require.Equal(t, created[2].ID, events[1].ID)
+	require.Equal(t, models.EventUserRegistered, events[0].Type)
+	require.Equal(t, first, events[0].AggregateID)
+	require.JSONEq(t, string(created[0].Payload), string(events[0].Payload))
+	require.WithinDuration(t, created[0].CreatedAt, events[0].CreatedAt, timePrecision)
+	require.Equal(t, 1, events[0].Attempts)
+	require.WithinDuration(t, lease, events[0].NextAttemptAt, timePrecision)
+	require.Nil(t, events[0].PublishedAt)
+
+	// leased events are not claimed again
+	events, err = repo.ClaimOutboxEvents(ctx, now, lease, 10)
+	require.NoError(t, err)
+	require.Empty(t, events)
+
+	require.NoError(t, repo.RetryOutboxEvent(ctx, created[0].ID, now.Add(-time.Second), "connection refused"))
+	events, err = repo.ClaimOutboxEvents(ctx, now, lease, 1)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	require.Equal(t, created[0].ID, events[0].ID)
+	require.Equal(t, 2, events[0].Attempts)
+	require.Equal(t, "connection refused", events[0].LastError)
+
+	// the next event of the user follows once the first one is published
+	require.NoError(t, repo.MarkOutboxEventPublished(ctx, created[0].ID, now))
+	events, err = repo.ClaimOutboxEvents(ctx, now, lease, 10)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	require.Equal(t, created[1].ID, events[0].ID)
+	require.Empty(t, events[0].LastError)
+
+	require.NoError(t, repo.MarkOutboxEventPublished(ctx, created[1].ID, now.Add(-2*time.Hour)))
+	require.NoError(t, repo.MarkOutboxEventPublished(ctx, created[2].ID, now.Add(-2*time.Hour)))
+
+	purged, err := repo.PurgeOutboxEvents(ctx, now.Add(-time.Hour))
+	require.NoError(t, err)
+	require.Equal(t, int64(2), purged)
+
+	purged, err = repo.PurgeOutboxEvents(ctx, now.Add(-time.Hour))
+	require.NoError(t, err)
+	require.Zero(t, purged)
+
+	events, err = repo.ClaimOutboxEvents(ctx, now.Add(time.Hour), lease, 10)
+	require.NoError(t, err)
+	require.Empty(t, events)
+}
@@ -0,0 +1,408 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/alonsoF100/authorization-service/internal/apperrors"
+	"github.com/alonsoF100/authorization-service/internal/models"
+	"modernc.org/sqlite"
+	sqlite3 "modernc.org/sqlite/lib"
+)
+
+// events recorded before the hash chain have NULL hashes, they don't take
+// part in the unique previous hash
+const auditEventColumns = `id, type, outcome, actor_type, actor_id, target_id, ip, user_agent, request_id, details, created_at,
+	COALESCE(prev_hash, ''), COALESCE(hash, '')`
+
+// CreateAuditEvent returns apperrors.ErrAuditChainConflict when another
+// event already follows the previous hash
+func (r Repository) CreateAuditEvent(ctx context.Context, event *models.AuditEvent) error {
+	const op = "repository/sqlite/audit.go/CreateAuditEvent"
+
+	const query = `
+	INSERT INTO audit_events (type, outcome, actor_type, actor_id, target_id, ip, user_agent, request_id, details, created_at, prev_hash, hash)
+	VALUES (?1, ?2, ?3, ?4, ?5, ?6, ?7, ?8, ?9, ?10, NULLIF(?11, ''), NULLIF(?12, ''))
+	`
+
+	slog.Debug("Query data",
+		slog.String("op", op),
+		slog.String("query_row", query),
+		slog.String("type", event.Type),
+	)
+
+	ctx, cancel := r.withTimeout(ctx)
+	defer cancel()
+
+	details := event.Details
+	if details == nil {
+		details = map[string]string{}
+	}
+	encoded, err := json.Marshal(details)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	result, err := r.conn(ctx).ExecContext(
+		ctx,
+		query,
+		event.Type,
+		event.Outcome,
+		event.ActorType,
+		event.ActorID,
+		event.TargetID,
+		event.IP,
+		event.UserAgent,
+		event.RequestID,
+		string(encoded),
+		event.CreatedAt.UTC(),
+		event.PrevHash,
+		event.Hash,
+	)
+	if err == nil {
+		event.ID, err = result.LastInsertId()
+	}
+	if err != nil {
+		var sqliteErr *sqlite.Error
+		if errors.As(err, &sqliteErr) && sqliteErr.Code() == sqlite3.SQLITE_CONSTRAINT_UNIQUE {
+			slog.Debug("Audit chain head already taken",
+				slog.String("op", op),
+				slog.String("prev_hash", event.PrevHash),
+			)
+			return apperrors.ErrAuditChainConflict
+		}
+
+		slog.Error("Failed to create audit event",
+			slog.String("op", op),
+			slog.String("type", event.Type),
+			slog.String("error", err.Error()),
+		)
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// LastAuditEvent returns the newest event, nil when there is none
+func (r Repository) LastAuditEvent(ctx context.Context) (*models.AuditEvent, error) {
+	const op = "repository/sqlite/audit.go/LastAuditEvent"
+
+	const query = `
+	SELECT ` + auditEventColumns + `
+	FROM audit_events
+	ORDER BY id DESC
+	LIMIT 1
+	`
+
+	slog.Debug("Query data",
+		slog.String("op", op),
+		slog.String("query_row", query),
+	)
+
+	ctx, cancel := r.withTimeout(ctx)
+	defer cancel()
+
+	event, err := scanAuditEvent(r.conn(ctx).QueryRowContext(ctx, query))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+
+		slog.Error("Database error",
+			slog.String("op", op),
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return event, nil
+}
+
+// LockAuditHead returns the newest event like LastAuditEvent. The pool has a
+// single connection, so other appends wait for the transaction of ctx to
+// end.
+func (r Repository) LockAuditHead(ctx context.Context) (*models.AuditEvent, error) {
+	return r.LastAuditEvent(ctx)
+}
+
+// ListAuditEvents returns the newest matching events first
+func (r Repository) ListAuditEvents(ctx context.Context, filter models.AuditFilter) ([]models.AuditEvent, error) {
+	const op = "repository/sqlite/audit.go/ListAuditEvents"
+
+	const query = `
+	SELECT ` + auditEventColumns + `
+	FROM audit_events
+	WHERE (?1 = '' OR type = ?1)
+		AND (?2 = '' OR outcome = ?2)
+		AND (?3 = '' OR actor_id = ?3)
+		AND (?4 = '' OR target_id = ?4)
+		AND (?5 = '' OR ip = ?5)
+		AND (?6 IS NULL OR created_at >= ?6)
+		AND (?7 IS NULL OR created_at < ?7)
+		AND (?8 = 0 OR id < ?8)
+	ORDER BY id DESC
+	LIMIT ?9
+	`
+
+	slog.Debug("Query data",
+		slog.String("op", op),
+		slog.String("query_row", query),
+	)
+
+	events, err := r.queryAuditEvents(ctx, query,
+		filter.Type,
+		filter.Outcome,
+		filter.ActorID,
+		filter.TargetID,
+		filter.IP,
+		utcTime(filter.Since),
+		utcTime(filter.Until),
+		filter.BeforeID,
+		filter.Limit,
+	)
+	if err != nil {
+		slog.Error("Database error",
+			slog.String("op", op),
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return events, nil
+}
+
+// ListAuditTrail returns up to limit events after afterID, oldest first
+func (r Repository) ListAuditTrail(ctx context.Context, afterID int64, limit int) ([]models.AuditEvent, error) {
+	const op = "repository/sqlite/audit.go/ListAuditTrail"
+
+	const query = `
+	SELECT ` + auditEventColumns + `
+	FROM audit_events
+	WHERE id > ?1
+	ORDER BY id
+	LIMIT ?2
+	`
+
+	slog.Debug("Query data",
+		slog.String("op", op),
+		slog.String("query_row", query),
+		slog.Int64("after_id", afterID),
+	)
+
+	events, err := r.queryAuditEvents(ctx, query, afterID, limit)
+	if err != nil {
+		slog.Error("Database error",
+			slog.String("op", op),
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return events, nil
+}
+
+func (r Repository) queryAuditEvents(ctx context.Context, query string, args ...any) ([]models.AuditEvent, error) {
+	ctx, cancel := r.withTimeout(ctx)
+	defer cancel()
+
+	rows, err := r.conn(ctx).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var events []models.AuditEvent
+	for rows.Next() {
+		event, err := scanAuditEvent(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		events = append(events, *event)
+	}
+
+	return events, rows.Err()
+}
+
+func scanAuditEvent(row rowScanner) (*models.AuditEvent, error) {
+	var (
+		event   models.AuditEvent
+		details string
+	)
+	err := row.Scan(
+		&event.ID,
+		&event.Type,
+		&event.Outcome,
+		&event.ActorType,
+		&event.ActorID,
+		&event.TargetID,
+		&event.IP,
+		&event.UserAgent,
+		&event.RequestID,
+		&details,
+		&event.CreatedAt,
+		&event.PrevHash,
+		&event.Hash,
+	)
+	if err == nil {
+		err = json.Unmarshal([]byte(details), &event.Details)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &event, nil
+}
+
+func (r Repository) CreateAuditCheckpoint(ctx context.Context, checkpoint *models.AuditCheckpoint) error {
+	const op = "repository/sqlite/audit.go/CreateAuditCheckpoint"
+
+	const query = `
+	INSERT INTO audit_checkpoints (event_id, hash, key_id, signature, created_at)
+	VALUES (?1, ?2, ?3, ?4, ?5)
+	`
+
+	slog.Debug("Query data",
+		slog.String("op", op),
+		slog.String("query_row", query),
+		slog.Int64("event_id", checkpoint.EventID),
+	)
+
+	ctx, cancel := r.withTimeout(ctx)
+	defer cancel()
+
+	result, err := r.conn(ctx).ExecContext(
+		ctx,
+		query,
+		checkpoint.EventID,
+		checkpoint.Hash,
+		checkpoint.KeyID,
+		checkpoint.Signature,
+		checkpoint.CreatedAt.UTC(),
+	)
+	if err == nil {
+		checkpoint.ID, err = result.LastInsertId()
+	}
+	if err != nil {
+		slog.Error("Failed to create audit checkpoint",
+			slog.String("op", op),
+			slog.Int64("event_id", checkpoint.EventID),
+			slog.String("error", err.Error()),
+		)
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// LastAuditCheckpoint returns the checkpoint of the newest event, nil when
+// there is none
+func (r Repository) LastAuditCheckpoint(ctx context.Context) (*models.AuditCheckpoint, error) {
+	const op = "repository/sqlite/audit.go/LastAuditCheckpoint"
+
+	const query = `
+	SELECT id, event_id, hash, key_id, signature, created_at
+	FROM audit_checkpoints
+	ORDER BY event_id DESC, id DESC
+	LIMIT 1
+	`
+
+	slog.Debug("Query data",
+		slog.String("op", op),
+		slog.String("query_row", query),
+	)
+
+	ctx, cancel := r.withTimeout(ctx)
+	defer cancel()
+
+	checkpoint, err := scanAuditCheckpoint(r.conn(ctx).QueryRowContext(ctx, query))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+
+		slog.Error("Database error",
+			slog.String("op", op),
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return checkpoint, nil
+}
+
+// ListAuditCheckpoints returns every checkpoint, oldest event first
+func (r Repository) ListAuditCheckpoints(ctx context.Context) ([]models.AuditCheckpoint, error) {
+	const op = "repository/sqlite/audit.go/ListAuditCheckpoints"
+
+	const query = `
+	SELECT id, event_id, hash, key_id, signature, created_at
+	FROM audit_checkpoints
+	ORDER BY event_id, id
+	`
+
+	slog.Debug("Query data",
+		slog.String("op", op),
+		slog.String("query_row", query),
+	)
+
+	ctx, cancel := r.withTimeout(ctx)
+	defer cancel()
+
+	rows, err := r.conn(ctx).QueryContext(ctx, query)
+	if err != nil {
+		slog.Error("Database error",
+			slog.String("op", op),
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var checkpoints []models.AuditCheckpoint
+	for rows.Next() {
+		checkpoint, err := scanAuditCheckpoint(rows)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		checkpoints = append(checkpoints, *checkpoint)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return checkpoints, nil
+}
+
+func scanAuditCheckpoint(row rowScanner) (*models.AuditCheckpoint, error) {
+	var checkpoint models.AuditCheckpoint
+	err := row.Scan(
+		&checkpoint.ID,
+		&checkpoint.EventID,
+		&checkpoint.Hash,
+		&checkpoint.KeyID,
+		&checkpoint.Signature,
+		&checkpoint.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &checkpoint, nil
+}
+
+// utcTime keeps a missing bound NULL
+func utcTime(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+
+	utc := t.UTC()
+	return &utc
+}
@@ -0,0 +1,162 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/alonsoF100/authorization-service/internal/apperrors"
+	"github.com/alonsoF100/authorization-service/internal/models"
+	"modernc.org/sqlite"
+	sqlite3 "modernc.org/sqlite/lib"
+)
+
+func (r Repository) CreateUser(ctx context.Context, userDB *models.User) (*models.User, error) {
+	const op = "repository/sqlite/auth.go/CreateUser"
+
+	const query = `
+	INSERT INTO users (id, nickname, email, password, created_at, updated_at) 
+	VALUES (?1, ?2, ?3, ?4, ?5, ?6) 
+	RETURNING nickname, email, id, created_at 
+	`
+
+	slog.Debug("Query data",
+		slog.String("op", op),
+		slog.String("query_row", query),
+		slog.String("id", userDB.ID),
+		slog.String("nickname", userDB.Nickname),
+		slog.String("email", userDB.Email),
+		slog.Int("password_length", len(userDB.PasswordHash)),
+		slog.Time("created_at", userDB.CreatedAt),
+		slog.Time("updated_at", userDB.UpdatedAt),
+	)
+
+	ctx, cancel := r.withTimeout(ctx)
+	defer cancel()
+
+	var user models.User
+	err := r.conn(ctx).QueryRowContext(
+		ctx,
+		query,
+		userDB.ID,
+		userDB.Nickname,
+		userDB.Email,
+		userDB.PasswordHash,
+		userDB.CreatedAt.UTC(),
+		userDB.UpdatedAt.UTC(),
+	).Scan(
+		&user.Nickname,
+		&user.Email,
+		&user.ID,
+		&user.CreatedAt,
+	)
+	if err != nil {
+		var sqliteErr *sqlite.Error
+		if errors.As(err, &sqliteErr) && sqliteErr.Code() == sqlite3.SQLITE_CONSTRAINT_UNIQUE {
+			// SQLite reports the column instead of the constraint name
+			switch {
+			case strings.Contains(sqliteErr.Error(), "users.email"):
+				slog.Debug("Email already exists",
+					slog.String("op", op),
+					slog.String("email", userDB.Email),
+				)
+				return nil, apperrors.ErrEmailExist
+
+			case strings.Contains(sqliteErr.Error(), "users.nickname"):
+				slog.Debug("Nickname already exists",
+					slog.String("op", op),
+					slog.String("nickname", userDB.Nickname),
+				)
+				return nil, apperrors.ErrUserExist
+			}
+		}
+
+		slog.Error("Failed to create user",
+			slog.String("op", op),
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	slog.Debug("User created succsessfully",
+		slog.String("op", op),
+		slog.String("nickname", user.Nickname),
+		slog.String("email", user.Email),
+		slog.String("id", user.ID),
+		slog.Time("created_at", user.CreatedAt),
+	)
+
+	return &user, nil
+}
+
+func (r Repository) FindByEmail(ctx context.Context, email string) (*models.User, error) {
+	const op = "repository/sqlite/auth.go/FindByEmail"
+
+	const query = `
+	SELECT id, email, nickname, password, roles, allowed_ips, disabled_at FROM users 
+	WHERE email = ?1
+	`
+
+	slog.Debug("Query data",
+		slog.String("op", op),
+		slog.String("query_row", query),
+		slog.String("email", email),
+	)
+
+	ctx, cancel := r.withTimeout(ctx)
+	defer cancel()
+
+	var (
+		user       models.User
+		roles      string
+		allowedIPs string
+	)
+	err := r.conn(ctx).QueryRowContext(
+		ctx,
+		query,
+		email,
+	).Scan(
+		&user.ID,
+		&user.Email,
+		&user.Nickname,
+		&user.PasswordHash,
+		&roles,
+		&allowedIPs,
+		&user.DisabledAt,
+	)
+	if err == nil {
+		err = json.Unmarshal([]byte(roles), &user.Roles)
+	}
+	if err == nil {
+		err = json.Unmarshal([]byte(allowedIPs), &user.AllowedIPs)
+	}
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			slog.Debug("User not found by email",
+				slog.String("op", op),
+				slog.String("email", email),
+			)
+			return nil, nil
+		}
+
+		slog.Error("Database error",
+			slog.String("op", op),
+			slog.String("email", email),
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	slog.Debug("User was succsessfully founded",
+		slog.String("op", op),
+		slog.String("nickname", user.Nickname),
+		slog.String("email", user.Email),
+		slog.String("id", user.ID),
+	)
+
+	return &user, nil
+}
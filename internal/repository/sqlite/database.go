@@ -0,0 +1,79 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"time"
+
+	"github.com/alonsoF100/authorization-service/internal/config"
+	"github.com/alonsoF100/authorization-service/internal/keyseal"
+	_ "modernc.org/sqlite"
+)
+
+type Repository struct {
+	db           *sql.DB
+	queryTimeout time.Duration
+	sealer       *keyseal.Sealer
+}
+
+func New(db *sql.DB, cfg *config.Config) *Repository {
+	return &Repository{
+		db:           db,
+		queryTimeout: cfg.Database.QueryTimeout,
+		sealer:       keyseal.New(cfg.JWT.KeyEncryptionKey),
+	}
+}
+
+// Open opens the database file at database.path. SQLite allows a single
+// writer, so the pool is limited to one connection instead of failing
+// concurrent writes with SQLITE_BUSY.
+func Open(ctx context.Context, cfg *config.Config) (*sql.DB, error) {
+	const op = "repository/sqlite/database.go/Open"
+
+	db, err := sql.Open("sqlite", dsn(cfg.Database.Path))
+	if err != nil {
+		slog.Error("Failed to open sqlite database",
+			slog.String("op", op),
+			slog.String("path", cfg.Database.Path),
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	db.SetMaxOpenConns(1)
+
+	if err := db.PingContext(ctx); err != nil {
+		slog.Error("Failed to ping sqlite database",
+			slog.String("op", op),
+			slog.String("path", cfg.Database.Path),
+			slog.String("error", err.Error()),
+		)
+		db.Close()
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return db, nil
+}
+
+func dsn(path string) string {
+	query := url.Values{}
+	query.Add("_pragma", "busy_timeout(5000)")
+	query.Add("_pragma", "journal_mode(WAL)")
+	query.Add("_pragma", "foreign_keys(1)")
+	// store timestamps in a sortable text format, created_at is compared
+	// and ordered as a string
+	query.Set("_time_format", "sqlite")
+
+	return "file:" + path + "?" + query.Encode()
+}
+
+func (r Repository) withTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	if r.queryTimeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+
+	return context.WithTimeout(ctx, r.queryTimeout)
+}
@@ -0,0 +1,148 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/alonsoF100/authorization-service/internal/models"
+)
+
+func (r Repository) CreateSigningKey(ctx context.Context, key *models.SigningKey) error {
+	const op = "repository/sqlite/keys.go/CreateSigningKey"
+
+	const query = `
+	INSERT INTO signing_keys (id, algorithm, private_key, created_at)
+	VALUES (?1, ?2, ?3, ?4)
+	`
+
+	slog.Debug("Query data",
+		slog.String("op", op),
+		slog.String("query_row", query),
+		slog.String("id", key.ID),
+		slog.String("algorithm", key.Algorithm),
+	)
+
+	privateKey, err := r.sealer.Encode(key.ID, key.PrivateKey)
+	if err != nil {
+		slog.Error("Failed to encode private key",
+			slog.String("op", op),
+			slog.String("error", err.Error()),
+		)
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	ctx, cancel := r.withTimeout(ctx)
+	defer cancel()
+
+	if _, err := r.conn(ctx).ExecContext(ctx, query, key.ID, key.Algorithm, privateKey, key.CreatedAt.UTC()); err != nil {
+		slog.Error("Failed to create signing key",
+			slog.String("op", op),
+			slog.String("error", err.Error()),
+		)
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	slog.Debug("Signing key created successfully",
+		slog.String("op", op),
+		slog.String("id", key.ID),
+	)
+
+	return nil
+}
+
+func (r Repository) ListSigningKeys(ctx context.Context) ([]models.SigningKey, error) {
+	const op = "repository/sqlite/keys.go/ListSigningKeys"
+
+	const query = `
+	SELECT id, algorithm, private_key, created_at, retired_at FROM signing_keys
+	ORDER BY created_at DESC
+	`
+
+	slog.Debug("Query data",
+		slog.String("op", op),
+		slog.String("query_row", query),
+	)
+
+	ctx, cancel := r.withTimeout(ctx)
+	defer cancel()
+
+	keys, err := r.listSigningKeys(ctx, query)
+	if err != nil {
+		slog.Error("Database error",
+			slog.String("op", op),
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	slog.Debug("Signing keys were successfully founded",
+		slog.String("op", op),
+		slog.Int("count", len(keys)),
+	)
+
+	return keys, nil
+}
+
+func (r Repository) listSigningKeys(ctx context.Context, query string) ([]models.SigningKey, error) {
+	rows, err := r.conn(ctx).QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var keys []models.SigningKey
+	for rows.Next() {
+		var (
+			key        models.SigningKey
+			privateKey string
+		)
+		if err := rows.Scan(&key.ID, &key.Algorithm, &privateKey, &key.CreatedAt, &key.RetiredAt); err != nil {
+			return nil, err
+		}
+
+		if key.PrivateKey, err = r.sealer.Decode(key.ID, privateKey); err != nil {
+			return nil, fmt.Errorf("failed to decode private key %s: %w", key.ID, err)
+		}
+
+		keys = append(keys, key)
+	}
+
+	return keys, rows.Err()
+}
+
+func (r Repository) RetireSigningKeys(ctx context.Context, createdBefore, retiredAt time.Time) error {
+	const op = "repository/sqlite/keys.go/RetireSigningKeys"
+
+	const query = `
+	UPDATE signing_keys SET retired_at = ?2
+	WHERE created_at < ?1 AND retired_at IS NULL
+	`
+
+	slog.Debug("Query data",
+		slog.String("op", op),
+		slog.String("query_row", query),
+		slog.Time("created_before", createdBefore),
+	)
+
+	ctx, cancel := r.withTimeout(ctx)
+	defer cancel()
+
+	row, err := r.conn(ctx).ExecContext(ctx, query, createdBefore.UTC(), retiredAt.UTC())
+	if err != nil {
+		slog.Error("Database error",
+			slog.String("op", op),
+			slog.String("error", err.Error()),
+		)
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	rowsAffected, _ := row.RowsAffected()
+	slog.Debug("Signing keys were successfully retired",
+		slog.String("op", op),
+		slog.Int64("rows_affected", rowsAffected),
+	)
+
+	return nil
+}
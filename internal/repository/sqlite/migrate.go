@@ -0,0 +1,100 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/alonsoF100/authorization-service/internal/config"
+	migrations "github.com/alonsoF100/authorization-service/migrations/sqlite"
+	"github.com/pressly/goose/v3"
+)
+
+// Migrate runs a goose command using the embedded migrations. The global
+// goose registry holds the postgres Go migrations, so it is disabled here.
+func Migrate(ctx context.Context, cfg *config.Config, command string) error {
+	const op = "repository/sqlite/migrate.go/Migrate"
+
+	db, err := Open(ctx, cfg)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer db.Close()
+
+	provider, err := goose.NewProvider(goose.DialectSQLite3, db, migrations.FS,
+		goose.WithDisableGlobalRegistry(true),
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := runMigrations(ctx, provider, command); err != nil {
+		slog.Error("Failed to run migrations",
+			slog.String("op", op),
+			slog.String("command", command),
+			slog.String("error", err.Error()),
+		)
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func runMigrations(ctx context.Context, provider *goose.Provider, command string) error {
+	switch command {
+	case "up":
+		results, err := provider.Up(ctx)
+		logResults(results...)
+		return err
+	case "down":
+		result, err := provider.Down(ctx)
+		logResults(result)
+		return err
+	case "redo":
+		result, err := provider.Down(ctx)
+		logResults(result)
+		if err != nil {
+			return err
+		}
+		result, err = provider.UpByOne(ctx)
+		logResults(result)
+		return err
+	case "status":
+		statuses, err := provider.Status(ctx)
+		if err != nil {
+			return err
+		}
+		for _, status := range statuses {
+			slog.Info("Migration status",
+				slog.Int64("version", status.Source.Version),
+				slog.String("source", status.Source.Path),
+				slog.String("state", string(status.State)),
+				slog.Time("applied_at", status.AppliedAt),
+			)
+		}
+		return nil
+	case "version":
+		version, err := provider.GetDBVersion(ctx)
+		if err != nil {
+			return err
+		}
+		slog.Info("Database version", slog.Int64("version", version))
+		return nil
+	default:
+		return fmt.Errorf("unknown migration command %q", command)
+	}
+}
+
+func logResults(results ...*goose.MigrationResult) {
+	for _, result := range results {
+		if result == nil || result.Error != nil {
+			continue
+		}
+		slog.Info("Migration applied",
+			slog.Int64("version", result.Source.Version),
+			slog.String("source", result.Source.Path),
+			slog.String("direction", result.Direction),
+			slog.Duration("duration", result.Duration),
+		)
+	}
+}
@@ -0,0 +1,409 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/alonsoF100/authorization-service/internal/models"
+)
+
+func (r Repository) CreateClient(ctx context.Context, client *models.Client) error {
+	const op = "repository/sqlite/oauth.go/CreateClient"
+
+	const query = `
+	INSERT INTO oauth_clients (id, name, secret_hash, redirect_uris, grant_types, post_logout_redirect_uris, scopes, created_at)
+	VALUES (?1, ?2, ?3, ?4, ?5, ?6, ?7, ?8)
+	`
+
+	slog.Debug("Query data",
+		slog.String("op", op),
+		slog.String("query_row", query),
+		slog.String("id", client.ID),
+		slog.String("name", client.Name),
+	)
+
+	ctx, cancel := r.withTimeout(ctx)
+	defer cancel()
+
+	_, err := r.conn(ctx).ExecContext(
+		ctx,
+		query,
+		client.ID,
+		client.Name,
+		client.SecretHash,
+		encodeStrings(client.RedirectURIs),
+		encodeStrings(client.GrantTypes),
+		encodeStrings(client.PostLogoutRedirectURIs),
+		encodeStrings(client.Scopes),
+		client.CreatedAt.UTC(),
+	)
+	if err != nil {
+		slog.Error("Failed to create client",
+			slog.String("op", op),
+			slog.String("error", err.Error()),
+		)
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	slog.Debug("Client created successfully",
+		slog.String("op", op),
+		slog.String("id", client.ID),
+	)
+
+	return nil
+}
+
+func (r Repository) FindClientByID(ctx context.Context, clientID string) (*models.Client, error) {
+	const op = "repository/sqlite/oauth.go/FindClientByID"
+
+	const query = `
+	SELECT id, name, secret_hash, redirect_uris, grant_types, post_logout_redirect_uris, scopes, created_at FROM oauth_clients
+	WHERE id = ?1
+	`
+
+	slog.Debug("Query data",
+		slog.String("op", op),
+		slog.String("query_row", query),
+		slog.String("id", clientID),
+	)
+
+	ctx, cancel := r.withTimeout(ctx)
+	defer cancel()
+
+	var (
+		client                                                   models.Client
+		redirectURIs, grantTypes, postLogoutRedirectURIs, scopes string
+	)
+	err := r.conn(ctx).QueryRowContext(ctx, query, clientID).Scan(
+		&client.ID,
+		&client.Name,
+		&client.SecretHash,
+		&redirectURIs,
+		&grantTypes,
+		&postLogoutRedirectURIs,
+		&scopes,
+		&client.CreatedAt,
+	)
+	if err == nil {
+		err = errors.Join(
+			json.Unmarshal([]byte(redirectURIs), &client.RedirectURIs),
+			json.Unmarshal([]byte(grantTypes), &client.GrantTypes),
+			json.Unmarshal([]byte(postLogoutRedirectURIs), &client.PostLogoutRedirectURIs),
+			json.Unmarshal([]byte(scopes), &client.Scopes),
+		)
+	}
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			slog.Debug("Client not found by id",
+				slog.String("op", op),
+				slog.String("client_id", clientID),
+			)
+			return nil, nil
+		}
+
+		slog.Error("Database error",
+			slog.String("op", op),
+			slog.String("client_id", clientID),
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &client, nil
+}
+
+func (r Repository) RevokeToken(ctx context.Context, jti string, expiresAt time.Time) error {
+	const op = "repository/sqlite/oauth.go/RevokeToken"
+
+	const query = `
+	INSERT INTO revoked_tokens (jti, expires_at)
+	VALUES (?1, ?2)
+	ON CONFLICT (jti) DO NOTHING
+	`
+
+	slog.Debug("Query data",
+		slog.String("op", op),
+		slog.String("query_row", query),
+		slog.String("jti", jti),
+	)
+
+	ctx, cancel := r.withTimeout(ctx)
+	defer cancel()
+
+	if _, err := r.conn(ctx).ExecContext(ctx, query, jti, expiresAt.UTC()); err != nil {
+		slog.Error("Database error",
+			slog.String("op", op),
+			slog.String("jti", jti),
+			slog.String("error", err.Error()),
+		)
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (r Repository) IsTokenRevoked(ctx context.Context, jti string) (bool, error) {
+	const op = "repository/sqlite/oauth.go/IsTokenRevoked"
+
+	const query = `
+	SELECT EXISTS (SELECT 1 FROM revoked_tokens WHERE jti = ?1)
+	`
+
+	ctx, cancel := r.withTimeout(ctx)
+	defer cancel()
+
+	var revoked bool
+	if err := r.conn(ctx).QueryRowContext(ctx, query, jti).Scan(&revoked); err != nil {
+		slog.Error("Database error",
+			slog.String("op", op),
+			slog.String("jti", jti),
+			slog.String("error", err.Error()),
+		)
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return revoked, nil
+}
+
+func (r Repository) PurgeRevokedTokens(ctx context.Context, expiredBefore time.Time) (int64, error) {
+	const op = "repository/sqlite/oauth.go/PurgeRevokedTokens"
+
+	const query = `
+	DELETE FROM revoked_tokens
+	WHERE expires_at < ?1
+	`
+
+	ctx, cancel := r.withTimeout(ctx)
+	defer cancel()
+
+	row, err := r.conn(ctx).ExecContext(ctx, query, expiredBefore.UTC())
+	if err != nil {
+		slog.Error("Database error",
+			slog.String("op", op),
+			slog.String("error", err.Error()),
+		)
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	purged, _ := row.RowsAffected()
+	return purged, nil
+}
+
+func (r Repository) CreateAuthorizationCode(ctx context.Context, code *models.AuthorizationCode) error {
+	const op = "repository/sqlite/oauth.go/CreateAuthorizationCode"
+
+	const query = `
+	INSERT INTO authorization_codes (code_hash, client_id, user_id, redirect_uri, scope, code_challenge, nonce, auth_time, session_id, expires_at, created_at)
+	VALUES (?1, ?2, ?3, ?4, ?5, ?6, ?7, ?8, ?9, ?10, ?11)
+	`
+
+	slog.Debug("Query data",
+		slog.String("op", op),
+		slog.String("query_row", query),
+		slog.String("client_id", code.ClientID),
+		slog.String("user_id", code.UserID),
+	)
+
+	ctx, cancel := r.withTimeout(ctx)
+	defer cancel()
+
+	_, err := r.conn(ctx).ExecContext(
+		ctx,
+		query,
+		code.CodeHash,
+		code.ClientID,
+		code.UserID,
+		code.RedirectURI,
+		code.Scope,
+		code.CodeChallenge,
+		code.Nonce,
+		code.AuthTime.UTC(),
+		code.SessionID,
+		code.ExpiresAt.UTC(),
+		code.CreatedAt.UTC(),
+	)
+	if err != nil {
+		slog.Error("Failed to create authorization code",
+			slog.String("op", op),
+			slog.String("error", err.Error()),
+		)
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// ConsumeAuthorizationCode deletes the code and returns it, a second call
+// with the same code finds nothing
+func (r Repository) ConsumeAuthorizationCode(ctx context.Context, codeHash string) (*models.AuthorizationCode, error) {
+	const op = "repository/sqlite/oauth.go/ConsumeAuthorizationCode"
+
+	const query = `
+	DELETE FROM authorization_codes
+	WHERE code_hash = ?1
+	RETURNING code_hash, client_id, user_id, redirect_uri, scope, code_challenge, nonce, auth_time, session_id, expires_at, created_at
+	`
+
+	slog.Debug("Query data",
+		slog.String("op", op),
+		slog.String("query_row", query),
+	)
+
+	ctx, cancel := r.withTimeout(ctx)
+	defer cancel()
+
+	var (
+		code     models.AuthorizationCode
+		authTime sql.NullTime
+	)
+	err := r.conn(ctx).QueryRowContext(ctx, query, codeHash).Scan(
+		&code.CodeHash,
+		&code.ClientID,
+		&code.UserID,
+		&code.RedirectURI,
+		&code.Scope,
+		&code.CodeChallenge,
+		&code.Nonce,
+		&authTime,
+		&code.SessionID,
+		&code.ExpiresAt,
+		&code.CreatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			slog.Debug("Authorization code not found",
+				slog.String("op", op),
+			)
+			return nil, nil
+		}
+
+		slog.Error("Database error",
+			slog.String("op", op),
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	code.AuthTime = authTime.Time
+
+	return &code, nil
+}
+
+func (r Repository) PurgeAuthorizationCodes(ctx context.Context, expiredBefore time.Time) (int64, error) {
+	const op = "repository/sqlite/oauth.go/PurgeAuthorizationCodes"
+
+	const query = `
+	DELETE FROM authorization_codes
+	WHERE expires_at < ?1
+	`
+
+	ctx, cancel := r.withTimeout(ctx)
+	defer cancel()
+
+	row, err := r.conn(ctx).ExecContext(ctx, query, expiredBefore.UTC())
+	if err != nil {
+		slog.Error("Database error",
+			slog.String("op", op),
+			slog.String("error", err.Error()),
+		)
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	purged, _ := row.RowsAffected()
+	return purged, nil
+}
+
+func (r Repository) FindConsent(ctx context.Context, userID, clientID string) (*models.Consent, error) {
+	const op = "repository/sqlite/oauth.go/FindConsent"
+
+	const query = `
+	SELECT user_id, client_id, scopes, granted_at FROM oauth_consents
+	WHERE user_id = ?1 AND client_id = ?2
+	`
+
+	slog.Debug("Query data",
+		slog.String("op", op),
+		slog.String("query_row", query),
+		slog.String("user_id", userID),
+		slog.String("client_id", clientID),
+	)
+
+	ctx, cancel := r.withTimeout(ctx)
+	defer cancel()
+
+	var (
+		consent models.Consent
+		scopes  string
+	)
+	err := r.conn(ctx).QueryRowContext(ctx, query, userID, clientID).Scan(
+		&consent.UserID,
+		&consent.ClientID,
+		&scopes,
+		&consent.GrantedAt,
+	)
+	if err == nil {
+		err = json.Unmarshal([]byte(scopes), &consent.Scopes)
+	}
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+
+		slog.Error("Database error",
+			slog.String("op", op),
+			slog.String("user_id", userID),
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &consent, nil
+}
+
+// SaveConsent creates the consent or replaces its scopes
+func (r Repository) SaveConsent(ctx context.Context, consent *models.Consent) error {
+	const op = "repository/sqlite/oauth.go/SaveConsent"
+
+	const query = `
+	INSERT INTO oauth_consents (user_id, client_id, scopes, granted_at)
+	VALUES (?1, ?2, ?3, ?4)
+	ON CONFLICT (user_id, client_id) DO UPDATE
+	SET scopes = excluded.scopes, granted_at = excluded.granted_at
+	`
+
+	slog.Debug("Query data",
+		slog.String("op", op),
+		slog.String("query_row", query),
+		slog.String("user_id", consent.UserID),
+		slog.String("client_id", consent.ClientID),
+	)
+
+	ctx, cancel := r.withTimeout(ctx)
+	defer cancel()
+
+	_, err := r.conn(ctx).ExecContext(ctx, query, consent.UserID, consent.ClientID, encodeStrings(consent.Scopes), consent.GrantedAt.UTC())
+	if err != nil {
+		slog.Error("Failed to save consent",
+			slog.String("op", op),
+			slog.String("user_id", consent.UserID),
+			slog.String("error", err.Error()),
+		)
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// encodeStrings renders a JSON array column, nil becomes [] instead of null
+func encodeStrings(values []string) string {
+	if values == nil {
+		values = []string{}
+	}
+
+	encoded, _ := json.Marshal(values)
+	return string(encoded)
+}
@@ -0,0 +1,230 @@
+package sqlite
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"time"
+
+	"github.com/alonsoF100/authorization-service/internal/models"
+)
+
+const outboxEventColumns = `id, type, aggregate_id, payload, created_at, attempts, next_attempt_at, last_error, published_at`
+
+func (r Repository) CreateOutboxEvent(ctx context.Context, event *models.OutboxEvent) error {
+	const op = "repository/sqlite/outbox.go/CreateOutboxEvent"
+
+	const query = `
+	INSERT INTO outbox_events (type, aggregate_id, payload, created_at, next_attempt_at)
+	VALUES (?1, ?2, ?3, ?4, ?5)
+	`
+
+	slog.Debug("Query data",
+		slog.String("op", op),
+		slog.String("query_row", query),
+		slog.String("type", event.Type),
+		slog.String("aggregate_id", event.AggregateID),
+	)
+
+	ctx, cancel := r.withTimeout(ctx)
+	defer cancel()
+
+	result, err := r.conn(ctx).ExecContext(
+		ctx,
+		query,
+		event.Type,
+		event.AggregateID,
+		string(event.Payload),
+		event.CreatedAt.UTC(),
+		event.NextAttemptAt.UTC(),
+	)
+	if err == nil {
+		event.ID, err = result.LastInsertId()
+	}
+	if err != nil {
+		slog.Error("Failed to create outbox event",
+			slog.String("op", op),
+			slog.String("type", event.Type),
+			slog.String("error", err.Error()),
+		)
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// ClaimOutboxEvents leases up to limit due events until claimedUntil. Only
+// the oldest pending event of each aggregate is claimed, the next one
+// waits until it is published.
+func (r Repository) ClaimOutboxEvents(ctx context.Context, now, claimedUntil time.Time, limit int) ([]models.OutboxEvent, error) {
+	const op = "repository/sqlite/outbox.go/ClaimOutboxEvents"
+
+	const query = `
+	UPDATE outbox_events SET attempts = attempts + 1, next_attempt_at = ?2
+	WHERE id IN (
+		SELECT e.id FROM outbox_events e
+		WHERE e.published_at IS NULL
+			AND e.next_attempt_at <= ?1
+			AND NOT EXISTS (
+				SELECT 1 FROM outbox_events p
+				WHERE p.aggregate_id = e.aggregate_id AND p.published_at IS NULL AND p.id < e.id
+			)
+		ORDER BY e.id
+		LIMIT ?3
+	)
+	RETURNING ` + outboxEventColumns
+
+	slog.Debug("Query data",
+		slog.String("op", op),
+		slog.String("query_row", query),
+	)
+
+	ctx, cancel := r.withTimeout(ctx)
+	defer cancel()
+
+	rows, err := r.conn(ctx).QueryContext(ctx, query, now.UTC(), claimedUntil.UTC(), limit)
+	if err != nil {
+		slog.Error("Database error",
+			slog.String("op", op),
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var events []models.OutboxEvent
+	for rows.Next() {
+		event, err := scanOutboxEvent(rows)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		events = append(events, *event)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// RETURNING doesn't keep the order of the subquery
+	slices.SortFunc(events, func(a, b models.OutboxEvent) int {
+		return cmp.Compare(a.ID, b.ID)
+	})
+
+	return events, nil
+}
+
+func (r Repository) MarkOutboxEventPublished(ctx context.Context, id int64, publishedAt time.Time) error {
+	const op = "repository/sqlite/outbox.go/MarkOutboxEventPublished"
+
+	const query = `
+	UPDATE outbox_events SET published_at = ?2, last_error = ''
+	WHERE id = ?1
+	`
+
+	slog.Debug("Query data",
+		slog.String("op", op),
+		slog.String("query_row", query),
+		slog.Int64("id", id),
+	)
+
+	ctx, cancel := r.withTimeout(ctx)
+	defer cancel()
+
+	if _, err := r.conn(ctx).ExecContext(ctx, query, id, publishedAt.UTC()); err != nil {
+		slog.Error("Database error",
+			slog.String("op", op),
+			slog.Int64("id", id),
+			slog.String("error", err.Error()),
+		)
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// RetryOutboxEvent records a failed delivery, the event is claimed again
+// at nextAttemptAt
+func (r Repository) RetryOutboxEvent(ctx context.Context, id int64, nextAttemptAt time.Time, lastError string) error {
+	const op = "repository/sqlite/outbox.go/RetryOutboxEvent"
+
+	const query = `
+	UPDATE outbox_events SET next_attempt_at = ?2, last_error = ?3
+	WHERE id = ?1
+	`
+
+	slog.Debug("Query data",
+		slog.String("op", op),
+		slog.String("query_row", query),
+		slog.Int64("id", id),
+	)
+
+	ctx, cancel := r.withTimeout(ctx)
+	defer cancel()
+
+	if _, err := r.conn(ctx).ExecContext(ctx, query, id, nextAttemptAt.UTC(), lastError); err != nil {
+		slog.Error("Database error",
+			slog.String("op", op),
+			slog.Int64("id", id),
+			slog.String("error", err.Error()),
+		)
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// PurgeOutboxEvents deletes events published before the given time
+func (r Repository) PurgeOutboxEvents(ctx context.Context, publishedBefore time.Time) (int64, error) {
+	const op = "repository/sqlite/outbox.go/PurgeOutboxEvents"
+
+	const query = `
+	DELETE FROM outbox_events
+	WHERE published_at IS NOT NULL AND published_at < ?1
+	`
+
+	slog.Debug("Query data",
+		slog.String("op", op),
+		slog.String("query_row", query),
+	)
+
+	ctx, cancel := r.withTimeout(ctx)
+	defer cancel()
+
+	result, err := r.conn(ctx).ExecContext(ctx, query, publishedBefore.UTC())
+	if err != nil {
+		slog.Error("Database error",
+			slog.String("op", op),
+			slog.String("error", err.Error()),
+		)
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	purged, _ := result.RowsAffected()
+	return purged, nil
+}
+
+func scanOutboxEvent(row rowScanner) (*models.OutboxEvent, error) {
+	var (
+		event   models.OutboxEvent
+		payload string
+	)
+	err := row.Scan(
+		&event.ID,
+		&event.Type,
+		&event.AggregateID,
+		&payload,
+		&event.CreatedAt,
+		&event.Attempts,
+		&event.NextAttemptAt,
+		&event.LastError,
+		&event.PublishedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	event.Payload = []byte(payload)
+
+	return &event, nil
+}
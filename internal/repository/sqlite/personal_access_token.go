@@ -0,0 +1,233 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/alonsoF100/authorization-service/internal/apperrors"
+	"github.com/alonsoF100/authorization-service/internal/models"
+)
+
+func (r Repository) CreatePersonalAccessToken(ctx context.Context, token *models.PersonalAccessToken) error {
+	const op = "repository/sqlite/personal_access_token.go/CreatePersonalAccessToken"
+
+	const query = `
+	INSERT INTO personal_access_tokens (id, user_id, name, token_hash, scopes, expires_at, created_at)
+	VALUES (?1, ?2, ?3, ?4, ?5, ?6, ?7)
+	`
+
+	slog.Debug("Query data",
+		slog.String("op", op),
+		slog.String("query_row", query),
+		slog.String("id", token.ID),
+		slog.String("user_id", token.UserID),
+	)
+
+	ctx, cancel := r.withTimeout(ctx)
+	defer cancel()
+
+	var expiresAt *time.Time
+	if token.ExpiresAt != nil {
+		utc := token.ExpiresAt.UTC()
+		expiresAt = &utc
+	}
+
+	_, err := r.conn(ctx).ExecContext(
+		ctx,
+		query,
+		token.ID,
+		token.UserID,
+		token.Name,
+		token.TokenHash,
+		encodeStrings(token.Scopes),
+		expiresAt,
+		token.CreatedAt.UTC(),
+	)
+	if err != nil {
+		slog.Error("Failed to create personal access token",
+			slog.String("op", op),
+			slog.String("user_id", token.UserID),
+			slog.String("error", err.Error()),
+		)
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// ListPersonalAccessTokens returns the tokens of a user, newest first
+func (r Repository) ListPersonalAccessTokens(ctx context.Context, userID string) ([]models.PersonalAccessToken, error) {
+	const op = "repository/sqlite/personal_access_token.go/ListPersonalAccessTokens"
+
+	const query = `
+	SELECT id, user_id, name, token_hash, scopes, expires_at, last_used_at, last_used_ip, created_at
+	FROM personal_access_tokens
+	WHERE user_id = ?1
+	ORDER BY created_at DESC, id
+	`
+
+	slog.Debug("Query data",
+		slog.String("op", op),
+		slog.String("query_row", query),
+		slog.String("user_id", userID),
+	)
+
+	ctx, cancel := r.withTimeout(ctx)
+	defer cancel()
+
+	rows, err := r.conn(ctx).QueryContext(ctx, query, userID)
+	if err != nil {
+		slog.Error("Database error",
+			slog.String("op", op),
+			slog.String("user_id", userID),
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var tokens []models.PersonalAccessToken
+	for rows.Next() {
+		token, err := scanPersonalAccessToken(rows)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		tokens = append(tokens, *token)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return tokens, nil
+}
+
+func (r Repository) FindPersonalAccessTokenByHash(ctx context.Context, tokenHash string) (*models.PersonalAccessToken, error) {
+	const op = "repository/sqlite/personal_access_token.go/FindPersonalAccessTokenByHash"
+
+	const query = `
+	SELECT id, user_id, name, token_hash, scopes, expires_at, last_used_at, last_used_ip, created_at
+	FROM personal_access_tokens
+	WHERE token_hash = ?1
+	`
+
+	slog.Debug("Query data",
+		slog.String("op", op),
+		slog.String("query_row", query),
+	)
+
+	ctx, cancel := r.withTimeout(ctx)
+	defer cancel()
+
+	token, err := scanPersonalAccessToken(r.conn(ctx).QueryRowContext(ctx, query, tokenHash))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+
+		slog.Error("Database error",
+			slog.String("op", op),
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return token, nil
+}
+
+// DeletePersonalAccessToken only deletes the token when it belongs to the
+// user
+func (r Repository) DeletePersonalAccessToken(ctx context.Context, userID, tokenID string) error {
+	const op = "repository/sqlite/personal_access_token.go/DeletePersonalAccessToken"
+
+	const query = `
+	DELETE FROM personal_access_tokens
+	WHERE id = ?1 AND user_id = ?2
+	`
+
+	slog.Debug("Query data",
+		slog.String("op", op),
+		slog.String("query_row", query),
+		slog.String("id", tokenID),
+		slog.String("user_id", userID),
+	)
+
+	return r.updatePersonalAccessToken(ctx, op, query, tokenID, userID)
+}
+
+func (r Repository) TouchPersonalAccessToken(ctx context.Context, tokenID string, usedAt time.Time, ip string) error {
+	const op = "repository/sqlite/personal_access_token.go/TouchPersonalAccessToken"
+
+	const query = `
+	UPDATE personal_access_tokens SET last_used_at = ?2, last_used_ip = ?3
+	WHERE id = ?1
+	`
+
+	slog.Debug("Query data",
+		slog.String("op", op),
+		slog.String("query_row", query),
+		slog.String("id", tokenID),
+	)
+
+	return r.updatePersonalAccessToken(ctx, op, query, tokenID, usedAt.UTC(), ip)
+}
+
+func (r Repository) updatePersonalAccessToken(ctx context.Context, op, query string, args ...any) error {
+	ctx, cancel := r.withTimeout(ctx)
+	defer cancel()
+
+	row, err := r.conn(ctx).ExecContext(ctx, query, args...)
+	if err != nil {
+		slog.Error("Database error",
+			slog.String("op", op),
+			slog.String("error", err.Error()),
+		)
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	rowsAffected, err := row.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if rowsAffected == 0 {
+		return apperrors.ErrTokenNotFound
+	}
+
+	return nil
+}
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanPersonalAccessToken(row rowScanner) (*models.PersonalAccessToken, error) {
+	var (
+		token  models.PersonalAccessToken
+		scopes string
+	)
+	err := row.Scan(
+		&token.ID,
+		&token.UserID,
+		&token.Name,
+		&token.TokenHash,
+		&scopes,
+		&token.ExpiresAt,
+		&token.LastUsedAt,
+		&token.LastUsedIP,
+		&token.CreatedAt,
+	)
+	if err == nil {
+		err = json.Unmarshal([]byte(scopes), &token.Scopes)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &token, nil
+}
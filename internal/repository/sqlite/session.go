@@ -0,0 +1,271 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/alonsoF100/authorization-service/internal/apperrors"
+	"github.com/alonsoF100/authorization-service/internal/models"
+)
+
+func (r Repository) CreateSession(ctx context.Context, session *models.Session) error {
+	const op = "repository/sqlite/session.go/CreateSession"
+
+	const query = `
+	INSERT INTO sessions (id, user_id, device_name, user_agent, ip, created_at, last_seen_at, expires_at)
+	VALUES (?1, ?2, ?3, ?4, ?5, ?6, ?7, ?8)
+	`
+
+	slog.Debug("Query data",
+		slog.String("op", op),
+		slog.String("query_row", query),
+		slog.String("id", session.ID),
+		slog.String("user_id", session.UserID),
+	)
+
+	ctx, cancel := r.withTimeout(ctx)
+	defer cancel()
+
+	_, err := r.conn(ctx).ExecContext(
+		ctx,
+		query,
+		session.ID,
+		session.UserID,
+		session.DeviceName,
+		session.UserAgent,
+		session.IP,
+		session.CreatedAt.UTC(),
+		session.LastSeenAt.UTC(),
+		session.ExpiresAt.UTC(),
+	)
+	if err != nil {
+		slog.Error("Failed to create session",
+			slog.String("op", op),
+			slog.String("user_id", session.UserID),
+			slog.String("error", err.Error()),
+		)
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (r Repository) FindSessionByID(ctx context.Context, sessionID string) (*models.Session, error) {
+	const op = "repository/sqlite/session.go/FindSessionByID"
+
+	const query = `
+	SELECT id, user_id, device_name, user_agent, ip, created_at, last_seen_at, expires_at
+	FROM sessions
+	WHERE id = ?1
+	`
+
+	slog.Debug("Query data",
+		slog.String("op", op),
+		slog.String("query_row", query),
+		slog.String("id", sessionID),
+	)
+
+	ctx, cancel := r.withTimeout(ctx)
+	defer cancel()
+
+	session, err := scanSession(r.conn(ctx).QueryRowContext(ctx, query, sessionID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+
+		slog.Error("Database error",
+			slog.String("op", op),
+			slog.String("id", sessionID),
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return session, nil
+}
+
+// ListSessions returns the sessions of a user that have not expired at now,
+// newest first
+func (r Repository) ListSessions(ctx context.Context, userID string, now time.Time) ([]models.Session, error) {
+	const op = "repository/sqlite/session.go/ListSessions"
+
+	const query = `
+	SELECT id, user_id, device_name, user_agent, ip, created_at, last_seen_at, expires_at
+	FROM sessions
+	WHERE user_id = ?1 AND expires_at > ?2
+	ORDER BY created_at DESC, id
+	`
+
+	slog.Debug("Query data",
+		slog.String("op", op),
+		slog.String("query_row", query),
+		slog.String("user_id", userID),
+	)
+
+	ctx, cancel := r.withTimeout(ctx)
+	defer cancel()
+
+	rows, err := r.conn(ctx).QueryContext(ctx, query, userID, now.UTC())
+	if err != nil {
+		slog.Error("Database error",
+			slog.String("op", op),
+			slog.String("user_id", userID),
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var sessions []models.Session
+	for rows.Next() {
+		session, err := scanSession(rows)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		sessions = append(sessions, *session)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return sessions, nil
+}
+
+// DeleteSession only deletes the session when it belongs to the user
+func (r Repository) DeleteSession(ctx context.Context, userID, sessionID string) error {
+	const op = "repository/sqlite/session.go/DeleteSession"
+
+	const query = `
+	DELETE FROM sessions
+	WHERE id = ?1 AND user_id = ?2
+	`
+
+	slog.Debug("Query data",
+		slog.String("op", op),
+		slog.String("query_row", query),
+		slog.String("id", sessionID),
+		slog.String("user_id", userID),
+	)
+
+	return r.updateSession(ctx, op, query, sessionID, userID)
+}
+
+// DeleteUserSessions signs the user out everywhere
+func (r Repository) DeleteUserSessions(ctx context.Context, userID string) error {
+	const op = "repository/sqlite/session.go/DeleteUserSessions"
+
+	const query = `
+	DELETE FROM sessions
+	WHERE user_id = ?1
+	`
+
+	slog.Debug("Query data",
+		slog.String("op", op),
+		slog.String("query_row", query),
+		slog.String("user_id", userID),
+	)
+
+	ctx, cancel := r.withTimeout(ctx)
+	defer cancel()
+
+	if _, err := r.conn(ctx).ExecContext(ctx, query, userID); err != nil {
+		slog.Error("Database error",
+			slog.String("op", op),
+			slog.String("error", err.Error()),
+		)
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (r Repository) TouchSession(ctx context.Context, sessionID string, seenAt time.Time) error {
+	const op = "repository/sqlite/session.go/TouchSession"
+
+	const query = `
+	UPDATE sessions SET last_seen_at = ?2
+	WHERE id = ?1
+	`
+
+	slog.Debug("Query data",
+		slog.String("op", op),
+		slog.String("query_row", query),
+		slog.String("id", sessionID),
+	)
+
+	return r.updateSession(ctx, op, query, sessionID, seenAt.UTC())
+}
+
+func (r Repository) PurgeSessions(ctx context.Context, expiredBefore time.Time) (int64, error) {
+	const op = "repository/sqlite/session.go/PurgeSessions"
+
+	const query = `
+	DELETE FROM sessions
+	WHERE expires_at < ?1
+	`
+
+	ctx, cancel := r.withTimeout(ctx)
+	defer cancel()
+
+	row, err := r.conn(ctx).ExecContext(ctx, query, expiredBefore.UTC())
+	if err != nil {
+		slog.Error("Database error",
+			slog.String("op", op),
+			slog.String("error", err.Error()),
+		)
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	purged, _ := row.RowsAffected()
+	return purged, nil
+}
+
+func (r Repository) updateSession(ctx context.Context, op, query string, args ...any) error {
+	ctx, cancel := r.withTimeout(ctx)
+	defer cancel()
+
+	row, err := r.conn(ctx).ExecContext(ctx, query, args...)
+	if err != nil {
+		slog.Error("Database error",
+			slog.String("op", op),
+			slog.String("error", err.Error()),
+		)
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	rowsAffected, err := row.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if rowsAffected == 0 {
+		return apperrors.ErrSessionNotFound
+	}
+
+	return nil
+}
+
+func scanSession(row rowScanner) (*models.Session, error) {
+	var session models.Session
+	err := row.Scan(
+		&session.ID,
+		&session.UserID,
+		&session.DeviceName,
+		&session.UserAgent,
+		&session.IP,
+		&session.CreatedAt,
+		&session.LastSeenAt,
+		&session.ExpiresAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &session, nil
+}
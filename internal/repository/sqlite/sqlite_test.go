@@ -0,0 +1,85 @@
+package sqlite_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/alonsoF100/authorization-service/internal/config"
+	"github.com/alonsoF100/authorization-service/internal/keyseal"
+	"github.com/alonsoF100/authorization-service/internal/models"
+	"github.com/alonsoF100/authorization-service/internal/repository"
+	"github.com/alonsoF100/authorization-service/internal/repository/repositorytest"
+	"github.com/alonsoF100/authorization-service/internal/repository/sqlite"
+	"github.com/stretchr/testify/require"
+)
+
+func newConfig(t *testing.T) *config.Config {
+	return &config.Config{
+		Database: config.DatabaseConfig{
+			Driver: config.DriverSQLite,
+			Path:   filepath.Join(t.TempDir(), "auth.db"),
+		},
+		JWT: config.JWTConfig{
+			KeyEncryptionKey: "keyEncryptionKeykeyEncryptionKey",
+		},
+	}
+}
+
+func TestConformance(t *testing.T) {
+	repositorytest.Run(t, func(t *testing.T) repository.Repository {
+		ctx := context.Background()
+		cfg := newConfig(t)
+
+		require.NoError(t, sqlite.Migrate(ctx, cfg, "up"))
+
+		db, err := sqlite.Open(ctx, cfg)
+		require.NoError(t, err)
+		t.Cleanup(func() { db.Close() })
+
+		return sqlite.New(db, cfg)
+	})
+}
+
+func TestMigrate(t *testing.T) {
+	ctx := context.Background()
+	cfg := newConfig(t)
+
+	for _, command := range []string{"up", "status", "version", "redo", "down"} {
+		require.NoError(t, sqlite.Migrate(ctx, cfg, command), command)
+	}
+
+	require.Error(t, sqlite.Migrate(ctx, cfg, "sideways"))
+}
+
+func TestSigningKeysEncrypted(t *testing.T) {
+	ctx := context.Background()
+	cfg := newConfig(t)
+
+	require.NoError(t, sqlite.Migrate(ctx, cfg, "up"))
+	db, err := sqlite.Open(ctx, cfg)
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	key := &models.SigningKey{ID: "kid1", Algorithm: "ES256", PrivateKey: privateKey, CreatedAt: time.Now()}
+	require.NoError(t, sqlite.New(db, cfg).CreateSigningKey(ctx, key))
+
+	var stored string
+	require.NoError(t, db.QueryRowContext(ctx, "SELECT private_key FROM signing_keys WHERE id = 'kid1'").Scan(&stored))
+	require.NotContains(t, stored, "BEGIN EC PRIVATE KEY")
+
+	// without the key encryption key the key can not be stored or read
+	repo := sqlite.New(db, &config.Config{})
+
+	_, err = repo.ListSigningKeys(ctx)
+	require.ErrorIs(t, err, keyseal.ErrNoKeyEncryptionKey)
+
+	key.ID = "kid2"
+	require.ErrorIs(t, repo.CreateSigningKey(ctx, key), keyseal.ErrNoKeyEncryptionKey)
+}
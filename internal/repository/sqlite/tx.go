@@ -0,0 +1,63 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+)
+
+// querier is implemented by both the database and a transaction
+type querier interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+type txContextKey struct{}
+
+// InTx runs fn in a transaction, repository calls made with the ctx passed
+// to fn join it and a nested InTx joins the outer transaction. The pool has
+// a single connection, so fn must not use a ctx without the transaction.
+func (r Repository) InTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	const op = "repository/sqlite/tx.go/InTx"
+
+	if _, ok := txFrom(ctx); ok {
+		return fn(ctx)
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := fn(context.WithValue(ctx, txContextKey{}, tx)); err != nil {
+		if rollbackErr := tx.Rollback(); rollbackErr != nil {
+			slog.Error("Failed to roll back transaction",
+				slog.String("op", op),
+				slog.String("error", rollbackErr.Error()),
+			)
+		}
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// conn returns the transaction of ctx, or the database outside of one
+func (r Repository) conn(ctx context.Context) querier {
+	if tx, ok := txFrom(ctx); ok {
+		return tx
+	}
+
+	return r.db
+}
+
+func txFrom(ctx context.Context) (*sql.Tx, bool) {
+	tx, ok := ctx.Value(txContextKey{}).(*sql.Tx)
+	return tx, ok
+}
@@ -0,0 +1,208 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/alonsoF100/authorization-service/internal/apperrors"
+	"github.com/alonsoF100/authorization-service/internal/models"
+)
+
+func (r Repository) FindByID(ctx context.Context, userID string) (*models.User, error) {
+	const op = "repository/sqlite/user.go/FindByID"
+
+	const query = `
+	SELECT id, nickname, email, roles, allowed_ips, disabled_at FROM users 
+	WHERE id = ?1
+	`
+
+	slog.Debug("Query data",
+		slog.String("op", op),
+		slog.String("query_row", query),
+		slog.String("id", userID),
+	)
+
+	ctx, cancel := r.withTimeout(ctx)
+	defer cancel()
+
+	var (
+		user       models.User
+		roles      string
+		allowedIPs string
+	)
+	err := r.conn(ctx).QueryRowContext(
+		ctx,
+		query,
+		userID,
+	).Scan(
+		&user.ID,
+		&user.Nickname,
+		&user.Email,
+		&roles,
+		&allowedIPs,
+		&user.DisabledAt,
+	)
+	if err == nil {
+		err = json.Unmarshal([]byte(roles), &user.Roles)
+	}
+	if err == nil {
+		err = json.Unmarshal([]byte(allowedIPs), &user.AllowedIPs)
+	}
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			slog.Debug("User not found by id",
+				slog.String("op", op),
+				slog.String("user_id", userID),
+			)
+			return nil, nil
+		}
+
+		slog.Error("Database error",
+			slog.String("op", op),
+			slog.String("user_id", userID),
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	slog.Debug("User was successfully founded",
+		slog.String("op", op),
+		slog.String("nickname", user.Nickname),
+		slog.String("email", user.Email),
+		slog.String("id", user.ID),
+	)
+
+	return &user, nil
+}
+
+func (r Repository) DeleteUser(ctx context.Context, userID string) error {
+	const op = "repository/sqlite/user.go/DeleteUser"
+
+	const query = `
+	DELETE FROM users 
+	WHERE id = ?1
+	`
+
+	slog.Debug("Query data",
+		slog.String("op", op),
+		slog.String("query_row", query),
+		slog.String("id", userID),
+	)
+
+	return r.updateUser(ctx, op, query, userID)
+}
+
+func (r Repository) UpdatePassword(ctx context.Context, userID, passwordHash string, updatedAt time.Time) error {
+	const op = "repository/sqlite/user.go/UpdatePassword"
+
+	const query = `
+	UPDATE users SET password = ?2, updated_at = ?3
+	WHERE id = ?1
+	`
+
+	slog.Debug("Query data",
+		slog.String("op", op),
+		slog.String("query_row", query),
+		slog.String("id", userID),
+		slog.Int("password_length", len(passwordHash)),
+	)
+
+	return r.updateUser(ctx, op, query, userID, passwordHash, updatedAt.UTC())
+}
+
+func (r Repository) DisableUser(ctx context.Context, userID string, disabledAt time.Time) error {
+	const op = "repository/sqlite/user.go/DisableUser"
+
+	const query = `
+	UPDATE users SET disabled_at = ?2, updated_at = ?2
+	WHERE id = ?1
+	`
+
+	slog.Debug("Query data",
+		slog.String("op", op),
+		slog.String("query_row", query),
+		slog.String("id", userID),
+		slog.Time("disabled_at", disabledAt),
+	)
+
+	return r.updateUser(ctx, op, query, userID, disabledAt.UTC())
+}
+
+func (r Repository) AddRole(ctx context.Context, userID, role string, updatedAt time.Time) error {
+	const op = "repository/sqlite/user.go/AddRole"
+
+	const query = `
+	UPDATE users SET 
+		roles = CASE WHEN EXISTS (SELECT 1 FROM json_each(roles) WHERE value = ?2)
+			THEN roles ELSE json_insert(roles, '$[#]', ?2) END,
+		updated_at = ?3
+	WHERE id = ?1
+	`
+
+	slog.Debug("Query data",
+		slog.String("op", op),
+		slog.String("query_row", query),
+		slog.String("id", userID),
+		slog.String("role", role),
+	)
+
+	return r.updateUser(ctx, op, query, userID, role, updatedAt.UTC())
+}
+
+func (r Repository) SetAllowedIPs(ctx context.Context, userID string, allowedIPs []string, updatedAt time.Time) error {
+	const op = "repository/sqlite/user.go/SetAllowedIPs"
+
+	const query = `
+	UPDATE users SET 
+		allowed_ips = ?2,
+		updated_at = ?3
+	WHERE id = ?1
+	`
+
+	slog.Debug("Query data",
+		slog.String("op", op),
+		slog.String("query_row", query),
+		slog.String("id", userID),
+		slog.Int("allowed_ips", len(allowedIPs)),
+	)
+
+	return r.updateUser(ctx, op, query, userID, encodeStrings(allowedIPs), updatedAt.UTC())
+}
+
+func (r Repository) updateUser(ctx context.Context, op, query string, args ...any) error {
+	ctx, cancel := r.withTimeout(ctx)
+	defer cancel()
+
+	row, err := r.conn(ctx).ExecContext(ctx, query, args...)
+	if err != nil {
+		slog.Error("Database error",
+			slog.String("op", op),
+			slog.String("error", err.Error()),
+		)
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	rowsAffected, err := row.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if rowsAffected == 0 {
+		slog.Debug("User not found by id",
+			slog.String("op", op),
+		)
+		return apperrors.ErrUserNotFoundByID
+	}
+
+	slog.Debug("User was successfully updated",
+		slog.String("op", op),
+		slog.Int64("rows_affected", rowsAffected),
+	)
+
+	return nil
+}
@@ -0,0 +1,152 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/netip"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/alonsoF100/authorization-service/internal/apperrors"
+	"github.com/alonsoF100/authorization-service/internal/config"
+	"github.com/alonsoF100/authorization-service/internal/models"
+)
+
+// SetAllowedIPs limits sign ins and every use of the tokens of the user to
+// the given addresses and CIDR ranges, an empty list lifts the restriction. The
+// list has to keep the address of the request, so users can not lock
+// themselves out.
+func (s UserService) SetAllowedIPs(ctx context.Context, userID, ip string, allowedIPs []string) ([]string, error) {
+	const op = "service/allowed_ip.go/SetAllowedIPs"
+
+	normalized := make([]string, 0, len(allowedIPs))
+	for _, value := range allowedIPs {
+		prefix, err := config.ParsePrefix(value)
+		if err != nil {
+			slog.Debug("Invalid allowed ip",
+				slog.String("op", op),
+				slog.String("value", value),
+			)
+			return nil, apperrors.ErrInvalidIPRange
+		}
+		if !slices.Contains(normalized, prefix.String()) {
+			normalized = append(normalized, prefix.String())
+		}
+	}
+
+	if !ipAllowed(normalized, ip) {
+		return nil, apperrors.ErrIPLockout
+	}
+
+	err := s.userRepository.SetAllowedIPs(ctx, userID, normalized, time.Now())
+	if err != nil {
+		if errors.Is(err, apperrors.ErrUserNotFoundByID) {
+			return nil, apperrors.ErrUserNotFoundByID
+		}
+
+		slog.Error("Database error during allowed ips update",
+			slog.String("op", op),
+			slog.String("user_id", userID),
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	slog.Info("Allowed ips updated",
+		slog.String("op", op),
+		slog.String("user_id", userID),
+		slog.Int("count", len(normalized)),
+	)
+	record(ctx, s.auditor, models.AuditEvent{
+		Type:     models.AuditAllowedIPsChanged,
+		TargetID: userID,
+		Details:  map[string]string{"allowed_ips": strings.Join(normalized, ",")},
+	})
+
+	return normalized, nil
+}
+
+// CheckAllowedIP rejects a user token used from an address outside the
+// allowed ips of its owner, or after the owner was disabled. Tokens of
+// clients and service accounts are not limited, personal access tokens are
+// checked when they are authenticated.
+func (s AuthService) CheckAllowedIP(ctx context.Context, claims *models.Claims, ip string) error {
+	if claims.Principal() != models.PrincipalUser || claims.ID == "" {
+		return nil
+	}
+
+	owner, err := s.TokenOwner(ctx, claims.ID)
+	if err != nil {
+		return err
+	}
+
+	return checkTokenOwner(owner, claims, ip)
+}
+
+// TokenOwner loads the user a token was issued to for CheckAllowedIP
+func (s AuthService) TokenOwner(ctx context.Context, userID string) (*models.User, error) {
+	const op = "service/allowed_ip.go/TokenOwner"
+
+	user, err := s.authRepository.FindByID(ctx, userID)
+	if err != nil {
+		slog.Error("Database error during allowed ips check",
+			slog.String("op", op),
+			slog.String("user_id", userID),
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if user == nil {
+		return nil, apperrors.ErrInvalidToken
+	}
+
+	return user, nil
+}
+
+func checkTokenOwner(owner *models.User, claims *models.Claims, ip string) error {
+	const op = "service/allowed_ip.go/checkTokenOwner"
+
+	if owner.Disabled() {
+		slog.Info("Token of a disabled user",
+			slog.String("op", op),
+			slog.String("user_id", claims.ID),
+		)
+		return apperrors.ErrUserDisabled
+	}
+	if !ipAllowed(owner.AllowedIPs, ip) {
+		slog.Info("Token used from a not allowed address",
+			slog.String("op", op),
+			slog.String("user_id", claims.ID),
+			slog.String("ip", ip),
+		)
+		return apperrors.ErrIPNotAllowed
+	}
+
+	return nil
+}
+
+// ipAllowed reports whether ip is in one of the ranges, an empty list allows
+// every address
+func ipAllowed(allowedIPs []string, ip string) bool {
+	if len(allowedIPs) == 0 {
+		return true
+	}
+
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return false
+	}
+	addr = addr.Unmap()
+
+	for _, value := range allowedIPs {
+		prefix, err := netip.ParsePrefix(value)
+		if err == nil && prefix.Contains(addr) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,181 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/alonsoF100/authorization-service/internal/apperrors"
+	"github.com/alonsoF100/authorization-service/internal/models"
+	"github.com/alonsoF100/authorization-service/internal/service"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetAllowedIPs(t *testing.T) {
+	ctx := context.Background()
+	someErr := errors.New("database error")
+
+	tests := []struct {
+		name        string
+		ip          string
+		allowedIPs  []string
+		setupMocks  func(mockRepo *service.UserRepositoryMock)
+		expected    []string
+		expectedErr error
+	}{
+		{
+			name:       "normalized and deduplicated",
+			ip:         "203.0.113.7",
+			allowedIPs: []string{"203.0.113.7/24", "203.0.113.0/24", "2001:db8::1"},
+			setupMocks: func(mockRepo *service.UserRepositoryMock) {
+				mockRepo.SetAllowedIPsMock.Set(func(_ context.Context, userID string, allowedIPs []string, updatedAt time.Time) error {
+					require.Equal(t, "user123", userID)
+					require.Equal(t, []string{"203.0.113.0/24", "2001:db8::1/128"}, allowedIPs)
+					require.WithinDuration(t, time.Now(), updatedAt, time.Second)
+					return nil
+				})
+			},
+			expected: []string{"203.0.113.0/24", "2001:db8::1/128"},
+		},
+		{
+			name:       "empty list lifts the restriction",
+			ip:         "192.0.2.1",
+			allowedIPs: nil,
+			setupMocks: func(mockRepo *service.UserRepositoryMock) {
+				mockRepo.SetAllowedIPsMock.Return(nil)
+			},
+			expected: []string{},
+		},
+		{
+			name:        "invalid range",
+			ip:          "203.0.113.7",
+			allowedIPs:  []string{"203.0.113.0/33"},
+			setupMocks:  func(mockRepo *service.UserRepositoryMock) {},
+			expectedErr: apperrors.ErrInvalidIPRange,
+		},
+		{
+			name:        "current address missing",
+			ip:          "192.0.2.1",
+			allowedIPs:  []string{"203.0.113.0/24"},
+			setupMocks:  func(mockRepo *service.UserRepositoryMock) {},
+			expectedErr: apperrors.ErrIPLockout,
+		},
+		{
+			name:       "database error",
+			ip:         "203.0.113.7",
+			allowedIPs: []string{"203.0.113.0/24"},
+			setupMocks: func(mockRepo *service.UserRepositoryMock) {
+				mockRepo.SetAllowedIPsMock.Return(someErr)
+			},
+			expectedErr: someErr,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := minimock.NewController(t)
+			mockRepo := service.NewUserRepositoryMock(mc)
+			tt.setupMocks(mockRepo)
+
+			allowedIPs, err := service.NewUserService(mockRepo, nil).SetAllowedIPs(ctx, "user123", tt.ip, tt.allowedIPs)
+			if tt.expectedErr == nil {
+				require.NoError(t, err)
+				require.Equal(t, tt.expected, allowedIPs)
+				return
+			}
+			require.ErrorIs(t, err, tt.expectedErr)
+			require.Nil(t, allowedIPs)
+		})
+	}
+}
+
+func TestCheckAllowedIP(t *testing.T) {
+	ctx := context.Background()
+	someErr := errors.New("database error")
+	userClaims := &models.Claims{ID: "user123"}
+
+	tests := []struct {
+		name        string
+		claims      *models.Claims
+		ip          string
+		setupMocks  func(mockRepo *service.AuthRepositoryMock)
+		expectedErr error
+	}{
+		{
+			name:   "address in range",
+			claims: userClaims,
+			ip:     "203.0.113.7",
+			setupMocks: func(mockRepo *service.AuthRepositoryMock) {
+				mockRepo.FindByIDMock.Expect(ctx, "user123").Return(&models.User{ID: "user123", AllowedIPs: []string{"203.0.113.0/24"}}, nil)
+			},
+		},
+		{
+			name:   "no restriction",
+			claims: userClaims,
+			ip:     "192.0.2.1",
+			setupMocks: func(mockRepo *service.AuthRepositoryMock) {
+				mockRepo.FindByIDMock.Expect(ctx, "user123").Return(&models.User{ID: "user123"}, nil)
+			},
+		},
+		{
+			name:   "address not allowed",
+			claims: userClaims,
+			ip:     "192.0.2.1",
+			setupMocks: func(mockRepo *service.AuthRepositoryMock) {
+				mockRepo.FindByIDMock.Expect(ctx, "user123").Return(&models.User{ID: "user123", AllowedIPs: []string{"203.0.113.0/24"}}, nil)
+			},
+			expectedErr: apperrors.ErrIPNotAllowed,
+		},
+		{
+			name:       "client token is not limited",
+			claims:     &models.Claims{ID: "client123", PrincipalType: models.PrincipalClient},
+			ip:         "192.0.2.1",
+			setupMocks: func(mockRepo *service.AuthRepositoryMock) {},
+		},
+		{
+			name:   "user disabled",
+			claims: userClaims,
+			ip:     "203.0.113.7",
+			setupMocks: func(mockRepo *service.AuthRepositoryMock) {
+				disabledAt := time.Now()
+				mockRepo.FindByIDMock.Expect(ctx, "user123").Return(&models.User{ID: "user123", DisabledAt: &disabledAt}, nil)
+			},
+			expectedErr: apperrors.ErrUserDisabled,
+		},
+		{
+			name:   "user not found",
+			claims: userClaims,
+			ip:     "192.0.2.1",
+			setupMocks: func(mockRepo *service.AuthRepositoryMock) {
+				mockRepo.FindByIDMock.Expect(ctx, "user123").Return(nil, nil)
+			},
+			expectedErr: apperrors.ErrInvalidToken,
+		},
+		{
+			name:   "database error",
+			claims: userClaims,
+			ip:     "192.0.2.1",
+			setupMocks: func(mockRepo *service.AuthRepositoryMock) {
+				mockRepo.FindByIDMock.Expect(ctx, "user123").Return(nil, someErr)
+			},
+			expectedErr: someErr,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := minimock.NewController(t)
+			mockRepo := service.NewAuthRepositoryMock(mc)
+			tt.setupMocks(mockRepo)
+
+			err := service.NewAuthService(mockRepo, nil, nil, nil).CheckAllowedIP(ctx, tt.claims, tt.ip)
+			if tt.expectedErr == nil {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, tt.expectedErr)
+		})
+	}
+}
@@ -0,0 +1,336 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/alonsoF100/authorization-service/internal/apperrors"
+	"github.com/alonsoF100/authorization-service/internal/audit"
+	"github.com/alonsoF100/authorization-service/internal/models"
+)
+
+const (
+	defaultAuditLimit = 50
+	maxAuditLimit     = 500
+	auditTrailPage    = 1000
+)
+
+type AuditRepository interface {
+	InTx(ctx context.Context, fn func(ctx context.Context) error) error
+	CreateAuditEvent(ctx context.Context, event *models.AuditEvent) error
+	LastAuditEvent(ctx context.Context) (*models.AuditEvent, error)
+	// LockAuditHead returns the newest event and keeps other appends out
+	// until the transaction of ctx ends
+	LockAuditHead(ctx context.Context) (*models.AuditEvent, error)
+	ListAuditEvents(ctx context.Context, filter models.AuditFilter) ([]models.AuditEvent, error)
+	ListAuditTrail(ctx context.Context, afterID int64, limit int) ([]models.AuditEvent, error)
+	CreateAuditCheckpoint(ctx context.Context, checkpoint *models.AuditCheckpoint) error
+	LastAuditCheckpoint(ctx context.Context) (*models.AuditCheckpoint, error)
+	ListAuditCheckpoints(ctx context.Context) ([]models.AuditCheckpoint, error)
+}
+
+// AuditKeys signs checkpoints with the active JWT signing key. Checking
+// them takes every key ever used, retired ones too.
+type AuditKeys interface {
+	ActiveKey() *models.SigningKey
+	List(ctx context.Context) ([]models.SigningKey, error)
+}
+
+// Auditor records security events, usually AuditService. Services take a
+// nil Auditor when nothing should be recorded.
+type Auditor interface {
+	Record(ctx context.Context, event models.AuditEvent)
+}
+
+type AuditService struct {
+	auditRepository AuditRepository
+	keys            AuditKeys
+}
+
+// NewAuditService takes nil keys when no checkpoints are signed or
+// checked
+func NewAuditService(repository AuditRepository, keys AuditKeys) *AuditService {
+	return &AuditService{
+		auditRepository: repository,
+		keys:            keys,
+	}
+}
+
+// Record appends the event to the audit trail. The address, user agent and
+// request id come from the audit source of the context, so does the actor
+// when the request is authenticated. A failed write is logged and not
+// returned, the audited action has already happened.
+func (s *AuditService) Record(ctx context.Context, event models.AuditEvent) {
+	const op = "service/audit.go/Record"
+
+	source := audit.SourceFrom(ctx)
+	if source.ActorID != "" {
+		event.ActorType = source.ActorType
+		event.ActorID = source.ActorID
+	}
+	event.IP = source.IP
+	event.UserAgent = source.UserAgent
+	event.RequestID = source.RequestID
+	if event.Outcome == "" {
+		event.Outcome = models.AuditSuccess
+	}
+	// the databases keep microseconds, the hash has to survive the round
+	// trip
+	event.CreatedAt = time.Now().UTC().Truncate(time.Microsecond)
+
+	// audit writes must not be cancelled together with the request they
+	// describe
+	ctx = context.WithoutCancel(ctx)
+	if err := s.append(ctx, &event); err != nil {
+		slog.Error("Failed to record audit event",
+			slog.String("op", op),
+			slog.String("type", event.Type),
+			slog.String("outcome", event.Outcome),
+			slog.String("actor_id", event.ActorID),
+			slog.String("target_id", event.TargetID),
+			slog.String("error", err.Error()),
+		)
+	}
+}
+
+// append chains the event to the newest one. The head stays locked until
+// the event is written, so concurrent appends of any instance can't fork
+// the chain.
+func (s *AuditService) append(ctx context.Context, event *models.AuditEvent) error {
+	return s.auditRepository.InTx(ctx, func(ctx context.Context) error {
+		last, err := s.auditRepository.LockAuditHead(ctx)
+		if err != nil {
+			return err
+		}
+
+		event.PrevHash = audit.GenesisHash
+		if last != nil && last.Hash != "" {
+			event.PrevHash = last.Hash
+		}
+		event.Hash = audit.Hash(*event)
+
+		return s.auditRepository.CreateAuditEvent(ctx, event)
+	})
+}
+
+// ListAuditEvents returns the newest events matching the filter, at most
+// maxAuditLimit of them
+func (s *AuditService) ListAuditEvents(ctx context.Context, filter models.AuditFilter) ([]models.AuditEvent, error) {
+	const op = "service/audit.go/ListAuditEvents"
+
+	if filter.Limit <= 0 {
+		filter.Limit = defaultAuditLimit
+	}
+	filter.Limit = min(filter.Limit, maxAuditLimit)
+
+	events, err := s.auditRepository.ListAuditEvents(ctx, filter)
+	if err != nil {
+		slog.Error("Database error during audit query",
+			slog.String("op", op),
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return events, nil
+}
+
+// Checkpoint signs the newest event with the active signing key. The
+// latest checkpoint is returned when the newest event is already signed,
+// nil when there is no chained event.
+func (s *AuditService) Checkpoint(ctx context.Context) (*models.AuditCheckpoint, error) {
+	const op = "service/audit.go/Checkpoint"
+
+	var key *models.SigningKey
+	if s.keys != nil {
+		key = s.keys.ActiveKey()
+	}
+	if key == nil {
+		return nil, apperrors.ErrNoSigningKey
+	}
+
+	head, err := s.auditRepository.LastAuditEvent(ctx)
+	if err != nil {
+		slog.Error("Database error during audit checkpoint",
+			slog.String("op", op),
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if head == nil || head.Hash == "" {
+		return nil, nil
+	}
+
+	last, err := s.auditRepository.LastAuditCheckpoint(ctx)
+	if err != nil {
+		slog.Error("Database error during audit checkpoint",
+			slog.String("op", op),
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if last != nil && last.EventID == head.ID {
+		return last, nil
+	}
+
+	checkpoint, err := audit.SignCheckpoint(key, head.ID, head.Hash, time.Now().UTC().Truncate(time.Microsecond))
+	if err != nil {
+		slog.Error("Failed to sign audit checkpoint",
+			slog.String("op", op),
+			slog.String("kid", key.ID),
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := s.auditRepository.CreateAuditCheckpoint(ctx, checkpoint); err != nil {
+		slog.Error("Database error during audit checkpoint",
+			slog.String("op", op),
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	slog.Info("Audit checkpoint signed",
+		slog.String("op", op),
+		slog.Int64("event_id", checkpoint.EventID),
+		slog.String("kid", checkpoint.KeyID),
+	)
+
+	return checkpoint, nil
+}
+
+// Checkpoints signs the newest event every interval. It blocks until ctx is
+// done.
+func (s *AuditService) Checkpoints(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			_, _ = s.Checkpoint(ctx)
+		}
+	}
+}
+
+// VerifyTrail walks the whole trail, the first broken link comes back as an
+// *audit.BrokenLinkError
+func (s *AuditService) VerifyTrail(ctx context.Context) (audit.Report, error) {
+	const op = "service/audit.go/VerifyTrail"
+
+	// checkpoints first, every one of them is older than the events read
+	// after
+	keys, checkpoints, err := s.checkpoints(ctx)
+	if err != nil {
+		return audit.Report{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	verifier := audit.NewVerifier(keys, checkpoints)
+	if err := s.walkTrail(ctx, verifier.Add); err != nil {
+		var broken *audit.BrokenLinkError
+		if errors.As(err, &broken) {
+			return audit.Report{}, err
+		}
+		return audit.Report{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return verifier.Finish()
+}
+
+// Export packs the whole trail into a bundle that verifies on its own. The
+// newest event is signed first when there is a signing key.
+func (s *AuditService) Export(ctx context.Context) (*audit.Bundle, error) {
+	const op = "service/audit.go/Export"
+
+	if _, err := s.Checkpoint(ctx); err != nil && !errors.Is(err, apperrors.ErrNoSigningKey) {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	keys, checkpoints, err := s.checkpoints(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var events []models.AuditEvent
+	err = s.walkTrail(ctx, func(event models.AuditEvent) error {
+		events = append(events, event)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return audit.NewBundle(events, checkpoints, keys, time.Now())
+}
+
+// checkpoints returns every checkpoint with the public keys to check them
+func (s *AuditService) checkpoints(ctx context.Context) ([]models.PublicKey, []models.AuditCheckpoint, error) {
+	const op = "service/audit.go/checkpoints"
+
+	var keys []models.PublicKey
+	if s.keys != nil {
+		signingKeys, err := s.keys.List(ctx)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, key := range signingKeys {
+			keys = append(keys, models.PublicKey{
+				ID:        key.ID,
+				Algorithm: key.Algorithm,
+				Key:       &key.PrivateKey.PublicKey,
+			})
+		}
+	}
+
+	checkpoints, err := s.auditRepository.ListAuditCheckpoints(ctx)
+	if err != nil {
+		slog.Error("Database error during audit checkpoints listing",
+			slog.String("op", op),
+			slog.String("error", err.Error()),
+		)
+		return nil, nil, err
+	}
+
+	return keys, checkpoints, nil
+}
+
+// walkTrail passes every event to fn in id order, page by page
+func (s *AuditService) walkTrail(ctx context.Context, fn func(event models.AuditEvent) error) error {
+	const op = "service/audit.go/walkTrail"
+
+	var afterID int64
+	for {
+		events, err := s.auditRepository.ListAuditTrail(ctx, afterID, auditTrailPage)
+		if err != nil {
+			slog.Error("Database error during audit trail walk",
+				slog.String("op", op),
+				slog.Int64("after_id", afterID),
+				slog.String("error", err.Error()),
+			)
+			return err
+		}
+
+		for _, event := range events {
+			if err := fn(event); err != nil {
+				return err
+			}
+		}
+		if len(events) < auditTrailPage {
+			return nil
+		}
+		afterID = events[len(events)-1].ID
+	}
+}
+
+// record is a no-op without an auditor
+func record(ctx context.Context, auditor Auditor, event models.AuditEvent) {
+	if auditor != nil {
+		auditor.Record(ctx, event)
+	}
+}
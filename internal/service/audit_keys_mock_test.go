@@ -0,0 +1,585 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package service
+
+//go:generate minimock -i github.com/alonsoF100/authorization-service/internal/service.AuditKeys -o audit_keys_mock_test.go -n AuditKeysMock -p service
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/alonsoF100/authorization-service/internal/models"
+	"github.com/gojuno/minimock/v3"
+)
+
+// AuditKeysMock implements AuditKeys
+type AuditKeysMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcActiveKey          func() (sp1 *models.SigningKey)
+	funcActiveKeyOrigin    string
+	inspectFuncActiveKey   func()
+	afterActiveKeyCounter  uint64
+	beforeActiveKeyCounter uint64
+	ActiveKeyMock          mAuditKeysMockActiveKey
+
+	funcList          func(ctx context.Context) (sa1 []models.SigningKey, err error)
+	funcListOrigin    string
+	inspectFuncList   func(ctx context.Context)
+	afterListCounter  uint64
+	beforeListCounter uint64
+	ListMock          mAuditKeysMockList
+}
+
+// NewAuditKeysMock returns a mock for AuditKeys
+func NewAuditKeysMock(t minimock.Tester) *AuditKeysMock {
+	m := &AuditKeysMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.ActiveKeyMock = mAuditKeysMockActiveKey{mock: m}
+
+	m.ListMock = mAuditKeysMockList{mock: m}
+	m.ListMock.callArgs = []*AuditKeysMockListParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mAuditKeysMockActiveKey struct {
+	optional           bool
+	mock               *AuditKeysMock
+	defaultExpectation *AuditKeysMockActiveKeyExpectation
+	expectations       []*AuditKeysMockActiveKeyExpectation
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AuditKeysMockActiveKeyExpectation specifies expectation struct of the AuditKeys.ActiveKey
+type AuditKeysMockActiveKeyExpectation struct {
+	mock *AuditKeysMock
+
+	results      *AuditKeysMockActiveKeyResults
+	returnOrigin string
+	Counter      uint64
+}
+
+// AuditKeysMockActiveKeyResults contains results of the AuditKeys.ActiveKey
+type AuditKeysMockActiveKeyResults struct {
+	sp1 *models.SigningKey
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmActiveKey *mAuditKeysMockActiveKey) Optional() *mAuditKeysMockActiveKey {
+	mmActiveKey.optional = true
+	return mmActiveKey
+}
+
+// Expect sets up expected params for AuditKeys.ActiveKey
+func (mmActiveKey *mAuditKeysMockActiveKey) Expect() *mAuditKeysMockActiveKey {
+	if mmActiveKey.mock.funcActiveKey != nil {
+		mmActiveKey.mock.t.Fatalf("AuditKeysMock.ActiveKey mock is already set by Set")
+	}
+
+	if mmActiveKey.defaultExpectation == nil {
+		mmActiveKey.defaultExpectation = &AuditKeysMockActiveKeyExpectation{}
+	}
+
+	return mmActiveKey
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuditKeys.ActiveKey
+func (mmActiveKey *mAuditKeysMockActiveKey) Inspect(f func()) *mAuditKeysMockActiveKey {
+	if mmActiveKey.mock.inspectFuncActiveKey != nil {
+		mmActiveKey.mock.t.Fatalf("Inspect function is already set for AuditKeysMock.ActiveKey")
+	}
+
+	mmActiveKey.mock.inspectFuncActiveKey = f
+
+	return mmActiveKey
+}
+
+// Return sets up results that will be returned by AuditKeys.ActiveKey
+func (mmActiveKey *mAuditKeysMockActiveKey) Return(sp1 *models.SigningKey) *AuditKeysMock {
+	if mmActiveKey.mock.funcActiveKey != nil {
+		mmActiveKey.mock.t.Fatalf("AuditKeysMock.ActiveKey mock is already set by Set")
+	}
+
+	if mmActiveKey.defaultExpectation == nil {
+		mmActiveKey.defaultExpectation = &AuditKeysMockActiveKeyExpectation{mock: mmActiveKey.mock}
+	}
+	mmActiveKey.defaultExpectation.results = &AuditKeysMockActiveKeyResults{sp1}
+	mmActiveKey.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmActiveKey.mock
+}
+
+// Set uses given function f to mock the AuditKeys.ActiveKey method
+func (mmActiveKey *mAuditKeysMockActiveKey) Set(f func() (sp1 *models.SigningKey)) *AuditKeysMock {
+	if mmActiveKey.defaultExpectation != nil {
+		mmActiveKey.mock.t.Fatalf("Default expectation is already set for the AuditKeys.ActiveKey method")
+	}
+
+	if len(mmActiveKey.expectations) > 0 {
+		mmActiveKey.mock.t.Fatalf("Some expectations are already set for the AuditKeys.ActiveKey method")
+	}
+
+	mmActiveKey.mock.funcActiveKey = f
+	mmActiveKey.mock.funcActiveKeyOrigin = minimock.CallerInfo(1)
+	return mmActiveKey.mock
+}
+
+// Times sets number of times AuditKeys.ActiveKey should be invoked
+func (mmActiveKey *mAuditKeysMockActiveKey) Times(n uint64) *mAuditKeysMockActiveKey {
+	if n == 0 {
+		mmActiveKey.mock.t.Fatalf("Times of AuditKeysMock.ActiveKey mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmActiveKey.expectedInvocations, n)
+	mmActiveKey.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmActiveKey
+}
+
+func (mmActiveKey *mAuditKeysMockActiveKey) invocationsDone() bool {
+	if len(mmActiveKey.expectations) == 0 && mmActiveKey.defaultExpectation == nil && mmActiveKey.mock.funcActiveKey == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmActiveKey.mock.afterActiveKeyCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmActiveKey.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ActiveKey implements AuditKeys
+func (mmActiveKey *AuditKeysMock) ActiveKey() (sp1 *models.SigningKey) {
+	mm_atomic.AddUint64(&mmActiveKey.beforeActiveKeyCounter, 1)
+	defer mm_atomic.AddUint64(&mmActiveKey.afterActiveKeyCounter, 1)
+
+	mmActiveKey.t.Helper()
+
+	if mmActiveKey.inspectFuncActiveKey != nil {
+		mmActiveKey.inspectFuncActiveKey()
+	}
+
+	if mmActiveKey.ActiveKeyMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmActiveKey.ActiveKeyMock.defaultExpectation.Counter, 1)
+
+		mm_results := mmActiveKey.ActiveKeyMock.defaultExpectation.results
+		if mm_results == nil {
+			mmActiveKey.t.Fatal("No results are set for the AuditKeysMock.ActiveKey")
+		}
+		return (*mm_results).sp1
+	}
+	if mmActiveKey.funcActiveKey != nil {
+		return mmActiveKey.funcActiveKey()
+	}
+	mmActiveKey.t.Fatalf("Unexpected call to AuditKeysMock.ActiveKey.")
+	return
+}
+
+// ActiveKeyAfterCounter returns a count of finished AuditKeysMock.ActiveKey invocations
+func (mmActiveKey *AuditKeysMock) ActiveKeyAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmActiveKey.afterActiveKeyCounter)
+}
+
+// ActiveKeyBeforeCounter returns a count of AuditKeysMock.ActiveKey invocations
+func (mmActiveKey *AuditKeysMock) ActiveKeyBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmActiveKey.beforeActiveKeyCounter)
+}
+
+// MinimockActiveKeyDone returns true if the count of the ActiveKey invocations corresponds
+// the number of defined expectations
+func (m *AuditKeysMock) MinimockActiveKeyDone() bool {
+	if m.ActiveKeyMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ActiveKeyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ActiveKeyMock.invocationsDone()
+}
+
+// MinimockActiveKeyInspect logs each unmet expectation
+func (m *AuditKeysMock) MinimockActiveKeyInspect() {
+	for _, e := range m.ActiveKeyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Error("Expected call to AuditKeysMock.ActiveKey")
+		}
+	}
+
+	afterActiveKeyCounter := mm_atomic.LoadUint64(&m.afterActiveKeyCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ActiveKeyMock.defaultExpectation != nil && afterActiveKeyCounter < 1 {
+		m.t.Errorf("Expected call to AuditKeysMock.ActiveKey at\n%s", m.ActiveKeyMock.defaultExpectation.returnOrigin)
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcActiveKey != nil && afterActiveKeyCounter < 1 {
+		m.t.Errorf("Expected call to AuditKeysMock.ActiveKey at\n%s", m.funcActiveKeyOrigin)
+	}
+
+	if !m.ActiveKeyMock.invocationsDone() && afterActiveKeyCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuditKeysMock.ActiveKey at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ActiveKeyMock.expectedInvocations), m.ActiveKeyMock.expectedInvocationsOrigin, afterActiveKeyCounter)
+	}
+}
+
+type mAuditKeysMockList struct {
+	optional           bool
+	mock               *AuditKeysMock
+	defaultExpectation *AuditKeysMockListExpectation
+	expectations       []*AuditKeysMockListExpectation
+
+	callArgs []*AuditKeysMockListParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AuditKeysMockListExpectation specifies expectation struct of the AuditKeys.List
+type AuditKeysMockListExpectation struct {
+	mock               *AuditKeysMock
+	params             *AuditKeysMockListParams
+	paramPtrs          *AuditKeysMockListParamPtrs
+	expectationOrigins AuditKeysMockListExpectationOrigins
+	results            *AuditKeysMockListResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AuditKeysMockListParams contains parameters of the AuditKeys.List
+type AuditKeysMockListParams struct {
+	ctx context.Context
+}
+
+// AuditKeysMockListParamPtrs contains pointers to parameters of the AuditKeys.List
+type AuditKeysMockListParamPtrs struct {
+	ctx *context.Context
+}
+
+// AuditKeysMockListResults contains results of the AuditKeys.List
+type AuditKeysMockListResults struct {
+	sa1 []models.SigningKey
+	err error
+}
+
+// AuditKeysMockListOrigins contains origins of expectations of the AuditKeys.List
+type AuditKeysMockListExpectationOrigins struct {
+	origin    string
+	originCtx string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmList *mAuditKeysMockList) Optional() *mAuditKeysMockList {
+	mmList.optional = true
+	return mmList
+}
+
+// Expect sets up expected params for AuditKeys.List
+func (mmList *mAuditKeysMockList) Expect(ctx context.Context) *mAuditKeysMockList {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("AuditKeysMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &AuditKeysMockListExpectation{}
+	}
+
+	if mmList.defaultExpectation.paramPtrs != nil {
+		mmList.mock.t.Fatalf("AuditKeysMock.List mock is already set by ExpectParams functions")
+	}
+
+	mmList.defaultExpectation.params = &AuditKeysMockListParams{ctx}
+	mmList.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmList.expectations {
+		if minimock.Equal(e.params, mmList.defaultExpectation.params) {
+			mmList.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmList.defaultExpectation.params)
+		}
+	}
+
+	return mmList
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuditKeys.List
+func (mmList *mAuditKeysMockList) ExpectCtxParam1(ctx context.Context) *mAuditKeysMockList {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("AuditKeysMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &AuditKeysMockListExpectation{}
+	}
+
+	if mmList.defaultExpectation.params != nil {
+		mmList.mock.t.Fatalf("AuditKeysMock.List mock is already set by Expect")
+	}
+
+	if mmList.defaultExpectation.paramPtrs == nil {
+		mmList.defaultExpectation.paramPtrs = &AuditKeysMockListParamPtrs{}
+	}
+	mmList.defaultExpectation.paramPtrs.ctx = &ctx
+	mmList.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmList
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuditKeys.List
+func (mmList *mAuditKeysMockList) Inspect(f func(ctx context.Context)) *mAuditKeysMockList {
+	if mmList.mock.inspectFuncList != nil {
+		mmList.mock.t.Fatalf("Inspect function is already set for AuditKeysMock.List")
+	}
+
+	mmList.mock.inspectFuncList = f
+
+	return mmList
+}
+
+// Return sets up results that will be returned by AuditKeys.List
+func (mmList *mAuditKeysMockList) Return(sa1 []models.SigningKey, err error) *AuditKeysMock {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("AuditKeysMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &AuditKeysMockListExpectation{mock: mmList.mock}
+	}
+	mmList.defaultExpectation.results = &AuditKeysMockListResults{sa1, err}
+	mmList.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmList.mock
+}
+
+// Set uses given function f to mock the AuditKeys.List method
+func (mmList *mAuditKeysMockList) Set(f func(ctx context.Context) (sa1 []models.SigningKey, err error)) *AuditKeysMock {
+	if mmList.defaultExpectation != nil {
+		mmList.mock.t.Fatalf("Default expectation is already set for the AuditKeys.List method")
+	}
+
+	if len(mmList.expectations) > 0 {
+		mmList.mock.t.Fatalf("Some expectations are already set for the AuditKeys.List method")
+	}
+
+	mmList.mock.funcList = f
+	mmList.mock.funcListOrigin = minimock.CallerInfo(1)
+	return mmList.mock
+}
+
+// When sets expectation for the AuditKeys.List which will trigger the result defined by the following
+// Then helper
+func (mmList *mAuditKeysMockList) When(ctx context.Context) *AuditKeysMockListExpectation {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("AuditKeysMock.List mock is already set by Set")
+	}
+
+	expectation := &AuditKeysMockListExpectation{
+		mock:               mmList.mock,
+		params:             &AuditKeysMockListParams{ctx},
+		expectationOrigins: AuditKeysMockListExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmList.expectations = append(mmList.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuditKeys.List return parameters for the expectation previously defined by the When method
+func (e *AuditKeysMockListExpectation) Then(sa1 []models.SigningKey, err error) *AuditKeysMock {
+	e.results = &AuditKeysMockListResults{sa1, err}
+	return e.mock
+}
+
+// Times sets number of times AuditKeys.List should be invoked
+func (mmList *mAuditKeysMockList) Times(n uint64) *mAuditKeysMockList {
+	if n == 0 {
+		mmList.mock.t.Fatalf("Times of AuditKeysMock.List mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmList.expectedInvocations, n)
+	mmList.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmList
+}
+
+func (mmList *mAuditKeysMockList) invocationsDone() bool {
+	if len(mmList.expectations) == 0 && mmList.defaultExpectation == nil && mmList.mock.funcList == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmList.mock.afterListCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmList.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// List implements AuditKeys
+func (mmList *AuditKeysMock) List(ctx context.Context) (sa1 []models.SigningKey, err error) {
+	mm_atomic.AddUint64(&mmList.beforeListCounter, 1)
+	defer mm_atomic.AddUint64(&mmList.afterListCounter, 1)
+
+	mmList.t.Helper()
+
+	if mmList.inspectFuncList != nil {
+		mmList.inspectFuncList(ctx)
+	}
+
+	mm_params := AuditKeysMockListParams{ctx}
+
+	// Record call args
+	mmList.ListMock.mutex.Lock()
+	mmList.ListMock.callArgs = append(mmList.ListMock.callArgs, &mm_params)
+	mmList.ListMock.mutex.Unlock()
+
+	for _, e := range mmList.ListMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.sa1, e.results.err
+		}
+	}
+
+	if mmList.ListMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmList.ListMock.defaultExpectation.Counter, 1)
+		mm_want := mmList.ListMock.defaultExpectation.params
+		mm_want_ptrs := mmList.ListMock.defaultExpectation.paramPtrs
+
+		mm_got := AuditKeysMockListParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmList.t.Errorf("AuditKeysMock.List got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmList.ListMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmList.t.Errorf("AuditKeysMock.List got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmList.ListMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmList.ListMock.defaultExpectation.results
+		if mm_results == nil {
+			mmList.t.Fatal("No results are set for the AuditKeysMock.List")
+		}
+		return (*mm_results).sa1, (*mm_results).err
+	}
+	if mmList.funcList != nil {
+		return mmList.funcList(ctx)
+	}
+	mmList.t.Fatalf("Unexpected call to AuditKeysMock.List. %v", ctx)
+	return
+}
+
+// ListAfterCounter returns a count of finished AuditKeysMock.List invocations
+func (mmList *AuditKeysMock) ListAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmList.afterListCounter)
+}
+
+// ListBeforeCounter returns a count of AuditKeysMock.List invocations
+func (mmList *AuditKeysMock) ListBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmList.beforeListCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuditKeysMock.List.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmList *mAuditKeysMockList) Calls() []*AuditKeysMockListParams {
+	mmList.mutex.RLock()
+
+	argCopy := make([]*AuditKeysMockListParams, len(mmList.callArgs))
+	copy(argCopy, mmList.callArgs)
+
+	mmList.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListDone returns true if the count of the List invocations corresponds
+// the number of defined expectations
+func (m *AuditKeysMock) MinimockListDone() bool {
+	if m.ListMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListMock.invocationsDone()
+}
+
+// MinimockListInspect logs each unmet expectation
+func (m *AuditKeysMock) MinimockListInspect() {
+	for _, e := range m.ListMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuditKeysMock.List at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListCounter := mm_atomic.LoadUint64(&m.afterListCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListMock.defaultExpectation != nil && afterListCounter < 1 {
+		if m.ListMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuditKeysMock.List at\n%s", m.ListMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AuditKeysMock.List at\n%s with params: %#v", m.ListMock.defaultExpectation.expectationOrigins.origin, *m.ListMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcList != nil && afterListCounter < 1 {
+		m.t.Errorf("Expected call to AuditKeysMock.List at\n%s", m.funcListOrigin)
+	}
+
+	if !m.ListMock.invocationsDone() && afterListCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuditKeysMock.List at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListMock.expectedInvocations), m.ListMock.expectedInvocationsOrigin, afterListCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *AuditKeysMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockActiveKeyInspect()
+
+			m.MinimockListInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *AuditKeysMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *AuditKeysMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockActiveKeyDone() &&
+		m.MinimockListDone()
+}
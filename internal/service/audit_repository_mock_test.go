@@ -0,0 +1,3170 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package service
+
+//go:generate minimock -i github.com/alonsoF100/authorization-service/internal/service.AuditRepository -o audit_repository_mock_test.go -n AuditRepositoryMock -p service
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/alonsoF100/authorization-service/internal/models"
+	"github.com/gojuno/minimock/v3"
+)
+
+// AuditRepositoryMock implements AuditRepository
+type AuditRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcCreateAuditCheckpoint          func(ctx context.Context, checkpoint *models.AuditCheckpoint) (err error)
+	funcCreateAuditCheckpointOrigin    string
+	inspectFuncCreateAuditCheckpoint   func(ctx context.Context, checkpoint *models.AuditCheckpoint)
+	afterCreateAuditCheckpointCounter  uint64
+	beforeCreateAuditCheckpointCounter uint64
+	CreateAuditCheckpointMock          mAuditRepositoryMockCreateAuditCheckpoint
+
+	funcCreateAuditEvent          func(ctx context.Context, event *models.AuditEvent) (err error)
+	funcCreateAuditEventOrigin    string
+	inspectFuncCreateAuditEvent   func(ctx context.Context, event *models.AuditEvent)
+	afterCreateAuditEventCounter  uint64
+	beforeCreateAuditEventCounter uint64
+	CreateAuditEventMock          mAuditRepositoryMockCreateAuditEvent
+
+	funcInTx          func(ctx context.Context, fn func(ctx context.Context) error) (err error)
+	funcInTxOrigin    string
+	inspectFuncInTx   func(ctx context.Context, fn func(ctx context.Context) error)
+	afterInTxCounter  uint64
+	beforeInTxCounter uint64
+	InTxMock          mAuditRepositoryMockInTx
+
+	funcLastAuditCheckpoint          func(ctx context.Context) (ap1 *models.AuditCheckpoint, err error)
+	funcLastAuditCheckpointOrigin    string
+	inspectFuncLastAuditCheckpoint   func(ctx context.Context)
+	afterLastAuditCheckpointCounter  uint64
+	beforeLastAuditCheckpointCounter uint64
+	LastAuditCheckpointMock          mAuditRepositoryMockLastAuditCheckpoint
+
+	funcLastAuditEvent          func(ctx context.Context) (ap1 *models.AuditEvent, err error)
+	funcLastAuditEventOrigin    string
+	inspectFuncLastAuditEvent   func(ctx context.Context)
+	afterLastAuditEventCounter  uint64
+	beforeLastAuditEventCounter uint64
+	LastAuditEventMock          mAuditRepositoryMockLastAuditEvent
+
+	funcListAuditCheckpoints          func(ctx context.Context) (aa1 []models.AuditCheckpoint, err error)
+	funcListAuditCheckpointsOrigin    string
+	inspectFuncListAuditCheckpoints   func(ctx context.Context)
+	afterListAuditCheckpointsCounter  uint64
+	beforeListAuditCheckpointsCounter uint64
+	ListAuditCheckpointsMock          mAuditRepositoryMockListAuditCheckpoints
+
+	funcListAuditEvents          func(ctx context.Context, filter models.AuditFilter) (aa1 []models.AuditEvent, err error)
+	funcListAuditEventsOrigin    string
+	inspectFuncListAuditEvents   func(ctx context.Context, filter models.AuditFilter)
+	afterListAuditEventsCounter  uint64
+	beforeListAuditEventsCounter uint64
+	ListAuditEventsMock          mAuditRepositoryMockListAuditEvents
+
+	funcListAuditTrail          func(ctx context.Context, afterID int64, limit int) (aa1 []models.AuditEvent, err error)
+	funcListAuditTrailOrigin    string
+	inspectFuncListAuditTrail   func(ctx context.Context, afterID int64, limit int)
+	afterListAuditTrailCounter  uint64
+	beforeListAuditTrailCounter uint64
+	ListAuditTrailMock          mAuditRepositoryMockListAuditTrail
+
+	funcLockAuditHead          func(ctx context.Context) (ap1 *models.AuditEvent, err error)
+	funcLockAuditHeadOrigin    string
+	inspectFuncLockAuditHead   func(ctx context.Context)
+	afterLockAuditHeadCounter  uint64
+	beforeLockAuditHeadCounter uint64
+	LockAuditHeadMock          mAuditRepositoryMockLockAuditHead
+}
+
+// NewAuditRepositoryMock returns a mock for AuditRepository
+func NewAuditRepositoryMock(t minimock.Tester) *AuditRepositoryMock {
+	m := &AuditRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CreateAuditCheckpointMock = mAuditRepositoryMockCreateAuditCheckpoint{mock: m}
+	m.CreateAuditCheckpointMock.callArgs = []*AuditRepositoryMockCreateAuditCheckpointParams{}
+
+	m.CreateAuditEventMock = mAuditRepositoryMockCreateAuditEvent{mock: m}
+	m.CreateAuditEventMock.callArgs = []*AuditRepositoryMockCreateAuditEventParams{}
+
+	m.InTxMock = mAuditRepositoryMockInTx{mock: m}
+	m.InTxMock.callArgs = []*AuditRepositoryMockInTxParams{}
+
+	m.LastAuditCheckpointMock = mAuditRepositoryMockLastAuditCheckpoint{mock: m}
+	m.LastAuditCheckpointMock.callArgs = []*AuditRepositoryMockLastAuditCheckpointParams{}
+
+	m.LastAuditEventMock = mAuditRepositoryMockLastAuditEvent{mock: m}
+	m.LastAuditEventMock.callArgs = []*AuditRepositoryMockLastAuditEventParams{}
+
+	m.ListAuditCheckpointsMock = mAuditRepositoryMockListAuditCheckpoints{mock: m}
+	m.ListAuditCheckpointsMock.callArgs = []*AuditRepositoryMockListAuditCheckpointsParams{}
+
+	m.ListAuditEventsMock = mAuditRepositoryMockListAuditEvents{mock: m}
+	m.ListAuditEventsMock.callArgs = []*AuditRepositoryMockListAuditEventsParams{}
+
+	m.ListAuditTrailMock = mAuditRepositoryMockListAuditTrail{mock: m}
+	m.ListAuditTrailMock.callArgs = []*AuditRepositoryMockListAuditTrailParams{}
+
+	m.LockAuditHeadMock = mAuditRepositoryMockLockAuditHead{mock: m}
+	m.LockAuditHeadMock.callArgs = []*AuditRepositoryMockLockAuditHeadParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mAuditRepositoryMockCreateAuditCheckpoint struct {
+	optional           bool
+	mock               *AuditRepositoryMock
+	defaultExpectation *AuditRepositoryMockCreateAuditCheckpointExpectation
+	expectations       []*AuditRepositoryMockCreateAuditCheckpointExpectation
+
+	callArgs []*AuditRepositoryMockCreateAuditCheckpointParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AuditRepositoryMockCreateAuditCheckpointExpectation specifies expectation struct of the AuditRepository.CreateAuditCheckpoint
+type AuditRepositoryMockCreateAuditCheckpointExpectation struct {
+	mock               *AuditRepositoryMock
+	params             *AuditRepositoryMockCreateAuditCheckpointParams
+	paramPtrs          *AuditRepositoryMockCreateAuditCheckpointParamPtrs
+	expectationOrigins AuditRepositoryMockCreateAuditCheckpointExpectationOrigins
+	results            *AuditRepositoryMockCreateAuditCheckpointResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AuditRepositoryMockCreateAuditCheckpointParams contains parameters of the AuditRepository.CreateAuditCheckpoint
+type AuditRepositoryMockCreateAuditCheckpointParams struct {
+	ctx        context.Context
+	checkpoint *models.AuditCheckpoint
+}
+
+// AuditRepositoryMockCreateAuditCheckpointParamPtrs contains pointers to parameters of the AuditRepository.CreateAuditCheckpoint
+type AuditRepositoryMockCreateAuditCheckpointParamPtrs struct {
+	ctx        *context.Context
+	checkpoint **models.AuditCheckpoint
+}
+
+// AuditRepositoryMockCreateAuditCheckpointResults contains results of the AuditRepository.CreateAuditCheckpoint
+type AuditRepositoryMockCreateAuditCheckpointResults struct {
+	err error
+}
+
+// AuditRepositoryMockCreateAuditCheckpointOrigins contains origins of expectations of the AuditRepository.CreateAuditCheckpoint
+type AuditRepositoryMockCreateAuditCheckpointExpectationOrigins struct {
+	origin           string
+	originCtx        string
+	originCheckpoint string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateAuditCheckpoint *mAuditRepositoryMockCreateAuditCheckpoint) Optional() *mAuditRepositoryMockCreateAuditCheckpoint {
+	mmCreateAuditCheckpoint.optional = true
+	return mmCreateAuditCheckpoint
+}
+
+// Expect sets up expected params for AuditRepository.CreateAuditCheckpoint
+func (mmCreateAuditCheckpoint *mAuditRepositoryMockCreateAuditCheckpoint) Expect(ctx context.Context, checkpoint *models.AuditCheckpoint) *mAuditRepositoryMockCreateAuditCheckpoint {
+	if mmCreateAuditCheckpoint.mock.funcCreateAuditCheckpoint != nil {
+		mmCreateAuditCheckpoint.mock.t.Fatalf("AuditRepositoryMock.CreateAuditCheckpoint mock is already set by Set")
+	}
+
+	if mmCreateAuditCheckpoint.defaultExpectation == nil {
+		mmCreateAuditCheckpoint.defaultExpectation = &AuditRepositoryMockCreateAuditCheckpointExpectation{}
+	}
+
+	if mmCreateAuditCheckpoint.defaultExpectation.paramPtrs != nil {
+		mmCreateAuditCheckpoint.mock.t.Fatalf("AuditRepositoryMock.CreateAuditCheckpoint mock is already set by ExpectParams functions")
+	}
+
+	mmCreateAuditCheckpoint.defaultExpectation.params = &AuditRepositoryMockCreateAuditCheckpointParams{ctx, checkpoint}
+	mmCreateAuditCheckpoint.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreateAuditCheckpoint.expectations {
+		if minimock.Equal(e.params, mmCreateAuditCheckpoint.defaultExpectation.params) {
+			mmCreateAuditCheckpoint.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateAuditCheckpoint.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateAuditCheckpoint
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuditRepository.CreateAuditCheckpoint
+func (mmCreateAuditCheckpoint *mAuditRepositoryMockCreateAuditCheckpoint) ExpectCtxParam1(ctx context.Context) *mAuditRepositoryMockCreateAuditCheckpoint {
+	if mmCreateAuditCheckpoint.mock.funcCreateAuditCheckpoint != nil {
+		mmCreateAuditCheckpoint.mock.t.Fatalf("AuditRepositoryMock.CreateAuditCheckpoint mock is already set by Set")
+	}
+
+	if mmCreateAuditCheckpoint.defaultExpectation == nil {
+		mmCreateAuditCheckpoint.defaultExpectation = &AuditRepositoryMockCreateAuditCheckpointExpectation{}
+	}
+
+	if mmCreateAuditCheckpoint.defaultExpectation.params != nil {
+		mmCreateAuditCheckpoint.mock.t.Fatalf("AuditRepositoryMock.CreateAuditCheckpoint mock is already set by Expect")
+	}
+
+	if mmCreateAuditCheckpoint.defaultExpectation.paramPtrs == nil {
+		mmCreateAuditCheckpoint.defaultExpectation.paramPtrs = &AuditRepositoryMockCreateAuditCheckpointParamPtrs{}
+	}
+	mmCreateAuditCheckpoint.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreateAuditCheckpoint.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreateAuditCheckpoint
+}
+
+// ExpectCheckpointParam2 sets up expected param checkpoint for AuditRepository.CreateAuditCheckpoint
+func (mmCreateAuditCheckpoint *mAuditRepositoryMockCreateAuditCheckpoint) ExpectCheckpointParam2(checkpoint *models.AuditCheckpoint) *mAuditRepositoryMockCreateAuditCheckpoint {
+	if mmCreateAuditCheckpoint.mock.funcCreateAuditCheckpoint != nil {
+		mmCreateAuditCheckpoint.mock.t.Fatalf("AuditRepositoryMock.CreateAuditCheckpoint mock is already set by Set")
+	}
+
+	if mmCreateAuditCheckpoint.defaultExpectation == nil {
+		mmCreateAuditCheckpoint.defaultExpectation = &AuditRepositoryMockCreateAuditCheckpointExpectation{}
+	}
+
+	if mmCreateAuditCheckpoint.defaultExpectation.params != nil {
+		mmCreateAuditCheckpoint.mock.t.Fatalf("AuditRepositoryMock.CreateAuditCheckpoint mock is already set by Expect")
+	}
+
+	if mmCreateAuditCheckpoint.defaultExpectation.paramPtrs == nil {
+		mmCreateAuditCheckpoint.defaultExpectation.paramPtrs = &AuditRepositoryMockCreateAuditCheckpointParamPtrs{}
+	}
+	mmCreateAuditCheckpoint.defaultExpectation.paramPtrs.checkpoint = &checkpoint
+	mmCreateAuditCheckpoint.defaultExpectation.expectationOrigins.originCheckpoint = minimock.CallerInfo(1)
+
+	return mmCreateAuditCheckpoint
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuditRepository.CreateAuditCheckpoint
+func (mmCreateAuditCheckpoint *mAuditRepositoryMockCreateAuditCheckpoint) Inspect(f func(ctx context.Context, checkpoint *models.AuditCheckpoint)) *mAuditRepositoryMockCreateAuditCheckpoint {
+	if mmCreateAuditCheckpoint.mock.inspectFuncCreateAuditCheckpoint != nil {
+		mmCreateAuditCheckpoint.mock.t.Fatalf("Inspect function is already set for AuditRepositoryMock.CreateAuditCheckpoint")
+	}
+
+	mmCreateAuditCheckpoint.mock.inspectFuncCreateAuditCheckpoint = f
+
+	return mmCreateAuditCheckpoint
+}
+
+// Return sets up results that will be returned by AuditRepository.CreateAuditCheckpoint
+func (mmCreateAuditCheckpoint *mAuditRepositoryMockCreateAuditCheckpoint) Return(err error) *AuditRepositoryMock {
+	if mmCreateAuditCheckpoint.mock.funcCreateAuditCheckpoint != nil {
+		mmCreateAuditCheckpoint.mock.t.Fatalf("AuditRepositoryMock.CreateAuditCheckpoint mock is already set by Set")
+	}
+
+	if mmCreateAuditCheckpoint.defaultExpectation == nil {
+		mmCreateAuditCheckpoint.defaultExpectation = &AuditRepositoryMockCreateAuditCheckpointExpectation{mock: mmCreateAuditCheckpoint.mock}
+	}
+	mmCreateAuditCheckpoint.defaultExpectation.results = &AuditRepositoryMockCreateAuditCheckpointResults{err}
+	mmCreateAuditCheckpoint.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreateAuditCheckpoint.mock
+}
+
+// Set uses given function f to mock the AuditRepository.CreateAuditCheckpoint method
+func (mmCreateAuditCheckpoint *mAuditRepositoryMockCreateAuditCheckpoint) Set(f func(ctx context.Context, checkpoint *models.AuditCheckpoint) (err error)) *AuditRepositoryMock {
+	if mmCreateAuditCheckpoint.defaultExpectation != nil {
+		mmCreateAuditCheckpoint.mock.t.Fatalf("Default expectation is already set for the AuditRepository.CreateAuditCheckpoint method")
+	}
+
+	if len(mmCreateAuditCheckpoint.expectations) > 0 {
+		mmCreateAuditCheckpoint.mock.t.Fatalf("Some expectations are already set for the AuditRepository.CreateAuditCheckpoint method")
+	}
+
+	mmCreateAuditCheckpoint.mock.funcCreateAuditCheckpoint = f
+	mmCreateAuditCheckpoint.mock.funcCreateAuditCheckpointOrigin = minimock.CallerInfo(1)
+	return mmCreateAuditCheckpoint.mock
+}
+
+// When sets expectation for the AuditRepository.CreateAuditCheckpoint which will trigger the result defined by the following
+// Then helper
+func (mmCreateAuditCheckpoint *mAuditRepositoryMockCreateAuditCheckpoint) When(ctx context.Context, checkpoint *models.AuditCheckpoint) *AuditRepositoryMockCreateAuditCheckpointExpectation {
+	if mmCreateAuditCheckpoint.mock.funcCreateAuditCheckpoint != nil {
+		mmCreateAuditCheckpoint.mock.t.Fatalf("AuditRepositoryMock.CreateAuditCheckpoint mock is already set by Set")
+	}
+
+	expectation := &AuditRepositoryMockCreateAuditCheckpointExpectation{
+		mock:               mmCreateAuditCheckpoint.mock,
+		params:             &AuditRepositoryMockCreateAuditCheckpointParams{ctx, checkpoint},
+		expectationOrigins: AuditRepositoryMockCreateAuditCheckpointExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreateAuditCheckpoint.expectations = append(mmCreateAuditCheckpoint.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuditRepository.CreateAuditCheckpoint return parameters for the expectation previously defined by the When method
+func (e *AuditRepositoryMockCreateAuditCheckpointExpectation) Then(err error) *AuditRepositoryMock {
+	e.results = &AuditRepositoryMockCreateAuditCheckpointResults{err}
+	return e.mock
+}
+
+// Times sets number of times AuditRepository.CreateAuditCheckpoint should be invoked
+func (mmCreateAuditCheckpoint *mAuditRepositoryMockCreateAuditCheckpoint) Times(n uint64) *mAuditRepositoryMockCreateAuditCheckpoint {
+	if n == 0 {
+		mmCreateAuditCheckpoint.mock.t.Fatalf("Times of AuditRepositoryMock.CreateAuditCheckpoint mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateAuditCheckpoint.expectedInvocations, n)
+	mmCreateAuditCheckpoint.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreateAuditCheckpoint
+}
+
+func (mmCreateAuditCheckpoint *mAuditRepositoryMockCreateAuditCheckpoint) invocationsDone() bool {
+	if len(mmCreateAuditCheckpoint.expectations) == 0 && mmCreateAuditCheckpoint.defaultExpectation == nil && mmCreateAuditCheckpoint.mock.funcCreateAuditCheckpoint == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateAuditCheckpoint.mock.afterCreateAuditCheckpointCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateAuditCheckpoint.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateAuditCheckpoint implements AuditRepository
+func (mmCreateAuditCheckpoint *AuditRepositoryMock) CreateAuditCheckpoint(ctx context.Context, checkpoint *models.AuditCheckpoint) (err error) {
+	mm_atomic.AddUint64(&mmCreateAuditCheckpoint.beforeCreateAuditCheckpointCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateAuditCheckpoint.afterCreateAuditCheckpointCounter, 1)
+
+	mmCreateAuditCheckpoint.t.Helper()
+
+	if mmCreateAuditCheckpoint.inspectFuncCreateAuditCheckpoint != nil {
+		mmCreateAuditCheckpoint.inspectFuncCreateAuditCheckpoint(ctx, checkpoint)
+	}
+
+	mm_params := AuditRepositoryMockCreateAuditCheckpointParams{ctx, checkpoint}
+
+	// Record call args
+	mmCreateAuditCheckpoint.CreateAuditCheckpointMock.mutex.Lock()
+	mmCreateAuditCheckpoint.CreateAuditCheckpointMock.callArgs = append(mmCreateAuditCheckpoint.CreateAuditCheckpointMock.callArgs, &mm_params)
+	mmCreateAuditCheckpoint.CreateAuditCheckpointMock.mutex.Unlock()
+
+	for _, e := range mmCreateAuditCheckpoint.CreateAuditCheckpointMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCreateAuditCheckpoint.CreateAuditCheckpointMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateAuditCheckpoint.CreateAuditCheckpointMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateAuditCheckpoint.CreateAuditCheckpointMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateAuditCheckpoint.CreateAuditCheckpointMock.defaultExpectation.paramPtrs
+
+		mm_got := AuditRepositoryMockCreateAuditCheckpointParams{ctx, checkpoint}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateAuditCheckpoint.t.Errorf("AuditRepositoryMock.CreateAuditCheckpoint got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateAuditCheckpoint.CreateAuditCheckpointMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.checkpoint != nil && !minimock.Equal(*mm_want_ptrs.checkpoint, mm_got.checkpoint) {
+				mmCreateAuditCheckpoint.t.Errorf("AuditRepositoryMock.CreateAuditCheckpoint got unexpected parameter checkpoint, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateAuditCheckpoint.CreateAuditCheckpointMock.defaultExpectation.expectationOrigins.originCheckpoint, *mm_want_ptrs.checkpoint, mm_got.checkpoint, minimock.Diff(*mm_want_ptrs.checkpoint, mm_got.checkpoint))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateAuditCheckpoint.t.Errorf("AuditRepositoryMock.CreateAuditCheckpoint got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreateAuditCheckpoint.CreateAuditCheckpointMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateAuditCheckpoint.CreateAuditCheckpointMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateAuditCheckpoint.t.Fatal("No results are set for the AuditRepositoryMock.CreateAuditCheckpoint")
+		}
+		return (*mm_results).err
+	}
+	if mmCreateAuditCheckpoint.funcCreateAuditCheckpoint != nil {
+		return mmCreateAuditCheckpoint.funcCreateAuditCheckpoint(ctx, checkpoint)
+	}
+	mmCreateAuditCheckpoint.t.Fatalf("Unexpected call to AuditRepositoryMock.CreateAuditCheckpoint. %v %v", ctx, checkpoint)
+	return
+}
+
+// CreateAuditCheckpointAfterCounter returns a count of finished AuditRepositoryMock.CreateAuditCheckpoint invocations
+func (mmCreateAuditCheckpoint *AuditRepositoryMock) CreateAuditCheckpointAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateAuditCheckpoint.afterCreateAuditCheckpointCounter)
+}
+
+// CreateAuditCheckpointBeforeCounter returns a count of AuditRepositoryMock.CreateAuditCheckpoint invocations
+func (mmCreateAuditCheckpoint *AuditRepositoryMock) CreateAuditCheckpointBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateAuditCheckpoint.beforeCreateAuditCheckpointCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuditRepositoryMock.CreateAuditCheckpoint.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateAuditCheckpoint *mAuditRepositoryMockCreateAuditCheckpoint) Calls() []*AuditRepositoryMockCreateAuditCheckpointParams {
+	mmCreateAuditCheckpoint.mutex.RLock()
+
+	argCopy := make([]*AuditRepositoryMockCreateAuditCheckpointParams, len(mmCreateAuditCheckpoint.callArgs))
+	copy(argCopy, mmCreateAuditCheckpoint.callArgs)
+
+	mmCreateAuditCheckpoint.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateAuditCheckpointDone returns true if the count of the CreateAuditCheckpoint invocations corresponds
+// the number of defined expectations
+func (m *AuditRepositoryMock) MinimockCreateAuditCheckpointDone() bool {
+	if m.CreateAuditCheckpointMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateAuditCheckpointMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateAuditCheckpointMock.invocationsDone()
+}
+
+// MinimockCreateAuditCheckpointInspect logs each unmet expectation
+func (m *AuditRepositoryMock) MinimockCreateAuditCheckpointInspect() {
+	for _, e := range m.CreateAuditCheckpointMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuditRepositoryMock.CreateAuditCheckpoint at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateAuditCheckpointCounter := mm_atomic.LoadUint64(&m.afterCreateAuditCheckpointCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateAuditCheckpointMock.defaultExpectation != nil && afterCreateAuditCheckpointCounter < 1 {
+		if m.CreateAuditCheckpointMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuditRepositoryMock.CreateAuditCheckpoint at\n%s", m.CreateAuditCheckpointMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AuditRepositoryMock.CreateAuditCheckpoint at\n%s with params: %#v", m.CreateAuditCheckpointMock.defaultExpectation.expectationOrigins.origin, *m.CreateAuditCheckpointMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateAuditCheckpoint != nil && afterCreateAuditCheckpointCounter < 1 {
+		m.t.Errorf("Expected call to AuditRepositoryMock.CreateAuditCheckpoint at\n%s", m.funcCreateAuditCheckpointOrigin)
+	}
+
+	if !m.CreateAuditCheckpointMock.invocationsDone() && afterCreateAuditCheckpointCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuditRepositoryMock.CreateAuditCheckpoint at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateAuditCheckpointMock.expectedInvocations), m.CreateAuditCheckpointMock.expectedInvocationsOrigin, afterCreateAuditCheckpointCounter)
+	}
+}
+
+type mAuditRepositoryMockCreateAuditEvent struct {
+	optional           bool
+	mock               *AuditRepositoryMock
+	defaultExpectation *AuditRepositoryMockCreateAuditEventExpectation
+	expectations       []*AuditRepositoryMockCreateAuditEventExpectation
+
+	callArgs []*AuditRepositoryMockCreateAuditEventParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AuditRepositoryMockCreateAuditEventExpectation specifies expectation struct of the AuditRepository.CreateAuditEvent
+type AuditRepositoryMockCreateAuditEventExpectation struct {
+	mock               *AuditRepositoryMock
+	params             *AuditRepositoryMockCreateAuditEventParams
+	paramPtrs          *AuditRepositoryMockCreateAuditEventParamPtrs
+	expectationOrigins AuditRepositoryMockCreateAuditEventExpectationOrigins
+	results            *AuditRepositoryMockCreateAuditEventResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AuditRepositoryMockCreateAuditEventParams contains parameters of the AuditRepository.CreateAuditEvent
+type AuditRepositoryMockCreateAuditEventParams struct {
+	ctx   context.Context
+	event *models.AuditEvent
+}
+
+// AuditRepositoryMockCreateAuditEventParamPtrs contains pointers to parameters of the AuditRepository.CreateAuditEvent
+type AuditRepositoryMockCreateAuditEventParamPtrs struct {
+	ctx   *context.Context
+	event **models.AuditEvent
+}
+
+// AuditRepositoryMockCreateAuditEventResults contains results of the AuditRepository.CreateAuditEvent
+type AuditRepositoryMockCreateAuditEventResults struct {
+	err error
+}
+
+// AuditRepositoryMockCreateAuditEventOrigins contains origins of expectations of the AuditRepository.CreateAuditEvent
+type AuditRepositoryMockCreateAuditEventExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originEvent string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateAuditEvent *mAuditRepositoryMockCreateAuditEvent) Optional() *mAuditRepositoryMockCreateAuditEvent {
+	mmCreateAuditEvent.optional = true
+	return mmCreateAuditEvent
+}
+
+// Expect sets up expected params for AuditRepository.CreateAuditEvent
+func (mmCreateAuditEvent *mAuditRepositoryMockCreateAuditEvent) Expect(ctx context.Context, event *models.AuditEvent) *mAuditRepositoryMockCreateAuditEvent {
+	if mmCreateAuditEvent.mock.funcCreateAuditEvent != nil {
+		mmCreateAuditEvent.mock.t.Fatalf("AuditRepositoryMock.CreateAuditEvent mock is already set by Set")
+	}
+
+	if mmCreateAuditEvent.defaultExpectation == nil {
+		mmCreateAuditEvent.defaultExpectation = &AuditRepositoryMockCreateAuditEventExpectation{}
+	}
+
+	if mmCreateAuditEvent.defaultExpectation.paramPtrs != nil {
+		mmCreateAuditEvent.mock.t.Fatalf("AuditRepositoryMock.CreateAuditEvent mock is already set by ExpectParams functions")
+	}
+
+	mmCreateAuditEvent.defaultExpectation.params = &AuditRepositoryMockCreateAuditEventParams{ctx, event}
+	mmCreateAuditEvent.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreateAuditEvent.expectations {
+		if minimock.Equal(e.params, mmCreateAuditEvent.defaultExpectation.params) {
+			mmCreateAuditEvent.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateAuditEvent.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateAuditEvent
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuditRepository.CreateAuditEvent
+func (mmCreateAuditEvent *mAuditRepositoryMockCreateAuditEvent) ExpectCtxParam1(ctx context.Context) *mAuditRepositoryMockCreateAuditEvent {
+	if mmCreateAuditEvent.mock.funcCreateAuditEvent != nil {
+		mmCreateAuditEvent.mock.t.Fatalf("AuditRepositoryMock.CreateAuditEvent mock is already set by Set")
+	}
+
+	if mmCreateAuditEvent.defaultExpectation == nil {
+		mmCreateAuditEvent.defaultExpectation = &AuditRepositoryMockCreateAuditEventExpectation{}
+	}
+
+	if mmCreateAuditEvent.defaultExpectation.params != nil {
+		mmCreateAuditEvent.mock.t.Fatalf("AuditRepositoryMock.CreateAuditEvent mock is already set by Expect")
+	}
+
+	if mmCreateAuditEvent.defaultExpectation.paramPtrs == nil {
+		mmCreateAuditEvent.defaultExpectation.paramPtrs = &AuditRepositoryMockCreateAuditEventParamPtrs{}
+	}
+	mmCreateAuditEvent.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreateAuditEvent.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreateAuditEvent
+}
+
+// ExpectEventParam2 sets up expected param event for AuditRepository.CreateAuditEvent
+func (mmCreateAuditEvent *mAuditRepositoryMockCreateAuditEvent) ExpectEventParam2(event *models.AuditEvent) *mAuditRepositoryMockCreateAuditEvent {
+	if mmCreateAuditEvent.mock.funcCreateAuditEvent != nil {
+		mmCreateAuditEvent.mock.t.Fatalf("AuditRepositoryMock.CreateAuditEvent mock is already set by Set")
+	}
+
+	if mmCreateAuditEvent.defaultExpectation == nil {
+		mmCreateAuditEvent.defaultExpectation = &AuditRepositoryMockCreateAuditEventExpectation{}
+	}
+
+	if mmCreateAuditEvent.defaultExpectation.params != nil {
+		mmCreateAuditEvent.mock.t.Fatalf("AuditRepositoryMock.CreateAuditEvent mock is already set by Expect")
+	}
+
+	if mmCreateAuditEvent.defaultExpectation.paramPtrs == nil {
+		mmCreateAuditEvent.defaultExpectation.paramPtrs = &AuditRepositoryMockCreateAuditEventParamPtrs{}
+	}
+	mmCreateAuditEvent.defaultExpectation.paramPtrs.event = &event
+	mmCreateAuditEvent.defaultExpectation.expectationOrigins.originEvent = minimock.CallerInfo(1)
+
+	return mmCreateAuditEvent
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuditRepository.CreateAuditEvent
+func (mmCreateAuditEvent *mAuditRepositoryMockCreateAuditEvent) Inspect(f func(ctx context.Context, event *models.AuditEvent)) *mAuditRepositoryMockCreateAuditEvent {
+	if mmCreateAuditEvent.mock.inspectFuncCreateAuditEvent != nil {
+		mmCreateAuditEvent.mock.t.Fatalf("Inspect function is already set for AuditRepositoryMock.CreateAuditEvent")
+	}
+
+	mmCreateAuditEvent.mock.inspectFuncCreateAuditEvent = f
+
+	return mmCreateAuditEvent
+}
+
+// Return sets up results that will be returned by AuditRepository.CreateAuditEvent
+func (mmCreateAuditEvent *mAuditRepositoryMockCreateAuditEvent) Return(err error) *AuditRepositoryMock {
+	if mmCreateAuditEvent.mock.funcCreateAuditEvent != nil {
+		mmCreateAuditEvent.mock.t.Fatalf("AuditRepositoryMock.CreateAuditEvent mock is already set by Set")
+	}
+
+	if mmCreateAuditEvent.defaultExpectation == nil {
+		mmCreateAuditEvent.defaultExpectation = &AuditRepositoryMockCreateAuditEventExpectation{mock: mmCreateAuditEvent.mock}
+	}
+	mmCreateAuditEvent.defaultExpectation.results = &AuditRepositoryMockCreateAuditEventResults{err}
+	mmCreateAuditEvent.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreateAuditEvent.mock
+}
+
+// Set uses given function f to mock the AuditRepository.CreateAuditEvent method
+func (mmCreateAuditEvent *mAuditRepositoryMockCreateAuditEvent) Set(f func(ctx context.Context, event *models.AuditEvent) (err error)) *AuditRepositoryMock {
+	if mmCreateAuditEvent.defaultExpectation != nil {
+		mmCreateAuditEvent.mock.t.Fatalf("Default expectation is already set for the AuditRepository.CreateAuditEvent method")
+	}
+
+	if len(mmCreateAuditEvent.expectations) > 0 {
+		mmCreateAuditEvent.mock.t.Fatalf("Some expectations are already set for the AuditRepository.CreateAuditEvent method")
+	}
+
+	mmCreateAuditEvent.mock.funcCreateAuditEvent = f
+	mmCreateAuditEvent.mock.funcCreateAuditEventOrigin = minimock.CallerInfo(1)
+	return mmCreateAuditEvent.mock
+}
+
+// When sets expectation for the AuditRepository.CreateAuditEvent which will trigger the result defined by the following
+// Then helper
+func (mmCreateAuditEvent *mAuditRepositoryMockCreateAuditEvent) When(ctx context.Context, event *models.AuditEvent) *AuditRepositoryMockCreateAuditEventExpectation {
+	if mmCreateAuditEvent.mock.funcCreateAuditEvent != nil {
+		mmCreateAuditEvent.mock.t.Fatalf("AuditRepositoryMock.CreateAuditEvent mock is already set by Set")
+	}
+
+	expectation := &AuditRepositoryMockCreateAuditEventExpectation{
+		mock:               mmCreateAuditEvent.mock,
+		params:             &AuditRepositoryMockCreateAuditEventParams{ctx, event},
+		expectationOrigins: AuditRepositoryMockCreateAuditEventExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreateAuditEvent.expectations = append(mmCreateAuditEvent.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuditRepository.CreateAuditEvent return parameters for the expectation previously defined by the When method
+func (e *AuditRepositoryMockCreateAuditEventExpectation) Then(err error) *AuditRepositoryMock {
+	e.results = &AuditRepositoryMockCreateAuditEventResults{err}
+	return e.mock
+}
+
+// Times sets number of times AuditRepository.CreateAuditEvent should be invoked
+func (mmCreateAuditEvent *mAuditRepositoryMockCreateAuditEvent) Times(n uint64) *mAuditRepositoryMockCreateAuditEvent {
+	if n == 0 {
+		mmCreateAuditEvent.mock.t.Fatalf("Times of AuditRepositoryMock.CreateAuditEvent mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateAuditEvent.expectedInvocations, n)
+	mmCreateAuditEvent.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreateAuditEvent
+}
+
+func (mmCreateAuditEvent *mAuditRepositoryMockCreateAuditEvent) invocationsDone() bool {
+	if len(mmCreateAuditEvent.expectations) == 0 && mmCreateAuditEvent.defaultExpectation == nil && mmCreateAuditEvent.mock.funcCreateAuditEvent == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateAuditEvent.mock.afterCreateAuditEventCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateAuditEvent.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateAuditEvent implements AuditRepository
+func (mmCreateAuditEvent *AuditRepositoryMock) CreateAuditEvent(ctx context.Context, event *models.AuditEvent) (err error) {
+	mm_atomic.AddUint64(&mmCreateAuditEvent.beforeCreateAuditEventCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateAuditEvent.afterCreateAuditEventCounter, 1)
+
+	mmCreateAuditEvent.t.Helper()
+
+	if mmCreateAuditEvent.inspectFuncCreateAuditEvent != nil {
+		mmCreateAuditEvent.inspectFuncCreateAuditEvent(ctx, event)
+	}
+
+	mm_params := AuditRepositoryMockCreateAuditEventParams{ctx, event}
+
+	// Record call args
+	mmCreateAuditEvent.CreateAuditEventMock.mutex.Lock()
+	mmCreateAuditEvent.CreateAuditEventMock.callArgs = append(mmCreateAuditEvent.CreateAuditEventMock.callArgs, &mm_params)
+	mmCreateAuditEvent.CreateAuditEventMock.mutex.Unlock()
+
+	for _, e := range mmCreateAuditEvent.CreateAuditEventMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCreateAuditEvent.CreateAuditEventMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateAuditEvent.CreateAuditEventMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateAuditEvent.CreateAuditEventMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateAuditEvent.CreateAuditEventMock.defaultExpectation.paramPtrs
+
+		mm_got := AuditRepositoryMockCreateAuditEventParams{ctx, event}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateAuditEvent.t.Errorf("AuditRepositoryMock.CreateAuditEvent got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateAuditEvent.CreateAuditEventMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.event != nil && !minimock.Equal(*mm_want_ptrs.event, mm_got.event) {
+				mmCreateAuditEvent.t.Errorf("AuditRepositoryMock.CreateAuditEvent got unexpected parameter event, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateAuditEvent.CreateAuditEventMock.defaultExpectation.expectationOrigins.originEvent, *mm_want_ptrs.event, mm_got.event, minimock.Diff(*mm_want_ptrs.event, mm_got.event))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateAuditEvent.t.Errorf("AuditRepositoryMock.CreateAuditEvent got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreateAuditEvent.CreateAuditEventMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateAuditEvent.CreateAuditEventMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateAuditEvent.t.Fatal("No results are set for the AuditRepositoryMock.CreateAuditEvent")
+		}
+		return (*mm_results).err
+	}
+	if mmCreateAuditEvent.funcCreateAuditEvent != nil {
+		return mmCreateAuditEvent.funcCreateAuditEvent(ctx, event)
+	}
+	mmCreateAuditEvent.t.Fatalf("Unexpected call to AuditRepositoryMock.CreateAuditEvent. %v %v", ctx, event)
+	return
+}
+
+// CreateAuditEventAfterCounter returns a count of finished AuditRepositoryMock.CreateAuditEvent invocations
+func (mmCreateAuditEvent *AuditRepositoryMock) CreateAuditEventAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateAuditEvent.afterCreateAuditEventCounter)
+}
+
+// CreateAuditEventBeforeCounter returns a count of AuditRepositoryMock.CreateAuditEvent invocations
+func (mmCreateAuditEvent *AuditRepositoryMock) CreateAuditEventBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateAuditEvent.beforeCreateAuditEventCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuditRepositoryMock.CreateAuditEvent.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateAuditEvent *mAuditRepositoryMockCreateAuditEvent) Calls() []*AuditRepositoryMockCreateAuditEventParams {
+	mmCreateAuditEvent.mutex.RLock()
+
+	argCopy := make([]*AuditRepositoryMockCreateAuditEventParams, len(mmCreateAuditEvent.callArgs))
+	copy(argCopy, mmCreateAuditEvent.callArgs)
+
+	mmCreateAuditEvent.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateAuditEventDone returns true if the count of the CreateAuditEvent invocations corresponds
+// the number of defined expectations
+func (m *AuditRepositoryMock) MinimockCreateAuditEventDone() bool {
+	if m.CreateAuditEventMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateAuditEventMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateAuditEventMock.invocationsDone()
+}
+
+// MinimockCreateAuditEventInspect logs each unmet expectation
+func (m *AuditRepositoryMock) MinimockCreateAuditEventInspect() {
+	for _, e := range m.CreateAuditEventMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuditRepositoryMock.CreateAuditEvent at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateAuditEventCounter := mm_atomic.LoadUint64(&m.afterCreateAuditEventCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateAuditEventMock.defaultExpectation != nil && afterCreateAuditEventCounter < 1 {
+		if m.CreateAuditEventMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuditRepositoryMock.CreateAuditEvent at\n%s", m.CreateAuditEventMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AuditRepositoryMock.CreateAuditEvent at\n%s with params: %#v", m.CreateAuditEventMock.defaultExpectation.expectationOrigins.origin, *m.CreateAuditEventMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateAuditEvent != nil && afterCreateAuditEventCounter < 1 {
+		m.t.Errorf("Expected call to AuditRepositoryMock.CreateAuditEvent at\n%s", m.funcCreateAuditEventOrigin)
+	}
+
+	if !m.CreateAuditEventMock.invocationsDone() && afterCreateAuditEventCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuditRepositoryMock.CreateAuditEvent at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateAuditEventMock.expectedInvocations), m.CreateAuditEventMock.expectedInvocationsOrigin, afterCreateAuditEventCounter)
+	}
+}
+
+type mAuditRepositoryMockInTx struct {
+	optional           bool
+	mock               *AuditRepositoryMock
+	defaultExpectation *AuditRepositoryMockInTxExpectation
+	expectations       []*AuditRepositoryMockInTxExpectation
+
+	callArgs []*AuditRepositoryMockInTxParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AuditRepositoryMockInTxExpectation specifies expectation struct of the AuditRepository.InTx
+type AuditRepositoryMockInTxExpectation struct {
+	mock               *AuditRepositoryMock
+	params             *AuditRepositoryMockInTxParams
+	paramPtrs          *AuditRepositoryMockInTxParamPtrs
+	expectationOrigins AuditRepositoryMockInTxExpectationOrigins
+	results            *AuditRepositoryMockInTxResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AuditRepositoryMockInTxParams contains parameters of the AuditRepository.InTx
+type AuditRepositoryMockInTxParams struct {
+	ctx context.Context
+	fn  func(ctx context.Context) error
+}
+
+// AuditRepositoryMockInTxParamPtrs contains pointers to parameters of the AuditRepository.InTx
+type AuditRepositoryMockInTxParamPtrs struct {
+	ctx *context.Context
+	fn  *func(ctx context.Context) error
+}
+
+// AuditRepositoryMockInTxResults contains results of the AuditRepository.InTx
+type AuditRepositoryMockInTxResults struct {
+	err error
+}
+
+// AuditRepositoryMockInTxOrigins contains origins of expectations of the AuditRepository.InTx
+type AuditRepositoryMockInTxExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originFn  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmInTx *mAuditRepositoryMockInTx) Optional() *mAuditRepositoryMockInTx {
+	mmInTx.optional = true
+	return mmInTx
+}
+
+// Expect sets up expected params for AuditRepository.InTx
+func (mmInTx *mAuditRepositoryMockInTx) Expect(ctx context.Context, fn func(ctx context.Context) error) *mAuditRepositoryMockInTx {
+	if mmInTx.mock.funcInTx != nil {
+		mmInTx.mock.t.Fatalf("AuditRepositoryMock.InTx mock is already set by Set")
+	}
+
+	if mmInTx.defaultExpectation == nil {
+		mmInTx.defaultExpectation = &AuditRepositoryMockInTxExpectation{}
+	}
+
+	if mmInTx.defaultExpectation.paramPtrs != nil {
+		mmInTx.mock.t.Fatalf("AuditRepositoryMock.InTx mock is already set by ExpectParams functions")
+	}
+
+	mmInTx.defaultExpectation.params = &AuditRepositoryMockInTxParams{ctx, fn}
+	mmInTx.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmInTx.expectations {
+		if minimock.Equal(e.params, mmInTx.defaultExpectation.params) {
+			mmInTx.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmInTx.defaultExpectation.params)
+		}
+	}
+
+	return mmInTx
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuditRepository.InTx
+func (mmInTx *mAuditRepositoryMockInTx) ExpectCtxParam1(ctx context.Context) *mAuditRepositoryMockInTx {
+	if mmInTx.mock.funcInTx != nil {
+		mmInTx.mock.t.Fatalf("AuditRepositoryMock.InTx mock is already set by Set")
+	}
+
+	if mmInTx.defaultExpectation == nil {
+		mmInTx.defaultExpectation = &AuditRepositoryMockInTxExpectation{}
+	}
+
+	if mmInTx.defaultExpectation.params != nil {
+		mmInTx.mock.t.Fatalf("AuditRepositoryMock.InTx mock is already set by Expect")
+	}
+
+	if mmInTx.defaultExpectation.paramPtrs == nil {
+		mmInTx.defaultExpectation.paramPtrs = &AuditRepositoryMockInTxParamPtrs{}
+	}
+	mmInTx.defaultExpectation.paramPtrs.ctx = &ctx
+	mmInTx.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmInTx
+}
+
+// ExpectFnParam2 sets up expected param fn for AuditRepository.InTx
+func (mmInTx *mAuditRepositoryMockInTx) ExpectFnParam2(fn func(ctx context.Context) error) *mAuditRepositoryMockInTx {
+	if mmInTx.mock.funcInTx != nil {
+		mmInTx.mock.t.Fatalf("AuditRepositoryMock.InTx mock is already set by Set")
+	}
+
+	if mmInTx.defaultExpectation == nil {
+		mmInTx.defaultExpectation = &AuditRepositoryMockInTxExpectation{}
+	}
+
+	if mmInTx.defaultExpectation.params != nil {
+		mmInTx.mock.t.Fatalf("AuditRepositoryMock.InTx mock is already set by Expect")
+	}
+
+	if mmInTx.defaultExpectation.paramPtrs == nil {
+		mmInTx.defaultExpectation.paramPtrs = &AuditRepositoryMockInTxParamPtrs{}
+	}
+	mmInTx.defaultExpectation.paramPtrs.fn = &fn
+	mmInTx.defaultExpectation.expectationOrigins.originFn = minimock.CallerInfo(1)
+
+	return mmInTx
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuditRepository.InTx
+func (mmInTx *mAuditRepositoryMockInTx) Inspect(f func(ctx context.Context, fn func(ctx context.Context) error)) *mAuditRepositoryMockInTx {
+	if mmInTx.mock.inspectFuncInTx != nil {
+		mmInTx.mock.t.Fatalf("Inspect function is already set for AuditRepositoryMock.InTx")
+	}
+
+	mmInTx.mock.inspectFuncInTx = f
+
+	return mmInTx
+}
+
+// Return sets up results that will be returned by AuditRepository.InTx
+func (mmInTx *mAuditRepositoryMockInTx) Return(err error) *AuditRepositoryMock {
+	if mmInTx.mock.funcInTx != nil {
+		mmInTx.mock.t.Fatalf("AuditRepositoryMock.InTx mock is already set by Set")
+	}
+
+	if mmInTx.defaultExpectation == nil {
+		mmInTx.defaultExpectation = &AuditRepositoryMockInTxExpectation{mock: mmInTx.mock}
+	}
+	mmInTx.defaultExpectation.results = &AuditRepositoryMockInTxResults{err}
+	mmInTx.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmInTx.mock
+}
+
+// Set uses given function f to mock the AuditRepository.InTx method
+func (mmInTx *mAuditRepositoryMockInTx) Set(f func(ctx context.Context, fn func(ctx context.Context) error) (err error)) *AuditRepositoryMock {
+	if mmInTx.defaultExpectation != nil {
+		mmInTx.mock.t.Fatalf("Default expectation is already set for the AuditRepository.InTx method")
+	}
+
+	if len(mmInTx.expectations) > 0 {
+		mmInTx.mock.t.Fatalf("Some expectations are already set for the AuditRepository.InTx method")
+	}
+
+	mmInTx.mock.funcInTx = f
+	mmInTx.mock.funcInTxOrigin = minimock.CallerInfo(1)
+	return mmInTx.mock
+}
+
+// When sets expectation for the AuditRepository.InTx which will trigger the result defined by the following
+// Then helper
+func (mmInTx *mAuditRepositoryMockInTx) When(ctx context.Context, fn func(ctx context.Context) error) *AuditRepositoryMockInTxExpectation {
+	if mmInTx.mock.funcInTx != nil {
+		mmInTx.mock.t.Fatalf("AuditRepositoryMock.InTx mock is already set by Set")
+	}
+
+	expectation := &AuditRepositoryMockInTxExpectation{
+		mock:               mmInTx.mock,
+		params:             &AuditRepositoryMockInTxParams{ctx, fn},
+		expectationOrigins: AuditRepositoryMockInTxExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmInTx.expectations = append(mmInTx.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuditRepository.InTx return parameters for the expectation previously defined by the When method
+func (e *AuditRepositoryMockInTxExpectation) Then(err error) *AuditRepositoryMock {
+	e.results = &AuditRepositoryMockInTxResults{err}
+	return e.mock
+}
+
+// Times sets number of times AuditRepository.InTx should be invoked
+func (mmInTx *mAuditRepositoryMockInTx) Times(n uint64) *mAuditRepositoryMockInTx {
+	if n == 0 {
+		mmInTx.mock.t.Fatalf("Times of AuditRepositoryMock.InTx mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmInTx.expectedInvocations, n)
+	mmInTx.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmInTx
+}
+
+func (mmInTx *mAuditRepositoryMockInTx) invocationsDone() bool {
+	if len(mmInTx.expectations) == 0 && mmInTx.defaultExpectation == nil && mmInTx.mock.funcInTx == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmInTx.mock.afterInTxCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmInTx.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// InTx implements AuditRepository
+func (mmInTx *AuditRepositoryMock) InTx(ctx context.Context, fn func(ctx context.Context) error) (err error) {
+	mm_atomic.AddUint64(&mmInTx.beforeInTxCounter, 1)
+	defer mm_atomic.AddUint64(&mmInTx.afterInTxCounter, 1)
+
+	mmInTx.t.Helper()
+
+	if mmInTx.inspectFuncInTx != nil {
+		mmInTx.inspectFuncInTx(ctx, fn)
+	}
+
+	mm_params := AuditRepositoryMockInTxParams{ctx, fn}
+
+	// Record call args
+	mmInTx.InTxMock.mutex.Lock()
+	mmInTx.InTxMock.callArgs = append(mmInTx.InTxMock.callArgs, &mm_params)
+	mmInTx.InTxMock.mutex.Unlock()
+
+	for _, e := range mmInTx.InTxMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmInTx.InTxMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmInTx.InTxMock.defaultExpectation.Counter, 1)
+		mm_want := mmInTx.InTxMock.defaultExpectation.params
+		mm_want_ptrs := mmInTx.InTxMock.defaultExpectation.paramPtrs
+
+		mm_got := AuditRepositoryMockInTxParams{ctx, fn}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmInTx.t.Errorf("AuditRepositoryMock.InTx got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmInTx.InTxMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.fn != nil && !minimock.Equal(*mm_want_ptrs.fn, mm_got.fn) {
+				mmInTx.t.Errorf("AuditRepositoryMock.InTx got unexpected parameter fn, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmInTx.InTxMock.defaultExpectation.expectationOrigins.originFn, *mm_want_ptrs.fn, mm_got.fn, minimock.Diff(*mm_want_ptrs.fn, mm_got.fn))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmInTx.t.Errorf("AuditRepositoryMock.InTx got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmInTx.InTxMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmInTx.InTxMock.defaultExpectation.results
+		if mm_results == nil {
+			mmInTx.t.Fatal("No results are set for the AuditRepositoryMock.InTx")
+		}
+		return (*mm_results).err
+	}
+	if mmInTx.funcInTx != nil {
+		return mmInTx.funcInTx(ctx, fn)
+	}
+	mmInTx.t.Fatalf("Unexpected call to AuditRepositoryMock.InTx. %v %v", ctx, fn)
+	return
+}
+
+// InTxAfterCounter returns a count of finished AuditRepositoryMock.InTx invocations
+func (mmInTx *AuditRepositoryMock) InTxAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmInTx.afterInTxCounter)
+}
+
+// InTxBeforeCounter returns a count of AuditRepositoryMock.InTx invocations
+func (mmInTx *AuditRepositoryMock) InTxBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmInTx.beforeInTxCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuditRepositoryMock.InTx.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmInTx *mAuditRepositoryMockInTx) Calls() []*AuditRepositoryMockInTxParams {
+	mmInTx.mutex.RLock()
+
+	argCopy := make([]*AuditRepositoryMockInTxParams, len(mmInTx.callArgs))
+	copy(argCopy, mmInTx.callArgs)
+
+	mmInTx.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockInTxDone returns true if the count of the InTx invocations corresponds
+// the number of defined expectations
+func (m *AuditRepositoryMock) MinimockInTxDone() bool {
+	if m.InTxMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.InTxMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.InTxMock.invocationsDone()
+}
+
+// MinimockInTxInspect logs each unmet expectation
+func (m *AuditRepositoryMock) MinimockInTxInspect() {
+	for _, e := range m.InTxMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuditRepositoryMock.InTx at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterInTxCounter := mm_atomic.LoadUint64(&m.afterInTxCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.InTxMock.defaultExpectation != nil && afterInTxCounter < 1 {
+		if m.InTxMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuditRepositoryMock.InTx at\n%s", m.InTxMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AuditRepositoryMock.InTx at\n%s with params: %#v", m.InTxMock.defaultExpectation.expectationOrigins.origin, *m.InTxMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcInTx != nil && afterInTxCounter < 1 {
+		m.t.Errorf("Expected call to AuditRepositoryMock.InTx at\n%s", m.funcInTxOrigin)
+	}
+
+	if !m.InTxMock.invocationsDone() && afterInTxCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuditRepositoryMock.InTx at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.InTxMock.expectedInvocations), m.InTxMock.expectedInvocationsOrigin, afterInTxCounter)
+	}
+}
+
+type mAuditRepositoryMockLastAuditCheckpoint struct {
+	optional           bool
+	mock               *AuditRepositoryMock
+	defaultExpectation *AuditRepositoryMockLastAuditCheckpointExpectation
+	expectations       []*AuditRepositoryMockLastAuditCheckpointExpectation
+
+	callArgs []*AuditRepositoryMockLastAuditCheckpointParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AuditRepositoryMockLastAuditCheckpointExpectation specifies expectation struct of the AuditRepository.LastAuditCheckpoint
+type AuditRepositoryMockLastAuditCheckpointExpectation struct {
+	mock               *AuditRepositoryMock
+	params             *AuditRepositoryMockLastAuditCheckpointParams
+	paramPtrs          *AuditRepositoryMockLastAuditCheckpointParamPtrs
+	expectationOrigins AuditRepositoryMockLastAuditCheckpointExpectationOrigins
+	results            *AuditRepositoryMockLastAuditCheckpointResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AuditRepositoryMockLastAuditCheckpointParams contains parameters of the AuditRepository.LastAuditCheckpoint
+type AuditRepositoryMockLastAuditCheckpointParams struct {
+	ctx context.Context
+}
+
+// AuditRepositoryMockLastAuditCheckpointParamPtrs contains pointers to parameters of the AuditRepository.LastAuditCheckpoint
+type AuditRepositoryMockLastAuditCheckpointParamPtrs struct {
+	ctx *context.Context
+}
+
+// AuditRepositoryMockLastAuditCheckpointResults contains results of the AuditRepository.LastAuditCheckpoint
+type AuditRepositoryMockLastAuditCheckpointResults struct {
+	ap1 *models.AuditCheckpoint
+	err error
+}
+
+// AuditRepositoryMockLastAuditCheckpointOrigins contains origins of expectations of the AuditRepository.LastAuditCheckpoint
+type AuditRepositoryMockLastAuditCheckpointExpectationOrigins struct {
+	origin    string
+	originCtx string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmLastAuditCheckpoint *mAuditRepositoryMockLastAuditCheckpoint) Optional() *mAuditRepositoryMockLastAuditCheckpoint {
+	mmLastAuditCheckpoint.optional = true
+	return mmLastAuditCheckpoint
+}
+
+// Expect sets up expected params for AuditRepository.LastAuditCheckpoint
+func (mmLastAuditCheckpoint *mAuditRepositoryMockLastAuditCheckpoint) Expect(ctx context.Context) *mAuditRepositoryMockLastAuditCheckpoint {
+	if mmLastAuditCheckpoint.mock.funcLastAuditCheckpoint != nil {
+		mmLastAuditCheckpoint.mock.t.Fatalf("AuditRepositoryMock.LastAuditCheckpoint mock is already set by Set")
+	}
+
+	if mmLastAuditCheckpoint.defaultExpectation == nil {
+		mmLastAuditCheckpoint.defaultExpectation = &AuditRepositoryMockLastAuditCheckpointExpectation{}
+	}
+
+	if mmLastAuditCheckpoint.defaultExpectation.paramPtrs != nil {
+		mmLastAuditCheckpoint.mock.t.Fatalf("AuditRepositoryMock.LastAuditCheckpoint mock is already set by ExpectParams functions")
+	}
+
+	mmLastAuditCheckpoint.defaultExpectation.params = &AuditRepositoryMockLastAuditCheckpointParams{ctx}
+	mmLastAuditCheckpoint.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmLastAuditCheckpoint.expectations {
+		if minimock.Equal(e.params, mmLastAuditCheckpoint.defaultExpectation.params) {
+			mmLastAuditCheckpoint.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmLastAuditCheckpoint.defaultExpectation.params)
+		}
+	}
+
+	return mmLastAuditCheckpoint
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuditRepository.LastAuditCheckpoint
+func (mmLastAuditCheckpoint *mAuditRepositoryMockLastAuditCheckpoint) ExpectCtxParam1(ctx context.Context) *mAuditRepositoryMockLastAuditCheckpoint {
+	if mmLastAuditCheckpoint.mock.funcLastAuditCheckpoint != nil {
+		mmLastAuditCheckpoint.mock.t.Fatalf("AuditRepositoryMock.LastAuditCheckpoint mock is already set by Set")
+	}
+
+	if mmLastAuditCheckpoint.defaultExpectation == nil {
+		mmLastAuditCheckpoint.defaultExpectation = &AuditRepositoryMockLastAuditCheckpointExpectation{}
+	}
+
+	if mmLastAuditCheckpoint.defaultExpectation.params != nil {
+		mmLastAuditCheckpoint.mock.t.Fatalf("AuditRepositoryMock.LastAuditCheckpoint mock is already set by Expect")
+	}
+
+	if mmLastAuditCheckpoint.defaultExpectation.paramPtrs == nil {
+		mmLastAuditCheckpoint.defaultExpectation.paramPtrs = &AuditRepositoryMockLastAuditCheckpointParamPtrs{}
+	}
+	mmLastAuditCheckpoint.defaultExpectation.paramPtrs.ctx = &ctx
+	mmLastAuditCheckpoint.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmLastAuditCheckpoint
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuditRepository.LastAuditCheckpoint
+func (mmLastAuditCheckpoint *mAuditRepositoryMockLastAuditCheckpoint) Inspect(f func(ctx context.Context)) *mAuditRepositoryMockLastAuditCheckpoint {
+	if mmLastAuditCheckpoint.mock.inspectFuncLastAuditCheckpoint != nil {
+		mmLastAuditCheckpoint.mock.t.Fatalf("Inspect function is already set for AuditRepositoryMock.LastAuditCheckpoint")
+	}
+
+	mmLastAuditCheckpoint.mock.inspectFuncLastAuditCheckpoint = f
+
+	return mmLastAuditCheckpoint
+}
+
+// Return sets up results that will be returned by AuditRepository.LastAuditCheckpoint
+func (mmLastAuditCheckpoint *mAuditRepositoryMockLastAuditCheckpoint) Return(ap1 *models.AuditCheckpoint, err error) *AuditRepositoryMock {
+	if mmLastAuditCheckpoint.mock.funcLastAuditCheckpoint != nil {
+		mmLastAuditCheckpoint.mock.t.Fatalf("AuditRepositoryMock.LastAuditCheckpoint mock is already set by Set")
+	}
+
+	if mmLastAuditCheckpoint.defaultExpectation == nil {
+		mmLastAuditCheckpoint.defaultExpectation = &AuditRepositoryMockLastAuditCheckpointExpectation{mock: mmLastAuditCheckpoint.mock}
+	}
+	mmLastAuditCheckpoint.defaultExpectation.results = &AuditRepositoryMockLastAuditCheckpointResults{ap1, err}
+	mmLastAuditCheckpoint.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmLastAuditCheckpoint.mock
+}
+
+// Set uses given function f to mock the AuditRepository.LastAuditCheckpoint method
+func (mmLastAuditCheckpoint *mAuditRepositoryMockLastAuditCheckpoint) Set(f func(ctx context.Context) (ap1 *models.AuditCheckpoint, err error)) *AuditRepositoryMock {
+	if mmLastAuditCheckpoint.defaultExpectation != nil {
+		mmLastAuditCheckpoint.mock.t.Fatalf("Default expectation is already set for the AuditRepository.LastAuditCheckpoint method")
+	}
+
+	if len(mmLastAuditCheckpoint.expectations) > 0 {
+		mmLastAuditCheckpoint.mock.t.Fatalf("Some expectations are already set for the AuditRepository.LastAuditCheckpoint method")
+	}
+
+	mmLastAuditCheckpoint.mock.funcLastAuditCheckpoint = f
+	mmLastAuditCheckpoint.mock.funcLastAuditCheckpointOrigin = minimock.CallerInfo(1)
+	return mmLastAuditCheckpoint.mock
+}
+
+// When sets expectation for the AuditRepository.LastAuditCheckpoint which will trigger the result defined by the following
+// Then helper
+func (mmLastAuditCheckpoint *mAuditRepositoryMockLastAuditCheckpoint) When(ctx context.Context) *AuditRepositoryMockLastAuditCheckpointExpectation {
+	if mmLastAuditCheckpoint.mock.funcLastAuditCheckpoint != nil {
+		mmLastAuditCheckpoint.mock.t.Fatalf("AuditRepositoryMock.LastAuditCheckpoint mock is already set by Set")
+	}
+
+	expectation := &AuditRepositoryMockLastAuditCheckpointExpectation{
+		mock:               mmLastAuditCheckpoint.mock,
+		params:             &AuditRepositoryMockLastAuditCheckpointParams{ctx},
+		expectationOrigins: AuditRepositoryMockLastAuditCheckpointExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmLastAuditCheckpoint.expectations = append(mmLastAuditCheckpoint.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuditRepository.LastAuditCheckpoint return parameters for the expectation previously defined by the When method
+func (e *AuditRepositoryMockLastAuditCheckpointExpectation) Then(ap1 *models.AuditCheckpoint, err error) *AuditRepositoryMock {
+	e.results = &AuditRepositoryMockLastAuditCheckpointResults{ap1, err}
+	return e.mock
+}
+
+// Times sets number of times AuditRepository.LastAuditCheckpoint should be invoked
+func (mmLastAuditCheckpoint *mAuditRepositoryMockLastAuditCheckpoint) Times(n uint64) *mAuditRepositoryMockLastAuditCheckpoint {
+	if n == 0 {
+		mmLastAuditCheckpoint.mock.t.Fatalf("Times of AuditRepositoryMock.LastAuditCheckpoint mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmLastAuditCheckpoint.expectedInvocations, n)
+	mmLastAuditCheckpoint.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmLastAuditCheckpoint
+}
+
+func (mmLastAuditCheckpoint *mAuditRepositoryMockLastAuditCheckpoint) invocationsDone() bool {
+	if len(mmLastAuditCheckpoint.expectations) == 0 && mmLastAuditCheckpoint.defaultExpectation == nil && mmLastAuditCheckpoint.mock.funcLastAuditCheckpoint == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmLastAuditCheckpoint.mock.afterLastAuditCheckpointCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmLastAuditCheckpoint.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// LastAuditCheckpoint implements AuditRepository
+func (mmLastAuditCheckpoint *AuditRepositoryMock) LastAuditCheckpoint(ctx context.Context) (ap1 *models.AuditCheckpoint, err error) {
+	mm_atomic.AddUint64(&mmLastAuditCheckpoint.beforeLastAuditCheckpointCounter, 1)
+	defer mm_atomic.AddUint64(&mmLastAuditCheckpoint.afterLastAuditCheckpointCounter, 1)
+
+	mmLastAuditCheckpoint.t.Helper()
+
+	if mmLastAuditCheckpoint.inspectFuncLastAuditCheckpoint != nil {
+		mmLastAuditCheckpoint.inspectFuncLastAuditCheckpoint(ctx)
+	}
+
+	mm_params := AuditRepositoryMockLastAuditCheckpointParams{ctx}
+
+	// Record call args
+	mmLastAuditCheckpoint.LastAuditCheckpointMock.mutex.Lock()
+	mmLastAuditCheckpoint.LastAuditCheckpointMock.callArgs = append(mmLastAuditCheckpoint.LastAuditCheckpointMock.callArgs, &mm_params)
+	mmLastAuditCheckpoint.LastAuditCheckpointMock.mutex.Unlock()
+
+	for _, e := range mmLastAuditCheckpoint.LastAuditCheckpointMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ap1, e.results.err
+		}
+	}
+
+	if mmLastAuditCheckpoint.LastAuditCheckpointMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmLastAuditCheckpoint.LastAuditCheckpointMock.defaultExpectation.Counter, 1)
+		mm_want := mmLastAuditCheckpoint.LastAuditCheckpointMock.defaultExpectation.params
+		mm_want_ptrs := mmLastAuditCheckpoint.LastAuditCheckpointMock.defaultExpectation.paramPtrs
+
+		mm_got := AuditRepositoryMockLastAuditCheckpointParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmLastAuditCheckpoint.t.Errorf("AuditRepositoryMock.LastAuditCheckpoint got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmLastAuditCheckpoint.LastAuditCheckpointMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmLastAuditCheckpoint.t.Errorf("AuditRepositoryMock.LastAuditCheckpoint got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmLastAuditCheckpoint.LastAuditCheckpointMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmLastAuditCheckpoint.LastAuditCheckpointMock.defaultExpectation.results
+		if mm_results == nil {
+			mmLastAuditCheckpoint.t.Fatal("No results are set for the AuditRepositoryMock.LastAuditCheckpoint")
+		}
+		return (*mm_results).ap1, (*mm_results).err
+	}
+	if mmLastAuditCheckpoint.funcLastAuditCheckpoint != nil {
+		return mmLastAuditCheckpoint.funcLastAuditCheckpoint(ctx)
+	}
+	mmLastAuditCheckpoint.t.Fatalf("Unexpected call to AuditRepositoryMock.LastAuditCheckpoint. %v", ctx)
+	return
+}
+
+// LastAuditCheckpointAfterCounter returns a count of finished AuditRepositoryMock.LastAuditCheckpoint invocations
+func (mmLastAuditCheckpoint *AuditRepositoryMock) LastAuditCheckpointAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLastAuditCheckpoint.afterLastAuditCheckpointCounter)
+}
+
+// LastAuditCheckpointBeforeCounter returns a count of AuditRepositoryMock.LastAuditCheckpoint invocations
+func (mmLastAuditCheckpoint *AuditRepositoryMock) LastAuditCheckpointBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLastAuditCheckpoint.beforeLastAuditCheckpointCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuditRepositoryMock.LastAuditCheckpoint.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmLastAuditCheckpoint *mAuditRepositoryMockLastAuditCheckpoint) Calls() []*AuditRepositoryMockLastAuditCheckpointParams {
+	mmLastAuditCheckpoint.mutex.RLock()
+
+	argCopy := make([]*AuditRepositoryMockLastAuditCheckpointParams, len(mmLastAuditCheckpoint.callArgs))
+	copy(argCopy, mmLastAuditCheckpoint.callArgs)
+
+	mmLastAuditCheckpoint.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockLastAuditCheckpointDone returns true if the count of the LastAuditCheckpoint invocations corresponds
+// the number of defined expectations
+func (m *AuditRepositoryMock) MinimockLastAuditCheckpointDone() bool {
+	if m.LastAuditCheckpointMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.LastAuditCheckpointMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.LastAuditCheckpointMock.invocationsDone()
+}
+
+// MinimockLastAuditCheckpointInspect logs each unmet expectation
+func (m *AuditRepositoryMock) MinimockLastAuditCheckpointInspect() {
+	for _, e := range m.LastAuditCheckpointMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuditRepositoryMock.LastAuditCheckpoint at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterLastAuditCheckpointCounter := mm_atomic.LoadUint64(&m.afterLastAuditCheckpointCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.LastAuditCheckpointMock.defaultExpectation != nil && afterLastAuditCheckpointCounter < 1 {
+		if m.LastAuditCheckpointMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuditRepositoryMock.LastAuditCheckpoint at\n%s", m.LastAuditCheckpointMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AuditRepositoryMock.LastAuditCheckpoint at\n%s with params: %#v", m.LastAuditCheckpointMock.defaultExpectation.expectationOrigins.origin, *m.LastAuditCheckpointMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcLastAuditCheckpoint != nil && afterLastAuditCheckpointCounter < 1 {
+		m.t.Errorf("Expected call to AuditRepositoryMock.LastAuditCheckpoint at\n%s", m.funcLastAuditCheckpointOrigin)
+	}
+
+	if !m.LastAuditCheckpointMock.invocationsDone() && afterLastAuditCheckpointCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuditRepositoryMock.LastAuditCheckpoint at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.LastAuditCheckpointMock.expectedInvocations), m.LastAuditCheckpointMock.expectedInvocationsOrigin, afterLastAuditCheckpointCounter)
+	}
+}
+
+type mAuditRepositoryMockLastAuditEvent struct {
+	optional           bool
+	mock               *AuditRepositoryMock
+	defaultExpectation *AuditRepositoryMockLastAuditEventExpectation
+	expectations       []*AuditRepositoryMockLastAuditEventExpectation
+
+	callArgs []*AuditRepositoryMockLastAuditEventParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AuditRepositoryMockLastAuditEventExpectation specifies expectation struct of the AuditRepository.LastAuditEvent
+type AuditRepositoryMockLastAuditEventExpectation struct {
+	mock               *AuditRepositoryMock
+	params             *AuditRepositoryMockLastAuditEventParams
+	paramPtrs          *AuditRepositoryMockLastAuditEventParamPtrs
+	expectationOrigins AuditRepositoryMockLastAuditEventExpectationOrigins
+	results            *AuditRepositoryMockLastAuditEventResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AuditRepositoryMockLastAuditEventParams contains parameters of the AuditRepository.LastAuditEvent
+type AuditRepositoryMockLastAuditEventParams struct {
+	ctx context.Context
+}
+
+// AuditRepositoryMockLastAuditEventParamPtrs contains pointers to parameters of the AuditRepository.LastAuditEvent
+type AuditRepositoryMockLastAuditEventParamPtrs struct {
+	ctx *context.Context
+}
+
+// AuditRepositoryMockLastAuditEventResults contains results of the AuditRepository.LastAuditEvent
+type AuditRepositoryMockLastAuditEventResults struct {
+	ap1 *models.AuditEvent
+	err error
+}
+
+// AuditRepositoryMockLastAuditEventOrigins contains origins of expectations of the AuditRepository.LastAuditEvent
+type AuditRepositoryMockLastAuditEventExpectationOrigins struct {
+	origin    string
+	originCtx string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmLastAuditEvent *mAuditRepositoryMockLastAuditEvent) Optional() *mAuditRepositoryMockLastAuditEvent {
+	mmLastAuditEvent.optional = true
+	return mmLastAuditEvent
+}
+
+// Expect sets up expected params for AuditRepository.LastAuditEvent
+func (mmLastAuditEvent *mAuditRepositoryMockLastAuditEvent) Expect(ctx context.Context) *mAuditRepositoryMockLastAuditEvent {
+	if mmLastAuditEvent.mock.funcLastAuditEvent != nil {
+		mmLastAuditEvent.mock.t.Fatalf("AuditRepositoryMock.LastAuditEvent mock is already set by Set")
+	}
+
+	if mmLastAuditEvent.defaultExpectation == nil {
+		mmLastAuditEvent.defaultExpectation = &AuditRepositoryMockLastAuditEventExpectation{}
+	}
+
+	if mmLastAuditEvent.defaultExpectation.paramPtrs != nil {
+		mmLastAuditEvent.mock.t.Fatalf("AuditRepositoryMock.LastAuditEvent mock is already set by ExpectParams functions")
+	}
+
+	mmLastAuditEvent.defaultExpectation.params = &AuditRepositoryMockLastAuditEventParams{ctx}
+	mmLastAuditEvent.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmLastAuditEvent.expectations {
+		if minimock.Equal(e.params, mmLastAuditEvent.defaultExpectation.params) {
+			mmLastAuditEvent.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmLastAuditEvent.defaultExpectation.params)
+		}
+	}
+
+	return mmLastAuditEvent
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuditRepository.LastAuditEvent
+func (mmLastAuditEvent *mAuditRepositoryMockLastAuditEvent) ExpectCtxParam1(ctx context.Context) *mAuditRepositoryMockLastAuditEvent {
+	if mmLastAuditEvent.mock.funcLastAuditEvent != nil {
+		mmLastAuditEvent.mock.t.Fatalf("AuditRepositoryMock.LastAuditEvent mock is already set by Set")
+	}
+
+	if mmLastAuditEvent.defaultExpectation == nil {
+		mmLastAuditEvent.defaultExpectation = &AuditRepositoryMockLastAuditEventExpectation{}
+	}
+
+	if mmLastAuditEvent.defaultExpectation.params != nil {
+		mmLastAuditEvent.mock.t.Fatalf("AuditRepositoryMock.LastAuditEvent mock is already set by Expect")
+	}
+
+	if mmLastAuditEvent.defaultExpectation.paramPtrs == nil {
+		mmLastAuditEvent.defaultExpectation.paramPtrs = &AuditRepositoryMockLastAuditEventParamPtrs{}
+	}
+	mmLastAuditEvent.defaultExpectation.paramPtrs.ctx = &ctx
+	mmLastAuditEvent.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmLastAuditEvent
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuditRepository.LastAuditEvent
+func (mmLastAuditEvent *mAuditRepositoryMockLastAuditEvent) Inspect(f func(ctx context.Context)) *mAuditRepositoryMockLastAuditEvent {
+	if mmLastAuditEvent.mock.inspectFuncLastAuditEvent != nil {
+		mmLastAuditEvent.mock.t.Fatalf("Inspect function is already set for AuditRepositoryMock.LastAuditEvent")
+	}
+
+	mmLastAuditEvent.mock.inspectFuncLastAuditEvent = f
+
+	return mmLastAuditEvent
+}
+
+// Return sets up results that will be returned by AuditRepository.LastAuditEvent
+func (mmLastAuditEvent *mAuditRepositoryMockLastAuditEvent) Return(ap1 *models.AuditEvent, err error) *AuditRepositoryMock {
+	if mmLastAuditEvent.mock.funcLastAuditEvent != nil {
+		mmLastAuditEvent.mock.t.Fatalf("AuditRepositoryMock.LastAuditEvent mock is already set by Set")
+	}
+
+	if mmLastAuditEvent.defaultExpectation == nil {
+		mmLastAuditEvent.defaultExpectation = &AuditRepositoryMockLastAuditEventExpectation{mock: mmLastAuditEvent.mock}
+	}
+	mmLastAuditEvent.defaultExpectation.results = &AuditRepositoryMockLastAuditEventResults{ap1, err}
+	mmLastAuditEvent.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmLastAuditEvent.mock
+}
+
+// Set uses given function f to mock the AuditRepository.LastAuditEvent method
+func (mmLastAuditEvent *mAuditRepositoryMockLastAuditEvent) Set(f func(ctx context.Context) (ap1 *models.AuditEvent, err error)) *AuditRepositoryMock {
+	if mmLastAuditEvent.defaultExpectation != nil {
+		mmLastAuditEvent.mock.t.Fatalf("Default expectation is already set for the AuditRepository.LastAuditEvent method")
+	}
+
+	if len(mmLastAuditEvent.expectations) > 0 {
+		mmLastAuditEvent.mock.t.Fatalf("Some expectations are already set for the AuditRepository.LastAuditEvent method")
+	}
+
+	mmLastAuditEvent.mock.funcLastAuditEvent = f
+	mmLastAuditEvent.mock.funcLastAuditEventOrigin = minimock.CallerInfo(1)
+	return mmLastAuditEvent.mock
+}
+
+// When sets expectation for the AuditRepository.LastAuditEvent which will trigger the result defined by the following
+// Then helper
+func (mmLastAuditEvent *mAuditRepositoryMockLastAuditEvent) When(ctx context.Context) *AuditRepositoryMockLastAuditEventExpectation {
+	if mmLastAuditEvent.mock.funcLastAuditEvent != nil {
+		mmLastAuditEvent.mock.t.Fatalf("AuditRepositoryMock.LastAuditEvent mock is already set by Set")
+	}
+
+	expectation := &AuditRepositoryMockLastAuditEventExpectation{
+		mock:               mmLastAuditEvent.mock,
+		params:             &AuditRepositoryMockLastAuditEventParams{ctx},
+		expectationOrigins: AuditRepositoryMockLastAuditEventExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmLastAuditEvent.expectations = append(mmLastAuditEvent.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuditRepository.LastAuditEvent return parameters for the expectation previously defined by the When method
+func (e *AuditRepositoryMockLastAuditEventExpectation) Then(ap1 *models.AuditEvent, err error) *AuditRepositoryMock {
+	e.results = &AuditRepositoryMockLastAuditEventResults{ap1, err}
+	return e.mock
+}
+
+// Times sets number of times AuditRepository.LastAuditEvent should be invoked
+func (mmLastAuditEvent *mAuditRepositoryMockLastAuditEvent) Times(n uint64) *mAuditRepositoryMockLastAuditEvent {
+	if n == 0 {
+		mmLastAuditEvent.mock.t.Fatalf("Times of AuditRepositoryMock.LastAuditEvent mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmLastAuditEvent.expectedInvocations, n)
+	mmLastAuditEvent.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmLastAuditEvent
+}
+
+func (mmLastAuditEvent *mAuditRepositoryMockLastAuditEvent) invocationsDone() bool {
+	if len(mmLastAuditEvent.expectations) == 0 && mmLastAuditEvent.defaultExpectation == nil && mmLastAuditEvent.mock.funcLastAuditEvent == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmLastAuditEvent.mock.afterLastAuditEventCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmLastAuditEvent.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// LastAuditEvent implements AuditRepository
+func (mmLastAuditEvent *AuditRepositoryMock) LastAuditEvent(ctx context.Context) (ap1 *models.AuditEvent, err error) {
+	mm_atomic.AddUint64(&mmLastAuditEvent.beforeLastAuditEventCounter, 1)
+	defer mm_atomic.AddUint64(&mmLastAuditEvent.afterLastAuditEventCounter, 1)
+
+	mmLastAuditEvent.t.Helper()
+
+	if mmLastAuditEvent.inspectFuncLastAuditEvent != nil {
+		mmLastAuditEvent.inspectFuncLastAuditEvent(ctx)
+	}
+
+	mm_params := AuditRepositoryMockLastAuditEventParams{ctx}
+
+	// Record call args
+	mmLastAuditEvent.LastAuditEventMock.mutex.Lock()
+	mmLastAuditEvent.LastAuditEventMock.callArgs = append(mmLastAuditEvent.LastAuditEventMock.callArgs, &mm_params)
+	mmLastAuditEvent.LastAuditEventMock.mutex.Unlock()
+
+	for _, e := range mmLastAuditEvent.LastAuditEventMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ap1, e.results.err
+		}
+	}
+
+	if mmLastAuditEvent.LastAuditEventMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmLastAuditEvent.LastAuditEventMock.defaultExpectation.Counter, 1)
+		mm_want := mmLastAuditEvent.LastAuditEventMock.defaultExpectation.params
+		mm_want_ptrs := mmLastAuditEvent.LastAuditEventMock.defaultExpectation.paramPtrs
+
+		mm_got := AuditRepositoryMockLastAuditEventParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmLastAuditEvent.t.Errorf("AuditRepositoryMock.LastAuditEvent got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmLastAuditEvent.LastAuditEventMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmLastAuditEvent.t.Errorf("AuditRepositoryMock.LastAuditEvent got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmLastAuditEvent.LastAuditEventMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmLastAuditEvent.LastAuditEventMock.defaultExpectation.results
+		if mm_results == nil {
+			mmLastAuditEvent.t.Fatal("No results are set for the AuditRepositoryMock.LastAuditEvent")
+		}
+		return (*mm_results).ap1, (*mm_results).err
+	}
+	if mmLastAuditEvent.funcLastAuditEvent != nil {
+		return mmLastAuditEvent.funcLastAuditEvent(ctx)
+	}
+	mmLastAuditEvent.t.Fatalf("Unexpected call to AuditRepositoryMock.LastAuditEvent. %v", ctx)
+	return
+}
+
+// LastAuditEventAfterCounter returns a count of finished AuditRepositoryMock.LastAuditEvent invocations
+func (mmLastAuditEvent *AuditRepositoryMock) LastAuditEventAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLastAuditEvent.afterLastAuditEventCounter)
+}
+
+// LastAuditEventBeforeCounter returns a count of AuditRepositoryMock.LastAuditEvent invocations
+func (mmLastAuditEvent *AuditRepositoryMock) LastAuditEventBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLastAuditEvent.beforeLastAuditEventCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuditRepositoryMock.LastAuditEvent.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmLastAuditEvent *mAuditRepositoryMockLastAuditEvent) Calls() []*AuditRepositoryMockLastAuditEventParams {
+	mmLastAuditEvent.mutex.RLock()
+
+	argCopy := make([]*AuditRepositoryMockLastAuditEventParams, len(mmLastAuditEvent.callArgs))
+	copy(argCopy, mmLastAuditEvent.callArgs)
+
+	mmLastAuditEvent.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockLastAuditEventDone returns true if the count of the LastAuditEvent invocations corresponds
+// the number of defined expectations
+func (m *AuditRepositoryMock) MinimockLastAuditEventDone() bool {
+	if m.LastAuditEventMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.LastAuditEventMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.LastAuditEventMock.invocationsDone()
+}
+
+// MinimockLastAuditEventInspect logs each unmet expectation
+func (m *AuditRepositoryMock) MinimockLastAuditEventInspect() {
+	for _, e := range m.LastAuditEventMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuditRepositoryMock.LastAuditEvent at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterLastAuditEventCounter := mm_atomic.LoadUint64(&m.afterLastAuditEventCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.LastAuditEventMock.defaultExpectation != nil && afterLastAuditEventCounter < 1 {
+		if m.LastAuditEventMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuditRepositoryMock.LastAuditEvent at\n%s", m.LastAuditEventMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AuditRepositoryMock.LastAuditEvent at\n%s with params: %#v", m.LastAuditEventMock.defaultExpectation.expectationOrigins.origin, *m.LastAuditEventMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcLastAuditEvent != nil && afterLastAuditEventCounter < 1 {
+		m.t.Errorf("Expected call to AuditRepositoryMock.LastAuditEvent at\n%s", m.funcLastAuditEventOrigin)
+	}
+
+	if !m.LastAuditEventMock.invocationsDone() && afterLastAuditEventCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuditRepositoryMock.LastAuditEvent at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.LastAuditEventMock.expectedInvocations), m.LastAuditEventMock.expectedInvocationsOrigin, afterLastAuditEventCounter)
+	}
+}
+
+type mAuditRepositoryMockListAuditCheckpoints struct {
+	optional           bool
+	mock               *AuditRepositoryMock
+	defaultExpectation *AuditRepositoryMockListAuditCheckpointsExpectation
+	expectations       []*AuditRepositoryMockListAuditCheckpointsExpectation
+
+	callArgs []*AuditRepositoryMockListAuditCheckpointsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AuditRepositoryMockListAuditCheckpointsExpectation specifies expectation struct of the AuditRepository.ListAuditCheckpoints
+type AuditRepositoryMockListAuditCheckpointsExpectation struct {
+	mock               *AuditRepositoryMock
+	params             *AuditRepositoryMockListAuditCheckpointsParams
+	paramPtrs          *AuditRepositoryMockListAuditCheckpointsParamPtrs
+	expectationOrigins AuditRepositoryMockListAuditCheckpointsExpectationOrigins
+	results            *AuditRepositoryMockListAuditCheckpointsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AuditRepositoryMockListAuditCheckpointsParams contains parameters of the AuditRepository.ListAuditCheckpoints
+type AuditRepositoryMockListAuditCheckpointsParams struct {
+	ctx context.Context
+}
+
+// AuditRepositoryMockListAuditCheckpointsParamPtrs contains pointers to parameters of the AuditRepository.ListAuditCheckpoints
+type AuditRepositoryMockListAuditCheckpointsParamPtrs struct {
+	ctx *context.Context
+}
+
+// AuditRepositoryMockListAuditCheckpointsResults contains results of the AuditRepository.ListAuditCheckpoints
+type AuditRepositoryMockListAuditCheckpointsResults struct {
+	aa1 []models.AuditCheckpoint
+	err error
+}
+
+// AuditRepositoryMockListAuditCheckpointsOrigins contains origins of expectations of the AuditRepository.ListAuditCheckpoints
+type AuditRepositoryMockListAuditCheckpointsExpectationOrigins struct {
+	origin    string
+	originCtx string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListAuditCheckpoints *mAuditRepositoryMockListAuditCheckpoints) Optional() *mAuditRepositoryMockListAuditCheckpoints {
+	mmListAuditCheckpoints.optional = true
+	return mmListAuditCheckpoints
+}
+
+// Expect sets up expected params for AuditRepository.ListAuditCheckpoints
+func (mmListAuditCheckpoints *mAuditRepositoryMockListAuditCheckpoints) Expect(ctx context.Context) *mAuditRepositoryMockListAuditCheckpoints {
+	if mmListAuditCheckpoints.mock.funcListAuditCheckpoints != nil {
+		mmListAuditCheckpoints.mock.t.Fatalf("AuditRepositoryMock.ListAuditCheckpoints mock is already set by Set")
+	}
+
+	if mmListAuditCheckpoints.defaultExpectation == nil {
+		mmListAuditCheckpoints.defaultExpectation = &AuditRepositoryMockListAuditCheckpointsExpectation{}
+	}
+
+	if mmListAuditCheckpoints.defaultExpectation.paramPtrs != nil {
+		mmListAuditCheckpoints.mock.t.Fatalf("AuditRepositoryMock.ListAuditCheckpoints mock is already set by ExpectParams functions")
+	}
+
+	mmListAuditCheckpoints.defaultExpectation.params = &AuditRepositoryMockListAuditCheckpointsParams{ctx}
+	mmListAuditCheckpoints.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListAuditCheckpoints.expectations {
+		if minimock.Equal(e.params, mmListAuditCheckpoints.defaultExpectation.params) {
+			mmListAuditCheckpoints.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListAuditCheckpoints.defaultExpectation.params)
+		}
+	}
+
+	return mmListAuditCheckpoints
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuditRepository.ListAuditCheckpoints
+func (mmListAuditCheckpoints *mAuditRepositoryMockListAuditCheckpoints) ExpectCtxParam1(ctx context.Context) *mAuditRepositoryMockListAuditCheckpoints {
+	if mmListAuditCheckpoints.mock.funcListAuditCheckpoints != nil {
+		mmListAuditCheckpoints.mock.t.Fatalf("AuditRepositoryMock.ListAuditCheckpoints mock is already set by Set")
+	}
+
+	if mmListAuditCheckpoints.defaultExpectation == nil {
+		mmListAuditCheckpoints.defaultExpectation = &AuditRepositoryMockListAuditCheckpointsExpectation{}
+	}
+
+	if mmListAuditCheckpoints.defaultExpectation.params != nil {
+		mmListAuditCheckpoints.mock.t.Fatalf("AuditRepositoryMock.ListAuditCheckpoints mock is already set by Expect")
+	}
+
+	if mmListAuditCheckpoints.defaultExpectation.paramPtrs == nil {
+		mmListAuditCheckpoints.defaultExpectation.paramPtrs = &AuditRepositoryMockListAuditCheckpointsParamPtrs{}
+	}
+	mmListAuditCheckpoints.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListAuditCheckpoints.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListAuditCheckpoints
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuditRepository.ListAuditCheckpoints
+func (mmListAuditCheckpoints *mAuditRepositoryMockListAuditCheckpoints) Inspect(f func(ctx context.Context)) *mAuditRepositoryMockListAuditCheckpoints {
+	if mmListAuditCheckpoints.mock.inspectFuncListAuditCheckpoints != nil {
+		mmListAuditCheckpoints.mock.t.Fatalf("Inspect function is already set for AuditRepositoryMock.ListAuditCheckpoints")
+	}
+
+	mmListAuditCheckpoints.mock.inspectFuncListAuditCheckpoints = f
+
+	return mmListAuditCheckpoints
+}
+
+// Return sets up results that will be returned by AuditRepository.ListAuditCheckpoints
+func (mmListAuditCheckpoints *mAuditRepositoryMockListAuditCheckpoints) Return(aa1 []models.AuditCheckpoint, err error) *AuditRepositoryMock {
+	if mmListAuditCheckpoints.mock.funcListAuditCheckpoints != nil {
+		mmListAuditCheckpoints.mock.t.Fatalf("AuditRepositoryMock.ListAuditCheckpoints mock is already set by Set")
+	}
+
+	if mmListAuditCheckpoints.defaultExpectation == nil {
+		mmListAuditCheckpoints.defaultExpectation = &AuditRepositoryMockListAuditCheckpointsExpectation{mock: mmListAuditCheckpoints.mock}
+	}
+	mmListAuditCheckpoints.defaultExpectation.results = &AuditRepositoryMockListAuditCheckpointsResults{aa1, err}
+	mmListAuditCheckpoints.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListAuditCheckpoints.mock
+}
+
+// Set uses given function f to mock the AuditRepository.ListAuditCheckpoints method
+func (mmListAuditCheckpoints *mAuditRepositoryMockListAuditCheckpoints) Set(f func(ctx context.Context) (aa1 []models.AuditCheckpoint, err error)) *AuditRepositoryMock {
+	if mmListAuditCheckpoints.defaultExpectation != nil {
+		mmListAuditCheckpoints.mock.t.Fatalf("Default expectation is already set for the AuditRepository.ListAuditCheckpoints method")
+	}
+
+	if len(mmListAuditCheckpoints.expectations) > 0 {
+		mmListAuditCheckpoints.mock.t.Fatalf("Some expectations are already set for the AuditRepository.ListAuditCheckpoints method")
+	}
+
+	mmListAuditCheckpoints.mock.funcListAuditCheckpoints = f
+	mmListAuditCheckpoints.mock.funcListAuditCheckpointsOrigin = minimock.CallerInfo(1)
+	return mmListAuditCheckpoints.mock
+}
+
+// When sets expectation for the AuditRepository.ListAuditCheckpoints which will trigger the result defined by the following
+// Then helper
+func (mmListAuditCheckpoints *mAuditRepositoryMockListAuditCheckpoints) When(ctx context.Context) *AuditRepositoryMockListAuditCheckpointsExpectation {
+	if mmListAuditCheckpoints.mock.funcListAuditCheckpoints != nil {
+		mmListAuditCheckpoints.mock.t.Fatalf("AuditRepositoryMock.ListAuditCheckpoints mock is already set by Set")
+	}
+
+	expectation := &AuditRepositoryMockListAuditCheckpointsExpectation{
+		mock:               mmListAuditCheckpoints.mock,
+		params:             &AuditRepositoryMockListAuditCheckpointsParams{ctx},
+		expectationOrigins: AuditRepositoryMockListAuditCheckpointsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListAuditCheckpoints.expectations = append(mmListAuditCheckpoints.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuditRepository.ListAuditCheckpoints return parameters for the expectation previously defined by the When method
+func (e *AuditRepositoryMockListAuditCheckpointsExpectation) Then(aa1 []models.AuditCheckpoint, err error) *AuditRepositoryMock {
+	e.results = &AuditRepositoryMockListAuditCheckpointsResults{aa1, err}
+	return e.mock
+}
+
+// Times sets number of times AuditRepository.ListAuditCheckpoints should be invoked
+func (mmListAuditCheckpoints *mAuditRepositoryMockListAuditCheckpoints) Times(n uint64) *mAuditRepositoryMockListAuditCheckpoints {
+	if n == 0 {
+		mmListAuditCheckpoints.mock.t.Fatalf("Times of AuditRepositoryMock.ListAuditCheckpoints mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListAuditCheckpoints.expectedInvocations, n)
+	mmListAuditCheckpoints.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListAuditCheckpoints
+}
+
+func (mmListAuditCheckpoints *mAuditRepositoryMockListAuditCheckpoints) invocationsDone() bool {
+	if len(mmListAuditCheckpoints.expectations) == 0 && mmListAuditCheckpoints.defaultExpectation == nil && mmListAuditCheckpoints.mock.funcListAuditCheckpoints == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListAuditCheckpoints.mock.afterListAuditCheckpointsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListAuditCheckpoints.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListAuditCheckpoints implements AuditRepository
+func (mmListAuditCheckpoints *AuditRepositoryMock) ListAuditCheckpoints(ctx context.Context) (aa1 []models.AuditCheckpoint, err error) {
+	mm_atomic.AddUint64(&mmListAuditCheckpoints.beforeListAuditCheckpointsCounter, 1)
+	defer mm_atomic.AddUint64(&mmListAuditCheckpoints.afterListAuditCheckpointsCounter, 1)
+
+	mmListAuditCheckpoints.t.Helper()
+
+	if mmListAuditCheckpoints.inspectFuncListAuditCheckpoints != nil {
+		mmListAuditCheckpoints.inspectFuncListAuditCheckpoints(ctx)
+	}
+
+	mm_params := AuditRepositoryMockListAuditCheckpointsParams{ctx}
+
+	// Record call args
+	mmListAuditCheckpoints.ListAuditCheckpointsMock.mutex.Lock()
+	mmListAuditCheckpoints.ListAuditCheckpointsMock.callArgs = append(mmListAuditCheckpoints.ListAuditCheckpointsMock.callArgs, &mm_params)
+	mmListAuditCheckpoints.ListAuditCheckpointsMock.mutex.Unlock()
+
+	for _, e := range mmListAuditCheckpoints.ListAuditCheckpointsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.aa1, e.results.err
+		}
+	}
+
+	if mmListAuditCheckpoints.ListAuditCheckpointsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListAuditCheckpoints.ListAuditCheckpointsMock.defaultExpectation.Counter, 1)
+		mm_want := mmListAuditCheckpoints.ListAuditCheckpointsMock.defaultExpectation.params
+		mm_want_ptrs := mmListAuditCheckpoints.ListAuditCheckpointsMock.defaultExpectation.paramPtrs
+
+		mm_got := AuditRepositoryMockListAuditCheckpointsParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListAuditCheckpoints.t.Errorf("AuditRepositoryMock.ListAuditCheckpoints got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListAuditCheckpoints.ListAuditCheckpointsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListAuditCheckpoints.t.Errorf("AuditRepositoryMock.ListAuditCheckpoints got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListAuditCheckpoints.ListAuditCheckpointsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListAuditCheckpoints.ListAuditCheckpointsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListAuditCheckpoints.t.Fatal("No results are set for the AuditRepositoryMock.ListAuditCheckpoints")
+		}
+		return (*mm_results).aa1, (*mm_results).err
+	}
+	if mmListAuditCheckpoints.funcListAuditCheckpoints != nil {
+		return mmListAuditCheckpoints.funcListAuditCheckpoints(ctx)
+	}
+	mmListAuditCheckpoints.t.Fatalf("Unexpected call to AuditRepositoryMock.ListAuditCheckpoints. %v", ctx)
+	return
+}
+
+// ListAuditCheckpointsAfterCounter returns a count of finished AuditRepositoryMock.ListAuditCheckpoints invocations
+func (mmListAuditCheckpoints *AuditRepositoryMock) ListAuditCheckpointsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListAuditCheckpoints.afterListAuditCheckpointsCounter)
+}
+
+// ListAuditCheckpointsBeforeCounter returns a count of AuditRepositoryMock.ListAuditCheckpoints invocations
+func (mmListAuditCheckpoints *AuditRepositoryMock) ListAuditCheckpointsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListAuditCheckpoints.beforeListAuditCheckpointsCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuditRepositoryMock.ListAuditCheckpoints.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListAuditCheckpoints *mAuditRepositoryMockListAuditCheckpoints) Calls() []*AuditRepositoryMockListAuditCheckpointsParams {
+	mmListAuditCheckpoints.mutex.RLock()
+
+	argCopy := make([]*AuditRepositoryMockListAuditCheckpointsParams, len(mmListAuditCheckpoints.callArgs))
+	copy(argCopy, mmListAuditCheckpoints.callArgs)
+
+	mmListAuditCheckpoints.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListAuditCheckpointsDone returns true if the count of the ListAuditCheckpoints invocations corresponds
+// the number of defined expectations
+func (m *AuditRepositoryMock) MinimockListAuditCheckpointsDone() bool {
+	if m.ListAuditCheckpointsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListAuditCheckpointsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListAuditCheckpointsMock.invocationsDone()
+}
+
+// MinimockListAuditCheckpointsInspect logs each unmet expectation
+func (m *AuditRepositoryMock) MinimockListAuditCheckpointsInspect() {
+	for _, e := range m.ListAuditCheckpointsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuditRepositoryMock.ListAuditCheckpoints at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListAuditCheckpointsCounter := mm_atomic.LoadUint64(&m.afterListAuditCheckpointsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListAuditCheckpointsMock.defaultExpectation != nil && afterListAuditCheckpointsCounter < 1 {
+		if m.ListAuditCheckpointsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuditRepositoryMock.ListAuditCheckpoints at\n%s", m.ListAuditCheckpointsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AuditRepositoryMock.ListAuditCheckpoints at\n%s with params: %#v", m.ListAuditCheckpointsMock.defaultExpectation.expectationOrigins.origin, *m.ListAuditCheckpointsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListAuditCheckpoints != nil && afterListAuditCheckpointsCounter < 1 {
+		m.t.Errorf("Expected call to AuditRepositoryMock.ListAuditCheckpoints at\n%s", m.funcListAuditCheckpointsOrigin)
+	}
+
+	if !m.ListAuditCheckpointsMock.invocationsDone() && afterListAuditCheckpointsCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuditRepositoryMock.ListAuditCheckpoints at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListAuditCheckpointsMock.expectedInvocations), m.ListAuditCheckpointsMock.expectedInvocationsOrigin, afterListAuditCheckpointsCounter)
+	}
+}
+
+type mAuditRepositoryMockListAuditEvents struct {
+	optional           bool
+	mock               *AuditRepositoryMock
+	defaultExpectation *AuditRepositoryMockListAuditEventsExpectation
+	expectations       []*AuditRepositoryMockListAuditEventsExpectation
+
+	callArgs []*AuditRepositoryMockListAuditEventsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AuditRepositoryMockListAuditEventsExpectation specifies expectation struct of the AuditRepository.ListAuditEvents
+type AuditRepositoryMockListAuditEventsExpectation struct {
+	mock               *AuditRepositoryMock
+	params             *AuditRepositoryMockListAuditEventsParams
+	paramPtrs          *AuditRepositoryMockListAuditEventsParamPtrs
+	expectationOrigins AuditRepositoryMockListAuditEventsExpectationOrigins
+	results            *AuditRepositoryMockListAuditEventsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AuditRepositoryMockListAuditEventsParams contains parameters of the AuditRepository.ListAuditEvents
+type AuditRepositoryMockListAuditEventsParams struct {
+	ctx    context.Context
+	filter models.AuditFilter
+}
+
+// AuditRepositoryMockListAuditEventsParamPtrs contains pointers to parameters of the AuditRepository.ListAuditEvents
+type AuditRepositoryMockListAuditEventsParamPtrs struct {
+	ctx    *context.Context
+	filter *models.AuditFilter
+}
+
+// AuditRepositoryMockListAuditEventsResults contains results of the AuditRepository.ListAuditEvents
+type AuditRepositoryMockListAuditEventsResults struct {
+	aa1 []models.AuditEvent
+	err error
+}
+
+// AuditRepositoryMockListAuditEventsOrigins contains origins of expectations of the AuditRepository.ListAuditEvents
+type AuditRepositoryMockListAuditEventsExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originFilter string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListAuditEvents *mAuditRepositoryMockListAuditEvents) Optional() *mAuditRepositoryMockListAuditEvents {
+	mmListAuditEvents.optional = true
+	return mmListAuditEvents
+}
+
+// Expect sets up expected params for AuditRepository.ListAuditEvents
+func (mmListAuditEvents *mAuditRepositoryMockListAuditEvents) Expect(ctx context.Context, filter models.AuditFilter) *mAuditRepositoryMockListAuditEvents {
+	if mmListAuditEvents.mock.funcListAuditEvents != nil {
+		mmListAuditEvents.mock.t.Fatalf("AuditRepositoryMock.ListAuditEvents mock is already set by Set")
+	}
+
+	if mmListAuditEvents.defaultExpectation == nil {
+		mmListAuditEvents.defaultExpectation = &AuditRepositoryMockListAuditEventsExpectation{}
+	}
+
+	if mmListAuditEvents.defaultExpectation.paramPtrs != nil {
+		mmListAuditEvents.mock.t.Fatalf("AuditRepositoryMock.ListAuditEvents mock is already set by ExpectParams functions")
+	}
+
+	mmListAuditEvents.defaultExpectation.params = &AuditRepositoryMockListAuditEventsParams{ctx, filter}
+	mmListAuditEvents.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListAuditEvents.expectations {
+		if minimock.Equal(e.params, mmListAuditEvents.defaultExpectation.params) {
+			mmListAuditEvents.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListAuditEvents.defaultExpectation.params)
+		}
+	}
+
+	return mmListAuditEvents
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuditRepository.ListAuditEvents
+func (mmListAuditEvents *mAuditRepositoryMockListAuditEvents) ExpectCtxParam1(ctx context.Context) *mAuditRepositoryMockListAuditEvents {
+	if mmListAuditEvents.mock.funcListAuditEvents != nil {
+		mmListAuditEvents.mock.t.Fatalf("AuditRepositoryMock.ListAuditEvents mock is already set by Set")
+	}
+
+	if mmListAuditEvents.defaultExpectation == nil {
+		mmListAuditEvents.defaultExpectation = &AuditRepositoryMockListAuditEventsExpectation{}
+	}
+
+	if mmListAuditEvents.defaultExpectation.params != nil {
+		mmListAuditEvents.mock.t.Fatalf("AuditRepositoryMock.ListAuditEvents mock is already set by Expect")
+	}
+
+	if mmListAuditEvents.defaultExpectation.paramPtrs == nil {
+		mmListAuditEvents.defaultExpectation.paramPtrs = &AuditRepositoryMockListAuditEventsParamPtrs{}
+	}
+	mmListAuditEvents.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListAuditEvents.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListAuditEvents
+}
+
+// ExpectFilterParam2 sets up expected param filter for AuditRepository.ListAuditEvents
+func (mmListAuditEvents *mAuditRepositoryMockListAuditEvents) ExpectFilterParam2(filter models.AuditFilter) *mAuditRepositoryMockListAuditEvents {
+	if mmListAuditEvents.mock.funcListAuditEvents != nil {
+		mmListAuditEvents.mock.t.Fatalf("AuditRepositoryMock.ListAuditEvents mock is already set by Set")
+	}
+
+	if mmListAuditEvents.defaultExpectation == nil {
+		mmListAuditEvents.defaultExpectation = &AuditRepositoryMockListAuditEventsExpectation{}
+	}
+
+	if mmListAuditEvents.defaultExpectation.params != nil {
+		mmListAuditEvents.mock.t.Fatalf("AuditRepositoryMock.ListAuditEvents mock is already set by Expect")
+	}
+
+	if mmListAuditEvents.defaultExpectation.paramPtrs == nil {
+		mmListAuditEvents.defaultExpectation.paramPtrs = &AuditRepositoryMockListAuditEventsParamPtrs{}
+	}
+	mmListAuditEvents.defaultExpectation.paramPtrs.filter = &filter
+	mmListAuditEvents.defaultExpectation.expectationOrigins.originFilter = minimock.CallerInfo(1)
+
+	return mmListAuditEvents
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuditRepository.ListAuditEvents
+func (mmListAuditEvents *mAuditRepositoryMockListAuditEvents) Inspect(f func(ctx context.Context, filter models.AuditFilter)) *mAuditRepositoryMockListAuditEvents {
+	if mmListAuditEvents.mock.inspectFuncListAuditEvents != nil {
+		mmListAuditEvents.mock.t.Fatalf("Inspect function is already set for AuditRepositoryMock.ListAuditEvents")
+	}
+
+	mmListAuditEvents.mock.inspectFuncListAuditEvents = f
+
+	return mmListAuditEvents
+}
+
+// Return sets up results that will be returned by AuditRepository.ListAuditEvents
+func (mmListAuditEvents *mAuditRepositoryMockListAuditEvents) Return(aa1 []models.AuditEvent, err error) *AuditRepositoryMock {
+	if mmListAuditEvents.mock.funcListAuditEvents != nil {
+		mmListAuditEvents.mock.t.Fatalf("AuditRepositoryMock.ListAuditEvents mock is already set by Set")
+	}
+
+	if mmListAuditEvents.defaultExpectation == nil {
+		mmListAuditEvents.defaultExpectation = &AuditRepositoryMockListAuditEventsExpectation{mock: mmListAuditEvents.mock}
+	}
+	mmListAuditEvents.defaultExpectation.results = &AuditRepositoryMockListAuditEventsResults{aa1, err}
+	mmListAuditEvents.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListAuditEvents.mock
+}
+
+// Set uses given function f to mock the AuditRepository.ListAuditEvents method
+func (mmListAuditEvents *mAuditRepositoryMockListAuditEvents) Set(f func(ctx context.Context, filter models.AuditFilter) (aa1 []models.AuditEvent, err error)) *AuditRepositoryMock {
+	if mmListAuditEvents.defaultExpectation != nil {
+		mmListAuditEvents.mock.t.Fatalf("Default expectation is already set for the AuditRepository.ListAuditEvents method")
+	}
+
+	if len(mmListAuditEvents.expectations) > 0 {
+		mmListAuditEvents.mock.t.Fatalf("Some expectations are already set for the AuditRepository.ListAuditEvents method")
+	}
+
+	mmListAuditEvents.mock.funcListAuditEvents = f
+	mmListAuditEvents.mock.funcListAuditEventsOrigin = minimock.CallerInfo(1)
+	return mmListAuditEvents.mock
+}
+
+// When sets expectation for the AuditRepository.ListAuditEvents which will trigger the result defined by the following
+// Then helper
+func (mmListAuditEvents *mAuditRepositoryMockListAuditEvents) When(ctx context.Context, filter models.AuditFilter) *AuditRepositoryMockListAuditEventsExpectation {
+	if mmListAuditEvents.mock.funcListAuditEvents != nil {
+		mmListAuditEvents.mock.t.Fatalf("AuditRepositoryMock.ListAuditEvents mock is already set by Set")
+	}
+
+	expectation := &AuditRepositoryMockListAuditEventsExpectation{
+		mock:               mmListAuditEvents.mock,
+		params:             &AuditRepositoryMockListAuditEventsParams{ctx, filter},
+		expectationOrigins: AuditRepositoryMockListAuditEventsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListAuditEvents.expectations = append(mmListAuditEvents.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuditRepository.ListAuditEvents return parameters for the expectation previously defined by the When method
+func (e *AuditRepositoryMockListAuditEventsExpectation) Then(aa1 []models.AuditEvent, err error) *AuditRepositoryMock {
+	e.results = &AuditRepositoryMockListAuditEventsResults{aa1, err}
+	return e.mock
+}
+
+// Times sets number of times AuditRepository.ListAuditEvents should be invoked
+func (mmListAuditEvents *mAuditRepositoryMockListAuditEvents) Times(n uint64) *mAuditRepositoryMockListAuditEvents {
+	if n == 0 {
+		mmListAuditEvents.mock.t.Fatalf("Times of AuditRepositoryMock.ListAuditEvents mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListAuditEvents.expectedInvocations, n)
+	mmListAuditEvents.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListAuditEvents
+}
+
+func (mmListAuditEvents *mAuditRepositoryMockListAuditEvents) invocationsDone() bool {
+	if len(mmListAuditEvents.expectations) == 0 && mmListAuditEvents.defaultExpectation == nil && mmListAuditEvents.mock.funcListAuditEvents == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListAuditEvents.mock.afterListAuditEventsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListAuditEvents.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListAuditEvents implements AuditRepository
+func (mmListAuditEvents *AuditRepositoryMock) ListAuditEvents(ctx context.Context, filter models.AuditFilter) (aa1 []models.AuditEvent, err error) {
+	mm_atomic.AddUint64(&mmListAuditEvents.beforeListAuditEventsCounter, 1)
+	defer mm_atomic.AddUint64(&mmListAuditEvents.afterListAuditEventsCounter, 1)
+
+	mmListAuditEvents.t.Helper()
+
+	if mmListAuditEvents.inspectFuncListAuditEvents != nil {
+		mmListAuditEvents.inspectFuncListAuditEvents(ctx, filter)
+	}
+
+	mm_params := AuditRepositoryMockListAuditEventsParams{ctx, filter}
+
+	// Record call args
+	mmListAuditEvents.ListAuditEventsMock.mutex.Lock()
+	mmListAuditEvents.ListAuditEventsMock.callArgs = append(mmListAuditEvents.ListAuditEventsMock.callArgs, &mm_params)
+	mmListAuditEvents.ListAuditEventsMock.mutex.Unlock()
+
+	for _, e := range mmListAuditEvents.ListAuditEventsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.aa1, e.results.err
+		}
+	}
+
+	if mmListAuditEvents.ListAuditEventsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListAuditEvents.ListAuditEventsMock.defaultExpectation.Counter, 1)
+		mm_want := mmListAuditEvents.ListAuditEventsMock.defaultExpectation.params
+		mm_want_ptrs := mmListAuditEvents.ListAuditEventsMock.defaultExpectation.paramPtrs
+
+		mm_got := AuditRepositoryMockListAuditEventsParams{ctx, filter}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListAuditEvents.t.Errorf("AuditRepositoryMock.ListAuditEvents got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListAuditEvents.ListAuditEventsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.filter != nil && !minimock.Equal(*mm_want_ptrs.filter, mm_got.filter) {
+				mmListAuditEvents.t.Errorf("AuditRepositoryMock.ListAuditEvents got unexpected parameter filter, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListAuditEvents.ListAuditEventsMock.defaultExpectation.expectationOrigins.originFilter, *mm_want_ptrs.filter, mm_got.filter, minimock.Diff(*mm_want_ptrs.filter, mm_got.filter))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListAuditEvents.t.Errorf("AuditRepositoryMock.ListAuditEvents got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListAuditEvents.ListAuditEventsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListAuditEvents.ListAuditEventsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListAuditEvents.t.Fatal("No results are set for the AuditRepositoryMock.ListAuditEvents")
+		}
+		return (*mm_results).aa1, (*mm_results).err
+	}
+	if mmListAuditEvents.funcListAuditEvents != nil {
+		return mmListAuditEvents.funcListAuditEvents(ctx, filter)
+	}
+	mmListAuditEvents.t.Fatalf("Unexpected call to AuditRepositoryMock.ListAuditEvents. %v %v", ctx, filter)
+	return
+}
+
+// ListAuditEventsAfterCounter returns a count of finished AuditRepositoryMock.ListAuditEvents invocations
+func (mmListAuditEvents *AuditRepositoryMock) ListAuditEventsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListAuditEvents.afterListAuditEventsCounter)
+}
+
+// ListAuditEventsBeforeCounter returns a count of AuditRepositoryMock.ListAuditEvents invocations
+func (mmListAuditEvents *AuditRepositoryMock) ListAuditEventsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListAuditEvents.beforeListAuditEventsCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuditRepositoryMock.ListAuditEvents.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListAuditEvents *mAuditRepositoryMockListAuditEvents) Calls() []*AuditRepositoryMockListAuditEventsParams {
+	mmListAuditEvents.mutex.RLock()
+
+	argCopy := make([]*AuditRepositoryMockListAuditEventsParams, len(mmListAuditEvents.callArgs))
+	copy(argCopy, mmListAuditEvents.callArgs)
+
+	mmListAuditEvents.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListAuditEventsDone returns true if the count of the ListAuditEvents invocations corresponds
+// the number of defined expectations
+func (m *AuditRepositoryMock) MinimockListAuditEventsDone() bool {
+	if m.ListAuditEventsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListAuditEventsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListAuditEventsMock.invocationsDone()
+}
+
+// MinimockListAuditEventsInspect logs each unmet expectation
+func (m *AuditRepositoryMock) MinimockListAuditEventsInspect() {
+	for _, e := range m.ListAuditEventsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuditRepositoryMock.ListAuditEvents at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListAuditEventsCounter := mm_atomic.LoadUint64(&m.afterListAuditEventsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListAuditEventsMock.defaultExpectation != nil && afterListAuditEventsCounter < 1 {
+		if m.ListAuditEventsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuditRepositoryMock.ListAuditEvents at\n%s", m.ListAuditEventsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AuditRepositoryMock.ListAuditEvents at\n%s with params: %#v", m.ListAuditEventsMock.defaultExpectation.expectationOrigins.origin, *m.ListAuditEventsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListAuditEvents != nil && afterListAuditEventsCounter < 1 {
+		m.t.Errorf("Expected call to AuditRepositoryMock.ListAuditEvents at\n%s", m.funcListAuditEventsOrigin)
+	}
+
+	if !m.ListAuditEventsMock.invocationsDone() && afterListAuditEventsCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuditRepositoryMock.ListAuditEvents at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListAuditEventsMock.expectedInvocations), m.ListAuditEventsMock.expectedInvocationsOrigin, afterListAuditEventsCounter)
+	}
+}
+
+type mAuditRepositoryMockListAuditTrail struct {
+	optional           bool
+	mock               *AuditRepositoryMock
+	defaultExpectation *AuditRepositoryMockListAuditTrailExpectation
+	expectations       []*AuditRepositoryMockListAuditTrailExpectation
+
+	callArgs []*AuditRepositoryMockListAuditTrailParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AuditRepositoryMockListAuditTrailExpectation specifies expectation struct of the AuditRepository.ListAuditTrail
+type AuditRepositoryMockListAuditTrailExpectation struct {
+	mock               *AuditRepositoryMock
+	params             *AuditRepositoryMockListAuditTrailParams
+	paramPtrs          *AuditRepositoryMockListAuditTrailParamPtrs
+	expectationOrigins AuditRepositoryMockListAuditTrailExpectationOrigins
+	results            *AuditRepositoryMockListAuditTrailResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AuditRepositoryMockListAuditTrailParams contains parameters of the AuditRepository.ListAuditTrail
+type AuditRepositoryMockListAuditTrailParams struct {
+	ctx     context.Context
+	afterID int64
+	limit   int
+}
+
+// AuditRepositoryMockListAuditTrailParamPtrs contains pointers to parameters of the AuditRepository.ListAuditTrail
+type AuditRepositoryMockListAuditTrailParamPtrs struct {
+	ctx     *context.Context
+	afterID *int64
+	limit   *int
+}
+
+// AuditRepositoryMockListAuditTrailResults contains results of the AuditRepository.ListAuditTrail
+type AuditRepositoryMockListAuditTrailResults struct {
+	aa1 []models.AuditEvent
+	err error
+}
+
+// AuditRepositoryMockListAuditTrailOrigins contains origins of expectations of the AuditRepository.ListAuditTrail
+type AuditRepositoryMockListAuditTrailExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originAfterID string
+	originLimit   string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListAuditTrail *mAuditRepositoryMockListAuditTrail) Optional() *mAuditRepositoryMockListAuditTrail {
+	mmListAuditTrail.optional = true
+	return mmListAuditTrail
+}
+
+// Expect sets up expected params for AuditRepository.ListAuditTrail
+func (mmListAuditTrail *mAuditRepositoryMockListAuditTrail) Expect(ctx context.Context, afterID int64, limit int) *mAuditRepositoryMockListAuditTrail {
+	if mmListAuditTrail.mock.funcListAuditTrail != nil {
+		mmListAuditTrail.mock.t.Fatalf("AuditRepositoryMock.ListAuditTrail mock is already set by Set")
+	}
+
+	if mmListAuditTrail.defaultExpectation == nil {
+		mmListAuditTrail.defaultExpectation = &AuditRepositoryMockListAuditTrailExpectation{}
+	}
+
+	if mmListAuditTrail.defaultExpectation.paramPtrs != nil {
+		mmListAuditTrail.mock.t.Fatalf("AuditRepositoryMock.ListAuditTrail mock is already set by ExpectParams functions")
+	}
+
+	mmListAuditTrail.defaultExpectation.params = &AuditRepositoryMockListAuditTrailParams{ctx, afterID, limit}
+	mmListAuditTrail.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListAuditTrail.expectations {
+		if minimock.Equal(e.params, mmListAuditTrail.defaultExpectation.params) {
+			mmListAuditTrail.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListAuditTrail.defaultExpectation.params)
+		}
+	}
+
+	return mmListAuditTrail
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuditRepository.ListAuditTrail
+func (mmListAuditTrail *mAuditRepositoryMockListAuditTrail) ExpectCtxParam1(ctx context.Context) *mAuditRepositoryMockListAuditTrail {
+	if mmListAuditTrail.mock.funcListAuditTrail != nil {
+		mmListAuditTrail.mock.t.Fatalf("AuditRepositoryMock.ListAuditTrail mock is already set by Set")
+	}
+
+	if mmListAuditTrail.defaultExpectation == nil {
+		mmListAuditTrail.defaultExpectation = &AuditRepositoryMockListAuditTrailExpectation{}
+	}
+
+	if mmListAuditTrail.defaultExpectation.params != nil {
+		mmListAuditTrail.mock.t.Fatalf("AuditRepositoryMock.ListAuditTrail mock is already set by Expect")
+	}
+
+	if mmListAuditTrail.defaultExpectation.paramPtrs == nil {
+		mmListAuditTrail.defaultExpectation.paramPtrs = &AuditRepositoryMockListAuditTrailParamPtrs{}
+	}
+	mmListAuditTrail.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListAuditTrail.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListAuditTrail
+}
+
+// ExpectAfterIDParam2 sets up expected param afterID for AuditRepository.ListAuditTrail
+func (mmListAuditTrail *mAuditRepositoryMockListAuditTrail) ExpectAfterIDParam2(afterID int64) *mAuditRepositoryMockListAuditTrail {
+	if mmListAuditTrail.mock.funcListAuditTrail != nil {
+		mmListAuditTrail.mock.t.Fatalf("AuditRepositoryMock.ListAuditTrail mock is already set by Set")
+	}
+
+	if mmListAuditTrail.defaultExpectation == nil {
+		mmListAuditTrail.defaultExpectation = &AuditRepositoryMockListAuditTrailExpectation{}
+	}
+
+	if mmListAuditTrail.defaultExpectation.params != nil {
+		mmListAuditTrail.mock.t.Fatalf("AuditRepositoryMock.ListAuditTrail mock is already set by Expect")
+	}
+
+	if mmListAuditTrail.defaultExpectation.paramPtrs == nil {
+		mmListAuditTrail.defaultExpectation.paramPtrs = &AuditRepositoryMockListAuditTrailParamPtrs{}
+	}
+	mmListAuditTrail.defaultExpectation.paramPtrs.afterID = &afterID
+	mmListAuditTrail.defaultExpectation.expectationOrigins.originAfterID = minimock.CallerInfo(1)
+
+	return mmListAuditTrail
+}
+
+// ExpectLimitParam3 sets up expected param limit for AuditRepository.ListAuditTrail
+func (mmListAuditTrail *mAuditRepositoryMockListAuditTrail) ExpectLimitParam3(limit int) *mAuditRepositoryMockListAuditTrail {
+	if mmListAuditTrail.mock.funcListAuditTrail != nil {
+		mmListAuditTrail.mock.t.Fatalf("AuditRepositoryMock.ListAuditTrail mock is already set by Set")
+	}
+
+	if mmListAuditTrail.defaultExpectation == nil {
+		mmListAuditTrail.defaultExpectation = &AuditRepositoryMockListAuditTrailExpectation{}
+	}
+
+	if mmListAuditTrail.defaultExpectation.params != nil {
+		mmListAuditTrail.mock.t.Fatalf("AuditRepositoryMock.ListAuditTrail mock is already set by Expect")
+	}
+
+	if mmListAuditTrail.defaultExpectation.paramPtrs == nil {
+		mmListAuditTrail.defaultExpectation.paramPtrs = &AuditRepositoryMockListAuditTrailParamPtrs{}
+	}
+	mmListAuditTrail.defaultExpectation.paramPtrs.limit = &limit
+	mmListAuditTrail.defaultExpectation.expectationOrigins.originLimit = minimock.CallerInfo(1)
+
+	return mmListAuditTrail
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuditRepository.ListAuditTrail
+func (mmListAuditTrail *mAuditRepositoryMockListAuditTrail) Inspect(f func(ctx context.Context, afterID int64, limit int)) *mAuditRepositoryMockListAuditTrail {
+	if mmListAuditTrail.mock.inspectFuncListAuditTrail != nil {
+		mmListAuditTrail.mock.t.Fatalf("Inspect function is already set for AuditRepositoryMock.ListAuditTrail")
+	}
+
+	mmListAuditTrail.mock.inspectFuncListAuditTrail = f
+
+	return mmListAuditTrail
+}
+
+// Return sets up results that will be returned by AuditRepository.ListAuditTrail
+func (mmListAuditTrail *mAuditRepositoryMockListAuditTrail) Return(aa1 []models.AuditEvent, err error) *AuditRepositoryMock {
+	if mmListAuditTrail.mock.funcListAuditTrail != nil {
+		mmListAuditTrail.mock.t.Fatalf("AuditRepositoryMock.ListAuditTrail mock is already set by Set")
+	}
+
+	if mmListAuditTrail.defaultExpectation == nil {
+		mmListAuditTrail.defaultExpectation = &AuditRepositoryMockListAuditTrailExpectation{mock: mmListAuditTrail.mock}
+	}
+	mmListAuditTrail.defaultExpectation.results = &AuditRepositoryMockListAuditTrailResults{aa1, err}
+	mmListAuditTrail.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListAuditTrail.mock
+}
+
+// Set uses given function f to mock the AuditRepository.ListAuditTrail method
+func (mmListAuditTrail *mAuditRepositoryMockListAuditTrail) Set(f func(ctx context.Context, afterID int64, limit int) (aa1 []models.AuditEvent, err error)) *AuditRepositoryMock {
+	if mmListAuditTrail.defaultExpectation != nil {
+		mmListAuditTrail.mock.t.Fatalf("Default expectation is already set for the AuditRepository.ListAuditTrail method")
+	}
+
+	if len(mmListAuditTrail.expectations) > 0 {
+		mmListAuditTrail.mock.t.Fatalf("Some expectations are already set for the AuditRepository.ListAuditTrail method")
+	}
+
+	mmListAuditTrail.mock.funcListAuditTrail = f
+	mmListAuditTrail.mock.funcListAuditTrailOrigin = minimock.CallerInfo(1)
+	return mmListAuditTrail.mock
+}
+
+// When sets expectation for the AuditRepository.ListAuditTrail which will trigger the result defined by the following
+// Then helper
+func (mmListAuditTrail *mAuditRepositoryMockListAuditTrail) When(ctx context.Context, afterID int64, limit int) *AuditRepositoryMockListAuditTrailExpectation {
+	if mmListAuditTrail.mock.funcListAuditTrail != nil {
+		mmListAuditTrail.mock.t.Fatalf("AuditRepositoryMock.ListAuditTrail mock is already set by Set")
+	}
+
+	expectation := &AuditRepositoryMockListAuditTrailExpectation{
+		mock:               mmListAuditTrail.mock,
+		params:             &AuditRepositoryMockListAuditTrailParams{ctx, afterID, limit},
+		expectationOrigins: AuditRepositoryMockListAuditTrailExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListAuditTrail.expectations = append(mmListAuditTrail.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuditRepository.ListAuditTrail return parameters for the expectation previously defined by the When method
+func (e *AuditRepositoryMockListAuditTrailExpectation) Then(aa1 []models.AuditEvent, err error) *AuditRepositoryMock {
+	e.results = &AuditRepositoryMockListAuditTrailResults{aa1, err}
+	return e.mock
+}
+
+// Times sets number of times AuditRepository.ListAuditTrail should be invoked
+func (mmListAuditTrail *mAuditRepositoryMockListAuditTrail) Times(n uint64) *mAuditRepositoryMockListAuditTrail {
+	if n == 0 {
+		mmListAuditTrail.mock.t.Fatalf("Times of AuditRepositoryMock.ListAuditTrail mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListAuditTrail.expectedInvocations, n)
+	mmListAuditTrail.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListAuditTrail
+}
+
+func (mmListAuditTrail *mAuditRepositoryMockListAuditTrail) invocationsDone() bool {
+	if len(mmListAuditTrail.expectations) == 0 && mmListAuditTrail.defaultExpectation == nil && mmListAuditTrail.mock.funcListAuditTrail == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListAuditTrail.mock.afterListAuditTrailCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListAuditTrail.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListAuditTrail implements AuditRepository
+func (mmListAuditTrail *AuditRepositoryMock) ListAuditTrail(ctx context.Context, afterID int64, limit int) (aa1 []models.AuditEvent, err error) {
+	mm_atomic.AddUint64(&mmListAuditTrail.beforeListAuditTrailCounter, 1)
+	defer mm_atomic.AddUint64(&mmListAuditTrail.afterListAuditTrailCounter, 1)
+
+	mmListAuditTrail.t.Helper()
+
+	if mmListAuditTrail.inspectFuncListAuditTrail != nil {
+		mmListAuditTrail.inspectFuncListAuditTrail(ctx, afterID, limit)
+	}
+
+	mm_params := AuditRepositoryMockListAuditTrailParams{ctx, afterID, limit}
+
+	// Record call args
+	mmListAuditTrail.ListAuditTrailMock.mutex.Lock()
+	mmListAuditTrail.ListAuditTrailMock.callArgs = append(mmListAuditTrail.ListAuditTrailMock.callArgs, &mm_params)
+	mmListAuditTrail.ListAuditTrailMock.mutex.Unlock()
+
+	for _, e := range mmListAuditTrail.ListAuditTrailMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.aa1, e.results.err
+		}
+	}
+
+	if mmListAuditTrail.ListAuditTrailMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListAuditTrail.ListAuditTrailMock.defaultExpectation.Counter, 1)
+		mm_want := mmListAuditTrail.ListAuditTrailMock.defaultExpectation.params
+		mm_want_ptrs := mmListAuditTrail.ListAuditTrailMock.defaultExpectation.paramPtrs
+
+		mm_got := AuditRepositoryMockListAuditTrailParams{ctx, afterID, limit}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListAuditTrail.t.Errorf("AuditRepositoryMock.ListAuditTrail got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListAuditTrail.ListAuditTrailMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.afterID != nil && !minimock.Equal(*mm_want_ptrs.afterID, mm_got.afterID) {
+				mmListAuditTrail.t.Errorf("AuditRepositoryMock.ListAuditTrail got unexpected parameter afterID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListAuditTrail.ListAuditTrailMock.defaultExpectation.expectationOrigins.originAfterID, *mm_want_ptrs.afterID, mm_got.afterID, minimock.Diff(*mm_want_ptrs.afterID, mm_got.afterID))
+			}
+
+			if mm_want_ptrs.limit != nil && !minimock.Equal(*mm_want_ptrs.limit, mm_got.limit) {
+				mmListAuditTrail.t.Errorf("AuditRepositoryMock.ListAuditTrail got unexpected parameter limit, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListAuditTrail.ListAuditTrailMock.defaultExpectation.expectationOrigins.originLimit, *mm_want_ptrs.limit, mm_got.limit, minimock.Diff(*mm_want_ptrs.limit, mm_got.limit))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListAuditTrail.t.Errorf("AuditRepositoryMock.ListAuditTrail got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListAuditTrail.ListAuditTrailMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListAuditTrail.ListAuditTrailMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListAuditTrail.t.Fatal("No results are set for the AuditRepositoryMock.ListAuditTrail")
+		}
+		return (*mm_results).aa1, (*mm_results).err
+	}
+	if mmListAuditTrail.funcListAuditTrail != nil {
+		return mmListAuditTrail.funcListAuditTrail(ctx, afterID, limit)
+	}
+	mmListAuditTrail.t.Fatalf("Unexpected call to AuditRepositoryMock.ListAuditTrail. %v %v %v", ctx, afterID, limit)
+	return
+}
+
+// ListAuditTrailAfterCounter returns a count of finished AuditRepositoryMock.ListAuditTrail invocations
+func (mmListAuditTrail *AuditRepositoryMock) ListAuditTrailAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListAuditTrail.afterListAuditTrailCounter)
+}
+
+// ListAuditTrailBeforeCounter returns a count of AuditRepositoryMock.ListAuditTrail invocations
+func (mmListAuditTrail *AuditRepositoryMock) ListAuditTrailBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListAuditTrail.beforeListAuditTrailCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuditRepositoryMock.ListAuditTrail.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListAuditTrail *mAuditRepositoryMockListAuditTrail) Calls() []*AuditRepositoryMockListAuditTrailParams {
+	mmListAuditTrail.mutex.RLock()
+
+	argCopy := make([]*AuditRepositoryMockListAuditTrailParams, len(mmListAuditTrail.callArgs))
+	copy(argCopy, mmListAuditTrail.callArgs)
+
+	mmListAuditTrail.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListAuditTrailDone returns true if the count of the ListAuditTrail invocations corresponds
+// the number of defined expectations
+func (m *AuditRepositoryMock) MinimockListAuditTrailDone() bool {
+	if m.ListAuditTrailMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListAuditTrailMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListAuditTrailMock.invocationsDone()
+}
+
+// MinimockListAuditTrailInspect logs each unmet expectation
+func (m *AuditRepositoryMock) MinimockListAuditTrailInspect() {
+	for _, e := range m.ListAuditTrailMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuditRepositoryMock.ListAuditTrail at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListAuditTrailCounter := mm_atomic.LoadUint64(&m.afterListAuditTrailCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListAuditTrailMock.defaultExpectation != nil && afterListAuditTrailCounter < 1 {
+		if m.ListAuditTrailMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuditRepositoryMock.ListAuditTrail at\n%s", m.ListAuditTrailMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AuditRepositoryMock.ListAuditTrail at\n%s with params: %#v", m.ListAuditTrailMock.defaultExpectation.expectationOrigins.origin, *m.ListAuditTrailMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListAuditTrail != nil && afterListAuditTrailCounter < 1 {
+		m.t.Errorf("Expected call to AuditRepositoryMock.ListAuditTrail at\n%s", m.funcListAuditTrailOrigin)
+	}
+
+	if !m.ListAuditTrailMock.invocationsDone() && afterListAuditTrailCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuditRepositoryMock.ListAuditTrail at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListAuditTrailMock.expectedInvocations), m.ListAuditTrailMock.expectedInvocationsOrigin, afterListAuditTrailCounter)
+	}
+}
+
+type mAuditRepositoryMockLockAuditHead struct {
+	optional           bool
+	mock               *AuditRepositoryMock
+	defaultExpectation *AuditRepositoryMockLockAuditHeadExpectation
+	expectations       []*AuditRepositoryMockLockAuditHeadExpectation
+
+	callArgs []*AuditRepositoryMockLockAuditHeadParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AuditRepositoryMockLockAuditHeadExpectation specifies expectation struct of the AuditRepository.LockAuditHead
+type AuditRepositoryMockLockAuditHeadExpectation struct {
+	mock               *AuditRepositoryMock
+	params             *AuditRepositoryMockLockAuditHeadParams
+	paramPtrs          *AuditRepositoryMockLockAuditHeadParamPtrs
+	expectationOrigins AuditRepositoryMockLockAuditHeadExpectationOrigins
+	results            *AuditRepositoryMockLockAuditHeadResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AuditRepositoryMockLockAuditHeadParams contains parameters of the AuditRepository.LockAuditHead
+type AuditRepositoryMockLockAuditHeadParams struct {
+	ctx context.Context
+}
+
+// AuditRepositoryMockLockAuditHeadParamPtrs contains pointers to parameters of the AuditRepository.LockAuditHead
+type AuditRepositoryMockLockAuditHeadParamPtrs struct {
+	ctx *context.Context
+}
+
+// AuditRepositoryMockLockAuditHeadResults contains results of the AuditRepository.LockAuditHead
+type AuditRepositoryMockLockAuditHeadResults struct {
+	ap1 *models.AuditEvent
+	err error
+}
+
+// AuditRepositoryMockLockAuditHeadOrigins contains origins of expectations of the AuditRepository.LockAuditHead
+type AuditRepositoryMockLockAuditHeadExpectationOrigins struct {
+	origin    string
+	originCtx string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmLockAuditHead *mAuditRepositoryMockLockAuditHead) Optional() *mAuditRepositoryMockLockAuditHead {
+	mmLockAuditHead.optional = true
+	return mmLockAuditHead
+}
+
+// Expect sets up expected params for AuditRepository.LockAuditHead
+func (mmLockAuditHead *mAuditRepositoryMockLockAuditHead) Expect(ctx context.Context) *mAuditRepositoryMockLockAuditHead {
+	if mmLockAuditHead.mock.funcLockAuditHead != nil {
+		mmLockAuditHead.mock.t.Fatalf("AuditRepositoryMock.LockAuditHead mock is already set by Set")
+	}
+
+	if mmLockAuditHead.defaultExpectation == nil {
+		mmLockAuditHead.defaultExpectation = &AuditRepositoryMockLockAuditHeadExpectation{}
+	}
+
+	if mmLockAuditHead.defaultExpectation.paramPtrs != nil {
+		mmLockAuditHead.mock.t.Fatalf("AuditRepositoryMock.LockAuditHead mock is already set by ExpectParams functions")
+	}
+
+	mmLockAuditHead.defaultExpectation.params = &AuditRepositoryMockLockAuditHeadParams{ctx}
+	mmLockAuditHead.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmLockAuditHead.expectations {
+		if minimock.Equal(e.params, mmLockAuditHead.defaultExpectation.params) {
+			mmLockAuditHead.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmLockAuditHead.defaultExpectation.params)
+		}
+	}
+
+	return mmLockAuditHead
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuditRepository.LockAuditHead
+func (mmLockAuditHead *mAuditRepositoryMockLockAuditHead) ExpectCtxParam1(ctx context.Context) *mAuditRepositoryMockLockAuditHead {
+	if mmLockAuditHead.mock.funcLockAuditHead != nil {
+		mmLockAuditHead.mock.t.Fatalf("AuditRepositoryMock.LockAuditHead mock is already set by Set")
+	}
+
+	if mmLockAuditHead.defaultExpectation == nil {
+		mmLockAuditHead.defaultExpectation = &AuditRepositoryMockLockAuditHeadExpectation{}
+	}
+
+	if mmLockAuditHead.defaultExpectation.params != nil {
+		mmLockAuditHead.mock.t.Fatalf("AuditRepositoryMock.LockAuditHead mock is already set by Expect")
+	}
+
+	if mmLockAuditHead.defaultExpectation.paramPtrs == nil {
+		mmLockAuditHead.defaultExpectation.paramPtrs = &AuditRepositoryMockLockAuditHeadParamPtrs{}
+	}
+	mmLockAuditHead.defaultExpectation.paramPtrs.ctx = &ctx
+	mmLockAuditHead.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmLockAuditHead
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuditRepository.LockAuditHead
+func (mmLockAuditHead *mAuditRepositoryMockLockAuditHead) Inspect(f func(ctx context.Context)) *mAuditRepositoryMockLockAuditHead {
+	if mmLockAuditHead.mock.inspectFuncLockAuditHead != nil {
+		mmLockAuditHead.mock.t.Fatalf("Inspect function is already set for AuditRepositoryMock.LockAuditHead")
+	}
+
+	mmLockAuditHead.mock.inspectFuncLockAuditHead = f
+
+	return mmLockAuditHead
+}
+
+// Return sets up results that will be returned by AuditRepository.LockAuditHead
+func (mmLockAuditHead *mAuditRepositoryMockLockAuditHead) Return(ap1 *models.AuditEvent, err error) *AuditRepositoryMock {
+	if mmLockAuditHead.mock.funcLockAuditHead != nil {
+		mmLockAuditHead.mock.t.Fatalf("AuditRepositoryMock.LockAuditHead mock is already set by Set")
+	}
+
+	if mmLockAuditHead.defaultExpectation == nil {
+		mmLockAuditHead.defaultExpectation = &AuditRepositoryMockLockAuditHeadExpectation{mock: mmLockAuditHead.mock}
+	}
+	mmLockAuditHead.defaultExpectation.results = &AuditRepositoryMockLockAuditHeadResults{ap1, err}
+	mmLockAuditHead.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmLockAuditHead.mock
+}
+
+// Set uses given function f to mock the AuditRepository.LockAuditHead method
+func (mmLockAuditHead *mAuditRepositoryMockLockAuditHead) Set(f func(ctx context.Context) (ap1 *models.AuditEvent, err error)) *AuditRepositoryMock {
+	if mmLockAuditHead.defaultExpectation != nil {
+		mmLockAuditHead.mock.t.Fatalf("Default expectation is already set for the AuditRepository.LockAuditHead method")
+	}
+
+	if len(mmLockAuditHead.expectations) > 0 {
+		mmLockAuditHead.mock.t.Fatalf("Some expectations are already set for the AuditRepository.LockAuditHead method")
+	}
+
+	mmLockAuditHead.mock.funcLockAuditHead = f
+	mmLockAuditHead.mock.funcLockAuditHeadOrigin = minimock.CallerInfo(1)
+	return mmLockAuditHead.mock
+}
+
+// When sets expectation for the AuditRepository.LockAuditHead which will trigger the result defined by the following
+// Then helper
+func (mmLockAuditHead *mAuditRepositoryMockLockAuditHead) When(ctx context.Context) *AuditRepositoryMockLockAuditHeadExpectation {
+	if mmLockAuditHead.mock.funcLockAuditHead != nil {
+		mmLockAuditHead.mock.t.Fatalf("AuditRepositoryMock.LockAuditHead mock is already set by Set")
+	}
+
+	expectation := &AuditRepositoryMockLockAuditHeadExpectation{
+		mock:               mmLockAuditHead.mock,
+		params:             &AuditRepositoryMockLockAuditHeadParams{ctx},
+		expectationOrigins: AuditRepositoryMockLockAuditHeadExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmLockAuditHead.expectations = append(mmLockAuditHead.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuditRepository.LockAuditHead return parameters for the expectation previously defined by the When method
+func (e *AuditRepositoryMockLockAuditHeadExpectation) Then(ap1 *models.AuditEvent, err error) *AuditRepositoryMock {
+	e.results = &AuditRepositoryMockLockAuditHeadResults{ap1, err}
+	return e.mock
+}
+
+// Times sets number of times AuditRepository.LockAuditHead should be invoked
+func (mmLockAuditHead *mAuditRepositoryMockLockAuditHead) Times(n uint64) *mAuditRepositoryMockLockAuditHead {
+	if n == 0 {
+		mmLockAuditHead.mock.t.Fatalf("Times of AuditRepositoryMock.LockAuditHead mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmLockAuditHead.expectedInvocations, n)
+	mmLockAuditHead.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmLockAuditHead
+}
+
+func (mmLockAuditHead *mAuditRepositoryMockLockAuditHead) invocationsDone() bool {
+	if len(mmLockAuditHead.expectations) == 0 && mmLockAuditHead.defaultExpectation == nil && mmLockAuditHead.mock.funcLockAuditHead == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmLockAuditHead.mock.afterLockAuditHeadCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmLockAuditHead.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// LockAuditHead implements AuditRepository
+func (mmLockAuditHead *AuditRepositoryMock) LockAuditHead(ctx context.Context) (ap1 *models.AuditEvent, err error) {
+	mm_atomic.AddUint64(&mmLockAuditHead.beforeLockAuditHeadCounter, 1)
+	defer mm_atomic.AddUint64(&mmLockAuditHead.afterLockAuditHeadCounter, 1)
+
+	mmLockAuditHead.t.Helper()
+
+	if mmLockAuditHead.inspectFuncLockAuditHead != nil {
+		mmLockAuditHead.inspectFuncLockAuditHead(ctx)
+	}
+
+	mm_params := AuditRepositoryMockLockAuditHeadParams{ctx}
+
+	// Record call args
+	mmLockAuditHead.LockAuditHeadMock.mutex.Lock()
+	mmLockAuditHead.LockAuditHeadMock.callArgs = append(mmLockAuditHead.LockAuditHeadMock.callArgs, &mm_params)
+	mmLockAuditHead.LockAuditHeadMock.mutex.Unlock()
+
+	for _, e := range mmLockAuditHead.LockAuditHeadMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ap1, e.results.err
+		}
+	}
+
+	if mmLockAuditHead.LockAuditHeadMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmLockAuditHead.LockAuditHeadMock.defaultExpectation.Counter, 1)
+		mm_want := mmLockAuditHead.LockAuditHeadMock.defaultExpectation.params
+		mm_want_ptrs := mmLockAuditHead.LockAuditHeadMock.defaultExpectation.paramPtrs
+
+		mm_got := AuditRepositoryMockLockAuditHeadParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmLockAuditHead.t.Errorf("AuditRepositoryMock.LockAuditHead got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmLockAuditHead.LockAuditHeadMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmLockAuditHead.t.Errorf("AuditRepositoryMock.LockAuditHead got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmLockAuditHead.LockAuditHeadMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmLockAuditHead.LockAuditHeadMock.defaultExpectation.results
+		if mm_results == nil {
+			mmLockAuditHead.t.Fatal("No results are set for the AuditRepositoryMock.LockAuditHead")
+		}
+		return (*mm_results).ap1, (*mm_results).err
+	}
+	if mmLockAuditHead.funcLockAuditHead != nil {
+		return mmLockAuditHead.funcLockAuditHead(ctx)
+	}
+	mmLockAuditHead.t.Fatalf("Unexpected call to AuditRepositoryMock.LockAuditHead. %v", ctx)
+	return
+}
+
+// LockAuditHeadAfterCounter returns a count of finished AuditRepositoryMock.LockAuditHead invocations
+func (mmLockAuditHead *AuditRepositoryMock) LockAuditHeadAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLockAuditHead.afterLockAuditHeadCounter)
+}
+
+// LockAuditHeadBeforeCounter returns a count of AuditRepositoryMock.LockAuditHead invocations
+func (mmLockAuditHead *AuditRepositoryMock) LockAuditHeadBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLockAuditHead.beforeLockAuditHeadCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuditRepositoryMock.LockAuditHead.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmLockAuditHead *mAuditRepositoryMockLockAuditHead) Calls() []*AuditRepositoryMockLockAuditHeadParams {
+	mmLockAuditHead.mutex.RLock()
+
+	argCopy := make([]*AuditRepositoryMockLockAuditHeadParams, len(mmLockAuditHead.callArgs))
+	copy(argCopy, mmLockAuditHead.callArgs)
+
+	mmLockAuditHead.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockLockAuditHeadDone returns true if the count of the LockAuditHead invocations corresponds
+// the number of defined expectations
+func (m *AuditRepositoryMock) MinimockLockAuditHeadDone() bool {
+	if m.LockAuditHeadMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.LockAuditHeadMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.LockAuditHeadMock.invocationsDone()
+}
+
+// MinimockLockAuditHeadInspect logs each unmet expectation
+func (m *AuditRepositoryMock) MinimockLockAuditHeadInspect() {
+	for _, e := range m.LockAuditHeadMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuditRepositoryMock.LockAuditHead at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterLockAuditHeadCounter := mm_atomic.LoadUint64(&m.afterLockAuditHeadCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.LockAuditHeadMock.defaultExpectation != nil && afterLockAuditHeadCounter < 1 {
+		if m.LockAuditHeadMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuditRepositoryMock.LockAuditHead at\n%s", m.LockAuditHeadMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AuditRepositoryMock.LockAuditHead at\n%s with params: %#v", m.LockAuditHeadMock.defaultExpectation.expectationOrigins.origin, *m.LockAuditHeadMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcLockAuditHead != nil && afterLockAuditHeadCounter < 1 {
+		m.t.Errorf("Expected call to AuditRepositoryMock.LockAuditHead at\n%s", m.funcLockAuditHeadOrigin)
+	}
+
+	if !m.LockAuditHeadMock.invocationsDone() && afterLockAuditHeadCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuditRepositoryMock.LockAuditHead at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.LockAuditHeadMock.expectedInvocations), m.LockAuditHeadMock.expectedInvocationsOrigin, afterLockAuditHeadCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *AuditRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCreateAuditCheckpointInspect()
+
+			m.MinimockCreateAuditEventInspect()
+
+			m.MinimockInTxInspect()
+
+			m.MinimockLastAuditCheckpointInspect()
+
+			m.MinimockLastAuditEventInspect()
+
+			m.MinimockListAuditCheckpointsInspect()
+
+			m.MinimockListAuditEventsInspect()
+
+			m.MinimockListAuditTrailInspect()
+
+			m.MinimockLockAuditHeadInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *AuditRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *AuditRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCreateAuditCheckpointDone() &&
+		m.MinimockCreateAuditEventDone() &&
+		m.MinimockInTxDone() &&
+		m.MinimockLastAuditCheckpointDone() &&
+		m.MinimockLastAuditEventDone() &&
+		m.MinimockListAuditCheckpointsDone() &&
+		m.MinimockListAuditEventsDone() &&
+		m.MinimockListAuditTrailDone() &&
+		m.MinimockLockAuditHeadDone()
+}
@@ -0,0 +1,320 @@
+package service_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/alonsoF100/authorization-service/internal/apperrors"
+	"github.com/alonsoF100/authorization-service/internal/audit"
+	"github.com/alonsoF100/authorization-service/internal/models"
+	"github.com/alonsoF100/authorization-service/internal/service"
+	"github.com/gojuno/minimock/v3"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecordAuditEvent(t *testing.T) {
+	source := audit.Source{IP: "192.0.2.1", UserAgent: "curl/8.5.0", RequestID: "req123"}
+	head := &models.AuditEvent{ID: 41, Hash: "head-hash"}
+
+	tests := []struct {
+		name             string
+		source           audit.Source
+		event            models.AuditEvent
+		last             *models.AuditEvent
+		expectedActor    string
+		expectedPrevHash string
+		repoErrs         []error
+	}{
+		{
+			name:             "anonymous request keeps the event actor",
+			source:           source,
+			event:            models.AuditEvent{Type: models.AuditUserRegistered, ActorType: "user", ActorID: "user123", TargetID: "user123"},
+			last:             head,
+			expectedActor:    "user123",
+			expectedPrevHash: head.Hash,
+			repoErrs:         []error{nil},
+		},
+		{
+			name: "authenticated caller is the actor",
+			source: audit.Source{ActorType: "user", ActorID: "admin123",
+				IP: source.IP, UserAgent: source.UserAgent, RequestID: source.RequestID},
+			event:            models.AuditEvent{Type: models.AuditSessionRevoked, TargetID: "user123"},
+			last:             head,
+			expectedActor:    "admin123",
+			expectedPrevHash: head.Hash,
+			repoErrs:         []error{nil},
+		},
+		{
+			name:             "first event starts the chain",
+			source:           source,
+			event:            models.AuditEvent{Type: models.AuditLogin},
+			expectedPrevHash: audit.GenesisHash,
+			repoErrs:         []error{nil},
+		},
+		{
+			name:             "event before the chain is skipped",
+			source:           source,
+			event:            models.AuditEvent{Type: models.AuditLogin},
+			last:             &models.AuditEvent{ID: 3},
+			expectedPrevHash: audit.GenesisHash,
+			repoErrs:         []error{nil},
+		},
+		{
+			name:             "chain conflict is not returned",
+			source:           source,
+			event:            models.AuditEvent{Type: models.AuditLogin},
+			last:             head,
+			expectedPrevHash: head.Hash,
+			repoErrs:         []error{apperrors.ErrAuditChainConflict},
+		},
+		{
+			name:             "failed write is not returned",
+			source:           source,
+			event:            models.AuditEvent{Type: models.AuditLogin, Outcome: models.AuditFailure},
+			last:             head,
+			expectedPrevHash: head.Hash,
+			repoErrs:         []error{errors.New("database error")},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := minimock.NewController(t)
+			mockRepo := service.NewAuditRepositoryMock(mc)
+
+			ctx, cancel := context.WithCancel(audit.WithSource(context.Background(), tt.source))
+			cancel()
+
+			inTx := false
+			mockRepo.InTxMock.Set(func(ctx context.Context, fn func(ctx context.Context) error) error {
+				inTx = true
+				defer func() { inTx = false }()
+				return fn(ctx)
+			})
+			mockRepo.LockAuditHeadMock.Set(func(ctx context.Context) (*models.AuditEvent, error) {
+				require.NoError(t, ctx.Err())
+				require.True(t, inTx)
+				return tt.last, nil
+			})
+			attempt := 0
+			mockRepo.CreateAuditEventMock.Set(func(ctx context.Context, event *models.AuditEvent) error {
+				require.NoError(t, ctx.Err())
+				require.Equal(t, tt.event.Type, event.Type)
+				require.Equal(t, tt.expectedActor, event.ActorID)
+				require.Equal(t, source.IP, event.IP)
+				require.Equal(t, source.UserAgent, event.UserAgent)
+				require.Equal(t, source.RequestID, event.RequestID)
+				require.WithinDuration(t, time.Now(), event.CreatedAt, time.Second)
+				if tt.event.Outcome == "" {
+					require.Equal(t, models.AuditSuccess, event.Outcome)
+				} else {
+					require.Equal(t, tt.event.Outcome, event.Outcome)
+				}
+				require.Equal(t, tt.expectedPrevHash, event.PrevHash)
+				require.Equal(t, audit.Hash(*event), event.Hash)
+				require.True(t, inTx)
+
+				attempt++
+				return tt.repoErrs[attempt-1]
+			})
+
+			service.NewAuditService(mockRepo, nil).Record(ctx, tt.event)
+			require.Len(t, tt.repoErrs, attempt)
+		})
+	}
+}
+
+func TestListAuditEvents(t *testing.T) {
+	ctx := context.Background()
+	someErr := errors.New("database error")
+
+	tests := []struct {
+		name          string
+		limit         int
+		expectedLimit int
+		repoErr       error
+	}{
+		{"default limit", 0, 50, nil},
+		{"requested limit", 10, 10, nil},
+		{"limit is capped", 10000, 500, nil},
+		{"database error", 10, 10, someErr},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := minimock.NewController(t)
+			mockRepo := service.NewAuditRepositoryMock(mc)
+
+			events := []models.AuditEvent{{ID: 2, Type: models.AuditLogin}, {ID: 1, Type: models.AuditUserRegistered}}
+			mockRepo.ListAuditEventsMock.
+				Expect(ctx, models.AuditFilter{TargetID: "user123", Limit: tt.expectedLimit}).
+				Return(events, tt.repoErr)
+
+			got, err := service.NewAuditService(mockRepo, nil).ListAuditEvents(ctx, models.AuditFilter{TargetID: "user123", Limit: tt.limit})
+			if tt.repoErr != nil {
+				require.ErrorIs(t, err, tt.repoErr)
+				require.Nil(t, got)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, events, got)
+		})
+	}
+}
+
+func newAuditSigningKey(t *testing.T) *models.SigningKey {
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	return &models.SigningKey{
+		ID:         uuid.New().String(),
+		Algorithm:  service.SigningAlgorithmES256,
+		PrivateKey: privateKey,
+		CreatedAt:  time.Now(),
+	}
+}
+
+// auditTrail chains count events like AuditService.Record does
+func auditTrail(count int) []models.AuditEvent {
+	events := make([]models.AuditEvent, 0, count)
+	prevHash := audit.GenesisHash
+	for i := range count {
+		event := models.AuditEvent{
+			ID:        int64(i + 1),
+			Type:      models.AuditLogin,
+			Outcome:   models.AuditSuccess,
+			CreatedAt: time.Now().UTC(),
+			PrevHash:  prevHash,
+		}
+		event.Hash = audit.Hash(event)
+		prevHash = event.Hash
+
+		events = append(events, event)
+	}
+
+	return events
+}
+
+func TestAuditCheckpoint(t *testing.T) {
+	ctx := context.Background()
+	key := newAuditSigningKey(t)
+	head := auditTrail(3)[2]
+
+	tests := []struct {
+		name        string
+		key         *models.SigningKey
+		setupMocks  func(mockRepo *service.AuditRepositoryMock)
+		expectedNew bool
+		expectedErr error
+	}{
+		{
+			name: "head is signed",
+			key:  key,
+			setupMocks: func(mockRepo *service.AuditRepositoryMock) {
+				mockRepo.LastAuditEventMock.Expect(ctx).Return(&head, nil)
+				mockRepo.LastAuditCheckpointMock.Expect(ctx).Return(&models.AuditCheckpoint{EventID: 1}, nil)
+				mockRepo.CreateAuditCheckpointMock.Set(func(_ context.Context, checkpoint *models.AuditCheckpoint) error {
+					require.Equal(t, head.ID, checkpoint.EventID)
+					require.Equal(t, head.Hash, checkpoint.Hash)
+					require.Equal(t, key.ID, checkpoint.KeyID)
+					return nil
+				})
+			},
+			expectedNew: true,
+		},
+		{
+			name: "head is already signed",
+			key:  key,
+			setupMocks: func(mockRepo *service.AuditRepositoryMock) {
+				mockRepo.LastAuditEventMock.Expect(ctx).Return(&head, nil)
+				mockRepo.LastAuditCheckpointMock.Expect(ctx).Return(&models.AuditCheckpoint{EventID: head.ID, Hash: head.Hash}, nil)
+			},
+		},
+		{
+			name: "no chained events",
+			key:  key,
+			setupMocks: func(mockRepo *service.AuditRepositoryMock) {
+				mockRepo.LastAuditEventMock.Expect(ctx).Return(&models.AuditEvent{ID: 1}, nil)
+			},
+		},
+		{
+			name:        "no signing key",
+			setupMocks:  func(mockRepo *service.AuditRepositoryMock) {},
+			expectedErr: apperrors.ErrNoSigningKey,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := minimock.NewController(t)
+			mockRepo := service.NewAuditRepositoryMock(mc)
+			mockKeys := service.NewAuditKeysMock(mc)
+			mockKeys.ActiveKeyMock.Return(tt.key)
+			tt.setupMocks(mockRepo)
+
+			checkpoint, err := service.NewAuditService(mockRepo, mockKeys).Checkpoint(ctx)
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			if tt.expectedNew {
+				require.NotNil(t, checkpoint)
+				require.NotEmpty(t, checkpoint.Signature)
+			}
+		})
+	}
+}
+
+func TestVerifyTrail(t *testing.T) {
+	ctx := context.Background()
+	key := newAuditSigningKey(t)
+
+	trail := auditTrail(4)
+	checkpoint, err := audit.SignCheckpoint(key, trail[3].ID, trail[3].Hash, time.Now())
+	require.NoError(t, err)
+
+	tampered := auditTrail(4)
+	tampered[2].IP = "198.51.100.7"
+
+	tests := []struct {
+		name          string
+		events        []models.AuditEvent
+		expectedEvent int64
+	}{
+		{name: "intact", events: trail},
+		{name: "altered event", events: tampered, expectedEvent: 3},
+		{name: "truncated tail", events: trail[:3], expectedEvent: 4},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := minimock.NewController(t)
+			mockRepo := service.NewAuditRepositoryMock(mc)
+			mockKeys := service.NewAuditKeysMock(mc)
+
+			mockKeys.ListMock.Expect(ctx).Return([]models.SigningKey{*key}, nil)
+			mockRepo.ListAuditCheckpointsMock.Expect(ctx).Return([]models.AuditCheckpoint{*checkpoint}, nil)
+			mockRepo.ListAuditTrailMock.Expect(ctx, 0, 1000).Return(tt.events, nil)
+
+			report, err := service.NewAuditService(mockRepo, mockKeys).VerifyTrail(ctx)
+			if tt.expectedEvent == 0 {
+				require.NoError(t, err)
+				require.Equal(t, 4, report.Events)
+				require.Equal(t, 1, report.Checkpoints)
+				require.Equal(t, trail[3].Hash, report.Head)
+				return
+			}
+
+			var broken *audit.BrokenLinkError
+			require.ErrorAs(t, err, &broken)
+			require.Equal(t, tt.expectedEvent, broken.EventID)
+		})
+	}
+}
@@ -0,0 +1,410 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package service
+
+//go:generate minimock -i github.com/alonsoF100/authorization-service/internal/service.Auditor -o auditor_mock_test.go -n AuditorMock -p service
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/alonsoF100/authorization-service/internal/models"
+	"github.com/gojuno/minimock/v3"
+)
+
+// AuditorMock implements Auditor
+type AuditorMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcRecord          func(ctx context.Context, event models.AuditEvent)
+	funcRecordOrigin    string
+	inspectFuncRecord   func(ctx context.Context, event models.AuditEvent)
+	afterRecordCounter  uint64
+	beforeRecordCounter uint64
+	RecordMock          mAuditorMockRecord
+}
+
+// NewAuditorMock returns a mock for Auditor
+func NewAuditorMock(t minimock.Tester) *AuditorMock {
+	m := &AuditorMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.RecordMock = mAuditorMockRecord{mock: m}
+	m.RecordMock.callArgs = []*AuditorMockRecordParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mAuditorMockRecord struct {
+	optional           bool
+	mock               *AuditorMock
+	defaultExpectation *AuditorMockRecordExpectation
+	expectations       []*AuditorMockRecordExpectation
+
+	callArgs []*AuditorMockRecordParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AuditorMockRecordExpectation specifies expectation struct of the Auditor.Record
+type AuditorMockRecordExpectation struct {
+	mock               *AuditorMock
+	params             *AuditorMockRecordParams
+	paramPtrs          *AuditorMockRecordParamPtrs
+	expectationOrigins AuditorMockRecordExpectationOrigins
+
+	returnOrigin string
+	Counter      uint64
+}
+
+// AuditorMockRecordParams contains parameters of the Auditor.Record
+type AuditorMockRecordParams struct {
+	ctx   context.Context
+	event models.AuditEvent
+}
+
+// AuditorMockRecordParamPtrs contains pointers to parameters of the Auditor.Record
+type AuditorMockRecordParamPtrs struct {
+	ctx   *context.Context
+	event *models.AuditEvent
+}
+
+// AuditorMockRecordOrigins contains origins of expectations of the Auditor.Record
+type AuditorMockRecordExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originEvent string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRecord *mAuditorMockRecord) Optional() *mAuditorMockRecord {
+	mmRecord.optional = true
+	return mmRecord
+}
+
+// Expect sets up expected params for Auditor.Record
+func (mmRecord *mAuditorMockRecord) Expect(ctx context.Context, event models.AuditEvent) *mAuditorMockRecord {
+	if mmRecord.mock.funcRecord != nil {
+		mmRecord.mock.t.Fatalf("AuditorMock.Record mock is already set by Set")
+	}
+
+	if mmRecord.defaultExpectation == nil {
+		mmRecord.defaultExpectation = &AuditorMockRecordExpectation{}
+	}
+
+	if mmRecord.defaultExpectation.paramPtrs != nil {
+		mmRecord.mock.t.Fatalf("AuditorMock.Record mock is already set by ExpectParams functions")
+	}
+
+	mmRecord.defaultExpectation.params = &AuditorMockRecordParams{ctx, event}
+	mmRecord.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRecord.expectations {
+		if minimock.Equal(e.params, mmRecord.defaultExpectation.params) {
+			mmRecord.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRecord.defaultExpectation.params)
+		}
+	}
+
+	return mmRecord
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Auditor.Record
+func (mmRecord *mAuditorMockRecord) ExpectCtxParam1(ctx context.Context) *mAuditorMockRecord {
+	if mmRecord.mock.funcRecord != nil {
+		mmRecord.mock.t.Fatalf("AuditorMock.Record mock is already set by Set")
+	}
+
+	if mmRecord.defaultExpectation == nil {
+		mmRecord.defaultExpectation = &AuditorMockRecordExpectation{}
+	}
+
+	if mmRecord.defaultExpectation.params != nil {
+		mmRecord.mock.t.Fatalf("AuditorMock.Record mock is already set by Expect")
+	}
+
+	if mmRecord.defaultExpectation.paramPtrs == nil {
+		mmRecord.defaultExpectation.paramPtrs = &AuditorMockRecordParamPtrs{}
+	}
+	mmRecord.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRecord.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRecord
+}
+
+// ExpectEventParam2 sets up expected param event for Auditor.Record
+func (mmRecord *mAuditorMockRecord) ExpectEventParam2(event models.AuditEvent) *mAuditorMockRecord {
+	if mmRecord.mock.funcRecord != nil {
+		mmRecord.mock.t.Fatalf("AuditorMock.Record mock is already set by Set")
+	}
+
+	if mmRecord.defaultExpectation == nil {
+		mmRecord.defaultExpectation = &AuditorMockRecordExpectation{}
+	}
+
+	if mmRecord.defaultExpectation.params != nil {
+		mmRecord.mock.t.Fatalf("AuditorMock.Record mock is already set by Expect")
+	}
+
+	if mmRecord.defaultExpectation.paramPtrs == nil {
+		mmRecord.defaultExpectation.paramPtrs = &AuditorMockRecordParamPtrs{}
+	}
+	mmRecord.defaultExpectation.paramPtrs.event = &event
+	mmRecord.defaultExpectation.expectationOrigins.originEvent = minimock.CallerInfo(1)
+
+	return mmRecord
+}
+
+// Inspect accepts an inspector function that has same arguments as the Auditor.Record
+func (mmRecord *mAuditorMockRecord) Inspect(f func(ctx context.Context, event models.AuditEvent)) *mAuditorMockRecord {
+	if mmRecord.mock.inspectFuncRecord != nil {
+		mmRecord.mock.t.Fatalf("Inspect function is already set for AuditorMock.Record")
+	}
+
+	mmRecord.mock.inspectFuncRecord = f
+
+	return mmRecord
+}
+
+// Return sets up results that will be returned by Auditor.Record
+func (mmRecord *mAuditorMockRecord) Return() *AuditorMock {
+	if mmRecord.mock.funcRecord != nil {
+		mmRecord.mock.t.Fatalf("AuditorMock.Record mock is already set by Set")
+	}
+
+	if mmRecord.defaultExpectation == nil {
+		mmRecord.defaultExpectation = &AuditorMockRecordExpectation{mock: mmRecord.mock}
+	}
+
+	mmRecord.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRecord.mock
+}
+
+// Set uses given function f to mock the Auditor.Record method
+func (mmRecord *mAuditorMockRecord) Set(f func(ctx context.Context, event models.AuditEvent)) *AuditorMock {
+	if mmRecord.defaultExpectation != nil {
+		mmRecord.mock.t.Fatalf("Default expectation is already set for the Auditor.Record method")
+	}
+
+	if len(mmRecord.expectations) > 0 {
+		mmRecord.mock.t.Fatalf("Some expectations are already set for the Auditor.Record method")
+	}
+
+	mmRecord.mock.funcRecord = f
+	mmRecord.mock.funcRecordOrigin = minimock.CallerInfo(1)
+	return mmRecord.mock
+}
+
+// When sets expectation for the Auditor.Record which will trigger the result defined by the following
+// Then helper
+func (mmRecord *mAuditorMockRecord) When(ctx context.Context, event models.AuditEvent) *AuditorMockRecordExpectation {
+	if mmRecord.mock.funcRecord != nil {
+		mmRecord.mock.t.Fatalf("AuditorMock.Record mock is already set by Set")
+	}
+
+	expectation := &AuditorMockRecordExpectation{
+		mock:               mmRecord.mock,
+		params:             &AuditorMockRecordParams{ctx, event},
+		expectationOrigins: AuditorMockRecordExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmRecord.expectations = append(mmRecord.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Auditor.Record return parameters for the expectation previously defined by the When method
+
+func (e *AuditorMockRecordExpectation) Then() *AuditorMock {
+	return e.mock
+}
+
+// Times sets number of times Auditor.Record should be invoked
+func (mmRecord *mAuditorMockRecord) Times(n uint64) *mAuditorMockRecord {
+	if n == 0 {
+		mmRecord.mock.t.Fatalf("Times of AuditorMock.Record mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRecord.expectedInvocations, n)
+	mmRecord.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRecord
+}
+
+func (mmRecord *mAuditorMockRecord) invocationsDone() bool {
+	if len(mmRecord.expectations) == 0 && mmRecord.defaultExpectation == nil && mmRecord.mock.funcRecord == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRecord.mock.afterRecordCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRecord.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Record implements Auditor
+func (mmRecord *AuditorMock) Record(ctx context.Context, event models.AuditEvent) {
+	mm_atomic.AddUint64(&mmRecord.beforeRecordCounter, 1)
+	defer mm_atomic.AddUint64(&mmRecord.afterRecordCounter, 1)
+
+	mmRecord.t.Helper()
+
+	if mmRecord.inspectFuncRecord != nil {
+		mmRecord.inspectFuncRecord(ctx, event)
+	}
+
+	mm_params := AuditorMockRecordParams{ctx, event}
+
+	// Record call args
+	mmRecord.RecordMock.mutex.Lock()
+	mmRecord.RecordMock.callArgs = append(mmRecord.RecordMock.callArgs, &mm_params)
+	mmRecord.RecordMock.mutex.Unlock()
+
+	for _, e := range mmRecord.RecordMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return
+		}
+	}
+
+	if mmRecord.RecordMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRecord.RecordMock.defaultExpectation.Counter, 1)
+		mm_want := mmRecord.RecordMock.defaultExpectation.params
+		mm_want_ptrs := mmRecord.RecordMock.defaultExpectation.paramPtrs
+
+		mm_got := AuditorMockRecordParams{ctx, event}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRecord.t.Errorf("AuditorMock.Record got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRecord.RecordMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.event != nil && !minimock.Equal(*mm_want_ptrs.event, mm_got.event) {
+				mmRecord.t.Errorf("AuditorMock.Record got unexpected parameter event, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRecord.RecordMock.defaultExpectation.expectationOrigins.originEvent, *mm_want_ptrs.event, mm_got.event, minimock.Diff(*mm_want_ptrs.event, mm_got.event))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRecord.t.Errorf("AuditorMock.Record got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRecord.RecordMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		return
+
+	}
+	if mmRecord.funcRecord != nil {
+		mmRecord.funcRecord(ctx, event)
+		return
+	}
+	mmRecord.t.Fatalf("Unexpected call to AuditorMock.Record. %v %v", ctx, event)
+
+}
+
+// RecordAfterCounter returns a count of finished AuditorMock.Record invocations
+func (mmRecord *AuditorMock) RecordAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRecord.afterRecordCounter)
+}
+
+// RecordBeforeCounter returns a count of AuditorMock.Record invocations
+func (mmRecord *AuditorMock) RecordBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRecord.beforeRecordCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuditorMock.Record.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRecord *mAuditorMockRecord) Calls() []*AuditorMockRecordParams {
+	mmRecord.mutex.RLock()
+
+	argCopy := make([]*AuditorMockRecordParams, len(mmRecord.callArgs))
+	copy(argCopy, mmRecord.callArgs)
+
+	mmRecord.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRecordDone returns true if the count of the Record invocations corresponds
+// the number of defined expectations
+func (m *AuditorMock) MinimockRecordDone() bool {
+	if m.RecordMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RecordMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RecordMock.invocationsDone()
+}
+
+// MinimockRecordInspect logs each unmet expectation
+func (m *AuditorMock) MinimockRecordInspect() {
+	for _, e := range m.RecordMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuditorMock.Record at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterRecordCounter := mm_atomic.LoadUint64(&m.afterRecordCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RecordMock.defaultExpectation != nil && afterRecordCounter < 1 {
+		if m.RecordMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuditorMock.Record at\n%s", m.RecordMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AuditorMock.Record at\n%s with params: %#v", m.RecordMock.defaultExpectation.expectationOrigins.origin, *m.RecordMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRecord != nil && afterRecordCounter < 1 {
+		m.t.Errorf("Expected call to AuditorMock.Record at\n%s", m.funcRecordOrigin)
+	}
+
+	if !m.RecordMock.invocationsDone() && afterRecordCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuditorMock.Record at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RecordMock.expectedInvocations), m.RecordMock.expectedInvocationsOrigin, afterRecordCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *AuditorMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockRecordInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *AuditorMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *AuditorMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockRecordDone()
+}
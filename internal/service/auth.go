@@ -5,10 +5,12 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"slices"
 	"time"
 
 	"github.com/alonsoF100/authorization-service/internal/apperrors"
 	"github.com/alonsoF100/authorization-service/internal/config"
+	"github.com/alonsoF100/authorization-service/internal/logger"
 	"github.com/alonsoF100/authorization-service/internal/models"
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
@@ -16,19 +18,38 @@ import (
 )
 
 type AuthRepository interface {
+	OutboxWriter
 	CreateUser(ctx context.Context, user *models.User) (*models.User, error)
 	FindByEmail(ctx context.Context, email string) (*models.User, error)
+	FindByID(ctx context.Context, userID string) (*models.User, error)
+	CreateSession(ctx context.Context, session *models.Session) error
+	FindSessionByID(ctx context.Context, sessionID string) (*models.Session, error)
+	TouchSession(ctx context.Context, sessionID string, seenAt time.Time) error
+	IsTokenRevoked(ctx context.Context, jti string) (bool, error)
+}
+
+// SigningKeys provides the asymmetric JWT keys. Without an active key tokens
+// are signed with the HS256 secret from config.
+type SigningKeys interface {
+	ActiveKey() *models.SigningKey
+	FindKey(kid string) *models.SigningKey
+	PublicKeys() []models.PublicKey
 }
 
 type AuthService struct {
 	authRepository AuthRepository
-	secretKey      string
+	signingKeys    SigningKeys
+	auditor        Auditor
 	cfg            *config.Config
+	// Passwords checks the passwords of new users, nil checks none
+	Passwords *PasswordPolicy
 }
 
-func NewAuthService(repository AuthRepository, cfg *config.Config) *AuthService {
+func NewAuthService(repository AuthRepository, signingKeys SigningKeys, auditor Auditor, cfg *config.Config) *AuthService {
 	return &AuthService{
 		authRepository: repository,
+		signingKeys:    signingKeys,
+		auditor:        auditor,
 		cfg:            cfg,
 	}
 }
@@ -41,6 +62,10 @@ func (s AuthService) SignUp(ctx context.Context, nickname, email, password strin
 		slog.String("email", email),
 	)
 
+	if err := s.Passwords.Check(password); err != nil {
+		return nil, err
+	}
+
 	hashed, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
 	if err != nil {
 		slog.Error("Registration failed: password hashing failed",
@@ -60,7 +85,19 @@ func (s AuthService) SignUp(ctx context.Context, nickname, email, password strin
 		UpdatedAt:    time.Now(),
 	}
 
-	user, err := s.authRepository.CreateUser(ctx, userDB)
+	var user *models.User
+	err = s.authRepository.InTx(ctx, func(ctx context.Context) (err error) {
+		user, err = s.authRepository.CreateUser(ctx, userDB)
+		if err != nil {
+			return err
+		}
+
+		return s.authRepository.CreateOutboxEvent(ctx, newOutboxEvent(models.EventUserRegistered, user.ID, map[string]string{
+			"user_id":  user.ID,
+			"email":    user.Email,
+			"nickname": user.Nickname,
+		}))
+	})
 	if err != nil {
 		if errors.Is(err, apperrors.ErrEmailExist) {
 			slog.Info("Registration rejected: email already registered",
@@ -93,11 +130,29 @@ func (s AuthService) SignUp(ctx context.Context, nickname, email, password strin
 		slog.String("email", email),
 		slog.String("nickname", user.Nickname),
 	)
+	record(ctx, s.auditor, models.AuditEvent{
+		Type:      models.AuditUserRegistered,
+		ActorType: string(models.PrincipalUser),
+		ActorID:   user.ID,
+		TargetID:  user.ID,
+	})
 
 	return user, nil
 }
 
-func (s AuthService) SignIn(ctx context.Context, email, password string) (string, error) {
+// SignIn starts a session for the device and returns a token tied to it,
+// the token stops working once the session is revoked
+func (s AuthService) SignIn(ctx context.Context, email, password, userAgent, ip string) (string, error) {
+	return s.signIn(ctx, email, password, userAgent, ip, models.TokenUseAccess)
+}
+
+// SignInSession starts a session like SignIn but returns a session token.
+// It only keeps the user signed in on the authorize page, the API rejects it.
+func (s AuthService) SignInSession(ctx context.Context, email, password, userAgent, ip string) (string, error) {
+	return s.signIn(ctx, email, password, userAgent, ip, models.TokenUseSession)
+}
+
+func (s AuthService) signIn(ctx context.Context, email, password, userAgent, ip, tokenUse string) (string, error) {
 	const op = "service/auth.go/SignIn"
 
 	slog.Debug("Starting authentication",
@@ -120,6 +175,7 @@ func (s AuthService) SignIn(ctx context.Context, email, password string) (string
 			slog.String("op", op),
 			slog.String("email", email),
 		)
+		s.loginFailed(ctx, "", email, "unknown_email")
 		return "", apperrors.ErrInvalidCredentials
 	}
 
@@ -130,10 +186,47 @@ func (s AuthService) SignIn(ctx context.Context, email, password string) (string
 			slog.String("email", email),
 			slog.String("user_id", user.ID),
 		)
+		s.loginFailed(ctx, user.ID, email, "invalid_password")
 		return "", apperrors.ErrInvalidCredentials
 	}
 
-	jwt, err := s.GenerateJWT(user)
+	if user.Disabled() {
+		slog.Info("Authentication failed: user disabled",
+			slog.String("op", op),
+			slog.String("email", email),
+			slog.String("user_id", user.ID),
+		)
+		s.loginFailed(ctx, user.ID, email, "user_disabled")
+		return "", apperrors.ErrUserDisabled
+	}
+
+	if !ipAllowed(user.AllowedIPs, ip) {
+		slog.Info("Authentication failed: address not allowed for user",
+			slog.String("op", op),
+			slog.String("email", email),
+			slog.String("user_id", user.ID),
+			slog.String("ip", ip),
+		)
+		s.loginFailed(ctx, user.ID, email, "ip_not_allowed")
+		return "", apperrors.ErrIPNotAllowed
+	}
+
+	session, err := s.createSession(ctx, user, userAgent, ip)
+	if err != nil {
+		slog.Error("Database error during session creation",
+			slog.String("op", op),
+			slog.String("user_id", user.ID),
+			slog.String("error", err.Error()),
+		)
+		return "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	claims := s.userClaims(user, "", "")
+	claims.SessionID = session.ID
+	if tokenUse == models.TokenUseSession {
+		claims.Roles = nil
+	}
+	jwt, err := s.signAs(claims, tokenUse)
 	if err != nil {
 		slog.Error("Failed to generate JWT",
 			slog.String("op", op),
@@ -148,32 +241,78 @@ func (s AuthService) SignIn(ctx context.Context, email, password string) (string
 		slog.String("user_id", user.ID),
 		slog.String("email", email),
 		slog.String("nickname", user.Nickname),
+		slog.String("session_id", session.ID),
 	)
+	record(ctx, s.auditor, models.AuditEvent{
+		Type:      models.AuditLogin,
+		ActorType: string(models.PrincipalUser),
+		ActorID:   user.ID,
+		TargetID:  user.ID,
+		Details:   map[string]string{"session_id": session.ID},
+	})
 
 	return jwt, nil
 }
 
+// loginFailed records a rejected sign in, the target is empty when the email
+// is not registered. Audit events can't be erased, so the email is only kept
+// for unknown users and only as the pseudonym logger.redaction.hmac_key gives.
+func (s AuthService) loginFailed(ctx context.Context, userID, email, reason string) {
+	details := map[string]string{"reason": reason}
+	if userID == "" && s.cfg != nil && s.cfg.Logger.Redaction.HMACKey != "" {
+		details["email"] = logger.Pseudonym([]byte(s.cfg.Logger.Redaction.HMACKey), email)
+	}
+
+	record(ctx, s.auditor, models.AuditEvent{
+		Type:     models.AuditLogin,
+		Outcome:  models.AuditFailure,
+		TargetID: userID,
+		Details:  details,
+	})
+}
+
 func (s AuthService) GenerateJWT(user *models.User) (string, error) {
-	const op = "service/auth.go/GenerateJWT"
+	return s.GenerateScopedJWT(user, "", "", "")
+}
 
-	claims := models.Claims{
-		ID:       user.ID,
-		Email:    user.Email,
-		Nickname: user.Nickname,
-		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(time.Now().Add(s.cfg.JWT.Expiry)),
-			IssuedAt:  jwt.NewNumericDate(time.Now()),
-			Subject:   user.ID,
-		},
+// GenerateScopedJWT signs a token issued to an OAuth client, the client id,
+// scope and session are left out of the token when empty. A token with a
+// session stops working when the session is revoked.
+func (s AuthService) GenerateScopedJWT(user *models.User, clientID, scope, sessionID string) (string, error) {
+	const op = "service/auth.go/GenerateScopedJWT"
+
+	claims := s.userClaims(user, clientID, scope)
+	claims.SessionID = sessionID
+	jwtStr, err := s.sign(claims)
+	if err != nil {
+		slog.Error("Failed to sign JWT token",
+			slog.String("op", op),
+			slog.String("user_id", user.ID),
+			slog.String("error", err.Error()),
+		)
+		return "", err
 	}
 
-	jwtToken := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	return jwtStr, nil
+}
+
+// GenerateClientJWT signs a machine token for the client credentials grant,
+// the subject is the client id and there is no user
+func (s AuthService) GenerateClientJWT(client *models.Client, scope string) (string, error) {
+	const op = "service/auth.go/GenerateClientJWT"
 
-	jwtStr, err := jwtToken.SignedString([]byte(s.cfg.JWT.SecretKey))
+	claims := models.Claims{
+		Scope:            scope,
+		ClientID:         client.ID,
+		PrincipalType:    models.PrincipalClient,
+		RegisteredClaims: s.registeredClaims(client.ID),
+	}
+
+	jwtStr, err := s.sign(claims)
 	if err != nil {
 		slog.Error("Failed to sign JWT token",
 			slog.String("op", op),
-			slog.String("user_id", user.ID),
+			slog.String("client_id", client.ID),
 			slog.String("error", err.Error()),
 		)
 		return "", err
@@ -182,19 +321,148 @@ func (s AuthService) GenerateJWT(user *models.User) (string, error) {
 	return jwtStr, nil
 }
 
+// userClaims leaves the roles out of tokens issued to OAuth clients, a
+// client acts for the user only within its scopes
+func (s AuthService) userClaims(user *models.User, clientID, scope string) models.Claims {
+	claims := models.Claims{
+		ID:               user.ID,
+		Email:            user.Email,
+		Nickname:         user.Nickname,
+		Scope:            scope,
+		ClientID:         clientID,
+		PrincipalType:    models.PrincipalUser,
+		RegisteredClaims: s.registeredClaims(user.ID),
+	}
+	if clientID == "" {
+		claims.Roles = user.Roles
+	}
+
+	return claims
+}
+
+func (s AuthService) registeredClaims(subject string) jwt.RegisteredClaims {
+	return jwt.RegisteredClaims{
+		Issuer:    s.cfg.OAuth.Issuer,
+		ExpiresAt: jwt.NewNumericDate(time.Now().Add(s.cfg.JWT.Expiry)),
+		IssuedAt:  jwt.NewNumericDate(time.Now()),
+		Subject:   subject,
+		ID:        uuid.New().String(),
+	}
+}
+
+// sign signs an access token
+func (s AuthService) sign(claims models.Claims) (string, error) {
+	return s.signAs(claims, models.TokenUseAccess)
+}
+
+// signAs uses the active ES256 key, or the HS256 secret when there is none
+func (s AuthService) signAs(claims models.Claims, tokenUse string) (string, error) {
+	claims.TokenUse = tokenUse
+
+	if key := s.activeKey(); key != nil {
+		jwtToken := jwt.NewWithClaims(jwt.SigningMethodES256, claims)
+		jwtToken.Header["kid"] = key.ID
+		return jwtToken.SignedString(key.PrivateKey)
+	}
+
+	jwtToken := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	return jwtToken.SignedString([]byte(s.cfg.JWT.SecretKey))
+}
+
 func (s AuthService) ValidateJWT(ctx context.Context, tokenString string) (*models.Claims, error) {
 	const op = "service/auth.go/ValidateToken"
 
+	claims, err := s.parse(tokenString)
+	if err != nil {
+		return nil, err
+	}
+
+	// ID tokens are signed with the same keys, older ones carry no
+	// token_use but all of them are issued for a client audience
+	if reason := s.accessTokenMismatch(claims); reason != "" {
+		slog.Debug("Token validation failed",
+			slog.String("op", op),
+			slog.String("error", reason),
+		)
+		return nil, apperrors.ErrInvalidToken
+	}
+
+	if claims.SessionID != "" {
+		if err := s.checkSession(ctx, claims.SessionID); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.checkRevoked(ctx, claims.RegisteredClaims.ID); err != nil {
+		return nil, err
+	}
+
+	return claims, nil
+}
+
+// ValidateSessionToken checks a token from SignInSession, it is only valid
+// while its session is
+func (s AuthService) ValidateSessionToken(ctx context.Context, tokenString string) (*models.Claims, error) {
+	const op = "service/auth.go/ValidateSessionToken"
+
+	claims, err := s.parse(tokenString)
+	if err != nil {
+		return nil, err
+	}
+
+	if claims.TokenUse != models.TokenUseSession || claims.SessionID == "" || len(claims.Audience) > 0 {
+		slog.Debug("Token validation failed",
+			slog.String("op", op),
+			slog.String("error", "not a session token"),
+		)
+		return nil, apperrors.ErrInvalidToken
+	}
+
+	if err := s.checkSession(ctx, claims.SessionID); err != nil {
+		return nil, err
+	}
+
+	if err := s.checkRevoked(ctx, claims.RegisteredClaims.ID); err != nil {
+		return nil, err
+	}
+
+	return claims, nil
+}
+
+// parse checks the signature and expiry of a token signed by signAs
+func (s AuthService) parse(tokenString string) (*models.Claims, error) {
+	const op = "service/auth.go/parse"
+
 	var claims models.Claims
 	_, err := jwt.ParseWithClaims(tokenString, &claims, func(t *jwt.Token) (any, error) {
-		if _, ok := t.Method.(*jwt.SigningMethodHMAC); !ok {
+		switch t.Method.(type) {
+		case *jwt.SigningMethodHMAC:
+			// once an ES256 key signs new tokens the shared secret only
+			// verifies old ones while jwt.accept_legacy_hs256 is set
+			if s.activeKey() != nil && !s.cfg.JWT.AcceptLegacyHS256 {
+				slog.Debug("Legacy HS256 token rejected",
+					slog.String("op", op),
+				)
+				return nil, apperrors.ErrInvalidToken
+			}
+			return []byte(s.cfg.JWT.SecretKey), nil
+		case *jwt.SigningMethodECDSA:
+			kid, _ := t.Header["kid"].(string)
+			if key := s.findKey(kid); key != nil {
+				return &key.PrivateKey.PublicKey, nil
+			}
+			slog.Debug("Unknown signing key",
+				slog.String("op", op),
+				slog.String("kid", kid),
+			)
+			return nil, apperrors.ErrInvalidToken
+		default:
 			slog.Debug("Invalid signing method",
 				slog.String("op", op),
 				slog.String("method", t.Method.Alg()),
 			)
 			return nil, apperrors.ErrInvalidToken
 		}
-		return []byte(s.cfg.JWT.SecretKey), nil
 	})
 	if err != nil {
 		slog.Debug("Token validation failed",
@@ -206,3 +474,56 @@ func (s AuthService) ValidateJWT(ctx context.Context, tokenString string) (*mode
 
 	return &claims, nil
 }
+
+func (s AuthService) checkRevoked(ctx context.Context, jti string) error {
+	const op = "service/auth.go/checkRevoked"
+
+	if jti == "" {
+		return nil
+	}
+
+	revoked, err := s.authRepository.IsTokenRevoked(ctx, jti)
+	if err != nil {
+		slog.Error("Database error during token validation",
+			slog.String("op", op),
+			slog.String("error", err.Error()),
+		)
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if revoked {
+		slog.Debug("Token is revoked",
+			slog.String("op", op),
+			slog.String("jti", jti),
+		)
+		return apperrors.ErrInvalidToken
+	}
+
+	return nil
+}
+
+func (s AuthService) accessTokenMismatch(claims *models.Claims) string {
+	switch {
+	case claims.TokenUse != "" && claims.TokenUse != models.TokenUseAccess:
+		return "not an access token"
+	case len(claims.Audience) > 0 && !slices.Contains(claims.Audience, s.cfg.OAuth.Issuer):
+		return "token issued for another audience"
+	}
+
+	return ""
+}
+
+func (s AuthService) activeKey() *models.SigningKey {
+	if s.signingKeys == nil {
+		return nil
+	}
+
+	return s.signingKeys.ActiveKey()
+}
+
+func (s AuthService) findKey(kid string) *models.SigningKey {
+	if s.signingKeys == nil || kid == "" {
+		return nil
+	}
+
+	return s.signingKeys.FindKey(kid)
+}
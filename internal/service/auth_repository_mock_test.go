@@ -8,6 +8,7 @@ import (
 	"context"
 	"sync"
 	mm_atomic "sync/atomic"
+	"time"
 	mm_time "time"
 
 	"github.com/alonsoF100/authorization-service/internal/models"
@@ -19,6 +20,20 @@ type AuthRepositoryMock struct {
 	t          minimock.Tester
 	finishOnce sync.Once
 
+	funcCreateOutboxEvent          func(ctx context.Context, event *models.OutboxEvent) (err error)
+	funcCreateOutboxEventOrigin    string
+	inspectFuncCreateOutboxEvent   func(ctx context.Context, event *models.OutboxEvent)
+	afterCreateOutboxEventCounter  uint64
+	beforeCreateOutboxEventCounter uint64
+	CreateOutboxEventMock          mAuthRepositoryMockCreateOutboxEvent
+
+	funcCreateSession          func(ctx context.Context, session *models.Session) (err error)
+	funcCreateSessionOrigin    string
+	inspectFuncCreateSession   func(ctx context.Context, session *models.Session)
+	afterCreateSessionCounter  uint64
+	beforeCreateSessionCounter uint64
+	CreateSessionMock          mAuthRepositoryMockCreateSession
+
 	funcCreateUser          func(ctx context.Context, user *models.User) (up1 *models.User, err error)
 	funcCreateUserOrigin    string
 	inspectFuncCreateUser   func(ctx context.Context, user *models.User)
@@ -32,6 +47,41 @@ type AuthRepositoryMock struct {
 	afterFindByEmailCounter  uint64
 	beforeFindByEmailCounter uint64
 	FindByEmailMock          mAuthRepositoryMockFindByEmail
+
+	funcFindByID          func(ctx context.Context, userID string) (up1 *models.User, err error)
+	funcFindByIDOrigin    string
+	inspectFuncFindByID   func(ctx context.Context, userID string)
+	afterFindByIDCounter  uint64
+	beforeFindByIDCounter uint64
+	FindByIDMock          mAuthRepositoryMockFindByID
+
+	funcFindSessionByID          func(ctx context.Context, sessionID string) (sp1 *models.Session, err error)
+	funcFindSessionByIDOrigin    string
+	inspectFuncFindSessionByID   func(ctx context.Context, sessionID string)
+	afterFindSessionByIDCounter  uint64
+	beforeFindSessionByIDCounter uint64
+	FindSessionByIDMock          mAuthRepositoryMockFindSessionByID
+
+	funcInTx          func(ctx context.Context, fn func(ctx context.Context) error) (err error)
+	funcInTxOrigin    string
+	inspectFuncInTx   func(ctx context.Context, fn func(ctx context.Context) error)
+	afterInTxCounter  uint64
+	beforeInTxCounter uint64
+	InTxMock          mAuthRepositoryMockInTx
+
+	funcIsTokenRevoked          func(ctx context.Context, jti string) (b1 bool, err error)
+	funcIsTokenRevokedOrigin    string
+	inspectFuncIsTokenRevoked   func(ctx context.Context, jti string)
+	afterIsTokenRevokedCounter  uint64
+	beforeIsTokenRevokedCounter uint64
+	IsTokenRevokedMock          mAuthRepositoryMockIsTokenRevoked
+
+	funcTouchSession          func(ctx context.Context, sessionID string, seenAt time.Time) (err error)
+	funcTouchSessionOrigin    string
+	inspectFuncTouchSession   func(ctx context.Context, sessionID string, seenAt time.Time)
+	afterTouchSessionCounter  uint64
+	beforeTouchSessionCounter uint64
+	TouchSessionMock          mAuthRepositoryMockTouchSession
 }
 
 // NewAuthRepositoryMock returns a mock for AuthRepository
@@ -42,64 +92,84 @@ func NewAuthRepositoryMock(t minimock.Tester) *AuthRepositoryMock {
 		controller.RegisterMocker(m)
 	}
 
+	m.CreateOutboxEventMock = mAuthRepositoryMockCreateOutboxEvent{mock: m}
+	m.CreateOutboxEventMock.callArgs = []*AuthRepositoryMockCreateOutboxEventParams{}
+
+	m.CreateSessionMock = mAuthRepositoryMockCreateSession{mock: m}
+	m.CreateSessionMock.callArgs = []*AuthRepositoryMockCreateSessionParams{}
+
 	m.CreateUserMock = mAuthRepositoryMockCreateUser{mock: m}
 	m.CreateUserMock.callArgs = []*AuthRepositoryMockCreateUserParams{}
 
 	m.FindByEmailMock = mAuthRepositoryMockFindByEmail{mock: m}
 	m.FindByEmailMock.callArgs = []*AuthRepositoryMockFindByEmailParams{}
 
+	m.FindByIDMock = mAuthRepositoryMockFindByID{mock: m}
+	m.FindByIDMock.callArgs = []*AuthRepositoryMockFindByIDParams{}
+
+	m.FindSessionByIDMock = mAuthRepositoryMockFindSessionByID{mock: m}
+	m.FindSessionByIDMock.callArgs = []*AuthRepositoryMockFindSessionByIDParams{}
+
+	m.InTxMock = mAuthRepositoryMockInTx{mock: m}
+	m.InTxMock.callArgs = []*AuthRepositoryMockInTxParams{}
+
+	m.IsTokenRevokedMock = mAuthRepositoryMockIsTokenRevoked{mock: m}
+	m.IsTokenRevokedMock.callArgs = []*AuthRepositoryMockIsTokenRevokedParams{}
+
+	m.TouchSessionMock = mAuthRepositoryMockTouchSession{mock: m}
+	m.TouchSessionMock.callArgs = []*AuthRepositoryMockTouchSessionParams{}
+
 	t.Cleanup(m.MinimockFinish)
 
 	return m
 }
 
-type mAuthRepositoryMockCreateUser struct {
+type mAuthRepositoryMockCreateOutboxEvent struct {
 	optional           bool
 	mock               *AuthRepositoryMock
-	defaultExpectation *AuthRepositoryMockCreateUserExpectation
-	expectations       []*AuthRepositoryMockCreateUserExpectation
+	defaultExpectation *AuthRepositoryMockCreateOutboxEventExpectation
+	expectations       []*AuthRepositoryMockCreateOutboxEventExpectation
 
-	callArgs []*AuthRepositoryMockCreateUserParams
+	callArgs []*AuthRepositoryMockCreateOutboxEventParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// AuthRepositoryMockCreateUserExpectation specifies expectation struct of the AuthRepository.CreateUser
-type AuthRepositoryMockCreateUserExpectation struct {
+// AuthRepositoryMockCreateOutboxEventExpectation specifies expectation struct of the AuthRepository.CreateOutboxEvent
+type AuthRepositoryMockCreateOutboxEventExpectation struct {
 	mock               *AuthRepositoryMock
-	params             *AuthRepositoryMockCreateUserParams
-	paramPtrs          *AuthRepositoryMockCreateUserParamPtrs
-	expectationOrigins AuthRepositoryMockCreateUserExpectationOrigins
-	results            *AuthRepositoryMockCreateUserResults
+	params             *AuthRepositoryMockCreateOutboxEventParams
+	paramPtrs          *AuthRepositoryMockCreateOutboxEventParamPtrs
+	expectationOrigins AuthRepositoryMockCreateOutboxEventExpectationOrigins
+	results            *AuthRepositoryMockCreateOutboxEventResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// AuthRepositoryMockCreateUserParams contains parameters of the AuthRepository.CreateUser
-type AuthRepositoryMockCreateUserParams struct {
-	ctx  context.Context
-	user *models.User
+// AuthRepositoryMockCreateOutboxEventParams contains parameters of the AuthRepository.CreateOutboxEvent
+type AuthRepositoryMockCreateOutboxEventParams struct {
+	ctx   context.Context
+	event *models.OutboxEvent
 }
 
-// AuthRepositoryMockCreateUserParamPtrs contains pointers to parameters of the AuthRepository.CreateUser
-type AuthRepositoryMockCreateUserParamPtrs struct {
-	ctx  *context.Context
-	user **models.User
+// AuthRepositoryMockCreateOutboxEventParamPtrs contains pointers to parameters of the AuthRepository.CreateOutboxEvent
+type AuthRepositoryMockCreateOutboxEventParamPtrs struct {
+	ctx   *context.Context
+	event **models.OutboxEvent
 }
 
-// AuthRepositoryMockCreateUserResults contains results of the AuthRepository.CreateUser
-type AuthRepositoryMockCreateUserResults struct {
-	up1 *models.User
+// AuthRepositoryMockCreateOutboxEventResults contains results of the AuthRepository.CreateOutboxEvent
+type AuthRepositoryMockCreateOutboxEventResults struct {
 	err error
 }
 
-// AuthRepositoryMockCreateUserOrigins contains origins of expectations of the AuthRepository.CreateUser
-type AuthRepositoryMockCreateUserExpectationOrigins struct {
-	origin     string
-	originCtx  string
-	originUser string
+// AuthRepositoryMockCreateOutboxEventOrigins contains origins of expectations of the AuthRepository.CreateOutboxEvent
+type AuthRepositoryMockCreateOutboxEventExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originEvent string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -107,342 +177,341 @@ type AuthRepositoryMockCreateUserExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmCreateUser *mAuthRepositoryMockCreateUser) Optional() *mAuthRepositoryMockCreateUser {
-	mmCreateUser.optional = true
-	return mmCreateUser
+func (mmCreateOutboxEvent *mAuthRepositoryMockCreateOutboxEvent) Optional() *mAuthRepositoryMockCreateOutboxEvent {
+	mmCreateOutboxEvent.optional = true
+	return mmCreateOutboxEvent
 }
 
-// Expect sets up expected params for AuthRepository.CreateUser
-func (mmCreateUser *mAuthRepositoryMockCreateUser) Expect(ctx context.Context, user *models.User) *mAuthRepositoryMockCreateUser {
-	if mmCreateUser.mock.funcCreateUser != nil {
-		mmCreateUser.mock.t.Fatalf("AuthRepositoryMock.CreateUser mock is already set by Set")
+// Expect sets up expected params for AuthRepository.CreateOutboxEvent
+func (mmCreateOutboxEvent *mAuthRepositoryMockCreateOutboxEvent) Expect(ctx context.Context, event *models.OutboxEvent) *mAuthRepositoryMockCreateOutboxEvent {
+	if mmCreateOutboxEvent.mock.funcCreateOutboxEvent != nil {
+		mmCreateOutboxEvent.mock.t.Fatalf("AuthRepositoryMock.CreateOutboxEvent mock is already set by Set")
 	}
 
-	if mmCreateUser.defaultExpectation == nil {
-		mmCreateUser.defaultExpectation = &AuthRepositoryMockCreateUserExpectation{}
+	if mmCreateOutboxEvent.defaultExpectation == nil {
+		mmCreateOutboxEvent.defaultExpectation = &AuthRepositoryMockCreateOutboxEventExpectation{}
 	}
 
-	if mmCreateUser.defaultExpectation.paramPtrs != nil {
-		mmCreateUser.mock.t.Fatalf("AuthRepositoryMock.CreateUser mock is already set by ExpectParams functions")
+	if mmCreateOutboxEvent.defaultExpectation.paramPtrs != nil {
+		mmCreateOutboxEvent.mock.t.Fatalf("AuthRepositoryMock.CreateOutboxEvent mock is already set by ExpectParams functions")
 	}
 
-	mmCreateUser.defaultExpectation.params = &AuthRepositoryMockCreateUserParams{ctx, user}
-	mmCreateUser.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmCreateUser.expectations {
-		if minimock.Equal(e.params, mmCreateUser.defaultExpectation.params) {
-			mmCreateUser.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateUser.defaultExpectation.params)
+	mmCreateOutboxEvent.defaultExpectation.params = &AuthRepositoryMockCreateOutboxEventParams{ctx, event}
+	mmCreateOutboxEvent.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreateOutboxEvent.expectations {
+		if minimock.Equal(e.params, mmCreateOutboxEvent.defaultExpectation.params) {
+			mmCreateOutboxEvent.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateOutboxEvent.defaultExpectation.params)
 		}
 	}
 
-	return mmCreateUser
+	return mmCreateOutboxEvent
 }
 
-// ExpectCtxParam1 sets up expected param ctx for AuthRepository.CreateUser
-func (mmCreateUser *mAuthRepositoryMockCreateUser) ExpectCtxParam1(ctx context.Context) *mAuthRepositoryMockCreateUser {
-	if mmCreateUser.mock.funcCreateUser != nil {
-		mmCreateUser.mock.t.Fatalf("AuthRepositoryMock.CreateUser mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for AuthRepository.CreateOutboxEvent
+func (mmCreateOutboxEvent *mAuthRepositoryMockCreateOutboxEvent) ExpectCtxParam1(ctx context.Context) *mAuthRepositoryMockCreateOutboxEvent {
+	if mmCreateOutboxEvent.mock.funcCreateOutboxEvent != nil {
+		mmCreateOutboxEvent.mock.t.Fatalf("AuthRepositoryMock.CreateOutboxEvent mock is already set by Set")
 	}
 
-	if mmCreateUser.defaultExpectation == nil {
-		mmCreateUser.defaultExpectation = &AuthRepositoryMockCreateUserExpectation{}
+	if mmCreateOutboxEvent.defaultExpectation == nil {
+		mmCreateOutboxEvent.defaultExpectation = &AuthRepositoryMockCreateOutboxEventExpectation{}
 	}
 
-	if mmCreateUser.defaultExpectation.params != nil {
-		mmCreateUser.mock.t.Fatalf("AuthRepositoryMock.CreateUser mock is already set by Expect")
+	if mmCreateOutboxEvent.defaultExpectation.params != nil {
+		mmCreateOutboxEvent.mock.t.Fatalf("AuthRepositoryMock.CreateOutboxEvent mock is already set by Expect")
 	}
 
-	if mmCreateUser.defaultExpectation.paramPtrs == nil {
-		mmCreateUser.defaultExpectation.paramPtrs = &AuthRepositoryMockCreateUserParamPtrs{}
+	if mmCreateOutboxEvent.defaultExpectation.paramPtrs == nil {
+		mmCreateOutboxEvent.defaultExpectation.paramPtrs = &AuthRepositoryMockCreateOutboxEventParamPtrs{}
 	}
-	mmCreateUser.defaultExpectation.paramPtrs.ctx = &ctx
-	mmCreateUser.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmCreateOutboxEvent.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreateOutboxEvent.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmCreateUser
+	return mmCreateOutboxEvent
 }
 
-// ExpectUserParam2 sets up expected param user for AuthRepository.CreateUser
-func (mmCreateUser *mAuthRepositoryMockCreateUser) ExpectUserParam2(user *models.User) *mAuthRepositoryMockCreateUser {
-	if mmCreateUser.mock.funcCreateUser != nil {
-		mmCreateUser.mock.t.Fatalf("AuthRepositoryMock.CreateUser mock is already set by Set")
+// ExpectEventParam2 sets up expected param event for AuthRepository.CreateOutboxEvent
+func (mmCreateOutboxEvent *mAuthRepositoryMockCreateOutboxEvent) ExpectEventParam2(event *models.OutboxEvent) *mAuthRepositoryMockCreateOutboxEvent {
+	if mmCreateOutboxEvent.mock.funcCreateOutboxEvent != nil {
+		mmCreateOutboxEvent.mock.t.Fatalf("AuthRepositoryMock.CreateOutboxEvent mock is already set by Set")
 	}
 
-	if mmCreateUser.defaultExpectation == nil {
-		mmCreateUser.defaultExpectation = &AuthRepositoryMockCreateUserExpectation{}
+	if mmCreateOutboxEvent.defaultExpectation == nil {
+		mmCreateOutboxEvent.defaultExpectation = &AuthRepositoryMockCreateOutboxEventExpectation{}
 	}
 
-	if mmCreateUser.defaultExpectation.params != nil {
-		mmCreateUser.mock.t.Fatalf("AuthRepositoryMock.CreateUser mock is already set by Expect")
+	if mmCreateOutboxEvent.defaultExpectation.params != nil {
+		mmCreateOutboxEvent.mock.t.Fatalf("AuthRepositoryMock.CreateOutboxEvent mock is already set by Expect")
 	}
 
-	if mmCreateUser.defaultExpectation.paramPtrs == nil {
-		mmCreateUser.defaultExpectation.paramPtrs = &AuthRepositoryMockCreateUserParamPtrs{}
+	if mmCreateOutboxEvent.defaultExpectation.paramPtrs == nil {
+		mmCreateOutboxEvent.defaultExpectation.paramPtrs = &AuthRepositoryMockCreateOutboxEventParamPtrs{}
 	}
-	mmCreateUser.defaultExpectation.paramPtrs.user = &user
-	mmCreateUser.defaultExpectation.expectationOrigins.originUser = minimock.CallerInfo(1)
+	mmCreateOutboxEvent.defaultExpectation.paramPtrs.event = &event
+	mmCreateOutboxEvent.defaultExpectation.expectationOrigins.originEvent = minimock.CallerInfo(1)
 
-	return mmCreateUser
+	return mmCreateOutboxEvent
 }
 
-// Inspect accepts an inspector function that has same arguments as the AuthRepository.CreateUser
-func (mmCreateUser *mAuthRepositoryMockCreateUser) Inspect(f func(ctx context.Context, user *models.User)) *mAuthRepositoryMockCreateUser {
-	if mmCreateUser.mock.inspectFuncCreateUser != nil {
-		mmCreateUser.mock.t.Fatalf("Inspect function is already set for AuthRepositoryMock.CreateUser")
+// Inspect accepts an inspector function that has same arguments as the AuthRepository.CreateOutboxEvent
+func (mmCreateOutboxEvent *mAuthRepositoryMockCreateOutboxEvent) Inspect(f func(ctx context.Context, event *models.OutboxEvent)) *mAuthRepositoryMockCreateOutboxEvent {
+	if mmCreateOutboxEvent.mock.inspectFuncCreateOutboxEvent != nil {
+		mmCreateOutboxEvent.mock.t.Fatalf("Inspect function is already set for AuthRepositoryMock.CreateOutboxEvent")
 	}
 
-	mmCreateUser.mock.inspectFuncCreateUser = f
+	mmCreateOutboxEvent.mock.inspectFuncCreateOutboxEvent = f
 
-	return mmCreateUser
+	return mmCreateOutboxEvent
 }
 
-// Return sets up results that will be returned by AuthRepository.CreateUser
-func (mmCreateUser *mAuthRepositoryMockCreateUser) Return(up1 *models.User, err error) *AuthRepositoryMock {
-	if mmCreateUser.mock.funcCreateUser != nil {
-		mmCreateUser.mock.t.Fatalf("AuthRepositoryMock.CreateUser mock is already set by Set")
+// Return sets up results that will be returned by AuthRepository.CreateOutboxEvent
+func (mmCreateOutboxEvent *mAuthRepositoryMockCreateOutboxEvent) Return(err error) *AuthRepositoryMock {
+	if mmCreateOutboxEvent.mock.funcCreateOutboxEvent != nil {
+		mmCreateOutboxEvent.mock.t.Fatalf("AuthRepositoryMock.CreateOutboxEvent mock is already set by Set")
 	}
 
-	if mmCreateUser.defaultExpectation == nil {
-		mmCreateUser.defaultExpectation = &AuthRepositoryMockCreateUserExpectation{mock: mmCreateUser.mock}
+	if mmCreateOutboxEvent.defaultExpectation == nil {
+		mmCreateOutboxEvent.defaultExpectation = &AuthRepositoryMockCreateOutboxEventExpectation{mock: mmCreateOutboxEvent.mock}
 	}
-	mmCreateUser.defaultExpectation.results = &AuthRepositoryMockCreateUserResults{up1, err}
-	mmCreateUser.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmCreateUser.mock
+	mmCreateOutboxEvent.defaultExpectation.results = &AuthRepositoryMockCreateOutboxEventResults{err}
+	mmCreateOutboxEvent.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreateOutboxEvent.mock
 }
 
-// Set uses given function f to mock the AuthRepository.CreateUser method
-func (mmCreateUser *mAuthRepositoryMockCreateUser) Set(f func(ctx context.Context, user *models.User) (up1 *models.User, err error)) *AuthRepositoryMock {
-	if mmCreateUser.defaultExpectation != nil {
-		mmCreateUser.mock.t.Fatalf("Default expectation is already set for the AuthRepository.CreateUser method")
+// Set uses given function f to mock the AuthRepository.CreateOutboxEvent method
+func (mmCreateOutboxEvent *mAuthRepositoryMockCreateOutboxEvent) Set(f func(ctx context.Context, event *models.OutboxEvent) (err error)) *AuthRepositoryMock {
+	if mmCreateOutboxEvent.defaultExpectation != nil {
+		mmCreateOutboxEvent.mock.t.Fatalf("Default expectation is already set for the AuthRepository.CreateOutboxEvent method")
 	}
 
-	if len(mmCreateUser.expectations) > 0 {
-		mmCreateUser.mock.t.Fatalf("Some expectations are already set for the AuthRepository.CreateUser method")
+	if len(mmCreateOutboxEvent.expectations) > 0 {
+		mmCreateOutboxEvent.mock.t.Fatalf("Some expectations are already set for the AuthRepository.CreateOutboxEvent method")
 	}
 
-	mmCreateUser.mock.funcCreateUser = f
-	mmCreateUser.mock.funcCreateUserOrigin = minimock.CallerInfo(1)
-	return mmCreateUser.mock
+	mmCreateOutboxEvent.mock.funcCreateOutboxEvent = f
+	mmCreateOutboxEvent.mock.funcCreateOutboxEventOrigin = minimock.CallerInfo(1)
+	return mmCreateOutboxEvent.mock
 }
 
-// When sets expectation for the AuthRepository.CreateUser which will trigger the result defined by the following
+// When sets expectation for the AuthRepository.CreateOutboxEvent which will trigger the result defined by the following
 // Then helper
-func (mmCreateUser *mAuthRepositoryMockCreateUser) When(ctx context.Context, user *models.User) *AuthRepositoryMockCreateUserExpectation {
-	if mmCreateUser.mock.funcCreateUser != nil {
-		mmCreateUser.mock.t.Fatalf("AuthRepositoryMock.CreateUser mock is already set by Set")
+func (mmCreateOutboxEvent *mAuthRepositoryMockCreateOutboxEvent) When(ctx context.Context, event *models.OutboxEvent) *AuthRepositoryMockCreateOutboxEventExpectation {
+	if mmCreateOutboxEvent.mock.funcCreateOutboxEvent != nil {
+		mmCreateOutboxEvent.mock.t.Fatalf("AuthRepositoryMock.CreateOutboxEvent mock is already set by Set")
 	}
 
-	expectation := &AuthRepositoryMockCreateUserExpectation{
-		mock:               mmCreateUser.mock,
-		params:             &AuthRepositoryMockCreateUserParams{ctx, user},
-		expectationOrigins: AuthRepositoryMockCreateUserExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &AuthRepositoryMockCreateOutboxEventExpectation{
+		mock:               mmCreateOutboxEvent.mock,
+		params:             &AuthRepositoryMockCreateOutboxEventParams{ctx, event},
+		expectationOrigins: AuthRepositoryMockCreateOutboxEventExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmCreateUser.expectations = append(mmCreateUser.expectations, expectation)
+	mmCreateOutboxEvent.expectations = append(mmCreateOutboxEvent.expectations, expectation)
 	return expectation
 }
 
-// Then sets up AuthRepository.CreateUser return parameters for the expectation previously defined by the When method
-func (e *AuthRepositoryMockCreateUserExpectation) Then(up1 *models.User, err error) *AuthRepositoryMock {
-	e.results = &AuthRepositoryMockCreateUserResults{up1, err}
+// Then sets up AuthRepository.CreateOutboxEvent return parameters for the expectation previously defined by the When method
+func (e *AuthRepositoryMockCreateOutboxEventExpectation) Then(err error) *AuthRepositoryMock {
+	e.results = &AuthRepositoryMockCreateOutboxEventResults{err}
 	return e.mock
 }
 
-// Times sets number of times AuthRepository.CreateUser should be invoked
-func (mmCreateUser *mAuthRepositoryMockCreateUser) Times(n uint64) *mAuthRepositoryMockCreateUser {
+// Times sets number of times AuthRepository.CreateOutboxEvent should be invoked
+func (mmCreateOutboxEvent *mAuthRepositoryMockCreateOutboxEvent) Times(n uint64) *mAuthRepositoryMockCreateOutboxEvent {
 	if n == 0 {
-		mmCreateUser.mock.t.Fatalf("Times of AuthRepositoryMock.CreateUser mock can not be zero")
+		mmCreateOutboxEvent.mock.t.Fatalf("Times of AuthRepositoryMock.CreateOutboxEvent mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmCreateUser.expectedInvocations, n)
-	mmCreateUser.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmCreateUser
+	mm_atomic.StoreUint64(&mmCreateOutboxEvent.expectedInvocations, n)
+	mmCreateOutboxEvent.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreateOutboxEvent
 }
 
-func (mmCreateUser *mAuthRepositoryMockCreateUser) invocationsDone() bool {
-	if len(mmCreateUser.expectations) == 0 && mmCreateUser.defaultExpectation == nil && mmCreateUser.mock.funcCreateUser == nil {
+func (mmCreateOutboxEvent *mAuthRepositoryMockCreateOutboxEvent) invocationsDone() bool {
+	if len(mmCreateOutboxEvent.expectations) == 0 && mmCreateOutboxEvent.defaultExpectation == nil && mmCreateOutboxEvent.mock.funcCreateOutboxEvent == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmCreateUser.mock.afterCreateUserCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmCreateUser.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateOutboxEvent.mock.afterCreateOutboxEventCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateOutboxEvent.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// CreateUser implements AuthRepository
-func (mmCreateUser *AuthRepositoryMock) CreateUser(ctx context.Context, user *models.User) (up1 *models.User, err error) {
-	mm_atomic.AddUint64(&mmCreateUser.beforeCreateUserCounter, 1)
-	defer mm_atomic.AddUint64(&mmCreateUser.afterCreateUserCounter, 1)
+// CreateOutboxEvent implements AuthRepository
+func (mmCreateOutboxEvent *AuthRepositoryMock) CreateOutboxEvent(ctx context.Context, event *models.OutboxEvent) (err error) {
+	mm_atomic.AddUint64(&mmCreateOutboxEvent.beforeCreateOutboxEventCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateOutboxEvent.afterCreateOutboxEventCounter, 1)
 
-	mmCreateUser.t.Helper()
+	mmCreateOutboxEvent.t.Helper()
 
-	if mmCreateUser.inspectFuncCreateUser != nil {
-		mmCreateUser.inspectFuncCreateUser(ctx, user)
+	if mmCreateOutboxEvent.inspectFuncCreateOutboxEvent != nil {
+		mmCreateOutboxEvent.inspectFuncCreateOutboxEvent(ctx, event)
 	}
 
-	mm_params := AuthRepositoryMockCreateUserParams{ctx, user}
+	mm_params := AuthRepositoryMockCreateOutboxEventParams{ctx, event}
 
 	// Record call args
-	mmCreateUser.CreateUserMock.mutex.Lock()
-	mmCreateUser.CreateUserMock.callArgs = append(mmCreateUser.CreateUserMock.callArgs, &mm_params)
-	mmCreateUser.CreateUserMock.mutex.Unlock()
+	mmCreateOutboxEvent.CreateOutboxEventMock.mutex.Lock()
+	mmCreateOutboxEvent.CreateOutboxEventMock.callArgs = append(mmCreateOutboxEvent.CreateOutboxEventMock.callArgs, &mm_params)
+	mmCreateOutboxEvent.CreateOutboxEventMock.mutex.Unlock()
 
-	for _, e := range mmCreateUser.CreateUserMock.expectations {
+	for _, e := range mmCreateOutboxEvent.CreateOutboxEventMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.up1, e.results.err
+			return e.results.err
 		}
 	}
 
-	if mmCreateUser.CreateUserMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmCreateUser.CreateUserMock.defaultExpectation.Counter, 1)
-		mm_want := mmCreateUser.CreateUserMock.defaultExpectation.params
-		mm_want_ptrs := mmCreateUser.CreateUserMock.defaultExpectation.paramPtrs
+	if mmCreateOutboxEvent.CreateOutboxEventMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateOutboxEvent.CreateOutboxEventMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateOutboxEvent.CreateOutboxEventMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateOutboxEvent.CreateOutboxEventMock.defaultExpectation.paramPtrs
 
-		mm_got := AuthRepositoryMockCreateUserParams{ctx, user}
+		mm_got := AuthRepositoryMockCreateOutboxEventParams{ctx, event}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmCreateUser.t.Errorf("AuthRepositoryMock.CreateUser got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmCreateUser.CreateUserMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmCreateOutboxEvent.t.Errorf("AuthRepositoryMock.CreateOutboxEvent got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateOutboxEvent.CreateOutboxEventMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.user != nil && !minimock.Equal(*mm_want_ptrs.user, mm_got.user) {
-				mmCreateUser.t.Errorf("AuthRepositoryMock.CreateUser got unexpected parameter user, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmCreateUser.CreateUserMock.defaultExpectation.expectationOrigins.originUser, *mm_want_ptrs.user, mm_got.user, minimock.Diff(*mm_want_ptrs.user, mm_got.user))
+			if mm_want_ptrs.event != nil && !minimock.Equal(*mm_want_ptrs.event, mm_got.event) {
+				mmCreateOutboxEvent.t.Errorf("AuthRepositoryMock.CreateOutboxEvent got unexpected parameter event, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateOutboxEvent.CreateOutboxEventMock.defaultExpectation.expectationOrigins.originEvent, *mm_want_ptrs.event, mm_got.event, minimock.Diff(*mm_want_ptrs.event, mm_got.event))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmCreateUser.t.Errorf("AuthRepositoryMock.CreateUser got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmCreateUser.CreateUserMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmCreateOutboxEvent.t.Errorf("AuthRepositoryMock.CreateOutboxEvent got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreateOutboxEvent.CreateOutboxEventMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmCreateUser.CreateUserMock.defaultExpectation.results
+		mm_results := mmCreateOutboxEvent.CreateOutboxEventMock.defaultExpectation.results
 		if mm_results == nil {
-			mmCreateUser.t.Fatal("No results are set for the AuthRepositoryMock.CreateUser")
+			mmCreateOutboxEvent.t.Fatal("No results are set for the AuthRepositoryMock.CreateOutboxEvent")
 		}
-		return (*mm_results).up1, (*mm_results).err
+		return (*mm_results).err
 	}
-	if mmCreateUser.funcCreateUser != nil {
-		return mmCreateUser.funcCreateUser(ctx, user)
+	if mmCreateOutboxEvent.funcCreateOutboxEvent != nil {
+		return mmCreateOutboxEvent.funcCreateOutboxEvent(ctx, event)
 	}
-	mmCreateUser.t.Fatalf("Unexpected call to AuthRepositoryMock.CreateUser. %v %v", ctx, user)
+	mmCreateOutboxEvent.t.Fatalf("Unexpected call to AuthRepositoryMock.CreateOutboxEvent. %v %v", ctx, event)
 	return
 }
 
-// CreateUserAfterCounter returns a count of finished AuthRepositoryMock.CreateUser invocations
-func (mmCreateUser *AuthRepositoryMock) CreateUserAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmCreateUser.afterCreateUserCounter)
+// CreateOutboxEventAfterCounter returns a count of finished AuthRepositoryMock.CreateOutboxEvent invocations
+func (mmCreateOutboxEvent *AuthRepositoryMock) CreateOutboxEventAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateOutboxEvent.afterCreateOutboxEventCounter)
 }
 
-// CreateUserBeforeCounter returns a count of AuthRepositoryMock.CreateUser invocations
-func (mmCreateUser *AuthRepositoryMock) CreateUserBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmCreateUser.beforeCreateUserCounter)
+// CreateOutboxEventBeforeCounter returns a count of AuthRepositoryMock.CreateOutboxEvent invocations
+func (mmCreateOutboxEvent *AuthRepositoryMock) CreateOutboxEventBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateOutboxEvent.beforeCreateOutboxEventCounter)
 }
 
-// Calls returns a list of arguments used in each call to AuthRepositoryMock.CreateUser.
+// Calls returns a list of arguments used in each call to AuthRepositoryMock.CreateOutboxEvent.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmCreateUser *mAuthRepositoryMockCreateUser) Calls() []*AuthRepositoryMockCreateUserParams {
-	mmCreateUser.mutex.RLock()
+func (mmCreateOutboxEvent *mAuthRepositoryMockCreateOutboxEvent) Calls() []*AuthRepositoryMockCreateOutboxEventParams {
+	mmCreateOutboxEvent.mutex.RLock()
 
-	argCopy := make([]*AuthRepositoryMockCreateUserParams, len(mmCreateUser.callArgs))
-	copy(argCopy, mmCreateUser.callArgs)
+	argCopy := make([]*AuthRepositoryMockCreateOutboxEventParams, len(mmCreateOutboxEvent.callArgs))
+	copy(argCopy, mmCreateOutboxEvent.callArgs)
 
-	mmCreateUser.mutex.RUnlock()
+	mmCreateOutboxEvent.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockCreateUserDone returns true if the count of the CreateUser invocations corresponds
+// MinimockCreateOutboxEventDone returns true if the count of the CreateOutboxEvent invocations corresponds
 // the number of defined expectations
-func (m *AuthRepositoryMock) MinimockCreateUserDone() bool {
-	if m.CreateUserMock.optional {
+func (m *AuthRepositoryMock) MinimockCreateOutboxEventDone() bool {
+	if m.CreateOutboxEventMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.CreateUserMock.expectations {
+	for _, e := range m.CreateOutboxEventMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.CreateUserMock.invocationsDone()
+	return m.CreateOutboxEventMock.invocationsDone()
 }
 
-// MinimockCreateUserInspect logs each unmet expectation
-func (m *AuthRepositoryMock) MinimockCreateUserInspect() {
-	for _, e := range m.CreateUserMock.expectations {
+// MinimockCreateOutboxEventInspect logs each unmet expectation
+func (m *AuthRepositoryMock) MinimockCreateOutboxEventInspect() {
+	for _, e := range m.CreateOutboxEventMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to AuthRepositoryMock.CreateUser at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to AuthRepositoryMock.CreateOutboxEvent at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterCreateUserCounter := mm_atomic.LoadUint64(&m.afterCreateUserCounter)
+	afterCreateOutboxEventCounter := mm_atomic.LoadUint64(&m.afterCreateOutboxEventCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.CreateUserMock.defaultExpectation != nil && afterCreateUserCounter < 1 {
-		if m.CreateUserMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to AuthRepositoryMock.CreateUser at\n%s", m.CreateUserMock.defaultExpectation.returnOrigin)
+	if m.CreateOutboxEventMock.defaultExpectation != nil && afterCreateOutboxEventCounter < 1 {
+		if m.CreateOutboxEventMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuthRepositoryMock.CreateOutboxEvent at\n%s", m.CreateOutboxEventMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to AuthRepositoryMock.CreateUser at\n%s with params: %#v", m.CreateUserMock.defaultExpectation.expectationOrigins.origin, *m.CreateUserMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to AuthRepositoryMock.CreateOutboxEvent at\n%s with params: %#v", m.CreateOutboxEventMock.defaultExpectation.expectationOrigins.origin, *m.CreateOutboxEventMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcCreateUser != nil && afterCreateUserCounter < 1 {
-		m.t.Errorf("Expected call to AuthRepositoryMock.CreateUser at\n%s", m.funcCreateUserOrigin)
+	if m.funcCreateOutboxEvent != nil && afterCreateOutboxEventCounter < 1 {
+		m.t.Errorf("Expected call to AuthRepositoryMock.CreateOutboxEvent at\n%s", m.funcCreateOutboxEventOrigin)
 	}
 
-	if !m.CreateUserMock.invocationsDone() && afterCreateUserCounter > 0 {
-		m.t.Errorf("Expected %d calls to AuthRepositoryMock.CreateUser at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.CreateUserMock.expectedInvocations), m.CreateUserMock.expectedInvocationsOrigin, afterCreateUserCounter)
+	if !m.CreateOutboxEventMock.invocationsDone() && afterCreateOutboxEventCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuthRepositoryMock.CreateOutboxEvent at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateOutboxEventMock.expectedInvocations), m.CreateOutboxEventMock.expectedInvocationsOrigin, afterCreateOutboxEventCounter)
 	}
 }
 
-type mAuthRepositoryMockFindByEmail struct {
+type mAuthRepositoryMockCreateSession struct {
 	optional           bool
 	mock               *AuthRepositoryMock
-	defaultExpectation *AuthRepositoryMockFindByEmailExpectation
-	expectations       []*AuthRepositoryMockFindByEmailExpectation
+	defaultExpectation *AuthRepositoryMockCreateSessionExpectation
+	expectations       []*AuthRepositoryMockCreateSessionExpectation
 
-	callArgs []*AuthRepositoryMockFindByEmailParams
+	callArgs []*AuthRepositoryMockCreateSessionParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// AuthRepositoryMockFindByEmailExpectation specifies expectation struct of the AuthRepository.FindByEmail
-type AuthRepositoryMockFindByEmailExpectation struct {
+// AuthRepositoryMockCreateSessionExpectation specifies expectation struct of the AuthRepository.CreateSession
+type AuthRepositoryMockCreateSessionExpectation struct {
 	mock               *AuthRepositoryMock
-	params             *AuthRepositoryMockFindByEmailParams
-	paramPtrs          *AuthRepositoryMockFindByEmailParamPtrs
-	expectationOrigins AuthRepositoryMockFindByEmailExpectationOrigins
-	results            *AuthRepositoryMockFindByEmailResults
+	params             *AuthRepositoryMockCreateSessionParams
+	paramPtrs          *AuthRepositoryMockCreateSessionParamPtrs
+	expectationOrigins AuthRepositoryMockCreateSessionExpectationOrigins
+	results            *AuthRepositoryMockCreateSessionResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// AuthRepositoryMockFindByEmailParams contains parameters of the AuthRepository.FindByEmail
-type AuthRepositoryMockFindByEmailParams struct {
-	ctx   context.Context
-	email string
+// AuthRepositoryMockCreateSessionParams contains parameters of the AuthRepository.CreateSession
+type AuthRepositoryMockCreateSessionParams struct {
+	ctx     context.Context
+	session *models.Session
 }
 
-// AuthRepositoryMockFindByEmailParamPtrs contains pointers to parameters of the AuthRepository.FindByEmail
-type AuthRepositoryMockFindByEmailParamPtrs struct {
-	ctx   *context.Context
-	email *string
+// AuthRepositoryMockCreateSessionParamPtrs contains pointers to parameters of the AuthRepository.CreateSession
+type AuthRepositoryMockCreateSessionParamPtrs struct {
+	ctx     *context.Context
+	session **models.Session
 }
 
-// AuthRepositoryMockFindByEmailResults contains results of the AuthRepository.FindByEmail
-type AuthRepositoryMockFindByEmailResults struct {
-	up1 *models.User
+// AuthRepositoryMockCreateSessionResults contains results of the AuthRepository.CreateSession
+type AuthRepositoryMockCreateSessionResults struct {
 	err error
 }
 
-// AuthRepositoryMockFindByEmailOrigins contains origins of expectations of the AuthRepository.FindByEmail
-type AuthRepositoryMockFindByEmailExpectationOrigins struct {
-	origin      string
-	originCtx   string
-	originEmail string
+// AuthRepositoryMockCreateSessionOrigins contains origins of expectations of the AuthRepository.CreateSession
+type AuthRepositoryMockCreateSessionExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originSession string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -450,292 +519,2722 @@ type AuthRepositoryMockFindByEmailExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmFindByEmail *mAuthRepositoryMockFindByEmail) Optional() *mAuthRepositoryMockFindByEmail {
-	mmFindByEmail.optional = true
-	return mmFindByEmail
+func (mmCreateSession *mAuthRepositoryMockCreateSession) Optional() *mAuthRepositoryMockCreateSession {
+	mmCreateSession.optional = true
+	return mmCreateSession
 }
 
-// Expect sets up expected params for AuthRepository.FindByEmail
-func (mmFindByEmail *mAuthRepositoryMockFindByEmail) Expect(ctx context.Context, email string) *mAuthRepositoryMockFindByEmail {
-	if mmFindByEmail.mock.funcFindByEmail != nil {
-		mmFindByEmail.mock.t.Fatalf("AuthRepositoryMock.FindByEmail mock is already set by Set")
+// Expect sets up expected params for AuthRepository.CreateSession
+func (mmCreateSession *mAuthRepositoryMockCreateSession) Expect(ctx context.Context, session *models.Session) *mAuthRepositoryMockCreateSession {
+	if mmCreateSession.mock.funcCreateSession != nil {
+		mmCreateSession.mock.t.Fatalf("AuthRepositoryMock.CreateSession mock is already set by Set")
 	}
 
-	if mmFindByEmail.defaultExpectation == nil {
-		mmFindByEmail.defaultExpectation = &AuthRepositoryMockFindByEmailExpectation{}
+	if mmCreateSession.defaultExpectation == nil {
+		mmCreateSession.defaultExpectation = &AuthRepositoryMockCreateSessionExpectation{}
 	}
 
-	if mmFindByEmail.defaultExpectation.paramPtrs != nil {
-		mmFindByEmail.mock.t.Fatalf("AuthRepositoryMock.FindByEmail mock is already set by ExpectParams functions")
+	if mmCreateSession.defaultExpectation.paramPtrs != nil {
+		mmCreateSession.mock.t.Fatalf("AuthRepositoryMock.CreateSession mock is already set by ExpectParams functions")
 	}
 
-	mmFindByEmail.defaultExpectation.params = &AuthRepositoryMockFindByEmailParams{ctx, email}
-	mmFindByEmail.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmFindByEmail.expectations {
-		if minimock.Equal(e.params, mmFindByEmail.defaultExpectation.params) {
-			mmFindByEmail.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmFindByEmail.defaultExpectation.params)
+	mmCreateSession.defaultExpectation.params = &AuthRepositoryMockCreateSessionParams{ctx, session}
+	mmCreateSession.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreateSession.expectations {
+		if minimock.Equal(e.params, mmCreateSession.defaultExpectation.params) {
+			mmCreateSession.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateSession.defaultExpectation.params)
 		}
 	}
 
-	return mmFindByEmail
+	return mmCreateSession
 }
 
-// ExpectCtxParam1 sets up expected param ctx for AuthRepository.FindByEmail
-func (mmFindByEmail *mAuthRepositoryMockFindByEmail) ExpectCtxParam1(ctx context.Context) *mAuthRepositoryMockFindByEmail {
-	if mmFindByEmail.mock.funcFindByEmail != nil {
-		mmFindByEmail.mock.t.Fatalf("AuthRepositoryMock.FindByEmail mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for AuthRepository.CreateSession
+func (mmCreateSession *mAuthRepositoryMockCreateSession) ExpectCtxParam1(ctx context.Context) *mAuthRepositoryMockCreateSession {
+	if mmCreateSession.mock.funcCreateSession != nil {
+		mmCreateSession.mock.t.Fatalf("AuthRepositoryMock.CreateSession mock is already set by Set")
 	}
 
-	if mmFindByEmail.defaultExpectation == nil {
-		mmFindByEmail.defaultExpectation = &AuthRepositoryMockFindByEmailExpectation{}
+	if mmCreateSession.defaultExpectation == nil {
+		mmCreateSession.defaultExpectation = &AuthRepositoryMockCreateSessionExpectation{}
 	}
 
-	if mmFindByEmail.defaultExpectation.params != nil {
-		mmFindByEmail.mock.t.Fatalf("AuthRepositoryMock.FindByEmail mock is already set by Expect")
+	if mmCreateSession.defaultExpectation.params != nil {
+		mmCreateSession.mock.t.Fatalf("AuthRepositoryMock.CreateSession mock is already set by Expect")
 	}
 
-	if mmFindByEmail.defaultExpectation.paramPtrs == nil {
-		mmFindByEmail.defaultExpectation.paramPtrs = &AuthRepositoryMockFindByEmailParamPtrs{}
+	if mmCreateSession.defaultExpectation.paramPtrs == nil {
+		mmCreateSession.defaultExpectation.paramPtrs = &AuthRepositoryMockCreateSessionParamPtrs{}
 	}
-	mmFindByEmail.defaultExpectation.paramPtrs.ctx = &ctx
-	mmFindByEmail.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmCreateSession.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreateSession.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmFindByEmail
+	return mmCreateSession
 }
 
-// ExpectEmailParam2 sets up expected param email for AuthRepository.FindByEmail
-func (mmFindByEmail *mAuthRepositoryMockFindByEmail) ExpectEmailParam2(email string) *mAuthRepositoryMockFindByEmail {
-	if mmFindByEmail.mock.funcFindByEmail != nil {
-		mmFindByEmail.mock.t.Fatalf("AuthRepositoryMock.FindByEmail mock is already set by Set")
+// ExpectSessionParam2 sets up expected param session for AuthRepository.CreateSession
+func (mmCreateSession *mAuthRepositoryMockCreateSession) ExpectSessionParam2(session *models.Session) *mAuthRepositoryMockCreateSession {
+	if mmCreateSession.mock.funcCreateSession != nil {
+		mmCreateSession.mock.t.Fatalf("AuthRepositoryMock.CreateSession mock is already set by Set")
 	}
 
-	if mmFindByEmail.defaultExpectation == nil {
-		mmFindByEmail.defaultExpectation = &AuthRepositoryMockFindByEmailExpectation{}
+	if mmCreateSession.defaultExpectation == nil {
+		mmCreateSession.defaultExpectation = &AuthRepositoryMockCreateSessionExpectation{}
 	}
 
-	if mmFindByEmail.defaultExpectation.params != nil {
-		mmFindByEmail.mock.t.Fatalf("AuthRepositoryMock.FindByEmail mock is already set by Expect")
+	if mmCreateSession.defaultExpectation.params != nil {
+		mmCreateSession.mock.t.Fatalf("AuthRepositoryMock.CreateSession mock is already set by Expect")
 	}
 
-	if mmFindByEmail.defaultExpectation.paramPtrs == nil {
+	if mmCreateSession.defaultExpectation.paramPtrs == nil {
+		mmCreateSession.defaultExpectation.paramPtrs = &AuthRepositoryMockCreateSessionParamPtrs{}
+	}
+	mmCreateSession.defaultExpectation.paramPtrs.session = &session
+	mmCreateSession.defaultExpectation.expectationOrigins.originSession = minimock.CallerInfo(1)
+
+	return mmCreateSession
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuthRepository.CreateSession
+func (mmCreateSession *mAuthRepositoryMockCreateSession) Inspect(f func(ctx context.Context, session *models.Session)) *mAuthRepositoryMockCreateSession {
+	if mmCreateSession.mock.inspectFuncCreateSession != nil {
+		mmCreateSession.mock.t.Fatalf("Inspect function is already set for AuthRepositoryMock.CreateSession")
+	}
+
+	mmCreateSession.mock.inspectFuncCreateSession = f
+
+	return mmCreateSession
+}
+
+// Return sets up results that will be returned by AuthRepository.CreateSession
+func (mmCreateSession *mAuthRepositoryMockCreateSession) Return(err error) *AuthRepositoryMock {
+	if mmCreateSession.mock.funcCreateSession != nil {
+		mmCreateSession.mock.t.Fatalf("AuthRepositoryMock.CreateSession mock is already set by Set")
+	}
+
+	if mmCreateSession.defaultExpectation == nil {
+		mmCreateSession.defaultExpectation = &AuthRepositoryMockCreateSessionExpectation{mock: mmCreateSession.mock}
+	}
+	mmCreateSession.defaultExpectation.results = &AuthRepositoryMockCreateSessionResults{err}
+	mmCreateSession.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreateSession.mock
+}
+
+// Set uses given function f to mock the AuthRepository.CreateSession method
+func (mmCreateSession *mAuthRepositoryMockCreateSession) Set(f func(ctx context.Context, session *models.Session) (err error)) *AuthRepositoryMock {
+	if mmCreateSession.defaultExpectation != nil {
+		mmCreateSession.mock.t.Fatalf("Default expectation is already set for the AuthRepository.CreateSession method")
+	}
+
+	if len(mmCreateSession.expectations) > 0 {
+		mmCreateSession.mock.t.Fatalf("Some expectations are already set for the AuthRepository.CreateSession method")
+	}
+
+	mmCreateSession.mock.funcCreateSession = f
+	mmCreateSession.mock.funcCreateSessionOrigin = minimock.CallerInfo(1)
+	return mmCreateSession.mock
+}
+
+// When sets expectation for the AuthRepository.CreateSession which will trigger the result defined by the following
+// Then helper
+func (mmCreateSession *mAuthRepositoryMockCreateSession) When(ctx context.Context, session *models.Session) *AuthRepositoryMockCreateSessionExpectation {
+	if mmCreateSession.mock.funcCreateSession != nil {
+		mmCreateSession.mock.t.Fatalf("AuthRepositoryMock.CreateSession mock is already set by Set")
+	}
+
+	expectation := &AuthRepositoryMockCreateSessionExpectation{
+		mock:               mmCreateSession.mock,
+		params:             &AuthRepositoryMockCreateSessionParams{ctx, session},
+		expectationOrigins: AuthRepositoryMockCreateSessionExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreateSession.expectations = append(mmCreateSession.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuthRepository.CreateSession return parameters for the expectation previously defined by the When method
+func (e *AuthRepositoryMockCreateSessionExpectation) Then(err error) *AuthRepositoryMock {
+	e.results = &AuthRepositoryMockCreateSessionResults{err}
+	return e.mock
+}
+
+// Times sets number of times AuthRepository.CreateSession should be invoked
+func (mmCreateSession *mAuthRepositoryMockCreateSession) Times(n uint64) *mAuthRepositoryMockCreateSession {
+	if n == 0 {
+		mmCreateSession.mock.t.Fatalf("Times of AuthRepositoryMock.CreateSession mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateSession.expectedInvocations, n)
+	mmCreateSession.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreateSession
+}
+
+func (mmCreateSession *mAuthRepositoryMockCreateSession) invocationsDone() bool {
+	if len(mmCreateSession.expectations) == 0 && mmCreateSession.defaultExpectation == nil && mmCreateSession.mock.funcCreateSession == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateSession.mock.afterCreateSessionCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateSession.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateSession implements AuthRepository
+func (mmCreateSession *AuthRepositoryMock) CreateSession(ctx context.Context, session *models.Session) (err error) {
+	mm_atomic.AddUint64(&mmCreateSession.beforeCreateSessionCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateSession.afterCreateSessionCounter, 1)
+
+	mmCreateSession.t.Helper()
+
+	if mmCreateSession.inspectFuncCreateSession != nil {
+		mmCreateSession.inspectFuncCreateSession(ctx, session)
+	}
+
+	mm_params := AuthRepositoryMockCreateSessionParams{ctx, session}
+
+	// Record call args
+	mmCreateSession.CreateSessionMock.mutex.Lock()
+	mmCreateSession.CreateSessionMock.callArgs = append(mmCreateSession.CreateSessionMock.callArgs, &mm_params)
+	mmCreateSession.CreateSessionMock.mutex.Unlock()
+
+	for _, e := range mmCreateSession.CreateSessionMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCreateSession.CreateSessionMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateSession.CreateSessionMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateSession.CreateSessionMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateSession.CreateSessionMock.defaultExpectation.paramPtrs
+
+		mm_got := AuthRepositoryMockCreateSessionParams{ctx, session}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateSession.t.Errorf("AuthRepositoryMock.CreateSession got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateSession.CreateSessionMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.session != nil && !minimock.Equal(*mm_want_ptrs.session, mm_got.session) {
+				mmCreateSession.t.Errorf("AuthRepositoryMock.CreateSession got unexpected parameter session, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateSession.CreateSessionMock.defaultExpectation.expectationOrigins.originSession, *mm_want_ptrs.session, mm_got.session, minimock.Diff(*mm_want_ptrs.session, mm_got.session))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateSession.t.Errorf("AuthRepositoryMock.CreateSession got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreateSession.CreateSessionMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateSession.CreateSessionMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateSession.t.Fatal("No results are set for the AuthRepositoryMock.CreateSession")
+		}
+		return (*mm_results).err
+	}
+	if mmCreateSession.funcCreateSession != nil {
+		return mmCreateSession.funcCreateSession(ctx, session)
+	}
+	mmCreateSession.t.Fatalf("Unexpected call to AuthRepositoryMock.CreateSession. %v %v", ctx, session)
+	return
+}
+
+// CreateSessionAfterCounter returns a count of finished AuthRepositoryMock.CreateSession invocations
+func (mmCreateSession *AuthRepositoryMock) CreateSessionAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateSession.afterCreateSessionCounter)
+}
+
+// CreateSessionBeforeCounter returns a count of AuthRepositoryMock.CreateSession invocations
+func (mmCreateSession *AuthRepositoryMock) CreateSessionBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateSession.beforeCreateSessionCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuthRepositoryMock.CreateSession.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateSession *mAuthRepositoryMockCreateSession) Calls() []*AuthRepositoryMockCreateSessionParams {
+	mmCreateSession.mutex.RLock()
+
+	argCopy := make([]*AuthRepositoryMockCreateSessionParams, len(mmCreateSession.callArgs))
+	copy(argCopy, mmCreateSession.callArgs)
+
+	mmCreateSession.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateSessionDone returns true if the count of the CreateSession invocations corresponds
+// the number of defined expectations
+func (m *AuthRepositoryMock) MinimockCreateSessionDone() bool {
+	if m.CreateSessionMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateSessionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateSessionMock.invocationsDone()
+}
+
+// MinimockCreateSessionInspect logs each unmet expectation
+func (m *AuthRepositoryMock) MinimockCreateSessionInspect() {
+	for _, e := range m.CreateSessionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuthRepositoryMock.CreateSession at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateSessionCounter := mm_atomic.LoadUint64(&m.afterCreateSessionCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateSessionMock.defaultExpectation != nil && afterCreateSessionCounter < 1 {
+		if m.CreateSessionMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuthRepositoryMock.CreateSession at\n%s", m.CreateSessionMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AuthRepositoryMock.CreateSession at\n%s with params: %#v", m.CreateSessionMock.defaultExpectation.expectationOrigins.origin, *m.CreateSessionMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateSession != nil && afterCreateSessionCounter < 1 {
+		m.t.Errorf("Expected call to AuthRepositoryMock.CreateSession at\n%s", m.funcCreateSessionOrigin)
+	}
+
+	if !m.CreateSessionMock.invocationsDone() && afterCreateSessionCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuthRepositoryMock.CreateSession at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateSessionMock.expectedInvocations), m.CreateSessionMock.expectedInvocationsOrigin, afterCreateSessionCounter)
+	}
+}
+
+type mAuthRepositoryMockCreateUser struct {
+	optional           bool
+	mock               *AuthRepositoryMock
+	defaultExpectation *AuthRepositoryMockCreateUserExpectation
+	expectations       []*AuthRepositoryMockCreateUserExpectation
+
+	callArgs []*AuthRepositoryMockCreateUserParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AuthRepositoryMockCreateUserExpectation specifies expectation struct of the AuthRepository.CreateUser
+type AuthRepositoryMockCreateUserExpectation struct {
+	mock               *AuthRepositoryMock
+	params             *AuthRepositoryMockCreateUserParams
+	paramPtrs          *AuthRepositoryMockCreateUserParamPtrs
+	expectationOrigins AuthRepositoryMockCreateUserExpectationOrigins
+	results            *AuthRepositoryMockCreateUserResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AuthRepositoryMockCreateUserParams contains parameters of the AuthRepository.CreateUser
+type AuthRepositoryMockCreateUserParams struct {
+	ctx  context.Context
+	user *models.User
+}
+
+// AuthRepositoryMockCreateUserParamPtrs contains pointers to parameters of the AuthRepository.CreateUser
+type AuthRepositoryMockCreateUserParamPtrs struct {
+	ctx  *context.Context
+	user **models.User
+}
+
+// AuthRepositoryMockCreateUserResults contains results of the AuthRepository.CreateUser
+type AuthRepositoryMockCreateUserResults struct {
+	up1 *models.User
+	err error
+}
+
+// AuthRepositoryMockCreateUserOrigins contains origins of expectations of the AuthRepository.CreateUser
+type AuthRepositoryMockCreateUserExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originUser string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateUser *mAuthRepositoryMockCreateUser) Optional() *mAuthRepositoryMockCreateUser {
+	mmCreateUser.optional = true
+	return mmCreateUser
+}
+
+// Expect sets up expected params for AuthRepository.CreateUser
+func (mmCreateUser *mAuthRepositoryMockCreateUser) Expect(ctx context.Context, user *models.User) *mAuthRepositoryMockCreateUser {
+	if mmCreateUser.mock.funcCreateUser != nil {
+		mmCreateUser.mock.t.Fatalf("AuthRepositoryMock.CreateUser mock is already set by Set")
+	}
+
+	if mmCreateUser.defaultExpectation == nil {
+		mmCreateUser.defaultExpectation = &AuthRepositoryMockCreateUserExpectation{}
+	}
+
+	if mmCreateUser.defaultExpectation.paramPtrs != nil {
+		mmCreateUser.mock.t.Fatalf("AuthRepositoryMock.CreateUser mock is already set by ExpectParams functions")
+	}
+
+	mmCreateUser.defaultExpectation.params = &AuthRepositoryMockCreateUserParams{ctx, user}
+	mmCreateUser.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreateUser.expectations {
+		if minimock.Equal(e.params, mmCreateUser.defaultExpectation.params) {
+			mmCreateUser.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateUser.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateUser
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuthRepository.CreateUser
+func (mmCreateUser *mAuthRepositoryMockCreateUser) ExpectCtxParam1(ctx context.Context) *mAuthRepositoryMockCreateUser {
+	if mmCreateUser.mock.funcCreateUser != nil {
+		mmCreateUser.mock.t.Fatalf("AuthRepositoryMock.CreateUser mock is already set by Set")
+	}
+
+	if mmCreateUser.defaultExpectation == nil {
+		mmCreateUser.defaultExpectation = &AuthRepositoryMockCreateUserExpectation{}
+	}
+
+	if mmCreateUser.defaultExpectation.params != nil {
+		mmCreateUser.mock.t.Fatalf("AuthRepositoryMock.CreateUser mock is already set by Expect")
+	}
+
+	if mmCreateUser.defaultExpectation.paramPtrs == nil {
+		mmCreateUser.defaultExpectation.paramPtrs = &AuthRepositoryMockCreateUserParamPtrs{}
+	}
+	mmCreateUser.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreateUser.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreateUser
+}
+
+// ExpectUserParam2 sets up expected param user for AuthRepository.CreateUser
+func (mmCreateUser *mAuthRepositoryMockCreateUser) ExpectUserParam2(user *models.User) *mAuthRepositoryMockCreateUser {
+	if mmCreateUser.mock.funcCreateUser != nil {
+		mmCreateUser.mock.t.Fatalf("AuthRepositoryMock.CreateUser mock is already set by Set")
+	}
+
+	if mmCreateUser.defaultExpectation == nil {
+		mmCreateUser.defaultExpectation = &AuthRepositoryMockCreateUserExpectation{}
+	}
+
+	if mmCreateUser.defaultExpectation.params != nil {
+		mmCreateUser.mock.t.Fatalf("AuthRepositoryMock.CreateUser mock is already set by Expect")
+	}
+
+	if mmCreateUser.defaultExpectation.paramPtrs == nil {
+		mmCreateUser.defaultExpectation.paramPtrs = &AuthRepositoryMockCreateUserParamPtrs{}
+	}
+	mmCreateUser.defaultExpectation.paramPtrs.user = &user
+	mmCreateUser.defaultExpectation.expectationOrigins.originUser = minimock.CallerInfo(1)
+
+	return mmCreateUser
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuthRepository.CreateUser
+func (mmCreateUser *mAuthRepositoryMockCreateUser) Inspect(f func(ctx context.Context, user *models.User)) *mAuthRepositoryMockCreateUser {
+	if mmCreateUser.mock.inspectFuncCreateUser != nil {
+		mmCreateUser.mock.t.Fatalf("Inspect function is already set for AuthRepositoryMock.CreateUser")
+	}
+
+	mmCreateUser.mock.inspectFuncCreateUser = f
+
+	return mmCreateUser
+}
+
+// Return sets up results that will be returned by AuthRepository.CreateUser
+func (mmCreateUser *mAuthRepositoryMockCreateUser) Return(up1 *models.User, err error) *AuthRepositoryMock {
+	if mmCreateUser.mock.funcCreateUser != nil {
+		mmCreateUser.mock.t.Fatalf("AuthRepositoryMock.CreateUser mock is already set by Set")
+	}
+
+	if mmCreateUser.defaultExpectation == nil {
+		mmCreateUser.defaultExpectation = &AuthRepositoryMockCreateUserExpectation{mock: mmCreateUser.mock}
+	}
+	mmCreateUser.defaultExpectation.results = &AuthRepositoryMockCreateUserResults{up1, err}
+	mmCreateUser.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreateUser.mock
+}
+
+// Set uses given function f to mock the AuthRepository.CreateUser method
+func (mmCreateUser *mAuthRepositoryMockCreateUser) Set(f func(ctx context.Context, user *models.User) (up1 *models.User, err error)) *AuthRepositoryMock {
+	if mmCreateUser.defaultExpectation != nil {
+		mmCreateUser.mock.t.Fatalf("Default expectation is already set for the AuthRepository.CreateUser method")
+	}
+
+	if len(mmCreateUser.expectations) > 0 {
+		mmCreateUser.mock.t.Fatalf("Some expectations are already set for the AuthRepository.CreateUser method")
+	}
+
+	mmCreateUser.mock.funcCreateUser = f
+	mmCreateUser.mock.funcCreateUserOrigin = minimock.CallerInfo(1)
+	return mmCreateUser.mock
+}
+
+// When sets expectation for the AuthRepository.CreateUser which will trigger the result defined by the following
+// Then helper
+func (mmCreateUser *mAuthRepositoryMockCreateUser) When(ctx context.Context, user *models.User) *AuthRepositoryMockCreateUserExpectation {
+	if mmCreateUser.mock.funcCreateUser != nil {
+		mmCreateUser.mock.t.Fatalf("AuthRepositoryMock.CreateUser mock is already set by Set")
+	}
+
+	expectation := &AuthRepositoryMockCreateUserExpectation{
+		mock:               mmCreateUser.mock,
+		params:             &AuthRepositoryMockCreateUserParams{ctx, user},
+		expectationOrigins: AuthRepositoryMockCreateUserExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreateUser.expectations = append(mmCreateUser.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuthRepository.CreateUser return parameters for the expectation previously defined by the When method
+func (e *AuthRepositoryMockCreateUserExpectation) Then(up1 *models.User, err error) *AuthRepositoryMock {
+	e.results = &AuthRepositoryMockCreateUserResults{up1, err}
+	return e.mock
+}
+
+// Times sets number of times AuthRepository.CreateUser should be invoked
+func (mmCreateUser *mAuthRepositoryMockCreateUser) Times(n uint64) *mAuthRepositoryMockCreateUser {
+	if n == 0 {
+		mmCreateUser.mock.t.Fatalf("Times of AuthRepositoryMock.CreateUser mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateUser.expectedInvocations, n)
+	mmCreateUser.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreateUser
+}
+
+func (mmCreateUser *mAuthRepositoryMockCreateUser) invocationsDone() bool {
+	if len(mmCreateUser.expectations) == 0 && mmCreateUser.defaultExpectation == nil && mmCreateUser.mock.funcCreateUser == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateUser.mock.afterCreateUserCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateUser.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateUser implements AuthRepository
+func (mmCreateUser *AuthRepositoryMock) CreateUser(ctx context.Context, user *models.User) (up1 *models.User, err error) {
+	mm_atomic.AddUint64(&mmCreateUser.beforeCreateUserCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateUser.afterCreateUserCounter, 1)
+
+	mmCreateUser.t.Helper()
+
+	if mmCreateUser.inspectFuncCreateUser != nil {
+		mmCreateUser.inspectFuncCreateUser(ctx, user)
+	}
+
+	mm_params := AuthRepositoryMockCreateUserParams{ctx, user}
+
+	// Record call args
+	mmCreateUser.CreateUserMock.mutex.Lock()
+	mmCreateUser.CreateUserMock.callArgs = append(mmCreateUser.CreateUserMock.callArgs, &mm_params)
+	mmCreateUser.CreateUserMock.mutex.Unlock()
+
+	for _, e := range mmCreateUser.CreateUserMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.up1, e.results.err
+		}
+	}
+
+	if mmCreateUser.CreateUserMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateUser.CreateUserMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateUser.CreateUserMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateUser.CreateUserMock.defaultExpectation.paramPtrs
+
+		mm_got := AuthRepositoryMockCreateUserParams{ctx, user}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateUser.t.Errorf("AuthRepositoryMock.CreateUser got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateUser.CreateUserMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.user != nil && !minimock.Equal(*mm_want_ptrs.user, mm_got.user) {
+				mmCreateUser.t.Errorf("AuthRepositoryMock.CreateUser got unexpected parameter user, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateUser.CreateUserMock.defaultExpectation.expectationOrigins.originUser, *mm_want_ptrs.user, mm_got.user, minimock.Diff(*mm_want_ptrs.user, mm_got.user))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateUser.t.Errorf("AuthRepositoryMock.CreateUser got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreateUser.CreateUserMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateUser.CreateUserMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateUser.t.Fatal("No results are set for the AuthRepositoryMock.CreateUser")
+		}
+		return (*mm_results).up1, (*mm_results).err
+	}
+	if mmCreateUser.funcCreateUser != nil {
+		return mmCreateUser.funcCreateUser(ctx, user)
+	}
+	mmCreateUser.t.Fatalf("Unexpected call to AuthRepositoryMock.CreateUser. %v %v", ctx, user)
+	return
+}
+
+// CreateUserAfterCounter returns a count of finished AuthRepositoryMock.CreateUser invocations
+func (mmCreateUser *AuthRepositoryMock) CreateUserAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateUser.afterCreateUserCounter)
+}
+
+// CreateUserBeforeCounter returns a count of AuthRepositoryMock.CreateUser invocations
+func (mmCreateUser *AuthRepositoryMock) CreateUserBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateUser.beforeCreateUserCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuthRepositoryMock.CreateUser.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateUser *mAuthRepositoryMockCreateUser) Calls() []*AuthRepositoryMockCreateUserParams {
+	mmCreateUser.mutex.RLock()
+
+	argCopy := make([]*AuthRepositoryMockCreateUserParams, len(mmCreateUser.callArgs))
+	copy(argCopy, mmCreateUser.callArgs)
+
+	mmCreateUser.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateUserDone returns true if the count of the CreateUser invocations corresponds
+// the number of defined expectations
+func (m *AuthRepositoryMock) MinimockCreateUserDone() bool {
+	if m.CreateUserMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateUserMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateUserMock.invocationsDone()
+}
+
+// MinimockCreateUserInspect logs each unmet expectation
+func (m *AuthRepositoryMock) MinimockCreateUserInspect() {
+	for _, e := range m.CreateUserMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuthRepositoryMock.CreateUser at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateUserCounter := mm_atomic.LoadUint64(&m.afterCreateUserCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateUserMock.defaultExpectation != nil && afterCreateUserCounter < 1 {
+		if m.CreateUserMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuthRepositoryMock.CreateUser at\n%s", m.CreateUserMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AuthRepositoryMock.CreateUser at\n%s with params: %#v", m.CreateUserMock.defaultExpectation.expectationOrigins.origin, *m.CreateUserMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateUser != nil && afterCreateUserCounter < 1 {
+		m.t.Errorf("Expected call to AuthRepositoryMock.CreateUser at\n%s", m.funcCreateUserOrigin)
+	}
+
+	if !m.CreateUserMock.invocationsDone() && afterCreateUserCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuthRepositoryMock.CreateUser at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateUserMock.expectedInvocations), m.CreateUserMock.expectedInvocationsOrigin, afterCreateUserCounter)
+	}
+}
+
+type mAuthRepositoryMockFindByEmail struct {
+	optional           bool
+	mock               *AuthRepositoryMock
+	defaultExpectation *AuthRepositoryMockFindByEmailExpectation
+	expectations       []*AuthRepositoryMockFindByEmailExpectation
+
+	callArgs []*AuthRepositoryMockFindByEmailParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AuthRepositoryMockFindByEmailExpectation specifies expectation struct of the AuthRepository.FindByEmail
+type AuthRepositoryMockFindByEmailExpectation struct {
+	mock               *AuthRepositoryMock
+	params             *AuthRepositoryMockFindByEmailParams
+	paramPtrs          *AuthRepositoryMockFindByEmailParamPtrs
+	expectationOrigins AuthRepositoryMockFindByEmailExpectationOrigins
+	results            *AuthRepositoryMockFindByEmailResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AuthRepositoryMockFindByEmailParams contains parameters of the AuthRepository.FindByEmail
+type AuthRepositoryMockFindByEmailParams struct {
+	ctx   context.Context
+	email string
+}
+
+// AuthRepositoryMockFindByEmailParamPtrs contains pointers to parameters of the AuthRepository.FindByEmail
+type AuthRepositoryMockFindByEmailParamPtrs struct {
+	ctx   *context.Context
+	email *string
+}
+
+// AuthRepositoryMockFindByEmailResults contains results of the AuthRepository.FindByEmail
+type AuthRepositoryMockFindByEmailResults struct {
+	up1 *models.User
+	err error
+}
+
+// AuthRepositoryMockFindByEmailOrigins contains origins of expectations of the AuthRepository.FindByEmail
+type AuthRepositoryMockFindByEmailExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originEmail string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmFindByEmail *mAuthRepositoryMockFindByEmail) Optional() *mAuthRepositoryMockFindByEmail {
+	mmFindByEmail.optional = true
+	return mmFindByEmail
+}
+
+// Expect sets up expected params for AuthRepository.FindByEmail
+func (mmFindByEmail *mAuthRepositoryMockFindByEmail) Expect(ctx context.Context, email string) *mAuthRepositoryMockFindByEmail {
+	if mmFindByEmail.mock.funcFindByEmail != nil {
+		mmFindByEmail.mock.t.Fatalf("AuthRepositoryMock.FindByEmail mock is already set by Set")
+	}
+
+	if mmFindByEmail.defaultExpectation == nil {
+		mmFindByEmail.defaultExpectation = &AuthRepositoryMockFindByEmailExpectation{}
+	}
+
+	if mmFindByEmail.defaultExpectation.paramPtrs != nil {
+		mmFindByEmail.mock.t.Fatalf("AuthRepositoryMock.FindByEmail mock is already set by ExpectParams functions")
+	}
+
+	mmFindByEmail.defaultExpectation.params = &AuthRepositoryMockFindByEmailParams{ctx, email}
+	mmFindByEmail.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmFindByEmail.expectations {
+		if minimock.Equal(e.params, mmFindByEmail.defaultExpectation.params) {
+			mmFindByEmail.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmFindByEmail.defaultExpectation.params)
+		}
+	}
+
+	return mmFindByEmail
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuthRepository.FindByEmail
+func (mmFindByEmail *mAuthRepositoryMockFindByEmail) ExpectCtxParam1(ctx context.Context) *mAuthRepositoryMockFindByEmail {
+	if mmFindByEmail.mock.funcFindByEmail != nil {
+		mmFindByEmail.mock.t.Fatalf("AuthRepositoryMock.FindByEmail mock is already set by Set")
+	}
+
+	if mmFindByEmail.defaultExpectation == nil {
+		mmFindByEmail.defaultExpectation = &AuthRepositoryMockFindByEmailExpectation{}
+	}
+
+	if mmFindByEmail.defaultExpectation.params != nil {
+		mmFindByEmail.mock.t.Fatalf("AuthRepositoryMock.FindByEmail mock is already set by Expect")
+	}
+
+	if mmFindByEmail.defaultExpectation.paramPtrs == nil {
+		mmFindByEmail.defaultExpectation.paramPtrs = &AuthRepositoryMockFindByEmailParamPtrs{}
+	}
+	mmFindByEmail.defaultExpectation.paramPtrs.ctx = &ctx
+	mmFindByEmail.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmFindByEmail
+}
+
+// ExpectEmailParam2 sets up expected param email for AuthRepository.FindByEmail
+func (mmFindByEmail *mAuthRepositoryMockFindByEmail) ExpectEmailParam2(email string) *mAuthRepositoryMockFindByEmail {
+	if mmFindByEmail.mock.funcFindByEmail != nil {
+		mmFindByEmail.mock.t.Fatalf("AuthRepositoryMock.FindByEmail mock is already set by Set")
+	}
+
+	if mmFindByEmail.defaultExpectation == nil {
+		mmFindByEmail.defaultExpectation = &AuthRepositoryMockFindByEmailExpectation{}
+	}
+
+	if mmFindByEmail.defaultExpectation.params != nil {
+		mmFindByEmail.mock.t.Fatalf("AuthRepositoryMock.FindByEmail mock is already set by Expect")
+	}
+
+	if mmFindByEmail.defaultExpectation.paramPtrs == nil {
 		mmFindByEmail.defaultExpectation.paramPtrs = &AuthRepositoryMockFindByEmailParamPtrs{}
 	}
-	mmFindByEmail.defaultExpectation.paramPtrs.email = &email
-	mmFindByEmail.defaultExpectation.expectationOrigins.originEmail = minimock.CallerInfo(1)
+	mmFindByEmail.defaultExpectation.paramPtrs.email = &email
+	mmFindByEmail.defaultExpectation.expectationOrigins.originEmail = minimock.CallerInfo(1)
+
+	return mmFindByEmail
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuthRepository.FindByEmail
+func (mmFindByEmail *mAuthRepositoryMockFindByEmail) Inspect(f func(ctx context.Context, email string)) *mAuthRepositoryMockFindByEmail {
+	if mmFindByEmail.mock.inspectFuncFindByEmail != nil {
+		mmFindByEmail.mock.t.Fatalf("Inspect function is already set for AuthRepositoryMock.FindByEmail")
+	}
+
+	mmFindByEmail.mock.inspectFuncFindByEmail = f
+
+	return mmFindByEmail
+}
+
+// Return sets up results that will be returned by AuthRepository.FindByEmail
+func (mmFindByEmail *mAuthRepositoryMockFindByEmail) Return(up1 *models.User, err error) *AuthRepositoryMock {
+	if mmFindByEmail.mock.funcFindByEmail != nil {
+		mmFindByEmail.mock.t.Fatalf("AuthRepositoryMock.FindByEmail mock is already set by Set")
+	}
+
+	if mmFindByEmail.defaultExpectation == nil {
+		mmFindByEmail.defaultExpectation = &AuthRepositoryMockFindByEmailExpectation{mock: mmFindByEmail.mock}
+	}
+	mmFindByEmail.defaultExpectation.results = &AuthRepositoryMockFindByEmailResults{up1, err}
+	mmFindByEmail.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmFindByEmail.mock
+}
+
+// Set uses given function f to mock the AuthRepository.FindByEmail method
+func (mmFindByEmail *mAuthRepositoryMockFindByEmail) Set(f func(ctx context.Context, email string) (up1 *models.User, err error)) *AuthRepositoryMock {
+	if mmFindByEmail.defaultExpectation != nil {
+		mmFindByEmail.mock.t.Fatalf("Default expectation is already set for the AuthRepository.FindByEmail method")
+	}
+
+	if len(mmFindByEmail.expectations) > 0 {
+		mmFindByEmail.mock.t.Fatalf("Some expectations are already set for the AuthRepository.FindByEmail method")
+	}
+
+	mmFindByEmail.mock.funcFindByEmail = f
+	mmFindByEmail.mock.funcFindByEmailOrigin = minimock.CallerInfo(1)
+	return mmFindByEmail.mock
+}
+
+// When sets expectation for the AuthRepository.FindByEmail which will trigger the result defined by the following
+// Then helper
+func (mmFindByEmail *mAuthRepositoryMockFindByEmail) When(ctx context.Context, email string) *AuthRepositoryMockFindByEmailExpectation {
+	if mmFindByEmail.mock.funcFindByEmail != nil {
+		mmFindByEmail.mock.t.Fatalf("AuthRepositoryMock.FindByEmail mock is already set by Set")
+	}
+
+	expectation := &AuthRepositoryMockFindByEmailExpectation{
+		mock:               mmFindByEmail.mock,
+		params:             &AuthRepositoryMockFindByEmailParams{ctx, email},
+		expectationOrigins: AuthRepositoryMockFindByEmailExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmFindByEmail.expectations = append(mmFindByEmail.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuthRepository.FindByEmail return parameters for the expectation previously defined by the When method
+func (e *AuthRepositoryMockFindByEmailExpectation) Then(up1 *models.User, err error) *AuthRepositoryMock {
+	e.results = &AuthRepositoryMockFindByEmailResults{up1, err}
+	return e.mock
+}
+
+// Times sets number of times AuthRepository.FindByEmail should be invoked
+func (mmFindByEmail *mAuthRepositoryMockFindByEmail) Times(n uint64) *mAuthRepositoryMockFindByEmail {
+	if n == 0 {
+		mmFindByEmail.mock.t.Fatalf("Times of AuthRepositoryMock.FindByEmail mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmFindByEmail.expectedInvocations, n)
+	mmFindByEmail.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmFindByEmail
+}
+
+func (mmFindByEmail *mAuthRepositoryMockFindByEmail) invocationsDone() bool {
+	if len(mmFindByEmail.expectations) == 0 && mmFindByEmail.defaultExpectation == nil && mmFindByEmail.mock.funcFindByEmail == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmFindByEmail.mock.afterFindByEmailCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmFindByEmail.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// FindByEmail implements AuthRepository
+func (mmFindByEmail *AuthRepositoryMock) FindByEmail(ctx context.Context, email string) (up1 *models.User, err error) {
+	mm_atomic.AddUint64(&mmFindByEmail.beforeFindByEmailCounter, 1)
+	defer mm_atomic.AddUint64(&mmFindByEmail.afterFindByEmailCounter, 1)
+
+	mmFindByEmail.t.Helper()
+
+	if mmFindByEmail.inspectFuncFindByEmail != nil {
+		mmFindByEmail.inspectFuncFindByEmail(ctx, email)
+	}
+
+	mm_params := AuthRepositoryMockFindByEmailParams{ctx, email}
+
+	// Record call args
+	mmFindByEmail.FindByEmailMock.mutex.Lock()
+	mmFindByEmail.FindByEmailMock.callArgs = append(mmFindByEmail.FindByEmailMock.callArgs, &mm_params)
+	mmFindByEmail.FindByEmailMock.mutex.Unlock()
+
+	for _, e := range mmFindByEmail.FindByEmailMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.up1, e.results.err
+		}
+	}
+
+	if mmFindByEmail.FindByEmailMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmFindByEmail.FindByEmailMock.defaultExpectation.Counter, 1)
+		mm_want := mmFindByEmail.FindByEmailMock.defaultExpectation.params
+		mm_want_ptrs := mmFindByEmail.FindByEmailMock.defaultExpectation.paramPtrs
+
+		mm_got := AuthRepositoryMockFindByEmailParams{ctx, email}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmFindByEmail.t.Errorf("AuthRepositoryMock.FindByEmail got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindByEmail.FindByEmailMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.email != nil && !minimock.Equal(*mm_want_ptrs.email, mm_got.email) {
+				mmFindByEmail.t.Errorf("AuthRepositoryMock.FindByEmail got unexpected parameter email, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindByEmail.FindByEmailMock.defaultExpectation.expectationOrigins.originEmail, *mm_want_ptrs.email, mm_got.email, minimock.Diff(*mm_want_ptrs.email, mm_got.email))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmFindByEmail.t.Errorf("AuthRepositoryMock.FindByEmail got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmFindByEmail.FindByEmailMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmFindByEmail.FindByEmailMock.defaultExpectation.results
+		if mm_results == nil {
+			mmFindByEmail.t.Fatal("No results are set for the AuthRepositoryMock.FindByEmail")
+		}
+		return (*mm_results).up1, (*mm_results).err
+	}
+	if mmFindByEmail.funcFindByEmail != nil {
+		return mmFindByEmail.funcFindByEmail(ctx, email)
+	}
+	mmFindByEmail.t.Fatalf("Unexpected call to AuthRepositoryMock.FindByEmail. %v %v", ctx, email)
+	return
+}
+
+// FindByEmailAfterCounter returns a count of finished AuthRepositoryMock.FindByEmail invocations
+func (mmFindByEmail *AuthRepositoryMock) FindByEmailAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFindByEmail.afterFindByEmailCounter)
+}
+
+// FindByEmailBeforeCounter returns a count of AuthRepositoryMock.FindByEmail invocations
+func (mmFindByEmail *AuthRepositoryMock) FindByEmailBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFindByEmail.beforeFindByEmailCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuthRepositoryMock.FindByEmail.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmFindByEmail *mAuthRepositoryMockFindByEmail) Calls() []*AuthRepositoryMockFindByEmailParams {
+	mmFindByEmail.mutex.RLock()
+
+	argCopy := make([]*AuthRepositoryMockFindByEmailParams, len(mmFindByEmail.callArgs))
+	copy(argCopy, mmFindByEmail.callArgs)
+
+	mmFindByEmail.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockFindByEmailDone returns true if the count of the FindByEmail invocations corresponds
+// the number of defined expectations
+func (m *AuthRepositoryMock) MinimockFindByEmailDone() bool {
+	if m.FindByEmailMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.FindByEmailMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.FindByEmailMock.invocationsDone()
+}
+
+// MinimockFindByEmailInspect logs each unmet expectation
+func (m *AuthRepositoryMock) MinimockFindByEmailInspect() {
+	for _, e := range m.FindByEmailMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuthRepositoryMock.FindByEmail at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterFindByEmailCounter := mm_atomic.LoadUint64(&m.afterFindByEmailCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.FindByEmailMock.defaultExpectation != nil && afterFindByEmailCounter < 1 {
+		if m.FindByEmailMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuthRepositoryMock.FindByEmail at\n%s", m.FindByEmailMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AuthRepositoryMock.FindByEmail at\n%s with params: %#v", m.FindByEmailMock.defaultExpectation.expectationOrigins.origin, *m.FindByEmailMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcFindByEmail != nil && afterFindByEmailCounter < 1 {
+		m.t.Errorf("Expected call to AuthRepositoryMock.FindByEmail at\n%s", m.funcFindByEmailOrigin)
+	}
+
+	if !m.FindByEmailMock.invocationsDone() && afterFindByEmailCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuthRepositoryMock.FindByEmail at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.FindByEmailMock.expectedInvocations), m.FindByEmailMock.expectedInvocationsOrigin, afterFindByEmailCounter)
+	}
+}
+
+type mAuthRepositoryMockFindByID struct {
+	optional           bool
+	mock               *AuthRepositoryMock
+	defaultExpectation *AuthRepositoryMockFindByIDExpectation
+	expectations       []*AuthRepositoryMockFindByIDExpectation
+
+	callArgs []*AuthRepositoryMockFindByIDParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AuthRepositoryMockFindByIDExpectation specifies expectation struct of the AuthRepository.FindByID
+type AuthRepositoryMockFindByIDExpectation struct {
+	mock               *AuthRepositoryMock
+	params             *AuthRepositoryMockFindByIDParams
+	paramPtrs          *AuthRepositoryMockFindByIDParamPtrs
+	expectationOrigins AuthRepositoryMockFindByIDExpectationOrigins
+	results            *AuthRepositoryMockFindByIDResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AuthRepositoryMockFindByIDParams contains parameters of the AuthRepository.FindByID
+type AuthRepositoryMockFindByIDParams struct {
+	ctx    context.Context
+	userID string
+}
+
+// AuthRepositoryMockFindByIDParamPtrs contains pointers to parameters of the AuthRepository.FindByID
+type AuthRepositoryMockFindByIDParamPtrs struct {
+	ctx    *context.Context
+	userID *string
+}
+
+// AuthRepositoryMockFindByIDResults contains results of the AuthRepository.FindByID
+type AuthRepositoryMockFindByIDResults struct {
+	up1 *models.User
+	err error
+}
+
+// AuthRepositoryMockFindByIDOrigins contains origins of expectations of the AuthRepository.FindByID
+type AuthRepositoryMockFindByIDExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmFindByID *mAuthRepositoryMockFindByID) Optional() *mAuthRepositoryMockFindByID {
+	mmFindByID.optional = true
+	return mmFindByID
+}
+
+// Expect sets up expected params for AuthRepository.FindByID
+func (mmFindByID *mAuthRepositoryMockFindByID) Expect(ctx context.Context, userID string) *mAuthRepositoryMockFindByID {
+	if mmFindByID.mock.funcFindByID != nil {
+		mmFindByID.mock.t.Fatalf("AuthRepositoryMock.FindByID mock is already set by Set")
+	}
+
+	if mmFindByID.defaultExpectation == nil {
+		mmFindByID.defaultExpectation = &AuthRepositoryMockFindByIDExpectation{}
+	}
+
+	if mmFindByID.defaultExpectation.paramPtrs != nil {
+		mmFindByID.mock.t.Fatalf("AuthRepositoryMock.FindByID mock is already set by ExpectParams functions")
+	}
+
+	mmFindByID.defaultExpectation.params = &AuthRepositoryMockFindByIDParams{ctx, userID}
+	mmFindByID.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmFindByID.expectations {
+		if minimock.Equal(e.params, mmFindByID.defaultExpectation.params) {
+			mmFindByID.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmFindByID.defaultExpectation.params)
+		}
+	}
+
+	return mmFindByID
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuthRepository.FindByID
+func (mmFindByID *mAuthRepositoryMockFindByID) ExpectCtxParam1(ctx context.Context) *mAuthRepositoryMockFindByID {
+	if mmFindByID.mock.funcFindByID != nil {
+		mmFindByID.mock.t.Fatalf("AuthRepositoryMock.FindByID mock is already set by Set")
+	}
+
+	if mmFindByID.defaultExpectation == nil {
+		mmFindByID.defaultExpectation = &AuthRepositoryMockFindByIDExpectation{}
+	}
+
+	if mmFindByID.defaultExpectation.params != nil {
+		mmFindByID.mock.t.Fatalf("AuthRepositoryMock.FindByID mock is already set by Expect")
+	}
+
+	if mmFindByID.defaultExpectation.paramPtrs == nil {
+		mmFindByID.defaultExpectation.paramPtrs = &AuthRepositoryMockFindByIDParamPtrs{}
+	}
+	mmFindByID.defaultExpectation.paramPtrs.ctx = &ctx
+	mmFindByID.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmFindByID
+}
+
+// ExpectUserIDParam2 sets up expected param userID for AuthRepository.FindByID
+func (mmFindByID *mAuthRepositoryMockFindByID) ExpectUserIDParam2(userID string) *mAuthRepositoryMockFindByID {
+	if mmFindByID.mock.funcFindByID != nil {
+		mmFindByID.mock.t.Fatalf("AuthRepositoryMock.FindByID mock is already set by Set")
+	}
+
+	if mmFindByID.defaultExpectation == nil {
+		mmFindByID.defaultExpectation = &AuthRepositoryMockFindByIDExpectation{}
+	}
+
+	if mmFindByID.defaultExpectation.params != nil {
+		mmFindByID.mock.t.Fatalf("AuthRepositoryMock.FindByID mock is already set by Expect")
+	}
+
+	if mmFindByID.defaultExpectation.paramPtrs == nil {
+		mmFindByID.defaultExpectation.paramPtrs = &AuthRepositoryMockFindByIDParamPtrs{}
+	}
+	mmFindByID.defaultExpectation.paramPtrs.userID = &userID
+	mmFindByID.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmFindByID
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuthRepository.FindByID
+func (mmFindByID *mAuthRepositoryMockFindByID) Inspect(f func(ctx context.Context, userID string)) *mAuthRepositoryMockFindByID {
+	if mmFindByID.mock.inspectFuncFindByID != nil {
+		mmFindByID.mock.t.Fatalf("Inspect function is already set for AuthRepositoryMock.FindByID")
+	}
+
+	mmFindByID.mock.inspectFuncFindByID = f
+
+	return mmFindByID
+}
+
+// Return sets up results that will be returned by AuthRepository.FindByID
+func (mmFindByID *mAuthRepositoryMockFindByID) Return(up1 *models.User, err error) *AuthRepositoryMock {
+	if mmFindByID.mock.funcFindByID != nil {
+		mmFindByID.mock.t.Fatalf("AuthRepositoryMock.FindByID mock is already set by Set")
+	}
+
+	if mmFindByID.defaultExpectation == nil {
+		mmFindByID.defaultExpectation = &AuthRepositoryMockFindByIDExpectation{mock: mmFindByID.mock}
+	}
+	mmFindByID.defaultExpectation.results = &AuthRepositoryMockFindByIDResults{up1, err}
+	mmFindByID.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmFindByID.mock
+}
+
+// Set uses given function f to mock the AuthRepository.FindByID method
+func (mmFindByID *mAuthRepositoryMockFindByID) Set(f func(ctx context.Context, userID string) (up1 *models.User, err error)) *AuthRepositoryMock {
+	if mmFindByID.defaultExpectation != nil {
+		mmFindByID.mock.t.Fatalf("Default expectation is already set for the AuthRepository.FindByID method")
+	}
+
+	if len(mmFindByID.expectations) > 0 {
+		mmFindByID.mock.t.Fatalf("Some expectations are already set for the AuthRepository.FindByID method")
+	}
+
+	mmFindByID.mock.funcFindByID = f
+	mmFindByID.mock.funcFindByIDOrigin = minimock.CallerInfo(1)
+	return mmFindByID.mock
+}
+
+// When sets expectation for the AuthRepository.FindByID which will trigger the result defined by the following
+// Then helper
+func (mmFindByID *mAuthRepositoryMockFindByID) When(ctx context.Context, userID string) *AuthRepositoryMockFindByIDExpectation {
+	if mmFindByID.mock.funcFindByID != nil {
+		mmFindByID.mock.t.Fatalf("AuthRepositoryMock.FindByID mock is already set by Set")
+	}
+
+	expectation := &AuthRepositoryMockFindByIDExpectation{
+		mock:               mmFindByID.mock,
+		params:             &AuthRepositoryMockFindByIDParams{ctx, userID},
+		expectationOrigins: AuthRepositoryMockFindByIDExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmFindByID.expectations = append(mmFindByID.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuthRepository.FindByID return parameters for the expectation previously defined by the When method
+func (e *AuthRepositoryMockFindByIDExpectation) Then(up1 *models.User, err error) *AuthRepositoryMock {
+	e.results = &AuthRepositoryMockFindByIDResults{up1, err}
+	return e.mock
+}
+
+// Times sets number of times AuthRepository.FindByID should be invoked
+func (mmFindByID *mAuthRepositoryMockFindByID) Times(n uint64) *mAuthRepositoryMockFindByID {
+	if n == 0 {
+		mmFindByID.mock.t.Fatalf("Times of AuthRepositoryMock.FindByID mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmFindByID.expectedInvocations, n)
+	mmFindByID.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmFindByID
+}
+
+func (mmFindByID *mAuthRepositoryMockFindByID) invocationsDone() bool {
+	if len(mmFindByID.expectations) == 0 && mmFindByID.defaultExpectation == nil && mmFindByID.mock.funcFindByID == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmFindByID.mock.afterFindByIDCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmFindByID.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// FindByID implements AuthRepository
+func (mmFindByID *AuthRepositoryMock) FindByID(ctx context.Context, userID string) (up1 *models.User, err error) {
+	mm_atomic.AddUint64(&mmFindByID.beforeFindByIDCounter, 1)
+	defer mm_atomic.AddUint64(&mmFindByID.afterFindByIDCounter, 1)
+
+	mmFindByID.t.Helper()
+
+	if mmFindByID.inspectFuncFindByID != nil {
+		mmFindByID.inspectFuncFindByID(ctx, userID)
+	}
+
+	mm_params := AuthRepositoryMockFindByIDParams{ctx, userID}
+
+	// Record call args
+	mmFindByID.FindByIDMock.mutex.Lock()
+	mmFindByID.FindByIDMock.callArgs = append(mmFindByID.FindByIDMock.callArgs, &mm_params)
+	mmFindByID.FindByIDMock.mutex.Unlock()
+
+	for _, e := range mmFindByID.FindByIDMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.up1, e.results.err
+		}
+	}
+
+	if mmFindByID.FindByIDMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmFindByID.FindByIDMock.defaultExpectation.Counter, 1)
+		mm_want := mmFindByID.FindByIDMock.defaultExpectation.params
+		mm_want_ptrs := mmFindByID.FindByIDMock.defaultExpectation.paramPtrs
+
+		mm_got := AuthRepositoryMockFindByIDParams{ctx, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmFindByID.t.Errorf("AuthRepositoryMock.FindByID got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindByID.FindByIDMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmFindByID.t.Errorf("AuthRepositoryMock.FindByID got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindByID.FindByIDMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmFindByID.t.Errorf("AuthRepositoryMock.FindByID got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmFindByID.FindByIDMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmFindByID.FindByIDMock.defaultExpectation.results
+		if mm_results == nil {
+			mmFindByID.t.Fatal("No results are set for the AuthRepositoryMock.FindByID")
+		}
+		return (*mm_results).up1, (*mm_results).err
+	}
+	if mmFindByID.funcFindByID != nil {
+		return mmFindByID.funcFindByID(ctx, userID)
+	}
+	mmFindByID.t.Fatalf("Unexpected call to AuthRepositoryMock.FindByID. %v %v", ctx, userID)
+	return
+}
+
+// FindByIDAfterCounter returns a count of finished AuthRepositoryMock.FindByID invocations
+func (mmFindByID *AuthRepositoryMock) FindByIDAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFindByID.afterFindByIDCounter)
+}
+
+// FindByIDBeforeCounter returns a count of AuthRepositoryMock.FindByID invocations
+func (mmFindByID *AuthRepositoryMock) FindByIDBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFindByID.beforeFindByIDCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuthRepositoryMock.FindByID.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmFindByID *mAuthRepositoryMockFindByID) Calls() []*AuthRepositoryMockFindByIDParams {
+	mmFindByID.mutex.RLock()
+
+	argCopy := make([]*AuthRepositoryMockFindByIDParams, len(mmFindByID.callArgs))
+	copy(argCopy, mmFindByID.callArgs)
+
+	mmFindByID.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockFindByIDDone returns true if the count of the FindByID invocations corresponds
+// the number of defined expectations
+func (m *AuthRepositoryMock) MinimockFindByIDDone() bool {
+	if m.FindByIDMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.FindByIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.FindByIDMock.invocationsDone()
+}
+
+// MinimockFindByIDInspect logs each unmet expectation
+func (m *AuthRepositoryMock) MinimockFindByIDInspect() {
+	for _, e := range m.FindByIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuthRepositoryMock.FindByID at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterFindByIDCounter := mm_atomic.LoadUint64(&m.afterFindByIDCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.FindByIDMock.defaultExpectation != nil && afterFindByIDCounter < 1 {
+		if m.FindByIDMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuthRepositoryMock.FindByID at\n%s", m.FindByIDMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AuthRepositoryMock.FindByID at\n%s with params: %#v", m.FindByIDMock.defaultExpectation.expectationOrigins.origin, *m.FindByIDMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcFindByID != nil && afterFindByIDCounter < 1 {
+		m.t.Errorf("Expected call to AuthRepositoryMock.FindByID at\n%s", m.funcFindByIDOrigin)
+	}
+
+	if !m.FindByIDMock.invocationsDone() && afterFindByIDCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuthRepositoryMock.FindByID at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.FindByIDMock.expectedInvocations), m.FindByIDMock.expectedInvocationsOrigin, afterFindByIDCounter)
+	}
+}
+
+type mAuthRepositoryMockFindSessionByID struct {
+	optional           bool
+	mock               *AuthRepositoryMock
+	defaultExpectation *AuthRepositoryMockFindSessionByIDExpectation
+	expectations       []*AuthRepositoryMockFindSessionByIDExpectation
+
+	callArgs []*AuthRepositoryMockFindSessionByIDParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AuthRepositoryMockFindSessionByIDExpectation specifies expectation struct of the AuthRepository.FindSessionByID
+type AuthRepositoryMockFindSessionByIDExpectation struct {
+	mock               *AuthRepositoryMock
+	params             *AuthRepositoryMockFindSessionByIDParams
+	paramPtrs          *AuthRepositoryMockFindSessionByIDParamPtrs
+	expectationOrigins AuthRepositoryMockFindSessionByIDExpectationOrigins
+	results            *AuthRepositoryMockFindSessionByIDResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AuthRepositoryMockFindSessionByIDParams contains parameters of the AuthRepository.FindSessionByID
+type AuthRepositoryMockFindSessionByIDParams struct {
+	ctx       context.Context
+	sessionID string
+}
+
+// AuthRepositoryMockFindSessionByIDParamPtrs contains pointers to parameters of the AuthRepository.FindSessionByID
+type AuthRepositoryMockFindSessionByIDParamPtrs struct {
+	ctx       *context.Context
+	sessionID *string
+}
+
+// AuthRepositoryMockFindSessionByIDResults contains results of the AuthRepository.FindSessionByID
+type AuthRepositoryMockFindSessionByIDResults struct {
+	sp1 *models.Session
+	err error
+}
+
+// AuthRepositoryMockFindSessionByIDOrigins contains origins of expectations of the AuthRepository.FindSessionByID
+type AuthRepositoryMockFindSessionByIDExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originSessionID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmFindSessionByID *mAuthRepositoryMockFindSessionByID) Optional() *mAuthRepositoryMockFindSessionByID {
+	mmFindSessionByID.optional = true
+	return mmFindSessionByID
+}
+
+// Expect sets up expected params for AuthRepository.FindSessionByID
+func (mmFindSessionByID *mAuthRepositoryMockFindSessionByID) Expect(ctx context.Context, sessionID string) *mAuthRepositoryMockFindSessionByID {
+	if mmFindSessionByID.mock.funcFindSessionByID != nil {
+		mmFindSessionByID.mock.t.Fatalf("AuthRepositoryMock.FindSessionByID mock is already set by Set")
+	}
+
+	if mmFindSessionByID.defaultExpectation == nil {
+		mmFindSessionByID.defaultExpectation = &AuthRepositoryMockFindSessionByIDExpectation{}
+	}
+
+	if mmFindSessionByID.defaultExpectation.paramPtrs != nil {
+		mmFindSessionByID.mock.t.Fatalf("AuthRepositoryMock.FindSessionByID mock is already set by ExpectParams functions")
+	}
+
+	mmFindSessionByID.defaultExpectation.params = &AuthRepositoryMockFindSessionByIDParams{ctx, sessionID}
+	mmFindSessionByID.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmFindSessionByID.expectations {
+		if minimock.Equal(e.params, mmFindSessionByID.defaultExpectation.params) {
+			mmFindSessionByID.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmFindSessionByID.defaultExpectation.params)
+		}
+	}
+
+	return mmFindSessionByID
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuthRepository.FindSessionByID
+func (mmFindSessionByID *mAuthRepositoryMockFindSessionByID) ExpectCtxParam1(ctx context.Context) *mAuthRepositoryMockFindSessionByID {
+	if mmFindSessionByID.mock.funcFindSessionByID != nil {
+		mmFindSessionByID.mock.t.Fatalf("AuthRepositoryMock.FindSessionByID mock is already set by Set")
+	}
+
+	if mmFindSessionByID.defaultExpectation == nil {
+		mmFindSessionByID.defaultExpectation = &AuthRepositoryMockFindSessionByIDExpectation{}
+	}
+
+	if mmFindSessionByID.defaultExpectation.params != nil {
+		mmFindSessionByID.mock.t.Fatalf("AuthRepositoryMock.FindSessionByID mock is already set by Expect")
+	}
+
+	if mmFindSessionByID.defaultExpectation.paramPtrs == nil {
+		mmFindSessionByID.defaultExpectation.paramPtrs = &AuthRepositoryMockFindSessionByIDParamPtrs{}
+	}
+	mmFindSessionByID.defaultExpectation.paramPtrs.ctx = &ctx
+	mmFindSessionByID.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmFindSessionByID
+}
+
+// ExpectSessionIDParam2 sets up expected param sessionID for AuthRepository.FindSessionByID
+func (mmFindSessionByID *mAuthRepositoryMockFindSessionByID) ExpectSessionIDParam2(sessionID string) *mAuthRepositoryMockFindSessionByID {
+	if mmFindSessionByID.mock.funcFindSessionByID != nil {
+		mmFindSessionByID.mock.t.Fatalf("AuthRepositoryMock.FindSessionByID mock is already set by Set")
+	}
+
+	if mmFindSessionByID.defaultExpectation == nil {
+		mmFindSessionByID.defaultExpectation = &AuthRepositoryMockFindSessionByIDExpectation{}
+	}
+
+	if mmFindSessionByID.defaultExpectation.params != nil {
+		mmFindSessionByID.mock.t.Fatalf("AuthRepositoryMock.FindSessionByID mock is already set by Expect")
+	}
+
+	if mmFindSessionByID.defaultExpectation.paramPtrs == nil {
+		mmFindSessionByID.defaultExpectation.paramPtrs = &AuthRepositoryMockFindSessionByIDParamPtrs{}
+	}
+	mmFindSessionByID.defaultExpectation.paramPtrs.sessionID = &sessionID
+	mmFindSessionByID.defaultExpectation.expectationOrigins.originSessionID = minimock.CallerInfo(1)
+
+	return mmFindSessionByID
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuthRepository.FindSessionByID
+func (mmFindSessionByID *mAuthRepositoryMockFindSessionByID) Inspect(f func(ctx context.Context, sessionID string)) *mAuthRepositoryMockFindSessionByID {
+	if mmFindSessionByID.mock.inspectFuncFindSessionByID != nil {
+		mmFindSessionByID.mock.t.Fatalf("Inspect function is already set for AuthRepositoryMock.FindSessionByID")
+	}
+
+	mmFindSessionByID.mock.inspectFuncFindSessionByID = f
+
+	return mmFindSessionByID
+}
+
+// Return sets up results that will be returned by AuthRepository.FindSessionByID
+func (mmFindSessionByID *mAuthRepositoryMockFindSessionByID) Return(sp1 *models.Session, err error) *AuthRepositoryMock {
+	if mmFindSessionByID.mock.funcFindSessionByID != nil {
+		mmFindSessionByID.mock.t.Fatalf("AuthRepositoryMock.FindSessionByID mock is already set by Set")
+	}
+
+	if mmFindSessionByID.defaultExpectation == nil {
+		mmFindSessionByID.defaultExpectation = &AuthRepositoryMockFindSessionByIDExpectation{mock: mmFindSessionByID.mock}
+	}
+	mmFindSessionByID.defaultExpectation.results = &AuthRepositoryMockFindSessionByIDResults{sp1, err}
+	mmFindSessionByID.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmFindSessionByID.mock
+}
+
+// Set uses given function f to mock the AuthRepository.FindSessionByID method
+func (mmFindSessionByID *mAuthRepositoryMockFindSessionByID) Set(f func(ctx context.Context, sessionID string) (sp1 *models.Session, err error)) *AuthRepositoryMock {
+	if mmFindSessionByID.defaultExpectation != nil {
+		mmFindSessionByID.mock.t.Fatalf("Default expectation is already set for the AuthRepository.FindSessionByID method")
+	}
+
+	if len(mmFindSessionByID.expectations) > 0 {
+		mmFindSessionByID.mock.t.Fatalf("Some expectations are already set for the AuthRepository.FindSessionByID method")
+	}
+
+	mmFindSessionByID.mock.funcFindSessionByID = f
+	mmFindSessionByID.mock.funcFindSessionByIDOrigin = minimock.CallerInfo(1)
+	return mmFindSessionByID.mock
+}
+
+// When sets expectation for the AuthRepository.FindSessionByID which will trigger the result defined by the following
+// Then helper
+func (mmFindSessionByID *mAuthRepositoryMockFindSessionByID) When(ctx context.Context, sessionID string) *AuthRepositoryMockFindSessionByIDExpectation {
+	if mmFindSessionByID.mock.funcFindSessionByID != nil {
+		mmFindSessionByID.mock.t.Fatalf("AuthRepositoryMock.FindSessionByID mock is already set by Set")
+	}
+
+	expectation := &AuthRepositoryMockFindSessionByIDExpectation{
+		mock:               mmFindSessionByID.mock,
+		params:             &AuthRepositoryMockFindSessionByIDParams{ctx, sessionID},
+		expectationOrigins: AuthRepositoryMockFindSessionByIDExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmFindSessionByID.expectations = append(mmFindSessionByID.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuthRepository.FindSessionByID return parameters for the expectation previously defined by the When method
+func (e *AuthRepositoryMockFindSessionByIDExpectation) Then(sp1 *models.Session, err error) *AuthRepositoryMock {
+	e.results = &AuthRepositoryMockFindSessionByIDResults{sp1, err}
+	return e.mock
+}
+
+// Times sets number of times AuthRepository.FindSessionByID should be invoked
+func (mmFindSessionByID *mAuthRepositoryMockFindSessionByID) Times(n uint64) *mAuthRepositoryMockFindSessionByID {
+	if n == 0 {
+		mmFindSessionByID.mock.t.Fatalf("Times of AuthRepositoryMock.FindSessionByID mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmFindSessionByID.expectedInvocations, n)
+	mmFindSessionByID.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmFindSessionByID
+}
+
+func (mmFindSessionByID *mAuthRepositoryMockFindSessionByID) invocationsDone() bool {
+	if len(mmFindSessionByID.expectations) == 0 && mmFindSessionByID.defaultExpectation == nil && mmFindSessionByID.mock.funcFindSessionByID == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmFindSessionByID.mock.afterFindSessionByIDCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmFindSessionByID.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// FindSessionByID implements AuthRepository
+func (mmFindSessionByID *AuthRepositoryMock) FindSessionByID(ctx context.Context, sessionID string) (sp1 *models.Session, err error) {
+	mm_atomic.AddUint64(&mmFindSessionByID.beforeFindSessionByIDCounter, 1)
+	defer mm_atomic.AddUint64(&mmFindSessionByID.afterFindSessionByIDCounter, 1)
+
+	mmFindSessionByID.t.Helper()
+
+	if mmFindSessionByID.inspectFuncFindSessionByID != nil {
+		mmFindSessionByID.inspectFuncFindSessionByID(ctx, sessionID)
+	}
+
+	mm_params := AuthRepositoryMockFindSessionByIDParams{ctx, sessionID}
+
+	// Record call args
+	mmFindSessionByID.FindSessionByIDMock.mutex.Lock()
+	mmFindSessionByID.FindSessionByIDMock.callArgs = append(mmFindSessionByID.FindSessionByIDMock.callArgs, &mm_params)
+	mmFindSessionByID.FindSessionByIDMock.mutex.Unlock()
+
+	for _, e := range mmFindSessionByID.FindSessionByIDMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.sp1, e.results.err
+		}
+	}
+
+	if mmFindSessionByID.FindSessionByIDMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmFindSessionByID.FindSessionByIDMock.defaultExpectation.Counter, 1)
+		mm_want := mmFindSessionByID.FindSessionByIDMock.defaultExpectation.params
+		mm_want_ptrs := mmFindSessionByID.FindSessionByIDMock.defaultExpectation.paramPtrs
+
+		mm_got := AuthRepositoryMockFindSessionByIDParams{ctx, sessionID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmFindSessionByID.t.Errorf("AuthRepositoryMock.FindSessionByID got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindSessionByID.FindSessionByIDMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.sessionID != nil && !minimock.Equal(*mm_want_ptrs.sessionID, mm_got.sessionID) {
+				mmFindSessionByID.t.Errorf("AuthRepositoryMock.FindSessionByID got unexpected parameter sessionID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindSessionByID.FindSessionByIDMock.defaultExpectation.expectationOrigins.originSessionID, *mm_want_ptrs.sessionID, mm_got.sessionID, minimock.Diff(*mm_want_ptrs.sessionID, mm_got.sessionID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmFindSessionByID.t.Errorf("AuthRepositoryMock.FindSessionByID got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmFindSessionByID.FindSessionByIDMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmFindSessionByID.FindSessionByIDMock.defaultExpectation.results
+		if mm_results == nil {
+			mmFindSessionByID.t.Fatal("No results are set for the AuthRepositoryMock.FindSessionByID")
+		}
+		return (*mm_results).sp1, (*mm_results).err
+	}
+	if mmFindSessionByID.funcFindSessionByID != nil {
+		return mmFindSessionByID.funcFindSessionByID(ctx, sessionID)
+	}
+	mmFindSessionByID.t.Fatalf("Unexpected call to AuthRepositoryMock.FindSessionByID. %v %v", ctx, sessionID)
+	return
+}
+
+// FindSessionByIDAfterCounter returns a count of finished AuthRepositoryMock.FindSessionByID invocations
+func (mmFindSessionByID *AuthRepositoryMock) FindSessionByIDAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFindSessionByID.afterFindSessionByIDCounter)
+}
+
+// FindSessionByIDBeforeCounter returns a count of AuthRepositoryMock.FindSessionByID invocations
+func (mmFindSessionByID *AuthRepositoryMock) FindSessionByIDBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFindSessionByID.beforeFindSessionByIDCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuthRepositoryMock.FindSessionByID.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmFindSessionByID *mAuthRepositoryMockFindSessionByID) Calls() []*AuthRepositoryMockFindSessionByIDParams {
+	mmFindSessionByID.mutex.RLock()
+
+	argCopy := make([]*AuthRepositoryMockFindSessionByIDParams, len(mmFindSessionByID.callArgs))
+	copy(argCopy, mmFindSessionByID.callArgs)
+
+	mmFindSessionByID.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockFindSessionByIDDone returns true if the count of the FindSessionByID invocations corresponds
+// the number of defined expectations
+func (m *AuthRepositoryMock) MinimockFindSessionByIDDone() bool {
+	if m.FindSessionByIDMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.FindSessionByIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.FindSessionByIDMock.invocationsDone()
+}
+
+// MinimockFindSessionByIDInspect logs each unmet expectation
+func (m *AuthRepositoryMock) MinimockFindSessionByIDInspect() {
+	for _, e := range m.FindSessionByIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuthRepositoryMock.FindSessionByID at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterFindSessionByIDCounter := mm_atomic.LoadUint64(&m.afterFindSessionByIDCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.FindSessionByIDMock.defaultExpectation != nil && afterFindSessionByIDCounter < 1 {
+		if m.FindSessionByIDMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuthRepositoryMock.FindSessionByID at\n%s", m.FindSessionByIDMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AuthRepositoryMock.FindSessionByID at\n%s with params: %#v", m.FindSessionByIDMock.defaultExpectation.expectationOrigins.origin, *m.FindSessionByIDMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcFindSessionByID != nil && afterFindSessionByIDCounter < 1 {
+		m.t.Errorf("Expected call to AuthRepositoryMock.FindSessionByID at\n%s", m.funcFindSessionByIDOrigin)
+	}
+
+	if !m.FindSessionByIDMock.invocationsDone() && afterFindSessionByIDCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuthRepositoryMock.FindSessionByID at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.FindSessionByIDMock.expectedInvocations), m.FindSessionByIDMock.expectedInvocationsOrigin, afterFindSessionByIDCounter)
+	}
+}
+
+type mAuthRepositoryMockInTx struct {
+	optional           bool
+	mock               *AuthRepositoryMock
+	defaultExpectation *AuthRepositoryMockInTxExpectation
+	expectations       []*AuthRepositoryMockInTxExpectation
+
+	callArgs []*AuthRepositoryMockInTxParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AuthRepositoryMockInTxExpectation specifies expectation struct of the AuthRepository.InTx
+type AuthRepositoryMockInTxExpectation struct {
+	mock               *AuthRepositoryMock
+	params             *AuthRepositoryMockInTxParams
+	paramPtrs          *AuthRepositoryMockInTxParamPtrs
+	expectationOrigins AuthRepositoryMockInTxExpectationOrigins
+	results            *AuthRepositoryMockInTxResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AuthRepositoryMockInTxParams contains parameters of the AuthRepository.InTx
+type AuthRepositoryMockInTxParams struct {
+	ctx context.Context
+	fn  func(ctx context.Context) error
+}
+
+// AuthRepositoryMockInTxParamPtrs contains pointers to parameters of the AuthRepository.InTx
+type AuthRepositoryMockInTxParamPtrs struct {
+	ctx *context.Context
+	fn  *func(ctx context.Context) error
+}
+
+// AuthRepositoryMockInTxResults contains results of the AuthRepository.InTx
+type AuthRepositoryMockInTxResults struct {
+	err error
+}
+
+// AuthRepositoryMockInTxOrigins contains origins of expectations of the AuthRepository.InTx
+type AuthRepositoryMockInTxExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originFn  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmInTx *mAuthRepositoryMockInTx) Optional() *mAuthRepositoryMockInTx {
+	mmInTx.optional = true
+	return mmInTx
+}
+
+// Expect sets up expected params for AuthRepository.InTx
+func (mmInTx *mAuthRepositoryMockInTx) Expect(ctx context.Context, fn func(ctx context.Context) error) *mAuthRepositoryMockInTx {
+	if mmInTx.mock.funcInTx != nil {
+		mmInTx.mock.t.Fatalf("AuthRepositoryMock.InTx mock is already set by Set")
+	}
+
+	if mmInTx.defaultExpectation == nil {
+		mmInTx.defaultExpectation = &AuthRepositoryMockInTxExpectation{}
+	}
+
+	if mmInTx.defaultExpectation.paramPtrs != nil {
+		mmInTx.mock.t.Fatalf("AuthRepositoryMock.InTx mock is already set by ExpectParams functions")
+	}
+
+	mmInTx.defaultExpectation.params = &AuthRepositoryMockInTxParams{ctx, fn}
+	mmInTx.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmInTx.expectations {
+		if minimock.Equal(e.params, mmInTx.defaultExpectation.params) {
+			mmInTx.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmInTx.defaultExpectation.params)
+		}
+	}
+
+	return mmInTx
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuthRepository.InTx
+func (mmInTx *mAuthRepositoryMockInTx) ExpectCtxParam1(ctx context.Context) *mAuthRepositoryMockInTx {
+	if mmInTx.mock.funcInTx != nil {
+		mmInTx.mock.t.Fatalf("AuthRepositoryMock.InTx mock is already set by Set")
+	}
+
+	if mmInTx.defaultExpectation == nil {
+		mmInTx.defaultExpectation = &AuthRepositoryMockInTxExpectation{}
+	}
+
+	if mmInTx.defaultExpectation.params != nil {
+		mmInTx.mock.t.Fatalf("AuthRepositoryMock.InTx mock is already set by Expect")
+	}
+
+	if mmInTx.defaultExpectation.paramPtrs == nil {
+		mmInTx.defaultExpectation.paramPtrs = &AuthRepositoryMockInTxParamPtrs{}
+	}
+	mmInTx.defaultExpectation.paramPtrs.ctx = &ctx
+	mmInTx.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmInTx
+}
+
+// ExpectFnParam2 sets up expected param fn for AuthRepository.InTx
+func (mmInTx *mAuthRepositoryMockInTx) ExpectFnParam2(fn func(ctx context.Context) error) *mAuthRepositoryMockInTx {
+	if mmInTx.mock.funcInTx != nil {
+		mmInTx.mock.t.Fatalf("AuthRepositoryMock.InTx mock is already set by Set")
+	}
+
+	if mmInTx.defaultExpectation == nil {
+		mmInTx.defaultExpectation = &AuthRepositoryMockInTxExpectation{}
+	}
+
+	if mmInTx.defaultExpectation.params != nil {
+		mmInTx.mock.t.Fatalf("AuthRepositoryMock.InTx mock is already set by Expect")
+	}
+
+	if mmInTx.defaultExpectation.paramPtrs == nil {
+		mmInTx.defaultExpectation.paramPtrs = &AuthRepositoryMockInTxParamPtrs{}
+	}
+	mmInTx.defaultExpectation.paramPtrs.fn = &fn
+	mmInTx.defaultExpectation.expectationOrigins.originFn = minimock.CallerInfo(1)
+
+	return mmInTx
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuthRepository.InTx
+func (mmInTx *mAuthRepositoryMockInTx) Inspect(f func(ctx context.Context, fn func(ctx context.Context) error)) *mAuthRepositoryMockInTx {
+	if mmInTx.mock.inspectFuncInTx != nil {
+		mmInTx.mock.t.Fatalf("Inspect function is already set for AuthRepositoryMock.InTx")
+	}
+
+	mmInTx.mock.inspectFuncInTx = f
+
+	return mmInTx
+}
+
+// Return sets up results that will be returned by AuthRepository.InTx
+func (mmInTx *mAuthRepositoryMockInTx) Return(err error) *AuthRepositoryMock {
+	if mmInTx.mock.funcInTx != nil {
+		mmInTx.mock.t.Fatalf("AuthRepositoryMock.InTx mock is already set by Set")
+	}
+
+	if mmInTx.defaultExpectation == nil {
+		mmInTx.defaultExpectation = &AuthRepositoryMockInTxExpectation{mock: mmInTx.mock}
+	}
+	mmInTx.defaultExpectation.results = &AuthRepositoryMockInTxResults{err}
+	mmInTx.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmInTx.mock
+}
+
+// Set uses given function f to mock the AuthRepository.InTx method
+func (mmInTx *mAuthRepositoryMockInTx) Set(f func(ctx context.Context, fn func(ctx context.Context) error) (err error)) *AuthRepositoryMock {
+	if mmInTx.defaultExpectation != nil {
+		mmInTx.mock.t.Fatalf("Default expectation is already set for the AuthRepository.InTx method")
+	}
+
+	if len(mmInTx.expectations) > 0 {
+		mmInTx.mock.t.Fatalf("Some expectations are already set for the AuthRepository.InTx method")
+	}
+
+	mmInTx.mock.funcInTx = f
+	mmInTx.mock.funcInTxOrigin = minimock.CallerInfo(1)
+	return mmInTx.mock
+}
+
+// When sets expectation for the AuthRepository.InTx which will trigger the result defined by the following
+// Then helper
+func (mmInTx *mAuthRepositoryMockInTx) When(ctx context.Context, fn func(ctx context.Context) error) *AuthRepositoryMockInTxExpectation {
+	if mmInTx.mock.funcInTx != nil {
+		mmInTx.mock.t.Fatalf("AuthRepositoryMock.InTx mock is already set by Set")
+	}
+
+	expectation := &AuthRepositoryMockInTxExpectation{
+		mock:               mmInTx.mock,
+		params:             &AuthRepositoryMockInTxParams{ctx, fn},
+		expectationOrigins: AuthRepositoryMockInTxExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmInTx.expectations = append(mmInTx.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuthRepository.InTx return parameters for the expectation previously defined by the When method
+func (e *AuthRepositoryMockInTxExpectation) Then(err error) *AuthRepositoryMock {
+	e.results = &AuthRepositoryMockInTxResults{err}
+	return e.mock
+}
+
+// Times sets number of times AuthRepository.InTx should be invoked
+func (mmInTx *mAuthRepositoryMockInTx) Times(n uint64) *mAuthRepositoryMockInTx {
+	if n == 0 {
+		mmInTx.mock.t.Fatalf("Times of AuthRepositoryMock.InTx mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmInTx.expectedInvocations, n)
+	mmInTx.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmInTx
+}
+
+func (mmInTx *mAuthRepositoryMockInTx) invocationsDone() bool {
+	if len(mmInTx.expectations) == 0 && mmInTx.defaultExpectation == nil && mmInTx.mock.funcInTx == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmInTx.mock.afterInTxCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmInTx.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// InTx implements AuthRepository
+func (mmInTx *AuthRepositoryMock) InTx(ctx context.Context, fn func(ctx context.Context) error) (err error) {
+	mm_atomic.AddUint64(&mmInTx.beforeInTxCounter, 1)
+	defer mm_atomic.AddUint64(&mmInTx.afterInTxCounter, 1)
+
+	mmInTx.t.Helper()
+
+	if mmInTx.inspectFuncInTx != nil {
+		mmInTx.inspectFuncInTx(ctx, fn)
+	}
+
+	mm_params := AuthRepositoryMockInTxParams{ctx, fn}
+
+	// Record call args
+	mmInTx.InTxMock.mutex.Lock()
+	mmInTx.InTxMock.callArgs = append(mmInTx.InTxMock.callArgs, &mm_params)
+	mmInTx.InTxMock.mutex.Unlock()
+
+	for _, e := range mmInTx.InTxMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmInTx.InTxMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmInTx.InTxMock.defaultExpectation.Counter, 1)
+		mm_want := mmInTx.InTxMock.defaultExpectation.params
+		mm_want_ptrs := mmInTx.InTxMock.defaultExpectation.paramPtrs
+
+		mm_got := AuthRepositoryMockInTxParams{ctx, fn}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmInTx.t.Errorf("AuthRepositoryMock.InTx got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmInTx.InTxMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.fn != nil && !minimock.Equal(*mm_want_ptrs.fn, mm_got.fn) {
+				mmInTx.t.Errorf("AuthRepositoryMock.InTx got unexpected parameter fn, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmInTx.InTxMock.defaultExpectation.expectationOrigins.originFn, *mm_want_ptrs.fn, mm_got.fn, minimock.Diff(*mm_want_ptrs.fn, mm_got.fn))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmInTx.t.Errorf("AuthRepositoryMock.InTx got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmInTx.InTxMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmInTx.InTxMock.defaultExpectation.results
+		if mm_results == nil {
+			mmInTx.t.Fatal("No results are set for the AuthRepositoryMock.InTx")
+		}
+		return (*mm_results).err
+	}
+	if mmInTx.funcInTx != nil {
+		return mmInTx.funcInTx(ctx, fn)
+	}
+	mmInTx.t.Fatalf("Unexpected call to AuthRepositoryMock.InTx. %v %v", ctx, fn)
+	return
+}
+
+// InTxAfterCounter returns a count of finished AuthRepositoryMock.InTx invocations
+func (mmInTx *AuthRepositoryMock) InTxAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmInTx.afterInTxCounter)
+}
+
+// InTxBeforeCounter returns a count of AuthRepositoryMock.InTx invocations
+func (mmInTx *AuthRepositoryMock) InTxBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmInTx.beforeInTxCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuthRepositoryMock.InTx.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmInTx *mAuthRepositoryMockInTx) Calls() []*AuthRepositoryMockInTxParams {
+	mmInTx.mutex.RLock()
+
+	argCopy := make([]*AuthRepositoryMockInTxParams, len(mmInTx.callArgs))
+	copy(argCopy, mmInTx.callArgs)
+
+	mmInTx.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockInTxDone returns true if the count of the InTx invocations corresponds
+// the number of defined expectations
+func (m *AuthRepositoryMock) MinimockInTxDone() bool {
+	if m.InTxMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.InTxMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.InTxMock.invocationsDone()
+}
+
+// MinimockInTxInspect logs each unmet expectation
+func (m *AuthRepositoryMock) MinimockInTxInspect() {
+	for _, e := range m.InTxMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuthRepositoryMock.InTx at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterInTxCounter := mm_atomic.LoadUint64(&m.afterInTxCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.InTxMock.defaultExpectation != nil && afterInTxCounter < 1 {
+		if m.InTxMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuthRepositoryMock.InTx at\n%s", m.InTxMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AuthRepositoryMock.InTx at\n%s with params: %#v", m.InTxMock.defaultExpectation.expectationOrigins.origin, *m.InTxMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcInTx != nil && afterInTxCounter < 1 {
+		m.t.Errorf("Expected call to AuthRepositoryMock.InTx at\n%s", m.funcInTxOrigin)
+	}
+
+	if !m.InTxMock.invocationsDone() && afterInTxCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuthRepositoryMock.InTx at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.InTxMock.expectedInvocations), m.InTxMock.expectedInvocationsOrigin, afterInTxCounter)
+	}
+}
+
+type mAuthRepositoryMockIsTokenRevoked struct {
+	optional           bool
+	mock               *AuthRepositoryMock
+	defaultExpectation *AuthRepositoryMockIsTokenRevokedExpectation
+	expectations       []*AuthRepositoryMockIsTokenRevokedExpectation
+
+	callArgs []*AuthRepositoryMockIsTokenRevokedParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AuthRepositoryMockIsTokenRevokedExpectation specifies expectation struct of the AuthRepository.IsTokenRevoked
+type AuthRepositoryMockIsTokenRevokedExpectation struct {
+	mock               *AuthRepositoryMock
+	params             *AuthRepositoryMockIsTokenRevokedParams
+	paramPtrs          *AuthRepositoryMockIsTokenRevokedParamPtrs
+	expectationOrigins AuthRepositoryMockIsTokenRevokedExpectationOrigins
+	results            *AuthRepositoryMockIsTokenRevokedResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AuthRepositoryMockIsTokenRevokedParams contains parameters of the AuthRepository.IsTokenRevoked
+type AuthRepositoryMockIsTokenRevokedParams struct {
+	ctx context.Context
+	jti string
+}
+
+// AuthRepositoryMockIsTokenRevokedParamPtrs contains pointers to parameters of the AuthRepository.IsTokenRevoked
+type AuthRepositoryMockIsTokenRevokedParamPtrs struct {
+	ctx *context.Context
+	jti *string
+}
+
+// AuthRepositoryMockIsTokenRevokedResults contains results of the AuthRepository.IsTokenRevoked
+type AuthRepositoryMockIsTokenRevokedResults struct {
+	b1  bool
+	err error
+}
+
+// AuthRepositoryMockIsTokenRevokedOrigins contains origins of expectations of the AuthRepository.IsTokenRevoked
+type AuthRepositoryMockIsTokenRevokedExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originJti string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmIsTokenRevoked *mAuthRepositoryMockIsTokenRevoked) Optional() *mAuthRepositoryMockIsTokenRevoked {
+	mmIsTokenRevoked.optional = true
+	return mmIsTokenRevoked
+}
+
+// Expect sets up expected params for AuthRepository.IsTokenRevoked
+func (mmIsTokenRevoked *mAuthRepositoryMockIsTokenRevoked) Expect(ctx context.Context, jti string) *mAuthRepositoryMockIsTokenRevoked {
+	if mmIsTokenRevoked.mock.funcIsTokenRevoked != nil {
+		mmIsTokenRevoked.mock.t.Fatalf("AuthRepositoryMock.IsTokenRevoked mock is already set by Set")
+	}
+
+	if mmIsTokenRevoked.defaultExpectation == nil {
+		mmIsTokenRevoked.defaultExpectation = &AuthRepositoryMockIsTokenRevokedExpectation{}
+	}
+
+	if mmIsTokenRevoked.defaultExpectation.paramPtrs != nil {
+		mmIsTokenRevoked.mock.t.Fatalf("AuthRepositoryMock.IsTokenRevoked mock is already set by ExpectParams functions")
+	}
+
+	mmIsTokenRevoked.defaultExpectation.params = &AuthRepositoryMockIsTokenRevokedParams{ctx, jti}
+	mmIsTokenRevoked.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmIsTokenRevoked.expectations {
+		if minimock.Equal(e.params, mmIsTokenRevoked.defaultExpectation.params) {
+			mmIsTokenRevoked.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmIsTokenRevoked.defaultExpectation.params)
+		}
+	}
+
+	return mmIsTokenRevoked
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuthRepository.IsTokenRevoked
+func (mmIsTokenRevoked *mAuthRepositoryMockIsTokenRevoked) ExpectCtxParam1(ctx context.Context) *mAuthRepositoryMockIsTokenRevoked {
+	if mmIsTokenRevoked.mock.funcIsTokenRevoked != nil {
+		mmIsTokenRevoked.mock.t.Fatalf("AuthRepositoryMock.IsTokenRevoked mock is already set by Set")
+	}
+
+	if mmIsTokenRevoked.defaultExpectation == nil {
+		mmIsTokenRevoked.defaultExpectation = &AuthRepositoryMockIsTokenRevokedExpectation{}
+	}
+
+	if mmIsTokenRevoked.defaultExpectation.params != nil {
+		mmIsTokenRevoked.mock.t.Fatalf("AuthRepositoryMock.IsTokenRevoked mock is already set by Expect")
+	}
+
+	if mmIsTokenRevoked.defaultExpectation.paramPtrs == nil {
+		mmIsTokenRevoked.defaultExpectation.paramPtrs = &AuthRepositoryMockIsTokenRevokedParamPtrs{}
+	}
+	mmIsTokenRevoked.defaultExpectation.paramPtrs.ctx = &ctx
+	mmIsTokenRevoked.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmIsTokenRevoked
+}
+
+// ExpectJtiParam2 sets up expected param jti for AuthRepository.IsTokenRevoked
+func (mmIsTokenRevoked *mAuthRepositoryMockIsTokenRevoked) ExpectJtiParam2(jti string) *mAuthRepositoryMockIsTokenRevoked {
+	if mmIsTokenRevoked.mock.funcIsTokenRevoked != nil {
+		mmIsTokenRevoked.mock.t.Fatalf("AuthRepositoryMock.IsTokenRevoked mock is already set by Set")
+	}
+
+	if mmIsTokenRevoked.defaultExpectation == nil {
+		mmIsTokenRevoked.defaultExpectation = &AuthRepositoryMockIsTokenRevokedExpectation{}
+	}
+
+	if mmIsTokenRevoked.defaultExpectation.params != nil {
+		mmIsTokenRevoked.mock.t.Fatalf("AuthRepositoryMock.IsTokenRevoked mock is already set by Expect")
+	}
+
+	if mmIsTokenRevoked.defaultExpectation.paramPtrs == nil {
+		mmIsTokenRevoked.defaultExpectation.paramPtrs = &AuthRepositoryMockIsTokenRevokedParamPtrs{}
+	}
+	mmIsTokenRevoked.defaultExpectation.paramPtrs.jti = &jti
+	mmIsTokenRevoked.defaultExpectation.expectationOrigins.originJti = minimock.CallerInfo(1)
+
+	return mmIsTokenRevoked
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuthRepository.IsTokenRevoked
+func (mmIsTokenRevoked *mAuthRepositoryMockIsTokenRevoked) Inspect(f func(ctx context.Context, jti string)) *mAuthRepositoryMockIsTokenRevoked {
+	if mmIsTokenRevoked.mock.inspectFuncIsTokenRevoked != nil {
+		mmIsTokenRevoked.mock.t.Fatalf("Inspect function is already set for AuthRepositoryMock.IsTokenRevoked")
+	}
+
+	mmIsTokenRevoked.mock.inspectFuncIsTokenRevoked = f
+
+	return mmIsTokenRevoked
+}
+
+// Return sets up results that will be returned by AuthRepository.IsTokenRevoked
+func (mmIsTokenRevoked *mAuthRepositoryMockIsTokenRevoked) Return(b1 bool, err error) *AuthRepositoryMock {
+	if mmIsTokenRevoked.mock.funcIsTokenRevoked != nil {
+		mmIsTokenRevoked.mock.t.Fatalf("AuthRepositoryMock.IsTokenRevoked mock is already set by Set")
+	}
+
+	if mmIsTokenRevoked.defaultExpectation == nil {
+		mmIsTokenRevoked.defaultExpectation = &AuthRepositoryMockIsTokenRevokedExpectation{mock: mmIsTokenRevoked.mock}
+	}
+	mmIsTokenRevoked.defaultExpectation.results = &AuthRepositoryMockIsTokenRevokedResults{b1, err}
+	mmIsTokenRevoked.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmIsTokenRevoked.mock
+}
+
+// Set uses given function f to mock the AuthRepository.IsTokenRevoked method
+func (mmIsTokenRevoked *mAuthRepositoryMockIsTokenRevoked) Set(f func(ctx context.Context, jti string) (b1 bool, err error)) *AuthRepositoryMock {
+	if mmIsTokenRevoked.defaultExpectation != nil {
+		mmIsTokenRevoked.mock.t.Fatalf("Default expectation is already set for the AuthRepository.IsTokenRevoked method")
+	}
+
+	if len(mmIsTokenRevoked.expectations) > 0 {
+		mmIsTokenRevoked.mock.t.Fatalf("Some expectations are already set for the AuthRepository.IsTokenRevoked method")
+	}
+
+	mmIsTokenRevoked.mock.funcIsTokenRevoked = f
+	mmIsTokenRevoked.mock.funcIsTokenRevokedOrigin = minimock.CallerInfo(1)
+	return mmIsTokenRevoked.mock
+}
+
+// When sets expectation for the AuthRepository.IsTokenRevoked which will trigger the result defined by the following
+// Then helper
+func (mmIsTokenRevoked *mAuthRepositoryMockIsTokenRevoked) When(ctx context.Context, jti string) *AuthRepositoryMockIsTokenRevokedExpectation {
+	if mmIsTokenRevoked.mock.funcIsTokenRevoked != nil {
+		mmIsTokenRevoked.mock.t.Fatalf("AuthRepositoryMock.IsTokenRevoked mock is already set by Set")
+	}
+
+	expectation := &AuthRepositoryMockIsTokenRevokedExpectation{
+		mock:               mmIsTokenRevoked.mock,
+		params:             &AuthRepositoryMockIsTokenRevokedParams{ctx, jti},
+		expectationOrigins: AuthRepositoryMockIsTokenRevokedExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmIsTokenRevoked.expectations = append(mmIsTokenRevoked.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuthRepository.IsTokenRevoked return parameters for the expectation previously defined by the When method
+func (e *AuthRepositoryMockIsTokenRevokedExpectation) Then(b1 bool, err error) *AuthRepositoryMock {
+	e.results = &AuthRepositoryMockIsTokenRevokedResults{b1, err}
+	return e.mock
+}
+
+// Times sets number of times AuthRepository.IsTokenRevoked should be invoked
+func (mmIsTokenRevoked *mAuthRepositoryMockIsTokenRevoked) Times(n uint64) *mAuthRepositoryMockIsTokenRevoked {
+	if n == 0 {
+		mmIsTokenRevoked.mock.t.Fatalf("Times of AuthRepositoryMock.IsTokenRevoked mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmIsTokenRevoked.expectedInvocations, n)
+	mmIsTokenRevoked.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmIsTokenRevoked
+}
+
+func (mmIsTokenRevoked *mAuthRepositoryMockIsTokenRevoked) invocationsDone() bool {
+	if len(mmIsTokenRevoked.expectations) == 0 && mmIsTokenRevoked.defaultExpectation == nil && mmIsTokenRevoked.mock.funcIsTokenRevoked == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmIsTokenRevoked.mock.afterIsTokenRevokedCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmIsTokenRevoked.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// IsTokenRevoked implements AuthRepository
+func (mmIsTokenRevoked *AuthRepositoryMock) IsTokenRevoked(ctx context.Context, jti string) (b1 bool, err error) {
+	mm_atomic.AddUint64(&mmIsTokenRevoked.beforeIsTokenRevokedCounter, 1)
+	defer mm_atomic.AddUint64(&mmIsTokenRevoked.afterIsTokenRevokedCounter, 1)
+
+	mmIsTokenRevoked.t.Helper()
+
+	if mmIsTokenRevoked.inspectFuncIsTokenRevoked != nil {
+		mmIsTokenRevoked.inspectFuncIsTokenRevoked(ctx, jti)
+	}
 
-	return mmFindByEmail
+	mm_params := AuthRepositoryMockIsTokenRevokedParams{ctx, jti}
+
+	// Record call args
+	mmIsTokenRevoked.IsTokenRevokedMock.mutex.Lock()
+	mmIsTokenRevoked.IsTokenRevokedMock.callArgs = append(mmIsTokenRevoked.IsTokenRevokedMock.callArgs, &mm_params)
+	mmIsTokenRevoked.IsTokenRevokedMock.mutex.Unlock()
+
+	for _, e := range mmIsTokenRevoked.IsTokenRevokedMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.b1, e.results.err
+		}
+	}
+
+	if mmIsTokenRevoked.IsTokenRevokedMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmIsTokenRevoked.IsTokenRevokedMock.defaultExpectation.Counter, 1)
+		mm_want := mmIsTokenRevoked.IsTokenRevokedMock.defaultExpectation.params
+		mm_want_ptrs := mmIsTokenRevoked.IsTokenRevokedMock.defaultExpectation.paramPtrs
+
+		mm_got := AuthRepositoryMockIsTokenRevokedParams{ctx, jti}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmIsTokenRevoked.t.Errorf("AuthRepositoryMock.IsTokenRevoked got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmIsTokenRevoked.IsTokenRevokedMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.jti != nil && !minimock.Equal(*mm_want_ptrs.jti, mm_got.jti) {
+				mmIsTokenRevoked.t.Errorf("AuthRepositoryMock.IsTokenRevoked got unexpected parameter jti, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmIsTokenRevoked.IsTokenRevokedMock.defaultExpectation.expectationOrigins.originJti, *mm_want_ptrs.jti, mm_got.jti, minimock.Diff(*mm_want_ptrs.jti, mm_got.jti))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmIsTokenRevoked.t.Errorf("AuthRepositoryMock.IsTokenRevoked got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmIsTokenRevoked.IsTokenRevokedMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmIsTokenRevoked.IsTokenRevokedMock.defaultExpectation.results
+		if mm_results == nil {
+			mmIsTokenRevoked.t.Fatal("No results are set for the AuthRepositoryMock.IsTokenRevoked")
+		}
+		return (*mm_results).b1, (*mm_results).err
+	}
+	if mmIsTokenRevoked.funcIsTokenRevoked != nil {
+		return mmIsTokenRevoked.funcIsTokenRevoked(ctx, jti)
+	}
+	mmIsTokenRevoked.t.Fatalf("Unexpected call to AuthRepositoryMock.IsTokenRevoked. %v %v", ctx, jti)
+	return
 }
 
-// Inspect accepts an inspector function that has same arguments as the AuthRepository.FindByEmail
-func (mmFindByEmail *mAuthRepositoryMockFindByEmail) Inspect(f func(ctx context.Context, email string)) *mAuthRepositoryMockFindByEmail {
-	if mmFindByEmail.mock.inspectFuncFindByEmail != nil {
-		mmFindByEmail.mock.t.Fatalf("Inspect function is already set for AuthRepositoryMock.FindByEmail")
+// IsTokenRevokedAfterCounter returns a count of finished AuthRepositoryMock.IsTokenRevoked invocations
+func (mmIsTokenRevoked *AuthRepositoryMock) IsTokenRevokedAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmIsTokenRevoked.afterIsTokenRevokedCounter)
+}
+
+// IsTokenRevokedBeforeCounter returns a count of AuthRepositoryMock.IsTokenRevoked invocations
+func (mmIsTokenRevoked *AuthRepositoryMock) IsTokenRevokedBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmIsTokenRevoked.beforeIsTokenRevokedCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuthRepositoryMock.IsTokenRevoked.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmIsTokenRevoked *mAuthRepositoryMockIsTokenRevoked) Calls() []*AuthRepositoryMockIsTokenRevokedParams {
+	mmIsTokenRevoked.mutex.RLock()
+
+	argCopy := make([]*AuthRepositoryMockIsTokenRevokedParams, len(mmIsTokenRevoked.callArgs))
+	copy(argCopy, mmIsTokenRevoked.callArgs)
+
+	mmIsTokenRevoked.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockIsTokenRevokedDone returns true if the count of the IsTokenRevoked invocations corresponds
+// the number of defined expectations
+func (m *AuthRepositoryMock) MinimockIsTokenRevokedDone() bool {
+	if m.IsTokenRevokedMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
 	}
 
-	mmFindByEmail.mock.inspectFuncFindByEmail = f
+	for _, e := range m.IsTokenRevokedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
 
-	return mmFindByEmail
+	return m.IsTokenRevokedMock.invocationsDone()
 }
 
-// Return sets up results that will be returned by AuthRepository.FindByEmail
-func (mmFindByEmail *mAuthRepositoryMockFindByEmail) Return(up1 *models.User, err error) *AuthRepositoryMock {
-	if mmFindByEmail.mock.funcFindByEmail != nil {
-		mmFindByEmail.mock.t.Fatalf("AuthRepositoryMock.FindByEmail mock is already set by Set")
+// MinimockIsTokenRevokedInspect logs each unmet expectation
+func (m *AuthRepositoryMock) MinimockIsTokenRevokedInspect() {
+	for _, e := range m.IsTokenRevokedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuthRepositoryMock.IsTokenRevoked at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
 	}
 
-	if mmFindByEmail.defaultExpectation == nil {
-		mmFindByEmail.defaultExpectation = &AuthRepositoryMockFindByEmailExpectation{mock: mmFindByEmail.mock}
+	afterIsTokenRevokedCounter := mm_atomic.LoadUint64(&m.afterIsTokenRevokedCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.IsTokenRevokedMock.defaultExpectation != nil && afterIsTokenRevokedCounter < 1 {
+		if m.IsTokenRevokedMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuthRepositoryMock.IsTokenRevoked at\n%s", m.IsTokenRevokedMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AuthRepositoryMock.IsTokenRevoked at\n%s with params: %#v", m.IsTokenRevokedMock.defaultExpectation.expectationOrigins.origin, *m.IsTokenRevokedMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcIsTokenRevoked != nil && afterIsTokenRevokedCounter < 1 {
+		m.t.Errorf("Expected call to AuthRepositoryMock.IsTokenRevoked at\n%s", m.funcIsTokenRevokedOrigin)
+	}
+
+	if !m.IsTokenRevokedMock.invocationsDone() && afterIsTokenRevokedCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuthRepositoryMock.IsTokenRevoked at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.IsTokenRevokedMock.expectedInvocations), m.IsTokenRevokedMock.expectedInvocationsOrigin, afterIsTokenRevokedCounter)
 	}
-	mmFindByEmail.defaultExpectation.results = &AuthRepositoryMockFindByEmailResults{up1, err}
-	mmFindByEmail.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmFindByEmail.mock
 }
 
-// Set uses given function f to mock the AuthRepository.FindByEmail method
-func (mmFindByEmail *mAuthRepositoryMockFindByEmail) Set(f func(ctx context.Context, email string) (up1 *models.User, err error)) *AuthRepositoryMock {
-	if mmFindByEmail.defaultExpectation != nil {
-		mmFindByEmail.mock.t.Fatalf("Default expectation is already set for the AuthRepository.FindByEmail method")
+type mAuthRepositoryMockTouchSession struct {
+	optional           bool
+	mock               *AuthRepositoryMock
+	defaultExpectation *AuthRepositoryMockTouchSessionExpectation
+	expectations       []*AuthRepositoryMockTouchSessionExpectation
+
+	callArgs []*AuthRepositoryMockTouchSessionParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AuthRepositoryMockTouchSessionExpectation specifies expectation struct of the AuthRepository.TouchSession
+type AuthRepositoryMockTouchSessionExpectation struct {
+	mock               *AuthRepositoryMock
+	params             *AuthRepositoryMockTouchSessionParams
+	paramPtrs          *AuthRepositoryMockTouchSessionParamPtrs
+	expectationOrigins AuthRepositoryMockTouchSessionExpectationOrigins
+	results            *AuthRepositoryMockTouchSessionResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AuthRepositoryMockTouchSessionParams contains parameters of the AuthRepository.TouchSession
+type AuthRepositoryMockTouchSessionParams struct {
+	ctx       context.Context
+	sessionID string
+	seenAt    time.Time
+}
+
+// AuthRepositoryMockTouchSessionParamPtrs contains pointers to parameters of the AuthRepository.TouchSession
+type AuthRepositoryMockTouchSessionParamPtrs struct {
+	ctx       *context.Context
+	sessionID *string
+	seenAt    *time.Time
+}
+
+// AuthRepositoryMockTouchSessionResults contains results of the AuthRepository.TouchSession
+type AuthRepositoryMockTouchSessionResults struct {
+	err error
+}
+
+// AuthRepositoryMockTouchSessionOrigins contains origins of expectations of the AuthRepository.TouchSession
+type AuthRepositoryMockTouchSessionExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originSessionID string
+	originSeenAt    string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmTouchSession *mAuthRepositoryMockTouchSession) Optional() *mAuthRepositoryMockTouchSession {
+	mmTouchSession.optional = true
+	return mmTouchSession
+}
+
+// Expect sets up expected params for AuthRepository.TouchSession
+func (mmTouchSession *mAuthRepositoryMockTouchSession) Expect(ctx context.Context, sessionID string, seenAt time.Time) *mAuthRepositoryMockTouchSession {
+	if mmTouchSession.mock.funcTouchSession != nil {
+		mmTouchSession.mock.t.Fatalf("AuthRepositoryMock.TouchSession mock is already set by Set")
 	}
 
-	if len(mmFindByEmail.expectations) > 0 {
-		mmFindByEmail.mock.t.Fatalf("Some expectations are already set for the AuthRepository.FindByEmail method")
+	if mmTouchSession.defaultExpectation == nil {
+		mmTouchSession.defaultExpectation = &AuthRepositoryMockTouchSessionExpectation{}
 	}
 
-	mmFindByEmail.mock.funcFindByEmail = f
-	mmFindByEmail.mock.funcFindByEmailOrigin = minimock.CallerInfo(1)
-	return mmFindByEmail.mock
+	if mmTouchSession.defaultExpectation.paramPtrs != nil {
+		mmTouchSession.mock.t.Fatalf("AuthRepositoryMock.TouchSession mock is already set by ExpectParams functions")
+	}
+
+	mmTouchSession.defaultExpectation.params = &AuthRepositoryMockTouchSessionParams{ctx, sessionID, seenAt}
+	mmTouchSession.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmTouchSession.expectations {
+		if minimock.Equal(e.params, mmTouchSession.defaultExpectation.params) {
+			mmTouchSession.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmTouchSession.defaultExpectation.params)
+		}
+	}
+
+	return mmTouchSession
 }
 
-// When sets expectation for the AuthRepository.FindByEmail which will trigger the result defined by the following
+// ExpectCtxParam1 sets up expected param ctx for AuthRepository.TouchSession
+func (mmTouchSession *mAuthRepositoryMockTouchSession) ExpectCtxParam1(ctx context.Context) *mAuthRepositoryMockTouchSession {
+	if mmTouchSession.mock.funcTouchSession != nil {
+		mmTouchSession.mock.t.Fatalf("AuthRepositoryMock.TouchSession mock is already set by Set")
+	}
+
+	if mmTouchSession.defaultExpectation == nil {
+		mmTouchSession.defaultExpectation = &AuthRepositoryMockTouchSessionExpectation{}
+	}
+
+	if mmTouchSession.defaultExpectation.params != nil {
+		mmTouchSession.mock.t.Fatalf("AuthRepositoryMock.TouchSession mock is already set by Expect")
+	}
+
+	if mmTouchSession.defaultExpectation.paramPtrs == nil {
+		mmTouchSession.defaultExpectation.paramPtrs = &AuthRepositoryMockTouchSessionParamPtrs{}
+	}
+	mmTouchSession.defaultExpectation.paramPtrs.ctx = &ctx
+	mmTouchSession.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmTouchSession
+}
+
+// ExpectSessionIDParam2 sets up expected param sessionID for AuthRepository.TouchSession
+func (mmTouchSession *mAuthRepositoryMockTouchSession) ExpectSessionIDParam2(sessionID string) *mAuthRepositoryMockTouchSession {
+	if mmTouchSession.mock.funcTouchSession != nil {
+		mmTouchSession.mock.t.Fatalf("AuthRepositoryMock.TouchSession mock is already set by Set")
+	}
+
+	if mmTouchSession.defaultExpectation == nil {
+		mmTouchSession.defaultExpectation = &AuthRepositoryMockTouchSessionExpectation{}
+	}
+
+	if mmTouchSession.defaultExpectation.params != nil {
+		mmTouchSession.mock.t.Fatalf("AuthRepositoryMock.TouchSession mock is already set by Expect")
+	}
+
+	if mmTouchSession.defaultExpectation.paramPtrs == nil {
+		mmTouchSession.defaultExpectation.paramPtrs = &AuthRepositoryMockTouchSessionParamPtrs{}
+	}
+	mmTouchSession.defaultExpectation.paramPtrs.sessionID = &sessionID
+	mmTouchSession.defaultExpectation.expectationOrigins.originSessionID = minimock.CallerInfo(1)
+
+	return mmTouchSession
+}
+
+// ExpectSeenAtParam3 sets up expected param seenAt for AuthRepository.TouchSession
+func (mmTouchSession *mAuthRepositoryMockTouchSession) ExpectSeenAtParam3(seenAt time.Time) *mAuthRepositoryMockTouchSession {
+	if mmTouchSession.mock.funcTouchSession != nil {
+		mmTouchSession.mock.t.Fatalf("AuthRepositoryMock.TouchSession mock is already set by Set")
+	}
+
+	if mmTouchSession.defaultExpectation == nil {
+		mmTouchSession.defaultExpectation = &AuthRepositoryMockTouchSessionExpectation{}
+	}
+
+	if mmTouchSession.defaultExpectation.params != nil {
+		mmTouchSession.mock.t.Fatalf("AuthRepositoryMock.TouchSession mock is already set by Expect")
+	}
+
+	if mmTouchSession.defaultExpectation.paramPtrs == nil {
+		mmTouchSession.defaultExpectation.paramPtrs = &AuthRepositoryMockTouchSessionParamPtrs{}
+	}
+	mmTouchSession.defaultExpectation.paramPtrs.seenAt = &seenAt
+	mmTouchSession.defaultExpectation.expectationOrigins.originSeenAt = minimock.CallerInfo(1)
+
+	return mmTouchSession
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuthRepository.TouchSession
+func (mmTouchSession *mAuthRepositoryMockTouchSession) Inspect(f func(ctx context.Context, sessionID string, seenAt time.Time)) *mAuthRepositoryMockTouchSession {
+	if mmTouchSession.mock.inspectFuncTouchSession != nil {
+		mmTouchSession.mock.t.Fatalf("Inspect function is already set for AuthRepositoryMock.TouchSession")
+	}
+
+	mmTouchSession.mock.inspectFuncTouchSession = f
+
+	return mmTouchSession
+}
+
+// Return sets up results that will be returned by AuthRepository.TouchSession
+func (mmTouchSession *mAuthRepositoryMockTouchSession) Return(err error) *AuthRepositoryMock {
+	if mmTouchSession.mock.funcTouchSession != nil {
+		mmTouchSession.mock.t.Fatalf("AuthRepositoryMock.TouchSession mock is already set by Set")
+	}
+
+	if mmTouchSession.defaultExpectation == nil {
+		mmTouchSession.defaultExpectation = &AuthRepositoryMockTouchSessionExpectation{mock: mmTouchSession.mock}
+	}
+	mmTouchSession.defaultExpectation.results = &AuthRepositoryMockTouchSessionResults{err}
+	mmTouchSession.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmTouchSession.mock
+}
+
+// Set uses given function f to mock the AuthRepository.TouchSession method
+func (mmTouchSession *mAuthRepositoryMockTouchSession) Set(f func(ctx context.Context, sessionID string, seenAt time.Time) (err error)) *AuthRepositoryMock {
+	if mmTouchSession.defaultExpectation != nil {
+		mmTouchSession.mock.t.Fatalf("Default expectation is already set for the AuthRepository.TouchSession method")
+	}
+
+	if len(mmTouchSession.expectations) > 0 {
+		mmTouchSession.mock.t.Fatalf("Some expectations are already set for the AuthRepository.TouchSession method")
+	}
+
+	mmTouchSession.mock.funcTouchSession = f
+	mmTouchSession.mock.funcTouchSessionOrigin = minimock.CallerInfo(1)
+	return mmTouchSession.mock
+}
+
+// When sets expectation for the AuthRepository.TouchSession which will trigger the result defined by the following
 // Then helper
-func (mmFindByEmail *mAuthRepositoryMockFindByEmail) When(ctx context.Context, email string) *AuthRepositoryMockFindByEmailExpectation {
-	if mmFindByEmail.mock.funcFindByEmail != nil {
-		mmFindByEmail.mock.t.Fatalf("AuthRepositoryMock.FindByEmail mock is already set by Set")
+func (mmTouchSession *mAuthRepositoryMockTouchSession) When(ctx context.Context, sessionID string, seenAt time.Time) *AuthRepositoryMockTouchSessionExpectation {
+	if mmTouchSession.mock.funcTouchSession != nil {
+		mmTouchSession.mock.t.Fatalf("AuthRepositoryMock.TouchSession mock is already set by Set")
 	}
 
-	expectation := &AuthRepositoryMockFindByEmailExpectation{
-		mock:               mmFindByEmail.mock,
-		params:             &AuthRepositoryMockFindByEmailParams{ctx, email},
-		expectationOrigins: AuthRepositoryMockFindByEmailExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &AuthRepositoryMockTouchSessionExpectation{
+		mock:               mmTouchSession.mock,
+		params:             &AuthRepositoryMockTouchSessionParams{ctx, sessionID, seenAt},
+		expectationOrigins: AuthRepositoryMockTouchSessionExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmFindByEmail.expectations = append(mmFindByEmail.expectations, expectation)
+	mmTouchSession.expectations = append(mmTouchSession.expectations, expectation)
 	return expectation
 }
 
-// Then sets up AuthRepository.FindByEmail return parameters for the expectation previously defined by the When method
-func (e *AuthRepositoryMockFindByEmailExpectation) Then(up1 *models.User, err error) *AuthRepositoryMock {
-	e.results = &AuthRepositoryMockFindByEmailResults{up1, err}
+// Then sets up AuthRepository.TouchSession return parameters for the expectation previously defined by the When method
+func (e *AuthRepositoryMockTouchSessionExpectation) Then(err error) *AuthRepositoryMock {
+	e.results = &AuthRepositoryMockTouchSessionResults{err}
 	return e.mock
 }
 
-// Times sets number of times AuthRepository.FindByEmail should be invoked
-func (mmFindByEmail *mAuthRepositoryMockFindByEmail) Times(n uint64) *mAuthRepositoryMockFindByEmail {
+// Times sets number of times AuthRepository.TouchSession should be invoked
+func (mmTouchSession *mAuthRepositoryMockTouchSession) Times(n uint64) *mAuthRepositoryMockTouchSession {
 	if n == 0 {
-		mmFindByEmail.mock.t.Fatalf("Times of AuthRepositoryMock.FindByEmail mock can not be zero")
+		mmTouchSession.mock.t.Fatalf("Times of AuthRepositoryMock.TouchSession mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmFindByEmail.expectedInvocations, n)
-	mmFindByEmail.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmFindByEmail
+	mm_atomic.StoreUint64(&mmTouchSession.expectedInvocations, n)
+	mmTouchSession.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmTouchSession
 }
 
-func (mmFindByEmail *mAuthRepositoryMockFindByEmail) invocationsDone() bool {
-	if len(mmFindByEmail.expectations) == 0 && mmFindByEmail.defaultExpectation == nil && mmFindByEmail.mock.funcFindByEmail == nil {
+func (mmTouchSession *mAuthRepositoryMockTouchSession) invocationsDone() bool {
+	if len(mmTouchSession.expectations) == 0 && mmTouchSession.defaultExpectation == nil && mmTouchSession.mock.funcTouchSession == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmFindByEmail.mock.afterFindByEmailCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmFindByEmail.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmTouchSession.mock.afterTouchSessionCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmTouchSession.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// FindByEmail implements AuthRepository
-func (mmFindByEmail *AuthRepositoryMock) FindByEmail(ctx context.Context, email string) (up1 *models.User, err error) {
-	mm_atomic.AddUint64(&mmFindByEmail.beforeFindByEmailCounter, 1)
-	defer mm_atomic.AddUint64(&mmFindByEmail.afterFindByEmailCounter, 1)
+// TouchSession implements AuthRepository
+func (mmTouchSession *AuthRepositoryMock) TouchSession(ctx context.Context, sessionID string, seenAt time.Time) (err error) {
+	mm_atomic.AddUint64(&mmTouchSession.beforeTouchSessionCounter, 1)
+	defer mm_atomic.AddUint64(&mmTouchSession.afterTouchSessionCounter, 1)
 
-	mmFindByEmail.t.Helper()
+	mmTouchSession.t.Helper()
 
-	if mmFindByEmail.inspectFuncFindByEmail != nil {
-		mmFindByEmail.inspectFuncFindByEmail(ctx, email)
+	if mmTouchSession.inspectFuncTouchSession != nil {
+		mmTouchSession.inspectFuncTouchSession(ctx, sessionID, seenAt)
 	}
 
-	mm_params := AuthRepositoryMockFindByEmailParams{ctx, email}
+	mm_params := AuthRepositoryMockTouchSessionParams{ctx, sessionID, seenAt}
 
 	// Record call args
-	mmFindByEmail.FindByEmailMock.mutex.Lock()
-	mmFindByEmail.FindByEmailMock.callArgs = append(mmFindByEmail.FindByEmailMock.callArgs, &mm_params)
-	mmFindByEmail.FindByEmailMock.mutex.Unlock()
+	mmTouchSession.TouchSessionMock.mutex.Lock()
+	mmTouchSession.TouchSessionMock.callArgs = append(mmTouchSession.TouchSessionMock.callArgs, &mm_params)
+	mmTouchSession.TouchSessionMock.mutex.Unlock()
 
-	for _, e := range mmFindByEmail.FindByEmailMock.expectations {
+	for _, e := range mmTouchSession.TouchSessionMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.up1, e.results.err
+			return e.results.err
 		}
 	}
 
-	if mmFindByEmail.FindByEmailMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmFindByEmail.FindByEmailMock.defaultExpectation.Counter, 1)
-		mm_want := mmFindByEmail.FindByEmailMock.defaultExpectation.params
-		mm_want_ptrs := mmFindByEmail.FindByEmailMock.defaultExpectation.paramPtrs
+	if mmTouchSession.TouchSessionMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmTouchSession.TouchSessionMock.defaultExpectation.Counter, 1)
+		mm_want := mmTouchSession.TouchSessionMock.defaultExpectation.params
+		mm_want_ptrs := mmTouchSession.TouchSessionMock.defaultExpectation.paramPtrs
 
-		mm_got := AuthRepositoryMockFindByEmailParams{ctx, email}
+		mm_got := AuthRepositoryMockTouchSessionParams{ctx, sessionID, seenAt}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmFindByEmail.t.Errorf("AuthRepositoryMock.FindByEmail got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmFindByEmail.FindByEmailMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmTouchSession.t.Errorf("AuthRepositoryMock.TouchSession got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmTouchSession.TouchSessionMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.email != nil && !minimock.Equal(*mm_want_ptrs.email, mm_got.email) {
-				mmFindByEmail.t.Errorf("AuthRepositoryMock.FindByEmail got unexpected parameter email, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmFindByEmail.FindByEmailMock.defaultExpectation.expectationOrigins.originEmail, *mm_want_ptrs.email, mm_got.email, minimock.Diff(*mm_want_ptrs.email, mm_got.email))
+			if mm_want_ptrs.sessionID != nil && !minimock.Equal(*mm_want_ptrs.sessionID, mm_got.sessionID) {
+				mmTouchSession.t.Errorf("AuthRepositoryMock.TouchSession got unexpected parameter sessionID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmTouchSession.TouchSessionMock.defaultExpectation.expectationOrigins.originSessionID, *mm_want_ptrs.sessionID, mm_got.sessionID, minimock.Diff(*mm_want_ptrs.sessionID, mm_got.sessionID))
+			}
+
+			if mm_want_ptrs.seenAt != nil && !minimock.Equal(*mm_want_ptrs.seenAt, mm_got.seenAt) {
+				mmTouchSession.t.Errorf("AuthRepositoryMock.TouchSession got unexpected parameter seenAt, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmTouchSession.TouchSessionMock.defaultExpectation.expectationOrigins.originSeenAt, *mm_want_ptrs.seenAt, mm_got.seenAt, minimock.Diff(*mm_want_ptrs.seenAt, mm_got.seenAt))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmFindByEmail.t.Errorf("AuthRepositoryMock.FindByEmail got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmFindByEmail.FindByEmailMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmTouchSession.t.Errorf("AuthRepositoryMock.TouchSession got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmTouchSession.TouchSessionMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmFindByEmail.FindByEmailMock.defaultExpectation.results
+		mm_results := mmTouchSession.TouchSessionMock.defaultExpectation.results
 		if mm_results == nil {
-			mmFindByEmail.t.Fatal("No results are set for the AuthRepositoryMock.FindByEmail")
+			mmTouchSession.t.Fatal("No results are set for the AuthRepositoryMock.TouchSession")
 		}
-		return (*mm_results).up1, (*mm_results).err
+		return (*mm_results).err
 	}
-	if mmFindByEmail.funcFindByEmail != nil {
-		return mmFindByEmail.funcFindByEmail(ctx, email)
+	if mmTouchSession.funcTouchSession != nil {
+		return mmTouchSession.funcTouchSession(ctx, sessionID, seenAt)
 	}
-	mmFindByEmail.t.Fatalf("Unexpected call to AuthRepositoryMock.FindByEmail. %v %v", ctx, email)
+	mmTouchSession.t.Fatalf("Unexpected call to AuthRepositoryMock.TouchSession. %v %v %v", ctx, sessionID, seenAt)
 	return
 }
 
-// FindByEmailAfterCounter returns a count of finished AuthRepositoryMock.FindByEmail invocations
-func (mmFindByEmail *AuthRepositoryMock) FindByEmailAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmFindByEmail.afterFindByEmailCounter)
+// TouchSessionAfterCounter returns a count of finished AuthRepositoryMock.TouchSession invocations
+func (mmTouchSession *AuthRepositoryMock) TouchSessionAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmTouchSession.afterTouchSessionCounter)
 }
 
-// FindByEmailBeforeCounter returns a count of AuthRepositoryMock.FindByEmail invocations
-func (mmFindByEmail *AuthRepositoryMock) FindByEmailBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmFindByEmail.beforeFindByEmailCounter)
+// TouchSessionBeforeCounter returns a count of AuthRepositoryMock.TouchSession invocations
+func (mmTouchSession *AuthRepositoryMock) TouchSessionBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmTouchSession.beforeTouchSessionCounter)
 }
 
-// Calls returns a list of arguments used in each call to AuthRepositoryMock.FindByEmail.
+// Calls returns a list of arguments used in each call to AuthRepositoryMock.TouchSession.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmFindByEmail *mAuthRepositoryMockFindByEmail) Calls() []*AuthRepositoryMockFindByEmailParams {
-	mmFindByEmail.mutex.RLock()
+func (mmTouchSession *mAuthRepositoryMockTouchSession) Calls() []*AuthRepositoryMockTouchSessionParams {
+	mmTouchSession.mutex.RLock()
 
-	argCopy := make([]*AuthRepositoryMockFindByEmailParams, len(mmFindByEmail.callArgs))
-	copy(argCopy, mmFindByEmail.callArgs)
+	argCopy := make([]*AuthRepositoryMockTouchSessionParams, len(mmTouchSession.callArgs))
+	copy(argCopy, mmTouchSession.callArgs)
 
-	mmFindByEmail.mutex.RUnlock()
+	mmTouchSession.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockFindByEmailDone returns true if the count of the FindByEmail invocations corresponds
+// MinimockTouchSessionDone returns true if the count of the TouchSession invocations corresponds
 // the number of defined expectations
-func (m *AuthRepositoryMock) MinimockFindByEmailDone() bool {
-	if m.FindByEmailMock.optional {
+func (m *AuthRepositoryMock) MinimockTouchSessionDone() bool {
+	if m.TouchSessionMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.FindByEmailMock.expectations {
+	for _, e := range m.TouchSessionMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.FindByEmailMock.invocationsDone()
+	return m.TouchSessionMock.invocationsDone()
 }
 
-// MinimockFindByEmailInspect logs each unmet expectation
-func (m *AuthRepositoryMock) MinimockFindByEmailInspect() {
-	for _, e := range m.FindByEmailMock.expectations {
+// MinimockTouchSessionInspect logs each unmet expectation
+func (m *AuthRepositoryMock) MinimockTouchSessionInspect() {
+	for _, e := range m.TouchSessionMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to AuthRepositoryMock.FindByEmail at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to AuthRepositoryMock.TouchSession at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterFindByEmailCounter := mm_atomic.LoadUint64(&m.afterFindByEmailCounter)
+	afterTouchSessionCounter := mm_atomic.LoadUint64(&m.afterTouchSessionCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.FindByEmailMock.defaultExpectation != nil && afterFindByEmailCounter < 1 {
-		if m.FindByEmailMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to AuthRepositoryMock.FindByEmail at\n%s", m.FindByEmailMock.defaultExpectation.returnOrigin)
+	if m.TouchSessionMock.defaultExpectation != nil && afterTouchSessionCounter < 1 {
+		if m.TouchSessionMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuthRepositoryMock.TouchSession at\n%s", m.TouchSessionMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to AuthRepositoryMock.FindByEmail at\n%s with params: %#v", m.FindByEmailMock.defaultExpectation.expectationOrigins.origin, *m.FindByEmailMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to AuthRepositoryMock.TouchSession at\n%s with params: %#v", m.TouchSessionMock.defaultExpectation.expectationOrigins.origin, *m.TouchSessionMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcFindByEmail != nil && afterFindByEmailCounter < 1 {
-		m.t.Errorf("Expected call to AuthRepositoryMock.FindByEmail at\n%s", m.funcFindByEmailOrigin)
+	if m.funcTouchSession != nil && afterTouchSessionCounter < 1 {
+		m.t.Errorf("Expected call to AuthRepositoryMock.TouchSession at\n%s", m.funcTouchSessionOrigin)
 	}
 
-	if !m.FindByEmailMock.invocationsDone() && afterFindByEmailCounter > 0 {
-		m.t.Errorf("Expected %d calls to AuthRepositoryMock.FindByEmail at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.FindByEmailMock.expectedInvocations), m.FindByEmailMock.expectedInvocationsOrigin, afterFindByEmailCounter)
+	if !m.TouchSessionMock.invocationsDone() && afterTouchSessionCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuthRepositoryMock.TouchSession at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.TouchSessionMock.expectedInvocations), m.TouchSessionMock.expectedInvocationsOrigin, afterTouchSessionCounter)
 	}
 }
 
@@ -743,9 +3242,23 @@ func (m *AuthRepositoryMock) MinimockFindByEmailInspect() {
 func (m *AuthRepositoryMock) MinimockFinish() {
 	m.finishOnce.Do(func() {
 		if !m.minimockDone() {
+			m.MinimockCreateOutboxEventInspect()
+
+			m.MinimockCreateSessionInspect()
+
 			m.MinimockCreateUserInspect()
 
 			m.MinimockFindByEmailInspect()
+
+			m.MinimockFindByIDInspect()
+
+			m.MinimockFindSessionByIDInspect()
+
+			m.MinimockInTxInspect()
+
+			m.MinimockIsTokenRevokedInspect()
+
+			m.MinimockTouchSessionInspect()
 		}
 	})
 }
@@ -769,6 +3282,13 @@ func (m *AuthRepositoryMock) MinimockWait(timeout mm_time.Duration) {
 func (m *AuthRepositoryMock) minimockDone() bool {
 	done := true
 	return done &&
+		m.MinimockCreateOutboxEventDone() &&
+		m.MinimockCreateSessionDone() &&
 		m.MinimockCreateUserDone() &&
-		m.MinimockFindByEmailDone()
+		m.MinimockFindByEmailDone() &&
+		m.MinimockFindByIDDone() &&
+		m.MinimockFindSessionByIDDone() &&
+		m.MinimockInTxDone() &&
+		m.MinimockIsTokenRevokedDone() &&
+		m.MinimockTouchSessionDone()
 }
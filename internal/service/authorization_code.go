@@ -0,0 +1,260 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/alonsoF100/authorization-service/internal/apperrors"
+	"github.com/alonsoF100/authorization-service/internal/models"
+)
+
+// FindClient returns apperrors.ErrInvalidClient for unknown clients
+func (s OAuthService) FindClient(ctx context.Context, clientID string) (*models.Client, error) {
+	const op = "service/authorization_code.go/FindClient"
+
+	client, err := s.oauthRepository.FindClientByID(ctx, clientID)
+	if err != nil {
+		slog.Error("Database error during client lookup",
+			slog.String("op", op),
+			slog.String("client_id", clientID),
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if client == nil {
+		return nil, apperrors.ErrInvalidClient
+	}
+
+	return client, nil
+}
+
+// HasConsent reports whether the user already granted every scope to the
+// client
+func (s OAuthService) HasConsent(ctx context.Context, userID, clientID string, scopes []string) (bool, error) {
+	const op = "service/authorization_code.go/HasConsent"
+
+	consent, err := s.oauthRepository.FindConsent(ctx, userID, clientID)
+	if err != nil {
+		slog.Error("Database error during consent lookup",
+			slog.String("op", op),
+			slog.String("user_id", userID),
+			slog.String("client_id", clientID),
+			slog.String("error", err.Error()),
+		)
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+	if consent == nil {
+		return false, nil
+	}
+
+	for _, scope := range scopes {
+		if !slices.Contains(consent.Scopes, scope) {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// GrantConsent adds scopes to the consent of the user for the client,
+// scopes granted before are kept
+func (s OAuthService) GrantConsent(ctx context.Context, userID, clientID string, scopes []string) error {
+	const op = "service/authorization_code.go/GrantConsent"
+
+	consent, err := s.oauthRepository.FindConsent(ctx, userID, clientID)
+	if err != nil {
+		slog.Error("Database error during consent lookup",
+			slog.String("op", op),
+			slog.String("user_id", userID),
+			slog.String("error", err.Error()),
+		)
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	granted := []string{}
+	if consent != nil {
+		granted = consent.Scopes
+	}
+	for _, scope := range scopes {
+		if !slices.Contains(granted, scope) {
+			granted = append(granted, scope)
+		}
+	}
+
+	err = s.oauthRepository.SaveConsent(ctx, &models.Consent{
+		UserID:    userID,
+		ClientID:  clientID,
+		Scopes:    granted,
+		GrantedAt: time.Now(),
+	})
+	if err != nil {
+		slog.Error("Database error during consent saving",
+			slog.String("op", op),
+			slog.String("user_id", userID),
+			slog.String("error", err.Error()),
+		)
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	slog.Info("Consent granted",
+		slog.String("op", op),
+		slog.String("user_id", userID),
+		slog.String("client_id", clientID),
+		slog.Any("scopes", scopes),
+	)
+
+	return nil
+}
+
+// IssueAuthorizationCode stores the request and returns a code valid for
+// oauth.code_ttl. Only the hash of the code is stored.
+func (s OAuthService) IssueAuthorizationCode(ctx context.Context, request *models.AuthorizationCode) (string, error) {
+	const op = "service/authorization_code.go/IssueAuthorizationCode"
+
+	code, err := randomToken(authorizationCodeSize)
+	if err != nil {
+		return "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	now := time.Now()
+	stored := *request
+	stored.CodeHash = hashSecret(code)
+	stored.CreatedAt = now
+	stored.ExpiresAt = now.Add(s.cfg.OAuth.CodeTTL)
+
+	if err := s.oauthRepository.CreateAuthorizationCode(ctx, &stored); err != nil {
+		slog.Error("Database error during authorization code issuing",
+			slog.String("op", op),
+			slog.String("client_id", stored.ClientID),
+			slog.String("user_id", stored.UserID),
+			slog.String("error", err.Error()),
+		)
+		return "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	slog.Debug("Authorization code issued",
+		slog.String("op", op),
+		slog.String("client_id", stored.ClientID),
+		slog.String("user_id", stored.UserID),
+	)
+
+	return code, nil
+}
+
+// ExchangeAuthorizationCode redeems a code for an access token. The code is
+// consumed before it is checked, so a failed attempt burns it too.
+func (s OAuthService) ExchangeAuthorizationCode(ctx context.Context, client *models.Client, code, redirectURI, codeVerifier string) (*models.Token, error) {
+	const op = "service/authorization_code.go/ExchangeAuthorizationCode"
+
+	if !client.AllowsGrant(models.GrantAuthorizationCode) {
+		return nil, apperrors.ErrUnauthorizedClient
+	}
+
+	stored, err := s.oauthRepository.ConsumeAuthorizationCode(ctx, hashSecret(code))
+	if err != nil {
+		slog.Error("Database error during code exchange",
+			slog.String("op", op),
+			slog.String("client_id", client.ID),
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var reason string
+	switch {
+	case stored == nil:
+		reason = "unknown or used code"
+	case time.Now().After(stored.ExpiresAt):
+		reason = "expired code"
+	case stored.ClientID != client.ID:
+		reason = "code issued to another client"
+	case stored.RedirectURI != redirectURI:
+		reason = "redirect uri mismatch"
+	case !verifyCodeChallenge(codeVerifier, stored.CodeChallenge):
+		reason = "code verifier mismatch"
+	}
+	if reason != "" {
+		slog.Info("Code exchange rejected",
+			slog.String("op", op),
+			slog.String("client_id", client.ID),
+			slog.String("reason", reason),
+		)
+		return nil, apperrors.ErrInvalidGrant
+	}
+
+	// the client may have lost a scope since the code was issued
+	for _, scope := range strings.Fields(stored.Scope) {
+		if !client.AllowsScope(scope) {
+			slog.Info("Code exchange rejected",
+				slog.String("op", op),
+				slog.String("client_id", client.ID),
+				slog.String("scope", scope),
+			)
+			return nil, apperrors.ErrInvalidScope
+		}
+	}
+
+	user, err := s.oauthRepository.FindByID(ctx, stored.UserID)
+	if err != nil {
+		slog.Error("Database error during code exchange",
+			slog.String("op", op),
+			slog.String("user_id", stored.UserID),
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if user == nil || user.Disabled() {
+		slog.Info("Code exchange rejected",
+			slog.String("op", op),
+			slog.String("client_id", client.ID),
+			slog.String("reason", "user missing or disabled"),
+		)
+		return nil, apperrors.ErrInvalidGrant
+	}
+
+	accessToken, err := s.tokenIssuer.GenerateScopedJWT(user, client.ID, stored.Scope, stored.SessionID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var idToken string
+	if slices.Contains(strings.Fields(stored.Scope), models.ScopeOpenID) {
+		idToken, err = s.tokenIssuer.GenerateIDToken(user, stored, accessToken)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	slog.Info("Authorization code exchanged",
+		slog.String("op", op),
+		slog.String("client_id", client.ID),
+		slog.String("user_id", user.ID),
+	)
+
+	return &models.Token{
+		AccessToken: accessToken,
+		TokenType:   "Bearer",
+		ExpiresIn:   s.cfg.JWT.Expiry,
+		Scope:       stored.Scope,
+		IDToken:     idToken,
+	}, nil
+}
+
+// verifyCodeChallenge checks a PKCE S256 verifier (RFC 7636 4.6)
+func verifyCodeChallenge(verifier, challenge string) bool {
+	if len(verifier) < 43 || len(verifier) > 128 {
+		return false
+	}
+
+	sum := sha256.Sum256([]byte(verifier))
+	expected := base64.RawURLEncoding.EncodeToString(sum[:])
+
+	return subtle.ConstantTimeCompare([]byte(expected), []byte(challenge)) == 1
+}
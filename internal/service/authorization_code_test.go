@@ -0,0 +1,213 @@
+package service_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alonsoF100/authorization-service/internal/apperrors"
+	"github.com/alonsoF100/authorization-service/internal/config"
+	"github.com/alonsoF100/authorization-service/internal/models"
+	"github.com/alonsoF100/authorization-service/internal/service"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+)
+
+func codeChallenge(verifier string) string {
+	sum := sha256.Sum256([]byte(verifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+func TestOAuthServiceConsent(t *testing.T) {
+	ctx := context.Background()
+	mc := minimock.NewController(t)
+	mockRepo := service.NewOAuthRepositoryMock(mc)
+
+	var stored *models.Consent
+	mockRepo.FindConsentMock.Set(func(ctx context.Context, userID, clientID string) (*models.Consent, error) {
+		return stored, nil
+	})
+	mockRepo.SaveConsentMock.Set(func(ctx context.Context, consent *models.Consent) error {
+		stored = consent
+		return nil
+	})
+
+	oauthService := service.NewOAuthService(mockRepo, nil, nil, nil, nil)
+
+	granted, err := oauthService.HasConsent(ctx, "user123", "client123", []string{"profile"})
+	require.NoError(t, err)
+	require.False(t, granted)
+
+	require.NoError(t, oauthService.GrantConsent(ctx, "user123", "client123", []string{"profile"}))
+	require.NoError(t, oauthService.GrantConsent(ctx, "user123", "client123", []string{"email", "profile"}))
+	require.Equal(t, []string{"profile", "email"}, stored.Scopes)
+
+	granted, err = oauthService.HasConsent(ctx, "user123", "client123", []string{"email", "profile"})
+	require.NoError(t, err)
+	require.True(t, granted)
+
+	granted, err = oauthService.HasConsent(ctx, "user123", "client123", []string{"admin"})
+	require.NoError(t, err)
+	require.False(t, granted)
+}
+
+func TestOAuthServiceAuthorizationCode(t *testing.T) {
+	ctx := context.Background()
+	cfg := &config.Config{
+		JWT:   config.JWTConfig{Expiry: time.Hour},
+		OAuth: config.OAuthConfig{CodeTTL: time.Minute},
+	}
+	verifier := strings.Repeat("v", 43)
+	client := &models.Client{
+		ID:           "client123",
+		RedirectURIs: []string{"https://app.example.com/callback"},
+		GrantTypes:   []string{models.GrantAuthorizationCode},
+	}
+	disabledAt := time.Now()
+
+	tests := []struct {
+		name        string
+		client      *models.Client
+		modify      func(code *models.AuthorizationCode)
+		user        *models.User
+		redirectURI string
+		verifier    string
+		expectedErr error
+	}{
+		{
+			name:        "valid exchange",
+			client:      client,
+			modify:      func(code *models.AuthorizationCode) {},
+			user:        &models.User{ID: "user123"},
+			redirectURI: "https://app.example.com/callback",
+			verifier:    verifier,
+		},
+		{
+			name:        "wrong verifier",
+			client:      client,
+			modify:      func(code *models.AuthorizationCode) {},
+			redirectURI: "https://app.example.com/callback",
+			verifier:    strings.Repeat("x", 43),
+			expectedErr: apperrors.ErrInvalidGrant,
+		},
+		{
+			name:        "short verifier",
+			client:      client,
+			modify:      func(code *models.AuthorizationCode) { code.CodeChallenge = codeChallenge("short") },
+			redirectURI: "https://app.example.com/callback",
+			verifier:    "short",
+			expectedErr: apperrors.ErrInvalidGrant,
+		},
+		{
+			name:        "redirect uri mismatch",
+			client:      client,
+			modify:      func(code *models.AuthorizationCode) {},
+			redirectURI: "https://evil.example.com/callback",
+			verifier:    verifier,
+			expectedErr: apperrors.ErrInvalidGrant,
+		},
+		{
+			name:        "code of another client",
+			client:      client,
+			modify:      func(code *models.AuthorizationCode) { code.ClientID = "other" },
+			redirectURI: "https://app.example.com/callback",
+			verifier:    verifier,
+			expectedErr: apperrors.ErrInvalidGrant,
+		},
+		{
+			name:        "expired code",
+			client:      client,
+			modify:      func(code *models.AuthorizationCode) { code.ExpiresAt = time.Now().Add(-time.Second) },
+			redirectURI: "https://app.example.com/callback",
+			verifier:    verifier,
+			expectedErr: apperrors.ErrInvalidGrant,
+		},
+		{
+			name:        "disabled user",
+			client:      client,
+			modify:      func(code *models.AuthorizationCode) {},
+			user:        &models.User{ID: "user123", DisabledAt: &disabledAt},
+			redirectURI: "https://app.example.com/callback",
+			verifier:    verifier,
+			expectedErr: apperrors.ErrInvalidGrant,
+		},
+		{
+			name:        "scope not allowed for the client",
+			client:      client,
+			modify:      func(code *models.AuthorizationCode) { code.Scope = "profile write" },
+			redirectURI: "https://app.example.com/callback",
+			verifier:    verifier,
+			expectedErr: apperrors.ErrInvalidScope,
+		},
+		{
+			name:        "grant not allowed",
+			client:      &models.Client{ID: "client123"},
+			redirectURI: "https://app.example.com/callback",
+			verifier:    verifier,
+			expectedErr: apperrors.ErrUnauthorizedClient,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := minimock.NewController(t)
+			mockRepo := service.NewOAuthRepositoryMock(mc)
+			mockIssuer := service.NewTokenIssuerMock(mc)
+
+			var stored *models.AuthorizationCode
+			mockRepo.CreateAuthorizationCodeMock.Set(func(ctx context.Context, code *models.AuthorizationCode) error {
+				stored = code
+				return nil
+			})
+			mockRepo.ConsumeAuthorizationCodeMock.Optional().Set(func(ctx context.Context, codeHash string) (*models.AuthorizationCode, error) {
+				if stored == nil || stored.CodeHash != codeHash {
+					return nil, nil
+				}
+				code := stored
+				stored = nil
+				tt.modify(code)
+				return code, nil
+			})
+			if tt.user != nil {
+				mockRepo.FindByIDMock.Expect(ctx, "user123").Return(tt.user, nil)
+			}
+			if tt.expectedErr == nil {
+				mockIssuer.GenerateScopedJWTMock.Expect(tt.user, "client123", "profile", "session123").Return("access", nil)
+			}
+
+			oauthService := service.NewOAuthService(mockRepo, nil, mockIssuer, nil, cfg)
+
+			code, err := oauthService.IssueAuthorizationCode(ctx, &models.AuthorizationCode{
+				ClientID:      "client123",
+				UserID:        "user123",
+				RedirectURI:   "https://app.example.com/callback",
+				Scope:         "profile",
+				CodeChallenge: codeChallenge(verifier),
+				SessionID:     "session123",
+			})
+			require.NoError(t, err)
+			require.NotEqual(t, code, stored.CodeHash)
+			require.WithinDuration(t, time.Now().Add(time.Minute), stored.ExpiresAt, time.Second)
+
+			token, err := oauthService.ExchangeAuthorizationCode(ctx, tt.client, code, tt.redirectURI, tt.verifier)
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+				require.Nil(t, token)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, "access", token.AccessToken)
+			require.Equal(t, "Bearer", token.TokenType)
+			require.Equal(t, time.Hour, token.ExpiresIn)
+			require.Equal(t, "profile", token.Scope)
+
+			// codes are single use
+			_, err = oauthService.ExchangeAuthorizationCode(ctx, tt.client, code, tt.redirectURI, tt.verifier)
+			require.ErrorIs(t, err, apperrors.ErrInvalidGrant)
+		})
+	}
+}
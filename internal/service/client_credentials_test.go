@@ -0,0 +1,111 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alonsoF100/authorization-service/internal/apperrors"
+	"github.com/alonsoF100/authorization-service/internal/config"
+	"github.com/alonsoF100/authorization-service/internal/models"
+	"github.com/alonsoF100/authorization-service/internal/service"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOAuthServiceClientCredentials(t *testing.T) {
+	ctx := context.Background()
+	cfg := &config.Config{JWT: config.JWTConfig{Expiry: time.Hour}}
+	client := &models.Client{
+		ID:         "client123",
+		GrantTypes: []string{models.GrantClientCredentials},
+		Scopes:     []string{"orders:read", "orders:write"},
+	}
+
+	tests := []struct {
+		name          string
+		client        *models.Client
+		scope         string
+		expectedScope string
+		expectedErr   error
+	}{
+		{
+			name:          "registered scopes by default",
+			client:        client,
+			expectedScope: "orders:read orders:write",
+		},
+		{
+			name:          "narrowed scope",
+			client:        client,
+			scope:         "orders:read",
+			expectedScope: "orders:read",
+		},
+		{
+			name:        "scope not granted",
+			client:      client,
+			scope:       "orders:read admin",
+			expectedErr: apperrors.ErrInvalidScope,
+		},
+		{
+			name:        "grant not allowed",
+			client:      &models.Client{ID: "client123", GrantTypes: []string{models.GrantAuthorizationCode}},
+			expectedErr: apperrors.ErrUnauthorizedClient,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := minimock.NewController(t)
+			mockIssuer := service.NewTokenIssuerMock(mc)
+			if tt.expectedErr == nil {
+				mockIssuer.GenerateClientJWTMock.Expect(tt.client, tt.expectedScope).Return("access", nil)
+			}
+
+			token, err := service.NewOAuthService(nil, nil, mockIssuer, nil, cfg).ClientCredentials(ctx, tt.client, tt.scope)
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+				require.Nil(t, token)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, &models.Token{
+				AccessToken: "access",
+				TokenType:   "Bearer",
+				ExpiresIn:   time.Hour,
+				Scope:       tt.expectedScope,
+			}, token)
+		})
+	}
+}
+
+func TestGenerateClientJWT(t *testing.T) {
+	cfg := &config.Config{
+		JWT: config.JWTConfig{
+			SecretKey: "someSecretsomeSecretsomeSecretsomeSecret",
+			Expiry:    time.Hour,
+		},
+	}
+	mockRepo := service.NewAuthRepositoryMock(minimock.NewController(t))
+	mockRepo.IsTokenRevokedMock.Return(false, nil)
+	authService := service.NewAuthService(mockRepo, nil, nil, cfg)
+
+	token, err := authService.GenerateClientJWT(&models.Client{ID: "client123"}, "orders:read")
+	require.NoError(t, err)
+
+	claims, err := authService.ValidateJWT(context.Background(), token)
+	require.NoError(t, err)
+	require.Equal(t, models.PrincipalClient, claims.Principal())
+	require.Equal(t, "client123", claims.Subject)
+	require.Equal(t, "client123", claims.ClientID)
+	require.Empty(t, claims.ID)
+	require.Equal(t, "orders:read", claims.Scope)
+
+	token, err = authService.GenerateJWT(&models.User{ID: "user123"})
+	require.NoError(t, err)
+
+	claims, err = authService.ValidateJWT(context.Background(), token)
+	require.NoError(t, err)
+	require.Equal(t, models.PrincipalUser, claims.Principal())
+	require.Equal(t, models.PrincipalUser, models.Claims{}.Principal())
+}
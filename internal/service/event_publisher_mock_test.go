@@ -0,0 +1,417 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package service
+
+//go:generate minimock -i github.com/alonsoF100/authorization-service/internal/service.EventPublisher -o event_publisher_mock_test.go -n EventPublisherMock -p service
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/alonsoF100/authorization-service/internal/models"
+	"github.com/gojuno/minimock/v3"
+)
+
+// EventPublisherMock implements EventPublisher
+type EventPublisherMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcPublish          func(ctx context.Context, event models.OutboxEvent) (err error)
+	funcPublishOrigin    string
+	inspectFuncPublish   func(ctx context.Context, event models.OutboxEvent)
+	afterPublishCounter  uint64
+	beforePublishCounter uint64
+	PublishMock          mEventPublisherMockPublish
+}
+
+// NewEventPublisherMock returns a mock for EventPublisher
+func NewEventPublisherMock(t minimock.Tester) *EventPublisherMock {
+	m := &EventPublisherMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.PublishMock = mEventPublisherMockPublish{mock: m}
+	m.PublishMock.callArgs = []*EventPublisherMockPublishParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mEventPublisherMockPublish struct {
+	optional           bool
+	mock               *EventPublisherMock
+	defaultExpectation *EventPublisherMockPublishExpectation
+	expectations       []*EventPublisherMockPublishExpectation
+
+	callArgs []*EventPublisherMockPublishParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// EventPublisherMockPublishExpectation specifies expectation struct of the EventPublisher.Publish
+type EventPublisherMockPublishExpectation struct {
+	mock               *EventPublisherMock
+	params             *EventPublisherMockPublishParams
+	paramPtrs          *EventPublisherMockPublishParamPtrs
+	expectationOrigins EventPublisherMockPublishExpectationOrigins
+	results            *EventPublisherMockPublishResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// EventPublisherMockPublishParams contains parameters of the EventPublisher.Publish
+type EventPublisherMockPublishParams struct {
+	ctx   context.Context
+	event models.OutboxEvent
+}
+
+// EventPublisherMockPublishParamPtrs contains pointers to parameters of the EventPublisher.Publish
+type EventPublisherMockPublishParamPtrs struct {
+	ctx   *context.Context
+	event *models.OutboxEvent
+}
+
+// EventPublisherMockPublishResults contains results of the EventPublisher.Publish
+type EventPublisherMockPublishResults struct {
+	err error
+}
+
+// EventPublisherMockPublishOrigins contains origins of expectations of the EventPublisher.Publish
+type EventPublisherMockPublishExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originEvent string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmPublish *mEventPublisherMockPublish) Optional() *mEventPublisherMockPublish {
+	mmPublish.optional = true
+	return mmPublish
+}
+
+// Expect sets up expected params for EventPublisher.Publish
+func (mmPublish *mEventPublisherMockPublish) Expect(ctx context.Context, event models.OutboxEvent) *mEventPublisherMockPublish {
+	if mmPublish.mock.funcPublish != nil {
+		mmPublish.mock.t.Fatalf("EventPublisherMock.Publish mock is already set by Set")
+	}
+
+	if mmPublish.defaultExpectation == nil {
+		mmPublish.defaultExpectation = &EventPublisherMockPublishExpectation{}
+	}
+
+	if mmPublish.defaultExpectation.paramPtrs != nil {
+		mmPublish.mock.t.Fatalf("EventPublisherMock.Publish mock is already set by ExpectParams functions")
+	}
+
+	mmPublish.defaultExpectation.params = &EventPublisherMockPublishParams{ctx, event}
+	mmPublish.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmPublish.expectations {
+		if minimock.Equal(e.params, mmPublish.defaultExpectation.params) {
+			mmPublish.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmPublish.defaultExpectation.params)
+		}
+	}
+
+	return mmPublish
+}
+
+// ExpectCtxParam1 sets up expected param ctx for EventPublisher.Publish
+func (mmPublish *mEventPublisherMockPublish) ExpectCtxParam1(ctx context.Context) *mEventPublisherMockPublish {
+	if mmPublish.mock.funcPublish != nil {
+		mmPublish.mock.t.Fatalf("EventPublisherMock.Publish mock is already set by Set")
+	}
+
+	if mmPublish.defaultExpectation == nil {
+		mmPublish.defaultExpectation = &EventPublisherMockPublishExpectation{}
+	}
+
+	if mmPublish.defaultExpectation.params != nil {
+		mmPublish.mock.t.Fatalf("EventPublisherMock.Publish mock is already set by Expect")
+	}
+
+	if mmPublish.defaultExpectation.paramPtrs == nil {
+		mmPublish.defaultExpectation.paramPtrs = &EventPublisherMockPublishParamPtrs{}
+	}
+	mmPublish.defaultExpectation.paramPtrs.ctx = &ctx
+	mmPublish.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmPublish
+}
+
+// ExpectEventParam2 sets up expected param event for EventPublisher.Publish
+func (mmPublish *mEventPublisherMockPublish) ExpectEventParam2(event models.OutboxEvent) *mEventPublisherMockPublish {
+	if mmPublish.mock.funcPublish != nil {
+		mmPublish.mock.t.Fatalf("EventPublisherMock.Publish mock is already set by Set")
+	}
+
+	if mmPublish.defaultExpectation == nil {
+		mmPublish.defaultExpectation = &EventPublisherMockPublishExpectation{}
+	}
+
+	if mmPublish.defaultExpectation.params != nil {
+		mmPublish.mock.t.Fatalf("EventPublisherMock.Publish mock is already set by Expect")
+	}
+
+	if mmPublish.defaultExpectation.paramPtrs == nil {
+		mmPublish.defaultExpectation.paramPtrs = &EventPublisherMockPublishParamPtrs{}
+	}
+	mmPublish.defaultExpectation.paramPtrs.event = &event
+	mmPublish.defaultExpectation.expectationOrigins.originEvent = minimock.CallerInfo(1)
+
+	return mmPublish
+}
+
+// Inspect accepts an inspector function that has same arguments as the EventPublisher.Publish
+func (mmPublish *mEventPublisherMockPublish) Inspect(f func(ctx context.Context, event models.OutboxEvent)) *mEventPublisherMockPublish {
+	if mmPublish.mock.inspectFuncPublish != nil {
+		mmPublish.mock.t.Fatalf("Inspect function is already set for EventPublisherMock.Publish")
+	}
+
+	mmPublish.mock.inspectFuncPublish = f
+
+	return mmPublish
+}
+
+// Return sets up results that will be returned by EventPublisher.Publish
+func (mmPublish *mEventPublisherMockPublish) Return(err error) *EventPublisherMock {
+	if mmPublish.mock.funcPublish != nil {
+		mmPublish.mock.t.Fatalf("EventPublisherMock.Publish mock is already set by Set")
+	}
+
+	if mmPublish.defaultExpectation == nil {
+		mmPublish.defaultExpectation = &EventPublisherMockPublishExpectation{mock: mmPublish.mock}
+	}
+	mmPublish.defaultExpectation.results = &EventPublisherMockPublishResults{err}
+	mmPublish.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmPublish.mock
+}
+
+// Set uses given function f to mock the EventPublisher.Publish method
+func (mmPublish *mEventPublisherMockPublish) Set(f func(ctx context.Context, event models.OutboxEvent) (err error)) *EventPublisherMock {
+	if mmPublish.defaultExpectation != nil {
+		mmPublish.mock.t.Fatalf("Default expectation is already set for the EventPublisher.Publish method")
+	}
+
+	if len(mmPublish.expectations) > 0 {
+		mmPublish.mock.t.Fatalf("Some expectations are already set for the EventPublisher.Publish method")
+	}
+
+	mmPublish.mock.funcPublish = f
+	mmPublish.mock.funcPublishOrigin = minimock.CallerInfo(1)
+	return mmPublish.mock
+}
+
+// When sets expectation for the EventPublisher.Publish which will trigger the result defined by the following
+// Then helper
+func (mmPublish *mEventPublisherMockPublish) When(ctx context.Context, event models.OutboxEvent) *EventPublisherMockPublishExpectation {
+	if mmPublish.mock.funcPublish != nil {
+		mmPublish.mock.t.Fatalf("EventPublisherMock.Publish mock is already set by Set")
+	}
+
+	expectation := &EventPublisherMockPublishExpectation{
+		mock:               mmPublish.mock,
+		params:             &EventPublisherMockPublishParams{ctx, event},
+		expectationOrigins: EventPublisherMockPublishExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmPublish.expectations = append(mmPublish.expectations, expectation)
+	return expectation
+}
+
+// Then sets up EventPublisher.Publish return parameters for the expectation previously defined by the When method
+func (e *EventPublisherMockPublishExpectation) Then(err error) *EventPublisherMock {
+	e.results = &EventPublisherMockPublishResults{err}
+	return e.mock
+}
+
+// Times sets number of times EventPublisher.Publish should be invoked
+func (mmPublish *mEventPublisherMockPublish) Times(n uint64) *mEventPublisherMockPublish {
+	if n == 0 {
+		mmPublish.mock.t.Fatalf("Times of EventPublisherMock.Publish mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmPublish.expectedInvocations, n)
+	mmPublish.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmPublish
+}
+
+func (mmPublish *mEventPublisherMockPublish) invocationsDone() bool {
+	if len(mmPublish.expectations) == 0 && mmPublish.defaultExpectation == nil && mmPublish.mock.funcPublish == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmPublish.mock.afterPublishCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmPublish.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Publish implements EventPublisher
+func (mmPublish *EventPublisherMock) Publish(ctx context.Context, event models.OutboxEvent) (err error) {
+	mm_atomic.AddUint64(&mmPublish.beforePublishCounter, 1)
+	defer mm_atomic.AddUint64(&mmPublish.afterPublishCounter, 1)
+
+	mmPublish.t.Helper()
+
+	if mmPublish.inspectFuncPublish != nil {
+		mmPublish.inspectFuncPublish(ctx, event)
+	}
+
+	mm_params := EventPublisherMockPublishParams{ctx, event}
+
+	// Record call args
+	mmPublish.PublishMock.mutex.Lock()
+	mmPublish.PublishMock.callArgs = append(mmPublish.PublishMock.callArgs, &mm_params)
+	mmPublish.PublishMock.mutex.Unlock()
+
+	for _, e := range mmPublish.PublishMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmPublish.PublishMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmPublish.PublishMock.defaultExpectation.Counter, 1)
+		mm_want := mmPublish.PublishMock.defaultExpectation.params
+		mm_want_ptrs := mmPublish.PublishMock.defaultExpectation.paramPtrs
+
+		mm_got := EventPublisherMockPublishParams{ctx, event}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmPublish.t.Errorf("EventPublisherMock.Publish got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPublish.PublishMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.event != nil && !minimock.Equal(*mm_want_ptrs.event, mm_got.event) {
+				mmPublish.t.Errorf("EventPublisherMock.Publish got unexpected parameter event, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPublish.PublishMock.defaultExpectation.expectationOrigins.originEvent, *mm_want_ptrs.event, mm_got.event, minimock.Diff(*mm_want_ptrs.event, mm_got.event))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmPublish.t.Errorf("EventPublisherMock.Publish got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmPublish.PublishMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmPublish.PublishMock.defaultExpectation.results
+		if mm_results == nil {
+			mmPublish.t.Fatal("No results are set for the EventPublisherMock.Publish")
+		}
+		return (*mm_results).err
+	}
+	if mmPublish.funcPublish != nil {
+		return mmPublish.funcPublish(ctx, event)
+	}
+	mmPublish.t.Fatalf("Unexpected call to EventPublisherMock.Publish. %v %v", ctx, event)
+	return
+}
+
+// PublishAfterCounter returns a count of finished EventPublisherMock.Publish invocations
+func (mmPublish *EventPublisherMock) PublishAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPublish.afterPublishCounter)
+}
+
+// PublishBeforeCounter returns a count of EventPublisherMock.Publish invocations
+func (mmPublish *EventPublisherMock) PublishBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPublish.beforePublishCounter)
+}
+
+// Calls returns a list of arguments used in each call to EventPublisherMock.Publish.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmPublish *mEventPublisherMockPublish) Calls() []*EventPublisherMockPublishParams {
+	mmPublish.mutex.RLock()
+
+	argCopy := make([]*EventPublisherMockPublishParams, len(mmPublish.callArgs))
+	copy(argCopy, mmPublish.callArgs)
+
+	mmPublish.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockPublishDone returns true if the count of the Publish invocations corresponds
+// the number of defined expectations
+func (m *EventPublisherMock) MinimockPublishDone() bool {
+	if m.PublishMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.PublishMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.PublishMock.invocationsDone()
+}
+
+// MinimockPublishInspect logs each unmet expectation
+func (m *EventPublisherMock) MinimockPublishInspect() {
+	for _, e := range m.PublishMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to EventPublisherMock.Publish at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterPublishCounter := mm_atomic.LoadUint64(&m.afterPublishCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.PublishMock.defaultExpectation != nil && afterPublishCounter < 1 {
+		if m.PublishMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to EventPublisherMock.Publish at\n%s", m.PublishMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to EventPublisherMock.Publish at\n%s with params: %#v", m.PublishMock.defaultExpectation.expectationOrigins.origin, *m.PublishMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcPublish != nil && afterPublishCounter < 1 {
+		m.t.Errorf("Expected call to EventPublisherMock.Publish at\n%s", m.funcPublishOrigin)
+	}
+
+	if !m.PublishMock.invocationsDone() && afterPublishCounter > 0 {
+		m.t.Errorf("Expected %d calls to EventPublisherMock.Publish at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.PublishMock.expectedInvocations), m.PublishMock.expectedInvocationsOrigin, afterPublishCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *EventPublisherMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockPublishInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *EventPublisherMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *EventPublisherMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockPublishDone()
+}
@@ -0,0 +1,1129 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package service
+
+//go:generate minimock -i github.com/alonsoF100/authorization-service/internal/service.KeyRepository -o key_repository_mock_test.go -n KeyRepositoryMock -p service
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	"time"
+	mm_time "time"
+
+	"github.com/alonsoF100/authorization-service/internal/models"
+	"github.com/gojuno/minimock/v3"
+)
+
+// KeyRepositoryMock implements KeyRepository
+type KeyRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcCreateSigningKey          func(ctx context.Context, key *models.SigningKey) (err error)
+	funcCreateSigningKeyOrigin    string
+	inspectFuncCreateSigningKey   func(ctx context.Context, key *models.SigningKey)
+	afterCreateSigningKeyCounter  uint64
+	beforeCreateSigningKeyCounter uint64
+	CreateSigningKeyMock          mKeyRepositoryMockCreateSigningKey
+
+	funcListSigningKeys          func(ctx context.Context) (sa1 []models.SigningKey, err error)
+	funcListSigningKeysOrigin    string
+	inspectFuncListSigningKeys   func(ctx context.Context)
+	afterListSigningKeysCounter  uint64
+	beforeListSigningKeysCounter uint64
+	ListSigningKeysMock          mKeyRepositoryMockListSigningKeys
+
+	funcRetireSigningKeys          func(ctx context.Context, createdBefore time.Time, retiredAt time.Time) (err error)
+	funcRetireSigningKeysOrigin    string
+	inspectFuncRetireSigningKeys   func(ctx context.Context, createdBefore time.Time, retiredAt time.Time)
+	afterRetireSigningKeysCounter  uint64
+	beforeRetireSigningKeysCounter uint64
+	RetireSigningKeysMock          mKeyRepositoryMockRetireSigningKeys
+}
+
+// NewKeyRepositoryMock returns a mock for KeyRepository
+func NewKeyRepositoryMock(t minimock.Tester) *KeyRepositoryMock {
+	m := &KeyRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CreateSigningKeyMock = mKeyRepositoryMockCreateSigningKey{mock: m}
+	m.CreateSigningKeyMock.callArgs = []*KeyRepositoryMockCreateSigningKeyParams{}
+
+	m.ListSigningKeysMock = mKeyRepositoryMockListSigningKeys{mock: m}
+	m.ListSigningKeysMock.callArgs = []*KeyRepositoryMockListSigningKeysParams{}
+
+	m.RetireSigningKeysMock = mKeyRepositoryMockRetireSigningKeys{mock: m}
+	m.RetireSigningKeysMock.callArgs = []*KeyRepositoryMockRetireSigningKeysParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mKeyRepositoryMockCreateSigningKey struct {
+	optional           bool
+	mock               *KeyRepositoryMock
+	defaultExpectation *KeyRepositoryMockCreateSigningKeyExpectation
+	expectations       []*KeyRepositoryMockCreateSigningKeyExpectation
+
+	callArgs []*KeyRepositoryMockCreateSigningKeyParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// KeyRepositoryMockCreateSigningKeyExpectation specifies expectation struct of the KeyRepository.CreateSigningKey
+type KeyRepositoryMockCreateSigningKeyExpectation struct {
+	mock               *KeyRepositoryMock
+	params             *KeyRepositoryMockCreateSigningKeyParams
+	paramPtrs          *KeyRepositoryMockCreateSigningKeyParamPtrs
+	expectationOrigins KeyRepositoryMockCreateSigningKeyExpectationOrigins
+	results            *KeyRepositoryMockCreateSigningKeyResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// KeyRepositoryMockCreateSigningKeyParams contains parameters of the KeyRepository.CreateSigningKey
+type KeyRepositoryMockCreateSigningKeyParams struct {
+	ctx context.Context
+	key *models.SigningKey
+}
+
+// KeyRepositoryMockCreateSigningKeyParamPtrs contains pointers to parameters of the KeyRepository.CreateSigningKey
+type KeyRepositoryMockCreateSigningKeyParamPtrs struct {
+	ctx *context.Context
+	key **models.SigningKey
+}
+
+// KeyRepositoryMockCreateSigningKeyResults contains results of the KeyRepository.CreateSigningKey
+type KeyRepositoryMockCreateSigningKeyResults struct {
+	err error
+}
+
+// KeyRepositoryMockCreateSigningKeyOrigins contains origins of expectations of the KeyRepository.CreateSigningKey
+type KeyRepositoryMockCreateSigningKeyExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originKey string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateSigningKey *mKeyRepositoryMockCreateSigningKey) Optional() *mKeyRepositoryMockCreateSigningKey {
+	mmCreateSigningKey.optional = true
+	return mmCreateSigningKey
+}
+
+// Expect sets up expected params for KeyRepository.CreateSigningKey
+func (mmCreateSigningKey *mKeyRepositoryMockCreateSigningKey) Expect(ctx context.Context, key *models.SigningKey) *mKeyRepositoryMockCreateSigningKey {
+	if mmCreateSigningKey.mock.funcCreateSigningKey != nil {
+		mmCreateSigningKey.mock.t.Fatalf("KeyRepositoryMock.CreateSigningKey mock is already set by Set")
+	}
+
+	if mmCreateSigningKey.defaultExpectation == nil {
+		mmCreateSigningKey.defaultExpectation = &KeyRepositoryMockCreateSigningKeyExpectation{}
+	}
+
+	if mmCreateSigningKey.defaultExpectation.paramPtrs != nil {
+		mmCreateSigningKey.mock.t.Fatalf("KeyRepositoryMock.CreateSigningKey mock is already set by ExpectParams functions")
+	}
+
+	mmCreateSigningKey.defaultExpectation.params = &KeyRepositoryMockCreateSigningKeyParams{ctx, key}
+	mmCreateSigningKey.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreateSigningKey.expectations {
+		if minimock.Equal(e.params, mmCreateSigningKey.defaultExpectation.params) {
+			mmCreateSigningKey.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateSigningKey.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateSigningKey
+}
+
+// ExpectCtxParam1 sets up expected param ctx for KeyRepository.CreateSigningKey
+func (mmCreateSigningKey *mKeyRepositoryMockCreateSigningKey) ExpectCtxParam1(ctx context.Context) *mKeyRepositoryMockCreateSigningKey {
+	if mmCreateSigningKey.mock.funcCreateSigningKey != nil {
+		mmCreateSigningKey.mock.t.Fatalf("KeyRepositoryMock.CreateSigningKey mock is already set by Set")
+	}
+
+	if mmCreateSigningKey.defaultExpectation == nil {
+		mmCreateSigningKey.defaultExpectation = &KeyRepositoryMockCreateSigningKeyExpectation{}
+	}
+
+	if mmCreateSigningKey.defaultExpectation.params != nil {
+		mmCreateSigningKey.mock.t.Fatalf("KeyRepositoryMock.CreateSigningKey mock is already set by Expect")
+	}
+
+	if mmCreateSigningKey.defaultExpectation.paramPtrs == nil {
+		mmCreateSigningKey.defaultExpectation.paramPtrs = &KeyRepositoryMockCreateSigningKeyParamPtrs{}
+	}
+	mmCreateSigningKey.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreateSigningKey.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreateSigningKey
+}
+
+// ExpectKeyParam2 sets up expected param key for KeyRepository.CreateSigningKey
+func (mmCreateSigningKey *mKeyRepositoryMockCreateSigningKey) ExpectKeyParam2(key *models.SigningKey) *mKeyRepositoryMockCreateSigningKey {
+	if mmCreateSigningKey.mock.funcCreateSigningKey != nil {
+		mmCreateSigningKey.mock.t.Fatalf("KeyRepositoryMock.CreateSigningKey mock is already set by Set")
+	}
+
+	if mmCreateSigningKey.defaultExpectation == nil {
+		mmCreateSigningKey.defaultExpectation = &KeyRepositoryMockCreateSigningKeyExpectation{}
+	}
+
+	if mmCreateSigningKey.defaultExpectation.params != nil {
+		mmCreateSigningKey.mock.t.Fatalf("KeyRepositoryMock.CreateSigningKey mock is already set by Expect")
+	}
+
+	if mmCreateSigningKey.defaultExpectation.paramPtrs == nil {
+		mmCreateSigningKey.defaultExpectation.paramPtrs = &KeyRepositoryMockCreateSigningKeyParamPtrs{}
+	}
+	mmCreateSigningKey.defaultExpectation.paramPtrs.key = &key
+	mmCreateSigningKey.defaultExpectation.expectationOrigins.originKey = minimock.CallerInfo(1)
+
+	return mmCreateSigningKey
+}
+
+// Inspect accepts an inspector function that has same arguments as the KeyRepository.CreateSigningKey
+func (mmCreateSigningKey *mKeyRepositoryMockCreateSigningKey) Inspect(f func(ctx context.Context, key *models.SigningKey)) *mKeyRepositoryMockCreateSigningKey {
+	if mmCreateSigningKey.mock.inspectFuncCreateSigningKey != nil {
+		mmCreateSigningKey.mock.t.Fatalf("Inspect function is already set for KeyRepositoryMock.CreateSigningKey")
+	}
+
+	mmCreateSigningKey.mock.inspectFuncCreateSigningKey = f
+
+	return mmCreateSigningKey
+}
+
+// Return sets up results that will be returned by KeyRepository.CreateSigningKey
+func (mmCreateSigningKey *mKeyRepositoryMockCreateSigningKey) Return(err error) *KeyRepositoryMock {
+	if mmCreateSigningKey.mock.funcCreateSigningKey != nil {
+		mmCreateSigningKey.mock.t.Fatalf("KeyRepositoryMock.CreateSigningKey mock is already set by Set")
+	}
+
+	if mmCreateSigningKey.defaultExpectation == nil {
+		mmCreateSigningKey.defaultExpectation = &KeyRepositoryMockCreateSigningKeyExpectation{mock: mmCreateSigningKey.mock}
+	}
+	mmCreateSigningKey.defaultExpectation.results = &KeyRepositoryMockCreateSigningKeyResults{err}
+	mmCreateSigningKey.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreateSigningKey.mock
+}
+
+// Set uses given function f to mock the KeyRepository.CreateSigningKey method
+func (mmCreateSigningKey *mKeyRepositoryMockCreateSigningKey) Set(f func(ctx context.Context, key *models.SigningKey) (err error)) *KeyRepositoryMock {
+	if mmCreateSigningKey.defaultExpectation != nil {
+		mmCreateSigningKey.mock.t.Fatalf("Default expectation is already set for the KeyRepository.CreateSigningKey method")
+	}
+
+	if len(mmCreateSigningKey.expectations) > 0 {
+		mmCreateSigningKey.mock.t.Fatalf("Some expectations are already set for the KeyRepository.CreateSigningKey method")
+	}
+
+	mmCreateSigningKey.mock.funcCreateSigningKey = f
+	mmCreateSigningKey.mock.funcCreateSigningKeyOrigin = minimock.CallerInfo(1)
+	return mmCreateSigningKey.mock
+}
+
+// When sets expectation for the KeyRepository.CreateSigningKey which will trigger the result defined by the following
+// Then helper
+func (mmCreateSigningKey *mKeyRepositoryMockCreateSigningKey) When(ctx context.Context, key *models.SigningKey) *KeyRepositoryMockCreateSigningKeyExpectation {
+	if mmCreateSigningKey.mock.funcCreateSigningKey != nil {
+		mmCreateSigningKey.mock.t.Fatalf("KeyRepositoryMock.CreateSigningKey mock is already set by Set")
+	}
+
+	expectation := &KeyRepositoryMockCreateSigningKeyExpectation{
+		mock:               mmCreateSigningKey.mock,
+		params:             &KeyRepositoryMockCreateSigningKeyParams{ctx, key},
+		expectationOrigins: KeyRepositoryMockCreateSigningKeyExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreateSigningKey.expectations = append(mmCreateSigningKey.expectations, expectation)
+	return expectation
+}
+
+// Then sets up KeyRepository.CreateSigningKey return parameters for the expectation previously defined by the When method
+func (e *KeyRepositoryMockCreateSigningKeyExpectation) Then(err error) *KeyRepositoryMock {
+	e.results = &KeyRepositoryMockCreateSigningKeyResults{err}
+	return e.mock
+}
+
+// Times sets number of times KeyRepository.CreateSigningKey should be invoked
+func (mmCreateSigningKey *mKeyRepositoryMockCreateSigningKey) Times(n uint64) *mKeyRepositoryMockCreateSigningKey {
+	if n == 0 {
+		mmCreateSigningKey.mock.t.Fatalf("Times of KeyRepositoryMock.CreateSigningKey mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateSigningKey.expectedInvocations, n)
+	mmCreateSigningKey.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreateSigningKey
+}
+
+func (mmCreateSigningKey *mKeyRepositoryMockCreateSigningKey) invocationsDone() bool {
+	if len(mmCreateSigningKey.expectations) == 0 && mmCreateSigningKey.defaultExpectation == nil && mmCreateSigningKey.mock.funcCreateSigningKey == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateSigningKey.mock.afterCreateSigningKeyCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateSigningKey.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateSigningKey implements KeyRepository
+func (mmCreateSigningKey *KeyRepositoryMock) CreateSigningKey(ctx context.Context, key *models.SigningKey) (err error) {
+	mm_atomic.AddUint64(&mmCreateSigningKey.beforeCreateSigningKeyCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateSigningKey.afterCreateSigningKeyCounter, 1)
+
+	mmCreateSigningKey.t.Helper()
+
+	if mmCreateSigningKey.inspectFuncCreateSigningKey != nil {
+		mmCreateSigningKey.inspectFuncCreateSigningKey(ctx, key)
+	}
+
+	mm_params := KeyRepositoryMockCreateSigningKeyParams{ctx, key}
+
+	// Record call args
+	mmCreateSigningKey.CreateSigningKeyMock.mutex.Lock()
+	mmCreateSigningKey.CreateSigningKeyMock.callArgs = append(mmCreateSigningKey.CreateSigningKeyMock.callArgs, &mm_params)
+	mmCreateSigningKey.CreateSigningKeyMock.mutex.Unlock()
+
+	for _, e := range mmCreateSigningKey.CreateSigningKeyMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCreateSigningKey.CreateSigningKeyMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateSigningKey.CreateSigningKeyMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateSigningKey.CreateSigningKeyMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateSigningKey.CreateSigningKeyMock.defaultExpectation.paramPtrs
+
+		mm_got := KeyRepositoryMockCreateSigningKeyParams{ctx, key}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateSigningKey.t.Errorf("KeyRepositoryMock.CreateSigningKey got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateSigningKey.CreateSigningKeyMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.key != nil && !minimock.Equal(*mm_want_ptrs.key, mm_got.key) {
+				mmCreateSigningKey.t.Errorf("KeyRepositoryMock.CreateSigningKey got unexpected parameter key, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateSigningKey.CreateSigningKeyMock.defaultExpectation.expectationOrigins.originKey, *mm_want_ptrs.key, mm_got.key, minimock.Diff(*mm_want_ptrs.key, mm_got.key))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateSigningKey.t.Errorf("KeyRepositoryMock.CreateSigningKey got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreateSigningKey.CreateSigningKeyMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateSigningKey.CreateSigningKeyMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateSigningKey.t.Fatal("No results are set for the KeyRepositoryMock.CreateSigningKey")
+		}
+		return (*mm_results).err
+	}
+	if mmCreateSigningKey.funcCreateSigningKey != nil {
+		return mmCreateSigningKey.funcCreateSigningKey(ctx, key)
+	}
+	mmCreateSigningKey.t.Fatalf("Unexpected call to KeyRepositoryMock.CreateSigningKey. %v %v", ctx, key)
+	return
+}
+
+// CreateSigningKeyAfterCounter returns a count of finished KeyRepositoryMock.CreateSigningKey invocations
+func (mmCreateSigningKey *KeyRepositoryMock) CreateSigningKeyAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateSigningKey.afterCreateSigningKeyCounter)
+}
+
+// CreateSigningKeyBeforeCounter returns a count of KeyRepositoryMock.CreateSigningKey invocations
+func (mmCreateSigningKey *KeyRepositoryMock) CreateSigningKeyBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateSigningKey.beforeCreateSigningKeyCounter)
+}
+
+// Calls returns a list of arguments used in each call to KeyRepositoryMock.CreateSigningKey.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateSigningKey *mKeyRepositoryMockCreateSigningKey) Calls() []*KeyRepositoryMockCreateSigningKeyParams {
+	mmCreateSigningKey.mutex.RLock()
+
+	argCopy := make([]*KeyRepositoryMockCreateSigningKeyParams, len(mmCreateSigningKey.callArgs))
+	copy(argCopy, mmCreateSigningKey.callArgs)
+
+	mmCreateSigningKey.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateSigningKeyDone returns true if the count of the CreateSigningKey invocations corresponds
+// the number of defined expectations
+func (m *KeyRepositoryMock) MinimockCreateSigningKeyDone() bool {
+	if m.CreateSigningKeyMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateSigningKeyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateSigningKeyMock.invocationsDone()
+}
+
+// MinimockCreateSigningKeyInspect logs each unmet expectation
+func (m *KeyRepositoryMock) MinimockCreateSigningKeyInspect() {
+	for _, e := range m.CreateSigningKeyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to KeyRepositoryMock.CreateSigningKey at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateSigningKeyCounter := mm_atomic.LoadUint64(&m.afterCreateSigningKeyCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateSigningKeyMock.defaultExpectation != nil && afterCreateSigningKeyCounter < 1 {
+		if m.CreateSigningKeyMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to KeyRepositoryMock.CreateSigningKey at\n%s", m.CreateSigningKeyMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to KeyRepositoryMock.CreateSigningKey at\n%s with params: %#v", m.CreateSigningKeyMock.defaultExpectation.expectationOrigins.origin, *m.CreateSigningKeyMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateSigningKey != nil && afterCreateSigningKeyCounter < 1 {
+		m.t.Errorf("Expected call to KeyRepositoryMock.CreateSigningKey at\n%s", m.funcCreateSigningKeyOrigin)
+	}
+
+	if !m.CreateSigningKeyMock.invocationsDone() && afterCreateSigningKeyCounter > 0 {
+		m.t.Errorf("Expected %d calls to KeyRepositoryMock.CreateSigningKey at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateSigningKeyMock.expectedInvocations), m.CreateSigningKeyMock.expectedInvocationsOrigin, afterCreateSigningKeyCounter)
+	}
+}
+
+type mKeyRepositoryMockListSigningKeys struct {
+	optional           bool
+	mock               *KeyRepositoryMock
+	defaultExpectation *KeyRepositoryMockListSigningKeysExpectation
+	expectations       []*KeyRepositoryMockListSigningKeysExpectation
+
+	callArgs []*KeyRepositoryMockListSigningKeysParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// KeyRepositoryMockListSigningKeysExpectation specifies expectation struct of the KeyRepository.ListSigningKeys
+type KeyRepositoryMockListSigningKeysExpectation struct {
+	mock               *KeyRepositoryMock
+	params             *KeyRepositoryMockListSigningKeysParams
+	paramPtrs          *KeyRepositoryMockListSigningKeysParamPtrs
+	expectationOrigins KeyRepositoryMockListSigningKeysExpectationOrigins
+	results            *KeyRepositoryMockListSigningKeysResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// KeyRepositoryMockListSigningKeysParams contains parameters of the KeyRepository.ListSigningKeys
+type KeyRepositoryMockListSigningKeysParams struct {
+	ctx context.Context
+}
+
+// KeyRepositoryMockListSigningKeysParamPtrs contains pointers to parameters of the KeyRepository.ListSigningKeys
+type KeyRepositoryMockListSigningKeysParamPtrs struct {
+	ctx *context.Context
+}
+
+// KeyRepositoryMockListSigningKeysResults contains results of the KeyRepository.ListSigningKeys
+type KeyRepositoryMockListSigningKeysResults struct {
+	sa1 []models.SigningKey
+	err error
+}
+
+// KeyRepositoryMockListSigningKeysOrigins contains origins of expectations of the KeyRepository.ListSigningKeys
+type KeyRepositoryMockListSigningKeysExpectationOrigins struct {
+	origin    string
+	originCtx string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListSigningKeys *mKeyRepositoryMockListSigningKeys) Optional() *mKeyRepositoryMockListSigningKeys {
+	mmListSigningKeys.optional = true
+	return mmListSigningKeys
+}
+
+// Expect sets up expected params for KeyRepository.ListSigningKeys
+func (mmListSigningKeys *mKeyRepositoryMockListSigningKeys) Expect(ctx context.Context) *mKeyRepositoryMockListSigningKeys {
+	if mmListSigningKeys.mock.funcListSigningKeys != nil {
+		mmListSigningKeys.mock.t.Fatalf("KeyRepositoryMock.ListSigningKeys mock is already set by Set")
+	}
+
+	if mmListSigningKeys.defaultExpectation == nil {
+		mmListSigningKeys.defaultExpectation = &KeyRepositoryMockListSigningKeysExpectation{}
+	}
+
+	if mmListSigningKeys.defaultExpectation.paramPtrs != nil {
+		mmListSigningKeys.mock.t.Fatalf("KeyRepositoryMock.ListSigningKeys mock is already set by ExpectParams functions")
+	}
+
+	mmListSigningKeys.defaultExpectation.params = &KeyRepositoryMockListSigningKeysParams{ctx}
+	mmListSigningKeys.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListSigningKeys.expectations {
+		if minimock.Equal(e.params, mmListSigningKeys.defaultExpectation.params) {
+			mmListSigningKeys.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListSigningKeys.defaultExpectation.params)
+		}
+	}
+
+	return mmListSigningKeys
+}
+
+// ExpectCtxParam1 sets up expected param ctx for KeyRepository.ListSigningKeys
+func (mmListSigningKeys *mKeyRepositoryMockListSigningKeys) ExpectCtxParam1(ctx context.Context) *mKeyRepositoryMockListSigningKeys {
+	if mmListSigningKeys.mock.funcListSigningKeys != nil {
+		mmListSigningKeys.mock.t.Fatalf("KeyRepositoryMock.ListSigningKeys mock is already set by Set")
+	}
+
+	if mmListSigningKeys.defaultExpectation == nil {
+		mmListSigningKeys.defaultExpectation = &KeyRepositoryMockListSigningKeysExpectation{}
+	}
+
+	if mmListSigningKeys.defaultExpectation.params != nil {
+		mmListSigningKeys.mock.t.Fatalf("KeyRepositoryMock.ListSigningKeys mock is already set by Expect")
+	}
+
+	if mmListSigningKeys.defaultExpectation.paramPtrs == nil {
+		mmListSigningKeys.defaultExpectation.paramPtrs = &KeyRepositoryMockListSigningKeysParamPtrs{}
+	}
+	mmListSigningKeys.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListSigningKeys.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListSigningKeys
+}
+
+// Inspect accepts an inspector function that has same arguments as the KeyRepository.ListSigningKeys
+func (mmListSigningKeys *mKeyRepositoryMockListSigningKeys) Inspect(f func(ctx context.Context)) *mKeyRepositoryMockListSigningKeys {
+	if mmListSigningKeys.mock.inspectFuncListSigningKeys != nil {
+		mmListSigningKeys.mock.t.Fatalf("Inspect function is already set for KeyRepositoryMock.ListSigningKeys")
+	}
+
+	mmListSigningKeys.mock.inspectFuncListSigningKeys = f
+
+	return mmListSigningKeys
+}
+
+// Return sets up results that will be returned by KeyRepository.ListSigningKeys
+func (mmListSigningKeys *mKeyRepositoryMockListSigningKeys) Return(sa1 []models.SigningKey, err error) *KeyRepositoryMock {
+	if mmListSigningKeys.mock.funcListSigningKeys != nil {
+		mmListSigningKeys.mock.t.Fatalf("KeyRepositoryMock.ListSigningKeys mock is already set by Set")
+	}
+
+	if mmListSigningKeys.defaultExpectation == nil {
+		mmListSigningKeys.defaultExpectation = &KeyRepositoryMockListSigningKeysExpectation{mock: mmListSigningKeys.mock}
+	}
+	mmListSigningKeys.defaultExpectation.results = &KeyRepositoryMockListSigningKeysResults{sa1, err}
+	mmListSigningKeys.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListSigningKeys.mock
+}
+
+// Set uses given function f to mock the KeyRepository.ListSigningKeys method
+func (mmListSigningKeys *mKeyRepositoryMockListSigningKeys) Set(f func(ctx context.Context) (sa1 []models.SigningKey, err error)) *KeyRepositoryMock {
+	if mmListSigningKeys.defaultExpectation != nil {
+		mmListSigningKeys.mock.t.Fatalf("Default expectation is already set for the KeyRepository.ListSigningKeys method")
+	}
+
+	if len(mmListSigningKeys.expectations) > 0 {
+		mmListSigningKeys.mock.t.Fatalf("Some expectations are already set for the KeyRepository.ListSigningKeys method")
+	}
+
+	mmListSigningKeys.mock.funcListSigningKeys = f
+	mmListSigningKeys.mock.funcListSigningKeysOrigin = minimock.CallerInfo(1)
+	return mmListSigningKeys.mock
+}
+
+// When sets expectation for the KeyRepository.ListSigningKeys which will trigger the result defined by the following
+// Then helper
+func (mmListSigningKeys *mKeyRepositoryMockListSigningKeys) When(ctx context.Context) *KeyRepositoryMockListSigningKeysExpectation {
+	if mmListSigningKeys.mock.funcListSigningKeys != nil {
+		mmListSigningKeys.mock.t.Fatalf("KeyRepositoryMock.ListSigningKeys mock is already set by Set")
+	}
+
+	expectation := &KeyRepositoryMockListSigningKeysExpectation{
+		mock:               mmListSigningKeys.mock,
+		params:             &KeyRepositoryMockListSigningKeysParams{ctx},
+		expectationOrigins: KeyRepositoryMockListSigningKeysExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListSigningKeys.expectations = append(mmListSigningKeys.expectations, expectation)
+	return expectation
+}
+
+// Then sets up KeyRepository.ListSigningKeys return parameters for the expectation previously defined by the When method
+func (e *KeyRepositoryMockListSigningKeysExpectation) Then(sa1 []models.SigningKey, err error) *KeyRepositoryMock {
+	e.results = &KeyRepositoryMockListSigningKeysResults{sa1, err}
+	return e.mock
+}
+
+// Times sets number of times KeyRepository.ListSigningKeys should be invoked
+func (mmListSigningKeys *mKeyRepositoryMockListSigningKeys) Times(n uint64) *mKeyRepositoryMockListSigningKeys {
+	if n == 0 {
+		mmListSigningKeys.mock.t.Fatalf("Times of KeyRepositoryMock.ListSigningKeys mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListSigningKeys.expectedInvocations, n)
+	mmListSigningKeys.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListSigningKeys
+}
+
+func (mmListSigningKeys *mKeyRepositoryMockListSigningKeys) invocationsDone() bool {
+	if len(mmListSigningKeys.expectations) == 0 && mmListSigningKeys.defaultExpectation == nil && mmListSigningKeys.mock.funcListSigningKeys == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListSigningKeys.mock.afterListSigningKeysCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListSigningKeys.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListSigningKeys implements KeyRepository
+func (mmListSigningKeys *KeyRepositoryMock) ListSigningKeys(ctx context.Context) (sa1 []models.SigningKey, err error) {
+	mm_atomic.AddUint64(&mmListSigningKeys.beforeListSigningKeysCounter, 1)
+	defer mm_atomic.AddUint64(&mmListSigningKeys.afterListSigningKeysCounter, 1)
+
+	mmListSigningKeys.t.Helper()
+
+	if mmListSigningKeys.inspectFuncListSigningKeys != nil {
+		mmListSigningKeys.inspectFuncListSigningKeys(ctx)
+	}
+
+	mm_params := KeyRepositoryMockListSigningKeysParams{ctx}
+
+	// Record call args
+	mmListSigningKeys.ListSigningKeysMock.mutex.Lock()
+	mmListSigningKeys.ListSigningKeysMock.callArgs = append(mmListSigningKeys.ListSigningKeysMock.callArgs, &mm_params)
+	mmListSigningKeys.ListSigningKeysMock.mutex.Unlock()
+
+	for _, e := range mmListSigningKeys.ListSigningKeysMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.sa1, e.results.err
+		}
+	}
+
+	if mmListSigningKeys.ListSigningKeysMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListSigningKeys.ListSigningKeysMock.defaultExpectation.Counter, 1)
+		mm_want := mmListSigningKeys.ListSigningKeysMock.defaultExpectation.params
+		mm_want_ptrs := mmListSigningKeys.ListSigningKeysMock.defaultExpectation.paramPtrs
+
+		mm_got := KeyRepositoryMockListSigningKeysParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListSigningKeys.t.Errorf("KeyRepositoryMock.ListSigningKeys got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListSigningKeys.ListSigningKeysMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListSigningKeys.t.Errorf("KeyRepositoryMock.ListSigningKeys got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListSigningKeys.ListSigningKeysMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListSigningKeys.ListSigningKeysMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListSigningKeys.t.Fatal("No results are set for the KeyRepositoryMock.ListSigningKeys")
+		}
+		return (*mm_results).sa1, (*mm_results).err
+	}
+	if mmListSigningKeys.funcListSigningKeys != nil {
+		return mmListSigningKeys.funcListSigningKeys(ctx)
+	}
+	mmListSigningKeys.t.Fatalf("Unexpected call to KeyRepositoryMock.ListSigningKeys. %v", ctx)
+	return
+}
+
+// ListSigningKeysAfterCounter returns a count of finished KeyRepositoryMock.ListSigningKeys invocations
+func (mmListSigningKeys *KeyRepositoryMock) ListSigningKeysAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListSigningKeys.afterListSigningKeysCounter)
+}
+
+// ListSigningKeysBeforeCounter returns a count of KeyRepositoryMock.ListSigningKeys invocations
+func (mmListSigningKeys *KeyRepositoryMock) ListSigningKeysBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListSigningKeys.beforeListSigningKeysCounter)
+}
+
+// Calls returns a list of arguments used in each call to KeyRepositoryMock.ListSigningKeys.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListSigningKeys *mKeyRepositoryMockListSigningKeys) Calls() []*KeyRepositoryMockListSigningKeysParams {
+	mmListSigningKeys.mutex.RLock()
+
+	argCopy := make([]*KeyRepositoryMockListSigningKeysParams, len(mmListSigningKeys.callArgs))
+	copy(argCopy, mmListSigningKeys.callArgs)
+
+	mmListSigningKeys.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListSigningKeysDone returns true if the count of the ListSigningKeys invocations corresponds
+// the number of defined expectations
+func (m *KeyRepositoryMock) MinimockListSigningKeysDone() bool {
+	if m.ListSigningKeysMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListSigningKeysMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListSigningKeysMock.invocationsDone()
+}
+
+// MinimockListSigningKeysInspect logs each unmet expectation
+func (m *KeyRepositoryMock) MinimockListSigningKeysInspect() {
+	for _, e := range m.ListSigningKeysMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to KeyRepositoryMock.ListSigningKeys at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListSigningKeysCounter := mm_atomic.LoadUint64(&m.afterListSigningKeysCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListSigningKeysMock.defaultExpectation != nil && afterListSigningKeysCounter < 1 {
+		if m.ListSigningKeysMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to KeyRepositoryMock.ListSigningKeys at\n%s", m.ListSigningKeysMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to KeyRepositoryMock.ListSigningKeys at\n%s with params: %#v", m.ListSigningKeysMock.defaultExpectation.expectationOrigins.origin, *m.ListSigningKeysMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListSigningKeys != nil && afterListSigningKeysCounter < 1 {
+		m.t.Errorf("Expected call to KeyRepositoryMock.ListSigningKeys at\n%s", m.funcListSigningKeysOrigin)
+	}
+
+	if !m.ListSigningKeysMock.invocationsDone() && afterListSigningKeysCounter > 0 {
+		m.t.Errorf("Expected %d calls to KeyRepositoryMock.ListSigningKeys at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListSigningKeysMock.expectedInvocations), m.ListSigningKeysMock.expectedInvocationsOrigin, afterListSigningKeysCounter)
+	}
+}
+
+type mKeyRepositoryMockRetireSigningKeys struct {
+	optional           bool
+	mock               *KeyRepositoryMock
+	defaultExpectation *KeyRepositoryMockRetireSigningKeysExpectation
+	expectations       []*KeyRepositoryMockRetireSigningKeysExpectation
+
+	callArgs []*KeyRepositoryMockRetireSigningKeysParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// KeyRepositoryMockRetireSigningKeysExpectation specifies expectation struct of the KeyRepository.RetireSigningKeys
+type KeyRepositoryMockRetireSigningKeysExpectation struct {
+	mock               *KeyRepositoryMock
+	params             *KeyRepositoryMockRetireSigningKeysParams
+	paramPtrs          *KeyRepositoryMockRetireSigningKeysParamPtrs
+	expectationOrigins KeyRepositoryMockRetireSigningKeysExpectationOrigins
+	results            *KeyRepositoryMockRetireSigningKeysResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// KeyRepositoryMockRetireSigningKeysParams contains parameters of the KeyRepository.RetireSigningKeys
+type KeyRepositoryMockRetireSigningKeysParams struct {
+	ctx           context.Context
+	createdBefore time.Time
+	retiredAt     time.Time
+}
+
+// KeyRepositoryMockRetireSigningKeysParamPtrs contains pointers to parameters of the KeyRepository.RetireSigningKeys
+type KeyRepositoryMockRetireSigningKeysParamPtrs struct {
+	ctx           *context.Context
+	createdBefore *time.Time
+	retiredAt     *time.Time
+}
+
+// KeyRepositoryMockRetireSigningKeysResults contains results of the KeyRepository.RetireSigningKeys
+type KeyRepositoryMockRetireSigningKeysResults struct {
+	err error
+}
+
+// KeyRepositoryMockRetireSigningKeysOrigins contains origins of expectations of the KeyRepository.RetireSigningKeys
+type KeyRepositoryMockRetireSigningKeysExpectationOrigins struct {
+	origin              string
+	originCtx           string
+	originCreatedBefore string
+	originRetiredAt     string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRetireSigningKeys *mKeyRepositoryMockRetireSigningKeys) Optional() *mKeyRepositoryMockRetireSigningKeys {
+	mmRetireSigningKeys.optional = true
+	return mmRetireSigningKeys
+}
+
+// Expect sets up expected params for KeyRepository.RetireSigningKeys
+func (mmRetireSigningKeys *mKeyRepositoryMockRetireSigningKeys) Expect(ctx context.Context, createdBefore time.Time, retiredAt time.Time) *mKeyRepositoryMockRetireSigningKeys {
+	if mmRetireSigningKeys.mock.funcRetireSigningKeys != nil {
+		mmRetireSigningKeys.mock.t.Fatalf("KeyRepositoryMock.RetireSigningKeys mock is already set by Set")
+	}
+
+	if mmRetireSigningKeys.defaultExpectation == nil {
+		mmRetireSigningKeys.defaultExpectation = &KeyRepositoryMockRetireSigningKeysExpectation{}
+	}
+
+	if mmRetireSigningKeys.defaultExpectation.paramPtrs != nil {
+		mmRetireSigningKeys.mock.t.Fatalf("KeyRepositoryMock.RetireSigningKeys mock is already set by ExpectParams functions")
+	}
+
+	mmRetireSigningKeys.defaultExpectation.params = &KeyRepositoryMockRetireSigningKeysParams{ctx, createdBefore, retiredAt}
+	mmRetireSigningKeys.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRetireSigningKeys.expectations {
+		if minimock.Equal(e.params, mmRetireSigningKeys.defaultExpectation.params) {
+			mmRetireSigningKeys.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRetireSigningKeys.defaultExpectation.params)
+		}
+	}
+
+	return mmRetireSigningKeys
+}
+
+// ExpectCtxParam1 sets up expected param ctx for KeyRepository.RetireSigningKeys
+func (mmRetireSigningKeys *mKeyRepositoryMockRetireSigningKeys) ExpectCtxParam1(ctx context.Context) *mKeyRepositoryMockRetireSigningKeys {
+	if mmRetireSigningKeys.mock.funcRetireSigningKeys != nil {
+		mmRetireSigningKeys.mock.t.Fatalf("KeyRepositoryMock.RetireSigningKeys mock is already set by Set")
+	}
+
+	if mmRetireSigningKeys.defaultExpectation == nil {
+		mmRetireSigningKeys.defaultExpectation = &KeyRepositoryMockRetireSigningKeysExpectation{}
+	}
+
+	if mmRetireSigningKeys.defaultExpectation.params != nil {
+		mmRetireSigningKeys.mock.t.Fatalf("KeyRepositoryMock.RetireSigningKeys mock is already set by Expect")
+	}
+
+	if mmRetireSigningKeys.defaultExpectation.paramPtrs == nil {
+		mmRetireSigningKeys.defaultExpectation.paramPtrs = &KeyRepositoryMockRetireSigningKeysParamPtrs{}
+	}
+	mmRetireSigningKeys.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRetireSigningKeys.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRetireSigningKeys
+}
+
+// ExpectCreatedBeforeParam2 sets up expected param createdBefore for KeyRepository.RetireSigningKeys
+func (mmRetireSigningKeys *mKeyRepositoryMockRetireSigningKeys) ExpectCreatedBeforeParam2(createdBefore time.Time) *mKeyRepositoryMockRetireSigningKeys {
+	if mmRetireSigningKeys.mock.funcRetireSigningKeys != nil {
+		mmRetireSigningKeys.mock.t.Fatalf("KeyRepositoryMock.RetireSigningKeys mock is already set by Set")
+	}
+
+	if mmRetireSigningKeys.defaultExpectation == nil {
+		mmRetireSigningKeys.defaultExpectation = &KeyRepositoryMockRetireSigningKeysExpectation{}
+	}
+
+	if mmRetireSigningKeys.defaultExpectation.params != nil {
+		mmRetireSigningKeys.mock.t.Fatalf("KeyRepositoryMock.RetireSigningKeys mock is already set by Expect")
+	}
+
+	if mmRetireSigningKeys.defaultExpectation.paramPtrs == nil {
+		mmRetireSigningKeys.defaultExpectation.paramPtrs = &KeyRepositoryMockRetireSigningKeysParamPtrs{}
+	}
+	mmRetireSigningKeys.defaultExpectation.paramPtrs.createdBefore = &createdBefore
+	mmRetireSigningKeys.defaultExpectation.expectationOrigins.originCreatedBefore = minimock.CallerInfo(1)
+
+	return mmRetireSigningKeys
+}
+
+// ExpectRetiredAtParam3 sets up expected param retiredAt for KeyRepository.RetireSigningKeys
+func (mmRetireSigningKeys *mKeyRepositoryMockRetireSigningKeys) ExpectRetiredAtParam3(retiredAt time.Time) *mKeyRepositoryMockRetireSigningKeys {
+	if mmRetireSigningKeys.mock.funcRetireSigningKeys != nil {
+		mmRetireSigningKeys.mock.t.Fatalf("KeyRepositoryMock.RetireSigningKeys mock is already set by Set")
+	}
+
+	if mmRetireSigningKeys.defaultExpectation == nil {
+		mmRetireSigningKeys.defaultExpectation = &KeyRepositoryMockRetireSigningKeysExpectation{}
+	}
+
+	if mmRetireSigningKeys.defaultExpectation.params != nil {
+		mmRetireSigningKeys.mock.t.Fatalf("KeyRepositoryMock.RetireSigningKeys mock is already set by Expect")
+	}
+
+	if mmRetireSigningKeys.defaultExpectation.paramPtrs == nil {
+		mmRetireSigningKeys.defaultExpectation.paramPtrs = &KeyRepositoryMockRetireSigningKeysParamPtrs{}
+	}
+	mmRetireSigningKeys.defaultExpectation.paramPtrs.retiredAt = &retiredAt
+	mmRetireSigningKeys.defaultExpectation.expectationOrigins.originRetiredAt = minimock.CallerInfo(1)
+
+	return mmRetireSigningKeys
+}
+
+// Inspect accepts an inspector function that has same arguments as the KeyRepository.RetireSigningKeys
+func (mmRetireSigningKeys *mKeyRepositoryMockRetireSigningKeys) Inspect(f func(ctx context.Context, createdBefore time.Time, retiredAt time.Time)) *mKeyRepositoryMockRetireSigningKeys {
+	if mmRetireSigningKeys.mock.inspectFuncRetireSigningKeys != nil {
+		mmRetireSigningKeys.mock.t.Fatalf("Inspect function is already set for KeyRepositoryMock.RetireSigningKeys")
+	}
+
+	mmRetireSigningKeys.mock.inspectFuncRetireSigningKeys = f
+
+	return mmRetireSigningKeys
+}
+
+// Return sets up results that will be returned by KeyRepository.RetireSigningKeys
+func (mmRetireSigningKeys *mKeyRepositoryMockRetireSigningKeys) Return(err error) *KeyRepositoryMock {
+	if mmRetireSigningKeys.mock.funcRetireSigningKeys != nil {
+		mmRetireSigningKeys.mock.t.Fatalf("KeyRepositoryMock.RetireSigningKeys mock is already set by Set")
+	}
+
+	if mmRetireSigningKeys.defaultExpectation == nil {
+		mmRetireSigningKeys.defaultExpectation = &KeyRepositoryMockRetireSigningKeysExpectation{mock: mmRetireSigningKeys.mock}
+	}
+	mmRetireSigningKeys.defaultExpectation.results = &KeyRepositoryMockRetireSigningKeysResults{err}
+	mmRetireSigningKeys.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRetireSigningKeys.mock
+}
+
+// Set uses given function f to mock the KeyRepository.RetireSigningKeys method
+func (mmRetireSigningKeys *mKeyRepositoryMockRetireSigningKeys) Set(f func(ctx context.Context, createdBefore time.Time, retiredAt time.Time) (err error)) *KeyRepositoryMock {
+	if mmRetireSigningKeys.defaultExpectation != nil {
+		mmRetireSigningKeys.mock.t.Fatalf("Default expectation is already set for the KeyRepository.RetireSigningKeys method")
+	}
+
+	if len(mmRetireSigningKeys.expectations) > 0 {
+		mmRetireSigningKeys.mock.t.Fatalf("Some expectations are already set for the KeyRepository.RetireSigningKeys method")
+	}
+
+	mmRetireSigningKeys.mock.funcRetireSigningKeys = f
+	mmRetireSigningKeys.mock.funcRetireSigningKeysOrigin = minimock.CallerInfo(1)
+	return mmRetireSigningKeys.mock
+}
+
+// When sets expectation for the KeyRepository.RetireSigningKeys which will trigger the result defined by the following
+// Then helper
+func (mmRetireSigningKeys *mKeyRepositoryMockRetireSigningKeys) When(ctx context.Context, createdBefore time.Time, retiredAt time.Time) *KeyRepositoryMockRetireSigningKeysExpectation {
+	if mmRetireSigningKeys.mock.funcRetireSigningKeys != nil {
+		mmRetireSigningKeys.mock.t.Fatalf("KeyRepositoryMock.RetireSigningKeys mock is already set by Set")
+	}
+
+	expectation := &KeyRepositoryMockRetireSigningKeysExpectation{
+		mock:               mmRetireSigningKeys.mock,
+		params:             &KeyRepositoryMockRetireSigningKeysParams{ctx, createdBefore, retiredAt},
+		expectationOrigins: KeyRepositoryMockRetireSigningKeysExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmRetireSigningKeys.expectations = append(mmRetireSigningKeys.expectations, expectation)
+	return expectation
+}
+
+// Then sets up KeyRepository.RetireSigningKeys return parameters for the expectation previously defined by the When method
+func (e *KeyRepositoryMockRetireSigningKeysExpectation) Then(err error) *KeyRepositoryMock {
+	e.results = &KeyRepositoryMockRetireSigningKeysResults{err}
+	return e.mock
+}
+
+// Times sets number of times KeyRepository.RetireSigningKeys should be invoked
+func (mmRetireSigningKeys *mKeyRepositoryMockRetireSigningKeys) Times(n uint64) *mKeyRepositoryMockRetireSigningKeys {
+	if n == 0 {
+		mmRetireSigningKeys.mock.t.Fatalf("Times of KeyRepositoryMock.RetireSigningKeys mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRetireSigningKeys.expectedInvocations, n)
+	mmRetireSigningKeys.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRetireSigningKeys
+}
+
+func (mmRetireSigningKeys *mKeyRepositoryMockRetireSigningKeys) invocationsDone() bool {
+	if len(mmRetireSigningKeys.expectations) == 0 && mmRetireSigningKeys.defaultExpectation == nil && mmRetireSigningKeys.mock.funcRetireSigningKeys == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRetireSigningKeys.mock.afterRetireSigningKeysCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRetireSigningKeys.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RetireSigningKeys implements KeyRepository
+func (mmRetireSigningKeys *KeyRepositoryMock) RetireSigningKeys(ctx context.Context, createdBefore time.Time, retiredAt time.Time) (err error) {
+	mm_atomic.AddUint64(&mmRetireSigningKeys.beforeRetireSigningKeysCounter, 1)
+	defer mm_atomic.AddUint64(&mmRetireSigningKeys.afterRetireSigningKeysCounter, 1)
+
+	mmRetireSigningKeys.t.Helper()
+
+	if mmRetireSigningKeys.inspectFuncRetireSigningKeys != nil {
+		mmRetireSigningKeys.inspectFuncRetireSigningKeys(ctx, createdBefore, retiredAt)
+	}
+
+	mm_params := KeyRepositoryMockRetireSigningKeysParams{ctx, createdBefore, retiredAt}
+
+	// Record call args
+	mmRetireSigningKeys.RetireSigningKeysMock.mutex.Lock()
+	mmRetireSigningKeys.RetireSigningKeysMock.callArgs = append(mmRetireSigningKeys.RetireSigningKeysMock.callArgs, &mm_params)
+	mmRetireSigningKeys.RetireSigningKeysMock.mutex.Unlock()
+
+	for _, e := range mmRetireSigningKeys.RetireSigningKeysMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmRetireSigningKeys.RetireSigningKeysMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRetireSigningKeys.RetireSigningKeysMock.defaultExpectation.Counter, 1)
+		mm_want := mmRetireSigningKeys.RetireSigningKeysMock.defaultExpectation.params
+		mm_want_ptrs := mmRetireSigningKeys.RetireSigningKeysMock.defaultExpectation.paramPtrs
+
+		mm_got := KeyRepositoryMockRetireSigningKeysParams{ctx, createdBefore, retiredAt}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRetireSigningKeys.t.Errorf("KeyRepositoryMock.RetireSigningKeys got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRetireSigningKeys.RetireSigningKeysMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.createdBefore != nil && !minimock.Equal(*mm_want_ptrs.createdBefore, mm_got.createdBefore) {
+				mmRetireSigningKeys.t.Errorf("KeyRepositoryMock.RetireSigningKeys got unexpected parameter createdBefore, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRetireSigningKeys.RetireSigningKeysMock.defaultExpectation.expectationOrigins.originCreatedBefore, *mm_want_ptrs.createdBefore, mm_got.createdBefore, minimock.Diff(*mm_want_ptrs.createdBefore, mm_got.createdBefore))
+			}
+
+			if mm_want_ptrs.retiredAt != nil && !minimock.Equal(*mm_want_ptrs.retiredAt, mm_got.retiredAt) {
+				mmRetireSigningKeys.t.Errorf("KeyRepositoryMock.RetireSigningKeys got unexpected parameter retiredAt, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRetireSigningKeys.RetireSigningKeysMock.defaultExpectation.expectationOrigins.originRetiredAt, *mm_want_ptrs.retiredAt, mm_got.retiredAt, minimock.Diff(*mm_want_ptrs.retiredAt, mm_got.retiredAt))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRetireSigningKeys.t.Errorf("KeyRepositoryMock.RetireSigningKeys got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRetireSigningKeys.RetireSigningKeysMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRetireSigningKeys.RetireSigningKeysMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRetireSigningKeys.t.Fatal("No results are set for the KeyRepositoryMock.RetireSigningKeys")
+		}
+		return (*mm_results).err
+	}
+	if mmRetireSigningKeys.funcRetireSigningKeys != nil {
+		return mmRetireSigningKeys.funcRetireSigningKeys(ctx, createdBefore, retiredAt)
+	}
+	mmRetireSigningKeys.t.Fatalf("Unexpected call to KeyRepositoryMock.RetireSigningKeys. %v %v %v", ctx, createdBefore, retiredAt)
+	return
+}
+
+// RetireSigningKeysAfterCounter returns a count of finished KeyRepositoryMock.RetireSigningKeys invocations
+func (mmRetireSigningKeys *KeyRepositoryMock) RetireSigningKeysAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRetireSigningKeys.afterRetireSigningKeysCounter)
+}
+
+// RetireSigningKeysBeforeCounter returns a count of KeyRepositoryMock.RetireSigningKeys invocations
+func (mmRetireSigningKeys *KeyRepositoryMock) RetireSigningKeysBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRetireSigningKeys.beforeRetireSigningKeysCounter)
+}
+
+// Calls returns a list of arguments used in each call to KeyRepositoryMock.RetireSigningKeys.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRetireSigningKeys *mKeyRepositoryMockRetireSigningKeys) Calls() []*KeyRepositoryMockRetireSigningKeysParams {
+	mmRetireSigningKeys.mutex.RLock()
+
+	argCopy := make([]*KeyRepositoryMockRetireSigningKeysParams, len(mmRetireSigningKeys.callArgs))
+	copy(argCopy, mmRetireSigningKeys.callArgs)
+
+	mmRetireSigningKeys.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRetireSigningKeysDone returns true if the count of the RetireSigningKeys invocations corresponds
+// the number of defined expectations
+func (m *KeyRepositoryMock) MinimockRetireSigningKeysDone() bool {
+	if m.RetireSigningKeysMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RetireSigningKeysMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RetireSigningKeysMock.invocationsDone()
+}
+
+// MinimockRetireSigningKeysInspect logs each unmet expectation
+func (m *KeyRepositoryMock) MinimockRetireSigningKeysInspect() {
+	for _, e := range m.RetireSigningKeysMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to KeyRepositoryMock.RetireSigningKeys at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterRetireSigningKeysCounter := mm_atomic.LoadUint64(&m.afterRetireSigningKeysCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RetireSigningKeysMock.defaultExpectation != nil && afterRetireSigningKeysCounter < 1 {
+		if m.RetireSigningKeysMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to KeyRepositoryMock.RetireSigningKeys at\n%s", m.RetireSigningKeysMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to KeyRepositoryMock.RetireSigningKeys at\n%s with params: %#v", m.RetireSigningKeysMock.defaultExpectation.expectationOrigins.origin, *m.RetireSigningKeysMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRetireSigningKeys != nil && afterRetireSigningKeysCounter < 1 {
+		m.t.Errorf("Expected call to KeyRepositoryMock.RetireSigningKeys at\n%s", m.funcRetireSigningKeysOrigin)
+	}
+
+	if !m.RetireSigningKeysMock.invocationsDone() && afterRetireSigningKeysCounter > 0 {
+		m.t.Errorf("Expected %d calls to KeyRepositoryMock.RetireSigningKeys at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RetireSigningKeysMock.expectedInvocations), m.RetireSigningKeysMock.expectedInvocationsOrigin, afterRetireSigningKeysCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *KeyRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCreateSigningKeyInspect()
+
+			m.MinimockListSigningKeysInspect()
+
+			m.MinimockRetireSigningKeysInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *KeyRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *KeyRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCreateSigningKeyDone() &&
+		m.MinimockListSigningKeysDone() &&
+		m.MinimockRetireSigningKeysDone()
+}
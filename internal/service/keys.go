@@ -0,0 +1,203 @@
+package service
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/alonsoF100/authorization-service/internal/models"
+	"github.com/google/uuid"
+)
+
+const SigningAlgorithmES256 = "ES256"
+
+type KeyRepository interface {
+	CreateSigningKey(ctx context.Context, key *models.SigningKey) error
+	ListSigningKeys(ctx context.Context) ([]models.SigningKey, error)
+	RetireSigningKeys(ctx context.Context, createdBefore, retiredAt time.Time) error
+}
+
+// KeyService keeps the JWT signing keys in memory. The newest key that is
+// not retired signs new tokens, every key that is not retired verifies them.
+type KeyService struct {
+	keyRepository KeyRepository
+
+	mu     sync.RWMutex
+	active *models.SigningKey
+	keys   map[string]*models.SigningKey
+}
+
+func NewKeyService(repository KeyRepository) *KeyService {
+	return &KeyService{
+		keyRepository: repository,
+		keys:          make(map[string]*models.SigningKey),
+	}
+}
+
+func (s *KeyService) Load(ctx context.Context) error {
+	const op = "service/keys.go/Load"
+
+	keys, err := s.keyRepository.ListSigningKeys(ctx)
+	if err != nil {
+		slog.Error("Database error during signing keys loading",
+			slog.String("op", op),
+			slog.String("error", err.Error()),
+		)
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	var active *models.SigningKey
+	loaded := make(map[string]*models.SigningKey, len(keys))
+	for i := range keys {
+		key := &keys[i]
+		if key.RetiredAt != nil {
+			continue
+		}
+
+		loaded[key.ID] = key
+		if active == nil || key.CreatedAt.After(active.CreatedAt) {
+			active = key
+		}
+	}
+
+	s.mu.Lock()
+	s.keys = loaded
+	s.active = active
+	s.mu.Unlock()
+
+	slog.Debug("Signing keys loaded",
+		slog.String("op", op),
+		slog.Int("count", len(loaded)),
+	)
+
+	return nil
+}
+
+// Refresh reloads the keys every interval so keys rotated by another
+// instance are picked up. It blocks until ctx is done.
+func (s *KeyService) Refresh(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			_ = s.Load(ctx)
+		}
+	}
+}
+
+// Rotate creates a new active key. The previous key stays valid so issued
+// tokens keep working, all older keys are retired.
+func (s *KeyService) Rotate(ctx context.Context) (*models.SigningKey, error) {
+	const op = "service/keys.go/Rotate"
+
+	if err := s.Load(ctx); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	previous := s.ActiveKey()
+
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		slog.Error("Failed to generate signing key",
+			slog.String("op", op),
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	key := &models.SigningKey{
+		ID:         uuid.New().String(),
+		Algorithm:  SigningAlgorithmES256,
+		PrivateKey: privateKey,
+		CreatedAt:  time.Now(),
+	}
+
+	if err := s.keyRepository.CreateSigningKey(ctx, key); err != nil {
+		slog.Error("Database error during key rotation",
+			slog.String("op", op),
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if previous != nil {
+		if err := s.keyRepository.RetireSigningKeys(ctx, previous.CreatedAt, time.Now()); err != nil {
+			slog.Error("Database error during key rotation",
+				slog.String("op", op),
+				slog.String("error", err.Error()),
+			)
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	if err := s.Load(ctx); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	slog.Info("Signing key rotated",
+		slog.String("op", op),
+		slog.String("kid", key.ID),
+	)
+
+	return key, nil
+}
+
+func (s *KeyService) List(ctx context.Context) ([]models.SigningKey, error) {
+	const op = "service/keys.go/List"
+
+	keys, err := s.keyRepository.ListSigningKeys(ctx)
+	if err != nil {
+		slog.Error("Database error during signing keys listing",
+			slog.String("op", op),
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return keys, nil
+}
+
+func (s *KeyService) ActiveKey() *models.SigningKey {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.active
+}
+
+func (s *KeyService) FindKey(kid string) *models.SigningKey {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.keys[kid]
+}
+
+// PublicKeys returns the verification keys of every key that is not
+// retired, ordered by kid
+func (s *KeyService) PublicKeys() []models.PublicKey {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	keys := make([]models.PublicKey, 0, len(s.keys))
+	for _, key := range s.keys {
+		keys = append(keys, models.PublicKey{
+			ID:        key.ID,
+			Algorithm: key.Algorithm,
+			Key:       &key.PrivateKey.PublicKey,
+		})
+	}
+	slices.SortFunc(keys, func(a, b models.PublicKey) int {
+		return strings.Compare(a.ID, b.ID)
+	})
+
+	return keys
+}
@@ -0,0 +1,152 @@
+package service_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/alonsoF100/authorization-service/internal/apperrors"
+	"github.com/alonsoF100/authorization-service/internal/config"
+	"github.com/alonsoF100/authorization-service/internal/models"
+	"github.com/alonsoF100/authorization-service/internal/service"
+	"github.com/gojuno/minimock/v3"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func newSigningKey(t *testing.T, createdAt time.Time) models.SigningKey {
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	return models.SigningKey{
+		ID:         uuid.New().String(),
+		Algorithm:  service.SigningAlgorithmES256,
+		PrivateKey: privateKey,
+		CreatedAt:  createdAt,
+	}
+}
+
+func TestKeyServiceLoad(t *testing.T) {
+	mc := minimock.NewController(t)
+	mockRepo := service.NewKeyRepositoryMock(mc)
+
+	now := time.Now()
+	retiredAt := now
+	oldest := newSigningKey(t, now.Add(-2*time.Hour))
+	oldest.RetiredAt = &retiredAt
+	previous := newSigningKey(t, now.Add(-time.Hour))
+	newest := newSigningKey(t, now)
+
+	mockRepo.ListSigningKeysMock.Return([]models.SigningKey{previous, newest, oldest}, nil)
+
+	keyService := service.NewKeyService(mockRepo)
+	require.NoError(t, keyService.Load(context.Background()))
+
+	require.Equal(t, newest.ID, keyService.ActiveKey().ID)
+	require.NotNil(t, keyService.FindKey(previous.ID))
+	require.Nil(t, keyService.FindKey(oldest.ID))
+}
+
+func TestKeyServiceLoadDatabaseError(t *testing.T) {
+	mc := minimock.NewController(t)
+	mockRepo := service.NewKeyRepositoryMock(mc)
+	someErr := errors.New("database error")
+
+	mockRepo.ListSigningKeysMock.Return(nil, someErr)
+
+	keyService := service.NewKeyService(mockRepo)
+
+	require.ErrorIs(t, keyService.Load(context.Background()), someErr)
+	require.Nil(t, keyService.ActiveKey())
+}
+
+func TestKeyServiceRotate(t *testing.T) {
+	mc := minimock.NewController(t)
+	mockRepo := service.NewKeyRepositoryMock(mc)
+
+	previous := newSigningKey(t, time.Now().Add(-time.Hour))
+	stored := []models.SigningKey{previous}
+
+	mockRepo.ListSigningKeysMock.Set(func(ctx context.Context) ([]models.SigningKey, error) {
+		return stored, nil
+	})
+	mockRepo.CreateSigningKeyMock.Set(func(ctx context.Context, key *models.SigningKey) error {
+		require.Equal(t, service.SigningAlgorithmES256, key.Algorithm)
+		require.NotNil(t, key.PrivateKey)
+		stored = append(stored, *key)
+		return nil
+	})
+	mockRepo.RetireSigningKeysMock.Set(func(ctx context.Context, createdBefore, retiredAt time.Time) error {
+		require.Equal(t, previous.CreatedAt, createdBefore)
+		return nil
+	})
+
+	keyService := service.NewKeyService(mockRepo)
+
+	key, err := keyService.Rotate(context.Background())
+	require.NoError(t, err)
+
+	require.Equal(t, key.ID, keyService.ActiveKey().ID)
+	require.NotNil(t, keyService.FindKey(previous.ID))
+}
+
+func TestAuthServiceSignsWithActiveKey(t *testing.T) {
+	mc := minimock.NewController(t)
+	mockRepo := service.NewKeyRepositoryMock(mc)
+
+	key := newSigningKey(t, time.Now())
+	mockRepo.ListSigningKeysMock.Return([]models.SigningKey{key}, nil)
+
+	keyService := service.NewKeyService(mockRepo)
+	require.NoError(t, keyService.Load(context.Background()))
+
+	cfg := &config.Config{
+		JWT: config.JWTConfig{
+			SecretKey: "someSecretsomeSecretsomeSecretsomeSecret",
+			Expiry:    time.Hour,
+		},
+	}
+	authRepo := service.NewAuthRepositoryMock(mc)
+	authRepo.IsTokenRevokedMock.Return(false, nil)
+	authService := service.NewAuthService(authRepo, keyService, nil, cfg)
+
+	user := &models.User{ID: uuid.New().String(), Email: "alonso@yandex.ru", Roles: []string{models.RoleAdmin}}
+	tokenString, err := authService.GenerateJWT(user)
+	require.NoError(t, err)
+
+	token, _, err := jwt.NewParser().ParseUnverified(tokenString, &models.Claims{})
+	require.NoError(t, err)
+	require.Equal(t, "ES256", token.Method.Alg())
+	require.Equal(t, key.ID, token.Header["kid"])
+
+	claims, err := authService.ValidateJWT(context.Background(), tokenString)
+	require.NoError(t, err)
+	require.Equal(t, user.ID, claims.ID)
+	require.Equal(t, []string{models.RoleAdmin}, claims.Roles)
+
+	// tokens signed with the legacy secret are rejected unless accepted
+	// explicitly
+	legacyToken, err := service.NewAuthService(nil, nil, nil, cfg).GenerateJWT(user)
+	require.NoError(t, err)
+	_, err = authService.ValidateJWT(context.Background(), legacyToken)
+	require.ErrorIs(t, err, apperrors.ErrInvalidToken)
+
+	cfg.JWT.AcceptLegacyHS256 = true
+	_, err = authService.ValidateJWT(context.Background(), legacyToken)
+	require.NoError(t, err)
+
+	// tokens signed by a key the service doesn't know are rejected
+	unknown := newSigningKey(t, time.Now())
+	forged := jwt.NewWithClaims(jwt.SigningMethodES256, models.Claims{ID: user.ID})
+	forged.Header["kid"] = unknown.ID
+	forgedString, err := forged.SignedString(unknown.PrivateKey)
+	require.NoError(t, err)
+
+	_, err = authService.ValidateJWT(context.Background(), forgedString)
+	require.ErrorIs(t, err, apperrors.ErrInvalidToken)
+}
@@ -0,0 +1,347 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/alonsoF100/authorization-service/internal/apperrors"
+	"github.com/alonsoF100/authorization-service/internal/config"
+	"github.com/alonsoF100/authorization-service/internal/models"
+	"github.com/google/uuid"
+)
+
+const (
+	clientSecretBytes     = 32
+	authorizationCodeSize = 32
+)
+
+// SupportedGrants lists the grant types a client can be registered with
+var SupportedGrants = []string{models.GrantAuthorizationCode, models.GrantClientCredentials}
+
+type OAuthRepository interface {
+	CreateClient(ctx context.Context, client *models.Client) error
+	FindClientByID(ctx context.Context, clientID string) (*models.Client, error)
+	FindByID(ctx context.Context, userID string) (*models.User, error)
+	RevokeToken(ctx context.Context, jti string, expiresAt time.Time) error
+	PurgeRevokedTokens(ctx context.Context, expiredBefore time.Time) (int64, error)
+	CreateAuthorizationCode(ctx context.Context, code *models.AuthorizationCode) error
+	ConsumeAuthorizationCode(ctx context.Context, codeHash string) (*models.AuthorizationCode, error)
+	PurgeAuthorizationCodes(ctx context.Context, expiredBefore time.Time) (int64, error)
+	FindConsent(ctx context.Context, userID, clientID string) (*models.Consent, error)
+	SaveConsent(ctx context.Context, consent *models.Consent) error
+	DeleteSession(ctx context.Context, userID, sessionID string) error
+	PurgeSessions(ctx context.Context, expiredBefore time.Time) (int64, error)
+}
+
+// TokenIssuer signs access and ID tokens for OAuth grants and checks the
+// tokens it issued that are not access tokens, usually AuthService
+type TokenIssuer interface {
+	GenerateScopedJWT(user *models.User, clientID, scope, sessionID string) (string, error)
+	GenerateClientJWT(client *models.Client, scope string) (string, error)
+	GenerateIDToken(user *models.User, code *models.AuthorizationCode, accessToken string) (string, error)
+	ParseIDToken(tokenString string) (*models.IDTokenClaims, error)
+	ValidateSessionToken(ctx context.Context, tokenString string) (*models.Claims, error)
+}
+
+// OAuthService is the authorization server and OpenID provider. It registers
+// and authenticates clients, runs the authorization code grant and answers
+// introspection, revocation and userinfo requests for the tokens issued by
+// AuthService.
+type OAuthService struct {
+	oauthRepository OAuthRepository
+	tokenValidator  TokenValidator
+	tokenIssuer     TokenIssuer
+	auditor         Auditor
+	cfg             *config.Config
+}
+
+func NewOAuthService(repository OAuthRepository, tokenValidator TokenValidator, tokenIssuer TokenIssuer, auditor Auditor, cfg *config.Config) *OAuthService {
+	return &OAuthService{
+		oauthRepository: repository,
+		tokenValidator:  tokenValidator,
+		tokenIssuer:     tokenIssuer,
+		auditor:         auditor,
+		cfg:             cfg,
+	}
+}
+
+// CreateClient registers a client and returns its secret, the secret is not
+// stored and can not be shown again.
+func (s OAuthService) CreateClient(ctx context.Context, name string, redirectURIs, postLogoutRedirectURIs, grantTypes, scopes []string) (*models.Client, string, error) {
+	const op = "service/oauth.go/CreateClient"
+
+	for _, redirectURI := range slices.Concat(redirectURIs, postLogoutRedirectURIs) {
+		if err := validateRedirectURI(redirectURI); err != nil {
+			return nil, "", fmt.Errorf("%s: %w", op, err)
+		}
+	}
+	for _, grantType := range grantTypes {
+		if !slices.Contains(SupportedGrants, grantType) {
+			return nil, "", fmt.Errorf("%s: unsupported grant type %q, supported %v", op, grantType, SupportedGrants)
+		}
+	}
+	if slices.Contains(grantTypes, models.GrantAuthorizationCode) && len(redirectURIs) == 0 {
+		return nil, "", fmt.Errorf("%s: the %s grant needs a redirect uri", op, models.GrantAuthorizationCode)
+	}
+	for _, scope := range scopes {
+		if !validScope(scope) {
+			return nil, "", fmt.Errorf("%s: invalid scope %q", op, scope)
+		}
+	}
+
+	clientSecret, err := randomToken(clientSecretBytes)
+	if err != nil {
+		return nil, "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	client := &models.Client{
+		ID:                     uuid.New().String(),
+		Name:                   name,
+		SecretHash:             hashSecret(clientSecret),
+		RedirectURIs:           redirectURIs,
+		PostLogoutRedirectURIs: postLogoutRedirectURIs,
+		GrantTypes:             grantTypes,
+		Scopes:                 scopes,
+		CreatedAt:              time.Now(),
+	}
+
+	if err := s.oauthRepository.CreateClient(ctx, client); err != nil {
+		slog.Error("Database error during client creation",
+			slog.String("op", op),
+			slog.String("error", err.Error()),
+		)
+		return nil, "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	slog.Info("Client created",
+		slog.String("op", op),
+		slog.String("client_id", client.ID),
+		slog.String("name", client.Name),
+	)
+	record(ctx, s.auditor, models.AuditEvent{
+		Type:     models.AuditClientCreated,
+		TargetID: client.ID,
+		Details:  map[string]string{"name": client.Name},
+	})
+
+	return client, clientSecret, nil
+}
+
+func (s OAuthService) AuthenticateClient(ctx context.Context, clientID, clientSecret string) (*models.Client, error) {
+	const op = "service/oauth.go/AuthenticateClient"
+
+	if clientID == "" || clientSecret == "" {
+		return nil, apperrors.ErrInvalidClient
+	}
+
+	client, err := s.oauthRepository.FindClientByID(ctx, clientID)
+	if err != nil {
+		slog.Error("Database error during client authentication",
+			slog.String("op", op),
+			slog.String("client_id", clientID),
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// an unknown client costs the same comparison as a wrong secret
+	secretHash := hashSecret("")
+	if client != nil {
+		secretHash = client.SecretHash
+	}
+
+	if subtle.ConstantTimeCompare([]byte(secretHash), []byte(hashSecret(clientSecret))) != 1 || client == nil {
+		slog.Info("Client authentication failed",
+			slog.String("op", op),
+			slog.String("client_id", clientID),
+		)
+		return nil, apperrors.ErrInvalidClient
+	}
+
+	return client, nil
+}
+
+// Introspect returns the claims of an active token. A token is active when
+// its signature is valid, it was not revoked and its owner still exists and
+// is not disabled. Every inactive token gives apperrors.ErrInvalidToken so
+// callers can not tell the reasons apart.
+func (s OAuthService) Introspect(ctx context.Context, token string) (*models.Claims, error) {
+	const op = "service/oauth.go/Introspect"
+
+	claims, err := s.tokenValidator.ValidateJWT(ctx, token)
+	if err != nil {
+		return nil, apperrors.ErrInvalidToken
+	}
+
+	active, err := s.ownerActive(ctx, claims)
+	if err != nil {
+		slog.Error("Database error during introspection",
+			slog.String("op", op),
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if !active {
+		slog.Debug("Token owner is missing or disabled",
+			slog.String("op", op),
+			slog.String("sub", claims.Subject),
+		)
+		return nil, apperrors.ErrInvalidToken
+	}
+
+	return claims, nil
+}
+
+func (s OAuthService) ownerActive(ctx context.Context, claims *models.Claims) (bool, error) {
+	switch {
+	case claims.ID != "":
+		user, err := s.oauthRepository.FindByID(ctx, claims.ID)
+		if err != nil {
+			return false, err
+		}
+		return user != nil && !user.Disabled(), nil
+	case claims.ClientID != "":
+		client, err := s.oauthRepository.FindClientByID(ctx, claims.ClientID)
+		if err != nil {
+			return false, err
+		}
+		return client != nil, nil
+	default:
+		return false, nil
+	}
+}
+
+// Revoke revokes a token following RFC 7009. Invalid tokens and tokens
+// issued to another client are ignored, the caller always gets success.
+// Tokens from sign in were issued to no client, no client can revoke them.
+func (s OAuthService) Revoke(ctx context.Context, client *models.Client, token string) error {
+	const op = "service/oauth.go/Revoke"
+
+	claims, err := s.tokenValidator.ValidateJWT(ctx, token)
+	if err != nil {
+		return nil
+	}
+
+	jti := claims.RegisteredClaims.ID
+	if jti == "" || claims.ExpiresAt == nil {
+		slog.Debug("Token can not be revoked",
+			slog.String("op", op),
+			slog.String("sub", claims.Subject),
+		)
+		return nil
+	}
+
+	if claims.ClientID != client.ID {
+		slog.Info("Client tried to revoke a token it was not issued",
+			slog.String("op", op),
+			slog.String("client_id", client.ID),
+			slog.String("jti", jti),
+		)
+		return nil
+	}
+
+	if err := s.oauthRepository.RevokeToken(ctx, jti, claims.ExpiresAt.Time); err != nil {
+		slog.Error("Database error during revocation",
+			slog.String("op", op),
+			slog.String("jti", jti),
+			slog.String("error", err.Error()),
+		)
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	slog.Info("Token revoked",
+		slog.String("op", op),
+		slog.String("client_id", client.ID),
+		slog.String("jti", jti),
+	)
+
+	return nil
+}
+
+// PurgeExpired drops expired authorization codes, sign in sessions and
+// revocations of expired tokens every interval, those fail validation
+// anyway. It blocks until ctx is done.
+func (s OAuthService) PurgeExpired(ctx context.Context, interval time.Duration) {
+	const op = "service/oauth.go/PurgeExpired"
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	purges := []struct {
+		name  string
+		purge func(ctx context.Context, expiredBefore time.Time) (int64, error)
+	}{
+		{"revoked_tokens", s.oauthRepository.PurgeRevokedTokens},
+		{"authorization_codes", s.oauthRepository.PurgeAuthorizationCodes},
+		{"sessions", s.oauthRepository.PurgeSessions},
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			for _, p := range purges {
+				purged, err := p.purge(ctx, time.Now())
+				if err != nil {
+					if !errors.Is(err, context.Canceled) {
+						slog.Error("Failed to purge expired entries",
+							slog.String("op", op),
+							slog.String("table", p.name),
+							slog.String("error", err.Error()),
+						)
+					}
+					continue
+				}
+				if purged > 0 {
+					slog.Debug("Expired entries purged",
+						slog.String("op", op),
+						slog.String("table", p.name),
+						slog.Int64("count", purged),
+					)
+				}
+			}
+		}
+	}
+}
+
+// validScope keeps scopes usable in the space separated scope claim
+func validScope(scope string) bool {
+	return scope != "" && !strings.ContainsAny(scope, " \t\n\"\\")
+}
+
+func hashSecret(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
+
+func randomToken(size int) (string, error) {
+	token := make([]byte, size)
+	if _, err := rand.Read(token); err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(token), nil
+}
+
+// validateRedirectURI follows RFC 6749 3.1.2, an absolute uri without a
+// fragment
+func validateRedirectURI(redirectURI string) error {
+	parsed, err := url.Parse(redirectURI)
+	if err != nil || parsed.Scheme == "" || parsed.Host == "" || parsed.Fragment != "" {
+		return fmt.Errorf("invalid redirect uri %q, it must be absolute and without a fragment", redirectURI)
+	}
+
+	return nil
+}
@@ -0,0 +1,4423 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package service
+
+//go:generate minimock -i github.com/alonsoF100/authorization-service/internal/service.OAuthRepository -o oauth_repository_mock_test.go -n OAuthRepositoryMock -p service
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	"time"
+	mm_time "time"
+
+	"github.com/alonsoF100/authorization-service/internal/models"
+	"github.com/gojuno/minimock/v3"
+)
+
+// OAuthRepositoryMock implements OAuthRepository
+type OAuthRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcConsumeAuthorizationCode          func(ctx context.Context, codeHash string) (ap1 *models.AuthorizationCode, err error)
+	funcConsumeAuthorizationCodeOrigin    string
+	inspectFuncConsumeAuthorizationCode   func(ctx context.Context, codeHash string)
+	afterConsumeAuthorizationCodeCounter  uint64
+	beforeConsumeAuthorizationCodeCounter uint64
+	ConsumeAuthorizationCodeMock          mOAuthRepositoryMockConsumeAuthorizationCode
+
+	funcCreateAuthorizationCode          func(ctx context.Context, code *models.AuthorizationCode) (err error)
+	funcCreateAuthorizationCodeOrigin    string
+	inspectFuncCreateAuthorizationCode   func(ctx context.Context, code *models.AuthorizationCode)
+	afterCreateAuthorizationCodeCounter  uint64
+	beforeCreateAuthorizationCodeCounter uint64
+	CreateAuthorizationCodeMock          mOAuthRepositoryMockCreateAuthorizationCode
+
+	funcCreateClient          func(ctx context.Context, client *models.Client) (err error)
+	funcCreateClientOrigin    string
+	inspectFuncCreateClient   func(ctx context.Context, client *models.Client)
+	afterCreateClientCounter  uint64
+	beforeCreateClientCounter uint64
+	CreateClientMock          mOAuthRepositoryMockCreateClient
+
+	funcDeleteSession          func(ctx context.Context, userID string, sessionID string) (err error)
+	funcDeleteSessionOrigin    string
+	inspectFuncDeleteSession   func(ctx context.Context, userID string, sessionID string)
+	afterDeleteSessionCounter  uint64
+	beforeDeleteSessionCounter uint64
+	DeleteSessionMock          mOAuthRepositoryMockDeleteSession
+
+	funcFindByID          func(ctx context.Context, userID string) (up1 *models.User, err error)
+	funcFindByIDOrigin    string
+	inspectFuncFindByID   func(ctx context.Context, userID string)
+	afterFindByIDCounter  uint64
+	beforeFindByIDCounter uint64
+	FindByIDMock          mOAuthRepositoryMockFindByID
+
+	funcFindClientByID          func(ctx context.Context, clientID string) (cp1 *models.Client, err error)
+	funcFindClientByIDOrigin    string
+	inspectFuncFindClientByID   func(ctx context.Context, clientID string)
+	afterFindClientByIDCounter  uint64
+	beforeFindClientByIDCounter uint64
+	FindClientByIDMock          mOAuthRepositoryMockFindClientByID
+
+	funcFindConsent          func(ctx context.Context, userID string, clientID string) (cp1 *models.Consent, err error)
+	funcFindConsentOrigin    string
+	inspectFuncFindConsent   func(ctx context.Context, userID string, clientID string)
+	afterFindConsentCounter  uint64
+	beforeFindConsentCounter uint64
+	FindConsentMock          mOAuthRepositoryMockFindConsent
+
+	funcPurgeAuthorizationCodes          func(ctx context.Context, expiredBefore time.Time) (i1 int64, err error)
+	funcPurgeAuthorizationCodesOrigin    string
+	inspectFuncPurgeAuthorizationCodes   func(ctx context.Context, expiredBefore time.Time)
+	afterPurgeAuthorizationCodesCounter  uint64
+	beforePurgeAuthorizationCodesCounter uint64
+	PurgeAuthorizationCodesMock          mOAuthRepositoryMockPurgeAuthorizationCodes
+
+	funcPurgeRevokedTokens          func(ctx context.Context, expiredBefore time.Time) (i1 int64, err error)
+	funcPurgeRevokedTokensOrigin    string
+	inspectFuncPurgeRevokedTokens   func(ctx context.Context, expiredBefore time.Time)
+	afterPurgeRevokedTokensCounter  uint64
+	beforePurgeRevokedTokensCounter uint64
+	PurgeRevokedTokensMock          mOAuthRepositoryMockPurgeRevokedTokens
+
+	funcPurgeSessions          func(ctx context.Context, expiredBefore time.Time) (i1 int64, err error)
+	funcPurgeSessionsOrigin    string
+	inspectFuncPurgeSessions   func(ctx context.Context, expiredBefore time.Time)
+	afterPurgeSessionsCounter  uint64
+	beforePurgeSessionsCounter uint64
+	PurgeSessionsMock          mOAuthRepositoryMockPurgeSessions
+
+	funcRevokeToken          func(ctx context.Context, jti string, expiresAt time.Time) (err error)
+	funcRevokeTokenOrigin    string
+	inspectFuncRevokeToken   func(ctx context.Context, jti string, expiresAt time.Time)
+	afterRevokeTokenCounter  uint64
+	beforeRevokeTokenCounter uint64
+	RevokeTokenMock          mOAuthRepositoryMockRevokeToken
+
+	funcSaveConsent          func(ctx context.Context, consent *models.Consent) (err error)
+	funcSaveConsentOrigin    string
+	inspectFuncSaveConsent   func(ctx context.Context, consent *models.Consent)
+	afterSaveConsentCounter  uint64
+	beforeSaveConsentCounter uint64
+	SaveConsentMock          mOAuthRepositoryMockSaveConsent
+}
+
+// NewOAuthRepositoryMock returns a mock for OAuthRepository
+func NewOAuthRepositoryMock(t minimock.Tester) *OAuthRepositoryMock {
+	m := &OAuthRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.ConsumeAuthorizationCodeMock = mOAuthRepositoryMockConsumeAuthorizationCode{mock: m}
+	m.ConsumeAuthorizationCodeMock.callArgs = []*OAuthRepositoryMockConsumeAuthorizationCodeParams{}
+
+	m.CreateAuthorizationCodeMock = mOAuthRepositoryMockCreateAuthorizationCode{mock: m}
+	m.CreateAuthorizationCodeMock.callArgs = []*OAuthRepositoryMockCreateAuthorizationCodeParams{}
+
+	m.CreateClientMock = mOAuthRepositoryMockCreateClient{mock: m}
+	m.CreateClientMock.callArgs = []*OAuthRepositoryMockCreateClientParams{}
+
+	m.DeleteSessionMock = mOAuthRepositoryMockDeleteSession{mock: m}
+	m.DeleteSessionMock.callArgs = []*OAuthRepositoryMockDeleteSessionParams{}
+
+	m.FindByIDMock = mOAuthRepositoryMockFindByID{mock: m}
+	m.FindByIDMock.callArgs = []*OAuthRepositoryMockFindByIDParams{}
+
+	m.FindClientByIDMock = mOAuthRepositoryMockFindClientByID{mock: m}
+	m.FindClientByIDMock.callArgs = []*OAuthRepositoryMockFindClientByIDParams{}
+
+	m.FindConsentMock = mOAuthRepositoryMockFindConsent{mock: m}
+	m.FindConsentMock.callArgs = []*OAuthRepositoryMockFindConsentParams{}
+
+	m.PurgeAuthorizationCodesMock = mOAuthRepositoryMockPurgeAuthorizationCodes{mock: m}
+	m.PurgeAuthorizationCodesMock.callArgs = []*OAuthRepositoryMockPurgeAuthorizationCodesParams{}
+
+	m.PurgeRevokedTokensMock = mOAuthRepositoryMockPurgeRevokedTokens{mock: m}
+	m.PurgeRevokedTokensMock.callArgs = []*OAuthRepositoryMockPurgeRevokedTokensParams{}
+
+	m.PurgeSessionsMock = mOAuthRepositoryMockPurgeSessions{mock: m}
+	m.PurgeSessionsMock.callArgs = []*OAuthRepositoryMockPurgeSessionsParams{}
+
+	m.RevokeTokenMock = mOAuthRepositoryMockRevokeToken{mock: m}
+	m.RevokeTokenMock.callArgs = []*OAuthRepositoryMockRevokeTokenParams{}
+
+	m.SaveConsentMock = mOAuthRepositoryMockSaveConsent{mock: m}
+	m.SaveConsentMock.callArgs = []*OAuthRepositoryMockSaveConsentParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mOAuthRepositoryMockConsumeAuthorizationCode struct {
+	optional           bool
+	mock               *OAuthRepositoryMock
+	defaultExpectation *OAuthRepositoryMockConsumeAuthorizationCodeExpectation
+	expectations       []*OAuthRepositoryMockConsumeAuthorizationCodeExpectation
+
+	callArgs []*OAuthRepositoryMockConsumeAuthorizationCodeParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OAuthRepositoryMockConsumeAuthorizationCodeExpectation specifies expectation struct of the OAuthRepository.ConsumeAuthorizationCode
+type OAuthRepositoryMockConsumeAuthorizationCodeExpectation struct {
+	mock               *OAuthRepositoryMock
+	params             *OAuthRepositoryMockConsumeAuthorizationCodeParams
+	paramPtrs          *OAuthRepositoryMockConsumeAuthorizationCodeParamPtrs
+	expectationOrigins OAuthRepositoryMockConsumeAuthorizationCodeExpectationOrigins
+	results            *OAuthRepositoryMockConsumeAuthorizationCodeResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// OAuthRepositoryMockConsumeAuthorizationCodeParams contains parameters of the OAuthRepository.ConsumeAuthorizationCode
+type OAuthRepositoryMockConsumeAuthorizationCodeParams struct {
+	ctx      context.Context
+	codeHash string
+}
+
+// OAuthRepositoryMockConsumeAuthorizationCodeParamPtrs contains pointers to parameters of the OAuthRepository.ConsumeAuthorizationCode
+type OAuthRepositoryMockConsumeAuthorizationCodeParamPtrs struct {
+	ctx      *context.Context
+	codeHash *string
+}
+
+// OAuthRepositoryMockConsumeAuthorizationCodeResults contains results of the OAuthRepository.ConsumeAuthorizationCode
+type OAuthRepositoryMockConsumeAuthorizationCodeResults struct {
+	ap1 *models.AuthorizationCode
+	err error
+}
+
+// OAuthRepositoryMockConsumeAuthorizationCodeOrigins contains origins of expectations of the OAuthRepository.ConsumeAuthorizationCode
+type OAuthRepositoryMockConsumeAuthorizationCodeExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originCodeHash string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmConsumeAuthorizationCode *mOAuthRepositoryMockConsumeAuthorizationCode) Optional() *mOAuthRepositoryMockConsumeAuthorizationCode {
+	mmConsumeAuthorizationCode.optional = true
+	return mmConsumeAuthorizationCode
+}
+
+// Expect sets up expected params for OAuthRepository.ConsumeAuthorizationCode
+func (mmConsumeAuthorizationCode *mOAuthRepositoryMockConsumeAuthorizationCode) Expect(ctx context.Context, codeHash string) *mOAuthRepositoryMockConsumeAuthorizationCode {
+	if mmConsumeAuthorizationCode.mock.funcConsumeAuthorizationCode != nil {
+		mmConsumeAuthorizationCode.mock.t.Fatalf("OAuthRepositoryMock.ConsumeAuthorizationCode mock is already set by Set")
+	}
+
+	if mmConsumeAuthorizationCode.defaultExpectation == nil {
+		mmConsumeAuthorizationCode.defaultExpectation = &OAuthRepositoryMockConsumeAuthorizationCodeExpectation{}
+	}
+
+	if mmConsumeAuthorizationCode.defaultExpectation.paramPtrs != nil {
+		mmConsumeAuthorizationCode.mock.t.Fatalf("OAuthRepositoryMock.ConsumeAuthorizationCode mock is already set by ExpectParams functions")
+	}
+
+	mmConsumeAuthorizationCode.defaultExpectation.params = &OAuthRepositoryMockConsumeAuthorizationCodeParams{ctx, codeHash}
+	mmConsumeAuthorizationCode.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmConsumeAuthorizationCode.expectations {
+		if minimock.Equal(e.params, mmConsumeAuthorizationCode.defaultExpectation.params) {
+			mmConsumeAuthorizationCode.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmConsumeAuthorizationCode.defaultExpectation.params)
+		}
+	}
+
+	return mmConsumeAuthorizationCode
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OAuthRepository.ConsumeAuthorizationCode
+func (mmConsumeAuthorizationCode *mOAuthRepositoryMockConsumeAuthorizationCode) ExpectCtxParam1(ctx context.Context) *mOAuthRepositoryMockConsumeAuthorizationCode {
+	if mmConsumeAuthorizationCode.mock.funcConsumeAuthorizationCode != nil {
+		mmConsumeAuthorizationCode.mock.t.Fatalf("OAuthRepositoryMock.ConsumeAuthorizationCode mock is already set by Set")
+	}
+
+	if mmConsumeAuthorizationCode.defaultExpectation == nil {
+		mmConsumeAuthorizationCode.defaultExpectation = &OAuthRepositoryMockConsumeAuthorizationCodeExpectation{}
+	}
+
+	if mmConsumeAuthorizationCode.defaultExpectation.params != nil {
+		mmConsumeAuthorizationCode.mock.t.Fatalf("OAuthRepositoryMock.ConsumeAuthorizationCode mock is already set by Expect")
+	}
+
+	if mmConsumeAuthorizationCode.defaultExpectation.paramPtrs == nil {
+		mmConsumeAuthorizationCode.defaultExpectation.paramPtrs = &OAuthRepositoryMockConsumeAuthorizationCodeParamPtrs{}
+	}
+	mmConsumeAuthorizationCode.defaultExpectation.paramPtrs.ctx = &ctx
+	mmConsumeAuthorizationCode.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmConsumeAuthorizationCode
+}
+
+// ExpectCodeHashParam2 sets up expected param codeHash for OAuthRepository.ConsumeAuthorizationCode
+func (mmConsumeAuthorizationCode *mOAuthRepositoryMockConsumeAuthorizationCode) ExpectCodeHashParam2(codeHash string) *mOAuthRepositoryMockConsumeAuthorizationCode {
+	if mmConsumeAuthorizationCode.mock.funcConsumeAuthorizationCode != nil {
+		mmConsumeAuthorizationCode.mock.t.Fatalf("OAuthRepositoryMock.ConsumeAuthorizationCode mock is already set by Set")
+	}
+
+	if mmConsumeAuthorizationCode.defaultExpectation == nil {
+		mmConsumeAuthorizationCode.defaultExpectation = &OAuthRepositoryMockConsumeAuthorizationCodeExpectation{}
+	}
+
+	if mmConsumeAuthorizationCode.defaultExpectation.params != nil {
+		mmConsumeAuthorizationCode.mock.t.Fatalf("OAuthRepositoryMock.ConsumeAuthorizationCode mock is already set by Expect")
+	}
+
+	if mmConsumeAuthorizationCode.defaultExpectation.paramPtrs == nil {
+		mmConsumeAuthorizationCode.defaultExpectation.paramPtrs = &OAuthRepositoryMockConsumeAuthorizationCodeParamPtrs{}
+	}
+	mmConsumeAuthorizationCode.defaultExpectation.paramPtrs.codeHash = &codeHash
+	mmConsumeAuthorizationCode.defaultExpectation.expectationOrigins.originCodeHash = minimock.CallerInfo(1)
+
+	return mmConsumeAuthorizationCode
+}
+
+// Inspect accepts an inspector function that has same arguments as the OAuthRepository.ConsumeAuthorizationCode
+func (mmConsumeAuthorizationCode *mOAuthRepositoryMockConsumeAuthorizationCode) Inspect(f func(ctx context.Context, codeHash string)) *mOAuthRepositoryMockConsumeAuthorizationCode {
+	if mmConsumeAuthorizationCode.mock.inspectFuncConsumeAuthorizationCode != nil {
+		mmConsumeAuthorizationCode.mock.t.Fatalf("Inspect function is already set for OAuthRepositoryMock.ConsumeAuthorizationCode")
+	}
+
+	mmConsumeAuthorizationCode.mock.inspectFuncConsumeAuthorizationCode = f
+
+	return mmConsumeAuthorizationCode
+}
+
+// Return sets up results that will be returned by OAuthRepository.ConsumeAuthorizationCode
+func (mmConsumeAuthorizationCode *mOAuthRepositoryMockConsumeAuthorizationCode) Return(ap1 *models.AuthorizationCode, err error) *OAuthRepositoryMock {
+	if mmConsumeAuthorizationCode.mock.funcConsumeAuthorizationCode != nil {
+		mmConsumeAuthorizationCode.mock.t.Fatalf("OAuthRepositoryMock.ConsumeAuthorizationCode mock is already set by Set")
+	}
+
+	if mmConsumeAuthorizationCode.defaultExpectation == nil {
+		mmConsumeAuthorizationCode.defaultExpectation = &OAuthRepositoryMockConsumeAuthorizationCodeExpectation{mock: mmConsumeAuthorizationCode.mock}
+	}
+	mmConsumeAuthorizationCode.defaultExpectation.results = &OAuthRepositoryMockConsumeAuthorizationCodeResults{ap1, err}
+	mmConsumeAuthorizationCode.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmConsumeAuthorizationCode.mock
+}
+
+// Set uses given function f to mock the OAuthRepository.ConsumeAuthorizationCode method
+func (mmConsumeAuthorizationCode *mOAuthRepositoryMockConsumeAuthorizationCode) Set(f func(ctx context.Context, codeHash string) (ap1 *models.AuthorizationCode, err error)) *OAuthRepositoryMock {
+	if mmConsumeAuthorizationCode.defaultExpectation != nil {
+		mmConsumeAuthorizationCode.mock.t.Fatalf("Default expectation is already set for the OAuthRepository.ConsumeAuthorizationCode method")
+	}
+
+	if len(mmConsumeAuthorizationCode.expectations) > 0 {
+		mmConsumeAuthorizationCode.mock.t.Fatalf("Some expectations are already set for the OAuthRepository.ConsumeAuthorizationCode method")
+	}
+
+	mmConsumeAuthorizationCode.mock.funcConsumeAuthorizationCode = f
+	mmConsumeAuthorizationCode.mock.funcConsumeAuthorizationCodeOrigin = minimock.CallerInfo(1)
+	return mmConsumeAuthorizationCode.mock
+}
+
+// When sets expectation for the OAuthRepository.ConsumeAuthorizationCode which will trigger the result defined by the following
+// Then helper
+func (mmConsumeAuthorizationCode *mOAuthRepositoryMockConsumeAuthorizationCode) When(ctx context.Context, codeHash string) *OAuthRepositoryMockConsumeAuthorizationCodeExpectation {
+	if mmConsumeAuthorizationCode.mock.funcConsumeAuthorizationCode != nil {
+		mmConsumeAuthorizationCode.mock.t.Fatalf("OAuthRepositoryMock.ConsumeAuthorizationCode mock is already set by Set")
+	}
+
+	expectation := &OAuthRepositoryMockConsumeAuthorizationCodeExpectation{
+		mock:               mmConsumeAuthorizationCode.mock,
+		params:             &OAuthRepositoryMockConsumeAuthorizationCodeParams{ctx, codeHash},
+		expectationOrigins: OAuthRepositoryMockConsumeAuthorizationCodeExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmConsumeAuthorizationCode.expectations = append(mmConsumeAuthorizationCode.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OAuthRepository.ConsumeAuthorizationCode return parameters for the expectation previously defined by the When method
+func (e *OAuthRepositoryMockConsumeAuthorizationCodeExpectation) Then(ap1 *models.AuthorizationCode, err error) *OAuthRepositoryMock {
+	e.results = &OAuthRepositoryMockConsumeAuthorizationCodeResults{ap1, err}
+	return e.mock
+}
+
+// Times sets number of times OAuthRepository.ConsumeAuthorizationCode should be invoked
+func (mmConsumeAuthorizationCode *mOAuthRepositoryMockConsumeAuthorizationCode) Times(n uint64) *mOAuthRepositoryMockConsumeAuthorizationCode {
+	if n == 0 {
+		mmConsumeAuthorizationCode.mock.t.Fatalf("Times of OAuthRepositoryMock.ConsumeAuthorizationCode mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmConsumeAuthorizationCode.expectedInvocations, n)
+	mmConsumeAuthorizationCode.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmConsumeAuthorizationCode
+}
+
+func (mmConsumeAuthorizationCode *mOAuthRepositoryMockConsumeAuthorizationCode) invocationsDone() bool {
+	if len(mmConsumeAuthorizationCode.expectations) == 0 && mmConsumeAuthorizationCode.defaultExpectation == nil && mmConsumeAuthorizationCode.mock.funcConsumeAuthorizationCode == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmConsumeAuthorizationCode.mock.afterConsumeAuthorizationCodeCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmConsumeAuthorizationCode.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ConsumeAuthorizationCode implements OAuthRepository
+func (mmConsumeAuthorizationCode *OAuthRepositoryMock) ConsumeAuthorizationCode(ctx context.Context, codeHash string) (ap1 *models.AuthorizationCode, err error) {
+	mm_atomic.AddUint64(&mmConsumeAuthorizationCode.beforeConsumeAuthorizationCodeCounter, 1)
+	defer mm_atomic.AddUint64(&mmConsumeAuthorizationCode.afterConsumeAuthorizationCodeCounter, 1)
+
+	mmConsumeAuthorizationCode.t.Helper()
+
+	if mmConsumeAuthorizationCode.inspectFuncConsumeAuthorizationCode != nil {
+		mmConsumeAuthorizationCode.inspectFuncConsumeAuthorizationCode(ctx, codeHash)
+	}
+
+	mm_params := OAuthRepositoryMockConsumeAuthorizationCodeParams{ctx, codeHash}
+
+	// Record call args
+	mmConsumeAuthorizationCode.ConsumeAuthorizationCodeMock.mutex.Lock()
+	mmConsumeAuthorizationCode.ConsumeAuthorizationCodeMock.callArgs = append(mmConsumeAuthorizationCode.ConsumeAuthorizationCodeMock.callArgs, &mm_params)
+	mmConsumeAuthorizationCode.ConsumeAuthorizationCodeMock.mutex.Unlock()
+
+	for _, e := range mmConsumeAuthorizationCode.ConsumeAuthorizationCodeMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ap1, e.results.err
+		}
+	}
+
+	if mmConsumeAuthorizationCode.ConsumeAuthorizationCodeMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmConsumeAuthorizationCode.ConsumeAuthorizationCodeMock.defaultExpectation.Counter, 1)
+		mm_want := mmConsumeAuthorizationCode.ConsumeAuthorizationCodeMock.defaultExpectation.params
+		mm_want_ptrs := mmConsumeAuthorizationCode.ConsumeAuthorizationCodeMock.defaultExpectation.paramPtrs
+
+		mm_got := OAuthRepositoryMockConsumeAuthorizationCodeParams{ctx, codeHash}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmConsumeAuthorizationCode.t.Errorf("OAuthRepositoryMock.ConsumeAuthorizationCode got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmConsumeAuthorizationCode.ConsumeAuthorizationCodeMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.codeHash != nil && !minimock.Equal(*mm_want_ptrs.codeHash, mm_got.codeHash) {
+				mmConsumeAuthorizationCode.t.Errorf("OAuthRepositoryMock.ConsumeAuthorizationCode got unexpected parameter codeHash, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmConsumeAuthorizationCode.ConsumeAuthorizationCodeMock.defaultExpectation.expectationOrigins.originCodeHash, *mm_want_ptrs.codeHash, mm_got.codeHash, minimock.Diff(*mm_want_ptrs.codeHash, mm_got.codeHash))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmConsumeAuthorizationCode.t.Errorf("OAuthRepositoryMock.ConsumeAuthorizationCode got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmConsumeAuthorizationCode.ConsumeAuthorizationCodeMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmConsumeAuthorizationCode.ConsumeAuthorizationCodeMock.defaultExpectation.results
+		if mm_results == nil {
+			mmConsumeAuthorizationCode.t.Fatal("No results are set for the OAuthRepositoryMock.ConsumeAuthorizationCode")
+		}
+		return (*mm_results).ap1, (*mm_results).err
+	}
+	if mmConsumeAuthorizationCode.funcConsumeAuthorizationCode != nil {
+		return mmConsumeAuthorizationCode.funcConsumeAuthorizationCode(ctx, codeHash)
+	}
+	mmConsumeAuthorizationCode.t.Fatalf("Unexpected call to OAuthRepositoryMock.ConsumeAuthorizationCode. %v %v", ctx, codeHash)
+	return
+}
+
+// ConsumeAuthorizationCodeAfterCounter returns a count of finished OAuthRepositoryMock.ConsumeAuthorizationCode invocations
+func (mmConsumeAuthorizationCode *OAuthRepositoryMock) ConsumeAuthorizationCodeAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmConsumeAuthorizationCode.afterConsumeAuthorizationCodeCounter)
+}
+
+// ConsumeAuthorizationCodeBeforeCounter returns a count of OAuthRepositoryMock.ConsumeAuthorizationCode invocations
+func (mmConsumeAuthorizationCode *OAuthRepositoryMock) ConsumeAuthorizationCodeBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmConsumeAuthorizationCode.beforeConsumeAuthorizationCodeCounter)
+}
+
+// Calls returns a list of arguments used in each call to OAuthRepositoryMock.ConsumeAuthorizationCode.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmConsumeAuthorizationCode *mOAuthRepositoryMockConsumeAuthorizationCode) Calls() []*OAuthRepositoryMockConsumeAuthorizationCodeParams {
+	mmConsumeAuthorizationCode.mutex.RLock()
+
+	argCopy := make([]*OAuthRepositoryMockConsumeAuthorizationCodeParams, len(mmConsumeAuthorizationCode.callArgs))
+	copy(argCopy, mmConsumeAuthorizationCode.callArgs)
+
+	mmConsumeAuthorizationCode.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockConsumeAuthorizationCodeDone returns true if the count of the ConsumeAuthorizationCode invocations corresponds
+// the number of defined expectations
+func (m *OAuthRepositoryMock) MinimockConsumeAuthorizationCodeDone() bool {
+	if m.ConsumeAuthorizationCodeMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ConsumeAuthorizationCodeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ConsumeAuthorizationCodeMock.invocationsDone()
+}
+
+// MinimockConsumeAuthorizationCodeInspect logs each unmet expectation
+func (m *OAuthRepositoryMock) MinimockConsumeAuthorizationCodeInspect() {
+	for _, e := range m.ConsumeAuthorizationCodeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OAuthRepositoryMock.ConsumeAuthorizationCode at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterConsumeAuthorizationCodeCounter := mm_atomic.LoadUint64(&m.afterConsumeAuthorizationCodeCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ConsumeAuthorizationCodeMock.defaultExpectation != nil && afterConsumeAuthorizationCodeCounter < 1 {
+		if m.ConsumeAuthorizationCodeMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OAuthRepositoryMock.ConsumeAuthorizationCode at\n%s", m.ConsumeAuthorizationCodeMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OAuthRepositoryMock.ConsumeAuthorizationCode at\n%s with params: %#v", m.ConsumeAuthorizationCodeMock.defaultExpectation.expectationOrigins.origin, *m.ConsumeAuthorizationCodeMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcConsumeAuthorizationCode != nil && afterConsumeAuthorizationCodeCounter < 1 {
+		m.t.Errorf("Expected call to OAuthRepositoryMock.ConsumeAuthorizationCode at\n%s", m.funcConsumeAuthorizationCodeOrigin)
+	}
+
+	if !m.ConsumeAuthorizationCodeMock.invocationsDone() && afterConsumeAuthorizationCodeCounter > 0 {
+		m.t.Errorf("Expected %d calls to OAuthRepositoryMock.ConsumeAuthorizationCode at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ConsumeAuthorizationCodeMock.expectedInvocations), m.ConsumeAuthorizationCodeMock.expectedInvocationsOrigin, afterConsumeAuthorizationCodeCounter)
+	}
+}
+
+type mOAuthRepositoryMockCreateAuthorizationCode struct {
+	optional           bool
+	mock               *OAuthRepositoryMock
+	defaultExpectation *OAuthRepositoryMockCreateAuthorizationCodeExpectation
+	expectations       []*OAuthRepositoryMockCreateAuthorizationCodeExpectation
+
+	callArgs []*OAuthRepositoryMockCreateAuthorizationCodeParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OAuthRepositoryMockCreateAuthorizationCodeExpectation specifies expectation struct of the OAuthRepository.CreateAuthorizationCode
+type OAuthRepositoryMockCreateAuthorizationCodeExpectation struct {
+	mock               *OAuthRepositoryMock
+	params             *OAuthRepositoryMockCreateAuthorizationCodeParams
+	paramPtrs          *OAuthRepositoryMockCreateAuthorizationCodeParamPtrs
+	expectationOrigins OAuthRepositoryMockCreateAuthorizationCodeExpectationOrigins
+	results            *OAuthRepositoryMockCreateAuthorizationCodeResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// OAuthRepositoryMockCreateAuthorizationCodeParams contains parameters of the OAuthRepository.CreateAuthorizationCode
+type OAuthRepositoryMockCreateAuthorizationCodeParams struct {
+	ctx  context.Context
+	code *models.AuthorizationCode
+}
+
+// OAuthRepositoryMockCreateAuthorizationCodeParamPtrs contains pointers to parameters of the OAuthRepository.CreateAuthorizationCode
+type OAuthRepositoryMockCreateAuthorizationCodeParamPtrs struct {
+	ctx  *context.Context
+	code **models.AuthorizationCode
+}
+
+// OAuthRepositoryMockCreateAuthorizationCodeResults contains results of the OAuthRepository.CreateAuthorizationCode
+type OAuthRepositoryMockCreateAuthorizationCodeResults struct {
+	err error
+}
+
+// OAuthRepositoryMockCreateAuthorizationCodeOrigins contains origins of expectations of the OAuthRepository.CreateAuthorizationCode
+type OAuthRepositoryMockCreateAuthorizationCodeExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originCode string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateAuthorizationCode *mOAuthRepositoryMockCreateAuthorizationCode) Optional() *mOAuthRepositoryMockCreateAuthorizationCode {
+	mmCreateAuthorizationCode.optional = true
+	return mmCreateAuthorizationCode
+}
+
+// Expect sets up expected params for OAuthRepository.CreateAuthorizationCode
+func (mmCreateAuthorizationCode *mOAuthRepositoryMockCreateAuthorizationCode) Expect(ctx context.Context, code *models.AuthorizationCode) *mOAuthRepositoryMockCreateAuthorizationCode {
+	if mmCreateAuthorizationCode.mock.funcCreateAuthorizationCode != nil {
+		mmCreateAuthorizationCode.mock.t.Fatalf("OAuthRepositoryMock.CreateAuthorizationCode mock is already set by Set")
+	}
+
+	if mmCreateAuthorizationCode.defaultExpectation == nil {
+		mmCreateAuthorizationCode.defaultExpectation = &OAuthRepositoryMockCreateAuthorizationCodeExpectation{}
+	}
+
+	if mmCreateAuthorizationCode.defaultExpectation.paramPtrs != nil {
+		mmCreateAuthorizationCode.mock.t.Fatalf("OAuthRepositoryMock.CreateAuthorizationCode mock is already set by ExpectParams functions")
+	}
+
+	mmCreateAuthorizationCode.defaultExpectation.params = &OAuthRepositoryMockCreateAuthorizationCodeParams{ctx, code}
+	mmCreateAuthorizationCode.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreateAuthorizationCode.expectations {
+		if minimock.Equal(e.params, mmCreateAuthorizationCode.defaultExpectation.params) {
+			mmCreateAuthorizationCode.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateAuthorizationCode.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateAuthorizationCode
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OAuthRepository.CreateAuthorizationCode
+func (mmCreateAuthorizationCode *mOAuthRepositoryMockCreateAuthorizationCode) ExpectCtxParam1(ctx context.Context) *mOAuthRepositoryMockCreateAuthorizationCode {
+	if mmCreateAuthorizationCode.mock.funcCreateAuthorizationCode != nil {
+		mmCreateAuthorizationCode.mock.t.Fatalf("OAuthRepositoryMock.CreateAuthorizationCode mock is already set by Set")
+	}
+
+	if mmCreateAuthorizationCode.defaultExpectation == nil {
+		mmCreateAuthorizationCode.defaultExpectation = &OAuthRepositoryMockCreateAuthorizationCodeExpectation{}
+	}
+
+	if mmCreateAuthorizationCode.defaultExpectation.params != nil {
+		mmCreateAuthorizationCode.mock.t.Fatalf("OAuthRepositoryMock.CreateAuthorizationCode mock is already set by Expect")
+	}
+
+	if mmCreateAuthorizationCode.defaultExpectation.paramPtrs == nil {
+		mmCreateAuthorizationCode.defaultExpectation.paramPtrs = &OAuthRepositoryMockCreateAuthorizationCodeParamPtrs{}
+	}
+	mmCreateAuthorizationCode.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreateAuthorizationCode.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreateAuthorizationCode
+}
+
+// ExpectCodeParam2 sets up expected param code for OAuthRepository.CreateAuthorizationCode
+func (mmCreateAuthorizationCode *mOAuthRepositoryMockCreateAuthorizationCode) ExpectCodeParam2(code *models.AuthorizationCode) *mOAuthRepositoryMockCreateAuthorizationCode {
+	if mmCreateAuthorizationCode.mock.funcCreateAuthorizationCode != nil {
+		mmCreateAuthorizationCode.mock.t.Fatalf("OAuthRepositoryMock.CreateAuthorizationCode mock is already set by Set")
+	}
+
+	if mmCreateAuthorizationCode.defaultExpectation == nil {
+		mmCreateAuthorizationCode.defaultExpectation = &OAuthRepositoryMockCreateAuthorizationCodeExpectation{}
+	}
+
+	if mmCreateAuthorizationCode.defaultExpectation.params != nil {
+		mmCreateAuthorizationCode.mock.t.Fatalf("OAuthRepositoryMock.CreateAuthorizationCode mock is already set by Expect")
+	}
+
+	if mmCreateAuthorizationCode.defaultExpectation.paramPtrs == nil {
+		mmCreateAuthorizationCode.defaultExpectation.paramPtrs = &OAuthRepositoryMockCreateAuthorizationCodeParamPtrs{}
+	}
+	mmCreateAuthorizationCode.defaultExpectation.paramPtrs.code = &code
+	mmCreateAuthorizationCode.defaultExpectation.expectationOrigins.originCode = minimock.CallerInfo(1)
+
+	return mmCreateAuthorizationCode
+}
+
+// Inspect accepts an inspector function that has same arguments as the OAuthRepository.CreateAuthorizationCode
+func (mmCreateAuthorizationCode *mOAuthRepositoryMockCreateAuthorizationCode) Inspect(f func(ctx context.Context, code *models.AuthorizationCode)) *mOAuthRepositoryMockCreateAuthorizationCode {
+	if mmCreateAuthorizationCode.mock.inspectFuncCreateAuthorizationCode != nil {
+		mmCreateAuthorizationCode.mock.t.Fatalf("Inspect function is already set for OAuthRepositoryMock.CreateAuthorizationCode")
+	}
+
+	mmCreateAuthorizationCode.mock.inspectFuncCreateAuthorizationCode = f
+
+	return mmCreateAuthorizationCode
+}
+
+// Return sets up results that will be returned by OAuthRepository.CreateAuthorizationCode
+func (mmCreateAuthorizationCode *mOAuthRepositoryMockCreateAuthorizationCode) Return(err error) *OAuthRepositoryMock {
+	if mmCreateAuthorizationCode.mock.funcCreateAuthorizationCode != nil {
+		mmCreateAuthorizationCode.mock.t.Fatalf("OAuthRepositoryMock.CreateAuthorizationCode mock is already set by Set")
+	}
+
+	if mmCreateAuthorizationCode.defaultExpectation == nil {
+		mmCreateAuthorizationCode.defaultExpectation = &OAuthRepositoryMockCreateAuthorizationCodeExpectation{mock: mmCreateAuthorizationCode.mock}
+	}
+	mmCreateAuthorizationCode.defaultExpectation.results = &OAuthRepositoryMockCreateAuthorizationCodeResults{err}
+	mmCreateAuthorizationCode.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreateAuthorizationCode.mock
+}
+
+// Set uses given function f to mock the OAuthRepository.CreateAuthorizationCode method
+func (mmCreateAuthorizationCode *mOAuthRepositoryMockCreateAuthorizationCode) Set(f func(ctx context.Context, code *models.AuthorizationCode) (err error)) *OAuthRepositoryMock {
+	if mmCreateAuthorizationCode.defaultExpectation != nil {
+		mmCreateAuthorizationCode.mock.t.Fatalf("Default expectation is already set for the OAuthRepository.CreateAuthorizationCode method")
+	}
+
+	if len(mmCreateAuthorizationCode.expectations) > 0 {
+		mmCreateAuthorizationCode.mock.t.Fatalf("Some expectations are already set for the OAuthRepository.CreateAuthorizationCode method")
+	}
+
+	mmCreateAuthorizationCode.mock.funcCreateAuthorizationCode = f
+	mmCreateAuthorizationCode.mock.funcCreateAuthorizationCodeOrigin = minimock.CallerInfo(1)
+	return mmCreateAuthorizationCode.mock
+}
+
+// When sets expectation for the OAuthRepository.CreateAuthorizationCode which will trigger the result defined by the following
+// Then helper
+func (mmCreateAuthorizationCode *mOAuthRepositoryMockCreateAuthorizationCode) When(ctx context.Context, code *models.AuthorizationCode) *OAuthRepositoryMockCreateAuthorizationCodeExpectation {
+	if mmCreateAuthorizationCode.mock.funcCreateAuthorizationCode != nil {
+		mmCreateAuthorizationCode.mock.t.Fatalf("OAuthRepositoryMock.CreateAuthorizationCode mock is already set by Set")
+	}
+
+	expectation := &OAuthRepositoryMockCreateAuthorizationCodeExpectation{
+		mock:               mmCreateAuthorizationCode.mock,
+		params:             &OAuthRepositoryMockCreateAuthorizationCodeParams{ctx, code},
+		expectationOrigins: OAuthRepositoryMockCreateAuthorizationCodeExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreateAuthorizationCode.expectations = append(mmCreateAuthorizationCode.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OAuthRepository.CreateAuthorizationCode return parameters for the expectation previously defined by the When method
+func (e *OAuthRepositoryMockCreateAuthorizationCodeExpectation) Then(err error) *OAuthRepositoryMock {
+	e.results = &OAuthRepositoryMockCreateAuthorizationCodeResults{err}
+	return e.mock
+}
+
+// Times sets number of times OAuthRepository.CreateAuthorizationCode should be invoked
+func (mmCreateAuthorizationCode *mOAuthRepositoryMockCreateAuthorizationCode) Times(n uint64) *mOAuthRepositoryMockCreateAuthorizationCode {
+	if n == 0 {
+		mmCreateAuthorizationCode.mock.t.Fatalf("Times of OAuthRepositoryMock.CreateAuthorizationCode mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateAuthorizationCode.expectedInvocations, n)
+	mmCreateAuthorizationCode.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreateAuthorizationCode
+}
+
+func (mmCreateAuthorizationCode *mOAuthRepositoryMockCreateAuthorizationCode) invocationsDone() bool {
+	if len(mmCreateAuthorizationCode.expectations) == 0 && mmCreateAuthorizationCode.defaultExpectation == nil && mmCreateAuthorizationCode.mock.funcCreateAuthorizationCode == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateAuthorizationCode.mock.afterCreateAuthorizationCodeCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateAuthorizationCode.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateAuthorizationCode implements OAuthRepository
+func (mmCreateAuthorizationCode *OAuthRepositoryMock) CreateAuthorizationCode(ctx context.Context, code *models.AuthorizationCode) (err error) {
+	mm_atomic.AddUint64(&mmCreateAuthorizationCode.beforeCreateAuthorizationCodeCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateAuthorizationCode.afterCreateAuthorizationCodeCounter, 1)
+
+	mmCreateAuthorizationCode.t.Helper()
+
+	if mmCreateAuthorizationCode.inspectFuncCreateAuthorizationCode != nil {
+		mmCreateAuthorizationCode.inspectFuncCreateAuthorizationCode(ctx, code)
+	}
+
+	mm_params := OAuthRepositoryMockCreateAuthorizationCodeParams{ctx, code}
+
+	// Record call args
+	mmCreateAuthorizationCode.CreateAuthorizationCodeMock.mutex.Lock()
+	mmCreateAuthorizationCode.CreateAuthorizationCodeMock.callArgs = append(mmCreateAuthorizationCode.CreateAuthorizationCodeMock.callArgs, &mm_params)
+	mmCreateAuthorizationCode.CreateAuthorizationCodeMock.mutex.Unlock()
+
+	for _, e := range mmCreateAuthorizationCode.CreateAuthorizationCodeMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCreateAuthorizationCode.CreateAuthorizationCodeMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateAuthorizationCode.CreateAuthorizationCodeMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateAuthorizationCode.CreateAuthorizationCodeMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateAuthorizationCode.CreateAuthorizationCodeMock.defaultExpectation.paramPtrs
+
+		mm_got := OAuthRepositoryMockCreateAuthorizationCodeParams{ctx, code}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateAuthorizationCode.t.Errorf("OAuthRepositoryMock.CreateAuthorizationCode got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateAuthorizationCode.CreateAuthorizationCodeMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.code != nil && !minimock.Equal(*mm_want_ptrs.code, mm_got.code) {
+				mmCreateAuthorizationCode.t.Errorf("OAuthRepositoryMock.CreateAuthorizationCode got unexpected parameter code, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateAuthorizationCode.CreateAuthorizationCodeMock.defaultExpectation.expectationOrigins.originCode, *mm_want_ptrs.code, mm_got.code, minimock.Diff(*mm_want_ptrs.code, mm_got.code))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateAuthorizationCode.t.Errorf("OAuthRepositoryMock.CreateAuthorizationCode got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreateAuthorizationCode.CreateAuthorizationCodeMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateAuthorizationCode.CreateAuthorizationCodeMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateAuthorizationCode.t.Fatal("No results are set for the OAuthRepositoryMock.CreateAuthorizationCode")
+		}
+		return (*mm_results).err
+	}
+	if mmCreateAuthorizationCode.funcCreateAuthorizationCode != nil {
+		return mmCreateAuthorizationCode.funcCreateAuthorizationCode(ctx, code)
+	}
+	mmCreateAuthorizationCode.t.Fatalf("Unexpected call to OAuthRepositoryMock.CreateAuthorizationCode. %v %v", ctx, code)
+	return
+}
+
+// CreateAuthorizationCodeAfterCounter returns a count of finished OAuthRepositoryMock.CreateAuthorizationCode invocations
+func (mmCreateAuthorizationCode *OAuthRepositoryMock) CreateAuthorizationCodeAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateAuthorizationCode.afterCreateAuthorizationCodeCounter)
+}
+
+// CreateAuthorizationCodeBeforeCounter returns a count of OAuthRepositoryMock.CreateAuthorizationCode invocations
+func (mmCreateAuthorizationCode *OAuthRepositoryMock) CreateAuthorizationCodeBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateAuthorizationCode.beforeCreateAuthorizationCodeCounter)
+}
+
+// Calls returns a list of arguments used in each call to OAuthRepositoryMock.CreateAuthorizationCode.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateAuthorizationCode *mOAuthRepositoryMockCreateAuthorizationCode) Calls() []*OAuthRepositoryMockCreateAuthorizationCodeParams {
+	mmCreateAuthorizationCode.mutex.RLock()
+
+	argCopy := make([]*OAuthRepositoryMockCreateAuthorizationCodeParams, len(mmCreateAuthorizationCode.callArgs))
+	copy(argCopy, mmCreateAuthorizationCode.callArgs)
+
+	mmCreateAuthorizationCode.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateAuthorizationCodeDone returns true if the count of the CreateAuthorizationCode invocations corresponds
+// the number of defined expectations
+func (m *OAuthRepositoryMock) MinimockCreateAuthorizationCodeDone() bool {
+	if m.CreateAuthorizationCodeMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateAuthorizationCodeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateAuthorizationCodeMock.invocationsDone()
+}
+
+// MinimockCreateAuthorizationCodeInspect logs each unmet expectation
+func (m *OAuthRepositoryMock) MinimockCreateAuthorizationCodeInspect() {
+	for _, e := range m.CreateAuthorizationCodeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OAuthRepositoryMock.CreateAuthorizationCode at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateAuthorizationCodeCounter := mm_atomic.LoadUint64(&m.afterCreateAuthorizationCodeCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateAuthorizationCodeMock.defaultExpectation != nil && afterCreateAuthorizationCodeCounter < 1 {
+		if m.CreateAuthorizationCodeMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OAuthRepositoryMock.CreateAuthorizationCode at\n%s", m.CreateAuthorizationCodeMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OAuthRepositoryMock.CreateAuthorizationCode at\n%s with params: %#v", m.CreateAuthorizationCodeMock.defaultExpectation.expectationOrigins.origin, *m.CreateAuthorizationCodeMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateAuthorizationCode != nil && afterCreateAuthorizationCodeCounter < 1 {
+		m.t.Errorf("Expected call to OAuthRepositoryMock.CreateAuthorizationCode at\n%s", m.funcCreateAuthorizationCodeOrigin)
+	}
+
+	if !m.CreateAuthorizationCodeMock.invocationsDone() && afterCreateAuthorizationCodeCounter > 0 {
+		m.t.Errorf("Expected %d calls to OAuthRepositoryMock.CreateAuthorizationCode at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateAuthorizationCodeMock.expectedInvocations), m.CreateAuthorizationCodeMock.expectedInvocationsOrigin, afterCreateAuthorizationCodeCounter)
+	}
+}
+
+type mOAuthRepositoryMockCreateClient struct {
+	optional           bool
+	mock               *OAuthRepositoryMock
+	defaultExpectation *OAuthRepositoryMockCreateClientExpectation
+	expectations       []*OAuthRepositoryMockCreateClientExpectation
+
+	callArgs []*OAuthRepositoryMockCreateClientParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OAuthRepositoryMockCreateClientExpectation specifies expectation struct of the OAuthRepository.CreateClient
+type OAuthRepositoryMockCreateClientExpectation struct {
+	mock               *OAuthRepositoryMock
+	params             *OAuthRepositoryMockCreateClientParams
+	paramPtrs          *OAuthRepositoryMockCreateClientParamPtrs
+	expectationOrigins OAuthRepositoryMockCreateClientExpectationOrigins
+	results            *OAuthRepositoryMockCreateClientResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// OAuthRepositoryMockCreateClientParams contains parameters of the OAuthRepository.CreateClient
+type OAuthRepositoryMockCreateClientParams struct {
+	ctx    context.Context
+	client *models.Client
+}
+
+// OAuthRepositoryMockCreateClientParamPtrs contains pointers to parameters of the OAuthRepository.CreateClient
+type OAuthRepositoryMockCreateClientParamPtrs struct {
+	ctx    *context.Context
+	client **models.Client
+}
+
+// OAuthRepositoryMockCreateClientResults contains results of the OAuthRepository.CreateClient
+type OAuthRepositoryMockCreateClientResults struct {
+	err error
+}
+
+// OAuthRepositoryMockCreateClientOrigins contains origins of expectations of the OAuthRepository.CreateClient
+type OAuthRepositoryMockCreateClientExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originClient string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateClient *mOAuthRepositoryMockCreateClient) Optional() *mOAuthRepositoryMockCreateClient {
+	mmCreateClient.optional = true
+	return mmCreateClient
+}
+
+// Expect sets up expected params for OAuthRepository.CreateClient
+func (mmCreateClient *mOAuthRepositoryMockCreateClient) Expect(ctx context.Context, client *models.Client) *mOAuthRepositoryMockCreateClient {
+	if mmCreateClient.mock.funcCreateClient != nil {
+		mmCreateClient.mock.t.Fatalf("OAuthRepositoryMock.CreateClient mock is already set by Set")
+	}
+
+	if mmCreateClient.defaultExpectation == nil {
+		mmCreateClient.defaultExpectation = &OAuthRepositoryMockCreateClientExpectation{}
+	}
+
+	if mmCreateClient.defaultExpectation.paramPtrs != nil {
+		mmCreateClient.mock.t.Fatalf("OAuthRepositoryMock.CreateClient mock is already set by ExpectParams functions")
+	}
+
+	mmCreateClient.defaultExpectation.params = &OAuthRepositoryMockCreateClientParams{ctx, client}
+	mmCreateClient.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreateClient.expectations {
+		if minimock.Equal(e.params, mmCreateClient.defaultExpectation.params) {
+			mmCreateClient.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateClient.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateClient
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OAuthRepository.CreateClient
+func (mmCreateClient *mOAuthRepositoryMockCreateClient) ExpectCtxParam1(ctx context.Context) *mOAuthRepositoryMockCreateClient {
+	if mmCreateClient.mock.funcCreateClient != nil {
+		mmCreateClient.mock.t.Fatalf("OAuthRepositoryMock.CreateClient mock is already set by Set")
+	}
+
+	if mmCreateClient.defaultExpectation == nil {
+		mmCreateClient.defaultExpectation = &OAuthRepositoryMockCreateClientExpectation{}
+	}
+
+	if mmCreateClient.defaultExpectation.params != nil {
+		mmCreateClient.mock.t.Fatalf("OAuthRepositoryMock.CreateClient mock is already set by Expect")
+	}
+
+	if mmCreateClient.defaultExpectation.paramPtrs == nil {
+		mmCreateClient.defaultExpectation.paramPtrs = &OAuthRepositoryMockCreateClientParamPtrs{}
+	}
+	mmCreateClient.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreateClient.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreateClient
+}
+
+// ExpectClientParam2 sets up expected param client for OAuthRepository.CreateClient
+func (mmCreateClient *mOAuthRepositoryMockCreateClient) ExpectClientParam2(client *models.Client) *mOAuthRepositoryMockCreateClient {
+	if mmCreateClient.mock.funcCreateClient != nil {
+		mmCreateClient.mock.t.Fatalf("OAuthRepositoryMock.CreateClient mock is already set by Set")
+	}
+
+	if mmCreateClient.defaultExpectation == nil {
+		mmCreateClient.defaultExpectation = &OAuthRepositoryMockCreateClientExpectation{}
+	}
+
+	if mmCreateClient.defaultExpectation.params != nil {
+		mmCreateClient.mock.t.Fatalf("OAuthRepositoryMock.CreateClient mock is already set by Expect")
+	}
+
+	if mmCreateClient.defaultExpectation.paramPtrs == nil {
+		mmCreateClient.defaultExpectation.paramPtrs = &OAuthRepositoryMockCreateClientParamPtrs{}
+	}
+	mmCreateClient.defaultExpectation.paramPtrs.client = &client
+	mmCreateClient.defaultExpectation.expectationOrigins.originClient = minimock.CallerInfo(1)
+
+	return mmCreateClient
+}
+
+// Inspect accepts an inspector function that has same arguments as the OAuthRepository.CreateClient
+func (mmCreateClient *mOAuthRepositoryMockCreateClient) Inspect(f func(ctx context.Context, client *models.Client)) *mOAuthRepositoryMockCreateClient {
+	if mmCreateClient.mock.inspectFuncCreateClient != nil {
+		mmCreateClient.mock.t.Fatalf("Inspect function is already set for OAuthRepositoryMock.CreateClient")
+	}
+
+	mmCreateClient.mock.inspectFuncCreateClient = f
+
+	return mmCreateClient
+}
+
+// Return sets up results that will be returned by OAuthRepository.CreateClient
+func (mmCreateClient *mOAuthRepositoryMockCreateClient) Return(err error) *OAuthRepositoryMock {
+	if mmCreateClient.mock.funcCreateClient != nil {
+		mmCreateClient.mock.t.Fatalf("OAuthRepositoryMock.CreateClient mock is already set by Set")
+	}
+
+	if mmCreateClient.defaultExpectation == nil {
+		mmCreateClient.defaultExpectation = &OAuthRepositoryMockCreateClientExpectation{mock: mmCreateClient.mock}
+	}
+	mmCreateClient.defaultExpectation.results = &OAuthRepositoryMockCreateClientResults{err}
+	mmCreateClient.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreateClient.mock
+}
+
+// Set uses given function f to mock the OAuthRepository.CreateClient method
+func (mmCreateClient *mOAuthRepositoryMockCreateClient) Set(f func(ctx context.Context, client *models.Client) (err error)) *OAuthRepositoryMock {
+	if mmCreateClient.defaultExpectation != nil {
+		mmCreateClient.mock.t.Fatalf("Default expectation is already set for the OAuthRepository.CreateClient method")
+	}
+
+	if len(mmCreateClient.expectations) > 0 {
+		mmCreateClient.mock.t.Fatalf("Some expectations are already set for the OAuthRepository.CreateClient method")
+	}
+
+	mmCreateClient.mock.funcCreateClient = f
+	mmCreateClient.mock.funcCreateClientOrigin = minimock.CallerInfo(1)
+	return mmCreateClient.mock
+}
+
+// When sets expectation for the OAuthRepository.CreateClient which will trigger the result defined by the following
+// Then helper
+func (mmCreateClient *mOAuthRepositoryMockCreateClient) When(ctx context.Context, client *models.Client) *OAuthRepositoryMockCreateClientExpectation {
+	if mmCreateClient.mock.funcCreateClient != nil {
+		mmCreateClient.mock.t.Fatalf("OAuthRepositoryMock.CreateClient mock is already set by Set")
+	}
+
+	expectation := &OAuthRepositoryMockCreateClientExpectation{
+		mock:               mmCreateClient.mock,
+		params:             &OAuthRepositoryMockCreateClientParams{ctx, client},
+		expectationOrigins: OAuthRepositoryMockCreateClientExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreateClient.expectations = append(mmCreateClient.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OAuthRepository.CreateClient return parameters for the expectation previously defined by the When method
+func (e *OAuthRepositoryMockCreateClientExpectation) Then(err error) *OAuthRepositoryMock {
+	e.results = &OAuthRepositoryMockCreateClientResults{err}
+	return e.mock
+}
+
+// Times sets number of times OAuthRepository.CreateClient should be invoked
+func (mmCreateClient *mOAuthRepositoryMockCreateClient) Times(n uint64) *mOAuthRepositoryMockCreateClient {
+	if n == 0 {
+		mmCreateClient.mock.t.Fatalf("Times of OAuthRepositoryMock.CreateClient mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateClient.expectedInvocations, n)
+	mmCreateClient.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreateClient
+}
+
+func (mmCreateClient *mOAuthRepositoryMockCreateClient) invocationsDone() bool {
+	if len(mmCreateClient.expectations) == 0 && mmCreateClient.defaultExpectation == nil && mmCreateClient.mock.funcCreateClient == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateClient.mock.afterCreateClientCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateClient.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateClient implements OAuthRepository
+func (mmCreateClient *OAuthRepositoryMock) CreateClient(ctx context.Context, client *models.Client) (err error) {
+	mm_atomic.AddUint64(&mmCreateClient.beforeCreateClientCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateClient.afterCreateClientCounter, 1)
+
+	mmCreateClient.t.Helper()
+
+	if mmCreateClient.inspectFuncCreateClient != nil {
+		mmCreateClient.inspectFuncCreateClient(ctx, client)
+	}
+
+	mm_params := OAuthRepositoryMockCreateClientParams{ctx, client}
+
+	// Record call args
+	mmCreateClient.CreateClientMock.mutex.Lock()
+	mmCreateClient.CreateClientMock.callArgs = append(mmCreateClient.CreateClientMock.callArgs, &mm_params)
+	mmCreateClient.CreateClientMock.mutex.Unlock()
+
+	for _, e := range mmCreateClient.CreateClientMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCreateClient.CreateClientMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateClient.CreateClientMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateClient.CreateClientMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateClient.CreateClientMock.defaultExpectation.paramPtrs
+
+		mm_got := OAuthRepositoryMockCreateClientParams{ctx, client}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateClient.t.Errorf("OAuthRepositoryMock.CreateClient got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateClient.CreateClientMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.client != nil && !minimock.Equal(*mm_want_ptrs.client, mm_got.client) {
+				mmCreateClient.t.Errorf("OAuthRepositoryMock.CreateClient got unexpected parameter client, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateClient.CreateClientMock.defaultExpectation.expectationOrigins.originClient, *mm_want_ptrs.client, mm_got.client, minimock.Diff(*mm_want_ptrs.client, mm_got.client))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateClient.t.Errorf("OAuthRepositoryMock.CreateClient got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreateClient.CreateClientMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateClient.CreateClientMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateClient.t.Fatal("No results are set for the OAuthRepositoryMock.CreateClient")
+		}
+		return (*mm_results).err
+	}
+	if mmCreateClient.funcCreateClient != nil {
+		return mmCreateClient.funcCreateClient(ctx, client)
+	}
+	mmCreateClient.t.Fatalf("Unexpected call to OAuthRepositoryMock.CreateClient. %v %v", ctx, client)
+	return
+}
+
+// CreateClientAfterCounter returns a count of finished OAuthRepositoryMock.CreateClient invocations
+func (mmCreateClient *OAuthRepositoryMock) CreateClientAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateClient.afterCreateClientCounter)
+}
+
+// CreateClientBeforeCounter returns a count of OAuthRepositoryMock.CreateClient invocations
+func (mmCreateClient *OAuthRepositoryMock) CreateClientBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateClient.beforeCreateClientCounter)
+}
+
+// Calls returns a list of arguments used in each call to OAuthRepositoryMock.CreateClient.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateClient *mOAuthRepositoryMockCreateClient) Calls() []*OAuthRepositoryMockCreateClientParams {
+	mmCreateClient.mutex.RLock()
+
+	argCopy := make([]*OAuthRepositoryMockCreateClientParams, len(mmCreateClient.callArgs))
+	copy(argCopy, mmCreateClient.callArgs)
+
+	mmCreateClient.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateClientDone returns true if the count of the CreateClient invocations corresponds
+// the number of defined expectations
+func (m *OAuthRepositoryMock) MinimockCreateClientDone() bool {
+	if m.CreateClientMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateClientMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateClientMock.invocationsDone()
+}
+
+// MinimockCreateClientInspect logs each unmet expectation
+func (m *OAuthRepositoryMock) MinimockCreateClientInspect() {
+	for _, e := range m.CreateClientMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OAuthRepositoryMock.CreateClient at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateClientCounter := mm_atomic.LoadUint64(&m.afterCreateClientCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateClientMock.defaultExpectation != nil && afterCreateClientCounter < 1 {
+		if m.CreateClientMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OAuthRepositoryMock.CreateClient at\n%s", m.CreateClientMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OAuthRepositoryMock.CreateClient at\n%s with params: %#v", m.CreateClientMock.defaultExpectation.expectationOrigins.origin, *m.CreateClientMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateClient != nil && afterCreateClientCounter < 1 {
+		m.t.Errorf("Expected call to OAuthRepositoryMock.CreateClient at\n%s", m.funcCreateClientOrigin)
+	}
+
+	if !m.CreateClientMock.invocationsDone() && afterCreateClientCounter > 0 {
+		m.t.Errorf("Expected %d calls to OAuthRepositoryMock.CreateClient at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateClientMock.expectedInvocations), m.CreateClientMock.expectedInvocationsOrigin, afterCreateClientCounter)
+	}
+}
+
+type mOAuthRepositoryMockDeleteSession struct {
+	optional           bool
+	mock               *OAuthRepositoryMock
+	defaultExpectation *OAuthRepositoryMockDeleteSessionExpectation
+	expectations       []*OAuthRepositoryMockDeleteSessionExpectation
+
+	callArgs []*OAuthRepositoryMockDeleteSessionParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OAuthRepositoryMockDeleteSessionExpectation specifies expectation struct of the OAuthRepository.DeleteSession
+type OAuthRepositoryMockDeleteSessionExpectation struct {
+	mock               *OAuthRepositoryMock
+	params             *OAuthRepositoryMockDeleteSessionParams
+	paramPtrs          *OAuthRepositoryMockDeleteSessionParamPtrs
+	expectationOrigins OAuthRepositoryMockDeleteSessionExpectationOrigins
+	results            *OAuthRepositoryMockDeleteSessionResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// OAuthRepositoryMockDeleteSessionParams contains parameters of the OAuthRepository.DeleteSession
+type OAuthRepositoryMockDeleteSessionParams struct {
+	ctx       context.Context
+	userID    string
+	sessionID string
+}
+
+// OAuthRepositoryMockDeleteSessionParamPtrs contains pointers to parameters of the OAuthRepository.DeleteSession
+type OAuthRepositoryMockDeleteSessionParamPtrs struct {
+	ctx       *context.Context
+	userID    *string
+	sessionID *string
+}
+
+// OAuthRepositoryMockDeleteSessionResults contains results of the OAuthRepository.DeleteSession
+type OAuthRepositoryMockDeleteSessionResults struct {
+	err error
+}
+
+// OAuthRepositoryMockDeleteSessionOrigins contains origins of expectations of the OAuthRepository.DeleteSession
+type OAuthRepositoryMockDeleteSessionExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originUserID    string
+	originSessionID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteSession *mOAuthRepositoryMockDeleteSession) Optional() *mOAuthRepositoryMockDeleteSession {
+	mmDeleteSession.optional = true
+	return mmDeleteSession
+}
+
+// Expect sets up expected params for OAuthRepository.DeleteSession
+func (mmDeleteSession *mOAuthRepositoryMockDeleteSession) Expect(ctx context.Context, userID string, sessionID string) *mOAuthRepositoryMockDeleteSession {
+	if mmDeleteSession.mock.funcDeleteSession != nil {
+		mmDeleteSession.mock.t.Fatalf("OAuthRepositoryMock.DeleteSession mock is already set by Set")
+	}
+
+	if mmDeleteSession.defaultExpectation == nil {
+		mmDeleteSession.defaultExpectation = &OAuthRepositoryMockDeleteSessionExpectation{}
+	}
+
+	if mmDeleteSession.defaultExpectation.paramPtrs != nil {
+		mmDeleteSession.mock.t.Fatalf("OAuthRepositoryMock.DeleteSession mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteSession.defaultExpectation.params = &OAuthRepositoryMockDeleteSessionParams{ctx, userID, sessionID}
+	mmDeleteSession.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteSession.expectations {
+		if minimock.Equal(e.params, mmDeleteSession.defaultExpectation.params) {
+			mmDeleteSession.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteSession.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteSession
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OAuthRepository.DeleteSession
+func (mmDeleteSession *mOAuthRepositoryMockDeleteSession) ExpectCtxParam1(ctx context.Context) *mOAuthRepositoryMockDeleteSession {
+	if mmDeleteSession.mock.funcDeleteSession != nil {
+		mmDeleteSession.mock.t.Fatalf("OAuthRepositoryMock.DeleteSession mock is already set by Set")
+	}
+
+	if mmDeleteSession.defaultExpectation == nil {
+		mmDeleteSession.defaultExpectation = &OAuthRepositoryMockDeleteSessionExpectation{}
+	}
+
+	if mmDeleteSession.defaultExpectation.params != nil {
+		mmDeleteSession.mock.t.Fatalf("OAuthRepositoryMock.DeleteSession mock is already set by Expect")
+	}
+
+	if mmDeleteSession.defaultExpectation.paramPtrs == nil {
+		mmDeleteSession.defaultExpectation.paramPtrs = &OAuthRepositoryMockDeleteSessionParamPtrs{}
+	}
+	mmDeleteSession.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteSession.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteSession
+}
+
+// ExpectUserIDParam2 sets up expected param userID for OAuthRepository.DeleteSession
+func (mmDeleteSession *mOAuthRepositoryMockDeleteSession) ExpectUserIDParam2(userID string) *mOAuthRepositoryMockDeleteSession {
+	if mmDeleteSession.mock.funcDeleteSession != nil {
+		mmDeleteSession.mock.t.Fatalf("OAuthRepositoryMock.DeleteSession mock is already set by Set")
+	}
+
+	if mmDeleteSession.defaultExpectation == nil {
+		mmDeleteSession.defaultExpectation = &OAuthRepositoryMockDeleteSessionExpectation{}
+	}
+
+	if mmDeleteSession.defaultExpectation.params != nil {
+		mmDeleteSession.mock.t.Fatalf("OAuthRepositoryMock.DeleteSession mock is already set by Expect")
+	}
+
+	if mmDeleteSession.defaultExpectation.paramPtrs == nil {
+		mmDeleteSession.defaultExpectation.paramPtrs = &OAuthRepositoryMockDeleteSessionParamPtrs{}
+	}
+	mmDeleteSession.defaultExpectation.paramPtrs.userID = &userID
+	mmDeleteSession.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmDeleteSession
+}
+
+// ExpectSessionIDParam3 sets up expected param sessionID for OAuthRepository.DeleteSession
+func (mmDeleteSession *mOAuthRepositoryMockDeleteSession) ExpectSessionIDParam3(sessionID string) *mOAuthRepositoryMockDeleteSession {
+	if mmDeleteSession.mock.funcDeleteSession != nil {
+		mmDeleteSession.mock.t.Fatalf("OAuthRepositoryMock.DeleteSession mock is already set by Set")
+	}
+
+	if mmDeleteSession.defaultExpectation == nil {
+		mmDeleteSession.defaultExpectation = &OAuthRepositoryMockDeleteSessionExpectation{}
+	}
+
+	if mmDeleteSession.defaultExpectation.params != nil {
+		mmDeleteSession.mock.t.Fatalf("OAuthRepositoryMock.DeleteSession mock is already set by Expect")
+	}
+
+	if mmDeleteSession.defaultExpectation.paramPtrs == nil {
+		mmDeleteSession.defaultExpectation.paramPtrs = &OAuthRepositoryMockDeleteSessionParamPtrs{}
+	}
+	mmDeleteSession.defaultExpectation.paramPtrs.sessionID = &sessionID
+	mmDeleteSession.defaultExpectation.expectationOrigins.originSessionID = minimock.CallerInfo(1)
+
+	return mmDeleteSession
+}
+
+// Inspect accepts an inspector function that has same arguments as the OAuthRepository.DeleteSession
+func (mmDeleteSession *mOAuthRepositoryMockDeleteSession) Inspect(f func(ctx context.Context, userID string, sessionID string)) *mOAuthRepositoryMockDeleteSession {
+	if mmDeleteSession.mock.inspectFuncDeleteSession != nil {
+		mmDeleteSession.mock.t.Fatalf("Inspect function is already set for OAuthRepositoryMock.DeleteSession")
+	}
+
+	mmDeleteSession.mock.inspectFuncDeleteSession = f
+
+	return mmDeleteSession
+}
+
+// Return sets up results that will be returned by OAuthRepository.DeleteSession
+func (mmDeleteSession *mOAuthRepositoryMockDeleteSession) Return(err error) *OAuthRepositoryMock {
+	if mmDeleteSession.mock.funcDeleteSession != nil {
+		mmDeleteSession.mock.t.Fatalf("OAuthRepositoryMock.DeleteSession mock is already set by Set")
+	}
+
+	if mmDeleteSession.defaultExpectation == nil {
+		mmDeleteSession.defaultExpectation = &OAuthRepositoryMockDeleteSessionExpectation{mock: mmDeleteSession.mock}
+	}
+	mmDeleteSession.defaultExpectation.results = &OAuthRepositoryMockDeleteSessionResults{err}
+	mmDeleteSession.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteSession.mock
+}
+
+// Set uses given function f to mock the OAuthRepository.DeleteSession method
+func (mmDeleteSession *mOAuthRepositoryMockDeleteSession) Set(f func(ctx context.Context, userID string, sessionID string) (err error)) *OAuthRepositoryMock {
+	if mmDeleteSession.defaultExpectation != nil {
+		mmDeleteSession.mock.t.Fatalf("Default expectation is already set for the OAuthRepository.DeleteSession method")
+	}
+
+	if len(mmDeleteSession.expectations) > 0 {
+		mmDeleteSession.mock.t.Fatalf("Some expectations are already set for the OAuthRepository.DeleteSession method")
+	}
+
+	mmDeleteSession.mock.funcDeleteSession = f
+	mmDeleteSession.mock.funcDeleteSessionOrigin = minimock.CallerInfo(1)
+	return mmDeleteSession.mock
+}
+
+// When sets expectation for the OAuthRepository.DeleteSession which will trigger the result defined by the following
+// Then helper
+func (mmDeleteSession *mOAuthRepositoryMockDeleteSession) When(ctx context.Context, userID string, sessionID string) *OAuthRepositoryMockDeleteSessionExpectation {
+	if mmDeleteSession.mock.funcDeleteSession != nil {
+		mmDeleteSession.mock.t.Fatalf("OAuthRepositoryMock.DeleteSession mock is already set by Set")
+	}
+
+	expectation := &OAuthRepositoryMockDeleteSessionExpectation{
+		mock:               mmDeleteSession.mock,
+		params:             &OAuthRepositoryMockDeleteSessionParams{ctx, userID, sessionID},
+		expectationOrigins: OAuthRepositoryMockDeleteSessionExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteSession.expectations = append(mmDeleteSession.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OAuthRepository.DeleteSession return parameters for the expectation previously defined by the When method
+func (e *OAuthRepositoryMockDeleteSessionExpectation) Then(err error) *OAuthRepositoryMock {
+	e.results = &OAuthRepositoryMockDeleteSessionResults{err}
+	return e.mock
+}
+
+// Times sets number of times OAuthRepository.DeleteSession should be invoked
+func (mmDeleteSession *mOAuthRepositoryMockDeleteSession) Times(n uint64) *mOAuthRepositoryMockDeleteSession {
+	if n == 0 {
+		mmDeleteSession.mock.t.Fatalf("Times of OAuthRepositoryMock.DeleteSession mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteSession.expectedInvocations, n)
+	mmDeleteSession.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteSession
+}
+
+func (mmDeleteSession *mOAuthRepositoryMockDeleteSession) invocationsDone() bool {
+	if len(mmDeleteSession.expectations) == 0 && mmDeleteSession.defaultExpectation == nil && mmDeleteSession.mock.funcDeleteSession == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteSession.mock.afterDeleteSessionCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteSession.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteSession implements OAuthRepository
+func (mmDeleteSession *OAuthRepositoryMock) DeleteSession(ctx context.Context, userID string, sessionID string) (err error) {
+	mm_atomic.AddUint64(&mmDeleteSession.beforeDeleteSessionCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteSession.afterDeleteSessionCounter, 1)
+
+	mmDeleteSession.t.Helper()
+
+	if mmDeleteSession.inspectFuncDeleteSession != nil {
+		mmDeleteSession.inspectFuncDeleteSession(ctx, userID, sessionID)
+	}
+
+	mm_params := OAuthRepositoryMockDeleteSessionParams{ctx, userID, sessionID}
+
+	// Record call args
+	mmDeleteSession.DeleteSessionMock.mutex.Lock()
+	mmDeleteSession.DeleteSessionMock.callArgs = append(mmDeleteSession.DeleteSessionMock.callArgs, &mm_params)
+	mmDeleteSession.DeleteSessionMock.mutex.Unlock()
+
+	for _, e := range mmDeleteSession.DeleteSessionMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteSession.DeleteSessionMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteSession.DeleteSessionMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteSession.DeleteSessionMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteSession.DeleteSessionMock.defaultExpectation.paramPtrs
+
+		mm_got := OAuthRepositoryMockDeleteSessionParams{ctx, userID, sessionID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteSession.t.Errorf("OAuthRepositoryMock.DeleteSession got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteSession.DeleteSessionMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmDeleteSession.t.Errorf("OAuthRepositoryMock.DeleteSession got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteSession.DeleteSessionMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.sessionID != nil && !minimock.Equal(*mm_want_ptrs.sessionID, mm_got.sessionID) {
+				mmDeleteSession.t.Errorf("OAuthRepositoryMock.DeleteSession got unexpected parameter sessionID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteSession.DeleteSessionMock.defaultExpectation.expectationOrigins.originSessionID, *mm_want_ptrs.sessionID, mm_got.sessionID, minimock.Diff(*mm_want_ptrs.sessionID, mm_got.sessionID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteSession.t.Errorf("OAuthRepositoryMock.DeleteSession got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteSession.DeleteSessionMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteSession.DeleteSessionMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteSession.t.Fatal("No results are set for the OAuthRepositoryMock.DeleteSession")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteSession.funcDeleteSession != nil {
+		return mmDeleteSession.funcDeleteSession(ctx, userID, sessionID)
+	}
+	mmDeleteSession.t.Fatalf("Unexpected call to OAuthRepositoryMock.DeleteSession. %v %v %v", ctx, userID, sessionID)
+	return
+}
+
+// DeleteSessionAfterCounter returns a count of finished OAuthRepositoryMock.DeleteSession invocations
+func (mmDeleteSession *OAuthRepositoryMock) DeleteSessionAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteSession.afterDeleteSessionCounter)
+}
+
+// DeleteSessionBeforeCounter returns a count of OAuthRepositoryMock.DeleteSession invocations
+func (mmDeleteSession *OAuthRepositoryMock) DeleteSessionBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteSession.beforeDeleteSessionCounter)
+}
+
+// Calls returns a list of arguments used in each call to OAuthRepositoryMock.DeleteSession.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteSession *mOAuthRepositoryMockDeleteSession) Calls() []*OAuthRepositoryMockDeleteSessionParams {
+	mmDeleteSession.mutex.RLock()
+
+	argCopy := make([]*OAuthRepositoryMockDeleteSessionParams, len(mmDeleteSession.callArgs))
+	copy(argCopy, mmDeleteSession.callArgs)
+
+	mmDeleteSession.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteSessionDone returns true if the count of the DeleteSession invocations corresponds
+// the number of defined expectations
+func (m *OAuthRepositoryMock) MinimockDeleteSessionDone() bool {
+	if m.DeleteSessionMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteSessionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteSessionMock.invocationsDone()
+}
+
+// MinimockDeleteSessionInspect logs each unmet expectation
+func (m *OAuthRepositoryMock) MinimockDeleteSessionInspect() {
+	for _, e := range m.DeleteSessionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OAuthRepositoryMock.DeleteSession at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteSessionCounter := mm_atomic.LoadUint64(&m.afterDeleteSessionCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteSessionMock.defaultExpectation != nil && afterDeleteSessionCounter < 1 {
+		if m.DeleteSessionMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OAuthRepositoryMock.DeleteSession at\n%s", m.DeleteSessionMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OAuthRepositoryMock.DeleteSession at\n%s with params: %#v", m.DeleteSessionMock.defaultExpectation.expectationOrigins.origin, *m.DeleteSessionMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteSession != nil && afterDeleteSessionCounter < 1 {
+		m.t.Errorf("Expected call to OAuthRepositoryMock.DeleteSession at\n%s", m.funcDeleteSessionOrigin)
+	}
+
+	if !m.DeleteSessionMock.invocationsDone() && afterDeleteSessionCounter > 0 {
+		m.t.Errorf("Expected %d calls to OAuthRepositoryMock.DeleteSession at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteSessionMock.expectedInvocations), m.DeleteSessionMock.expectedInvocationsOrigin, afterDeleteSessionCounter)
+	}
+}
+
+type mOAuthRepositoryMockFindByID struct {
+	optional           bool
+	mock               *OAuthRepositoryMock
+	defaultExpectation *OAuthRepositoryMockFindByIDExpectation
+	expectations       []*OAuthRepositoryMockFindByIDExpectation
+
+	callArgs []*OAuthRepositoryMockFindByIDParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OAuthRepositoryMockFindByIDExpectation specifies expectation struct of the OAuthRepository.FindByID
+type OAuthRepositoryMockFindByIDExpectation struct {
+	mock               *OAuthRepositoryMock
+	params             *OAuthRepositoryMockFindByIDParams
+	paramPtrs          *OAuthRepositoryMockFindByIDParamPtrs
+	expectationOrigins OAuthRepositoryMockFindByIDExpectationOrigins
+	results            *OAuthRepositoryMockFindByIDResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// OAuthRepositoryMockFindByIDParams contains parameters of the OAuthRepository.FindByID
+type OAuthRepositoryMockFindByIDParams struct {
+	ctx    context.Context
+	userID string
+}
+
+// OAuthRepositoryMockFindByIDParamPtrs contains pointers to parameters of the OAuthRepository.FindByID
+type OAuthRepositoryMockFindByIDParamPtrs struct {
+	ctx    *context.Context
+	userID *string
+}
+
+// OAuthRepositoryMockFindByIDResults contains results of the OAuthRepository.FindByID
+type OAuthRepositoryMockFindByIDResults struct {
+	up1 *models.User
+	err error
+}
+
+// OAuthRepositoryMockFindByIDOrigins contains origins of expectations of the OAuthRepository.FindByID
+type OAuthRepositoryMockFindByIDExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmFindByID *mOAuthRepositoryMockFindByID) Optional() *mOAuthRepositoryMockFindByID {
+	mmFindByID.optional = true
+	return mmFindByID
+}
+
+// Expect sets up expected params for OAuthRepository.FindByID
+func (mmFindByID *mOAuthRepositoryMockFindByID) Expect(ctx context.Context, userID string) *mOAuthRepositoryMockFindByID {
+	if mmFindByID.mock.funcFindByID != nil {
+		mmFindByID.mock.t.Fatalf("OAuthRepositoryMock.FindByID mock is already set by Set")
+	}
+
+	if mmFindByID.defaultExpectation == nil {
+		mmFindByID.defaultExpectation = &OAuthRepositoryMockFindByIDExpectation{}
+	}
+
+	if mmFindByID.defaultExpectation.paramPtrs != nil {
+		mmFindByID.mock.t.Fatalf("OAuthRepositoryMock.FindByID mock is already set by ExpectParams functions")
+	}
+
+	mmFindByID.defaultExpectation.params = &OAuthRepositoryMockFindByIDParams{ctx, userID}
+	mmFindByID.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmFindByID.expectations {
+		if minimock.Equal(e.params, mmFindByID.defaultExpectation.params) {
+			mmFindByID.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmFindByID.defaultExpectation.params)
+		}
+	}
+
+	return mmFindByID
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OAuthRepository.FindByID
+func (mmFindByID *mOAuthRepositoryMockFindByID) ExpectCtxParam1(ctx context.Context) *mOAuthRepositoryMockFindByID {
+	if mmFindByID.mock.funcFindByID != nil {
+		mmFindByID.mock.t.Fatalf("OAuthRepositoryMock.FindByID mock is already set by Set")
+	}
+
+	if mmFindByID.defaultExpectation == nil {
+		mmFindByID.defaultExpectation = &OAuthRepositoryMockFindByIDExpectation{}
+	}
+
+	if mmFindByID.defaultExpectation.params != nil {
+		mmFindByID.mock.t.Fatalf("OAuthRepositoryMock.FindByID mock is already set by Expect")
+	}
+
+	if mmFindByID.defaultExpectation.paramPtrs == nil {
+		mmFindByID.defaultExpectation.paramPtrs = &OAuthRepositoryMockFindByIDParamPtrs{}
+	}
+	mmFindByID.defaultExpectation.paramPtrs.ctx = &ctx
+	mmFindByID.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmFindByID
+}
+
+// ExpectUserIDParam2 sets up expected param userID for OAuthRepository.FindByID
+func (mmFindByID *mOAuthRepositoryMockFindByID) ExpectUserIDParam2(userID string) *mOAuthRepositoryMockFindByID {
+	if mmFindByID.mock.funcFindByID != nil {
+		mmFindByID.mock.t.Fatalf("OAuthRepositoryMock.FindByID mock is already set by Set")
+	}
+
+	if mmFindByID.defaultExpectation == nil {
+		mmFindByID.defaultExpectation = &OAuthRepositoryMockFindByIDExpectation{}
+	}
+
+	if mmFindByID.defaultExpectation.params != nil {
+		mmFindByID.mock.t.Fatalf("OAuthRepositoryMock.FindByID mock is already set by Expect")
+	}
+
+	if mmFindByID.defaultExpectation.paramPtrs == nil {
+		mmFindByID.defaultExpectation.paramPtrs = &OAuthRepositoryMockFindByIDParamPtrs{}
+	}
+	mmFindByID.defaultExpectation.paramPtrs.userID = &userID
+	mmFindByID.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmFindByID
+}
+
+// Inspect accepts an inspector function that has same arguments as the OAuthRepository.FindByID
+func (mmFindByID *mOAuthRepositoryMockFindByID) Inspect(f func(ctx context.Context, userID string)) *mOAuthRepositoryMockFindByID {
+	if mmFindByID.mock.inspectFuncFindByID != nil {
+		mmFindByID.mock.t.Fatalf("Inspect function is already set for OAuthRepositoryMock.FindByID")
+	}
+
+	mmFindByID.mock.inspectFuncFindByID = f
+
+	return mmFindByID
+}
+
+// Return sets up results that will be returned by OAuthRepository.FindByID
+func (mmFindByID *mOAuthRepositoryMockFindByID) Return(up1 *models.User, err error) *OAuthRepositoryMock {
+	if mmFindByID.mock.funcFindByID != nil {
+		mmFindByID.mock.t.Fatalf("OAuthRepositoryMock.FindByID mock is already set by Set")
+	}
+
+	if mmFindByID.defaultExpectation == nil {
+		mmFindByID.defaultExpectation = &OAuthRepositoryMockFindByIDExpectation{mock: mmFindByID.mock}
+	}
+	mmFindByID.defaultExpectation.results = &OAuthRepositoryMockFindByIDResults{up1, err}
+	mmFindByID.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmFindByID.mock
+}
+
+// Set uses given function f to mock the OAuthRepository.FindByID method
+func (mmFindByID *mOAuthRepositoryMockFindByID) Set(f func(ctx context.Context, userID string) (up1 *models.User, err error)) *OAuthRepositoryMock {
+	if mmFindByID.defaultExpectation != nil {
+		mmFindByID.mock.t.Fatalf("Default expectation is already set for the OAuthRepository.FindByID method")
+	}
+
+	if len(mmFindByID.expectations) > 0 {
+		mmFindByID.mock.t.Fatalf("Some expectations are already set for the OAuthRepository.FindByID method")
+	}
+
+	mmFindByID.mock.funcFindByID = f
+	mmFindByID.mock.funcFindByIDOrigin = minimock.CallerInfo(1)
+	return mmFindByID.mock
+}
+
+// When sets expectation for the OAuthRepository.FindByID which will trigger the result defined by the following
+// Then helper
+func (mmFindByID *mOAuthRepositoryMockFindByID) When(ctx context.Context, userID string) *OAuthRepositoryMockFindByIDExpectation {
+	if mmFindByID.mock.funcFindByID != nil {
+		mmFindByID.mock.t.Fatalf("OAuthRepositoryMock.FindByID mock is already set by Set")
+	}
+
+	expectation := &OAuthRepositoryMockFindByIDExpectation{
+		mock:               mmFindByID.mock,
+		params:             &OAuthRepositoryMockFindByIDParams{ctx, userID},
+		expectationOrigins: OAuthRepositoryMockFindByIDExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmFindByID.expectations = append(mmFindByID.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OAuthRepository.FindByID return parameters for the expectation previously defined by the When method
+func (e *OAuthRepositoryMockFindByIDExpectation) Then(up1 *models.User, err error) *OAuthRepositoryMock {
+	e.results = &OAuthRepositoryMockFindByIDResults{up1, err}
+	return e.mock
+}
+
+// Times sets number of times OAuthRepository.FindByID should be invoked
+func (mmFindByID *mOAuthRepositoryMockFindByID) Times(n uint64) *mOAuthRepositoryMockFindByID {
+	if n == 0 {
+		mmFindByID.mock.t.Fatalf("Times of OAuthRepositoryMock.FindByID mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmFindByID.expectedInvocations, n)
+	mmFindByID.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmFindByID
+}
+
+func (mmFindByID *mOAuthRepositoryMockFindByID) invocationsDone() bool {
+	if len(mmFindByID.expectations) == 0 && mmFindByID.defaultExpectation == nil && mmFindByID.mock.funcFindByID == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmFindByID.mock.afterFindByIDCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmFindByID.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// FindByID implements OAuthRepository
+func (mmFindByID *OAuthRepositoryMock) FindByID(ctx context.Context, userID string) (up1 *models.User, err error) {
+	mm_atomic.AddUint64(&mmFindByID.beforeFindByIDCounter, 1)
+	defer mm_atomic.AddUint64(&mmFindByID.afterFindByIDCounter, 1)
+
+	mmFindByID.t.Helper()
+
+	if mmFindByID.inspectFuncFindByID != nil {
+		mmFindByID.inspectFuncFindByID(ctx, userID)
+	}
+
+	mm_params := OAuthRepositoryMockFindByIDParams{ctx, userID}
+
+	// Record call args
+	mmFindByID.FindByIDMock.mutex.Lock()
+	mmFindByID.FindByIDMock.callArgs = append(mmFindByID.FindByIDMock.callArgs, &mm_params)
+	mmFindByID.FindByIDMock.mutex.Unlock()
+
+	for _, e := range mmFindByID.FindByIDMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.up1, e.results.err
+		}
+	}
+
+	if mmFindByID.FindByIDMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmFindByID.FindByIDMock.defaultExpectation.Counter, 1)
+		mm_want := mmFindByID.FindByIDMock.defaultExpectation.params
+		mm_want_ptrs := mmFindByID.FindByIDMock.defaultExpectation.paramPtrs
+
+		mm_got := OAuthRepositoryMockFindByIDParams{ctx, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmFindByID.t.Errorf("OAuthRepositoryMock.FindByID got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindByID.FindByIDMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmFindByID.t.Errorf("OAuthRepositoryMock.FindByID got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindByID.FindByIDMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmFindByID.t.Errorf("OAuthRepositoryMock.FindByID got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmFindByID.FindByIDMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmFindByID.FindByIDMock.defaultExpectation.results
+		if mm_results == nil {
+			mmFindByID.t.Fatal("No results are set for the OAuthRepositoryMock.FindByID")
+		}
+		return (*mm_results).up1, (*mm_results).err
+	}
+	if mmFindByID.funcFindByID != nil {
+		return mmFindByID.funcFindByID(ctx, userID)
+	}
+	mmFindByID.t.Fatalf("Unexpected call to OAuthRepositoryMock.FindByID. %v %v", ctx, userID)
+	return
+}
+
+// FindByIDAfterCounter returns a count of finished OAuthRepositoryMock.FindByID invocations
+func (mmFindByID *OAuthRepositoryMock) FindByIDAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFindByID.afterFindByIDCounter)
+}
+
+// FindByIDBeforeCounter returns a count of OAuthRepositoryMock.FindByID invocations
+func (mmFindByID *OAuthRepositoryMock) FindByIDBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFindByID.beforeFindByIDCounter)
+}
+
+// Calls returns a list of arguments used in each call to OAuthRepositoryMock.FindByID.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmFindByID *mOAuthRepositoryMockFindByID) Calls() []*OAuthRepositoryMockFindByIDParams {
+	mmFindByID.mutex.RLock()
+
+	argCopy := make([]*OAuthRepositoryMockFindByIDParams, len(mmFindByID.callArgs))
+	copy(argCopy, mmFindByID.callArgs)
+
+	mmFindByID.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockFindByIDDone returns true if the count of the FindByID invocations corresponds
+// the number of defined expectations
+func (m *OAuthRepositoryMock) MinimockFindByIDDone() bool {
+	if m.FindByIDMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.FindByIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.FindByIDMock.invocationsDone()
+}
+
+// MinimockFindByIDInspect logs each unmet expectation
+func (m *OAuthRepositoryMock) MinimockFindByIDInspect() {
+	for _, e := range m.FindByIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OAuthRepositoryMock.FindByID at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterFindByIDCounter := mm_atomic.LoadUint64(&m.afterFindByIDCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.FindByIDMock.defaultExpectation != nil && afterFindByIDCounter < 1 {
+		if m.FindByIDMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OAuthRepositoryMock.FindByID at\n%s", m.FindByIDMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OAuthRepositoryMock.FindByID at\n%s with params: %#v", m.FindByIDMock.defaultExpectation.expectationOrigins.origin, *m.FindByIDMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcFindByID != nil && afterFindByIDCounter < 1 {
+		m.t.Errorf("Expected call to OAuthRepositoryMock.FindByID at\n%s", m.funcFindByIDOrigin)
+	}
+
+	if !m.FindByIDMock.invocationsDone() && afterFindByIDCounter > 0 {
+		m.t.Errorf("Expected %d calls to OAuthRepositoryMock.FindByID at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.FindByIDMock.expectedInvocations), m.FindByIDMock.expectedInvocationsOrigin, afterFindByIDCounter)
+	}
+}
+
+type mOAuthRepositoryMockFindClientByID struct {
+	optional           bool
+	mock               *OAuthRepositoryMock
+	defaultExpectation *OAuthRepositoryMockFindClientByIDExpectation
+	expectations       []*OAuthRepositoryMockFindClientByIDExpectation
+
+	callArgs []*OAuthRepositoryMockFindClientByIDParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OAuthRepositoryMockFindClientByIDExpectation specifies expectation struct of the OAuthRepository.FindClientByID
+type OAuthRepositoryMockFindClientByIDExpectation struct {
+	mock               *OAuthRepositoryMock
+	params             *OAuthRepositoryMockFindClientByIDParams
+	paramPtrs          *OAuthRepositoryMockFindClientByIDParamPtrs
+	expectationOrigins OAuthRepositoryMockFindClientByIDExpectationOrigins
+	results            *OAuthRepositoryMockFindClientByIDResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// OAuthRepositoryMockFindClientByIDParams contains parameters of the OAuthRepository.FindClientByID
+type OAuthRepositoryMockFindClientByIDParams struct {
+	ctx      context.Context
+	clientID string
+}
+
+// OAuthRepositoryMockFindClientByIDParamPtrs contains pointers to parameters of the OAuthRepository.FindClientByID
+type OAuthRepositoryMockFindClientByIDParamPtrs struct {
+	ctx      *context.Context
+	clientID *string
+}
+
+// OAuthRepositoryMockFindClientByIDResults contains results of the OAuthRepository.FindClientByID
+type OAuthRepositoryMockFindClientByIDResults struct {
+	cp1 *models.Client
+	err error
+}
+
+// OAuthRepositoryMockFindClientByIDOrigins contains origins of expectations of the OAuthRepository.FindClientByID
+type OAuthRepositoryMockFindClientByIDExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originClientID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmFindClientByID *mOAuthRepositoryMockFindClientByID) Optional() *mOAuthRepositoryMockFindClientByID {
+	mmFindClientByID.optional = true
+	return mmFindClientByID
+}
+
+// Expect sets up expected params for OAuthRepository.FindClientByID
+func (mmFindClientByID *mOAuthRepositoryMockFindClientByID) Expect(ctx context.Context, clientID string) *mOAuthRepositoryMockFindClientByID {
+	if mmFindClientByID.mock.funcFindClientByID != nil {
+		mmFindClientByID.mock.t.Fatalf("OAuthRepositoryMock.FindClientByID mock is already set by Set")
+	}
+
+	if mmFindClientByID.defaultExpectation == nil {
+		mmFindClientByID.defaultExpectation = &OAuthRepositoryMockFindClientByIDExpectation{}
+	}
+
+	if mmFindClientByID.defaultExpectation.paramPtrs != nil {
+		mmFindClientByID.mock.t.Fatalf("OAuthRepositoryMock.FindClientByID mock is already set by ExpectParams functions")
+	}
+
+	mmFindClientByID.defaultExpectation.params = &OAuthRepositoryMockFindClientByIDParams{ctx, clientID}
+	mmFindClientByID.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmFindClientByID.expectations {
+		if minimock.Equal(e.params, mmFindClientByID.defaultExpectation.params) {
+			mmFindClientByID.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmFindClientByID.defaultExpectation.params)
+		}
+	}
+
+	return mmFindClientByID
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OAuthRepository.FindClientByID
+func (mmFindClientByID *mOAuthRepositoryMockFindClientByID) ExpectCtxParam1(ctx context.Context) *mOAuthRepositoryMockFindClientByID {
+	if mmFindClientByID.mock.funcFindClientByID != nil {
+		mmFindClientByID.mock.t.Fatalf("OAuthRepositoryMock.FindClientByID mock is already set by Set")
+	}
+
+	if mmFindClientByID.defaultExpectation == nil {
+		mmFindClientByID.defaultExpectation = &OAuthRepositoryMockFindClientByIDExpectation{}
+	}
+
+	if mmFindClientByID.defaultExpectation.params != nil {
+		mmFindClientByID.mock.t.Fatalf("OAuthRepositoryMock.FindClientByID mock is already set by Expect")
+	}
+
+	if mmFindClientByID.defaultExpectation.paramPtrs == nil {
+		mmFindClientByID.defaultExpectation.paramPtrs = &OAuthRepositoryMockFindClientByIDParamPtrs{}
+	}
+	mmFindClientByID.defaultExpectation.paramPtrs.ctx = &ctx
+	mmFindClientByID.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmFindClientByID
+}
+
+// ExpectClientIDParam2 sets up expected param clientID for OAuthRepository.FindClientByID
+func (mmFindClientByID *mOAuthRepositoryMockFindClientByID) ExpectClientIDParam2(clientID string) *mOAuthRepositoryMockFindClientByID {
+	if mmFindClientByID.mock.funcFindClientByID != nil {
+		mmFindClientByID.mock.t.Fatalf("OAuthRepositoryMock.FindClientByID mock is already set by Set")
+	}
+
+	if mmFindClientByID.defaultExpectation == nil {
+		mmFindClientByID.defaultExpectation = &OAuthRepositoryMockFindClientByIDExpectation{}
+	}
+
+	if mmFindClientByID.defaultExpectation.params != nil {
+		mmFindClientByID.mock.t.Fatalf("OAuthRepositoryMock.FindClientByID mock is already set by Expect")
+	}
+
+	if mmFindClientByID.defaultExpectation.paramPtrs == nil {
+		mmFindClientByID.defaultExpectation.paramPtrs = &OAuthRepositoryMockFindClientByIDParamPtrs{}
+	}
+	mmFindClientByID.defaultExpectation.paramPtrs.clientID = &clientID
+	mmFindClientByID.defaultExpectation.expectationOrigins.originClientID = minimock.CallerInfo(1)
+
+	return mmFindClientByID
+}
+
+// Inspect accepts an inspector function that has same arguments as the OAuthRepository.FindClientByID
+func (mmFindClientByID *mOAuthRepositoryMockFindClientByID) Inspect(f func(ctx context.Context, clientID string)) *mOAuthRepositoryMockFindClientByID {
+	if mmFindClientByID.mock.inspectFuncFindClientByID != nil {
+		mmFindClientByID.mock.t.Fatalf("Inspect function is already set for OAuthRepositoryMock.FindClientByID")
+	}
+
+	mmFindClientByID.mock.inspectFuncFindClientByID = f
+
+	return mmFindClientByID
+}
+
+// Return sets up results that will be returned by OAuthRepository.FindClientByID
+func (mmFindClientByID *mOAuthRepositoryMockFindClientByID) Return(cp1 *models.Client, err error) *OAuthRepositoryMock {
+	if mmFindClientByID.mock.funcFindClientByID != nil {
+		mmFindClientByID.mock.t.Fatalf("OAuthRepositoryMock.FindClientByID mock is already set by Set")
+	}
+
+	if mmFindClientByID.defaultExpectation == nil {
+		mmFindClientByID.defaultExpectation = &OAuthRepositoryMockFindClientByIDExpectation{mock: mmFindClientByID.mock}
+	}
+	mmFindClientByID.defaultExpectation.results = &OAuthRepositoryMockFindClientByIDResults{cp1, err}
+	mmFindClientByID.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmFindClientByID.mock
+}
+
+// Set uses given function f to mock the OAuthRepository.FindClientByID method
+func (mmFindClientByID *mOAuthRepositoryMockFindClientByID) Set(f func(ctx context.Context, clientID string) (cp1 *models.Client, err error)) *OAuthRepositoryMock {
+	if mmFindClientByID.defaultExpectation != nil {
+		mmFindClientByID.mock.t.Fatalf("Default expectation is already set for the OAuthRepository.FindClientByID method")
+	}
+
+	if len(mmFindClientByID.expectations) > 0 {
+		mmFindClientByID.mock.t.Fatalf("Some expectations are already set for the OAuthRepository.FindClientByID method")
+	}
+
+	mmFindClientByID.mock.funcFindClientByID = f
+	mmFindClientByID.mock.funcFindClientByIDOrigin = minimock.CallerInfo(1)
+	return mmFindClientByID.mock
+}
+
+// When sets expectation for the OAuthRepository.FindClientByID which will trigger the result defined by the following
+// Then helper
+func (mmFindClientByID *mOAuthRepositoryMockFindClientByID) When(ctx context.Context, clientID string) *OAuthRepositoryMockFindClientByIDExpectation {
+	if mmFindClientByID.mock.funcFindClientByID != nil {
+		mmFindClientByID.mock.t.Fatalf("OAuthRepositoryMock.FindClientByID mock is already set by Set")
+	}
+
+	expectation := &OAuthRepositoryMockFindClientByIDExpectation{
+		mock:               mmFindClientByID.mock,
+		params:             &OAuthRepositoryMockFindClientByIDParams{ctx, clientID},
+		expectationOrigins: OAuthRepositoryMockFindClientByIDExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmFindClientByID.expectations = append(mmFindClientByID.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OAuthRepository.FindClientByID return parameters for the expectation previously defined by the When method
+func (e *OAuthRepositoryMockFindClientByIDExpectation) Then(cp1 *models.Client, err error) *OAuthRepositoryMock {
+	e.results = &OAuthRepositoryMockFindClientByIDResults{cp1, err}
+	return e.mock
+}
+
+// Times sets number of times OAuthRepository.FindClientByID should be invoked
+func (mmFindClientByID *mOAuthRepositoryMockFindClientByID) Times(n uint64) *mOAuthRepositoryMockFindClientByID {
+	if n == 0 {
+		mmFindClientByID.mock.t.Fatalf("Times of OAuthRepositoryMock.FindClientByID mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmFindClientByID.expectedInvocations, n)
+	mmFindClientByID.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmFindClientByID
+}
+
+func (mmFindClientByID *mOAuthRepositoryMockFindClientByID) invocationsDone() bool {
+	if len(mmFindClientByID.expectations) == 0 && mmFindClientByID.defaultExpectation == nil && mmFindClientByID.mock.funcFindClientByID == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmFindClientByID.mock.afterFindClientByIDCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmFindClientByID.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// FindClientByID implements OAuthRepository
+func (mmFindClientByID *OAuthRepositoryMock) FindClientByID(ctx context.Context, clientID string) (cp1 *models.Client, err error) {
+	mm_atomic.AddUint64(&mmFindClientByID.beforeFindClientByIDCounter, 1)
+	defer mm_atomic.AddUint64(&mmFindClientByID.afterFindClientByIDCounter, 1)
+
+	mmFindClientByID.t.Helper()
+
+	if mmFindClientByID.inspectFuncFindClientByID != nil {
+		mmFindClientByID.inspectFuncFindClientByID(ctx, clientID)
+	}
+
+	mm_params := OAuthRepositoryMockFindClientByIDParams{ctx, clientID}
+
+	// Record call args
+	mmFindClientByID.FindClientByIDMock.mutex.Lock()
+	mmFindClientByID.FindClientByIDMock.callArgs = append(mmFindClientByID.FindClientByIDMock.callArgs, &mm_params)
+	mmFindClientByID.FindClientByIDMock.mutex.Unlock()
+
+	for _, e := range mmFindClientByID.FindClientByIDMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.cp1, e.results.err
+		}
+	}
+
+	if mmFindClientByID.FindClientByIDMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmFindClientByID.FindClientByIDMock.defaultExpectation.Counter, 1)
+		mm_want := mmFindClientByID.FindClientByIDMock.defaultExpectation.params
+		mm_want_ptrs := mmFindClientByID.FindClientByIDMock.defaultExpectation.paramPtrs
+
+		mm_got := OAuthRepositoryMockFindClientByIDParams{ctx, clientID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmFindClientByID.t.Errorf("OAuthRepositoryMock.FindClientByID got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindClientByID.FindClientByIDMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.clientID != nil && !minimock.Equal(*mm_want_ptrs.clientID, mm_got.clientID) {
+				mmFindClientByID.t.Errorf("OAuthRepositoryMock.FindClientByID got unexpected parameter clientID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindClientByID.FindClientByIDMock.defaultExpectation.expectationOrigins.originClientID, *mm_want_ptrs.clientID, mm_got.clientID, minimock.Diff(*mm_want_ptrs.clientID, mm_got.clientID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmFindClientByID.t.Errorf("OAuthRepositoryMock.FindClientByID got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmFindClientByID.FindClientByIDMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmFindClientByID.FindClientByIDMock.defaultExpectation.results
+		if mm_results == nil {
+			mmFindClientByID.t.Fatal("No results are set for the OAuthRepositoryMock.FindClientByID")
+		}
+		return (*mm_results).cp1, (*mm_results).err
+	}
+	if mmFindClientByID.funcFindClientByID != nil {
+		return mmFindClientByID.funcFindClientByID(ctx, clientID)
+	}
+	mmFindClientByID.t.Fatalf("Unexpected call to OAuthRepositoryMock.FindClientByID. %v %v", ctx, clientID)
+	return
+}
+
+// FindClientByIDAfterCounter returns a count of finished OAuthRepositoryMock.FindClientByID invocations
+func (mmFindClientByID *OAuthRepositoryMock) FindClientByIDAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFindClientByID.afterFindClientByIDCounter)
+}
+
+// FindClientByIDBeforeCounter returns a count of OAuthRepositoryMock.FindClientByID invocations
+func (mmFindClientByID *OAuthRepositoryMock) FindClientByIDBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFindClientByID.beforeFindClientByIDCounter)
+}
+
+// Calls returns a list of arguments used in each call to OAuthRepositoryMock.FindClientByID.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmFindClientByID *mOAuthRepositoryMockFindClientByID) Calls() []*OAuthRepositoryMockFindClientByIDParams {
+	mmFindClientByID.mutex.RLock()
+
+	argCopy := make([]*OAuthRepositoryMockFindClientByIDParams, len(mmFindClientByID.callArgs))
+	copy(argCopy, mmFindClientByID.callArgs)
+
+	mmFindClientByID.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockFindClientByIDDone returns true if the count of the FindClientByID invocations corresponds
+// the number of defined expectations
+func (m *OAuthRepositoryMock) MinimockFindClientByIDDone() bool {
+	if m.FindClientByIDMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.FindClientByIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.FindClientByIDMock.invocationsDone()
+}
+
+// MinimockFindClientByIDInspect logs each unmet expectation
+func (m *OAuthRepositoryMock) MinimockFindClientByIDInspect() {
+	for _, e := range m.FindClientByIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OAuthRepositoryMock.FindClientByID at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterFindClientByIDCounter := mm_atomic.LoadUint64(&m.afterFindClientByIDCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.FindClientByIDMock.defaultExpectation != nil && afterFindClientByIDCounter < 1 {
+		if m.FindClientByIDMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OAuthRepositoryMock.FindClientByID at\n%s", m.FindClientByIDMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OAuthRepositoryMock.FindClientByID at\n%s with params: %#v", m.FindClientByIDMock.defaultExpectation.expectationOrigins.origin, *m.FindClientByIDMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcFindClientByID != nil && afterFindClientByIDCounter < 1 {
+		m.t.Errorf("Expected call to OAuthRepositoryMock.FindClientByID at\n%s", m.funcFindClientByIDOrigin)
+	}
+
+	if !m.FindClientByIDMock.invocationsDone() && afterFindClientByIDCounter > 0 {
+		m.t.Errorf("Expected %d calls to OAuthRepositoryMock.FindClientByID at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.FindClientByIDMock.expectedInvocations), m.FindClientByIDMock.expectedInvocationsOrigin, afterFindClientByIDCounter)
+	}
+}
+
+type mOAuthRepositoryMockFindConsent struct {
+	optional           bool
+	mock               *OAuthRepositoryMock
+	defaultExpectation *OAuthRepositoryMockFindConsentExpectation
+	expectations       []*OAuthRepositoryMockFindConsentExpectation
+
+	callArgs []*OAuthRepositoryMockFindConsentParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OAuthRepositoryMockFindConsentExpectation specifies expectation struct of the OAuthRepository.FindConsent
+type OAuthRepositoryMockFindConsentExpectation struct {
+	mock               *OAuthRepositoryMock
+	params             *OAuthRepositoryMockFindConsentParams
+	paramPtrs          *OAuthRepositoryMockFindConsentParamPtrs
+	expectationOrigins OAuthRepositoryMockFindConsentExpectationOrigins
+	results            *OAuthRepositoryMockFindConsentResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// OAuthRepositoryMockFindConsentParams contains parameters of the OAuthRepository.FindConsent
+type OAuthRepositoryMockFindConsentParams struct {
+	ctx      context.Context
+	userID   string
+	clientID string
+}
+
+// OAuthRepositoryMockFindConsentParamPtrs contains pointers to parameters of the OAuthRepository.FindConsent
+type OAuthRepositoryMockFindConsentParamPtrs struct {
+	ctx      *context.Context
+	userID   *string
+	clientID *string
+}
+
+// OAuthRepositoryMockFindConsentResults contains results of the OAuthRepository.FindConsent
+type OAuthRepositoryMockFindConsentResults struct {
+	cp1 *models.Consent
+	err error
+}
+
+// OAuthRepositoryMockFindConsentOrigins contains origins of expectations of the OAuthRepository.FindConsent
+type OAuthRepositoryMockFindConsentExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originUserID   string
+	originClientID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmFindConsent *mOAuthRepositoryMockFindConsent) Optional() *mOAuthRepositoryMockFindConsent {
+	mmFindConsent.optional = true
+	return mmFindConsent
+}
+
+// Expect sets up expected params for OAuthRepository.FindConsent
+func (mmFindConsent *mOAuthRepositoryMockFindConsent) Expect(ctx context.Context, userID string, clientID string) *mOAuthRepositoryMockFindConsent {
+	if mmFindConsent.mock.funcFindConsent != nil {
+		mmFindConsent.mock.t.Fatalf("OAuthRepositoryMock.FindConsent mock is already set by Set")
+	}
+
+	if mmFindConsent.defaultExpectation == nil {
+		mmFindConsent.defaultExpectation = &OAuthRepositoryMockFindConsentExpectation{}
+	}
+
+	if mmFindConsent.defaultExpectation.paramPtrs != nil {
+		mmFindConsent.mock.t.Fatalf("OAuthRepositoryMock.FindConsent mock is already set by ExpectParams functions")
+	}
+
+	mmFindConsent.defaultExpectation.params = &OAuthRepositoryMockFindConsentParams{ctx, userID, clientID}
+	mmFindConsent.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmFindConsent.expectations {
+		if minimock.Equal(e.params, mmFindConsent.defaultExpectation.params) {
+			mmFindConsent.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmFindConsent.defaultExpectation.params)
+		}
+	}
+
+	return mmFindConsent
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OAuthRepository.FindConsent
+func (mmFindConsent *mOAuthRepositoryMockFindConsent) ExpectCtxParam1(ctx context.Context) *mOAuthRepositoryMockFindConsent {
+	if mmFindConsent.mock.funcFindConsent != nil {
+		mmFindConsent.mock.t.Fatalf("OAuthRepositoryMock.FindConsent mock is already set by Set")
+	}
+
+	if mmFindConsent.defaultExpectation == nil {
+		mmFindConsent.defaultExpectation = &OAuthRepositoryMockFindConsentExpectation{}
+	}
+
+	if mmFindConsent.defaultExpectation.params != nil {
+		mmFindConsent.mock.t.Fatalf("OAuthRepositoryMock.FindConsent mock is already set by Expect")
+	}
+
+	if mmFindConsent.defaultExpectation.paramPtrs == nil {
+		mmFindConsent.defaultExpectation.paramPtrs = &OAuthRepositoryMockFindConsentParamPtrs{}
+	}
+	mmFindConsent.defaultExpectation.paramPtrs.ctx = &ctx
+	mmFindConsent.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmFindConsent
+}
+
+// ExpectUserIDParam2 sets up expected param userID for OAuthRepository.FindConsent
+func (mmFindConsent *mOAuthRepositoryMockFindConsent) ExpectUserIDParam2(userID string) *mOAuthRepositoryMockFindConsent {
+	if mmFindConsent.mock.funcFindConsent != nil {
+		mmFindConsent.mock.t.Fatalf("OAuthRepositoryMock.FindConsent mock is already set by Set")
+	}
+
+	if mmFindConsent.defaultExpectation == nil {
+		mmFindConsent.defaultExpectation = &OAuthRepositoryMockFindConsentExpectation{}
+	}
+
+	if mmFindConsent.defaultExpectation.params != nil {
+		mmFindConsent.mock.t.Fatalf("OAuthRepositoryMock.FindConsent mock is already set by Expect")
+	}
+
+	if mmFindConsent.defaultExpectation.paramPtrs == nil {
+		mmFindConsent.defaultExpectation.paramPtrs = &OAuthRepositoryMockFindConsentParamPtrs{}
+	}
+	mmFindConsent.defaultExpectation.paramPtrs.userID = &userID
+	mmFindConsent.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmFindConsent
+}
+
+// ExpectClientIDParam3 sets up expected param clientID for OAuthRepository.FindConsent
+func (mmFindConsent *mOAuthRepositoryMockFindConsent) ExpectClientIDParam3(clientID string) *mOAuthRepositoryMockFindConsent {
+	if mmFindConsent.mock.funcFindConsent != nil {
+		mmFindConsent.mock.t.Fatalf("OAuthRepositoryMock.FindConsent mock is already set by Set")
+	}
+
+	if mmFindConsent.defaultExpectation == nil {
+		mmFindConsent.defaultExpectation = &OAuthRepositoryMockFindConsentExpectation{}
+	}
+
+	if mmFindConsent.defaultExpectation.params != nil {
+		mmFindConsent.mock.t.Fatalf("OAuthRepositoryMock.FindConsent mock is already set by Expect")
+	}
+
+	if mmFindConsent.defaultExpectation.paramPtrs == nil {
+		mmFindConsent.defaultExpectation.paramPtrs = &OAuthRepositoryMockFindConsentParamPtrs{}
+	}
+	mmFindConsent.defaultExpectation.paramPtrs.clientID = &clientID
+	mmFindConsent.defaultExpectation.expectationOrigins.originClientID = minimock.CallerInfo(1)
+
+	return mmFindConsent
+}
+
+// Inspect accepts an inspector function that has same arguments as the OAuthRepository.FindConsent
+func (mmFindConsent *mOAuthRepositoryMockFindConsent) Inspect(f func(ctx context.Context, userID string, clientID string)) *mOAuthRepositoryMockFindConsent {
+	if mmFindConsent.mock.inspectFuncFindConsent != nil {
+		mmFindConsent.mock.t.Fatalf("Inspect function is already set for OAuthRepositoryMock.FindConsent")
+	}
+
+	mmFindConsent.mock.inspectFuncFindConsent = f
+
+	return mmFindConsent
+}
+
+// Return sets up results that will be returned by OAuthRepository.FindConsent
+func (mmFindConsent *mOAuthRepositoryMockFindConsent) Return(cp1 *models.Consent, err error) *OAuthRepositoryMock {
+	if mmFindConsent.mock.funcFindConsent != nil {
+		mmFindConsent.mock.t.Fatalf("OAuthRepositoryMock.FindConsent mock is already set by Set")
+	}
+
+	if mmFindConsent.defaultExpectation == nil {
+		mmFindConsent.defaultExpectation = &OAuthRepositoryMockFindConsentExpectation{mock: mmFindConsent.mock}
+	}
+	mmFindConsent.defaultExpectation.results = &OAuthRepositoryMockFindConsentResults{cp1, err}
+	mmFindConsent.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmFindConsent.mock
+}
+
+// Set uses given function f to mock the OAuthRepository.FindConsent method
+func (mmFindConsent *mOAuthRepositoryMockFindConsent) Set(f func(ctx context.Context, userID string, clientID string) (cp1 *models.Consent, err error)) *OAuthRepositoryMock {
+	if mmFindConsent.defaultExpectation != nil {
+		mmFindConsent.mock.t.Fatalf("Default expectation is already set for the OAuthRepository.FindConsent method")
+	}
+
+	if len(mmFindConsent.expectations) > 0 {
+		mmFindConsent.mock.t.Fatalf("Some expectations are already set for the OAuthRepository.FindConsent method")
+	}
+
+	mmFindConsent.mock.funcFindConsent = f
+	mmFindConsent.mock.funcFindConsentOrigin = minimock.CallerInfo(1)
+	return mmFindConsent.mock
+}
+
+// When sets expectation for the OAuthRepository.FindConsent which will trigger the result defined by the following
+// Then helper
+func (mmFindConsent *mOAuthRepositoryMockFindConsent) When(ctx context.Context, userID string, clientID string) *OAuthRepositoryMockFindConsentExpectation {
+	if mmFindConsent.mock.funcFindConsent != nil {
+		mmFindConsent.mock.t.Fatalf("OAuthRepositoryMock.FindConsent mock is already set by Set")
+	}
+
+	expectation := &OAuthRepositoryMockFindConsentExpectation{
+		mock:               mmFindConsent.mock,
+		params:             &OAuthRepositoryMockFindConsentParams{ctx, userID, clientID},
+		expectationOrigins: OAuthRepositoryMockFindConsentExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmFindConsent.expectations = append(mmFindConsent.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OAuthRepository.FindConsent return parameters for the expectation previously defined by the When method
+func (e *OAuthRepositoryMockFindConsentExpectation) Then(cp1 *models.Consent, err error) *OAuthRepositoryMock {
+	e.results = &OAuthRepositoryMockFindConsentResults{cp1, err}
+	return e.mock
+}
+
+// Times sets number of times OAuthRepository.FindConsent should be invoked
+func (mmFindConsent *mOAuthRepositoryMockFindConsent) Times(n uint64) *mOAuthRepositoryMockFindConsent {
+	if n == 0 {
+		mmFindConsent.mock.t.Fatalf("Times of OAuthRepositoryMock.FindConsent mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmFindConsent.expectedInvocations, n)
+	mmFindConsent.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmFindConsent
+}
+
+func (mmFindConsent *mOAuthRepositoryMockFindConsent) invocationsDone() bool {
+	if len(mmFindConsent.expectations) == 0 && mmFindConsent.defaultExpectation == nil && mmFindConsent.mock.funcFindConsent == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmFindConsent.mock.afterFindConsentCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmFindConsent.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// FindConsent implements OAuthRepository
+func (mmFindConsent *OAuthRepositoryMock) FindConsent(ctx context.Context, userID string, clientID string) (cp1 *models.Consent, err error) {
+	mm_atomic.AddUint64(&mmFindConsent.beforeFindConsentCounter, 1)
+	defer mm_atomic.AddUint64(&mmFindConsent.afterFindConsentCounter, 1)
+
+	mmFindConsent.t.Helper()
+
+	if mmFindConsent.inspectFuncFindConsent != nil {
+		mmFindConsent.inspectFuncFindConsent(ctx, userID, clientID)
+	}
+
+	mm_params := OAuthRepositoryMockFindConsentParams{ctx, userID, clientID}
+
+	// Record call args
+	mmFindConsent.FindConsentMock.mutex.Lock()
+	mmFindConsent.FindConsentMock.callArgs = append(mmFindConsent.FindConsentMock.callArgs, &mm_params)
+	mmFindConsent.FindConsentMock.mutex.Unlock()
+
+	for _, e := range mmFindConsent.FindConsentMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.cp1, e.results.err
+		}
+	}
+
+	if mmFindConsent.FindConsentMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmFindConsent.FindConsentMock.defaultExpectation.Counter, 1)
+		mm_want := mmFindConsent.FindConsentMock.defaultExpectation.params
+		mm_want_ptrs := mmFindConsent.FindConsentMock.defaultExpectation.paramPtrs
+
+		mm_got := OAuthRepositoryMockFindConsentParams{ctx, userID, clientID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmFindConsent.t.Errorf("OAuthRepositoryMock.FindConsent got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindConsent.FindConsentMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmFindConsent.t.Errorf("OAuthRepositoryMock.FindConsent got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindConsent.FindConsentMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.clientID != nil && !minimock.Equal(*mm_want_ptrs.clientID, mm_got.clientID) {
+				mmFindConsent.t.Errorf("OAuthRepositoryMock.FindConsent got unexpected parameter clientID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindConsent.FindConsentMock.defaultExpectation.expectationOrigins.originClientID, *mm_want_ptrs.clientID, mm_got.clientID, minimock.Diff(*mm_want_ptrs.clientID, mm_got.clientID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmFindConsent.t.Errorf("OAuthRepositoryMock.FindConsent got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmFindConsent.FindConsentMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmFindConsent.FindConsentMock.defaultExpectation.results
+		if mm_results == nil {
+			mmFindConsent.t.Fatal("No results are set for the OAuthRepositoryMock.FindConsent")
+		}
+		return (*mm_results).cp1, (*mm_results).err
+	}
+	if mmFindConsent.funcFindConsent != nil {
+		return mmFindConsent.funcFindConsent(ctx, userID, clientID)
+	}
+	mmFindConsent.t.Fatalf("Unexpected call to OAuthRepositoryMock.FindConsent. %v %v %v", ctx, userID, clientID)
+	return
+}
+
+// FindConsentAfterCounter returns a count of finished OAuthRepositoryMock.FindConsent invocations
+func (mmFindConsent *OAuthRepositoryMock) FindConsentAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFindConsent.afterFindConsentCounter)
+}
+
+// FindConsentBeforeCounter returns a count of OAuthRepositoryMock.FindConsent invocations
+func (mmFindConsent *OAuthRepositoryMock) FindConsentBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFindConsent.beforeFindConsentCounter)
+}
+
+// Calls returns a list of arguments used in each call to OAuthRepositoryMock.FindConsent.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmFindConsent *mOAuthRepositoryMockFindConsent) Calls() []*OAuthRepositoryMockFindConsentParams {
+	mmFindConsent.mutex.RLock()
+
+	argCopy := make([]*OAuthRepositoryMockFindConsentParams, len(mmFindConsent.callArgs))
+	copy(argCopy, mmFindConsent.callArgs)
+
+	mmFindConsent.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockFindConsentDone returns true if the count of the FindConsent invocations corresponds
+// the number of defined expectations
+func (m *OAuthRepositoryMock) MinimockFindConsentDone() bool {
+	if m.FindConsentMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.FindConsentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.FindConsentMock.invocationsDone()
+}
+
+// MinimockFindConsentInspect logs each unmet expectation
+func (m *OAuthRepositoryMock) MinimockFindConsentInspect() {
+	for _, e := range m.FindConsentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OAuthRepositoryMock.FindConsent at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterFindConsentCounter := mm_atomic.LoadUint64(&m.afterFindConsentCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.FindConsentMock.defaultExpectation != nil && afterFindConsentCounter < 1 {
+		if m.FindConsentMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OAuthRepositoryMock.FindConsent at\n%s", m.FindConsentMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OAuthRepositoryMock.FindConsent at\n%s with params: %#v", m.FindConsentMock.defaultExpectation.expectationOrigins.origin, *m.FindConsentMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcFindConsent != nil && afterFindConsentCounter < 1 {
+		m.t.Errorf("Expected call to OAuthRepositoryMock.FindConsent at\n%s", m.funcFindConsentOrigin)
+	}
+
+	if !m.FindConsentMock.invocationsDone() && afterFindConsentCounter > 0 {
+		m.t.Errorf("Expected %d calls to OAuthRepositoryMock.FindConsent at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.FindConsentMock.expectedInvocations), m.FindConsentMock.expectedInvocationsOrigin, afterFindConsentCounter)
+	}
+}
+
+type mOAuthRepositoryMockPurgeAuthorizationCodes struct {
+	optional           bool
+	mock               *OAuthRepositoryMock
+	defaultExpectation *OAuthRepositoryMockPurgeAuthorizationCodesExpectation
+	expectations       []*OAuthRepositoryMockPurgeAuthorizationCodesExpectation
+
+	callArgs []*OAuthRepositoryMockPurgeAuthorizationCodesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OAuthRepositoryMockPurgeAuthorizationCodesExpectation specifies expectation struct of the OAuthRepository.PurgeAuthorizationCodes
+type OAuthRepositoryMockPurgeAuthorizationCodesExpectation struct {
+	mock               *OAuthRepositoryMock
+	params             *OAuthRepositoryMockPurgeAuthorizationCodesParams
+	paramPtrs          *OAuthRepositoryMockPurgeAuthorizationCodesParamPtrs
+	expectationOrigins OAuthRepositoryMockPurgeAuthorizationCodesExpectationOrigins
+	results            *OAuthRepositoryMockPurgeAuthorizationCodesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// OAuthRepositoryMockPurgeAuthorizationCodesParams contains parameters of the OAuthRepository.PurgeAuthorizationCodes
+type OAuthRepositoryMockPurgeAuthorizationCodesParams struct {
+	ctx           context.Context
+	expiredBefore time.Time
+}
+
+// OAuthRepositoryMockPurgeAuthorizationCodesParamPtrs contains pointers to parameters of the OAuthRepository.PurgeAuthorizationCodes
+type OAuthRepositoryMockPurgeAuthorizationCodesParamPtrs struct {
+	ctx           *context.Context
+	expiredBefore *time.Time
+}
+
+// OAuthRepositoryMockPurgeAuthorizationCodesResults contains results of the OAuthRepository.PurgeAuthorizationCodes
+type OAuthRepositoryMockPurgeAuthorizationCodesResults struct {
+	i1  int64
+	err error
+}
+
+// OAuthRepositoryMockPurgeAuthorizationCodesOrigins contains origins of expectations of the OAuthRepository.PurgeAuthorizationCodes
+type OAuthRepositoryMockPurgeAuthorizationCodesExpectationOrigins struct {
+	origin              string
+	originCtx           string
+	originExpiredBefore string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmPurgeAuthorizationCodes *mOAuthRepositoryMockPurgeAuthorizationCodes) Optional() *mOAuthRepositoryMockPurgeAuthorizationCodes {
+	mmPurgeAuthorizationCodes.optional = true
+	return mmPurgeAuthorizationCodes
+}
+
+// Expect sets up expected params for OAuthRepository.PurgeAuthorizationCodes
+func (mmPurgeAuthorizationCodes *mOAuthRepositoryMockPurgeAuthorizationCodes) Expect(ctx context.Context, expiredBefore time.Time) *mOAuthRepositoryMockPurgeAuthorizationCodes {
+	if mmPurgeAuthorizationCodes.mock.funcPurgeAuthorizationCodes != nil {
+		mmPurgeAuthorizationCodes.mock.t.Fatalf("OAuthRepositoryMock.PurgeAuthorizationCodes mock is already set by Set")
+	}
+
+	if mmPurgeAuthorizationCodes.defaultExpectation == nil {
+		mmPurgeAuthorizationCodes.defaultExpectation = &OAuthRepositoryMockPurgeAuthorizationCodesExpectation{}
+	}
+
+	if mmPurgeAuthorizationCodes.defaultExpectation.paramPtrs != nil {
+		mmPurgeAuthorizationCodes.mock.t.Fatalf("OAuthRepositoryMock.PurgeAuthorizationCodes mock is already set by ExpectParams functions")
+	}
+
+	mmPurgeAuthorizationCodes.defaultExpectation.params = &OAuthRepositoryMockPurgeAuthorizationCodesParams{ctx, expiredBefore}
+	mmPurgeAuthorizationCodes.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmPurgeAuthorizationCodes.expectations {
+		if minimock.Equal(e.params, mmPurgeAuthorizationCodes.defaultExpectation.params) {
+			mmPurgeAuthorizationCodes.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmPurgeAuthorizationCodes.defaultExpectation.params)
+		}
+	}
+
+	return mmPurgeAuthorizationCodes
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OAuthRepository.PurgeAuthorizationCodes
+func (mmPurgeAuthorizationCodes *mOAuthRepositoryMockPurgeAuthorizationCodes) ExpectCtxParam1(ctx context.Context) *mOAuthRepositoryMockPurgeAuthorizationCodes {
+	if mmPurgeAuthorizationCodes.mock.funcPurgeAuthorizationCodes != nil {
+		mmPurgeAuthorizationCodes.mock.t.Fatalf("OAuthRepositoryMock.PurgeAuthorizationCodes mock is already set by Set")
+	}
+
+	if mmPurgeAuthorizationCodes.defaultExpectation == nil {
+		mmPurgeAuthorizationCodes.defaultExpectation = &OAuthRepositoryMockPurgeAuthorizationCodesExpectation{}
+	}
+
+	if mmPurgeAuthorizationCodes.defaultExpectation.params != nil {
+		mmPurgeAuthorizationCodes.mock.t.Fatalf("OAuthRepositoryMock.PurgeAuthorizationCodes mock is already set by Expect")
+	}
+
+	if mmPurgeAuthorizationCodes.defaultExpectation.paramPtrs == nil {
+		mmPurgeAuthorizationCodes.defaultExpectation.paramPtrs = &OAuthRepositoryMockPurgeAuthorizationCodesParamPtrs{}
+	}
+	mmPurgeAuthorizationCodes.defaultExpectation.paramPtrs.ctx = &ctx
+	mmPurgeAuthorizationCodes.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmPurgeAuthorizationCodes
+}
+
+// ExpectExpiredBeforeParam2 sets up expected param expiredBefore for OAuthRepository.PurgeAuthorizationCodes
+func (mmPurgeAuthorizationCodes *mOAuthRepositoryMockPurgeAuthorizationCodes) ExpectExpiredBeforeParam2(expiredBefore time.Time) *mOAuthRepositoryMockPurgeAuthorizationCodes {
+	if mmPurgeAuthorizationCodes.mock.funcPurgeAuthorizationCodes != nil {
+		mmPurgeAuthorizationCodes.mock.t.Fatalf("OAuthRepositoryMock.PurgeAuthorizationCodes mock is already set by Set")
+	}
+
+	if mmPurgeAuthorizationCodes.defaultExpectation == nil {
+		mmPurgeAuthorizationCodes.defaultExpectation = &OAuthRepositoryMockPurgeAuthorizationCodesExpectation{}
+	}
+
+	if mmPurgeAuthorizationCodes.defaultExpectation.params != nil {
+		mmPurgeAuthorizationCodes.mock.t.Fatalf("OAuthRepositoryMock.PurgeAuthorizationCodes mock is already set by Expect")
+	}
+
+	if mmPurgeAuthorizationCodes.defaultExpectation.paramPtrs == nil {
+		mmPurgeAuthorizationCodes.defaultExpectation.paramPtrs = &OAuthRepositoryMockPurgeAuthorizationCodesParamPtrs{}
+	}
+	mmPurgeAuthorizationCodes.defaultExpectation.paramPtrs.expiredBefore = &expiredBefore
+	mmPurgeAuthorizationCodes.defaultExpectation.expectationOrigins.originExpiredBefore = minimock.CallerInfo(1)
+
+	return mmPurgeAuthorizationCodes
+}
+
+// Inspect accepts an inspector function that has same arguments as the OAuthRepository.PurgeAuthorizationCodes
+func (mmPurgeAuthorizationCodes *mOAuthRepositoryMockPurgeAuthorizationCodes) Inspect(f func(ctx context.Context, expiredBefore time.Time)) *mOAuthRepositoryMockPurgeAuthorizationCodes {
+	if mmPurgeAuthorizationCodes.mock.inspectFuncPurgeAuthorizationCodes != nil {
+		mmPurgeAuthorizationCodes.mock.t.Fatalf("Inspect function is already set for OAuthRepositoryMock.PurgeAuthorizationCodes")
+	}
+
+	mmPurgeAuthorizationCodes.mock.inspectFuncPurgeAuthorizationCodes = f
+
+	return mmPurgeAuthorizationCodes
+}
+
+// Return sets up results that will be returned by OAuthRepository.PurgeAuthorizationCodes
+func (mmPurgeAuthorizationCodes *mOAuthRepositoryMockPurgeAuthorizationCodes) Return(i1 int64, err error) *OAuthRepositoryMock {
+	if mmPurgeAuthorizationCodes.mock.funcPurgeAuthorizationCodes != nil {
+		mmPurgeAuthorizationCodes.mock.t.Fatalf("OAuthRepositoryMock.PurgeAuthorizationCodes mock is already set by Set")
+	}
+
+	if mmPurgeAuthorizationCodes.defaultExpectation == nil {
+		mmPurgeAuthorizationCodes.defaultExpectation = &OAuthRepositoryMockPurgeAuthorizationCodesExpectation{mock: mmPurgeAuthorizationCodes.mock}
+	}
+	mmPurgeAuthorizationCodes.defaultExpectation.results = &OAuthRepositoryMockPurgeAuthorizationCodesResults{i1, err}
+	mmPurgeAuthorizationCodes.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmPurgeAuthorizationCodes.mock
+}
+
+// Set uses given function f to mock the OAuthRepository.PurgeAuthorizationCodes method
+func (mmPurgeAuthorizationCodes *mOAuthRepositoryMockPurgeAuthorizationCodes) Set(f func(ctx context.Context, expiredBefore time.Time) (i1 int64, err error)) *OAuthRepositoryMock {
+	if mmPurgeAuthorizationCodes.defaultExpectation != nil {
+		mmPurgeAuthorizationCodes.mock.t.Fatalf("Default expectation is already set for the OAuthRepository.PurgeAuthorizationCodes method")
+	}
+
+	if len(mmPurgeAuthorizationCodes.expectations) > 0 {
+		mmPurgeAuthorizationCodes.mock.t.Fatalf("Some expectations are already set for the OAuthRepository.PurgeAuthorizationCodes method")
+	}
+
+	mmPurgeAuthorizationCodes.mock.funcPurgeAuthorizationCodes = f
+	mmPurgeAuthorizationCodes.mock.funcPurgeAuthorizationCodesOrigin = minimock.CallerInfo(1)
+	return mmPurgeAuthorizationCodes.mock
+}
+
+// When sets expectation for the OAuthRepository.PurgeAuthorizationCodes which will trigger the result defined by the following
+// Then helper
+func (mmPurgeAuthorizationCodes *mOAuthRepositoryMockPurgeAuthorizationCodes) When(ctx context.Context, expiredBefore time.Time) *OAuthRepositoryMockPurgeAuthorizationCodesExpectation {
+	if mmPurgeAuthorizationCodes.mock.funcPurgeAuthorizationCodes != nil {
+		mmPurgeAuthorizationCodes.mock.t.Fatalf("OAuthRepositoryMock.PurgeAuthorizationCodes mock is already set by Set")
+	}
+
+	expectation := &OAuthRepositoryMockPurgeAuthorizationCodesExpectation{
+		mock:               mmPurgeAuthorizationCodes.mock,
+		params:             &OAuthRepositoryMockPurgeAuthorizationCodesParams{ctx, expiredBefore},
+		expectationOrigins: OAuthRepositoryMockPurgeAuthorizationCodesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmPurgeAuthorizationCodes.expectations = append(mmPurgeAuthorizationCodes.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OAuthRepository.PurgeAuthorizationCodes return parameters for the expectation previously defined by the When method
+func (e *OAuthRepositoryMockPurgeAuthorizationCodesExpectation) Then(i1 int64, err error) *OAuthRepositoryMock {
+	e.results = &OAuthRepositoryMockPurgeAuthorizationCodesResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times OAuthRepository.PurgeAuthorizationCodes should be invoked
+func (mmPurgeAuthorizationCodes *mOAuthRepositoryMockPurgeAuthorizationCodes) Times(n uint64) *mOAuthRepositoryMockPurgeAuthorizationCodes {
+	if n == 0 {
+		mmPurgeAuthorizationCodes.mock.t.Fatalf("Times of OAuthRepositoryMock.PurgeAuthorizationCodes mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmPurgeAuthorizationCodes.expectedInvocations, n)
+	mmPurgeAuthorizationCodes.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmPurgeAuthorizationCodes
+}
+
+func (mmPurgeAuthorizationCodes *mOAuthRepositoryMockPurgeAuthorizationCodes) invocationsDone() bool {
+	if len(mmPurgeAuthorizationCodes.expectations) == 0 && mmPurgeAuthorizationCodes.defaultExpectation == nil && mmPurgeAuthorizationCodes.mock.funcPurgeAuthorizationCodes == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmPurgeAuthorizationCodes.mock.afterPurgeAuthorizationCodesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmPurgeAuthorizationCodes.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// PurgeAuthorizationCodes implements OAuthRepository
+func (mmPurgeAuthorizationCodes *OAuthRepositoryMock) PurgeAuthorizationCodes(ctx context.Context, expiredBefore time.Time) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmPurgeAuthorizationCodes.beforePurgeAuthorizationCodesCounter, 1)
+	defer mm_atomic.AddUint64(&mmPurgeAuthorizationCodes.afterPurgeAuthorizationCodesCounter, 1)
+
+	mmPurgeAuthorizationCodes.t.Helper()
+
+	if mmPurgeAuthorizationCodes.inspectFuncPurgeAuthorizationCodes != nil {
+		mmPurgeAuthorizationCodes.inspectFuncPurgeAuthorizationCodes(ctx, expiredBefore)
+	}
+
+	mm_params := OAuthRepositoryMockPurgeAuthorizationCodesParams{ctx, expiredBefore}
+
+	// Record call args
+	mmPurgeAuthorizationCodes.PurgeAuthorizationCodesMock.mutex.Lock()
+	mmPurgeAuthorizationCodes.PurgeAuthorizationCodesMock.callArgs = append(mmPurgeAuthorizationCodes.PurgeAuthorizationCodesMock.callArgs, &mm_params)
+	mmPurgeAuthorizationCodes.PurgeAuthorizationCodesMock.mutex.Unlock()
+
+	for _, e := range mmPurgeAuthorizationCodes.PurgeAuthorizationCodesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmPurgeAuthorizationCodes.PurgeAuthorizationCodesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmPurgeAuthorizationCodes.PurgeAuthorizationCodesMock.defaultExpectation.Counter, 1)
+		mm_want := mmPurgeAuthorizationCodes.PurgeAuthorizationCodesMock.defaultExpectation.params
+		mm_want_ptrs := mmPurgeAuthorizationCodes.PurgeAuthorizationCodesMock.defaultExpectation.paramPtrs
+
+		mm_got := OAuthRepositoryMockPurgeAuthorizationCodesParams{ctx, expiredBefore}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmPurgeAuthorizationCodes.t.Errorf("OAuthRepositoryMock.PurgeAuthorizationCodes got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPurgeAuthorizationCodes.PurgeAuthorizationCodesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.expiredBefore != nil && !minimock.Equal(*mm_want_ptrs.expiredBefore, mm_got.expiredBefore) {
+				mmPurgeAuthorizationCodes.t.Errorf("OAuthRepositoryMock.PurgeAuthorizationCodes got unexpected parameter expiredBefore, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPurgeAuthorizationCodes.PurgeAuthorizationCodesMock.defaultExpectation.expectationOrigins.originExpiredBefore, *mm_want_ptrs.expiredBefore, mm_got.expiredBefore, minimock.Diff(*mm_want_ptrs.expiredBefore, mm_got.expiredBefore))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmPurgeAuthorizationCodes.t.Errorf("OAuthRepositoryMock.PurgeAuthorizationCodes got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmPurgeAuthorizationCodes.PurgeAuthorizationCodesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmPurgeAuthorizationCodes.PurgeAuthorizationCodesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmPurgeAuthorizationCodes.t.Fatal("No results are set for the OAuthRepositoryMock.PurgeAuthorizationCodes")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmPurgeAuthorizationCodes.funcPurgeAuthorizationCodes != nil {
+		return mmPurgeAuthorizationCodes.funcPurgeAuthorizationCodes(ctx, expiredBefore)
+	}
+	mmPurgeAuthorizationCodes.t.Fatalf("Unexpected call to OAuthRepositoryMock.PurgeAuthorizationCodes. %v %v", ctx, expiredBefore)
+	return
+}
+
+// PurgeAuthorizationCodesAfterCounter returns a count of finished OAuthRepositoryMock.PurgeAuthorizationCodes invocations
+func (mmPurgeAuthorizationCodes *OAuthRepositoryMock) PurgeAuthorizationCodesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPurgeAuthorizationCodes.afterPurgeAuthorizationCodesCounter)
+}
+
+// PurgeAuthorizationCodesBeforeCounter returns a count of OAuthRepositoryMock.PurgeAuthorizationCodes invocations
+func (mmPurgeAuthorizationCodes *OAuthRepositoryMock) PurgeAuthorizationCodesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPurgeAuthorizationCodes.beforePurgeAuthorizationCodesCounter)
+}
+
+// Calls returns a list of arguments used in each call to OAuthRepositoryMock.PurgeAuthorizationCodes.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmPurgeAuthorizationCodes *mOAuthRepositoryMockPurgeAuthorizationCodes) Calls() []*OAuthRepositoryMockPurgeAuthorizationCodesParams {
+	mmPurgeAuthorizationCodes.mutex.RLock()
+
+	argCopy := make([]*OAuthRepositoryMockPurgeAuthorizationCodesParams, len(mmPurgeAuthorizationCodes.callArgs))
+	copy(argCopy, mmPurgeAuthorizationCodes.callArgs)
+
+	mmPurgeAuthorizationCodes.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockPurgeAuthorizationCodesDone returns true if the count of the PurgeAuthorizationCodes invocations corresponds
+// the number of defined expectations
+func (m *OAuthRepositoryMock) MinimockPurgeAuthorizationCodesDone() bool {
+	if m.PurgeAuthorizationCodesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.PurgeAuthorizationCodesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.PurgeAuthorizationCodesMock.invocationsDone()
+}
+
+// MinimockPurgeAuthorizationCodesInspect logs each unmet expectation
+func (m *OAuthRepositoryMock) MinimockPurgeAuthorizationCodesInspect() {
+	for _, e := range m.PurgeAuthorizationCodesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OAuthRepositoryMock.PurgeAuthorizationCodes at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterPurgeAuthorizationCodesCounter := mm_atomic.LoadUint64(&m.afterPurgeAuthorizationCodesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.PurgeAuthorizationCodesMock.defaultExpectation != nil && afterPurgeAuthorizationCodesCounter < 1 {
+		if m.PurgeAuthorizationCodesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OAuthRepositoryMock.PurgeAuthorizationCodes at\n%s", m.PurgeAuthorizationCodesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OAuthRepositoryMock.PurgeAuthorizationCodes at\n%s with params: %#v", m.PurgeAuthorizationCodesMock.defaultExpectation.expectationOrigins.origin, *m.PurgeAuthorizationCodesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcPurgeAuthorizationCodes != nil && afterPurgeAuthorizationCodesCounter < 1 {
+		m.t.Errorf("Expected call to OAuthRepositoryMock.PurgeAuthorizationCodes at\n%s", m.funcPurgeAuthorizationCodesOrigin)
+	}
+
+	if !m.PurgeAuthorizationCodesMock.invocationsDone() && afterPurgeAuthorizationCodesCounter > 0 {
+		m.t.Errorf("Expected %d calls to OAuthRepositoryMock.PurgeAuthorizationCodes at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.PurgeAuthorizationCodesMock.expectedInvocations), m.PurgeAuthorizationCodesMock.expectedInvocationsOrigin, afterPurgeAuthorizationCodesCounter)
+	}
+}
+
+type mOAuthRepositoryMockPurgeRevokedTokens struct {
+	optional           bool
+	mock               *OAuthRepositoryMock
+	defaultExpectation *OAuthRepositoryMockPurgeRevokedTokensExpectation
+	expectations       []*OAuthRepositoryMockPurgeRevokedTokensExpectation
+
+	callArgs []*OAuthRepositoryMockPurgeRevokedTokensParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OAuthRepositoryMockPurgeRevokedTokensExpectation specifies expectation struct of the OAuthRepository.PurgeRevokedTokens
+type OAuthRepositoryMockPurgeRevokedTokensExpectation struct {
+	mock               *OAuthRepositoryMock
+	params             *OAuthRepositoryMockPurgeRevokedTokensParams
+	paramPtrs          *OAuthRepositoryMockPurgeRevokedTokensParamPtrs
+	expectationOrigins OAuthRepositoryMockPurgeRevokedTokensExpectationOrigins
+	results            *OAuthRepositoryMockPurgeRevokedTokensResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// OAuthRepositoryMockPurgeRevokedTokensParams contains parameters of the OAuthRepository.PurgeRevokedTokens
+type OAuthRepositoryMockPurgeRevokedTokensParams struct {
+	ctx           context.Context
+	expiredBefore time.Time
+}
+
+// OAuthRepositoryMockPurgeRevokedTokensParamPtrs contains pointers to parameters of the OAuthRepository.PurgeRevokedTokens
+type OAuthRepositoryMockPurgeRevokedTokensParamPtrs struct {
+	ctx           *context.Context
+	expiredBefore *time.Time
+}
+
+// OAuthRepositoryMockPurgeRevokedTokensResults contains results of the OAuthRepository.PurgeRevokedTokens
+type OAuthRepositoryMockPurgeRevokedTokensResults struct {
+	i1  int64
+	err error
+}
+
+// OAuthRepositoryMockPurgeRevokedTokensOrigins contains origins of expectations of the OAuthRepository.PurgeRevokedTokens
+type OAuthRepositoryMockPurgeRevokedTokensExpectationOrigins struct {
+	origin              string
+	originCtx           string
+	originExpiredBefore string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmPurgeRevokedTokens *mOAuthRepositoryMockPurgeRevokedTokens) Optional() *mOAuthRepositoryMockPurgeRevokedTokens {
+	mmPurgeRevokedTokens.optional = true
+	return mmPurgeRevokedTokens
+}
+
+// Expect sets up expected params for OAuthRepository.PurgeRevokedTokens
+func (mmPurgeRevokedTokens *mOAuthRepositoryMockPurgeRevokedTokens) Expect(ctx context.Context, expiredBefore time.Time) *mOAuthRepositoryMockPurgeRevokedTokens {
+	if mmPurgeRevokedTokens.mock.funcPurgeRevokedTokens != nil {
+		mmPurgeRevokedTokens.mock.t.Fatalf("OAuthRepositoryMock.PurgeRevokedTokens mock is already set by Set")
+	}
+
+	if mmPurgeRevokedTokens.defaultExpectation == nil {
+		mmPurgeRevokedTokens.defaultExpectation = &OAuthRepositoryMockPurgeRevokedTokensExpectation{}
+	}
+
+	if mmPurgeRevokedTokens.defaultExpectation.paramPtrs != nil {
+		mmPurgeRevokedTokens.mock.t.Fatalf("OAuthRepositoryMock.PurgeRevokedTokens mock is already set by ExpectParams functions")
+	}
+
+	mmPurgeRevokedTokens.defaultExpectation.params = &OAuthRepositoryMockPurgeRevokedTokensParams{ctx, expiredBefore}
+	mmPurgeRevokedTokens.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmPurgeRevokedTokens.expectations {
+		if minimock.Equal(e.params, mmPurgeRevokedTokens.defaultExpectation.params) {
+			mmPurgeRevokedTokens.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmPurgeRevokedTokens.defaultExpectation.params)
+		}
+	}
+
+	return mmPurgeRevokedTokens
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OAuthRepository.PurgeRevokedTokens
+func (mmPurgeRevokedTokens *mOAuthRepositoryMockPurgeRevokedTokens) ExpectCtxParam1(ctx context.Context) *mOAuthRepositoryMockPurgeRevokedTokens {
+	if mmPurgeRevokedTokens.mock.funcPurgeRevokedTokens != nil {
+		mmPurgeRevokedTokens.mock.t.Fatalf("OAuthRepositoryMock.PurgeRevokedTokens mock is already set by Set")
+	}
+
+	if mmPurgeRevokedTokens.defaultExpectation == nil {
+		mmPurgeRevokedTokens.defaultExpectation = &OAuthRepositoryMockPurgeRevokedTokensExpectation{}
+	}
+
+	if mmPurgeRevokedTokens.defaultExpectation.params != nil {
+		mmPurgeRevokedTokens.mock.t.Fatalf("OAuthRepositoryMock.PurgeRevokedTokens mock is already set by Expect")
+	}
+
+	if mmPurgeRevokedTokens.defaultExpectation.paramPtrs == nil {
+		mmPurgeRevokedTokens.defaultExpectation.paramPtrs = &OAuthRepositoryMockPurgeRevokedTokensParamPtrs{}
+	}
+	mmPurgeRevokedTokens.defaultExpectation.paramPtrs.ctx = &ctx
+	mmPurgeRevokedTokens.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmPurgeRevokedTokens
+}
+
+// ExpectExpiredBeforeParam2 sets up expected param expiredBefore for OAuthRepository.PurgeRevokedTokens
+func (mmPurgeRevokedTokens *mOAuthRepositoryMockPurgeRevokedTokens) ExpectExpiredBeforeParam2(expiredBefore time.Time) *mOAuthRepositoryMockPurgeRevokedTokens {
+	if mmPurgeRevokedTokens.mock.funcPurgeRevokedTokens != nil {
+		mmPurgeRevokedTokens.mock.t.Fatalf("OAuthRepositoryMock.PurgeRevokedTokens mock is already set by Set")
+	}
+
+	if mmPurgeRevokedTokens.defaultExpectation == nil {
+		mmPurgeRevokedTokens.defaultExpectation = &OAuthRepositoryMockPurgeRevokedTokensExpectation{}
+	}
+
+	if mmPurgeRevokedTokens.defaultExpectation.params != nil {
+		mmPurgeRevokedTokens.mock.t.Fatalf("OAuthRepositoryMock.PurgeRevokedTokens mock is already set by Expect")
+	}
+
+	if mmPurgeRevokedTokens.defaultExpectation.paramPtrs == nil {
+		mmPurgeRevokedTokens.defaultExpectation.paramPtrs = &OAuthRepositoryMockPurgeRevokedTokensParamPtrs{}
+	}
+	mmPurgeRevokedTokens.defaultExpectation.paramPtrs.expiredBefore = &expiredBefore
+	mmPurgeRevokedTokens.defaultExpectation.expectationOrigins.originExpiredBefore = minimock.CallerInfo(1)
+
+	return mmPurgeRevokedTokens
+}
+
+// Inspect accepts an inspector function that has same arguments as the OAuthRepository.PurgeRevokedTokens
+func (mmPurgeRevokedTokens *mOAuthRepositoryMockPurgeRevokedTokens) Inspect(f func(ctx context.Context, expiredBefore time.Time)) *mOAuthRepositoryMockPurgeRevokedTokens {
+	if mmPurgeRevokedTokens.mock.inspectFuncPurgeRevokedTokens != nil {
+		mmPurgeRevokedTokens.mock.t.Fatalf("Inspect function is already set for OAuthRepositoryMock.PurgeRevokedTokens")
+	}
+
+	mmPurgeRevokedTokens.mock.inspectFuncPurgeRevokedTokens = f
+
+	return mmPurgeRevokedTokens
+}
+
+// Return sets up results that will be returned by OAuthRepository.PurgeRevokedTokens
+func (mmPurgeRevokedTokens *mOAuthRepositoryMockPurgeRevokedTokens) Return(i1 int64, err error) *OAuthRepositoryMock {
+	if mmPurgeRevokedTokens.mock.funcPurgeRevokedTokens != nil {
+		mmPurgeRevokedTokens.mock.t.Fatalf("OAuthRepositoryMock.PurgeRevokedTokens mock is already set by Set")
+	}
+
+	if mmPurgeRevokedTokens.defaultExpectation == nil {
+		mmPurgeRevokedTokens.defaultExpectation = &OAuthRepositoryMockPurgeRevokedTokensExpectation{mock: mmPurgeRevokedTokens.mock}
+	}
+	mmPurgeRevokedTokens.defaultExpectation.results = &OAuthRepositoryMockPurgeRevokedTokensResults{i1, err}
+	mmPurgeRevokedTokens.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmPurgeRevokedTokens.mock
+}
+
+// Set uses given function f to mock the OAuthRepository.PurgeRevokedTokens method
+func (mmPurgeRevokedTokens *mOAuthRepositoryMockPurgeRevokedTokens) Set(f func(ctx context.Context, expiredBefore time.Time) (i1 int64, err error)) *OAuthRepositoryMock {
+	if mmPurgeRevokedTokens.defaultExpectation != nil {
+		mmPurgeRevokedTokens.mock.t.Fatalf("Default expectation is already set for the OAuthRepository.PurgeRevokedTokens method")
+	}
+
+	if len(mmPurgeRevokedTokens.expectations) > 0 {
+		mmPurgeRevokedTokens.mock.t.Fatalf("Some expectations are already set for the OAuthRepository.PurgeRevokedTokens method")
+	}
+
+	mmPurgeRevokedTokens.mock.funcPurgeRevokedTokens = f
+	mmPurgeRevokedTokens.mock.funcPurgeRevokedTokensOrigin = minimock.CallerInfo(1)
+	return mmPurgeRevokedTokens.mock
+}
+
+// When sets expectation for the OAuthRepository.PurgeRevokedTokens which will trigger the result defined by the following
+// Then helper
+func (mmPurgeRevokedTokens *mOAuthRepositoryMockPurgeRevokedTokens) When(ctx context.Context, expiredBefore time.Time) *OAuthRepositoryMockPurgeRevokedTokensExpectation {
+	if mmPurgeRevokedTokens.mock.funcPurgeRevokedTokens != nil {
+		mmPurgeRevokedTokens.mock.t.Fatalf("OAuthRepositoryMock.PurgeRevokedTokens mock is already set by Set")
+	}
+
+	expectation := &OAuthRepositoryMockPurgeRevokedTokensExpectation{
+		mock:               mmPurgeRevokedTokens.mock,
+		params:             &OAuthRepositoryMockPurgeRevokedTokensParams{ctx, expiredBefore},
+		expectationOrigins: OAuthRepositoryMockPurgeRevokedTokensExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmPurgeRevokedTokens.expectations = append(mmPurgeRevokedTokens.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OAuthRepository.PurgeRevokedTokens return parameters for the expectation previously defined by the When method
+func (e *OAuthRepositoryMockPurgeRevokedTokensExpectation) Then(i1 int64, err error) *OAuthRepositoryMock {
+	e.results = &OAuthRepositoryMockPurgeRevokedTokensResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times OAuthRepository.PurgeRevokedTokens should be invoked
+func (mmPurgeRevokedTokens *mOAuthRepositoryMockPurgeRevokedTokens) Times(n uint64) *mOAuthRepositoryMockPurgeRevokedTokens {
+	if n == 0 {
+		mmPurgeRevokedTokens.mock.t.Fatalf("Times of OAuthRepositoryMock.PurgeRevokedTokens mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmPurgeRevokedTokens.expectedInvocations, n)
+	mmPurgeRevokedTokens.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmPurgeRevokedTokens
+}
+
+func (mmPurgeRevokedTokens *mOAuthRepositoryMockPurgeRevokedTokens) invocationsDone() bool {
+	if len(mmPurgeRevokedTokens.expectations) == 0 && mmPurgeRevokedTokens.defaultExpectation == nil && mmPurgeRevokedTokens.mock.funcPurgeRevokedTokens == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmPurgeRevokedTokens.mock.afterPurgeRevokedTokensCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmPurgeRevokedTokens.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// PurgeRevokedTokens implements OAuthRepository
+func (mmPurgeRevokedTokens *OAuthRepositoryMock) PurgeRevokedTokens(ctx context.Context, expiredBefore time.Time) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmPurgeRevokedTokens.beforePurgeRevokedTokensCounter, 1)
+	defer mm_atomic.AddUint64(&mmPurgeRevokedTokens.afterPurgeRevokedTokensCounter, 1)
+
+	mmPurgeRevokedTokens.t.Helper()
+
+	if mmPurgeRevokedTokens.inspectFuncPurgeRevokedTokens != nil {
+		mmPurgeRevokedTokens.inspectFuncPurgeRevokedTokens(ctx, expiredBefore)
+	}
+
+	mm_params := OAuthRepositoryMockPurgeRevokedTokensParams{ctx, expiredBefore}
+
+	// Record call args
+	mmPurgeRevokedTokens.PurgeRevokedTokensMock.mutex.Lock()
+	mmPurgeRevokedTokens.PurgeRevokedTokensMock.callArgs = append(mmPurgeRevokedTokens.PurgeRevokedTokensMock.callArgs, &mm_params)
+	mmPurgeRevokedTokens.PurgeRevokedTokensMock.mutex.Unlock()
+
+	for _, e := range mmPurgeRevokedTokens.PurgeRevokedTokensMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmPurgeRevokedTokens.PurgeRevokedTokensMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmPurgeRevokedTokens.PurgeRevokedTokensMock.defaultExpectation.Counter, 1)
+		mm_want := mmPurgeRevokedTokens.PurgeRevokedTokensMock.defaultExpectation.params
+		mm_want_ptrs := mmPurgeRevokedTokens.PurgeRevokedTokensMock.defaultExpectation.paramPtrs
+
+		mm_got := OAuthRepositoryMockPurgeRevokedTokensParams{ctx, expiredBefore}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmPurgeRevokedTokens.t.Errorf("OAuthRepositoryMock.PurgeRevokedTokens got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPurgeRevokedTokens.PurgeRevokedTokensMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.expiredBefore != nil && !minimock.Equal(*mm_want_ptrs.expiredBefore, mm_got.expiredBefore) {
+				mmPurgeRevokedTokens.t.Errorf("OAuthRepositoryMock.PurgeRevokedTokens got unexpected parameter expiredBefore, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPurgeRevokedTokens.PurgeRevokedTokensMock.defaultExpectation.expectationOrigins.originExpiredBefore, *mm_want_ptrs.expiredBefore, mm_got.expiredBefore, minimock.Diff(*mm_want_ptrs.expiredBefore, mm_got.expiredBefore))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmPurgeRevokedTokens.t.Errorf("OAuthRepositoryMock.PurgeRevokedTokens got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmPurgeRevokedTokens.PurgeRevokedTokensMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmPurgeRevokedTokens.PurgeRevokedTokensMock.defaultExpectation.results
+		if mm_results == nil {
+			mmPurgeRevokedTokens.t.Fatal("No results are set for the OAuthRepositoryMock.PurgeRevokedTokens")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmPurgeRevokedTokens.funcPurgeRevokedTokens != nil {
+		return mmPurgeRevokedTokens.funcPurgeRevokedTokens(ctx, expiredBefore)
+	}
+	mmPurgeRevokedTokens.t.Fatalf("Unexpected call to OAuthRepositoryMock.PurgeRevokedTokens. %v %v", ctx, expiredBefore)
+	return
+}
+
+// PurgeRevokedTokensAfterCounter returns a count of finished OAuthRepositoryMock.PurgeRevokedTokens invocations
+func (mmPurgeRevokedTokens *OAuthRepositoryMock) PurgeRevokedTokensAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPurgeRevokedTokens.afterPurgeRevokedTokensCounter)
+}
+
+// PurgeRevokedTokensBeforeCounter returns a count of OAuthRepositoryMock.PurgeRevokedTokens invocations
+func (mmPurgeRevokedTokens *OAuthRepositoryMock) PurgeRevokedTokensBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPurgeRevokedTokens.beforePurgeRevokedTokensCounter)
+}
+
+// Calls returns a list of arguments used in each call to OAuthRepositoryMock.PurgeRevokedTokens.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmPurgeRevokedTokens *mOAuthRepositoryMockPurgeRevokedTokens) Calls() []*OAuthRepositoryMockPurgeRevokedTokensParams {
+	mmPurgeRevokedTokens.mutex.RLock()
+
+	argCopy := make([]*OAuthRepositoryMockPurgeRevokedTokensParams, len(mmPurgeRevokedTokens.callArgs))
+	copy(argCopy, mmPurgeRevokedTokens.callArgs)
+
+	mmPurgeRevokedTokens.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockPurgeRevokedTokensDone returns true if the count of the PurgeRevokedTokens invocations corresponds
+// the number of defined expectations
+func (m *OAuthRepositoryMock) MinimockPurgeRevokedTokensDone() bool {
+	if m.PurgeRevokedTokensMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.PurgeRevokedTokensMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.PurgeRevokedTokensMock.invocationsDone()
+}
+
+// MinimockPurgeRevokedTokensInspect logs each unmet expectation
+func (m *OAuthRepositoryMock) MinimockPurgeRevokedTokensInspect() {
+	for _, e := range m.PurgeRevokedTokensMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OAuthRepositoryMock.PurgeRevokedTokens at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterPurgeRevokedTokensCounter := mm_atomic.LoadUint64(&m.afterPurgeRevokedTokensCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.PurgeRevokedTokensMock.defaultExpectation != nil && afterPurgeRevokedTokensCounter < 1 {
+		if m.PurgeRevokedTokensMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OAuthRepositoryMock.PurgeRevokedTokens at\n%s", m.PurgeRevokedTokensMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OAuthRepositoryMock.PurgeRevokedTokens at\n%s with params: %#v", m.PurgeRevokedTokensMock.defaultExpectation.expectationOrigins.origin, *m.PurgeRevokedTokensMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcPurgeRevokedTokens != nil && afterPurgeRevokedTokensCounter < 1 {
+		m.t.Errorf("Expected call to OAuthRepositoryMock.PurgeRevokedTokens at\n%s", m.funcPurgeRevokedTokensOrigin)
+	}
+
+	if !m.PurgeRevokedTokensMock.invocationsDone() && afterPurgeRevokedTokensCounter > 0 {
+		m.t.Errorf("Expected %d calls to OAuthRepositoryMock.PurgeRevokedTokens at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.PurgeRevokedTokensMock.expectedInvocations), m.PurgeRevokedTokensMock.expectedInvocationsOrigin, afterPurgeRevokedTokensCounter)
+	}
+}
+
+type mOAuthRepositoryMockPurgeSessions struct {
+	optional           bool
+	mock               *OAuthRepositoryMock
+	defaultExpectation *OAuthRepositoryMockPurgeSessionsExpectation
+	expectations       []*OAuthRepositoryMockPurgeSessionsExpectation
+
+	callArgs []*OAuthRepositoryMockPurgeSessionsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OAuthRepositoryMockPurgeSessionsExpectation specifies expectation struct of the OAuthRepository.PurgeSessions
+type OAuthRepositoryMockPurgeSessionsExpectation struct {
+	mock               *OAuthRepositoryMock
+	params             *OAuthRepositoryMockPurgeSessionsParams
+	paramPtrs          *OAuthRepositoryMockPurgeSessionsParamPtrs
+	expectationOrigins OAuthRepositoryMockPurgeSessionsExpectationOrigins
+	results            *OAuthRepositoryMockPurgeSessionsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// OAuthRepositoryMockPurgeSessionsParams contains parameters of the OAuthRepository.PurgeSessions
+type OAuthRepositoryMockPurgeSessionsParams struct {
+	ctx           context.Context
+	expiredBefore time.Time
+}
+
+// OAuthRepositoryMockPurgeSessionsParamPtrs contains pointers to parameters of the OAuthRepository.PurgeSessions
+type OAuthRepositoryMockPurgeSessionsParamPtrs struct {
+	ctx           *context.Context
+	expiredBefore *time.Time
+}
+
+// OAuthRepositoryMockPurgeSessionsResults contains results of the OAuthRepository.PurgeSessions
+type OAuthRepositoryMockPurgeSessionsResults struct {
+	i1  int64
+	err error
+}
+
+// OAuthRepositoryMockPurgeSessionsOrigins contains origins of expectations of the OAuthRepository.PurgeSessions
+type OAuthRepositoryMockPurgeSessionsExpectationOrigins struct {
+	origin              string
+	originCtx           string
+	originExpiredBefore string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmPurgeSessions *mOAuthRepositoryMockPurgeSessions) Optional() *mOAuthRepositoryMockPurgeSessions {
+	mmPurgeSessions.optional = true
+	return mmPurgeSessions
+}
+
+// Expect sets up expected params for OAuthRepository.PurgeSessions
+func (mmPurgeSessions *mOAuthRepositoryMockPurgeSessions) Expect(ctx context.Context, expiredBefore time.Time) *mOAuthRepositoryMockPurgeSessions {
+	if mmPurgeSessions.mock.funcPurgeSessions != nil {
+		mmPurgeSessions.mock.t.Fatalf("OAuthRepositoryMock.PurgeSessions mock is already set by Set")
+	}
+
+	if mmPurgeSessions.defaultExpectation == nil {
+		mmPurgeSessions.defaultExpectation = &OAuthRepositoryMockPurgeSessionsExpectation{}
+	}
+
+	if mmPurgeSessions.defaultExpectation.paramPtrs != nil {
+		mmPurgeSessions.mock.t.Fatalf("OAuthRepositoryMock.PurgeSessions mock is already set by ExpectParams functions")
+	}
+
+	mmPurgeSessions.defaultExpectation.params = &OAuthRepositoryMockPurgeSessionsParams{ctx, expiredBefore}
+	mmPurgeSessions.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmPurgeSessions.expectations {
+		if minimock.Equal(e.params, mmPurgeSessions.defaultExpectation.params) {
+			mmPurgeSessions.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmPurgeSessions.defaultExpectation.params)
+		}
+	}
+
+	return mmPurgeSessions
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OAuthRepository.PurgeSessions
+func (mmPurgeSessions *mOAuthRepositoryMockPurgeSessions) ExpectCtxParam1(ctx context.Context) *mOAuthRepositoryMockPurgeSessions {
+	if mmPurgeSessions.mock.funcPurgeSessions != nil {
+		mmPurgeSessions.mock.t.Fatalf("OAuthRepositoryMock.PurgeSessions mock is already set by Set")
+	}
+
+	if mmPurgeSessions.defaultExpectation == nil {
+		mmPurgeSessions.defaultExpectation = &OAuthRepositoryMockPurgeSessionsExpectation{}
+	}
+
+	if mmPurgeSessions.defaultExpectation.params != nil {
+		mmPurgeSessions.mock.t.Fatalf("OAuthRepositoryMock.PurgeSessions mock is already set by Expect")
+	}
+
+	if mmPurgeSessions.defaultExpectation.paramPtrs == nil {
+		mmPurgeSessions.defaultExpectation.paramPtrs = &OAuthRepositoryMockPurgeSessionsParamPtrs{}
+	}
+	mmPurgeSessions.defaultExpectation.paramPtrs.ctx = &ctx
+	mmPurgeSessions.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmPurgeSessions
+}
+
+// ExpectExpiredBeforeParam2 sets up expected param expiredBefore for OAuthRepository.PurgeSessions
+func (mmPurgeSessions *mOAuthRepositoryMockPurgeSessions) ExpectExpiredBeforeParam2(expiredBefore time.Time) *mOAuthRepositoryMockPurgeSessions {
+	if mmPurgeSessions.mock.funcPurgeSessions != nil {
+		mmPurgeSessions.mock.t.Fatalf("OAuthRepositoryMock.PurgeSessions mock is already set by Set")
+	}
+
+	if mmPurgeSessions.defaultExpectation == nil {
+		mmPurgeSessions.defaultExpectation = &OAuthRepositoryMockPurgeSessionsExpectation{}
+	}
+
+	if mmPurgeSessions.defaultExpectation.params != nil {
+		mmPurgeSessions.mock.t.Fatalf("OAuthRepositoryMock.PurgeSessions mock is already set by Expect")
+	}
+
+	if mmPurgeSessions.defaultExpectation.paramPtrs == nil {
+		mmPurgeSessions.defaultExpectation.paramPtrs = &OAuthRepositoryMockPurgeSessionsParamPtrs{}
+	}
+	mmPurgeSessions.defaultExpectation.paramPtrs.expiredBefore = &expiredBefore
+	mmPurgeSessions.defaultExpectation.expectationOrigins.originExpiredBefore = minimock.CallerInfo(1)
+
+	return mmPurgeSessions
+}
+
+// Inspect accepts an inspector function that has same arguments as the OAuthRepository.PurgeSessions
+func (mmPurgeSessions *mOAuthRepositoryMockPurgeSessions) Inspect(f func(ctx context.Context, expiredBefore time.Time)) *mOAuthRepositoryMockPurgeSessions {
+	if mmPurgeSessions.mock.inspectFuncPurgeSessions != nil {
+		mmPurgeSessions.mock.t.Fatalf("Inspect function is already set for OAuthRepositoryMock.PurgeSessions")
+	}
+
+	mmPurgeSessions.mock.inspectFuncPurgeSessions = f
+
+	return mmPurgeSessions
+}
+
+// Return sets up results that will be returned by OAuthRepository.PurgeSessions
+func (mmPurgeSessions *mOAuthRepositoryMockPurgeSessions) Return(i1 int64, err error) *OAuthRepositoryMock {
+	if mmPurgeSessions.mock.funcPurgeSessions != nil {
+		mmPurgeSessions.mock.t.Fatalf("OAuthRepositoryMock.PurgeSessions mock is already set by Set")
+	}
+
+	if mmPurgeSessions.defaultExpectation == nil {
+		mmPurgeSessions.defaultExpectation = &OAuthRepositoryMockPurgeSessionsExpectation{mock: mmPurgeSessions.mock}
+	}
+	mmPurgeSessions.defaultExpectation.results = &OAuthRepositoryMockPurgeSessionsResults{i1, err}
+	mmPurgeSessions.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmPurgeSessions.mock
+}
+
+// Set uses given function f to mock the OAuthRepository.PurgeSessions method
+func (mmPurgeSessions *mOAuthRepositoryMockPurgeSessions) Set(f func(ctx context.Context, expiredBefore time.Time) (i1 int64, err error)) *OAuthRepositoryMock {
+	if mmPurgeSessions.defaultExpectation != nil {
+		mmPurgeSessions.mock.t.Fatalf("Default expectation is already set for the OAuthRepository.PurgeSessions method")
+	}
+
+	if len(mmPurgeSessions.expectations) > 0 {
+		mmPurgeSessions.mock.t.Fatalf("Some expectations are already set for the OAuthRepository.PurgeSessions method")
+	}
+
+	mmPurgeSessions.mock.funcPurgeSessions = f
+	mmPurgeSessions.mock.funcPurgeSessionsOrigin = minimock.CallerInfo(1)
+	return mmPurgeSessions.mock
+}
+
+// When sets expectation for the OAuthRepository.PurgeSessions which will trigger the result defined by the following
+// Then helper
+func (mmPurgeSessions *mOAuthRepositoryMockPurgeSessions) When(ctx context.Context, expiredBefore time.Time) *OAuthRepositoryMockPurgeSessionsExpectation {
+	if mmPurgeSessions.mock.funcPurgeSessions != nil {
+		mmPurgeSessions.mock.t.Fatalf("OAuthRepositoryMock.PurgeSessions mock is already set by Set")
+	}
+
+	expectation := &OAuthRepositoryMockPurgeSessionsExpectation{
+		mock:               mmPurgeSessions.mock,
+		params:             &OAuthRepositoryMockPurgeSessionsParams{ctx, expiredBefore},
+		expectationOrigins: OAuthRepositoryMockPurgeSessionsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmPurgeSessions.expectations = append(mmPurgeSessions.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OAuthRepository.PurgeSessions return parameters for the expectation previously defined by the When method
+func (e *OAuthRepositoryMockPurgeSessionsExpectation) Then(i1 int64, err error) *OAuthRepositoryMock {
+	e.results = &OAuthRepositoryMockPurgeSessionsResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times OAuthRepository.PurgeSessions should be invoked
+func (mmPurgeSessions *mOAuthRepositoryMockPurgeSessions) Times(n uint64) *mOAuthRepositoryMockPurgeSessions {
+	if n == 0 {
+		mmPurgeSessions.mock.t.Fatalf("Times of OAuthRepositoryMock.PurgeSessions mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmPurgeSessions.expectedInvocations, n)
+	mmPurgeSessions.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmPurgeSessions
+}
+
+func (mmPurgeSessions *mOAuthRepositoryMockPurgeSessions) invocationsDone() bool {
+	if len(mmPurgeSessions.expectations) == 0 && mmPurgeSessions.defaultExpectation == nil && mmPurgeSessions.mock.funcPurgeSessions == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmPurgeSessions.mock.afterPurgeSessionsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmPurgeSessions.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// PurgeSessions implements OAuthRepository
+func (mmPurgeSessions *OAuthRepositoryMock) PurgeSessions(ctx context.Context, expiredBefore time.Time) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmPurgeSessions.beforePurgeSessionsCounter, 1)
+	defer mm_atomic.AddUint64(&mmPurgeSessions.afterPurgeSessionsCounter, 1)
+
+	mmPurgeSessions.t.Helper()
+
+	if mmPurgeSessions.inspectFuncPurgeSessions != nil {
+		mmPurgeSessions.inspectFuncPurgeSessions(ctx, expiredBefore)
+	}
+
+	mm_params := OAuthRepositoryMockPurgeSessionsParams{ctx, expiredBefore}
+
+	// Record call args
+	mmPurgeSessions.PurgeSessionsMock.mutex.Lock()
+	mmPurgeSessions.PurgeSessionsMock.callArgs = append(mmPurgeSessions.PurgeSessionsMock.callArgs, &mm_params)
+	mmPurgeSessions.PurgeSessionsMock.mutex.Unlock()
+
+	for _, e := range mmPurgeSessions.PurgeSessionsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmPurgeSessions.PurgeSessionsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmPurgeSessions.PurgeSessionsMock.defaultExpectation.Counter, 1)
+		mm_want := mmPurgeSessions.PurgeSessionsMock.defaultExpectation.params
+		mm_want_ptrs := mmPurgeSessions.PurgeSessionsMock.defaultExpectation.paramPtrs
+
+		mm_got := OAuthRepositoryMockPurgeSessionsParams{ctx, expiredBefore}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmPurgeSessions.t.Errorf("OAuthRepositoryMock.PurgeSessions got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPurgeSessions.PurgeSessionsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.expiredBefore != nil && !minimock.Equal(*mm_want_ptrs.expiredBefore, mm_got.expiredBefore) {
+				mmPurgeSessions.t.Errorf("OAuthRepositoryMock.PurgeSessions got unexpected parameter expiredBefore, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPurgeSessions.PurgeSessionsMock.defaultExpectation.expectationOrigins.originExpiredBefore, *mm_want_ptrs.expiredBefore, mm_got.expiredBefore, minimock.Diff(*mm_want_ptrs.expiredBefore, mm_got.expiredBefore))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmPurgeSessions.t.Errorf("OAuthRepositoryMock.PurgeSessions got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmPurgeSessions.PurgeSessionsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmPurgeSessions.PurgeSessionsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmPurgeSessions.t.Fatal("No results are set for the OAuthRepositoryMock.PurgeSessions")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmPurgeSessions.funcPurgeSessions != nil {
+		return mmPurgeSessions.funcPurgeSessions(ctx, expiredBefore)
+	}
+	mmPurgeSessions.t.Fatalf("Unexpected call to OAuthRepositoryMock.PurgeSessions. %v %v", ctx, expiredBefore)
+	return
+}
+
+// PurgeSessionsAfterCounter returns a count of finished OAuthRepositoryMock.PurgeSessions invocations
+func (mmPurgeSessions *OAuthRepositoryMock) PurgeSessionsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPurgeSessions.afterPurgeSessionsCounter)
+}
+
+// PurgeSessionsBeforeCounter returns a count of OAuthRepositoryMock.PurgeSessions invocations
+func (mmPurgeSessions *OAuthRepositoryMock) PurgeSessionsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPurgeSessions.beforePurgeSessionsCounter)
+}
+
+// Calls returns a list of arguments used in each call to OAuthRepositoryMock.PurgeSessions.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmPurgeSessions *mOAuthRepositoryMockPurgeSessions) Calls() []*OAuthRepositoryMockPurgeSessionsParams {
+	mmPurgeSessions.mutex.RLock()
+
+	argCopy := make([]*OAuthRepositoryMockPurgeSessionsParams, len(mmPurgeSessions.callArgs))
+	copy(argCopy, mmPurgeSessions.callArgs)
+
+	mmPurgeSessions.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockPurgeSessionsDone returns true if the count of the PurgeSessions invocations corresponds
+// the number of defined expectations
+func (m *OAuthRepositoryMock) MinimockPurgeSessionsDone() bool {
+	if m.PurgeSessionsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.PurgeSessionsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.PurgeSessionsMock.invocationsDone()
+}
+
+// MinimockPurgeSessionsInspect logs each unmet expectation
+func (m *OAuthRepositoryMock) MinimockPurgeSessionsInspect() {
+	for _, e := range m.PurgeSessionsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OAuthRepositoryMock.PurgeSessions at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterPurgeSessionsCounter := mm_atomic.LoadUint64(&m.afterPurgeSessionsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.PurgeSessionsMock.defaultExpectation != nil && afterPurgeSessionsCounter < 1 {
+		if m.PurgeSessionsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OAuthRepositoryMock.PurgeSessions at\n%s", m.PurgeSessionsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OAuthRepositoryMock.PurgeSessions at\n%s with params: %#v", m.PurgeSessionsMock.defaultExpectation.expectationOrigins.origin, *m.PurgeSessionsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcPurgeSessions != nil && afterPurgeSessionsCounter < 1 {
+		m.t.Errorf("Expected call to OAuthRepositoryMock.PurgeSessions at\n%s", m.funcPurgeSessionsOrigin)
+	}
+
+	if !m.PurgeSessionsMock.invocationsDone() && afterPurgeSessionsCounter > 0 {
+		m.t.Errorf("Expected %d calls to OAuthRepositoryMock.PurgeSessions at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.PurgeSessionsMock.expectedInvocations), m.PurgeSessionsMock.expectedInvocationsOrigin, afterPurgeSessionsCounter)
+	}
+}
+
+type mOAuthRepositoryMockRevokeToken struct {
+	optional           bool
+	mock               *OAuthRepositoryMock
+	defaultExpectation *OAuthRepositoryMockRevokeTokenExpectation
+	expectations       []*OAuthRepositoryMockRevokeTokenExpectation
+
+	callArgs []*OAuthRepositoryMockRevokeTokenParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OAuthRepositoryMockRevokeTokenExpectation specifies expectation struct of the OAuthRepository.RevokeToken
+type OAuthRepositoryMockRevokeTokenExpectation struct {
+	mock               *OAuthRepositoryMock
+	params             *OAuthRepositoryMockRevokeTokenParams
+	paramPtrs          *OAuthRepositoryMockRevokeTokenParamPtrs
+	expectationOrigins OAuthRepositoryMockRevokeTokenExpectationOrigins
+	results            *OAuthRepositoryMockRevokeTokenResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// OAuthRepositoryMockRevokeTokenParams contains parameters of the OAuthRepository.RevokeToken
+type OAuthRepositoryMockRevokeTokenParams struct {
+	ctx       context.Context
+	jti       string
+	expiresAt time.Time
+}
+
+// OAuthRepositoryMockRevokeTokenParamPtrs contains pointers to parameters of the OAuthRepository.RevokeToken
+type OAuthRepositoryMockRevokeTokenParamPtrs struct {
+	ctx       *context.Context
+	jti       *string
+	expiresAt *time.Time
+}
+
+// OAuthRepositoryMockRevokeTokenResults contains results of the OAuthRepository.RevokeToken
+type OAuthRepositoryMockRevokeTokenResults struct {
+	err error
+}
+
+// OAuthRepositoryMockRevokeTokenOrigins contains origins of expectations of the OAuthRepository.RevokeToken
+type OAuthRepositoryMockRevokeTokenExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originJti       string
+	originExpiresAt string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRevokeToken *mOAuthRepositoryMockRevokeToken) Optional() *mOAuthRepositoryMockRevokeToken {
+	mmRevokeToken.optional = true
+	return mmRevokeToken
+}
+
+// Expect sets up expected params for OAuthRepository.RevokeToken
+func (mmRevokeToken *mOAuthRepositoryMockRevokeToken) Expect(ctx context.Context, jti string, expiresAt time.Time) *mOAuthRepositoryMockRevokeToken {
+	if mmRevokeToken.mock.funcRevokeToken != nil {
+		mmRevokeToken.mock.t.Fatalf("OAuthRepositoryMock.RevokeToken mock is already set by Set")
+	}
+
+	if mmRevokeToken.defaultExpectation == nil {
+		mmRevokeToken.defaultExpectation = &OAuthRepositoryMockRevokeTokenExpectation{}
+	}
+
+	if mmRevokeToken.defaultExpectation.paramPtrs != nil {
+		mmRevokeToken.mock.t.Fatalf("OAuthRepositoryMock.RevokeToken mock is already set by ExpectParams functions")
+	}
+
+	mmRevokeToken.defaultExpectation.params = &OAuthRepositoryMockRevokeTokenParams{ctx, jti, expiresAt}
+	mmRevokeToken.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRevokeToken.expectations {
+		if minimock.Equal(e.params, mmRevokeToken.defaultExpectation.params) {
+			mmRevokeToken.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRevokeToken.defaultExpectation.params)
+		}
+	}
+
+	return mmRevokeToken
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OAuthRepository.RevokeToken
+func (mmRevokeToken *mOAuthRepositoryMockRevokeToken) ExpectCtxParam1(ctx context.Context) *mOAuthRepositoryMockRevokeToken {
+	if mmRevokeToken.mock.funcRevokeToken != nil {
+		mmRevokeToken.mock.t.Fatalf("OAuthRepositoryMock.RevokeToken mock is already set by Set")
+	}
+
+	if mmRevokeToken.defaultExpectation == nil {
+		mmRevokeToken.defaultExpectation = &OAuthRepositoryMockRevokeTokenExpectation{}
+	}
+
+	if mmRevokeToken.defaultExpectation.params != nil {
+		mmRevokeToken.mock.t.Fatalf("OAuthRepositoryMock.RevokeToken mock is already set by Expect")
+	}
+
+	if mmRevokeToken.defaultExpectation.paramPtrs == nil {
+		mmRevokeToken.defaultExpectation.paramPtrs = &OAuthRepositoryMockRevokeTokenParamPtrs{}
+	}
+	mmRevokeToken.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRevokeToken.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRevokeToken
+}
+
+// ExpectJtiParam2 sets up expected param jti for OAuthRepository.RevokeToken
+func (mmRevokeToken *mOAuthRepositoryMockRevokeToken) ExpectJtiParam2(jti string) *mOAuthRepositoryMockRevokeToken {
+	if mmRevokeToken.mock.funcRevokeToken != nil {
+		mmRevokeToken.mock.t.Fatalf("OAuthRepositoryMock.RevokeToken mock is already set by Set")
+	}
+
+	if mmRevokeToken.defaultExpectation == nil {
+		mmRevokeToken.defaultExpectation = &OAuthRepositoryMockRevokeTokenExpectation{}
+	}
+
+	if mmRevokeToken.defaultExpectation.params != nil {
+		mmRevokeToken.mock.t.Fatalf("OAuthRepositoryMock.RevokeToken mock is already set by Expect")
+	}
+
+	if mmRevokeToken.defaultExpectation.paramPtrs == nil {
+		mmRevokeToken.defaultExpectation.paramPtrs = &OAuthRepositoryMockRevokeTokenParamPtrs{}
+	}
+	mmRevokeToken.defaultExpectation.paramPtrs.jti = &jti
+	mmRevokeToken.defaultExpectation.expectationOrigins.originJti = minimock.CallerInfo(1)
+
+	return mmRevokeToken
+}
+
+// ExpectExpiresAtParam3 sets up expected param expiresAt for OAuthRepository.RevokeToken
+func (mmRevokeToken *mOAuthRepositoryMockRevokeToken) ExpectExpiresAtParam3(expiresAt time.Time) *mOAuthRepositoryMockRevokeToken {
+	if mmRevokeToken.mock.funcRevokeToken != nil {
+		mmRevokeToken.mock.t.Fatalf("OAuthRepositoryMock.RevokeToken mock is already set by Set")
+	}
+
+	if mmRevokeToken.defaultExpectation == nil {
+		mmRevokeToken.defaultExpectation = &OAuthRepositoryMockRevokeTokenExpectation{}
+	}
+
+	if mmRevokeToken.defaultExpectation.params != nil {
+		mmRevokeToken.mock.t.Fatalf("OAuthRepositoryMock.RevokeToken mock is already set by Expect")
+	}
+
+	if mmRevokeToken.defaultExpectation.paramPtrs == nil {
+		mmRevokeToken.defaultExpectation.paramPtrs = &OAuthRepositoryMockRevokeTokenParamPtrs{}
+	}
+	mmRevokeToken.defaultExpectation.paramPtrs.expiresAt = &expiresAt
+	mmRevokeToken.defaultExpectation.expectationOrigins.originExpiresAt = minimock.CallerInfo(1)
+
+	return mmRevokeToken
+}
+
+// Inspect accepts an inspector function that has same arguments as the OAuthRepository.RevokeToken
+func (mmRevokeToken *mOAuthRepositoryMockRevokeToken) Inspect(f func(ctx context.Context, jti string, expiresAt time.Time)) *mOAuthRepositoryMockRevokeToken {
+	if mmRevokeToken.mock.inspectFuncRevokeToken != nil {
+		mmRevokeToken.mock.t.Fatalf("Inspect function is already set for OAuthRepositoryMock.RevokeToken")
+	}
+
+	mmRevokeToken.mock.inspectFuncRevokeToken = f
+
+	return mmRevokeToken
+}
+
+// Return sets up results that will be returned by OAuthRepository.RevokeToken
+func (mmRevokeToken *mOAuthRepositoryMockRevokeToken) Return(err error) *OAuthRepositoryMock {
+	if mmRevokeToken.mock.funcRevokeToken != nil {
+		mmRevokeToken.mock.t.Fatalf("OAuthRepositoryMock.RevokeToken mock is already set by Set")
+	}
+
+	if mmRevokeToken.defaultExpectation == nil {
+		mmRevokeToken.defaultExpectation = &OAuthRepositoryMockRevokeTokenExpectation{mock: mmRevokeToken.mock}
+	}
+	mmRevokeToken.defaultExpectation.results = &OAuthRepositoryMockRevokeTokenResults{err}
+	mmRevokeToken.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRevokeToken.mock
+}
+
+// Set uses given function f to mock the OAuthRepository.RevokeToken method
+func (mmRevokeToken *mOAuthRepositoryMockRevokeToken) Set(f func(ctx context.Context, jti string, expiresAt time.Time) (err error)) *OAuthRepositoryMock {
+	if mmRevokeToken.defaultExpectation != nil {
+		mmRevokeToken.mock.t.Fatalf("Default expectation is already set for the OAuthRepository.RevokeToken method")
+	}
+
+	if len(mmRevokeToken.expectations) > 0 {
+		mmRevokeToken.mock.t.Fatalf("Some expectations are already set for the OAuthRepository.RevokeToken method")
+	}
+
+	mmRevokeToken.mock.funcRevokeToken = f
+	mmRevokeToken.mock.funcRevokeTokenOrigin = minimock.CallerInfo(1)
+	return mmRevokeToken.mock
+}
+
+// When sets expectation for the OAuthRepository.RevokeToken which will trigger the result defined by the following
+// Then helper
+func (mmRevokeToken *mOAuthRepositoryMockRevokeToken) When(ctx context.Context, jti string, expiresAt time.Time) *OAuthRepositoryMockRevokeTokenExpectation {
+	if mmRevokeToken.mock.funcRevokeToken != nil {
+		mmRevokeToken.mock.t.Fatalf("OAuthRepositoryMock.RevokeToken mock is already set by Set")
+	}
+
+	expectation := &OAuthRepositoryMockRevokeTokenExpectation{
+		mock:               mmRevokeToken.mock,
+		params:             &OAuthRepositoryMockRevokeTokenParams{ctx, jti, expiresAt},
+		expectationOrigins: OAuthRepositoryMockRevokeTokenExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmRevokeToken.expectations = append(mmRevokeToken.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OAuthRepository.RevokeToken return parameters for the expectation previously defined by the When method
+func (e *OAuthRepositoryMockRevokeTokenExpectation) Then(err error) *OAuthRepositoryMock {
+	e.results = &OAuthRepositoryMockRevokeTokenResults{err}
+	return e.mock
+}
+
+// Times sets number of times OAuthRepository.RevokeToken should be invoked
+func (mmRevokeToken *mOAuthRepositoryMockRevokeToken) Times(n uint64) *mOAuthRepositoryMockRevokeToken {
+	if n == 0 {
+		mmRevokeToken.mock.t.Fatalf("Times of OAuthRepositoryMock.RevokeToken mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRevokeToken.expectedInvocations, n)
+	mmRevokeToken.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRevokeToken
+}
+
+func (mmRevokeToken *mOAuthRepositoryMockRevokeToken) invocationsDone() bool {
+	if len(mmRevokeToken.expectations) == 0 && mmRevokeToken.defaultExpectation == nil && mmRevokeToken.mock.funcRevokeToken == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRevokeToken.mock.afterRevokeTokenCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRevokeToken.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RevokeToken implements OAuthRepository
+func (mmRevokeToken *OAuthRepositoryMock) RevokeToken(ctx context.Context, jti string, expiresAt time.Time) (err error) {
+	mm_atomic.AddUint64(&mmRevokeToken.beforeRevokeTokenCounter, 1)
+	defer mm_atomic.AddUint64(&mmRevokeToken.afterRevokeTokenCounter, 1)
+
+	mmRevokeToken.t.Helper()
+
+	if mmRevokeToken.inspectFuncRevokeToken != nil {
+		mmRevokeToken.inspectFuncRevokeToken(ctx, jti, expiresAt)
+	}
+
+	mm_params := OAuthRepositoryMockRevokeTokenParams{ctx, jti, expiresAt}
+
+	// Record call args
+	mmRevokeToken.RevokeTokenMock.mutex.Lock()
+	mmRevokeToken.RevokeTokenMock.callArgs = append(mmRevokeToken.RevokeTokenMock.callArgs, &mm_params)
+	mmRevokeToken.RevokeTokenMock.mutex.Unlock()
+
+	for _, e := range mmRevokeToken.RevokeTokenMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmRevokeToken.RevokeTokenMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRevokeToken.RevokeTokenMock.defaultExpectation.Counter, 1)
+		mm_want := mmRevokeToken.RevokeTokenMock.defaultExpectation.params
+		mm_want_ptrs := mmRevokeToken.RevokeTokenMock.defaultExpectation.paramPtrs
+
+		mm_got := OAuthRepositoryMockRevokeTokenParams{ctx, jti, expiresAt}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRevokeToken.t.Errorf("OAuthRepositoryMock.RevokeToken got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRevokeToken.RevokeTokenMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.jti != nil && !minimock.Equal(*mm_want_ptrs.jti, mm_got.jti) {
+				mmRevokeToken.t.Errorf("OAuthRepositoryMock.RevokeToken got unexpected parameter jti, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRevokeToken.RevokeTokenMock.defaultExpectation.expectationOrigins.originJti, *mm_want_ptrs.jti, mm_got.jti, minimock.Diff(*mm_want_ptrs.jti, mm_got.jti))
+			}
+
+			if mm_want_ptrs.expiresAt != nil && !minimock.Equal(*mm_want_ptrs.expiresAt, mm_got.expiresAt) {
+				mmRevokeToken.t.Errorf("OAuthRepositoryMock.RevokeToken got unexpected parameter expiresAt, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRevokeToken.RevokeTokenMock.defaultExpectation.expectationOrigins.originExpiresAt, *mm_want_ptrs.expiresAt, mm_got.expiresAt, minimock.Diff(*mm_want_ptrs.expiresAt, mm_got.expiresAt))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRevokeToken.t.Errorf("OAuthRepositoryMock.RevokeToken got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRevokeToken.RevokeTokenMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRevokeToken.RevokeTokenMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRevokeToken.t.Fatal("No results are set for the OAuthRepositoryMock.RevokeToken")
+		}
+		return (*mm_results).err
+	}
+	if mmRevokeToken.funcRevokeToken != nil {
+		return mmRevokeToken.funcRevokeToken(ctx, jti, expiresAt)
+	}
+	mmRevokeToken.t.Fatalf("Unexpected call to OAuthRepositoryMock.RevokeToken. %v %v %v", ctx, jti, expiresAt)
+	return
+}
+
+// RevokeTokenAfterCounter returns a count of finished OAuthRepositoryMock.RevokeToken invocations
+func (mmRevokeToken *OAuthRepositoryMock) RevokeTokenAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRevokeToken.afterRevokeTokenCounter)
+}
+
+// RevokeTokenBeforeCounter returns a count of OAuthRepositoryMock.RevokeToken invocations
+func (mmRevokeToken *OAuthRepositoryMock) RevokeTokenBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRevokeToken.beforeRevokeTokenCounter)
+}
+
+// Calls returns a list of arguments used in each call to OAuthRepositoryMock.RevokeToken.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRevokeToken *mOAuthRepositoryMockRevokeToken) Calls() []*OAuthRepositoryMockRevokeTokenParams {
+	mmRevokeToken.mutex.RLock()
+
+	argCopy := make([]*OAuthRepositoryMockRevokeTokenParams, len(mmRevokeToken.callArgs))
+	copy(argCopy, mmRevokeToken.callArgs)
+
+	mmRevokeToken.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRevokeTokenDone returns true if the count of the RevokeToken invocations corresponds
+// the number of defined expectations
+func (m *OAuthRepositoryMock) MinimockRevokeTokenDone() bool {
+	if m.RevokeTokenMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RevokeTokenMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RevokeTokenMock.invocationsDone()
+}
+
+// MinimockRevokeTokenInspect logs each unmet expectation
+func (m *OAuthRepositoryMock) MinimockRevokeTokenInspect() {
+	for _, e := range m.RevokeTokenMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OAuthRepositoryMock.RevokeToken at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterRevokeTokenCounter := mm_atomic.LoadUint64(&m.afterRevokeTokenCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RevokeTokenMock.defaultExpectation != nil && afterRevokeTokenCounter < 1 {
+		if m.RevokeTokenMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OAuthRepositoryMock.RevokeToken at\n%s", m.RevokeTokenMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OAuthRepositoryMock.RevokeToken at\n%s with params: %#v", m.RevokeTokenMock.defaultExpectation.expectationOrigins.origin, *m.RevokeTokenMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRevokeToken != nil && afterRevokeTokenCounter < 1 {
+		m.t.Errorf("Expected call to OAuthRepositoryMock.RevokeToken at\n%s", m.funcRevokeTokenOrigin)
+	}
+
+	if !m.RevokeTokenMock.invocationsDone() && afterRevokeTokenCounter > 0 {
+		m.t.Errorf("Expected %d calls to OAuthRepositoryMock.RevokeToken at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RevokeTokenMock.expectedInvocations), m.RevokeTokenMock.expectedInvocationsOrigin, afterRevokeTokenCounter)
+	}
+}
+
+type mOAuthRepositoryMockSaveConsent struct {
+	optional           bool
+	mock               *OAuthRepositoryMock
+	defaultExpectation *OAuthRepositoryMockSaveConsentExpectation
+	expectations       []*OAuthRepositoryMockSaveConsentExpectation
+
+	callArgs []*OAuthRepositoryMockSaveConsentParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OAuthRepositoryMockSaveConsentExpectation specifies expectation struct of the OAuthRepository.SaveConsent
+type OAuthRepositoryMockSaveConsentExpectation struct {
+	mock               *OAuthRepositoryMock
+	params             *OAuthRepositoryMockSaveConsentParams
+	paramPtrs          *OAuthRepositoryMockSaveConsentParamPtrs
+	expectationOrigins OAuthRepositoryMockSaveConsentExpectationOrigins
+	results            *OAuthRepositoryMockSaveConsentResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// OAuthRepositoryMockSaveConsentParams contains parameters of the OAuthRepository.SaveConsent
+type OAuthRepositoryMockSaveConsentParams struct {
+	ctx     context.Context
+	consent *models.Consent
+}
+
+// OAuthRepositoryMockSaveConsentParamPtrs contains pointers to parameters of the OAuthRepository.SaveConsent
+type OAuthRepositoryMockSaveConsentParamPtrs struct {
+	ctx     *context.Context
+	consent **models.Consent
+}
+
+// OAuthRepositoryMockSaveConsentResults contains results of the OAuthRepository.SaveConsent
+type OAuthRepositoryMockSaveConsentResults struct {
+	err error
+}
+
+// OAuthRepositoryMockSaveConsentOrigins contains origins of expectations of the OAuthRepository.SaveConsent
+type OAuthRepositoryMockSaveConsentExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originConsent string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSaveConsent *mOAuthRepositoryMockSaveConsent) Optional() *mOAuthRepositoryMockSaveConsent {
+	mmSaveConsent.optional = true
+	return mmSaveConsent
+}
+
+// Expect sets up expected params for OAuthRepository.SaveConsent
+func (mmSaveConsent *mOAuthRepositoryMockSaveConsent) Expect(ctx context.Context, consent *models.Consent) *mOAuthRepositoryMockSaveConsent {
+	if mmSaveConsent.mock.funcSaveConsent != nil {
+		mmSaveConsent.mock.t.Fatalf("OAuthRepositoryMock.SaveConsent mock is already set by Set")
+	}
+
+	if mmSaveConsent.defaultExpectation == nil {
+		mmSaveConsent.defaultExpectation = &OAuthRepositoryMockSaveConsentExpectation{}
+	}
+
+	if mmSaveConsent.defaultExpectation.paramPtrs != nil {
+		mmSaveConsent.mock.t.Fatalf("OAuthRepositoryMock.SaveConsent mock is already set by ExpectParams functions")
+	}
+
+	mmSaveConsent.defaultExpectation.params = &OAuthRepositoryMockSaveConsentParams{ctx, consent}
+	mmSaveConsent.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSaveConsent.expectations {
+		if minimock.Equal(e.params, mmSaveConsent.defaultExpectation.params) {
+			mmSaveConsent.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSaveConsent.defaultExpectation.params)
+		}
+	}
+
+	return mmSaveConsent
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OAuthRepository.SaveConsent
+func (mmSaveConsent *mOAuthRepositoryMockSaveConsent) ExpectCtxParam1(ctx context.Context) *mOAuthRepositoryMockSaveConsent {
+	if mmSaveConsent.mock.funcSaveConsent != nil {
+		mmSaveConsent.mock.t.Fatalf("OAuthRepositoryMock.SaveConsent mock is already set by Set")
+	}
+
+	if mmSaveConsent.defaultExpectation == nil {
+		mmSaveConsent.defaultExpectation = &OAuthRepositoryMockSaveConsentExpectation{}
+	}
+
+	if mmSaveConsent.defaultExpectation.params != nil {
+		mmSaveConsent.mock.t.Fatalf("OAuthRepositoryMock.SaveConsent mock is already set by Expect")
+	}
+
+	if mmSaveConsent.defaultExpectation.paramPtrs == nil {
+		mmSaveConsent.defaultExpectation.paramPtrs = &OAuthRepositoryMockSaveConsentParamPtrs{}
+	}
+	mmSaveConsent.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSaveConsent.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSaveConsent
+}
+
+// ExpectConsentParam2 sets up expected param consent for OAuthRepository.SaveConsent
+func (mmSaveConsent *mOAuthRepositoryMockSaveConsent) ExpectConsentParam2(consent *models.Consent) *mOAuthRepositoryMockSaveConsent {
+	if mmSaveConsent.mock.funcSaveConsent != nil {
+		mmSaveConsent.mock.t.Fatalf("OAuthRepositoryMock.SaveConsent mock is already set by Set")
+	}
+
+	if mmSaveConsent.defaultExpectation == nil {
+		mmSaveConsent.defaultExpectation = &OAuthRepositoryMockSaveConsentExpectation{}
+	}
+
+	if mmSaveConsent.defaultExpectation.params != nil {
+		mmSaveConsent.mock.t.Fatalf("OAuthRepositoryMock.SaveConsent mock is already set by Expect")
+	}
+
+	if mmSaveConsent.defaultExpectation.paramPtrs == nil {
+		mmSaveConsent.defaultExpectation.paramPtrs = &OAuthRepositoryMockSaveConsentParamPtrs{}
+	}
+	mmSaveConsent.defaultExpectation.paramPtrs.consent = &consent
+	mmSaveConsent.defaultExpectation.expectationOrigins.originConsent = minimock.CallerInfo(1)
+
+	return mmSaveConsent
+}
+
+// Inspect accepts an inspector function that has same arguments as the OAuthRepository.SaveConsent
+func (mmSaveConsent *mOAuthRepositoryMockSaveConsent) Inspect(f func(ctx context.Context, consent *models.Consent)) *mOAuthRepositoryMockSaveConsent {
+	if mmSaveConsent.mock.inspectFuncSaveConsent != nil {
+		mmSaveConsent.mock.t.Fatalf("Inspect function is already set for OAuthRepositoryMock.SaveConsent")
+	}
+
+	mmSaveConsent.mock.inspectFuncSaveConsent = f
+
+	return mmSaveConsent
+}
+
+// Return sets up results that will be returned by OAuthRepository.SaveConsent
+func (mmSaveConsent *mOAuthRepositoryMockSaveConsent) Return(err error) *OAuthRepositoryMock {
+	if mmSaveConsent.mock.funcSaveConsent != nil {
+		mmSaveConsent.mock.t.Fatalf("OAuthRepositoryMock.SaveConsent mock is already set by Set")
+	}
+
+	if mmSaveConsent.defaultExpectation == nil {
+		mmSaveConsent.defaultExpectation = &OAuthRepositoryMockSaveConsentExpectation{mock: mmSaveConsent.mock}
+	}
+	mmSaveConsent.defaultExpectation.results = &OAuthRepositoryMockSaveConsentResults{err}
+	mmSaveConsent.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSaveConsent.mock
+}
+
+// Set uses given function f to mock the OAuthRepository.SaveConsent method
+func (mmSaveConsent *mOAuthRepositoryMockSaveConsent) Set(f func(ctx context.Context, consent *models.Consent) (err error)) *OAuthRepositoryMock {
+	if mmSaveConsent.defaultExpectation != nil {
+		mmSaveConsent.mock.t.Fatalf("Default expectation is already set for the OAuthRepository.SaveConsent method")
+	}
+
+	if len(mmSaveConsent.expectations) > 0 {
+		mmSaveConsent.mock.t.Fatalf("Some expectations are already set for the OAuthRepository.SaveConsent method")
+	}
+
+	mmSaveConsent.mock.funcSaveConsent = f
+	mmSaveConsent.mock.funcSaveConsentOrigin = minimock.CallerInfo(1)
+	return mmSaveConsent.mock
+}
+
+// When sets expectation for the OAuthRepository.SaveConsent which will trigger the result defined by the following
+// Then helper
+func (mmSaveConsent *mOAuthRepositoryMockSaveConsent) When(ctx context.Context, consent *models.Consent) *OAuthRepositoryMockSaveConsentExpectation {
+	if mmSaveConsent.mock.funcSaveConsent != nil {
+		mmSaveConsent.mock.t.Fatalf("OAuthRepositoryMock.SaveConsent mock is already set by Set")
+	}
+
+	expectation := &OAuthRepositoryMockSaveConsentExpectation{
+		mock:               mmSaveConsent.mock,
+		params:             &OAuthRepositoryMockSaveConsentParams{ctx, consent},
+		expectationOrigins: OAuthRepositoryMockSaveConsentExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSaveConsent.expectations = append(mmSaveConsent.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OAuthRepository.SaveConsent return parameters for the expectation previously defined by the When method
+func (e *OAuthRepositoryMockSaveConsentExpectation) Then(err error) *OAuthRepositoryMock {
+	e.results = &OAuthRepositoryMockSaveConsentResults{err}
+	return e.mock
+}
+
+// Times sets number of times OAuthRepository.SaveConsent should be invoked
+func (mmSaveConsent *mOAuthRepositoryMockSaveConsent) Times(n uint64) *mOAuthRepositoryMockSaveConsent {
+	if n == 0 {
+		mmSaveConsent.mock.t.Fatalf("Times of OAuthRepositoryMock.SaveConsent mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSaveConsent.expectedInvocations, n)
+	mmSaveConsent.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSaveConsent
+}
+
+func (mmSaveConsent *mOAuthRepositoryMockSaveConsent) invocationsDone() bool {
+	if len(mmSaveConsent.expectations) == 0 && mmSaveConsent.defaultExpectation == nil && mmSaveConsent.mock.funcSaveConsent == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSaveConsent.mock.afterSaveConsentCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSaveConsent.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// SaveConsent implements OAuthRepository
+func (mmSaveConsent *OAuthRepositoryMock) SaveConsent(ctx context.Context, consent *models.Consent) (err error) {
+	mm_atomic.AddUint64(&mmSaveConsent.beforeSaveConsentCounter, 1)
+	defer mm_atomic.AddUint64(&mmSaveConsent.afterSaveConsentCounter, 1)
+
+	mmSaveConsent.t.Helper()
+
+	if mmSaveConsent.inspectFuncSaveConsent != nil {
+		mmSaveConsent.inspectFuncSaveConsent(ctx, consent)
+	}
+
+	mm_params := OAuthRepositoryMockSaveConsentParams{ctx, consent}
+
+	// Record call args
+	mmSaveConsent.SaveConsentMock.mutex.Lock()
+	mmSaveConsent.SaveConsentMock.callArgs = append(mmSaveConsent.SaveConsentMock.callArgs, &mm_params)
+	mmSaveConsent.SaveConsentMock.mutex.Unlock()
+
+	for _, e := range mmSaveConsent.SaveConsentMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmSaveConsent.SaveConsentMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSaveConsent.SaveConsentMock.defaultExpectation.Counter, 1)
+		mm_want := mmSaveConsent.SaveConsentMock.defaultExpectation.params
+		mm_want_ptrs := mmSaveConsent.SaveConsentMock.defaultExpectation.paramPtrs
+
+		mm_got := OAuthRepositoryMockSaveConsentParams{ctx, consent}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSaveConsent.t.Errorf("OAuthRepositoryMock.SaveConsent got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSaveConsent.SaveConsentMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.consent != nil && !minimock.Equal(*mm_want_ptrs.consent, mm_got.consent) {
+				mmSaveConsent.t.Errorf("OAuthRepositoryMock.SaveConsent got unexpected parameter consent, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSaveConsent.SaveConsentMock.defaultExpectation.expectationOrigins.originConsent, *mm_want_ptrs.consent, mm_got.consent, minimock.Diff(*mm_want_ptrs.consent, mm_got.consent))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSaveConsent.t.Errorf("OAuthRepositoryMock.SaveConsent got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSaveConsent.SaveConsentMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSaveConsent.SaveConsentMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSaveConsent.t.Fatal("No results are set for the OAuthRepositoryMock.SaveConsent")
+		}
+		return (*mm_results).err
+	}
+	if mmSaveConsent.funcSaveConsent != nil {
+		return mmSaveConsent.funcSaveConsent(ctx, consent)
+	}
+	mmSaveConsent.t.Fatalf("Unexpected call to OAuthRepositoryMock.SaveConsent. %v %v", ctx, consent)
+	return
+}
+
+// SaveConsentAfterCounter returns a count of finished OAuthRepositoryMock.SaveConsent invocations
+func (mmSaveConsent *OAuthRepositoryMock) SaveConsentAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSaveConsent.afterSaveConsentCounter)
+}
+
+// SaveConsentBeforeCounter returns a count of OAuthRepositoryMock.SaveConsent invocations
+func (mmSaveConsent *OAuthRepositoryMock) SaveConsentBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSaveConsent.beforeSaveConsentCounter)
+}
+
+// Calls returns a list of arguments used in each call to OAuthRepositoryMock.SaveConsent.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSaveConsent *mOAuthRepositoryMockSaveConsent) Calls() []*OAuthRepositoryMockSaveConsentParams {
+	mmSaveConsent.mutex.RLock()
+
+	argCopy := make([]*OAuthRepositoryMockSaveConsentParams, len(mmSaveConsent.callArgs))
+	copy(argCopy, mmSaveConsent.callArgs)
+
+	mmSaveConsent.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSaveConsentDone returns true if the count of the SaveConsent invocations corresponds
+// the number of defined expectations
+func (m *OAuthRepositoryMock) MinimockSaveConsentDone() bool {
+	if m.SaveConsentMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SaveConsentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SaveConsentMock.invocationsDone()
+}
+
+// MinimockSaveConsentInspect logs each unmet expectation
+func (m *OAuthRepositoryMock) MinimockSaveConsentInspect() {
+	for _, e := range m.SaveConsentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OAuthRepositoryMock.SaveConsent at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSaveConsentCounter := mm_atomic.LoadUint64(&m.afterSaveConsentCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SaveConsentMock.defaultExpectation != nil && afterSaveConsentCounter < 1 {
+		if m.SaveConsentMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OAuthRepositoryMock.SaveConsent at\n%s", m.SaveConsentMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OAuthRepositoryMock.SaveConsent at\n%s with params: %#v", m.SaveConsentMock.defaultExpectation.expectationOrigins.origin, *m.SaveConsentMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSaveConsent != nil && afterSaveConsentCounter < 1 {
+		m.t.Errorf("Expected call to OAuthRepositoryMock.SaveConsent at\n%s", m.funcSaveConsentOrigin)
+	}
+
+	if !m.SaveConsentMock.invocationsDone() && afterSaveConsentCounter > 0 {
+		m.t.Errorf("Expected %d calls to OAuthRepositoryMock.SaveConsent at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SaveConsentMock.expectedInvocations), m.SaveConsentMock.expectedInvocationsOrigin, afterSaveConsentCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *OAuthRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockConsumeAuthorizationCodeInspect()
+
+			m.MinimockCreateAuthorizationCodeInspect()
+
+			m.MinimockCreateClientInspect()
+
+			m.MinimockDeleteSessionInspect()
+
+			m.MinimockFindByIDInspect()
+
+			m.MinimockFindClientByIDInspect()
+
+			m.MinimockFindConsentInspect()
+
+			m.MinimockPurgeAuthorizationCodesInspect()
+
+			m.MinimockPurgeRevokedTokensInspect()
+
+			m.MinimockPurgeSessionsInspect()
+
+			m.MinimockRevokeTokenInspect()
+
+			m.MinimockSaveConsentInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *OAuthRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *OAuthRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockConsumeAuthorizationCodeDone() &&
+		m.MinimockCreateAuthorizationCodeDone() &&
+		m.MinimockCreateClientDone() &&
+		m.MinimockDeleteSessionDone() &&
+		m.MinimockFindByIDDone() &&
+		m.MinimockFindClientByIDDone() &&
+		m.MinimockFindConsentDone() &&
+		m.MinimockPurgeAuthorizationCodesDone() &&
+		m.MinimockPurgeRevokedTokensDone() &&
+		m.MinimockPurgeSessionsDone() &&
+		m.MinimockRevokeTokenDone() &&
+		m.MinimockSaveConsentDone()
+}
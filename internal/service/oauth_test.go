@@ -0,0 +1,221 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/alonsoF100/authorization-service/internal/apperrors"
+	"github.com/alonsoF100/authorization-service/internal/models"
+	"github.com/alonsoF100/authorization-service/internal/service"
+	"github.com/gojuno/minimock/v3"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOAuthServiceClients(t *testing.T) {
+	ctx := context.Background()
+	mc := minimock.NewController(t)
+	mockRepo := service.NewOAuthRepositoryMock(mc)
+
+	var stored *models.Client
+	mockRepo.CreateClientMock.Set(func(ctx context.Context, client *models.Client) error {
+		stored = client
+		return nil
+	})
+	mockRepo.FindClientByIDMock.Set(func(ctx context.Context, clientID string) (*models.Client, error) {
+		if stored != nil && stored.ID == clientID {
+			return stored, nil
+		}
+		return nil, nil
+	})
+
+	oauthService := service.NewOAuthService(mockRepo, service.NewTokenValidatorMock(mc), nil, nil, nil)
+
+	_, _, err := oauthService.CreateClient(ctx, "gateway", []string{"/callback"}, nil, nil, nil)
+	require.Error(t, err)
+	_, _, err = oauthService.CreateClient(ctx, "gateway", nil, []string{"/logged-out"}, nil, nil)
+	require.Error(t, err)
+	_, _, err = oauthService.CreateClient(ctx, "gateway", nil, nil, []string{"password"}, nil)
+	require.Error(t, err)
+	_, _, err = oauthService.CreateClient(ctx, "gateway", nil, nil, []string{models.GrantAuthorizationCode}, nil)
+	require.Error(t, err)
+	_, _, err = oauthService.CreateClient(ctx, "gateway", nil, nil, []string{models.GrantClientCredentials}, []string{"orders read"})
+	require.Error(t, err)
+
+	client, secret, err := oauthService.CreateClient(
+		ctx,
+		"gateway",
+		[]string{"https://gateway.example.com/callback"},
+		[]string{"https://gateway.example.com"},
+		[]string{models.GrantAuthorizationCode, models.GrantClientCredentials},
+		[]string{"orders:read"},
+	)
+	require.NoError(t, err)
+	require.Equal(t, "gateway", client.Name)
+	require.True(t, client.AllowsPostLogoutRedirect("https://gateway.example.com"))
+	require.Equal(t, []string{"orders:read"}, client.Scopes)
+	require.True(t, client.AllowsGrant(models.GrantAuthorizationCode))
+	require.NotEmpty(t, secret)
+	require.NotContains(t, stored.SecretHash, secret)
+
+	authenticated, err := oauthService.AuthenticateClient(ctx, client.ID, secret)
+	require.NoError(t, err)
+	require.Equal(t, client.ID, authenticated.ID)
+
+	_, err = oauthService.AuthenticateClient(ctx, client.ID, "wrong")
+	require.ErrorIs(t, err, apperrors.ErrInvalidClient)
+
+	_, err = oauthService.AuthenticateClient(ctx, "missing", secret)
+	require.ErrorIs(t, err, apperrors.ErrInvalidClient)
+
+	_, err = oauthService.AuthenticateClient(ctx, client.ID, "")
+	require.ErrorIs(t, err, apperrors.ErrInvalidClient)
+}
+
+func TestOAuthServiceIntrospect(t *testing.T) {
+	ctx := context.Background()
+	someErr := errors.New("database error")
+	disabledAt := time.Now()
+
+	userClaims := func() *models.Claims {
+		return &models.Claims{
+			ID:    "user123",
+			Email: "alonso@yandex.ru",
+			RegisteredClaims: jwt.RegisteredClaims{
+				ID:        "jti123",
+				Subject:   "user123",
+				ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+			},
+		}
+	}
+
+	tests := []struct {
+		name        string
+		setupMocks  func(repo *service.OAuthRepositoryMock, validator *service.TokenValidatorMock)
+		expectedErr error
+	}{
+		{
+			name: "active user token",
+			setupMocks: func(repo *service.OAuthRepositoryMock, validator *service.TokenValidatorMock) {
+				validator.ValidateJWTMock.Return(userClaims(), nil)
+				repo.FindByIDMock.Expect(ctx, "user123").Return(&models.User{ID: "user123"}, nil)
+			},
+		},
+		{
+			name: "active client token",
+			setupMocks: func(repo *service.OAuthRepositoryMock, validator *service.TokenValidatorMock) {
+				claims := userClaims()
+				claims.ID = ""
+				claims.ClientID = "client123"
+				validator.ValidateJWTMock.Return(claims, nil)
+				repo.FindClientByIDMock.Expect(ctx, "client123").Return(&models.Client{ID: "client123"}, nil)
+			},
+		},
+		{
+			name: "invalid signature",
+			setupMocks: func(repo *service.OAuthRepositoryMock, validator *service.TokenValidatorMock) {
+				validator.ValidateJWTMock.Return(nil, apperrors.ErrInvalidToken)
+			},
+			expectedErr: apperrors.ErrInvalidToken,
+		},
+		{
+			name: "deleted user",
+			setupMocks: func(repo *service.OAuthRepositoryMock, validator *service.TokenValidatorMock) {
+				validator.ValidateJWTMock.Return(userClaims(), nil)
+				repo.FindByIDMock.Return(nil, nil)
+			},
+			expectedErr: apperrors.ErrInvalidToken,
+		},
+		{
+			name: "disabled user",
+			setupMocks: func(repo *service.OAuthRepositoryMock, validator *service.TokenValidatorMock) {
+				validator.ValidateJWTMock.Return(userClaims(), nil)
+				repo.FindByIDMock.Return(&models.User{ID: "user123", DisabledAt: &disabledAt}, nil)
+			},
+			expectedErr: apperrors.ErrInvalidToken,
+		},
+		{
+			name: "database error",
+			setupMocks: func(repo *service.OAuthRepositoryMock, validator *service.TokenValidatorMock) {
+				validator.ValidateJWTMock.Return(userClaims(), nil)
+				repo.FindByIDMock.Return(nil, someErr)
+			},
+			expectedErr: someErr,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := minimock.NewController(t)
+			mockRepo := service.NewOAuthRepositoryMock(mc)
+			mockValidator := service.NewTokenValidatorMock(mc)
+			tt.setupMocks(mockRepo, mockValidator)
+
+			claims, err := service.NewOAuthService(mockRepo, mockValidator, nil, nil, nil).Introspect(ctx, "token")
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+				require.Nil(t, claims)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, "jti123", claims.RegisteredClaims.ID)
+		})
+	}
+}
+
+func TestOAuthServiceRevoke(t *testing.T) {
+	ctx := context.Background()
+	client := &models.Client{ID: "client123"}
+	expiresAt := time.Now().Add(time.Hour).Truncate(time.Second)
+
+	claims := func(clientID string) *models.Claims {
+		return &models.Claims{
+			ID:       "user123",
+			ClientID: clientID,
+			RegisteredClaims: jwt.RegisteredClaims{
+				ID:        "jti123",
+				ExpiresAt: jwt.NewNumericDate(expiresAt),
+			},
+		}
+	}
+
+	t.Run("revokes the token until it expires", func(t *testing.T) {
+		mc := minimock.NewController(t)
+		mockRepo := service.NewOAuthRepositoryMock(mc)
+		mockValidator := service.NewTokenValidatorMock(mc)
+		mockValidator.ValidateJWTMock.Return(claims("client123"), nil)
+		mockRepo.RevokeTokenMock.Expect(ctx, "jti123", expiresAt).Return(nil)
+
+		require.NoError(t, service.NewOAuthService(mockRepo, mockValidator, nil, nil, nil).Revoke(ctx, client, "token"))
+	})
+
+	t.Run("ignores tokens of another client", func(t *testing.T) {
+		mc := minimock.NewController(t)
+		mockRepo := service.NewOAuthRepositoryMock(mc)
+		mockValidator := service.NewTokenValidatorMock(mc)
+		mockValidator.ValidateJWTMock.Return(claims("other"), nil)
+
+		require.NoError(t, service.NewOAuthService(mockRepo, mockValidator, nil, nil, nil).Revoke(ctx, client, "token"))
+	})
+
+	t.Run("ignores first-party tokens", func(t *testing.T) {
+		mc := minimock.NewController(t)
+		mockRepo := service.NewOAuthRepositoryMock(mc)
+		mockValidator := service.NewTokenValidatorMock(mc)
+		mockValidator.ValidateJWTMock.Return(claims(""), nil)
+
+		require.NoError(t, service.NewOAuthService(mockRepo, mockValidator, nil, nil, nil).Revoke(ctx, client, "token"))
+	})
+
+	t.Run("ignores invalid tokens", func(t *testing.T) {
+		mc := minimock.NewController(t)
+		mockRepo := service.NewOAuthRepositoryMock(mc)
+		mockValidator := service.NewTokenValidatorMock(mc)
+		mockValidator.ValidateJWTMock.Return(nil, apperrors.ErrInvalidToken)
+
+		require.NoError(t, service.NewOAuthService(mockRepo, mockValidator, nil, nil, nil).Revoke(ctx, client, "token"))
+	})
+}
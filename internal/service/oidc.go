@@ -0,0 +1,262 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/alonsoF100/authorization-service/internal/apperrors"
+	"github.com/alonsoF100/authorization-service/internal/models"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// GenerateIDToken signs an OpenID Connect ID token for the user the code
+// was issued to. ID tokens are verified by relying parties through the
+// published keys, so they are never signed with the HS256 secret.
+func (s AuthService) GenerateIDToken(user *models.User, code *models.AuthorizationCode, accessToken string) (string, error) {
+	const op = "service/oidc.go/GenerateIDToken"
+
+	key := s.activeKey()
+	if key == nil {
+		slog.Error("ID token requested without a signing key",
+			slog.String("op", op),
+			slog.String("client_id", code.ClientID),
+		)
+		return "", apperrors.ErrNoSigningKey
+	}
+
+	now := time.Now()
+	info := scopedUserInfo(user, code.Scope)
+	claims := models.IDTokenClaims{
+		Nonce:         code.Nonce,
+		AtHash:        accessTokenHash(accessToken),
+		Nickname:      info.Nickname,
+		Email:         info.Email,
+		EmailVerified: info.EmailVerified,
+		TokenUse:      models.TokenUseID,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    s.cfg.OAuth.Issuer,
+			Subject:   user.ID,
+			Audience:  jwt.ClaimStrings{code.ClientID},
+			ExpiresAt: jwt.NewNumericDate(now.Add(s.cfg.JWT.Expiry)),
+			IssuedAt:  jwt.NewNumericDate(now),
+		},
+	}
+	if !code.AuthTime.IsZero() {
+		claims.AuthTime = jwt.NewNumericDate(code.AuthTime)
+	}
+
+	jwtToken := jwt.NewWithClaims(jwt.SigningMethodES256, claims)
+	jwtToken.Header["kid"] = key.ID
+	idToken, err := jwtToken.SignedString(key.PrivateKey)
+	if err != nil {
+		slog.Error("Failed to sign ID token",
+			slog.String("op", op),
+			slog.String("user_id", user.ID),
+			slog.String("error", err.Error()),
+		)
+		return "", err
+	}
+
+	return idToken, nil
+}
+
+// ParseIDToken checks the signature and issuer of an ID token we issued.
+// Expiry is not checked, an expired ID token is still a valid logout hint.
+func (s AuthService) ParseIDToken(tokenString string) (*models.IDTokenClaims, error) {
+	const op = "service/oidc.go/ParseIDToken"
+
+	var claims models.IDTokenClaims
+	_, err := jwt.ParseWithClaims(tokenString, &claims, func(t *jwt.Token) (any, error) {
+		kid, _ := t.Header["kid"].(string)
+		if key := s.findKey(kid); key != nil {
+			return &key.PrivateKey.PublicKey, nil
+		}
+		return nil, apperrors.ErrInvalidToken
+	},
+		jwt.WithValidMethods([]string{jwt.SigningMethodES256.Alg()}),
+		jwt.WithoutClaimsValidation(),
+	)
+	if err == nil && claims.Issuer != s.cfg.OAuth.Issuer {
+		err = fmt.Errorf("unexpected issuer %q", claims.Issuer)
+	}
+	if err == nil && claims.TokenUse == models.TokenUseAccess {
+		err = errors.New("access token used as an ID token")
+	}
+	if err != nil {
+		slog.Debug("ID token validation failed",
+			slog.String("op", op),
+			slog.String("error", err.Error()),
+		)
+		return nil, apperrors.ErrInvalidToken
+	}
+
+	return &claims, nil
+}
+
+// PublicKeys returns the keys relying parties verify ID tokens with
+func (s AuthService) PublicKeys() []models.PublicKey {
+	if s.signingKeys == nil {
+		return nil
+	}
+
+	return s.signingKeys.PublicKeys()
+}
+
+// UserInfo returns the claims about the owner of an access token that its
+// scope allows. The token must be active and carry the openid scope.
+func (s OAuthService) UserInfo(ctx context.Context, accessToken string) (*models.UserInfo, error) {
+	const op = "service/oidc.go/UserInfo"
+
+	claims, err := s.Introspect(ctx, accessToken)
+	if err != nil {
+		return nil, err
+	}
+	if claims.ID == "" {
+		return nil, apperrors.ErrInvalidToken
+	}
+	if !slices.Contains(claims.Scopes(), models.ScopeOpenID) {
+		return nil, apperrors.ErrMissingScope
+	}
+
+	user, err := s.oauthRepository.FindByID(ctx, claims.ID)
+	if err != nil {
+		slog.Error("Database error during userinfo request",
+			slog.String("op", op),
+			slog.String("user_id", claims.ID),
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if user == nil {
+		return nil, apperrors.ErrInvalidToken
+	}
+
+	info := scopedUserInfo(user, claims.Scope)
+	return &info, nil
+}
+
+// ValidateLogout checks an RP-initiated logout request. The client comes
+// from client_id or the audience of the ID token hint, a post logout
+// redirect uri must be registered for it.
+func (s OAuthService) ValidateLogout(ctx context.Context, idTokenHint, clientID, postLogoutRedirectURI string) error {
+	const op = "service/oidc.go/ValidateLogout"
+
+	if idTokenHint != "" {
+		claims, err := s.tokenIssuer.ParseIDToken(idTokenHint)
+		if err != nil {
+			return apperrors.ErrInvalidToken
+		}
+
+		switch {
+		case clientID == "" && len(claims.Audience) > 0:
+			clientID = claims.Audience[0]
+		case clientID != "" && !slices.Contains(claims.Audience, clientID):
+			slog.Info("Logout rejected: ID token hint issued to another client",
+				slog.String("op", op),
+				slog.String("client_id", clientID),
+			)
+			return apperrors.ErrInvalidToken
+		}
+	}
+
+	if postLogoutRedirectURI == "" {
+		return nil
+	}
+	if clientID == "" {
+		return apperrors.ErrInvalidRedirectURI
+	}
+
+	client, err := s.FindClient(ctx, clientID)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if !client.AllowsPostLogoutRedirect(postLogoutRedirectURI) {
+		slog.Info("Logout rejected: unregistered post logout redirect uri",
+			slog.String("op", op),
+			slog.String("client_id", client.ID),
+			slog.String("redirect_uri", postLogoutRedirectURI),
+		)
+		return apperrors.ErrInvalidRedirectURI
+	}
+
+	return nil
+}
+
+// EndSession revokes the session token of the authorize page and signs
+// out its session. An invalid or expired session is already over, that is
+// not an error.
+func (s OAuthService) EndSession(ctx context.Context, sessionToken string) error {
+	const op = "service/oidc.go/EndSession"
+
+	claims, err := s.tokenIssuer.ValidateSessionToken(ctx, sessionToken)
+	if err != nil || claims.RegisteredClaims.ID == "" || claims.ExpiresAt == nil {
+		return nil
+	}
+
+	if err := s.oauthRepository.RevokeToken(ctx, claims.RegisteredClaims.ID, claims.ExpiresAt.Time); err != nil {
+		slog.Error("Database error during logout",
+			slog.String("op", op),
+			slog.String("user_id", claims.ID),
+			slog.String("error", err.Error()),
+		)
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if claims.SessionID != "" {
+		err := s.oauthRepository.DeleteSession(ctx, claims.ID, claims.SessionID)
+		if err != nil && !errors.Is(err, apperrors.ErrSessionNotFound) {
+			slog.Error("Database error during logout",
+				slog.String("op", op),
+				slog.String("user_id", claims.ID),
+				slog.String("error", err.Error()),
+			)
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	slog.Info("Session ended",
+		slog.String("op", op),
+		slog.String("user_id", claims.ID),
+	)
+	record(ctx, s.auditor, models.AuditEvent{
+		Type:      models.AuditLogout,
+		ActorType: string(claims.Principal()),
+		ActorID:   claims.ID,
+		TargetID:  claims.ID,
+		Details:   map[string]string{"session_id": claims.SessionID},
+	})
+
+	return nil
+}
+
+// scopedUserInfo keeps the claims the scope grants, profile gives the
+// nickname and email the address. Addresses are not verified yet.
+func scopedUserInfo(user *models.User, scope string) models.UserInfo {
+	info := models.UserInfo{Subject: user.ID}
+
+	scopes := strings.Fields(scope)
+	if slices.Contains(scopes, models.ScopeProfile) {
+		info.Nickname = user.Nickname
+	}
+	if slices.Contains(scopes, models.ScopeEmail) {
+		verified := false
+		info.Email = user.Email
+		info.EmailVerified = &verified
+	}
+
+	return info
+}
+
+// accessTokenHash is the at_hash claim, the left half of the SHA-256 of the
+// access token (OpenID Connect Core 3.1.3.6)
+func accessTokenHash(accessToken string) string {
+	sum := sha256.Sum256([]byte(accessToken))
+	return base64.RawURLEncoding.EncodeToString(sum[:len(sum)/2])
+}
@@ -0,0 +1,333 @@
+package service_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"github.com/alonsoF100/authorization-service/internal/apperrors"
+	"github.com/alonsoF100/authorization-service/internal/config"
+	"github.com/alonsoF100/authorization-service/internal/models"
+	"github.com/alonsoF100/authorization-service/internal/service"
+	"github.com/gojuno/minimock/v3"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+)
+
+func oidcConfig() *config.Config {
+	return &config.Config{
+		JWT:   config.JWTConfig{Expiry: time.Hour},
+		OAuth: config.OAuthConfig{Issuer: "https://auth.example.com", CodeTTL: time.Minute},
+	}
+}
+
+func loadedKeyService(t *testing.T, keys ...models.SigningKey) *service.KeyService {
+	mc := minimock.NewController(t)
+	mockRepo := service.NewKeyRepositoryMock(mc)
+	mockRepo.ListSigningKeysMock.Return(keys, nil)
+
+	keyService := service.NewKeyService(mockRepo)
+	require.NoError(t, keyService.Load(context.Background()))
+
+	return keyService
+}
+
+func TestGenerateIDToken(t *testing.T) {
+	key := newSigningKey(t, time.Now())
+	authService := service.NewAuthService(nil, loadedKeyService(t, key), nil, oidcConfig())
+
+	user := &models.User{ID: "user123", Nickname: "alonsoF100", Email: "alonso@yandex.ru"}
+	authTime := time.Now().Add(-time.Minute).Truncate(time.Second)
+	code := &models.AuthorizationCode{
+		ClientID: "client123",
+		Scope:    "openid email",
+		Nonce:    "n-0S6_WzA2Mj",
+		AuthTime: authTime,
+	}
+
+	idToken, err := authService.GenerateIDToken(user, code, "access")
+	require.NoError(t, err)
+
+	claims, err := authService.ParseIDToken(idToken)
+	require.NoError(t, err)
+	require.Equal(t, "https://auth.example.com", claims.Issuer)
+	require.Equal(t, "user123", claims.Subject)
+	require.Equal(t, jwt.ClaimStrings{"client123"}, claims.Audience)
+	require.Equal(t, code.Nonce, claims.Nonce)
+	require.Equal(t, authTime, claims.AuthTime.Time)
+
+	sum := sha256.Sum256([]byte("access"))
+	require.Equal(t, base64.RawURLEncoding.EncodeToString(sum[:16]), claims.AtHash)
+
+	// only the email scope was granted
+	require.Empty(t, claims.Nickname)
+	require.Equal(t, user.Email, claims.Email)
+	require.NotNil(t, claims.EmailVerified)
+	require.False(t, *claims.EmailVerified)
+
+	publicKeys := authService.PublicKeys()
+	require.Len(t, publicKeys, 1)
+	require.Equal(t, key.ID, publicKeys[0].ID)
+	require.True(t, key.PrivateKey.PublicKey.Equal(publicKeys[0].Key))
+
+	otherIssuer := oidcConfig()
+	otherIssuer.OAuth.Issuer = "https://other.example.com"
+	_, err = service.NewAuthService(nil, loadedKeyService(t, key), nil, otherIssuer).ParseIDToken(idToken)
+	require.ErrorIs(t, err, apperrors.ErrInvalidToken)
+
+	_, err = service.NewAuthService(nil, loadedKeyService(t), nil, oidcConfig()).ParseIDToken(idToken)
+	require.ErrorIs(t, err, apperrors.ErrInvalidToken)
+}
+
+func TestGenerateIDTokenWithoutSigningKey(t *testing.T) {
+	authService := service.NewAuthService(nil, nil, nil, oidcConfig())
+
+	_, err := authService.GenerateIDToken(&models.User{ID: "user123"}, &models.AuthorizationCode{}, "access")
+	require.ErrorIs(t, err, apperrors.ErrNoSigningKey)
+	require.Empty(t, authService.PublicKeys())
+}
+
+func TestOAuthServiceExchangeOpenIDCode(t *testing.T) {
+	ctx := context.Background()
+	mc := minimock.NewController(t)
+	mockRepo := service.NewOAuthRepositoryMock(mc)
+	mockIssuer := service.NewTokenIssuerMock(mc)
+
+	verifier := "dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk"
+	user := &models.User{ID: "user123"}
+	client := &models.Client{
+		ID:           "client123",
+		RedirectURIs: []string{"https://app.example.com/callback"},
+		GrantTypes:   []string{models.GrantAuthorizationCode},
+	}
+
+	var stored *models.AuthorizationCode
+	mockRepo.CreateAuthorizationCodeMock.Set(func(ctx context.Context, code *models.AuthorizationCode) error {
+		stored = code
+		return nil
+	})
+	mockRepo.ConsumeAuthorizationCodeMock.Set(func(ctx context.Context, codeHash string) (*models.AuthorizationCode, error) {
+		return stored, nil
+	})
+	mockRepo.FindByIDMock.Expect(ctx, "user123").Return(user, nil)
+	mockIssuer.GenerateScopedJWTMock.Expect(user, "client123", "openid profile", "").Return("access", nil)
+	mockIssuer.GenerateIDTokenMock.Set(func(u *models.User, code *models.AuthorizationCode, accessToken string) (string, error) {
+		require.Equal(t, "nonce", code.Nonce)
+		require.Equal(t, "access", accessToken)
+		return "id", nil
+	})
+
+	oauthService := service.NewOAuthService(mockRepo, nil, mockIssuer, nil, oidcConfig())
+
+	code, err := oauthService.IssueAuthorizationCode(ctx, &models.AuthorizationCode{
+		ClientID:      "client123",
+		UserID:        "user123",
+		RedirectURI:   "https://app.example.com/callback",
+		Scope:         "openid profile",
+		CodeChallenge: codeChallenge(verifier),
+		Nonce:         "nonce",
+	})
+	require.NoError(t, err)
+
+	token, err := oauthService.ExchangeAuthorizationCode(ctx, client, code, "https://app.example.com/callback", verifier)
+	require.NoError(t, err)
+	require.Equal(t, "access", token.AccessToken)
+	require.Equal(t, "id", token.IDToken)
+}
+
+func TestOAuthServiceUserInfo(t *testing.T) {
+	ctx := context.Background()
+	user := &models.User{ID: "user123", Nickname: "alonsoF100", Email: "alonso@yandex.ru"}
+
+	claims := func(userID, scope string) *models.Claims {
+		return &models.Claims{
+			ID:       userID,
+			ClientID: "client123",
+			Scope:    scope,
+			RegisteredClaims: jwt.RegisteredClaims{
+				ID: "jti123",
+			},
+		}
+	}
+
+	tests := []struct {
+		name        string
+		claims      *models.Claims
+		expected    *models.UserInfo
+		expectedErr error
+	}{
+		{
+			name:     "profile scope",
+			claims:   claims("user123", "openid profile"),
+			expected: &models.UserInfo{Subject: "user123", Nickname: "alonsoF100"},
+		},
+		{
+			name:        "without openid scope",
+			claims:      claims("user123", "profile"),
+			expectedErr: apperrors.ErrMissingScope,
+		},
+		{
+			name:        "client token",
+			claims:      claims("", "openid"),
+			expectedErr: apperrors.ErrInvalidToken,
+		},
+		{
+			name:        "invalid token",
+			expectedErr: apperrors.ErrInvalidToken,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := minimock.NewController(t)
+			mockRepo := service.NewOAuthRepositoryMock(mc)
+			mockValidator := service.NewTokenValidatorMock(mc)
+
+			if tt.claims == nil {
+				mockValidator.ValidateJWTMock.Return(nil, apperrors.ErrInvalidToken)
+			} else {
+				mockValidator.ValidateJWTMock.Return(tt.claims, nil)
+				mockRepo.FindByIDMock.Optional().Return(user, nil)
+				mockRepo.FindClientByIDMock.Optional().Return(&models.Client{ID: "client123"}, nil)
+			}
+
+			info, err := service.NewOAuthService(mockRepo, mockValidator, nil, nil, nil).UserInfo(ctx, "token")
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+				require.Nil(t, info)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, info)
+		})
+	}
+}
+
+func TestOAuthServiceValidateLogout(t *testing.T) {
+	ctx := context.Background()
+	client := &models.Client{
+		ID:                     "client123",
+		PostLogoutRedirectURIs: []string{"https://app.example.com"},
+	}
+	hint := &models.IDTokenClaims{
+		RegisteredClaims: jwt.RegisteredClaims{Audience: jwt.ClaimStrings{"client123"}},
+	}
+
+	tests := []struct {
+		name        string
+		idTokenHint string
+		clientID    string
+		redirectURI string
+		expectedErr error
+	}{
+		{
+			name: "plain logout",
+		},
+		{
+			name:        "client from the hint",
+			idTokenHint: "hint",
+			redirectURI: "https://app.example.com",
+		},
+		{
+			name:        "client id",
+			clientID:    "client123",
+			redirectURI: "https://app.example.com",
+		},
+		{
+			name:        "unregistered redirect uri",
+			clientID:    "client123",
+			redirectURI: "https://evil.example.com",
+			expectedErr: apperrors.ErrInvalidRedirectURI,
+		},
+		{
+			name:        "redirect uri without client",
+			redirectURI: "https://app.example.com",
+			expectedErr: apperrors.ErrInvalidRedirectURI,
+		},
+		{
+			name:        "hint issued to another client",
+			idTokenHint: "hint",
+			clientID:    "client456",
+			expectedErr: apperrors.ErrInvalidToken,
+		},
+		{
+			name:        "invalid hint",
+			idTokenHint: "invalid",
+			expectedErr: apperrors.ErrInvalidToken,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := minimock.NewController(t)
+			mockRepo := service.NewOAuthRepositoryMock(mc)
+			mockIssuer := service.NewTokenIssuerMock(mc)
+
+			mockIssuer.ParseIDTokenMock.Optional().Set(func(tokenString string) (*models.IDTokenClaims, error) {
+				if tokenString != "hint" {
+					return nil, apperrors.ErrInvalidToken
+				}
+				return hint, nil
+			})
+			mockRepo.FindClientByIDMock.Optional().Expect(ctx, "client123").Return(client, nil)
+
+			err := service.NewOAuthService(mockRepo, nil, mockIssuer, nil, nil).
+				ValidateLogout(ctx, tt.idTokenHint, tt.clientID, tt.redirectURI)
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestOAuthServiceEndSession(t *testing.T) {
+	ctx := context.Background()
+	expiresAt := time.Now().Add(time.Hour).Truncate(time.Second)
+
+	t.Run("revokes the session token", func(t *testing.T) {
+		mc := minimock.NewController(t)
+		mockRepo := service.NewOAuthRepositoryMock(mc)
+		mockIssuer := service.NewTokenIssuerMock(mc)
+		mockIssuer.ValidateSessionTokenMock.Return(&models.Claims{
+			ID: "user123",
+			RegisteredClaims: jwt.RegisteredClaims{
+				ID:        "jti123",
+				ExpiresAt: jwt.NewNumericDate(expiresAt),
+			},
+		}, nil)
+		mockRepo.RevokeTokenMock.Expect(ctx, "jti123", expiresAt).Return(nil)
+
+		require.NoError(t, service.NewOAuthService(mockRepo, nil, mockIssuer, nil, nil).EndSession(ctx, "session"))
+	})
+
+	t.Run("signs out the session", func(t *testing.T) {
+		mc := minimock.NewController(t)
+		mockRepo := service.NewOAuthRepositoryMock(mc)
+		mockIssuer := service.NewTokenIssuerMock(mc)
+		mockIssuer.ValidateSessionTokenMock.Return(&models.Claims{
+			ID:        "user123",
+			SessionID: "session123",
+			RegisteredClaims: jwt.RegisteredClaims{
+				ID:        "jti123",
+				ExpiresAt: jwt.NewNumericDate(expiresAt),
+			},
+		}, nil)
+		mockRepo.RevokeTokenMock.Expect(ctx, "jti123", expiresAt).Return(nil)
+		mockRepo.DeleteSessionMock.Expect(ctx, "user123", "session123").Return(apperrors.ErrSessionNotFound)
+
+		require.NoError(t, service.NewOAuthService(mockRepo, nil, mockIssuer, nil, nil).EndSession(ctx, "session"))
+	})
+
+	t.Run("ignores invalid sessions", func(t *testing.T) {
+		mc := minimock.NewController(t)
+		mockIssuer := service.NewTokenIssuerMock(mc)
+		mockIssuer.ValidateSessionTokenMock.Return(nil, apperrors.ErrInvalidToken)
+
+		require.NoError(t, service.NewOAuthService(nil, nil, mockIssuer, nil, nil).EndSession(ctx, "expired"))
+	})
+}
@@ -0,0 +1,194 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/alonsoF100/authorization-service/internal/config"
+	"github.com/alonsoF100/authorization-service/internal/models"
+)
+
+// how often published events past the retention are deleted
+const outboxPurgeInterval = time.Hour
+
+// OutboxWriter stores events in the transaction of the change they describe.
+// Repository calls made with the ctx passed to fn join the transaction, fn
+// runs again when the transaction is retried.
+type OutboxWriter interface {
+	InTx(ctx context.Context, fn func(ctx context.Context) error) error
+	CreateOutboxEvent(ctx context.Context, event *models.OutboxEvent) error
+}
+
+type OutboxRepository interface {
+	ClaimOutboxEvents(ctx context.Context, now, claimedUntil time.Time, limit int) ([]models.OutboxEvent, error)
+	MarkOutboxEventPublished(ctx context.Context, id int64, publishedAt time.Time) error
+	RetryOutboxEvent(ctx context.Context, id int64, nextAttemptAt time.Time, lastError string) error
+	PurgeOutboxEvents(ctx context.Context, publishedBefore time.Time) (int64, error)
+}
+
+// EventPublisher delivers outbox events. Delivery is at least once, an event
+// is published again when the relay stops before recording the success, so
+// consumers should skip event ids they have seen.
+type EventPublisher interface {
+	Publish(ctx context.Context, event models.OutboxEvent) error
+}
+
+// OutboxRelay publishes pending events, events of one user in the order they
+// were written. A failed event holds back the later events of its user
+// until it is delivered.
+type OutboxRelay struct {
+	repository OutboxRepository
+	publisher  EventPublisher
+	cfg        config.OutboxConfig
+}
+
+func NewOutboxRelay(repository OutboxRepository, publisher EventPublisher, cfg config.OutboxConfig) *OutboxRelay {
+	return &OutboxRelay{
+		repository: repository,
+		publisher:  publisher,
+		cfg:        cfg,
+	}
+}
+
+// Run publishes pending events every poll interval and purges the published
+// ones past the retention. It blocks until ctx is done.
+func (r *OutboxRelay) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.cfg.PollInterval)
+	defer ticker.Stop()
+
+	var purgedAt time.Time
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		// a full batch means more events are waiting
+		for {
+			claimed, err := r.Relay(ctx)
+			if err != nil || claimed < r.cfg.BatchSize {
+				break
+			}
+		}
+
+		if r.cfg.Retention > 0 && time.Since(purgedAt) >= outboxPurgeInterval {
+			r.purge(ctx)
+			purgedAt = time.Now()
+		}
+	}
+}
+
+// Relay claims a batch of due events and publishes them, it returns how many
+// were claimed
+func (r *OutboxRelay) Relay(ctx context.Context) (int, error) {
+	const op = "service/outbox.go/Relay"
+
+	now := time.Now()
+	events, err := r.repository.ClaimOutboxEvents(ctx, now, now.Add(r.cfg.Lease), r.cfg.BatchSize)
+	if err != nil {
+		if !errors.Is(err, context.Canceled) {
+			slog.Error("Failed to claim outbox events",
+				slog.String("op", op),
+				slog.String("error", err.Error()),
+			)
+		}
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	for _, event := range events {
+		// past the lease another relay may have claimed the event, it is
+		// left to the next claim
+		if time.Now().After(event.NextAttemptAt) {
+			continue
+		}
+
+		r.publish(ctx, event)
+	}
+
+	return len(events), nil
+}
+
+func (r *OutboxRelay) publish(ctx context.Context, event models.OutboxEvent) {
+	const op = "service/outbox.go/publish"
+
+	err := r.publisher.Publish(ctx, event)
+	if err == nil {
+		if err := r.repository.MarkOutboxEventPublished(ctx, event.ID, time.Now()); err != nil {
+			slog.Error("Failed to mark outbox event published, it will be delivered again",
+				slog.String("op", op),
+				slog.Int64("event_id", event.ID),
+				slog.String("error", err.Error()),
+			)
+		}
+		return
+	}
+
+	delay := r.backoff(event.Attempts)
+	slog.Warn("Failed to publish outbox event",
+		slog.String("op", op),
+		slog.Int64("event_id", event.ID),
+		slog.String("type", event.Type),
+		slog.Int("attempt", event.Attempts),
+		slog.Duration("retry_in", delay),
+		slog.String("error", err.Error()),
+	)
+
+	if err := r.repository.RetryOutboxEvent(ctx, event.ID, time.Now().Add(delay), err.Error()); err != nil {
+		slog.Error("Failed to schedule outbox event retry",
+			slog.String("op", op),
+			slog.Int64("event_id", event.ID),
+			slog.String("error", err.Error()),
+		)
+	}
+}
+
+// backoff doubles the delay after every failed attempt
+func (r *OutboxRelay) backoff(attempts int) time.Duration {
+	delay := r.cfg.MinBackoff
+	for i := 1; i < attempts && delay < r.cfg.MaxBackoff; i++ {
+		delay *= 2
+	}
+
+	return min(delay, r.cfg.MaxBackoff)
+}
+
+func (r *OutboxRelay) purge(ctx context.Context) {
+	const op = "service/outbox.go/purge"
+
+	purged, err := r.repository.PurgeOutboxEvents(ctx, time.Now().Add(-r.cfg.Retention))
+	if err != nil {
+		if !errors.Is(err, context.Canceled) {
+			slog.Error("Failed to purge published outbox events",
+				slog.String("op", op),
+				slog.String("error", err.Error()),
+			)
+		}
+		return
+	}
+
+	if purged > 0 {
+		slog.Debug("Published outbox events purged",
+			slog.String("op", op),
+			slog.Int64("count", purged),
+		)
+	}
+}
+
+func newOutboxEvent(eventType, userID string, payload map[string]string) *models.OutboxEvent {
+	// a map of strings always encodes
+	encoded, _ := json.Marshal(payload)
+
+	now := time.Now()
+	return &models.OutboxEvent{
+		Type:          eventType,
+		AggregateID:   userID,
+		Payload:       encoded,
+		CreatedAt:     now,
+		NextAttemptAt: now,
+	}
+}
@@ -0,0 +1,1640 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package service
+
+//go:generate minimock -i github.com/alonsoF100/authorization-service/internal/service.OutboxRepository -o outbox_repository_mock_test.go -n OutboxRepositoryMock -p service
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	"time"
+	mm_time "time"
+
+	"github.com/alonsoF100/authorization-service/internal/models"
+	"github.com/gojuno/minimock/v3"
+)
+
+// OutboxRepositoryMock implements OutboxRepository
+type OutboxRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcClaimOutboxEvents          func(ctx context.Context, now time.Time, claimedUntil time.Time, limit int) (oa1 []models.OutboxEvent, err error)
+	funcClaimOutboxEventsOrigin    string
+	inspectFuncClaimOutboxEvents   func(ctx context.Context, now time.Time, claimedUntil time.Time, limit int)
+	afterClaimOutboxEventsCounter  uint64
+	beforeClaimOutboxEventsCounter uint64
+	ClaimOutboxEventsMock          mOutboxRepositoryMockClaimOutboxEvents
+
+	funcMarkOutboxEventPublished          func(ctx context.Context, id int64, publishedAt time.Time) (err error)
+	funcMarkOutboxEventPublishedOrigin    string
+	inspectFuncMarkOutboxEventPublished   func(ctx context.Context, id int64, publishedAt time.Time)
+	afterMarkOutboxEventPublishedCounter  uint64
+	beforeMarkOutboxEventPublishedCounter uint64
+	MarkOutboxEventPublishedMock          mOutboxRepositoryMockMarkOutboxEventPublished
+
+	funcPurgeOutboxEvents          func(ctx context.Context, publishedBefore time.Time) (i1 int64, err error)
+	funcPurgeOutboxEventsOrigin    string
+	inspectFuncPurgeOutboxEvents   func(ctx context.Context, publishedBefore time.Time)
+	afterPurgeOutboxEventsCounter  uint64
+	beforePurgeOutboxEventsCounter uint64
+	PurgeOutboxEventsMock          mOutboxRepositoryMockPurgeOutboxEvents
+
+	funcRetryOutboxEvent          func(ctx context.Context, id int64, nextAttemptAt time.Time, lastError string) (err error)
+	funcRetryOutboxEventOrigin    string
+	inspectFuncRetryOutboxEvent   func(ctx context.Context, id int64, nextAttemptAt time.Time, lastError string)
+	afterRetryOutboxEventCounter  uint64
+	beforeRetryOutboxEventCounter uint64
+	RetryOutboxEventMock          mOutboxRepositoryMockRetryOutboxEvent
+}
+
+// NewOutboxRepositoryMock returns a mock for OutboxRepository
+func NewOutboxRepositoryMock(t minimock.Tester) *OutboxRepositoryMock {
+	m := &OutboxRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.ClaimOutboxEventsMock = mOutboxRepositoryMockClaimOutboxEvents{mock: m}
+	m.ClaimOutboxEventsMock.callArgs = []*OutboxRepositoryMockClaimOutboxEventsParams{}
+
+	m.MarkOutboxEventPublishedMock = mOutboxRepositoryMockMarkOutboxEventPublished{mock: m}
+	m.MarkOutboxEventPublishedMock.callArgs = []*OutboxRepositoryMockMarkOutboxEventPublishedParams{}
+
+	m.PurgeOutboxEventsMock = mOutboxRepositoryMockPurgeOutboxEvents{mock: m}
+	m.PurgeOutboxEventsMock.callArgs = []*OutboxRepositoryMockPurgeOutboxEventsParams{}
+
+	m.RetryOutboxEventMock = mOutboxRepositoryMockRetryOutboxEvent{mock: m}
+	m.RetryOutboxEventMock.callArgs = []*OutboxRepositoryMockRetryOutboxEventParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mOutboxRepositoryMockClaimOutboxEvents struct {
+	optional           bool
+	mock               *OutboxRepositoryMock
+	defaultExpectation *OutboxRepositoryMockClaimOutboxEventsExpectation
+	expectations       []*OutboxRepositoryMockClaimOutboxEventsExpectation
+
+	callArgs []*OutboxRepositoryMockClaimOutboxEventsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OutboxRepositoryMockClaimOutboxEventsExpectation specifies expectation struct of the OutboxRepository.ClaimOutboxEvents
+type OutboxRepositoryMockClaimOutboxEventsExpectation struct {
+	mock               *OutboxRepositoryMock
+	params             *OutboxRepositoryMockClaimOutboxEventsParams
+	paramPtrs          *OutboxRepositoryMockClaimOutboxEventsParamPtrs
+	expectationOrigins OutboxRepositoryMockClaimOutboxEventsExpectationOrigins
+	results            *OutboxRepositoryMockClaimOutboxEventsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// OutboxRepositoryMockClaimOutboxEventsParams contains parameters of the OutboxRepository.ClaimOutboxEvents
+type OutboxRepositoryMockClaimOutboxEventsParams struct {
+	ctx          context.Context
+	now          time.Time
+	claimedUntil time.Time
+	limit        int
+}
+
+// OutboxRepositoryMockClaimOutboxEventsParamPtrs contains pointers to parameters of the OutboxRepository.ClaimOutboxEvents
+type OutboxRepositoryMockClaimOutboxEventsParamPtrs struct {
+	ctx          *context.Context
+	now          *time.Time
+	claimedUntil *time.Time
+	limit        *int
+}
+
+// OutboxRepositoryMockClaimOutboxEventsResults contains results of the OutboxRepository.ClaimOutboxEvents
+type OutboxRepositoryMockClaimOutboxEventsResults struct {
+	oa1 []models.OutboxEvent
+	err error
+}
+
+// OutboxRepositoryMockClaimOutboxEventsOrigins contains origins of expectations of the OutboxRepository.ClaimOutboxEvents
+type OutboxRepositoryMockClaimOutboxEventsExpectationOrigins struct {
+	origin             string
+	originCtx          string
+	originNow          string
+	originClaimedUntil string
+	originLimit        string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmClaimOutboxEvents *mOutboxRepositoryMockClaimOutboxEvents) Optional() *mOutboxRepositoryMockClaimOutboxEvents {
+	mmClaimOutboxEvents.optional = true
+	return mmClaimOutboxEvents
+}
+
+// Expect sets up expected params for OutboxRepository.ClaimOutboxEvents
+func (mmClaimOutboxEvents *mOutboxRepositoryMockClaimOutboxEvents) Expect(ctx context.Context, now time.Time, claimedUntil time.Time, limit int) *mOutboxRepositoryMockClaimOutboxEvents {
+	if mmClaimOutboxEvents.mock.funcClaimOutboxEvents != nil {
+		mmClaimOutboxEvents.mock.t.Fatalf("OutboxRepositoryMock.ClaimOutboxEvents mock is already set by Set")
+	}
+
+	if mmClaimOutboxEvents.defaultExpectation == nil {
+		mmClaimOutboxEvents.defaultExpectation = &OutboxRepositoryMockClaimOutboxEventsExpectation{}
+	}
+
+	if mmClaimOutboxEvents.defaultExpectation.paramPtrs != nil {
+		mmClaimOutboxEvents.mock.t.Fatalf("OutboxRepositoryMock.ClaimOutboxEvents mock is already set by ExpectParams functions")
+	}
+
+	mmClaimOutboxEvents.defaultExpectation.params = &OutboxRepositoryMockClaimOutboxEventsParams{ctx, now, claimedUntil, limit}
+	mmClaimOutboxEvents.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmClaimOutboxEvents.expectations {
+		if minimock.Equal(e.params, mmClaimOutboxEvents.defaultExpectation.params) {
+			mmClaimOutboxEvents.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmClaimOutboxEvents.defaultExpectation.params)
+		}
+	}
+
+	return mmClaimOutboxEvents
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OutboxRepository.ClaimOutboxEvents
+func (mmClaimOutboxEvents *mOutboxRepositoryMockClaimOutboxEvents) ExpectCtxParam1(ctx context.Context) *mOutboxRepositoryMockClaimOutboxEvents {
+	if mmClaimOutboxEvents.mock.funcClaimOutboxEvents != nil {
+		mmClaimOutboxEvents.mock.t.Fatalf("OutboxRepositoryMock.ClaimOutboxEvents mock is already set by Set")
+	}
+
+	if mmClaimOutboxEvents.defaultExpectation == nil {
+		mmClaimOutboxEvents.defaultExpectation = &OutboxRepositoryMockClaimOutboxEventsExpectation{}
+	}
+
+	if mmClaimOutboxEvents.defaultExpectation.params != nil {
+		mmClaimOutboxEvents.mock.t.Fatalf("OutboxRepositoryMock.ClaimOutboxEvents mock is already set by Expect")
+	}
+
+	if mmClaimOutboxEvents.defaultExpectation.paramPtrs == nil {
+		mmClaimOutboxEvents.defaultExpectation.paramPtrs = &OutboxRepositoryMockClaimOutboxEventsParamPtrs{}
+	}
+	mmClaimOutboxEvents.defaultExpectation.paramPtrs.ctx = &ctx
+	mmClaimOutboxEvents.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmClaimOutboxEvents
+}
+
+// ExpectNowParam2 sets up expected param now for OutboxRepository.ClaimOutboxEvents
+func (mmClaimOutboxEvents *mOutboxRepositoryMockClaimOutboxEvents) ExpectNowParam2(now time.Time) *mOutboxRepositoryMockClaimOutboxEvents {
+	if mmClaimOutboxEvents.mock.funcClaimOutboxEvents != nil {
+		mmClaimOutboxEvents.mock.t.Fatalf("OutboxRepositoryMock.ClaimOutboxEvents mock is already set by Set")
+	}
+
+	if mmClaimOutboxEvents.defaultExpectation == nil {
+		mmClaimOutboxEvents.defaultExpectation = &OutboxRepositoryMockClaimOutboxEventsExpectation{}
+	}
+
+	if mmClaimOutboxEvents.defaultExpectation.params != nil {
+		mmClaimOutboxEvents.mock.t.Fatalf("OutboxRepositoryMock.ClaimOutboxEvents mock is already set by Expect")
+	}
+
+	if mmClaimOutboxEvents.defaultExpectation.paramPtrs == nil {
+		mmClaimOutboxEvents.defaultExpectation.paramPtrs = &OutboxRepositoryMockClaimOutboxEventsParamPtrs{}
+	}
+	mmClaimOutboxEvents.defaultExpectation.paramPtrs.now = &now
+	mmClaimOutboxEvents.defaultExpectation.expectationOrigins.originNow = minimock.CallerInfo(1)
+
+	return mmClaimOutboxEvents
+}
+
+// ExpectClaimedUntilParam3 sets up expected param claimedUntil for OutboxRepository.ClaimOutboxEvents
+func (mmClaimOutboxEvents *mOutboxRepositoryMockClaimOutboxEvents) ExpectClaimedUntilParam3(claimedUntil time.Time) *mOutboxRepositoryMockClaimOutboxEvents {
+	if mmClaimOutboxEvents.mock.funcClaimOutboxEvents != nil {
+		mmClaimOutboxEvents.mock.t.Fatalf("OutboxRepositoryMock.ClaimOutboxEvents mock is already set by Set")
+	}
+
+	if mmClaimOutboxEvents.defaultExpectation == nil {
+		mmClaimOutboxEvents.defaultExpectation = &OutboxRepositoryMockClaimOutboxEventsExpectation{}
+	}
+
+	if mmClaimOutboxEvents.defaultExpectation.params != nil {
+		mmClaimOutboxEvents.mock.t.Fatalf("OutboxRepositoryMock.ClaimOutboxEvents mock is already set by Expect")
+	}
+
+	if mmClaimOutboxEvents.defaultExpectation.paramPtrs == nil {
+		mmClaimOutboxEvents.defaultExpectation.paramPtrs = &OutboxRepositoryMockClaimOutboxEventsParamPtrs{}
+	}
+	mmClaimOutboxEvents.defaultExpectation.paramPtrs.claimedUntil = &claimedUntil
+	mmClaimOutboxEvents.defaultExpectation.expectationOrigins.originClaimedUntil = minimock.CallerInfo(1)
+
+	return mmClaimOutboxEvents
+}
+
+// ExpectLimitParam4 sets up expected param limit for OutboxRepository.ClaimOutboxEvents
+func (mmClaimOutboxEvents *mOutboxRepositoryMockClaimOutboxEvents) ExpectLimitParam4(limit int) *mOutboxRepositoryMockClaimOutboxEvents {
+	if mmClaimOutboxEvents.mock.funcClaimOutboxEvents != nil {
+		mmClaimOutboxEvents.mock.t.Fatalf("OutboxRepositoryMock.ClaimOutboxEvents mock is already set by Set")
+	}
+
+	if mmClaimOutboxEvents.defaultExpectation == nil {
+		mmClaimOutboxEvents.defaultExpectation = &OutboxRepositoryMockClaimOutboxEventsExpectation{}
+	}
+
+	if mmClaimOutboxEvents.defaultExpectation.params != nil {
+		mmClaimOutboxEvents.mock.t.Fatalf("OutboxRepositoryMock.ClaimOutboxEvents mock is already set by Expect")
+	}
+
+	if mmClaimOutboxEvents.defaultExpectation.paramPtrs == nil {
+		mmClaimOutboxEvents.defaultExpectation.paramPtrs = &OutboxRepositoryMockClaimOutboxEventsParamPtrs{}
+	}
+	mmClaimOutboxEvents.defaultExpectation.paramPtrs.limit = &limit
+	mmClaimOutboxEvents.defaultExpectation.expectationOrigins.originLimit = minimock.CallerInfo(1)
+
+	return mmClaimOutboxEvents
+}
+
+// Inspect accepts an inspector function that has same arguments as the OutboxRepository.ClaimOutboxEvents
+func (mmClaimOutboxEvents *mOutboxRepositoryMockClaimOutboxEvents) Inspect(f func(ctx context.Context, now time.Time, claimedUntil time.Time, limit int)) *mOutboxRepositoryMockClaimOutboxEvents {
+	if mmClaimOutboxEvents.mock.inspectFuncClaimOutboxEvents != nil {
+		mmClaimOutboxEvents.mock.t.Fatalf("Inspect function is already set for OutboxRepositoryMock.ClaimOutboxEvents")
+	}
+
+	mmClaimOutboxEvents.mock.inspectFuncClaimOutboxEvents = f
+
+	return mmClaimOutboxEvents
+}
+
+// Return sets up results that will be returned by OutboxRepository.ClaimOutboxEvents
+func (mmClaimOutboxEvents *mOutboxRepositoryMockClaimOutboxEvents) Return(oa1 []models.OutboxEvent, err error) *OutboxRepositoryMock {
+	if mmClaimOutboxEvents.mock.funcClaimOutboxEvents != nil {
+		mmClaimOutboxEvents.mock.t.Fatalf("OutboxRepositoryMock.ClaimOutboxEvents mock is already set by Set")
+	}
+
+	if mmClaimOutboxEvents.defaultExpectation == nil {
+		mmClaimOutboxEvents.defaultExpectation = &OutboxRepositoryMockClaimOutboxEventsExpectation{mock: mmClaimOutboxEvents.mock}
+	}
+	mmClaimOutboxEvents.defaultExpectation.results = &OutboxRepositoryMockClaimOutboxEventsResults{oa1, err}
+	mmClaimOutboxEvents.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmClaimOutboxEvents.mock
+}
+
+// Set uses given function f to mock the OutboxRepository.ClaimOutboxEvents method
+func (mmClaimOutboxEvents *mOutboxRepositoryMockClaimOutboxEvents) Set(f func(ctx context.Context, now time.Time, claimedUntil time.Time, limit int) (oa1 []models.OutboxEvent, err error)) *OutboxRepositoryMock {
+	if mmClaimOutboxEvents.defaultExpectation != nil {
+		mmClaimOutboxEvents.mock.t.Fatalf("Default expectation is already set for the OutboxRepository.ClaimOutboxEvents method")
+	}
+
+	if len(mmClaimOutboxEvents.expectations) > 0 {
+		mmClaimOutboxEvents.mock.t.Fatalf("Some expectations are already set for the OutboxRepository.ClaimOutboxEvents method")
+	}
+
+	mmClaimOutboxEvents.mock.funcClaimOutboxEvents = f
+	mmClaimOutboxEvents.mock.funcClaimOutboxEventsOrigin = minimock.CallerInfo(1)
+	return mmClaimOutboxEvents.mock
+}
+
+// When sets expectation for the OutboxRepository.ClaimOutboxEvents which will trigger the result defined by the following
+// Then helper
+func (mmClaimOutboxEvents *mOutboxRepositoryMockClaimOutboxEvents) When(ctx context.Context, now time.Time, claimedUntil time.Time, limit int) *OutboxRepositoryMockClaimOutboxEventsExpectation {
+	if mmClaimOutboxEvents.mock.funcClaimOutboxEvents != nil {
+		mmClaimOutboxEvents.mock.t.Fatalf("OutboxRepositoryMock.ClaimOutboxEvents mock is already set by Set")
+	}
+
+	expectation := &OutboxRepositoryMockClaimOutboxEventsExpectation{
+		mock:               mmClaimOutboxEvents.mock,
+		params:             &OutboxRepositoryMockClaimOutboxEventsParams{ctx, now, claimedUntil, limit},
+		expectationOrigins: OutboxRepositoryMockClaimOutboxEventsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmClaimOutboxEvents.expectations = append(mmClaimOutboxEvents.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OutboxRepository.ClaimOutboxEvents return parameters for the expectation previously defined by the When method
+func (e *OutboxRepositoryMockClaimOutboxEventsExpectation) Then(oa1 []models.OutboxEvent, err error) *OutboxRepositoryMock {
+	e.results = &OutboxRepositoryMockClaimOutboxEventsResults{oa1, err}
+	return e.mock
+}
+
+// Times sets number of times OutboxRepository.ClaimOutboxEvents should be invoked
+func (mmClaimOutboxEvents *mOutboxRepositoryMockClaimOutboxEvents) Times(n uint64) *mOutboxRepositoryMockClaimOutboxEvents {
+	if n == 0 {
+		mmClaimOutboxEvents.mock.t.Fatalf("Times of OutboxRepositoryMock.ClaimOutboxEvents mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmClaimOutboxEvents.expectedInvocations, n)
+	mmClaimOutboxEvents.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmClaimOutboxEvents
+}
+
+func (mmClaimOutboxEvents *mOutboxRepositoryMockClaimOutboxEvents) invocationsDone() bool {
+	if len(mmClaimOutboxEvents.expectations) == 0 && mmClaimOutboxEvents.defaultExpectation == nil && mmClaimOutboxEvents.mock.funcClaimOutboxEvents == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmClaimOutboxEvents.mock.afterClaimOutboxEventsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmClaimOutboxEvents.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ClaimOutboxEvents implements OutboxRepository
+func (mmClaimOutboxEvents *OutboxRepositoryMock) ClaimOutboxEvents(ctx context.Context, now time.Time, claimedUntil time.Time, limit int) (oa1 []models.OutboxEvent, err error) {
+	mm_atomic.AddUint64(&mmClaimOutboxEvents.beforeClaimOutboxEventsCounter, 1)
+	defer mm_atomic.AddUint64(&mmClaimOutboxEvents.afterClaimOutboxEventsCounter, 1)
+
+	mmClaimOutboxEvents.t.Helper()
+
+	if mmClaimOutboxEvents.inspectFuncClaimOutboxEvents != nil {
+		mmClaimOutboxEvents.inspectFuncClaimOutboxEvents(ctx, now, claimedUntil, limit)
+	}
+
+	mm_params := OutboxRepositoryMockClaimOutboxEventsParams{ctx, now, claimedUntil, limit}
+
+	// Record call args
+	mmClaimOutboxEvents.ClaimOutboxEventsMock.mutex.Lock()
+	mmClaimOutboxEvents.ClaimOutboxEventsMock.callArgs = append(mmClaimOutboxEvents.ClaimOutboxEventsMock.callArgs, &mm_params)
+	mmClaimOutboxEvents.ClaimOutboxEventsMock.mutex.Unlock()
+
+	for _, e := range mmClaimOutboxEvents.ClaimOutboxEventsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.oa1, e.results.err
+		}
+	}
+
+	if mmClaimOutboxEvents.ClaimOutboxEventsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmClaimOutboxEvents.ClaimOutboxEventsMock.defaultExpectation.Counter, 1)
+		mm_want := mmClaimOutboxEvents.ClaimOutboxEventsMock.defaultExpectation.params
+		mm_want_ptrs := mmClaimOutboxEvents.ClaimOutboxEventsMock.defaultExpectation.paramPtrs
+
+		mm_got := OutboxRepositoryMockClaimOutboxEventsParams{ctx, now, claimedUntil, limit}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmClaimOutboxEvents.t.Errorf("OutboxRepositoryMock.ClaimOutboxEvents got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmClaimOutboxEvents.ClaimOutboxEventsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.now != nil && !minimock.Equal(*mm_want_ptrs.now, mm_got.now) {
+				mmClaimOutboxEvents.t.Errorf("OutboxRepositoryMock.ClaimOutboxEvents got unexpected parameter now, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmClaimOutboxEvents.ClaimOutboxEventsMock.defaultExpectation.expectationOrigins.originNow, *mm_want_ptrs.now, mm_got.now, minimock.Diff(*mm_want_ptrs.now, mm_got.now))
+			}
+
+			if mm_want_ptrs.claimedUntil != nil && !minimock.Equal(*mm_want_ptrs.claimedUntil, mm_got.claimedUntil) {
+				mmClaimOutboxEvents.t.Errorf("OutboxRepositoryMock.ClaimOutboxEvents got unexpected parameter claimedUntil, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmClaimOutboxEvents.ClaimOutboxEventsMock.defaultExpectation.expectationOrigins.originClaimedUntil, *mm_want_ptrs.claimedUntil, mm_got.claimedUntil, minimock.Diff(*mm_want_ptrs.claimedUntil, mm_got.claimedUntil))
+			}
+
+			if mm_want_ptrs.limit != nil && !minimock.Equal(*mm_want_ptrs.limit, mm_got.limit) {
+				mmClaimOutboxEvents.t.Errorf("OutboxRepositoryMock.ClaimOutboxEvents got unexpected parameter limit, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmClaimOutboxEvents.ClaimOutboxEventsMock.defaultExpectation.expectationOrigins.originLimit, *mm_want_ptrs.limit, mm_got.limit, minimock.Diff(*mm_want_ptrs.limit, mm_got.limit))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmClaimOutboxEvents.t.Errorf("OutboxRepositoryMock.ClaimOutboxEvents got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmClaimOutboxEvents.ClaimOutboxEventsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmClaimOutboxEvents.ClaimOutboxEventsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmClaimOutboxEvents.t.Fatal("No results are set for the OutboxRepositoryMock.ClaimOutboxEvents")
+		}
+		return (*mm_results).oa1, (*mm_results).err
+	}
+	if mmClaimOutboxEvents.funcClaimOutboxEvents != nil {
+		return mmClaimOutboxEvents.funcClaimOutboxEvents(ctx, now, claimedUntil, limit)
+	}
+	mmClaimOutboxEvents.t.Fatalf("Unexpected call to OutboxRepositoryMock.ClaimOutboxEvents. %v %v %v %v", ctx, now, claimedUntil, limit)
+	return
+}
+
+// ClaimOutboxEventsAfterCounter returns a count of finished OutboxRepositoryMock.ClaimOutboxEvents invocations
+func (mmClaimOutboxEvents *OutboxRepositoryMock) ClaimOutboxEventsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmClaimOutboxEvents.afterClaimOutboxEventsCounter)
+}
+
+// ClaimOutboxEventsBeforeCounter returns a count of OutboxRepositoryMock.ClaimOutboxEvents invocations
+func (mmClaimOutboxEvents *OutboxRepositoryMock) ClaimOutboxEventsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmClaimOutboxEvents.beforeClaimOutboxEventsCounter)
+}
+
+// Calls returns a list of arguments used in each call to OutboxRepositoryMock.ClaimOutboxEvents.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmClaimOutboxEvents *mOutboxRepositoryMockClaimOutboxEvents) Calls() []*OutboxRepositoryMockClaimOutboxEventsParams {
+	mmClaimOutboxEvents.mutex.RLock()
+
+	argCopy := make([]*OutboxRepositoryMockClaimOutboxEventsParams, len(mmClaimOutboxEvents.callArgs))
+	copy(argCopy, mmClaimOutboxEvents.callArgs)
+
+	mmClaimOutboxEvents.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockClaimOutboxEventsDone returns true if the count of the ClaimOutboxEvents invocations corresponds
+// the number of defined expectations
+func (m *OutboxRepositoryMock) MinimockClaimOutboxEventsDone() bool {
+	if m.ClaimOutboxEventsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ClaimOutboxEventsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ClaimOutboxEventsMock.invocationsDone()
+}
+
+// MinimockClaimOutboxEventsInspect logs each unmet expectation
+func (m *OutboxRepositoryMock) MinimockClaimOutboxEventsInspect() {
+	for _, e := range m.ClaimOutboxEventsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OutboxRepositoryMock.ClaimOutboxEvents at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterClaimOutboxEventsCounter := mm_atomic.LoadUint64(&m.afterClaimOutboxEventsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ClaimOutboxEventsMock.defaultExpectation != nil && afterClaimOutboxEventsCounter < 1 {
+		if m.ClaimOutboxEventsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OutboxRepositoryMock.ClaimOutboxEvents at\n%s", m.ClaimOutboxEventsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OutboxRepositoryMock.ClaimOutboxEvents at\n%s with params: %#v", m.ClaimOutboxEventsMock.defaultExpectation.expectationOrigins.origin, *m.ClaimOutboxEventsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcClaimOutboxEvents != nil && afterClaimOutboxEventsCounter < 1 {
+		m.t.Errorf("Expected call to OutboxRepositoryMock.ClaimOutboxEvents at\n%s", m.funcClaimOutboxEventsOrigin)
+	}
+
+	if !m.ClaimOutboxEventsMock.invocationsDone() && afterClaimOutboxEventsCounter > 0 {
+		m.t.Errorf("Expected %d calls to OutboxRepositoryMock.ClaimOutboxEvents at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ClaimOutboxEventsMock.expectedInvocations), m.ClaimOutboxEventsMock.expectedInvocationsOrigin, afterClaimOutboxEventsCounter)
+	}
+}
+
+type mOutboxRepositoryMockMarkOutboxEventPublished struct {
+	optional           bool
+	mock               *OutboxRepositoryMock
+	defaultExpectation *OutboxRepositoryMockMarkOutboxEventPublishedExpectation
+	expectations       []*OutboxRepositoryMockMarkOutboxEventPublishedExpectation
+
+	callArgs []*OutboxRepositoryMockMarkOutboxEventPublishedParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OutboxRepositoryMockMarkOutboxEventPublishedExpectation specifies expectation struct of the OutboxRepository.MarkOutboxEventPublished
+type OutboxRepositoryMockMarkOutboxEventPublishedExpectation struct {
+	mock               *OutboxRepositoryMock
+	params             *OutboxRepositoryMockMarkOutboxEventPublishedParams
+	paramPtrs          *OutboxRepositoryMockMarkOutboxEventPublishedParamPtrs
+	expectationOrigins OutboxRepositoryMockMarkOutboxEventPublishedExpectationOrigins
+	results            *OutboxRepositoryMockMarkOutboxEventPublishedResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// OutboxRepositoryMockMarkOutboxEventPublishedParams contains parameters of the OutboxRepository.MarkOutboxEventPublished
+type OutboxRepositoryMockMarkOutboxEventPublishedParams struct {
+	ctx         context.Context
+	id          int64
+	publishedAt time.Time
+}
+
+// OutboxRepositoryMockMarkOutboxEventPublishedParamPtrs contains pointers to parameters of the OutboxRepository.MarkOutboxEventPublished
+type OutboxRepositoryMockMarkOutboxEventPublishedParamPtrs struct {
+	ctx         *context.Context
+	id          *int64
+	publishedAt *time.Time
+}
+
+// OutboxRepositoryMockMarkOutboxEventPublishedResults contains results of the OutboxRepository.MarkOutboxEventPublished
+type OutboxRepositoryMockMarkOutboxEventPublishedResults struct {
+	err error
+}
+
+// OutboxRepositoryMockMarkOutboxEventPublishedOrigins contains origins of expectations of the OutboxRepository.MarkOutboxEventPublished
+type OutboxRepositoryMockMarkOutboxEventPublishedExpectationOrigins struct {
+	origin            string
+	originCtx         string
+	originId          string
+	originPublishedAt string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmMarkOutboxEventPublished *mOutboxRepositoryMockMarkOutboxEventPublished) Optional() *mOutboxRepositoryMockMarkOutboxEventPublished {
+	mmMarkOutboxEventPublished.optional = true
+	return mmMarkOutboxEventPublished
+}
+
+// Expect sets up expected params for OutboxRepository.MarkOutboxEventPublished
+func (mmMarkOutboxEventPublished *mOutboxRepositoryMockMarkOutboxEventPublished) Expect(ctx context.Context, id int64, publishedAt time.Time) *mOutboxRepositoryMockMarkOutboxEventPublished {
+	if mmMarkOutboxEventPublished.mock.funcMarkOutboxEventPublished != nil {
+		mmMarkOutboxEventPublished.mock.t.Fatalf("OutboxRepositoryMock.MarkOutboxEventPublished mock is already set by Set")
+	}
+
+	if mmMarkOutboxEventPublished.defaultExpectation == nil {
+		mmMarkOutboxEventPublished.defaultExpectation = &OutboxRepositoryMockMarkOutboxEventPublishedExpectation{}
+	}
+
+	if mmMarkOutboxEventPublished.defaultExpectation.paramPtrs != nil {
+		mmMarkOutboxEventPublished.mock.t.Fatalf("OutboxRepositoryMock.MarkOutboxEventPublished mock is already set by ExpectParams functions")
+	}
+
+	mmMarkOutboxEventPublished.defaultExpectation.params = &OutboxRepositoryMockMarkOutboxEventPublishedParams{ctx, id, publishedAt}
+	mmMarkOutboxEventPublished.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmMarkOutboxEventPublished.expectations {
+		if minimock.Equal(e.params, mmMarkOutboxEventPublished.defaultExpectation.params) {
+			mmMarkOutboxEventPublished.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmMarkOutboxEventPublished.defaultExpectation.params)
+		}
+	}
+
+	return mmMarkOutboxEventPublished
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OutboxRepository.MarkOutboxEventPublished
+func (mmMarkOutboxEventPublished *mOutboxRepositoryMockMarkOutboxEventPublished) ExpectCtxParam1(ctx context.Context) *mOutboxRepositoryMockMarkOutboxEventPublished {
+	if mmMarkOutboxEventPublished.mock.funcMarkOutboxEventPublished != nil {
+		mmMarkOutboxEventPublished.mock.t.Fatalf("OutboxRepositoryMock.MarkOutboxEventPublished mock is already set by Set")
+	}
+
+	if mmMarkOutboxEventPublished.defaultExpectation == nil {
+		mmMarkOutboxEventPublished.defaultExpectation = &OutboxRepositoryMockMarkOutboxEventPublishedExpectation{}
+	}
+
+	if mmMarkOutboxEventPublished.defaultExpectation.params != nil {
+		mmMarkOutboxEventPublished.mock.t.Fatalf("OutboxRepositoryMock.MarkOutboxEventPublished mock is already set by Expect")
+	}
+
+	if mmMarkOutboxEventPublished.defaultExpectation.paramPtrs == nil {
+		mmMarkOutboxEventPublished.defaultExpectation.paramPtrs = &OutboxRepositoryMockMarkOutboxEventPublishedParamPtrs{}
+	}
+	mmMarkOutboxEventPublished.defaultExpectation.paramPtrs.ctx = &ctx
+	mmMarkOutboxEventPublished.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmMarkOutboxEventPublished
+}
+
+// ExpectIdParam2 sets up expected param id for OutboxRepository.MarkOutboxEventPublished
+func (mmMarkOutboxEventPublished *mOutboxRepositoryMockMarkOutboxEventPublished) ExpectIdParam2(id int64) *mOutboxRepositoryMockMarkOutboxEventPublished {
+	if mmMarkOutboxEventPublished.mock.funcMarkOutboxEventPublished != nil {
+		mmMarkOutboxEventPublished.mock.t.Fatalf("OutboxRepositoryMock.MarkOutboxEventPublished mock is already set by Set")
+	}
+
+	if mmMarkOutboxEventPublished.defaultExpectation == nil {
+		mmMarkOutboxEventPublished.defaultExpectation = &OutboxRepositoryMockMarkOutboxEventPublishedExpectation{}
+	}
+
+	if mmMarkOutboxEventPublished.defaultExpectation.params != nil {
+		mmMarkOutboxEventPublished.mock.t.Fatalf("OutboxRepositoryMock.MarkOutboxEventPublished mock is already set by Expect")
+	}
+
+	if mmMarkOutboxEventPublished.defaultExpectation.paramPtrs == nil {
+		mmMarkOutboxEventPublished.defaultExpectation.paramPtrs = &OutboxRepositoryMockMarkOutboxEventPublishedParamPtrs{}
+	}
+	mmMarkOutboxEventPublished.defaultExpectation.paramPtrs.id = &id
+	mmMarkOutboxEventPublished.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmMarkOutboxEventPublished
+}
+
+// ExpectPublishedAtParam3 sets up expected param publishedAt for OutboxRepository.MarkOutboxEventPublished
+func (mmMarkOutboxEventPublished *mOutboxRepositoryMockMarkOutboxEventPublished) ExpectPublishedAtParam3(publishedAt time.Time) *mOutboxRepositoryMockMarkOutboxEventPublished {
+	if mmMarkOutboxEventPublished.mock.funcMarkOutboxEventPublished != nil {
+		mmMarkOutboxEventPublished.mock.t.Fatalf("OutboxRepositoryMock.MarkOutboxEventPublished mock is already set by Set")
+	}
+
+	if mmMarkOutboxEventPublished.defaultExpectation == nil {
+		mmMarkOutboxEventPublished.defaultExpectation = &OutboxRepositoryMockMarkOutboxEventPublishedExpectation{}
+	}
+
+	if mmMarkOutboxEventPublished.defaultExpectation.params != nil {
+		mmMarkOutboxEventPublished.mock.t.Fatalf("OutboxRepositoryMock.MarkOutboxEventPublished mock is already set by Expect")
+	}
+
+	if mmMarkOutboxEventPublished.defaultExpectation.paramPtrs == nil {
+		mmMarkOutboxEventPublished.defaultExpectation.paramPtrs = &OutboxRepositoryMockMarkOutboxEventPublishedParamPtrs{}
+	}
+	mmMarkOutboxEventPublished.defaultExpectation.paramPtrs.publishedAt = &publishedAt
+	mmMarkOutboxEventPublished.defaultExpectation.expectationOrigins.originPublishedAt = minimock.CallerInfo(1)
+
+	return mmMarkOutboxEventPublished
+}
+
+// Inspect accepts an inspector function that has same arguments as the OutboxRepository.MarkOutboxEventPublished
+func (mmMarkOutboxEventPublished *mOutboxRepositoryMockMarkOutboxEventPublished) Inspect(f func(ctx context.Context, id int64, publishedAt time.Time)) *mOutboxRepositoryMockMarkOutboxEventPublished {
+	if mmMarkOutboxEventPublished.mock.inspectFuncMarkOutboxEventPublished != nil {
+		mmMarkOutboxEventPublished.mock.t.Fatalf("Inspect function is already set for OutboxRepositoryMock.MarkOutboxEventPublished")
+	}
+
+	mmMarkOutboxEventPublished.mock.inspectFuncMarkOutboxEventPublished = f
+
+	return mmMarkOutboxEventPublished
+}
+
+// Return sets up results that will be returned by OutboxRepository.MarkOutboxEventPublished
+func (mmMarkOutboxEventPublished *mOutboxRepositoryMockMarkOutboxEventPublished) Return(err error) *OutboxRepositoryMock {
+	if mmMarkOutboxEventPublished.mock.funcMarkOutboxEventPublished != nil {
+		mmMarkOutboxEventPublished.mock.t.Fatalf("OutboxRepositoryMock.MarkOutboxEventPublished mock is already set by Set")
+	}
+
+	if mmMarkOutboxEventPublished.defaultExpectation == nil {
+		mmMarkOutboxEventPublished.defaultExpectation = &OutboxRepositoryMockMarkOutboxEventPublishedExpectation{mock: mmMarkOutboxEventPublished.mock}
+	}
+	mmMarkOutboxEventPublished.defaultExpectation.results = &OutboxRepositoryMockMarkOutboxEventPublishedResults{err}
+	mmMarkOutboxEventPublished.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmMarkOutboxEventPublished.mock
+}
+
+// Set uses given function f to mock the OutboxRepository.MarkOutboxEventPublished method
+func (mmMarkOutboxEventPublished *mOutboxRepositoryMockMarkOutboxEventPublished) Set(f func(ctx context.Context, id int64, publishedAt time.Time) (err error)) *OutboxRepositoryMock {
+	if mmMarkOutboxEventPublished.defaultExpectation != nil {
+		mmMarkOutboxEventPublished.mock.t.Fatalf("Default expectation is already set for the OutboxRepository.MarkOutboxEventPublished method")
+	}
+
+	if len(mmMarkOutboxEventPublished.expectations) > 0 {
+		mmMarkOutboxEventPublished.mock.t.Fatalf("Some expectations are already set for the OutboxRepository.MarkOutboxEventPublished method")
+	}
+
+	mmMarkOutboxEventPublished.mock.funcMarkOutboxEventPublished = f
+	mmMarkOutboxEventPublished.mock.funcMarkOutboxEventPublishedOrigin = minimock.CallerInfo(1)
+	return mmMarkOutboxEventPublished.mock
+}
+
+// When sets expectation for the OutboxRepository.MarkOutboxEventPublished which will trigger the result defined by the following
+// Then helper
+func (mmMarkOutboxEventPublished *mOutboxRepositoryMockMarkOutboxEventPublished) When(ctx context.Context, id int64, publishedAt time.Time) *OutboxRepositoryMockMarkOutboxEventPublishedExpectation {
+	if mmMarkOutboxEventPublished.mock.funcMarkOutboxEventPublished != nil {
+		mmMarkOutboxEventPublished.mock.t.Fatalf("OutboxRepositoryMock.MarkOutboxEventPublished mock is already set by Set")
+	}
+
+	expectation := &OutboxRepositoryMockMarkOutboxEventPublishedExpectation{
+		mock:               mmMarkOutboxEventPublished.mock,
+		params:             &OutboxRepositoryMockMarkOutboxEventPublishedParams{ctx, id, publishedAt},
+		expectationOrigins: OutboxRepositoryMockMarkOutboxEventPublishedExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmMarkOutboxEventPublished.expectations = append(mmMarkOutboxEventPublished.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OutboxRepository.MarkOutboxEventPublished return parameters for the expectation previously defined by the When method
+func (e *OutboxRepositoryMockMarkOutboxEventPublishedExpectation) Then(err error) *OutboxRepositoryMock {
+	e.results = &OutboxRepositoryMockMarkOutboxEventPublishedResults{err}
+	return e.mock
+}
+
+// Times sets number of times OutboxRepository.MarkOutboxEventPublished should be invoked
+func (mmMarkOutboxEventPublished *mOutboxRepositoryMockMarkOutboxEventPublished) Times(n uint64) *mOutboxRepositoryMockMarkOutboxEventPublished {
+	if n == 0 {
+		mmMarkOutboxEventPublished.mock.t.Fatalf("Times of OutboxRepositoryMock.MarkOutboxEventPublished mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmMarkOutboxEventPublished.expectedInvocations, n)
+	mmMarkOutboxEventPublished.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmMarkOutboxEventPublished
+}
+
+func (mmMarkOutboxEventPublished *mOutboxRepositoryMockMarkOutboxEventPublished) invocationsDone() bool {
+	if len(mmMarkOutboxEventPublished.expectations) == 0 && mmMarkOutboxEventPublished.defaultExpectation == nil && mmMarkOutboxEventPublished.mock.funcMarkOutboxEventPublished == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmMarkOutboxEventPublished.mock.afterMarkOutboxEventPublishedCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmMarkOutboxEventPublished.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// MarkOutboxEventPublished implements OutboxRepository
+func (mmMarkOutboxEventPublished *OutboxRepositoryMock) MarkOutboxEventPublished(ctx context.Context, id int64, publishedAt time.Time) (err error) {
+	mm_atomic.AddUint64(&mmMarkOutboxEventPublished.beforeMarkOutboxEventPublishedCounter, 1)
+	defer mm_atomic.AddUint64(&mmMarkOutboxEventPublished.afterMarkOutboxEventPublishedCounter, 1)
+
+	mmMarkOutboxEventPublished.t.Helper()
+
+	if mmMarkOutboxEventPublished.inspectFuncMarkOutboxEventPublished != nil {
+		mmMarkOutboxEventPublished.inspectFuncMarkOutboxEventPublished(ctx, id, publishedAt)
+	}
+
+	mm_params := OutboxRepositoryMockMarkOutboxEventPublishedParams{ctx, id, publishedAt}
+
+	// Record call args
+	mmMarkOutboxEventPublished.MarkOutboxEventPublishedMock.mutex.Lock()
+	mmMarkOutboxEventPublished.MarkOutboxEventPublishedMock.callArgs = append(mmMarkOutboxEventPublished.MarkOutboxEventPublishedMock.callArgs, &mm_params)
+	mmMarkOutboxEventPublished.MarkOutboxEventPublishedMock.mutex.Unlock()
+
+	for _, e := range mmMarkOutboxEventPublished.MarkOutboxEventPublishedMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmMarkOutboxEventPublished.MarkOutboxEventPublishedMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmMarkOutboxEventPublished.MarkOutboxEventPublishedMock.defaultExpectation.Counter, 1)
+		mm_want := mmMarkOutboxEventPublished.MarkOutboxEventPublishedMock.defaultExpectation.params
+		mm_want_ptrs := mmMarkOutboxEventPublished.MarkOutboxEventPublishedMock.defaultExpectation.paramPtrs
+
+		mm_got := OutboxRepositoryMockMarkOutboxEventPublishedParams{ctx, id, publishedAt}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmMarkOutboxEventPublished.t.Errorf("OutboxRepositoryMock.MarkOutboxEventPublished got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMarkOutboxEventPublished.MarkOutboxEventPublishedMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmMarkOutboxEventPublished.t.Errorf("OutboxRepositoryMock.MarkOutboxEventPublished got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMarkOutboxEventPublished.MarkOutboxEventPublishedMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+			if mm_want_ptrs.publishedAt != nil && !minimock.Equal(*mm_want_ptrs.publishedAt, mm_got.publishedAt) {
+				mmMarkOutboxEventPublished.t.Errorf("OutboxRepositoryMock.MarkOutboxEventPublished got unexpected parameter publishedAt, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMarkOutboxEventPublished.MarkOutboxEventPublishedMock.defaultExpectation.expectationOrigins.originPublishedAt, *mm_want_ptrs.publishedAt, mm_got.publishedAt, minimock.Diff(*mm_want_ptrs.publishedAt, mm_got.publishedAt))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmMarkOutboxEventPublished.t.Errorf("OutboxRepositoryMock.MarkOutboxEventPublished got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmMarkOutboxEventPublished.MarkOutboxEventPublishedMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmMarkOutboxEventPublished.MarkOutboxEventPublishedMock.defaultExpectation.results
+		if mm_results == nil {
+			mmMarkOutboxEventPublished.t.Fatal("No results are set for the OutboxRepositoryMock.MarkOutboxEventPublished")
+		}
+		return (*mm_results).err
+	}
+	if mmMarkOutboxEventPublished.funcMarkOutboxEventPublished != nil {
+		return mmMarkOutboxEventPublished.funcMarkOutboxEventPublished(ctx, id, publishedAt)
+	}
+	mmMarkOutboxEventPublished.t.Fatalf("Unexpected call to OutboxRepositoryMock.MarkOutboxEventPublished. %v %v %v", ctx, id, publishedAt)
+	return
+}
+
+// MarkOutboxEventPublishedAfterCounter returns a count of finished OutboxRepositoryMock.MarkOutboxEventPublished invocations
+func (mmMarkOutboxEventPublished *OutboxRepositoryMock) MarkOutboxEventPublishedAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMarkOutboxEventPublished.afterMarkOutboxEventPublishedCounter)
+}
+
+// MarkOutboxEventPublishedBeforeCounter returns a count of OutboxRepositoryMock.MarkOutboxEventPublished invocations
+func (mmMarkOutboxEventPublished *OutboxRepositoryMock) MarkOutboxEventPublishedBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMarkOutboxEventPublished.beforeMarkOutboxEventPublishedCounter)
+}
+
+// Calls returns a list of arguments used in each call to OutboxRepositoryMock.MarkOutboxEventPublished.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmMarkOutboxEventPublished *mOutboxRepositoryMockMarkOutboxEventPublished) Calls() []*OutboxRepositoryMockMarkOutboxEventPublishedParams {
+	mmMarkOutboxEventPublished.mutex.RLock()
+
+	argCopy := make([]*OutboxRepositoryMockMarkOutboxEventPublishedParams, len(mmMarkOutboxEventPublished.callArgs))
+	copy(argCopy, mmMarkOutboxEventPublished.callArgs)
+
+	mmMarkOutboxEventPublished.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockMarkOutboxEventPublishedDone returns true if the count of the MarkOutboxEventPublished invocations corresponds
+// the number of defined expectations
+func (m *OutboxRepositoryMock) MinimockMarkOutboxEventPublishedDone() bool {
+	if m.MarkOutboxEventPublishedMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.MarkOutboxEventPublishedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.MarkOutboxEventPublishedMock.invocationsDone()
+}
+
+// MinimockMarkOutboxEventPublishedInspect logs each unmet expectation
+func (m *OutboxRepositoryMock) MinimockMarkOutboxEventPublishedInspect() {
+	for _, e := range m.MarkOutboxEventPublishedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OutboxRepositoryMock.MarkOutboxEventPublished at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterMarkOutboxEventPublishedCounter := mm_atomic.LoadUint64(&m.afterMarkOutboxEventPublishedCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.MarkOutboxEventPublishedMock.defaultExpectation != nil && afterMarkOutboxEventPublishedCounter < 1 {
+		if m.MarkOutboxEventPublishedMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OutboxRepositoryMock.MarkOutboxEventPublished at\n%s", m.MarkOutboxEventPublishedMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OutboxRepositoryMock.MarkOutboxEventPublished at\n%s with params: %#v", m.MarkOutboxEventPublishedMock.defaultExpectation.expectationOrigins.origin, *m.MarkOutboxEventPublishedMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcMarkOutboxEventPublished != nil && afterMarkOutboxEventPublishedCounter < 1 {
+		m.t.Errorf("Expected call to OutboxRepositoryMock.MarkOutboxEventPublished at\n%s", m.funcMarkOutboxEventPublishedOrigin)
+	}
+
+	if !m.MarkOutboxEventPublishedMock.invocationsDone() && afterMarkOutboxEventPublishedCounter > 0 {
+		m.t.Errorf("Expected %d calls to OutboxRepositoryMock.MarkOutboxEventPublished at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.MarkOutboxEventPublishedMock.expectedInvocations), m.MarkOutboxEventPublishedMock.expectedInvocationsOrigin, afterMarkOutboxEventPublishedCounter)
+	}
+}
+
+type mOutboxRepositoryMockPurgeOutboxEvents struct {
+	optional           bool
+	mock               *OutboxRepositoryMock
+	defaultExpectation *OutboxRepositoryMockPurgeOutboxEventsExpectation
+	expectations       []*OutboxRepositoryMockPurgeOutboxEventsExpectation
+
+	callArgs []*OutboxRepositoryMockPurgeOutboxEventsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OutboxRepositoryMockPurgeOutboxEventsExpectation specifies expectation struct of the OutboxRepository.PurgeOutboxEvents
+type OutboxRepositoryMockPurgeOutboxEventsExpectation struct {
+	mock               *OutboxRepositoryMock
+	params             *OutboxRepositoryMockPurgeOutboxEventsParams
+	paramPtrs          *OutboxRepositoryMockPurgeOutboxEventsParamPtrs
+	expectationOrigins OutboxRepositoryMockPurgeOutboxEventsExpectationOrigins
+	results            *OutboxRepositoryMockPurgeOutboxEventsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// OutboxRepositoryMockPurgeOutboxEventsParams contains parameters of the OutboxRepository.PurgeOutboxEvents
+type OutboxRepositoryMockPurgeOutboxEventsParams struct {
+	ctx             context.Context
+	publishedBefore time.Time
+}
+
+// OutboxRepositoryMockPurgeOutboxEventsParamPtrs contains pointers to parameters of the OutboxRepository.PurgeOutboxEvents
+type OutboxRepositoryMockPurgeOutboxEventsParamPtrs struct {
+	ctx             *context.Context
+	publishedBefore *time.Time
+}
+
+// OutboxRepositoryMockPurgeOutboxEventsResults contains results of the OutboxRepository.PurgeOutboxEvents
+type OutboxRepositoryMockPurgeOutboxEventsResults struct {
+	i1  int64
+	err error
+}
+
+// OutboxRepositoryMockPurgeOutboxEventsOrigins contains origins of expectations of the OutboxRepository.PurgeOutboxEvents
+type OutboxRepositoryMockPurgeOutboxEventsExpectationOrigins struct {
+	origin                string
+	originCtx             string
+	originPublishedBefore string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmPurgeOutboxEvents *mOutboxRepositoryMockPurgeOutboxEvents) Optional() *mOutboxRepositoryMockPurgeOutboxEvents {
+	mmPurgeOutboxEvents.optional = true
+	return mmPurgeOutboxEvents
+}
+
+// Expect sets up expected params for OutboxRepository.PurgeOutboxEvents
+func (mmPurgeOutboxEvents *mOutboxRepositoryMockPurgeOutboxEvents) Expect(ctx context.Context, publishedBefore time.Time) *mOutboxRepositoryMockPurgeOutboxEvents {
+	if mmPurgeOutboxEvents.mock.funcPurgeOutboxEvents != nil {
+		mmPurgeOutboxEvents.mock.t.Fatalf("OutboxRepositoryMock.PurgeOutboxEvents mock is already set by Set")
+	}
+
+	if mmPurgeOutboxEvents.defaultExpectation == nil {
+		mmPurgeOutboxEvents.defaultExpectation = &OutboxRepositoryMockPurgeOutboxEventsExpectation{}
+	}
+
+	if mmPurgeOutboxEvents.defaultExpectation.paramPtrs != nil {
+		mmPurgeOutboxEvents.mock.t.Fatalf("OutboxRepositoryMock.PurgeOutboxEvents mock is already set by ExpectParams functions")
+	}
+
+	mmPurgeOutboxEvents.defaultExpectation.params = &OutboxRepositoryMockPurgeOutboxEventsParams{ctx, publishedBefore}
+	mmPurgeOutboxEvents.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmPurgeOutboxEvents.expectations {
+		if minimock.Equal(e.params, mmPurgeOutboxEvents.defaultExpectation.params) {
+			mmPurgeOutboxEvents.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmPurgeOutboxEvents.defaultExpectation.params)
+		}
+	}
+
+	return mmPurgeOutboxEvents
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OutboxRepository.PurgeOutboxEvents
+func (mmPurgeOutboxEvents *mOutboxRepositoryMockPurgeOutboxEvents) ExpectCtxParam1(ctx context.Context) *mOutboxRepositoryMockPurgeOutboxEvents {
+	if mmPurgeOutboxEvents.mock.funcPurgeOutboxEvents != nil {
+		mmPurgeOutboxEvents.mock.t.Fatalf("OutboxRepositoryMock.PurgeOutboxEvents mock is already set by Set")
+	}
+
+	if mmPurgeOutboxEvents.defaultExpectation == nil {
+		mmPurgeOutboxEvents.defaultExpectation = &OutboxRepositoryMockPurgeOutboxEventsExpectation{}
+	}
+
+	if mmPurgeOutboxEvents.defaultExpectation.params != nil {
+		mmPurgeOutboxEvents.mock.t.Fatalf("OutboxRepositoryMock.PurgeOutboxEvents mock is already set by Expect")
+	}
+
+	if mmPurgeOutboxEvents.defaultExpectation.paramPtrs == nil {
+		mmPurgeOutboxEvents.defaultExpectation.paramPtrs = &OutboxRepositoryMockPurgeOutboxEventsParamPtrs{}
+	}
+	mmPurgeOutboxEvents.defaultExpectation.paramPtrs.ctx = &ctx
+	mmPurgeOutboxEvents.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmPurgeOutboxEvents
+}
+
+// ExpectPublishedBeforeParam2 sets up expected param publishedBefore for OutboxRepository.PurgeOutboxEvents
+func (mmPurgeOutboxEvents *mOutboxRepositoryMockPurgeOutboxEvents) ExpectPublishedBeforeParam2(publishedBefore time.Time) *mOutboxRepositoryMockPurgeOutboxEvents {
+	if mmPurgeOutboxEvents.mock.funcPurgeOutboxEvents != nil {
+		mmPurgeOutboxEvents.mock.t.Fatalf("OutboxRepositoryMock.PurgeOutboxEvents mock is already set by Set")
+	}
+
+	if mmPurgeOutboxEvents.defaultExpectation == nil {
+		mmPurgeOutboxEvents.defaultExpectation = &OutboxRepositoryMockPurgeOutboxEventsExpectation{}
+	}
+
+	if mmPurgeOutboxEvents.defaultExpectation.params != nil {
+		mmPurgeOutboxEvents.mock.t.Fatalf("OutboxRepositoryMock.PurgeOutboxEvents mock is already set by Expect")
+	}
+
+	if mmPurgeOutboxEvents.defaultExpectation.paramPtrs == nil {
+		mmPurgeOutboxEvents.defaultExpectation.paramPtrs = &OutboxRepositoryMockPurgeOutboxEventsParamPtrs{}
+	}
+	mmPurgeOutboxEvents.defaultExpectation.paramPtrs.publishedBefore = &publishedBefore
+	mmPurgeOutboxEvents.defaultExpectation.expectationOrigins.originPublishedBefore = minimock.CallerInfo(1)
+
+	return mmPurgeOutboxEvents
+}
+
+// Inspect accepts an inspector function that has same arguments as the OutboxRepository.PurgeOutboxEvents
+func (mmPurgeOutboxEvents *mOutboxRepositoryMockPurgeOutboxEvents) Inspect(f func(ctx context.Context, publishedBefore time.Time)) *mOutboxRepositoryMockPurgeOutboxEvents {
+	if mmPurgeOutboxEvents.mock.inspectFuncPurgeOutboxEvents != nil {
+		mmPurgeOutboxEvents.mock.t.Fatalf("Inspect function is already set for OutboxRepositoryMock.PurgeOutboxEvents")
+	}
+
+	mmPurgeOutboxEvents.mock.inspectFuncPurgeOutboxEvents = f
+
+	return mmPurgeOutboxEvents
+}
+
+// Return sets up results that will be returned by OutboxRepository.PurgeOutboxEvents
+func (mmPurgeOutboxEvents *mOutboxRepositoryMockPurgeOutboxEvents) Return(i1 int64, err error) *OutboxRepositoryMock {
+	if mmPurgeOutboxEvents.mock.funcPurgeOutboxEvents != nil {
+		mmPurgeOutboxEvents.mock.t.Fatalf("OutboxRepositoryMock.PurgeOutboxEvents mock is already set by Set")
+	}
+
+	if mmPurgeOutboxEvents.defaultExpectation == nil {
+		mmPurgeOutboxEvents.defaultExpectation = &OutboxRepositoryMockPurgeOutboxEventsExpectation{mock: mmPurgeOutboxEvents.mock}
+	}
+	mmPurgeOutboxEvents.defaultExpectation.results = &OutboxRepositoryMockPurgeOutboxEventsResults{i1, err}
+	mmPurgeOutboxEvents.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmPurgeOutboxEvents.mock
+}
+
+// Set uses given function f to mock the OutboxRepository.PurgeOutboxEvents method
+func (mmPurgeOutboxEvents *mOutboxRepositoryMockPurgeOutboxEvents) Set(f func(ctx context.Context, publishedBefore time.Time) (i1 int64, err error)) *OutboxRepositoryMock {
+	if mmPurgeOutboxEvents.defaultExpectation != nil {
+		mmPurgeOutboxEvents.mock.t.Fatalf("Default expectation is already set for the OutboxRepository.PurgeOutboxEvents method")
+	}
+
+	if len(mmPurgeOutboxEvents.expectations) > 0 {
+		mmPurgeOutboxEvents.mock.t.Fatalf("Some expectations are already set for the OutboxRepository.PurgeOutboxEvents method")
+	}
+
+	mmPurgeOutboxEvents.mock.funcPurgeOutboxEvents = f
+	mmPurgeOutboxEvents.mock.funcPurgeOutboxEventsOrigin = minimock.CallerInfo(1)
+	return mmPurgeOutboxEvents.mock
+}
+
+// When sets expectation for the OutboxRepository.PurgeOutboxEvents which will trigger the result defined by the following
+// Then helper
+func (mmPurgeOutboxEvents *mOutboxRepositoryMockPurgeOutboxEvents) When(ctx context.Context, publishedBefore time.Time) *OutboxRepositoryMockPurgeOutboxEventsExpectation {
+	if mmPurgeOutboxEvents.mock.funcPurgeOutboxEvents != nil {
+		mmPurgeOutboxEvents.mock.t.Fatalf("OutboxRepositoryMock.PurgeOutboxEvents mock is already set by Set")
+	}
+
+	expectation := &OutboxRepositoryMockPurgeOutboxEventsExpectation{
+		mock:               mmPurgeOutboxEvents.mock,
+		params:             &OutboxRepositoryMockPurgeOutboxEventsParams{ctx, publishedBefore},
+		expectationOrigins: OutboxRepositoryMockPurgeOutboxEventsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmPurgeOutboxEvents.expectations = append(mmPurgeOutboxEvents.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OutboxRepository.PurgeOutboxEvents return parameters for the expectation previously defined by the When method
+func (e *OutboxRepositoryMockPurgeOutboxEventsExpectation) Then(i1 int64, err error) *OutboxRepositoryMock {
+	e.results = &OutboxRepositoryMockPurgeOutboxEventsResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times OutboxRepository.PurgeOutboxEvents should be invoked
+func (mmPurgeOutboxEvents *mOutboxRepositoryMockPurgeOutboxEvents) Times(n uint64) *mOutboxRepositoryMockPurgeOutboxEvents {
+	if n == 0 {
+		mmPurgeOutboxEvents.mock.t.Fatalf("Times of OutboxRepositoryMock.PurgeOutboxEvents mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmPurgeOutboxEvents.expectedInvocations, n)
+	mmPurgeOutboxEvents.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmPurgeOutboxEvents
+}
+
+func (mmPurgeOutboxEvents *mOutboxRepositoryMockPurgeOutboxEvents) invocationsDone() bool {
+	if len(mmPurgeOutboxEvents.expectations) == 0 && mmPurgeOutboxEvents.defaultExpectation == nil && mmPurgeOutboxEvents.mock.funcPurgeOutboxEvents == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmPurgeOutboxEvents.mock.afterPurgeOutboxEventsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmPurgeOutboxEvents.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// PurgeOutboxEvents implements OutboxRepository
+func (mmPurgeOutboxEvents *OutboxRepositoryMock) PurgeOutboxEvents(ctx context.Context, publishedBefore time.Time) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmPurgeOutboxEvents.beforePurgeOutboxEventsCounter, 1)
+	defer mm_atomic.AddUint64(&mmPurgeOutboxEvents.afterPurgeOutboxEventsCounter, 1)
+
+	mmPurgeOutboxEvents.t.Helper()
+
+	if mmPurgeOutboxEvents.inspectFuncPurgeOutboxEvents != nil {
+		mmPurgeOutboxEvents.inspectFuncPurgeOutboxEvents(ctx, publishedBefore)
+	}
+
+	mm_params := OutboxRepositoryMockPurgeOutboxEventsParams{ctx, publishedBefore}
+
+	// Record call args
+	mmPurgeOutboxEvents.PurgeOutboxEventsMock.mutex.Lock()
+	mmPurgeOutboxEvents.PurgeOutboxEventsMock.callArgs = append(mmPurgeOutboxEvents.PurgeOutboxEventsMock.callArgs, &mm_params)
+	mmPurgeOutboxEvents.PurgeOutboxEventsMock.mutex.Unlock()
+
+	for _, e := range mmPurgeOutboxEvents.PurgeOutboxEventsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmPurgeOutboxEvents.PurgeOutboxEventsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmPurgeOutboxEvents.PurgeOutboxEventsMock.defaultExpectation.Counter, 1)
+		mm_want := mmPurgeOutboxEvents.PurgeOutboxEventsMock.defaultExpectation.params
+		mm_want_ptrs := mmPurgeOutboxEvents.PurgeOutboxEventsMock.defaultExpectation.paramPtrs
+
+		mm_got := OutboxRepositoryMockPurgeOutboxEventsParams{ctx, publishedBefore}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmPurgeOutboxEvents.t.Errorf("OutboxRepositoryMock.PurgeOutboxEvents got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPurgeOutboxEvents.PurgeOutboxEventsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.publishedBefore != nil && !minimock.Equal(*mm_want_ptrs.publishedBefore, mm_got.publishedBefore) {
+				mmPurgeOutboxEvents.t.Errorf("OutboxRepositoryMock.PurgeOutboxEvents got unexpected parameter publishedBefore, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPurgeOutboxEvents.PurgeOutboxEventsMock.defaultExpectation.expectationOrigins.originPublishedBefore, *mm_want_ptrs.publishedBefore, mm_got.publishedBefore, minimock.Diff(*mm_want_ptrs.publishedBefore, mm_got.publishedBefore))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmPurgeOutboxEvents.t.Errorf("OutboxRepositoryMock.PurgeOutboxEvents got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmPurgeOutboxEvents.PurgeOutboxEventsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmPurgeOutboxEvents.PurgeOutboxEventsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmPurgeOutboxEvents.t.Fatal("No results are set for the OutboxRepositoryMock.PurgeOutboxEvents")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmPurgeOutboxEvents.funcPurgeOutboxEvents != nil {
+		return mmPurgeOutboxEvents.funcPurgeOutboxEvents(ctx, publishedBefore)
+	}
+	mmPurgeOutboxEvents.t.Fatalf("Unexpected call to OutboxRepositoryMock.PurgeOutboxEvents. %v %v", ctx, publishedBefore)
+	return
+}
+
+// PurgeOutboxEventsAfterCounter returns a count of finished OutboxRepositoryMock.PurgeOutboxEvents invocations
+func (mmPurgeOutboxEvents *OutboxRepositoryMock) PurgeOutboxEventsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPurgeOutboxEvents.afterPurgeOutboxEventsCounter)
+}
+
+// PurgeOutboxEventsBeforeCounter returns a count of OutboxRepositoryMock.PurgeOutboxEvents invocations
+func (mmPurgeOutboxEvents *OutboxRepositoryMock) PurgeOutboxEventsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPurgeOutboxEvents.beforePurgeOutboxEventsCounter)
+}
+
+// Calls returns a list of arguments used in each call to OutboxRepositoryMock.PurgeOutboxEvents.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmPurgeOutboxEvents *mOutboxRepositoryMockPurgeOutboxEvents) Calls() []*OutboxRepositoryMockPurgeOutboxEventsParams {
+	mmPurgeOutboxEvents.mutex.RLock()
+
+	argCopy := make([]*OutboxRepositoryMockPurgeOutboxEventsParams, len(mmPurgeOutboxEvents.callArgs))
+	copy(argCopy, mmPurgeOutboxEvents.callArgs)
+
+	mmPurgeOutboxEvents.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockPurgeOutboxEventsDone returns true if the count of the PurgeOutboxEvents invocations corresponds
+// the number of defined expectations
+func (m *OutboxRepositoryMock) MinimockPurgeOutboxEventsDone() bool {
+	if m.PurgeOutboxEventsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.PurgeOutboxEventsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.PurgeOutboxEventsMock.invocationsDone()
+}
+
+// MinimockPurgeOutboxEventsInspect logs each unmet expectation
+func (m *OutboxRepositoryMock) MinimockPurgeOutboxEventsInspect() {
+	for _, e := range m.PurgeOutboxEventsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OutboxRepositoryMock.PurgeOutboxEvents at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterPurgeOutboxEventsCounter := mm_atomic.LoadUint64(&m.afterPurgeOutboxEventsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.PurgeOutboxEventsMock.defaultExpectation != nil && afterPurgeOutboxEventsCounter < 1 {
+		if m.PurgeOutboxEventsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OutboxRepositoryMock.PurgeOutboxEvents at\n%s", m.PurgeOutboxEventsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OutboxRepositoryMock.PurgeOutboxEvents at\n%s with params: %#v", m.PurgeOutboxEventsMock.defaultExpectation.expectationOrigins.origin, *m.PurgeOutboxEventsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcPurgeOutboxEvents != nil && afterPurgeOutboxEventsCounter < 1 {
+		m.t.Errorf("Expected call to OutboxRepositoryMock.PurgeOutboxEvents at\n%s", m.funcPurgeOutboxEventsOrigin)
+	}
+
+	if !m.PurgeOutboxEventsMock.invocationsDone() && afterPurgeOutboxEventsCounter > 0 {
+		m.t.Errorf("Expected %d calls to OutboxRepositoryMock.PurgeOutboxEvents at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.PurgeOutboxEventsMock.expectedInvocations), m.PurgeOutboxEventsMock.expectedInvocationsOrigin, afterPurgeOutboxEventsCounter)
+	}
+}
+
+type mOutboxRepositoryMockRetryOutboxEvent struct {
+	optional           bool
+	mock               *OutboxRepositoryMock
+	defaultExpectation *OutboxRepositoryMockRetryOutboxEventExpectation
+	expectations       []*OutboxRepositoryMockRetryOutboxEventExpectation
+
+	callArgs []*OutboxRepositoryMockRetryOutboxEventParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OutboxRepositoryMockRetryOutboxEventExpectation specifies expectation struct of the OutboxRepository.RetryOutboxEvent
+type OutboxRepositoryMockRetryOutboxEventExpectation struct {
+	mock               *OutboxRepositoryMock
+	params             *OutboxRepositoryMockRetryOutboxEventParams
+	paramPtrs          *OutboxRepositoryMockRetryOutboxEventParamPtrs
+	expectationOrigins OutboxRepositoryMockRetryOutboxEventExpectationOrigins
+	results            *OutboxRepositoryMockRetryOutboxEventResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// OutboxRepositoryMockRetryOutboxEventParams contains parameters of the OutboxRepository.RetryOutboxEvent
+type OutboxRepositoryMockRetryOutboxEventParams struct {
+	ctx           context.Context
+	id            int64
+	nextAttemptAt time.Time
+	lastError     string
+}
+
+// OutboxRepositoryMockRetryOutboxEventParamPtrs contains pointers to parameters of the OutboxRepository.RetryOutboxEvent
+type OutboxRepositoryMockRetryOutboxEventParamPtrs struct {
+	ctx           *context.Context
+	id            *int64
+	nextAttemptAt *time.Time
+	lastError     *string
+}
+
+// OutboxRepositoryMockRetryOutboxEventResults contains results of the OutboxRepository.RetryOutboxEvent
+type OutboxRepositoryMockRetryOutboxEventResults struct {
+	err error
+}
+
+// OutboxRepositoryMockRetryOutboxEventOrigins contains origins of expectations of the OutboxRepository.RetryOutboxEvent
+type OutboxRepositoryMockRetryOutboxEventExpectationOrigins struct {
+	origin              string
+	originCtx           string
+	originId            string
+	originNextAttemptAt string
+	originLastError     string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRetryOutboxEvent *mOutboxRepositoryMockRetryOutboxEvent) Optional() *mOutboxRepositoryMockRetryOutboxEvent {
+	mmRetryOutboxEvent.optional = true
+	return mmRetryOutboxEvent
+}
+
+// Expect sets up expected params for OutboxRepository.RetryOutboxEvent
+func (mmRetryOutboxEvent *mOutboxRepositoryMockRetryOutboxEvent) Expect(ctx context.Context, id int64, nextAttemptAt time.Time, lastError string) *mOutboxRepositoryMockRetryOutboxEvent {
+	if mmRetryOutboxEvent.mock.funcRetryOutboxEvent != nil {
+		mmRetryOutboxEvent.mock.t.Fatalf("OutboxRepositoryMock.RetryOutboxEvent mock is already set by Set")
+	}
+
+	if mmRetryOutboxEvent.defaultExpectation == nil {
+		mmRetryOutboxEvent.defaultExpectation = &OutboxRepositoryMockRetryOutboxEventExpectation{}
+	}
+
+	if mmRetryOutboxEvent.defaultExpectation.paramPtrs != nil {
+		mmRetryOutboxEvent.mock.t.Fatalf("OutboxRepositoryMock.RetryOutboxEvent mock is already set by ExpectParams functions")
+	}
+
+	mmRetryOutboxEvent.defaultExpectation.params = &OutboxRepositoryMockRetryOutboxEventParams{ctx, id, nextAttemptAt, lastError}
+	mmRetryOutboxEvent.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRetryOutboxEvent.expectations {
+		if minimock.Equal(e.params, mmRetryOutboxEvent.defaultExpectation.params) {
+			mmRetryOutboxEvent.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRetryOutboxEvent.defaultExpectation.params)
+		}
+	}
+
+	return mmRetryOutboxEvent
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OutboxRepository.RetryOutboxEvent
+func (mmRetryOutboxEvent *mOutboxRepositoryMockRetryOutboxEvent) ExpectCtxParam1(ctx context.Context) *mOutboxRepositoryMockRetryOutboxEvent {
+	if mmRetryOutboxEvent.mock.funcRetryOutboxEvent != nil {
+		mmRetryOutboxEvent.mock.t.Fatalf("OutboxRepositoryMock.RetryOutboxEvent mock is already set by Set")
+	}
+
+	if mmRetryOutboxEvent.defaultExpectation == nil {
+		mmRetryOutboxEvent.defaultExpectation = &OutboxRepositoryMockRetryOutboxEventExpectation{}
+	}
+
+	if mmRetryOutboxEvent.defaultExpectation.params != nil {
+		mmRetryOutboxEvent.mock.t.Fatalf("OutboxRepositoryMock.RetryOutboxEvent mock is already set by Expect")
+	}
+
+	if mmRetryOutboxEvent.defaultExpectation.paramPtrs == nil {
+		mmRetryOutboxEvent.defaultExpectation.paramPtrs = &OutboxRepositoryMockRetryOutboxEventParamPtrs{}
+	}
+	mmRetryOutboxEvent.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRetryOutboxEvent.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRetryOutboxEvent
+}
+
+// ExpectIdParam2 sets up expected param id for OutboxRepository.RetryOutboxEvent
+func (mmRetryOutboxEvent *mOutboxRepositoryMockRetryOutboxEvent) ExpectIdParam2(id int64) *mOutboxRepositoryMockRetryOutboxEvent {
+	if mmRetryOutboxEvent.mock.funcRetryOutboxEvent != nil {
+		mmRetryOutboxEvent.mock.t.Fatalf("OutboxRepositoryMock.RetryOutboxEvent mock is already set by Set")
+	}
+
+	if mmRetryOutboxEvent.defaultExpectation == nil {
+		mmRetryOutboxEvent.defaultExpectation = &OutboxRepositoryMockRetryOutboxEventExpectation{}
+	}
+
+	if mmRetryOutboxEvent.defaultExpectation.params != nil {
+		mmRetryOutboxEvent.mock.t.Fatalf("OutboxRepositoryMock.RetryOutboxEvent mock is already set by Expect")
+	}
+
+	if mmRetryOutboxEvent.defaultExpectation.paramPtrs == nil {
+		mmRetryOutboxEvent.defaultExpectation.paramPtrs = &OutboxRepositoryMockRetryOutboxEventParamPtrs{}
+	}
+	mmRetryOutboxEvent.defaultExpectation.paramPtrs.id = &id
+	mmRetryOutboxEvent.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmRetryOutboxEvent
+}
+
+// ExpectNextAttemptAtParam3 sets up expected param nextAttemptAt for OutboxRepository.RetryOutboxEvent
+func (mmRetryOutboxEvent *mOutboxRepositoryMockRetryOutboxEvent) ExpectNextAttemptAtParam3(nextAttemptAt time.Time) *mOutboxRepositoryMockRetryOutboxEvent {
+	if mmRetryOutboxEvent.mock.funcRetryOutboxEvent != nil {
+		mmRetryOutboxEvent.mock.t.Fatalf("OutboxRepositoryMock.RetryOutboxEvent mock is already set by Set")
+	}
+
+	if mmRetryOutboxEvent.defaultExpectation == nil {
+		mmRetryOutboxEvent.defaultExpectation = &OutboxRepositoryMockRetryOutboxEventExpectation{}
+	}
+
+	if mmRetryOutboxEvent.defaultExpectation.params != nil {
+		mmRetryOutboxEvent.mock.t.Fatalf("OutboxRepositoryMock.RetryOutboxEvent mock is already set by Expect")
+	}
+
+	if mmRetryOutboxEvent.defaultExpectation.paramPtrs == nil {
+		mmRetryOutboxEvent.defaultExpectation.paramPtrs = &OutboxRepositoryMockRetryOutboxEventParamPtrs{}
+	}
+	mmRetryOutboxEvent.defaultExpectation.paramPtrs.nextAttemptAt = &nextAttemptAt
+	mmRetryOutboxEvent.defaultExpectation.expectationOrigins.originNextAttemptAt = minimock.CallerInfo(1)
+
+	return mmRetryOutboxEvent
+}
+
+// ExpectLastErrorParam4 sets up expected param lastError for OutboxRepository.RetryOutboxEvent
+func (mmRetryOutboxEvent *mOutboxRepositoryMockRetryOutboxEvent) ExpectLastErrorParam4(lastError string) *mOutboxRepositoryMockRetryOutboxEvent {
+	if mmRetryOutboxEvent.mock.funcRetryOutboxEvent != nil {
+		mmRetryOutboxEvent.mock.t.Fatalf("OutboxRepositoryMock.RetryOutboxEvent mock is already set by Set")
+	}
+
+	if mmRetryOutboxEvent.defaultExpectation == nil {
+		mmRetryOutboxEvent.defaultExpectation = &OutboxRepositoryMockRetryOutboxEventExpectation{}
+	}
+
+	if mmRetryOutboxEvent.defaultExpectation.params != nil {
+		mmRetryOutboxEvent.mock.t.Fatalf("OutboxRepositoryMock.RetryOutboxEvent mock is already set by Expect")
+	}
+
+	if mmRetryOutboxEvent.defaultExpectation.paramPtrs == nil {
+		mmRetryOutboxEvent.defaultExpectation.paramPtrs = &OutboxRepositoryMockRetryOutboxEventParamPtrs{}
+	}
+	mmRetryOutboxEvent.defaultExpectation.paramPtrs.lastError = &lastError
+	mmRetryOutboxEvent.defaultExpectation.expectationOrigins.originLastError = minimock.CallerInfo(1)
+
+	return mmRetryOutboxEvent
+}
+
+// Inspect accepts an inspector function that has same arguments as the OutboxRepository.RetryOutboxEvent
+func (mmRetryOutboxEvent *mOutboxRepositoryMockRetryOutboxEvent) Inspect(f func(ctx context.Context, id int64, nextAttemptAt time.Time, lastError string)) *mOutboxRepositoryMockRetryOutboxEvent {
+	if mmRetryOutboxEvent.mock.inspectFuncRetryOutboxEvent != nil {
+		mmRetryOutboxEvent.mock.t.Fatalf("Inspect function is already set for OutboxRepositoryMock.RetryOutboxEvent")
+	}
+
+	mmRetryOutboxEvent.mock.inspectFuncRetryOutboxEvent = f
+
+	return mmRetryOutboxEvent
+}
+
+// Return sets up results that will be returned by OutboxRepository.RetryOutboxEvent
+func (mmRetryOutboxEvent *mOutboxRepositoryMockRetryOutboxEvent) Return(err error) *OutboxRepositoryMock {
+	if mmRetryOutboxEvent.mock.funcRetryOutboxEvent != nil {
+		mmRetryOutboxEvent.mock.t.Fatalf("OutboxRepositoryMock.RetryOutboxEvent mock is already set by Set")
+	}
+
+	if mmRetryOutboxEvent.defaultExpectation == nil {
+		mmRetryOutboxEvent.defaultExpectation = &OutboxRepositoryMockRetryOutboxEventExpectation{mock: mmRetryOutboxEvent.mock}
+	}
+	mmRetryOutboxEvent.defaultExpectation.results = &OutboxRepositoryMockRetryOutboxEventResults{err}
+	mmRetryOutboxEvent.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRetryOutboxEvent.mock
+}
+
+// Set uses given function f to mock the OutboxRepository.RetryOutboxEvent method
+func (mmRetryOutboxEvent *mOutboxRepositoryMockRetryOutboxEvent) Set(f func(ctx context.Context, id int64, nextAttemptAt time.Time, lastError string) (err error)) *OutboxRepositoryMock {
+	if mmRetryOutboxEvent.defaultExpectation != nil {
+		mmRetryOutboxEvent.mock.t.Fatalf("Default expectation is already set for the OutboxRepository.RetryOutboxEvent method")
+	}
+
+	if len(mmRetryOutboxEvent.expectations) > 0 {
+		mmRetryOutboxEvent.mock.t.Fatalf("Some expectations are already set for the OutboxRepository.RetryOutboxEvent method")
+	}
+
+	mmRetryOutboxEvent.mock.funcRetryOutboxEvent = f
+	mmRetryOutboxEvent.mock.funcRetryOutboxEventOrigin = minimock.CallerInfo(1)
+	return mmRetryOutboxEvent.mock
+}
+
+// When sets expectation for the OutboxRepository.RetryOutboxEvent which will trigger the result defined by the following
+// Then helper
+func (mmRetryOutboxEvent *mOutboxRepositoryMockRetryOutboxEvent) When(ctx context.Context, id int64, nextAttemptAt time.Time, lastError string) *OutboxRepositoryMockRetryOutboxEventExpectation {
+	if mmRetryOutboxEvent.mock.funcRetryOutboxEvent != nil {
+		mmRetryOutboxEvent.mock.t.Fatalf("OutboxRepositoryMock.RetryOutboxEvent mock is already set by Set")
+	}
+
+	expectation := &OutboxRepositoryMockRetryOutboxEventExpectation{
+		mock:               mmRetryOutboxEvent.mock,
+		params:             &OutboxRepositoryMockRetryOutboxEventParams{ctx, id, nextAttemptAt, lastError},
+		expectationOrigins: OutboxRepositoryMockRetryOutboxEventExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmRetryOutboxEvent.expectations = append(mmRetryOutboxEvent.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OutboxRepository.RetryOutboxEvent return parameters for the expectation previously defined by the When method
+func (e *OutboxRepositoryMockRetryOutboxEventExpectation) Then(err error) *OutboxRepositoryMock {
+	e.results = &OutboxRepositoryMockRetryOutboxEventResults{err}
+	return e.mock
+}
+
+// Times sets number of times OutboxRepository.RetryOutboxEvent should be invoked
+func (mmRetryOutboxEvent *mOutboxRepositoryMockRetryOutboxEvent) Times(n uint64) *mOutboxRepositoryMockRetryOutboxEvent {
+	if n == 0 {
+		mmRetryOutboxEvent.mock.t.Fatalf("Times of OutboxRepositoryMock.RetryOutboxEvent mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRetryOutboxEvent.expectedInvocations, n)
+	mmRetryOutboxEvent.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRetryOutboxEvent
+}
+
+func (mmRetryOutboxEvent *mOutboxRepositoryMockRetryOutboxEvent) invocationsDone() bool {
+	if len(mmRetryOutboxEvent.expectations) == 0 && mmRetryOutboxEvent.defaultExpectation == nil && mmRetryOutboxEvent.mock.funcRetryOutboxEvent == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRetryOutboxEvent.mock.afterRetryOutboxEventCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRetryOutboxEvent.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RetryOutboxEvent implements OutboxRepository
+func (mmRetryOutboxEvent *OutboxRepositoryMock) RetryOutboxEvent(ctx context.Context, id int64, nextAttemptAt time.Time, lastError string) (err error) {
+	mm_atomic.AddUint64(&mmRetryOutboxEvent.beforeRetryOutboxEventCounter, 1)
+	defer mm_atomic.AddUint64(&mmRetryOutboxEvent.afterRetryOutboxEventCounter, 1)
+
+	mmRetryOutboxEvent.t.Helper()
+
+	if mmRetryOutboxEvent.inspectFuncRetryOutboxEvent != nil {
+		mmRetryOutboxEvent.inspectFuncRetryOutboxEvent(ctx, id, nextAttemptAt, lastError)
+	}
+
+	mm_params := OutboxRepositoryMockRetryOutboxEventParams{ctx, id, nextAttemptAt, lastError}
+
+	// Record call args
+	mmRetryOutboxEvent.RetryOutboxEventMock.mutex.Lock()
+	mmRetryOutboxEvent.RetryOutboxEventMock.callArgs = append(mmRetryOutboxEvent.RetryOutboxEventMock.callArgs, &mm_params)
+	mmRetryOutboxEvent.RetryOutboxEventMock.mutex.Unlock()
+
+	for _, e := range mmRetryOutboxEvent.RetryOutboxEventMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmRetryOutboxEvent.RetryOutboxEventMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRetryOutboxEvent.RetryOutboxEventMock.defaultExpectation.Counter, 1)
+		mm_want := mmRetryOutboxEvent.RetryOutboxEventMock.defaultExpectation.params
+		mm_want_ptrs := mmRetryOutboxEvent.RetryOutboxEventMock.defaultExpectation.paramPtrs
+
+		mm_got := OutboxRepositoryMockRetryOutboxEventParams{ctx, id, nextAttemptAt, lastError}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRetryOutboxEvent.t.Errorf("OutboxRepositoryMock.RetryOutboxEvent got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRetryOutboxEvent.RetryOutboxEventMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmRetryOutboxEvent.t.Errorf("OutboxRepositoryMock.RetryOutboxEvent got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRetryOutboxEvent.RetryOutboxEventMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+			if mm_want_ptrs.nextAttemptAt != nil && !minimock.Equal(*mm_want_ptrs.nextAttemptAt, mm_got.nextAttemptAt) {
+				mmRetryOutboxEvent.t.Errorf("OutboxRepositoryMock.RetryOutboxEvent got unexpected parameter nextAttemptAt, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRetryOutboxEvent.RetryOutboxEventMock.defaultExpectation.expectationOrigins.originNextAttemptAt, *mm_want_ptrs.nextAttemptAt, mm_got.nextAttemptAt, minimock.Diff(*mm_want_ptrs.nextAttemptAt, mm_got.nextAttemptAt))
+			}
+
+			if mm_want_ptrs.lastError != nil && !minimock.Equal(*mm_want_ptrs.lastError, mm_got.lastError) {
+				mmRetryOutboxEvent.t.Errorf("OutboxRepositoryMock.RetryOutboxEvent got unexpected parameter lastError, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRetryOutboxEvent.RetryOutboxEventMock.defaultExpectation.expectationOrigins.originLastError, *mm_want_ptrs.lastError, mm_got.lastError, minimock.Diff(*mm_want_ptrs.lastError, mm_got.lastError))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRetryOutboxEvent.t.Errorf("OutboxRepositoryMock.RetryOutboxEvent got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRetryOutboxEvent.RetryOutboxEventMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRetryOutboxEvent.RetryOutboxEventMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRetryOutboxEvent.t.Fatal("No results are set for the OutboxRepositoryMock.RetryOutboxEvent")
+		}
+		return (*mm_results).err
+	}
+	if mmRetryOutboxEvent.funcRetryOutboxEvent != nil {
+		return mmRetryOutboxEvent.funcRetryOutboxEvent(ctx, id, nextAttemptAt, lastError)
+	}
+	mmRetryOutboxEvent.t.Fatalf("Unexpected call to OutboxRepositoryMock.RetryOutboxEvent. %v %v %v %v", ctx, id, nextAttemptAt, lastError)
+	return
+}
+
+// RetryOutboxEventAfterCounter returns a count of finished OutboxRepositoryMock.RetryOutboxEvent invocations
+func (mmRetryOutboxEvent *OutboxRepositoryMock) RetryOutboxEventAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRetryOutboxEvent.afterRetryOutboxEventCounter)
+}
+
+// RetryOutboxEventBeforeCounter returns a count of OutboxRepositoryMock.RetryOutboxEvent invocations
+func (mmRetryOutboxEvent *OutboxRepositoryMock) RetryOutboxEventBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRetryOutboxEvent.beforeRetryOutboxEventCounter)
+}
+
+// Calls returns a list of arguments used in each call to OutboxRepositoryMock.RetryOutboxEvent.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRetryOutboxEvent *mOutboxRepositoryMockRetryOutboxEvent) Calls() []*OutboxRepositoryMockRetryOutboxEventParams {
+	mmRetryOutboxEvent.mutex.RLock()
+
+	argCopy := make([]*OutboxRepositoryMockRetryOutboxEventParams, len(mmRetryOutboxEvent.callArgs))
+	copy(argCopy, mmRetryOutboxEvent.callArgs)
+
+	mmRetryOutboxEvent.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRetryOutboxEventDone returns true if the count of the RetryOutboxEvent invocations corresponds
+// the number of defined expectations
+func (m *OutboxRepositoryMock) MinimockRetryOutboxEventDone() bool {
+	if m.RetryOutboxEventMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RetryOutboxEventMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RetryOutboxEventMock.invocationsDone()
+}
+
+// MinimockRetryOutboxEventInspect logs each unmet expectation
+func (m *OutboxRepositoryMock) MinimockRetryOutboxEventInspect() {
+	for _, e := range m.RetryOutboxEventMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OutboxRepositoryMock.RetryOutboxEvent at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterRetryOutboxEventCounter := mm_atomic.LoadUint64(&m.afterRetryOutboxEventCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RetryOutboxEventMock.defaultExpectation != nil && afterRetryOutboxEventCounter < 1 {
+		if m.RetryOutboxEventMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OutboxRepositoryMock.RetryOutboxEvent at\n%s", m.RetryOutboxEventMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OutboxRepositoryMock.RetryOutboxEvent at\n%s with params: %#v", m.RetryOutboxEventMock.defaultExpectation.expectationOrigins.origin, *m.RetryOutboxEventMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRetryOutboxEvent != nil && afterRetryOutboxEventCounter < 1 {
+		m.t.Errorf("Expected call to OutboxRepositoryMock.RetryOutboxEvent at\n%s", m.funcRetryOutboxEventOrigin)
+	}
+
+	if !m.RetryOutboxEventMock.invocationsDone() && afterRetryOutboxEventCounter > 0 {
+		m.t.Errorf("Expected %d calls to OutboxRepositoryMock.RetryOutboxEvent at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RetryOutboxEventMock.expectedInvocations), m.RetryOutboxEventMock.expectedInvocationsOrigin, afterRetryOutboxEventCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *OutboxRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockClaimOutboxEventsInspect()
+
+			m.MinimockMarkOutboxEventPublishedInspect()
+
+			m.MinimockPurgeOutboxEventsInspect()
+
+			m.MinimockRetryOutboxEventInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *OutboxRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *OutboxRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockClaimOutboxEventsDone() &&
+		m.MinimockMarkOutboxEventPublishedDone() &&
+		m.MinimockPurgeOutboxEventsDone() &&
+		m.MinimockRetryOutboxEventDone()
+}
@@ -0,0 +1,145 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/alonsoF100/authorization-service/internal/config"
+	"github.com/alonsoF100/authorization-service/internal/models"
+	"github.com/alonsoF100/authorization-service/internal/service"
+	"github.com/gojuno/minimock/v3"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+// inTx stands in for a repository transaction
+func inTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+func TestDeleteUserOutboxError(t *testing.T) {
+	mc := minimock.NewController(t)
+	mockRepo := service.NewUserRepositoryMock(mc)
+	mockRepo.InTxMock.Set(inTx)
+
+	ctx := context.Background()
+	userID := uuid.New().String()
+	someErr := errors.New("database error")
+
+	mockRepo.DeleteUserMock.Expect(ctx, userID).Return(nil)
+	mockRepo.CreateOutboxEventMock.Return(someErr)
+
+	err := service.NewUserService(mockRepo, nil).DeleteUser(ctx, userID)
+	require.ErrorIs(t, err, someErr)
+}
+
+func TestOutboxRelay(t *testing.T) {
+	ctx := context.Background()
+	cfg := config.OutboxConfig{
+		BatchSize:  10,
+		Lease:      30 * time.Second,
+		MinBackoff: time.Second,
+		MaxBackoff: 10 * time.Second,
+	}
+	someErr := errors.New("connection refused")
+
+	event := func(id int64, attempts int, leasedFor time.Duration) models.OutboxEvent {
+		return models.OutboxEvent{
+			ID:            id,
+			Type:          models.EventUserRegistered,
+			AggregateID:   uuid.New().String(),
+			Attempts:      attempts,
+			NextAttemptAt: time.Now().Add(leasedFor),
+		}
+	}
+
+	tests := []struct {
+		name         string
+		events       []models.OutboxEvent
+		claimErr     error
+		publishErr   error
+		wantPublish  []int64
+		wantRetryIn  time.Duration
+		expectedErr  error
+		expectedSize int
+	}{
+		{
+			name:         "published in order",
+			events:       []models.OutboxEvent{event(1, 1, time.Minute), event(2, 1, time.Minute)},
+			wantPublish:  []int64{1, 2},
+			expectedSize: 2,
+		},
+		{
+			name:         "failed delivery backs off",
+			events:       []models.OutboxEvent{event(1, 3, time.Minute)},
+			publishErr:   someErr,
+			wantPublish:  []int64{1},
+			wantRetryIn:  4 * time.Second,
+			expectedSize: 1,
+		},
+		{
+			name:         "backoff is capped",
+			events:       []models.OutboxEvent{event(1, 20, time.Minute)},
+			publishErr:   someErr,
+			wantPublish:  []int64{1},
+			wantRetryIn:  10 * time.Second,
+			expectedSize: 1,
+		},
+		{
+			name:         "expired lease is skipped",
+			events:       []models.OutboxEvent{event(1, 1, -time.Second)},
+			expectedSize: 1,
+		},
+		{
+			name:        "claim error",
+			claimErr:    someErr,
+			expectedErr: someErr,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := minimock.NewController(t)
+			mockRepo := service.NewOutboxRepositoryMock(mc)
+			mockPublisher := service.NewEventPublisherMock(mc)
+
+			mockRepo.ClaimOutboxEventsMock.Set(func(_ context.Context, now, claimedUntil time.Time, limit int) ([]models.OutboxEvent, error) {
+				require.Equal(t, cfg.Lease, claimedUntil.Sub(now))
+				require.Equal(t, cfg.BatchSize, limit)
+				return tt.events, tt.claimErr
+			})
+
+			var published []int64
+			if len(tt.wantPublish) > 0 {
+				mockPublisher.PublishMock.Set(func(_ context.Context, event models.OutboxEvent) error {
+					published = append(published, event.ID)
+					return tt.publishErr
+				})
+			}
+			if len(tt.wantPublish) > 0 && tt.publishErr == nil {
+				mockRepo.MarkOutboxEventPublishedMock.Set(func(_ context.Context, id int64, publishedAt time.Time) error {
+					require.WithinDuration(t, time.Now(), publishedAt, time.Second)
+					return nil
+				})
+			}
+			if tt.publishErr != nil {
+				mockRepo.RetryOutboxEventMock.Set(func(_ context.Context, id int64, nextAttemptAt time.Time, lastError string) error {
+					require.WithinDuration(t, time.Now().Add(tt.wantRetryIn), nextAttemptAt, time.Second)
+					require.Equal(t, tt.publishErr.Error(), lastError)
+					return nil
+				})
+			}
+
+			claimed, err := service.NewOutboxRelay(mockRepo, mockPublisher, cfg).Relay(ctx)
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedSize, claimed)
+			require.Equal(t, tt.wantPublish, published)
+		})
+	}
+}
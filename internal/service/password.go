@@ -0,0 +1,45 @@
+package service
+
+import (
+	"sync/atomic"
+	"unicode/utf8"
+
+	"github.com/alonsoF100/authorization-service/internal/apperrors"
+	"github.com/alonsoF100/authorization-service/internal/config"
+)
+
+// PasswordPolicy checks new passwords against the password config. Update
+// swaps the policy on config reload while passwords are being checked.
+type PasswordPolicy struct {
+	cfg atomic.Pointer[config.PasswordConfig]
+}
+
+func NewPasswordPolicy(cfg config.PasswordConfig) *PasswordPolicy {
+	p := &PasswordPolicy{}
+	p.Update(cfg)
+
+	return p
+}
+
+func (p *PasswordPolicy) Update(cfg config.PasswordConfig) {
+	p.cfg.Store(&cfg)
+}
+
+// Check returns ErrWeakPassword for a password outside the policy. A nil
+// policy only keeps the bcrypt limit.
+func (p *PasswordPolicy) Check(password string) error {
+	if len(password) > config.MaxPasswordBytes {
+		return apperrors.ErrWeakPassword
+	}
+	if p == nil {
+		return nil
+	}
+
+	cfg := p.cfg.Load()
+	length := utf8.RuneCountInString(password)
+	if length < cfg.MinLength || (cfg.MaxLength > 0 && length > cfg.MaxLength) {
+		return apperrors.ErrWeakPassword
+	}
+
+	return nil
+}
@@ -0,0 +1,31 @@
+package service_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/alonsoF100/authorization-service/internal/apperrors"
+	"github.com/alonsoF100/authorization-service/internal/config"
+	"github.com/alonsoF100/authorization-service/internal/service"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPasswordPolicy(t *testing.T) {
+	policy := service.NewPasswordPolicy(config.PasswordConfig{MinLength: 8, MaxLength: 12})
+
+	require.NoError(t, policy.Check("password"))
+	// lengths count characters, not bytes
+	require.NoError(t, policy.Check("пароль12"))
+	require.ErrorIs(t, policy.Check("short"), apperrors.ErrWeakPassword)
+	require.ErrorIs(t, policy.Check("password12345"), apperrors.ErrWeakPassword)
+
+	policy.Update(config.PasswordConfig{MinLength: 4, MaxLength: 72})
+	require.NoError(t, policy.Check("pass"))
+	require.ErrorIs(t, policy.Check(strings.Repeat("я", 40)), apperrors.ErrWeakPassword)
+
+	t.Run("nil policy keeps the bcrypt limit", func(t *testing.T) {
+		var policy *service.PasswordPolicy
+		require.NoError(t, policy.Check("1"))
+		require.ErrorIs(t, policy.Check(strings.Repeat("a", 73)), apperrors.ErrWeakPassword)
+	})
+}
@@ -0,0 +1,202 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/alonsoF100/authorization-service/internal/apperrors"
+	"github.com/alonsoF100/authorization-service/internal/models"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+)
+
+const (
+	personalAccessTokenBytes = 32
+	// lastUsedTouchInterval limits last used writes of personal access
+	// tokens and API keys used in a loop
+	lastUsedTouchInterval = time.Minute
+)
+
+// CreatePersonalAccessToken returns the token and its plaintext, only the
+// hash is stored so the plaintext can not be shown again
+func (s UserService) CreatePersonalAccessToken(ctx context.Context, userID, name string, scopes []string, expiresAt *time.Time) (*models.PersonalAccessToken, string, error) {
+	const op = "service/personal_access_token.go/CreatePersonalAccessToken"
+
+	for _, scope := range scopes {
+		if !validScope(scope) {
+			return nil, "", apperrors.ErrMalformedScope
+		}
+	}
+
+	secret, err := randomToken(personalAccessTokenBytes)
+	if err != nil {
+		return nil, "", fmt.Errorf("%s: %w", op, err)
+	}
+	plaintext := models.PersonalAccessTokenPrefix + secret
+
+	token := &models.PersonalAccessToken{
+		ID:        uuid.New().String(),
+		UserID:    userID,
+		Name:      name,
+		TokenHash: hashSecret(plaintext),
+		Scopes:    scopes,
+		ExpiresAt: expiresAt,
+		CreatedAt: time.Now(),
+	}
+
+	if err := s.userRepository.CreatePersonalAccessToken(ctx, token); err != nil {
+		slog.Error("Database error during personal access token creation",
+			slog.String("op", op),
+			slog.String("user_id", userID),
+			slog.String("error", err.Error()),
+		)
+		return nil, "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	slog.Info("Personal access token created",
+		slog.String("op", op),
+		slog.String("user_id", userID),
+		slog.String("token_id", token.ID),
+	)
+	record(ctx, s.auditor, models.AuditEvent{
+		Type:     models.AuditTokenCreated,
+		TargetID: userID,
+		Details:  map[string]string{"token_id": token.ID, "name": name},
+	})
+
+	return token, plaintext, nil
+}
+
+func (s UserService) ListPersonalAccessTokens(ctx context.Context, userID string) ([]models.PersonalAccessToken, error) {
+	const op = "service/personal_access_token.go/ListPersonalAccessTokens"
+
+	tokens, err := s.userRepository.ListPersonalAccessTokens(ctx, userID)
+	if err != nil {
+		slog.Error("Database error during personal access token listing",
+			slog.String("op", op),
+			slog.String("user_id", userID),
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return tokens, nil
+}
+
+func (s UserService) DeletePersonalAccessToken(ctx context.Context, userID, tokenID string) error {
+	const op = "service/personal_access_token.go/DeletePersonalAccessToken"
+
+	// ids are UUIDs, anything else can not exist and would upset postgres
+	if uuid.Validate(tokenID) != nil {
+		return apperrors.ErrTokenNotFound
+	}
+
+	err := s.userRepository.DeletePersonalAccessToken(ctx, userID, tokenID)
+	if err != nil {
+		if errors.Is(err, apperrors.ErrTokenNotFound) {
+			return apperrors.ErrTokenNotFound
+		}
+
+		slog.Error("Database error during personal access token deletion",
+			slog.String("op", op),
+			slog.String("user_id", userID),
+			slog.String("error", err.Error()),
+		)
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	slog.Info("Personal access token deleted",
+		slog.String("op", op),
+		slog.String("user_id", userID),
+		slog.String("token_id", tokenID),
+	)
+	record(ctx, s.auditor, models.AuditEvent{
+		Type:     models.AuditTokenDeleted,
+		TargetID: userID,
+		Details:  map[string]string{"token_id": tokenID},
+	})
+
+	return nil
+}
+
+// AuthenticatePersonalAccessToken returns user claims for a personal access
+// token, limited to the scopes of the token. The roles of the user are only
+// carried with the admin scope. The user is loaded on every call so
+// disabling an account locks out its tokens immediately.
+func (s UserService) AuthenticatePersonalAccessToken(ctx context.Context, plaintext, ip string) (*models.Claims, error) {
+	const op = "service/personal_access_token.go/AuthenticatePersonalAccessToken"
+
+	if !strings.HasPrefix(plaintext, models.PersonalAccessTokenPrefix) {
+		return nil, apperrors.ErrInvalidToken
+	}
+
+	token, err := s.userRepository.FindPersonalAccessTokenByHash(ctx, hashSecret(plaintext))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	now := time.Now()
+	if token == nil || token.Expired(now) {
+		slog.Info("Personal access token rejected",
+			slog.String("op", op),
+			slog.Bool("found", token != nil),
+		)
+		return nil, apperrors.ErrInvalidToken
+	}
+
+	user, err := s.userRepository.FindByID(ctx, token.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if user == nil {
+		return nil, apperrors.ErrInvalidToken
+	}
+	if user.Disabled() {
+		return nil, apperrors.ErrUserDisabled
+	}
+	if !ipAllowed(user.AllowedIPs, ip) {
+		slog.Info("Personal access token used from a not allowed address",
+			slog.String("op", op),
+			slog.String("token_id", token.ID),
+			slog.String("ip", ip),
+		)
+		return nil, apperrors.ErrIPNotAllowed
+	}
+
+	if token.LastUsedAt == nil || token.LastUsedIP != ip || now.Sub(*token.LastUsedAt) >= lastUsedTouchInterval {
+		// a failed write only loses usage data, the request can go on
+		if err := s.userRepository.TouchPersonalAccessToken(ctx, token.ID, now, ip); err != nil {
+			slog.Warn("Failed to record personal access token usage",
+				slog.String("op", op),
+				slog.String("token_id", token.ID),
+				slog.String("error", err.Error()),
+			)
+		}
+	}
+
+	claims := &models.Claims{
+		ID:            user.ID,
+		Email:         user.Email,
+		Nickname:      user.Nickname,
+		Scope:         strings.Join(token.Scopes, " "),
+		PrincipalType: models.PrincipalUser,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:  user.ID,
+			ID:       token.ID,
+			IssuedAt: jwt.NewNumericDate(token.CreatedAt),
+		},
+	}
+	if token.ExpiresAt != nil {
+		claims.ExpiresAt = jwt.NewNumericDate(*token.ExpiresAt)
+	}
+	if slices.Contains(token.Scopes, models.ScopeAdmin) {
+		claims.Roles = user.Roles
+	}
+
+	return claims, nil
+}
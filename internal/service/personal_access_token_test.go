@@ -0,0 +1,269 @@
+package service_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alonsoF100/authorization-service/internal/apperrors"
+	"github.com/alonsoF100/authorization-service/internal/models"
+	"github.com/alonsoF100/authorization-service/internal/service"
+	"github.com/gojuno/minimock/v3"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreatePersonalAccessToken(t *testing.T) {
+	mc := minimock.NewController(t)
+	mockRepo := service.NewUserRepositoryMock(mc)
+
+	ctx := context.Background()
+	userID := uuid.New().String()
+	expiresAt := time.Now().Add(time.Hour)
+
+	var stored *models.PersonalAccessToken
+	mockRepo.CreatePersonalAccessTokenMock.Set(func(_ context.Context, token *models.PersonalAccessToken) error {
+		stored = token
+		return nil
+	})
+
+	userService := service.NewUserService(mockRepo, nil)
+
+	token, plaintext, err := userService.CreatePersonalAccessToken(ctx, userID, "ci", []string{"read"}, &expiresAt)
+	require.NoError(t, err)
+	require.Same(t, stored, token)
+	require.True(t, strings.HasPrefix(plaintext, models.PersonalAccessTokenPrefix))
+	require.Equal(t, userID, token.UserID)
+	require.Equal(t, "ci", token.Name)
+	require.Equal(t, []string{"read"}, token.Scopes)
+	require.Equal(t, &expiresAt, token.ExpiresAt)
+
+	// only the hash is stored
+	sum := sha256.Sum256([]byte(plaintext))
+	require.Equal(t, hex.EncodeToString(sum[:]), token.TokenHash)
+	require.NotContains(t, token.TokenHash, plaintext)
+}
+
+func TestCreatePersonalAccessTokenMalformedScope(t *testing.T) {
+	mc := minimock.NewController(t)
+	mockRepo := service.NewUserRepositoryMock(mc)
+
+	userService := service.NewUserService(mockRepo, nil)
+
+	_, _, err := userService.CreatePersonalAccessToken(context.Background(), uuid.New().String(), "ci", []string{"read write"}, nil)
+	require.ErrorIs(t, err, apperrors.ErrMalformedScope)
+}
+
+func TestDeletePersonalAccessToken(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New().String()
+	tokenID := uuid.New().String()
+
+	tests := []struct {
+		name        string
+		tokenID     string
+		setupMocks  func(mockRepo *service.UserRepositoryMock)
+		expectedErr error
+	}{
+		{
+			name:    "deleted",
+			tokenID: tokenID,
+			setupMocks: func(mockRepo *service.UserRepositoryMock) {
+				mockRepo.DeletePersonalAccessTokenMock.Expect(ctx, userID, tokenID).Return(nil)
+			},
+		},
+		{
+			name:    "not found",
+			tokenID: tokenID,
+			setupMocks: func(mockRepo *service.UserRepositoryMock) {
+				mockRepo.DeletePersonalAccessTokenMock.Expect(ctx, userID, tokenID).Return(apperrors.ErrTokenNotFound)
+			},
+			expectedErr: apperrors.ErrTokenNotFound,
+		},
+		{
+			name:        "malformed id",
+			tokenID:     "not-a-uuid",
+			setupMocks:  func(mockRepo *service.UserRepositoryMock) {},
+			expectedErr: apperrors.ErrTokenNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := minimock.NewController(t)
+			mockRepo := service.NewUserRepositoryMock(mc)
+			tt.setupMocks(mockRepo)
+
+			err := service.NewUserService(mockRepo, nil).DeletePersonalAccessToken(ctx, userID, tt.tokenID)
+			if tt.expectedErr == nil {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, tt.expectedErr)
+		})
+	}
+}
+
+func TestAuthenticatePersonalAccessToken(t *testing.T) {
+	ctx := context.Background()
+	plaintext := models.PersonalAccessTokenPrefix + "secret"
+	sum := sha256.Sum256([]byte(plaintext))
+	tokenHash := hex.EncodeToString(sum[:])
+
+	user := &models.User{
+		ID:       uuid.New().String(),
+		Nickname: "alonso",
+		Email:    "alonso@mail.ru",
+		Roles:    []string{models.RoleAdmin},
+	}
+	past := time.Now().Add(-time.Hour)
+	future := time.Now().Add(time.Hour)
+	recently := time.Now().Add(-time.Second)
+
+	newToken := func(modify func(token *models.PersonalAccessToken)) *models.PersonalAccessToken {
+		token := &models.PersonalAccessToken{
+			ID:        uuid.New().String(),
+			UserID:    user.ID,
+			Name:      "ci",
+			TokenHash: tokenHash,
+			Scopes:    []string{"read", "write"},
+			CreatedAt: past,
+		}
+		modify(token)
+		return token
+	}
+
+	tests := []struct {
+		name        string
+		token       string
+		setupMocks  func(mockRepo *service.UserRepositoryMock)
+		wantScope   string
+		wantRoles   []string
+		expectedErr error
+	}{
+		{
+			name:  "valid token records usage",
+			token: plaintext,
+			setupMocks: func(mockRepo *service.UserRepositoryMock) {
+				token := newToken(func(token *models.PersonalAccessToken) { token.ExpiresAt = &future })
+				mockRepo.FindPersonalAccessTokenByHashMock.Expect(ctx, tokenHash).Return(token, nil)
+				mockRepo.FindByIDMock.Expect(ctx, user.ID).Return(user, nil)
+				mockRepo.TouchPersonalAccessTokenMock.Set(func(_ context.Context, tokenID string, _ time.Time, ip string) error {
+					require.Equal(t, token.ID, tokenID)
+					require.Equal(t, "192.0.2.1", ip)
+					return nil
+				})
+			},
+			wantScope: "read write",
+		},
+		{
+			name:  "recent usage from the same ip is not recorded again",
+			token: plaintext,
+			setupMocks: func(mockRepo *service.UserRepositoryMock) {
+				token := newToken(func(token *models.PersonalAccessToken) {
+					token.LastUsedAt = &recently
+					token.LastUsedIP = "192.0.2.1"
+				})
+				mockRepo.FindPersonalAccessTokenByHashMock.Expect(ctx, tokenHash).Return(token, nil)
+				mockRepo.FindByIDMock.Expect(ctx, user.ID).Return(user, nil)
+			},
+			wantScope: "read write",
+		},
+		{
+			name:  "failed usage write does not fail authentication",
+			token: plaintext,
+			setupMocks: func(mockRepo *service.UserRepositoryMock) {
+				token := newToken(func(token *models.PersonalAccessToken) {})
+				mockRepo.FindPersonalAccessTokenByHashMock.Expect(ctx, tokenHash).Return(token, nil)
+				mockRepo.FindByIDMock.Expect(ctx, user.ID).Return(user, nil)
+				mockRepo.TouchPersonalAccessTokenMock.Return(errors.New("database error"))
+			},
+			wantScope: "read write",
+		},
+		{
+			name:  "admin scope carries the roles",
+			token: plaintext,
+			setupMocks: func(mockRepo *service.UserRepositoryMock) {
+				token := newToken(func(token *models.PersonalAccessToken) {
+					token.Scopes = []string{"read", models.ScopeAdmin}
+					token.LastUsedAt = &recently
+					token.LastUsedIP = "192.0.2.1"
+				})
+				mockRepo.FindPersonalAccessTokenByHashMock.Expect(ctx, tokenHash).Return(token, nil)
+				mockRepo.FindByIDMock.Expect(ctx, user.ID).Return(user, nil)
+			},
+			wantScope: "read admin",
+			wantRoles: user.Roles,
+		},
+		{
+			name:        "missing prefix",
+			token:       "secret",
+			setupMocks:  func(mockRepo *service.UserRepositoryMock) {},
+			expectedErr: apperrors.ErrInvalidToken,
+		},
+		{
+			name:  "unknown token",
+			token: plaintext,
+			setupMocks: func(mockRepo *service.UserRepositoryMock) {
+				mockRepo.FindPersonalAccessTokenByHashMock.Expect(ctx, tokenHash).Return(nil, nil)
+			},
+			expectedErr: apperrors.ErrInvalidToken,
+		},
+		{
+			name:  "expired token",
+			token: plaintext,
+			setupMocks: func(mockRepo *service.UserRepositoryMock) {
+				token := newToken(func(token *models.PersonalAccessToken) { token.ExpiresAt = &past })
+				mockRepo.FindPersonalAccessTokenByHashMock.Expect(ctx, tokenHash).Return(token, nil)
+			},
+			expectedErr: apperrors.ErrInvalidToken,
+		},
+		{
+			name:  "disabled user",
+			token: plaintext,
+			setupMocks: func(mockRepo *service.UserRepositoryMock) {
+				disabled := *user
+				disabled.DisabledAt = &past
+				mockRepo.FindPersonalAccessTokenByHashMock.Expect(ctx, tokenHash).Return(newToken(func(token *models.PersonalAccessToken) {}), nil)
+				mockRepo.FindByIDMock.Expect(ctx, user.ID).Return(&disabled, nil)
+			},
+			expectedErr: apperrors.ErrUserDisabled,
+		},
+		{
+			name:  "address not allowed for user",
+			token: plaintext,
+			setupMocks: func(mockRepo *service.UserRepositoryMock) {
+				restricted := *user
+				restricted.AllowedIPs = []string{"203.0.113.0/24"}
+				mockRepo.FindPersonalAccessTokenByHashMock.Expect(ctx, tokenHash).Return(newToken(func(token *models.PersonalAccessToken) {}), nil)
+				mockRepo.FindByIDMock.Expect(ctx, user.ID).Return(&restricted, nil)
+			},
+			expectedErr: apperrors.ErrIPNotAllowed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := minimock.NewController(t)
+			mockRepo := service.NewUserRepositoryMock(mc)
+			tt.setupMocks(mockRepo)
+
+			claims, err := service.NewUserService(mockRepo, nil).AuthenticatePersonalAccessToken(ctx, tt.token, "192.0.2.1")
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+				require.Nil(t, claims)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, user.ID, claims.ID)
+			require.Equal(t, tt.wantRoles, claims.Roles)
+			require.Equal(t, tt.wantScope, claims.Scope)
+			require.Equal(t, models.PrincipalUser, claims.Principal())
+		})
+	}
+}
@@ -0,0 +1,285 @@
+package service
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/alonsoF100/authorization-service/internal/apperrors"
+	"github.com/alonsoF100/authorization-service/internal/models"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+)
+
+const apiKeyBytes = 32
+
+type ServiceAccountRepository interface {
+	CreateServiceAccount(ctx context.Context, account *models.ServiceAccount) error
+	ListServiceAccounts(ctx context.Context) ([]models.ServiceAccount, error)
+	FindServiceAccountByID(ctx context.Context, accountID string) (*models.ServiceAccount, error)
+	DeleteServiceAccount(ctx context.Context, accountID string) error
+	CreateAPIKey(ctx context.Context, key *models.APIKey) error
+	ListAPIKeys(ctx context.Context, accountID string) ([]models.APIKey, error)
+	FindAPIKeyByID(ctx context.Context, keyID string) (*models.APIKey, error)
+	DeleteAPIKey(ctx context.Context, accountID, keyID string) error
+	TouchAPIKey(ctx context.Context, keyID string, usedAt time.Time) error
+}
+
+// ServiceAccountService manages service accounts and their API keys. A
+// service account has any number of keys so a key can be rotated by
+// creating a new one and deleting the old one once clients switched over.
+type ServiceAccountService struct {
+	repository ServiceAccountRepository
+	auditor    Auditor
+}
+
+func NewServiceAccountService(repository ServiceAccountRepository, auditor Auditor) *ServiceAccountService {
+	return &ServiceAccountService{
+		repository: repository,
+		auditor:    auditor,
+	}
+}
+
+func (s ServiceAccountService) CreateServiceAccount(ctx context.Context, ownerID, name string, roles []string) (*models.ServiceAccount, error) {
+	const op = "service/service_account.go/CreateServiceAccount"
+
+	account := &models.ServiceAccount{
+		ID:        uuid.New().String(),
+		Name:      name,
+		OwnerID:   ownerID,
+		Roles:     roles,
+		CreatedAt: time.Now(),
+	}
+
+	if err := s.repository.CreateServiceAccount(ctx, account); err != nil {
+		if errors.Is(err, apperrors.ErrServiceAccountExist) {
+			return nil, apperrors.ErrServiceAccountExist
+		}
+
+		slog.Error("Database error during service account creation",
+			slog.String("op", op),
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	slog.Info("Service account created",
+		slog.String("op", op),
+		slog.String("service_account_id", account.ID),
+		slog.String("owner_id", ownerID),
+	)
+	record(ctx, s.auditor, models.AuditEvent{
+		Type:     models.AuditServiceAccountCreated,
+		TargetID: account.ID,
+		Details:  map[string]string{"name": account.Name},
+	})
+
+	return account, nil
+}
+
+func (s ServiceAccountService) ListServiceAccounts(ctx context.Context) ([]models.ServiceAccount, error) {
+	const op = "service/service_account.go/ListServiceAccounts"
+
+	accounts, err := s.repository.ListServiceAccounts(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return accounts, nil
+}
+
+func (s ServiceAccountService) GetServiceAccount(ctx context.Context, accountID string) (*models.ServiceAccount, error) {
+	const op = "service/service_account.go/GetServiceAccount"
+
+	// ids are UUIDs, anything else can not exist and would upset postgres
+	if uuid.Validate(accountID) != nil {
+		return nil, apperrors.ErrServiceAccountNotFound
+	}
+
+	account, err := s.repository.FindServiceAccountByID(ctx, accountID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if account == nil {
+		return nil, apperrors.ErrServiceAccountNotFound
+	}
+
+	return account, nil
+}
+
+// DeleteServiceAccount deletes the account together with its keys
+func (s ServiceAccountService) DeleteServiceAccount(ctx context.Context, accountID string) error {
+	const op = "service/service_account.go/DeleteServiceAccount"
+
+	if uuid.Validate(accountID) != nil {
+		return apperrors.ErrServiceAccountNotFound
+	}
+
+	if err := s.repository.DeleteServiceAccount(ctx, accountID); err != nil {
+		if errors.Is(err, apperrors.ErrServiceAccountNotFound) {
+			return apperrors.ErrServiceAccountNotFound
+		}
+
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	slog.Info("Service account deleted",
+		slog.String("op", op),
+		slog.String("service_account_id", accountID),
+	)
+	record(ctx, s.auditor, models.AuditEvent{
+		Type:     models.AuditServiceAccountDeleted,
+		TargetID: accountID,
+	})
+
+	return nil
+}
+
+// CreateAPIKey returns the key and its plaintext, only the hash is stored so
+// the plaintext can not be shown again
+func (s ServiceAccountService) CreateAPIKey(ctx context.Context, accountID string) (*models.APIKey, string, error) {
+	const op = "service/service_account.go/CreateAPIKey"
+
+	if _, err := s.GetServiceAccount(ctx, accountID); err != nil {
+		return nil, "", err
+	}
+
+	secret, err := randomToken(apiKeyBytes)
+	if err != nil {
+		return nil, "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	key := &models.APIKey{
+		ID:               uuid.New().String(),
+		ServiceAccountID: accountID,
+		CreatedAt:        time.Now(),
+	}
+	plaintext := models.APIKeyPrefix + key.ID + "." + secret
+	key.KeyHash = hashSecret(plaintext)
+
+	if err := s.repository.CreateAPIKey(ctx, key); err != nil {
+		slog.Error("Database error during api key creation",
+			slog.String("op", op),
+			slog.String("service_account_id", accountID),
+			slog.String("error", err.Error()),
+		)
+		return nil, "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	slog.Info("API key created",
+		slog.String("op", op),
+		slog.String("service_account_id", accountID),
+		slog.String("key_id", key.ID),
+	)
+	record(ctx, s.auditor, models.AuditEvent{
+		Type:     models.AuditAPIKeyCreated,
+		TargetID: accountID,
+		Details:  map[string]string{"key_id": key.ID},
+	})
+
+	return key, plaintext, nil
+}
+
+func (s ServiceAccountService) ListAPIKeys(ctx context.Context, accountID string) ([]models.APIKey, error) {
+	const op = "service/service_account.go/ListAPIKeys"
+
+	if _, err := s.GetServiceAccount(ctx, accountID); err != nil {
+		return nil, err
+	}
+
+	keys, err := s.repository.ListAPIKeys(ctx, accountID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return keys, nil
+}
+
+func (s ServiceAccountService) DeleteAPIKey(ctx context.Context, accountID, keyID string) error {
+	const op = "service/service_account.go/DeleteAPIKey"
+
+	if uuid.Validate(accountID) != nil || uuid.Validate(keyID) != nil {
+		return apperrors.ErrAPIKeyNotFound
+	}
+
+	if err := s.repository.DeleteAPIKey(ctx, accountID, keyID); err != nil {
+		if errors.Is(err, apperrors.ErrAPIKeyNotFound) {
+			return apperrors.ErrAPIKeyNotFound
+		}
+
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	slog.Info("API key deleted",
+		slog.String("op", op),
+		slog.String("service_account_id", accountID),
+		slog.String("key_id", keyID),
+	)
+	record(ctx, s.auditor, models.AuditEvent{
+		Type:     models.AuditAPIKeyDeleted,
+		TargetID: accountID,
+		Details:  map[string]string{"key_id": keyID},
+	})
+
+	return nil
+}
+
+// AuthenticateAPIKey returns service account claims for an API key. The key
+// id embedded in the key finds the stored hash, which is compared in
+// constant time.
+func (s ServiceAccountService) AuthenticateAPIKey(ctx context.Context, plaintext string) (*models.Claims, error) {
+	const op = "service/service_account.go/AuthenticateAPIKey"
+
+	keyID, _, ok := strings.Cut(strings.TrimPrefix(plaintext, models.APIKeyPrefix), ".")
+	if !ok || !strings.HasPrefix(plaintext, models.APIKeyPrefix) || uuid.Validate(keyID) != nil {
+		return nil, apperrors.ErrInvalidToken
+	}
+
+	key, err := s.repository.FindAPIKeyByID(ctx, keyID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if key == nil || subtle.ConstantTimeCompare([]byte(hashSecret(plaintext)), []byte(key.KeyHash)) != 1 {
+		slog.Info("API key rejected",
+			slog.String("op", op),
+			slog.String("key_id", keyID),
+		)
+		return nil, apperrors.ErrInvalidToken
+	}
+
+	account, err := s.repository.FindServiceAccountByID(ctx, key.ServiceAccountID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if account == nil {
+		return nil, apperrors.ErrInvalidToken
+	}
+
+	now := time.Now()
+	if key.LastUsedAt == nil || now.Sub(*key.LastUsedAt) >= lastUsedTouchInterval {
+		// a failed write only loses usage data, the request can go on
+		if err := s.repository.TouchAPIKey(ctx, key.ID, now); err != nil {
+			slog.Warn("Failed to record api key usage",
+				slog.String("op", op),
+				slog.String("key_id", key.ID),
+				slog.String("error", err.Error()),
+			)
+		}
+	}
+
+	return &models.Claims{
+		ID:            account.ID,
+		Nickname:      account.Name,
+		Roles:         account.Roles,
+		PrincipalType: models.PrincipalServiceAccount,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:  account.ID,
+			ID:       key.ID,
+			IssuedAt: jwt.NewNumericDate(key.CreatedAt),
+		},
+	}, nil
+}
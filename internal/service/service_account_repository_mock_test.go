@@ -0,0 +1,3293 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package service
+
+//go:generate minimock -i github.com/alonsoF100/authorization-service/internal/service.ServiceAccountRepository -o service_account_repository_mock_test.go -n ServiceAccountRepositoryMock -p service
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	"time"
+	mm_time "time"
+
+	"github.com/alonsoF100/authorization-service/internal/models"
+	"github.com/gojuno/minimock/v3"
+)
+
+// ServiceAccountRepositoryMock implements ServiceAccountRepository
+type ServiceAccountRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcCreateAPIKey          func(ctx context.Context, key *models.APIKey) (err error)
+	funcCreateAPIKeyOrigin    string
+	inspectFuncCreateAPIKey   func(ctx context.Context, key *models.APIKey)
+	afterCreateAPIKeyCounter  uint64
+	beforeCreateAPIKeyCounter uint64
+	CreateAPIKeyMock          mServiceAccountRepositoryMockCreateAPIKey
+
+	funcCreateServiceAccount          func(ctx context.Context, account *models.ServiceAccount) (err error)
+	funcCreateServiceAccountOrigin    string
+	inspectFuncCreateServiceAccount   func(ctx context.Context, account *models.ServiceAccount)
+	afterCreateServiceAccountCounter  uint64
+	beforeCreateServiceAccountCounter uint64
+	CreateServiceAccountMock          mServiceAccountRepositoryMockCreateServiceAccount
+
+	funcDeleteAPIKey          func(ctx context.Context, accountID string, keyID string) (err error)
+	funcDeleteAPIKeyOrigin    string
+	inspectFuncDeleteAPIKey   func(ctx context.Context, accountID string, keyID string)
+	afterDeleteAPIKeyCounter  uint64
+	beforeDeleteAPIKeyCounter uint64
+	DeleteAPIKeyMock          mServiceAccountRepositoryMockDeleteAPIKey
+
+	funcDeleteServiceAccount          func(ctx context.Context, accountID string) (err error)
+	funcDeleteServiceAccountOrigin    string
+	inspectFuncDeleteServiceAccount   func(ctx context.Context, accountID string)
+	afterDeleteServiceAccountCounter  uint64
+	beforeDeleteServiceAccountCounter uint64
+	DeleteServiceAccountMock          mServiceAccountRepositoryMockDeleteServiceAccount
+
+	funcFindAPIKeyByID          func(ctx context.Context, keyID string) (ap1 *models.APIKey, err error)
+	funcFindAPIKeyByIDOrigin    string
+	inspectFuncFindAPIKeyByID   func(ctx context.Context, keyID string)
+	afterFindAPIKeyByIDCounter  uint64
+	beforeFindAPIKeyByIDCounter uint64
+	FindAPIKeyByIDMock          mServiceAccountRepositoryMockFindAPIKeyByID
+
+	funcFindServiceAccountByID          func(ctx context.Context, accountID string) (sp1 *models.ServiceAccount, err error)
+	funcFindServiceAccountByIDOrigin    string
+	inspectFuncFindServiceAccountByID   func(ctx context.Context, accountID string)
+	afterFindServiceAccountByIDCounter  uint64
+	beforeFindServiceAccountByIDCounter uint64
+	FindServiceAccountByIDMock          mServiceAccountRepositoryMockFindServiceAccountByID
+
+	funcListAPIKeys          func(ctx context.Context, accountID string) (aa1 []models.APIKey, err error)
+	funcListAPIKeysOrigin    string
+	inspectFuncListAPIKeys   func(ctx context.Context, accountID string)
+	afterListAPIKeysCounter  uint64
+	beforeListAPIKeysCounter uint64
+	ListAPIKeysMock          mServiceAccountRepositoryMockListAPIKeys
+
+	funcListServiceAccounts          func(ctx context.Context) (sa1 []models.ServiceAccount, err error)
+	funcListServiceAccountsOrigin    string
+	inspectFuncListServiceAccounts   func(ctx context.Context)
+	afterListServiceAccountsCounter  uint64
+	beforeListServiceAccountsCounter uint64
+	ListServiceAccountsMock          mServiceAccountRepositoryMockListServiceAccounts
+
+	funcTouchAPIKey          func(ctx context.Context, keyID string, usedAt time.Time) (err error)
+	funcTouchAPIKeyOrigin    string
+	inspectFuncTouchAPIKey   func(ctx context.Context, keyID string, usedAt time.Time)
+	afterTouchAPIKeyCounter  uint64
+	beforeTouchAPIKeyCounter uint64
+	TouchAPIKeyMock          mServiceAccountRepositoryMockTouchAPIKey
+}
+
+// NewServiceAccountRepositoryMock returns a mock for ServiceAccountRepository
+func NewServiceAccountRepositoryMock(t minimock.Tester) *ServiceAccountRepositoryMock {
+	m := &ServiceAccountRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CreateAPIKeyMock = mServiceAccountRepositoryMockCreateAPIKey{mock: m}
+	m.CreateAPIKeyMock.callArgs = []*ServiceAccountRepositoryMockCreateAPIKeyParams{}
+
+	m.CreateServiceAccountMock = mServiceAccountRepositoryMockCreateServiceAccount{mock: m}
+	m.CreateServiceAccountMock.callArgs = []*ServiceAccountRepositoryMockCreateServiceAccountParams{}
+
+	m.DeleteAPIKeyMock = mServiceAccountRepositoryMockDeleteAPIKey{mock: m}
+	m.DeleteAPIKeyMock.callArgs = []*ServiceAccountRepositoryMockDeleteAPIKeyParams{}
+
+	m.DeleteServiceAccountMock = mServiceAccountRepositoryMockDeleteServiceAccount{mock: m}
+	m.DeleteServiceAccountMock.callArgs = []*ServiceAccountRepositoryMockDeleteServiceAccountParams{}
+
+	m.FindAPIKeyByIDMock = mServiceAccountRepositoryMockFindAPIKeyByID{mock: m}
+	m.FindAPIKeyByIDMock.callArgs = []*ServiceAccountRepositoryMockFindAPIKeyByIDParams{}
+
+	m.FindServiceAccountByIDMock = mServiceAccountRepositoryMockFindServiceAccountByID{mock: m}
+	m.FindServiceAccountByIDMock.callArgs = []*ServiceAccountRepositoryMockFindServiceAccountByIDParams{}
+
+	m.ListAPIKeysMock = mServiceAccountRepositoryMockListAPIKeys{mock: m}
+	m.ListAPIKeysMock.callArgs = []*ServiceAccountRepositoryMockListAPIKeysParams{}
+
+	m.ListServiceAccountsMock = mServiceAccountRepositoryMockListServiceAccounts{mock: m}
+	m.ListServiceAccountsMock.callArgs = []*ServiceAccountRepositoryMockListServiceAccountsParams{}
+
+	m.TouchAPIKeyMock = mServiceAccountRepositoryMockTouchAPIKey{mock: m}
+	m.TouchAPIKeyMock.callArgs = []*ServiceAccountRepositoryMockTouchAPIKeyParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mServiceAccountRepositoryMockCreateAPIKey struct {
+	optional           bool
+	mock               *ServiceAccountRepositoryMock
+	defaultExpectation *ServiceAccountRepositoryMockCreateAPIKeyExpectation
+	expectations       []*ServiceAccountRepositoryMockCreateAPIKeyExpectation
+
+	callArgs []*ServiceAccountRepositoryMockCreateAPIKeyParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ServiceAccountRepositoryMockCreateAPIKeyExpectation specifies expectation struct of the ServiceAccountRepository.CreateAPIKey
+type ServiceAccountRepositoryMockCreateAPIKeyExpectation struct {
+	mock               *ServiceAccountRepositoryMock
+	params             *ServiceAccountRepositoryMockCreateAPIKeyParams
+	paramPtrs          *ServiceAccountRepositoryMockCreateAPIKeyParamPtrs
+	expectationOrigins ServiceAccountRepositoryMockCreateAPIKeyExpectationOrigins
+	results            *ServiceAccountRepositoryMockCreateAPIKeyResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ServiceAccountRepositoryMockCreateAPIKeyParams contains parameters of the ServiceAccountRepository.CreateAPIKey
+type ServiceAccountRepositoryMockCreateAPIKeyParams struct {
+	ctx context.Context
+	key *models.APIKey
+}
+
+// ServiceAccountRepositoryMockCreateAPIKeyParamPtrs contains pointers to parameters of the ServiceAccountRepository.CreateAPIKey
+type ServiceAccountRepositoryMockCreateAPIKeyParamPtrs struct {
+	ctx *context.Context
+	key **models.APIKey
+}
+
+// ServiceAccountRepositoryMockCreateAPIKeyResults contains results of the ServiceAccountRepository.CreateAPIKey
+type ServiceAccountRepositoryMockCreateAPIKeyResults struct {
+	err error
+}
+
+// ServiceAccountRepositoryMockCreateAPIKeyOrigins contains origins of expectations of the ServiceAccountRepository.CreateAPIKey
+type ServiceAccountRepositoryMockCreateAPIKeyExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originKey string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateAPIKey *mServiceAccountRepositoryMockCreateAPIKey) Optional() *mServiceAccountRepositoryMockCreateAPIKey {
+	mmCreateAPIKey.optional = true
+	return mmCreateAPIKey
+}
+
+// Expect sets up expected params for ServiceAccountRepository.CreateAPIKey
+func (mmCreateAPIKey *mServiceAccountRepositoryMockCreateAPIKey) Expect(ctx context.Context, key *models.APIKey) *mServiceAccountRepositoryMockCreateAPIKey {
+	if mmCreateAPIKey.mock.funcCreateAPIKey != nil {
+		mmCreateAPIKey.mock.t.Fatalf("ServiceAccountRepositoryMock.CreateAPIKey mock is already set by Set")
+	}
+
+	if mmCreateAPIKey.defaultExpectation == nil {
+		mmCreateAPIKey.defaultExpectation = &ServiceAccountRepositoryMockCreateAPIKeyExpectation{}
+	}
+
+	if mmCreateAPIKey.defaultExpectation.paramPtrs != nil {
+		mmCreateAPIKey.mock.t.Fatalf("ServiceAccountRepositoryMock.CreateAPIKey mock is already set by ExpectParams functions")
+	}
+
+	mmCreateAPIKey.defaultExpectation.params = &ServiceAccountRepositoryMockCreateAPIKeyParams{ctx, key}
+	mmCreateAPIKey.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreateAPIKey.expectations {
+		if minimock.Equal(e.params, mmCreateAPIKey.defaultExpectation.params) {
+			mmCreateAPIKey.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateAPIKey.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateAPIKey
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ServiceAccountRepository.CreateAPIKey
+func (mmCreateAPIKey *mServiceAccountRepositoryMockCreateAPIKey) ExpectCtxParam1(ctx context.Context) *mServiceAccountRepositoryMockCreateAPIKey {
+	if mmCreateAPIKey.mock.funcCreateAPIKey != nil {
+		mmCreateAPIKey.mock.t.Fatalf("ServiceAccountRepositoryMock.CreateAPIKey mock is already set by Set")
+	}
+
+	if mmCreateAPIKey.defaultExpectation == nil {
+		mmCreateAPIKey.defaultExpectation = &ServiceAccountRepositoryMockCreateAPIKeyExpectation{}
+	}
+
+	if mmCreateAPIKey.defaultExpectation.params != nil {
+		mmCreateAPIKey.mock.t.Fatalf("ServiceAccountRepositoryMock.CreateAPIKey mock is already set by Expect")
+	}
+
+	if mmCreateAPIKey.defaultExpectation.paramPtrs == nil {
+		mmCreateAPIKey.defaultExpectation.paramPtrs = &ServiceAccountRepositoryMockCreateAPIKeyParamPtrs{}
+	}
+	mmCreateAPIKey.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreateAPIKey.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreateAPIKey
+}
+
+// ExpectKeyParam2 sets up expected param key for ServiceAccountRepository.CreateAPIKey
+func (mmCreateAPIKey *mServiceAccountRepositoryMockCreateAPIKey) ExpectKeyParam2(key *models.APIKey) *mServiceAccountRepositoryMockCreateAPIKey {
+	if mmCreateAPIKey.mock.funcCreateAPIKey != nil {
+		mmCreateAPIKey.mock.t.Fatalf("ServiceAccountRepositoryMock.CreateAPIKey mock is already set by Set")
+	}
+
+	if mmCreateAPIKey.defaultExpectation == nil {
+		mmCreateAPIKey.defaultExpectation = &ServiceAccountRepositoryMockCreateAPIKeyExpectation{}
+	}
+
+	if mmCreateAPIKey.defaultExpectation.params != nil {
+		mmCreateAPIKey.mock.t.Fatalf("ServiceAccountRepositoryMock.CreateAPIKey mock is already set by Expect")
+	}
+
+	if mmCreateAPIKey.defaultExpectation.paramPtrs == nil {
+		mmCreateAPIKey.defaultExpectation.paramPtrs = &ServiceAccountRepositoryMockCreateAPIKeyParamPtrs{}
+	}
+	mmCreateAPIKey.defaultExpectation.paramPtrs.key = &key
+	mmCreateAPIKey.defaultExpectation.expectationOrigins.originKey = minimock.CallerInfo(1)
+
+	return mmCreateAPIKey
+}
+
+// Inspect accepts an inspector function that has same arguments as the ServiceAccountRepository.CreateAPIKey
+func (mmCreateAPIKey *mServiceAccountRepositoryMockCreateAPIKey) Inspect(f func(ctx context.Context, key *models.APIKey)) *mServiceAccountRepositoryMockCreateAPIKey {
+	if mmCreateAPIKey.mock.inspectFuncCreateAPIKey != nil {
+		mmCreateAPIKey.mock.t.Fatalf("Inspect function is already set for ServiceAccountRepositoryMock.CreateAPIKey")
+	}
+
+	mmCreateAPIKey.mock.inspectFuncCreateAPIKey = f
+
+	return mmCreateAPIKey
+}
+
+// Return sets up results that will be returned by ServiceAccountRepository.CreateAPIKey
+func (mmCreateAPIKey *mServiceAccountRepositoryMockCreateAPIKey) Return(err error) *ServiceAccountRepositoryMock {
+	if mmCreateAPIKey.mock.funcCreateAPIKey != nil {
+		mmCreateAPIKey.mock.t.Fatalf("ServiceAccountRepositoryMock.CreateAPIKey mock is already set by Set")
+	}
+
+	if mmCreateAPIKey.defaultExpectation == nil {
+		mmCreateAPIKey.defaultExpectation = &ServiceAccountRepositoryMockCreateAPIKeyExpectation{mock: mmCreateAPIKey.mock}
+	}
+	mmCreateAPIKey.defaultExpectation.results = &ServiceAccountRepositoryMockCreateAPIKeyResults{err}
+	mmCreateAPIKey.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreateAPIKey.mock
+}
+
+// Set uses given function f to mock the ServiceAccountRepository.CreateAPIKey method
+func (mmCreateAPIKey *mServiceAccountRepositoryMockCreateAPIKey) Set(f func(ctx context.Context, key *models.APIKey) (err error)) *ServiceAccountRepositoryMock {
+	if mmCreateAPIKey.defaultExpectation != nil {
+		mmCreateAPIKey.mock.t.Fatalf("Default expectation is already set for the ServiceAccountRepository.CreateAPIKey method")
+	}
+
+	if len(mmCreateAPIKey.expectations) > 0 {
+		mmCreateAPIKey.mock.t.Fatalf("Some expectations are already set for the ServiceAccountRepository.CreateAPIKey method")
+	}
+
+	mmCreateAPIKey.mock.funcCreateAPIKey = f
+	mmCreateAPIKey.mock.funcCreateAPIKeyOrigin = minimock.CallerInfo(1)
+	return mmCreateAPIKey.mock
+}
+
+// When sets expectation for the ServiceAccountRepository.CreateAPIKey which will trigger the result defined by the following
+// Then helper
+func (mmCreateAPIKey *mServiceAccountRepositoryMockCreateAPIKey) When(ctx context.Context, key *models.APIKey) *ServiceAccountRepositoryMockCreateAPIKeyExpectation {
+	if mmCreateAPIKey.mock.funcCreateAPIKey != nil {
+		mmCreateAPIKey.mock.t.Fatalf("ServiceAccountRepositoryMock.CreateAPIKey mock is already set by Set")
+	}
+
+	expectation := &ServiceAccountRepositoryMockCreateAPIKeyExpectation{
+		mock:               mmCreateAPIKey.mock,
+		params:             &ServiceAccountRepositoryMockCreateAPIKeyParams{ctx, key},
+		expectationOrigins: ServiceAccountRepositoryMockCreateAPIKeyExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreateAPIKey.expectations = append(mmCreateAPIKey.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ServiceAccountRepository.CreateAPIKey return parameters for the expectation previously defined by the When method
+func (e *ServiceAccountRepositoryMockCreateAPIKeyExpectation) Then(err error) *ServiceAccountRepositoryMock {
+	e.results = &ServiceAccountRepositoryMockCreateAPIKeyResults{err}
+	return e.mock
+}
+
+// Times sets number of times ServiceAccountRepository.CreateAPIKey should be invoked
+func (mmCreateAPIKey *mServiceAccountRepositoryMockCreateAPIKey) Times(n uint64) *mServiceAccountRepositoryMockCreateAPIKey {
+	if n == 0 {
+		mmCreateAPIKey.mock.t.Fatalf("Times of ServiceAccountRepositoryMock.CreateAPIKey mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateAPIKey.expectedInvocations, n)
+	mmCreateAPIKey.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreateAPIKey
+}
+
+func (mmCreateAPIKey *mServiceAccountRepositoryMockCreateAPIKey) invocationsDone() bool {
+	if len(mmCreateAPIKey.expectations) == 0 && mmCreateAPIKey.defaultExpectation == nil && mmCreateAPIKey.mock.funcCreateAPIKey == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateAPIKey.mock.afterCreateAPIKeyCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateAPIKey.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateAPIKey implements ServiceAccountRepository
+func (mmCreateAPIKey *ServiceAccountRepositoryMock) CreateAPIKey(ctx context.Context, key *models.APIKey) (err error) {
+	mm_atomic.AddUint64(&mmCreateAPIKey.beforeCreateAPIKeyCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateAPIKey.afterCreateAPIKeyCounter, 1)
+
+	mmCreateAPIKey.t.Helper()
+
+	if mmCreateAPIKey.inspectFuncCreateAPIKey != nil {
+		mmCreateAPIKey.inspectFuncCreateAPIKey(ctx, key)
+	}
+
+	mm_params := ServiceAccountRepositoryMockCreateAPIKeyParams{ctx, key}
+
+	// Record call args
+	mmCreateAPIKey.CreateAPIKeyMock.mutex.Lock()
+	mmCreateAPIKey.CreateAPIKeyMock.callArgs = append(mmCreateAPIKey.CreateAPIKeyMock.callArgs, &mm_params)
+	mmCreateAPIKey.CreateAPIKeyMock.mutex.Unlock()
+
+	for _, e := range mmCreateAPIKey.CreateAPIKeyMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCreateAPIKey.CreateAPIKeyMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateAPIKey.CreateAPIKeyMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateAPIKey.CreateAPIKeyMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateAPIKey.CreateAPIKeyMock.defaultExpectation.paramPtrs
+
+		mm_got := ServiceAccountRepositoryMockCreateAPIKeyParams{ctx, key}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateAPIKey.t.Errorf("ServiceAccountRepositoryMock.CreateAPIKey got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateAPIKey.CreateAPIKeyMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.key != nil && !minimock.Equal(*mm_want_ptrs.key, mm_got.key) {
+				mmCreateAPIKey.t.Errorf("ServiceAccountRepositoryMock.CreateAPIKey got unexpected parameter key, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateAPIKey.CreateAPIKeyMock.defaultExpectation.expectationOrigins.originKey, *mm_want_ptrs.key, mm_got.key, minimock.Diff(*mm_want_ptrs.key, mm_got.key))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateAPIKey.t.Errorf("ServiceAccountRepositoryMock.CreateAPIKey got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreateAPIKey.CreateAPIKeyMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateAPIKey.CreateAPIKeyMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateAPIKey.t.Fatal("No results are set for the ServiceAccountRepositoryMock.CreateAPIKey")
+		}
+		return (*mm_results).err
+	}
+	if mmCreateAPIKey.funcCreateAPIKey != nil {
+		return mmCreateAPIKey.funcCreateAPIKey(ctx, key)
+	}
+	mmCreateAPIKey.t.Fatalf("Unexpected call to ServiceAccountRepositoryMock.CreateAPIKey. %v %v", ctx, key)
+	return
+}
+
+// CreateAPIKeyAfterCounter returns a count of finished ServiceAccountRepositoryMock.CreateAPIKey invocations
+func (mmCreateAPIKey *ServiceAccountRepositoryMock) CreateAPIKeyAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateAPIKey.afterCreateAPIKeyCounter)
+}
+
+// CreateAPIKeyBeforeCounter returns a count of ServiceAccountRepositoryMock.CreateAPIKey invocations
+func (mmCreateAPIKey *ServiceAccountRepositoryMock) CreateAPIKeyBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateAPIKey.beforeCreateAPIKeyCounter)
+}
+
+// Calls returns a list of arguments used in each call to ServiceAccountRepositoryMock.CreateAPIKey.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateAPIKey *mServiceAccountRepositoryMockCreateAPIKey) Calls() []*ServiceAccountRepositoryMockCreateAPIKeyParams {
+	mmCreateAPIKey.mutex.RLock()
+
+	argCopy := make([]*ServiceAccountRepositoryMockCreateAPIKeyParams, len(mmCreateAPIKey.callArgs))
+	copy(argCopy, mmCreateAPIKey.callArgs)
+
+	mmCreateAPIKey.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateAPIKeyDone returns true if the count of the CreateAPIKey invocations corresponds
+// the number of defined expectations
+func (m *ServiceAccountRepositoryMock) MinimockCreateAPIKeyDone() bool {
+	if m.CreateAPIKeyMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateAPIKeyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateAPIKeyMock.invocationsDone()
+}
+
+// MinimockCreateAPIKeyInspect logs each unmet expectation
+func (m *ServiceAccountRepositoryMock) MinimockCreateAPIKeyInspect() {
+	for _, e := range m.CreateAPIKeyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ServiceAccountRepositoryMock.CreateAPIKey at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateAPIKeyCounter := mm_atomic.LoadUint64(&m.afterCreateAPIKeyCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateAPIKeyMock.defaultExpectation != nil && afterCreateAPIKeyCounter < 1 {
+		if m.CreateAPIKeyMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ServiceAccountRepositoryMock.CreateAPIKey at\n%s", m.CreateAPIKeyMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ServiceAccountRepositoryMock.CreateAPIKey at\n%s with params: %#v", m.CreateAPIKeyMock.defaultExpectation.expectationOrigins.origin, *m.CreateAPIKeyMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateAPIKey != nil && afterCreateAPIKeyCounter < 1 {
+		m.t.Errorf("Expected call to ServiceAccountRepositoryMock.CreateAPIKey at\n%s", m.funcCreateAPIKeyOrigin)
+	}
+
+	if !m.CreateAPIKeyMock.invocationsDone() && afterCreateAPIKeyCounter > 0 {
+		m.t.Errorf("Expected %d calls to ServiceAccountRepositoryMock.CreateAPIKey at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateAPIKeyMock.expectedInvocations), m.CreateAPIKeyMock.expectedInvocationsOrigin, afterCreateAPIKeyCounter)
+	}
+}
+
+type mServiceAccountRepositoryMockCreateServiceAccount struct {
+	optional           bool
+	mock               *ServiceAccountRepositoryMock
+	defaultExpectation *ServiceAccountRepositoryMockCreateServiceAccountExpectation
+	expectations       []*ServiceAccountRepositoryMockCreateServiceAccountExpectation
+
+	callArgs []*ServiceAccountRepositoryMockCreateServiceAccountParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ServiceAccountRepositoryMockCreateServiceAccountExpectation specifies expectation struct of the ServiceAccountRepository.CreateServiceAccount
+type ServiceAccountRepositoryMockCreateServiceAccountExpectation struct {
+	mock               *ServiceAccountRepositoryMock
+	params             *ServiceAccountRepositoryMockCreateServiceAccountParams
+	paramPtrs          *ServiceAccountRepositoryMockCreateServiceAccountParamPtrs
+	expectationOrigins ServiceAccountRepositoryMockCreateServiceAccountExpectationOrigins
+	results            *ServiceAccountRepositoryMockCreateServiceAccountResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ServiceAccountRepositoryMockCreateServiceAccountParams contains parameters of the ServiceAccountRepository.CreateServiceAccount
+type ServiceAccountRepositoryMockCreateServiceAccountParams struct {
+	ctx     context.Context
+	account *models.ServiceAccount
+}
+
+// ServiceAccountRepositoryMockCreateServiceAccountParamPtrs contains pointers to parameters of the ServiceAccountRepository.CreateServiceAccount
+type ServiceAccountRepositoryMockCreateServiceAccountParamPtrs struct {
+	ctx     *context.Context
+	account **models.ServiceAccount
+}
+
+// ServiceAccountRepositoryMockCreateServiceAccountResults contains results of the ServiceAccountRepository.CreateServiceAccount
+type ServiceAccountRepositoryMockCreateServiceAccountResults struct {
+	err error
+}
+
+// ServiceAccountRepositoryMockCreateServiceAccountOrigins contains origins of expectations of the ServiceAccountRepository.CreateServiceAccount
+type ServiceAccountRepositoryMockCreateServiceAccountExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originAccount string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateServiceAccount *mServiceAccountRepositoryMockCreateServiceAccount) Optional() *mServiceAccountRepositoryMockCreateServiceAccount {
+	mmCreateServiceAccount.optional = true
+	return mmCreateServiceAccount
+}
+
+// Expect sets up expected params for ServiceAccountRepository.CreateServiceAccount
+func (mmCreateServiceAccount *mServiceAccountRepositoryMockCreateServiceAccount) Expect(ctx context.Context, account *models.ServiceAccount) *mServiceAccountRepositoryMockCreateServiceAccount {
+	if mmCreateServiceAccount.mock.funcCreateServiceAccount != nil {
+		mmCreateServiceAccount.mock.t.Fatalf("ServiceAccountRepositoryMock.CreateServiceAccount mock is already set by Set")
+	}
+
+	if mmCreateServiceAccount.defaultExpectation == nil {
+		mmCreateServiceAccount.defaultExpectation = &ServiceAccountRepositoryMockCreateServiceAccountExpectation{}
+	}
+
+	if mmCreateServiceAccount.defaultExpectation.paramPtrs != nil {
+		mmCreateServiceAccount.mock.t.Fatalf("ServiceAccountRepositoryMock.CreateServiceAccount mock is already set by ExpectParams functions")
+	}
+
+	mmCreateServiceAccount.defaultExpectation.params = &ServiceAccountRepositoryMockCreateServiceAccountParams{ctx, account}
+	mmCreateServiceAccount.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreateServiceAccount.expectations {
+		if minimock.Equal(e.params, mmCreateServiceAccount.defaultExpectation.params) {
+			mmCreateServiceAccount.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateServiceAccount.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateServiceAccount
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ServiceAccountRepository.CreateServiceAccount
+func (mmCreateServiceAccount *mServiceAccountRepositoryMockCreateServiceAccount) ExpectCtxParam1(ctx context.Context) *mServiceAccountRepositoryMockCreateServiceAccount {
+	if mmCreateServiceAccount.mock.funcCreateServiceAccount != nil {
+		mmCreateServiceAccount.mock.t.Fatalf("ServiceAccountRepositoryMock.CreateServiceAccount mock is already set by Set")
+	}
+
+	if mmCreateServiceAccount.defaultExpectation == nil {
+		mmCreateServiceAccount.defaultExpectation = &ServiceAccountRepositoryMockCreateServiceAccountExpectation{}
+	}
+
+	if mmCreateServiceAccount.defaultExpectation.params != nil {
+		mmCreateServiceAccount.mock.t.Fatalf("ServiceAccountRepositoryMock.CreateServiceAccount mock is already set by Expect")
+	}
+
+	if mmCreateServiceAccount.defaultExpectation.paramPtrs == nil {
+		mmCreateServiceAccount.defaultExpectation.paramPtrs = &ServiceAccountRepositoryMockCreateServiceAccountParamPtrs{}
+	}
+	mmCreateServiceAccount.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreateServiceAccount.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreateServiceAccount
+}
+
+// ExpectAccountParam2 sets up expected param account for ServiceAccountRepository.CreateServiceAccount
+func (mmCreateServiceAccount *mServiceAccountRepositoryMockCreateServiceAccount) ExpectAccountParam2(account *models.ServiceAccount) *mServiceAccountRepositoryMockCreateServiceAccount {
+	if mmCreateServiceAccount.mock.funcCreateServiceAccount != nil {
+		mmCreateServiceAccount.mock.t.Fatalf("ServiceAccountRepositoryMock.CreateServiceAccount mock is already set by Set")
+	}
+
+	if mmCreateServiceAccount.defaultExpectation == nil {
+		mmCreateServiceAccount.defaultExpectation = &ServiceAccountRepositoryMockCreateServiceAccountExpectation{}
+	}
+
+	if mmCreateServiceAccount.defaultExpectation.params != nil {
+		mmCreateServiceAccount.mock.t.Fatalf("ServiceAccountRepositoryMock.CreateServiceAccount mock is already set by Expect")
+	}
+
+	if mmCreateServiceAccount.defaultExpectation.paramPtrs == nil {
+		mmCreateServiceAccount.defaultExpectation.paramPtrs = &ServiceAccountRepositoryMockCreateServiceAccountParamPtrs{}
+	}
+	mmCreateServiceAccount.defaultExpectation.paramPtrs.account = &account
+	mmCreateServiceAccount.defaultExpectation.expectationOrigins.originAccount = minimock.CallerInfo(1)
+
+	return mmCreateServiceAccount
+}
+
+// Inspect accepts an inspector function that has same arguments as the ServiceAccountRepository.CreateServiceAccount
+func (mmCreateServiceAccount *mServiceAccountRepositoryMockCreateServiceAccount) Inspect(f func(ctx context.Context, account *models.ServiceAccount)) *mServiceAccountRepositoryMockCreateServiceAccount {
+	if mmCreateServiceAccount.mock.inspectFuncCreateServiceAccount != nil {
+		mmCreateServiceAccount.mock.t.Fatalf("Inspect function is already set for ServiceAccountRepositoryMock.CreateServiceAccount")
+	}
+
+	mmCreateServiceAccount.mock.inspectFuncCreateServiceAccount = f
+
+	return mmCreateServiceAccount
+}
+
+// Return sets up results that will be returned by ServiceAccountRepository.CreateServiceAccount
+func (mmCreateServiceAccount *mServiceAccountRepositoryMockCreateServiceAccount) Return(err error) *ServiceAccountRepositoryMock {
+	if mmCreateServiceAccount.mock.funcCreateServiceAccount != nil {
+		mmCreateServiceAccount.mock.t.Fatalf("ServiceAccountRepositoryMock.CreateServiceAccount mock is already set by Set")
+	}
+
+	if mmCreateServiceAccount.defaultExpectation == nil {
+		mmCreateServiceAccount.defaultExpectation = &ServiceAccountRepositoryMockCreateServiceAccountExpectation{mock: mmCreateServiceAccount.mock}
+	}
+	mmCreateServiceAccount.defaultExpectation.results = &ServiceAccountRepositoryMockCreateServiceAccountResults{err}
+	mmCreateServiceAccount.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreateServiceAccount.mock
+}
+
+// Set uses given function f to mock the ServiceAccountRepository.CreateServiceAccount method
+func (mmCreateServiceAccount *mServiceAccountRepositoryMockCreateServiceAccount) Set(f func(ctx context.Context, account *models.ServiceAccount) (err error)) *ServiceAccountRepositoryMock {
+	if mmCreateServiceAccount.defaultExpectation != nil {
+		mmCreateServiceAccount.mock.t.Fatalf("Default expectation is already set for the ServiceAccountRepository.CreateServiceAccount method")
+	}
+
+	if len(mmCreateServiceAccount.expectations) > 0 {
+		mmCreateServiceAccount.mock.t.Fatalf("Some expectations are already set for the ServiceAccountRepository.CreateServiceAccount method")
+	}
+
+	mmCreateServiceAccount.mock.funcCreateServiceAccount = f
+	mmCreateServiceAccount.mock.funcCreateServiceAccountOrigin = minimock.CallerInfo(1)
+	return mmCreateServiceAccount.mock
+}
+
+// When sets expectation for the ServiceAccountRepository.CreateServiceAccount which will trigger the result defined by the following
+// Then helper
+func (mmCreateServiceAccount *mServiceAccountRepositoryMockCreateServiceAccount) When(ctx context.Context, account *models.ServiceAccount) *ServiceAccountRepositoryMockCreateServiceAccountExpectation {
+	if mmCreateServiceAccount.mock.funcCreateServiceAccount != nil {
+		mmCreateServiceAccount.mock.t.Fatalf("ServiceAccountRepositoryMock.CreateServiceAccount mock is already set by Set")
+	}
+
+	expectation := &ServiceAccountRepositoryMockCreateServiceAccountExpectation{
+		mock:               mmCreateServiceAccount.mock,
+		params:             &ServiceAccountRepositoryMockCreateServiceAccountParams{ctx, account},
+		expectationOrigins: ServiceAccountRepositoryMockCreateServiceAccountExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreateServiceAccount.expectations = append(mmCreateServiceAccount.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ServiceAccountRepository.CreateServiceAccount return parameters for the expectation previously defined by the When method
+func (e *ServiceAccountRepositoryMockCreateServiceAccountExpectation) Then(err error) *ServiceAccountRepositoryMock {
+	e.results = &ServiceAccountRepositoryMockCreateServiceAccountResults{err}
+	return e.mock
+}
+
+// Times sets number of times ServiceAccountRepository.CreateServiceAccount should be invoked
+func (mmCreateServiceAccount *mServiceAccountRepositoryMockCreateServiceAccount) Times(n uint64) *mServiceAccountRepositoryMockCreateServiceAccount {
+	if n == 0 {
+		mmCreateServiceAccount.mock.t.Fatalf("Times of ServiceAccountRepositoryMock.CreateServiceAccount mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateServiceAccount.expectedInvocations, n)
+	mmCreateServiceAccount.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreateServiceAccount
+}
+
+func (mmCreateServiceAccount *mServiceAccountRepositoryMockCreateServiceAccount) invocationsDone() bool {
+	if len(mmCreateServiceAccount.expectations) == 0 && mmCreateServiceAccount.defaultExpectation == nil && mmCreateServiceAccount.mock.funcCreateServiceAccount == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateServiceAccount.mock.afterCreateServiceAccountCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateServiceAccount.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateServiceAccount implements ServiceAccountRepository
+func (mmCreateServiceAccount *ServiceAccountRepositoryMock) CreateServiceAccount(ctx context.Context, account *models.ServiceAccount) (err error) {
+	mm_atomic.AddUint64(&mmCreateServiceAccount.beforeCreateServiceAccountCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateServiceAccount.afterCreateServiceAccountCounter, 1)
+
+	mmCreateServiceAccount.t.Helper()
+
+	if mmCreateServiceAccount.inspectFuncCreateServiceAccount != nil {
+		mmCreateServiceAccount.inspectFuncCreateServiceAccount(ctx, account)
+	}
+
+	mm_params := ServiceAccountRepositoryMockCreateServiceAccountParams{ctx, account}
+
+	// Record call args
+	mmCreateServiceAccount.CreateServiceAccountMock.mutex.Lock()
+	mmCreateServiceAccount.CreateServiceAccountMock.callArgs = append(mmCreateServiceAccount.CreateServiceAccountMock.callArgs, &mm_params)
+	mmCreateServiceAccount.CreateServiceAccountMock.mutex.Unlock()
+
+	for _, e := range mmCreateServiceAccount.CreateServiceAccountMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCreateServiceAccount.CreateServiceAccountMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateServiceAccount.CreateServiceAccountMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateServiceAccount.CreateServiceAccountMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateServiceAccount.CreateServiceAccountMock.defaultExpectation.paramPtrs
+
+		mm_got := ServiceAccountRepositoryMockCreateServiceAccountParams{ctx, account}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateServiceAccount.t.Errorf("ServiceAccountRepositoryMock.CreateServiceAccount got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateServiceAccount.CreateServiceAccountMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.account != nil && !minimock.Equal(*mm_want_ptrs.account, mm_got.account) {
+				mmCreateServiceAccount.t.Errorf("ServiceAccountRepositoryMock.CreateServiceAccount got unexpected parameter account, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateServiceAccount.CreateServiceAccountMock.defaultExpectation.expectationOrigins.originAccount, *mm_want_ptrs.account, mm_got.account, minimock.Diff(*mm_want_ptrs.account, mm_got.account))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateServiceAccount.t.Errorf("ServiceAccountRepositoryMock.CreateServiceAccount got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreateServiceAccount.CreateServiceAccountMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateServiceAccount.CreateServiceAccountMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateServiceAccount.t.Fatal("No results are set for the ServiceAccountRepositoryMock.CreateServiceAccount")
+		}
+		return (*mm_results).err
+	}
+	if mmCreateServiceAccount.funcCreateServiceAccount != nil {
+		return mmCreateServiceAccount.funcCreateServiceAccount(ctx, account)
+	}
+	mmCreateServiceAccount.t.Fatalf("Unexpected call to ServiceAccountRepositoryMock.CreateServiceAccount. %v %v", ctx, account)
+	return
+}
+
+// CreateServiceAccountAfterCounter returns a count of finished ServiceAccountRepositoryMock.CreateServiceAccount invocations
+func (mmCreateServiceAccount *ServiceAccountRepositoryMock) CreateServiceAccountAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateServiceAccount.afterCreateServiceAccountCounter)
+}
+
+// CreateServiceAccountBeforeCounter returns a count of ServiceAccountRepositoryMock.CreateServiceAccount invocations
+func (mmCreateServiceAccount *ServiceAccountRepositoryMock) CreateServiceAccountBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateServiceAccount.beforeCreateServiceAccountCounter)
+}
+
+// Calls returns a list of arguments used in each call to ServiceAccountRepositoryMock.CreateServiceAccount.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateServiceAccount *mServiceAccountRepositoryMockCreateServiceAccount) Calls() []*ServiceAccountRepositoryMockCreateServiceAccountParams {
+	mmCreateServiceAccount.mutex.RLock()
+
+	argCopy := make([]*ServiceAccountRepositoryMockCreateServiceAccountParams, len(mmCreateServiceAccount.callArgs))
+	copy(argCopy, mmCreateServiceAccount.callArgs)
+
+	mmCreateServiceAccount.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateServiceAccountDone returns true if the count of the CreateServiceAccount invocations corresponds
+// the number of defined expectations
+func (m *ServiceAccountRepositoryMock) MinimockCreateServiceAccountDone() bool {
+	if m.CreateServiceAccountMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateServiceAccountMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateServiceAccountMock.invocationsDone()
+}
+
+// MinimockCreateServiceAccountInspect logs each unmet expectation
+func (m *ServiceAccountRepositoryMock) MinimockCreateServiceAccountInspect() {
+	for _, e := range m.CreateServiceAccountMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ServiceAccountRepositoryMock.CreateServiceAccount at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateServiceAccountCounter := mm_atomic.LoadUint64(&m.afterCreateServiceAccountCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateServiceAccountMock.defaultExpectation != nil && afterCreateServiceAccountCounter < 1 {
+		if m.CreateServiceAccountMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ServiceAccountRepositoryMock.CreateServiceAccount at\n%s", m.CreateServiceAccountMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ServiceAccountRepositoryMock.CreateServiceAccount at\n%s with params: %#v", m.CreateServiceAccountMock.defaultExpectation.expectationOrigins.origin, *m.CreateServiceAccountMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateServiceAccount != nil && afterCreateServiceAccountCounter < 1 {
+		m.t.Errorf("Expected call to ServiceAccountRepositoryMock.CreateServiceAccount at\n%s", m.funcCreateServiceAccountOrigin)
+	}
+
+	if !m.CreateServiceAccountMock.invocationsDone() && afterCreateServiceAccountCounter > 0 {
+		m.t.Errorf("Expected %d calls to ServiceAccountRepositoryMock.CreateServiceAccount at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateServiceAccountMock.expectedInvocations), m.CreateServiceAccountMock.expectedInvocationsOrigin, afterCreateServiceAccountCounter)
+	}
+}
+
+type mServiceAccountRepositoryMockDeleteAPIKey struct {
+	optional           bool
+	mock               *ServiceAccountRepositoryMock
+	defaultExpectation *ServiceAccountRepositoryMockDeleteAPIKeyExpectation
+	expectations       []*ServiceAccountRepositoryMockDeleteAPIKeyExpectation
+
+	callArgs []*ServiceAccountRepositoryMockDeleteAPIKeyParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ServiceAccountRepositoryMockDeleteAPIKeyExpectation specifies expectation struct of the ServiceAccountRepository.DeleteAPIKey
+type ServiceAccountRepositoryMockDeleteAPIKeyExpectation struct {
+	mock               *ServiceAccountRepositoryMock
+	params             *ServiceAccountRepositoryMockDeleteAPIKeyParams
+	paramPtrs          *ServiceAccountRepositoryMockDeleteAPIKeyParamPtrs
+	expectationOrigins ServiceAccountRepositoryMockDeleteAPIKeyExpectationOrigins
+	results            *ServiceAccountRepositoryMockDeleteAPIKeyResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ServiceAccountRepositoryMockDeleteAPIKeyParams contains parameters of the ServiceAccountRepository.DeleteAPIKey
+type ServiceAccountRepositoryMockDeleteAPIKeyParams struct {
+	ctx       context.Context
+	accountID string
+	keyID     string
+}
+
+// ServiceAccountRepositoryMockDeleteAPIKeyParamPtrs contains pointers to parameters of the ServiceAccountRepository.DeleteAPIKey
+type ServiceAccountRepositoryMockDeleteAPIKeyParamPtrs struct {
+	ctx       *context.Context
+	accountID *string
+	keyID     *string
+}
+
+// ServiceAccountRepositoryMockDeleteAPIKeyResults contains results of the ServiceAccountRepository.DeleteAPIKey
+type ServiceAccountRepositoryMockDeleteAPIKeyResults struct {
+	err error
+}
+
+// ServiceAccountRepositoryMockDeleteAPIKeyOrigins contains origins of expectations of the ServiceAccountRepository.DeleteAPIKey
+type ServiceAccountRepositoryMockDeleteAPIKeyExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originAccountID string
+	originKeyID     string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteAPIKey *mServiceAccountRepositoryMockDeleteAPIKey) Optional() *mServiceAccountRepositoryMockDeleteAPIKey {
+	mmDeleteAPIKey.optional = true
+	return mmDeleteAPIKey
+}
+
+// Expect sets up expected params for ServiceAccountRepository.DeleteAPIKey
+func (mmDeleteAPIKey *mServiceAccountRepositoryMockDeleteAPIKey) Expect(ctx context.Context, accountID string, keyID string) *mServiceAccountRepositoryMockDeleteAPIKey {
+	if mmDeleteAPIKey.mock.funcDeleteAPIKey != nil {
+		mmDeleteAPIKey.mock.t.Fatalf("ServiceAccountRepositoryMock.DeleteAPIKey mock is already set by Set")
+	}
+
+	if mmDeleteAPIKey.defaultExpectation == nil {
+		mmDeleteAPIKey.defaultExpectation = &ServiceAccountRepositoryMockDeleteAPIKeyExpectation{}
+	}
+
+	if mmDeleteAPIKey.defaultExpectation.paramPtrs != nil {
+		mmDeleteAPIKey.mock.t.Fatalf("ServiceAccountRepositoryMock.DeleteAPIKey mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteAPIKey.defaultExpectation.params = &ServiceAccountRepositoryMockDeleteAPIKeyParams{ctx, accountID, keyID}
+	mmDeleteAPIKey.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteAPIKey.expectations {
+		if minimock.Equal(e.params, mmDeleteAPIKey.defaultExpectation.params) {
+			mmDeleteAPIKey.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteAPIKey.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteAPIKey
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ServiceAccountRepository.DeleteAPIKey
+func (mmDeleteAPIKey *mServiceAccountRepositoryMockDeleteAPIKey) ExpectCtxParam1(ctx context.Context) *mServiceAccountRepositoryMockDeleteAPIKey {
+	if mmDeleteAPIKey.mock.funcDeleteAPIKey != nil {
+		mmDeleteAPIKey.mock.t.Fatalf("ServiceAccountRepositoryMock.DeleteAPIKey mock is already set by Set")
+	}
+
+	if mmDeleteAPIKey.defaultExpectation == nil {
+		mmDeleteAPIKey.defaultExpectation = &ServiceAccountRepositoryMockDeleteAPIKeyExpectation{}
+	}
+
+	if mmDeleteAPIKey.defaultExpectation.params != nil {
+		mmDeleteAPIKey.mock.t.Fatalf("ServiceAccountRepositoryMock.DeleteAPIKey mock is already set by Expect")
+	}
+
+	if mmDeleteAPIKey.defaultExpectation.paramPtrs == nil {
+		mmDeleteAPIKey.defaultExpectation.paramPtrs = &ServiceAccountRepositoryMockDeleteAPIKeyParamPtrs{}
+	}
+	mmDeleteAPIKey.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteAPIKey.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteAPIKey
+}
+
+// ExpectAccountIDParam2 sets up expected param accountID for ServiceAccountRepository.DeleteAPIKey
+func (mmDeleteAPIKey *mServiceAccountRepositoryMockDeleteAPIKey) ExpectAccountIDParam2(accountID string) *mServiceAccountRepositoryMockDeleteAPIKey {
+	if mmDeleteAPIKey.mock.funcDeleteAPIKey != nil {
+		mmDeleteAPIKey.mock.t.Fatalf("ServiceAccountRepositoryMock.DeleteAPIKey mock is already set by Set")
+	}
+
+	if mmDeleteAPIKey.defaultExpectation == nil {
+		mmDeleteAPIKey.defaultExpectation = &ServiceAccountRepositoryMockDeleteAPIKeyExpectation{}
+	}
+
+	if mmDeleteAPIKey.defaultExpectation.params != nil {
+		mmDeleteAPIKey.mock.t.Fatalf("ServiceAccountRepositoryMock.DeleteAPIKey mock is already set by Expect")
+	}
+
+	if mmDeleteAPIKey.defaultExpectation.paramPtrs == nil {
+		mmDeleteAPIKey.defaultExpectation.paramPtrs = &ServiceAccountRepositoryMockDeleteAPIKeyParamPtrs{}
+	}
+	mmDeleteAPIKey.defaultExpectation.paramPtrs.accountID = &accountID
+	mmDeleteAPIKey.defaultExpectation.expectationOrigins.originAccountID = minimock.CallerInfo(1)
+
+	return mmDeleteAPIKey
+}
+
+// ExpectKeyIDParam3 sets up expected param keyID for ServiceAccountRepository.DeleteAPIKey
+func (mmDeleteAPIKey *mServiceAccountRepositoryMockDeleteAPIKey) ExpectKeyIDParam3(keyID string) *mServiceAccountRepositoryMockDeleteAPIKey {
+	if mmDeleteAPIKey.mock.funcDeleteAPIKey != nil {
+		mmDeleteAPIKey.mock.t.Fatalf("ServiceAccountRepositoryMock.DeleteAPIKey mock is already set by Set")
+	}
+
+	if mmDeleteAPIKey.defaultExpectation == nil {
+		mmDeleteAPIKey.defaultExpectation = &ServiceAccountRepositoryMockDeleteAPIKeyExpectation{}
+	}
+
+	if mmDeleteAPIKey.defaultExpectation.params != nil {
+		mmDeleteAPIKey.mock.t.Fatalf("ServiceAccountRepositoryMock.DeleteAPIKey mock is already set by Expect")
+	}
+
+	if mmDeleteAPIKey.defaultExpectation.paramPtrs == nil {
+		mmDeleteAPIKey.defaultExpectation.paramPtrs = &ServiceAccountRepositoryMockDeleteAPIKeyParamPtrs{}
+	}
+	mmDeleteAPIKey.defaultExpectation.paramPtrs.keyID = &keyID
+	mmDeleteAPIKey.defaultExpectation.expectationOrigins.originKeyID = minimock.CallerInfo(1)
+
+	return mmDeleteAPIKey
+}
+
+// Inspect accepts an inspector function that has same arguments as the ServiceAccountRepository.DeleteAPIKey
+func (mmDeleteAPIKey *mServiceAccountRepositoryMockDeleteAPIKey) Inspect(f func(ctx context.Context, accountID string, keyID string)) *mServiceAccountRepositoryMockDeleteAPIKey {
+	if mmDeleteAPIKey.mock.inspectFuncDeleteAPIKey != nil {
+		mmDeleteAPIKey.mock.t.Fatalf("Inspect function is already set for ServiceAccountRepositoryMock.DeleteAPIKey")
+	}
+
+	mmDeleteAPIKey.mock.inspectFuncDeleteAPIKey = f
+
+	return mmDeleteAPIKey
+}
+
+// Return sets up results that will be returned by ServiceAccountRepository.DeleteAPIKey
+func (mmDeleteAPIKey *mServiceAccountRepositoryMockDeleteAPIKey) Return(err error) *ServiceAccountRepositoryMock {
+	if mmDeleteAPIKey.mock.funcDeleteAPIKey != nil {
+		mmDeleteAPIKey.mock.t.Fatalf("ServiceAccountRepositoryMock.DeleteAPIKey mock is already set by Set")
+	}
+
+	if mmDeleteAPIKey.defaultExpectation == nil {
+		mmDeleteAPIKey.defaultExpectation = &ServiceAccountRepositoryMockDeleteAPIKeyExpectation{mock: mmDeleteAPIKey.mock}
+	}
+	mmDeleteAPIKey.defaultExpectation.results = &ServiceAccountRepositoryMockDeleteAPIKeyResults{err}
+	mmDeleteAPIKey.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteAPIKey.mock
+}
+
+// Set uses given function f to mock the ServiceAccountRepository.DeleteAPIKey method
+func (mmDeleteAPIKey *mServiceAccountRepositoryMockDeleteAPIKey) Set(f func(ctx context.Context, accountID string, keyID string) (err error)) *ServiceAccountRepositoryMock {
+	if mmDeleteAPIKey.defaultExpectation != nil {
+		mmDeleteAPIKey.mock.t.Fatalf("Default expectation is already set for the ServiceAccountRepository.DeleteAPIKey method")
+	}
+
+	if len(mmDeleteAPIKey.expectations) > 0 {
+		mmDeleteAPIKey.mock.t.Fatalf("Some expectations are already set for the ServiceAccountRepository.DeleteAPIKey method")
+	}
+
+	mmDeleteAPIKey.mock.funcDeleteAPIKey = f
+	mmDeleteAPIKey.mock.funcDeleteAPIKeyOrigin = minimock.CallerInfo(1)
+	return mmDeleteAPIKey.mock
+}
+
+// When sets expectation for the ServiceAccountRepository.DeleteAPIKey which will trigger the result defined by the following
+// Then helper
+func (mmDeleteAPIKey *mServiceAccountRepositoryMockDeleteAPIKey) When(ctx context.Context, accountID string, keyID string) *ServiceAccountRepositoryMockDeleteAPIKeyExpectation {
+	if mmDeleteAPIKey.mock.funcDeleteAPIKey != nil {
+		mmDeleteAPIKey.mock.t.Fatalf("ServiceAccountRepositoryMock.DeleteAPIKey mock is already set by Set")
+	}
+
+	expectation := &ServiceAccountRepositoryMockDeleteAPIKeyExpectation{
+		mock:               mmDeleteAPIKey.mock,
+		params:             &ServiceAccountRepositoryMockDeleteAPIKeyParams{ctx, accountID, keyID},
+		expectationOrigins: ServiceAccountRepositoryMockDeleteAPIKeyExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteAPIKey.expectations = append(mmDeleteAPIKey.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ServiceAccountRepository.DeleteAPIKey return parameters for the expectation previously defined by the When method
+func (e *ServiceAccountRepositoryMockDeleteAPIKeyExpectation) Then(err error) *ServiceAccountRepositoryMock {
+	e.results = &ServiceAccountRepositoryMockDeleteAPIKeyResults{err}
+	return e.mock
+}
+
+// Times sets number of times ServiceAccountRepository.DeleteAPIKey should be invoked
+func (mmDeleteAPIKey *mServiceAccountRepositoryMockDeleteAPIKey) Times(n uint64) *mServiceAccountRepositoryMockDeleteAPIKey {
+	if n == 0 {
+		mmDeleteAPIKey.mock.t.Fatalf("Times of ServiceAccountRepositoryMock.DeleteAPIKey mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteAPIKey.expectedInvocations, n)
+	mmDeleteAPIKey.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteAPIKey
+}
+
+func (mmDeleteAPIKey *mServiceAccountRepositoryMockDeleteAPIKey) invocationsDone() bool {
+	if len(mmDeleteAPIKey.expectations) == 0 && mmDeleteAPIKey.defaultExpectation == nil && mmDeleteAPIKey.mock.funcDeleteAPIKey == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteAPIKey.mock.afterDeleteAPIKeyCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteAPIKey.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteAPIKey implements ServiceAccountRepository
+func (mmDeleteAPIKey *ServiceAccountRepositoryMock) DeleteAPIKey(ctx context.Context, accountID string, keyID string) (err error) {
+	mm_atomic.AddUint64(&mmDeleteAPIKey.beforeDeleteAPIKeyCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteAPIKey.afterDeleteAPIKeyCounter, 1)
+
+	mmDeleteAPIKey.t.Helper()
+
+	if mmDeleteAPIKey.inspectFuncDeleteAPIKey != nil {
+		mmDeleteAPIKey.inspectFuncDeleteAPIKey(ctx, accountID, keyID)
+	}
+
+	mm_params := ServiceAccountRepositoryMockDeleteAPIKeyParams{ctx, accountID, keyID}
+
+	// Record call args
+	mmDeleteAPIKey.DeleteAPIKeyMock.mutex.Lock()
+	mmDeleteAPIKey.DeleteAPIKeyMock.callArgs = append(mmDeleteAPIKey.DeleteAPIKeyMock.callArgs, &mm_params)
+	mmDeleteAPIKey.DeleteAPIKeyMock.mutex.Unlock()
+
+	for _, e := range mmDeleteAPIKey.DeleteAPIKeyMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteAPIKey.DeleteAPIKeyMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteAPIKey.DeleteAPIKeyMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteAPIKey.DeleteAPIKeyMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteAPIKey.DeleteAPIKeyMock.defaultExpectation.paramPtrs
+
+		mm_got := ServiceAccountRepositoryMockDeleteAPIKeyParams{ctx, accountID, keyID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteAPIKey.t.Errorf("ServiceAccountRepositoryMock.DeleteAPIKey got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteAPIKey.DeleteAPIKeyMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.accountID != nil && !minimock.Equal(*mm_want_ptrs.accountID, mm_got.accountID) {
+				mmDeleteAPIKey.t.Errorf("ServiceAccountRepositoryMock.DeleteAPIKey got unexpected parameter accountID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteAPIKey.DeleteAPIKeyMock.defaultExpectation.expectationOrigins.originAccountID, *mm_want_ptrs.accountID, mm_got.accountID, minimock.Diff(*mm_want_ptrs.accountID, mm_got.accountID))
+			}
+
+			if mm_want_ptrs.keyID != nil && !minimock.Equal(*mm_want_ptrs.keyID, mm_got.keyID) {
+				mmDeleteAPIKey.t.Errorf("ServiceAccountRepositoryMock.DeleteAPIKey got unexpected parameter keyID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteAPIKey.DeleteAPIKeyMock.defaultExpectation.expectationOrigins.originKeyID, *mm_want_ptrs.keyID, mm_got.keyID, minimock.Diff(*mm_want_ptrs.keyID, mm_got.keyID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteAPIKey.t.Errorf("ServiceAccountRepositoryMock.DeleteAPIKey got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteAPIKey.DeleteAPIKeyMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteAPIKey.DeleteAPIKeyMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteAPIKey.t.Fatal("No results are set for the ServiceAccountRepositoryMock.DeleteAPIKey")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteAPIKey.funcDeleteAPIKey != nil {
+		return mmDeleteAPIKey.funcDeleteAPIKey(ctx, accountID, keyID)
+	}
+	mmDeleteAPIKey.t.Fatalf("Unexpected call to ServiceAccountRepositoryMock.DeleteAPIKey. %v %v %v", ctx, accountID, keyID)
+	return
+}
+
+// DeleteAPIKeyAfterCounter returns a count of finished ServiceAccountRepositoryMock.DeleteAPIKey invocations
+func (mmDeleteAPIKey *ServiceAccountRepositoryMock) DeleteAPIKeyAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteAPIKey.afterDeleteAPIKeyCounter)
+}
+
+// DeleteAPIKeyBeforeCounter returns a count of ServiceAccountRepositoryMock.DeleteAPIKey invocations
+func (mmDeleteAPIKey *ServiceAccountRepositoryMock) DeleteAPIKeyBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteAPIKey.beforeDeleteAPIKeyCounter)
+}
+
+// Calls returns a list of arguments used in each call to ServiceAccountRepositoryMock.DeleteAPIKey.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteAPIKey *mServiceAccountRepositoryMockDeleteAPIKey) Calls() []*ServiceAccountRepositoryMockDeleteAPIKeyParams {
+	mmDeleteAPIKey.mutex.RLock()
+
+	argCopy := make([]*ServiceAccountRepositoryMockDeleteAPIKeyParams, len(mmDeleteAPIKey.callArgs))
+	copy(argCopy, mmDeleteAPIKey.callArgs)
+
+	mmDeleteAPIKey.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteAPIKeyDone returns true if the count of the DeleteAPIKey invocations corresponds
+// the number of defined expectations
+func (m *ServiceAccountRepositoryMock) MinimockDeleteAPIKeyDone() bool {
+	if m.DeleteAPIKeyMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteAPIKeyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteAPIKeyMock.invocationsDone()
+}
+
+// MinimockDeleteAPIKeyInspect logs each unmet expectation
+func (m *ServiceAccountRepositoryMock) MinimockDeleteAPIKeyInspect() {
+	for _, e := range m.DeleteAPIKeyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ServiceAccountRepositoryMock.DeleteAPIKey at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteAPIKeyCounter := mm_atomic.LoadUint64(&m.afterDeleteAPIKeyCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteAPIKeyMock.defaultExpectation != nil && afterDeleteAPIKeyCounter < 1 {
+		if m.DeleteAPIKeyMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ServiceAccountRepositoryMock.DeleteAPIKey at\n%s", m.DeleteAPIKeyMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ServiceAccountRepositoryMock.DeleteAPIKey at\n%s with params: %#v", m.DeleteAPIKeyMock.defaultExpectation.expectationOrigins.origin, *m.DeleteAPIKeyMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteAPIKey != nil && afterDeleteAPIKeyCounter < 1 {
+		m.t.Errorf("Expected call to ServiceAccountRepositoryMock.DeleteAPIKey at\n%s", m.funcDeleteAPIKeyOrigin)
+	}
+
+	if !m.DeleteAPIKeyMock.invocationsDone() && afterDeleteAPIKeyCounter > 0 {
+		m.t.Errorf("Expected %d calls to ServiceAccountRepositoryMock.DeleteAPIKey at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteAPIKeyMock.expectedInvocations), m.DeleteAPIKeyMock.expectedInvocationsOrigin, afterDeleteAPIKeyCounter)
+	}
+}
+
+type mServiceAccountRepositoryMockDeleteServiceAccount struct {
+	optional           bool
+	mock               *ServiceAccountRepositoryMock
+	defaultExpectation *ServiceAccountRepositoryMockDeleteServiceAccountExpectation
+	expectations       []*ServiceAccountRepositoryMockDeleteServiceAccountExpectation
+
+	callArgs []*ServiceAccountRepositoryMockDeleteServiceAccountParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ServiceAccountRepositoryMockDeleteServiceAccountExpectation specifies expectation struct of the ServiceAccountRepository.DeleteServiceAccount
+type ServiceAccountRepositoryMockDeleteServiceAccountExpectation struct {
+	mock               *ServiceAccountRepositoryMock
+	params             *ServiceAccountRepositoryMockDeleteServiceAccountParams
+	paramPtrs          *ServiceAccountRepositoryMockDeleteServiceAccountParamPtrs
+	expectationOrigins ServiceAccountRepositoryMockDeleteServiceAccountExpectationOrigins
+	results            *ServiceAccountRepositoryMockDeleteServiceAccountResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ServiceAccountRepositoryMockDeleteServiceAccountParams contains parameters of the ServiceAccountRepository.DeleteServiceAccount
+type ServiceAccountRepositoryMockDeleteServiceAccountParams struct {
+	ctx       context.Context
+	accountID string
+}
+
+// ServiceAccountRepositoryMockDeleteServiceAccountParamPtrs contains pointers to parameters of the ServiceAccountRepository.DeleteServiceAccount
+type ServiceAccountRepositoryMockDeleteServiceAccountParamPtrs struct {
+	ctx       *context.Context
+	accountID *string
+}
+
+// ServiceAccountRepositoryMockDeleteServiceAccountResults contains results of the ServiceAccountRepository.DeleteServiceAccount
+type ServiceAccountRepositoryMockDeleteServiceAccountResults struct {
+	err error
+}
+
+// ServiceAccountRepositoryMockDeleteServiceAccountOrigins contains origins of expectations of the ServiceAccountRepository.DeleteServiceAccount
+type ServiceAccountRepositoryMockDeleteServiceAccountExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originAccountID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteServiceAccount *mServiceAccountRepositoryMockDeleteServiceAccount) Optional() *mServiceAccountRepositoryMockDeleteServiceAccount {
+	mmDeleteServiceAccount.optional = true
+	return mmDeleteServiceAccount
+}
+
+// Expect sets up expected params for ServiceAccountRepository.DeleteServiceAccount
+func (mmDeleteServiceAccount *mServiceAccountRepositoryMockDeleteServiceAccount) Expect(ctx context.Context, accountID string) *mServiceAccountRepositoryMockDeleteServiceAccount {
+	if mmDeleteServiceAccount.mock.funcDeleteServiceAccount != nil {
+		mmDeleteServiceAccount.mock.t.Fatalf("ServiceAccountRepositoryMock.DeleteServiceAccount mock is already set by Set")
+	}
+
+	if mmDeleteServiceAccount.defaultExpectation == nil {
+		mmDeleteServiceAccount.defaultExpectation = &ServiceAccountRepositoryMockDeleteServiceAccountExpectation{}
+	}
+
+	if mmDeleteServiceAccount.defaultExpectation.paramPtrs != nil {
+		mmDeleteServiceAccount.mock.t.Fatalf("ServiceAccountRepositoryMock.DeleteServiceAccount mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteServiceAccount.defaultExpectation.params = &ServiceAccountRepositoryMockDeleteServiceAccountParams{ctx, accountID}
+	mmDeleteServiceAccount.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteServiceAccount.expectations {
+		if minimock.Equal(e.params, mmDeleteServiceAccount.defaultExpectation.params) {
+			mmDeleteServiceAccount.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteServiceAccount.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteServiceAccount
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ServiceAccountRepository.DeleteServiceAccount
+func (mmDeleteServiceAccount *mServiceAccountRepositoryMockDeleteServiceAccount) ExpectCtxParam1(ctx context.Context) *mServiceAccountRepositoryMockDeleteServiceAccount {
+	if mmDeleteServiceAccount.mock.funcDeleteServiceAccount != nil {
+		mmDeleteServiceAccount.mock.t.Fatalf("ServiceAccountRepositoryMock.DeleteServiceAccount mock is already set by Set")
+	}
+
+	if mmDeleteServiceAccount.defaultExpectation == nil {
+		mmDeleteServiceAccount.defaultExpectation = &ServiceAccountRepositoryMockDeleteServiceAccountExpectation{}
+	}
+
+	if mmDeleteServiceAccount.defaultExpectation.params != nil {
+		mmDeleteServiceAccount.mock.t.Fatalf("ServiceAccountRepositoryMock.DeleteServiceAccount mock is already set by Expect")
+	}
+
+	if mmDeleteServiceAccount.defaultExpectation.paramPtrs == nil {
+		mmDeleteServiceAccount.defaultExpectation.paramPtrs = &ServiceAccountRepositoryMockDeleteServiceAccountParamPtrs{}
+	}
+	mmDeleteServiceAccount.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteServiceAccount.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteServiceAccount
+}
+
+// ExpectAccountIDParam2 sets up expected param accountID for ServiceAccountRepository.DeleteServiceAccount
+func (mmDeleteServiceAccount *mServiceAccountRepositoryMockDeleteServiceAccount) ExpectAccountIDParam2(accountID string) *mServiceAccountRepositoryMockDeleteServiceAccount {
+	if mmDeleteServiceAccount.mock.funcDeleteServiceAccount != nil {
+		mmDeleteServiceAccount.mock.t.Fatalf("ServiceAccountRepositoryMock.DeleteServiceAccount mock is already set by Set")
+	}
+
+	if mmDeleteServiceAccount.defaultExpectation == nil {
+		mmDeleteServiceAccount.defaultExpectation = &ServiceAccountRepositoryMockDeleteServiceAccountExpectation{}
+	}
+
+	if mmDeleteServiceAccount.defaultExpectation.params != nil {
+		mmDeleteServiceAccount.mock.t.Fatalf("ServiceAccountRepositoryMock.DeleteServiceAccount mock is already set by Expect")
+	}
+
+	if mmDeleteServiceAccount.defaultExpectation.paramPtrs == nil {
+		mmDeleteServiceAccount.defaultExpectation.paramPtrs = &ServiceAccountRepositoryMockDeleteServiceAccountParamPtrs{}
+	}
+	mmDeleteServiceAccount.defaultExpectation.paramPtrs.accountID = &accountID
+	mmDeleteServiceAccount.defaultExpectation.expectationOrigins.originAccountID = minimock.CallerInfo(1)
+
+	return mmDeleteServiceAccount
+}
+
+// Inspect accepts an inspector function that has same arguments as the ServiceAccountRepository.DeleteServiceAccount
+func (mmDeleteServiceAccount *mServiceAccountRepositoryMockDeleteServiceAccount) Inspect(f func(ctx context.Context, accountID string)) *mServiceAccountRepositoryMockDeleteServiceAccount {
+	if mmDeleteServiceAccount.mock.inspectFuncDeleteServiceAccount != nil {
+		mmDeleteServiceAccount.mock.t.Fatalf("Inspect function is already set for ServiceAccountRepositoryMock.DeleteServiceAccount")
+	}
+
+	mmDeleteServiceAccount.mock.inspectFuncDeleteServiceAccount = f
+
+	return mmDeleteServiceAccount
+}
+
+// Return sets up results that will be returned by ServiceAccountRepository.DeleteServiceAccount
+func (mmDeleteServiceAccount *mServiceAccountRepositoryMockDeleteServiceAccount) Return(err error) *ServiceAccountRepositoryMock {
+	if mmDeleteServiceAccount.mock.funcDeleteServiceAccount != nil {
+		mmDeleteServiceAccount.mock.t.Fatalf("ServiceAccountRepositoryMock.DeleteServiceAccount mock is already set by Set")
+	}
+
+	if mmDeleteServiceAccount.defaultExpectation == nil {
+		mmDeleteServiceAccount.defaultExpectation = &ServiceAccountRepositoryMockDeleteServiceAccountExpectation{mock: mmDeleteServiceAccount.mock}
+	}
+	mmDeleteServiceAccount.defaultExpectation.results = &ServiceAccountRepositoryMockDeleteServiceAccountResults{err}
+	mmDeleteServiceAccount.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteServiceAccount.mock
+}
+
+// Set uses given function f to mock the ServiceAccountRepository.DeleteServiceAccount method
+func (mmDeleteServiceAccount *mServiceAccountRepositoryMockDeleteServiceAccount) Set(f func(ctx context.Context, accountID string) (err error)) *ServiceAccountRepositoryMock {
+	if mmDeleteServiceAccount.defaultExpectation != nil {
+		mmDeleteServiceAccount.mock.t.Fatalf("Default expectation is already set for the ServiceAccountRepository.DeleteServiceAccount method")
+	}
+
+	if len(mmDeleteServiceAccount.expectations) > 0 {
+		mmDeleteServiceAccount.mock.t.Fatalf("Some expectations are already set for the ServiceAccountRepository.DeleteServiceAccount method")
+	}
+
+	mmDeleteServiceAccount.mock.funcDeleteServiceAccount = f
+	mmDeleteServiceAccount.mock.funcDeleteServiceAccountOrigin = minimock.CallerInfo(1)
+	return mmDeleteServiceAccount.mock
+}
+
+// When sets expectation for the ServiceAccountRepository.DeleteServiceAccount which will trigger the result defined by the following
+// Then helper
+func (mmDeleteServiceAccount *mServiceAccountRepositoryMockDeleteServiceAccount) When(ctx context.Context, accountID string) *ServiceAccountRepositoryMockDeleteServiceAccountExpectation {
+	if mmDeleteServiceAccount.mock.funcDeleteServiceAccount != nil {
+		mmDeleteServiceAccount.mock.t.Fatalf("ServiceAccountRepositoryMock.DeleteServiceAccount mock is already set by Set")
+	}
+
+	expectation := &ServiceAccountRepositoryMockDeleteServiceAccountExpectation{
+		mock:               mmDeleteServiceAccount.mock,
+		params:             &ServiceAccountRepositoryMockDeleteServiceAccountParams{ctx, accountID},
+		expectationOrigins: ServiceAccountRepositoryMockDeleteServiceAccountExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteServiceAccount.expectations = append(mmDeleteServiceAccount.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ServiceAccountRepository.DeleteServiceAccount return parameters for the expectation previously defined by the When method
+func (e *ServiceAccountRepositoryMockDeleteServiceAccountExpectation) Then(err error) *ServiceAccountRepositoryMock {
+	e.results = &ServiceAccountRepositoryMockDeleteServiceAccountResults{err}
+	return e.mock
+}
+
+// Times sets number of times ServiceAccountRepository.DeleteServiceAccount should be invoked
+func (mmDeleteServiceAccount *mServiceAccountRepositoryMockDeleteServiceAccount) Times(n uint64) *mServiceAccountRepositoryMockDeleteServiceAccount {
+	if n == 0 {
+		mmDeleteServiceAccount.mock.t.Fatalf("Times of ServiceAccountRepositoryMock.DeleteServiceAccount mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteServiceAccount.expectedInvocations, n)
+	mmDeleteServiceAccount.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteServiceAccount
+}
+
+func (mmDeleteServiceAccount *mServiceAccountRepositoryMockDeleteServiceAccount) invocationsDone() bool {
+	if len(mmDeleteServiceAccount.expectations) == 0 && mmDeleteServiceAccount.defaultExpectation == nil && mmDeleteServiceAccount.mock.funcDeleteServiceAccount == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteServiceAccount.mock.afterDeleteServiceAccountCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteServiceAccount.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteServiceAccount implements ServiceAccountRepository
+func (mmDeleteServiceAccount *ServiceAccountRepositoryMock) DeleteServiceAccount(ctx context.Context, accountID string) (err error) {
+	mm_atomic.AddUint64(&mmDeleteServiceAccount.beforeDeleteServiceAccountCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteServiceAccount.afterDeleteServiceAccountCounter, 1)
+
+	mmDeleteServiceAccount.t.Helper()
+
+	if mmDeleteServiceAccount.inspectFuncDeleteServiceAccount != nil {
+		mmDeleteServiceAccount.inspectFuncDeleteServiceAccount(ctx, accountID)
+	}
+
+	mm_params := ServiceAccountRepositoryMockDeleteServiceAccountParams{ctx, accountID}
+
+	// Record call args
+	mmDeleteServiceAccount.DeleteServiceAccountMock.mutex.Lock()
+	mmDeleteServiceAccount.DeleteServiceAccountMock.callArgs = append(mmDeleteServiceAccount.DeleteServiceAccountMock.callArgs, &mm_params)
+	mmDeleteServiceAccount.DeleteServiceAccountMock.mutex.Unlock()
+
+	for _, e := range mmDeleteServiceAccount.DeleteServiceAccountMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteServiceAccount.DeleteServiceAccountMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteServiceAccount.DeleteServiceAccountMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteServiceAccount.DeleteServiceAccountMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteServiceAccount.DeleteServiceAccountMock.defaultExpectation.paramPtrs
+
+		mm_got := ServiceAccountRepositoryMockDeleteServiceAccountParams{ctx, accountID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteServiceAccount.t.Errorf("ServiceAccountRepositoryMock.DeleteServiceAccount got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteServiceAccount.DeleteServiceAccountMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.accountID != nil && !minimock.Equal(*mm_want_ptrs.accountID, mm_got.accountID) {
+				mmDeleteServiceAccount.t.Errorf("ServiceAccountRepositoryMock.DeleteServiceAccount got unexpected parameter accountID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteServiceAccount.DeleteServiceAccountMock.defaultExpectation.expectationOrigins.originAccountID, *mm_want_ptrs.accountID, mm_got.accountID, minimock.Diff(*mm_want_ptrs.accountID, mm_got.accountID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteServiceAccount.t.Errorf("ServiceAccountRepositoryMock.DeleteServiceAccount got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteServiceAccount.DeleteServiceAccountMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteServiceAccount.DeleteServiceAccountMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteServiceAccount.t.Fatal("No results are set for the ServiceAccountRepositoryMock.DeleteServiceAccount")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteServiceAccount.funcDeleteServiceAccount != nil {
+		return mmDeleteServiceAccount.funcDeleteServiceAccount(ctx, accountID)
+	}
+	mmDeleteServiceAccount.t.Fatalf("Unexpected call to ServiceAccountRepositoryMock.DeleteServiceAccount. %v %v", ctx, accountID)
+	return
+}
+
+// DeleteServiceAccountAfterCounter returns a count of finished ServiceAccountRepositoryMock.DeleteServiceAccount invocations
+func (mmDeleteServiceAccount *ServiceAccountRepositoryMock) DeleteServiceAccountAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteServiceAccount.afterDeleteServiceAccountCounter)
+}
+
+// DeleteServiceAccountBeforeCounter returns a count of ServiceAccountRepositoryMock.DeleteServiceAccount invocations
+func (mmDeleteServiceAccount *ServiceAccountRepositoryMock) DeleteServiceAccountBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteServiceAccount.beforeDeleteServiceAccountCounter)
+}
+
+// Calls returns a list of arguments used in each call to ServiceAccountRepositoryMock.DeleteServiceAccount.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteServiceAccount *mServiceAccountRepositoryMockDeleteServiceAccount) Calls() []*ServiceAccountRepositoryMockDeleteServiceAccountParams {
+	mmDeleteServiceAccount.mutex.RLock()
+
+	argCopy := make([]*ServiceAccountRepositoryMockDeleteServiceAccountParams, len(mmDeleteServiceAccount.callArgs))
+	copy(argCopy, mmDeleteServiceAccount.callArgs)
+
+	mmDeleteServiceAccount.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteServiceAccountDone returns true if the count of the DeleteServiceAccount invocations corresponds
+// the number of defined expectations
+func (m *ServiceAccountRepositoryMock) MinimockDeleteServiceAccountDone() bool {
+	if m.DeleteServiceAccountMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteServiceAccountMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteServiceAccountMock.invocationsDone()
+}
+
+// MinimockDeleteServiceAccountInspect logs each unmet expectation
+func (m *ServiceAccountRepositoryMock) MinimockDeleteServiceAccountInspect() {
+	for _, e := range m.DeleteServiceAccountMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ServiceAccountRepositoryMock.DeleteServiceAccount at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteServiceAccountCounter := mm_atomic.LoadUint64(&m.afterDeleteServiceAccountCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteServiceAccountMock.defaultExpectation != nil && afterDeleteServiceAccountCounter < 1 {
+		if m.DeleteServiceAccountMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ServiceAccountRepositoryMock.DeleteServiceAccount at\n%s", m.DeleteServiceAccountMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ServiceAccountRepositoryMock.DeleteServiceAccount at\n%s with params: %#v", m.DeleteServiceAccountMock.defaultExpectation.expectationOrigins.origin, *m.DeleteServiceAccountMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteServiceAccount != nil && afterDeleteServiceAccountCounter < 1 {
+		m.t.Errorf("Expected call to ServiceAccountRepositoryMock.DeleteServiceAccount at\n%s", m.funcDeleteServiceAccountOrigin)
+	}
+
+	if !m.DeleteServiceAccountMock.invocationsDone() && afterDeleteServiceAccountCounter > 0 {
+		m.t.Errorf("Expected %d calls to ServiceAccountRepositoryMock.DeleteServiceAccount at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteServiceAccountMock.expectedInvocations), m.DeleteServiceAccountMock.expectedInvocationsOrigin, afterDeleteServiceAccountCounter)
+	}
+}
+
+type mServiceAccountRepositoryMockFindAPIKeyByID struct {
+	optional           bool
+	mock               *ServiceAccountRepositoryMock
+	defaultExpectation *ServiceAccountRepositoryMockFindAPIKeyByIDExpectation
+	expectations       []*ServiceAccountRepositoryMockFindAPIKeyByIDExpectation
+
+	callArgs []*ServiceAccountRepositoryMockFindAPIKeyByIDParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ServiceAccountRepositoryMockFindAPIKeyByIDExpectation specifies expectation struct of the ServiceAccountRepository.FindAPIKeyByID
+type ServiceAccountRepositoryMockFindAPIKeyByIDExpectation struct {
+	mock               *ServiceAccountRepositoryMock
+	params             *ServiceAccountRepositoryMockFindAPIKeyByIDParams
+	paramPtrs          *ServiceAccountRepositoryMockFindAPIKeyByIDParamPtrs
+	expectationOrigins ServiceAccountRepositoryMockFindAPIKeyByIDExpectationOrigins
+	results            *ServiceAccountRepositoryMockFindAPIKeyByIDResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ServiceAccountRepositoryMockFindAPIKeyByIDParams contains parameters of the ServiceAccountRepository.FindAPIKeyByID
+type ServiceAccountRepositoryMockFindAPIKeyByIDParams struct {
+	ctx   context.Context
+	keyID string
+}
+
+// ServiceAccountRepositoryMockFindAPIKeyByIDParamPtrs contains pointers to parameters of the ServiceAccountRepository.FindAPIKeyByID
+type ServiceAccountRepositoryMockFindAPIKeyByIDParamPtrs struct {
+	ctx   *context.Context
+	keyID *string
+}
+
+// ServiceAccountRepositoryMockFindAPIKeyByIDResults contains results of the ServiceAccountRepository.FindAPIKeyByID
+type ServiceAccountRepositoryMockFindAPIKeyByIDResults struct {
+	ap1 *models.APIKey
+	err error
+}
+
+// ServiceAccountRepositoryMockFindAPIKeyByIDOrigins contains origins of expectations of the ServiceAccountRepository.FindAPIKeyByID
+type ServiceAccountRepositoryMockFindAPIKeyByIDExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originKeyID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmFindAPIKeyByID *mServiceAccountRepositoryMockFindAPIKeyByID) Optional() *mServiceAccountRepositoryMockFindAPIKeyByID {
+	mmFindAPIKeyByID.optional = true
+	return mmFindAPIKeyByID
+}
+
+// Expect sets up expected params for ServiceAccountRepository.FindAPIKeyByID
+func (mmFindAPIKeyByID *mServiceAccountRepositoryMockFindAPIKeyByID) Expect(ctx context.Context, keyID string) *mServiceAccountRepositoryMockFindAPIKeyByID {
+	if mmFindAPIKeyByID.mock.funcFindAPIKeyByID != nil {
+		mmFindAPIKeyByID.mock.t.Fatalf("ServiceAccountRepositoryMock.FindAPIKeyByID mock is already set by Set")
+	}
+
+	if mmFindAPIKeyByID.defaultExpectation == nil {
+		mmFindAPIKeyByID.defaultExpectation = &ServiceAccountRepositoryMockFindAPIKeyByIDExpectation{}
+	}
+
+	if mmFindAPIKeyByID.defaultExpectation.paramPtrs != nil {
+		mmFindAPIKeyByID.mock.t.Fatalf("ServiceAccountRepositoryMock.FindAPIKeyByID mock is already set by ExpectParams functions")
+	}
+
+	mmFindAPIKeyByID.defaultExpectation.params = &ServiceAccountRepositoryMockFindAPIKeyByIDParams{ctx, keyID}
+	mmFindAPIKeyByID.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmFindAPIKeyByID.expectations {
+		if minimock.Equal(e.params, mmFindAPIKeyByID.defaultExpectation.params) {
+			mmFindAPIKeyByID.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmFindAPIKeyByID.defaultExpectation.params)
+		}
+	}
+
+	return mmFindAPIKeyByID
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ServiceAccountRepository.FindAPIKeyByID
+func (mmFindAPIKeyByID *mServiceAccountRepositoryMockFindAPIKeyByID) ExpectCtxParam1(ctx context.Context) *mServiceAccountRepositoryMockFindAPIKeyByID {
+	if mmFindAPIKeyByID.mock.funcFindAPIKeyByID != nil {
+		mmFindAPIKeyByID.mock.t.Fatalf("ServiceAccountRepositoryMock.FindAPIKeyByID mock is already set by Set")
+	}
+
+	if mmFindAPIKeyByID.defaultExpectation == nil {
+		mmFindAPIKeyByID.defaultExpectation = &ServiceAccountRepositoryMockFindAPIKeyByIDExpectation{}
+	}
+
+	if mmFindAPIKeyByID.defaultExpectation.params != nil {
+		mmFindAPIKeyByID.mock.t.Fatalf("ServiceAccountRepositoryMock.FindAPIKeyByID mock is already set by Expect")
+	}
+
+	if mmFindAPIKeyByID.defaultExpectation.paramPtrs == nil {
+		mmFindAPIKeyByID.defaultExpectation.paramPtrs = &ServiceAccountRepositoryMockFindAPIKeyByIDParamPtrs{}
+	}
+	mmFindAPIKeyByID.defaultExpectation.paramPtrs.ctx = &ctx
+	mmFindAPIKeyByID.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmFindAPIKeyByID
+}
+
+// ExpectKeyIDParam2 sets up expected param keyID for ServiceAccountRepository.FindAPIKeyByID
+func (mmFindAPIKeyByID *mServiceAccountRepositoryMockFindAPIKeyByID) ExpectKeyIDParam2(keyID string) *mServiceAccountRepositoryMockFindAPIKeyByID {
+	if mmFindAPIKeyByID.mock.funcFindAPIKeyByID != nil {
+		mmFindAPIKeyByID.mock.t.Fatalf("ServiceAccountRepositoryMock.FindAPIKeyByID mock is already set by Set")
+	}
+
+	if mmFindAPIKeyByID.defaultExpectation == nil {
+		mmFindAPIKeyByID.defaultExpectation = &ServiceAccountRepositoryMockFindAPIKeyByIDExpectation{}
+	}
+
+	if mmFindAPIKeyByID.defaultExpectation.params != nil {
+		mmFindAPIKeyByID.mock.t.Fatalf("ServiceAccountRepositoryMock.FindAPIKeyByID mock is already set by Expect")
+	}
+
+	if mmFindAPIKeyByID.defaultExpectation.paramPtrs == nil {
+		mmFindAPIKeyByID.defaultExpectation.paramPtrs = &ServiceAccountRepositoryMockFindAPIKeyByIDParamPtrs{}
+	}
+	mmFindAPIKeyByID.defaultExpectation.paramPtrs.keyID = &keyID
+	mmFindAPIKeyByID.defaultExpectation.expectationOrigins.originKeyID = minimock.CallerInfo(1)
+
+	return mmFindAPIKeyByID
+}
+
+// Inspect accepts an inspector function that has same arguments as the ServiceAccountRepository.FindAPIKeyByID
+func (mmFindAPIKeyByID *mServiceAccountRepositoryMockFindAPIKeyByID) Inspect(f func(ctx context.Context, keyID string)) *mServiceAccountRepositoryMockFindAPIKeyByID {
+	if mmFindAPIKeyByID.mock.inspectFuncFindAPIKeyByID != nil {
+		mmFindAPIKeyByID.mock.t.Fatalf("Inspect function is already set for ServiceAccountRepositoryMock.FindAPIKeyByID")
+	}
+
+	mmFindAPIKeyByID.mock.inspectFuncFindAPIKeyByID = f
+
+	return mmFindAPIKeyByID
+}
+
+// Return sets up results that will be returned by ServiceAccountRepository.FindAPIKeyByID
+func (mmFindAPIKeyByID *mServiceAccountRepositoryMockFindAPIKeyByID) Return(ap1 *models.APIKey, err error) *ServiceAccountRepositoryMock {
+	if mmFindAPIKeyByID.mock.funcFindAPIKeyByID != nil {
+		mmFindAPIKeyByID.mock.t.Fatalf("ServiceAccountRepositoryMock.FindAPIKeyByID mock is already set by Set")
+	}
+
+	if mmFindAPIKeyByID.defaultExpectation == nil {
+		mmFindAPIKeyByID.defaultExpectation = &ServiceAccountRepositoryMockFindAPIKeyByIDExpectation{mock: mmFindAPIKeyByID.mock}
+	}
+	mmFindAPIKeyByID.defaultExpectation.results = &ServiceAccountRepositoryMockFindAPIKeyByIDResults{ap1, err}
+	mmFindAPIKeyByID.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmFindAPIKeyByID.mock
+}
+
+// Set uses given function f to mock the ServiceAccountRepository.FindAPIKeyByID method
+func (mmFindAPIKeyByID *mServiceAccountRepositoryMockFindAPIKeyByID) Set(f func(ctx context.Context, keyID string) (ap1 *models.APIKey, err error)) *ServiceAccountRepositoryMock {
+	if mmFindAPIKeyByID.defaultExpectation != nil {
+		mmFindAPIKeyByID.mock.t.Fatalf("Default expectation is already set for the ServiceAccountRepository.FindAPIKeyByID method")
+	}
+
+	if len(mmFindAPIKeyByID.expectations) > 0 {
+		mmFindAPIKeyByID.mock.t.Fatalf("Some expectations are already set for the ServiceAccountRepository.FindAPIKeyByID method")
+	}
+
+	mmFindAPIKeyByID.mock.funcFindAPIKeyByID = f
+	mmFindAPIKeyByID.mock.funcFindAPIKeyByIDOrigin = minimock.CallerInfo(1)
+	return mmFindAPIKeyByID.mock
+}
+
+// When sets expectation for the ServiceAccountRepository.FindAPIKeyByID which will trigger the result defined by the following
+// Then helper
+func (mmFindAPIKeyByID *mServiceAccountRepositoryMockFindAPIKeyByID) When(ctx context.Context, keyID string) *ServiceAccountRepositoryMockFindAPIKeyByIDExpectation {
+	if mmFindAPIKeyByID.mock.funcFindAPIKeyByID != nil {
+		mmFindAPIKeyByID.mock.t.Fatalf("ServiceAccountRepositoryMock.FindAPIKeyByID mock is already set by Set")
+	}
+
+	expectation := &ServiceAccountRepositoryMockFindAPIKeyByIDExpectation{
+		mock:               mmFindAPIKeyByID.mock,
+		params:             &ServiceAccountRepositoryMockFindAPIKeyByIDParams{ctx, keyID},
+		expectationOrigins: ServiceAccountRepositoryMockFindAPIKeyByIDExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmFindAPIKeyByID.expectations = append(mmFindAPIKeyByID.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ServiceAccountRepository.FindAPIKeyByID return parameters for the expectation previously defined by the When method
+func (e *ServiceAccountRepositoryMockFindAPIKeyByIDExpectation) Then(ap1 *models.APIKey, err error) *ServiceAccountRepositoryMock {
+	e.results = &ServiceAccountRepositoryMockFindAPIKeyByIDResults{ap1, err}
+	return e.mock
+}
+
+// Times sets number of times ServiceAccountRepository.FindAPIKeyByID should be invoked
+func (mmFindAPIKeyByID *mServiceAccountRepositoryMockFindAPIKeyByID) Times(n uint64) *mServiceAccountRepositoryMockFindAPIKeyByID {
+	if n == 0 {
+		mmFindAPIKeyByID.mock.t.Fatalf("Times of ServiceAccountRepositoryMock.FindAPIKeyByID mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmFindAPIKeyByID.expectedInvocations, n)
+	mmFindAPIKeyByID.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmFindAPIKeyByID
+}
+
+func (mmFindAPIKeyByID *mServiceAccountRepositoryMockFindAPIKeyByID) invocationsDone() bool {
+	if len(mmFindAPIKeyByID.expectations) == 0 && mmFindAPIKeyByID.defaultExpectation == nil && mmFindAPIKeyByID.mock.funcFindAPIKeyByID == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmFindAPIKeyByID.mock.afterFindAPIKeyByIDCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmFindAPIKeyByID.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// FindAPIKeyByID implements ServiceAccountRepository
+func (mmFindAPIKeyByID *ServiceAccountRepositoryMock) FindAPIKeyByID(ctx context.Context, keyID string) (ap1 *models.APIKey, err error) {
+	mm_atomic.AddUint64(&mmFindAPIKeyByID.beforeFindAPIKeyByIDCounter, 1)
+	defer mm_atomic.AddUint64(&mmFindAPIKeyByID.afterFindAPIKeyByIDCounter, 1)
+
+	mmFindAPIKeyByID.t.Helper()
+
+	if mmFindAPIKeyByID.inspectFuncFindAPIKeyByID != nil {
+		mmFindAPIKeyByID.inspectFuncFindAPIKeyByID(ctx, keyID)
+	}
+
+	mm_params := ServiceAccountRepositoryMockFindAPIKeyByIDParams{ctx, keyID}
+
+	// Record call args
+	mmFindAPIKeyByID.FindAPIKeyByIDMock.mutex.Lock()
+	mmFindAPIKeyByID.FindAPIKeyByIDMock.callArgs = append(mmFindAPIKeyByID.FindAPIKeyByIDMock.callArgs, &mm_params)
+	mmFindAPIKeyByID.FindAPIKeyByIDMock.mutex.Unlock()
+
+	for _, e := range mmFindAPIKeyByID.FindAPIKeyByIDMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ap1, e.results.err
+		}
+	}
+
+	if mmFindAPIKeyByID.FindAPIKeyByIDMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmFindAPIKeyByID.FindAPIKeyByIDMock.defaultExpectation.Counter, 1)
+		mm_want := mmFindAPIKeyByID.FindAPIKeyByIDMock.defaultExpectation.params
+		mm_want_ptrs := mmFindAPIKeyByID.FindAPIKeyByIDMock.defaultExpectation.paramPtrs
+
+		mm_got := ServiceAccountRepositoryMockFindAPIKeyByIDParams{ctx, keyID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmFindAPIKeyByID.t.Errorf("ServiceAccountRepositoryMock.FindAPIKeyByID got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindAPIKeyByID.FindAPIKeyByIDMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.keyID != nil && !minimock.Equal(*mm_want_ptrs.keyID, mm_got.keyID) {
+				mmFindAPIKeyByID.t.Errorf("ServiceAccountRepositoryMock.FindAPIKeyByID got unexpected parameter keyID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindAPIKeyByID.FindAPIKeyByIDMock.defaultExpectation.expectationOrigins.originKeyID, *mm_want_ptrs.keyID, mm_got.keyID, minimock.Diff(*mm_want_ptrs.keyID, mm_got.keyID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmFindAPIKeyByID.t.Errorf("ServiceAccountRepositoryMock.FindAPIKeyByID got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmFindAPIKeyByID.FindAPIKeyByIDMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmFindAPIKeyByID.FindAPIKeyByIDMock.defaultExpectation.results
+		if mm_results == nil {
+			mmFindAPIKeyByID.t.Fatal("No results are set for the ServiceAccountRepositoryMock.FindAPIKeyByID")
+		}
+		return (*mm_results).ap1, (*mm_results).err
+	}
+	if mmFindAPIKeyByID.funcFindAPIKeyByID != nil {
+		return mmFindAPIKeyByID.funcFindAPIKeyByID(ctx, keyID)
+	}
+	mmFindAPIKeyByID.t.Fatalf("Unexpected call to ServiceAccountRepositoryMock.FindAPIKeyByID. %v %v", ctx, keyID)
+	return
+}
+
+// FindAPIKeyByIDAfterCounter returns a count of finished ServiceAccountRepositoryMock.FindAPIKeyByID invocations
+func (mmFindAPIKeyByID *ServiceAccountRepositoryMock) FindAPIKeyByIDAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFindAPIKeyByID.afterFindAPIKeyByIDCounter)
+}
+
+// FindAPIKeyByIDBeforeCounter returns a count of ServiceAccountRepositoryMock.FindAPIKeyByID invocations
+func (mmFindAPIKeyByID *ServiceAccountRepositoryMock) FindAPIKeyByIDBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFindAPIKeyByID.beforeFindAPIKeyByIDCounter)
+}
+
+// Calls returns a list of arguments used in each call to ServiceAccountRepositoryMock.FindAPIKeyByID.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmFindAPIKeyByID *mServiceAccountRepositoryMockFindAPIKeyByID) Calls() []*ServiceAccountRepositoryMockFindAPIKeyByIDParams {
+	mmFindAPIKeyByID.mutex.RLock()
+
+	argCopy := make([]*ServiceAccountRepositoryMockFindAPIKeyByIDParams, len(mmFindAPIKeyByID.callArgs))
+	copy(argCopy, mmFindAPIKeyByID.callArgs)
+
+	mmFindAPIKeyByID.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockFindAPIKeyByIDDone returns true if the count of the FindAPIKeyByID invocations corresponds
+// the number of defined expectations
+func (m *ServiceAccountRepositoryMock) MinimockFindAPIKeyByIDDone() bool {
+	if m.FindAPIKeyByIDMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.FindAPIKeyByIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.FindAPIKeyByIDMock.invocationsDone()
+}
+
+// MinimockFindAPIKeyByIDInspect logs each unmet expectation
+func (m *ServiceAccountRepositoryMock) MinimockFindAPIKeyByIDInspect() {
+	for _, e := range m.FindAPIKeyByIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ServiceAccountRepositoryMock.FindAPIKeyByID at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterFindAPIKeyByIDCounter := mm_atomic.LoadUint64(&m.afterFindAPIKeyByIDCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.FindAPIKeyByIDMock.defaultExpectation != nil && afterFindAPIKeyByIDCounter < 1 {
+		if m.FindAPIKeyByIDMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ServiceAccountRepositoryMock.FindAPIKeyByID at\n%s", m.FindAPIKeyByIDMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ServiceAccountRepositoryMock.FindAPIKeyByID at\n%s with params: %#v", m.FindAPIKeyByIDMock.defaultExpectation.expectationOrigins.origin, *m.FindAPIKeyByIDMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcFindAPIKeyByID != nil && afterFindAPIKeyByIDCounter < 1 {
+		m.t.Errorf("Expected call to ServiceAccountRepositoryMock.FindAPIKeyByID at\n%s", m.funcFindAPIKeyByIDOrigin)
+	}
+
+	if !m.FindAPIKeyByIDMock.invocationsDone() && afterFindAPIKeyByIDCounter > 0 {
+		m.t.Errorf("Expected %d calls to ServiceAccountRepositoryMock.FindAPIKeyByID at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.FindAPIKeyByIDMock.expectedInvocations), m.FindAPIKeyByIDMock.expectedInvocationsOrigin, afterFindAPIKeyByIDCounter)
+	}
+}
+
+type mServiceAccountRepositoryMockFindServiceAccountByID struct {
+	optional           bool
+	mock               *ServiceAccountRepositoryMock
+	defaultExpectation *ServiceAccountRepositoryMockFindServiceAccountByIDExpectation
+	expectations       []*ServiceAccountRepositoryMockFindServiceAccountByIDExpectation
+
+	callArgs []*ServiceAccountRepositoryMockFindServiceAccountByIDParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ServiceAccountRepositoryMockFindServiceAccountByIDExpectation specifies expectation struct of the ServiceAccountRepository.FindServiceAccountByID
+type ServiceAccountRepositoryMockFindServiceAccountByIDExpectation struct {
+	mock               *ServiceAccountRepositoryMock
+	params             *ServiceAccountRepositoryMockFindServiceAccountByIDParams
+	paramPtrs          *ServiceAccountRepositoryMockFindServiceAccountByIDParamPtrs
+	expectationOrigins ServiceAccountRepositoryMockFindServiceAccountByIDExpectationOrigins
+	results            *ServiceAccountRepositoryMockFindServiceAccountByIDResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ServiceAccountRepositoryMockFindServiceAccountByIDParams contains parameters of the ServiceAccountRepository.FindServiceAccountByID
+type ServiceAccountRepositoryMockFindServiceAccountByIDParams struct {
+	ctx       context.Context
+	accountID string
+}
+
+// ServiceAccountRepositoryMockFindServiceAccountByIDParamPtrs contains pointers to parameters of the ServiceAccountRepository.FindServiceAccountByID
+type ServiceAccountRepositoryMockFindServiceAccountByIDParamPtrs struct {
+	ctx       *context.Context
+	accountID *string
+}
+
+// ServiceAccountRepositoryMockFindServiceAccountByIDResults contains results of the ServiceAccountRepository.FindServiceAccountByID
+type ServiceAccountRepositoryMockFindServiceAccountByIDResults struct {
+	sp1 *models.ServiceAccount
+	err error
+}
+
+// ServiceAccountRepositoryMockFindServiceAccountByIDOrigins contains origins of expectations of the ServiceAccountRepository.FindServiceAccountByID
+type ServiceAccountRepositoryMockFindServiceAccountByIDExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originAccountID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmFindServiceAccountByID *mServiceAccountRepositoryMockFindServiceAccountByID) Optional() *mServiceAccountRepositoryMockFindServiceAccountByID {
+	mmFindServiceAccountByID.optional = true
+	return mmFindServiceAccountByID
+}
+
+// Expect sets up expected params for ServiceAccountRepository.FindServiceAccountByID
+func (mmFindServiceAccountByID *mServiceAccountRepositoryMockFindServiceAccountByID) Expect(ctx context.Context, accountID string) *mServiceAccountRepositoryMockFindServiceAccountByID {
+	if mmFindServiceAccountByID.mock.funcFindServiceAccountByID != nil {
+		mmFindServiceAccountByID.mock.t.Fatalf("ServiceAccountRepositoryMock.FindServiceAccountByID mock is already set by Set")
+	}
+
+	if mmFindServiceAccountByID.defaultExpectation == nil {
+		mmFindServiceAccountByID.defaultExpectation = &ServiceAccountRepositoryMockFindServiceAccountByIDExpectation{}
+	}
+
+	if mmFindServiceAccountByID.defaultExpectation.paramPtrs != nil {
+		mmFindServiceAccountByID.mock.t.Fatalf("ServiceAccountRepositoryMock.FindServiceAccountByID mock is already set by ExpectParams functions")
+	}
+
+	mmFindServiceAccountByID.defaultExpectation.params = &ServiceAccountRepositoryMockFindServiceAccountByIDParams{ctx, accountID}
+	mmFindServiceAccountByID.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmFindServiceAccountByID.expectations {
+		if minimock.Equal(e.params, mmFindServiceAccountByID.defaultExpectation.params) {
+			mmFindServiceAccountByID.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmFindServiceAccountByID.defaultExpectation.params)
+		}
+	}
+
+	return mmFindServiceAccountByID
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ServiceAccountRepository.FindServiceAccountByID
+func (mmFindServiceAccountByID *mServiceAccountRepositoryMockFindServiceAccountByID) ExpectCtxParam1(ctx context.Context) *mServiceAccountRepositoryMockFindServiceAccountByID {
+	if mmFindServiceAccountByID.mock.funcFindServiceAccountByID != nil {
+		mmFindServiceAccountByID.mock.t.Fatalf("ServiceAccountRepositoryMock.FindServiceAccountByID mock is already set by Set")
+	}
+
+	if mmFindServiceAccountByID.defaultExpectation == nil {
+		mmFindServiceAccountByID.defaultExpectation = &ServiceAccountRepositoryMockFindServiceAccountByIDExpectation{}
+	}
+
+	if mmFindServiceAccountByID.defaultExpectation.params != nil {
+		mmFindServiceAccountByID.mock.t.Fatalf("ServiceAccountRepositoryMock.FindServiceAccountByID mock is already set by Expect")
+	}
+
+	if mmFindServiceAccountByID.defaultExpectation.paramPtrs == nil {
+		mmFindServiceAccountByID.defaultExpectation.paramPtrs = &ServiceAccountRepositoryMockFindServiceAccountByIDParamPtrs{}
+	}
+	mmFindServiceAccountByID.defaultExpectation.paramPtrs.ctx = &ctx
+	mmFindServiceAccountByID.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmFindServiceAccountByID
+}
+
+// ExpectAccountIDParam2 sets up expected param accountID for ServiceAccountRepository.FindServiceAccountByID
+func (mmFindServiceAccountByID *mServiceAccountRepositoryMockFindServiceAccountByID) ExpectAccountIDParam2(accountID string) *mServiceAccountRepositoryMockFindServiceAccountByID {
+	if mmFindServiceAccountByID.mock.funcFindServiceAccountByID != nil {
+		mmFindServiceAccountByID.mock.t.Fatalf("ServiceAccountRepositoryMock.FindServiceAccountByID mock is already set by Set")
+	}
+
+	if mmFindServiceAccountByID.defaultExpectation == nil {
+		mmFindServiceAccountByID.defaultExpectation = &ServiceAccountRepositoryMockFindServiceAccountByIDExpectation{}
+	}
+
+	if mmFindServiceAccountByID.defaultExpectation.params != nil {
+		mmFindServiceAccountByID.mock.t.Fatalf("ServiceAccountRepositoryMock.FindServiceAccountByID mock is already set by Expect")
+	}
+
+	if mmFindServiceAccountByID.defaultExpectation.paramPtrs == nil {
+		mmFindServiceAccountByID.defaultExpectation.paramPtrs = &ServiceAccountRepositoryMockFindServiceAccountByIDParamPtrs{}
+	}
+	mmFindServiceAccountByID.defaultExpectation.paramPtrs.accountID = &accountID
+	mmFindServiceAccountByID.defaultExpectation.expectationOrigins.originAccountID = minimock.CallerInfo(1)
+
+	return mmFindServiceAccountByID
+}
+
+// Inspect accepts an inspector function that has same arguments as the ServiceAccountRepository.FindServiceAccountByID
+func (mmFindServiceAccountByID *mServiceAccountRepositoryMockFindServiceAccountByID) Inspect(f func(ctx context.Context, accountID string)) *mServiceAccountRepositoryMockFindServiceAccountByID {
+	if mmFindServiceAccountByID.mock.inspectFuncFindServiceAccountByID != nil {
+		mmFindServiceAccountByID.mock.t.Fatalf("Inspect function is already set for ServiceAccountRepositoryMock.FindServiceAccountByID")
+	}
+
+	mmFindServiceAccountByID.mock.inspectFuncFindServiceAccountByID = f
+
+	return mmFindServiceAccountByID
+}
+
+// Return sets up results that will be returned by ServiceAccountRepository.FindServiceAccountByID
+func (mmFindServiceAccountByID *mServiceAccountRepositoryMockFindServiceAccountByID) Return(sp1 *models.ServiceAccount, err error) *ServiceAccountRepositoryMock {
+	if mmFindServiceAccountByID.mock.funcFindServiceAccountByID != nil {
+		mmFindServiceAccountByID.mock.t.Fatalf("ServiceAccountRepositoryMock.FindServiceAccountByID mock is already set by Set")
+	}
+
+	if mmFindServiceAccountByID.defaultExpectation == nil {
+		mmFindServiceAccountByID.defaultExpectation = &ServiceAccountRepositoryMockFindServiceAccountByIDExpectation{mock: mmFindServiceAccountByID.mock}
+	}
+	mmFindServiceAccountByID.defaultExpectation.results = &ServiceAccountRepositoryMockFindServiceAccountByIDResults{sp1, err}
+	mmFindServiceAccountByID.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmFindServiceAccountByID.mock
+}
+
+// Set uses given function f to mock the ServiceAccountRepository.FindServiceAccountByID method
+func (mmFindServiceAccountByID *mServiceAccountRepositoryMockFindServiceAccountByID) Set(f func(ctx context.Context, accountID string) (sp1 *models.ServiceAccount, err error)) *ServiceAccountRepositoryMock {
+	if mmFindServiceAccountByID.defaultExpectation != nil {
+		mmFindServiceAccountByID.mock.t.Fatalf("Default expectation is already set for the ServiceAccountRepository.FindServiceAccountByID method")
+	}
+
+	if len(mmFindServiceAccountByID.expectations) > 0 {
+		mmFindServiceAccountByID.mock.t.Fatalf("Some expectations are already set for the ServiceAccountRepository.FindServiceAccountByID method")
+	}
+
+	mmFindServiceAccountByID.mock.funcFindServiceAccountByID = f
+	mmFindServiceAccountByID.mock.funcFindServiceAccountByIDOrigin = minimock.CallerInfo(1)
+	return mmFindServiceAccountByID.mock
+}
+
+// When sets expectation for the ServiceAccountRepository.FindServiceAccountByID which will trigger the result defined by the following
+// Then helper
+func (mmFindServiceAccountByID *mServiceAccountRepositoryMockFindServiceAccountByID) When(ctx context.Context, accountID string) *ServiceAccountRepositoryMockFindServiceAccountByIDExpectation {
+	if mmFindServiceAccountByID.mock.funcFindServiceAccountByID != nil {
+		mmFindServiceAccountByID.mock.t.Fatalf("ServiceAccountRepositoryMock.FindServiceAccountByID mock is already set by Set")
+	}
+
+	expectation := &ServiceAccountRepositoryMockFindServiceAccountByIDExpectation{
+		mock:               mmFindServiceAccountByID.mock,
+		params:             &ServiceAccountRepositoryMockFindServiceAccountByIDParams{ctx, accountID},
+		expectationOrigins: ServiceAccountRepositoryMockFindServiceAccountByIDExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmFindServiceAccountByID.expectations = append(mmFindServiceAccountByID.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ServiceAccountRepository.FindServiceAccountByID return parameters for the expectation previously defined by the When method
+func (e *ServiceAccountRepositoryMockFindServiceAccountByIDExpectation) Then(sp1 *models.ServiceAccount, err error) *ServiceAccountRepositoryMock {
+	e.results = &ServiceAccountRepositoryMockFindServiceAccountByIDResults{sp1, err}
+	return e.mock
+}
+
+// Times sets number of times ServiceAccountRepository.FindServiceAccountByID should be invoked
+func (mmFindServiceAccountByID *mServiceAccountRepositoryMockFindServiceAccountByID) Times(n uint64) *mServiceAccountRepositoryMockFindServiceAccountByID {
+	if n == 0 {
+		mmFindServiceAccountByID.mock.t.Fatalf("Times of ServiceAccountRepositoryMock.FindServiceAccountByID mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmFindServiceAccountByID.expectedInvocations, n)
+	mmFindServiceAccountByID.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmFindServiceAccountByID
+}
+
+func (mmFindServiceAccountByID *mServiceAccountRepositoryMockFindServiceAccountByID) invocationsDone() bool {
+	if len(mmFindServiceAccountByID.expectations) == 0 && mmFindServiceAccountByID.defaultExpectation == nil && mmFindServiceAccountByID.mock.funcFindServiceAccountByID == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmFindServiceAccountByID.mock.afterFindServiceAccountByIDCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmFindServiceAccountByID.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// FindServiceAccountByID implements ServiceAccountRepository
+func (mmFindServiceAccountByID *ServiceAccountRepositoryMock) FindServiceAccountByID(ctx context.Context, accountID string) (sp1 *models.ServiceAccount, err error) {
+	mm_atomic.AddUint64(&mmFindServiceAccountByID.beforeFindServiceAccountByIDCounter, 1)
+	defer mm_atomic.AddUint64(&mmFindServiceAccountByID.afterFindServiceAccountByIDCounter, 1)
+
+	mmFindServiceAccountByID.t.Helper()
+
+	if mmFindServiceAccountByID.inspectFuncFindServiceAccountByID != nil {
+		mmFindServiceAccountByID.inspectFuncFindServiceAccountByID(ctx, accountID)
+	}
+
+	mm_params := ServiceAccountRepositoryMockFindServiceAccountByIDParams{ctx, accountID}
+
+	// Record call args
+	mmFindServiceAccountByID.FindServiceAccountByIDMock.mutex.Lock()
+	mmFindServiceAccountByID.FindServiceAccountByIDMock.callArgs = append(mmFindServiceAccountByID.FindServiceAccountByIDMock.callArgs, &mm_params)
+	mmFindServiceAccountByID.FindServiceAccountByIDMock.mutex.Unlock()
+
+	for _, e := range mmFindServiceAccountByID.FindServiceAccountByIDMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.sp1, e.results.err
+		}
+	}
+
+	if mmFindServiceAccountByID.FindServiceAccountByIDMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmFindServiceAccountByID.FindServiceAccountByIDMock.defaultExpectation.Counter, 1)
+		mm_want := mmFindServiceAccountByID.FindServiceAccountByIDMock.defaultExpectation.params
+		mm_want_ptrs := mmFindServiceAccountByID.FindServiceAccountByIDMock.defaultExpectation.paramPtrs
+
+		mm_got := ServiceAccountRepositoryMockFindServiceAccountByIDParams{ctx, accountID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmFindServiceAccountByID.t.Errorf("ServiceAccountRepositoryMock.FindServiceAccountByID got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindServiceAccountByID.FindServiceAccountByIDMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.accountID != nil && !minimock.Equal(*mm_want_ptrs.accountID, mm_got.accountID) {
+				mmFindServiceAccountByID.t.Errorf("ServiceAccountRepositoryMock.FindServiceAccountByID got unexpected parameter accountID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindServiceAccountByID.FindServiceAccountByIDMock.defaultExpectation.expectationOrigins.originAccountID, *mm_want_ptrs.accountID, mm_got.accountID, minimock.Diff(*mm_want_ptrs.accountID, mm_got.accountID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmFindServiceAccountByID.t.Errorf("ServiceAccountRepositoryMock.FindServiceAccountByID got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmFindServiceAccountByID.FindServiceAccountByIDMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmFindServiceAccountByID.FindServiceAccountByIDMock.defaultExpectation.results
+		if mm_results == nil {
+			mmFindServiceAccountByID.t.Fatal("No results are set for the ServiceAccountRepositoryMock.FindServiceAccountByID")
+		}
+		return (*mm_results).sp1, (*mm_results).err
+	}
+	if mmFindServiceAccountByID.funcFindServiceAccountByID != nil {
+		return mmFindServiceAccountByID.funcFindServiceAccountByID(ctx, accountID)
+	}
+	mmFindServiceAccountByID.t.Fatalf("Unexpected call to ServiceAccountRepositoryMock.FindServiceAccountByID. %v %v", ctx, accountID)
+	return
+}
+
+// FindServiceAccountByIDAfterCounter returns a count of finished ServiceAccountRepositoryMock.FindServiceAccountByID invocations
+func (mmFindServiceAccountByID *ServiceAccountRepositoryMock) FindServiceAccountByIDAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFindServiceAccountByID.afterFindServiceAccountByIDCounter)
+}
+
+// FindServiceAccountByIDBeforeCounter returns a count of ServiceAccountRepositoryMock.FindServiceAccountByID invocations
+func (mmFindServiceAccountByID *ServiceAccountRepositoryMock) FindServiceAccountByIDBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFindServiceAccountByID.beforeFindServiceAccountByIDCounter)
+}
+
+// Calls returns a list of arguments used in each call to ServiceAccountRepositoryMock.FindServiceAccountByID.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmFindServiceAccountByID *mServiceAccountRepositoryMockFindServiceAccountByID) Calls() []*ServiceAccountRepositoryMockFindServiceAccountByIDParams {
+	mmFindServiceAccountByID.mutex.RLock()
+
+	argCopy := make([]*ServiceAccountRepositoryMockFindServiceAccountByIDParams, len(mmFindServiceAccountByID.callArgs))
+	copy(argCopy, mmFindServiceAccountByID.callArgs)
+
+	mmFindServiceAccountByID.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockFindServiceAccountByIDDone returns true if the count of the FindServiceAccountByID invocations corresponds
+// the number of defined expectations
+func (m *ServiceAccountRepositoryMock) MinimockFindServiceAccountByIDDone() bool {
+	if m.FindServiceAccountByIDMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.FindServiceAccountByIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.FindServiceAccountByIDMock.invocationsDone()
+}
+
+// MinimockFindServiceAccountByIDInspect logs each unmet expectation
+func (m *ServiceAccountRepositoryMock) MinimockFindServiceAccountByIDInspect() {
+	for _, e := range m.FindServiceAccountByIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ServiceAccountRepositoryMock.FindServiceAccountByID at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterFindServiceAccountByIDCounter := mm_atomic.LoadUint64(&m.afterFindServiceAccountByIDCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.FindServiceAccountByIDMock.defaultExpectation != nil && afterFindServiceAccountByIDCounter < 1 {
+		if m.FindServiceAccountByIDMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ServiceAccountRepositoryMock.FindServiceAccountByID at\n%s", m.FindServiceAccountByIDMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ServiceAccountRepositoryMock.FindServiceAccountByID at\n%s with params: %#v", m.FindServiceAccountByIDMock.defaultExpectation.expectationOrigins.origin, *m.FindServiceAccountByIDMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcFindServiceAccountByID != nil && afterFindServiceAccountByIDCounter < 1 {
+		m.t.Errorf("Expected call to ServiceAccountRepositoryMock.FindServiceAccountByID at\n%s", m.funcFindServiceAccountByIDOrigin)
+	}
+
+	if !m.FindServiceAccountByIDMock.invocationsDone() && afterFindServiceAccountByIDCounter > 0 {
+		m.t.Errorf("Expected %d calls to ServiceAccountRepositoryMock.FindServiceAccountByID at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.FindServiceAccountByIDMock.expectedInvocations), m.FindServiceAccountByIDMock.expectedInvocationsOrigin, afterFindServiceAccountByIDCounter)
+	}
+}
+
+type mServiceAccountRepositoryMockListAPIKeys struct {
+	optional           bool
+	mock               *ServiceAccountRepositoryMock
+	defaultExpectation *ServiceAccountRepositoryMockListAPIKeysExpectation
+	expectations       []*ServiceAccountRepositoryMockListAPIKeysExpectation
+
+	callArgs []*ServiceAccountRepositoryMockListAPIKeysParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ServiceAccountRepositoryMockListAPIKeysExpectation specifies expectation struct of the ServiceAccountRepository.ListAPIKeys
+type ServiceAccountRepositoryMockListAPIKeysExpectation struct {
+	mock               *ServiceAccountRepositoryMock
+	params             *ServiceAccountRepositoryMockListAPIKeysParams
+	paramPtrs          *ServiceAccountRepositoryMockListAPIKeysParamPtrs
+	expectationOrigins ServiceAccountRepositoryMockListAPIKeysExpectationOrigins
+	results            *ServiceAccountRepositoryMockListAPIKeysResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ServiceAccountRepositoryMockListAPIKeysParams contains parameters of the ServiceAccountRepository.ListAPIKeys
+type ServiceAccountRepositoryMockListAPIKeysParams struct {
+	ctx       context.Context
+	accountID string
+}
+
+// ServiceAccountRepositoryMockListAPIKeysParamPtrs contains pointers to parameters of the ServiceAccountRepository.ListAPIKeys
+type ServiceAccountRepositoryMockListAPIKeysParamPtrs struct {
+	ctx       *context.Context
+	accountID *string
+}
+
+// ServiceAccountRepositoryMockListAPIKeysResults contains results of the ServiceAccountRepository.ListAPIKeys
+type ServiceAccountRepositoryMockListAPIKeysResults struct {
+	aa1 []models.APIKey
+	err error
+}
+
+// ServiceAccountRepositoryMockListAPIKeysOrigins contains origins of expectations of the ServiceAccountRepository.ListAPIKeys
+type ServiceAccountRepositoryMockListAPIKeysExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originAccountID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListAPIKeys *mServiceAccountRepositoryMockListAPIKeys) Optional() *mServiceAccountRepositoryMockListAPIKeys {
+	mmListAPIKeys.optional = true
+	return mmListAPIKeys
+}
+
+// Expect sets up expected params for ServiceAccountRepository.ListAPIKeys
+func (mmListAPIKeys *mServiceAccountRepositoryMockListAPIKeys) Expect(ctx context.Context, accountID string) *mServiceAccountRepositoryMockListAPIKeys {
+	if mmListAPIKeys.mock.funcListAPIKeys != nil {
+		mmListAPIKeys.mock.t.Fatalf("ServiceAccountRepositoryMock.ListAPIKeys mock is already set by Set")
+	}
+
+	if mmListAPIKeys.defaultExpectation == nil {
+		mmListAPIKeys.defaultExpectation = &ServiceAccountRepositoryMockListAPIKeysExpectation{}
+	}
+
+	if mmListAPIKeys.defaultExpectation.paramPtrs != nil {
+		mmListAPIKeys.mock.t.Fatalf("ServiceAccountRepositoryMock.ListAPIKeys mock is already set by ExpectParams functions")
+	}
+
+	mmListAPIKeys.defaultExpectation.params = &ServiceAccountRepositoryMockListAPIKeysParams{ctx, accountID}
+	mmListAPIKeys.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListAPIKeys.expectations {
+		if minimock.Equal(e.params, mmListAPIKeys.defaultExpectation.params) {
+			mmListAPIKeys.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListAPIKeys.defaultExpectation.params)
+		}
+	}
+
+	return mmListAPIKeys
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ServiceAccountRepository.ListAPIKeys
+func (mmListAPIKeys *mServiceAccountRepositoryMockListAPIKeys) ExpectCtxParam1(ctx context.Context) *mServiceAccountRepositoryMockListAPIKeys {
+	if mmListAPIKeys.mock.funcListAPIKeys != nil {
+		mmListAPIKeys.mock.t.Fatalf("ServiceAccountRepositoryMock.ListAPIKeys mock is already set by Set")
+	}
+
+	if mmListAPIKeys.defaultExpectation == nil {
+		mmListAPIKeys.defaultExpectation = &ServiceAccountRepositoryMockListAPIKeysExpectation{}
+	}
+
+	if mmListAPIKeys.defaultExpectation.params != nil {
+		mmListAPIKeys.mock.t.Fatalf("ServiceAccountRepositoryMock.ListAPIKeys mock is already set by Expect")
+	}
+
+	if mmListAPIKeys.defaultExpectation.paramPtrs == nil {
+		mmListAPIKeys.defaultExpectation.paramPtrs = &ServiceAccountRepositoryMockListAPIKeysParamPtrs{}
+	}
+	mmListAPIKeys.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListAPIKeys.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListAPIKeys
+}
+
+// ExpectAccountIDParam2 sets up expected param accountID for ServiceAccountRepository.ListAPIKeys
+func (mmListAPIKeys *mServiceAccountRepositoryMockListAPIKeys) ExpectAccountIDParam2(accountID string) *mServiceAccountRepositoryMockListAPIKeys {
+	if mmListAPIKeys.mock.funcListAPIKeys != nil {
+		mmListAPIKeys.mock.t.Fatalf("ServiceAccountRepositoryMock.ListAPIKeys mock is already set by Set")
+	}
+
+	if mmListAPIKeys.defaultExpectation == nil {
+		mmListAPIKeys.defaultExpectation = &ServiceAccountRepositoryMockListAPIKeysExpectation{}
+	}
+
+	if mmListAPIKeys.defaultExpectation.params != nil {
+		mmListAPIKeys.mock.t.Fatalf("ServiceAccountRepositoryMock.ListAPIKeys mock is already set by Expect")
+	}
+
+	if mmListAPIKeys.defaultExpectation.paramPtrs == nil {
+		mmListAPIKeys.defaultExpectation.paramPtrs = &ServiceAccountRepositoryMockListAPIKeysParamPtrs{}
+	}
+	mmListAPIKeys.defaultExpectation.paramPtrs.accountID = &accountID
+	mmListAPIKeys.defaultExpectation.expectationOrigins.originAccountID = minimock.CallerInfo(1)
+
+	return mmListAPIKeys
+}
+
+// Inspect accepts an inspector function that has same arguments as the ServiceAccountRepository.ListAPIKeys
+func (mmListAPIKeys *mServiceAccountRepositoryMockListAPIKeys) Inspect(f func(ctx context.Context, accountID string)) *mServiceAccountRepositoryMockListAPIKeys {
+	if mmListAPIKeys.mock.inspectFuncListAPIKeys != nil {
+		mmListAPIKeys.mock.t.Fatalf("Inspect function is already set for ServiceAccountRepositoryMock.ListAPIKeys")
+	}
+
+	mmListAPIKeys.mock.inspectFuncListAPIKeys = f
+
+	return mmListAPIKeys
+}
+
+// Return sets up results that will be returned by ServiceAccountRepository.ListAPIKeys
+func (mmListAPIKeys *mServiceAccountRepositoryMockListAPIKeys) Return(aa1 []models.APIKey, err error) *ServiceAccountRepositoryMock {
+	if mmListAPIKeys.mock.funcListAPIKeys != nil {
+		mmListAPIKeys.mock.t.Fatalf("ServiceAccountRepositoryMock.ListAPIKeys mock is already set by Set")
+	}
+
+	if mmListAPIKeys.defaultExpectation == nil {
+		mmListAPIKeys.defaultExpectation = &ServiceAccountRepositoryMockListAPIKeysExpectation{mock: mmListAPIKeys.mock}
+	}
+	mmListAPIKeys.defaultExpectation.results = &ServiceAccountRepositoryMockListAPIKeysResults{aa1, err}
+	mmListAPIKeys.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListAPIKeys.mock
+}
+
+// Set uses given function f to mock the ServiceAccountRepository.ListAPIKeys method
+func (mmListAPIKeys *mServiceAccountRepositoryMockListAPIKeys) Set(f func(ctx context.Context, accountID string) (aa1 []models.APIKey, err error)) *ServiceAccountRepositoryMock {
+	if mmListAPIKeys.defaultExpectation != nil {
+		mmListAPIKeys.mock.t.Fatalf("Default expectation is already set for the ServiceAccountRepository.ListAPIKeys method")
+	}
+
+	if len(mmListAPIKeys.expectations) > 0 {
+		mmListAPIKeys.mock.t.Fatalf("Some expectations are already set for the ServiceAccountRepository.ListAPIKeys method")
+	}
+
+	mmListAPIKeys.mock.funcListAPIKeys = f
+	mmListAPIKeys.mock.funcListAPIKeysOrigin = minimock.CallerInfo(1)
+	return mmListAPIKeys.mock
+}
+
+// When sets expectation for the ServiceAccountRepository.ListAPIKeys which will trigger the result defined by the following
+// Then helper
+func (mmListAPIKeys *mServiceAccountRepositoryMockListAPIKeys) When(ctx context.Context, accountID string) *ServiceAccountRepositoryMockListAPIKeysExpectation {
+	if mmListAPIKeys.mock.funcListAPIKeys != nil {
+		mmListAPIKeys.mock.t.Fatalf("ServiceAccountRepositoryMock.ListAPIKeys mock is already set by Set")
+	}
+
+	expectation := &ServiceAccountRepositoryMockListAPIKeysExpectation{
+		mock:               mmListAPIKeys.mock,
+		params:             &ServiceAccountRepositoryMockListAPIKeysParams{ctx, accountID},
+		expectationOrigins: ServiceAccountRepositoryMockListAPIKeysExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListAPIKeys.expectations = append(mmListAPIKeys.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ServiceAccountRepository.ListAPIKeys return parameters for the expectation previously defined by the When method
+func (e *ServiceAccountRepositoryMockListAPIKeysExpectation) Then(aa1 []models.APIKey, err error) *ServiceAccountRepositoryMock {
+	e.results = &ServiceAccountRepositoryMockListAPIKeysResults{aa1, err}
+	return e.mock
+}
+
+// Times sets number of times ServiceAccountRepository.ListAPIKeys should be invoked
+func (mmListAPIKeys *mServiceAccountRepositoryMockListAPIKeys) Times(n uint64) *mServiceAccountRepositoryMockListAPIKeys {
+	if n == 0 {
+		mmListAPIKeys.mock.t.Fatalf("Times of ServiceAccountRepositoryMock.ListAPIKeys mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListAPIKeys.expectedInvocations, n)
+	mmListAPIKeys.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListAPIKeys
+}
+
+func (mmListAPIKeys *mServiceAccountRepositoryMockListAPIKeys) invocationsDone() bool {
+	if len(mmListAPIKeys.expectations) == 0 && mmListAPIKeys.defaultExpectation == nil && mmListAPIKeys.mock.funcListAPIKeys == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListAPIKeys.mock.afterListAPIKeysCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListAPIKeys.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListAPIKeys implements ServiceAccountRepository
+func (mmListAPIKeys *ServiceAccountRepositoryMock) ListAPIKeys(ctx context.Context, accountID string) (aa1 []models.APIKey, err error) {
+	mm_atomic.AddUint64(&mmListAPIKeys.beforeListAPIKeysCounter, 1)
+	defer mm_atomic.AddUint64(&mmListAPIKeys.afterListAPIKeysCounter, 1)
+
+	mmListAPIKeys.t.Helper()
+
+	if mmListAPIKeys.inspectFuncListAPIKeys != nil {
+		mmListAPIKeys.inspectFuncListAPIKeys(ctx, accountID)
+	}
+
+	mm_params := ServiceAccountRepositoryMockListAPIKeysParams{ctx, accountID}
+
+	// Record call args
+	mmListAPIKeys.ListAPIKeysMock.mutex.Lock()
+	mmListAPIKeys.ListAPIKeysMock.callArgs = append(mmListAPIKeys.ListAPIKeysMock.callArgs, &mm_params)
+	mmListAPIKeys.ListAPIKeysMock.mutex.Unlock()
+
+	for _, e := range mmListAPIKeys.ListAPIKeysMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.aa1, e.results.err
+		}
+	}
+
+	if mmListAPIKeys.ListAPIKeysMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListAPIKeys.ListAPIKeysMock.defaultExpectation.Counter, 1)
+		mm_want := mmListAPIKeys.ListAPIKeysMock.defaultExpectation.params
+		mm_want_ptrs := mmListAPIKeys.ListAPIKeysMock.defaultExpectation.paramPtrs
+
+		mm_got := ServiceAccountRepositoryMockListAPIKeysParams{ctx, accountID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListAPIKeys.t.Errorf("ServiceAccountRepositoryMock.ListAPIKeys got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListAPIKeys.ListAPIKeysMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.accountID != nil && !minimock.Equal(*mm_want_ptrs.accountID, mm_got.accountID) {
+				mmListAPIKeys.t.Errorf("ServiceAccountRepositoryMock.ListAPIKeys got unexpected parameter accountID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListAPIKeys.ListAPIKeysMock.defaultExpectation.expectationOrigins.originAccountID, *mm_want_ptrs.accountID, mm_got.accountID, minimock.Diff(*mm_want_ptrs.accountID, mm_got.accountID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListAPIKeys.t.Errorf("ServiceAccountRepositoryMock.ListAPIKeys got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListAPIKeys.ListAPIKeysMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListAPIKeys.ListAPIKeysMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListAPIKeys.t.Fatal("No results are set for the ServiceAccountRepositoryMock.ListAPIKeys")
+		}
+		return (*mm_results).aa1, (*mm_results).err
+	}
+	if mmListAPIKeys.funcListAPIKeys != nil {
+		return mmListAPIKeys.funcListAPIKeys(ctx, accountID)
+	}
+	mmListAPIKeys.t.Fatalf("Unexpected call to ServiceAccountRepositoryMock.ListAPIKeys. %v %v", ctx, accountID)
+	return
+}
+
+// ListAPIKeysAfterCounter returns a count of finished ServiceAccountRepositoryMock.ListAPIKeys invocations
+func (mmListAPIKeys *ServiceAccountRepositoryMock) ListAPIKeysAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListAPIKeys.afterListAPIKeysCounter)
+}
+
+// ListAPIKeysBeforeCounter returns a count of ServiceAccountRepositoryMock.ListAPIKeys invocations
+func (mmListAPIKeys *ServiceAccountRepositoryMock) ListAPIKeysBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListAPIKeys.beforeListAPIKeysCounter)
+}
+
+// Calls returns a list of arguments used in each call to ServiceAccountRepositoryMock.ListAPIKeys.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListAPIKeys *mServiceAccountRepositoryMockListAPIKeys) Calls() []*ServiceAccountRepositoryMockListAPIKeysParams {
+	mmListAPIKeys.mutex.RLock()
+
+	argCopy := make([]*ServiceAccountRepositoryMockListAPIKeysParams, len(mmListAPIKeys.callArgs))
+	copy(argCopy, mmListAPIKeys.callArgs)
+
+	mmListAPIKeys.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListAPIKeysDone returns true if the count of the ListAPIKeys invocations corresponds
+// the number of defined expectations
+func (m *ServiceAccountRepositoryMock) MinimockListAPIKeysDone() bool {
+	if m.ListAPIKeysMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListAPIKeysMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListAPIKeysMock.invocationsDone()
+}
+
+// MinimockListAPIKeysInspect logs each unmet expectation
+func (m *ServiceAccountRepositoryMock) MinimockListAPIKeysInspect() {
+	for _, e := range m.ListAPIKeysMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ServiceAccountRepositoryMock.ListAPIKeys at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListAPIKeysCounter := mm_atomic.LoadUint64(&m.afterListAPIKeysCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListAPIKeysMock.defaultExpectation != nil && afterListAPIKeysCounter < 1 {
+		if m.ListAPIKeysMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ServiceAccountRepositoryMock.ListAPIKeys at\n%s", m.ListAPIKeysMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ServiceAccountRepositoryMock.ListAPIKeys at\n%s with params: %#v", m.ListAPIKeysMock.defaultExpectation.expectationOrigins.origin, *m.ListAPIKeysMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListAPIKeys != nil && afterListAPIKeysCounter < 1 {
+		m.t.Errorf("Expected call to ServiceAccountRepositoryMock.ListAPIKeys at\n%s", m.funcListAPIKeysOrigin)
+	}
+
+	if !m.ListAPIKeysMock.invocationsDone() && afterListAPIKeysCounter > 0 {
+		m.t.Errorf("Expected %d calls to ServiceAccountRepositoryMock.ListAPIKeys at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListAPIKeysMock.expectedInvocations), m.ListAPIKeysMock.expectedInvocationsOrigin, afterListAPIKeysCounter)
+	}
+}
+
+type mServiceAccountRepositoryMockListServiceAccounts struct {
+	optional           bool
+	mock               *ServiceAccountRepositoryMock
+	defaultExpectation *ServiceAccountRepositoryMockListServiceAccountsExpectation
+	expectations       []*ServiceAccountRepositoryMockListServiceAccountsExpectation
+
+	callArgs []*ServiceAccountRepositoryMockListServiceAccountsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ServiceAccountRepositoryMockListServiceAccountsExpectation specifies expectation struct of the ServiceAccountRepository.ListServiceAccounts
+type ServiceAccountRepositoryMockListServiceAccountsExpectation struct {
+	mock               *ServiceAccountRepositoryMock
+	params             *ServiceAccountRepositoryMockListServiceAccountsParams
+	paramPtrs          *ServiceAccountRepositoryMockListServiceAccountsParamPtrs
+	expectationOrigins ServiceAccountRepositoryMockListServiceAccountsExpectationOrigins
+	results            *ServiceAccountRepositoryMockListServiceAccountsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ServiceAccountRepositoryMockListServiceAccountsParams contains parameters of the ServiceAccountRepository.ListServiceAccounts
+type ServiceAccountRepositoryMockListServiceAccountsParams struct {
+	ctx context.Context
+}
+
+// ServiceAccountRepositoryMockListServiceAccountsParamPtrs contains pointers to parameters of the ServiceAccountRepository.ListServiceAccounts
+type ServiceAccountRepositoryMockListServiceAccountsParamPtrs struct {
+	ctx *context.Context
+}
+
+// ServiceAccountRepositoryMockListServiceAccountsResults contains results of the ServiceAccountRepository.ListServiceAccounts
+type ServiceAccountRepositoryMockListServiceAccountsResults struct {
+	sa1 []models.ServiceAccount
+	err error
+}
+
+// ServiceAccountRepositoryMockListServiceAccountsOrigins contains origins of expectations of the ServiceAccountRepository.ListServiceAccounts
+type ServiceAccountRepositoryMockListServiceAccountsExpectationOrigins struct {
+	origin    string
+	originCtx string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListServiceAccounts *mServiceAccountRepositoryMockListServiceAccounts) Optional() *mServiceAccountRepositoryMockListServiceAccounts {
+	mmListServiceAccounts.optional = true
+	return mmListServiceAccounts
+}
+
+// Expect sets up expected params for ServiceAccountRepository.ListServiceAccounts
+func (mmListServiceAccounts *mServiceAccountRepositoryMockListServiceAccounts) Expect(ctx context.Context) *mServiceAccountRepositoryMockListServiceAccounts {
+	if mmListServiceAccounts.mock.funcListServiceAccounts != nil {
+		mmListServiceAccounts.mock.t.Fatalf("ServiceAccountRepositoryMock.ListServiceAccounts mock is already set by Set")
+	}
+
+	if mmListServiceAccounts.defaultExpectation == nil {
+		mmListServiceAccounts.defaultExpectation = &ServiceAccountRepositoryMockListServiceAccountsExpectation{}
+	}
+
+	if mmListServiceAccounts.defaultExpectation.paramPtrs != nil {
+		mmListServiceAccounts.mock.t.Fatalf("ServiceAccountRepositoryMock.ListServiceAccounts mock is already set by ExpectParams functions")
+	}
+
+	mmListServiceAccounts.defaultExpectation.params = &ServiceAccountRepositoryMockListServiceAccountsParams{ctx}
+	mmListServiceAccounts.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListServiceAccounts.expectations {
+		if minimock.Equal(e.params, mmListServiceAccounts.defaultExpectation.params) {
+			mmListServiceAccounts.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListServiceAccounts.defaultExpectation.params)
+		}
+	}
+
+	return mmListServiceAccounts
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ServiceAccountRepository.ListServiceAccounts
+func (mmListServiceAccounts *mServiceAccountRepositoryMockListServiceAccounts) ExpectCtxParam1(ctx context.Context) *mServiceAccountRepositoryMockListServiceAccounts {
+	if mmListServiceAccounts.mock.funcListServiceAccounts != nil {
+		mmListServiceAccounts.mock.t.Fatalf("ServiceAccountRepositoryMock.ListServiceAccounts mock is already set by Set")
+	}
+
+	if mmListServiceAccounts.defaultExpectation == nil {
+		mmListServiceAccounts.defaultExpectation = &ServiceAccountRepositoryMockListServiceAccountsExpectation{}
+	}
+
+	if mmListServiceAccounts.defaultExpectation.params != nil {
+		mmListServiceAccounts.mock.t.Fatalf("ServiceAccountRepositoryMock.ListServiceAccounts mock is already set by Expect")
+	}
+
+	if mmListServiceAccounts.defaultExpectation.paramPtrs == nil {
+		mmListServiceAccounts.defaultExpectation.paramPtrs = &ServiceAccountRepositoryMockListServiceAccountsParamPtrs{}
+	}
+	mmListServiceAccounts.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListServiceAccounts.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListServiceAccounts
+}
+
+// Inspect accepts an inspector function that has same arguments as the ServiceAccountRepository.ListServiceAccounts
+func (mmListServiceAccounts *mServiceAccountRepositoryMockListServiceAccounts) Inspect(f func(ctx context.Context)) *mServiceAccountRepositoryMockListServiceAccounts {
+	if mmListServiceAccounts.mock.inspectFuncListServiceAccounts != nil {
+		mmListServiceAccounts.mock.t.Fatalf("Inspect function is already set for ServiceAccountRepositoryMock.ListServiceAccounts")
+	}
+
+	mmListServiceAccounts.mock.inspectFuncListServiceAccounts = f
+
+	return mmListServiceAccounts
+}
+
+// Return sets up results that will be returned by ServiceAccountRepository.ListServiceAccounts
+func (mmListServiceAccounts *mServiceAccountRepositoryMockListServiceAccounts) Return(sa1 []models.ServiceAccount, err error) *ServiceAccountRepositoryMock {
+	if mmListServiceAccounts.mock.funcListServiceAccounts != nil {
+		mmListServiceAccounts.mock.t.Fatalf("ServiceAccountRepositoryMock.ListServiceAccounts mock is already set by Set")
+	}
+
+	if mmListServiceAccounts.defaultExpectation == nil {
+		mmListServiceAccounts.defaultExpectation = &ServiceAccountRepositoryMockListServiceAccountsExpectation{mock: mmListServiceAccounts.mock}
+	}
+	mmListServiceAccounts.defaultExpectation.results = &ServiceAccountRepositoryMockListServiceAccountsResults{sa1, err}
+	mmListServiceAccounts.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListServiceAccounts.mock
+}
+
+// Set uses given function f to mock the ServiceAccountRepository.ListServiceAccounts method
+func (mmListServiceAccounts *mServiceAccountRepositoryMockListServiceAccounts) Set(f func(ctx context.Context) (sa1 []models.ServiceAccount, err error)) *ServiceAccountRepositoryMock {
+	if mmListServiceAccounts.defaultExpectation != nil {
+		mmListServiceAccounts.mock.t.Fatalf("Default expectation is already set for the ServiceAccountRepository.ListServiceAccounts method")
+	}
+
+	if len(mmListServiceAccounts.expectations) > 0 {
+		mmListServiceAccounts.mock.t.Fatalf("Some expectations are already set for the ServiceAccountRepository.ListServiceAccounts method")
+	}
+
+	mmListServiceAccounts.mock.funcListServiceAccounts = f
+	mmListServiceAccounts.mock.funcListServiceAccountsOrigin = minimock.CallerInfo(1)
+	return mmListServiceAccounts.mock
+}
+
+// When sets expectation for the ServiceAccountRepository.ListServiceAccounts which will trigger the result defined by the following
+// Then helper
+func (mmListServiceAccounts *mServiceAccountRepositoryMockListServiceAccounts) When(ctx context.Context) *ServiceAccountRepositoryMockListServiceAccountsExpectation {
+	if mmListServiceAccounts.mock.funcListServiceAccounts != nil {
+		mmListServiceAccounts.mock.t.Fatalf("ServiceAccountRepositoryMock.ListServiceAccounts mock is already set by Set")
+	}
+
+	expectation := &ServiceAccountRepositoryMockListServiceAccountsExpectation{
+		mock:               mmListServiceAccounts.mock,
+		params:             &ServiceAccountRepositoryMockListServiceAccountsParams{ctx},
+		expectationOrigins: ServiceAccountRepositoryMockListServiceAccountsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListServiceAccounts.expectations = append(mmListServiceAccounts.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ServiceAccountRepository.ListServiceAccounts return parameters for the expectation previously defined by the When method
+func (e *ServiceAccountRepositoryMockListServiceAccountsExpectation) Then(sa1 []models.ServiceAccount, err error) *ServiceAccountRepositoryMock {
+	e.results = &ServiceAccountRepositoryMockListServiceAccountsResults{sa1, err}
+	return e.mock
+}
+
+// Times sets number of times ServiceAccountRepository.ListServiceAccounts should be invoked
+func (mmListServiceAccounts *mServiceAccountRepositoryMockListServiceAccounts) Times(n uint64) *mServiceAccountRepositoryMockListServiceAccounts {
+	if n == 0 {
+		mmListServiceAccounts.mock.t.Fatalf("Times of ServiceAccountRepositoryMock.ListServiceAccounts mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListServiceAccounts.expectedInvocations, n)
+	mmListServiceAccounts.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListServiceAccounts
+}
+
+func (mmListServiceAccounts *mServiceAccountRepositoryMockListServiceAccounts) invocationsDone() bool {
+	if len(mmListServiceAccounts.expectations) == 0 && mmListServiceAccounts.defaultExpectation == nil && mmListServiceAccounts.mock.funcListServiceAccounts == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListServiceAccounts.mock.afterListServiceAccountsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListServiceAccounts.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListServiceAccounts implements ServiceAccountRepository
+func (mmListServiceAccounts *ServiceAccountRepositoryMock) ListServiceAccounts(ctx context.Context) (sa1 []models.ServiceAccount, err error) {
+	mm_atomic.AddUint64(&mmListServiceAccounts.beforeListServiceAccountsCounter, 1)
+	defer mm_atomic.AddUint64(&mmListServiceAccounts.afterListServiceAccountsCounter, 1)
+
+	mmListServiceAccounts.t.Helper()
+
+	if mmListServiceAccounts.inspectFuncListServiceAccounts != nil {
+		mmListServiceAccounts.inspectFuncListServiceAccounts(ctx)
+	}
+
+	mm_params := ServiceAccountRepositoryMockListServiceAccountsParams{ctx}
+
+	// Record call args
+	mmListServiceAccounts.ListServiceAccountsMock.mutex.Lock()
+	mmListServiceAccounts.ListServiceAccountsMock.callArgs = append(mmListServiceAccounts.ListServiceAccountsMock.callArgs, &mm_params)
+	mmListServiceAccounts.ListServiceAccountsMock.mutex.Unlock()
+
+	for _, e := range mmListServiceAccounts.ListServiceAccountsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.sa1, e.results.err
+		}
+	}
+
+	if mmListServiceAccounts.ListServiceAccountsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListServiceAccounts.ListServiceAccountsMock.defaultExpectation.Counter, 1)
+		mm_want := mmListServiceAccounts.ListServiceAccountsMock.defaultExpectation.params
+		mm_want_ptrs := mmListServiceAccounts.ListServiceAccountsMock.defaultExpectation.paramPtrs
+
+		mm_got := ServiceAccountRepositoryMockListServiceAccountsParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListServiceAccounts.t.Errorf("ServiceAccountRepositoryMock.ListServiceAccounts got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListServiceAccounts.ListServiceAccountsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListServiceAccounts.t.Errorf("ServiceAccountRepositoryMock.ListServiceAccounts got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListServiceAccounts.ListServiceAccountsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListServiceAccounts.ListServiceAccountsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListServiceAccounts.t.Fatal("No results are set for the ServiceAccountRepositoryMock.ListServiceAccounts")
+		}
+		return (*mm_results).sa1, (*mm_results).err
+	}
+	if mmListServiceAccounts.funcListServiceAccounts != nil {
+		return mmListServiceAccounts.funcListServiceAccounts(ctx)
+	}
+	mmListServiceAccounts.t.Fatalf("Unexpected call to ServiceAccountRepositoryMock.ListServiceAccounts. %v", ctx)
+	return
+}
+
+// ListServiceAccountsAfterCounter returns a count of finished ServiceAccountRepositoryMock.ListServiceAccounts invocations
+func (mmListServiceAccounts *ServiceAccountRepositoryMock) ListServiceAccountsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListServiceAccounts.afterListServiceAccountsCounter)
+}
+
+// ListServiceAccountsBeforeCounter returns a count of ServiceAccountRepositoryMock.ListServiceAccounts invocations
+func (mmListServiceAccounts *ServiceAccountRepositoryMock) ListServiceAccountsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListServiceAccounts.beforeListServiceAccountsCounter)
+}
+
+// Calls returns a list of arguments used in each call to ServiceAccountRepositoryMock.ListServiceAccounts.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListServiceAccounts *mServiceAccountRepositoryMockListServiceAccounts) Calls() []*ServiceAccountRepositoryMockListServiceAccountsParams {
+	mmListServiceAccounts.mutex.RLock()
+
+	argCopy := make([]*ServiceAccountRepositoryMockListServiceAccountsParams, len(mmListServiceAccounts.callArgs))
+	copy(argCopy, mmListServiceAccounts.callArgs)
+
+	mmListServiceAccounts.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListServiceAccountsDone returns true if the count of the ListServiceAccounts invocations corresponds
+// the number of defined expectations
+func (m *ServiceAccountRepositoryMock) MinimockListServiceAccountsDone() bool {
+	if m.ListServiceAccountsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListServiceAccountsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListServiceAccountsMock.invocationsDone()
+}
+
+// MinimockListServiceAccountsInspect logs each unmet expectation
+func (m *ServiceAccountRepositoryMock) MinimockListServiceAccountsInspect() {
+	for _, e := range m.ListServiceAccountsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ServiceAccountRepositoryMock.ListServiceAccounts at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListServiceAccountsCounter := mm_atomic.LoadUint64(&m.afterListServiceAccountsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListServiceAccountsMock.defaultExpectation != nil && afterListServiceAccountsCounter < 1 {
+		if m.ListServiceAccountsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ServiceAccountRepositoryMock.ListServiceAccounts at\n%s", m.ListServiceAccountsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ServiceAccountRepositoryMock.ListServiceAccounts at\n%s with params: %#v", m.ListServiceAccountsMock.defaultExpectation.expectationOrigins.origin, *m.ListServiceAccountsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListServiceAccounts != nil && afterListServiceAccountsCounter < 1 {
+		m.t.Errorf("Expected call to ServiceAccountRepositoryMock.ListServiceAccounts at\n%s", m.funcListServiceAccountsOrigin)
+	}
+
+	if !m.ListServiceAccountsMock.invocationsDone() && afterListServiceAccountsCounter > 0 {
+		m.t.Errorf("Expected %d calls to ServiceAccountRepositoryMock.ListServiceAccounts at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListServiceAccountsMock.expectedInvocations), m.ListServiceAccountsMock.expectedInvocationsOrigin, afterListServiceAccountsCounter)
+	}
+}
+
+type mServiceAccountRepositoryMockTouchAPIKey struct {
+	optional           bool
+	mock               *ServiceAccountRepositoryMock
+	defaultExpectation *ServiceAccountRepositoryMockTouchAPIKeyExpectation
+	expectations       []*ServiceAccountRepositoryMockTouchAPIKeyExpectation
+
+	callArgs []*ServiceAccountRepositoryMockTouchAPIKeyParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ServiceAccountRepositoryMockTouchAPIKeyExpectation specifies expectation struct of the ServiceAccountRepository.TouchAPIKey
+type ServiceAccountRepositoryMockTouchAPIKeyExpectation struct {
+	mock               *ServiceAccountRepositoryMock
+	params             *ServiceAccountRepositoryMockTouchAPIKeyParams
+	paramPtrs          *ServiceAccountRepositoryMockTouchAPIKeyParamPtrs
+	expectationOrigins ServiceAccountRepositoryMockTouchAPIKeyExpectationOrigins
+	results            *ServiceAccountRepositoryMockTouchAPIKeyResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ServiceAccountRepositoryMockTouchAPIKeyParams contains parameters of the ServiceAccountRepository.TouchAPIKey
+type ServiceAccountRepositoryMockTouchAPIKeyParams struct {
+	ctx    context.Context
+	keyID  string
+	usedAt time.Time
+}
+
+// ServiceAccountRepositoryMockTouchAPIKeyParamPtrs contains pointers to parameters of the ServiceAccountRepository.TouchAPIKey
+type ServiceAccountRepositoryMockTouchAPIKeyParamPtrs struct {
+	ctx    *context.Context
+	keyID  *string
+	usedAt *time.Time
+}
+
+// ServiceAccountRepositoryMockTouchAPIKeyResults contains results of the ServiceAccountRepository.TouchAPIKey
+type ServiceAccountRepositoryMockTouchAPIKeyResults struct {
+	err error
+}
+
+// ServiceAccountRepositoryMockTouchAPIKeyOrigins contains origins of expectations of the ServiceAccountRepository.TouchAPIKey
+type ServiceAccountRepositoryMockTouchAPIKeyExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originKeyID  string
+	originUsedAt string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmTouchAPIKey *mServiceAccountRepositoryMockTouchAPIKey) Optional() *mServiceAccountRepositoryMockTouchAPIKey {
+	mmTouchAPIKey.optional = true
+	return mmTouchAPIKey
+}
+
+// Expect sets up expected params for ServiceAccountRepository.TouchAPIKey
+func (mmTouchAPIKey *mServiceAccountRepositoryMockTouchAPIKey) Expect(ctx context.Context, keyID string, usedAt time.Time) *mServiceAccountRepositoryMockTouchAPIKey {
+	if mmTouchAPIKey.mock.funcTouchAPIKey != nil {
+		mmTouchAPIKey.mock.t.Fatalf("ServiceAccountRepositoryMock.TouchAPIKey mock is already set by Set")
+	}
+
+	if mmTouchAPIKey.defaultExpectation == nil {
+		mmTouchAPIKey.defaultExpectation = &ServiceAccountRepositoryMockTouchAPIKeyExpectation{}
+	}
+
+	if mmTouchAPIKey.defaultExpectation.paramPtrs != nil {
+		mmTouchAPIKey.mock.t.Fatalf("ServiceAccountRepositoryMock.TouchAPIKey mock is already set by ExpectParams functions")
+	}
+
+	mmTouchAPIKey.defaultExpectation.params = &ServiceAccountRepositoryMockTouchAPIKeyParams{ctx, keyID, usedAt}
+	mmTouchAPIKey.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmTouchAPIKey.expectations {
+		if minimock.Equal(e.params, mmTouchAPIKey.defaultExpectation.params) {
+			mmTouchAPIKey.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmTouchAPIKey.defaultExpectation.params)
+		}
+	}
+
+	return mmTouchAPIKey
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ServiceAccountRepository.TouchAPIKey
+func (mmTouchAPIKey *mServiceAccountRepositoryMockTouchAPIKey) ExpectCtxParam1(ctx context.Context) *mServiceAccountRepositoryMockTouchAPIKey {
+	if mmTouchAPIKey.mock.funcTouchAPIKey != nil {
+		mmTouchAPIKey.mock.t.Fatalf("ServiceAccountRepositoryMock.TouchAPIKey mock is already set by Set")
+	}
+
+	if mmTouchAPIKey.defaultExpectation == nil {
+		mmTouchAPIKey.defaultExpectation = &ServiceAccountRepositoryMockTouchAPIKeyExpectation{}
+	}
+
+	if mmTouchAPIKey.defaultExpectation.params != nil {
+		mmTouchAPIKey.mock.t.Fatalf("ServiceAccountRepositoryMock.TouchAPIKey mock is already set by Expect")
+	}
+
+	if mmTouchAPIKey.defaultExpectation.paramPtrs == nil {
+		mmTouchAPIKey.defaultExpectation.paramPtrs = &ServiceAccountRepositoryMockTouchAPIKeyParamPtrs{}
+	}
+	mmTouchAPIKey.defaultExpectation.paramPtrs.ctx = &ctx
+	mmTouchAPIKey.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmTouchAPIKey
+}
+
+// ExpectKeyIDParam2 sets up expected param keyID for ServiceAccountRepository.TouchAPIKey
+func (mmTouchAPIKey *mServiceAccountRepositoryMockTouchAPIKey) ExpectKeyIDParam2(keyID string) *mServiceAccountRepositoryMockTouchAPIKey {
+	if mmTouchAPIKey.mock.funcTouchAPIKey != nil {
+		mmTouchAPIKey.mock.t.Fatalf("ServiceAccountRepositoryMock.TouchAPIKey mock is already set by Set")
+	}
+
+	if mmTouchAPIKey.defaultExpectation == nil {
+		mmTouchAPIKey.defaultExpectation = &ServiceAccountRepositoryMockTouchAPIKeyExpectation{}
+	}
+
+	if mmTouchAPIKey.defaultExpectation.params != nil {
+		mmTouchAPIKey.mock.t.Fatalf("ServiceAccountRepositoryMock.TouchAPIKey mock is already set by Expect")
+	}
+
+	if mmTouchAPIKey.defaultExpectation.paramPtrs == nil {
+		mmTouchAPIKey.defaultExpectation.paramPtrs = &ServiceAccountRepositoryMockTouchAPIKeyParamPtrs{}
+	}
+	mmTouchAPIKey.defaultExpectation.paramPtrs.keyID = &keyID
+	mmTouchAPIKey.defaultExpectation.expectationOrigins.originKeyID = minimock.CallerInfo(1)
+
+	return mmTouchAPIKey
+}
+
+// ExpectUsedAtParam3 sets up expected param usedAt for ServiceAccountRepository.TouchAPIKey
+func (mmTouchAPIKey *mServiceAccountRepositoryMockTouchAPIKey) ExpectUsedAtParam3(usedAt time.Time) *mServiceAccountRepositoryMockTouchAPIKey {
+	if mmTouchAPIKey.mock.funcTouchAPIKey != nil {
+		mmTouchAPIKey.mock.t.Fatalf("ServiceAccountRepositoryMock.TouchAPIKey mock is already set by Set")
+	}
+
+	if mmTouchAPIKey.defaultExpectation == nil {
+		mmTouchAPIKey.defaultExpectation = &ServiceAccountRepositoryMockTouchAPIKeyExpectation{}
+	}
+
+	if mmTouchAPIKey.defaultExpectation.params != nil {
+		mmTouchAPIKey.mock.t.Fatalf("ServiceAccountRepositoryMock.TouchAPIKey mock is already set by Expect")
+	}
+
+	if mmTouchAPIKey.defaultExpectation.paramPtrs == nil {
+		mmTouchAPIKey.defaultExpectation.paramPtrs = &ServiceAccountRepositoryMockTouchAPIKeyParamPtrs{}
+	}
+	mmTouchAPIKey.defaultExpectation.paramPtrs.usedAt = &usedAt
+	mmTouchAPIKey.defaultExpectation.expectationOrigins.originUsedAt = minimock.CallerInfo(1)
+
+	return mmTouchAPIKey
+}
+
+// Inspect accepts an inspector function that has same arguments as the ServiceAccountRepository.TouchAPIKey
+func (mmTouchAPIKey *mServiceAccountRepositoryMockTouchAPIKey) Inspect(f func(ctx context.Context, keyID string, usedAt time.Time)) *mServiceAccountRepositoryMockTouchAPIKey {
+	if mmTouchAPIKey.mock.inspectFuncTouchAPIKey != nil {
+		mmTouchAPIKey.mock.t.Fatalf("Inspect function is already set for ServiceAccountRepositoryMock.TouchAPIKey")
+	}
+
+	mmTouchAPIKey.mock.inspectFuncTouchAPIKey = f
+
+	return mmTouchAPIKey
+}
+
+// Return sets up results that will be returned by ServiceAccountRepository.TouchAPIKey
+func (mmTouchAPIKey *mServiceAccountRepositoryMockTouchAPIKey) Return(err error) *ServiceAccountRepositoryMock {
+	if mmTouchAPIKey.mock.funcTouchAPIKey != nil {
+		mmTouchAPIKey.mock.t.Fatalf("ServiceAccountRepositoryMock.TouchAPIKey mock is already set by Set")
+	}
+
+	if mmTouchAPIKey.defaultExpectation == nil {
+		mmTouchAPIKey.defaultExpectation = &ServiceAccountRepositoryMockTouchAPIKeyExpectation{mock: mmTouchAPIKey.mock}
+	}
+	mmTouchAPIKey.defaultExpectation.results = &ServiceAccountRepositoryMockTouchAPIKeyResults{err}
+	mmTouchAPIKey.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmTouchAPIKey.mock
+}
+
+// Set uses given function f to mock the ServiceAccountRepository.TouchAPIKey method
+func (mmTouchAPIKey *mServiceAccountRepositoryMockTouchAPIKey) Set(f func(ctx context.Context, keyID string, usedAt time.Time) (err error)) *ServiceAccountRepositoryMock {
+	if mmTouchAPIKey.defaultExpectation != nil {
+		mmTouchAPIKey.mock.t.Fatalf("Default expectation is already set for the ServiceAccountRepository.TouchAPIKey method")
+	}
+
+	if len(mmTouchAPIKey.expectations) > 0 {
+		mmTouchAPIKey.mock.t.Fatalf("Some expectations are already set for the ServiceAccountRepository.TouchAPIKey method")
+	}
+
+	mmTouchAPIKey.mock.funcTouchAPIKey = f
+	mmTouchAPIKey.mock.funcTouchAPIKeyOrigin = minimock.CallerInfo(1)
+	return mmTouchAPIKey.mock
+}
+
+// When sets expectation for the ServiceAccountRepository.TouchAPIKey which will trigger the result defined by the following
+// Then helper
+func (mmTouchAPIKey *mServiceAccountRepositoryMockTouchAPIKey) When(ctx context.Context, keyID string, usedAt time.Time) *ServiceAccountRepositoryMockTouchAPIKeyExpectation {
+	if mmTouchAPIKey.mock.funcTouchAPIKey != nil {
+		mmTouchAPIKey.mock.t.Fatalf("ServiceAccountRepositoryMock.TouchAPIKey mock is already set by Set")
+	}
+
+	expectation := &ServiceAccountRepositoryMockTouchAPIKeyExpectation{
+		mock:               mmTouchAPIKey.mock,
+		params:             &ServiceAccountRepositoryMockTouchAPIKeyParams{ctx, keyID, usedAt},
+		expectationOrigins: ServiceAccountRepositoryMockTouchAPIKeyExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmTouchAPIKey.expectations = append(mmTouchAPIKey.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ServiceAccountRepository.TouchAPIKey return parameters for the expectation previously defined by the When method
+func (e *ServiceAccountRepositoryMockTouchAPIKeyExpectation) Then(err error) *ServiceAccountRepositoryMock {
+	e.results = &ServiceAccountRepositoryMockTouchAPIKeyResults{err}
+	return e.mock
+}
+
+// Times sets number of times ServiceAccountRepository.TouchAPIKey should be invoked
+func (mmTouchAPIKey *mServiceAccountRepositoryMockTouchAPIKey) Times(n uint64) *mServiceAccountRepositoryMockTouchAPIKey {
+	if n == 0 {
+		mmTouchAPIKey.mock.t.Fatalf("Times of ServiceAccountRepositoryMock.TouchAPIKey mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmTouchAPIKey.expectedInvocations, n)
+	mmTouchAPIKey.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmTouchAPIKey
+}
+
+func (mmTouchAPIKey *mServiceAccountRepositoryMockTouchAPIKey) invocationsDone() bool {
+	if len(mmTouchAPIKey.expectations) == 0 && mmTouchAPIKey.defaultExpectation == nil && mmTouchAPIKey.mock.funcTouchAPIKey == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmTouchAPIKey.mock.afterTouchAPIKeyCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmTouchAPIKey.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// TouchAPIKey implements ServiceAccountRepository
+func (mmTouchAPIKey *ServiceAccountRepositoryMock) TouchAPIKey(ctx context.Context, keyID string, usedAt time.Time) (err error) {
+	mm_atomic.AddUint64(&mmTouchAPIKey.beforeTouchAPIKeyCounter, 1)
+	defer mm_atomic.AddUint64(&mmTouchAPIKey.afterTouchAPIKeyCounter, 1)
+
+	mmTouchAPIKey.t.Helper()
+
+	if mmTouchAPIKey.inspectFuncTouchAPIKey != nil {
+		mmTouchAPIKey.inspectFuncTouchAPIKey(ctx, keyID, usedAt)
+	}
+
+	mm_params := ServiceAccountRepositoryMockTouchAPIKeyParams{ctx, keyID, usedAt}
+
+	// Record call args
+	mmTouchAPIKey.TouchAPIKeyMock.mutex.Lock()
+	mmTouchAPIKey.TouchAPIKeyMock.callArgs = append(mmTouchAPIKey.TouchAPIKeyMock.callArgs, &mm_params)
+	mmTouchAPIKey.TouchAPIKeyMock.mutex.Unlock()
+
+	for _, e := range mmTouchAPIKey.TouchAPIKeyMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmTouchAPIKey.TouchAPIKeyMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmTouchAPIKey.TouchAPIKeyMock.defaultExpectation.Counter, 1)
+		mm_want := mmTouchAPIKey.TouchAPIKeyMock.defaultExpectation.params
+		mm_want_ptrs := mmTouchAPIKey.TouchAPIKeyMock.defaultExpectation.paramPtrs
+
+		mm_got := ServiceAccountRepositoryMockTouchAPIKeyParams{ctx, keyID, usedAt}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmTouchAPIKey.t.Errorf("ServiceAccountRepositoryMock.TouchAPIKey got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmTouchAPIKey.TouchAPIKeyMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.keyID != nil && !minimock.Equal(*mm_want_ptrs.keyID, mm_got.keyID) {
+				mmTouchAPIKey.t.Errorf("ServiceAccountRepositoryMock.TouchAPIKey got unexpected parameter keyID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmTouchAPIKey.TouchAPIKeyMock.defaultExpectation.expectationOrigins.originKeyID, *mm_want_ptrs.keyID, mm_got.keyID, minimock.Diff(*mm_want_ptrs.keyID, mm_got.keyID))
+			}
+
+			if mm_want_ptrs.usedAt != nil && !minimock.Equal(*mm_want_ptrs.usedAt, mm_got.usedAt) {
+				mmTouchAPIKey.t.Errorf("ServiceAccountRepositoryMock.TouchAPIKey got unexpected parameter usedAt, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmTouchAPIKey.TouchAPIKeyMock.defaultExpectation.expectationOrigins.originUsedAt, *mm_want_ptrs.usedAt, mm_got.usedAt, minimock.Diff(*mm_want_ptrs.usedAt, mm_got.usedAt))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmTouchAPIKey.t.Errorf("ServiceAccountRepositoryMock.TouchAPIKey got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmTouchAPIKey.TouchAPIKeyMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmTouchAPIKey.TouchAPIKeyMock.defaultExpectation.results
+		if mm_results == nil {
+			mmTouchAPIKey.t.Fatal("No results are set for the ServiceAccountRepositoryMock.TouchAPIKey")
+		}
+		return (*mm_results).err
+	}
+	if mmTouchAPIKey.funcTouchAPIKey != nil {
+		return mmTouchAPIKey.funcTouchAPIKey(ctx, keyID, usedAt)
+	}
+	mmTouchAPIKey.t.Fatalf("Unexpected call to ServiceAccountRepositoryMock.TouchAPIKey. %v %v %v", ctx, keyID, usedAt)
+	return
+}
+
+// TouchAPIKeyAfterCounter returns a count of finished ServiceAccountRepositoryMock.TouchAPIKey invocations
+func (mmTouchAPIKey *ServiceAccountRepositoryMock) TouchAPIKeyAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmTouchAPIKey.afterTouchAPIKeyCounter)
+}
+
+// TouchAPIKeyBeforeCounter returns a count of ServiceAccountRepositoryMock.TouchAPIKey invocations
+func (mmTouchAPIKey *ServiceAccountRepositoryMock) TouchAPIKeyBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmTouchAPIKey.beforeTouchAPIKeyCounter)
+}
+
+// Calls returns a list of arguments used in each call to ServiceAccountRepositoryMock.TouchAPIKey.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmTouchAPIKey *mServiceAccountRepositoryMockTouchAPIKey) Calls() []*ServiceAccountRepositoryMockTouchAPIKeyParams {
+	mmTouchAPIKey.mutex.RLock()
+
+	argCopy := make([]*ServiceAccountRepositoryMockTouchAPIKeyParams, len(mmTouchAPIKey.callArgs))
+	copy(argCopy, mmTouchAPIKey.callArgs)
+
+	mmTouchAPIKey.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockTouchAPIKeyDone returns true if the count of the TouchAPIKey invocations corresponds
+// the number of defined expectations
+func (m *ServiceAccountRepositoryMock) MinimockTouchAPIKeyDone() bool {
+	if m.TouchAPIKeyMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.TouchAPIKeyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.TouchAPIKeyMock.invocationsDone()
+}
+
+// MinimockTouchAPIKeyInspect logs each unmet expectation
+func (m *ServiceAccountRepositoryMock) MinimockTouchAPIKeyInspect() {
+	for _, e := range m.TouchAPIKeyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ServiceAccountRepositoryMock.TouchAPIKey at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterTouchAPIKeyCounter := mm_atomic.LoadUint64(&m.afterTouchAPIKeyCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.TouchAPIKeyMock.defaultExpectation != nil && afterTouchAPIKeyCounter < 1 {
+		if m.TouchAPIKeyMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ServiceAccountRepositoryMock.TouchAPIKey at\n%s", m.TouchAPIKeyMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ServiceAccountRepositoryMock.TouchAPIKey at\n%s with params: %#v", m.TouchAPIKeyMock.defaultExpectation.expectationOrigins.origin, *m.TouchAPIKeyMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcTouchAPIKey != nil && afterTouchAPIKeyCounter < 1 {
+		m.t.Errorf("Expected call to ServiceAccountRepositoryMock.TouchAPIKey at\n%s", m.funcTouchAPIKeyOrigin)
+	}
+
+	if !m.TouchAPIKeyMock.invocationsDone() && afterTouchAPIKeyCounter > 0 {
+		m.t.Errorf("Expected %d calls to ServiceAccountRepositoryMock.TouchAPIKey at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.TouchAPIKeyMock.expectedInvocations), m.TouchAPIKeyMock.expectedInvocationsOrigin, afterTouchAPIKeyCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *ServiceAccountRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCreateAPIKeyInspect()
+
+			m.MinimockCreateServiceAccountInspect()
+
+			m.MinimockDeleteAPIKeyInspect()
+
+			m.MinimockDeleteServiceAccountInspect()
+
+			m.MinimockFindAPIKeyByIDInspect()
+
+			m.MinimockFindServiceAccountByIDInspect()
+
+			m.MinimockListAPIKeysInspect()
+
+			m.MinimockListServiceAccountsInspect()
+
+			m.MinimockTouchAPIKeyInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *ServiceAccountRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *ServiceAccountRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCreateAPIKeyDone() &&
+		m.MinimockCreateServiceAccountDone() &&
+		m.MinimockDeleteAPIKeyDone() &&
+		m.MinimockDeleteServiceAccountDone() &&
+		m.MinimockFindAPIKeyByIDDone() &&
+		m.MinimockFindServiceAccountByIDDone() &&
+		m.MinimockListAPIKeysDone() &&
+		m.MinimockListServiceAccountsDone() &&
+		m.MinimockTouchAPIKeyDone()
+}
@@ -0,0 +1,148 @@
+package service_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alonsoF100/authorization-service/internal/apperrors"
+	"github.com/alonsoF100/authorization-service/internal/models"
+	"github.com/alonsoF100/authorization-service/internal/service"
+	"github.com/gojuno/minimock/v3"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateServiceAccountDuplicate(t *testing.T) {
+	mc := minimock.NewController(t)
+	mockRepo := service.NewServiceAccountRepositoryMock(mc)
+
+	mockRepo.CreateServiceAccountMock.Return(apperrors.ErrServiceAccountExist)
+
+	_, err := service.NewServiceAccountService(mockRepo, nil).CreateServiceAccount(context.Background(), uuid.New().String(), "deployer", nil)
+	require.ErrorIs(t, err, apperrors.ErrServiceAccountExist)
+}
+
+func TestCreateAPIKey(t *testing.T) {
+	ctx := context.Background()
+	account := &models.ServiceAccount{ID: uuid.New().String(), Name: "deployer"}
+
+	t.Run("success", func(t *testing.T) {
+		mc := minimock.NewController(t)
+		mockRepo := service.NewServiceAccountRepositoryMock(mc)
+
+		var stored *models.APIKey
+		mockRepo.FindServiceAccountByIDMock.Expect(ctx, account.ID).Return(account, nil)
+		mockRepo.CreateAPIKeyMock.Set(func(_ context.Context, key *models.APIKey) error {
+			stored = key
+			return nil
+		})
+
+		key, plaintext, err := service.NewServiceAccountService(mockRepo, nil).CreateAPIKey(ctx, account.ID)
+		require.NoError(t, err)
+		require.Same(t, stored, key)
+		require.Equal(t, account.ID, key.ServiceAccountID)
+		require.True(t, strings.HasPrefix(plaintext, models.APIKeyPrefix+key.ID+"."))
+
+		sum := sha256.Sum256([]byte(plaintext))
+		require.Equal(t, hex.EncodeToString(sum[:]), key.KeyHash)
+	})
+
+	t.Run("missing account", func(t *testing.T) {
+		mc := minimock.NewController(t)
+		mockRepo := service.NewServiceAccountRepositoryMock(mc)
+
+		mockRepo.FindServiceAccountByIDMock.Expect(ctx, account.ID).Return(nil, nil)
+
+		_, _, err := service.NewServiceAccountService(mockRepo, nil).CreateAPIKey(ctx, account.ID)
+		require.ErrorIs(t, err, apperrors.ErrServiceAccountNotFound)
+	})
+}
+
+func TestAuthenticateAPIKey(t *testing.T) {
+	ctx := context.Background()
+
+	account := &models.ServiceAccount{
+		ID:    uuid.New().String(),
+		Name:  "deployer",
+		Roles: []string{"deploy"},
+	}
+	keyID := uuid.New().String()
+	plaintext := models.APIKeyPrefix + keyID + ".secret"
+	sum := sha256.Sum256([]byte(plaintext))
+	key := &models.APIKey{
+		ID:               keyID,
+		ServiceAccountID: account.ID,
+		KeyHash:          hex.EncodeToString(sum[:]),
+		CreatedAt:        time.Now().Add(-time.Hour),
+	}
+
+	tests := []struct {
+		name        string
+		key         string
+		setupMocks  func(mockRepo *service.ServiceAccountRepositoryMock)
+		expectedErr error
+	}{
+		{
+			name: "valid key",
+			key:  plaintext,
+			setupMocks: func(mockRepo *service.ServiceAccountRepositoryMock) {
+				mockRepo.FindAPIKeyByIDMock.Expect(ctx, keyID).Return(key, nil)
+				mockRepo.FindServiceAccountByIDMock.Expect(ctx, account.ID).Return(account, nil)
+				mockRepo.TouchAPIKeyMock.Return(nil)
+			},
+		},
+		{
+			name: "wrong secret",
+			key:  models.APIKeyPrefix + keyID + ".guess",
+			setupMocks: func(mockRepo *service.ServiceAccountRepositoryMock) {
+				mockRepo.FindAPIKeyByIDMock.Expect(ctx, keyID).Return(key, nil)
+			},
+			expectedErr: apperrors.ErrInvalidToken,
+		},
+		{
+			name: "unknown key",
+			key:  plaintext,
+			setupMocks: func(mockRepo *service.ServiceAccountRepositoryMock) {
+				mockRepo.FindAPIKeyByIDMock.Expect(ctx, keyID).Return(nil, nil)
+			},
+			expectedErr: apperrors.ErrInvalidToken,
+		},
+		{
+			name:        "malformed key id",
+			key:         models.APIKeyPrefix + "abc.secret",
+			setupMocks:  func(mockRepo *service.ServiceAccountRepositoryMock) {},
+			expectedErr: apperrors.ErrInvalidToken,
+		},
+		{
+			name:        "missing prefix",
+			key:         keyID + ".secret",
+			setupMocks:  func(mockRepo *service.ServiceAccountRepositoryMock) {},
+			expectedErr: apperrors.ErrInvalidToken,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := minimock.NewController(t)
+			mockRepo := service.NewServiceAccountRepositoryMock(mc)
+			tt.setupMocks(mockRepo)
+
+			claims, err := service.NewServiceAccountService(mockRepo, nil).AuthenticateAPIKey(ctx, tt.key)
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+				require.Nil(t, claims)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, account.ID, claims.ID)
+			require.Equal(t, account.Name, claims.Nickname)
+			require.Equal(t, account.Roles, claims.Roles)
+			require.Equal(t, models.PrincipalServiceAccount, claims.Principal())
+		})
+	}
+}
@@ -0,0 +1,190 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/alonsoF100/authorization-service/internal/apperrors"
+	"github.com/alonsoF100/authorization-service/internal/models"
+	"github.com/google/uuid"
+)
+
+// createSession records a sign in, the session lives as long as the token
+// issued with it
+func (s AuthService) createSession(ctx context.Context, user *models.User, userAgent, ip string) (*models.Session, error) {
+	now := time.Now()
+	session := &models.Session{
+		ID:         uuid.New().String(),
+		UserID:     user.ID,
+		DeviceName: DeviceName(userAgent),
+		UserAgent:  userAgent,
+		IP:         ip,
+		CreatedAt:  now,
+		LastSeenAt: now,
+		ExpiresAt:  now.Add(s.cfg.JWT.Expiry),
+	}
+
+	if err := s.authRepository.CreateSession(ctx, session); err != nil {
+		return nil, err
+	}
+
+	return session, nil
+}
+
+// checkSession rejects tokens of revoked or expired sessions and records
+// when the session was last seen
+func (s AuthService) checkSession(ctx context.Context, sessionID string) error {
+	const op = "service/session.go/checkSession"
+
+	session, err := s.authRepository.FindSessionByID(ctx, sessionID)
+	if err != nil {
+		slog.Error("Database error during session check",
+			slog.String("op", op),
+			slog.String("session_id", sessionID),
+			slog.String("error", err.Error()),
+		)
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	now := time.Now()
+	if session == nil || !session.ExpiresAt.After(now) {
+		slog.Debug("Token of a revoked session",
+			slog.String("op", op),
+			slog.String("session_id", sessionID),
+		)
+		return apperrors.ErrInvalidToken
+	}
+
+	if now.Sub(session.LastSeenAt) >= lastUsedTouchInterval {
+		// a failed write only loses usage data, the request can go on
+		if err := s.authRepository.TouchSession(ctx, sessionID, now); err != nil {
+			slog.Warn("Failed to record session activity",
+				slog.String("op", op),
+				slog.String("session_id", sessionID),
+				slog.String("error", err.Error()),
+			)
+		}
+	}
+
+	return nil
+}
+
+// ListSessions returns the active sessions of a user, newest first
+func (s UserService) ListSessions(ctx context.Context, userID string) ([]models.Session, error) {
+	const op = "service/session.go/ListSessions"
+
+	sessions, err := s.userRepository.ListSessions(ctx, userID, time.Now())
+	if err != nil {
+		slog.Error("Database error during session listing",
+			slog.String("op", op),
+			slog.String("user_id", userID),
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return sessions, nil
+}
+
+// DeleteSession signs the device out, every token of the session stops
+// working
+func (s UserService) DeleteSession(ctx context.Context, userID, sessionID string) error {
+	const op = "service/session.go/DeleteSession"
+
+	if uuid.Validate(sessionID) != nil {
+		return apperrors.ErrSessionNotFound
+	}
+
+	err := s.userRepository.DeleteSession(ctx, userID, sessionID)
+	if err != nil {
+		if errors.Is(err, apperrors.ErrSessionNotFound) {
+			return apperrors.ErrSessionNotFound
+		}
+
+		slog.Error("Database error during session deletion",
+			slog.String("op", op),
+			slog.String("user_id", userID),
+			slog.String("error", err.Error()),
+		)
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	slog.Info("Session revoked",
+		slog.String("op", op),
+		slog.String("user_id", userID),
+		slog.String("session_id", sessionID),
+	)
+	record(ctx, s.auditor, models.AuditEvent{
+		Type:     models.AuditSessionRevoked,
+		TargetID: userID,
+		Details:  map[string]string{"session_id": sessionID},
+	})
+
+	return nil
+}
+
+var (
+	// browsers are checked in order, most user agents name several of them
+	userAgentBrowsers = []struct{ token, name string }{
+		{"Edg/", "Edge"},
+		{"EdgA/", "Edge"},
+		{"OPR/", "Opera"},
+		{"YaBrowser/", "Yandex Browser"},
+		{"Firefox/", "Firefox"},
+		{"FxiOS/", "Firefox"},
+		{"CriOS/", "Chrome"},
+		{"Chrome/", "Chrome"},
+		{"Safari/", "Safari"},
+	}
+	userAgentSystems = []struct{ token, name string }{
+		{"iPhone", "iOS"},
+		{"iPad", "iPadOS"},
+		{"Android", "Android"},
+		{"Windows", "Windows"},
+		{"CrOS", "ChromeOS"},
+		{"Mac OS X", "macOS"},
+		{"Linux", "Linux"},
+	}
+)
+
+// DeviceName makes a User-Agent readable, like "Firefox on Linux". Clients
+// that are not browsers are named by their product, like "curl".
+func DeviceName(userAgent string) string {
+	var browser, system string
+	for _, b := range userAgentBrowsers {
+		if strings.Contains(userAgent, b.token) {
+			browser = b.name
+			break
+		}
+	}
+	for _, sys := range userAgentSystems {
+		if strings.Contains(userAgent, sys.token) {
+			system = sys.name
+			break
+		}
+	}
+
+	switch {
+	case browser != "" && system != "":
+		return browser + " on " + system
+	case browser != "":
+		return browser
+	case system != "":
+		return system
+	}
+
+	product, _, _ := strings.Cut(userAgent, "/")
+	product = strings.TrimSpace(product)
+	if product == "" || product == "Mozilla" || strings.ContainsAny(product, " ;()") {
+		return "Unknown device"
+	}
+	if len(product) > 50 {
+		product = product[:50]
+	}
+
+	return product
+}
@@ -0,0 +1,179 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/alonsoF100/authorization-service/internal/apperrors"
+	"github.com/alonsoF100/authorization-service/internal/config"
+	"github.com/alonsoF100/authorization-service/internal/models"
+	"github.com/alonsoF100/authorization-service/internal/service"
+	"github.com/gojuno/minimock/v3"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+const userAgent = "Mozilla/5.0 (X11; Linux x86_64; rv:128.0) Gecko/20100101 Firefox/128.0"
+
+func TestDeviceName(t *testing.T) {
+	tests := []struct {
+		userAgent string
+		expected  string
+	}{
+		{userAgent, "Firefox on Linux"},
+		{"Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/131.0.0.0 Safari/537.36", "Chrome on macOS"},
+		{"Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/131.0.0.0 Safari/537.36 Edg/131.0.0.0", "Edge on Windows"},
+		{"Mozilla/5.0 (iPhone; CPU iPhone OS 17_5 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.5 Mobile/15E148 Safari/604.1", "Safari on iOS"},
+		{"Mozilla/5.0 (Linux; Android 14; Pixel 8) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/131.0.0.0 Mobile Safari/537.36", "Chrome on Android"},
+		{"curl/8.5.0", "curl"},
+		{"grpc-go/1.70.0", "grpc-go"},
+		{"Mozilla/5.0 (compatible)", "Unknown device"},
+		{"", "Unknown device"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expected, func(t *testing.T) {
+			require.Equal(t, tt.expected, service.DeviceName(tt.userAgent))
+		})
+	}
+}
+
+func TestValidateJWTSession(t *testing.T) {
+	ctx := context.Background()
+	cfg := &config.Config{
+		JWT: config.JWTConfig{
+			SecretKey: "someSecret",
+			Expiry:    time.Hour,
+		},
+	}
+	sessionID := uuid.New().String()
+	someErr := errors.New("database error")
+
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, models.Claims{
+		ID:        "user123",
+		SessionID: sessionID,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		},
+	}).SignedString([]byte(cfg.JWT.SecretKey))
+	require.NoError(t, err)
+
+	session := func(lastSeenAt, expiresAt time.Time) *models.Session {
+		return &models.Session{ID: sessionID, UserID: "user123", LastSeenAt: lastSeenAt, ExpiresAt: expiresAt}
+	}
+
+	tests := []struct {
+		name        string
+		setupMocks  func(mockRepo *service.AuthRepositoryMock)
+		expectedErr error
+	}{
+		{
+			name: "active session",
+			setupMocks: func(mockRepo *service.AuthRepositoryMock) {
+				mockRepo.FindSessionByIDMock.Expect(ctx, sessionID).Return(session(time.Now(), time.Now().Add(time.Hour)), nil)
+			},
+		},
+		{
+			name: "last seen is recorded",
+			setupMocks: func(mockRepo *service.AuthRepositoryMock) {
+				mockRepo.FindSessionByIDMock.Expect(ctx, sessionID).Return(session(time.Now().Add(-time.Hour), time.Now().Add(time.Hour)), nil)
+				mockRepo.TouchSessionMock.Set(func(_ context.Context, id string, seenAt time.Time) error {
+					require.Equal(t, sessionID, id)
+					require.WithinDuration(t, time.Now(), seenAt, time.Second)
+					return someErr
+				})
+			},
+		},
+		{
+			name: "revoked session",
+			setupMocks: func(mockRepo *service.AuthRepositoryMock) {
+				mockRepo.FindSessionByIDMock.Expect(ctx, sessionID).Return(nil, nil)
+			},
+			expectedErr: apperrors.ErrInvalidToken,
+		},
+		{
+			name: "expired session",
+			setupMocks: func(mockRepo *service.AuthRepositoryMock) {
+				mockRepo.FindSessionByIDMock.Expect(ctx, sessionID).Return(session(time.Now(), time.Now().Add(-time.Second)), nil)
+			},
+			expectedErr: apperrors.ErrInvalidToken,
+		},
+		{
+			name: "database error",
+			setupMocks: func(mockRepo *service.AuthRepositoryMock) {
+				mockRepo.FindSessionByIDMock.Expect(ctx, sessionID).Return(nil, someErr)
+			},
+			expectedErr: someErr,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := minimock.NewController(t)
+			mockRepo := service.NewAuthRepositoryMock(mc)
+			tt.setupMocks(mockRepo)
+
+			claims, err := service.NewAuthService(mockRepo, nil, nil, cfg).ValidateJWT(ctx, token)
+			if tt.expectedErr == nil {
+				require.NoError(t, err)
+				require.Equal(t, sessionID, claims.SessionID)
+				return
+			}
+			require.ErrorIs(t, err, tt.expectedErr)
+			require.Nil(t, claims)
+		})
+	}
+}
+
+func TestDeleteSession(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New().String()
+	sessionID := uuid.New().String()
+
+	tests := []struct {
+		name        string
+		sessionID   string
+		setupMocks  func(mockRepo *service.UserRepositoryMock)
+		expectedErr error
+	}{
+		{
+			name:      "deleted",
+			sessionID: sessionID,
+			setupMocks: func(mockRepo *service.UserRepositoryMock) {
+				mockRepo.DeleteSessionMock.Expect(ctx, userID, sessionID).Return(nil)
+			},
+		},
+		{
+			name:      "not found",
+			sessionID: sessionID,
+			setupMocks: func(mockRepo *service.UserRepositoryMock) {
+				mockRepo.DeleteSessionMock.Expect(ctx, userID, sessionID).Return(apperrors.ErrSessionNotFound)
+			},
+			expectedErr: apperrors.ErrSessionNotFound,
+		},
+		{
+			name:        "malformed id",
+			sessionID:   "not-a-uuid",
+			setupMocks:  func(mockRepo *service.UserRepositoryMock) {},
+			expectedErr: apperrors.ErrSessionNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := minimock.NewController(t)
+			mockRepo := service.NewUserRepositoryMock(mc)
+			tt.setupMocks(mockRepo)
+
+			err := service.NewUserService(mockRepo, nil).DeleteSession(ctx, userID, tt.sessionID)
+			if tt.expectedErr == nil {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, tt.expectedErr)
+		})
+	}
+}
@@ -0,0 +1,193 @@
+package service
+
+import (
+	"container/list"
+	"context"
+	"crypto/sha256"
+	"sync"
+	"time"
+
+	"github.com/alonsoF100/authorization-service/internal/models"
+)
+
+type TokenValidator interface {
+	ValidateJWT(ctx context.Context, tokenString string) (*models.Claims, error)
+	TokenOwner(ctx context.Context, userID string) (*models.User, error)
+}
+
+// TokenCache remembers successful token validations for a short time so
+// hot paths like forward auth skip the signature check. The owners of user
+// tokens are kept next to them for the allowed ips check. Failures are not
+// cached. Entries never outlive the token itself.
+type TokenCache struct {
+	validator TokenValidator
+	ttl       time.Duration
+	size      int
+
+	mu      sync.Mutex
+	entries map[[sha256.Size]byte]*list.Element
+	owners  map[string]*list.Element
+	order   *list.List // front is the most recently used
+}
+
+type tokenCacheEntry struct {
+	key       [sha256.Size]byte
+	claims    *models.Claims
+	owner     *models.User // set on owner entries instead of claims
+	expiresAt time.Time
+}
+
+func NewTokenCache(validator TokenValidator, ttl time.Duration, size int) *TokenCache {
+	return &TokenCache{
+		validator: validator,
+		ttl:       ttl,
+		size:      size,
+		entries:   make(map[[sha256.Size]byte]*list.Element),
+		owners:    make(map[string]*list.Element),
+		order:     list.New(),
+	}
+}
+
+func (c *TokenCache) ValidateJWT(ctx context.Context, tokenString string) (*models.Claims, error) {
+	if c.ttl <= 0 || c.size <= 0 {
+		return c.validator.ValidateJWT(ctx, tokenString)
+	}
+
+	// tokens are not kept in memory in plain text
+	key := sha256.Sum256([]byte(tokenString))
+	now := time.Now()
+
+	if claims, ok := c.get(key, now); ok {
+		return claims, nil
+	}
+
+	claims, err := c.validator.ValidateJWT(ctx, tokenString)
+	if err != nil {
+		return nil, err
+	}
+
+	expiresAt := now.Add(c.ttl)
+	if claims.ExpiresAt != nil && claims.ExpiresAt.Before(expiresAt) {
+		expiresAt = claims.ExpiresAt.Time
+	}
+	c.put(key, claims, expiresAt)
+
+	return claims, nil
+}
+
+// CheckAllowedIP works like AuthService.CheckAllowedIP, only the owner is
+// loaded at most once per ttl and the address is matched on every call
+func (c *TokenCache) CheckAllowedIP(ctx context.Context, claims *models.Claims, ip string) error {
+	if claims.Principal() != models.PrincipalUser || claims.ID == "" {
+		return nil
+	}
+
+	now := time.Now()
+	owner, ok := c.getOwner(claims.ID, now)
+	if !ok {
+		var err error
+		owner, err = c.validator.TokenOwner(ctx, claims.ID)
+		if err != nil {
+			return err
+		}
+		if c.ttl > 0 && c.size > 0 {
+			c.putOwner(owner, now.Add(c.ttl))
+		}
+	}
+
+	return checkTokenOwner(owner, claims, ip)
+}
+
+func (c *TokenCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.order.Len()
+}
+
+func (c *TokenCache) get(key [sha256.Size]byte, now time.Time) (*models.Claims, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	element, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+
+	entry := element.Value.(*tokenCacheEntry)
+	if !now.Before(entry.expiresAt) {
+		c.remove(element)
+		return nil, false
+	}
+
+	c.order.MoveToFront(element)
+
+	return entry.claims, true
+}
+
+func (c *TokenCache) getOwner(userID string, now time.Time) (*models.User, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	element, ok := c.owners[userID]
+	if !ok {
+		return nil, false
+	}
+
+	entry := element.Value.(*tokenCacheEntry)
+	if !now.Before(entry.expiresAt) {
+		c.remove(element)
+		return nil, false
+	}
+
+	c.order.MoveToFront(element)
+
+	return entry.owner, true
+}
+
+func (c *TokenCache) putOwner(owner *models.User, expiresAt time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if element, ok := c.owners[owner.ID]; ok {
+		c.remove(element)
+	}
+
+	c.owners[owner.ID] = c.order.PushFront(&tokenCacheEntry{
+		owner:     owner,
+		expiresAt: expiresAt,
+	})
+
+	for c.order.Len() > c.size {
+		c.remove(c.order.Back())
+	}
+}
+
+func (c *TokenCache) put(key [sha256.Size]byte, claims *models.Claims, expiresAt time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if element, ok := c.entries[key]; ok {
+		c.remove(element)
+	}
+
+	c.entries[key] = c.order.PushFront(&tokenCacheEntry{
+		key:       key,
+		claims:    claims,
+		expiresAt: expiresAt,
+	})
+
+	for c.order.Len() > c.size {
+		c.remove(c.order.Back())
+	}
+}
+
+func (c *TokenCache) remove(element *list.Element) {
+	c.order.Remove(element)
+	entry := element.Value.(*tokenCacheEntry)
+	if entry.owner != nil {
+		delete(c.owners, entry.owner.ID)
+		return
+	}
+	delete(c.entries, entry.key)
+}
@@ -0,0 +1,140 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alonsoF100/authorization-service/internal/apperrors"
+	"github.com/alonsoF100/authorization-service/internal/models"
+	"github.com/alonsoF100/authorization-service/internal/service"
+	"github.com/gojuno/minimock/v3"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+)
+
+func claimsExpiringIn(d time.Duration) *models.Claims {
+	return &models.Claims{
+		ID: "user123",
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(d)),
+		},
+	}
+}
+
+func TestTokenCache(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("caches successful validations", func(t *testing.T) {
+		mc := minimock.NewController(t)
+		validator := service.NewTokenValidatorMock(mc)
+		validator.ValidateJWTMock.Expect(ctx, "token").Return(claimsExpiringIn(time.Hour), nil).
+			ValidateJWTMock.Times(1)
+
+		cache := service.NewTokenCache(validator, time.Minute, 10)
+		for range 3 {
+			claims, err := cache.ValidateJWT(ctx, "token")
+			require.NoError(t, err)
+			require.Equal(t, "user123", claims.ID)
+		}
+	})
+
+	t.Run("does not cache failures", func(t *testing.T) {
+		mc := minimock.NewController(t)
+		validator := service.NewTokenValidatorMock(mc)
+		validator.ValidateJWTMock.Expect(ctx, "bad").Return(nil, apperrors.ErrInvalidToken).
+			ValidateJWTMock.Times(2)
+
+		cache := service.NewTokenCache(validator, time.Minute, 10)
+		for range 2 {
+			_, err := cache.ValidateJWT(ctx, "bad")
+			require.ErrorIs(t, err, apperrors.ErrInvalidToken)
+		}
+		require.Zero(t, cache.Len())
+	})
+
+	t.Run("entries expire with the token", func(t *testing.T) {
+		mc := minimock.NewController(t)
+		validator := service.NewTokenValidatorMock(mc)
+		validator.ValidateJWTMock.Expect(ctx, "token").Return(claimsExpiringIn(time.Second), nil).
+			ValidateJWTMock.Times(2)
+
+		cache := service.NewTokenCache(validator, time.Hour, 10)
+		_, err := cache.ValidateJWT(ctx, "token")
+		require.NoError(t, err)
+
+		// NumericDate has second precision
+		time.Sleep(time.Second)
+
+		_, err = cache.ValidateJWT(ctx, "token")
+		require.NoError(t, err)
+	})
+
+	t.Run("evicts least recently used", func(t *testing.T) {
+		mc := minimock.NewController(t)
+		validator := service.NewTokenValidatorMock(mc)
+		validator.ValidateJWTMock.Set(func(ctx context.Context, tokenString string) (*models.Claims, error) {
+			return claimsExpiringIn(time.Hour), nil
+		})
+
+		cache := service.NewTokenCache(validator, time.Minute, 2)
+		for _, token := range []string{"a", "b", "a", "c"} {
+			_, err := cache.ValidateJWT(ctx, token)
+			require.NoError(t, err)
+		}
+		require.Equal(t, 2, cache.Len())
+		require.EqualValues(t, 3, validator.ValidateJWTAfterCounter())
+
+		// "b" was evicted, "a" and "c" are still cached
+		for _, token := range []string{"a", "c", "b"} {
+			_, err := cache.ValidateJWT(ctx, token)
+			require.NoError(t, err)
+		}
+		require.EqualValues(t, 4, validator.ValidateJWTAfterCounter())
+	})
+
+	t.Run("disabled without ttl", func(t *testing.T) {
+		mc := minimock.NewController(t)
+		validator := service.NewTokenValidatorMock(mc)
+		validator.ValidateJWTMock.Expect(ctx, "token").Return(claimsExpiringIn(time.Hour), nil).
+			ValidateJWTMock.Times(2)
+
+		cache := service.NewTokenCache(validator, 0, 10)
+		for range 2 {
+			_, err := cache.ValidateJWT(ctx, "token")
+			require.NoError(t, err)
+		}
+		require.Zero(t, cache.Len())
+	})
+
+	t.Run("caches the token owner", func(t *testing.T) {
+		mc := minimock.NewController(t)
+		validator := service.NewTokenValidatorMock(mc)
+		validator.TokenOwnerMock.Expect(ctx, "user123").
+			Return(&models.User{ID: "user123", AllowedIPs: []string{"203.0.113.0/24"}}, nil).
+			TokenOwnerMock.Times(1)
+
+		cache := service.NewTokenCache(validator, time.Minute, 10)
+		claims := claimsExpiringIn(time.Hour)
+		for range 2 {
+			require.NoError(t, cache.CheckAllowedIP(ctx, claims, "203.0.113.7"))
+			require.ErrorIs(t, cache.CheckAllowedIP(ctx, claims, "198.51.100.9"), apperrors.ErrIPNotAllowed)
+		}
+	})
+
+	t.Run("loads the token owner without ttl", func(t *testing.T) {
+		disabledAt := time.Now()
+		mc := minimock.NewController(t)
+		validator := service.NewTokenValidatorMock(mc)
+		validator.TokenOwnerMock.Expect(ctx, "user123").
+			Return(&models.User{ID: "user123", DisabledAt: &disabledAt}, nil).
+			TokenOwnerMock.Times(2)
+
+		cache := service.NewTokenCache(validator, 0, 10)
+		for range 2 {
+			err := cache.CheckAllowedIP(ctx, claimsExpiringIn(time.Hour), "203.0.113.7")
+			require.ErrorIs(t, err, apperrors.ErrUserDisabled)
+		}
+		require.Zero(t, cache.Len())
+	})
+}
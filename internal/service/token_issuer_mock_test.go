@@ -0,0 +1,1904 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package service
+
+//go:generate minimock -i github.com/alonsoF100/authorization-service/internal/service.TokenIssuer -o token_issuer_mock_test.go -n TokenIssuerMock -p service
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/alonsoF100/authorization-service/internal/models"
+	"github.com/gojuno/minimock/v3"
+)
+
+// TokenIssuerMock implements TokenIssuer
+type TokenIssuerMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcGenerateClientJWT          func(client *models.Client, scope string) (s1 string, err error)
+	funcGenerateClientJWTOrigin    string
+	inspectFuncGenerateClientJWT   func(client *models.Client, scope string)
+	afterGenerateClientJWTCounter  uint64
+	beforeGenerateClientJWTCounter uint64
+	GenerateClientJWTMock          mTokenIssuerMockGenerateClientJWT
+
+	funcGenerateIDToken          func(user *models.User, code *models.AuthorizationCode, accessToken string) (s1 string, err error)
+	funcGenerateIDTokenOrigin    string
+	inspectFuncGenerateIDToken   func(user *models.User, code *models.AuthorizationCode, accessToken string)
+	afterGenerateIDTokenCounter  uint64
+	beforeGenerateIDTokenCounter uint64
+	GenerateIDTokenMock          mTokenIssuerMockGenerateIDToken
+
+	funcGenerateScopedJWT          func(user *models.User, clientID string, scope string, sessionID string) (s1 string, err error)
+	funcGenerateScopedJWTOrigin    string
+	inspectFuncGenerateScopedJWT   func(user *models.User, clientID string, scope string, sessionID string)
+	afterGenerateScopedJWTCounter  uint64
+	beforeGenerateScopedJWTCounter uint64
+	GenerateScopedJWTMock          mTokenIssuerMockGenerateScopedJWT
+
+	funcParseIDToken          func(tokenString string) (ip1 *models.IDTokenClaims, err error)
+	funcParseIDTokenOrigin    string
+	inspectFuncParseIDToken   func(tokenString string)
+	afterParseIDTokenCounter  uint64
+	beforeParseIDTokenCounter uint64
+	ParseIDTokenMock          mTokenIssuerMockParseIDToken
+
+	funcValidateSessionToken          func(ctx context.Context, tokenString string) (cp1 *models.Claims, err error)
+	funcValidateSessionTokenOrigin    string
+	inspectFuncValidateSessionToken   func(ctx context.Context, tokenString string)
+	afterValidateSessionTokenCounter  uint64
+	beforeValidateSessionTokenCounter uint64
+	ValidateSessionTokenMock          mTokenIssuerMockValidateSessionToken
+}
+
+// NewTokenIssuerMock returns a mock for TokenIssuer
+func NewTokenIssuerMock(t minimock.Tester) *TokenIssuerMock {
+	m := &TokenIssuerMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.GenerateClientJWTMock = mTokenIssuerMockGenerateClientJWT{mock: m}
+	m.GenerateClientJWTMock.callArgs = []*TokenIssuerMockGenerateClientJWTParams{}
+
+	m.GenerateIDTokenMock = mTokenIssuerMockGenerateIDToken{mock: m}
+	m.GenerateIDTokenMock.callArgs = []*TokenIssuerMockGenerateIDTokenParams{}
+
+	m.GenerateScopedJWTMock = mTokenIssuerMockGenerateScopedJWT{mock: m}
+	m.GenerateScopedJWTMock.callArgs = []*TokenIssuerMockGenerateScopedJWTParams{}
+
+	m.ParseIDTokenMock = mTokenIssuerMockParseIDToken{mock: m}
+	m.ParseIDTokenMock.callArgs = []*TokenIssuerMockParseIDTokenParams{}
+
+	m.ValidateSessionTokenMock = mTokenIssuerMockValidateSessionToken{mock: m}
+	m.ValidateSessionTokenMock.callArgs = []*TokenIssuerMockValidateSessionTokenParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mTokenIssuerMockGenerateClientJWT struct {
+	optional           bool
+	mock               *TokenIssuerMock
+	defaultExpectation *TokenIssuerMockGenerateClientJWTExpectation
+	expectations       []*TokenIssuerMockGenerateClientJWTExpectation
+
+	callArgs []*TokenIssuerMockGenerateClientJWTParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// TokenIssuerMockGenerateClientJWTExpectation specifies expectation struct of the TokenIssuer.GenerateClientJWT
+type TokenIssuerMockGenerateClientJWTExpectation struct {
+	mock               *TokenIssuerMock
+	params             *TokenIssuerMockGenerateClientJWTParams
+	paramPtrs          *TokenIssuerMockGenerateClientJWTParamPtrs
+	expectationOrigins TokenIssuerMockGenerateClientJWTExpectationOrigins
+	results            *TokenIssuerMockGenerateClientJWTResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// TokenIssuerMockGenerateClientJWTParams contains parameters of the TokenIssuer.GenerateClientJWT
+type TokenIssuerMockGenerateClientJWTParams struct {
+	client *models.Client
+	scope  string
+}
+
+// TokenIssuerMockGenerateClientJWTParamPtrs contains pointers to parameters of the TokenIssuer.GenerateClientJWT
+type TokenIssuerMockGenerateClientJWTParamPtrs struct {
+	client **models.Client
+	scope  *string
+}
+
+// TokenIssuerMockGenerateClientJWTResults contains results of the TokenIssuer.GenerateClientJWT
+type TokenIssuerMockGenerateClientJWTResults struct {
+	s1  string
+	err error
+}
+
+// TokenIssuerMockGenerateClientJWTOrigins contains origins of expectations of the TokenIssuer.GenerateClientJWT
+type TokenIssuerMockGenerateClientJWTExpectationOrigins struct {
+	origin       string
+	originClient string
+	originScope  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGenerateClientJWT *mTokenIssuerMockGenerateClientJWT) Optional() *mTokenIssuerMockGenerateClientJWT {
+	mmGenerateClientJWT.optional = true
+	return mmGenerateClientJWT
+}
+
+// Expect sets up expected params for TokenIssuer.GenerateClientJWT
+func (mmGenerateClientJWT *mTokenIssuerMockGenerateClientJWT) Expect(client *models.Client, scope string) *mTokenIssuerMockGenerateClientJWT {
+	if mmGenerateClientJWT.mock.funcGenerateClientJWT != nil {
+		mmGenerateClientJWT.mock.t.Fatalf("TokenIssuerMock.GenerateClientJWT mock is already set by Set")
+	}
+
+	if mmGenerateClientJWT.defaultExpectation == nil {
+		mmGenerateClientJWT.defaultExpectation = &TokenIssuerMockGenerateClientJWTExpectation{}
+	}
+
+	if mmGenerateClientJWT.defaultExpectation.paramPtrs != nil {
+		mmGenerateClientJWT.mock.t.Fatalf("TokenIssuerMock.GenerateClientJWT mock is already set by ExpectParams functions")
+	}
+
+	mmGenerateClientJWT.defaultExpectation.params = &TokenIssuerMockGenerateClientJWTParams{client, scope}
+	mmGenerateClientJWT.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGenerateClientJWT.expectations {
+		if minimock.Equal(e.params, mmGenerateClientJWT.defaultExpectation.params) {
+			mmGenerateClientJWT.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGenerateClientJWT.defaultExpectation.params)
+		}
+	}
+
+	return mmGenerateClientJWT
+}
+
+// ExpectClientParam1 sets up expected param client for TokenIssuer.GenerateClientJWT
+func (mmGenerateClientJWT *mTokenIssuerMockGenerateClientJWT) ExpectClientParam1(client *models.Client) *mTokenIssuerMockGenerateClientJWT {
+	if mmGenerateClientJWT.mock.funcGenerateClientJWT != nil {
+		mmGenerateClientJWT.mock.t.Fatalf("TokenIssuerMock.GenerateClientJWT mock is already set by Set")
+	}
+
+	if mmGenerateClientJWT.defaultExpectation == nil {
+		mmGenerateClientJWT.defaultExpectation = &TokenIssuerMockGenerateClientJWTExpectation{}
+	}
+
+	if mmGenerateClientJWT.defaultExpectation.params != nil {
+		mmGenerateClientJWT.mock.t.Fatalf("TokenIssuerMock.GenerateClientJWT mock is already set by Expect")
+	}
+
+	if mmGenerateClientJWT.defaultExpectation.paramPtrs == nil {
+		mmGenerateClientJWT.defaultExpectation.paramPtrs = &TokenIssuerMockGenerateClientJWTParamPtrs{}
+	}
+	mmGenerateClientJWT.defaultExpectation.paramPtrs.client = &client
+	mmGenerateClientJWT.defaultExpectation.expectationOrigins.originClient = minimock.CallerInfo(1)
+
+	return mmGenerateClientJWT
+}
+
+// ExpectScopeParam2 sets up expected param scope for TokenIssuer.GenerateClientJWT
+func (mmGenerateClientJWT *mTokenIssuerMockGenerateClientJWT) ExpectScopeParam2(scope string) *mTokenIssuerMockGenerateClientJWT {
+	if mmGenerateClientJWT.mock.funcGenerateClientJWT != nil {
+		mmGenerateClientJWT.mock.t.Fatalf("TokenIssuerMock.GenerateClientJWT mock is already set by Set")
+	}
+
+	if mmGenerateClientJWT.defaultExpectation == nil {
+		mmGenerateClientJWT.defaultExpectation = &TokenIssuerMockGenerateClientJWTExpectation{}
+	}
+
+	if mmGenerateClientJWT.defaultExpectation.params != nil {
+		mmGenerateClientJWT.mock.t.Fatalf("TokenIssuerMock.GenerateClientJWT mock is already set by Expect")
+	}
+
+	if mmGenerateClientJWT.defaultExpectation.paramPtrs == nil {
+		mmGenerateClientJWT.defaultExpectation.paramPtrs = &TokenIssuerMockGenerateClientJWTParamPtrs{}
+	}
+	mmGenerateClientJWT.defaultExpectation.paramPtrs.scope = &scope
+	mmGenerateClientJWT.defaultExpectation.expectationOrigins.originScope = minimock.CallerInfo(1)
+
+	return mmGenerateClientJWT
+}
+
+// Inspect accepts an inspector function that has same arguments as the TokenIssuer.GenerateClientJWT
+func (mmGenerateClientJWT *mTokenIssuerMockGenerateClientJWT) Inspect(f func(client *models.Client, scope string)) *mTokenIssuerMockGenerateClientJWT {
+	if mmGenerateClientJWT.mock.inspectFuncGenerateClientJWT != nil {
+		mmGenerateClientJWT.mock.t.Fatalf("Inspect function is already set for TokenIssuerMock.GenerateClientJWT")
+	}
+
+	mmGenerateClientJWT.mock.inspectFuncGenerateClientJWT = f
+
+	return mmGenerateClientJWT
+}
+
+// Return sets up results that will be returned by TokenIssuer.GenerateClientJWT
+func (mmGenerateClientJWT *mTokenIssuerMockGenerateClientJWT) Return(s1 string, err error) *TokenIssuerMock {
+	if mmGenerateClientJWT.mock.funcGenerateClientJWT != nil {
+		mmGenerateClientJWT.mock.t.Fatalf("TokenIssuerMock.GenerateClientJWT mock is already set by Set")
+	}
+
+	if mmGenerateClientJWT.defaultExpectation == nil {
+		mmGenerateClientJWT.defaultExpectation = &TokenIssuerMockGenerateClientJWTExpectation{mock: mmGenerateClientJWT.mock}
+	}
+	mmGenerateClientJWT.defaultExpectation.results = &TokenIssuerMockGenerateClientJWTResults{s1, err}
+	mmGenerateClientJWT.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGenerateClientJWT.mock
+}
+
+// Set uses given function f to mock the TokenIssuer.GenerateClientJWT method
+func (mmGenerateClientJWT *mTokenIssuerMockGenerateClientJWT) Set(f func(client *models.Client, scope string) (s1 string, err error)) *TokenIssuerMock {
+	if mmGenerateClientJWT.defaultExpectation != nil {
+		mmGenerateClientJWT.mock.t.Fatalf("Default expectation is already set for the TokenIssuer.GenerateClientJWT method")
+	}
+
+	if len(mmGenerateClientJWT.expectations) > 0 {
+		mmGenerateClientJWT.mock.t.Fatalf("Some expectations are already set for the TokenIssuer.GenerateClientJWT method")
+	}
+
+	mmGenerateClientJWT.mock.funcGenerateClientJWT = f
+	mmGenerateClientJWT.mock.funcGenerateClientJWTOrigin = minimock.CallerInfo(1)
+	return mmGenerateClientJWT.mock
+}
+
+// When sets expectation for the TokenIssuer.GenerateClientJWT which will trigger the result defined by the following
+// Then helper
+func (mmGenerateClientJWT *mTokenIssuerMockGenerateClientJWT) When(client *models.Client, scope string) *TokenIssuerMockGenerateClientJWTExpectation {
+	if mmGenerateClientJWT.mock.funcGenerateClientJWT != nil {
+		mmGenerateClientJWT.mock.t.Fatalf("TokenIssuerMock.GenerateClientJWT mock is already set by Set")
+	}
+
+	expectation := &TokenIssuerMockGenerateClientJWTExpectation{
+		mock:               mmGenerateClientJWT.mock,
+		params:             &TokenIssuerMockGenerateClientJWTParams{client, scope},
+		expectationOrigins: TokenIssuerMockGenerateClientJWTExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGenerateClientJWT.expectations = append(mmGenerateClientJWT.expectations, expectation)
+	return expectation
+}
+
+// Then sets up TokenIssuer.GenerateClientJWT return parameters for the expectation previously defined by the When method
+func (e *TokenIssuerMockGenerateClientJWTExpectation) Then(s1 string, err error) *TokenIssuerMock {
+	e.results = &TokenIssuerMockGenerateClientJWTResults{s1, err}
+	return e.mock
+}
+
+// Times sets number of times TokenIssuer.GenerateClientJWT should be invoked
+func (mmGenerateClientJWT *mTokenIssuerMockGenerateClientJWT) Times(n uint64) *mTokenIssuerMockGenerateClientJWT {
+	if n == 0 {
+		mmGenerateClientJWT.mock.t.Fatalf("Times of TokenIssuerMock.GenerateClientJWT mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGenerateClientJWT.expectedInvocations, n)
+	mmGenerateClientJWT.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGenerateClientJWT
+}
+
+func (mmGenerateClientJWT *mTokenIssuerMockGenerateClientJWT) invocationsDone() bool {
+	if len(mmGenerateClientJWT.expectations) == 0 && mmGenerateClientJWT.defaultExpectation == nil && mmGenerateClientJWT.mock.funcGenerateClientJWT == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGenerateClientJWT.mock.afterGenerateClientJWTCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGenerateClientJWT.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GenerateClientJWT implements TokenIssuer
+func (mmGenerateClientJWT *TokenIssuerMock) GenerateClientJWT(client *models.Client, scope string) (s1 string, err error) {
+	mm_atomic.AddUint64(&mmGenerateClientJWT.beforeGenerateClientJWTCounter, 1)
+	defer mm_atomic.AddUint64(&mmGenerateClientJWT.afterGenerateClientJWTCounter, 1)
+
+	mmGenerateClientJWT.t.Helper()
+
+	if mmGenerateClientJWT.inspectFuncGenerateClientJWT != nil {
+		mmGenerateClientJWT.inspectFuncGenerateClientJWT(client, scope)
+	}
+
+	mm_params := TokenIssuerMockGenerateClientJWTParams{client, scope}
+
+	// Record call args
+	mmGenerateClientJWT.GenerateClientJWTMock.mutex.Lock()
+	mmGenerateClientJWT.GenerateClientJWTMock.callArgs = append(mmGenerateClientJWT.GenerateClientJWTMock.callArgs, &mm_params)
+	mmGenerateClientJWT.GenerateClientJWTMock.mutex.Unlock()
+
+	for _, e := range mmGenerateClientJWT.GenerateClientJWTMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.s1, e.results.err
+		}
+	}
+
+	if mmGenerateClientJWT.GenerateClientJWTMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGenerateClientJWT.GenerateClientJWTMock.defaultExpectation.Counter, 1)
+		mm_want := mmGenerateClientJWT.GenerateClientJWTMock.defaultExpectation.params
+		mm_want_ptrs := mmGenerateClientJWT.GenerateClientJWTMock.defaultExpectation.paramPtrs
+
+		mm_got := TokenIssuerMockGenerateClientJWTParams{client, scope}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.client != nil && !minimock.Equal(*mm_want_ptrs.client, mm_got.client) {
+				mmGenerateClientJWT.t.Errorf("TokenIssuerMock.GenerateClientJWT got unexpected parameter client, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGenerateClientJWT.GenerateClientJWTMock.defaultExpectation.expectationOrigins.originClient, *mm_want_ptrs.client, mm_got.client, minimock.Diff(*mm_want_ptrs.client, mm_got.client))
+			}
+
+			if mm_want_ptrs.scope != nil && !minimock.Equal(*mm_want_ptrs.scope, mm_got.scope) {
+				mmGenerateClientJWT.t.Errorf("TokenIssuerMock.GenerateClientJWT got unexpected parameter scope, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGenerateClientJWT.GenerateClientJWTMock.defaultExpectation.expectationOrigins.originScope, *mm_want_ptrs.scope, mm_got.scope, minimock.Diff(*mm_want_ptrs.scope, mm_got.scope))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGenerateClientJWT.t.Errorf("TokenIssuerMock.GenerateClientJWT got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGenerateClientJWT.GenerateClientJWTMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGenerateClientJWT.GenerateClientJWTMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGenerateClientJWT.t.Fatal("No results are set for the TokenIssuerMock.GenerateClientJWT")
+		}
+		return (*mm_results).s1, (*mm_results).err
+	}
+	if mmGenerateClientJWT.funcGenerateClientJWT != nil {
+		return mmGenerateClientJWT.funcGenerateClientJWT(client, scope)
+	}
+	mmGenerateClientJWT.t.Fatalf("Unexpected call to TokenIssuerMock.GenerateClientJWT. %v %v", client, scope)
+	return
+}
+
+// GenerateClientJWTAfterCounter returns a count of finished TokenIssuerMock.GenerateClientJWT invocations
+func (mmGenerateClientJWT *TokenIssuerMock) GenerateClientJWTAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGenerateClientJWT.afterGenerateClientJWTCounter)
+}
+
+// GenerateClientJWTBeforeCounter returns a count of TokenIssuerMock.GenerateClientJWT invocations
+func (mmGenerateClientJWT *TokenIssuerMock) GenerateClientJWTBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGenerateClientJWT.beforeGenerateClientJWTCounter)
+}
+
+// Calls returns a list of arguments used in each call to TokenIssuerMock.GenerateClientJWT.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGenerateClientJWT *mTokenIssuerMockGenerateClientJWT) Calls() []*TokenIssuerMockGenerateClientJWTParams {
+	mmGenerateClientJWT.mutex.RLock()
+
+	argCopy := make([]*TokenIssuerMockGenerateClientJWTParams, len(mmGenerateClientJWT.callArgs))
+	copy(argCopy, mmGenerateClientJWT.callArgs)
+
+	mmGenerateClientJWT.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGenerateClientJWTDone returns true if the count of the GenerateClientJWT invocations corresponds
+// the number of defined expectations
+func (m *TokenIssuerMock) MinimockGenerateClientJWTDone() bool {
+	if m.GenerateClientJWTMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GenerateClientJWTMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GenerateClientJWTMock.invocationsDone()
+}
+
+// MinimockGenerateClientJWTInspect logs each unmet expectation
+func (m *TokenIssuerMock) MinimockGenerateClientJWTInspect() {
+	for _, e := range m.GenerateClientJWTMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to TokenIssuerMock.GenerateClientJWT at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGenerateClientJWTCounter := mm_atomic.LoadUint64(&m.afterGenerateClientJWTCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GenerateClientJWTMock.defaultExpectation != nil && afterGenerateClientJWTCounter < 1 {
+		if m.GenerateClientJWTMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to TokenIssuerMock.GenerateClientJWT at\n%s", m.GenerateClientJWTMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to TokenIssuerMock.GenerateClientJWT at\n%s with params: %#v", m.GenerateClientJWTMock.defaultExpectation.expectationOrigins.origin, *m.GenerateClientJWTMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGenerateClientJWT != nil && afterGenerateClientJWTCounter < 1 {
+		m.t.Errorf("Expected call to TokenIssuerMock.GenerateClientJWT at\n%s", m.funcGenerateClientJWTOrigin)
+	}
+
+	if !m.GenerateClientJWTMock.invocationsDone() && afterGenerateClientJWTCounter > 0 {
+		m.t.Errorf("Expected %d calls to TokenIssuerMock.GenerateClientJWT at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GenerateClientJWTMock.expectedInvocations), m.GenerateClientJWTMock.expectedInvocationsOrigin, afterGenerateClientJWTCounter)
+	}
+}
+
+type mTokenIssuerMockGenerateIDToken struct {
+	optional           bool
+	mock               *TokenIssuerMock
+	defaultExpectation *TokenIssuerMockGenerateIDTokenExpectation
+	expectations       []*TokenIssuerMockGenerateIDTokenExpectation
+
+	callArgs []*TokenIssuerMockGenerateIDTokenParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// TokenIssuerMockGenerateIDTokenExpectation specifies expectation struct of the TokenIssuer.GenerateIDToken
+type TokenIssuerMockGenerateIDTokenExpectation struct {
+	mock               *TokenIssuerMock
+	params             *TokenIssuerMockGenerateIDTokenParams
+	paramPtrs          *TokenIssuerMockGenerateIDTokenParamPtrs
+	expectationOrigins TokenIssuerMockGenerateIDTokenExpectationOrigins
+	results            *TokenIssuerMockGenerateIDTokenResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// TokenIssuerMockGenerateIDTokenParams contains parameters of the TokenIssuer.GenerateIDToken
+type TokenIssuerMockGenerateIDTokenParams struct {
+	user        *models.User
+	code        *models.AuthorizationCode
+	accessToken string
+}
+
+// TokenIssuerMockGenerateIDTokenParamPtrs contains pointers to parameters of the TokenIssuer.GenerateIDToken
+type TokenIssuerMockGenerateIDTokenParamPtrs struct {
+	user        **models.User
+	code        **models.AuthorizationCode
+	accessToken *string
+}
+
+// TokenIssuerMockGenerateIDTokenResults contains results of the TokenIssuer.GenerateIDToken
+type TokenIssuerMockGenerateIDTokenResults struct {
+	s1  string
+	err error
+}
+
+// TokenIssuerMockGenerateIDTokenOrigins contains origins of expectations of the TokenIssuer.GenerateIDToken
+type TokenIssuerMockGenerateIDTokenExpectationOrigins struct {
+	origin            string
+	originUser        string
+	originCode        string
+	originAccessToken string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGenerateIDToken *mTokenIssuerMockGenerateIDToken) Optional() *mTokenIssuerMockGenerateIDToken {
+	mmGenerateIDToken.optional = true
+	return mmGenerateIDToken
+}
+
+// Expect sets up expected params for TokenIssuer.GenerateIDToken
+func (mmGenerateIDToken *mTokenIssuerMockGenerateIDToken) Expect(user *models.User, code *models.AuthorizationCode, accessToken string) *mTokenIssuerMockGenerateIDToken {
+	if mmGenerateIDToken.mock.funcGenerateIDToken != nil {
+		mmGenerateIDToken.mock.t.Fatalf("TokenIssuerMock.GenerateIDToken mock is already set by Set")
+	}
+
+	if mmGenerateIDToken.defaultExpectation == nil {
+		mmGenerateIDToken.defaultExpectation = &TokenIssuerMockGenerateIDTokenExpectation{}
+	}
+
+	if mmGenerateIDToken.defaultExpectation.paramPtrs != nil {
+		mmGenerateIDToken.mock.t.Fatalf("TokenIssuerMock.GenerateIDToken mock is already set by ExpectParams functions")
+	}
+
+	mmGenerateIDToken.defaultExpectation.params = &TokenIssuerMockGenerateIDTokenParams{user, code, accessToken}
+	mmGenerateIDToken.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGenerateIDToken.expectations {
+		if minimock.Equal(e.params, mmGenerateIDToken.defaultExpectation.params) {
+			mmGenerateIDToken.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGenerateIDToken.defaultExpectation.params)
+		}
+	}
+
+	return mmGenerateIDToken
+}
+
+// ExpectUserParam1 sets up expected param user for TokenIssuer.GenerateIDToken
+func (mmGenerateIDToken *mTokenIssuerMockGenerateIDToken) ExpectUserParam1(user *models.User) *mTokenIssuerMockGenerateIDToken {
+	if mmGenerateIDToken.mock.funcGenerateIDToken != nil {
+		mmGenerateIDToken.mock.t.Fatalf("TokenIssuerMock.GenerateIDToken mock is already set by Set")
+	}
+
+	if mmGenerateIDToken.defaultExpectation == nil {
+		mmGenerateIDToken.defaultExpectation = &TokenIssuerMockGenerateIDTokenExpectation{}
+	}
+
+	if mmGenerateIDToken.defaultExpectation.params != nil {
+		mmGenerateIDToken.mock.t.Fatalf("TokenIssuerMock.GenerateIDToken mock is already set by Expect")
+	}
+
+	if mmGenerateIDToken.defaultExpectation.paramPtrs == nil {
+		mmGenerateIDToken.defaultExpectation.paramPtrs = &TokenIssuerMockGenerateIDTokenParamPtrs{}
+	}
+	mmGenerateIDToken.defaultExpectation.paramPtrs.user = &user
+	mmGenerateIDToken.defaultExpectation.expectationOrigins.originUser = minimock.CallerInfo(1)
+
+	return mmGenerateIDToken
+}
+
+// ExpectCodeParam2 sets up expected param code for TokenIssuer.GenerateIDToken
+func (mmGenerateIDToken *mTokenIssuerMockGenerateIDToken) ExpectCodeParam2(code *models.AuthorizationCode) *mTokenIssuerMockGenerateIDToken {
+	if mmGenerateIDToken.mock.funcGenerateIDToken != nil {
+		mmGenerateIDToken.mock.t.Fatalf("TokenIssuerMock.GenerateIDToken mock is already set by Set")
+	}
+
+	if mmGenerateIDToken.defaultExpectation == nil {
+		mmGenerateIDToken.defaultExpectation = &TokenIssuerMockGenerateIDTokenExpectation{}
+	}
+
+	if mmGenerateIDToken.defaultExpectation.params != nil {
+		mmGenerateIDToken.mock.t.Fatalf("TokenIssuerMock.GenerateIDToken mock is already set by Expect")
+	}
+
+	if mmGenerateIDToken.defaultExpectation.paramPtrs == nil {
+		mmGenerateIDToken.defaultExpectation.paramPtrs = &TokenIssuerMockGenerateIDTokenParamPtrs{}
+	}
+	mmGenerateIDToken.defaultExpectation.paramPtrs.code = &code
+	mmGenerateIDToken.defaultExpectation.expectationOrigins.originCode = minimock.CallerInfo(1)
+
+	return mmGenerateIDToken
+}
+
+// ExpectAccessTokenParam3 sets up expected param accessToken for TokenIssuer.GenerateIDToken
+func (mmGenerateIDToken *mTokenIssuerMockGenerateIDToken) ExpectAccessTokenParam3(accessToken string) *mTokenIssuerMockGenerateIDToken {
+	if mmGenerateIDToken.mock.funcGenerateIDToken != nil {
+		mmGenerateIDToken.mock.t.Fatalf("TokenIssuerMock.GenerateIDToken mock is already set by Set")
+	}
+
+	if mmGenerateIDToken.defaultExpectation == nil {
+		mmGenerateIDToken.defaultExpectation = &TokenIssuerMockGenerateIDTokenExpectation{}
+	}
+
+	if mmGenerateIDToken.defaultExpectation.params != nil {
+		mmGenerateIDToken.mock.t.Fatalf("TokenIssuerMock.GenerateIDToken mock is already set by Expect")
+	}
+
+	if mmGenerateIDToken.defaultExpectation.paramPtrs == nil {
+		mmGenerateIDToken.defaultExpectation.paramPtrs = &TokenIssuerMockGenerateIDTokenParamPtrs{}
+	}
+	mmGenerateIDToken.defaultExpectation.paramPtrs.accessToken = &accessToken
+	mmGenerateIDToken.defaultExpectation.expectationOrigins.originAccessToken = minimock.CallerInfo(1)
+
+	return mmGenerateIDToken
+}
+
+// Inspect accepts an inspector function that has same arguments as the TokenIssuer.GenerateIDToken
+func (mmGenerateIDToken *mTokenIssuerMockGenerateIDToken) Inspect(f func(user *models.User, code *models.AuthorizationCode, accessToken string)) *mTokenIssuerMockGenerateIDToken {
+	if mmGenerateIDToken.mock.inspectFuncGenerateIDToken != nil {
+		mmGenerateIDToken.mock.t.Fatalf("Inspect function is already set for TokenIssuerMock.GenerateIDToken")
+	}
+
+	mmGenerateIDToken.mock.inspectFuncGenerateIDToken = f
+
+	return mmGenerateIDToken
+}
+
+// Return sets up results that will be returned by TokenIssuer.GenerateIDToken
+func (mmGenerateIDToken *mTokenIssuerMockGenerateIDToken) Return(s1 string, err error) *TokenIssuerMock {
+	if mmGenerateIDToken.mock.funcGenerateIDToken != nil {
+		mmGenerateIDToken.mock.t.Fatalf("TokenIssuerMock.GenerateIDToken mock is already set by Set")
+	}
+
+	if mmGenerateIDToken.defaultExpectation == nil {
+		mmGenerateIDToken.defaultExpectation = &TokenIssuerMockGenerateIDTokenExpectation{mock: mmGenerateIDToken.mock}
+	}
+	mmGenerateIDToken.defaultExpectation.results = &TokenIssuerMockGenerateIDTokenResults{s1, err}
+	mmGenerateIDToken.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGenerateIDToken.mock
+}
+
+// Set uses given function f to mock the TokenIssuer.GenerateIDToken method
+func (mmGenerateIDToken *mTokenIssuerMockGenerateIDToken) Set(f func(user *models.User, code *models.AuthorizationCode, accessToken string) (s1 string, err error)) *TokenIssuerMock {
+	if mmGenerateIDToken.defaultExpectation != nil {
+		mmGenerateIDToken.mock.t.Fatalf("Default expectation is already set for the TokenIssuer.GenerateIDToken method")
+	}
+
+	if len(mmGenerateIDToken.expectations) > 0 {
+		mmGenerateIDToken.mock.t.Fatalf("Some expectations are already set for the TokenIssuer.GenerateIDToken method")
+	}
+
+	mmGenerateIDToken.mock.funcGenerateIDToken = f
+	mmGenerateIDToken.mock.funcGenerateIDTokenOrigin = minimock.CallerInfo(1)
+	return mmGenerateIDToken.mock
+}
+
+// When sets expectation for the TokenIssuer.GenerateIDToken which will trigger the result defined by the following
+// Then helper
+func (mmGenerateIDToken *mTokenIssuerMockGenerateIDToken) When(user *models.User, code *models.AuthorizationCode, accessToken string) *TokenIssuerMockGenerateIDTokenExpectation {
+	if mmGenerateIDToken.mock.funcGenerateIDToken != nil {
+		mmGenerateIDToken.mock.t.Fatalf("TokenIssuerMock.GenerateIDToken mock is already set by Set")
+	}
+
+	expectation := &TokenIssuerMockGenerateIDTokenExpectation{
+		mock:               mmGenerateIDToken.mock,
+		params:             &TokenIssuerMockGenerateIDTokenParams{user, code, accessToken},
+		expectationOrigins: TokenIssuerMockGenerateIDTokenExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGenerateIDToken.expectations = append(mmGenerateIDToken.expectations, expectation)
+	return expectation
+}
+
+// Then sets up TokenIssuer.GenerateIDToken return parameters for the expectation previously defined by the When method
+func (e *TokenIssuerMockGenerateIDTokenExpectation) Then(s1 string, err error) *TokenIssuerMock {
+	e.results = &TokenIssuerMockGenerateIDTokenResults{s1, err}
+	return e.mock
+}
+
+// Times sets number of times TokenIssuer.GenerateIDToken should be invoked
+func (mmGenerateIDToken *mTokenIssuerMockGenerateIDToken) Times(n uint64) *mTokenIssuerMockGenerateIDToken {
+	if n == 0 {
+		mmGenerateIDToken.mock.t.Fatalf("Times of TokenIssuerMock.GenerateIDToken mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGenerateIDToken.expectedInvocations, n)
+	mmGenerateIDToken.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGenerateIDToken
+}
+
+func (mmGenerateIDToken *mTokenIssuerMockGenerateIDToken) invocationsDone() bool {
+	if len(mmGenerateIDToken.expectations) == 0 && mmGenerateIDToken.defaultExpectation == nil && mmGenerateIDToken.mock.funcGenerateIDToken == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGenerateIDToken.mock.afterGenerateIDTokenCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGenerateIDToken.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GenerateIDToken implements TokenIssuer
+func (mmGenerateIDToken *TokenIssuerMock) GenerateIDToken(user *models.User, code *models.AuthorizationCode, accessToken string) (s1 string, err error) {
+	mm_atomic.AddUint64(&mmGenerateIDToken.beforeGenerateIDTokenCounter, 1)
+	defer mm_atomic.AddUint64(&mmGenerateIDToken.afterGenerateIDTokenCounter, 1)
+
+	mmGenerateIDToken.t.Helper()
+
+	if mmGenerateIDToken.inspectFuncGenerateIDToken != nil {
+		mmGenerateIDToken.inspectFuncGenerateIDToken(user, code, accessToken)
+	}
+
+	mm_params := TokenIssuerMockGenerateIDTokenParams{user, code, accessToken}
+
+	// Record call args
+	mmGenerateIDToken.GenerateIDTokenMock.mutex.Lock()
+	mmGenerateIDToken.GenerateIDTokenMock.callArgs = append(mmGenerateIDToken.GenerateIDTokenMock.callArgs, &mm_params)
+	mmGenerateIDToken.GenerateIDTokenMock.mutex.Unlock()
+
+	for _, e := range mmGenerateIDToken.GenerateIDTokenMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.s1, e.results.err
+		}
+	}
+
+	if mmGenerateIDToken.GenerateIDTokenMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGenerateIDToken.GenerateIDTokenMock.defaultExpectation.Counter, 1)
+		mm_want := mmGenerateIDToken.GenerateIDTokenMock.defaultExpectation.params
+		mm_want_ptrs := mmGenerateIDToken.GenerateIDTokenMock.defaultExpectation.paramPtrs
+
+		mm_got := TokenIssuerMockGenerateIDTokenParams{user, code, accessToken}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.user != nil && !minimock.Equal(*mm_want_ptrs.user, mm_got.user) {
+				mmGenerateIDToken.t.Errorf("TokenIssuerMock.GenerateIDToken got unexpected parameter user, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGenerateIDToken.GenerateIDTokenMock.defaultExpectation.expectationOrigins.originUser, *mm_want_ptrs.user, mm_got.user, minimock.Diff(*mm_want_ptrs.user, mm_got.user))
+			}
+
+			if mm_want_ptrs.code != nil && !minimock.Equal(*mm_want_ptrs.code, mm_got.code) {
+				mmGenerateIDToken.t.Errorf("TokenIssuerMock.GenerateIDToken got unexpected parameter code, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGenerateIDToken.GenerateIDTokenMock.defaultExpectation.expectationOrigins.originCode, *mm_want_ptrs.code, mm_got.code, minimock.Diff(*mm_want_ptrs.code, mm_got.code))
+			}
+
+			if mm_want_ptrs.accessToken != nil && !minimock.Equal(*mm_want_ptrs.accessToken, mm_got.accessToken) {
+				mmGenerateIDToken.t.Errorf("TokenIssuerMock.GenerateIDToken got unexpected parameter accessToken, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGenerateIDToken.GenerateIDTokenMock.defaultExpectation.expectationOrigins.originAccessToken, *mm_want_ptrs.accessToken, mm_got.accessToken, minimock.Diff(*mm_want_ptrs.accessToken, mm_got.accessToken))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGenerateIDToken.t.Errorf("TokenIssuerMock.GenerateIDToken got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGenerateIDToken.GenerateIDTokenMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGenerateIDToken.GenerateIDTokenMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGenerateIDToken.t.Fatal("No results are set for the TokenIssuerMock.GenerateIDToken")
+		}
+		return (*mm_results).s1, (*mm_results).err
+	}
+	if mmGenerateIDToken.funcGenerateIDToken != nil {
+		return mmGenerateIDToken.funcGenerateIDToken(user, code, accessToken)
+	}
+	mmGenerateIDToken.t.Fatalf("Unexpected call to TokenIssuerMock.GenerateIDToken. %v %v %v", user, code, accessToken)
+	return
+}
+
+// GenerateIDTokenAfterCounter returns a count of finished TokenIssuerMock.GenerateIDToken invocations
+func (mmGenerateIDToken *TokenIssuerMock) GenerateIDTokenAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGenerateIDToken.afterGenerateIDTokenCounter)
+}
+
+// GenerateIDTokenBeforeCounter returns a count of TokenIssuerMock.GenerateIDToken invocations
+func (mmGenerateIDToken *TokenIssuerMock) GenerateIDTokenBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGenerateIDToken.beforeGenerateIDTokenCounter)
+}
+
+// Calls returns a list of arguments used in each call to TokenIssuerMock.GenerateIDToken.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGenerateIDToken *mTokenIssuerMockGenerateIDToken) Calls() []*TokenIssuerMockGenerateIDTokenParams {
+	mmGenerateIDToken.mutex.RLock()
+
+	argCopy := make([]*TokenIssuerMockGenerateIDTokenParams, len(mmGenerateIDToken.callArgs))
+	copy(argCopy, mmGenerateIDToken.callArgs)
+
+	mmGenerateIDToken.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGenerateIDTokenDone returns true if the count of the GenerateIDToken invocations corresponds
+// the number of defined expectations
+func (m *TokenIssuerMock) MinimockGenerateIDTokenDone() bool {
+	if m.GenerateIDTokenMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GenerateIDTokenMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GenerateIDTokenMock.invocationsDone()
+}
+
+// MinimockGenerateIDTokenInspect logs each unmet expectation
+func (m *TokenIssuerMock) MinimockGenerateIDTokenInspect() {
+	for _, e := range m.GenerateIDTokenMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to TokenIssuerMock.GenerateIDToken at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGenerateIDTokenCounter := mm_atomic.LoadUint64(&m.afterGenerateIDTokenCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GenerateIDTokenMock.defaultExpectation != nil && afterGenerateIDTokenCounter < 1 {
+		if m.GenerateIDTokenMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to TokenIssuerMock.GenerateIDToken at\n%s", m.GenerateIDTokenMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to TokenIssuerMock.GenerateIDToken at\n%s with params: %#v", m.GenerateIDTokenMock.defaultExpectation.expectationOrigins.origin, *m.GenerateIDTokenMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGenerateIDToken != nil && afterGenerateIDTokenCounter < 1 {
+		m.t.Errorf("Expected call to TokenIssuerMock.GenerateIDToken at\n%s", m.funcGenerateIDTokenOrigin)
+	}
+
+	if !m.GenerateIDTokenMock.invocationsDone() && afterGenerateIDTokenCounter > 0 {
+		m.t.Errorf("Expected %d calls to TokenIssuerMock.GenerateIDToken at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GenerateIDTokenMock.expectedInvocations), m.GenerateIDTokenMock.expectedInvocationsOrigin, afterGenerateIDTokenCounter)
+	}
+}
+
+type mTokenIssuerMockGenerateScopedJWT struct {
+	optional           bool
+	mock               *TokenIssuerMock
+	defaultExpectation *TokenIssuerMockGenerateScopedJWTExpectation
+	expectations       []*TokenIssuerMockGenerateScopedJWTExpectation
+
+	callArgs []*TokenIssuerMockGenerateScopedJWTParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// TokenIssuerMockGenerateScopedJWTExpectation specifies expectation struct of the TokenIssuer.GenerateScopedJWT
+type TokenIssuerMockGenerateScopedJWTExpectation struct {
+	mock               *TokenIssuerMock
+	params             *TokenIssuerMockGenerateScopedJWTParams
+	paramPtrs          *TokenIssuerMockGenerateScopedJWTParamPtrs
+	expectationOrigins TokenIssuerMockGenerateScopedJWTExpectationOrigins
+	results            *TokenIssuerMockGenerateScopedJWTResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// TokenIssuerMockGenerateScopedJWTParams contains parameters of the TokenIssuer.GenerateScopedJWT
+type TokenIssuerMockGenerateScopedJWTParams struct {
+	user      *models.User
+	clientID  string
+	scope     string
+	sessionID string
+}
+
+// TokenIssuerMockGenerateScopedJWTParamPtrs contains pointers to parameters of the TokenIssuer.GenerateScopedJWT
+type TokenIssuerMockGenerateScopedJWTParamPtrs struct {
+	user      **models.User
+	clientID  *string
+	scope     *string
+	sessionID *string
+}
+
+// TokenIssuerMockGenerateScopedJWTResults contains results of the TokenIssuer.GenerateScopedJWT
+type TokenIssuerMockGenerateScopedJWTResults struct {
+	s1  string
+	err error
+}
+
+// TokenIssuerMockGenerateScopedJWTOrigins contains origins of expectations of the TokenIssuer.GenerateScopedJWT
+type TokenIssuerMockGenerateScopedJWTExpectationOrigins struct {
+	origin          string
+	originUser      string
+	originClientID  string
+	originScope     string
+	originSessionID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGenerateScopedJWT *mTokenIssuerMockGenerateScopedJWT) Optional() *mTokenIssuerMockGenerateScopedJWT {
+	mmGenerateScopedJWT.optional = true
+	return mmGenerateScopedJWT
+}
+
+// Expect sets up expected params for TokenIssuer.GenerateScopedJWT
+func (mmGenerateScopedJWT *mTokenIssuerMockGenerateScopedJWT) Expect(user *models.User, clientID string, scope string, sessionID string) *mTokenIssuerMockGenerateScopedJWT {
+	if mmGenerateScopedJWT.mock.funcGenerateScopedJWT != nil {
+		mmGenerateScopedJWT.mock.t.Fatalf("TokenIssuerMock.GenerateScopedJWT mock is already set by Set")
+	}
+
+	if mmGenerateScopedJWT.defaultExpectation == nil {
+		mmGenerateScopedJWT.defaultExpectation = &TokenIssuerMockGenerateScopedJWTExpectation{}
+	}
+
+	if mmGenerateScopedJWT.defaultExpectation.paramPtrs != nil {
+		mmGenerateScopedJWT.mock.t.Fatalf("TokenIssuerMock.GenerateScopedJWT mock is already set by ExpectParams functions")
+	}
+
+	mmGenerateScopedJWT.defaultExpectation.params = &TokenIssuerMockGenerateScopedJWTParams{user, clientID, scope, sessionID}
+	mmGenerateScopedJWT.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGenerateScopedJWT.expectations {
+		if minimock.Equal(e.params, mmGenerateScopedJWT.defaultExpectation.params) {
+			mmGenerateScopedJWT.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGenerateScopedJWT.defaultExpectation.params)
+		}
+	}
+
+	return mmGenerateScopedJWT
+}
+
+// ExpectUserParam1 sets up expected param user for TokenIssuer.GenerateScopedJWT
+func (mmGenerateScopedJWT *mTokenIssuerMockGenerateScopedJWT) ExpectUserParam1(user *models.User) *mTokenIssuerMockGenerateScopedJWT {
+	if mmGenerateScopedJWT.mock.funcGenerateScopedJWT != nil {
+		mmGenerateScopedJWT.mock.t.Fatalf("TokenIssuerMock.GenerateScopedJWT mock is already set by Set")
+	}
+
+	if mmGenerateScopedJWT.defaultExpectation == nil {
+		mmGenerateScopedJWT.defaultExpectation = &TokenIssuerMockGenerateScopedJWTExpectation{}
+	}
+
+	if mmGenerateScopedJWT.defaultExpectation.params != nil {
+		mmGenerateScopedJWT.mock.t.Fatalf("TokenIssuerMock.GenerateScopedJWT mock is already set by Expect")
+	}
+
+	if mmGenerateScopedJWT.defaultExpectation.paramPtrs == nil {
+		mmGenerateScopedJWT.defaultExpectation.paramPtrs = &TokenIssuerMockGenerateScopedJWTParamPtrs{}
+	}
+	mmGenerateScopedJWT.defaultExpectation.paramPtrs.user = &user
+	mmGenerateScopedJWT.defaultExpectation.expectationOrigins.originUser = minimock.CallerInfo(1)
+
+	return mmGenerateScopedJWT
+}
+
+// ExpectClientIDParam2 sets up expected param clientID for TokenIssuer.GenerateScopedJWT
+func (mmGenerateScopedJWT *mTokenIssuerMockGenerateScopedJWT) ExpectClientIDParam2(clientID string) *mTokenIssuerMockGenerateScopedJWT {
+	if mmGenerateScopedJWT.mock.funcGenerateScopedJWT != nil {
+		mmGenerateScopedJWT.mock.t.Fatalf("TokenIssuerMock.GenerateScopedJWT mock is already set by Set")
+	}
+
+	if mmGenerateScopedJWT.defaultExpectation == nil {
+		mmGenerateScopedJWT.defaultExpectation = &TokenIssuerMockGenerateScopedJWTExpectation{}
+	}
+
+	if mmGenerateScopedJWT.defaultExpectation.params != nil {
+		mmGenerateScopedJWT.mock.t.Fatalf("TokenIssuerMock.GenerateScopedJWT mock is already set by Expect")
+	}
+
+	if mmGenerateScopedJWT.defaultExpectation.paramPtrs == nil {
+		mmGenerateScopedJWT.defaultExpectation.paramPtrs = &TokenIssuerMockGenerateScopedJWTParamPtrs{}
+	}
+	mmGenerateScopedJWT.defaultExpectation.paramPtrs.clientID = &clientID
+	mmGenerateScopedJWT.defaultExpectation.expectationOrigins.originClientID = minimock.CallerInfo(1)
+
+	return mmGenerateScopedJWT
+}
+
+// ExpectScopeParam3 sets up expected param scope for TokenIssuer.GenerateScopedJWT
+func (mmGenerateScopedJWT *mTokenIssuerMockGenerateScopedJWT) ExpectScopeParam3(scope string) *mTokenIssuerMockGenerateScopedJWT {
+	if mmGenerateScopedJWT.mock.funcGenerateScopedJWT != nil {
+		mmGenerateScopedJWT.mock.t.Fatalf("TokenIssuerMock.GenerateScopedJWT mock is already set by Set")
+	}
+
+	if mmGenerateScopedJWT.defaultExpectation == nil {
+		mmGenerateScopedJWT.defaultExpectation = &TokenIssuerMockGenerateScopedJWTExpectation{}
+	}
+
+	if mmGenerateScopedJWT.defaultExpectation.params != nil {
+		mmGenerateScopedJWT.mock.t.Fatalf("TokenIssuerMock.GenerateScopedJWT mock is already set by Expect")
+	}
+
+	if mmGenerateScopedJWT.defaultExpectation.paramPtrs == nil {
+		mmGenerateScopedJWT.defaultExpectation.paramPtrs = &TokenIssuerMockGenerateScopedJWTParamPtrs{}
+	}
+	mmGenerateScopedJWT.defaultExpectation.paramPtrs.scope = &scope
+	mmGenerateScopedJWT.defaultExpectation.expectationOrigins.originScope = minimock.CallerInfo(1)
+
+	return mmGenerateScopedJWT
+}
+
+// ExpectSessionIDParam4 sets up expected param sessionID for TokenIssuer.GenerateScopedJWT
+func (mmGenerateScopedJWT *mTokenIssuerMockGenerateScopedJWT) ExpectSessionIDParam4(sessionID string) *mTokenIssuerMockGenerateScopedJWT {
+	if mmGenerateScopedJWT.mock.funcGenerateScopedJWT != nil {
+		mmGenerateScopedJWT.mock.t.Fatalf("TokenIssuerMock.GenerateScopedJWT mock is already set by Set")
+	}
+
+	if mmGenerateScopedJWT.defaultExpectation == nil {
+		mmGenerateScopedJWT.defaultExpectation = &TokenIssuerMockGenerateScopedJWTExpectation{}
+	}
+
+	if mmGenerateScopedJWT.defaultExpectation.params != nil {
+		mmGenerateScopedJWT.mock.t.Fatalf("TokenIssuerMock.GenerateScopedJWT mock is already set by Expect")
+	}
+
+	if mmGenerateScopedJWT.defaultExpectation.paramPtrs == nil {
+		mmGenerateScopedJWT.defaultExpectation.paramPtrs = &TokenIssuerMockGenerateScopedJWTParamPtrs{}
+	}
+	mmGenerateScopedJWT.defaultExpectation.paramPtrs.sessionID = &sessionID
+	mmGenerateScopedJWT.defaultExpectation.expectationOrigins.originSessionID = minimock.CallerInfo(1)
+
+	return mmGenerateScopedJWT
+}
+
+// Inspect accepts an inspector function that has same arguments as the TokenIssuer.GenerateScopedJWT
+func (mmGenerateScopedJWT *mTokenIssuerMockGenerateScopedJWT) Inspect(f func(user *models.User, clientID string, scope string, sessionID string)) *mTokenIssuerMockGenerateScopedJWT {
+	if mmGenerateScopedJWT.mock.inspectFuncGenerateScopedJWT != nil {
+		mmGenerateScopedJWT.mock.t.Fatalf("Inspect function is already set for TokenIssuerMock.GenerateScopedJWT")
+	}
+
+	mmGenerateScopedJWT.mock.inspectFuncGenerateScopedJWT = f
+
+	return mmGenerateScopedJWT
+}
+
+// Return sets up results that will be returned by TokenIssuer.GenerateScopedJWT
+func (mmGenerateScopedJWT *mTokenIssuerMockGenerateScopedJWT) Return(s1 string, err error) *TokenIssuerMock {
+	if mmGenerateScopedJWT.mock.funcGenerateScopedJWT != nil {
+		mmGenerateScopedJWT.mock.t.Fatalf("TokenIssuerMock.GenerateScopedJWT mock is already set by Set")
+	}
+
+	if mmGenerateScopedJWT.defaultExpectation == nil {
+		mmGenerateScopedJWT.defaultExpectation = &TokenIssuerMockGenerateScopedJWTExpectation{mock: mmGenerateScopedJWT.mock}
+	}
+	mmGenerateScopedJWT.defaultExpectation.results = &TokenIssuerMockGenerateScopedJWTResults{s1, err}
+	mmGenerateScopedJWT.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGenerateScopedJWT.mock
+}
+
+// Set uses given function f to mock the TokenIssuer.GenerateScopedJWT method
+func (mmGenerateScopedJWT *mTokenIssuerMockGenerateScopedJWT) Set(f func(user *models.User, clientID string, scope string, sessionID string) (s1 string, err error)) *TokenIssuerMock {
+	if mmGenerateScopedJWT.defaultExpectation != nil {
+		mmGenerateScopedJWT.mock.t.Fatalf("Default expectation is already set for the TokenIssuer.GenerateScopedJWT method")
+	}
+
+	if len(mmGenerateScopedJWT.expectations) > 0 {
+		mmGenerateScopedJWT.mock.t.Fatalf("Some expectations are already set for the TokenIssuer.GenerateScopedJWT method")
+	}
+
+	mmGenerateScopedJWT.mock.funcGenerateScopedJWT = f
+	mmGenerateScopedJWT.mock.funcGenerateScopedJWTOrigin = minimock.CallerInfo(1)
+	return mmGenerateScopedJWT.mock
+}
+
+// When sets expectation for the TokenIssuer.GenerateScopedJWT which will trigger the result defined by the following
+// Then helper
+func (mmGenerateScopedJWT *mTokenIssuerMockGenerateScopedJWT) When(user *models.User, clientID string, scope string, sessionID string) *TokenIssuerMockGenerateScopedJWTExpectation {
+	if mmGenerateScopedJWT.mock.funcGenerateScopedJWT != nil {
+		mmGenerateScopedJWT.mock.t.Fatalf("TokenIssuerMock.GenerateScopedJWT mock is already set by Set")
+	}
+
+	expectation := &TokenIssuerMockGenerateScopedJWTExpectation{
+		mock:               mmGenerateScopedJWT.mock,
+		params:             &TokenIssuerMockGenerateScopedJWTParams{user, clientID, scope, sessionID},
+		expectationOrigins: TokenIssuerMockGenerateScopedJWTExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGenerateScopedJWT.expectations = append(mmGenerateScopedJWT.expectations, expectation)
+	return expectation
+}
+
+// Then sets up TokenIssuer.GenerateScopedJWT return parameters for the expectation previously defined by the When method
+func (e *TokenIssuerMockGenerateScopedJWTExpectation) Then(s1 string, err error) *TokenIssuerMock {
+	e.results = &TokenIssuerMockGenerateScopedJWTResults{s1, err}
+	return e.mock
+}
+
+// Times sets number of times TokenIssuer.GenerateScopedJWT should be invoked
+func (mmGenerateScopedJWT *mTokenIssuerMockGenerateScopedJWT) Times(n uint64) *mTokenIssuerMockGenerateScopedJWT {
+	if n == 0 {
+		mmGenerateScopedJWT.mock.t.Fatalf("Times of TokenIssuerMock.GenerateScopedJWT mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGenerateScopedJWT.expectedInvocations, n)
+	mmGenerateScopedJWT.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGenerateScopedJWT
+}
+
+func (mmGenerateScopedJWT *mTokenIssuerMockGenerateScopedJWT) invocationsDone() bool {
+	if len(mmGenerateScopedJWT.expectations) == 0 && mmGenerateScopedJWT.defaultExpectation == nil && mmGenerateScopedJWT.mock.funcGenerateScopedJWT == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGenerateScopedJWT.mock.afterGenerateScopedJWTCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGenerateScopedJWT.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GenerateScopedJWT implements TokenIssuer
+func (mmGenerateScopedJWT *TokenIssuerMock) GenerateScopedJWT(user *models.User, clientID string, scope string, sessionID string) (s1 string, err error) {
+	mm_atomic.AddUint64(&mmGenerateScopedJWT.beforeGenerateScopedJWTCounter, 1)
+	defer mm_atomic.AddUint64(&mmGenerateScopedJWT.afterGenerateScopedJWTCounter, 1)
+
+	mmGenerateScopedJWT.t.Helper()
+
+	if mmGenerateScopedJWT.inspectFuncGenerateScopedJWT != nil {
+		mmGenerateScopedJWT.inspectFuncGenerateScopedJWT(user, clientID, scope, sessionID)
+	}
+
+	mm_params := TokenIssuerMockGenerateScopedJWTParams{user, clientID, scope, sessionID}
+
+	// Record call args
+	mmGenerateScopedJWT.GenerateScopedJWTMock.mutex.Lock()
+	mmGenerateScopedJWT.GenerateScopedJWTMock.callArgs = append(mmGenerateScopedJWT.GenerateScopedJWTMock.callArgs, &mm_params)
+	mmGenerateScopedJWT.GenerateScopedJWTMock.mutex.Unlock()
+
+	for _, e := range mmGenerateScopedJWT.GenerateScopedJWTMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.s1, e.results.err
+		}
+	}
+
+	if mmGenerateScopedJWT.GenerateScopedJWTMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGenerateScopedJWT.GenerateScopedJWTMock.defaultExpectation.Counter, 1)
+		mm_want := mmGenerateScopedJWT.GenerateScopedJWTMock.defaultExpectation.params
+		mm_want_ptrs := mmGenerateScopedJWT.GenerateScopedJWTMock.defaultExpectation.paramPtrs
+
+		mm_got := TokenIssuerMockGenerateScopedJWTParams{user, clientID, scope, sessionID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.user != nil && !minimock.Equal(*mm_want_ptrs.user, mm_got.user) {
+				mmGenerateScopedJWT.t.Errorf("TokenIssuerMock.GenerateScopedJWT got unexpected parameter user, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGenerateScopedJWT.GenerateScopedJWTMock.defaultExpectation.expectationOrigins.originUser, *mm_want_ptrs.user, mm_got.user, minimock.Diff(*mm_want_ptrs.user, mm_got.user))
+			}
+
+			if mm_want_ptrs.clientID != nil && !minimock.Equal(*mm_want_ptrs.clientID, mm_got.clientID) {
+				mmGenerateScopedJWT.t.Errorf("TokenIssuerMock.GenerateScopedJWT got unexpected parameter clientID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGenerateScopedJWT.GenerateScopedJWTMock.defaultExpectation.expectationOrigins.originClientID, *mm_want_ptrs.clientID, mm_got.clientID, minimock.Diff(*mm_want_ptrs.clientID, mm_got.clientID))
+			}
+
+			if mm_want_ptrs.scope != nil && !minimock.Equal(*mm_want_ptrs.scope, mm_got.scope) {
+				mmGenerateScopedJWT.t.Errorf("TokenIssuerMock.GenerateScopedJWT got unexpected parameter scope, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGenerateScopedJWT.GenerateScopedJWTMock.defaultExpectation.expectationOrigins.originScope, *mm_want_ptrs.scope, mm_got.scope, minimock.Diff(*mm_want_ptrs.scope, mm_got.scope))
+			}
+
+			if mm_want_ptrs.sessionID != nil && !minimock.Equal(*mm_want_ptrs.sessionID, mm_got.sessionID) {
+				mmGenerateScopedJWT.t.Errorf("TokenIssuerMock.GenerateScopedJWT got unexpected parameter sessionID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGenerateScopedJWT.GenerateScopedJWTMock.defaultExpectation.expectationOrigins.originSessionID, *mm_want_ptrs.sessionID, mm_got.sessionID, minimock.Diff(*mm_want_ptrs.sessionID, mm_got.sessionID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGenerateScopedJWT.t.Errorf("TokenIssuerMock.GenerateScopedJWT got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGenerateScopedJWT.GenerateScopedJWTMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGenerateScopedJWT.GenerateScopedJWTMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGenerateScopedJWT.t.Fatal("No results are set for the TokenIssuerMock.GenerateScopedJWT")
+		}
+		return (*mm_results).s1, (*mm_results).err
+	}
+	if mmGenerateScopedJWT.funcGenerateScopedJWT != nil {
+		return mmGenerateScopedJWT.funcGenerateScopedJWT(user, clientID, scope, sessionID)
+	}
+	mmGenerateScopedJWT.t.Fatalf("Unexpected call to TokenIssuerMock.GenerateScopedJWT. %v %v %v %v", user, clientID, scope, sessionID)
+	return
+}
+
+// GenerateScopedJWTAfterCounter returns a count of finished TokenIssuerMock.GenerateScopedJWT invocations
+func (mmGenerateScopedJWT *TokenIssuerMock) GenerateScopedJWTAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGenerateScopedJWT.afterGenerateScopedJWTCounter)
+}
+
+// GenerateScopedJWTBeforeCounter returns a count of TokenIssuerMock.GenerateScopedJWT invocations
+func (mmGenerateScopedJWT *TokenIssuerMock) GenerateScopedJWTBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGenerateScopedJWT.beforeGenerateScopedJWTCounter)
+}
+
+// Calls returns a list of arguments used in each call to TokenIssuerMock.GenerateScopedJWT.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGenerateScopedJWT *mTokenIssuerMockGenerateScopedJWT) Calls() []*TokenIssuerMockGenerateScopedJWTParams {
+	mmGenerateScopedJWT.mutex.RLock()
+
+	argCopy := make([]*TokenIssuerMockGenerateScopedJWTParams, len(mmGenerateScopedJWT.callArgs))
+	copy(argCopy, mmGenerateScopedJWT.callArgs)
+
+	mmGenerateScopedJWT.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGenerateScopedJWTDone returns true if the count of the GenerateScopedJWT invocations corresponds
+// the number of defined expectations
+func (m *TokenIssuerMock) MinimockGenerateScopedJWTDone() bool {
+	if m.GenerateScopedJWTMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GenerateScopedJWTMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GenerateScopedJWTMock.invocationsDone()
+}
+
+// MinimockGenerateScopedJWTInspect logs each unmet expectation
+func (m *TokenIssuerMock) MinimockGenerateScopedJWTInspect() {
+	for _, e := range m.GenerateScopedJWTMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to TokenIssuerMock.GenerateScopedJWT at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGenerateScopedJWTCounter := mm_atomic.LoadUint64(&m.afterGenerateScopedJWTCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GenerateScopedJWTMock.defaultExpectation != nil && afterGenerateScopedJWTCounter < 1 {
+		if m.GenerateScopedJWTMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to TokenIssuerMock.GenerateScopedJWT at\n%s", m.GenerateScopedJWTMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to TokenIssuerMock.GenerateScopedJWT at\n%s with params: %#v", m.GenerateScopedJWTMock.defaultExpectation.expectationOrigins.origin, *m.GenerateScopedJWTMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGenerateScopedJWT != nil && afterGenerateScopedJWTCounter < 1 {
+		m.t.Errorf("Expected call to TokenIssuerMock.GenerateScopedJWT at\n%s", m.funcGenerateScopedJWTOrigin)
+	}
+
+	if !m.GenerateScopedJWTMock.invocationsDone() && afterGenerateScopedJWTCounter > 0 {
+		m.t.Errorf("Expected %d calls to TokenIssuerMock.GenerateScopedJWT at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GenerateScopedJWTMock.expectedInvocations), m.GenerateScopedJWTMock.expectedInvocationsOrigin, afterGenerateScopedJWTCounter)
+	}
+}
+
+type mTokenIssuerMockParseIDToken struct {
+	optional           bool
+	mock               *TokenIssuerMock
+	defaultExpectation *TokenIssuerMockParseIDTokenExpectation
+	expectations       []*TokenIssuerMockParseIDTokenExpectation
+
+	callArgs []*TokenIssuerMockParseIDTokenParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// TokenIssuerMockParseIDTokenExpectation specifies expectation struct of the TokenIssuer.ParseIDToken
+type TokenIssuerMockParseIDTokenExpectation struct {
+	mock               *TokenIssuerMock
+	params             *TokenIssuerMockParseIDTokenParams
+	paramPtrs          *TokenIssuerMockParseIDTokenParamPtrs
+	expectationOrigins TokenIssuerMockParseIDTokenExpectationOrigins
+	results            *TokenIssuerMockParseIDTokenResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// TokenIssuerMockParseIDTokenParams contains parameters of the TokenIssuer.ParseIDToken
+type TokenIssuerMockParseIDTokenParams struct {
+	tokenString string
+}
+
+// TokenIssuerMockParseIDTokenParamPtrs contains pointers to parameters of the TokenIssuer.ParseIDToken
+type TokenIssuerMockParseIDTokenParamPtrs struct {
+	tokenString *string
+}
+
+// TokenIssuerMockParseIDTokenResults contains results of the TokenIssuer.ParseIDToken
+type TokenIssuerMockParseIDTokenResults struct {
+	ip1 *models.IDTokenClaims
+	err error
+}
+
+// TokenIssuerMockParseIDTokenOrigins contains origins of expectations of the TokenIssuer.ParseIDToken
+type TokenIssuerMockParseIDTokenExpectationOrigins struct {
+	origin            string
+	originTokenString string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmParseIDToken *mTokenIssuerMockParseIDToken) Optional() *mTokenIssuerMockParseIDToken {
+	mmParseIDToken.optional = true
+	return mmParseIDToken
+}
+
+// Expect sets up expected params for TokenIssuer.ParseIDToken
+func (mmParseIDToken *mTokenIssuerMockParseIDToken) Expect(tokenString string) *mTokenIssuerMockParseIDToken {
+	if mmParseIDToken.mock.funcParseIDToken != nil {
+		mmParseIDToken.mock.t.Fatalf("TokenIssuerMock.ParseIDToken mock is already set by Set")
+	}
+
+	if mmParseIDToken.defaultExpectation == nil {
+		mmParseIDToken.defaultExpectation = &TokenIssuerMockParseIDTokenExpectation{}
+	}
+
+	if mmParseIDToken.defaultExpectation.paramPtrs != nil {
+		mmParseIDToken.mock.t.Fatalf("TokenIssuerMock.ParseIDToken mock is already set by ExpectParams functions")
+	}
+
+	mmParseIDToken.defaultExpectation.params = &TokenIssuerMockParseIDTokenParams{tokenString}
+	mmParseIDToken.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmParseIDToken.expectations {
+		if minimock.Equal(e.params, mmParseIDToken.defaultExpectation.params) {
+			mmParseIDToken.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmParseIDToken.defaultExpectation.params)
+		}
+	}
+
+	return mmParseIDToken
+}
+
+// ExpectTokenStringParam1 sets up expected param tokenString for TokenIssuer.ParseIDToken
+func (mmParseIDToken *mTokenIssuerMockParseIDToken) ExpectTokenStringParam1(tokenString string) *mTokenIssuerMockParseIDToken {
+	if mmParseIDToken.mock.funcParseIDToken != nil {
+		mmParseIDToken.mock.t.Fatalf("TokenIssuerMock.ParseIDToken mock is already set by Set")
+	}
+
+	if mmParseIDToken.defaultExpectation == nil {
+		mmParseIDToken.defaultExpectation = &TokenIssuerMockParseIDTokenExpectation{}
+	}
+
+	if mmParseIDToken.defaultExpectation.params != nil {
+		mmParseIDToken.mock.t.Fatalf("TokenIssuerMock.ParseIDToken mock is already set by Expect")
+	}
+
+	if mmParseIDToken.defaultExpectation.paramPtrs == nil {
+		mmParseIDToken.defaultExpectation.paramPtrs = &TokenIssuerMockParseIDTokenParamPtrs{}
+	}
+	mmParseIDToken.defaultExpectation.paramPtrs.tokenString = &tokenString
+	mmParseIDToken.defaultExpectation.expectationOrigins.originTokenString = minimock.CallerInfo(1)
+
+	return mmParseIDToken
+}
+
+// Inspect accepts an inspector function that has same arguments as the TokenIssuer.ParseIDToken
+func (mmParseIDToken *mTokenIssuerMockParseIDToken) Inspect(f func(tokenString string)) *mTokenIssuerMockParseIDToken {
+	if mmParseIDToken.mock.inspectFuncParseIDToken != nil {
+		mmParseIDToken.mock.t.Fatalf("Inspect function is already set for TokenIssuerMock.ParseIDToken")
+	}
+
+	mmParseIDToken.mock.inspectFuncParseIDToken = f
+
+	return mmParseIDToken
+}
+
+// Return sets up results that will be returned by TokenIssuer.ParseIDToken
+func (mmParseIDToken *mTokenIssuerMockParseIDToken) Return(ip1 *models.IDTokenClaims, err error) *TokenIssuerMock {
+	if mmParseIDToken.mock.funcParseIDToken != nil {
+		mmParseIDToken.mock.t.Fatalf("TokenIssuerMock.ParseIDToken mock is already set by Set")
+	}
+
+	if mmParseIDToken.defaultExpectation == nil {
+		mmParseIDToken.defaultExpectation = &TokenIssuerMockParseIDTokenExpectation{mock: mmParseIDToken.mock}
+	}
+	mmParseIDToken.defaultExpectation.results = &TokenIssuerMockParseIDTokenResults{ip1, err}
+	mmParseIDToken.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmParseIDToken.mock
+}
+
+// Set uses given function f to mock the TokenIssuer.ParseIDToken method
+func (mmParseIDToken *mTokenIssuerMockParseIDToken) Set(f func(tokenString string) (ip1 *models.IDTokenClaims, err error)) *TokenIssuerMock {
+	if mmParseIDToken.defaultExpectation != nil {
+		mmParseIDToken.mock.t.Fatalf("Default expectation is already set for the TokenIssuer.ParseIDToken method")
+	}
+
+	if len(mmParseIDToken.expectations) > 0 {
+		mmParseIDToken.mock.t.Fatalf("Some expectations are already set for the TokenIssuer.ParseIDToken method")
+	}
+
+	mmParseIDToken.mock.funcParseIDToken = f
+	mmParseIDToken.mock.funcParseIDTokenOrigin = minimock.CallerInfo(1)
+	return mmParseIDToken.mock
+}
+
+// When sets expectation for the TokenIssuer.ParseIDToken which will trigger the result defined by the following
+// Then helper
+func (mmParseIDToken *mTokenIssuerMockParseIDToken) When(tokenString string) *TokenIssuerMockParseIDTokenExpectation {
+	if mmParseIDToken.mock.funcParseIDToken != nil {
+		mmParseIDToken.mock.t.Fatalf("TokenIssuerMock.ParseIDToken mock is already set by Set")
+	}
+
+	expectation := &TokenIssuerMockParseIDTokenExpectation{
+		mock:               mmParseIDToken.mock,
+		params:             &TokenIssuerMockParseIDTokenParams{tokenString},
+		expectationOrigins: TokenIssuerMockParseIDTokenExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmParseIDToken.expectations = append(mmParseIDToken.expectations, expectation)
+	return expectation
+}
+
+// Then sets up TokenIssuer.ParseIDToken return parameters for the expectation previously defined by the When method
+func (e *TokenIssuerMockParseIDTokenExpectation) Then(ip1 *models.IDTokenClaims, err error) *TokenIssuerMock {
+	e.results = &TokenIssuerMockParseIDTokenResults{ip1, err}
+	return e.mock
+}
+
+// Times sets number of times TokenIssuer.ParseIDToken should be invoked
+func (mmParseIDToken *mTokenIssuerMockParseIDToken) Times(n uint64) *mTokenIssuerMockParseIDToken {
+	if n == 0 {
+		mmParseIDToken.mock.t.Fatalf("Times of TokenIssuerMock.ParseIDToken mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmParseIDToken.expectedInvocations, n)
+	mmParseIDToken.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmParseIDToken
+}
+
+func (mmParseIDToken *mTokenIssuerMockParseIDToken) invocationsDone() bool {
+	if len(mmParseIDToken.expectations) == 0 && mmParseIDToken.defaultExpectation == nil && mmParseIDToken.mock.funcParseIDToken == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmParseIDToken.mock.afterParseIDTokenCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmParseIDToken.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ParseIDToken implements TokenIssuer
+func (mmParseIDToken *TokenIssuerMock) ParseIDToken(tokenString string) (ip1 *models.IDTokenClaims, err error) {
+	mm_atomic.AddUint64(&mmParseIDToken.beforeParseIDTokenCounter, 1)
+	defer mm_atomic.AddUint64(&mmParseIDToken.afterParseIDTokenCounter, 1)
+
+	mmParseIDToken.t.Helper()
+
+	if mmParseIDToken.inspectFuncParseIDToken != nil {
+		mmParseIDToken.inspectFuncParseIDToken(tokenString)
+	}
+
+	mm_params := TokenIssuerMockParseIDTokenParams{tokenString}
+
+	// Record call args
+	mmParseIDToken.ParseIDTokenMock.mutex.Lock()
+	mmParseIDToken.ParseIDTokenMock.callArgs = append(mmParseIDToken.ParseIDTokenMock.callArgs, &mm_params)
+	mmParseIDToken.ParseIDTokenMock.mutex.Unlock()
+
+	for _, e := range mmParseIDToken.ParseIDTokenMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ip1, e.results.err
+		}
+	}
+
+	if mmParseIDToken.ParseIDTokenMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmParseIDToken.ParseIDTokenMock.defaultExpectation.Counter, 1)
+		mm_want := mmParseIDToken.ParseIDTokenMock.defaultExpectation.params
+		mm_want_ptrs := mmParseIDToken.ParseIDTokenMock.defaultExpectation.paramPtrs
+
+		mm_got := TokenIssuerMockParseIDTokenParams{tokenString}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.tokenString != nil && !minimock.Equal(*mm_want_ptrs.tokenString, mm_got.tokenString) {
+				mmParseIDToken.t.Errorf("TokenIssuerMock.ParseIDToken got unexpected parameter tokenString, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmParseIDToken.ParseIDTokenMock.defaultExpectation.expectationOrigins.originTokenString, *mm_want_ptrs.tokenString, mm_got.tokenString, minimock.Diff(*mm_want_ptrs.tokenString, mm_got.tokenString))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmParseIDToken.t.Errorf("TokenIssuerMock.ParseIDToken got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmParseIDToken.ParseIDTokenMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmParseIDToken.ParseIDTokenMock.defaultExpectation.results
+		if mm_results == nil {
+			mmParseIDToken.t.Fatal("No results are set for the TokenIssuerMock.ParseIDToken")
+		}
+		return (*mm_results).ip1, (*mm_results).err
+	}
+	if mmParseIDToken.funcParseIDToken != nil {
+		return mmParseIDToken.funcParseIDToken(tokenString)
+	}
+	mmParseIDToken.t.Fatalf("Unexpected call to TokenIssuerMock.ParseIDToken. %v", tokenString)
+	return
+}
+
+// ParseIDTokenAfterCounter returns a count of finished TokenIssuerMock.ParseIDToken invocations
+func (mmParseIDToken *TokenIssuerMock) ParseIDTokenAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmParseIDToken.afterParseIDTokenCounter)
+}
+
+// ParseIDTokenBeforeCounter returns a count of TokenIssuerMock.ParseIDToken invocations
+func (mmParseIDToken *TokenIssuerMock) ParseIDTokenBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmParseIDToken.beforeParseIDTokenCounter)
+}
+
+// Calls returns a list of arguments used in each call to TokenIssuerMock.ParseIDToken.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmParseIDToken *mTokenIssuerMockParseIDToken) Calls() []*TokenIssuerMockParseIDTokenParams {
+	mmParseIDToken.mutex.RLock()
+
+	argCopy := make([]*TokenIssuerMockParseIDTokenParams, len(mmParseIDToken.callArgs))
+	copy(argCopy, mmParseIDToken.callArgs)
+
+	mmParseIDToken.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockParseIDTokenDone returns true if the count of the ParseIDToken invocations corresponds
+// the number of defined expectations
+func (m *TokenIssuerMock) MinimockParseIDTokenDone() bool {
+	if m.ParseIDTokenMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ParseIDTokenMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ParseIDTokenMock.invocationsDone()
+}
+
+// MinimockParseIDTokenInspect logs each unmet expectation
+func (m *TokenIssuerMock) MinimockParseIDTokenInspect() {
+	for _, e := range m.ParseIDTokenMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to TokenIssuerMock.ParseIDToken at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterParseIDTokenCounter := mm_atomic.LoadUint64(&m.afterParseIDTokenCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ParseIDTokenMock.defaultExpectation != nil && afterParseIDTokenCounter < 1 {
+		if m.ParseIDTokenMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to TokenIssuerMock.ParseIDToken at\n%s", m.ParseIDTokenMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to TokenIssuerMock.ParseIDToken at\n%s with params: %#v", m.ParseIDTokenMock.defaultExpectation.expectationOrigins.origin, *m.ParseIDTokenMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcParseIDToken != nil && afterParseIDTokenCounter < 1 {
+		m.t.Errorf("Expected call to TokenIssuerMock.ParseIDToken at\n%s", m.funcParseIDTokenOrigin)
+	}
+
+	if !m.ParseIDTokenMock.invocationsDone() && afterParseIDTokenCounter > 0 {
+		m.t.Errorf("Expected %d calls to TokenIssuerMock.ParseIDToken at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ParseIDTokenMock.expectedInvocations), m.ParseIDTokenMock.expectedInvocationsOrigin, afterParseIDTokenCounter)
+	}
+}
+
+type mTokenIssuerMockValidateSessionToken struct {
+	optional           bool
+	mock               *TokenIssuerMock
+	defaultExpectation *TokenIssuerMockValidateSessionTokenExpectation
+	expectations       []*TokenIssuerMockValidateSessionTokenExpectation
+
+	callArgs []*TokenIssuerMockValidateSessionTokenParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// TokenIssuerMockValidateSessionTokenExpectation specifies expectation struct of the TokenIssuer.ValidateSessionToken
+type TokenIssuerMockValidateSessionTokenExpectation struct {
+	mock               *TokenIssuerMock
+	params             *TokenIssuerMockValidateSessionTokenParams
+	paramPtrs          *TokenIssuerMockValidateSessionTokenParamPtrs
+	expectationOrigins TokenIssuerMockValidateSessionTokenExpectationOrigins
+	results            *TokenIssuerMockValidateSessionTokenResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// TokenIssuerMockValidateSessionTokenParams contains parameters of the TokenIssuer.ValidateSessionToken
+type TokenIssuerMockValidateSessionTokenParams struct {
+	ctx         context.Context
+	tokenString string
+}
+
+// TokenIssuerMockValidateSessionTokenParamPtrs contains pointers to parameters of the TokenIssuer.ValidateSessionToken
+type TokenIssuerMockValidateSessionTokenParamPtrs struct {
+	ctx         *context.Context
+	tokenString *string
+}
+
+// TokenIssuerMockValidateSessionTokenResults contains results of the TokenIssuer.ValidateSessionToken
+type TokenIssuerMockValidateSessionTokenResults struct {
+	cp1 *models.Claims
+	err error
+}
+
+// TokenIssuerMockValidateSessionTokenOrigins contains origins of expectations of the TokenIssuer.ValidateSessionToken
+type TokenIssuerMockValidateSessionTokenExpectationOrigins struct {
+	origin            string
+	originCtx         string
+	originTokenString string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmValidateSessionToken *mTokenIssuerMockValidateSessionToken) Optional() *mTokenIssuerMockValidateSessionToken {
+	mmValidateSessionToken.optional = true
+	return mmValidateSessionToken
+}
+
+// Expect sets up expected params for TokenIssuer.ValidateSessionToken
+func (mmValidateSessionToken *mTokenIssuerMockValidateSessionToken) Expect(ctx context.Context, tokenString string) *mTokenIssuerMockValidateSessionToken {
+	if mmValidateSessionToken.mock.funcValidateSessionToken != nil {
+		mmValidateSessionToken.mock.t.Fatalf("TokenIssuerMock.ValidateSessionToken mock is already set by Set")
+	}
+
+	if mmValidateSessionToken.defaultExpectation == nil {
+		mmValidateSessionToken.defaultExpectation = &TokenIssuerMockValidateSessionTokenExpectation{}
+	}
+
+	if mmValidateSessionToken.defaultExpectation.paramPtrs != nil {
+		mmValidateSessionToken.mock.t.Fatalf("TokenIssuerMock.ValidateSessionToken mock is already set by ExpectParams functions")
+	}
+
+	mmValidateSessionToken.defaultExpectation.params = &TokenIssuerMockValidateSessionTokenParams{ctx, tokenString}
+	mmValidateSessionToken.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmValidateSessionToken.expectations {
+		if minimock.Equal(e.params, mmValidateSessionToken.defaultExpectation.params) {
+			mmValidateSessionToken.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmValidateSessionToken.defaultExpectation.params)
+		}
+	}
+
+	return mmValidateSessionToken
+}
+
+// ExpectCtxParam1 sets up expected param ctx for TokenIssuer.ValidateSessionToken
+func (mmValidateSessionToken *mTokenIssuerMockValidateSessionToken) ExpectCtxParam1(ctx context.Context) *mTokenIssuerMockValidateSessionToken {
+	if mmValidateSessionToken.mock.funcValidateSessionToken != nil {
+		mmValidateSessionToken.mock.t.Fatalf("TokenIssuerMock.ValidateSessionToken mock is already set by Set")
+	}
+
+	if mmValidateSessionToken.defaultExpectation == nil {
+		mmValidateSessionToken.defaultExpectation = &TokenIssuerMockValidateSessionTokenExpectation{}
+	}
+
+	if mmValidateSessionToken.defaultExpectation.params != nil {
+		mmValidateSessionToken.mock.t.Fatalf("TokenIssuerMock.ValidateSessionToken mock is already set by Expect")
+	}
+
+	if mmValidateSessionToken.defaultExpectation.paramPtrs == nil {
+		mmValidateSessionToken.defaultExpectation.paramPtrs = &TokenIssuerMockValidateSessionTokenParamPtrs{}
+	}
+	mmValidateSessionToken.defaultExpectation.paramPtrs.ctx = &ctx
+	mmValidateSessionToken.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmValidateSessionToken
+}
+
+// ExpectTokenStringParam2 sets up expected param tokenString for TokenIssuer.ValidateSessionToken
+func (mmValidateSessionToken *mTokenIssuerMockValidateSessionToken) ExpectTokenStringParam2(tokenString string) *mTokenIssuerMockValidateSessionToken {
+	if mmValidateSessionToken.mock.funcValidateSessionToken != nil {
+		mmValidateSessionToken.mock.t.Fatalf("TokenIssuerMock.ValidateSessionToken mock is already set by Set")
+	}
+
+	if mmValidateSessionToken.defaultExpectation == nil {
+		mmValidateSessionToken.defaultExpectation = &TokenIssuerMockValidateSessionTokenExpectation{}
+	}
+
+	if mmValidateSessionToken.defaultExpectation.params != nil {
+		mmValidateSessionToken.mock.t.Fatalf("TokenIssuerMock.ValidateSessionToken mock is already set by Expect")
+	}
+
+	if mmValidateSessionToken.defaultExpectation.paramPtrs == nil {
+		mmValidateSessionToken.defaultExpectation.paramPtrs = &TokenIssuerMockValidateSessionTokenParamPtrs{}
+	}
+	mmValidateSessionToken.defaultExpectation.paramPtrs.tokenString = &tokenString
+	mmValidateSessionToken.defaultExpectation.expectationOrigins.originTokenString = minimock.CallerInfo(1)
+
+	return mmValidateSessionToken
+}
+
+// Inspect accepts an inspector function that has same arguments as the TokenIssuer.ValidateSessionToken
+func (mmValidateSessionToken *mTokenIssuerMockValidateSessionToken) Inspect(f func(ctx context.Context, tokenString string)) *mTokenIssuerMockValidateSessionToken {
+	if mmValidateSessionToken.mock.inspectFuncValidateSessionToken != nil {
+		mmValidateSessionToken.mock.t.Fatalf("Inspect function is already set for TokenIssuerMock.ValidateSessionToken")
+	}
+
+	mmValidateSessionToken.mock.inspectFuncValidateSessionToken = f
+
+	return mmValidateSessionToken
+}
+
+// Return sets up results that will be returned by TokenIssuer.ValidateSessionToken
+func (mmValidateSessionToken *mTokenIssuerMockValidateSessionToken) Return(cp1 *models.Claims, err error) *TokenIssuerMock {
+	if mmValidateSessionToken.mock.funcValidateSessionToken != nil {
+		mmValidateSessionToken.mock.t.Fatalf("TokenIssuerMock.ValidateSessionToken mock is already set by Set")
+	}
+
+	if mmValidateSessionToken.defaultExpectation == nil {
+		mmValidateSessionToken.defaultExpectation = &TokenIssuerMockValidateSessionTokenExpectation{mock: mmValidateSessionToken.mock}
+	}
+	mmValidateSessionToken.defaultExpectation.results = &TokenIssuerMockValidateSessionTokenResults{cp1, err}
+	mmValidateSessionToken.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmValidateSessionToken.mock
+}
+
+// Set uses given function f to mock the TokenIssuer.ValidateSessionToken method
+func (mmValidateSessionToken *mTokenIssuerMockValidateSessionToken) Set(f func(ctx context.Context, tokenString string) (cp1 *models.Claims, err error)) *TokenIssuerMock {
+	if mmValidateSessionToken.defaultExpectation != nil {
+		mmValidateSessionToken.mock.t.Fatalf("Default expectation is already set for the TokenIssuer.ValidateSessionToken method")
+	}
+
+	if len(mmValidateSessionToken.expectations) > 0 {
+		mmValidateSessionToken.mock.t.Fatalf("Some expectations are already set for the TokenIssuer.ValidateSessionToken method")
+	}
+
+	mmValidateSessionToken.mock.funcValidateSessionToken = f
+	mmValidateSessionToken.mock.funcValidateSessionTokenOrigin = minimock.CallerInfo(1)
+	return mmValidateSessionToken.mock
+}
+
+// When sets expectation for the TokenIssuer.ValidateSessionToken which will trigger the result defined by the following
+// Then helper
+func (mmValidateSessionToken *mTokenIssuerMockValidateSessionToken) When(ctx context.Context, tokenString string) *TokenIssuerMockValidateSessionTokenExpectation {
+	if mmValidateSessionToken.mock.funcValidateSessionToken != nil {
+		mmValidateSessionToken.mock.t.Fatalf("TokenIssuerMock.ValidateSessionToken mock is already set by Set")
+	}
+
+	expectation := &TokenIssuerMockValidateSessionTokenExpectation{
+		mock:               mmValidateSessionToken.mock,
+		params:             &TokenIssuerMockValidateSessionTokenParams{ctx, tokenString},
+		expectationOrigins: TokenIssuerMockValidateSessionTokenExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmValidateSessionToken.expectations = append(mmValidateSessionToken.expectations, expectation)
+	return expectation
+}
+
+// Then sets up TokenIssuer.ValidateSessionToken return parameters for the expectation previously defined by the When method
+func (e *TokenIssuerMockValidateSessionTokenExpectation) Then(cp1 *models.Claims, err error) *TokenIssuerMock {
+	e.results = &TokenIssuerMockValidateSessionTokenResults{cp1, err}
+	return e.mock
+}
+
+// Times sets number of times TokenIssuer.ValidateSessionToken should be invoked
+func (mmValidateSessionToken *mTokenIssuerMockValidateSessionToken) Times(n uint64) *mTokenIssuerMockValidateSessionToken {
+	if n == 0 {
+		mmValidateSessionToken.mock.t.Fatalf("Times of TokenIssuerMock.ValidateSessionToken mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmValidateSessionToken.expectedInvocations, n)
+	mmValidateSessionToken.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmValidateSessionToken
+}
+
+func (mmValidateSessionToken *mTokenIssuerMockValidateSessionToken) invocationsDone() bool {
+	if len(mmValidateSessionToken.expectations) == 0 && mmValidateSessionToken.defaultExpectation == nil && mmValidateSessionToken.mock.funcValidateSessionToken == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmValidateSessionToken.mock.afterValidateSessionTokenCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmValidateSessionToken.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ValidateSessionToken implements TokenIssuer
+func (mmValidateSessionToken *TokenIssuerMock) ValidateSessionToken(ctx context.Context, tokenString string) (cp1 *models.Claims, err error) {
+	mm_atomic.AddUint64(&mmValidateSessionToken.beforeValidateSessionTokenCounter, 1)
+	defer mm_atomic.AddUint64(&mmValidateSessionToken.afterValidateSessionTokenCounter, 1)
+
+	mmValidateSessionToken.t.Helper()
+
+	if mmValidateSessionToken.inspectFuncValidateSessionToken != nil {
+		mmValidateSessionToken.inspectFuncValidateSessionToken(ctx, tokenString)
+	}
+
+	mm_params := TokenIssuerMockValidateSessionTokenParams{ctx, tokenString}
+
+	// Record call args
+	mmValidateSessionToken.ValidateSessionTokenMock.mutex.Lock()
+	mmValidateSessionToken.ValidateSessionTokenMock.callArgs = append(mmValidateSessionToken.ValidateSessionTokenMock.callArgs, &mm_params)
+	mmValidateSessionToken.ValidateSessionTokenMock.mutex.Unlock()
+
+	for _, e := range mmValidateSessionToken.ValidateSessionTokenMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.cp1, e.results.err
+		}
+	}
+
+	if mmValidateSessionToken.ValidateSessionTokenMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmValidateSessionToken.ValidateSessionTokenMock.defaultExpectation.Counter, 1)
+		mm_want := mmValidateSessionToken.ValidateSessionTokenMock.defaultExpectation.params
+		mm_want_ptrs := mmValidateSessionToken.ValidateSessionTokenMock.defaultExpectation.paramPtrs
+
+		mm_got := TokenIssuerMockValidateSessionTokenParams{ctx, tokenString}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmValidateSessionToken.t.Errorf("TokenIssuerMock.ValidateSessionToken got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmValidateSessionToken.ValidateSessionTokenMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.tokenString != nil && !minimock.Equal(*mm_want_ptrs.tokenString, mm_got.tokenString) {
+				mmValidateSessionToken.t.Errorf("TokenIssuerMock.ValidateSessionToken got unexpected parameter tokenString, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmValidateSessionToken.ValidateSessionTokenMock.defaultExpectation.expectationOrigins.originTokenString, *mm_want_ptrs.tokenString, mm_got.tokenString, minimock.Diff(*mm_want_ptrs.tokenString, mm_got.tokenString))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmValidateSessionToken.t.Errorf("TokenIssuerMock.ValidateSessionToken got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmValidateSessionToken.ValidateSessionTokenMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmValidateSessionToken.ValidateSessionTokenMock.defaultExpectation.results
+		if mm_results == nil {
+			mmValidateSessionToken.t.Fatal("No results are set for the TokenIssuerMock.ValidateSessionToken")
+		}
+		return (*mm_results).cp1, (*mm_results).err
+	}
+	if mmValidateSessionToken.funcValidateSessionToken != nil {
+		return mmValidateSessionToken.funcValidateSessionToken(ctx, tokenString)
+	}
+	mmValidateSessionToken.t.Fatalf("Unexpected call to TokenIssuerMock.ValidateSessionToken. %v %v", ctx, tokenString)
+	return
+}
+
+// ValidateSessionTokenAfterCounter returns a count of finished TokenIssuerMock.ValidateSessionToken invocations
+func (mmValidateSessionToken *TokenIssuerMock) ValidateSessionTokenAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmValidateSessionToken.afterValidateSessionTokenCounter)
+}
+
+// ValidateSessionTokenBeforeCounter returns a count of TokenIssuerMock.ValidateSessionToken invocations
+func (mmValidateSessionToken *TokenIssuerMock) ValidateSessionTokenBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmValidateSessionToken.beforeValidateSessionTokenCounter)
+}
+
+// Calls returns a list of arguments used in each call to TokenIssuerMock.ValidateSessionToken.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmValidateSessionToken *mTokenIssuerMockValidateSessionToken) Calls() []*TokenIssuerMockValidateSessionTokenParams {
+	mmValidateSessionToken.mutex.RLock()
+
+	argCopy := make([]*TokenIssuerMockValidateSessionTokenParams, len(mmValidateSessionToken.callArgs))
+	copy(argCopy, mmValidateSessionToken.callArgs)
+
+	mmValidateSessionToken.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockValidateSessionTokenDone returns true if the count of the ValidateSessionToken invocations corresponds
+// the number of defined expectations
+func (m *TokenIssuerMock) MinimockValidateSessionTokenDone() bool {
+	if m.ValidateSessionTokenMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ValidateSessionTokenMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ValidateSessionTokenMock.invocationsDone()
+}
+
+// MinimockValidateSessionTokenInspect logs each unmet expectation
+func (m *TokenIssuerMock) MinimockValidateSessionTokenInspect() {
+	for _, e := range m.ValidateSessionTokenMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to TokenIssuerMock.ValidateSessionToken at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterValidateSessionTokenCounter := mm_atomic.LoadUint64(&m.afterValidateSessionTokenCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ValidateSessionTokenMock.defaultExpectation != nil && afterValidateSessionTokenCounter < 1 {
+		if m.ValidateSessionTokenMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to TokenIssuerMock.ValidateSessionToken at\n%s", m.ValidateSessionTokenMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to TokenIssuerMock.ValidateSessionToken at\n%s with params: %#v", m.ValidateSessionTokenMock.defaultExpectation.expectationOrigins.origin, *m.ValidateSessionTokenMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcValidateSessionToken != nil && afterValidateSessionTokenCounter < 1 {
+		m.t.Errorf("Expected call to TokenIssuerMock.ValidateSessionToken at\n%s", m.funcValidateSessionTokenOrigin)
+	}
+
+	if !m.ValidateSessionTokenMock.invocationsDone() && afterValidateSessionTokenCounter > 0 {
+		m.t.Errorf("Expected %d calls to TokenIssuerMock.ValidateSessionToken at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ValidateSessionTokenMock.expectedInvocations), m.ValidateSessionTokenMock.expectedInvocationsOrigin, afterValidateSessionTokenCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *TokenIssuerMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockGenerateClientJWTInspect()
+
+			m.MinimockGenerateIDTokenInspect()
+
+			m.MinimockGenerateScopedJWTInspect()
+
+			m.MinimockParseIDTokenInspect()
+
+			m.MinimockValidateSessionTokenInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *TokenIssuerMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *TokenIssuerMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockGenerateClientJWTDone() &&
+		m.MinimockGenerateIDTokenDone() &&
+		m.MinimockGenerateScopedJWTDone() &&
+		m.MinimockParseIDTokenDone() &&
+		m.MinimockValidateSessionTokenDone()
+}
@@ -5,23 +5,43 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"time"
 
 	"github.com/alonsoF100/authorization-service/internal/apperrors"
 	"github.com/alonsoF100/authorization-service/internal/models"
+	"golang.org/x/crypto/bcrypt"
 )
 
 type UserRepository interface {
+	OutboxWriter
 	FindByID(ctx context.Context, userID string) (*models.User, error)
+	FindByEmail(ctx context.Context, email string) (*models.User, error)
 	DeleteUser(ctx context.Context, userID string) error
+	UpdatePassword(ctx context.Context, userID, passwordHash string, updatedAt time.Time) error
+	DisableUser(ctx context.Context, userID string, disabledAt time.Time) error
+	AddRole(ctx context.Context, userID, role string, updatedAt time.Time) error
+	SetAllowedIPs(ctx context.Context, userID string, allowedIPs []string, updatedAt time.Time) error
+	CreatePersonalAccessToken(ctx context.Context, token *models.PersonalAccessToken) error
+	ListPersonalAccessTokens(ctx context.Context, userID string) ([]models.PersonalAccessToken, error)
+	FindPersonalAccessTokenByHash(ctx context.Context, tokenHash string) (*models.PersonalAccessToken, error)
+	DeletePersonalAccessToken(ctx context.Context, userID, tokenID string) error
+	TouchPersonalAccessToken(ctx context.Context, tokenID string, usedAt time.Time, ip string) error
+	ListSessions(ctx context.Context, userID string, now time.Time) ([]models.Session, error)
+	DeleteSession(ctx context.Context, userID, sessionID string) error
+	DeleteUserSessions(ctx context.Context, userID string) error
 }
 
 type UserService struct {
 	userRepository UserRepository
+	auditor        Auditor
+	// Passwords checks the passwords set, nil checks none
+	Passwords *PasswordPolicy
 }
 
-func NewUserService(repository UserRepository) *UserService {
+func NewUserService(repository UserRepository, auditor Auditor) *UserService {
 	return &UserService{
 		userRepository: repository,
+		auditor:        auditor,
 	}
 }
 
@@ -69,7 +89,15 @@ func (s UserService) DeleteUser(ctx context.Context, userID string) error {
 		slog.String("user_id", userID),
 	)
 
-	err := s.userRepository.DeleteUser(ctx, userID)
+	err := s.userRepository.InTx(ctx, func(ctx context.Context) error {
+		if err := s.userRepository.DeleteUser(ctx, userID); err != nil {
+			return err
+		}
+
+		return s.userRepository.CreateOutboxEvent(ctx, newOutboxEvent(models.EventUserDeleted, userID, map[string]string{
+			"user_id": userID,
+		}))
+	})
 	if err != nil {
 		if errors.Is(err, apperrors.ErrUserNotFoundByID) {
 			slog.Info("Delete process failed: user not founded",
@@ -91,6 +119,141 @@ func (s UserService) DeleteUser(ctx context.Context, userID string) error {
 		slog.String("op", op),
 		slog.String("user_id", userID),
 	)
+	record(ctx, s.auditor, models.AuditEvent{
+		Type:     models.AuditUserDeleted,
+		TargetID: userID,
+	})
 
 	return nil
 }
+
+func (s UserService) GetUserByEmail(ctx context.Context, email string) (*models.User, error) {
+	const op = "service/user.go/GetUserByEmail"
+
+	user, err := s.userRepository.FindByEmail(ctx, email)
+	if err != nil {
+		slog.Error("Database error during user search",
+			slog.String("op", op),
+			slog.String("email", email),
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if user == nil {
+		slog.Info("User search failed: user not founded",
+			slog.String("op", op),
+			slog.String("email", email),
+		)
+		return nil, apperrors.ErrUserNotFoundByMail
+	}
+
+	return user, nil
+}
+
+func (s UserService) SetPassword(ctx context.Context, userID, password string) error {
+	const op = "service/user.go/SetPassword"
+
+	if err := s.Passwords.Check(password); err != nil {
+		return err
+	}
+
+	hashed, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		slog.Error("Set password failed: password hashing failed",
+			slog.String("op", op),
+			slog.String("user_id", userID),
+			slog.String("error", err.Error()),
+		)
+		return err
+	}
+
+	err = s.userRepository.InTx(ctx, func(ctx context.Context) error {
+		if err := s.userRepository.UpdatePassword(ctx, userID, string(hashed), time.Now()); err != nil {
+			return err
+		}
+
+		return s.userRepository.CreateOutboxEvent(ctx, newOutboxEvent(models.EventUserPasswordChanged, userID, map[string]string{
+			"user_id": userID,
+		}))
+	})
+	if err != nil {
+		return s.updateError(op, userID, err)
+	}
+
+	slog.Info("Password changed successfully",
+		slog.String("op", op),
+		slog.String("user_id", userID),
+	)
+	record(ctx, s.auditor, models.AuditEvent{
+		Type:     models.AuditPasswordChanged,
+		TargetID: userID,
+	})
+
+	return nil
+}
+
+func (s UserService) DisableUser(ctx context.Context, userID string) error {
+	const op = "service/user.go/DisableUser"
+
+	// the sessions go with it, so the tokens already issued stop working
+	err := s.userRepository.InTx(ctx, func(ctx context.Context) error {
+		if err := s.userRepository.DisableUser(ctx, userID, time.Now()); err != nil {
+			return err
+		}
+
+		return s.userRepository.DeleteUserSessions(ctx, userID)
+	})
+	if err != nil {
+		return s.updateError(op, userID, err)
+	}
+
+	slog.Info("User disabled successfully",
+		slog.String("op", op),
+		slog.String("user_id", userID),
+	)
+	record(ctx, s.auditor, models.AuditEvent{
+		Type:     models.AuditUserDisabled,
+		TargetID: userID,
+	})
+
+	return nil
+}
+
+func (s UserService) GrantRole(ctx context.Context, userID, role string) error {
+	const op = "service/user.go/GrantRole"
+
+	if err := s.userRepository.AddRole(ctx, userID, role, time.Now()); err != nil {
+		return s.updateError(op, userID, err)
+	}
+
+	slog.Info("Role granted successfully",
+		slog.String("op", op),
+		slog.String("user_id", userID),
+		slog.String("role", role),
+	)
+	record(ctx, s.auditor, models.AuditEvent{
+		Type:     models.AuditRoleGranted,
+		TargetID: userID,
+		Details:  map[string]string{"role": role},
+	})
+
+	return nil
+}
+
+func (s UserService) updateError(op, userID string, err error) error {
+	if errors.Is(err, apperrors.ErrUserNotFoundByID) {
+		slog.Info("Update failed: user not founded",
+			slog.String("op", op),
+			slog.String("user_id", userID),
+		)
+		return apperrors.ErrUserNotFoundByID
+	}
+
+	slog.Error("Database error during user update",
+		slog.String("op", op),
+		slog.String("user_id", userID),
+		slog.String("error", err.Error()),
+	)
+	return fmt.Errorf("%s: %w", op, err)
+}
@@ -8,6 +8,7 @@ import (
 	"context"
 	"sync"
 	mm_atomic "sync/atomic"
+	"time"
 	mm_time "time"
 
 	"github.com/alonsoF100/authorization-service/internal/models"
@@ -19,6 +20,41 @@ type UserRepositoryMock struct {
 	t          minimock.Tester
 	finishOnce sync.Once
 
+	funcAddRole          func(ctx context.Context, userID string, role string, updatedAt time.Time) (err error)
+	funcAddRoleOrigin    string
+	inspectFuncAddRole   func(ctx context.Context, userID string, role string, updatedAt time.Time)
+	afterAddRoleCounter  uint64
+	beforeAddRoleCounter uint64
+	AddRoleMock          mUserRepositoryMockAddRole
+
+	funcCreateOutboxEvent          func(ctx context.Context, event *models.OutboxEvent) (err error)
+	funcCreateOutboxEventOrigin    string
+	inspectFuncCreateOutboxEvent   func(ctx context.Context, event *models.OutboxEvent)
+	afterCreateOutboxEventCounter  uint64
+	beforeCreateOutboxEventCounter uint64
+	CreateOutboxEventMock          mUserRepositoryMockCreateOutboxEvent
+
+	funcCreatePersonalAccessToken          func(ctx context.Context, token *models.PersonalAccessToken) (err error)
+	funcCreatePersonalAccessTokenOrigin    string
+	inspectFuncCreatePersonalAccessToken   func(ctx context.Context, token *models.PersonalAccessToken)
+	afterCreatePersonalAccessTokenCounter  uint64
+	beforeCreatePersonalAccessTokenCounter uint64
+	CreatePersonalAccessTokenMock          mUserRepositoryMockCreatePersonalAccessToken
+
+	funcDeletePersonalAccessToken          func(ctx context.Context, userID string, tokenID string) (err error)
+	funcDeletePersonalAccessTokenOrigin    string
+	inspectFuncDeletePersonalAccessToken   func(ctx context.Context, userID string, tokenID string)
+	afterDeletePersonalAccessTokenCounter  uint64
+	beforeDeletePersonalAccessTokenCounter uint64
+	DeletePersonalAccessTokenMock          mUserRepositoryMockDeletePersonalAccessToken
+
+	funcDeleteSession          func(ctx context.Context, userID string, sessionID string) (err error)
+	funcDeleteSessionOrigin    string
+	inspectFuncDeleteSession   func(ctx context.Context, userID string, sessionID string)
+	afterDeleteSessionCounter  uint64
+	beforeDeleteSessionCounter uint64
+	DeleteSessionMock          mUserRepositoryMockDeleteSession
+
 	funcDeleteUser          func(ctx context.Context, userID string) (err error)
 	funcDeleteUserOrigin    string
 	inspectFuncDeleteUser   func(ctx context.Context, userID string)
@@ -26,12 +62,82 @@ type UserRepositoryMock struct {
 	beforeDeleteUserCounter uint64
 	DeleteUserMock          mUserRepositoryMockDeleteUser
 
+	funcDeleteUserSessions          func(ctx context.Context, userID string) (err error)
+	funcDeleteUserSessionsOrigin    string
+	inspectFuncDeleteUserSessions   func(ctx context.Context, userID string)
+	afterDeleteUserSessionsCounter  uint64
+	beforeDeleteUserSessionsCounter uint64
+	DeleteUserSessionsMock          mUserRepositoryMockDeleteUserSessions
+
+	funcDisableUser          func(ctx context.Context, userID string, disabledAt time.Time) (err error)
+	funcDisableUserOrigin    string
+	inspectFuncDisableUser   func(ctx context.Context, userID string, disabledAt time.Time)
+	afterDisableUserCounter  uint64
+	beforeDisableUserCounter uint64
+	DisableUserMock          mUserRepositoryMockDisableUser
+
+	funcFindByEmail          func(ctx context.Context, email string) (up1 *models.User, err error)
+	funcFindByEmailOrigin    string
+	inspectFuncFindByEmail   func(ctx context.Context, email string)
+	afterFindByEmailCounter  uint64
+	beforeFindByEmailCounter uint64
+	FindByEmailMock          mUserRepositoryMockFindByEmail
+
 	funcFindByID          func(ctx context.Context, userID string) (up1 *models.User, err error)
 	funcFindByIDOrigin    string
 	inspectFuncFindByID   func(ctx context.Context, userID string)
 	afterFindByIDCounter  uint64
 	beforeFindByIDCounter uint64
 	FindByIDMock          mUserRepositoryMockFindByID
+
+	funcFindPersonalAccessTokenByHash          func(ctx context.Context, tokenHash string) (pp1 *models.PersonalAccessToken, err error)
+	funcFindPersonalAccessTokenByHashOrigin    string
+	inspectFuncFindPersonalAccessTokenByHash   func(ctx context.Context, tokenHash string)
+	afterFindPersonalAccessTokenByHashCounter  uint64
+	beforeFindPersonalAccessTokenByHashCounter uint64
+	FindPersonalAccessTokenByHashMock          mUserRepositoryMockFindPersonalAccessTokenByHash
+
+	funcInTx          func(ctx context.Context, fn func(ctx context.Context) error) (err error)
+	funcInTxOrigin    string
+	inspectFuncInTx   func(ctx context.Context, fn func(ctx context.Context) error)
+	afterInTxCounter  uint64
+	beforeInTxCounter uint64
+	InTxMock          mUserRepositoryMockInTx
+
+	funcListPersonalAccessTokens          func(ctx context.Context, userID string) (pa1 []models.PersonalAccessToken, err error)
+	funcListPersonalAccessTokensOrigin    string
+	inspectFuncListPersonalAccessTokens   func(ctx context.Context, userID string)
+	afterListPersonalAccessTokensCounter  uint64
+	beforeListPersonalAccessTokensCounter uint64
+	ListPersonalAccessTokensMock          mUserRepositoryMockListPersonalAccessTokens
+
+	funcListSessions          func(ctx context.Context, userID string, now time.Time) (sa1 []models.Session, err error)
+	funcListSessionsOrigin    string
+	inspectFuncListSessions   func(ctx context.Context, userID string, now time.Time)
+	afterListSessionsCounter  uint64
+	beforeListSessionsCounter uint64
+	ListSessionsMock          mUserRepositoryMockListSessions
+
+	funcSetAllowedIPs          func(ctx context.Context, userID string, allowedIPs []string, updatedAt time.Time) (err error)
+	funcSetAllowedIPsOrigin    string
+	inspectFuncSetAllowedIPs   func(ctx context.Context, userID string, allowedIPs []string, updatedAt time.Time)
+	afterSetAllowedIPsCounter  uint64
+	beforeSetAllowedIPsCounter uint64
+	SetAllowedIPsMock          mUserRepositoryMockSetAllowedIPs
+
+	funcTouchPersonalAccessToken          func(ctx context.Context, tokenID string, usedAt time.Time, ip string) (err error)
+	funcTouchPersonalAccessTokenOrigin    string
+	inspectFuncTouchPersonalAccessToken   func(ctx context.Context, tokenID string, usedAt time.Time, ip string)
+	afterTouchPersonalAccessTokenCounter  uint64
+	beforeTouchPersonalAccessTokenCounter uint64
+	TouchPersonalAccessTokenMock          mUserRepositoryMockTouchPersonalAccessToken
+
+	funcUpdatePassword          func(ctx context.Context, userID string, passwordHash string, updatedAt time.Time) (err error)
+	funcUpdatePasswordOrigin    string
+	inspectFuncUpdatePassword   func(ctx context.Context, userID string, passwordHash string, updatedAt time.Time)
+	afterUpdatePasswordCounter  uint64
+	beforeUpdatePasswordCounter uint64
+	UpdatePasswordMock          mUserRepositoryMockUpdatePassword
 }
 
 // NewUserRepositoryMock returns a mock for UserRepository
@@ -42,63 +148,114 @@ func NewUserRepositoryMock(t minimock.Tester) *UserRepositoryMock {
 		controller.RegisterMocker(m)
 	}
 
+	m.AddRoleMock = mUserRepositoryMockAddRole{mock: m}
+	m.AddRoleMock.callArgs = []*UserRepositoryMockAddRoleParams{}
+
+	m.CreateOutboxEventMock = mUserRepositoryMockCreateOutboxEvent{mock: m}
+	m.CreateOutboxEventMock.callArgs = []*UserRepositoryMockCreateOutboxEventParams{}
+
+	m.CreatePersonalAccessTokenMock = mUserRepositoryMockCreatePersonalAccessToken{mock: m}
+	m.CreatePersonalAccessTokenMock.callArgs = []*UserRepositoryMockCreatePersonalAccessTokenParams{}
+
+	m.DeletePersonalAccessTokenMock = mUserRepositoryMockDeletePersonalAccessToken{mock: m}
+	m.DeletePersonalAccessTokenMock.callArgs = []*UserRepositoryMockDeletePersonalAccessTokenParams{}
+
+	m.DeleteSessionMock = mUserRepositoryMockDeleteSession{mock: m}
+	m.DeleteSessionMock.callArgs = []*UserRepositoryMockDeleteSessionParams{}
+
 	m.DeleteUserMock = mUserRepositoryMockDeleteUser{mock: m}
 	m.DeleteUserMock.callArgs = []*UserRepositoryMockDeleteUserParams{}
 
+	m.DeleteUserSessionsMock = mUserRepositoryMockDeleteUserSessions{mock: m}
+	m.DeleteUserSessionsMock.callArgs = []*UserRepositoryMockDeleteUserSessionsParams{}
+
+	m.DisableUserMock = mUserRepositoryMockDisableUser{mock: m}
+	m.DisableUserMock.callArgs = []*UserRepositoryMockDisableUserParams{}
+
+	m.FindByEmailMock = mUserRepositoryMockFindByEmail{mock: m}
+	m.FindByEmailMock.callArgs = []*UserRepositoryMockFindByEmailParams{}
+
 	m.FindByIDMock = mUserRepositoryMockFindByID{mock: m}
 	m.FindByIDMock.callArgs = []*UserRepositoryMockFindByIDParams{}
 
+	m.FindPersonalAccessTokenByHashMock = mUserRepositoryMockFindPersonalAccessTokenByHash{mock: m}
+	m.FindPersonalAccessTokenByHashMock.callArgs = []*UserRepositoryMockFindPersonalAccessTokenByHashParams{}
+
+	m.InTxMock = mUserRepositoryMockInTx{mock: m}
+	m.InTxMock.callArgs = []*UserRepositoryMockInTxParams{}
+
+	m.ListPersonalAccessTokensMock = mUserRepositoryMockListPersonalAccessTokens{mock: m}
+	m.ListPersonalAccessTokensMock.callArgs = []*UserRepositoryMockListPersonalAccessTokensParams{}
+
+	m.ListSessionsMock = mUserRepositoryMockListSessions{mock: m}
+	m.ListSessionsMock.callArgs = []*UserRepositoryMockListSessionsParams{}
+
+	m.SetAllowedIPsMock = mUserRepositoryMockSetAllowedIPs{mock: m}
+	m.SetAllowedIPsMock.callArgs = []*UserRepositoryMockSetAllowedIPsParams{}
+
+	m.TouchPersonalAccessTokenMock = mUserRepositoryMockTouchPersonalAccessToken{mock: m}
+	m.TouchPersonalAccessTokenMock.callArgs = []*UserRepositoryMockTouchPersonalAccessTokenParams{}
+
+	m.UpdatePasswordMock = mUserRepositoryMockUpdatePassword{mock: m}
+	m.UpdatePasswordMock.callArgs = []*UserRepositoryMockUpdatePasswordParams{}
+
 	t.Cleanup(m.MinimockFinish)
 
 	return m
 }
 
-type mUserRepositoryMockDeleteUser struct {
+type mUserRepositoryMockAddRole struct {
 	optional           bool
 	mock               *UserRepositoryMock
-	defaultExpectation *UserRepositoryMockDeleteUserExpectation
-	expectations       []*UserRepositoryMockDeleteUserExpectation
+	defaultExpectation *UserRepositoryMockAddRoleExpectation
+	expectations       []*UserRepositoryMockAddRoleExpectation
 
-	callArgs []*UserRepositoryMockDeleteUserParams
+	callArgs []*UserRepositoryMockAddRoleParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// UserRepositoryMockDeleteUserExpectation specifies expectation struct of the UserRepository.DeleteUser
-type UserRepositoryMockDeleteUserExpectation struct {
+// UserRepositoryMockAddRoleExpectation specifies expectation struct of the UserRepository.AddRole
+type UserRepositoryMockAddRoleExpectation struct {
 	mock               *UserRepositoryMock
-	params             *UserRepositoryMockDeleteUserParams
-	paramPtrs          *UserRepositoryMockDeleteUserParamPtrs
-	expectationOrigins UserRepositoryMockDeleteUserExpectationOrigins
-	results            *UserRepositoryMockDeleteUserResults
+	params             *UserRepositoryMockAddRoleParams
+	paramPtrs          *UserRepositoryMockAddRoleParamPtrs
+	expectationOrigins UserRepositoryMockAddRoleExpectationOrigins
+	results            *UserRepositoryMockAddRoleResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// UserRepositoryMockDeleteUserParams contains parameters of the UserRepository.DeleteUser
-type UserRepositoryMockDeleteUserParams struct {
-	ctx    context.Context
-	userID string
+// UserRepositoryMockAddRoleParams contains parameters of the UserRepository.AddRole
+type UserRepositoryMockAddRoleParams struct {
+	ctx       context.Context
+	userID    string
+	role      string
+	updatedAt time.Time
 }
 
-// UserRepositoryMockDeleteUserParamPtrs contains pointers to parameters of the UserRepository.DeleteUser
-type UserRepositoryMockDeleteUserParamPtrs struct {
-	ctx    *context.Context
-	userID *string
+// UserRepositoryMockAddRoleParamPtrs contains pointers to parameters of the UserRepository.AddRole
+type UserRepositoryMockAddRoleParamPtrs struct {
+	ctx       *context.Context
+	userID    *string
+	role      *string
+	updatedAt *time.Time
 }
 
-// UserRepositoryMockDeleteUserResults contains results of the UserRepository.DeleteUser
-type UserRepositoryMockDeleteUserResults struct {
+// UserRepositoryMockAddRoleResults contains results of the UserRepository.AddRole
+type UserRepositoryMockAddRoleResults struct {
 	err error
 }
 
-// UserRepositoryMockDeleteUserOrigins contains origins of expectations of the UserRepository.DeleteUser
-type UserRepositoryMockDeleteUserExpectationOrigins struct {
-	origin       string
-	originCtx    string
-	originUserID string
+// UserRepositoryMockAddRoleOrigins contains origins of expectations of the UserRepository.AddRole
+type UserRepositoryMockAddRoleExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originUserID    string
+	originRole      string
+	originUpdatedAt string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -106,342 +263,397 @@ type UserRepositoryMockDeleteUserExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmDeleteUser *mUserRepositoryMockDeleteUser) Optional() *mUserRepositoryMockDeleteUser {
-	mmDeleteUser.optional = true
-	return mmDeleteUser
+func (mmAddRole *mUserRepositoryMockAddRole) Optional() *mUserRepositoryMockAddRole {
+	mmAddRole.optional = true
+	return mmAddRole
 }
 
-// Expect sets up expected params for UserRepository.DeleteUser
-func (mmDeleteUser *mUserRepositoryMockDeleteUser) Expect(ctx context.Context, userID string) *mUserRepositoryMockDeleteUser {
-	if mmDeleteUser.mock.funcDeleteUser != nil {
-		mmDeleteUser.mock.t.Fatalf("UserRepositoryMock.DeleteUser mock is already set by Set")
+// Expect sets up expected params for UserRepository.AddRole
+func (mmAddRole *mUserRepositoryMockAddRole) Expect(ctx context.Context, userID string, role string, updatedAt time.Time) *mUserRepositoryMockAddRole {
+	if mmAddRole.mock.funcAddRole != nil {
+		mmAddRole.mock.t.Fatalf("UserRepositoryMock.AddRole mock is already set by Set")
 	}
 
-	if mmDeleteUser.defaultExpectation == nil {
-		mmDeleteUser.defaultExpectation = &UserRepositoryMockDeleteUserExpectation{}
+	if mmAddRole.defaultExpectation == nil {
+		mmAddRole.defaultExpectation = &UserRepositoryMockAddRoleExpectation{}
 	}
 
-	if mmDeleteUser.defaultExpectation.paramPtrs != nil {
-		mmDeleteUser.mock.t.Fatalf("UserRepositoryMock.DeleteUser mock is already set by ExpectParams functions")
+	if mmAddRole.defaultExpectation.paramPtrs != nil {
+		mmAddRole.mock.t.Fatalf("UserRepositoryMock.AddRole mock is already set by ExpectParams functions")
 	}
 
-	mmDeleteUser.defaultExpectation.params = &UserRepositoryMockDeleteUserParams{ctx, userID}
-	mmDeleteUser.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmDeleteUser.expectations {
-		if minimock.Equal(e.params, mmDeleteUser.defaultExpectation.params) {
-			mmDeleteUser.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteUser.defaultExpectation.params)
+	mmAddRole.defaultExpectation.params = &UserRepositoryMockAddRoleParams{ctx, userID, role, updatedAt}
+	mmAddRole.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmAddRole.expectations {
+		if minimock.Equal(e.params, mmAddRole.defaultExpectation.params) {
+			mmAddRole.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAddRole.defaultExpectation.params)
 		}
 	}
 
-	return mmDeleteUser
+	return mmAddRole
 }
 
-// ExpectCtxParam1 sets up expected param ctx for UserRepository.DeleteUser
-func (mmDeleteUser *mUserRepositoryMockDeleteUser) ExpectCtxParam1(ctx context.Context) *mUserRepositoryMockDeleteUser {
-	if mmDeleteUser.mock.funcDeleteUser != nil {
-		mmDeleteUser.mock.t.Fatalf("UserRepositoryMock.DeleteUser mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for UserRepository.AddRole
+func (mmAddRole *mUserRepositoryMockAddRole) ExpectCtxParam1(ctx context.Context) *mUserRepositoryMockAddRole {
+	if mmAddRole.mock.funcAddRole != nil {
+		mmAddRole.mock.t.Fatalf("UserRepositoryMock.AddRole mock is already set by Set")
 	}
 
-	if mmDeleteUser.defaultExpectation == nil {
-		mmDeleteUser.defaultExpectation = &UserRepositoryMockDeleteUserExpectation{}
+	if mmAddRole.defaultExpectation == nil {
+		mmAddRole.defaultExpectation = &UserRepositoryMockAddRoleExpectation{}
 	}
 
-	if mmDeleteUser.defaultExpectation.params != nil {
-		mmDeleteUser.mock.t.Fatalf("UserRepositoryMock.DeleteUser mock is already set by Expect")
+	if mmAddRole.defaultExpectation.params != nil {
+		mmAddRole.mock.t.Fatalf("UserRepositoryMock.AddRole mock is already set by Expect")
 	}
 
-	if mmDeleteUser.defaultExpectation.paramPtrs == nil {
-		mmDeleteUser.defaultExpectation.paramPtrs = &UserRepositoryMockDeleteUserParamPtrs{}
+	if mmAddRole.defaultExpectation.paramPtrs == nil {
+		mmAddRole.defaultExpectation.paramPtrs = &UserRepositoryMockAddRoleParamPtrs{}
 	}
-	mmDeleteUser.defaultExpectation.paramPtrs.ctx = &ctx
-	mmDeleteUser.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmAddRole.defaultExpectation.paramPtrs.ctx = &ctx
+	mmAddRole.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmDeleteUser
+	return mmAddRole
 }
 
-// ExpectUserIDParam2 sets up expected param userID for UserRepository.DeleteUser
-func (mmDeleteUser *mUserRepositoryMockDeleteUser) ExpectUserIDParam2(userID string) *mUserRepositoryMockDeleteUser {
-	if mmDeleteUser.mock.funcDeleteUser != nil {
-		mmDeleteUser.mock.t.Fatalf("UserRepositoryMock.DeleteUser mock is already set by Set")
+// ExpectUserIDParam2 sets up expected param userID for UserRepository.AddRole
+func (mmAddRole *mUserRepositoryMockAddRole) ExpectUserIDParam2(userID string) *mUserRepositoryMockAddRole {
+	if mmAddRole.mock.funcAddRole != nil {
+		mmAddRole.mock.t.Fatalf("UserRepositoryMock.AddRole mock is already set by Set")
 	}
 
-	if mmDeleteUser.defaultExpectation == nil {
-		mmDeleteUser.defaultExpectation = &UserRepositoryMockDeleteUserExpectation{}
+	if mmAddRole.defaultExpectation == nil {
+		mmAddRole.defaultExpectation = &UserRepositoryMockAddRoleExpectation{}
 	}
 
-	if mmDeleteUser.defaultExpectation.params != nil {
-		mmDeleteUser.mock.t.Fatalf("UserRepositoryMock.DeleteUser mock is already set by Expect")
+	if mmAddRole.defaultExpectation.params != nil {
+		mmAddRole.mock.t.Fatalf("UserRepositoryMock.AddRole mock is already set by Expect")
 	}
 
-	if mmDeleteUser.defaultExpectation.paramPtrs == nil {
-		mmDeleteUser.defaultExpectation.paramPtrs = &UserRepositoryMockDeleteUserParamPtrs{}
+	if mmAddRole.defaultExpectation.paramPtrs == nil {
+		mmAddRole.defaultExpectation.paramPtrs = &UserRepositoryMockAddRoleParamPtrs{}
 	}
-	mmDeleteUser.defaultExpectation.paramPtrs.userID = &userID
-	mmDeleteUser.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+	mmAddRole.defaultExpectation.paramPtrs.userID = &userID
+	mmAddRole.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
 
-	return mmDeleteUser
+	return mmAddRole
 }
 
-// Inspect accepts an inspector function that has same arguments as the UserRepository.DeleteUser
-func (mmDeleteUser *mUserRepositoryMockDeleteUser) Inspect(f func(ctx context.Context, userID string)) *mUserRepositoryMockDeleteUser {
-	if mmDeleteUser.mock.inspectFuncDeleteUser != nil {
-		mmDeleteUser.mock.t.Fatalf("Inspect function is already set for UserRepositoryMock.DeleteUser")
+// ExpectRoleParam3 sets up expected param role for UserRepository.AddRole
+func (mmAddRole *mUserRepositoryMockAddRole) ExpectRoleParam3(role string) *mUserRepositoryMockAddRole {
+	if mmAddRole.mock.funcAddRole != nil {
+		mmAddRole.mock.t.Fatalf("UserRepositoryMock.AddRole mock is already set by Set")
 	}
 
-	mmDeleteUser.mock.inspectFuncDeleteUser = f
+	if mmAddRole.defaultExpectation == nil {
+		mmAddRole.defaultExpectation = &UserRepositoryMockAddRoleExpectation{}
+	}
 
-	return mmDeleteUser
+	if mmAddRole.defaultExpectation.params != nil {
+		mmAddRole.mock.t.Fatalf("UserRepositoryMock.AddRole mock is already set by Expect")
+	}
+
+	if mmAddRole.defaultExpectation.paramPtrs == nil {
+		mmAddRole.defaultExpectation.paramPtrs = &UserRepositoryMockAddRoleParamPtrs{}
+	}
+	mmAddRole.defaultExpectation.paramPtrs.role = &role
+	mmAddRole.defaultExpectation.expectationOrigins.originRole = minimock.CallerInfo(1)
+
+	return mmAddRole
 }
 
-// Return sets up results that will be returned by UserRepository.DeleteUser
-func (mmDeleteUser *mUserRepositoryMockDeleteUser) Return(err error) *UserRepositoryMock {
-	if mmDeleteUser.mock.funcDeleteUser != nil {
-		mmDeleteUser.mock.t.Fatalf("UserRepositoryMock.DeleteUser mock is already set by Set")
+// ExpectUpdatedAtParam4 sets up expected param updatedAt for UserRepository.AddRole
+func (mmAddRole *mUserRepositoryMockAddRole) ExpectUpdatedAtParam4(updatedAt time.Time) *mUserRepositoryMockAddRole {
+	if mmAddRole.mock.funcAddRole != nil {
+		mmAddRole.mock.t.Fatalf("UserRepositoryMock.AddRole mock is already set by Set")
 	}
 
-	if mmDeleteUser.defaultExpectation == nil {
-		mmDeleteUser.defaultExpectation = &UserRepositoryMockDeleteUserExpectation{mock: mmDeleteUser.mock}
+	if mmAddRole.defaultExpectation == nil {
+		mmAddRole.defaultExpectation = &UserRepositoryMockAddRoleExpectation{}
 	}
-	mmDeleteUser.defaultExpectation.results = &UserRepositoryMockDeleteUserResults{err}
-	mmDeleteUser.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmDeleteUser.mock
+
+	if mmAddRole.defaultExpectation.params != nil {
+		mmAddRole.mock.t.Fatalf("UserRepositoryMock.AddRole mock is already set by Expect")
+	}
+
+	if mmAddRole.defaultExpectation.paramPtrs == nil {
+		mmAddRole.defaultExpectation.paramPtrs = &UserRepositoryMockAddRoleParamPtrs{}
+	}
+	mmAddRole.defaultExpectation.paramPtrs.updatedAt = &updatedAt
+	mmAddRole.defaultExpectation.expectationOrigins.originUpdatedAt = minimock.CallerInfo(1)
+
+	return mmAddRole
 }
 
-// Set uses given function f to mock the UserRepository.DeleteUser method
-func (mmDeleteUser *mUserRepositoryMockDeleteUser) Set(f func(ctx context.Context, userID string) (err error)) *UserRepositoryMock {
-	if mmDeleteUser.defaultExpectation != nil {
-		mmDeleteUser.mock.t.Fatalf("Default expectation is already set for the UserRepository.DeleteUser method")
+// Inspect accepts an inspector function that has same arguments as the UserRepository.AddRole
+func (mmAddRole *mUserRepositoryMockAddRole) Inspect(f func(ctx context.Context, userID string, role string, updatedAt time.Time)) *mUserRepositoryMockAddRole {
+	if mmAddRole.mock.inspectFuncAddRole != nil {
+		mmAddRole.mock.t.Fatalf("Inspect function is already set for UserRepositoryMock.AddRole")
 	}
 
-	if len(mmDeleteUser.expectations) > 0 {
-		mmDeleteUser.mock.t.Fatalf("Some expectations are already set for the UserRepository.DeleteUser method")
+	mmAddRole.mock.inspectFuncAddRole = f
+
+	return mmAddRole
+}
+
+// Return sets up results that will be returned by UserRepository.AddRole
+func (mmAddRole *mUserRepositoryMockAddRole) Return(err error) *UserRepositoryMock {
+	if mmAddRole.mock.funcAddRole != nil {
+		mmAddRole.mock.t.Fatalf("UserRepositoryMock.AddRole mock is already set by Set")
 	}
 
-	mmDeleteUser.mock.funcDeleteUser = f
-	mmDeleteUser.mock.funcDeleteUserOrigin = minimock.CallerInfo(1)
-	return mmDeleteUser.mock
+	if mmAddRole.defaultExpectation == nil {
+		mmAddRole.defaultExpectation = &UserRepositoryMockAddRoleExpectation{mock: mmAddRole.mock}
+	}
+	mmAddRole.defaultExpectation.results = &UserRepositoryMockAddRoleResults{err}
+	mmAddRole.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmAddRole.mock
 }
 
-// When sets expectation for the UserRepository.DeleteUser which will trigger the result defined by the following
+// Set uses given function f to mock the UserRepository.AddRole method
+func (mmAddRole *mUserRepositoryMockAddRole) Set(f func(ctx context.Context, userID string, role string, updatedAt time.Time) (err error)) *UserRepositoryMock {
+	if mmAddRole.defaultExpectation != nil {
+		mmAddRole.mock.t.Fatalf("Default expectation is already set for the UserRepository.AddRole method")
+	}
+
+	if len(mmAddRole.expectations) > 0 {
+		mmAddRole.mock.t.Fatalf("Some expectations are already set for the UserRepository.AddRole method")
+	}
+
+	mmAddRole.mock.funcAddRole = f
+	mmAddRole.mock.funcAddRoleOrigin = minimock.CallerInfo(1)
+	return mmAddRole.mock
+}
+
+// When sets expectation for the UserRepository.AddRole which will trigger the result defined by the following
 // Then helper
-func (mmDeleteUser *mUserRepositoryMockDeleteUser) When(ctx context.Context, userID string) *UserRepositoryMockDeleteUserExpectation {
-	if mmDeleteUser.mock.funcDeleteUser != nil {
-		mmDeleteUser.mock.t.Fatalf("UserRepositoryMock.DeleteUser mock is already set by Set")
+func (mmAddRole *mUserRepositoryMockAddRole) When(ctx context.Context, userID string, role string, updatedAt time.Time) *UserRepositoryMockAddRoleExpectation {
+	if mmAddRole.mock.funcAddRole != nil {
+		mmAddRole.mock.t.Fatalf("UserRepositoryMock.AddRole mock is already set by Set")
 	}
 
-	expectation := &UserRepositoryMockDeleteUserExpectation{
-		mock:               mmDeleteUser.mock,
-		params:             &UserRepositoryMockDeleteUserParams{ctx, userID},
-		expectationOrigins: UserRepositoryMockDeleteUserExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &UserRepositoryMockAddRoleExpectation{
+		mock:               mmAddRole.mock,
+		params:             &UserRepositoryMockAddRoleParams{ctx, userID, role, updatedAt},
+		expectationOrigins: UserRepositoryMockAddRoleExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmDeleteUser.expectations = append(mmDeleteUser.expectations, expectation)
+	mmAddRole.expectations = append(mmAddRole.expectations, expectation)
 	return expectation
 }
 
-// Then sets up UserRepository.DeleteUser return parameters for the expectation previously defined by the When method
-func (e *UserRepositoryMockDeleteUserExpectation) Then(err error) *UserRepositoryMock {
-	e.results = &UserRepositoryMockDeleteUserResults{err}
+// Then sets up UserRepository.AddRole return parameters for the expectation previously defined by the When method
+func (e *UserRepositoryMockAddRoleExpectation) Then(err error) *UserRepositoryMock {
+	e.results = &UserRepositoryMockAddRoleResults{err}
 	return e.mock
 }
 
-// Times sets number of times UserRepository.DeleteUser should be invoked
-func (mmDeleteUser *mUserRepositoryMockDeleteUser) Times(n uint64) *mUserRepositoryMockDeleteUser {
+// Times sets number of times UserRepository.AddRole should be invoked
+func (mmAddRole *mUserRepositoryMockAddRole) Times(n uint64) *mUserRepositoryMockAddRole {
 	if n == 0 {
-		mmDeleteUser.mock.t.Fatalf("Times of UserRepositoryMock.DeleteUser mock can not be zero")
+		mmAddRole.mock.t.Fatalf("Times of UserRepositoryMock.AddRole mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmDeleteUser.expectedInvocations, n)
-	mmDeleteUser.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmDeleteUser
+	mm_atomic.StoreUint64(&mmAddRole.expectedInvocations, n)
+	mmAddRole.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmAddRole
 }
 
-func (mmDeleteUser *mUserRepositoryMockDeleteUser) invocationsDone() bool {
-	if len(mmDeleteUser.expectations) == 0 && mmDeleteUser.defaultExpectation == nil && mmDeleteUser.mock.funcDeleteUser == nil {
+func (mmAddRole *mUserRepositoryMockAddRole) invocationsDone() bool {
+	if len(mmAddRole.expectations) == 0 && mmAddRole.defaultExpectation == nil && mmAddRole.mock.funcAddRole == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmDeleteUser.mock.afterDeleteUserCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteUser.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmAddRole.mock.afterAddRoleCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmAddRole.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// DeleteUser implements UserRepository
-func (mmDeleteUser *UserRepositoryMock) DeleteUser(ctx context.Context, userID string) (err error) {
-	mm_atomic.AddUint64(&mmDeleteUser.beforeDeleteUserCounter, 1)
-	defer mm_atomic.AddUint64(&mmDeleteUser.afterDeleteUserCounter, 1)
+// AddRole implements UserRepository
+func (mmAddRole *UserRepositoryMock) AddRole(ctx context.Context, userID string, role string, updatedAt time.Time) (err error) {
+	mm_atomic.AddUint64(&mmAddRole.beforeAddRoleCounter, 1)
+	defer mm_atomic.AddUint64(&mmAddRole.afterAddRoleCounter, 1)
 
-	mmDeleteUser.t.Helper()
+	mmAddRole.t.Helper()
 
-	if mmDeleteUser.inspectFuncDeleteUser != nil {
-		mmDeleteUser.inspectFuncDeleteUser(ctx, userID)
+	if mmAddRole.inspectFuncAddRole != nil {
+		mmAddRole.inspectFuncAddRole(ctx, userID, role, updatedAt)
 	}
 
-	mm_params := UserRepositoryMockDeleteUserParams{ctx, userID}
+	mm_params := UserRepositoryMockAddRoleParams{ctx, userID, role, updatedAt}
 
 	// Record call args
-	mmDeleteUser.DeleteUserMock.mutex.Lock()
-	mmDeleteUser.DeleteUserMock.callArgs = append(mmDeleteUser.DeleteUserMock.callArgs, &mm_params)
-	mmDeleteUser.DeleteUserMock.mutex.Unlock()
+	mmAddRole.AddRoleMock.mutex.Lock()
+	mmAddRole.AddRoleMock.callArgs = append(mmAddRole.AddRoleMock.callArgs, &mm_params)
+	mmAddRole.AddRoleMock.mutex.Unlock()
 
-	for _, e := range mmDeleteUser.DeleteUserMock.expectations {
+	for _, e := range mmAddRole.AddRoleMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
 			return e.results.err
 		}
 	}
 
-	if mmDeleteUser.DeleteUserMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmDeleteUser.DeleteUserMock.defaultExpectation.Counter, 1)
-		mm_want := mmDeleteUser.DeleteUserMock.defaultExpectation.params
-		mm_want_ptrs := mmDeleteUser.DeleteUserMock.defaultExpectation.paramPtrs
+	if mmAddRole.AddRoleMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAddRole.AddRoleMock.defaultExpectation.Counter, 1)
+		mm_want := mmAddRole.AddRoleMock.defaultExpectation.params
+		mm_want_ptrs := mmAddRole.AddRoleMock.defaultExpectation.paramPtrs
 
-		mm_got := UserRepositoryMockDeleteUserParams{ctx, userID}
+		mm_got := UserRepositoryMockAddRoleParams{ctx, userID, role, updatedAt}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmDeleteUser.t.Errorf("UserRepositoryMock.DeleteUser got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmDeleteUser.DeleteUserMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmAddRole.t.Errorf("UserRepositoryMock.AddRole got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddRole.AddRoleMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
 			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
-				mmDeleteUser.t.Errorf("UserRepositoryMock.DeleteUser got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmDeleteUser.DeleteUserMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+				mmAddRole.t.Errorf("UserRepositoryMock.AddRole got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddRole.AddRoleMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.role != nil && !minimock.Equal(*mm_want_ptrs.role, mm_got.role) {
+				mmAddRole.t.Errorf("UserRepositoryMock.AddRole got unexpected parameter role, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddRole.AddRoleMock.defaultExpectation.expectationOrigins.originRole, *mm_want_ptrs.role, mm_got.role, minimock.Diff(*mm_want_ptrs.role, mm_got.role))
+			}
+
+			if mm_want_ptrs.updatedAt != nil && !minimock.Equal(*mm_want_ptrs.updatedAt, mm_got.updatedAt) {
+				mmAddRole.t.Errorf("UserRepositoryMock.AddRole got unexpected parameter updatedAt, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddRole.AddRoleMock.defaultExpectation.expectationOrigins.originUpdatedAt, *mm_want_ptrs.updatedAt, mm_got.updatedAt, minimock.Diff(*mm_want_ptrs.updatedAt, mm_got.updatedAt))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmDeleteUser.t.Errorf("UserRepositoryMock.DeleteUser got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmDeleteUser.DeleteUserMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmAddRole.t.Errorf("UserRepositoryMock.AddRole got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmAddRole.AddRoleMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmDeleteUser.DeleteUserMock.defaultExpectation.results
+		mm_results := mmAddRole.AddRoleMock.defaultExpectation.results
 		if mm_results == nil {
-			mmDeleteUser.t.Fatal("No results are set for the UserRepositoryMock.DeleteUser")
+			mmAddRole.t.Fatal("No results are set for the UserRepositoryMock.AddRole")
 		}
 		return (*mm_results).err
 	}
-	if mmDeleteUser.funcDeleteUser != nil {
-		return mmDeleteUser.funcDeleteUser(ctx, userID)
+	if mmAddRole.funcAddRole != nil {
+		return mmAddRole.funcAddRole(ctx, userID, role, updatedAt)
 	}
-	mmDeleteUser.t.Fatalf("Unexpected call to UserRepositoryMock.DeleteUser. %v %v", ctx, userID)
+	mmAddRole.t.Fatalf("Unexpected call to UserRepositoryMock.AddRole. %v %v %v %v", ctx, userID, role, updatedAt)
 	return
 }
 
-// DeleteUserAfterCounter returns a count of finished UserRepositoryMock.DeleteUser invocations
-func (mmDeleteUser *UserRepositoryMock) DeleteUserAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmDeleteUser.afterDeleteUserCounter)
+// AddRoleAfterCounter returns a count of finished UserRepositoryMock.AddRole invocations
+func (mmAddRole *UserRepositoryMock) AddRoleAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddRole.afterAddRoleCounter)
 }
 
-// DeleteUserBeforeCounter returns a count of UserRepositoryMock.DeleteUser invocations
-func (mmDeleteUser *UserRepositoryMock) DeleteUserBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmDeleteUser.beforeDeleteUserCounter)
+// AddRoleBeforeCounter returns a count of UserRepositoryMock.AddRole invocations
+func (mmAddRole *UserRepositoryMock) AddRoleBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddRole.beforeAddRoleCounter)
 }
 
-// Calls returns a list of arguments used in each call to UserRepositoryMock.DeleteUser.
+// Calls returns a list of arguments used in each call to UserRepositoryMock.AddRole.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmDeleteUser *mUserRepositoryMockDeleteUser) Calls() []*UserRepositoryMockDeleteUserParams {
-	mmDeleteUser.mutex.RLock()
+func (mmAddRole *mUserRepositoryMockAddRole) Calls() []*UserRepositoryMockAddRoleParams {
+	mmAddRole.mutex.RLock()
 
-	argCopy := make([]*UserRepositoryMockDeleteUserParams, len(mmDeleteUser.callArgs))
-	copy(argCopy, mmDeleteUser.callArgs)
+	argCopy := make([]*UserRepositoryMockAddRoleParams, len(mmAddRole.callArgs))
+	copy(argCopy, mmAddRole.callArgs)
 
-	mmDeleteUser.mutex.RUnlock()
+	mmAddRole.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockDeleteUserDone returns true if the count of the DeleteUser invocations corresponds
+// MinimockAddRoleDone returns true if the count of the AddRole invocations corresponds
 // the number of defined expectations
-func (m *UserRepositoryMock) MinimockDeleteUserDone() bool {
-	if m.DeleteUserMock.optional {
+func (m *UserRepositoryMock) MinimockAddRoleDone() bool {
+	if m.AddRoleMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.DeleteUserMock.expectations {
+	for _, e := range m.AddRoleMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.DeleteUserMock.invocationsDone()
+	return m.AddRoleMock.invocationsDone()
 }
 
-// MinimockDeleteUserInspect logs each unmet expectation
-func (m *UserRepositoryMock) MinimockDeleteUserInspect() {
-	for _, e := range m.DeleteUserMock.expectations {
+// MinimockAddRoleInspect logs each unmet expectation
+func (m *UserRepositoryMock) MinimockAddRoleInspect() {
+	for _, e := range m.AddRoleMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to UserRepositoryMock.DeleteUser at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to UserRepositoryMock.AddRole at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterDeleteUserCounter := mm_atomic.LoadUint64(&m.afterDeleteUserCounter)
+	afterAddRoleCounter := mm_atomic.LoadUint64(&m.afterAddRoleCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.DeleteUserMock.defaultExpectation != nil && afterDeleteUserCounter < 1 {
-		if m.DeleteUserMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to UserRepositoryMock.DeleteUser at\n%s", m.DeleteUserMock.defaultExpectation.returnOrigin)
+	if m.AddRoleMock.defaultExpectation != nil && afterAddRoleCounter < 1 {
+		if m.AddRoleMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UserRepositoryMock.AddRole at\n%s", m.AddRoleMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to UserRepositoryMock.DeleteUser at\n%s with params: %#v", m.DeleteUserMock.defaultExpectation.expectationOrigins.origin, *m.DeleteUserMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to UserRepositoryMock.AddRole at\n%s with params: %#v", m.AddRoleMock.defaultExpectation.expectationOrigins.origin, *m.AddRoleMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcDeleteUser != nil && afterDeleteUserCounter < 1 {
-		m.t.Errorf("Expected call to UserRepositoryMock.DeleteUser at\n%s", m.funcDeleteUserOrigin)
+	if m.funcAddRole != nil && afterAddRoleCounter < 1 {
+		m.t.Errorf("Expected call to UserRepositoryMock.AddRole at\n%s", m.funcAddRoleOrigin)
 	}
 
-	if !m.DeleteUserMock.invocationsDone() && afterDeleteUserCounter > 0 {
-		m.t.Errorf("Expected %d calls to UserRepositoryMock.DeleteUser at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.DeleteUserMock.expectedInvocations), m.DeleteUserMock.expectedInvocationsOrigin, afterDeleteUserCounter)
+	if !m.AddRoleMock.invocationsDone() && afterAddRoleCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserRepositoryMock.AddRole at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.AddRoleMock.expectedInvocations), m.AddRoleMock.expectedInvocationsOrigin, afterAddRoleCounter)
 	}
 }
 
-type mUserRepositoryMockFindByID struct {
+type mUserRepositoryMockCreateOutboxEvent struct {
 	optional           bool
 	mock               *UserRepositoryMock
-	defaultExpectation *UserRepositoryMockFindByIDExpectation
-	expectations       []*UserRepositoryMockFindByIDExpectation
+	defaultExpectation *UserRepositoryMockCreateOutboxEventExpectation
+	expectations       []*UserRepositoryMockCreateOutboxEventExpectation
 
-	callArgs []*UserRepositoryMockFindByIDParams
+	callArgs []*UserRepositoryMockCreateOutboxEventParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// UserRepositoryMockFindByIDExpectation specifies expectation struct of the UserRepository.FindByID
-type UserRepositoryMockFindByIDExpectation struct {
+// UserRepositoryMockCreateOutboxEventExpectation specifies expectation struct of the UserRepository.CreateOutboxEvent
+type UserRepositoryMockCreateOutboxEventExpectation struct {
 	mock               *UserRepositoryMock
-	params             *UserRepositoryMockFindByIDParams
-	paramPtrs          *UserRepositoryMockFindByIDParamPtrs
-	expectationOrigins UserRepositoryMockFindByIDExpectationOrigins
-	results            *UserRepositoryMockFindByIDResults
+	params             *UserRepositoryMockCreateOutboxEventParams
+	paramPtrs          *UserRepositoryMockCreateOutboxEventParamPtrs
+	expectationOrigins UserRepositoryMockCreateOutboxEventExpectationOrigins
+	results            *UserRepositoryMockCreateOutboxEventResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// UserRepositoryMockFindByIDParams contains parameters of the UserRepository.FindByID
-type UserRepositoryMockFindByIDParams struct {
-	ctx    context.Context
-	userID string
+// UserRepositoryMockCreateOutboxEventParams contains parameters of the UserRepository.CreateOutboxEvent
+type UserRepositoryMockCreateOutboxEventParams struct {
+	ctx   context.Context
+	event *models.OutboxEvent
 }
 
-// UserRepositoryMockFindByIDParamPtrs contains pointers to parameters of the UserRepository.FindByID
-type UserRepositoryMockFindByIDParamPtrs struct {
-	ctx    *context.Context
-	userID *string
+// UserRepositoryMockCreateOutboxEventParamPtrs contains pointers to parameters of the UserRepository.CreateOutboxEvent
+type UserRepositoryMockCreateOutboxEventParamPtrs struct {
+	ctx   *context.Context
+	event **models.OutboxEvent
 }
 
-// UserRepositoryMockFindByIDResults contains results of the UserRepository.FindByID
-type UserRepositoryMockFindByIDResults struct {
-	up1 *models.User
+// UserRepositoryMockCreateOutboxEventResults contains results of the UserRepository.CreateOutboxEvent
+type UserRepositoryMockCreateOutboxEventResults struct {
 	err error
 }
 
-// UserRepositoryMockFindByIDOrigins contains origins of expectations of the UserRepository.FindByID
-type UserRepositoryMockFindByIDExpectationOrigins struct {
-	origin       string
-	originCtx    string
-	originUserID string
+// UserRepositoryMockCreateOutboxEventOrigins contains origins of expectations of the UserRepository.CreateOutboxEvent
+type UserRepositoryMockCreateOutboxEventExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originEvent string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -449,292 +661,5737 @@ type UserRepositoryMockFindByIDExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmFindByID *mUserRepositoryMockFindByID) Optional() *mUserRepositoryMockFindByID {
-	mmFindByID.optional = true
-	return mmFindByID
+func (mmCreateOutboxEvent *mUserRepositoryMockCreateOutboxEvent) Optional() *mUserRepositoryMockCreateOutboxEvent {
+	mmCreateOutboxEvent.optional = true
+	return mmCreateOutboxEvent
 }
 
-// Expect sets up expected params for UserRepository.FindByID
-func (mmFindByID *mUserRepositoryMockFindByID) Expect(ctx context.Context, userID string) *mUserRepositoryMockFindByID {
-	if mmFindByID.mock.funcFindByID != nil {
-		mmFindByID.mock.t.Fatalf("UserRepositoryMock.FindByID mock is already set by Set")
+// Expect sets up expected params for UserRepository.CreateOutboxEvent
+func (mmCreateOutboxEvent *mUserRepositoryMockCreateOutboxEvent) Expect(ctx context.Context, event *models.OutboxEvent) *mUserRepositoryMockCreateOutboxEvent {
+	if mmCreateOutboxEvent.mock.funcCreateOutboxEvent != nil {
+		mmCreateOutboxEvent.mock.t.Fatalf("UserRepositoryMock.CreateOutboxEvent mock is already set by Set")
 	}
 
-	if mmFindByID.defaultExpectation == nil {
-		mmFindByID.defaultExpectation = &UserRepositoryMockFindByIDExpectation{}
+	if mmCreateOutboxEvent.defaultExpectation == nil {
+		mmCreateOutboxEvent.defaultExpectation = &UserRepositoryMockCreateOutboxEventExpectation{}
 	}
 
-	if mmFindByID.defaultExpectation.paramPtrs != nil {
-		mmFindByID.mock.t.Fatalf("UserRepositoryMock.FindByID mock is already set by ExpectParams functions")
+	if mmCreateOutboxEvent.defaultExpectation.paramPtrs != nil {
+		mmCreateOutboxEvent.mock.t.Fatalf("UserRepositoryMock.CreateOutboxEvent mock is already set by ExpectParams functions")
 	}
 
-	mmFindByID.defaultExpectation.params = &UserRepositoryMockFindByIDParams{ctx, userID}
-	mmFindByID.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmFindByID.expectations {
-		if minimock.Equal(e.params, mmFindByID.defaultExpectation.params) {
+	mmCreateOutboxEvent.defaultExpectation.params = &UserRepositoryMockCreateOutboxEventParams{ctx, event}
+	mmCreateOutboxEvent.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreateOutboxEvent.expectations {
+		if minimock.Equal(e.params, mmCreateOutboxEvent.defaultExpectation.params) {
+			mmCreateOutboxEvent.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateOutboxEvent.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateOutboxEvent
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UserRepository.CreateOutboxEvent
+func (mmCreateOutboxEvent *mUserRepositoryMockCreateOutboxEvent) ExpectCtxParam1(ctx context.Context) *mUserRepositoryMockCreateOutboxEvent {
+	if mmCreateOutboxEvent.mock.funcCreateOutboxEvent != nil {
+		mmCreateOutboxEvent.mock.t.Fatalf("UserRepositoryMock.CreateOutboxEvent mock is already set by Set")
+	}
+
+	if mmCreateOutboxEvent.defaultExpectation == nil {
+		mmCreateOutboxEvent.defaultExpectation = &UserRepositoryMockCreateOutboxEventExpectation{}
+	}
+
+	if mmCreateOutboxEvent.defaultExpectation.params != nil {
+		mmCreateOutboxEvent.mock.t.Fatalf("UserRepositoryMock.CreateOutboxEvent mock is already set by Expect")
+	}
+
+	if mmCreateOutboxEvent.defaultExpectation.paramPtrs == nil {
+		mmCreateOutboxEvent.defaultExpectation.paramPtrs = &UserRepositoryMockCreateOutboxEventParamPtrs{}
+	}
+	mmCreateOutboxEvent.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreateOutboxEvent.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreateOutboxEvent
+}
+
+// ExpectEventParam2 sets up expected param event for UserRepository.CreateOutboxEvent
+func (mmCreateOutboxEvent *mUserRepositoryMockCreateOutboxEvent) ExpectEventParam2(event *models.OutboxEvent) *mUserRepositoryMockCreateOutboxEvent {
+	if mmCreateOutboxEvent.mock.funcCreateOutboxEvent != nil {
+		mmCreateOutboxEvent.mock.t.Fatalf("UserRepositoryMock.CreateOutboxEvent mock is already set by Set")
+	}
+
+	if mmCreateOutboxEvent.defaultExpectation == nil {
+		mmCreateOutboxEvent.defaultExpectation = &UserRepositoryMockCreateOutboxEventExpectation{}
+	}
+
+	if mmCreateOutboxEvent.defaultExpectation.params != nil {
+		mmCreateOutboxEvent.mock.t.Fatalf("UserRepositoryMock.CreateOutboxEvent mock is already set by Expect")
+	}
+
+	if mmCreateOutboxEvent.defaultExpectation.paramPtrs == nil {
+		mmCreateOutboxEvent.defaultExpectation.paramPtrs = &UserRepositoryMockCreateOutboxEventParamPtrs{}
+	}
+	mmCreateOutboxEvent.defaultExpectation.paramPtrs.event = &event
+	mmCreateOutboxEvent.defaultExpectation.expectationOrigins.originEvent = minimock.CallerInfo(1)
+
+	return mmCreateOutboxEvent
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserRepository.CreateOutboxEvent
+func (mmCreateOutboxEvent *mUserRepositoryMockCreateOutboxEvent) Inspect(f func(ctx context.Context, event *models.OutboxEvent)) *mUserRepositoryMockCreateOutboxEvent {
+	if mmCreateOutboxEvent.mock.inspectFuncCreateOutboxEvent != nil {
+		mmCreateOutboxEvent.mock.t.Fatalf("Inspect function is already set for UserRepositoryMock.CreateOutboxEvent")
+	}
+
+	mmCreateOutboxEvent.mock.inspectFuncCreateOutboxEvent = f
+
+	return mmCreateOutboxEvent
+}
+
+// Return sets up results that will be returned by UserRepository.CreateOutboxEvent
+func (mmCreateOutboxEvent *mUserRepositoryMockCreateOutboxEvent) Return(err error) *UserRepositoryMock {
+	if mmCreateOutboxEvent.mock.funcCreateOutboxEvent != nil {
+		mmCreateOutboxEvent.mock.t.Fatalf("UserRepositoryMock.CreateOutboxEvent mock is already set by Set")
+	}
+
+	if mmCreateOutboxEvent.defaultExpectation == nil {
+		mmCreateOutboxEvent.defaultExpectation = &UserRepositoryMockCreateOutboxEventExpectation{mock: mmCreateOutboxEvent.mock}
+	}
+	mmCreateOutboxEvent.defaultExpectation.results = &UserRepositoryMockCreateOutboxEventResults{err}
+	mmCreateOutboxEvent.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreateOutboxEvent.mock
+}
+
+// Set uses given function f to mock the UserRepository.CreateOutboxEvent method
+func (mmCreateOutboxEvent *mUserRepositoryMockCreateOutboxEvent) Set(f func(ctx context.Context, event *models.OutboxEvent) (err error)) *UserRepositoryMock {
+	if mmCreateOutboxEvent.defaultExpectation != nil {
+		mmCreateOutboxEvent.mock.t.Fatalf("Default expectation is already set for the UserRepository.CreateOutboxEvent method")
+	}
+
+	if len(mmCreateOutboxEvent.expectations) > 0 {
+		mmCreateOutboxEvent.mock.t.Fatalf("Some expectations are already set for the UserRepository.CreateOutboxEvent method")
+	}
+
+	mmCreateOutboxEvent.mock.funcCreateOutboxEvent = f
+	mmCreateOutboxEvent.mock.funcCreateOutboxEventOrigin = minimock.CallerInfo(1)
+	return mmCreateOutboxEvent.mock
+}
+
+// When sets expectation for the UserRepository.CreateOutboxEvent which will trigger the result defined by the following
+// Then helper
+func (mmCreateOutboxEvent *mUserRepositoryMockCreateOutboxEvent) When(ctx context.Context, event *models.OutboxEvent) *UserRepositoryMockCreateOutboxEventExpectation {
+	if mmCreateOutboxEvent.mock.funcCreateOutboxEvent != nil {
+		mmCreateOutboxEvent.mock.t.Fatalf("UserRepositoryMock.CreateOutboxEvent mock is already set by Set")
+	}
+
+	expectation := &UserRepositoryMockCreateOutboxEventExpectation{
+		mock:               mmCreateOutboxEvent.mock,
+		params:             &UserRepositoryMockCreateOutboxEventParams{ctx, event},
+		expectationOrigins: UserRepositoryMockCreateOutboxEventExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreateOutboxEvent.expectations = append(mmCreateOutboxEvent.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserRepository.CreateOutboxEvent return parameters for the expectation previously defined by the When method
+func (e *UserRepositoryMockCreateOutboxEventExpectation) Then(err error) *UserRepositoryMock {
+	e.results = &UserRepositoryMockCreateOutboxEventResults{err}
+	return e.mock
+}
+
+// Times sets number of times UserRepository.CreateOutboxEvent should be invoked
+func (mmCreateOutboxEvent *mUserRepositoryMockCreateOutboxEvent) Times(n uint64) *mUserRepositoryMockCreateOutboxEvent {
+	if n == 0 {
+		mmCreateOutboxEvent.mock.t.Fatalf("Times of UserRepositoryMock.CreateOutboxEvent mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateOutboxEvent.expectedInvocations, n)
+	mmCreateOutboxEvent.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreateOutboxEvent
+}
+
+func (mmCreateOutboxEvent *mUserRepositoryMockCreateOutboxEvent) invocationsDone() bool {
+	if len(mmCreateOutboxEvent.expectations) == 0 && mmCreateOutboxEvent.defaultExpectation == nil && mmCreateOutboxEvent.mock.funcCreateOutboxEvent == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateOutboxEvent.mock.afterCreateOutboxEventCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateOutboxEvent.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateOutboxEvent implements UserRepository
+func (mmCreateOutboxEvent *UserRepositoryMock) CreateOutboxEvent(ctx context.Context, event *models.OutboxEvent) (err error) {
+	mm_atomic.AddUint64(&mmCreateOutboxEvent.beforeCreateOutboxEventCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateOutboxEvent.afterCreateOutboxEventCounter, 1)
+
+	mmCreateOutboxEvent.t.Helper()
+
+	if mmCreateOutboxEvent.inspectFuncCreateOutboxEvent != nil {
+		mmCreateOutboxEvent.inspectFuncCreateOutboxEvent(ctx, event)
+	}
+
+	mm_params := UserRepositoryMockCreateOutboxEventParams{ctx, event}
+
+	// Record call args
+	mmCreateOutboxEvent.CreateOutboxEventMock.mutex.Lock()
+	mmCreateOutboxEvent.CreateOutboxEventMock.callArgs = append(mmCreateOutboxEvent.CreateOutboxEventMock.callArgs, &mm_params)
+	mmCreateOutboxEvent.CreateOutboxEventMock.mutex.Unlock()
+
+	for _, e := range mmCreateOutboxEvent.CreateOutboxEventMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCreateOutboxEvent.CreateOutboxEventMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateOutboxEvent.CreateOutboxEventMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateOutboxEvent.CreateOutboxEventMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateOutboxEvent.CreateOutboxEventMock.defaultExpectation.paramPtrs
+
+		mm_got := UserRepositoryMockCreateOutboxEventParams{ctx, event}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateOutboxEvent.t.Errorf("UserRepositoryMock.CreateOutboxEvent got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateOutboxEvent.CreateOutboxEventMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.event != nil && !minimock.Equal(*mm_want_ptrs.event, mm_got.event) {
+				mmCreateOutboxEvent.t.Errorf("UserRepositoryMock.CreateOutboxEvent got unexpected parameter event, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateOutboxEvent.CreateOutboxEventMock.defaultExpectation.expectationOrigins.originEvent, *mm_want_ptrs.event, mm_got.event, minimock.Diff(*mm_want_ptrs.event, mm_got.event))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateOutboxEvent.t.Errorf("UserRepositoryMock.CreateOutboxEvent got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreateOutboxEvent.CreateOutboxEventMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateOutboxEvent.CreateOutboxEventMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateOutboxEvent.t.Fatal("No results are set for the UserRepositoryMock.CreateOutboxEvent")
+		}
+		return (*mm_results).err
+	}
+	if mmCreateOutboxEvent.funcCreateOutboxEvent != nil {
+		return mmCreateOutboxEvent.funcCreateOutboxEvent(ctx, event)
+	}
+	mmCreateOutboxEvent.t.Fatalf("Unexpected call to UserRepositoryMock.CreateOutboxEvent. %v %v", ctx, event)
+	return
+}
+
+// CreateOutboxEventAfterCounter returns a count of finished UserRepositoryMock.CreateOutboxEvent invocations
+func (mmCreateOutboxEvent *UserRepositoryMock) CreateOutboxEventAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateOutboxEvent.afterCreateOutboxEventCounter)
+}
+
+// CreateOutboxEventBeforeCounter returns a count of UserRepositoryMock.CreateOutboxEvent invocations
+func (mmCreateOutboxEvent *UserRepositoryMock) CreateOutboxEventBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateOutboxEvent.beforeCreateOutboxEventCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserRepositoryMock.CreateOutboxEvent.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateOutboxEvent *mUserRepositoryMockCreateOutboxEvent) Calls() []*UserRepositoryMockCreateOutboxEventParams {
+	mmCreateOutboxEvent.mutex.RLock()
+
+	argCopy := make([]*UserRepositoryMockCreateOutboxEventParams, len(mmCreateOutboxEvent.callArgs))
+	copy(argCopy, mmCreateOutboxEvent.callArgs)
+
+	mmCreateOutboxEvent.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateOutboxEventDone returns true if the count of the CreateOutboxEvent invocations corresponds
+// the number of defined expectations
+func (m *UserRepositoryMock) MinimockCreateOutboxEventDone() bool {
+	if m.CreateOutboxEventMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateOutboxEventMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateOutboxEventMock.invocationsDone()
+}
+
+// MinimockCreateOutboxEventInspect logs each unmet expectation
+func (m *UserRepositoryMock) MinimockCreateOutboxEventInspect() {
+	for _, e := range m.CreateOutboxEventMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserRepositoryMock.CreateOutboxEvent at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateOutboxEventCounter := mm_atomic.LoadUint64(&m.afterCreateOutboxEventCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateOutboxEventMock.defaultExpectation != nil && afterCreateOutboxEventCounter < 1 {
+		if m.CreateOutboxEventMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UserRepositoryMock.CreateOutboxEvent at\n%s", m.CreateOutboxEventMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UserRepositoryMock.CreateOutboxEvent at\n%s with params: %#v", m.CreateOutboxEventMock.defaultExpectation.expectationOrigins.origin, *m.CreateOutboxEventMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateOutboxEvent != nil && afterCreateOutboxEventCounter < 1 {
+		m.t.Errorf("Expected call to UserRepositoryMock.CreateOutboxEvent at\n%s", m.funcCreateOutboxEventOrigin)
+	}
+
+	if !m.CreateOutboxEventMock.invocationsDone() && afterCreateOutboxEventCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserRepositoryMock.CreateOutboxEvent at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateOutboxEventMock.expectedInvocations), m.CreateOutboxEventMock.expectedInvocationsOrigin, afterCreateOutboxEventCounter)
+	}
+}
+
+type mUserRepositoryMockCreatePersonalAccessToken struct {
+	optional           bool
+	mock               *UserRepositoryMock
+	defaultExpectation *UserRepositoryMockCreatePersonalAccessTokenExpectation
+	expectations       []*UserRepositoryMockCreatePersonalAccessTokenExpectation
+
+	callArgs []*UserRepositoryMockCreatePersonalAccessTokenParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UserRepositoryMockCreatePersonalAccessTokenExpectation specifies expectation struct of the UserRepository.CreatePersonalAccessToken
+type UserRepositoryMockCreatePersonalAccessTokenExpectation struct {
+	mock               *UserRepositoryMock
+	params             *UserRepositoryMockCreatePersonalAccessTokenParams
+	paramPtrs          *UserRepositoryMockCreatePersonalAccessTokenParamPtrs
+	expectationOrigins UserRepositoryMockCreatePersonalAccessTokenExpectationOrigins
+	results            *UserRepositoryMockCreatePersonalAccessTokenResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UserRepositoryMockCreatePersonalAccessTokenParams contains parameters of the UserRepository.CreatePersonalAccessToken
+type UserRepositoryMockCreatePersonalAccessTokenParams struct {
+	ctx   context.Context
+	token *models.PersonalAccessToken
+}
+
+// UserRepositoryMockCreatePersonalAccessTokenParamPtrs contains pointers to parameters of the UserRepository.CreatePersonalAccessToken
+type UserRepositoryMockCreatePersonalAccessTokenParamPtrs struct {
+	ctx   *context.Context
+	token **models.PersonalAccessToken
+}
+
+// UserRepositoryMockCreatePersonalAccessTokenResults contains results of the UserRepository.CreatePersonalAccessToken
+type UserRepositoryMockCreatePersonalAccessTokenResults struct {
+	err error
+}
+
+// UserRepositoryMockCreatePersonalAccessTokenOrigins contains origins of expectations of the UserRepository.CreatePersonalAccessToken
+type UserRepositoryMockCreatePersonalAccessTokenExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originToken string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreatePersonalAccessToken *mUserRepositoryMockCreatePersonalAccessToken) Optional() *mUserRepositoryMockCreatePersonalAccessToken {
+	mmCreatePersonalAccessToken.optional = true
+	return mmCreatePersonalAccessToken
+}
+
+// Expect sets up expected params for UserRepository.CreatePersonalAccessToken
+func (mmCreatePersonalAccessToken *mUserRepositoryMockCreatePersonalAccessToken) Expect(ctx context.Context, token *models.PersonalAccessToken) *mUserRepositoryMockCreatePersonalAccessToken {
+	if mmCreatePersonalAccessToken.mock.funcCreatePersonalAccessToken != nil {
+		mmCreatePersonalAccessToken.mock.t.Fatalf("UserRepositoryMock.CreatePersonalAccessToken mock is already set by Set")
+	}
+
+	if mmCreatePersonalAccessToken.defaultExpectation == nil {
+		mmCreatePersonalAccessToken.defaultExpectation = &UserRepositoryMockCreatePersonalAccessTokenExpectation{}
+	}
+
+	if mmCreatePersonalAccessToken.defaultExpectation.paramPtrs != nil {
+		mmCreatePersonalAccessToken.mock.t.Fatalf("UserRepositoryMock.CreatePersonalAccessToken mock is already set by ExpectParams functions")
+	}
+
+	mmCreatePersonalAccessToken.defaultExpectation.params = &UserRepositoryMockCreatePersonalAccessTokenParams{ctx, token}
+	mmCreatePersonalAccessToken.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreatePersonalAccessToken.expectations {
+		if minimock.Equal(e.params, mmCreatePersonalAccessToken.defaultExpectation.params) {
+			mmCreatePersonalAccessToken.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreatePersonalAccessToken.defaultExpectation.params)
+		}
+	}
+
+	return mmCreatePersonalAccessToken
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UserRepository.CreatePersonalAccessToken
+func (mmCreatePersonalAccessToken *mUserRepositoryMockCreatePersonalAccessToken) ExpectCtxParam1(ctx context.Context) *mUserRepositoryMockCreatePersonalAccessToken {
+	if mmCreatePersonalAccessToken.mock.funcCreatePersonalAccessToken != nil {
+		mmCreatePersonalAccessToken.mock.t.Fatalf("UserRepositoryMock.CreatePersonalAccessToken mock is already set by Set")
+	}
+
+	if mmCreatePersonalAccessToken.defaultExpectation == nil {
+		mmCreatePersonalAccessToken.defaultExpectation = &UserRepositoryMockCreatePersonalAccessTokenExpectation{}
+	}
+
+	if mmCreatePersonalAccessToken.defaultExpectation.params != nil {
+		mmCreatePersonalAccessToken.mock.t.Fatalf("UserRepositoryMock.CreatePersonalAccessToken mock is already set by Expect")
+	}
+
+	if mmCreatePersonalAccessToken.defaultExpectation.paramPtrs == nil {
+		mmCreatePersonalAccessToken.defaultExpectation.paramPtrs = &UserRepositoryMockCreatePersonalAccessTokenParamPtrs{}
+	}
+	mmCreatePersonalAccessToken.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreatePersonalAccessToken.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreatePersonalAccessToken
+}
+
+// ExpectTokenParam2 sets up expected param token for UserRepository.CreatePersonalAccessToken
+func (mmCreatePersonalAccessToken *mUserRepositoryMockCreatePersonalAccessToken) ExpectTokenParam2(token *models.PersonalAccessToken) *mUserRepositoryMockCreatePersonalAccessToken {
+	if mmCreatePersonalAccessToken.mock.funcCreatePersonalAccessToken != nil {
+		mmCreatePersonalAccessToken.mock.t.Fatalf("UserRepositoryMock.CreatePersonalAccessToken mock is already set by Set")
+	}
+
+	if mmCreatePersonalAccessToken.defaultExpectation == nil {
+		mmCreatePersonalAccessToken.defaultExpectation = &UserRepositoryMockCreatePersonalAccessTokenExpectation{}
+	}
+
+	if mmCreatePersonalAccessToken.defaultExpectation.params != nil {
+		mmCreatePersonalAccessToken.mock.t.Fatalf("UserRepositoryMock.CreatePersonalAccessToken mock is already set by Expect")
+	}
+
+	if mmCreatePersonalAccessToken.defaultExpectation.paramPtrs == nil {
+		mmCreatePersonalAccessToken.defaultExpectation.paramPtrs = &UserRepositoryMockCreatePersonalAccessTokenParamPtrs{}
+	}
+	mmCreatePersonalAccessToken.defaultExpectation.paramPtrs.token = &token
+	mmCreatePersonalAccessToken.defaultExpectation.expectationOrigins.originToken = minimock.CallerInfo(1)
+
+	return mmCreatePersonalAccessToken
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserRepository.CreatePersonalAccessToken
+func (mmCreatePersonalAccessToken *mUserRepositoryMockCreatePersonalAccessToken) Inspect(f func(ctx context.Context, token *models.PersonalAccessToken)) *mUserRepositoryMockCreatePersonalAccessToken {
+	if mmCreatePersonalAccessToken.mock.inspectFuncCreatePersonalAccessToken != nil {
+		mmCreatePersonalAccessToken.mock.t.Fatalf("Inspect function is already set for UserRepositoryMock.CreatePersonalAccessToken")
+	}
+
+	mmCreatePersonalAccessToken.mock.inspectFuncCreatePersonalAccessToken = f
+
+	return mmCreatePersonalAccessToken
+}
+
+// Return sets up results that will be returned by UserRepository.CreatePersonalAccessToken
+func (mmCreatePersonalAccessToken *mUserRepositoryMockCreatePersonalAccessToken) Return(err error) *UserRepositoryMock {
+	if mmCreatePersonalAccessToken.mock.funcCreatePersonalAccessToken != nil {
+		mmCreatePersonalAccessToken.mock.t.Fatalf("UserRepositoryMock.CreatePersonalAccessToken mock is already set by Set")
+	}
+
+	if mmCreatePersonalAccessToken.defaultExpectation == nil {
+		mmCreatePersonalAccessToken.defaultExpectation = &UserRepositoryMockCreatePersonalAccessTokenExpectation{mock: mmCreatePersonalAccessToken.mock}
+	}
+	mmCreatePersonalAccessToken.defaultExpectation.results = &UserRepositoryMockCreatePersonalAccessTokenResults{err}
+	mmCreatePersonalAccessToken.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreatePersonalAccessToken.mock
+}
+
+// Set uses given function f to mock the UserRepository.CreatePersonalAccessToken method
+func (mmCreatePersonalAccessToken *mUserRepositoryMockCreatePersonalAccessToken) Set(f func(ctx context.Context, token *models.PersonalAccessToken) (err error)) *UserRepositoryMock {
+	if mmCreatePersonalAccessToken.defaultExpectation != nil {
+		mmCreatePersonalAccessToken.mock.t.Fatalf("Default expectation is already set for the UserRepository.CreatePersonalAccessToken method")
+	}
+
+	if len(mmCreatePersonalAccessToken.expectations) > 0 {
+		mmCreatePersonalAccessToken.mock.t.Fatalf("Some expectations are already set for the UserRepository.CreatePersonalAccessToken method")
+	}
+
+	mmCreatePersonalAccessToken.mock.funcCreatePersonalAccessToken = f
+	mmCreatePersonalAccessToken.mock.funcCreatePersonalAccessTokenOrigin = minimock.CallerInfo(1)
+	return mmCreatePersonalAccessToken.mock
+}
+
+// When sets expectation for the UserRepository.CreatePersonalAccessToken which will trigger the result defined by the following
+// Then helper
+func (mmCreatePersonalAccessToken *mUserRepositoryMockCreatePersonalAccessToken) When(ctx context.Context, token *models.PersonalAccessToken) *UserRepositoryMockCreatePersonalAccessTokenExpectation {
+	if mmCreatePersonalAccessToken.mock.funcCreatePersonalAccessToken != nil {
+		mmCreatePersonalAccessToken.mock.t.Fatalf("UserRepositoryMock.CreatePersonalAccessToken mock is already set by Set")
+	}
+
+	expectation := &UserRepositoryMockCreatePersonalAccessTokenExpectation{
+		mock:               mmCreatePersonalAccessToken.mock,
+		params:             &UserRepositoryMockCreatePersonalAccessTokenParams{ctx, token},
+		expectationOrigins: UserRepositoryMockCreatePersonalAccessTokenExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreatePersonalAccessToken.expectations = append(mmCreatePersonalAccessToken.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserRepository.CreatePersonalAccessToken return parameters for the expectation previously defined by the When method
+func (e *UserRepositoryMockCreatePersonalAccessTokenExpectation) Then(err error) *UserRepositoryMock {
+	e.results = &UserRepositoryMockCreatePersonalAccessTokenResults{err}
+	return e.mock
+}
+
+// Times sets number of times UserRepository.CreatePersonalAccessToken should be invoked
+func (mmCreatePersonalAccessToken *mUserRepositoryMockCreatePersonalAccessToken) Times(n uint64) *mUserRepositoryMockCreatePersonalAccessToken {
+	if n == 0 {
+		mmCreatePersonalAccessToken.mock.t.Fatalf("Times of UserRepositoryMock.CreatePersonalAccessToken mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreatePersonalAccessToken.expectedInvocations, n)
+	mmCreatePersonalAccessToken.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreatePersonalAccessToken
+}
+
+func (mmCreatePersonalAccessToken *mUserRepositoryMockCreatePersonalAccessToken) invocationsDone() bool {
+	if len(mmCreatePersonalAccessToken.expectations) == 0 && mmCreatePersonalAccessToken.defaultExpectation == nil && mmCreatePersonalAccessToken.mock.funcCreatePersonalAccessToken == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreatePersonalAccessToken.mock.afterCreatePersonalAccessTokenCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreatePersonalAccessToken.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreatePersonalAccessToken implements UserRepository
+func (mmCreatePersonalAccessToken *UserRepositoryMock) CreatePersonalAccessToken(ctx context.Context, token *models.PersonalAccessToken) (err error) {
+	mm_atomic.AddUint64(&mmCreatePersonalAccessToken.beforeCreatePersonalAccessTokenCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreatePersonalAccessToken.afterCreatePersonalAccessTokenCounter, 1)
+
+	mmCreatePersonalAccessToken.t.Helper()
+
+	if mmCreatePersonalAccessToken.inspectFuncCreatePersonalAccessToken != nil {
+		mmCreatePersonalAccessToken.inspectFuncCreatePersonalAccessToken(ctx, token)
+	}
+
+	mm_params := UserRepositoryMockCreatePersonalAccessTokenParams{ctx, token}
+
+	// Record call args
+	mmCreatePersonalAccessToken.CreatePersonalAccessTokenMock.mutex.Lock()
+	mmCreatePersonalAccessToken.CreatePersonalAccessTokenMock.callArgs = append(mmCreatePersonalAccessToken.CreatePersonalAccessTokenMock.callArgs, &mm_params)
+	mmCreatePersonalAccessToken.CreatePersonalAccessTokenMock.mutex.Unlock()
+
+	for _, e := range mmCreatePersonalAccessToken.CreatePersonalAccessTokenMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCreatePersonalAccessToken.CreatePersonalAccessTokenMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreatePersonalAccessToken.CreatePersonalAccessTokenMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreatePersonalAccessToken.CreatePersonalAccessTokenMock.defaultExpectation.params
+		mm_want_ptrs := mmCreatePersonalAccessToken.CreatePersonalAccessTokenMock.defaultExpectation.paramPtrs
+
+		mm_got := UserRepositoryMockCreatePersonalAccessTokenParams{ctx, token}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreatePersonalAccessToken.t.Errorf("UserRepositoryMock.CreatePersonalAccessToken got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreatePersonalAccessToken.CreatePersonalAccessTokenMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.token != nil && !minimock.Equal(*mm_want_ptrs.token, mm_got.token) {
+				mmCreatePersonalAccessToken.t.Errorf("UserRepositoryMock.CreatePersonalAccessToken got unexpected parameter token, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreatePersonalAccessToken.CreatePersonalAccessTokenMock.defaultExpectation.expectationOrigins.originToken, *mm_want_ptrs.token, mm_got.token, minimock.Diff(*mm_want_ptrs.token, mm_got.token))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreatePersonalAccessToken.t.Errorf("UserRepositoryMock.CreatePersonalAccessToken got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreatePersonalAccessToken.CreatePersonalAccessTokenMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreatePersonalAccessToken.CreatePersonalAccessTokenMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreatePersonalAccessToken.t.Fatal("No results are set for the UserRepositoryMock.CreatePersonalAccessToken")
+		}
+		return (*mm_results).err
+	}
+	if mmCreatePersonalAccessToken.funcCreatePersonalAccessToken != nil {
+		return mmCreatePersonalAccessToken.funcCreatePersonalAccessToken(ctx, token)
+	}
+	mmCreatePersonalAccessToken.t.Fatalf("Unexpected call to UserRepositoryMock.CreatePersonalAccessToken. %v %v", ctx, token)
+	return
+}
+
+// CreatePersonalAccessTokenAfterCounter returns a count of finished UserRepositoryMock.CreatePersonalAccessToken invocations
+func (mmCreatePersonalAccessToken *UserRepositoryMock) CreatePersonalAccessTokenAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreatePersonalAccessToken.afterCreatePersonalAccessTokenCounter)
+}
+
+// CreatePersonalAccessTokenBeforeCounter returns a count of UserRepositoryMock.CreatePersonalAccessToken invocations
+func (mmCreatePersonalAccessToken *UserRepositoryMock) CreatePersonalAccessTokenBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreatePersonalAccessToken.beforeCreatePersonalAccessTokenCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserRepositoryMock.CreatePersonalAccessToken.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreatePersonalAccessToken *mUserRepositoryMockCreatePersonalAccessToken) Calls() []*UserRepositoryMockCreatePersonalAccessTokenParams {
+	mmCreatePersonalAccessToken.mutex.RLock()
+
+	argCopy := make([]*UserRepositoryMockCreatePersonalAccessTokenParams, len(mmCreatePersonalAccessToken.callArgs))
+	copy(argCopy, mmCreatePersonalAccessToken.callArgs)
+
+	mmCreatePersonalAccessToken.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreatePersonalAccessTokenDone returns true if the count of the CreatePersonalAccessToken invocations corresponds
+// the number of defined expectations
+func (m *UserRepositoryMock) MinimockCreatePersonalAccessTokenDone() bool {
+	if m.CreatePersonalAccessTokenMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreatePersonalAccessTokenMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreatePersonalAccessTokenMock.invocationsDone()
+}
+
+// MinimockCreatePersonalAccessTokenInspect logs each unmet expectation
+func (m *UserRepositoryMock) MinimockCreatePersonalAccessTokenInspect() {
+	for _, e := range m.CreatePersonalAccessTokenMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserRepositoryMock.CreatePersonalAccessToken at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreatePersonalAccessTokenCounter := mm_atomic.LoadUint64(&m.afterCreatePersonalAccessTokenCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreatePersonalAccessTokenMock.defaultExpectation != nil && afterCreatePersonalAccessTokenCounter < 1 {
+		if m.CreatePersonalAccessTokenMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UserRepositoryMock.CreatePersonalAccessToken at\n%s", m.CreatePersonalAccessTokenMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UserRepositoryMock.CreatePersonalAccessToken at\n%s with params: %#v", m.CreatePersonalAccessTokenMock.defaultExpectation.expectationOrigins.origin, *m.CreatePersonalAccessTokenMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreatePersonalAccessToken != nil && afterCreatePersonalAccessTokenCounter < 1 {
+		m.t.Errorf("Expected call to UserRepositoryMock.CreatePersonalAccessToken at\n%s", m.funcCreatePersonalAccessTokenOrigin)
+	}
+
+	if !m.CreatePersonalAccessTokenMock.invocationsDone() && afterCreatePersonalAccessTokenCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserRepositoryMock.CreatePersonalAccessToken at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreatePersonalAccessTokenMock.expectedInvocations), m.CreatePersonalAccessTokenMock.expectedInvocationsOrigin, afterCreatePersonalAccessTokenCounter)
+	}
+}
+
+type mUserRepositoryMockDeletePersonalAccessToken struct {
+	optional           bool
+	mock               *UserRepositoryMock
+	defaultExpectation *UserRepositoryMockDeletePersonalAccessTokenExpectation
+	expectations       []*UserRepositoryMockDeletePersonalAccessTokenExpectation
+
+	callArgs []*UserRepositoryMockDeletePersonalAccessTokenParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UserRepositoryMockDeletePersonalAccessTokenExpectation specifies expectation struct of the UserRepository.DeletePersonalAccessToken
+type UserRepositoryMockDeletePersonalAccessTokenExpectation struct {
+	mock               *UserRepositoryMock
+	params             *UserRepositoryMockDeletePersonalAccessTokenParams
+	paramPtrs          *UserRepositoryMockDeletePersonalAccessTokenParamPtrs
+	expectationOrigins UserRepositoryMockDeletePersonalAccessTokenExpectationOrigins
+	results            *UserRepositoryMockDeletePersonalAccessTokenResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UserRepositoryMockDeletePersonalAccessTokenParams contains parameters of the UserRepository.DeletePersonalAccessToken
+type UserRepositoryMockDeletePersonalAccessTokenParams struct {
+	ctx     context.Context
+	userID  string
+	tokenID string
+}
+
+// UserRepositoryMockDeletePersonalAccessTokenParamPtrs contains pointers to parameters of the UserRepository.DeletePersonalAccessToken
+type UserRepositoryMockDeletePersonalAccessTokenParamPtrs struct {
+	ctx     *context.Context
+	userID  *string
+	tokenID *string
+}
+
+// UserRepositoryMockDeletePersonalAccessTokenResults contains results of the UserRepository.DeletePersonalAccessToken
+type UserRepositoryMockDeletePersonalAccessTokenResults struct {
+	err error
+}
+
+// UserRepositoryMockDeletePersonalAccessTokenOrigins contains origins of expectations of the UserRepository.DeletePersonalAccessToken
+type UserRepositoryMockDeletePersonalAccessTokenExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originUserID  string
+	originTokenID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeletePersonalAccessToken *mUserRepositoryMockDeletePersonalAccessToken) Optional() *mUserRepositoryMockDeletePersonalAccessToken {
+	mmDeletePersonalAccessToken.optional = true
+	return mmDeletePersonalAccessToken
+}
+
+// Expect sets up expected params for UserRepository.DeletePersonalAccessToken
+func (mmDeletePersonalAccessToken *mUserRepositoryMockDeletePersonalAccessToken) Expect(ctx context.Context, userID string, tokenID string) *mUserRepositoryMockDeletePersonalAccessToken {
+	if mmDeletePersonalAccessToken.mock.funcDeletePersonalAccessToken != nil {
+		mmDeletePersonalAccessToken.mock.t.Fatalf("UserRepositoryMock.DeletePersonalAccessToken mock is already set by Set")
+	}
+
+	if mmDeletePersonalAccessToken.defaultExpectation == nil {
+		mmDeletePersonalAccessToken.defaultExpectation = &UserRepositoryMockDeletePersonalAccessTokenExpectation{}
+	}
+
+	if mmDeletePersonalAccessToken.defaultExpectation.paramPtrs != nil {
+		mmDeletePersonalAccessToken.mock.t.Fatalf("UserRepositoryMock.DeletePersonalAccessToken mock is already set by ExpectParams functions")
+	}
+
+	mmDeletePersonalAccessToken.defaultExpectation.params = &UserRepositoryMockDeletePersonalAccessTokenParams{ctx, userID, tokenID}
+	mmDeletePersonalAccessToken.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeletePersonalAccessToken.expectations {
+		if minimock.Equal(e.params, mmDeletePersonalAccessToken.defaultExpectation.params) {
+			mmDeletePersonalAccessToken.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeletePersonalAccessToken.defaultExpectation.params)
+		}
+	}
+
+	return mmDeletePersonalAccessToken
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UserRepository.DeletePersonalAccessToken
+func (mmDeletePersonalAccessToken *mUserRepositoryMockDeletePersonalAccessToken) ExpectCtxParam1(ctx context.Context) *mUserRepositoryMockDeletePersonalAccessToken {
+	if mmDeletePersonalAccessToken.mock.funcDeletePersonalAccessToken != nil {
+		mmDeletePersonalAccessToken.mock.t.Fatalf("UserRepositoryMock.DeletePersonalAccessToken mock is already set by Set")
+	}
+
+	if mmDeletePersonalAccessToken.defaultExpectation == nil {
+		mmDeletePersonalAccessToken.defaultExpectation = &UserRepositoryMockDeletePersonalAccessTokenExpectation{}
+	}
+
+	if mmDeletePersonalAccessToken.defaultExpectation.params != nil {
+		mmDeletePersonalAccessToken.mock.t.Fatalf("UserRepositoryMock.DeletePersonalAccessToken mock is already set by Expect")
+	}
+
+	if mmDeletePersonalAccessToken.defaultExpectation.paramPtrs == nil {
+		mmDeletePersonalAccessToken.defaultExpectation.paramPtrs = &UserRepositoryMockDeletePersonalAccessTokenParamPtrs{}
+	}
+	mmDeletePersonalAccessToken.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeletePersonalAccessToken.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeletePersonalAccessToken
+}
+
+// ExpectUserIDParam2 sets up expected param userID for UserRepository.DeletePersonalAccessToken
+func (mmDeletePersonalAccessToken *mUserRepositoryMockDeletePersonalAccessToken) ExpectUserIDParam2(userID string) *mUserRepositoryMockDeletePersonalAccessToken {
+	if mmDeletePersonalAccessToken.mock.funcDeletePersonalAccessToken != nil {
+		mmDeletePersonalAccessToken.mock.t.Fatalf("UserRepositoryMock.DeletePersonalAccessToken mock is already set by Set")
+	}
+
+	if mmDeletePersonalAccessToken.defaultExpectation == nil {
+		mmDeletePersonalAccessToken.defaultExpectation = &UserRepositoryMockDeletePersonalAccessTokenExpectation{}
+	}
+
+	if mmDeletePersonalAccessToken.defaultExpectation.params != nil {
+		mmDeletePersonalAccessToken.mock.t.Fatalf("UserRepositoryMock.DeletePersonalAccessToken mock is already set by Expect")
+	}
+
+	if mmDeletePersonalAccessToken.defaultExpectation.paramPtrs == nil {
+		mmDeletePersonalAccessToken.defaultExpectation.paramPtrs = &UserRepositoryMockDeletePersonalAccessTokenParamPtrs{}
+	}
+	mmDeletePersonalAccessToken.defaultExpectation.paramPtrs.userID = &userID
+	mmDeletePersonalAccessToken.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmDeletePersonalAccessToken
+}
+
+// ExpectTokenIDParam3 sets up expected param tokenID for UserRepository.DeletePersonalAccessToken
+func (mmDeletePersonalAccessToken *mUserRepositoryMockDeletePersonalAccessToken) ExpectTokenIDParam3(tokenID string) *mUserRepositoryMockDeletePersonalAccessToken {
+	if mmDeletePersonalAccessToken.mock.funcDeletePersonalAccessToken != nil {
+		mmDeletePersonalAccessToken.mock.t.Fatalf("UserRepositoryMock.DeletePersonalAccessToken mock is already set by Set")
+	}
+
+	if mmDeletePersonalAccessToken.defaultExpectation == nil {
+		mmDeletePersonalAccessToken.defaultExpectation = &UserRepositoryMockDeletePersonalAccessTokenExpectation{}
+	}
+
+	if mmDeletePersonalAccessToken.defaultExpectation.params != nil {
+		mmDeletePersonalAccessToken.mock.t.Fatalf("UserRepositoryMock.DeletePersonalAccessToken mock is already set by Expect")
+	}
+
+	if mmDeletePersonalAccessToken.defaultExpectation.paramPtrs == nil {
+		mmDeletePersonalAccessToken.defaultExpectation.paramPtrs = &UserRepositoryMockDeletePersonalAccessTokenParamPtrs{}
+	}
+	mmDeletePersonalAccessToken.defaultExpectation.paramPtrs.tokenID = &tokenID
+	mmDeletePersonalAccessToken.defaultExpectation.expectationOrigins.originTokenID = minimock.CallerInfo(1)
+
+	return mmDeletePersonalAccessToken
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserRepository.DeletePersonalAccessToken
+func (mmDeletePersonalAccessToken *mUserRepositoryMockDeletePersonalAccessToken) Inspect(f func(ctx context.Context, userID string, tokenID string)) *mUserRepositoryMockDeletePersonalAccessToken {
+	if mmDeletePersonalAccessToken.mock.inspectFuncDeletePersonalAccessToken != nil {
+		mmDeletePersonalAccessToken.mock.t.Fatalf("Inspect function is already set for UserRepositoryMock.DeletePersonalAccessToken")
+	}
+
+	mmDeletePersonalAccessToken.mock.inspectFuncDeletePersonalAccessToken = f
+
+	return mmDeletePersonalAccessToken
+}
+
+// Return sets up results that will be returned by UserRepository.DeletePersonalAccessToken
+func (mmDeletePersonalAccessToken *mUserRepositoryMockDeletePersonalAccessToken) Return(err error) *UserRepositoryMock {
+	if mmDeletePersonalAccessToken.mock.funcDeletePersonalAccessToken != nil {
+		mmDeletePersonalAccessToken.mock.t.Fatalf("UserRepositoryMock.DeletePersonalAccessToken mock is already set by Set")
+	}
+
+	if mmDeletePersonalAccessToken.defaultExpectation == nil {
+		mmDeletePersonalAccessToken.defaultExpectation = &UserRepositoryMockDeletePersonalAccessTokenExpectation{mock: mmDeletePersonalAccessToken.mock}
+	}
+	mmDeletePersonalAccessToken.defaultExpectation.results = &UserRepositoryMockDeletePersonalAccessTokenResults{err}
+	mmDeletePersonalAccessToken.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeletePersonalAccessToken.mock
+}
+
+// Set uses given function f to mock the UserRepository.DeletePersonalAccessToken method
+func (mmDeletePersonalAccessToken *mUserRepositoryMockDeletePersonalAccessToken) Set(f func(ctx context.Context, userID string, tokenID string) (err error)) *UserRepositoryMock {
+	if mmDeletePersonalAccessToken.defaultExpectation != nil {
+		mmDeletePersonalAccessToken.mock.t.Fatalf("Default expectation is already set for the UserRepository.DeletePersonalAccessToken method")
+	}
+
+	if len(mmDeletePersonalAccessToken.expectations) > 0 {
+		mmDeletePersonalAccessToken.mock.t.Fatalf("Some expectations are already set for the UserRepository.DeletePersonalAccessToken method")
+	}
+
+	mmDeletePersonalAccessToken.mock.funcDeletePersonalAccessToken = f
+	mmDeletePersonalAccessToken.mock.funcDeletePersonalAccessTokenOrigin = minimock.CallerInfo(1)
+	return mmDeletePersonalAccessToken.mock
+}
+
+// When sets expectation for the UserRepository.DeletePersonalAccessToken which will trigger the result defined by the following
+// Then helper
+func (mmDeletePersonalAccessToken *mUserRepositoryMockDeletePersonalAccessToken) When(ctx context.Context, userID string, tokenID string) *UserRepositoryMockDeletePersonalAccessTokenExpectation {
+	if mmDeletePersonalAccessToken.mock.funcDeletePersonalAccessToken != nil {
+		mmDeletePersonalAccessToken.mock.t.Fatalf("UserRepositoryMock.DeletePersonalAccessToken mock is already set by Set")
+	}
+
+	expectation := &UserRepositoryMockDeletePersonalAccessTokenExpectation{
+		mock:               mmDeletePersonalAccessToken.mock,
+		params:             &UserRepositoryMockDeletePersonalAccessTokenParams{ctx, userID, tokenID},
+		expectationOrigins: UserRepositoryMockDeletePersonalAccessTokenExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeletePersonalAccessToken.expectations = append(mmDeletePersonalAccessToken.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserRepository.DeletePersonalAccessToken return parameters for the expectation previously defined by the When method
+func (e *UserRepositoryMockDeletePersonalAccessTokenExpectation) Then(err error) *UserRepositoryMock {
+	e.results = &UserRepositoryMockDeletePersonalAccessTokenResults{err}
+	return e.mock
+}
+
+// Times sets number of times UserRepository.DeletePersonalAccessToken should be invoked
+func (mmDeletePersonalAccessToken *mUserRepositoryMockDeletePersonalAccessToken) Times(n uint64) *mUserRepositoryMockDeletePersonalAccessToken {
+	if n == 0 {
+		mmDeletePersonalAccessToken.mock.t.Fatalf("Times of UserRepositoryMock.DeletePersonalAccessToken mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeletePersonalAccessToken.expectedInvocations, n)
+	mmDeletePersonalAccessToken.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeletePersonalAccessToken
+}
+
+func (mmDeletePersonalAccessToken *mUserRepositoryMockDeletePersonalAccessToken) invocationsDone() bool {
+	if len(mmDeletePersonalAccessToken.expectations) == 0 && mmDeletePersonalAccessToken.defaultExpectation == nil && mmDeletePersonalAccessToken.mock.funcDeletePersonalAccessToken == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeletePersonalAccessToken.mock.afterDeletePersonalAccessTokenCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeletePersonalAccessToken.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeletePersonalAccessToken implements UserRepository
+func (mmDeletePersonalAccessToken *UserRepositoryMock) DeletePersonalAccessToken(ctx context.Context, userID string, tokenID string) (err error) {
+	mm_atomic.AddUint64(&mmDeletePersonalAccessToken.beforeDeletePersonalAccessTokenCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeletePersonalAccessToken.afterDeletePersonalAccessTokenCounter, 1)
+
+	mmDeletePersonalAccessToken.t.Helper()
+
+	if mmDeletePersonalAccessToken.inspectFuncDeletePersonalAccessToken != nil {
+		mmDeletePersonalAccessToken.inspectFuncDeletePersonalAccessToken(ctx, userID, tokenID)
+	}
+
+	mm_params := UserRepositoryMockDeletePersonalAccessTokenParams{ctx, userID, tokenID}
+
+	// Record call args
+	mmDeletePersonalAccessToken.DeletePersonalAccessTokenMock.mutex.Lock()
+	mmDeletePersonalAccessToken.DeletePersonalAccessTokenMock.callArgs = append(mmDeletePersonalAccessToken.DeletePersonalAccessTokenMock.callArgs, &mm_params)
+	mmDeletePersonalAccessToken.DeletePersonalAccessTokenMock.mutex.Unlock()
+
+	for _, e := range mmDeletePersonalAccessToken.DeletePersonalAccessTokenMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeletePersonalAccessToken.DeletePersonalAccessTokenMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeletePersonalAccessToken.DeletePersonalAccessTokenMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeletePersonalAccessToken.DeletePersonalAccessTokenMock.defaultExpectation.params
+		mm_want_ptrs := mmDeletePersonalAccessToken.DeletePersonalAccessTokenMock.defaultExpectation.paramPtrs
+
+		mm_got := UserRepositoryMockDeletePersonalAccessTokenParams{ctx, userID, tokenID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeletePersonalAccessToken.t.Errorf("UserRepositoryMock.DeletePersonalAccessToken got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeletePersonalAccessToken.DeletePersonalAccessTokenMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmDeletePersonalAccessToken.t.Errorf("UserRepositoryMock.DeletePersonalAccessToken got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeletePersonalAccessToken.DeletePersonalAccessTokenMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.tokenID != nil && !minimock.Equal(*mm_want_ptrs.tokenID, mm_got.tokenID) {
+				mmDeletePersonalAccessToken.t.Errorf("UserRepositoryMock.DeletePersonalAccessToken got unexpected parameter tokenID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeletePersonalAccessToken.DeletePersonalAccessTokenMock.defaultExpectation.expectationOrigins.originTokenID, *mm_want_ptrs.tokenID, mm_got.tokenID, minimock.Diff(*mm_want_ptrs.tokenID, mm_got.tokenID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeletePersonalAccessToken.t.Errorf("UserRepositoryMock.DeletePersonalAccessToken got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeletePersonalAccessToken.DeletePersonalAccessTokenMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeletePersonalAccessToken.DeletePersonalAccessTokenMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeletePersonalAccessToken.t.Fatal("No results are set for the UserRepositoryMock.DeletePersonalAccessToken")
+		}
+		return (*mm_results).err
+	}
+	if mmDeletePersonalAccessToken.funcDeletePersonalAccessToken != nil {
+		return mmDeletePersonalAccessToken.funcDeletePersonalAccessToken(ctx, userID, tokenID)
+	}
+	mmDeletePersonalAccessToken.t.Fatalf("Unexpected call to UserRepositoryMock.DeletePersonalAccessToken. %v %v %v", ctx, userID, tokenID)
+	return
+}
+
+// DeletePersonalAccessTokenAfterCounter returns a count of finished UserRepositoryMock.DeletePersonalAccessToken invocations
+func (mmDeletePersonalAccessToken *UserRepositoryMock) DeletePersonalAccessTokenAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeletePersonalAccessToken.afterDeletePersonalAccessTokenCounter)
+}
+
+// DeletePersonalAccessTokenBeforeCounter returns a count of UserRepositoryMock.DeletePersonalAccessToken invocations
+func (mmDeletePersonalAccessToken *UserRepositoryMock) DeletePersonalAccessTokenBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeletePersonalAccessToken.beforeDeletePersonalAccessTokenCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserRepositoryMock.DeletePersonalAccessToken.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeletePersonalAccessToken *mUserRepositoryMockDeletePersonalAccessToken) Calls() []*UserRepositoryMockDeletePersonalAccessTokenParams {
+	mmDeletePersonalAccessToken.mutex.RLock()
+
+	argCopy := make([]*UserRepositoryMockDeletePersonalAccessTokenParams, len(mmDeletePersonalAccessToken.callArgs))
+	copy(argCopy, mmDeletePersonalAccessToken.callArgs)
+
+	mmDeletePersonalAccessToken.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeletePersonalAccessTokenDone returns true if the count of the DeletePersonalAccessToken invocations corresponds
+// the number of defined expectations
+func (m *UserRepositoryMock) MinimockDeletePersonalAccessTokenDone() bool {
+	if m.DeletePersonalAccessTokenMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeletePersonalAccessTokenMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeletePersonalAccessTokenMock.invocationsDone()
+}
+
+// MinimockDeletePersonalAccessTokenInspect logs each unmet expectation
+func (m *UserRepositoryMock) MinimockDeletePersonalAccessTokenInspect() {
+	for _, e := range m.DeletePersonalAccessTokenMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserRepositoryMock.DeletePersonalAccessToken at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeletePersonalAccessTokenCounter := mm_atomic.LoadUint64(&m.afterDeletePersonalAccessTokenCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeletePersonalAccessTokenMock.defaultExpectation != nil && afterDeletePersonalAccessTokenCounter < 1 {
+		if m.DeletePersonalAccessTokenMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UserRepositoryMock.DeletePersonalAccessToken at\n%s", m.DeletePersonalAccessTokenMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UserRepositoryMock.DeletePersonalAccessToken at\n%s with params: %#v", m.DeletePersonalAccessTokenMock.defaultExpectation.expectationOrigins.origin, *m.DeletePersonalAccessTokenMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeletePersonalAccessToken != nil && afterDeletePersonalAccessTokenCounter < 1 {
+		m.t.Errorf("Expected call to UserRepositoryMock.DeletePersonalAccessToken at\n%s", m.funcDeletePersonalAccessTokenOrigin)
+	}
+
+	if !m.DeletePersonalAccessTokenMock.invocationsDone() && afterDeletePersonalAccessTokenCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserRepositoryMock.DeletePersonalAccessToken at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeletePersonalAccessTokenMock.expectedInvocations), m.DeletePersonalAccessTokenMock.expectedInvocationsOrigin, afterDeletePersonalAccessTokenCounter)
+	}
+}
+
+type mUserRepositoryMockDeleteSession struct {
+	optional           bool
+	mock               *UserRepositoryMock
+	defaultExpectation *UserRepositoryMockDeleteSessionExpectation
+	expectations       []*UserRepositoryMockDeleteSessionExpectation
+
+	callArgs []*UserRepositoryMockDeleteSessionParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UserRepositoryMockDeleteSessionExpectation specifies expectation struct of the UserRepository.DeleteSession
+type UserRepositoryMockDeleteSessionExpectation struct {
+	mock               *UserRepositoryMock
+	params             *UserRepositoryMockDeleteSessionParams
+	paramPtrs          *UserRepositoryMockDeleteSessionParamPtrs
+	expectationOrigins UserRepositoryMockDeleteSessionExpectationOrigins
+	results            *UserRepositoryMockDeleteSessionResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UserRepositoryMockDeleteSessionParams contains parameters of the UserRepository.DeleteSession
+type UserRepositoryMockDeleteSessionParams struct {
+	ctx       context.Context
+	userID    string
+	sessionID string
+}
+
+// UserRepositoryMockDeleteSessionParamPtrs contains pointers to parameters of the UserRepository.DeleteSession
+type UserRepositoryMockDeleteSessionParamPtrs struct {
+	ctx       *context.Context
+	userID    *string
+	sessionID *string
+}
+
+// UserRepositoryMockDeleteSessionResults contains results of the UserRepository.DeleteSession
+type UserRepositoryMockDeleteSessionResults struct {
+	err error
+}
+
+// UserRepositoryMockDeleteSessionOrigins contains origins of expectations of the UserRepository.DeleteSession
+type UserRepositoryMockDeleteSessionExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originUserID    string
+	originSessionID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteSession *mUserRepositoryMockDeleteSession) Optional() *mUserRepositoryMockDeleteSession {
+	mmDeleteSession.optional = true
+	return mmDeleteSession
+}
+
+// Expect sets up expected params for UserRepository.DeleteSession
+func (mmDeleteSession *mUserRepositoryMockDeleteSession) Expect(ctx context.Context, userID string, sessionID string) *mUserRepositoryMockDeleteSession {
+	if mmDeleteSession.mock.funcDeleteSession != nil {
+		mmDeleteSession.mock.t.Fatalf("UserRepositoryMock.DeleteSession mock is already set by Set")
+	}
+
+	if mmDeleteSession.defaultExpectation == nil {
+		mmDeleteSession.defaultExpectation = &UserRepositoryMockDeleteSessionExpectation{}
+	}
+
+	if mmDeleteSession.defaultExpectation.paramPtrs != nil {
+		mmDeleteSession.mock.t.Fatalf("UserRepositoryMock.DeleteSession mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteSession.defaultExpectation.params = &UserRepositoryMockDeleteSessionParams{ctx, userID, sessionID}
+	mmDeleteSession.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteSession.expectations {
+		if minimock.Equal(e.params, mmDeleteSession.defaultExpectation.params) {
+			mmDeleteSession.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteSession.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteSession
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UserRepository.DeleteSession
+func (mmDeleteSession *mUserRepositoryMockDeleteSession) ExpectCtxParam1(ctx context.Context) *mUserRepositoryMockDeleteSession {
+	if mmDeleteSession.mock.funcDeleteSession != nil {
+		mmDeleteSession.mock.t.Fatalf("UserRepositoryMock.DeleteSession mock is already set by Set")
+	}
+
+	if mmDeleteSession.defaultExpectation == nil {
+		mmDeleteSession.defaultExpectation = &UserRepositoryMockDeleteSessionExpectation{}
+	}
+
+	if mmDeleteSession.defaultExpectation.params != nil {
+		mmDeleteSession.mock.t.Fatalf("UserRepositoryMock.DeleteSession mock is already set by Expect")
+	}
+
+	if mmDeleteSession.defaultExpectation.paramPtrs == nil {
+		mmDeleteSession.defaultExpectation.paramPtrs = &UserRepositoryMockDeleteSessionParamPtrs{}
+	}
+	mmDeleteSession.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteSession.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteSession
+}
+
+// ExpectUserIDParam2 sets up expected param userID for UserRepository.DeleteSession
+func (mmDeleteSession *mUserRepositoryMockDeleteSession) ExpectUserIDParam2(userID string) *mUserRepositoryMockDeleteSession {
+	if mmDeleteSession.mock.funcDeleteSession != nil {
+		mmDeleteSession.mock.t.Fatalf("UserRepositoryMock.DeleteSession mock is already set by Set")
+	}
+
+	if mmDeleteSession.defaultExpectation == nil {
+		mmDeleteSession.defaultExpectation = &UserRepositoryMockDeleteSessionExpectation{}
+	}
+
+	if mmDeleteSession.defaultExpectation.params != nil {
+		mmDeleteSession.mock.t.Fatalf("UserRepositoryMock.DeleteSession mock is already set by Expect")
+	}
+
+	if mmDeleteSession.defaultExpectation.paramPtrs == nil {
+		mmDeleteSession.defaultExpectation.paramPtrs = &UserRepositoryMockDeleteSessionParamPtrs{}
+	}
+	mmDeleteSession.defaultExpectation.paramPtrs.userID = &userID
+	mmDeleteSession.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmDeleteSession
+}
+
+// ExpectSessionIDParam3 sets up expected param sessionID for UserRepository.DeleteSession
+func (mmDeleteSession *mUserRepositoryMockDeleteSession) ExpectSessionIDParam3(sessionID string) *mUserRepositoryMockDeleteSession {
+	if mmDeleteSession.mock.funcDeleteSession != nil {
+		mmDeleteSession.mock.t.Fatalf("UserRepositoryMock.DeleteSession mock is already set by Set")
+	}
+
+	if mmDeleteSession.defaultExpectation == nil {
+		mmDeleteSession.defaultExpectation = &UserRepositoryMockDeleteSessionExpectation{}
+	}
+
+	if mmDeleteSession.defaultExpectation.params != nil {
+		mmDeleteSession.mock.t.Fatalf("UserRepositoryMock.DeleteSession mock is already set by Expect")
+	}
+
+	if mmDeleteSession.defaultExpectation.paramPtrs == nil {
+		mmDeleteSession.defaultExpectation.paramPtrs = &UserRepositoryMockDeleteSessionParamPtrs{}
+	}
+	mmDeleteSession.defaultExpectation.paramPtrs.sessionID = &sessionID
+	mmDeleteSession.defaultExpectation.expectationOrigins.originSessionID = minimock.CallerInfo(1)
+
+	return mmDeleteSession
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserRepository.DeleteSession
+func (mmDeleteSession *mUserRepositoryMockDeleteSession) Inspect(f func(ctx context.Context, userID string, sessionID string)) *mUserRepositoryMockDeleteSession {
+	if mmDeleteSession.mock.inspectFuncDeleteSession != nil {
+		mmDeleteSession.mock.t.Fatalf("Inspect function is already set for UserRepositoryMock.DeleteSession")
+	}
+
+	mmDeleteSession.mock.inspectFuncDeleteSession = f
+
+	return mmDeleteSession
+}
+
+// Return sets up results that will be returned by UserRepository.DeleteSession
+func (mmDeleteSession *mUserRepositoryMockDeleteSession) Return(err error) *UserRepositoryMock {
+	if mmDeleteSession.mock.funcDeleteSession != nil {
+		mmDeleteSession.mock.t.Fatalf("UserRepositoryMock.DeleteSession mock is already set by Set")
+	}
+
+	if mmDeleteSession.defaultExpectation == nil {
+		mmDeleteSession.defaultExpectation = &UserRepositoryMockDeleteSessionExpectation{mock: mmDeleteSession.mock}
+	}
+	mmDeleteSession.defaultExpectation.results = &UserRepositoryMockDeleteSessionResults{err}
+	mmDeleteSession.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteSession.mock
+}
+
+// Set uses given function f to mock the UserRepository.DeleteSession method
+func (mmDeleteSession *mUserRepositoryMockDeleteSession) Set(f func(ctx context.Context, userID string, sessionID string) (err error)) *UserRepositoryMock {
+	if mmDeleteSession.defaultExpectation != nil {
+		mmDeleteSession.mock.t.Fatalf("Default expectation is already set for the UserRepository.DeleteSession method")
+	}
+
+	if len(mmDeleteSession.expectations) > 0 {
+		mmDeleteSession.mock.t.Fatalf("Some expectations are already set for the UserRepository.DeleteSession method")
+	}
+
+	mmDeleteSession.mock.funcDeleteSession = f
+	mmDeleteSession.mock.funcDeleteSessionOrigin = minimock.CallerInfo(1)
+	return mmDeleteSession.mock
+}
+
+// When sets expectation for the UserRepository.DeleteSession which will trigger the result defined by the following
+// Then helper
+func (mmDeleteSession *mUserRepositoryMockDeleteSession) When(ctx context.Context, userID string, sessionID string) *UserRepositoryMockDeleteSessionExpectation {
+	if mmDeleteSession.mock.funcDeleteSession != nil {
+		mmDeleteSession.mock.t.Fatalf("UserRepositoryMock.DeleteSession mock is already set by Set")
+	}
+
+	expectation := &UserRepositoryMockDeleteSessionExpectation{
+		mock:               mmDeleteSession.mock,
+		params:             &UserRepositoryMockDeleteSessionParams{ctx, userID, sessionID},
+		expectationOrigins: UserRepositoryMockDeleteSessionExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteSession.expectations = append(mmDeleteSession.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserRepository.DeleteSession return parameters for the expectation previously defined by the When method
+func (e *UserRepositoryMockDeleteSessionExpectation) Then(err error) *UserRepositoryMock {
+	e.results = &UserRepositoryMockDeleteSessionResults{err}
+	return e.mock
+}
+
+// Times sets number of times UserRepository.DeleteSession should be invoked
+func (mmDeleteSession *mUserRepositoryMockDeleteSession) Times(n uint64) *mUserRepositoryMockDeleteSession {
+	if n == 0 {
+		mmDeleteSession.mock.t.Fatalf("Times of UserRepositoryMock.DeleteSession mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteSession.expectedInvocations, n)
+	mmDeleteSession.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteSession
+}
+
+func (mmDeleteSession *mUserRepositoryMockDeleteSession) invocationsDone() bool {
+	if len(mmDeleteSession.expectations) == 0 && mmDeleteSession.defaultExpectation == nil && mmDeleteSession.mock.funcDeleteSession == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteSession.mock.afterDeleteSessionCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteSession.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteSession implements UserRepository
+func (mmDeleteSession *UserRepositoryMock) DeleteSession(ctx context.Context, userID string, sessionID string) (err error) {
+	mm_atomic.AddUint64(&mmDeleteSession.beforeDeleteSessionCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteSession.afterDeleteSessionCounter, 1)
+
+	mmDeleteSession.t.Helper()
+
+	if mmDeleteSession.inspectFuncDeleteSession != nil {
+		mmDeleteSession.inspectFuncDeleteSession(ctx, userID, sessionID)
+	}
+
+	mm_params := UserRepositoryMockDeleteSessionParams{ctx, userID, sessionID}
+
+	// Record call args
+	mmDeleteSession.DeleteSessionMock.mutex.Lock()
+	mmDeleteSession.DeleteSessionMock.callArgs = append(mmDeleteSession.DeleteSessionMock.callArgs, &mm_params)
+	mmDeleteSession.DeleteSessionMock.mutex.Unlock()
+
+	for _, e := range mmDeleteSession.DeleteSessionMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteSession.DeleteSessionMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteSession.DeleteSessionMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteSession.DeleteSessionMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteSession.DeleteSessionMock.defaultExpectation.paramPtrs
+
+		mm_got := UserRepositoryMockDeleteSessionParams{ctx, userID, sessionID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteSession.t.Errorf("UserRepositoryMock.DeleteSession got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteSession.DeleteSessionMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmDeleteSession.t.Errorf("UserRepositoryMock.DeleteSession got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteSession.DeleteSessionMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.sessionID != nil && !minimock.Equal(*mm_want_ptrs.sessionID, mm_got.sessionID) {
+				mmDeleteSession.t.Errorf("UserRepositoryMock.DeleteSession got unexpected parameter sessionID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteSession.DeleteSessionMock.defaultExpectation.expectationOrigins.originSessionID, *mm_want_ptrs.sessionID, mm_got.sessionID, minimock.Diff(*mm_want_ptrs.sessionID, mm_got.sessionID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteSession.t.Errorf("UserRepositoryMock.DeleteSession got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteSession.DeleteSessionMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteSession.DeleteSessionMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteSession.t.Fatal("No results are set for the UserRepositoryMock.DeleteSession")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteSession.funcDeleteSession != nil {
+		return mmDeleteSession.funcDeleteSession(ctx, userID, sessionID)
+	}
+	mmDeleteSession.t.Fatalf("Unexpected call to UserRepositoryMock.DeleteSession. %v %v %v", ctx, userID, sessionID)
+	return
+}
+
+// DeleteSessionAfterCounter returns a count of finished UserRepositoryMock.DeleteSession invocations
+func (mmDeleteSession *UserRepositoryMock) DeleteSessionAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteSession.afterDeleteSessionCounter)
+}
+
+// DeleteSessionBeforeCounter returns a count of UserRepositoryMock.DeleteSession invocations
+func (mmDeleteSession *UserRepositoryMock) DeleteSessionBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteSession.beforeDeleteSessionCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserRepositoryMock.DeleteSession.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteSession *mUserRepositoryMockDeleteSession) Calls() []*UserRepositoryMockDeleteSessionParams {
+	mmDeleteSession.mutex.RLock()
+
+	argCopy := make([]*UserRepositoryMockDeleteSessionParams, len(mmDeleteSession.callArgs))
+	copy(argCopy, mmDeleteSession.callArgs)
+
+	mmDeleteSession.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteSessionDone returns true if the count of the DeleteSession invocations corresponds
+// the number of defined expectations
+func (m *UserRepositoryMock) MinimockDeleteSessionDone() bool {
+	if m.DeleteSessionMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteSessionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteSessionMock.invocationsDone()
+}
+
+// MinimockDeleteSessionInspect logs each unmet expectation
+func (m *UserRepositoryMock) MinimockDeleteSessionInspect() {
+	for _, e := range m.DeleteSessionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserRepositoryMock.DeleteSession at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteSessionCounter := mm_atomic.LoadUint64(&m.afterDeleteSessionCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteSessionMock.defaultExpectation != nil && afterDeleteSessionCounter < 1 {
+		if m.DeleteSessionMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UserRepositoryMock.DeleteSession at\n%s", m.DeleteSessionMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UserRepositoryMock.DeleteSession at\n%s with params: %#v", m.DeleteSessionMock.defaultExpectation.expectationOrigins.origin, *m.DeleteSessionMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteSession != nil && afterDeleteSessionCounter < 1 {
+		m.t.Errorf("Expected call to UserRepositoryMock.DeleteSession at\n%s", m.funcDeleteSessionOrigin)
+	}
+
+	if !m.DeleteSessionMock.invocationsDone() && afterDeleteSessionCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserRepositoryMock.DeleteSession at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteSessionMock.expectedInvocations), m.DeleteSessionMock.expectedInvocationsOrigin, afterDeleteSessionCounter)
+	}
+}
+
+type mUserRepositoryMockDeleteUser struct {
+	optional           bool
+	mock               *UserRepositoryMock
+	defaultExpectation *UserRepositoryMockDeleteUserExpectation
+	expectations       []*UserRepositoryMockDeleteUserExpectation
+
+	callArgs []*UserRepositoryMockDeleteUserParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UserRepositoryMockDeleteUserExpectation specifies expectation struct of the UserRepository.DeleteUser
+type UserRepositoryMockDeleteUserExpectation struct {
+	mock               *UserRepositoryMock
+	params             *UserRepositoryMockDeleteUserParams
+	paramPtrs          *UserRepositoryMockDeleteUserParamPtrs
+	expectationOrigins UserRepositoryMockDeleteUserExpectationOrigins
+	results            *UserRepositoryMockDeleteUserResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UserRepositoryMockDeleteUserParams contains parameters of the UserRepository.DeleteUser
+type UserRepositoryMockDeleteUserParams struct {
+	ctx    context.Context
+	userID string
+}
+
+// UserRepositoryMockDeleteUserParamPtrs contains pointers to parameters of the UserRepository.DeleteUser
+type UserRepositoryMockDeleteUserParamPtrs struct {
+	ctx    *context.Context
+	userID *string
+}
+
+// UserRepositoryMockDeleteUserResults contains results of the UserRepository.DeleteUser
+type UserRepositoryMockDeleteUserResults struct {
+	err error
+}
+
+// UserRepositoryMockDeleteUserOrigins contains origins of expectations of the UserRepository.DeleteUser
+type UserRepositoryMockDeleteUserExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteUser *mUserRepositoryMockDeleteUser) Optional() *mUserRepositoryMockDeleteUser {
+	mmDeleteUser.optional = true
+	return mmDeleteUser
+}
+
+// Expect sets up expected params for UserRepository.DeleteUser
+func (mmDeleteUser *mUserRepositoryMockDeleteUser) Expect(ctx context.Context, userID string) *mUserRepositoryMockDeleteUser {
+	if mmDeleteUser.mock.funcDeleteUser != nil {
+		mmDeleteUser.mock.t.Fatalf("UserRepositoryMock.DeleteUser mock is already set by Set")
+	}
+
+	if mmDeleteUser.defaultExpectation == nil {
+		mmDeleteUser.defaultExpectation = &UserRepositoryMockDeleteUserExpectation{}
+	}
+
+	if mmDeleteUser.defaultExpectation.paramPtrs != nil {
+		mmDeleteUser.mock.t.Fatalf("UserRepositoryMock.DeleteUser mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteUser.defaultExpectation.params = &UserRepositoryMockDeleteUserParams{ctx, userID}
+	mmDeleteUser.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteUser.expectations {
+		if minimock.Equal(e.params, mmDeleteUser.defaultExpectation.params) {
+			mmDeleteUser.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteUser.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteUser
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UserRepository.DeleteUser
+func (mmDeleteUser *mUserRepositoryMockDeleteUser) ExpectCtxParam1(ctx context.Context) *mUserRepositoryMockDeleteUser {
+	if mmDeleteUser.mock.funcDeleteUser != nil {
+		mmDeleteUser.mock.t.Fatalf("UserRepositoryMock.DeleteUser mock is already set by Set")
+	}
+
+	if mmDeleteUser.defaultExpectation == nil {
+		mmDeleteUser.defaultExpectation = &UserRepositoryMockDeleteUserExpectation{}
+	}
+
+	if mmDeleteUser.defaultExpectation.params != nil {
+		mmDeleteUser.mock.t.Fatalf("UserRepositoryMock.DeleteUser mock is already set by Expect")
+	}
+
+	if mmDeleteUser.defaultExpectation.paramPtrs == nil {
+		mmDeleteUser.defaultExpectation.paramPtrs = &UserRepositoryMockDeleteUserParamPtrs{}
+	}
+	mmDeleteUser.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteUser.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteUser
+}
+
+// ExpectUserIDParam2 sets up expected param userID for UserRepository.DeleteUser
+func (mmDeleteUser *mUserRepositoryMockDeleteUser) ExpectUserIDParam2(userID string) *mUserRepositoryMockDeleteUser {
+	if mmDeleteUser.mock.funcDeleteUser != nil {
+		mmDeleteUser.mock.t.Fatalf("UserRepositoryMock.DeleteUser mock is already set by Set")
+	}
+
+	if mmDeleteUser.defaultExpectation == nil {
+		mmDeleteUser.defaultExpectation = &UserRepositoryMockDeleteUserExpectation{}
+	}
+
+	if mmDeleteUser.defaultExpectation.params != nil {
+		mmDeleteUser.mock.t.Fatalf("UserRepositoryMock.DeleteUser mock is already set by Expect")
+	}
+
+	if mmDeleteUser.defaultExpectation.paramPtrs == nil {
+		mmDeleteUser.defaultExpectation.paramPtrs = &UserRepositoryMockDeleteUserParamPtrs{}
+	}
+	mmDeleteUser.defaultExpectation.paramPtrs.userID = &userID
+	mmDeleteUser.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmDeleteUser
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserRepository.DeleteUser
+func (mmDeleteUser *mUserRepositoryMockDeleteUser) Inspect(f func(ctx context.Context, userID string)) *mUserRepositoryMockDeleteUser {
+	if mmDeleteUser.mock.inspectFuncDeleteUser != nil {
+		mmDeleteUser.mock.t.Fatalf("Inspect function is already set for UserRepositoryMock.DeleteUser")
+	}
+
+	mmDeleteUser.mock.inspectFuncDeleteUser = f
+
+	return mmDeleteUser
+}
+
+// Return sets up results that will be returned by UserRepository.DeleteUser
+func (mmDeleteUser *mUserRepositoryMockDeleteUser) Return(err error) *UserRepositoryMock {
+	if mmDeleteUser.mock.funcDeleteUser != nil {
+		mmDeleteUser.mock.t.Fatalf("UserRepositoryMock.DeleteUser mock is already set by Set")
+	}
+
+	if mmDeleteUser.defaultExpectation == nil {
+		mmDeleteUser.defaultExpectation = &UserRepositoryMockDeleteUserExpectation{mock: mmDeleteUser.mock}
+	}
+	mmDeleteUser.defaultExpectation.results = &UserRepositoryMockDeleteUserResults{err}
+	mmDeleteUser.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteUser.mock
+}
+
+// Set uses given function f to mock the UserRepository.DeleteUser method
+func (mmDeleteUser *mUserRepositoryMockDeleteUser) Set(f func(ctx context.Context, userID string) (err error)) *UserRepositoryMock {
+	if mmDeleteUser.defaultExpectation != nil {
+		mmDeleteUser.mock.t.Fatalf("Default expectation is already set for the UserRepository.DeleteUser method")
+	}
+
+	if len(mmDeleteUser.expectations) > 0 {
+		mmDeleteUser.mock.t.Fatalf("Some expectations are already set for the UserRepository.DeleteUser method")
+	}
+
+	mmDeleteUser.mock.funcDeleteUser = f
+	mmDeleteUser.mock.funcDeleteUserOrigin = minimock.CallerInfo(1)
+	return mmDeleteUser.mock
+}
+
+// When sets expectation for the UserRepository.DeleteUser which will trigger the result defined by the following
+// Then helper
+func (mmDeleteUser *mUserRepositoryMockDeleteUser) When(ctx context.Context, userID string) *UserRepositoryMockDeleteUserExpectation {
+	if mmDeleteUser.mock.funcDeleteUser != nil {
+		mmDeleteUser.mock.t.Fatalf("UserRepositoryMock.DeleteUser mock is already set by Set")
+	}
+
+	expectation := &UserRepositoryMockDeleteUserExpectation{
+		mock:               mmDeleteUser.mock,
+		params:             &UserRepositoryMockDeleteUserParams{ctx, userID},
+		expectationOrigins: UserRepositoryMockDeleteUserExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteUser.expectations = append(mmDeleteUser.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserRepository.DeleteUser return parameters for the expectation previously defined by the When method
+func (e *UserRepositoryMockDeleteUserExpectation) Then(err error) *UserRepositoryMock {
+	e.results = &UserRepositoryMockDeleteUserResults{err}
+	return e.mock
+}
+
+// Times sets number of times UserRepository.DeleteUser should be invoked
+func (mmDeleteUser *mUserRepositoryMockDeleteUser) Times(n uint64) *mUserRepositoryMockDeleteUser {
+	if n == 0 {
+		mmDeleteUser.mock.t.Fatalf("Times of UserRepositoryMock.DeleteUser mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteUser.expectedInvocations, n)
+	mmDeleteUser.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteUser
+}
+
+func (mmDeleteUser *mUserRepositoryMockDeleteUser) invocationsDone() bool {
+	if len(mmDeleteUser.expectations) == 0 && mmDeleteUser.defaultExpectation == nil && mmDeleteUser.mock.funcDeleteUser == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteUser.mock.afterDeleteUserCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteUser.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteUser implements UserRepository
+func (mmDeleteUser *UserRepositoryMock) DeleteUser(ctx context.Context, userID string) (err error) {
+	mm_atomic.AddUint64(&mmDeleteUser.beforeDeleteUserCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteUser.afterDeleteUserCounter, 1)
+
+	mmDeleteUser.t.Helper()
+
+	if mmDeleteUser.inspectFuncDeleteUser != nil {
+		mmDeleteUser.inspectFuncDeleteUser(ctx, userID)
+	}
+
+	mm_params := UserRepositoryMockDeleteUserParams{ctx, userID}
+
+	// Record call args
+	mmDeleteUser.DeleteUserMock.mutex.Lock()
+	mmDeleteUser.DeleteUserMock.callArgs = append(mmDeleteUser.DeleteUserMock.callArgs, &mm_params)
+	mmDeleteUser.DeleteUserMock.mutex.Unlock()
+
+	for _, e := range mmDeleteUser.DeleteUserMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteUser.DeleteUserMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteUser.DeleteUserMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteUser.DeleteUserMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteUser.DeleteUserMock.defaultExpectation.paramPtrs
+
+		mm_got := UserRepositoryMockDeleteUserParams{ctx, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteUser.t.Errorf("UserRepositoryMock.DeleteUser got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteUser.DeleteUserMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmDeleteUser.t.Errorf("UserRepositoryMock.DeleteUser got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteUser.DeleteUserMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteUser.t.Errorf("UserRepositoryMock.DeleteUser got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteUser.DeleteUserMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteUser.DeleteUserMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteUser.t.Fatal("No results are set for the UserRepositoryMock.DeleteUser")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteUser.funcDeleteUser != nil {
+		return mmDeleteUser.funcDeleteUser(ctx, userID)
+	}
+	mmDeleteUser.t.Fatalf("Unexpected call to UserRepositoryMock.DeleteUser. %v %v", ctx, userID)
+	return
+}
+
+// DeleteUserAfterCounter returns a count of finished UserRepositoryMock.DeleteUser invocations
+func (mmDeleteUser *UserRepositoryMock) DeleteUserAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteUser.afterDeleteUserCounter)
+}
+
+// DeleteUserBeforeCounter returns a count of UserRepositoryMock.DeleteUser invocations
+func (mmDeleteUser *UserRepositoryMock) DeleteUserBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteUser.beforeDeleteUserCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserRepositoryMock.DeleteUser.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteUser *mUserRepositoryMockDeleteUser) Calls() []*UserRepositoryMockDeleteUserParams {
+	mmDeleteUser.mutex.RLock()
+
+	argCopy := make([]*UserRepositoryMockDeleteUserParams, len(mmDeleteUser.callArgs))
+	copy(argCopy, mmDeleteUser.callArgs)
+
+	mmDeleteUser.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteUserDone returns true if the count of the DeleteUser invocations corresponds
+// the number of defined expectations
+func (m *UserRepositoryMock) MinimockDeleteUserDone() bool {
+	if m.DeleteUserMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteUserMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteUserMock.invocationsDone()
+}
+
+// MinimockDeleteUserInspect logs each unmet expectation
+func (m *UserRepositoryMock) MinimockDeleteUserInspect() {
+	for _, e := range m.DeleteUserMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserRepositoryMock.DeleteUser at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteUserCounter := mm_atomic.LoadUint64(&m.afterDeleteUserCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteUserMock.defaultExpectation != nil && afterDeleteUserCounter < 1 {
+		if m.DeleteUserMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UserRepositoryMock.DeleteUser at\n%s", m.DeleteUserMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UserRepositoryMock.DeleteUser at\n%s with params: %#v", m.DeleteUserMock.defaultExpectation.expectationOrigins.origin, *m.DeleteUserMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteUser != nil && afterDeleteUserCounter < 1 {
+		m.t.Errorf("Expected call to UserRepositoryMock.DeleteUser at\n%s", m.funcDeleteUserOrigin)
+	}
+
+	if !m.DeleteUserMock.invocationsDone() && afterDeleteUserCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserRepositoryMock.DeleteUser at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteUserMock.expectedInvocations), m.DeleteUserMock.expectedInvocationsOrigin, afterDeleteUserCounter)
+	}
+}
+
+type mUserRepositoryMockDeleteUserSessions struct {
+	optional           bool
+	mock               *UserRepositoryMock
+	defaultExpectation *UserRepositoryMockDeleteUserSessionsExpectation
+	expectations       []*UserRepositoryMockDeleteUserSessionsExpectation
+
+	callArgs []*UserRepositoryMockDeleteUserSessionsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UserRepositoryMockDeleteUserSessionsExpectation specifies expectation struct of the UserRepository.DeleteUserSessions
+type UserRepositoryMockDeleteUserSessionsExpectation struct {
+	mock               *UserRepositoryMock
+	params             *UserRepositoryMockDeleteUserSessionsParams
+	paramPtrs          *UserRepositoryMockDeleteUserSessionsParamPtrs
+	expectationOrigins UserRepositoryMockDeleteUserSessionsExpectationOrigins
+	results            *UserRepositoryMockDeleteUserSessionsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UserRepositoryMockDeleteUserSessionsParams contains parameters of the UserRepository.DeleteUserSessions
+type UserRepositoryMockDeleteUserSessionsParams struct {
+	ctx    context.Context
+	userID string
+}
+
+// UserRepositoryMockDeleteUserSessionsParamPtrs contains pointers to parameters of the UserRepository.DeleteUserSessions
+type UserRepositoryMockDeleteUserSessionsParamPtrs struct {
+	ctx    *context.Context
+	userID *string
+}
+
+// UserRepositoryMockDeleteUserSessionsResults contains results of the UserRepository.DeleteUserSessions
+type UserRepositoryMockDeleteUserSessionsResults struct {
+	err error
+}
+
+// UserRepositoryMockDeleteUserSessionsOrigins contains origins of expectations of the UserRepository.DeleteUserSessions
+type UserRepositoryMockDeleteUserSessionsExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteUserSessions *mUserRepositoryMockDeleteUserSessions) Optional() *mUserRepositoryMockDeleteUserSessions {
+	mmDeleteUserSessions.optional = true
+	return mmDeleteUserSessions
+}
+
+// Expect sets up expected params for UserRepository.DeleteUserSessions
+func (mmDeleteUserSessions *mUserRepositoryMockDeleteUserSessions) Expect(ctx context.Context, userID string) *mUserRepositoryMockDeleteUserSessions {
+	if mmDeleteUserSessions.mock.funcDeleteUserSessions != nil {
+		mmDeleteUserSessions.mock.t.Fatalf("UserRepositoryMock.DeleteUserSessions mock is already set by Set")
+	}
+
+	if mmDeleteUserSessions.defaultExpectation == nil {
+		mmDeleteUserSessions.defaultExpectation = &UserRepositoryMockDeleteUserSessionsExpectation{}
+	}
+
+	if mmDeleteUserSessions.defaultExpectation.paramPtrs != nil {
+		mmDeleteUserSessions.mock.t.Fatalf("UserRepositoryMock.DeleteUserSessions mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteUserSessions.defaultExpectation.params = &UserRepositoryMockDeleteUserSessionsParams{ctx, userID}
+	mmDeleteUserSessions.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteUserSessions.expectations {
+		if minimock.Equal(e.params, mmDeleteUserSessions.defaultExpectation.params) {
+			mmDeleteUserSessions.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteUserSessions.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteUserSessions
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UserRepository.DeleteUserSessions
+func (mmDeleteUserSessions *mUserRepositoryMockDeleteUserSessions) ExpectCtxParam1(ctx context.Context) *mUserRepositoryMockDeleteUserSessions {
+	if mmDeleteUserSessions.mock.funcDeleteUserSessions != nil {
+		mmDeleteUserSessions.mock.t.Fatalf("UserRepositoryMock.DeleteUserSessions mock is already set by Set")
+	}
+
+	if mmDeleteUserSessions.defaultExpectation == nil {
+		mmDeleteUserSessions.defaultExpectation = &UserRepositoryMockDeleteUserSessionsExpectation{}
+	}
+
+	if mmDeleteUserSessions.defaultExpectation.params != nil {
+		mmDeleteUserSessions.mock.t.Fatalf("UserRepositoryMock.DeleteUserSessions mock is already set by Expect")
+	}
+
+	if mmDeleteUserSessions.defaultExpectation.paramPtrs == nil {
+		mmDeleteUserSessions.defaultExpectation.paramPtrs = &UserRepositoryMockDeleteUserSessionsParamPtrs{}
+	}
+	mmDeleteUserSessions.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteUserSessions.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteUserSessions
+}
+
+// ExpectUserIDParam2 sets up expected param userID for UserRepository.DeleteUserSessions
+func (mmDeleteUserSessions *mUserRepositoryMockDeleteUserSessions) ExpectUserIDParam2(userID string) *mUserRepositoryMockDeleteUserSessions {
+	if mmDeleteUserSessions.mock.funcDeleteUserSessions != nil {
+		mmDeleteUserSessions.mock.t.Fatalf("UserRepositoryMock.DeleteUserSessions mock is already set by Set")
+	}
+
+	if mmDeleteUserSessions.defaultExpectation == nil {
+		mmDeleteUserSessions.defaultExpectation = &UserRepositoryMockDeleteUserSessionsExpectation{}
+	}
+
+	if mmDeleteUserSessions.defaultExpectation.params != nil {
+		mmDeleteUserSessions.mock.t.Fatalf("UserRepositoryMock.DeleteUserSessions mock is already set by Expect")
+	}
+
+	if mmDeleteUserSessions.defaultExpectation.paramPtrs == nil {
+		mmDeleteUserSessions.defaultExpectation.paramPtrs = &UserRepositoryMockDeleteUserSessionsParamPtrs{}
+	}
+	mmDeleteUserSessions.defaultExpectation.paramPtrs.userID = &userID
+	mmDeleteUserSessions.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmDeleteUserSessions
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserRepository.DeleteUserSessions
+func (mmDeleteUserSessions *mUserRepositoryMockDeleteUserSessions) Inspect(f func(ctx context.Context, userID string)) *mUserRepositoryMockDeleteUserSessions {
+	if mmDeleteUserSessions.mock.inspectFuncDeleteUserSessions != nil {
+		mmDeleteUserSessions.mock.t.Fatalf("Inspect function is already set for UserRepositoryMock.DeleteUserSessions")
+	}
+
+	mmDeleteUserSessions.mock.inspectFuncDeleteUserSessions = f
+
+	return mmDeleteUserSessions
+}
+
+// Return sets up results that will be returned by UserRepository.DeleteUserSessions
+func (mmDeleteUserSessions *mUserRepositoryMockDeleteUserSessions) Return(err error) *UserRepositoryMock {
+	if mmDeleteUserSessions.mock.funcDeleteUserSessions != nil {
+		mmDeleteUserSessions.mock.t.Fatalf("UserRepositoryMock.DeleteUserSessions mock is already set by Set")
+	}
+
+	if mmDeleteUserSessions.defaultExpectation == nil {
+		mmDeleteUserSessions.defaultExpectation = &UserRepositoryMockDeleteUserSessionsExpectation{mock: mmDeleteUserSessions.mock}
+	}
+	mmDeleteUserSessions.defaultExpectation.results = &UserRepositoryMockDeleteUserSessionsResults{err}
+	mmDeleteUserSessions.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteUserSessions.mock
+}
+
+// Set uses given function f to mock the UserRepository.DeleteUserSessions method
+func (mmDeleteUserSessions *mUserRepositoryMockDeleteUserSessions) Set(f func(ctx context.Context, userID string) (err error)) *UserRepositoryMock {
+	if mmDeleteUserSessions.defaultExpectation != nil {
+		mmDeleteUserSessions.mock.t.Fatalf("Default expectation is already set for the UserRepository.DeleteUserSessions method")
+	}
+
+	if len(mmDeleteUserSessions.expectations) > 0 {
+		mmDeleteUserSessions.mock.t.Fatalf("Some expectations are already set for the UserRepository.DeleteUserSessions method")
+	}
+
+	mmDeleteUserSessions.mock.funcDeleteUserSessions = f
+	mmDeleteUserSessions.mock.funcDeleteUserSessionsOrigin = minimock.CallerInfo(1)
+	return mmDeleteUserSessions.mock
+}
+
+// When sets expectation for the UserRepository.DeleteUserSessions which will trigger the result defined by the following
+// Then helper
+func (mmDeleteUserSessions *mUserRepositoryMockDeleteUserSessions) When(ctx context.Context, userID string) *UserRepositoryMockDeleteUserSessionsExpectation {
+	if mmDeleteUserSessions.mock.funcDeleteUserSessions != nil {
+		mmDeleteUserSessions.mock.t.Fatalf("UserRepositoryMock.DeleteUserSessions mock is already set by Set")
+	}
+
+	expectation := &UserRepositoryMockDeleteUserSessionsExpectation{
+		mock:               mmDeleteUserSessions.mock,
+		params:             &UserRepositoryMockDeleteUserSessionsParams{ctx, userID},
+		expectationOrigins: UserRepositoryMockDeleteUserSessionsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteUserSessions.expectations = append(mmDeleteUserSessions.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserRepository.DeleteUserSessions return parameters for the expectation previously defined by the When method
+func (e *UserRepositoryMockDeleteUserSessionsExpectation) Then(err error) *UserRepositoryMock {
+	e.results = &UserRepositoryMockDeleteUserSessionsResults{err}
+	return e.mock
+}
+
+// Times sets number of times UserRepository.DeleteUserSessions should be invoked
+func (mmDeleteUserSessions *mUserRepositoryMockDeleteUserSessions) Times(n uint64) *mUserRepositoryMockDeleteUserSessions {
+	if n == 0 {
+		mmDeleteUserSessions.mock.t.Fatalf("Times of UserRepositoryMock.DeleteUserSessions mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteUserSessions.expectedInvocations, n)
+	mmDeleteUserSessions.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteUserSessions
+}
+
+func (mmDeleteUserSessions *mUserRepositoryMockDeleteUserSessions) invocationsDone() bool {
+	if len(mmDeleteUserSessions.expectations) == 0 && mmDeleteUserSessions.defaultExpectation == nil && mmDeleteUserSessions.mock.funcDeleteUserSessions == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteUserSessions.mock.afterDeleteUserSessionsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteUserSessions.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteUserSessions implements UserRepository
+func (mmDeleteUserSessions *UserRepositoryMock) DeleteUserSessions(ctx context.Context, userID string) (err error) {
+	mm_atomic.AddUint64(&mmDeleteUserSessions.beforeDeleteUserSessionsCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteUserSessions.afterDeleteUserSessionsCounter, 1)
+
+	mmDeleteUserSessions.t.Helper()
+
+	if mmDeleteUserSessions.inspectFuncDeleteUserSessions != nil {
+		mmDeleteUserSessions.inspectFuncDeleteUserSessions(ctx, userID)
+	}
+
+	mm_params := UserRepositoryMockDeleteUserSessionsParams{ctx, userID}
+
+	// Record call args
+	mmDeleteUserSessions.DeleteUserSessionsMock.mutex.Lock()
+	mmDeleteUserSessions.DeleteUserSessionsMock.callArgs = append(mmDeleteUserSessions.DeleteUserSessionsMock.callArgs, &mm_params)
+	mmDeleteUserSessions.DeleteUserSessionsMock.mutex.Unlock()
+
+	for _, e := range mmDeleteUserSessions.DeleteUserSessionsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteUserSessions.DeleteUserSessionsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteUserSessions.DeleteUserSessionsMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteUserSessions.DeleteUserSessionsMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteUserSessions.DeleteUserSessionsMock.defaultExpectation.paramPtrs
+
+		mm_got := UserRepositoryMockDeleteUserSessionsParams{ctx, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteUserSessions.t.Errorf("UserRepositoryMock.DeleteUserSessions got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteUserSessions.DeleteUserSessionsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmDeleteUserSessions.t.Errorf("UserRepositoryMock.DeleteUserSessions got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteUserSessions.DeleteUserSessionsMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteUserSessions.t.Errorf("UserRepositoryMock.DeleteUserSessions got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteUserSessions.DeleteUserSessionsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteUserSessions.DeleteUserSessionsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteUserSessions.t.Fatal("No results are set for the UserRepositoryMock.DeleteUserSessions")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteUserSessions.funcDeleteUserSessions != nil {
+		return mmDeleteUserSessions.funcDeleteUserSessions(ctx, userID)
+	}
+	mmDeleteUserSessions.t.Fatalf("Unexpected call to UserRepositoryMock.DeleteUserSessions. %v %v", ctx, userID)
+	return
+}
+
+// DeleteUserSessionsAfterCounter returns a count of finished UserRepositoryMock.DeleteUserSessions invocations
+func (mmDeleteUserSessions *UserRepositoryMock) DeleteUserSessionsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteUserSessions.afterDeleteUserSessionsCounter)
+}
+
+// DeleteUserSessionsBeforeCounter returns a count of UserRepositoryMock.DeleteUserSessions invocations
+func (mmDeleteUserSessions *UserRepositoryMock) DeleteUserSessionsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteUserSessions.beforeDeleteUserSessionsCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserRepositoryMock.DeleteUserSessions.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteUserSessions *mUserRepositoryMockDeleteUserSessions) Calls() []*UserRepositoryMockDeleteUserSessionsParams {
+	mmDeleteUserSessions.mutex.RLock()
+
+	argCopy := make([]*UserRepositoryMockDeleteUserSessionsParams, len(mmDeleteUserSessions.callArgs))
+	copy(argCopy, mmDeleteUserSessions.callArgs)
+
+	mmDeleteUserSessions.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteUserSessionsDone returns true if the count of the DeleteUserSessions invocations corresponds
+// the number of defined expectations
+func (m *UserRepositoryMock) MinimockDeleteUserSessionsDone() bool {
+	if m.DeleteUserSessionsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteUserSessionsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteUserSessionsMock.invocationsDone()
+}
+
+// MinimockDeleteUserSessionsInspect logs each unmet expectation
+func (m *UserRepositoryMock) MinimockDeleteUserSessionsInspect() {
+	for _, e := range m.DeleteUserSessionsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserRepositoryMock.DeleteUserSessions at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteUserSessionsCounter := mm_atomic.LoadUint64(&m.afterDeleteUserSessionsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteUserSessionsMock.defaultExpectation != nil && afterDeleteUserSessionsCounter < 1 {
+		if m.DeleteUserSessionsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UserRepositoryMock.DeleteUserSessions at\n%s", m.DeleteUserSessionsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UserRepositoryMock.DeleteUserSessions at\n%s with params: %#v", m.DeleteUserSessionsMock.defaultExpectation.expectationOrigins.origin, *m.DeleteUserSessionsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteUserSessions != nil && afterDeleteUserSessionsCounter < 1 {
+		m.t.Errorf("Expected call to UserRepositoryMock.DeleteUserSessions at\n%s", m.funcDeleteUserSessionsOrigin)
+	}
+
+	if !m.DeleteUserSessionsMock.invocationsDone() && afterDeleteUserSessionsCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserRepositoryMock.DeleteUserSessions at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteUserSessionsMock.expectedInvocations), m.DeleteUserSessionsMock.expectedInvocationsOrigin, afterDeleteUserSessionsCounter)
+	}
+}
+
+type mUserRepositoryMockDisableUser struct {
+	optional           bool
+	mock               *UserRepositoryMock
+	defaultExpectation *UserRepositoryMockDisableUserExpectation
+	expectations       []*UserRepositoryMockDisableUserExpectation
+
+	callArgs []*UserRepositoryMockDisableUserParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UserRepositoryMockDisableUserExpectation specifies expectation struct of the UserRepository.DisableUser
+type UserRepositoryMockDisableUserExpectation struct {
+	mock               *UserRepositoryMock
+	params             *UserRepositoryMockDisableUserParams
+	paramPtrs          *UserRepositoryMockDisableUserParamPtrs
+	expectationOrigins UserRepositoryMockDisableUserExpectationOrigins
+	results            *UserRepositoryMockDisableUserResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UserRepositoryMockDisableUserParams contains parameters of the UserRepository.DisableUser
+type UserRepositoryMockDisableUserParams struct {
+	ctx        context.Context
+	userID     string
+	disabledAt time.Time
+}
+
+// UserRepositoryMockDisableUserParamPtrs contains pointers to parameters of the UserRepository.DisableUser
+type UserRepositoryMockDisableUserParamPtrs struct {
+	ctx        *context.Context
+	userID     *string
+	disabledAt *time.Time
+}
+
+// UserRepositoryMockDisableUserResults contains results of the UserRepository.DisableUser
+type UserRepositoryMockDisableUserResults struct {
+	err error
+}
+
+// UserRepositoryMockDisableUserOrigins contains origins of expectations of the UserRepository.DisableUser
+type UserRepositoryMockDisableUserExpectationOrigins struct {
+	origin           string
+	originCtx        string
+	originUserID     string
+	originDisabledAt string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDisableUser *mUserRepositoryMockDisableUser) Optional() *mUserRepositoryMockDisableUser {
+	mmDisableUser.optional = true
+	return mmDisableUser
+}
+
+// Expect sets up expected params for UserRepository.DisableUser
+func (mmDisableUser *mUserRepositoryMockDisableUser) Expect(ctx context.Context, userID string, disabledAt time.Time) *mUserRepositoryMockDisableUser {
+	if mmDisableUser.mock.funcDisableUser != nil {
+		mmDisableUser.mock.t.Fatalf("UserRepositoryMock.DisableUser mock is already set by Set")
+	}
+
+	if mmDisableUser.defaultExpectation == nil {
+		mmDisableUser.defaultExpectation = &UserRepositoryMockDisableUserExpectation{}
+	}
+
+	if mmDisableUser.defaultExpectation.paramPtrs != nil {
+		mmDisableUser.mock.t.Fatalf("UserRepositoryMock.DisableUser mock is already set by ExpectParams functions")
+	}
+
+	mmDisableUser.defaultExpectation.params = &UserRepositoryMockDisableUserParams{ctx, userID, disabledAt}
+	mmDisableUser.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDisableUser.expectations {
+		if minimock.Equal(e.params, mmDisableUser.defaultExpectation.params) {
+			mmDisableUser.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDisableUser.defaultExpectation.params)
+		}
+	}
+
+	return mmDisableUser
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UserRepository.DisableUser
+func (mmDisableUser *mUserRepositoryMockDisableUser) ExpectCtxParam1(ctx context.Context) *mUserRepositoryMockDisableUser {
+	if mmDisableUser.mock.funcDisableUser != nil {
+		mmDisableUser.mock.t.Fatalf("UserRepositoryMock.DisableUser mock is already set by Set")
+	}
+
+	if mmDisableUser.defaultExpectation == nil {
+		mmDisableUser.defaultExpectation = &UserRepositoryMockDisableUserExpectation{}
+	}
+
+	if mmDisableUser.defaultExpectation.params != nil {
+		mmDisableUser.mock.t.Fatalf("UserRepositoryMock.DisableUser mock is already set by Expect")
+	}
+
+	if mmDisableUser.defaultExpectation.paramPtrs == nil {
+		mmDisableUser.defaultExpectation.paramPtrs = &UserRepositoryMockDisableUserParamPtrs{}
+	}
+	mmDisableUser.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDisableUser.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDisableUser
+}
+
+// ExpectUserIDParam2 sets up expected param userID for UserRepository.DisableUser
+func (mmDisableUser *mUserRepositoryMockDisableUser) ExpectUserIDParam2(userID string) *mUserRepositoryMockDisableUser {
+	if mmDisableUser.mock.funcDisableUser != nil {
+		mmDisableUser.mock.t.Fatalf("UserRepositoryMock.DisableUser mock is already set by Set")
+	}
+
+	if mmDisableUser.defaultExpectation == nil {
+		mmDisableUser.defaultExpectation = &UserRepositoryMockDisableUserExpectation{}
+	}
+
+	if mmDisableUser.defaultExpectation.params != nil {
+		mmDisableUser.mock.t.Fatalf("UserRepositoryMock.DisableUser mock is already set by Expect")
+	}
+
+	if mmDisableUser.defaultExpectation.paramPtrs == nil {
+		mmDisableUser.defaultExpectation.paramPtrs = &UserRepositoryMockDisableUserParamPtrs{}
+	}
+	mmDisableUser.defaultExpectation.paramPtrs.userID = &userID
+	mmDisableUser.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmDisableUser
+}
+
+// ExpectDisabledAtParam3 sets up expected param disabledAt for UserRepository.DisableUser
+func (mmDisableUser *mUserRepositoryMockDisableUser) ExpectDisabledAtParam3(disabledAt time.Time) *mUserRepositoryMockDisableUser {
+	if mmDisableUser.mock.funcDisableUser != nil {
+		mmDisableUser.mock.t.Fatalf("UserRepositoryMock.DisableUser mock is already set by Set")
+	}
+
+	if mmDisableUser.defaultExpectation == nil {
+		mmDisableUser.defaultExpectation = &UserRepositoryMockDisableUserExpectation{}
+	}
+
+	if mmDisableUser.defaultExpectation.params != nil {
+		mmDisableUser.mock.t.Fatalf("UserRepositoryMock.DisableUser mock is already set by Expect")
+	}
+
+	if mmDisableUser.defaultExpectation.paramPtrs == nil {
+		mmDisableUser.defaultExpectation.paramPtrs = &UserRepositoryMockDisableUserParamPtrs{}
+	}
+	mmDisableUser.defaultExpectation.paramPtrs.disabledAt = &disabledAt
+	mmDisableUser.defaultExpectation.expectationOrigins.originDisabledAt = minimock.CallerInfo(1)
+
+	return mmDisableUser
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserRepository.DisableUser
+func (mmDisableUser *mUserRepositoryMockDisableUser) Inspect(f func(ctx context.Context, userID string, disabledAt time.Time)) *mUserRepositoryMockDisableUser {
+	if mmDisableUser.mock.inspectFuncDisableUser != nil {
+		mmDisableUser.mock.t.Fatalf("Inspect function is already set for UserRepositoryMock.DisableUser")
+	}
+
+	mmDisableUser.mock.inspectFuncDisableUser = f
+
+	return mmDisableUser
+}
+
+// Return sets up results that will be returned by UserRepository.DisableUser
+func (mmDisableUser *mUserRepositoryMockDisableUser) Return(err error) *UserRepositoryMock {
+	if mmDisableUser.mock.funcDisableUser != nil {
+		mmDisableUser.mock.t.Fatalf("UserRepositoryMock.DisableUser mock is already set by Set")
+	}
+
+	if mmDisableUser.defaultExpectation == nil {
+		mmDisableUser.defaultExpectation = &UserRepositoryMockDisableUserExpectation{mock: mmDisableUser.mock}
+	}
+	mmDisableUser.defaultExpectation.results = &UserRepositoryMockDisableUserResults{err}
+	mmDisableUser.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDisableUser.mock
+}
+
+// Set uses given function f to mock the UserRepository.DisableUser method
+func (mmDisableUser *mUserRepositoryMockDisableUser) Set(f func(ctx context.Context, userID string, disabledAt time.Time) (err error)) *UserRepositoryMock {
+	if mmDisableUser.defaultExpectation != nil {
+		mmDisableUser.mock.t.Fatalf("Default expectation is already set for the UserRepository.DisableUser method")
+	}
+
+	if len(mmDisableUser.expectations) > 0 {
+		mmDisableUser.mock.t.Fatalf("Some expectations are already set for the UserRepository.DisableUser method")
+	}
+
+	mmDisableUser.mock.funcDisableUser = f
+	mmDisableUser.mock.funcDisableUserOrigin = minimock.CallerInfo(1)
+	return mmDisableUser.mock
+}
+
+// When sets expectation for the UserRepository.DisableUser which will trigger the result defined by the following
+// Then helper
+func (mmDisableUser *mUserRepositoryMockDisableUser) When(ctx context.Context, userID string, disabledAt time.Time) *UserRepositoryMockDisableUserExpectation {
+	if mmDisableUser.mock.funcDisableUser != nil {
+		mmDisableUser.mock.t.Fatalf("UserRepositoryMock.DisableUser mock is already set by Set")
+	}
+
+	expectation := &UserRepositoryMockDisableUserExpectation{
+		mock:               mmDisableUser.mock,
+		params:             &UserRepositoryMockDisableUserParams{ctx, userID, disabledAt},
+		expectationOrigins: UserRepositoryMockDisableUserExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDisableUser.expectations = append(mmDisableUser.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserRepository.DisableUser return parameters for the expectation previously defined by the When method
+func (e *UserRepositoryMockDisableUserExpectation) Then(err error) *UserRepositoryMock {
+	e.results = &UserRepositoryMockDisableUserResults{err}
+	return e.mock
+}
+
+// Times sets number of times UserRepository.DisableUser should be invoked
+func (mmDisableUser *mUserRepositoryMockDisableUser) Times(n uint64) *mUserRepositoryMockDisableUser {
+	if n == 0 {
+		mmDisableUser.mock.t.Fatalf("Times of UserRepositoryMock.DisableUser mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDisableUser.expectedInvocations, n)
+	mmDisableUser.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDisableUser
+}
+
+func (mmDisableUser *mUserRepositoryMockDisableUser) invocationsDone() bool {
+	if len(mmDisableUser.expectations) == 0 && mmDisableUser.defaultExpectation == nil && mmDisableUser.mock.funcDisableUser == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDisableUser.mock.afterDisableUserCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDisableUser.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DisableUser implements UserRepository
+func (mmDisableUser *UserRepositoryMock) DisableUser(ctx context.Context, userID string, disabledAt time.Time) (err error) {
+	mm_atomic.AddUint64(&mmDisableUser.beforeDisableUserCounter, 1)
+	defer mm_atomic.AddUint64(&mmDisableUser.afterDisableUserCounter, 1)
+
+	mmDisableUser.t.Helper()
+
+	if mmDisableUser.inspectFuncDisableUser != nil {
+		mmDisableUser.inspectFuncDisableUser(ctx, userID, disabledAt)
+	}
+
+	mm_params := UserRepositoryMockDisableUserParams{ctx, userID, disabledAt}
+
+	// Record call args
+	mmDisableUser.DisableUserMock.mutex.Lock()
+	mmDisableUser.DisableUserMock.callArgs = append(mmDisableUser.DisableUserMock.callArgs, &mm_params)
+	mmDisableUser.DisableUserMock.mutex.Unlock()
+
+	for _, e := range mmDisableUser.DisableUserMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDisableUser.DisableUserMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDisableUser.DisableUserMock.defaultExpectation.Counter, 1)
+		mm_want := mmDisableUser.DisableUserMock.defaultExpectation.params
+		mm_want_ptrs := mmDisableUser.DisableUserMock.defaultExpectation.paramPtrs
+
+		mm_got := UserRepositoryMockDisableUserParams{ctx, userID, disabledAt}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDisableUser.t.Errorf("UserRepositoryMock.DisableUser got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDisableUser.DisableUserMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmDisableUser.t.Errorf("UserRepositoryMock.DisableUser got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDisableUser.DisableUserMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.disabledAt != nil && !minimock.Equal(*mm_want_ptrs.disabledAt, mm_got.disabledAt) {
+				mmDisableUser.t.Errorf("UserRepositoryMock.DisableUser got unexpected parameter disabledAt, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDisableUser.DisableUserMock.defaultExpectation.expectationOrigins.originDisabledAt, *mm_want_ptrs.disabledAt, mm_got.disabledAt, minimock.Diff(*mm_want_ptrs.disabledAt, mm_got.disabledAt))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDisableUser.t.Errorf("UserRepositoryMock.DisableUser got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDisableUser.DisableUserMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDisableUser.DisableUserMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDisableUser.t.Fatal("No results are set for the UserRepositoryMock.DisableUser")
+		}
+		return (*mm_results).err
+	}
+	if mmDisableUser.funcDisableUser != nil {
+		return mmDisableUser.funcDisableUser(ctx, userID, disabledAt)
+	}
+	mmDisableUser.t.Fatalf("Unexpected call to UserRepositoryMock.DisableUser. %v %v %v", ctx, userID, disabledAt)
+	return
+}
+
+// DisableUserAfterCounter returns a count of finished UserRepositoryMock.DisableUser invocations
+func (mmDisableUser *UserRepositoryMock) DisableUserAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDisableUser.afterDisableUserCounter)
+}
+
+// DisableUserBeforeCounter returns a count of UserRepositoryMock.DisableUser invocations
+func (mmDisableUser *UserRepositoryMock) DisableUserBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDisableUser.beforeDisableUserCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserRepositoryMock.DisableUser.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDisableUser *mUserRepositoryMockDisableUser) Calls() []*UserRepositoryMockDisableUserParams {
+	mmDisableUser.mutex.RLock()
+
+	argCopy := make([]*UserRepositoryMockDisableUserParams, len(mmDisableUser.callArgs))
+	copy(argCopy, mmDisableUser.callArgs)
+
+	mmDisableUser.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDisableUserDone returns true if the count of the DisableUser invocations corresponds
+// the number of defined expectations
+func (m *UserRepositoryMock) MinimockDisableUserDone() bool {
+	if m.DisableUserMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DisableUserMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DisableUserMock.invocationsDone()
+}
+
+// MinimockDisableUserInspect logs each unmet expectation
+func (m *UserRepositoryMock) MinimockDisableUserInspect() {
+	for _, e := range m.DisableUserMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserRepositoryMock.DisableUser at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDisableUserCounter := mm_atomic.LoadUint64(&m.afterDisableUserCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DisableUserMock.defaultExpectation != nil && afterDisableUserCounter < 1 {
+		if m.DisableUserMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UserRepositoryMock.DisableUser at\n%s", m.DisableUserMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UserRepositoryMock.DisableUser at\n%s with params: %#v", m.DisableUserMock.defaultExpectation.expectationOrigins.origin, *m.DisableUserMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDisableUser != nil && afterDisableUserCounter < 1 {
+		m.t.Errorf("Expected call to UserRepositoryMock.DisableUser at\n%s", m.funcDisableUserOrigin)
+	}
+
+	if !m.DisableUserMock.invocationsDone() && afterDisableUserCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserRepositoryMock.DisableUser at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DisableUserMock.expectedInvocations), m.DisableUserMock.expectedInvocationsOrigin, afterDisableUserCounter)
+	}
+}
+
+type mUserRepositoryMockFindByEmail struct {
+	optional           bool
+	mock               *UserRepositoryMock
+	defaultExpectation *UserRepositoryMockFindByEmailExpectation
+	expectations       []*UserRepositoryMockFindByEmailExpectation
+
+	callArgs []*UserRepositoryMockFindByEmailParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UserRepositoryMockFindByEmailExpectation specifies expectation struct of the UserRepository.FindByEmail
+type UserRepositoryMockFindByEmailExpectation struct {
+	mock               *UserRepositoryMock
+	params             *UserRepositoryMockFindByEmailParams
+	paramPtrs          *UserRepositoryMockFindByEmailParamPtrs
+	expectationOrigins UserRepositoryMockFindByEmailExpectationOrigins
+	results            *UserRepositoryMockFindByEmailResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UserRepositoryMockFindByEmailParams contains parameters of the UserRepository.FindByEmail
+type UserRepositoryMockFindByEmailParams struct {
+	ctx   context.Context
+	email string
+}
+
+// UserRepositoryMockFindByEmailParamPtrs contains pointers to parameters of the UserRepository.FindByEmail
+type UserRepositoryMockFindByEmailParamPtrs struct {
+	ctx   *context.Context
+	email *string
+}
+
+// UserRepositoryMockFindByEmailResults contains results of the UserRepository.FindByEmail
+type UserRepositoryMockFindByEmailResults struct {
+	up1 *models.User
+	err error
+}
+
+// UserRepositoryMockFindByEmailOrigins contains origins of expectations of the UserRepository.FindByEmail
+type UserRepositoryMockFindByEmailExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originEmail string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmFindByEmail *mUserRepositoryMockFindByEmail) Optional() *mUserRepositoryMockFindByEmail {
+	mmFindByEmail.optional = true
+	return mmFindByEmail
+}
+
+// Expect sets up expected params for UserRepository.FindByEmail
+func (mmFindByEmail *mUserRepositoryMockFindByEmail) Expect(ctx context.Context, email string) *mUserRepositoryMockFindByEmail {
+	if mmFindByEmail.mock.funcFindByEmail != nil {
+		mmFindByEmail.mock.t.Fatalf("UserRepositoryMock.FindByEmail mock is already set by Set")
+	}
+
+	if mmFindByEmail.defaultExpectation == nil {
+		mmFindByEmail.defaultExpectation = &UserRepositoryMockFindByEmailExpectation{}
+	}
+
+	if mmFindByEmail.defaultExpectation.paramPtrs != nil {
+		mmFindByEmail.mock.t.Fatalf("UserRepositoryMock.FindByEmail mock is already set by ExpectParams functions")
+	}
+
+	mmFindByEmail.defaultExpectation.params = &UserRepositoryMockFindByEmailParams{ctx, email}
+	mmFindByEmail.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmFindByEmail.expectations {
+		if minimock.Equal(e.params, mmFindByEmail.defaultExpectation.params) {
+			mmFindByEmail.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmFindByEmail.defaultExpectation.params)
+		}
+	}
+
+	return mmFindByEmail
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UserRepository.FindByEmail
+func (mmFindByEmail *mUserRepositoryMockFindByEmail) ExpectCtxParam1(ctx context.Context) *mUserRepositoryMockFindByEmail {
+	if mmFindByEmail.mock.funcFindByEmail != nil {
+		mmFindByEmail.mock.t.Fatalf("UserRepositoryMock.FindByEmail mock is already set by Set")
+	}
+
+	if mmFindByEmail.defaultExpectation == nil {
+		mmFindByEmail.defaultExpectation = &UserRepositoryMockFindByEmailExpectation{}
+	}
+
+	if mmFindByEmail.defaultExpectation.params != nil {
+		mmFindByEmail.mock.t.Fatalf("UserRepositoryMock.FindByEmail mock is already set by Expect")
+	}
+
+	if mmFindByEmail.defaultExpectation.paramPtrs == nil {
+		mmFindByEmail.defaultExpectation.paramPtrs = &UserRepositoryMockFindByEmailParamPtrs{}
+	}
+	mmFindByEmail.defaultExpectation.paramPtrs.ctx = &ctx
+	mmFindByEmail.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmFindByEmail
+}
+
+// ExpectEmailParam2 sets up expected param email for UserRepository.FindByEmail
+func (mmFindByEmail *mUserRepositoryMockFindByEmail) ExpectEmailParam2(email string) *mUserRepositoryMockFindByEmail {
+	if mmFindByEmail.mock.funcFindByEmail != nil {
+		mmFindByEmail.mock.t.Fatalf("UserRepositoryMock.FindByEmail mock is already set by Set")
+	}
+
+	if mmFindByEmail.defaultExpectation == nil {
+		mmFindByEmail.defaultExpectation = &UserRepositoryMockFindByEmailExpectation{}
+	}
+
+	if mmFindByEmail.defaultExpectation.params != nil {
+		mmFindByEmail.mock.t.Fatalf("UserRepositoryMock.FindByEmail mock is already set by Expect")
+	}
+
+	if mmFindByEmail.defaultExpectation.paramPtrs == nil {
+		mmFindByEmail.defaultExpectation.paramPtrs = &UserRepositoryMockFindByEmailParamPtrs{}
+	}
+	mmFindByEmail.defaultExpectation.paramPtrs.email = &email
+	mmFindByEmail.defaultExpectation.expectationOrigins.originEmail = minimock.CallerInfo(1)
+
+	return mmFindByEmail
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserRepository.FindByEmail
+func (mmFindByEmail *mUserRepositoryMockFindByEmail) Inspect(f func(ctx context.Context, email string)) *mUserRepositoryMockFindByEmail {
+	if mmFindByEmail.mock.inspectFuncFindByEmail != nil {
+		mmFindByEmail.mock.t.Fatalf("Inspect function is already set for UserRepositoryMock.FindByEmail")
+	}
+
+	mmFindByEmail.mock.inspectFuncFindByEmail = f
+
+	return mmFindByEmail
+}
+
+// Return sets up results that will be returned by UserRepository.FindByEmail
+func (mmFindByEmail *mUserRepositoryMockFindByEmail) Return(up1 *models.User, err error) *UserRepositoryMock {
+	if mmFindByEmail.mock.funcFindByEmail != nil {
+		mmFindByEmail.mock.t.Fatalf("UserRepositoryMock.FindByEmail mock is already set by Set")
+	}
+
+	if mmFindByEmail.defaultExpectation == nil {
+		mmFindByEmail.defaultExpectation = &UserRepositoryMockFindByEmailExpectation{mock: mmFindByEmail.mock}
+	}
+	mmFindByEmail.defaultExpectation.results = &UserRepositoryMockFindByEmailResults{up1, err}
+	mmFindByEmail.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmFindByEmail.mock
+}
+
+// Set uses given function f to mock the UserRepository.FindByEmail method
+func (mmFindByEmail *mUserRepositoryMockFindByEmail) Set(f func(ctx context.Context, email string) (up1 *models.User, err error)) *UserRepositoryMock {
+	if mmFindByEmail.defaultExpectation != nil {
+		mmFindByEmail.mock.t.Fatalf("Default expectation is already set for the UserRepository.FindByEmail method")
+	}
+
+	if len(mmFindByEmail.expectations) > 0 {
+		mmFindByEmail.mock.t.Fatalf("Some expectations are already set for the UserRepository.FindByEmail method")
+	}
+
+	mmFindByEmail.mock.funcFindByEmail = f
+	mmFindByEmail.mock.funcFindByEmailOrigin = minimock.CallerInfo(1)
+	return mmFindByEmail.mock
+}
+
+// When sets expectation for the UserRepository.FindByEmail which will trigger the result defined by the following
+// Then helper
+func (mmFindByEmail *mUserRepositoryMockFindByEmail) When(ctx context.Context, email string) *UserRepositoryMockFindByEmailExpectation {
+	if mmFindByEmail.mock.funcFindByEmail != nil {
+		mmFindByEmail.mock.t.Fatalf("UserRepositoryMock.FindByEmail mock is already set by Set")
+	}
+
+	expectation := &UserRepositoryMockFindByEmailExpectation{
+		mock:               mmFindByEmail.mock,
+		params:             &UserRepositoryMockFindByEmailParams{ctx, email},
+		expectationOrigins: UserRepositoryMockFindByEmailExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmFindByEmail.expectations = append(mmFindByEmail.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserRepository.FindByEmail return parameters for the expectation previously defined by the When method
+func (e *UserRepositoryMockFindByEmailExpectation) Then(up1 *models.User, err error) *UserRepositoryMock {
+	e.results = &UserRepositoryMockFindByEmailResults{up1, err}
+	return e.mock
+}
+
+// Times sets number of times UserRepository.FindByEmail should be invoked
+func (mmFindByEmail *mUserRepositoryMockFindByEmail) Times(n uint64) *mUserRepositoryMockFindByEmail {
+	if n == 0 {
+		mmFindByEmail.mock.t.Fatalf("Times of UserRepositoryMock.FindByEmail mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmFindByEmail.expectedInvocations, n)
+	mmFindByEmail.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmFindByEmail
+}
+
+func (mmFindByEmail *mUserRepositoryMockFindByEmail) invocationsDone() bool {
+	if len(mmFindByEmail.expectations) == 0 && mmFindByEmail.defaultExpectation == nil && mmFindByEmail.mock.funcFindByEmail == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmFindByEmail.mock.afterFindByEmailCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmFindByEmail.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// FindByEmail implements UserRepository
+func (mmFindByEmail *UserRepositoryMock) FindByEmail(ctx context.Context, email string) (up1 *models.User, err error) {
+	mm_atomic.AddUint64(&mmFindByEmail.beforeFindByEmailCounter, 1)
+	defer mm_atomic.AddUint64(&mmFindByEmail.afterFindByEmailCounter, 1)
+
+	mmFindByEmail.t.Helper()
+
+	if mmFindByEmail.inspectFuncFindByEmail != nil {
+		mmFindByEmail.inspectFuncFindByEmail(ctx, email)
+	}
+
+	mm_params := UserRepositoryMockFindByEmailParams{ctx, email}
+
+	// Record call args
+	mmFindByEmail.FindByEmailMock.mutex.Lock()
+	mmFindByEmail.FindByEmailMock.callArgs = append(mmFindByEmail.FindByEmailMock.callArgs, &mm_params)
+	mmFindByEmail.FindByEmailMock.mutex.Unlock()
+
+	for _, e := range mmFindByEmail.FindByEmailMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.up1, e.results.err
+		}
+	}
+
+	if mmFindByEmail.FindByEmailMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmFindByEmail.FindByEmailMock.defaultExpectation.Counter, 1)
+		mm_want := mmFindByEmail.FindByEmailMock.defaultExpectation.params
+		mm_want_ptrs := mmFindByEmail.FindByEmailMock.defaultExpectation.paramPtrs
+
+		mm_got := UserRepositoryMockFindByEmailParams{ctx, email}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmFindByEmail.t.Errorf("UserRepositoryMock.FindByEmail got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindByEmail.FindByEmailMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.email != nil && !minimock.Equal(*mm_want_ptrs.email, mm_got.email) {
+				mmFindByEmail.t.Errorf("UserRepositoryMock.FindByEmail got unexpected parameter email, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindByEmail.FindByEmailMock.defaultExpectation.expectationOrigins.originEmail, *mm_want_ptrs.email, mm_got.email, minimock.Diff(*mm_want_ptrs.email, mm_got.email))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmFindByEmail.t.Errorf("UserRepositoryMock.FindByEmail got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmFindByEmail.FindByEmailMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmFindByEmail.FindByEmailMock.defaultExpectation.results
+		if mm_results == nil {
+			mmFindByEmail.t.Fatal("No results are set for the UserRepositoryMock.FindByEmail")
+		}
+		return (*mm_results).up1, (*mm_results).err
+	}
+	if mmFindByEmail.funcFindByEmail != nil {
+		return mmFindByEmail.funcFindByEmail(ctx, email)
+	}
+	mmFindByEmail.t.Fatalf("Unexpected call to UserRepositoryMock.FindByEmail. %v %v", ctx, email)
+	return
+}
+
+// FindByEmailAfterCounter returns a count of finished UserRepositoryMock.FindByEmail invocations
+func (mmFindByEmail *UserRepositoryMock) FindByEmailAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFindByEmail.afterFindByEmailCounter)
+}
+
+// FindByEmailBeforeCounter returns a count of UserRepositoryMock.FindByEmail invocations
+func (mmFindByEmail *UserRepositoryMock) FindByEmailBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFindByEmail.beforeFindByEmailCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserRepositoryMock.FindByEmail.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmFindByEmail *mUserRepositoryMockFindByEmail) Calls() []*UserRepositoryMockFindByEmailParams {
+	mmFindByEmail.mutex.RLock()
+
+	argCopy := make([]*UserRepositoryMockFindByEmailParams, len(mmFindByEmail.callArgs))
+	copy(argCopy, mmFindByEmail.callArgs)
+
+	mmFindByEmail.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockFindByEmailDone returns true if the count of the FindByEmail invocations corresponds
+// the number of defined expectations
+func (m *UserRepositoryMock) MinimockFindByEmailDone() bool {
+	if m.FindByEmailMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.FindByEmailMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.FindByEmailMock.invocationsDone()
+}
+
+// MinimockFindByEmailInspect logs each unmet expectation
+func (m *UserRepositoryMock) MinimockFindByEmailInspect() {
+	for _, e := range m.FindByEmailMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserRepositoryMock.FindByEmail at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterFindByEmailCounter := mm_atomic.LoadUint64(&m.afterFindByEmailCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.FindByEmailMock.defaultExpectation != nil && afterFindByEmailCounter < 1 {
+		if m.FindByEmailMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UserRepositoryMock.FindByEmail at\n%s", m.FindByEmailMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UserRepositoryMock.FindByEmail at\n%s with params: %#v", m.FindByEmailMock.defaultExpectation.expectationOrigins.origin, *m.FindByEmailMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcFindByEmail != nil && afterFindByEmailCounter < 1 {
+		m.t.Errorf("Expected call to UserRepositoryMock.FindByEmail at\n%s", m.funcFindByEmailOrigin)
+	}
+
+	if !m.FindByEmailMock.invocationsDone() && afterFindByEmailCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserRepositoryMock.FindByEmail at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.FindByEmailMock.expectedInvocations), m.FindByEmailMock.expectedInvocationsOrigin, afterFindByEmailCounter)
+	}
+}
+
+type mUserRepositoryMockFindByID struct {
+	optional           bool
+	mock               *UserRepositoryMock
+	defaultExpectation *UserRepositoryMockFindByIDExpectation
+	expectations       []*UserRepositoryMockFindByIDExpectation
+
+	callArgs []*UserRepositoryMockFindByIDParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UserRepositoryMockFindByIDExpectation specifies expectation struct of the UserRepository.FindByID
+type UserRepositoryMockFindByIDExpectation struct {
+	mock               *UserRepositoryMock
+	params             *UserRepositoryMockFindByIDParams
+	paramPtrs          *UserRepositoryMockFindByIDParamPtrs
+	expectationOrigins UserRepositoryMockFindByIDExpectationOrigins
+	results            *UserRepositoryMockFindByIDResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UserRepositoryMockFindByIDParams contains parameters of the UserRepository.FindByID
+type UserRepositoryMockFindByIDParams struct {
+	ctx    context.Context
+	userID string
+}
+
+// UserRepositoryMockFindByIDParamPtrs contains pointers to parameters of the UserRepository.FindByID
+type UserRepositoryMockFindByIDParamPtrs struct {
+	ctx    *context.Context
+	userID *string
+}
+
+// UserRepositoryMockFindByIDResults contains results of the UserRepository.FindByID
+type UserRepositoryMockFindByIDResults struct {
+	up1 *models.User
+	err error
+}
+
+// UserRepositoryMockFindByIDOrigins contains origins of expectations of the UserRepository.FindByID
+type UserRepositoryMockFindByIDExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmFindByID *mUserRepositoryMockFindByID) Optional() *mUserRepositoryMockFindByID {
+	mmFindByID.optional = true
+	return mmFindByID
+}
+
+// Expect sets up expected params for UserRepository.FindByID
+func (mmFindByID *mUserRepositoryMockFindByID) Expect(ctx context.Context, userID string) *mUserRepositoryMockFindByID {
+	if mmFindByID.mock.funcFindByID != nil {
+		mmFindByID.mock.t.Fatalf("UserRepositoryMock.FindByID mock is already set by Set")
+	}
+
+	if mmFindByID.defaultExpectation == nil {
+		mmFindByID.defaultExpectation = &UserRepositoryMockFindByIDExpectation{}
+	}
+
+	if mmFindByID.defaultExpectation.paramPtrs != nil {
+		mmFindByID.mock.t.Fatalf("UserRepositoryMock.FindByID mock is already set by ExpectParams functions")
+	}
+
+	mmFindByID.defaultExpectation.params = &UserRepositoryMockFindByIDParams{ctx, userID}
+	mmFindByID.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmFindByID.expectations {
+		if minimock.Equal(e.params, mmFindByID.defaultExpectation.params) {
 			mmFindByID.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmFindByID.defaultExpectation.params)
 		}
 	}
 
-	return mmFindByID
+	return mmFindByID
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UserRepository.FindByID
+func (mmFindByID *mUserRepositoryMockFindByID) ExpectCtxParam1(ctx context.Context) *mUserRepositoryMockFindByID {
+	if mmFindByID.mock.funcFindByID != nil {
+		mmFindByID.mock.t.Fatalf("UserRepositoryMock.FindByID mock is already set by Set")
+	}
+
+	if mmFindByID.defaultExpectation == nil {
+		mmFindByID.defaultExpectation = &UserRepositoryMockFindByIDExpectation{}
+	}
+
+	if mmFindByID.defaultExpectation.params != nil {
+		mmFindByID.mock.t.Fatalf("UserRepositoryMock.FindByID mock is already set by Expect")
+	}
+
+	if mmFindByID.defaultExpectation.paramPtrs == nil {
+		mmFindByID.defaultExpectation.paramPtrs = &UserRepositoryMockFindByIDParamPtrs{}
+	}
+	mmFindByID.defaultExpectation.paramPtrs.ctx = &ctx
+	mmFindByID.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmFindByID
+}
+
+// ExpectUserIDParam2 sets up expected param userID for UserRepository.FindByID
+func (mmFindByID *mUserRepositoryMockFindByID) ExpectUserIDParam2(userID string) *mUserRepositoryMockFindByID {
+	if mmFindByID.mock.funcFindByID != nil {
+		mmFindByID.mock.t.Fatalf("UserRepositoryMock.FindByID mock is already set by Set")
+	}
+
+	if mmFindByID.defaultExpectation == nil {
+		mmFindByID.defaultExpectation = &UserRepositoryMockFindByIDExpectation{}
+	}
+
+	if mmFindByID.defaultExpectation.params != nil {
+		mmFindByID.mock.t.Fatalf("UserRepositoryMock.FindByID mock is already set by Expect")
+	}
+
+	if mmFindByID.defaultExpectation.paramPtrs == nil {
+		mmFindByID.defaultExpectation.paramPtrs = &UserRepositoryMockFindByIDParamPtrs{}
+	}
+	mmFindByID.defaultExpectation.paramPtrs.userID = &userID
+	mmFindByID.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmFindByID
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserRepository.FindByID
+func (mmFindByID *mUserRepositoryMockFindByID) Inspect(f func(ctx context.Context, userID string)) *mUserRepositoryMockFindByID {
+	if mmFindByID.mock.inspectFuncFindByID != nil {
+		mmFindByID.mock.t.Fatalf("Inspect function is already set for UserRepositoryMock.FindByID")
+	}
+
+	mmFindByID.mock.inspectFuncFindByID = f
+
+	return mmFindByID
+}
+
+// Return sets up results that will be returned by UserRepository.FindByID
+func (mmFindByID *mUserRepositoryMockFindByID) Return(up1 *models.User, err error) *UserRepositoryMock {
+	if mmFindByID.mock.funcFindByID != nil {
+		mmFindByID.mock.t.Fatalf("UserRepositoryMock.FindByID mock is already set by Set")
+	}
+
+	if mmFindByID.defaultExpectation == nil {
+		mmFindByID.defaultExpectation = &UserRepositoryMockFindByIDExpectation{mock: mmFindByID.mock}
+	}
+	mmFindByID.defaultExpectation.results = &UserRepositoryMockFindByIDResults{up1, err}
+	mmFindByID.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmFindByID.mock
+}
+
+// Set uses given function f to mock the UserRepository.FindByID method
+func (mmFindByID *mUserRepositoryMockFindByID) Set(f func(ctx context.Context, userID string) (up1 *models.User, err error)) *UserRepositoryMock {
+	if mmFindByID.defaultExpectation != nil {
+		mmFindByID.mock.t.Fatalf("Default expectation is already set for the UserRepository.FindByID method")
+	}
+
+	if len(mmFindByID.expectations) > 0 {
+		mmFindByID.mock.t.Fatalf("Some expectations are already set for the UserRepository.FindByID method")
+	}
+
+	mmFindByID.mock.funcFindByID = f
+	mmFindByID.mock.funcFindByIDOrigin = minimock.CallerInfo(1)
+	return mmFindByID.mock
+}
+
+// When sets expectation for the UserRepository.FindByID which will trigger the result defined by the following
+// Then helper
+func (mmFindByID *mUserRepositoryMockFindByID) When(ctx context.Context, userID string) *UserRepositoryMockFindByIDExpectation {
+	if mmFindByID.mock.funcFindByID != nil {
+		mmFindByID.mock.t.Fatalf("UserRepositoryMock.FindByID mock is already set by Set")
+	}
+
+	expectation := &UserRepositoryMockFindByIDExpectation{
+		mock:               mmFindByID.mock,
+		params:             &UserRepositoryMockFindByIDParams{ctx, userID},
+		expectationOrigins: UserRepositoryMockFindByIDExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmFindByID.expectations = append(mmFindByID.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserRepository.FindByID return parameters for the expectation previously defined by the When method
+func (e *UserRepositoryMockFindByIDExpectation) Then(up1 *models.User, err error) *UserRepositoryMock {
+	e.results = &UserRepositoryMockFindByIDResults{up1, err}
+	return e.mock
+}
+
+// Times sets number of times UserRepository.FindByID should be invoked
+func (mmFindByID *mUserRepositoryMockFindByID) Times(n uint64) *mUserRepositoryMockFindByID {
+	if n == 0 {
+		mmFindByID.mock.t.Fatalf("Times of UserRepositoryMock.FindByID mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmFindByID.expectedInvocations, n)
+	mmFindByID.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmFindByID
+}
+
+func (mmFindByID *mUserRepositoryMockFindByID) invocationsDone() bool {
+	if len(mmFindByID.expectations) == 0 && mmFindByID.defaultExpectation == nil && mmFindByID.mock.funcFindByID == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmFindByID.mock.afterFindByIDCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmFindByID.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// FindByID implements UserRepository
+func (mmFindByID *UserRepositoryMock) FindByID(ctx context.Context, userID string) (up1 *models.User, err error) {
+	mm_atomic.AddUint64(&mmFindByID.beforeFindByIDCounter, 1)
+	defer mm_atomic.AddUint64(&mmFindByID.afterFindByIDCounter, 1)
+
+	mmFindByID.t.Helper()
+
+	if mmFindByID.inspectFuncFindByID != nil {
+		mmFindByID.inspectFuncFindByID(ctx, userID)
+	}
+
+	mm_params := UserRepositoryMockFindByIDParams{ctx, userID}
+
+	// Record call args
+	mmFindByID.FindByIDMock.mutex.Lock()
+	mmFindByID.FindByIDMock.callArgs = append(mmFindByID.FindByIDMock.callArgs, &mm_params)
+	mmFindByID.FindByIDMock.mutex.Unlock()
+
+	for _, e := range mmFindByID.FindByIDMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.up1, e.results.err
+		}
+	}
+
+	if mmFindByID.FindByIDMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmFindByID.FindByIDMock.defaultExpectation.Counter, 1)
+		mm_want := mmFindByID.FindByIDMock.defaultExpectation.params
+		mm_want_ptrs := mmFindByID.FindByIDMock.defaultExpectation.paramPtrs
+
+		mm_got := UserRepositoryMockFindByIDParams{ctx, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmFindByID.t.Errorf("UserRepositoryMock.FindByID got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindByID.FindByIDMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmFindByID.t.Errorf("UserRepositoryMock.FindByID got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindByID.FindByIDMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmFindByID.t.Errorf("UserRepositoryMock.FindByID got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmFindByID.FindByIDMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmFindByID.FindByIDMock.defaultExpectation.results
+		if mm_results == nil {
+			mmFindByID.t.Fatal("No results are set for the UserRepositoryMock.FindByID")
+		}
+		return (*mm_results).up1, (*mm_results).err
+	}
+	if mmFindByID.funcFindByID != nil {
+		return mmFindByID.funcFindByID(ctx, userID)
+	}
+	mmFindByID.t.Fatalf("Unexpected call to UserRepositoryMock.FindByID. %v %v", ctx, userID)
+	return
+}
+
+// FindByIDAfterCounter returns a count of finished UserRepositoryMock.FindByID invocations
+func (mmFindByID *UserRepositoryMock) FindByIDAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFindByID.afterFindByIDCounter)
+}
+
+// FindByIDBeforeCounter returns a count of UserRepositoryMock.FindByID invocations
+func (mmFindByID *UserRepositoryMock) FindByIDBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFindByID.beforeFindByIDCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserRepositoryMock.FindByID.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmFindByID *mUserRepositoryMockFindByID) Calls() []*UserRepositoryMockFindByIDParams {
+	mmFindByID.mutex.RLock()
+
+	argCopy := make([]*UserRepositoryMockFindByIDParams, len(mmFindByID.callArgs))
+	copy(argCopy, mmFindByID.callArgs)
+
+	mmFindByID.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockFindByIDDone returns true if the count of the FindByID invocations corresponds
+// the number of defined expectations
+func (m *UserRepositoryMock) MinimockFindByIDDone() bool {
+	if m.FindByIDMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.FindByIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.FindByIDMock.invocationsDone()
+}
+
+// MinimockFindByIDInspect logs each unmet expectation
+func (m *UserRepositoryMock) MinimockFindByIDInspect() {
+	for _, e := range m.FindByIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserRepositoryMock.FindByID at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterFindByIDCounter := mm_atomic.LoadUint64(&m.afterFindByIDCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.FindByIDMock.defaultExpectation != nil && afterFindByIDCounter < 1 {
+		if m.FindByIDMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UserRepositoryMock.FindByID at\n%s", m.FindByIDMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UserRepositoryMock.FindByID at\n%s with params: %#v", m.FindByIDMock.defaultExpectation.expectationOrigins.origin, *m.FindByIDMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcFindByID != nil && afterFindByIDCounter < 1 {
+		m.t.Errorf("Expected call to UserRepositoryMock.FindByID at\n%s", m.funcFindByIDOrigin)
+	}
+
+	if !m.FindByIDMock.invocationsDone() && afterFindByIDCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserRepositoryMock.FindByID at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.FindByIDMock.expectedInvocations), m.FindByIDMock.expectedInvocationsOrigin, afterFindByIDCounter)
+	}
+}
+
+type mUserRepositoryMockFindPersonalAccessTokenByHash struct {
+	optional           bool
+	mock               *UserRepositoryMock
+	defaultExpectation *UserRepositoryMockFindPersonalAccessTokenByHashExpectation
+	expectations       []*UserRepositoryMockFindPersonalAccessTokenByHashExpectation
+
+	callArgs []*UserRepositoryMockFindPersonalAccessTokenByHashParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UserRepositoryMockFindPersonalAccessTokenByHashExpectation specifies expectation struct of the UserRepository.FindPersonalAccessTokenByHash
+type UserRepositoryMockFindPersonalAccessTokenByHashExpectation struct {
+	mock               *UserRepositoryMock
+	params             *UserRepositoryMockFindPersonalAccessTokenByHashParams
+	paramPtrs          *UserRepositoryMockFindPersonalAccessTokenByHashParamPtrs
+	expectationOrigins UserRepositoryMockFindPersonalAccessTokenByHashExpectationOrigins
+	results            *UserRepositoryMockFindPersonalAccessTokenByHashResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UserRepositoryMockFindPersonalAccessTokenByHashParams contains parameters of the UserRepository.FindPersonalAccessTokenByHash
+type UserRepositoryMockFindPersonalAccessTokenByHashParams struct {
+	ctx       context.Context
+	tokenHash string
+}
+
+// UserRepositoryMockFindPersonalAccessTokenByHashParamPtrs contains pointers to parameters of the UserRepository.FindPersonalAccessTokenByHash
+type UserRepositoryMockFindPersonalAccessTokenByHashParamPtrs struct {
+	ctx       *context.Context
+	tokenHash *string
+}
+
+// UserRepositoryMockFindPersonalAccessTokenByHashResults contains results of the UserRepository.FindPersonalAccessTokenByHash
+type UserRepositoryMockFindPersonalAccessTokenByHashResults struct {
+	pp1 *models.PersonalAccessToken
+	err error
+}
+
+// UserRepositoryMockFindPersonalAccessTokenByHashOrigins contains origins of expectations of the UserRepository.FindPersonalAccessTokenByHash
+type UserRepositoryMockFindPersonalAccessTokenByHashExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originTokenHash string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmFindPersonalAccessTokenByHash *mUserRepositoryMockFindPersonalAccessTokenByHash) Optional() *mUserRepositoryMockFindPersonalAccessTokenByHash {
+	mmFindPersonalAccessTokenByHash.optional = true
+	return mmFindPersonalAccessTokenByHash
+}
+
+// Expect sets up expected params for UserRepository.FindPersonalAccessTokenByHash
+func (mmFindPersonalAccessTokenByHash *mUserRepositoryMockFindPersonalAccessTokenByHash) Expect(ctx context.Context, tokenHash string) *mUserRepositoryMockFindPersonalAccessTokenByHash {
+	if mmFindPersonalAccessTokenByHash.mock.funcFindPersonalAccessTokenByHash != nil {
+		mmFindPersonalAccessTokenByHash.mock.t.Fatalf("UserRepositoryMock.FindPersonalAccessTokenByHash mock is already set by Set")
+	}
+
+	if mmFindPersonalAccessTokenByHash.defaultExpectation == nil {
+		mmFindPersonalAccessTokenByHash.defaultExpectation = &UserRepositoryMockFindPersonalAccessTokenByHashExpectation{}
+	}
+
+	if mmFindPersonalAccessTokenByHash.defaultExpectation.paramPtrs != nil {
+		mmFindPersonalAccessTokenByHash.mock.t.Fatalf("UserRepositoryMock.FindPersonalAccessTokenByHash mock is already set by ExpectParams functions")
+	}
+
+	mmFindPersonalAccessTokenByHash.defaultExpectation.params = &UserRepositoryMockFindPersonalAccessTokenByHashParams{ctx, tokenHash}
+	mmFindPersonalAccessTokenByHash.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmFindPersonalAccessTokenByHash.expectations {
+		if minimock.Equal(e.params, mmFindPersonalAccessTokenByHash.defaultExpectation.params) {
+			mmFindPersonalAccessTokenByHash.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmFindPersonalAccessTokenByHash.defaultExpectation.params)
+		}
+	}
+
+	return mmFindPersonalAccessTokenByHash
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UserRepository.FindPersonalAccessTokenByHash
+func (mmFindPersonalAccessTokenByHash *mUserRepositoryMockFindPersonalAccessTokenByHash) ExpectCtxParam1(ctx context.Context) *mUserRepositoryMockFindPersonalAccessTokenByHash {
+	if mmFindPersonalAccessTokenByHash.mock.funcFindPersonalAccessTokenByHash != nil {
+		mmFindPersonalAccessTokenByHash.mock.t.Fatalf("UserRepositoryMock.FindPersonalAccessTokenByHash mock is already set by Set")
+	}
+
+	if mmFindPersonalAccessTokenByHash.defaultExpectation == nil {
+		mmFindPersonalAccessTokenByHash.defaultExpectation = &UserRepositoryMockFindPersonalAccessTokenByHashExpectation{}
+	}
+
+	if mmFindPersonalAccessTokenByHash.defaultExpectation.params != nil {
+		mmFindPersonalAccessTokenByHash.mock.t.Fatalf("UserRepositoryMock.FindPersonalAccessTokenByHash mock is already set by Expect")
+	}
+
+	if mmFindPersonalAccessTokenByHash.defaultExpectation.paramPtrs == nil {
+		mmFindPersonalAccessTokenByHash.defaultExpectation.paramPtrs = &UserRepositoryMockFindPersonalAccessTokenByHashParamPtrs{}
+	}
+	mmFindPersonalAccessTokenByHash.defaultExpectation.paramPtrs.ctx = &ctx
+	mmFindPersonalAccessTokenByHash.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmFindPersonalAccessTokenByHash
+}
+
+// ExpectTokenHashParam2 sets up expected param tokenHash for UserRepository.FindPersonalAccessTokenByHash
+func (mmFindPersonalAccessTokenByHash *mUserRepositoryMockFindPersonalAccessTokenByHash) ExpectTokenHashParam2(tokenHash string) *mUserRepositoryMockFindPersonalAccessTokenByHash {
+	if mmFindPersonalAccessTokenByHash.mock.funcFindPersonalAccessTokenByHash != nil {
+		mmFindPersonalAccessTokenByHash.mock.t.Fatalf("UserRepositoryMock.FindPersonalAccessTokenByHash mock is already set by Set")
+	}
+
+	if mmFindPersonalAccessTokenByHash.defaultExpectation == nil {
+		mmFindPersonalAccessTokenByHash.defaultExpectation = &UserRepositoryMockFindPersonalAccessTokenByHashExpectation{}
+	}
+
+	if mmFindPersonalAccessTokenByHash.defaultExpectation.params != nil {
+		mmFindPersonalAccessTokenByHash.mock.t.Fatalf("UserRepositoryMock.FindPersonalAccessTokenByHash mock is already set by Expect")
+	}
+
+	if mmFindPersonalAccessTokenByHash.defaultExpectation.paramPtrs == nil {
+		mmFindPersonalAccessTokenByHash.defaultExpectation.paramPtrs = &UserRepositoryMockFindPersonalAccessTokenByHashParamPtrs{}
+	}
+	mmFindPersonalAccessTokenByHash.defaultExpectation.paramPtrs.tokenHash = &tokenHash
+	mmFindPersonalAccessTokenByHash.defaultExpectation.expectationOrigins.originTokenHash = minimock.CallerInfo(1)
+
+	return mmFindPersonalAccessTokenByHash
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserRepository.FindPersonalAccessTokenByHash
+func (mmFindPersonalAccessTokenByHash *mUserRepositoryMockFindPersonalAccessTokenByHash) Inspect(f func(ctx context.Context, tokenHash string)) *mUserRepositoryMockFindPersonalAccessTokenByHash {
+	if mmFindPersonalAccessTokenByHash.mock.inspectFuncFindPersonalAccessTokenByHash != nil {
+		mmFindPersonalAccessTokenByHash.mock.t.Fatalf("Inspect function is already set for UserRepositoryMock.FindPersonalAccessTokenByHash")
+	}
+
+	mmFindPersonalAccessTokenByHash.mock.inspectFuncFindPersonalAccessTokenByHash = f
+
+	return mmFindPersonalAccessTokenByHash
+}
+
+// Return sets up results that will be returned by UserRepository.FindPersonalAccessTokenByHash
+func (mmFindPersonalAccessTokenByHash *mUserRepositoryMockFindPersonalAccessTokenByHash) Return(pp1 *models.PersonalAccessToken, err error) *UserRepositoryMock {
+	if mmFindPersonalAccessTokenByHash.mock.funcFindPersonalAccessTokenByHash != nil {
+		mmFindPersonalAccessTokenByHash.mock.t.Fatalf("UserRepositoryMock.FindPersonalAccessTokenByHash mock is already set by Set")
+	}
+
+	if mmFindPersonalAccessTokenByHash.defaultExpectation == nil {
+		mmFindPersonalAccessTokenByHash.defaultExpectation = &UserRepositoryMockFindPersonalAccessTokenByHashExpectation{mock: mmFindPersonalAccessTokenByHash.mock}
+	}
+	mmFindPersonalAccessTokenByHash.defaultExpectation.results = &UserRepositoryMockFindPersonalAccessTokenByHashResults{pp1, err}
+	mmFindPersonalAccessTokenByHash.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmFindPersonalAccessTokenByHash.mock
+}
+
+// Set uses given function f to mock the UserRepository.FindPersonalAccessTokenByHash method
+func (mmFindPersonalAccessTokenByHash *mUserRepositoryMockFindPersonalAccessTokenByHash) Set(f func(ctx context.Context, tokenHash string) (pp1 *models.PersonalAccessToken, err error)) *UserRepositoryMock {
+	if mmFindPersonalAccessTokenByHash.defaultExpectation != nil {
+		mmFindPersonalAccessTokenByHash.mock.t.Fatalf("Default expectation is already set for the UserRepository.FindPersonalAccessTokenByHash method")
+	}
+
+	if len(mmFindPersonalAccessTokenByHash.expectations) > 0 {
+		mmFindPersonalAccessTokenByHash.mock.t.Fatalf("Some expectations are already set for the UserRepository.FindPersonalAccessTokenByHash method")
+	}
+
+	mmFindPersonalAccessTokenByHash.mock.funcFindPersonalAccessTokenByHash = f
+	mmFindPersonalAccessTokenByHash.mock.funcFindPersonalAccessTokenByHashOrigin = minimock.CallerInfo(1)
+	return mmFindPersonalAccessTokenByHash.mock
+}
+
+// When sets expectation for the UserRepository.FindPersonalAccessTokenByHash which will trigger the result defined by the following
+// Then helper
+func (mmFindPersonalAccessTokenByHash *mUserRepositoryMockFindPersonalAccessTokenByHash) When(ctx context.Context, tokenHash string) *UserRepositoryMockFindPersonalAccessTokenByHashExpectation {
+	if mmFindPersonalAccessTokenByHash.mock.funcFindPersonalAccessTokenByHash != nil {
+		mmFindPersonalAccessTokenByHash.mock.t.Fatalf("UserRepositoryMock.FindPersonalAccessTokenByHash mock is already set by Set")
+	}
+
+	expectation := &UserRepositoryMockFindPersonalAccessTokenByHashExpectation{
+		mock:               mmFindPersonalAccessTokenByHash.mock,
+		params:             &UserRepositoryMockFindPersonalAccessTokenByHashParams{ctx, tokenHash},
+		expectationOrigins: UserRepositoryMockFindPersonalAccessTokenByHashExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmFindPersonalAccessTokenByHash.expectations = append(mmFindPersonalAccessTokenByHash.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserRepository.FindPersonalAccessTokenByHash return parameters for the expectation previously defined by the When method
+func (e *UserRepositoryMockFindPersonalAccessTokenByHashExpectation) Then(pp1 *models.PersonalAccessToken, err error) *UserRepositoryMock {
+	e.results = &UserRepositoryMockFindPersonalAccessTokenByHashResults{pp1, err}
+	return e.mock
+}
+
+// Times sets number of times UserRepository.FindPersonalAccessTokenByHash should be invoked
+func (mmFindPersonalAccessTokenByHash *mUserRepositoryMockFindPersonalAccessTokenByHash) Times(n uint64) *mUserRepositoryMockFindPersonalAccessTokenByHash {
+	if n == 0 {
+		mmFindPersonalAccessTokenByHash.mock.t.Fatalf("Times of UserRepositoryMock.FindPersonalAccessTokenByHash mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmFindPersonalAccessTokenByHash.expectedInvocations, n)
+	mmFindPersonalAccessTokenByHash.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmFindPersonalAccessTokenByHash
+}
+
+func (mmFindPersonalAccessTokenByHash *mUserRepositoryMockFindPersonalAccessTokenByHash) invocationsDone() bool {
+	if len(mmFindPersonalAccessTokenByHash.expectations) == 0 && mmFindPersonalAccessTokenByHash.defaultExpectation == nil && mmFindPersonalAccessTokenByHash.mock.funcFindPersonalAccessTokenByHash == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmFindPersonalAccessTokenByHash.mock.afterFindPersonalAccessTokenByHashCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmFindPersonalAccessTokenByHash.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// FindPersonalAccessTokenByHash implements UserRepository
+func (mmFindPersonalAccessTokenByHash *UserRepositoryMock) FindPersonalAccessTokenByHash(ctx context.Context, tokenHash string) (pp1 *models.PersonalAccessToken, err error) {
+	mm_atomic.AddUint64(&mmFindPersonalAccessTokenByHash.beforeFindPersonalAccessTokenByHashCounter, 1)
+	defer mm_atomic.AddUint64(&mmFindPersonalAccessTokenByHash.afterFindPersonalAccessTokenByHashCounter, 1)
+
+	mmFindPersonalAccessTokenByHash.t.Helper()
+
+	if mmFindPersonalAccessTokenByHash.inspectFuncFindPersonalAccessTokenByHash != nil {
+		mmFindPersonalAccessTokenByHash.inspectFuncFindPersonalAccessTokenByHash(ctx, tokenHash)
+	}
+
+	mm_params := UserRepositoryMockFindPersonalAccessTokenByHashParams{ctx, tokenHash}
+
+	// Record call args
+	mmFindPersonalAccessTokenByHash.FindPersonalAccessTokenByHashMock.mutex.Lock()
+	mmFindPersonalAccessTokenByHash.FindPersonalAccessTokenByHashMock.callArgs = append(mmFindPersonalAccessTokenByHash.FindPersonalAccessTokenByHashMock.callArgs, &mm_params)
+	mmFindPersonalAccessTokenByHash.FindPersonalAccessTokenByHashMock.mutex.Unlock()
+
+	for _, e := range mmFindPersonalAccessTokenByHash.FindPersonalAccessTokenByHashMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.pp1, e.results.err
+		}
+	}
+
+	if mmFindPersonalAccessTokenByHash.FindPersonalAccessTokenByHashMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmFindPersonalAccessTokenByHash.FindPersonalAccessTokenByHashMock.defaultExpectation.Counter, 1)
+		mm_want := mmFindPersonalAccessTokenByHash.FindPersonalAccessTokenByHashMock.defaultExpectation.params
+		mm_want_ptrs := mmFindPersonalAccessTokenByHash.FindPersonalAccessTokenByHashMock.defaultExpectation.paramPtrs
+
+		mm_got := UserRepositoryMockFindPersonalAccessTokenByHashParams{ctx, tokenHash}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmFindPersonalAccessTokenByHash.t.Errorf("UserRepositoryMock.FindPersonalAccessTokenByHash got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindPersonalAccessTokenByHash.FindPersonalAccessTokenByHashMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.tokenHash != nil && !minimock.Equal(*mm_want_ptrs.tokenHash, mm_got.tokenHash) {
+				mmFindPersonalAccessTokenByHash.t.Errorf("UserRepositoryMock.FindPersonalAccessTokenByHash got unexpected parameter tokenHash, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindPersonalAccessTokenByHash.FindPersonalAccessTokenByHashMock.defaultExpectation.expectationOrigins.originTokenHash, *mm_want_ptrs.tokenHash, mm_got.tokenHash, minimock.Diff(*mm_want_ptrs.tokenHash, mm_got.tokenHash))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmFindPersonalAccessTokenByHash.t.Errorf("UserRepositoryMock.FindPersonalAccessTokenByHash got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmFindPersonalAccessTokenByHash.FindPersonalAccessTokenByHashMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmFindPersonalAccessTokenByHash.FindPersonalAccessTokenByHashMock.defaultExpectation.results
+		if mm_results == nil {
+			mmFindPersonalAccessTokenByHash.t.Fatal("No results are set for the UserRepositoryMock.FindPersonalAccessTokenByHash")
+		}
+		return (*mm_results).pp1, (*mm_results).err
+	}
+	if mmFindPersonalAccessTokenByHash.funcFindPersonalAccessTokenByHash != nil {
+		return mmFindPersonalAccessTokenByHash.funcFindPersonalAccessTokenByHash(ctx, tokenHash)
+	}
+	mmFindPersonalAccessTokenByHash.t.Fatalf("Unexpected call to UserRepositoryMock.FindPersonalAccessTokenByHash. %v %v", ctx, tokenHash)
+	return
+}
+
+// FindPersonalAccessTokenByHashAfterCounter returns a count of finished UserRepositoryMock.FindPersonalAccessTokenByHash invocations
+func (mmFindPersonalAccessTokenByHash *UserRepositoryMock) FindPersonalAccessTokenByHashAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFindPersonalAccessTokenByHash.afterFindPersonalAccessTokenByHashCounter)
+}
+
+// FindPersonalAccessTokenByHashBeforeCounter returns a count of UserRepositoryMock.FindPersonalAccessTokenByHash invocations
+func (mmFindPersonalAccessTokenByHash *UserRepositoryMock) FindPersonalAccessTokenByHashBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFindPersonalAccessTokenByHash.beforeFindPersonalAccessTokenByHashCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserRepositoryMock.FindPersonalAccessTokenByHash.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmFindPersonalAccessTokenByHash *mUserRepositoryMockFindPersonalAccessTokenByHash) Calls() []*UserRepositoryMockFindPersonalAccessTokenByHashParams {
+	mmFindPersonalAccessTokenByHash.mutex.RLock()
+
+	argCopy := make([]*UserRepositoryMockFindPersonalAccessTokenByHashParams, len(mmFindPersonalAccessTokenByHash.callArgs))
+	copy(argCopy, mmFindPersonalAccessTokenByHash.callArgs)
+
+	mmFindPersonalAccessTokenByHash.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockFindPersonalAccessTokenByHashDone returns true if the count of the FindPersonalAccessTokenByHash invocations corresponds
+// the number of defined expectations
+func (m *UserRepositoryMock) MinimockFindPersonalAccessTokenByHashDone() bool {
+	if m.FindPersonalAccessTokenByHashMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.FindPersonalAccessTokenByHashMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.FindPersonalAccessTokenByHashMock.invocationsDone()
+}
+
+// MinimockFindPersonalAccessTokenByHashInspect logs each unmet expectation
+func (m *UserRepositoryMock) MinimockFindPersonalAccessTokenByHashInspect() {
+	for _, e := range m.FindPersonalAccessTokenByHashMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserRepositoryMock.FindPersonalAccessTokenByHash at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterFindPersonalAccessTokenByHashCounter := mm_atomic.LoadUint64(&m.afterFindPersonalAccessTokenByHashCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.FindPersonalAccessTokenByHashMock.defaultExpectation != nil && afterFindPersonalAccessTokenByHashCounter < 1 {
+		if m.FindPersonalAccessTokenByHashMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UserRepositoryMock.FindPersonalAccessTokenByHash at\n%s", m.FindPersonalAccessTokenByHashMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UserRepositoryMock.FindPersonalAccessTokenByHash at\n%s with params: %#v", m.FindPersonalAccessTokenByHashMock.defaultExpectation.expectationOrigins.origin, *m.FindPersonalAccessTokenByHashMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcFindPersonalAccessTokenByHash != nil && afterFindPersonalAccessTokenByHashCounter < 1 {
+		m.t.Errorf("Expected call to UserRepositoryMock.FindPersonalAccessTokenByHash at\n%s", m.funcFindPersonalAccessTokenByHashOrigin)
+	}
+
+	if !m.FindPersonalAccessTokenByHashMock.invocationsDone() && afterFindPersonalAccessTokenByHashCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserRepositoryMock.FindPersonalAccessTokenByHash at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.FindPersonalAccessTokenByHashMock.expectedInvocations), m.FindPersonalAccessTokenByHashMock.expectedInvocationsOrigin, afterFindPersonalAccessTokenByHashCounter)
+	}
+}
+
+type mUserRepositoryMockInTx struct {
+	optional           bool
+	mock               *UserRepositoryMock
+	defaultExpectation *UserRepositoryMockInTxExpectation
+	expectations       []*UserRepositoryMockInTxExpectation
+
+	callArgs []*UserRepositoryMockInTxParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UserRepositoryMockInTxExpectation specifies expectation struct of the UserRepository.InTx
+type UserRepositoryMockInTxExpectation struct {
+	mock               *UserRepositoryMock
+	params             *UserRepositoryMockInTxParams
+	paramPtrs          *UserRepositoryMockInTxParamPtrs
+	expectationOrigins UserRepositoryMockInTxExpectationOrigins
+	results            *UserRepositoryMockInTxResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UserRepositoryMockInTxParams contains parameters of the UserRepository.InTx
+type UserRepositoryMockInTxParams struct {
+	ctx context.Context
+	fn  func(ctx context.Context) error
+}
+
+// UserRepositoryMockInTxParamPtrs contains pointers to parameters of the UserRepository.InTx
+type UserRepositoryMockInTxParamPtrs struct {
+	ctx *context.Context
+	fn  *func(ctx context.Context) error
+}
+
+// UserRepositoryMockInTxResults contains results of the UserRepository.InTx
+type UserRepositoryMockInTxResults struct {
+	err error
+}
+
+// UserRepositoryMockInTxOrigins contains origins of expectations of the UserRepository.InTx
+type UserRepositoryMockInTxExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originFn  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmInTx *mUserRepositoryMockInTx) Optional() *mUserRepositoryMockInTx {
+	mmInTx.optional = true
+	return mmInTx
+}
+
+// Expect sets up expected params for UserRepository.InTx
+func (mmInTx *mUserRepositoryMockInTx) Expect(ctx context.Context, fn func(ctx context.Context) error) *mUserRepositoryMockInTx {
+	if mmInTx.mock.funcInTx != nil {
+		mmInTx.mock.t.Fatalf("UserRepositoryMock.InTx mock is already set by Set")
+	}
+
+	if mmInTx.defaultExpectation == nil {
+		mmInTx.defaultExpectation = &UserRepositoryMockInTxExpectation{}
+	}
+
+	if mmInTx.defaultExpectation.paramPtrs != nil {
+		mmInTx.mock.t.Fatalf("UserRepositoryMock.InTx mock is already set by ExpectParams functions")
+	}
+
+	mmInTx.defaultExpectation.params = &UserRepositoryMockInTxParams{ctx, fn}
+	mmInTx.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmInTx.expectations {
+		if minimock.Equal(e.params, mmInTx.defaultExpectation.params) {
+			mmInTx.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmInTx.defaultExpectation.params)
+		}
+	}
+
+	return mmInTx
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UserRepository.InTx
+func (mmInTx *mUserRepositoryMockInTx) ExpectCtxParam1(ctx context.Context) *mUserRepositoryMockInTx {
+	if mmInTx.mock.funcInTx != nil {
+		mmInTx.mock.t.Fatalf("UserRepositoryMock.InTx mock is already set by Set")
+	}
+
+	if mmInTx.defaultExpectation == nil {
+		mmInTx.defaultExpectation = &UserRepositoryMockInTxExpectation{}
+	}
+
+	if mmInTx.defaultExpectation.params != nil {
+		mmInTx.mock.t.Fatalf("UserRepositoryMock.InTx mock is already set by Expect")
+	}
+
+	if mmInTx.defaultExpectation.paramPtrs == nil {
+		mmInTx.defaultExpectation.paramPtrs = &UserRepositoryMockInTxParamPtrs{}
+	}
+	mmInTx.defaultExpectation.paramPtrs.ctx = &ctx
+	mmInTx.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmInTx
+}
+
+// ExpectFnParam2 sets up expected param fn for UserRepository.InTx
+func (mmInTx *mUserRepositoryMockInTx) ExpectFnParam2(fn func(ctx context.Context) error) *mUserRepositoryMockInTx {
+	if mmInTx.mock.funcInTx != nil {
+		mmInTx.mock.t.Fatalf("UserRepositoryMock.InTx mock is already set by Set")
+	}
+
+	if mmInTx.defaultExpectation == nil {
+		mmInTx.defaultExpectation = &UserRepositoryMockInTxExpectation{}
+	}
+
+	if mmInTx.defaultExpectation.params != nil {
+		mmInTx.mock.t.Fatalf("UserRepositoryMock.InTx mock is already set by Expect")
+	}
+
+	if mmInTx.defaultExpectation.paramPtrs == nil {
+		mmInTx.defaultExpectation.paramPtrs = &UserRepositoryMockInTxParamPtrs{}
+	}
+	mmInTx.defaultExpectation.paramPtrs.fn = &fn
+	mmInTx.defaultExpectation.expectationOrigins.originFn = minimock.CallerInfo(1)
+
+	return mmInTx
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserRepository.InTx
+func (mmInTx *mUserRepositoryMockInTx) Inspect(f func(ctx context.Context, fn func(ctx context.Context) error)) *mUserRepositoryMockInTx {
+	if mmInTx.mock.inspectFuncInTx != nil {
+		mmInTx.mock.t.Fatalf("Inspect function is already set for UserRepositoryMock.InTx")
+	}
+
+	mmInTx.mock.inspectFuncInTx = f
+
+	return mmInTx
+}
+
+// Return sets up results that will be returned by UserRepository.InTx
+func (mmInTx *mUserRepositoryMockInTx) Return(err error) *UserRepositoryMock {
+	if mmInTx.mock.funcInTx != nil {
+		mmInTx.mock.t.Fatalf("UserRepositoryMock.InTx mock is already set by Set")
+	}
+
+	if mmInTx.defaultExpectation == nil {
+		mmInTx.defaultExpectation = &UserRepositoryMockInTxExpectation{mock: mmInTx.mock}
+	}
+	mmInTx.defaultExpectation.results = &UserRepositoryMockInTxResults{err}
+	mmInTx.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmInTx.mock
+}
+
+// Set uses given function f to mock the UserRepository.InTx method
+func (mmInTx *mUserRepositoryMockInTx) Set(f func(ctx context.Context, fn func(ctx context.Context) error) (err error)) *UserRepositoryMock {
+	if mmInTx.defaultExpectation != nil {
+		mmInTx.mock.t.Fatalf("Default expectation is already set for the UserRepository.InTx method")
+	}
+
+	if len(mmInTx.expectations) > 0 {
+		mmInTx.mock.t.Fatalf("Some expectations are already set for the UserRepository.InTx method")
+	}
+
+	mmInTx.mock.funcInTx = f
+	mmInTx.mock.funcInTxOrigin = minimock.CallerInfo(1)
+	return mmInTx.mock
+}
+
+// When sets expectation for the UserRepository.InTx which will trigger the result defined by the following
+// Then helper
+func (mmInTx *mUserRepositoryMockInTx) When(ctx context.Context, fn func(ctx context.Context) error) *UserRepositoryMockInTxExpectation {
+	if mmInTx.mock.funcInTx != nil {
+		mmInTx.mock.t.Fatalf("UserRepositoryMock.InTx mock is already set by Set")
+	}
+
+	expectation := &UserRepositoryMockInTxExpectation{
+		mock:               mmInTx.mock,
+		params:             &UserRepositoryMockInTxParams{ctx, fn},
+		expectationOrigins: UserRepositoryMockInTxExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmInTx.expectations = append(mmInTx.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserRepository.InTx return parameters for the expectation previously defined by the When method
+func (e *UserRepositoryMockInTxExpectation) Then(err error) *UserRepositoryMock {
+	e.results = &UserRepositoryMockInTxResults{err}
+	return e.mock
+}
+
+// Times sets number of times UserRepository.InTx should be invoked
+func (mmInTx *mUserRepositoryMockInTx) Times(n uint64) *mUserRepositoryMockInTx {
+	if n == 0 {
+		mmInTx.mock.t.Fatalf("Times of UserRepositoryMock.InTx mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmInTx.expectedInvocations, n)
+	mmInTx.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmInTx
+}
+
+func (mmInTx *mUserRepositoryMockInTx) invocationsDone() bool {
+	if len(mmInTx.expectations) == 0 && mmInTx.defaultExpectation == nil && mmInTx.mock.funcInTx == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmInTx.mock.afterInTxCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmInTx.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// InTx implements UserRepository
+func (mmInTx *UserRepositoryMock) InTx(ctx context.Context, fn func(ctx context.Context) error) (err error) {
+	mm_atomic.AddUint64(&mmInTx.beforeInTxCounter, 1)
+	defer mm_atomic.AddUint64(&mmInTx.afterInTxCounter, 1)
+
+	mmInTx.t.Helper()
+
+	if mmInTx.inspectFuncInTx != nil {
+		mmInTx.inspectFuncInTx(ctx, fn)
+	}
+
+	mm_params := UserRepositoryMockInTxParams{ctx, fn}
+
+	// Record call args
+	mmInTx.InTxMock.mutex.Lock()
+	mmInTx.InTxMock.callArgs = append(mmInTx.InTxMock.callArgs, &mm_params)
+	mmInTx.InTxMock.mutex.Unlock()
+
+	for _, e := range mmInTx.InTxMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmInTx.InTxMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmInTx.InTxMock.defaultExpectation.Counter, 1)
+		mm_want := mmInTx.InTxMock.defaultExpectation.params
+		mm_want_ptrs := mmInTx.InTxMock.defaultExpectation.paramPtrs
+
+		mm_got := UserRepositoryMockInTxParams{ctx, fn}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmInTx.t.Errorf("UserRepositoryMock.InTx got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmInTx.InTxMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.fn != nil && !minimock.Equal(*mm_want_ptrs.fn, mm_got.fn) {
+				mmInTx.t.Errorf("UserRepositoryMock.InTx got unexpected parameter fn, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmInTx.InTxMock.defaultExpectation.expectationOrigins.originFn, *mm_want_ptrs.fn, mm_got.fn, minimock.Diff(*mm_want_ptrs.fn, mm_got.fn))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmInTx.t.Errorf("UserRepositoryMock.InTx got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmInTx.InTxMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmInTx.InTxMock.defaultExpectation.results
+		if mm_results == nil {
+			mmInTx.t.Fatal("No results are set for the UserRepositoryMock.InTx")
+		}
+		return (*mm_results).err
+	}
+	if mmInTx.funcInTx != nil {
+		return mmInTx.funcInTx(ctx, fn)
+	}
+	mmInTx.t.Fatalf("Unexpected call to UserRepositoryMock.InTx. %v %v", ctx, fn)
+	return
+}
+
+// InTxAfterCounter returns a count of finished UserRepositoryMock.InTx invocations
+func (mmInTx *UserRepositoryMock) InTxAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmInTx.afterInTxCounter)
+}
+
+// InTxBeforeCounter returns a count of UserRepositoryMock.InTx invocations
+func (mmInTx *UserRepositoryMock) InTxBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmInTx.beforeInTxCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserRepositoryMock.InTx.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmInTx *mUserRepositoryMockInTx) Calls() []*UserRepositoryMockInTxParams {
+	mmInTx.mutex.RLock()
+
+	argCopy := make([]*UserRepositoryMockInTxParams, len(mmInTx.callArgs))
+	copy(argCopy, mmInTx.callArgs)
+
+	mmInTx.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockInTxDone returns true if the count of the InTx invocations corresponds
+// the number of defined expectations
+func (m *UserRepositoryMock) MinimockInTxDone() bool {
+	if m.InTxMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.InTxMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.InTxMock.invocationsDone()
+}
+
+// MinimockInTxInspect logs each unmet expectation
+func (m *UserRepositoryMock) MinimockInTxInspect() {
+	for _, e := range m.InTxMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserRepositoryMock.InTx at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterInTxCounter := mm_atomic.LoadUint64(&m.afterInTxCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.InTxMock.defaultExpectation != nil && afterInTxCounter < 1 {
+		if m.InTxMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UserRepositoryMock.InTx at\n%s", m.InTxMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UserRepositoryMock.InTx at\n%s with params: %#v", m.InTxMock.defaultExpectation.expectationOrigins.origin, *m.InTxMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcInTx != nil && afterInTxCounter < 1 {
+		m.t.Errorf("Expected call to UserRepositoryMock.InTx at\n%s", m.funcInTxOrigin)
+	}
+
+	if !m.InTxMock.invocationsDone() && afterInTxCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserRepositoryMock.InTx at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.InTxMock.expectedInvocations), m.InTxMock.expectedInvocationsOrigin, afterInTxCounter)
+	}
+}
+
+type mUserRepositoryMockListPersonalAccessTokens struct {
+	optional           bool
+	mock               *UserRepositoryMock
+	defaultExpectation *UserRepositoryMockListPersonalAccessTokensExpectation
+	expectations       []*UserRepositoryMockListPersonalAccessTokensExpectation
+
+	callArgs []*UserRepositoryMockListPersonalAccessTokensParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UserRepositoryMockListPersonalAccessTokensExpectation specifies expectation struct of the UserRepository.ListPersonalAccessTokens
+type UserRepositoryMockListPersonalAccessTokensExpectation struct {
+	mock               *UserRepositoryMock
+	params             *UserRepositoryMockListPersonalAccessTokensParams
+	paramPtrs          *UserRepositoryMockListPersonalAccessTokensParamPtrs
+	expectationOrigins UserRepositoryMockListPersonalAccessTokensExpectationOrigins
+	results            *UserRepositoryMockListPersonalAccessTokensResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UserRepositoryMockListPersonalAccessTokensParams contains parameters of the UserRepository.ListPersonalAccessTokens
+type UserRepositoryMockListPersonalAccessTokensParams struct {
+	ctx    context.Context
+	userID string
+}
+
+// UserRepositoryMockListPersonalAccessTokensParamPtrs contains pointers to parameters of the UserRepository.ListPersonalAccessTokens
+type UserRepositoryMockListPersonalAccessTokensParamPtrs struct {
+	ctx    *context.Context
+	userID *string
+}
+
+// UserRepositoryMockListPersonalAccessTokensResults contains results of the UserRepository.ListPersonalAccessTokens
+type UserRepositoryMockListPersonalAccessTokensResults struct {
+	pa1 []models.PersonalAccessToken
+	err error
+}
+
+// UserRepositoryMockListPersonalAccessTokensOrigins contains origins of expectations of the UserRepository.ListPersonalAccessTokens
+type UserRepositoryMockListPersonalAccessTokensExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListPersonalAccessTokens *mUserRepositoryMockListPersonalAccessTokens) Optional() *mUserRepositoryMockListPersonalAccessTokens {
+	mmListPersonalAccessTokens.optional = true
+	return mmListPersonalAccessTokens
+}
+
+// Expect sets up expected params for UserRepository.ListPersonalAccessTokens
+func (mmListPersonalAccessTokens *mUserRepositoryMockListPersonalAccessTokens) Expect(ctx context.Context, userID string) *mUserRepositoryMockListPersonalAccessTokens {
+	if mmListPersonalAccessTokens.mock.funcListPersonalAccessTokens != nil {
+		mmListPersonalAccessTokens.mock.t.Fatalf("UserRepositoryMock.ListPersonalAccessTokens mock is already set by Set")
+	}
+
+	if mmListPersonalAccessTokens.defaultExpectation == nil {
+		mmListPersonalAccessTokens.defaultExpectation = &UserRepositoryMockListPersonalAccessTokensExpectation{}
+	}
+
+	if mmListPersonalAccessTokens.defaultExpectation.paramPtrs != nil {
+		mmListPersonalAccessTokens.mock.t.Fatalf("UserRepositoryMock.ListPersonalAccessTokens mock is already set by ExpectParams functions")
+	}
+
+	mmListPersonalAccessTokens.defaultExpectation.params = &UserRepositoryMockListPersonalAccessTokensParams{ctx, userID}
+	mmListPersonalAccessTokens.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListPersonalAccessTokens.expectations {
+		if minimock.Equal(e.params, mmListPersonalAccessTokens.defaultExpectation.params) {
+			mmListPersonalAccessTokens.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListPersonalAccessTokens.defaultExpectation.params)
+		}
+	}
+
+	return mmListPersonalAccessTokens
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UserRepository.ListPersonalAccessTokens
+func (mmListPersonalAccessTokens *mUserRepositoryMockListPersonalAccessTokens) ExpectCtxParam1(ctx context.Context) *mUserRepositoryMockListPersonalAccessTokens {
+	if mmListPersonalAccessTokens.mock.funcListPersonalAccessTokens != nil {
+		mmListPersonalAccessTokens.mock.t.Fatalf("UserRepositoryMock.ListPersonalAccessTokens mock is already set by Set")
+	}
+
+	if mmListPersonalAccessTokens.defaultExpectation == nil {
+		mmListPersonalAccessTokens.defaultExpectation = &UserRepositoryMockListPersonalAccessTokensExpectation{}
+	}
+
+	if mmListPersonalAccessTokens.defaultExpectation.params != nil {
+		mmListPersonalAccessTokens.mock.t.Fatalf("UserRepositoryMock.ListPersonalAccessTokens mock is already set by Expect")
+	}
+
+	if mmListPersonalAccessTokens.defaultExpectation.paramPtrs == nil {
+		mmListPersonalAccessTokens.defaultExpectation.paramPtrs = &UserRepositoryMockListPersonalAccessTokensParamPtrs{}
+	}
+	mmListPersonalAccessTokens.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListPersonalAccessTokens.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListPersonalAccessTokens
+}
+
+// ExpectUserIDParam2 sets up expected param userID for UserRepository.ListPersonalAccessTokens
+func (mmListPersonalAccessTokens *mUserRepositoryMockListPersonalAccessTokens) ExpectUserIDParam2(userID string) *mUserRepositoryMockListPersonalAccessTokens {
+	if mmListPersonalAccessTokens.mock.funcListPersonalAccessTokens != nil {
+		mmListPersonalAccessTokens.mock.t.Fatalf("UserRepositoryMock.ListPersonalAccessTokens mock is already set by Set")
+	}
+
+	if mmListPersonalAccessTokens.defaultExpectation == nil {
+		mmListPersonalAccessTokens.defaultExpectation = &UserRepositoryMockListPersonalAccessTokensExpectation{}
+	}
+
+	if mmListPersonalAccessTokens.defaultExpectation.params != nil {
+		mmListPersonalAccessTokens.mock.t.Fatalf("UserRepositoryMock.ListPersonalAccessTokens mock is already set by Expect")
+	}
+
+	if mmListPersonalAccessTokens.defaultExpectation.paramPtrs == nil {
+		mmListPersonalAccessTokens.defaultExpectation.paramPtrs = &UserRepositoryMockListPersonalAccessTokensParamPtrs{}
+	}
+	mmListPersonalAccessTokens.defaultExpectation.paramPtrs.userID = &userID
+	mmListPersonalAccessTokens.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmListPersonalAccessTokens
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserRepository.ListPersonalAccessTokens
+func (mmListPersonalAccessTokens *mUserRepositoryMockListPersonalAccessTokens) Inspect(f func(ctx context.Context, userID string)) *mUserRepositoryMockListPersonalAccessTokens {
+	if mmListPersonalAccessTokens.mock.inspectFuncListPersonalAccessTokens != nil {
+		mmListPersonalAccessTokens.mock.t.Fatalf("Inspect function is already set for UserRepositoryMock.ListPersonalAccessTokens")
+	}
+
+	mmListPersonalAccessTokens.mock.inspectFuncListPersonalAccessTokens = f
+
+	return mmListPersonalAccessTokens
+}
+
+// Return sets up results that will be returned by UserRepository.ListPersonalAccessTokens
+func (mmListPersonalAccessTokens *mUserRepositoryMockListPersonalAccessTokens) Return(pa1 []models.PersonalAccessToken, err error) *UserRepositoryMock {
+	if mmListPersonalAccessTokens.mock.funcListPersonalAccessTokens != nil {
+		mmListPersonalAccessTokens.mock.t.Fatalf("UserRepositoryMock.ListPersonalAccessTokens mock is already set by Set")
+	}
+
+	if mmListPersonalAccessTokens.defaultExpectation == nil {
+		mmListPersonalAccessTokens.defaultExpectation = &UserRepositoryMockListPersonalAccessTokensExpectation{mock: mmListPersonalAccessTokens.mock}
+	}
+	mmListPersonalAccessTokens.defaultExpectation.results = &UserRepositoryMockListPersonalAccessTokensResults{pa1, err}
+	mmListPersonalAccessTokens.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListPersonalAccessTokens.mock
+}
+
+// Set uses given function f to mock the UserRepository.ListPersonalAccessTokens method
+func (mmListPersonalAccessTokens *mUserRepositoryMockListPersonalAccessTokens) Set(f func(ctx context.Context, userID string) (pa1 []models.PersonalAccessToken, err error)) *UserRepositoryMock {
+	if mmListPersonalAccessTokens.defaultExpectation != nil {
+		mmListPersonalAccessTokens.mock.t.Fatalf("Default expectation is already set for the UserRepository.ListPersonalAccessTokens method")
+	}
+
+	if len(mmListPersonalAccessTokens.expectations) > 0 {
+		mmListPersonalAccessTokens.mock.t.Fatalf("Some expectations are already set for the UserRepository.ListPersonalAccessTokens method")
+	}
+
+	mmListPersonalAccessTokens.mock.funcListPersonalAccessTokens = f
+	mmListPersonalAccessTokens.mock.funcListPersonalAccessTokensOrigin = minimock.CallerInfo(1)
+	return mmListPersonalAccessTokens.mock
+}
+
+// When sets expectation for the UserRepository.ListPersonalAccessTokens which will trigger the result defined by the following
+// Then helper
+func (mmListPersonalAccessTokens *mUserRepositoryMockListPersonalAccessTokens) When(ctx context.Context, userID string) *UserRepositoryMockListPersonalAccessTokensExpectation {
+	if mmListPersonalAccessTokens.mock.funcListPersonalAccessTokens != nil {
+		mmListPersonalAccessTokens.mock.t.Fatalf("UserRepositoryMock.ListPersonalAccessTokens mock is already set by Set")
+	}
+
+	expectation := &UserRepositoryMockListPersonalAccessTokensExpectation{
+		mock:               mmListPersonalAccessTokens.mock,
+		params:             &UserRepositoryMockListPersonalAccessTokensParams{ctx, userID},
+		expectationOrigins: UserRepositoryMockListPersonalAccessTokensExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListPersonalAccessTokens.expectations = append(mmListPersonalAccessTokens.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserRepository.ListPersonalAccessTokens return parameters for the expectation previously defined by the When method
+func (e *UserRepositoryMockListPersonalAccessTokensExpectation) Then(pa1 []models.PersonalAccessToken, err error) *UserRepositoryMock {
+	e.results = &UserRepositoryMockListPersonalAccessTokensResults{pa1, err}
+	return e.mock
+}
+
+// Times sets number of times UserRepository.ListPersonalAccessTokens should be invoked
+func (mmListPersonalAccessTokens *mUserRepositoryMockListPersonalAccessTokens) Times(n uint64) *mUserRepositoryMockListPersonalAccessTokens {
+	if n == 0 {
+		mmListPersonalAccessTokens.mock.t.Fatalf("Times of UserRepositoryMock.ListPersonalAccessTokens mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListPersonalAccessTokens.expectedInvocations, n)
+	mmListPersonalAccessTokens.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListPersonalAccessTokens
+}
+
+func (mmListPersonalAccessTokens *mUserRepositoryMockListPersonalAccessTokens) invocationsDone() bool {
+	if len(mmListPersonalAccessTokens.expectations) == 0 && mmListPersonalAccessTokens.defaultExpectation == nil && mmListPersonalAccessTokens.mock.funcListPersonalAccessTokens == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListPersonalAccessTokens.mock.afterListPersonalAccessTokensCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListPersonalAccessTokens.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListPersonalAccessTokens implements UserRepository
+func (mmListPersonalAccessTokens *UserRepositoryMock) ListPersonalAccessTokens(ctx context.Context, userID string) (pa1 []models.PersonalAccessToken, err error) {
+	mm_atomic.AddUint64(&mmListPersonalAccessTokens.beforeListPersonalAccessTokensCounter, 1)
+	defer mm_atomic.AddUint64(&mmListPersonalAccessTokens.afterListPersonalAccessTokensCounter, 1)
+
+	mmListPersonalAccessTokens.t.Helper()
+
+	if mmListPersonalAccessTokens.inspectFuncListPersonalAccessTokens != nil {
+		mmListPersonalAccessTokens.inspectFuncListPersonalAccessTokens(ctx, userID)
+	}
+
+	mm_params := UserRepositoryMockListPersonalAccessTokensParams{ctx, userID}
+
+	// Record call args
+	mmListPersonalAccessTokens.ListPersonalAccessTokensMock.mutex.Lock()
+	mmListPersonalAccessTokens.ListPersonalAccessTokensMock.callArgs = append(mmListPersonalAccessTokens.ListPersonalAccessTokensMock.callArgs, &mm_params)
+	mmListPersonalAccessTokens.ListPersonalAccessTokensMock.mutex.Unlock()
+
+	for _, e := range mmListPersonalAccessTokens.ListPersonalAccessTokensMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.pa1, e.results.err
+		}
+	}
+
+	if mmListPersonalAccessTokens.ListPersonalAccessTokensMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListPersonalAccessTokens.ListPersonalAccessTokensMock.defaultExpectation.Counter, 1)
+		mm_want := mmListPersonalAccessTokens.ListPersonalAccessTokensMock.defaultExpectation.params
+		mm_want_ptrs := mmListPersonalAccessTokens.ListPersonalAccessTokensMock.defaultExpectation.paramPtrs
+
+		mm_got := UserRepositoryMockListPersonalAccessTokensParams{ctx, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListPersonalAccessTokens.t.Errorf("UserRepositoryMock.ListPersonalAccessTokens got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListPersonalAccessTokens.ListPersonalAccessTokensMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmListPersonalAccessTokens.t.Errorf("UserRepositoryMock.ListPersonalAccessTokens got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListPersonalAccessTokens.ListPersonalAccessTokensMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListPersonalAccessTokens.t.Errorf("UserRepositoryMock.ListPersonalAccessTokens got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListPersonalAccessTokens.ListPersonalAccessTokensMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListPersonalAccessTokens.ListPersonalAccessTokensMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListPersonalAccessTokens.t.Fatal("No results are set for the UserRepositoryMock.ListPersonalAccessTokens")
+		}
+		return (*mm_results).pa1, (*mm_results).err
+	}
+	if mmListPersonalAccessTokens.funcListPersonalAccessTokens != nil {
+		return mmListPersonalAccessTokens.funcListPersonalAccessTokens(ctx, userID)
+	}
+	mmListPersonalAccessTokens.t.Fatalf("Unexpected call to UserRepositoryMock.ListPersonalAccessTokens. %v %v", ctx, userID)
+	return
+}
+
+// ListPersonalAccessTokensAfterCounter returns a count of finished UserRepositoryMock.ListPersonalAccessTokens invocations
+func (mmListPersonalAccessTokens *UserRepositoryMock) ListPersonalAccessTokensAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListPersonalAccessTokens.afterListPersonalAccessTokensCounter)
+}
+
+// ListPersonalAccessTokensBeforeCounter returns a count of UserRepositoryMock.ListPersonalAccessTokens invocations
+func (mmListPersonalAccessTokens *UserRepositoryMock) ListPersonalAccessTokensBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListPersonalAccessTokens.beforeListPersonalAccessTokensCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserRepositoryMock.ListPersonalAccessTokens.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListPersonalAccessTokens *mUserRepositoryMockListPersonalAccessTokens) Calls() []*UserRepositoryMockListPersonalAccessTokensParams {
+	mmListPersonalAccessTokens.mutex.RLock()
+
+	argCopy := make([]*UserRepositoryMockListPersonalAccessTokensParams, len(mmListPersonalAccessTokens.callArgs))
+	copy(argCopy, mmListPersonalAccessTokens.callArgs)
+
+	mmListPersonalAccessTokens.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListPersonalAccessTokensDone returns true if the count of the ListPersonalAccessTokens invocations corresponds
+// the number of defined expectations
+func (m *UserRepositoryMock) MinimockListPersonalAccessTokensDone() bool {
+	if m.ListPersonalAccessTokensMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListPersonalAccessTokensMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListPersonalAccessTokensMock.invocationsDone()
+}
+
+// MinimockListPersonalAccessTokensInspect logs each unmet expectation
+func (m *UserRepositoryMock) MinimockListPersonalAccessTokensInspect() {
+	for _, e := range m.ListPersonalAccessTokensMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserRepositoryMock.ListPersonalAccessTokens at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListPersonalAccessTokensCounter := mm_atomic.LoadUint64(&m.afterListPersonalAccessTokensCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListPersonalAccessTokensMock.defaultExpectation != nil && afterListPersonalAccessTokensCounter < 1 {
+		if m.ListPersonalAccessTokensMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UserRepositoryMock.ListPersonalAccessTokens at\n%s", m.ListPersonalAccessTokensMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UserRepositoryMock.ListPersonalAccessTokens at\n%s with params: %#v", m.ListPersonalAccessTokensMock.defaultExpectation.expectationOrigins.origin, *m.ListPersonalAccessTokensMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListPersonalAccessTokens != nil && afterListPersonalAccessTokensCounter < 1 {
+		m.t.Errorf("Expected call to UserRepositoryMock.ListPersonalAccessTokens at\n%s", m.funcListPersonalAccessTokensOrigin)
+	}
+
+	if !m.ListPersonalAccessTokensMock.invocationsDone() && afterListPersonalAccessTokensCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserRepositoryMock.ListPersonalAccessTokens at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListPersonalAccessTokensMock.expectedInvocations), m.ListPersonalAccessTokensMock.expectedInvocationsOrigin, afterListPersonalAccessTokensCounter)
+	}
+}
+
+type mUserRepositoryMockListSessions struct {
+	optional           bool
+	mock               *UserRepositoryMock
+	defaultExpectation *UserRepositoryMockListSessionsExpectation
+	expectations       []*UserRepositoryMockListSessionsExpectation
+
+	callArgs []*UserRepositoryMockListSessionsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UserRepositoryMockListSessionsExpectation specifies expectation struct of the UserRepository.ListSessions
+type UserRepositoryMockListSessionsExpectation struct {
+	mock               *UserRepositoryMock
+	params             *UserRepositoryMockListSessionsParams
+	paramPtrs          *UserRepositoryMockListSessionsParamPtrs
+	expectationOrigins UserRepositoryMockListSessionsExpectationOrigins
+	results            *UserRepositoryMockListSessionsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UserRepositoryMockListSessionsParams contains parameters of the UserRepository.ListSessions
+type UserRepositoryMockListSessionsParams struct {
+	ctx    context.Context
+	userID string
+	now    time.Time
+}
+
+// UserRepositoryMockListSessionsParamPtrs contains pointers to parameters of the UserRepository.ListSessions
+type UserRepositoryMockListSessionsParamPtrs struct {
+	ctx    *context.Context
+	userID *string
+	now    *time.Time
+}
+
+// UserRepositoryMockListSessionsResults contains results of the UserRepository.ListSessions
+type UserRepositoryMockListSessionsResults struct {
+	sa1 []models.Session
+	err error
+}
+
+// UserRepositoryMockListSessionsOrigins contains origins of expectations of the UserRepository.ListSessions
+type UserRepositoryMockListSessionsExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+	originNow    string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListSessions *mUserRepositoryMockListSessions) Optional() *mUserRepositoryMockListSessions {
+	mmListSessions.optional = true
+	return mmListSessions
+}
+
+// Expect sets up expected params for UserRepository.ListSessions
+func (mmListSessions *mUserRepositoryMockListSessions) Expect(ctx context.Context, userID string, now time.Time) *mUserRepositoryMockListSessions {
+	if mmListSessions.mock.funcListSessions != nil {
+		mmListSessions.mock.t.Fatalf("UserRepositoryMock.ListSessions mock is already set by Set")
+	}
+
+	if mmListSessions.defaultExpectation == nil {
+		mmListSessions.defaultExpectation = &UserRepositoryMockListSessionsExpectation{}
+	}
+
+	if mmListSessions.defaultExpectation.paramPtrs != nil {
+		mmListSessions.mock.t.Fatalf("UserRepositoryMock.ListSessions mock is already set by ExpectParams functions")
+	}
+
+	mmListSessions.defaultExpectation.params = &UserRepositoryMockListSessionsParams{ctx, userID, now}
+	mmListSessions.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListSessions.expectations {
+		if minimock.Equal(e.params, mmListSessions.defaultExpectation.params) {
+			mmListSessions.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListSessions.defaultExpectation.params)
+		}
+	}
+
+	return mmListSessions
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UserRepository.ListSessions
+func (mmListSessions *mUserRepositoryMockListSessions) ExpectCtxParam1(ctx context.Context) *mUserRepositoryMockListSessions {
+	if mmListSessions.mock.funcListSessions != nil {
+		mmListSessions.mock.t.Fatalf("UserRepositoryMock.ListSessions mock is already set by Set")
+	}
+
+	if mmListSessions.defaultExpectation == nil {
+		mmListSessions.defaultExpectation = &UserRepositoryMockListSessionsExpectation{}
+	}
+
+	if mmListSessions.defaultExpectation.params != nil {
+		mmListSessions.mock.t.Fatalf("UserRepositoryMock.ListSessions mock is already set by Expect")
+	}
+
+	if mmListSessions.defaultExpectation.paramPtrs == nil {
+		mmListSessions.defaultExpectation.paramPtrs = &UserRepositoryMockListSessionsParamPtrs{}
+	}
+	mmListSessions.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListSessions.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListSessions
+}
+
+// ExpectUserIDParam2 sets up expected param userID for UserRepository.ListSessions
+func (mmListSessions *mUserRepositoryMockListSessions) ExpectUserIDParam2(userID string) *mUserRepositoryMockListSessions {
+	if mmListSessions.mock.funcListSessions != nil {
+		mmListSessions.mock.t.Fatalf("UserRepositoryMock.ListSessions mock is already set by Set")
+	}
+
+	if mmListSessions.defaultExpectation == nil {
+		mmListSessions.defaultExpectation = &UserRepositoryMockListSessionsExpectation{}
+	}
+
+	if mmListSessions.defaultExpectation.params != nil {
+		mmListSessions.mock.t.Fatalf("UserRepositoryMock.ListSessions mock is already set by Expect")
+	}
+
+	if mmListSessions.defaultExpectation.paramPtrs == nil {
+		mmListSessions.defaultExpectation.paramPtrs = &UserRepositoryMockListSessionsParamPtrs{}
+	}
+	mmListSessions.defaultExpectation.paramPtrs.userID = &userID
+	mmListSessions.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmListSessions
+}
+
+// ExpectNowParam3 sets up expected param now for UserRepository.ListSessions
+func (mmListSessions *mUserRepositoryMockListSessions) ExpectNowParam3(now time.Time) *mUserRepositoryMockListSessions {
+	if mmListSessions.mock.funcListSessions != nil {
+		mmListSessions.mock.t.Fatalf("UserRepositoryMock.ListSessions mock is already set by Set")
+	}
+
+	if mmListSessions.defaultExpectation == nil {
+		mmListSessions.defaultExpectation = &UserRepositoryMockListSessionsExpectation{}
+	}
+
+	if mmListSessions.defaultExpectation.params != nil {
+		mmListSessions.mock.t.Fatalf("UserRepositoryMock.ListSessions mock is already set by Expect")
+	}
+
+	if mmListSessions.defaultExpectation.paramPtrs == nil {
+		mmListSessions.defaultExpectation.paramPtrs = &UserRepositoryMockListSessionsParamPtrs{}
+	}
+	mmListSessions.defaultExpectation.paramPtrs.now = &now
+	mmListSessions.defaultExpectation.expectationOrigins.originNow = minimock.CallerInfo(1)
+
+	return mmListSessions
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserRepository.ListSessions
+func (mmListSessions *mUserRepositoryMockListSessions) Inspect(f func(ctx context.Context, userID string, now time.Time)) *mUserRepositoryMockListSessions {
+	if mmListSessions.mock.inspectFuncListSessions != nil {
+		mmListSessions.mock.t.Fatalf("Inspect function is already set for UserRepositoryMock.ListSessions")
+	}
+
+	mmListSessions.mock.inspectFuncListSessions = f
+
+	return mmListSessions
+}
+
+// Return sets up results that will be returned by UserRepository.ListSessions
+func (mmListSessions *mUserRepositoryMockListSessions) Return(sa1 []models.Session, err error) *UserRepositoryMock {
+	if mmListSessions.mock.funcListSessions != nil {
+		mmListSessions.mock.t.Fatalf("UserRepositoryMock.ListSessions mock is already set by Set")
+	}
+
+	if mmListSessions.defaultExpectation == nil {
+		mmListSessions.defaultExpectation = &UserRepositoryMockListSessionsExpectation{mock: mmListSessions.mock}
+	}
+	mmListSessions.defaultExpectation.results = &UserRepositoryMockListSessionsResults{sa1, err}
+	mmListSessions.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListSessions.mock
+}
+
+// Set uses given function f to mock the UserRepository.ListSessions method
+func (mmListSessions *mUserRepositoryMockListSessions) Set(f func(ctx context.Context, userID string, now time.Time) (sa1 []models.Session, err error)) *UserRepositoryMock {
+	if mmListSessions.defaultExpectation != nil {
+		mmListSessions.mock.t.Fatalf("Default expectation is already set for the UserRepository.ListSessions method")
+	}
+
+	if len(mmListSessions.expectations) > 0 {
+		mmListSessions.mock.t.Fatalf("Some expectations are already set for the UserRepository.ListSessions method")
+	}
+
+	mmListSessions.mock.funcListSessions = f
+	mmListSessions.mock.funcListSessionsOrigin = minimock.CallerInfo(1)
+	return mmListSessions.mock
+}
+
+// When sets expectation for the UserRepository.ListSessions which will trigger the result defined by the following
+// Then helper
+func (mmListSessions *mUserRepositoryMockListSessions) When(ctx context.Context, userID string, now time.Time) *UserRepositoryMockListSessionsExpectation {
+	if mmListSessions.mock.funcListSessions != nil {
+		mmListSessions.mock.t.Fatalf("UserRepositoryMock.ListSessions mock is already set by Set")
+	}
+
+	expectation := &UserRepositoryMockListSessionsExpectation{
+		mock:               mmListSessions.mock,
+		params:             &UserRepositoryMockListSessionsParams{ctx, userID, now},
+		expectationOrigins: UserRepositoryMockListSessionsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListSessions.expectations = append(mmListSessions.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserRepository.ListSessions return parameters for the expectation previously defined by the When method
+func (e *UserRepositoryMockListSessionsExpectation) Then(sa1 []models.Session, err error) *UserRepositoryMock {
+	e.results = &UserRepositoryMockListSessionsResults{sa1, err}
+	return e.mock
+}
+
+// Times sets number of times UserRepository.ListSessions should be invoked
+func (mmListSessions *mUserRepositoryMockListSessions) Times(n uint64) *mUserRepositoryMockListSessions {
+	if n == 0 {
+		mmListSessions.mock.t.Fatalf("Times of UserRepositoryMock.ListSessions mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListSessions.expectedInvocations, n)
+	mmListSessions.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListSessions
+}
+
+func (mmListSessions *mUserRepositoryMockListSessions) invocationsDone() bool {
+	if len(mmListSessions.expectations) == 0 && mmListSessions.defaultExpectation == nil && mmListSessions.mock.funcListSessions == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListSessions.mock.afterListSessionsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListSessions.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListSessions implements UserRepository
+func (mmListSessions *UserRepositoryMock) ListSessions(ctx context.Context, userID string, now time.Time) (sa1 []models.Session, err error) {
+	mm_atomic.AddUint64(&mmListSessions.beforeListSessionsCounter, 1)
+	defer mm_atomic.AddUint64(&mmListSessions.afterListSessionsCounter, 1)
+
+	mmListSessions.t.Helper()
+
+	if mmListSessions.inspectFuncListSessions != nil {
+		mmListSessions.inspectFuncListSessions(ctx, userID, now)
+	}
+
+	mm_params := UserRepositoryMockListSessionsParams{ctx, userID, now}
+
+	// Record call args
+	mmListSessions.ListSessionsMock.mutex.Lock()
+	mmListSessions.ListSessionsMock.callArgs = append(mmListSessions.ListSessionsMock.callArgs, &mm_params)
+	mmListSessions.ListSessionsMock.mutex.Unlock()
+
+	for _, e := range mmListSessions.ListSessionsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.sa1, e.results.err
+		}
+	}
+
+	if mmListSessions.ListSessionsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListSessions.ListSessionsMock.defaultExpectation.Counter, 1)
+		mm_want := mmListSessions.ListSessionsMock.defaultExpectation.params
+		mm_want_ptrs := mmListSessions.ListSessionsMock.defaultExpectation.paramPtrs
+
+		mm_got := UserRepositoryMockListSessionsParams{ctx, userID, now}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListSessions.t.Errorf("UserRepositoryMock.ListSessions got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListSessions.ListSessionsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmListSessions.t.Errorf("UserRepositoryMock.ListSessions got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListSessions.ListSessionsMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.now != nil && !minimock.Equal(*mm_want_ptrs.now, mm_got.now) {
+				mmListSessions.t.Errorf("UserRepositoryMock.ListSessions got unexpected parameter now, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListSessions.ListSessionsMock.defaultExpectation.expectationOrigins.originNow, *mm_want_ptrs.now, mm_got.now, minimock.Diff(*mm_want_ptrs.now, mm_got.now))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListSessions.t.Errorf("UserRepositoryMock.ListSessions got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListSessions.ListSessionsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListSessions.ListSessionsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListSessions.t.Fatal("No results are set for the UserRepositoryMock.ListSessions")
+		}
+		return (*mm_results).sa1, (*mm_results).err
+	}
+	if mmListSessions.funcListSessions != nil {
+		return mmListSessions.funcListSessions(ctx, userID, now)
+	}
+	mmListSessions.t.Fatalf("Unexpected call to UserRepositoryMock.ListSessions. %v %v %v", ctx, userID, now)
+	return
+}
+
+// ListSessionsAfterCounter returns a count of finished UserRepositoryMock.ListSessions invocations
+func (mmListSessions *UserRepositoryMock) ListSessionsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListSessions.afterListSessionsCounter)
+}
+
+// ListSessionsBeforeCounter returns a count of UserRepositoryMock.ListSessions invocations
+func (mmListSessions *UserRepositoryMock) ListSessionsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListSessions.beforeListSessionsCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserRepositoryMock.ListSessions.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListSessions *mUserRepositoryMockListSessions) Calls() []*UserRepositoryMockListSessionsParams {
+	mmListSessions.mutex.RLock()
+
+	argCopy := make([]*UserRepositoryMockListSessionsParams, len(mmListSessions.callArgs))
+	copy(argCopy, mmListSessions.callArgs)
+
+	mmListSessions.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListSessionsDone returns true if the count of the ListSessions invocations corresponds
+// the number of defined expectations
+func (m *UserRepositoryMock) MinimockListSessionsDone() bool {
+	if m.ListSessionsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListSessionsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListSessionsMock.invocationsDone()
+}
+
+// MinimockListSessionsInspect logs each unmet expectation
+func (m *UserRepositoryMock) MinimockListSessionsInspect() {
+	for _, e := range m.ListSessionsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserRepositoryMock.ListSessions at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListSessionsCounter := mm_atomic.LoadUint64(&m.afterListSessionsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListSessionsMock.defaultExpectation != nil && afterListSessionsCounter < 1 {
+		if m.ListSessionsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UserRepositoryMock.ListSessions at\n%s", m.ListSessionsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UserRepositoryMock.ListSessions at\n%s with params: %#v", m.ListSessionsMock.defaultExpectation.expectationOrigins.origin, *m.ListSessionsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListSessions != nil && afterListSessionsCounter < 1 {
+		m.t.Errorf("Expected call to UserRepositoryMock.ListSessions at\n%s", m.funcListSessionsOrigin)
+	}
+
+	if !m.ListSessionsMock.invocationsDone() && afterListSessionsCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserRepositoryMock.ListSessions at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListSessionsMock.expectedInvocations), m.ListSessionsMock.expectedInvocationsOrigin, afterListSessionsCounter)
+	}
+}
+
+type mUserRepositoryMockSetAllowedIPs struct {
+	optional           bool
+	mock               *UserRepositoryMock
+	defaultExpectation *UserRepositoryMockSetAllowedIPsExpectation
+	expectations       []*UserRepositoryMockSetAllowedIPsExpectation
+
+	callArgs []*UserRepositoryMockSetAllowedIPsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UserRepositoryMockSetAllowedIPsExpectation specifies expectation struct of the UserRepository.SetAllowedIPs
+type UserRepositoryMockSetAllowedIPsExpectation struct {
+	mock               *UserRepositoryMock
+	params             *UserRepositoryMockSetAllowedIPsParams
+	paramPtrs          *UserRepositoryMockSetAllowedIPsParamPtrs
+	expectationOrigins UserRepositoryMockSetAllowedIPsExpectationOrigins
+	results            *UserRepositoryMockSetAllowedIPsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UserRepositoryMockSetAllowedIPsParams contains parameters of the UserRepository.SetAllowedIPs
+type UserRepositoryMockSetAllowedIPsParams struct {
+	ctx        context.Context
+	userID     string
+	allowedIPs []string
+	updatedAt  time.Time
+}
+
+// UserRepositoryMockSetAllowedIPsParamPtrs contains pointers to parameters of the UserRepository.SetAllowedIPs
+type UserRepositoryMockSetAllowedIPsParamPtrs struct {
+	ctx        *context.Context
+	userID     *string
+	allowedIPs *[]string
+	updatedAt  *time.Time
+}
+
+// UserRepositoryMockSetAllowedIPsResults contains results of the UserRepository.SetAllowedIPs
+type UserRepositoryMockSetAllowedIPsResults struct {
+	err error
+}
+
+// UserRepositoryMockSetAllowedIPsOrigins contains origins of expectations of the UserRepository.SetAllowedIPs
+type UserRepositoryMockSetAllowedIPsExpectationOrigins struct {
+	origin           string
+	originCtx        string
+	originUserID     string
+	originAllowedIPs string
+	originUpdatedAt  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSetAllowedIPs *mUserRepositoryMockSetAllowedIPs) Optional() *mUserRepositoryMockSetAllowedIPs {
+	mmSetAllowedIPs.optional = true
+	return mmSetAllowedIPs
+}
+
+// Expect sets up expected params for UserRepository.SetAllowedIPs
+func (mmSetAllowedIPs *mUserRepositoryMockSetAllowedIPs) Expect(ctx context.Context, userID string, allowedIPs []string, updatedAt time.Time) *mUserRepositoryMockSetAllowedIPs {
+	if mmSetAllowedIPs.mock.funcSetAllowedIPs != nil {
+		mmSetAllowedIPs.mock.t.Fatalf("UserRepositoryMock.SetAllowedIPs mock is already set by Set")
+	}
+
+	if mmSetAllowedIPs.defaultExpectation == nil {
+		mmSetAllowedIPs.defaultExpectation = &UserRepositoryMockSetAllowedIPsExpectation{}
+	}
+
+	if mmSetAllowedIPs.defaultExpectation.paramPtrs != nil {
+		mmSetAllowedIPs.mock.t.Fatalf("UserRepositoryMock.SetAllowedIPs mock is already set by ExpectParams functions")
+	}
+
+	mmSetAllowedIPs.defaultExpectation.params = &UserRepositoryMockSetAllowedIPsParams{ctx, userID, allowedIPs, updatedAt}
+	mmSetAllowedIPs.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSetAllowedIPs.expectations {
+		if minimock.Equal(e.params, mmSetAllowedIPs.defaultExpectation.params) {
+			mmSetAllowedIPs.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSetAllowedIPs.defaultExpectation.params)
+		}
+	}
+
+	return mmSetAllowedIPs
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UserRepository.SetAllowedIPs
+func (mmSetAllowedIPs *mUserRepositoryMockSetAllowedIPs) ExpectCtxParam1(ctx context.Context) *mUserRepositoryMockSetAllowedIPs {
+	if mmSetAllowedIPs.mock.funcSetAllowedIPs != nil {
+		mmSetAllowedIPs.mock.t.Fatalf("UserRepositoryMock.SetAllowedIPs mock is already set by Set")
+	}
+
+	if mmSetAllowedIPs.defaultExpectation == nil {
+		mmSetAllowedIPs.defaultExpectation = &UserRepositoryMockSetAllowedIPsExpectation{}
+	}
+
+	if mmSetAllowedIPs.defaultExpectation.params != nil {
+		mmSetAllowedIPs.mock.t.Fatalf("UserRepositoryMock.SetAllowedIPs mock is already set by Expect")
+	}
+
+	if mmSetAllowedIPs.defaultExpectation.paramPtrs == nil {
+		mmSetAllowedIPs.defaultExpectation.paramPtrs = &UserRepositoryMockSetAllowedIPsParamPtrs{}
+	}
+	mmSetAllowedIPs.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSetAllowedIPs.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSetAllowedIPs
+}
+
+// ExpectUserIDParam2 sets up expected param userID for UserRepository.SetAllowedIPs
+func (mmSetAllowedIPs *mUserRepositoryMockSetAllowedIPs) ExpectUserIDParam2(userID string) *mUserRepositoryMockSetAllowedIPs {
+	if mmSetAllowedIPs.mock.funcSetAllowedIPs != nil {
+		mmSetAllowedIPs.mock.t.Fatalf("UserRepositoryMock.SetAllowedIPs mock is already set by Set")
+	}
+
+	if mmSetAllowedIPs.defaultExpectation == nil {
+		mmSetAllowedIPs.defaultExpectation = &UserRepositoryMockSetAllowedIPsExpectation{}
+	}
+
+	if mmSetAllowedIPs.defaultExpectation.params != nil {
+		mmSetAllowedIPs.mock.t.Fatalf("UserRepositoryMock.SetAllowedIPs mock is already set by Expect")
+	}
+
+	if mmSetAllowedIPs.defaultExpectation.paramPtrs == nil {
+		mmSetAllowedIPs.defaultExpectation.paramPtrs = &UserRepositoryMockSetAllowedIPsParamPtrs{}
+	}
+	mmSetAllowedIPs.defaultExpectation.paramPtrs.userID = &userID
+	mmSetAllowedIPs.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmSetAllowedIPs
+}
+
+// ExpectAllowedIPsParam3 sets up expected param allowedIPs for UserRepository.SetAllowedIPs
+func (mmSetAllowedIPs *mUserRepositoryMockSetAllowedIPs) ExpectAllowedIPsParam3(allowedIPs []string) *mUserRepositoryMockSetAllowedIPs {
+	if mmSetAllowedIPs.mock.funcSetAllowedIPs != nil {
+		mmSetAllowedIPs.mock.t.Fatalf("UserRepositoryMock.SetAllowedIPs mock is already set by Set")
+	}
+
+	if mmSetAllowedIPs.defaultExpectation == nil {
+		mmSetAllowedIPs.defaultExpectation = &UserRepositoryMockSetAllowedIPsExpectation{}
+	}
+
+	if mmSetAllowedIPs.defaultExpectation.params != nil {
+		mmSetAllowedIPs.mock.t.Fatalf("UserRepositoryMock.SetAllowedIPs mock is already set by Expect")
+	}
+
+	if mmSetAllowedIPs.defaultExpectation.paramPtrs == nil {
+		mmSetAllowedIPs.defaultExpectation.paramPtrs = &UserRepositoryMockSetAllowedIPsParamPtrs{}
+	}
+	mmSetAllowedIPs.defaultExpectation.paramPtrs.allowedIPs = &allowedIPs
+	mmSetAllowedIPs.defaultExpectation.expectationOrigins.originAllowedIPs = minimock.CallerInfo(1)
+
+	return mmSetAllowedIPs
+}
+
+// ExpectUpdatedAtParam4 sets up expected param updatedAt for UserRepository.SetAllowedIPs
+func (mmSetAllowedIPs *mUserRepositoryMockSetAllowedIPs) ExpectUpdatedAtParam4(updatedAt time.Time) *mUserRepositoryMockSetAllowedIPs {
+	if mmSetAllowedIPs.mock.funcSetAllowedIPs != nil {
+		mmSetAllowedIPs.mock.t.Fatalf("UserRepositoryMock.SetAllowedIPs mock is already set by Set")
+	}
+
+	if mmSetAllowedIPs.defaultExpectation == nil {
+		mmSetAllowedIPs.defaultExpectation = &UserRepositoryMockSetAllowedIPsExpectation{}
+	}
+
+	if mmSetAllowedIPs.defaultExpectation.params != nil {
+		mmSetAllowedIPs.mock.t.Fatalf("UserRepositoryMock.SetAllowedIPs mock is already set by Expect")
+	}
+
+	if mmSetAllowedIPs.defaultExpectation.paramPtrs == nil {
+		mmSetAllowedIPs.defaultExpectation.paramPtrs = &UserRepositoryMockSetAllowedIPsParamPtrs{}
+	}
+	mmSetAllowedIPs.defaultExpectation.paramPtrs.updatedAt = &updatedAt
+	mmSetAllowedIPs.defaultExpectation.expectationOrigins.originUpdatedAt = minimock.CallerInfo(1)
+
+	return mmSetAllowedIPs
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserRepository.SetAllowedIPs
+func (mmSetAllowedIPs *mUserRepositoryMockSetAllowedIPs) Inspect(f func(ctx context.Context, userID string, allowedIPs []string, updatedAt time.Time)) *mUserRepositoryMockSetAllowedIPs {
+	if mmSetAllowedIPs.mock.inspectFuncSetAllowedIPs != nil {
+		mmSetAllowedIPs.mock.t.Fatalf("Inspect function is already set for UserRepositoryMock.SetAllowedIPs")
+	}
+
+	mmSetAllowedIPs.mock.inspectFuncSetAllowedIPs = f
+
+	return mmSetAllowedIPs
+}
+
+// Return sets up results that will be returned by UserRepository.SetAllowedIPs
+func (mmSetAllowedIPs *mUserRepositoryMockSetAllowedIPs) Return(err error) *UserRepositoryMock {
+	if mmSetAllowedIPs.mock.funcSetAllowedIPs != nil {
+		mmSetAllowedIPs.mock.t.Fatalf("UserRepositoryMock.SetAllowedIPs mock is already set by Set")
+	}
+
+	if mmSetAllowedIPs.defaultExpectation == nil {
+		mmSetAllowedIPs.defaultExpectation = &UserRepositoryMockSetAllowedIPsExpectation{mock: mmSetAllowedIPs.mock}
+	}
+	mmSetAllowedIPs.defaultExpectation.results = &UserRepositoryMockSetAllowedIPsResults{err}
+	mmSetAllowedIPs.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSetAllowedIPs.mock
+}
+
+// Set uses given function f to mock the UserRepository.SetAllowedIPs method
+func (mmSetAllowedIPs *mUserRepositoryMockSetAllowedIPs) Set(f func(ctx context.Context, userID string, allowedIPs []string, updatedAt time.Time) (err error)) *UserRepositoryMock {
+	if mmSetAllowedIPs.defaultExpectation != nil {
+		mmSetAllowedIPs.mock.t.Fatalf("Default expectation is already set for the UserRepository.SetAllowedIPs method")
+	}
+
+	if len(mmSetAllowedIPs.expectations) > 0 {
+		mmSetAllowedIPs.mock.t.Fatalf("Some expectations are already set for the UserRepository.SetAllowedIPs method")
+	}
+
+	mmSetAllowedIPs.mock.funcSetAllowedIPs = f
+	mmSetAllowedIPs.mock.funcSetAllowedIPsOrigin = minimock.CallerInfo(1)
+	return mmSetAllowedIPs.mock
+}
+
+// When sets expectation for the UserRepository.SetAllowedIPs which will trigger the result defined by the following
+// Then helper
+func (mmSetAllowedIPs *mUserRepositoryMockSetAllowedIPs) When(ctx context.Context, userID string, allowedIPs []string, updatedAt time.Time) *UserRepositoryMockSetAllowedIPsExpectation {
+	if mmSetAllowedIPs.mock.funcSetAllowedIPs != nil {
+		mmSetAllowedIPs.mock.t.Fatalf("UserRepositoryMock.SetAllowedIPs mock is already set by Set")
+	}
+
+	expectation := &UserRepositoryMockSetAllowedIPsExpectation{
+		mock:               mmSetAllowedIPs.mock,
+		params:             &UserRepositoryMockSetAllowedIPsParams{ctx, userID, allowedIPs, updatedAt},
+		expectationOrigins: UserRepositoryMockSetAllowedIPsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSetAllowedIPs.expectations = append(mmSetAllowedIPs.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserRepository.SetAllowedIPs return parameters for the expectation previously defined by the When method
+func (e *UserRepositoryMockSetAllowedIPsExpectation) Then(err error) *UserRepositoryMock {
+	e.results = &UserRepositoryMockSetAllowedIPsResults{err}
+	return e.mock
+}
+
+// Times sets number of times UserRepository.SetAllowedIPs should be invoked
+func (mmSetAllowedIPs *mUserRepositoryMockSetAllowedIPs) Times(n uint64) *mUserRepositoryMockSetAllowedIPs {
+	if n == 0 {
+		mmSetAllowedIPs.mock.t.Fatalf("Times of UserRepositoryMock.SetAllowedIPs mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSetAllowedIPs.expectedInvocations, n)
+	mmSetAllowedIPs.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSetAllowedIPs
+}
+
+func (mmSetAllowedIPs *mUserRepositoryMockSetAllowedIPs) invocationsDone() bool {
+	if len(mmSetAllowedIPs.expectations) == 0 && mmSetAllowedIPs.defaultExpectation == nil && mmSetAllowedIPs.mock.funcSetAllowedIPs == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSetAllowedIPs.mock.afterSetAllowedIPsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSetAllowedIPs.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// SetAllowedIPs implements UserRepository
+func (mmSetAllowedIPs *UserRepositoryMock) SetAllowedIPs(ctx context.Context, userID string, allowedIPs []string, updatedAt time.Time) (err error) {
+	mm_atomic.AddUint64(&mmSetAllowedIPs.beforeSetAllowedIPsCounter, 1)
+	defer mm_atomic.AddUint64(&mmSetAllowedIPs.afterSetAllowedIPsCounter, 1)
+
+	mmSetAllowedIPs.t.Helper()
+
+	if mmSetAllowedIPs.inspectFuncSetAllowedIPs != nil {
+		mmSetAllowedIPs.inspectFuncSetAllowedIPs(ctx, userID, allowedIPs, updatedAt)
+	}
+
+	mm_params := UserRepositoryMockSetAllowedIPsParams{ctx, userID, allowedIPs, updatedAt}
+
+	// Record call args
+	mmSetAllowedIPs.SetAllowedIPsMock.mutex.Lock()
+	mmSetAllowedIPs.SetAllowedIPsMock.callArgs = append(mmSetAllowedIPs.SetAllowedIPsMock.callArgs, &mm_params)
+	mmSetAllowedIPs.SetAllowedIPsMock.mutex.Unlock()
+
+	for _, e := range mmSetAllowedIPs.SetAllowedIPsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmSetAllowedIPs.SetAllowedIPsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSetAllowedIPs.SetAllowedIPsMock.defaultExpectation.Counter, 1)
+		mm_want := mmSetAllowedIPs.SetAllowedIPsMock.defaultExpectation.params
+		mm_want_ptrs := mmSetAllowedIPs.SetAllowedIPsMock.defaultExpectation.paramPtrs
+
+		mm_got := UserRepositoryMockSetAllowedIPsParams{ctx, userID, allowedIPs, updatedAt}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSetAllowedIPs.t.Errorf("UserRepositoryMock.SetAllowedIPs got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetAllowedIPs.SetAllowedIPsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmSetAllowedIPs.t.Errorf("UserRepositoryMock.SetAllowedIPs got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetAllowedIPs.SetAllowedIPsMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.allowedIPs != nil && !minimock.Equal(*mm_want_ptrs.allowedIPs, mm_got.allowedIPs) {
+				mmSetAllowedIPs.t.Errorf("UserRepositoryMock.SetAllowedIPs got unexpected parameter allowedIPs, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetAllowedIPs.SetAllowedIPsMock.defaultExpectation.expectationOrigins.originAllowedIPs, *mm_want_ptrs.allowedIPs, mm_got.allowedIPs, minimock.Diff(*mm_want_ptrs.allowedIPs, mm_got.allowedIPs))
+			}
+
+			if mm_want_ptrs.updatedAt != nil && !minimock.Equal(*mm_want_ptrs.updatedAt, mm_got.updatedAt) {
+				mmSetAllowedIPs.t.Errorf("UserRepositoryMock.SetAllowedIPs got unexpected parameter updatedAt, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetAllowedIPs.SetAllowedIPsMock.defaultExpectation.expectationOrigins.originUpdatedAt, *mm_want_ptrs.updatedAt, mm_got.updatedAt, minimock.Diff(*mm_want_ptrs.updatedAt, mm_got.updatedAt))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSetAllowedIPs.t.Errorf("UserRepositoryMock.SetAllowedIPs got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSetAllowedIPs.SetAllowedIPsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSetAllowedIPs.SetAllowedIPsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSetAllowedIPs.t.Fatal("No results are set for the UserRepositoryMock.SetAllowedIPs")
+		}
+		return (*mm_results).err
+	}
+	if mmSetAllowedIPs.funcSetAllowedIPs != nil {
+		return mmSetAllowedIPs.funcSetAllowedIPs(ctx, userID, allowedIPs, updatedAt)
+	}
+	mmSetAllowedIPs.t.Fatalf("Unexpected call to UserRepositoryMock.SetAllowedIPs. %v %v %v %v", ctx, userID, allowedIPs, updatedAt)
+	return
+}
+
+// SetAllowedIPsAfterCounter returns a count of finished UserRepositoryMock.SetAllowedIPs invocations
+func (mmSetAllowedIPs *UserRepositoryMock) SetAllowedIPsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetAllowedIPs.afterSetAllowedIPsCounter)
+}
+
+// SetAllowedIPsBeforeCounter returns a count of UserRepositoryMock.SetAllowedIPs invocations
+func (mmSetAllowedIPs *UserRepositoryMock) SetAllowedIPsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetAllowedIPs.beforeSetAllowedIPsCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserRepositoryMock.SetAllowedIPs.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSetAllowedIPs *mUserRepositoryMockSetAllowedIPs) Calls() []*UserRepositoryMockSetAllowedIPsParams {
+	mmSetAllowedIPs.mutex.RLock()
+
+	argCopy := make([]*UserRepositoryMockSetAllowedIPsParams, len(mmSetAllowedIPs.callArgs))
+	copy(argCopy, mmSetAllowedIPs.callArgs)
+
+	mmSetAllowedIPs.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSetAllowedIPsDone returns true if the count of the SetAllowedIPs invocations corresponds
+// the number of defined expectations
+func (m *UserRepositoryMock) MinimockSetAllowedIPsDone() bool {
+	if m.SetAllowedIPsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SetAllowedIPsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SetAllowedIPsMock.invocationsDone()
+}
+
+// MinimockSetAllowedIPsInspect logs each unmet expectation
+func (m *UserRepositoryMock) MinimockSetAllowedIPsInspect() {
+	for _, e := range m.SetAllowedIPsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserRepositoryMock.SetAllowedIPs at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSetAllowedIPsCounter := mm_atomic.LoadUint64(&m.afterSetAllowedIPsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SetAllowedIPsMock.defaultExpectation != nil && afterSetAllowedIPsCounter < 1 {
+		if m.SetAllowedIPsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UserRepositoryMock.SetAllowedIPs at\n%s", m.SetAllowedIPsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UserRepositoryMock.SetAllowedIPs at\n%s with params: %#v", m.SetAllowedIPsMock.defaultExpectation.expectationOrigins.origin, *m.SetAllowedIPsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSetAllowedIPs != nil && afterSetAllowedIPsCounter < 1 {
+		m.t.Errorf("Expected call to UserRepositoryMock.SetAllowedIPs at\n%s", m.funcSetAllowedIPsOrigin)
+	}
+
+	if !m.SetAllowedIPsMock.invocationsDone() && afterSetAllowedIPsCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserRepositoryMock.SetAllowedIPs at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SetAllowedIPsMock.expectedInvocations), m.SetAllowedIPsMock.expectedInvocationsOrigin, afterSetAllowedIPsCounter)
+	}
+}
+
+type mUserRepositoryMockTouchPersonalAccessToken struct {
+	optional           bool
+	mock               *UserRepositoryMock
+	defaultExpectation *UserRepositoryMockTouchPersonalAccessTokenExpectation
+	expectations       []*UserRepositoryMockTouchPersonalAccessTokenExpectation
+
+	callArgs []*UserRepositoryMockTouchPersonalAccessTokenParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UserRepositoryMockTouchPersonalAccessTokenExpectation specifies expectation struct of the UserRepository.TouchPersonalAccessToken
+type UserRepositoryMockTouchPersonalAccessTokenExpectation struct {
+	mock               *UserRepositoryMock
+	params             *UserRepositoryMockTouchPersonalAccessTokenParams
+	paramPtrs          *UserRepositoryMockTouchPersonalAccessTokenParamPtrs
+	expectationOrigins UserRepositoryMockTouchPersonalAccessTokenExpectationOrigins
+	results            *UserRepositoryMockTouchPersonalAccessTokenResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UserRepositoryMockTouchPersonalAccessTokenParams contains parameters of the UserRepository.TouchPersonalAccessToken
+type UserRepositoryMockTouchPersonalAccessTokenParams struct {
+	ctx     context.Context
+	tokenID string
+	usedAt  time.Time
+	ip      string
+}
+
+// UserRepositoryMockTouchPersonalAccessTokenParamPtrs contains pointers to parameters of the UserRepository.TouchPersonalAccessToken
+type UserRepositoryMockTouchPersonalAccessTokenParamPtrs struct {
+	ctx     *context.Context
+	tokenID *string
+	usedAt  *time.Time
+	ip      *string
+}
+
+// UserRepositoryMockTouchPersonalAccessTokenResults contains results of the UserRepository.TouchPersonalAccessToken
+type UserRepositoryMockTouchPersonalAccessTokenResults struct {
+	err error
+}
+
+// UserRepositoryMockTouchPersonalAccessTokenOrigins contains origins of expectations of the UserRepository.TouchPersonalAccessToken
+type UserRepositoryMockTouchPersonalAccessTokenExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originTokenID string
+	originUsedAt  string
+	originIp      string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmTouchPersonalAccessToken *mUserRepositoryMockTouchPersonalAccessToken) Optional() *mUserRepositoryMockTouchPersonalAccessToken {
+	mmTouchPersonalAccessToken.optional = true
+	return mmTouchPersonalAccessToken
+}
+
+// Expect sets up expected params for UserRepository.TouchPersonalAccessToken
+func (mmTouchPersonalAccessToken *mUserRepositoryMockTouchPersonalAccessToken) Expect(ctx context.Context, tokenID string, usedAt time.Time, ip string) *mUserRepositoryMockTouchPersonalAccessToken {
+	if mmTouchPersonalAccessToken.mock.funcTouchPersonalAccessToken != nil {
+		mmTouchPersonalAccessToken.mock.t.Fatalf("UserRepositoryMock.TouchPersonalAccessToken mock is already set by Set")
+	}
+
+	if mmTouchPersonalAccessToken.defaultExpectation == nil {
+		mmTouchPersonalAccessToken.defaultExpectation = &UserRepositoryMockTouchPersonalAccessTokenExpectation{}
+	}
+
+	if mmTouchPersonalAccessToken.defaultExpectation.paramPtrs != nil {
+		mmTouchPersonalAccessToken.mock.t.Fatalf("UserRepositoryMock.TouchPersonalAccessToken mock is already set by ExpectParams functions")
+	}
+
+	mmTouchPersonalAccessToken.defaultExpectation.params = &UserRepositoryMockTouchPersonalAccessTokenParams{ctx, tokenID, usedAt, ip}
+	mmTouchPersonalAccessToken.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmTouchPersonalAccessToken.expectations {
+		if minimock.Equal(e.params, mmTouchPersonalAccessToken.defaultExpectation.params) {
+			mmTouchPersonalAccessToken.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmTouchPersonalAccessToken.defaultExpectation.params)
+		}
+	}
+
+	return mmTouchPersonalAccessToken
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UserRepository.TouchPersonalAccessToken
+func (mmTouchPersonalAccessToken *mUserRepositoryMockTouchPersonalAccessToken) ExpectCtxParam1(ctx context.Context) *mUserRepositoryMockTouchPersonalAccessToken {
+	if mmTouchPersonalAccessToken.mock.funcTouchPersonalAccessToken != nil {
+		mmTouchPersonalAccessToken.mock.t.Fatalf("UserRepositoryMock.TouchPersonalAccessToken mock is already set by Set")
+	}
+
+	if mmTouchPersonalAccessToken.defaultExpectation == nil {
+		mmTouchPersonalAccessToken.defaultExpectation = &UserRepositoryMockTouchPersonalAccessTokenExpectation{}
+	}
+
+	if mmTouchPersonalAccessToken.defaultExpectation.params != nil {
+		mmTouchPersonalAccessToken.mock.t.Fatalf("UserRepositoryMock.TouchPersonalAccessToken mock is already set by Expect")
+	}
+
+	if mmTouchPersonalAccessToken.defaultExpectation.paramPtrs == nil {
+		mmTouchPersonalAccessToken.defaultExpectation.paramPtrs = &UserRepositoryMockTouchPersonalAccessTokenParamPtrs{}
+	}
+	mmTouchPersonalAccessToken.defaultExpectation.paramPtrs.ctx = &ctx
+	mmTouchPersonalAccessToken.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmTouchPersonalAccessToken
+}
+
+// ExpectTokenIDParam2 sets up expected param tokenID for UserRepository.TouchPersonalAccessToken
+func (mmTouchPersonalAccessToken *mUserRepositoryMockTouchPersonalAccessToken) ExpectTokenIDParam2(tokenID string) *mUserRepositoryMockTouchPersonalAccessToken {
+	if mmTouchPersonalAccessToken.mock.funcTouchPersonalAccessToken != nil {
+		mmTouchPersonalAccessToken.mock.t.Fatalf("UserRepositoryMock.TouchPersonalAccessToken mock is already set by Set")
+	}
+
+	if mmTouchPersonalAccessToken.defaultExpectation == nil {
+		mmTouchPersonalAccessToken.defaultExpectation = &UserRepositoryMockTouchPersonalAccessTokenExpectation{}
+	}
+
+	if mmTouchPersonalAccessToken.defaultExpectation.params != nil {
+		mmTouchPersonalAccessToken.mock.t.Fatalf("UserRepositoryMock.TouchPersonalAccessToken mock is already set by Expect")
+	}
+
+	if mmTouchPersonalAccessToken.defaultExpectation.paramPtrs == nil {
+		mmTouchPersonalAccessToken.defaultExpectation.paramPtrs = &UserRepositoryMockTouchPersonalAccessTokenParamPtrs{}
+	}
+	mmTouchPersonalAccessToken.defaultExpectation.paramPtrs.tokenID = &tokenID
+	mmTouchPersonalAccessToken.defaultExpectation.expectationOrigins.originTokenID = minimock.CallerInfo(1)
+
+	return mmTouchPersonalAccessToken
+}
+
+// ExpectUsedAtParam3 sets up expected param usedAt for UserRepository.TouchPersonalAccessToken
+func (mmTouchPersonalAccessToken *mUserRepositoryMockTouchPersonalAccessToken) ExpectUsedAtParam3(usedAt time.Time) *mUserRepositoryMockTouchPersonalAccessToken {
+	if mmTouchPersonalAccessToken.mock.funcTouchPersonalAccessToken != nil {
+		mmTouchPersonalAccessToken.mock.t.Fatalf("UserRepositoryMock.TouchPersonalAccessToken mock is already set by Set")
+	}
+
+	if mmTouchPersonalAccessToken.defaultExpectation == nil {
+		mmTouchPersonalAccessToken.defaultExpectation = &UserRepositoryMockTouchPersonalAccessTokenExpectation{}
+	}
+
+	if mmTouchPersonalAccessToken.defaultExpectation.params != nil {
+		mmTouchPersonalAccessToken.mock.t.Fatalf("UserRepositoryMock.TouchPersonalAccessToken mock is already set by Expect")
+	}
+
+	if mmTouchPersonalAccessToken.defaultExpectation.paramPtrs == nil {
+		mmTouchPersonalAccessToken.defaultExpectation.paramPtrs = &UserRepositoryMockTouchPersonalAccessTokenParamPtrs{}
+	}
+	mmTouchPersonalAccessToken.defaultExpectation.paramPtrs.usedAt = &usedAt
+	mmTouchPersonalAccessToken.defaultExpectation.expectationOrigins.originUsedAt = minimock.CallerInfo(1)
+
+	return mmTouchPersonalAccessToken
+}
+
+// ExpectIpParam4 sets up expected param ip for UserRepository.TouchPersonalAccessToken
+func (mmTouchPersonalAccessToken *mUserRepositoryMockTouchPersonalAccessToken) ExpectIpParam4(ip string) *mUserRepositoryMockTouchPersonalAccessToken {
+	if mmTouchPersonalAccessToken.mock.funcTouchPersonalAccessToken != nil {
+		mmTouchPersonalAccessToken.mock.t.Fatalf("UserRepositoryMock.TouchPersonalAccessToken mock is already set by Set")
+	}
+
+	if mmTouchPersonalAccessToken.defaultExpectation == nil {
+		mmTouchPersonalAccessToken.defaultExpectation = &UserRepositoryMockTouchPersonalAccessTokenExpectation{}
+	}
+
+	if mmTouchPersonalAccessToken.defaultExpectation.params != nil {
+		mmTouchPersonalAccessToken.mock.t.Fatalf("UserRepositoryMock.TouchPersonalAccessToken mock is already set by Expect")
+	}
+
+	if mmTouchPersonalAccessToken.defaultExpectation.paramPtrs == nil {
+		mmTouchPersonalAccessToken.defaultExpectation.paramPtrs = &UserRepositoryMockTouchPersonalAccessTokenParamPtrs{}
+	}
+	mmTouchPersonalAccessToken.defaultExpectation.paramPtrs.ip = &ip
+	mmTouchPersonalAccessToken.defaultExpectation.expectationOrigins.originIp = minimock.CallerInfo(1)
+
+	return mmTouchPersonalAccessToken
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserRepository.TouchPersonalAccessToken
+func (mmTouchPersonalAccessToken *mUserRepositoryMockTouchPersonalAccessToken) Inspect(f func(ctx context.Context, tokenID string, usedAt time.Time, ip string)) *mUserRepositoryMockTouchPersonalAccessToken {
+	if mmTouchPersonalAccessToken.mock.inspectFuncTouchPersonalAccessToken != nil {
+		mmTouchPersonalAccessToken.mock.t.Fatalf("Inspect function is already set for UserRepositoryMock.TouchPersonalAccessToken")
+	}
+
+	mmTouchPersonalAccessToken.mock.inspectFuncTouchPersonalAccessToken = f
+
+	return mmTouchPersonalAccessToken
+}
+
+// Return sets up results that will be returned by UserRepository.TouchPersonalAccessToken
+func (mmTouchPersonalAccessToken *mUserRepositoryMockTouchPersonalAccessToken) Return(err error) *UserRepositoryMock {
+	if mmTouchPersonalAccessToken.mock.funcTouchPersonalAccessToken != nil {
+		mmTouchPersonalAccessToken.mock.t.Fatalf("UserRepositoryMock.TouchPersonalAccessToken mock is already set by Set")
+	}
+
+	if mmTouchPersonalAccessToken.defaultExpectation == nil {
+		mmTouchPersonalAccessToken.defaultExpectation = &UserRepositoryMockTouchPersonalAccessTokenExpectation{mock: mmTouchPersonalAccessToken.mock}
+	}
+	mmTouchPersonalAccessToken.defaultExpectation.results = &UserRepositoryMockTouchPersonalAccessTokenResults{err}
+	mmTouchPersonalAccessToken.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmTouchPersonalAccessToken.mock
+}
+
+// Set uses given function f to mock the UserRepository.TouchPersonalAccessToken method
+func (mmTouchPersonalAccessToken *mUserRepositoryMockTouchPersonalAccessToken) Set(f func(ctx context.Context, tokenID string, usedAt time.Time, ip string) (err error)) *UserRepositoryMock {
+	if mmTouchPersonalAccessToken.defaultExpectation != nil {
+		mmTouchPersonalAccessToken.mock.t.Fatalf("Default expectation is already set for the UserRepository.TouchPersonalAccessToken method")
+	}
+
+	if len(mmTouchPersonalAccessToken.expectations) > 0 {
+		mmTouchPersonalAccessToken.mock.t.Fatalf("Some expectations are already set for the UserRepository.TouchPersonalAccessToken method")
+	}
+
+	mmTouchPersonalAccessToken.mock.funcTouchPersonalAccessToken = f
+	mmTouchPersonalAccessToken.mock.funcTouchPersonalAccessTokenOrigin = minimock.CallerInfo(1)
+	return mmTouchPersonalAccessToken.mock
+}
+
+// When sets expectation for the UserRepository.TouchPersonalAccessToken which will trigger the result defined by the following
+// Then helper
+func (mmTouchPersonalAccessToken *mUserRepositoryMockTouchPersonalAccessToken) When(ctx context.Context, tokenID string, usedAt time.Time, ip string) *UserRepositoryMockTouchPersonalAccessTokenExpectation {
+	if mmTouchPersonalAccessToken.mock.funcTouchPersonalAccessToken != nil {
+		mmTouchPersonalAccessToken.mock.t.Fatalf("UserRepositoryMock.TouchPersonalAccessToken mock is already set by Set")
+	}
+
+	expectation := &UserRepositoryMockTouchPersonalAccessTokenExpectation{
+		mock:               mmTouchPersonalAccessToken.mock,
+		params:             &UserRepositoryMockTouchPersonalAccessTokenParams{ctx, tokenID, usedAt, ip},
+		expectationOrigins: UserRepositoryMockTouchPersonalAccessTokenExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmTouchPersonalAccessToken.expectations = append(mmTouchPersonalAccessToken.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserRepository.TouchPersonalAccessToken return parameters for the expectation previously defined by the When method
+func (e *UserRepositoryMockTouchPersonalAccessTokenExpectation) Then(err error) *UserRepositoryMock {
+	e.results = &UserRepositoryMockTouchPersonalAccessTokenResults{err}
+	return e.mock
+}
+
+// Times sets number of times UserRepository.TouchPersonalAccessToken should be invoked
+func (mmTouchPersonalAccessToken *mUserRepositoryMockTouchPersonalAccessToken) Times(n uint64) *mUserRepositoryMockTouchPersonalAccessToken {
+	if n == 0 {
+		mmTouchPersonalAccessToken.mock.t.Fatalf("Times of UserRepositoryMock.TouchPersonalAccessToken mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmTouchPersonalAccessToken.expectedInvocations, n)
+	mmTouchPersonalAccessToken.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmTouchPersonalAccessToken
+}
+
+func (mmTouchPersonalAccessToken *mUserRepositoryMockTouchPersonalAccessToken) invocationsDone() bool {
+	if len(mmTouchPersonalAccessToken.expectations) == 0 && mmTouchPersonalAccessToken.defaultExpectation == nil && mmTouchPersonalAccessToken.mock.funcTouchPersonalAccessToken == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmTouchPersonalAccessToken.mock.afterTouchPersonalAccessTokenCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmTouchPersonalAccessToken.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// TouchPersonalAccessToken implements UserRepository
+func (mmTouchPersonalAccessToken *UserRepositoryMock) TouchPersonalAccessToken(ctx context.Context, tokenID string, usedAt time.Time, ip string) (err error) {
+	mm_atomic.AddUint64(&mmTouchPersonalAccessToken.beforeTouchPersonalAccessTokenCounter, 1)
+	defer mm_atomic.AddUint64(&mmTouchPersonalAccessToken.afterTouchPersonalAccessTokenCounter, 1)
+
+	mmTouchPersonalAccessToken.t.Helper()
+
+	if mmTouchPersonalAccessToken.inspectFuncTouchPersonalAccessToken != nil {
+		mmTouchPersonalAccessToken.inspectFuncTouchPersonalAccessToken(ctx, tokenID, usedAt, ip)
+	}
+
+	mm_params := UserRepositoryMockTouchPersonalAccessTokenParams{ctx, tokenID, usedAt, ip}
+
+	// Record call args
+	mmTouchPersonalAccessToken.TouchPersonalAccessTokenMock.mutex.Lock()
+	mmTouchPersonalAccessToken.TouchPersonalAccessTokenMock.callArgs = append(mmTouchPersonalAccessToken.TouchPersonalAccessTokenMock.callArgs, &mm_params)
+	mmTouchPersonalAccessToken.TouchPersonalAccessTokenMock.mutex.Unlock()
+
+	for _, e := range mmTouchPersonalAccessToken.TouchPersonalAccessTokenMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmTouchPersonalAccessToken.TouchPersonalAccessTokenMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmTouchPersonalAccessToken.TouchPersonalAccessTokenMock.defaultExpectation.Counter, 1)
+		mm_want := mmTouchPersonalAccessToken.TouchPersonalAccessTokenMock.defaultExpectation.params
+		mm_want_ptrs := mmTouchPersonalAccessToken.TouchPersonalAccessTokenMock.defaultExpectation.paramPtrs
+
+		mm_got := UserRepositoryMockTouchPersonalAccessTokenParams{ctx, tokenID, usedAt, ip}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmTouchPersonalAccessToken.t.Errorf("UserRepositoryMock.TouchPersonalAccessToken got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmTouchPersonalAccessToken.TouchPersonalAccessTokenMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.tokenID != nil && !minimock.Equal(*mm_want_ptrs.tokenID, mm_got.tokenID) {
+				mmTouchPersonalAccessToken.t.Errorf("UserRepositoryMock.TouchPersonalAccessToken got unexpected parameter tokenID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmTouchPersonalAccessToken.TouchPersonalAccessTokenMock.defaultExpectation.expectationOrigins.originTokenID, *mm_want_ptrs.tokenID, mm_got.tokenID, minimock.Diff(*mm_want_ptrs.tokenID, mm_got.tokenID))
+			}
+
+			if mm_want_ptrs.usedAt != nil && !minimock.Equal(*mm_want_ptrs.usedAt, mm_got.usedAt) {
+				mmTouchPersonalAccessToken.t.Errorf("UserRepositoryMock.TouchPersonalAccessToken got unexpected parameter usedAt, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmTouchPersonalAccessToken.TouchPersonalAccessTokenMock.defaultExpectation.expectationOrigins.originUsedAt, *mm_want_ptrs.usedAt, mm_got.usedAt, minimock.Diff(*mm_want_ptrs.usedAt, mm_got.usedAt))
+			}
+
+			if mm_want_ptrs.ip != nil && !minimock.Equal(*mm_want_ptrs.ip, mm_got.ip) {
+				mmTouchPersonalAccessToken.t.Errorf("UserRepositoryMock.TouchPersonalAccessToken got unexpected parameter ip, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmTouchPersonalAccessToken.TouchPersonalAccessTokenMock.defaultExpectation.expectationOrigins.originIp, *mm_want_ptrs.ip, mm_got.ip, minimock.Diff(*mm_want_ptrs.ip, mm_got.ip))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmTouchPersonalAccessToken.t.Errorf("UserRepositoryMock.TouchPersonalAccessToken got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmTouchPersonalAccessToken.TouchPersonalAccessTokenMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmTouchPersonalAccessToken.TouchPersonalAccessTokenMock.defaultExpectation.results
+		if mm_results == nil {
+			mmTouchPersonalAccessToken.t.Fatal("No results are set for the UserRepositoryMock.TouchPersonalAccessToken")
+		}
+		return (*mm_results).err
+	}
+	if mmTouchPersonalAccessToken.funcTouchPersonalAccessToken != nil {
+		return mmTouchPersonalAccessToken.funcTouchPersonalAccessToken(ctx, tokenID, usedAt, ip)
+	}
+	mmTouchPersonalAccessToken.t.Fatalf("Unexpected call to UserRepositoryMock.TouchPersonalAccessToken. %v %v %v %v", ctx, tokenID, usedAt, ip)
+	return
+}
+
+// TouchPersonalAccessTokenAfterCounter returns a count of finished UserRepositoryMock.TouchPersonalAccessToken invocations
+func (mmTouchPersonalAccessToken *UserRepositoryMock) TouchPersonalAccessTokenAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmTouchPersonalAccessToken.afterTouchPersonalAccessTokenCounter)
 }
 
-// ExpectCtxParam1 sets up expected param ctx for UserRepository.FindByID
-func (mmFindByID *mUserRepositoryMockFindByID) ExpectCtxParam1(ctx context.Context) *mUserRepositoryMockFindByID {
-	if mmFindByID.mock.funcFindByID != nil {
-		mmFindByID.mock.t.Fatalf("UserRepositoryMock.FindByID mock is already set by Set")
+// TouchPersonalAccessTokenBeforeCounter returns a count of UserRepositoryMock.TouchPersonalAccessToken invocations
+func (mmTouchPersonalAccessToken *UserRepositoryMock) TouchPersonalAccessTokenBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmTouchPersonalAccessToken.beforeTouchPersonalAccessTokenCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserRepositoryMock.TouchPersonalAccessToken.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmTouchPersonalAccessToken *mUserRepositoryMockTouchPersonalAccessToken) Calls() []*UserRepositoryMockTouchPersonalAccessTokenParams {
+	mmTouchPersonalAccessToken.mutex.RLock()
+
+	argCopy := make([]*UserRepositoryMockTouchPersonalAccessTokenParams, len(mmTouchPersonalAccessToken.callArgs))
+	copy(argCopy, mmTouchPersonalAccessToken.callArgs)
+
+	mmTouchPersonalAccessToken.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockTouchPersonalAccessTokenDone returns true if the count of the TouchPersonalAccessToken invocations corresponds
+// the number of defined expectations
+func (m *UserRepositoryMock) MinimockTouchPersonalAccessTokenDone() bool {
+	if m.TouchPersonalAccessTokenMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
 	}
 
-	if mmFindByID.defaultExpectation == nil {
-		mmFindByID.defaultExpectation = &UserRepositoryMockFindByIDExpectation{}
+	for _, e := range m.TouchPersonalAccessTokenMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
 	}
 
-	if mmFindByID.defaultExpectation.params != nil {
-		mmFindByID.mock.t.Fatalf("UserRepositoryMock.FindByID mock is already set by Expect")
+	return m.TouchPersonalAccessTokenMock.invocationsDone()
+}
+
+// MinimockTouchPersonalAccessTokenInspect logs each unmet expectation
+func (m *UserRepositoryMock) MinimockTouchPersonalAccessTokenInspect() {
+	for _, e := range m.TouchPersonalAccessTokenMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserRepositoryMock.TouchPersonalAccessToken at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
 	}
 
-	if mmFindByID.defaultExpectation.paramPtrs == nil {
-		mmFindByID.defaultExpectation.paramPtrs = &UserRepositoryMockFindByIDParamPtrs{}
+	afterTouchPersonalAccessTokenCounter := mm_atomic.LoadUint64(&m.afterTouchPersonalAccessTokenCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.TouchPersonalAccessTokenMock.defaultExpectation != nil && afterTouchPersonalAccessTokenCounter < 1 {
+		if m.TouchPersonalAccessTokenMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UserRepositoryMock.TouchPersonalAccessToken at\n%s", m.TouchPersonalAccessTokenMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UserRepositoryMock.TouchPersonalAccessToken at\n%s with params: %#v", m.TouchPersonalAccessTokenMock.defaultExpectation.expectationOrigins.origin, *m.TouchPersonalAccessTokenMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcTouchPersonalAccessToken != nil && afterTouchPersonalAccessTokenCounter < 1 {
+		m.t.Errorf("Expected call to UserRepositoryMock.TouchPersonalAccessToken at\n%s", m.funcTouchPersonalAccessTokenOrigin)
 	}
-	mmFindByID.defaultExpectation.paramPtrs.ctx = &ctx
-	mmFindByID.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmFindByID
+	if !m.TouchPersonalAccessTokenMock.invocationsDone() && afterTouchPersonalAccessTokenCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserRepositoryMock.TouchPersonalAccessToken at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.TouchPersonalAccessTokenMock.expectedInvocations), m.TouchPersonalAccessTokenMock.expectedInvocationsOrigin, afterTouchPersonalAccessTokenCounter)
+	}
 }
 
-// ExpectUserIDParam2 sets up expected param userID for UserRepository.FindByID
-func (mmFindByID *mUserRepositoryMockFindByID) ExpectUserIDParam2(userID string) *mUserRepositoryMockFindByID {
-	if mmFindByID.mock.funcFindByID != nil {
-		mmFindByID.mock.t.Fatalf("UserRepositoryMock.FindByID mock is already set by Set")
+type mUserRepositoryMockUpdatePassword struct {
+	optional           bool
+	mock               *UserRepositoryMock
+	defaultExpectation *UserRepositoryMockUpdatePasswordExpectation
+	expectations       []*UserRepositoryMockUpdatePasswordExpectation
+
+	callArgs []*UserRepositoryMockUpdatePasswordParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UserRepositoryMockUpdatePasswordExpectation specifies expectation struct of the UserRepository.UpdatePassword
+type UserRepositoryMockUpdatePasswordExpectation struct {
+	mock               *UserRepositoryMock
+	params             *UserRepositoryMockUpdatePasswordParams
+	paramPtrs          *UserRepositoryMockUpdatePasswordParamPtrs
+	expectationOrigins UserRepositoryMockUpdatePasswordExpectationOrigins
+	results            *UserRepositoryMockUpdatePasswordResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UserRepositoryMockUpdatePasswordParams contains parameters of the UserRepository.UpdatePassword
+type UserRepositoryMockUpdatePasswordParams struct {
+	ctx          context.Context
+	userID       string
+	passwordHash string
+	updatedAt    time.Time
+}
+
+// UserRepositoryMockUpdatePasswordParamPtrs contains pointers to parameters of the UserRepository.UpdatePassword
+type UserRepositoryMockUpdatePasswordParamPtrs struct {
+	ctx          *context.Context
+	userID       *string
+	passwordHash *string
+	updatedAt    *time.Time
+}
+
+// UserRepositoryMockUpdatePasswordResults contains results of the UserRepository.UpdatePassword
+type UserRepositoryMockUpdatePasswordResults struct {
+	err error
+}
+
+// UserRepositoryMockUpdatePasswordOrigins contains origins of expectations of the UserRepository.UpdatePassword
+type UserRepositoryMockUpdatePasswordExpectationOrigins struct {
+	origin             string
+	originCtx          string
+	originUserID       string
+	originPasswordHash string
+	originUpdatedAt    string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUpdatePassword *mUserRepositoryMockUpdatePassword) Optional() *mUserRepositoryMockUpdatePassword {
+	mmUpdatePassword.optional = true
+	return mmUpdatePassword
+}
+
+// Expect sets up expected params for UserRepository.UpdatePassword
+func (mmUpdatePassword *mUserRepositoryMockUpdatePassword) Expect(ctx context.Context, userID string, passwordHash string, updatedAt time.Time) *mUserRepositoryMockUpdatePassword {
+	if mmUpdatePassword.mock.funcUpdatePassword != nil {
+		mmUpdatePassword.mock.t.Fatalf("UserRepositoryMock.UpdatePassword mock is already set by Set")
 	}
 
-	if mmFindByID.defaultExpectation == nil {
-		mmFindByID.defaultExpectation = &UserRepositoryMockFindByIDExpectation{}
+	if mmUpdatePassword.defaultExpectation == nil {
+		mmUpdatePassword.defaultExpectation = &UserRepositoryMockUpdatePasswordExpectation{}
 	}
 
-	if mmFindByID.defaultExpectation.params != nil {
-		mmFindByID.mock.t.Fatalf("UserRepositoryMock.FindByID mock is already set by Expect")
+	if mmUpdatePassword.defaultExpectation.paramPtrs != nil {
+		mmUpdatePassword.mock.t.Fatalf("UserRepositoryMock.UpdatePassword mock is already set by ExpectParams functions")
 	}
 
-	if mmFindByID.defaultExpectation.paramPtrs == nil {
-		mmFindByID.defaultExpectation.paramPtrs = &UserRepositoryMockFindByIDParamPtrs{}
+	mmUpdatePassword.defaultExpectation.params = &UserRepositoryMockUpdatePasswordParams{ctx, userID, passwordHash, updatedAt}
+	mmUpdatePassword.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUpdatePassword.expectations {
+		if minimock.Equal(e.params, mmUpdatePassword.defaultExpectation.params) {
+			mmUpdatePassword.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdatePassword.defaultExpectation.params)
+		}
 	}
-	mmFindByID.defaultExpectation.paramPtrs.userID = &userID
-	mmFindByID.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
 
-	return mmFindByID
+	return mmUpdatePassword
 }
 
-// Inspect accepts an inspector function that has same arguments as the UserRepository.FindByID
-func (mmFindByID *mUserRepositoryMockFindByID) Inspect(f func(ctx context.Context, userID string)) *mUserRepositoryMockFindByID {
-	if mmFindByID.mock.inspectFuncFindByID != nil {
-		mmFindByID.mock.t.Fatalf("Inspect function is already set for UserRepositoryMock.FindByID")
+// ExpectCtxParam1 sets up expected param ctx for UserRepository.UpdatePassword
+func (mmUpdatePassword *mUserRepositoryMockUpdatePassword) ExpectCtxParam1(ctx context.Context) *mUserRepositoryMockUpdatePassword {
+	if mmUpdatePassword.mock.funcUpdatePassword != nil {
+		mmUpdatePassword.mock.t.Fatalf("UserRepositoryMock.UpdatePassword mock is already set by Set")
 	}
 
-	mmFindByID.mock.inspectFuncFindByID = f
+	if mmUpdatePassword.defaultExpectation == nil {
+		mmUpdatePassword.defaultExpectation = &UserRepositoryMockUpdatePasswordExpectation{}
+	}
 
-	return mmFindByID
+	if mmUpdatePassword.defaultExpectation.params != nil {
+		mmUpdatePassword.mock.t.Fatalf("UserRepositoryMock.UpdatePassword mock is already set by Expect")
+	}
+
+	if mmUpdatePassword.defaultExpectation.paramPtrs == nil {
+		mmUpdatePassword.defaultExpectation.paramPtrs = &UserRepositoryMockUpdatePasswordParamPtrs{}
+	}
+	mmUpdatePassword.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUpdatePassword.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmUpdatePassword
 }
 
-// Return sets up results that will be returned by UserRepository.FindByID
-func (mmFindByID *mUserRepositoryMockFindByID) Return(up1 *models.User, err error) *UserRepositoryMock {
-	if mmFindByID.mock.funcFindByID != nil {
-		mmFindByID.mock.t.Fatalf("UserRepositoryMock.FindByID mock is already set by Set")
+// ExpectUserIDParam2 sets up expected param userID for UserRepository.UpdatePassword
+func (mmUpdatePassword *mUserRepositoryMockUpdatePassword) ExpectUserIDParam2(userID string) *mUserRepositoryMockUpdatePassword {
+	if mmUpdatePassword.mock.funcUpdatePassword != nil {
+		mmUpdatePassword.mock.t.Fatalf("UserRepositoryMock.UpdatePassword mock is already set by Set")
 	}
 
-	if mmFindByID.defaultExpectation == nil {
-		mmFindByID.defaultExpectation = &UserRepositoryMockFindByIDExpectation{mock: mmFindByID.mock}
+	if mmUpdatePassword.defaultExpectation == nil {
+		mmUpdatePassword.defaultExpectation = &UserRepositoryMockUpdatePasswordExpectation{}
 	}
-	mmFindByID.defaultExpectation.results = &UserRepositoryMockFindByIDResults{up1, err}
-	mmFindByID.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmFindByID.mock
+
+	if mmUpdatePassword.defaultExpectation.params != nil {
+		mmUpdatePassword.mock.t.Fatalf("UserRepositoryMock.UpdatePassword mock is already set by Expect")
+	}
+
+	if mmUpdatePassword.defaultExpectation.paramPtrs == nil {
+		mmUpdatePassword.defaultExpectation.paramPtrs = &UserRepositoryMockUpdatePasswordParamPtrs{}
+	}
+	mmUpdatePassword.defaultExpectation.paramPtrs.userID = &userID
+	mmUpdatePassword.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmUpdatePassword
 }
 
-// Set uses given function f to mock the UserRepository.FindByID method
-func (mmFindByID *mUserRepositoryMockFindByID) Set(f func(ctx context.Context, userID string) (up1 *models.User, err error)) *UserRepositoryMock {
-	if mmFindByID.defaultExpectation != nil {
-		mmFindByID.mock.t.Fatalf("Default expectation is already set for the UserRepository.FindByID method")
+// ExpectPasswordHashParam3 sets up expected param passwordHash for UserRepository.UpdatePassword
+func (mmUpdatePassword *mUserRepositoryMockUpdatePassword) ExpectPasswordHashParam3(passwordHash string) *mUserRepositoryMockUpdatePassword {
+	if mmUpdatePassword.mock.funcUpdatePassword != nil {
+		mmUpdatePassword.mock.t.Fatalf("UserRepositoryMock.UpdatePassword mock is already set by Set")
 	}
 
-	if len(mmFindByID.expectations) > 0 {
-		mmFindByID.mock.t.Fatalf("Some expectations are already set for the UserRepository.FindByID method")
+	if mmUpdatePassword.defaultExpectation == nil {
+		mmUpdatePassword.defaultExpectation = &UserRepositoryMockUpdatePasswordExpectation{}
 	}
 
-	mmFindByID.mock.funcFindByID = f
-	mmFindByID.mock.funcFindByIDOrigin = minimock.CallerInfo(1)
-	return mmFindByID.mock
+	if mmUpdatePassword.defaultExpectation.params != nil {
+		mmUpdatePassword.mock.t.Fatalf("UserRepositoryMock.UpdatePassword mock is already set by Expect")
+	}
+
+	if mmUpdatePassword.defaultExpectation.paramPtrs == nil {
+		mmUpdatePassword.defaultExpectation.paramPtrs = &UserRepositoryMockUpdatePasswordParamPtrs{}
+	}
+	mmUpdatePassword.defaultExpectation.paramPtrs.passwordHash = &passwordHash
+	mmUpdatePassword.defaultExpectation.expectationOrigins.originPasswordHash = minimock.CallerInfo(1)
+
+	return mmUpdatePassword
 }
 
-// When sets expectation for the UserRepository.FindByID which will trigger the result defined by the following
+// ExpectUpdatedAtParam4 sets up expected param updatedAt for UserRepository.UpdatePassword
+func (mmUpdatePassword *mUserRepositoryMockUpdatePassword) ExpectUpdatedAtParam4(updatedAt time.Time) *mUserRepositoryMockUpdatePassword {
+	if mmUpdatePassword.mock.funcUpdatePassword != nil {
+		mmUpdatePassword.mock.t.Fatalf("UserRepositoryMock.UpdatePassword mock is already set by Set")
+	}
+
+	if mmUpdatePassword.defaultExpectation == nil {
+		mmUpdatePassword.defaultExpectation = &UserRepositoryMockUpdatePasswordExpectation{}
+	}
+
+	if mmUpdatePassword.defaultExpectation.params != nil {
+		mmUpdatePassword.mock.t.Fatalf("UserRepositoryMock.UpdatePassword mock is already set by Expect")
+	}
+
+	if mmUpdatePassword.defaultExpectation.paramPtrs == nil {
+		mmUpdatePassword.defaultExpectation.paramPtrs = &UserRepositoryMockUpdatePasswordParamPtrs{}
+	}
+	mmUpdatePassword.defaultExpectation.paramPtrs.updatedAt = &updatedAt
+	mmUpdatePassword.defaultExpectation.expectationOrigins.originUpdatedAt = minimock.CallerInfo(1)
+
+	return mmUpdatePassword
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserRepository.UpdatePassword
+func (mmUpdatePassword *mUserRepositoryMockUpdatePassword) Inspect(f func(ctx context.Context, userID string, passwordHash string, updatedAt time.Time)) *mUserRepositoryMockUpdatePassword {
+	if mmUpdatePassword.mock.inspectFuncUpdatePassword != nil {
+		mmUpdatePassword.mock.t.Fatalf("Inspect function is already set for UserRepositoryMock.UpdatePassword")
+	}
+
+	mmUpdatePassword.mock.inspectFuncUpdatePassword = f
+
+	return mmUpdatePassword
+}
+
+// Return sets up results that will be returned by UserRepository.UpdatePassword
+func (mmUpdatePassword *mUserRepositoryMockUpdatePassword) Return(err error) *UserRepositoryMock {
+	if mmUpdatePassword.mock.funcUpdatePassword != nil {
+		mmUpdatePassword.mock.t.Fatalf("UserRepositoryMock.UpdatePassword mock is already set by Set")
+	}
+
+	if mmUpdatePassword.defaultExpectation == nil {
+		mmUpdatePassword.defaultExpectation = &UserRepositoryMockUpdatePasswordExpectation{mock: mmUpdatePassword.mock}
+	}
+	mmUpdatePassword.defaultExpectation.results = &UserRepositoryMockUpdatePasswordResults{err}
+	mmUpdatePassword.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmUpdatePassword.mock
+}
+
+// Set uses given function f to mock the UserRepository.UpdatePassword method
+func (mmUpdatePassword *mUserRepositoryMockUpdatePassword) Set(f func(ctx context.Context, userID string, passwordHash string, updatedAt time.Time) (err error)) *UserRepositoryMock {
+	if mmUpdatePassword.defaultExpectation != nil {
+		mmUpdatePassword.mock.t.Fatalf("Default expectation is already set for the UserRepository.UpdatePassword method")
+	}
+
+	if len(mmUpdatePassword.expectations) > 0 {
+		mmUpdatePassword.mock.t.Fatalf("Some expectations are already set for the UserRepository.UpdatePassword method")
+	}
+
+	mmUpdatePassword.mock.funcUpdatePassword = f
+	mmUpdatePassword.mock.funcUpdatePasswordOrigin = minimock.CallerInfo(1)
+	return mmUpdatePassword.mock
+}
+
+// When sets expectation for the UserRepository.UpdatePassword which will trigger the result defined by the following
 // Then helper
-func (mmFindByID *mUserRepositoryMockFindByID) When(ctx context.Context, userID string) *UserRepositoryMockFindByIDExpectation {
-	if mmFindByID.mock.funcFindByID != nil {
-		mmFindByID.mock.t.Fatalf("UserRepositoryMock.FindByID mock is already set by Set")
+func (mmUpdatePassword *mUserRepositoryMockUpdatePassword) When(ctx context.Context, userID string, passwordHash string, updatedAt time.Time) *UserRepositoryMockUpdatePasswordExpectation {
+	if mmUpdatePassword.mock.funcUpdatePassword != nil {
+		mmUpdatePassword.mock.t.Fatalf("UserRepositoryMock.UpdatePassword mock is already set by Set")
 	}
 
-	expectation := &UserRepositoryMockFindByIDExpectation{
-		mock:               mmFindByID.mock,
-		params:             &UserRepositoryMockFindByIDParams{ctx, userID},
-		expectationOrigins: UserRepositoryMockFindByIDExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &UserRepositoryMockUpdatePasswordExpectation{
+		mock:               mmUpdatePassword.mock,
+		params:             &UserRepositoryMockUpdatePasswordParams{ctx, userID, passwordHash, updatedAt},
+		expectationOrigins: UserRepositoryMockUpdatePasswordExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmFindByID.expectations = append(mmFindByID.expectations, expectation)
+	mmUpdatePassword.expectations = append(mmUpdatePassword.expectations, expectation)
 	return expectation
 }
 
-// Then sets up UserRepository.FindByID return parameters for the expectation previously defined by the When method
-func (e *UserRepositoryMockFindByIDExpectation) Then(up1 *models.User, err error) *UserRepositoryMock {
-	e.results = &UserRepositoryMockFindByIDResults{up1, err}
+// Then sets up UserRepository.UpdatePassword return parameters for the expectation previously defined by the When method
+func (e *UserRepositoryMockUpdatePasswordExpectation) Then(err error) *UserRepositoryMock {
+	e.results = &UserRepositoryMockUpdatePasswordResults{err}
 	return e.mock
 }
 
-// Times sets number of times UserRepository.FindByID should be invoked
-func (mmFindByID *mUserRepositoryMockFindByID) Times(n uint64) *mUserRepositoryMockFindByID {
+// Times sets number of times UserRepository.UpdatePassword should be invoked
+func (mmUpdatePassword *mUserRepositoryMockUpdatePassword) Times(n uint64) *mUserRepositoryMockUpdatePassword {
 	if n == 0 {
-		mmFindByID.mock.t.Fatalf("Times of UserRepositoryMock.FindByID mock can not be zero")
+		mmUpdatePassword.mock.t.Fatalf("Times of UserRepositoryMock.UpdatePassword mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmFindByID.expectedInvocations, n)
-	mmFindByID.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmFindByID
+	mm_atomic.StoreUint64(&mmUpdatePassword.expectedInvocations, n)
+	mmUpdatePassword.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmUpdatePassword
 }
 
-func (mmFindByID *mUserRepositoryMockFindByID) invocationsDone() bool {
-	if len(mmFindByID.expectations) == 0 && mmFindByID.defaultExpectation == nil && mmFindByID.mock.funcFindByID == nil {
+func (mmUpdatePassword *mUserRepositoryMockUpdatePassword) invocationsDone() bool {
+	if len(mmUpdatePassword.expectations) == 0 && mmUpdatePassword.defaultExpectation == nil && mmUpdatePassword.mock.funcUpdatePassword == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmFindByID.mock.afterFindByIDCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmFindByID.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmUpdatePassword.mock.afterUpdatePasswordCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUpdatePassword.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// FindByID implements UserRepository
-func (mmFindByID *UserRepositoryMock) FindByID(ctx context.Context, userID string) (up1 *models.User, err error) {
-	mm_atomic.AddUint64(&mmFindByID.beforeFindByIDCounter, 1)
-	defer mm_atomic.AddUint64(&mmFindByID.afterFindByIDCounter, 1)
+// UpdatePassword implements UserRepository
+func (mmUpdatePassword *UserRepositoryMock) UpdatePassword(ctx context.Context, userID string, passwordHash string, updatedAt time.Time) (err error) {
+	mm_atomic.AddUint64(&mmUpdatePassword.beforeUpdatePasswordCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdatePassword.afterUpdatePasswordCounter, 1)
 
-	mmFindByID.t.Helper()
+	mmUpdatePassword.t.Helper()
 
-	if mmFindByID.inspectFuncFindByID != nil {
-		mmFindByID.inspectFuncFindByID(ctx, userID)
+	if mmUpdatePassword.inspectFuncUpdatePassword != nil {
+		mmUpdatePassword.inspectFuncUpdatePassword(ctx, userID, passwordHash, updatedAt)
 	}
 
-	mm_params := UserRepositoryMockFindByIDParams{ctx, userID}
+	mm_params := UserRepositoryMockUpdatePasswordParams{ctx, userID, passwordHash, updatedAt}
 
 	// Record call args
-	mmFindByID.FindByIDMock.mutex.Lock()
-	mmFindByID.FindByIDMock.callArgs = append(mmFindByID.FindByIDMock.callArgs, &mm_params)
-	mmFindByID.FindByIDMock.mutex.Unlock()
+	mmUpdatePassword.UpdatePasswordMock.mutex.Lock()
+	mmUpdatePassword.UpdatePasswordMock.callArgs = append(mmUpdatePassword.UpdatePasswordMock.callArgs, &mm_params)
+	mmUpdatePassword.UpdatePasswordMock.mutex.Unlock()
 
-	for _, e := range mmFindByID.FindByIDMock.expectations {
+	for _, e := range mmUpdatePassword.UpdatePasswordMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.up1, e.results.err
+			return e.results.err
 		}
 	}
 
-	if mmFindByID.FindByIDMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmFindByID.FindByIDMock.defaultExpectation.Counter, 1)
-		mm_want := mmFindByID.FindByIDMock.defaultExpectation.params
-		mm_want_ptrs := mmFindByID.FindByIDMock.defaultExpectation.paramPtrs
+	if mmUpdatePassword.UpdatePasswordMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdatePassword.UpdatePasswordMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdatePassword.UpdatePasswordMock.defaultExpectation.params
+		mm_want_ptrs := mmUpdatePassword.UpdatePasswordMock.defaultExpectation.paramPtrs
 
-		mm_got := UserRepositoryMockFindByIDParams{ctx, userID}
+		mm_got := UserRepositoryMockUpdatePasswordParams{ctx, userID, passwordHash, updatedAt}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmFindByID.t.Errorf("UserRepositoryMock.FindByID got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmFindByID.FindByIDMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmUpdatePassword.t.Errorf("UserRepositoryMock.UpdatePassword got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdatePassword.UpdatePasswordMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
 			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
-				mmFindByID.t.Errorf("UserRepositoryMock.FindByID got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmFindByID.FindByIDMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+				mmUpdatePassword.t.Errorf("UserRepositoryMock.UpdatePassword got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdatePassword.UpdatePasswordMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.passwordHash != nil && !minimock.Equal(*mm_want_ptrs.passwordHash, mm_got.passwordHash) {
+				mmUpdatePassword.t.Errorf("UserRepositoryMock.UpdatePassword got unexpected parameter passwordHash, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdatePassword.UpdatePasswordMock.defaultExpectation.expectationOrigins.originPasswordHash, *mm_want_ptrs.passwordHash, mm_got.passwordHash, minimock.Diff(*mm_want_ptrs.passwordHash, mm_got.passwordHash))
+			}
+
+			if mm_want_ptrs.updatedAt != nil && !minimock.Equal(*mm_want_ptrs.updatedAt, mm_got.updatedAt) {
+				mmUpdatePassword.t.Errorf("UserRepositoryMock.UpdatePassword got unexpected parameter updatedAt, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdatePassword.UpdatePasswordMock.defaultExpectation.expectationOrigins.originUpdatedAt, *mm_want_ptrs.updatedAt, mm_got.updatedAt, minimock.Diff(*mm_want_ptrs.updatedAt, mm_got.updatedAt))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmFindByID.t.Errorf("UserRepositoryMock.FindByID got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmFindByID.FindByIDMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmUpdatePassword.t.Errorf("UserRepositoryMock.UpdatePassword got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmUpdatePassword.UpdatePasswordMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmFindByID.FindByIDMock.defaultExpectation.results
+		mm_results := mmUpdatePassword.UpdatePasswordMock.defaultExpectation.results
 		if mm_results == nil {
-			mmFindByID.t.Fatal("No results are set for the UserRepositoryMock.FindByID")
+			mmUpdatePassword.t.Fatal("No results are set for the UserRepositoryMock.UpdatePassword")
 		}
-		return (*mm_results).up1, (*mm_results).err
+		return (*mm_results).err
 	}
-	if mmFindByID.funcFindByID != nil {
-		return mmFindByID.funcFindByID(ctx, userID)
+	if mmUpdatePassword.funcUpdatePassword != nil {
+		return mmUpdatePassword.funcUpdatePassword(ctx, userID, passwordHash, updatedAt)
 	}
-	mmFindByID.t.Fatalf("Unexpected call to UserRepositoryMock.FindByID. %v %v", ctx, userID)
+	mmUpdatePassword.t.Fatalf("Unexpected call to UserRepositoryMock.UpdatePassword. %v %v %v %v", ctx, userID, passwordHash, updatedAt)
 	return
 }
 
-// FindByIDAfterCounter returns a count of finished UserRepositoryMock.FindByID invocations
-func (mmFindByID *UserRepositoryMock) FindByIDAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmFindByID.afterFindByIDCounter)
+// UpdatePasswordAfterCounter returns a count of finished UserRepositoryMock.UpdatePassword invocations
+func (mmUpdatePassword *UserRepositoryMock) UpdatePasswordAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdatePassword.afterUpdatePasswordCounter)
 }
 
-// FindByIDBeforeCounter returns a count of UserRepositoryMock.FindByID invocations
-func (mmFindByID *UserRepositoryMock) FindByIDBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmFindByID.beforeFindByIDCounter)
+// UpdatePasswordBeforeCounter returns a count of UserRepositoryMock.UpdatePassword invocations
+func (mmUpdatePassword *UserRepositoryMock) UpdatePasswordBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdatePassword.beforeUpdatePasswordCounter)
 }
 
-// Calls returns a list of arguments used in each call to UserRepositoryMock.FindByID.
+// Calls returns a list of arguments used in each call to UserRepositoryMock.UpdatePassword.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmFindByID *mUserRepositoryMockFindByID) Calls() []*UserRepositoryMockFindByIDParams {
-	mmFindByID.mutex.RLock()
+func (mmUpdatePassword *mUserRepositoryMockUpdatePassword) Calls() []*UserRepositoryMockUpdatePasswordParams {
+	mmUpdatePassword.mutex.RLock()
 
-	argCopy := make([]*UserRepositoryMockFindByIDParams, len(mmFindByID.callArgs))
-	copy(argCopy, mmFindByID.callArgs)
+	argCopy := make([]*UserRepositoryMockUpdatePasswordParams, len(mmUpdatePassword.callArgs))
+	copy(argCopy, mmUpdatePassword.callArgs)
 
-	mmFindByID.mutex.RUnlock()
+	mmUpdatePassword.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockFindByIDDone returns true if the count of the FindByID invocations corresponds
+// MinimockUpdatePasswordDone returns true if the count of the UpdatePassword invocations corresponds
 // the number of defined expectations
-func (m *UserRepositoryMock) MinimockFindByIDDone() bool {
-	if m.FindByIDMock.optional {
+func (m *UserRepositoryMock) MinimockUpdatePasswordDone() bool {
+	if m.UpdatePasswordMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.FindByIDMock.expectations {
+	for _, e := range m.UpdatePasswordMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.FindByIDMock.invocationsDone()
+	return m.UpdatePasswordMock.invocationsDone()
 }
 
-// MinimockFindByIDInspect logs each unmet expectation
-func (m *UserRepositoryMock) MinimockFindByIDInspect() {
-	for _, e := range m.FindByIDMock.expectations {
+// MinimockUpdatePasswordInspect logs each unmet expectation
+func (m *UserRepositoryMock) MinimockUpdatePasswordInspect() {
+	for _, e := range m.UpdatePasswordMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to UserRepositoryMock.FindByID at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to UserRepositoryMock.UpdatePassword at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterFindByIDCounter := mm_atomic.LoadUint64(&m.afterFindByIDCounter)
+	afterUpdatePasswordCounter := mm_atomic.LoadUint64(&m.afterUpdatePasswordCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.FindByIDMock.defaultExpectation != nil && afterFindByIDCounter < 1 {
-		if m.FindByIDMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to UserRepositoryMock.FindByID at\n%s", m.FindByIDMock.defaultExpectation.returnOrigin)
+	if m.UpdatePasswordMock.defaultExpectation != nil && afterUpdatePasswordCounter < 1 {
+		if m.UpdatePasswordMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UserRepositoryMock.UpdatePassword at\n%s", m.UpdatePasswordMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to UserRepositoryMock.FindByID at\n%s with params: %#v", m.FindByIDMock.defaultExpectation.expectationOrigins.origin, *m.FindByIDMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to UserRepositoryMock.UpdatePassword at\n%s with params: %#v", m.UpdatePasswordMock.defaultExpectation.expectationOrigins.origin, *m.UpdatePasswordMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcFindByID != nil && afterFindByIDCounter < 1 {
-		m.t.Errorf("Expected call to UserRepositoryMock.FindByID at\n%s", m.funcFindByIDOrigin)
+	if m.funcUpdatePassword != nil && afterUpdatePasswordCounter < 1 {
+		m.t.Errorf("Expected call to UserRepositoryMock.UpdatePassword at\n%s", m.funcUpdatePasswordOrigin)
 	}
 
-	if !m.FindByIDMock.invocationsDone() && afterFindByIDCounter > 0 {
-		m.t.Errorf("Expected %d calls to UserRepositoryMock.FindByID at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.FindByIDMock.expectedInvocations), m.FindByIDMock.expectedInvocationsOrigin, afterFindByIDCounter)
+	if !m.UpdatePasswordMock.invocationsDone() && afterUpdatePasswordCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserRepositoryMock.UpdatePassword at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.UpdatePasswordMock.expectedInvocations), m.UpdatePasswordMock.expectedInvocationsOrigin, afterUpdatePasswordCounter)
 	}
 }
 
@@ -742,9 +6399,39 @@ func (m *UserRepositoryMock) MinimockFindByIDInspect() {
 func (m *UserRepositoryMock) MinimockFinish() {
 	m.finishOnce.Do(func() {
 		if !m.minimockDone() {
+			m.MinimockAddRoleInspect()
+
+			m.MinimockCreateOutboxEventInspect()
+
+			m.MinimockCreatePersonalAccessTokenInspect()
+
+			m.MinimockDeletePersonalAccessTokenInspect()
+
+			m.MinimockDeleteSessionInspect()
+
 			m.MinimockDeleteUserInspect()
 
+			m.MinimockDeleteUserSessionsInspect()
+
+			m.MinimockDisableUserInspect()
+
+			m.MinimockFindByEmailInspect()
+
 			m.MinimockFindByIDInspect()
+
+			m.MinimockFindPersonalAccessTokenByHashInspect()
+
+			m.MinimockInTxInspect()
+
+			m.MinimockListPersonalAccessTokensInspect()
+
+			m.MinimockListSessionsInspect()
+
+			m.MinimockSetAllowedIPsInspect()
+
+			m.MinimockTouchPersonalAccessTokenInspect()
+
+			m.MinimockUpdatePasswordInspect()
 		}
 	})
 }
@@ -768,6 +6455,21 @@ func (m *UserRepositoryMock) MinimockWait(timeout mm_time.Duration) {
 func (m *UserRepositoryMock) minimockDone() bool {
 	done := true
 	return done &&
+		m.MinimockAddRoleDone() &&
+		m.MinimockCreateOutboxEventDone() &&
+		m.MinimockCreatePersonalAccessTokenDone() &&
+		m.MinimockDeletePersonalAccessTokenDone() &&
+		m.MinimockDeleteSessionDone() &&
 		m.MinimockDeleteUserDone() &&
-		m.MinimockFindByIDDone()
+		m.MinimockDeleteUserSessionsDone() &&
+		m.MinimockDisableUserDone() &&
+		m.MinimockFindByEmailDone() &&
+		m.MinimockFindByIDDone() &&
+		m.MinimockFindPersonalAccessTokenByHashDone() &&
+		m.MinimockInTxDone() &&
+		m.MinimockListPersonalAccessTokensDone() &&
+		m.MinimockListSessionsDone() &&
+		m.MinimockSetAllowedIPsDone() &&
+		m.MinimockTouchPersonalAccessTokenDone() &&
+		m.MinimockUpdatePasswordDone()
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/alonsoF100/authorization-service/internal/apperrors"
 	"github.com/alonsoF100/authorization-service/internal/models"
@@ -11,6 +12,7 @@ import (
 	"github.com/gojuno/minimock/v3"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
 )
 
 func TestGetUserSuccess(t *testing.T) {
@@ -27,7 +29,7 @@ func TestGetUserSuccess(t *testing.T) {
 
 	mockRepo.FindByIDMock.Expect(ctx, userID).Return(expectedUser, nil)
 
-	userService := service.NewUserService(mockRepo)
+	userService := service.NewUserService(mockRepo, nil)
 
 	user, err := userService.GetUser(ctx, userID)
 
@@ -46,7 +48,7 @@ func TestGetUserDatabaseError(t *testing.T) {
 
 	mockRepo.FindByIDMock.Expect(ctx, userID).Return(nil, someErr)
 
-	userService := service.NewUserService(mockRepo)
+	userService := service.NewUserService(mockRepo, nil)
 
 	user, err := userService.GetUser(ctx, userID)
 
@@ -65,7 +67,7 @@ func TestGetUserNotFound(t *testing.T) {
 
 	mockRepo.FindByIDMock.Expect(ctx, userID).Return(nil, nil)
 
-	userService := service.NewUserService(mockRepo)
+	userService := service.NewUserService(mockRepo, nil)
 
 	user, err := userService.GetUser(ctx, userID)
 
@@ -77,13 +79,20 @@ func TestGetUserNotFound(t *testing.T) {
 func TestDeleteUserSuccess(t *testing.T) {
 	mc := minimock.NewController(t)
 	mockRepo := service.NewUserRepositoryMock(mc)
+	mockRepo.InTxMock.Set(inTx)
 
 	ctx := context.Background()
 	userID := uuid.New().String()
 
 	mockRepo.DeleteUserMock.Expect(ctx, userID).Return(nil)
+	mockRepo.CreateOutboxEventMock.Set(func(_ context.Context, event *models.OutboxEvent) error {
+		require.Equal(t, models.EventUserDeleted, event.Type)
+		require.Equal(t, userID, event.AggregateID)
+		require.JSONEq(t, `{"user_id":"`+userID+`"}`, string(event.Payload))
+		return nil
+	})
 
-	userService := service.NewUserService(mockRepo)
+	userService := service.NewUserService(mockRepo, nil)
 
 	err := userService.DeleteUser(ctx, userID)
 
@@ -93,6 +102,7 @@ func TestDeleteUserSuccess(t *testing.T) {
 func TestDeleteUserDatabaseError(t *testing.T) {
 	mc := minimock.NewController(t)
 	mockRepo := service.NewUserRepositoryMock(mc)
+	mockRepo.InTxMock.Set(inTx)
 
 	ctx := context.Background()
 	userID := uuid.New().String()
@@ -100,7 +110,7 @@ func TestDeleteUserDatabaseError(t *testing.T) {
 
 	mockRepo.DeleteUserMock.Expect(ctx, userID).Return(someErr)
 
-	userService := service.NewUserService(mockRepo)
+	userService := service.NewUserService(mockRepo, nil)
 
 	err := userService.DeleteUser(ctx, userID)
 
@@ -111,6 +121,7 @@ func TestDeleteUserDatabaseError(t *testing.T) {
 func TestDeleteUserNotFound(t *testing.T) {
 	mc := minimock.NewController(t)
 	mockRepo := service.NewUserRepositoryMock(mc)
+	mockRepo.InTxMock.Set(inTx)
 
 	ctx := context.Background()
 	userID := uuid.New().String()
@@ -118,10 +129,118 @@ func TestDeleteUserNotFound(t *testing.T) {
 
 	mockRepo.DeleteUserMock.Expect(ctx, userID).Return(someErr)
 
-	userService := service.NewUserService(mockRepo)
+	userService := service.NewUserService(mockRepo, nil)
 
 	err := userService.DeleteUser(ctx, userID)
 
 	require.Error(t, err)
 	require.Equal(t, someErr, err)
 }
+
+func TestGetUserByEmail(t *testing.T) {
+	mc := minimock.NewController(t)
+	mockRepo := service.NewUserRepositoryMock(mc)
+
+	ctx := context.Background()
+	email := "john@example.com"
+	expectedUser := &models.User{ID: uuid.New().String(), Email: email}
+
+	mockRepo.FindByEmailMock.When(ctx, email).Then(expectedUser, nil)
+	mockRepo.FindByEmailMock.When(ctx, "missing@example.com").Then(nil, nil)
+
+	userService := service.NewUserService(mockRepo, nil)
+
+	user, err := userService.GetUserByEmail(ctx, email)
+	require.NoError(t, err)
+	require.Equal(t, expectedUser, user)
+
+	user, err = userService.GetUserByEmail(ctx, "missing@example.com")
+	require.Equal(t, apperrors.ErrUserNotFoundByMail, err)
+	require.Nil(t, user)
+}
+
+func TestSetPassword(t *testing.T) {
+	mc := minimock.NewController(t)
+	mockRepo := service.NewUserRepositoryMock(mc)
+	mockRepo.InTxMock.Set(inTx)
+
+	ctx := context.Background()
+	userID := uuid.New().String()
+	password := "new_password_123"
+
+	mockRepo.UpdatePasswordMock.Set(func(ctx context.Context, id, passwordHash string, updatedAt time.Time) error {
+		require.Equal(t, userID, id)
+		require.NotEqual(t, password, passwordHash)
+		require.NoError(t, bcrypt.CompareHashAndPassword([]byte(passwordHash), []byte(password)))
+		require.WithinDuration(t, time.Now(), updatedAt, time.Second)
+		return nil
+	})
+	mockRepo.CreateOutboxEventMock.Set(func(_ context.Context, event *models.OutboxEvent) error {
+		require.Equal(t, models.EventUserPasswordChanged, event.Type)
+		require.Equal(t, userID, event.AggregateID)
+		return nil
+	})
+
+	userService := service.NewUserService(mockRepo, nil)
+
+	require.NoError(t, userService.SetPassword(ctx, userID, password))
+}
+
+func TestDisableUser(t *testing.T) {
+	tests := []struct {
+		name        string
+		repoErr     error
+		expectedErr error
+	}{
+		{"success", nil, nil},
+		{"not found", apperrors.ErrUserNotFoundByID, apperrors.ErrUserNotFoundByID},
+		{"database error", errors.New("database error"), errors.New("database error")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := minimock.NewController(t)
+			mockRepo := service.NewUserRepositoryMock(mc)
+
+			ctx := context.Background()
+			userID := uuid.New().String()
+
+			mockRepo.InTxMock.Set(func(ctx context.Context, fn func(ctx context.Context) error) error {
+				return fn(ctx)
+			})
+			mockRepo.DisableUserMock.Set(func(_ context.Context, id string, disabledAt time.Time) error {
+				require.Equal(t, userID, id)
+				require.WithinDuration(t, time.Now(), disabledAt, time.Second)
+				return tt.repoErr
+			})
+			if tt.repoErr == nil {
+				mockRepo.DeleteUserSessionsMock.Expect(ctx, userID).Return(nil)
+			}
+
+			err := service.NewUserService(mockRepo, nil).DisableUser(ctx, userID)
+			if tt.expectedErr == nil {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tt.expectedErr.Error())
+		})
+	}
+}
+
+func TestGrantRole(t *testing.T) {
+	mc := minimock.NewController(t)
+	mockRepo := service.NewUserRepositoryMock(mc)
+
+	ctx := context.Background()
+	userID := uuid.New().String()
+
+	mockRepo.AddRoleMock.Set(func(_ context.Context, id, role string, updatedAt time.Time) error {
+		require.Equal(t, userID, id)
+		require.Equal(t, models.RoleAdmin, role)
+		return nil
+	})
+
+	userService := service.NewUserService(mockRepo, nil)
+
+	require.NoError(t, userService.GrantRole(ctx, userID, models.RoleAdmin))
+}
@@ -0,0 +1,210 @@
+package extauthz
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/alonsoF100/authorization-service/internal/apperrors"
+	"github.com/alonsoF100/authorization-service/internal/config"
+	"github.com/alonsoF100/authorization-service/internal/models"
+	"github.com/alonsoF100/authorization-service/internal/transport/http/dto"
+	corev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	authv3 "github.com/envoyproxy/go-control-plane/envoy/service/auth/v3"
+	typev3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	"google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc/codes"
+)
+
+type TokenVerifier interface {
+	ValidateJWT(ctx context.Context, tokenString string) (*models.Claims, error)
+}
+
+// AddressChecker rejects user tokens used outside the allowed ips of the
+// user, usually the AuthService
+type AddressChecker interface {
+	CheckAllowedIP(ctx context.Context, claims *models.Claims, ip string) error
+}
+
+// Server implements envoy.service.auth.v3.Authorization for Envoy's
+// ext_authz filter
+type Server struct {
+	authv3.UnimplementedAuthorizationServer
+	Verifier TokenVerifier
+	// Addresses checks the downstream address, nil skips the check
+	Addresses AddressChecker
+	Cfg       *config.Config
+}
+
+func New(verifier TokenVerifier, cfg *config.Config) *Server {
+	return &Server{
+		Verifier: verifier,
+		Cfg:      cfg,
+	}
+}
+
+func (s *Server) Check(ctx context.Context, req *authv3.CheckRequest) (*authv3.CheckResponse, error) {
+	const op = "grpc/extauthz/extauthz.go/Check"
+
+	request := req.GetAttributes().GetRequest().GetHttp()
+	path, _, _ := strings.Cut(request.GetPath(), "?")
+
+	rule := matchRule(s.Cfg.ExtAuthz.Rules, path)
+	if rule != nil && rule.Public {
+		return s.allow(nil), nil
+	}
+
+	token := extractToken(request.GetHeaders(), s.Cfg.ForwardAuth.CookieName)
+	if token == "" {
+		slog.Debug("Ext authz denied: missing token",
+			slog.String("op", op),
+			slog.String("path", path),
+		)
+		return deny(codes.Unauthenticated, http.StatusUnauthorized, apperrors.ErrUnauthorized), nil
+	}
+
+	claims, err := s.Verifier.ValidateJWT(ctx, token)
+	if err != nil {
+		slog.Debug("Ext authz denied: invalid token",
+			slog.String("op", op),
+			slog.String("path", path),
+			slog.String("error", err.Error()),
+		)
+		return deny(codes.Unauthenticated, http.StatusUnauthorized, apperrors.ErrInvalidToken), nil
+	}
+
+	if s.Addresses != nil {
+		ip := req.GetAttributes().GetSource().GetAddress().GetSocketAddress().GetAddress()
+		if err := s.Addresses.CheckAllowedIP(ctx, claims, ip); err != nil {
+			slog.Debug("Ext authz denied: address not allowed",
+				slog.String("op", op),
+				slog.String("path", path),
+				slog.String("user_id", claims.ID),
+				slog.String("error", err.Error()),
+			)
+			if errors.Is(err, apperrors.ErrIPNotAllowed) {
+				return deny(codes.PermissionDenied, http.StatusForbidden, apperrors.ErrIPNotAllowed), nil
+			}
+			return deny(codes.Unauthenticated, http.StatusUnauthorized, apperrors.ErrInvalidToken), nil
+		}
+	}
+
+	if rule != nil {
+		if err := permitted(rule, claims); err != nil {
+			slog.Debug("Ext authz denied",
+				slog.String("op", op),
+				slog.String("path", path),
+				slog.String("user_id", claims.ID),
+				slog.String("error", err.Error()),
+			)
+			return deny(codes.PermissionDenied, http.StatusForbidden, err), nil
+		}
+	}
+
+	return s.allow(claims), nil
+}
+
+// matchRule returns the rule with the longest prefix of path
+func matchRule(rules []config.RouteRule, path string) *config.RouteRule {
+	var match *config.RouteRule
+	for i := range rules {
+		if config.PathHasPrefix(path, rules[i].Prefix) && (match == nil || len(rules[i].Prefix) > len(match.Prefix)) {
+			match = &rules[i]
+		}
+	}
+
+	return match
+}
+
+func permitted(rule *config.RouteRule, claims *models.Claims) error {
+	for _, role := range rule.Roles {
+		if !slices.Contains(claims.Roles, role) {
+			return apperrors.ErrMissingRole
+		}
+	}
+
+	scopes := claims.Scopes()
+	for _, scope := range rule.Scopes {
+		if !slices.Contains(scopes, scope) {
+			return apperrors.ErrMissingScope
+		}
+	}
+
+	return nil
+}
+
+// extractToken reads the bearer token, falling back to the token cookie
+func extractToken(headers map[string]string, cookieName string) string {
+	request := &http.Request{Header: http.Header{}}
+	for name, value := range headers {
+		request.Header.Set(name, value)
+	}
+
+	if parts := strings.Split(request.Header.Get("Authorization"), " "); len(parts) == 2 && parts[0] == "Bearer" {
+		return parts[1]
+	}
+
+	if cookieName != "" {
+		if cookie, err := request.Cookie(cookieName); err == nil {
+			return cookie.Value
+		}
+	}
+
+	return ""
+}
+
+// allow injects the identity headers. Every configured header is either
+// overwritten or removed, so clients can't spoof them.
+func (s *Server) allow(claims *models.Claims) *authv3.CheckResponse {
+	if claims == nil {
+		claims = &models.Claims{}
+	}
+
+	names := s.Cfg.ForwardAuth.Headers
+	response := &authv3.OkHttpResponse{}
+	for _, header := range []struct{ name, value string }{
+		{names.UserID, claims.ID},
+		{names.Email, claims.Email},
+		{names.Nickname, claims.Nickname},
+		{names.Roles, strings.Join(claims.Roles, ",")},
+	} {
+		switch {
+		case header.name == "":
+		case header.value == "":
+			response.HeadersToRemove = append(response.HeadersToRemove, header.name)
+		default:
+			response.Headers = append(response.Headers, &corev3.HeaderValueOption{
+				Header:       &corev3.HeaderValue{Key: header.name, Value: header.value},
+				AppendAction: corev3.HeaderValueOption_OVERWRITE_IF_EXISTS_OR_ADD,
+			})
+		}
+	}
+
+	return &authv3.CheckResponse{
+		Status:       &status.Status{Code: int32(codes.OK)},
+		HttpResponse: &authv3.CheckResponse_OkResponse{OkResponse: response},
+	}
+}
+
+// deny answers with the same JSON body as the HTTP API
+func deny(code codes.Code, httpStatus int, err error) *authv3.CheckResponse {
+	body, _ := json.Marshal(dto.NewErrorResponse(err))
+
+	return &authv3.CheckResponse{
+		Status: &status.Status{Code: int32(code), Message: err.Error()},
+		HttpResponse: &authv3.CheckResponse_DeniedResponse{
+			DeniedResponse: &authv3.DeniedHttpResponse{
+				Status: &typev3.HttpStatus{Code: typev3.StatusCode(httpStatus)},
+				Headers: []*corev3.HeaderValueOption{{
+					Header:       &corev3.HeaderValue{Key: "Content-Type", Value: "application/json"},
+					AppendAction: corev3.HeaderValueOption_OVERWRITE_IF_EXISTS_OR_ADD,
+				}},
+				Body: string(body),
+			},
+		},
+	}
+}
@@ -0,0 +1,257 @@
+package extauthz_test
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"testing"
+
+	"github.com/alonsoF100/authorization-service/internal/apperrors"
+	"github.com/alonsoF100/authorization-service/internal/config"
+	"github.com/alonsoF100/authorization-service/internal/models"
+	"github.com/alonsoF100/authorization-service/internal/transport/grpc/extauthz"
+	"github.com/alonsoF100/authorization-service/internal/transport/http/dto"
+	corev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	authv3 "github.com/envoyproxy/go-control-plane/envoy/service/auth/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+type verifier map[string]*models.Claims
+
+func (v verifier) ValidateJWT(ctx context.Context, token string) (*models.Claims, error) {
+	if claims, ok := v[token]; ok {
+		return claims, nil
+	}
+	return nil, apperrors.ErrInvalidToken
+}
+
+// addresses allows user123 only from 192.0.2.1
+type addresses struct{}
+
+func (addresses) CheckAllowedIP(ctx context.Context, claims *models.Claims, ip string) error {
+	if claims.ID == "user123" && ip != "192.0.2.1" {
+		return apperrors.ErrIPNotAllowed
+	}
+	return nil
+}
+
+func newClient(t *testing.T, srv *extauthz.Server) authv3.AuthorizationClient {
+	lis := bufconn.Listen(1024 * 1024)
+
+	grpcServer := grpc.NewServer()
+	authv3.RegisterAuthorizationServer(grpcServer, srv)
+	go grpcServer.Serve(lis)
+	t.Cleanup(grpcServer.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	return authv3.NewAuthorizationClient(conn)
+}
+
+func checkRequest(path string, headers map[string]string) *authv3.CheckRequest {
+	return &authv3.CheckRequest{
+		Attributes: &authv3.AttributeContext{
+			Request: &authv3.AttributeContext_Request{
+				Http: &authv3.AttributeContext_HttpRequest{
+					Method:  "GET",
+					Path:    path,
+					Headers: headers,
+				},
+			},
+		},
+	}
+}
+
+func TestCheck(t *testing.T) {
+	cfg := &config.Config{
+		ForwardAuth: config.ForwardAuthConfig{
+			Headers: config.ForwardAuthHeaders{
+				UserID: "x-user-id",
+				Email:  "x-user-email",
+				Roles:  "x-user-roles",
+			},
+			CookieName: "access_token",
+		},
+		ExtAuthz: config.ExtAuthzConfig{
+			Enabled: true,
+			Rules: []config.RouteRule{
+				{Prefix: "/public", Public: true},
+				{Prefix: "/admin", Roles: []string{models.RoleAdmin}},
+				{Prefix: "/admin/reports", Scopes: []string{"reports:read"}},
+			},
+		},
+	}
+
+	client := newClient(t, extauthz.New(verifier{
+		"user":  {ID: "user123", Email: "user@mail.ru"},
+		"admin": {ID: "admin123", Email: "admin@mail.ru", Roles: []string{models.RoleAdmin}},
+		"robot": {ID: "robot123", Scope: "reports:read reports:write"},
+	}, cfg))
+
+	tests := []struct {
+		name        string
+		path        string
+		headers     map[string]string
+		wantCode    codes.Code
+		wantHTTP    int
+		wantError   error
+		wantHeaders map[string]string
+		wantRemoved []string
+	}{
+		{
+			name:        "public path",
+			path:        "/public/docs",
+			wantCode:    codes.OK,
+			wantRemoved: []string{"x-user-id", "x-user-email", "x-user-roles"},
+		},
+		{
+			name:      "prefix matches whole segments",
+			path:      "/publicity",
+			wantCode:  codes.Unauthenticated,
+			wantHTTP:  401,
+			wantError: apperrors.ErrUnauthorized,
+		},
+		{
+			name:      "missing token",
+			path:      "/orders",
+			wantCode:  codes.Unauthenticated,
+			wantHTTP:  401,
+			wantError: apperrors.ErrUnauthorized,
+		},
+		{
+			name:      "invalid token",
+			path:      "/orders",
+			headers:   map[string]string{"authorization": "Bearer forged"},
+			wantCode:  codes.Unauthenticated,
+			wantHTTP:  401,
+			wantError: apperrors.ErrInvalidToken,
+		},
+		{
+			name:        "valid token without rule",
+			path:        "/orders?page=2",
+			headers:     map[string]string{"authorization": "Bearer user", "x-user-id": "spoofed"},
+			wantCode:    codes.OK,
+			wantHeaders: map[string]string{"x-user-id": "user123", "x-user-email": "user@mail.ru"},
+			wantRemoved: []string{"x-user-roles"},
+		},
+		{
+			name:        "token cookie",
+			path:        "/orders",
+			headers:     map[string]string{"cookie": "theme=dark; access_token=user"},
+			wantCode:    codes.OK,
+			wantHeaders: map[string]string{"x-user-id": "user123"},
+		},
+		{
+			name:      "missing role",
+			path:      "/admin/users",
+			headers:   map[string]string{"authorization": "Bearer user"},
+			wantCode:  codes.PermissionDenied,
+			wantHTTP:  403,
+			wantError: apperrors.ErrMissingRole,
+		},
+		{
+			name:        "required role",
+			path:        "/admin/users",
+			headers:     map[string]string{"authorization": "Bearer admin"},
+			wantCode:    codes.OK,
+			wantHeaders: map[string]string{"x-user-id": "admin123", "x-user-roles": "admin"},
+		},
+		{
+			name:      "longest prefix wins",
+			path:      "/admin/reports/2025",
+			headers:   map[string]string{"authorization": "Bearer admin"},
+			wantCode:  codes.PermissionDenied,
+			wantHTTP:  403,
+			wantError: apperrors.ErrMissingScope,
+		},
+		{
+			name:        "required scope",
+			path:        "/admin/reports/2025",
+			headers:     map[string]string{"authorization": "Bearer robot"},
+			wantCode:    codes.OK,
+			wantHeaders: map[string]string{"x-user-id": "robot123"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := client.Check(context.Background(), checkRequest(tt.path, tt.headers))
+			require.NoError(t, err)
+			require.Equal(t, int32(tt.wantCode), resp.GetStatus().GetCode())
+
+			if tt.wantCode != codes.OK {
+				denied := resp.GetDeniedResponse()
+				require.NotNil(t, denied)
+				require.EqualValues(t, tt.wantHTTP, denied.GetStatus().GetCode())
+
+				var body dto.ErrorResponse
+				require.NoError(t, json.Unmarshal([]byte(denied.GetBody()), &body))
+				require.Equal(t, tt.wantError.Error(), body.Error)
+				return
+			}
+
+			ok := resp.GetOkResponse()
+			require.NotNil(t, ok)
+
+			headers := make(map[string]string)
+			for _, header := range ok.GetHeaders() {
+				headers[header.GetHeader().GetKey()] = header.GetHeader().GetValue()
+			}
+			for name, value := range tt.wantHeaders {
+				require.Equal(t, value, headers[name], name)
+			}
+			for _, name := range tt.wantRemoved {
+				require.Contains(t, ok.GetHeadersToRemove(), name)
+			}
+		})
+	}
+}
+
+func TestCheckAllowedIP(t *testing.T) {
+	cfg := &config.Config{
+		ForwardAuth: config.ForwardAuthConfig{
+			Headers: config.ForwardAuthHeaders{UserID: "x-user-id"},
+		},
+		ExtAuthz: config.ExtAuthzConfig{Enabled: true},
+	}
+
+	srv := extauthz.New(verifier{"user": {ID: "user123"}}, cfg)
+	srv.Addresses = addresses{}
+	client := newClient(t, srv)
+
+	withSource := func(ip string) *authv3.CheckRequest {
+		req := checkRequest("/orders", map[string]string{"authorization": "Bearer user"})
+		req.Attributes.Source = &authv3.AttributeContext_Peer{
+			Address: &corev3.Address{
+				Address: &corev3.Address_SocketAddress{
+					SocketAddress: &corev3.SocketAddress{Address: ip},
+				},
+			},
+		}
+		return req
+	}
+
+	resp, err := client.Check(context.Background(), withSource("192.0.2.1"))
+	require.NoError(t, err)
+	require.Equal(t, int32(codes.OK), resp.GetStatus().GetCode())
+
+	resp, err = client.Check(context.Background(), withSource("198.51.100.9"))
+	require.NoError(t, err)
+	require.Equal(t, int32(codes.PermissionDenied), resp.GetStatus().GetCode())
+	require.EqualValues(t, 403, resp.GetDeniedResponse().GetStatus().GetCode())
+
+	var body dto.ErrorResponse
+	require.NoError(t, json.Unmarshal([]byte(resp.GetDeniedResponse().GetBody()), &body))
+	require.Equal(t, apperrors.ErrIPNotAllowed.Error(), body.Error)
+}
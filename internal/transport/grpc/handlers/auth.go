@@ -0,0 +1,136 @@
+package handlers
+
+import (
+	"context"
+	"log/slog"
+
+	authv1 "github.com/alonsoF100/authorization-service/api/auth/v1"
+	"github.com/alonsoF100/authorization-service/internal/apperrors"
+	"github.com/alonsoF100/authorization-service/internal/transport/grpc/interceptor"
+	"github.com/alonsoF100/authorization-service/internal/transport/http/dto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+/*
+method: /auth.v1.AuthService/SignUp
+info: public
+
+succeed: created user
+
+failed: InvalidArgument, AlreadyExists, Internal
+*/
+func (h Handler) SignUp(ctx context.Context, req *authv1.SignUpRequest) (*authv1.SignUpResponse, error) {
+	const op = "grpc/handlers/auth.go/SignUp"
+
+	request := dto.SignUpRequest{
+		Nickname: req.GetNickname(),
+		Email:    req.GetEmail(),
+		Password: req.GetPassword(),
+	}
+	if err := h.Validator.Struct(request); err != nil {
+		slog.Warn("Failed to validate request",
+			slog.String("op", op),
+			slog.String("error", err.Error()),
+		)
+		return nil, statusError(apperrors.ErrFailedToValidate)
+	}
+
+	user, err := h.AuthService.SignUp(
+		ctx,
+		request.Nickname,
+		request.Email,
+		request.Password,
+	)
+	if err != nil {
+		slog.Debug("Failed to sign up",
+			slog.String("op", op),
+			slog.String("email", request.Email),
+			slog.String("nickname", request.Nickname),
+			slog.String("error", err.Error()),
+		)
+		return nil, statusError(err)
+	}
+
+	return &authv1.SignUpResponse{
+		Id:        user.ID,
+		Nickname:  user.Nickname,
+		Email:     user.Email,
+		CreatedAt: timestamppb.New(user.CreatedAt),
+	}, nil
+}
+
+/*
+method: /auth.v1.AuthService/SignIn
+info: public
+
+succeed: JWT token
+
+failed: InvalidArgument, Unauthenticated, PermissionDenied, Internal
+*/
+func (h Handler) SignIn(ctx context.Context, req *authv1.SignInRequest) (*authv1.SignInResponse, error) {
+	const op = "grpc/handlers/auth.go/SignIn"
+
+	request := dto.SignInRequest{
+		Email:    req.GetEmail(),
+		Password: req.GetPassword(),
+	}
+	if err := h.Validator.Struct(request); err != nil {
+		slog.Warn("Failed to validate request",
+			slog.String("op", op),
+			slog.String("error", err.Error()),
+		)
+		return nil, statusError(apperrors.ErrFailedToValidate)
+	}
+
+	userAgent, ip := interceptor.CallerInfo(ctx)
+	token, err := h.AuthService.SignIn(
+		ctx,
+		request.Email,
+		request.Password,
+		userAgent,
+		ip,
+	)
+	if err != nil {
+		slog.Debug("Authentication failed",
+			slog.String("op", op),
+			slog.String("email", request.Email),
+			slog.String("error", err.Error()),
+		)
+		return nil, statusError(err)
+	}
+
+	return &authv1.SignInResponse{Token: token}, nil
+}
+
+/*
+method: /auth.v1.AuthService/ValidateToken
+info: public, lets other services check a token without sharing the keys
+
+succeed: token claims
+
+failed: Unauthenticated
+*/
+func (h Handler) ValidateToken(ctx context.Context, req *authv1.ValidateTokenRequest) (*authv1.ValidateTokenResponse, error) {
+	const op = "grpc/handlers/auth.go/ValidateToken"
+
+	claims, err := h.AuthService.ValidateJWT(ctx, req.GetToken())
+	if err != nil {
+		slog.Debug("Token validation failed",
+			slog.String("op", op),
+			slog.String("error", err.Error()),
+		)
+		return nil, statusError(apperrors.ErrInvalidToken)
+	}
+
+	response := &authv1.ValidateTokenResponse{
+		UserId:   claims.ID,
+		Email:    claims.Email,
+		Nickname: claims.Nickname,
+		Roles:    claims.Roles,
+	}
+	if claims.ExpiresAt != nil {
+		response.ExpiresAt = timestamppb.New(claims.ExpiresAt.Time)
+	}
+
+	return response, nil
+}
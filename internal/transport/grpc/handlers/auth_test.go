@@ -0,0 +1,208 @@
+package handlers_test
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	authv1 "github.com/alonsoF100/authorization-service/api/auth/v1"
+	"github.com/alonsoF100/authorization-service/internal/apperrors"
+	"github.com/alonsoF100/authorization-service/internal/models"
+	"github.com/alonsoF100/authorization-service/internal/transport/grpc/handlers"
+	"github.com/gojuno/minimock/v3"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+func TestSignUp(t *testing.T) {
+	mc := minimock.NewController(t)
+	mockService := handlers.NewAuthServiceMock(mc)
+	h := handlers.New(mockService, nil)
+
+	createdAt := time.Now().UTC()
+
+	tests := []struct {
+		name      string
+		req       *authv1.SignUpRequest
+		mockSetup func(ctx context.Context)
+		wantCode  codes.Code
+	}{
+		{
+			name: "success",
+			req:  &authv1.SignUpRequest{Nickname: "alonso", Email: "alonso@mail.ru", Password: "password123"},
+			mockSetup: func(ctx context.Context) {
+				mockService.SignUpMock.Expect(ctx, "alonso", "alonso@mail.ru", "password123").
+					Return(&models.User{ID: "user123", Nickname: "alonso", Email: "alonso@mail.ru", CreatedAt: createdAt}, nil)
+			},
+			wantCode: codes.OK,
+		},
+		{
+			name:      "invalid email",
+			req:       &authv1.SignUpRequest{Nickname: "alonso", Email: "alonso", Password: "password123"},
+			mockSetup: func(ctx context.Context) {},
+			wantCode:  codes.InvalidArgument,
+		},
+		{
+			name: "email exists",
+			req:  &authv1.SignUpRequest{Nickname: "alonso", Email: "alonso@mail.ru", Password: "password123"},
+			mockSetup: func(ctx context.Context) {
+				mockService.SignUpMock.Expect(ctx, "alonso", "alonso@mail.ru", "password123").
+					Return(nil, apperrors.ErrEmailExist)
+			},
+			wantCode: codes.AlreadyExists,
+		},
+		{
+			name: "password outside the policy",
+			req:  &authv1.SignUpRequest{Nickname: "alonso", Email: "alonso@mail.ru", Password: "short"},
+			mockSetup: func(ctx context.Context) {
+				mockService.SignUpMock.Expect(ctx, "alonso", "alonso@mail.ru", "short").
+					Return(nil, apperrors.ErrWeakPassword)
+			},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name: "service error",
+			req:  &authv1.SignUpRequest{Nickname: "alonso", Email: "alonso@mail.ru", Password: "password123"},
+			mockSetup: func(ctx context.Context) {
+				mockService.SignUpMock.Expect(ctx, "alonso", "alonso@mail.ru", "password123").
+					Return(nil, errors.New("db error"))
+			},
+			wantCode: codes.Internal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			tt.mockSetup(ctx)
+
+			resp, err := h.SignUp(ctx, tt.req)
+
+			require.Equal(t, tt.wantCode, status.Code(err))
+			if tt.wantCode == codes.OK {
+				require.Equal(t, "user123", resp.GetId())
+				require.Equal(t, "alonso", resp.GetNickname())
+				require.Equal(t, createdAt, resp.GetCreatedAt().AsTime())
+			}
+		})
+	}
+}
+
+func TestSignIn(t *testing.T) {
+	mc := minimock.NewController(t)
+	mockService := handlers.NewAuthServiceMock(mc)
+	h := handlers.New(mockService, nil)
+
+	tests := []struct {
+		name      string
+		req       *authv1.SignInRequest
+		mockSetup func(ctx context.Context)
+		wantCode  codes.Code
+		wantError error
+	}{
+		{
+			name: "success",
+			req:  &authv1.SignInRequest{Email: "alonso@mail.ru", Password: "password123"},
+			mockSetup: func(ctx context.Context) {
+				mockService.SignInMock.Expect(ctx, "alonso@mail.ru", "password123", "", "").Return("token", nil)
+			},
+			wantCode: codes.OK,
+		},
+		{
+			name:      "missing password",
+			req:       &authv1.SignInRequest{Email: "alonso@mail.ru"},
+			mockSetup: func(ctx context.Context) {},
+			wantCode:  codes.InvalidArgument,
+			wantError: apperrors.ErrFailedToValidate,
+		},
+		{
+			name: "invalid credentials",
+			req:  &authv1.SignInRequest{Email: "alonso@mail.ru", Password: "password123"},
+			mockSetup: func(ctx context.Context) {
+				mockService.SignInMock.Expect(ctx, "alonso@mail.ru", "password123", "", "").Return("", apperrors.ErrInvalidCredentials)
+			},
+			wantCode:  codes.Unauthenticated,
+			wantError: apperrors.ErrInvalidCredentials,
+		},
+		{
+			name: "user disabled",
+			req:  &authv1.SignInRequest{Email: "alonso@mail.ru", Password: "password123"},
+			mockSetup: func(ctx context.Context) {
+				mockService.SignInMock.Expect(ctx, "alonso@mail.ru", "password123", "", "").Return("", apperrors.ErrUserDisabled)
+			},
+			wantCode:  codes.PermissionDenied,
+			wantError: apperrors.ErrUserDisabled,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			tt.mockSetup(ctx)
+
+			resp, err := h.SignIn(ctx, tt.req)
+
+			require.Equal(t, tt.wantCode, status.Code(err))
+			if tt.wantError != nil {
+				require.Equal(t, tt.wantError.Error(), status.Convert(err).Message())
+				return
+			}
+			require.Equal(t, "token", resp.GetToken())
+		})
+	}
+}
+
+func TestSignInCallerInfo(t *testing.T) {
+	mc := minimock.NewController(t)
+	mockService := handlers.NewAuthServiceMock(mc)
+	h := handlers.New(mockService, nil)
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("user-agent", "grpc-go/1.70.0"))
+	ctx = peer.NewContext(ctx, &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("192.0.2.1"), Port: 4242}})
+	mockService.SignInMock.Expect(ctx, "alonso@mail.ru", "password123", "grpc-go/1.70.0", "192.0.2.1").Return("token", nil)
+
+	resp, err := h.SignIn(ctx, &authv1.SignInRequest{Email: "alonso@mail.ru", Password: "password123"})
+	require.NoError(t, err)
+	require.Equal(t, "token", resp.GetToken())
+}
+
+func TestValidateToken(t *testing.T) {
+	mc := minimock.NewController(t)
+	mockService := handlers.NewAuthServiceMock(mc)
+	h := handlers.New(mockService, nil)
+
+	ctx := context.Background()
+	expiresAt := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+
+	t.Run("valid token", func(t *testing.T) {
+		mockService.ValidateJWTMock.Expect(ctx, "valid").Return(&models.Claims{
+			ID:       "user123",
+			Email:    "alonso@mail.ru",
+			Nickname: "alonso",
+			Roles:    []string{models.RoleAdmin},
+			RegisteredClaims: jwt.RegisteredClaims{
+				ExpiresAt: jwt.NewNumericDate(expiresAt),
+			},
+		}, nil)
+
+		resp, err := h.ValidateToken(ctx, &authv1.ValidateTokenRequest{Token: "valid"})
+		require.NoError(t, err)
+		require.Equal(t, "user123", resp.GetUserId())
+		require.Equal(t, []string{models.RoleAdmin}, resp.GetRoles())
+		require.Equal(t, expiresAt, resp.GetExpiresAt().AsTime())
+	})
+
+	t.Run("invalid token", func(t *testing.T) {
+		mockService.ValidateJWTMock.Expect(ctx, "invalid").Return(nil, errors.New("signature is invalid"))
+
+		_, err := h.ValidateToken(ctx, &authv1.ValidateTokenRequest{Token: "invalid"})
+		require.Equal(t, codes.Unauthenticated, status.Code(err))
+		require.Equal(t, apperrors.ErrInvalidToken.Error(), status.Convert(err).Message())
+	})
+}
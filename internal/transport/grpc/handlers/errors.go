@@ -0,0 +1,41 @@
+package handlers
+
+import (
+	"errors"
+
+	"github.com/alonsoF100/authorization-service/internal/apperrors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var errorCodes = []struct {
+	err  error
+	code codes.Code
+}{
+	{apperrors.ErrUserExist, codes.AlreadyExists},
+	{apperrors.ErrEmailExist, codes.AlreadyExists},
+	{apperrors.ErrUserNotFoundByID, codes.NotFound},
+	{apperrors.ErrUserNotFoundByMail, codes.NotFound},
+	{apperrors.ErrUserDisabled, codes.PermissionDenied},
+	{apperrors.ErrIPNotAllowed, codes.PermissionDenied},
+	{apperrors.ErrInvalidCredentials, codes.Unauthenticated},
+	{apperrors.ErrInvalidToken, codes.Unauthenticated},
+	{apperrors.ErrUnauthorized, codes.Unauthenticated},
+	{apperrors.ErrClientPrincipal, codes.PermissionDenied},
+	{apperrors.ErrMissingScope, codes.PermissionDenied},
+	{apperrors.ErrFailedToDecode, codes.InvalidArgument},
+	{apperrors.ErrFailedToValidate, codes.InvalidArgument},
+	{apperrors.ErrWeakPassword, codes.InvalidArgument},
+}
+
+// statusError converts an apperrors error to a gRPC status, anything
+// unknown becomes Internal without leaking the cause to the client
+func statusError(err error) error {
+	for _, e := range errorCodes {
+		if errors.Is(err, e.err) {
+			return status.Error(e.code, e.err.Error())
+		}
+	}
+
+	return status.Error(codes.Internal, apperrors.ErrServer.Error())
+}
@@ -0,0 +1,45 @@
+package handlers
+
+import (
+	"context"
+	"sync/atomic"
+
+	authv1 "github.com/alonsoF100/authorization-service/api/auth/v1"
+	"github.com/alonsoF100/authorization-service/internal/config"
+	"github.com/alonsoF100/authorization-service/internal/models"
+	"github.com/alonsoF100/authorization-service/internal/transport/grpc/interceptor"
+	"github.com/go-playground/validator/v10"
+)
+
+type AuthService interface {
+	SignUp(ctx context.Context, nickname, email, password string) (*models.User, error)
+	SignIn(ctx context.Context, email, password, userAgent, ip string) (string, error)
+	ValidateJWT(ctx context.Context, tokenString string) (*models.Claims, error)
+	CheckAllowedIP(ctx context.Context, claims *models.Claims, ip string) error
+}
+
+type UserService interface {
+	GetUser(ctx context.Context, userID string) (*models.User, error)
+	DeleteUser(ctx context.Context, userID string) error
+}
+
+type Handler struct {
+	authv1.UnimplementedAuthServiceServer
+	AuthService AuthService
+	UserService UserService
+	Validator   *validator.Validate
+	// LiveConfig holds the config as reloaded at runtime, the rate limits
+	// are read from it. Nil keeps the config the server was built with.
+	LiveConfig *atomic.Pointer[config.Config]
+	// RateLimitStore backs the rate limits of SignUp and SignIn, nil turns
+	// them off
+	RateLimitStore interceptor.RateLimitStore
+}
+
+func New(authService AuthService, userService UserService) *Handler {
+	return &Handler{
+		AuthService: authService,
+		UserService: userService,
+		Validator:   validator.New(),
+	}
+}
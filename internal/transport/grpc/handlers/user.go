@@ -0,0 +1,90 @@
+package handlers
+
+import (
+	"context"
+	"log/slog"
+
+	authv1 "github.com/alonsoF100/authorization-service/api/auth/v1"
+	"github.com/alonsoF100/authorization-service/internal/apperrors"
+	"github.com/alonsoF100/authorization-service/internal/models"
+	"github.com/alonsoF100/authorization-service/internal/transport/grpc/interceptor"
+)
+
+/*
+method: /auth.v1.AuthService/GetUser
+info: barer token in authorization metadata
+
+succeed: user of the token
+
+failed: Unauthenticated, PermissionDenied for client tokens and missing
+scopes, NotFound, Internal
+*/
+func (h Handler) GetUser(ctx context.Context, _ *authv1.GetUserRequest) (*authv1.GetUserResponse, error) {
+	const op = "grpc/handlers/user.go/GetUser"
+
+	claims, ok := interceptor.GetUserFromContext(ctx)
+	if !ok {
+		slog.Error("User claims not found in context",
+			slog.String("op", op))
+		return nil, statusError(apperrors.ErrUnauthorized)
+	}
+	if claims.Principal() == models.PrincipalClient {
+		return nil, statusError(apperrors.ErrClientPrincipal)
+	}
+	if !claims.AllowsScope(models.ScopeRead) {
+		return nil, statusError(apperrors.ErrMissingScope)
+	}
+
+	user, err := h.UserService.GetUser(ctx, claims.ID)
+	if err != nil {
+		slog.Debug("Failed to get user",
+			slog.String("op", op),
+			slog.String("user_id", claims.ID),
+			slog.String("error", err.Error()),
+		)
+		return nil, statusError(err)
+	}
+
+	return &authv1.GetUserResponse{
+		Id:       user.ID,
+		Nickname: user.Nickname,
+		Email:    user.Email,
+	}, nil
+}
+
+/*
+method: /auth.v1.AuthService/DeleteUser
+info: barer token in authorization metadata
+
+succeed: empty response
+
+failed: Unauthenticated, PermissionDenied for client tokens and missing
+scopes, NotFound, Internal
+*/
+func (h Handler) DeleteUser(ctx context.Context, _ *authv1.DeleteUserRequest) (*authv1.DeleteUserResponse, error) {
+	const op = "grpc/handlers/user.go/DeleteUser"
+
+	claims, ok := interceptor.GetUserFromContext(ctx)
+	if !ok {
+		slog.Error("User claims not found in context",
+			slog.String("op", op))
+		return nil, statusError(apperrors.ErrUnauthorized)
+	}
+	if claims.Principal() == models.PrincipalClient {
+		return nil, statusError(apperrors.ErrClientPrincipal)
+	}
+	if !claims.AllowsScope(models.ScopeWrite) {
+		return nil, statusError(apperrors.ErrMissingScope)
+	}
+
+	if err := h.UserService.DeleteUser(ctx, claims.ID); err != nil {
+		slog.Debug("Failed to delete user",
+			slog.String("op", op),
+			slog.String("user_id", claims.ID),
+			slog.String("error", err.Error()),
+		)
+		return nil, statusError(err)
+	}
+
+	return &authv1.DeleteUserResponse{}, nil
+}
@@ -0,0 +1,139 @@
+package handlers_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	authv1 "github.com/alonsoF100/authorization-service/api/auth/v1"
+	"github.com/alonsoF100/authorization-service/internal/apperrors"
+	"github.com/alonsoF100/authorization-service/internal/models"
+	"github.com/alonsoF100/authorization-service/internal/transport/grpc/handlers"
+	"github.com/alonsoF100/authorization-service/internal/transport/grpc/interceptor"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGetUser(t *testing.T) {
+	mc := minimock.NewController(t)
+	mockService := handlers.NewUserServiceMock(mc)
+	h := handlers.New(nil, mockService)
+
+	tests := []struct {
+		name      string
+		claims    *models.Claims
+		mockSetup func(ctx context.Context)
+		wantCode  codes.Code
+	}{
+		{
+			name:   "success",
+			claims: &models.Claims{ID: "user123"},
+			mockSetup: func(ctx context.Context) {
+				mockService.GetUserMock.Expect(ctx, "user123").
+					Return(&models.User{ID: "user123", Nickname: "alonso", Email: "alonso@mail.ru"}, nil)
+			},
+			wantCode: codes.OK,
+		},
+		{
+			name:      "no claims in context",
+			mockSetup: func(ctx context.Context) {},
+			wantCode:  codes.Unauthenticated,
+		},
+		{
+			name:      "client token",
+			claims:    &models.Claims{ClientID: "client123", PrincipalType: models.PrincipalClient},
+			mockSetup: func(ctx context.Context) {},
+			wantCode:  codes.PermissionDenied,
+		},
+		{
+			name:   "user not found",
+			claims: &models.Claims{ID: "user123"},
+			mockSetup: func(ctx context.Context) {
+				mockService.GetUserMock.Expect(ctx, "user123").Return(nil, apperrors.ErrUserNotFoundByID)
+			},
+			wantCode: codes.NotFound,
+		},
+		{
+			name:   "service error",
+			claims: &models.Claims{ID: "user123"},
+			mockSetup: func(ctx context.Context) {
+				mockService.GetUserMock.Expect(ctx, "user123").Return(nil, errors.New("db error"))
+			},
+			wantCode: codes.Internal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.claims != nil {
+				ctx = context.WithValue(ctx, interceptor.UserContextKey, tt.claims)
+			}
+			tt.mockSetup(ctx)
+
+			resp, err := h.GetUser(ctx, &authv1.GetUserRequest{})
+
+			require.Equal(t, tt.wantCode, status.Code(err))
+			if tt.wantCode == codes.OK {
+				require.Equal(t, "alonso@mail.ru", resp.GetEmail())
+			}
+		})
+	}
+}
+
+func TestDeleteUser(t *testing.T) {
+	mc := minimock.NewController(t)
+	mockService := handlers.NewUserServiceMock(mc)
+	h := handlers.New(nil, mockService)
+
+	tests := []struct {
+		name      string
+		claims    *models.Claims
+		mockSetup func(ctx context.Context)
+		wantCode  codes.Code
+	}{
+		{
+			name:   "success",
+			claims: &models.Claims{ID: "user123"},
+			mockSetup: func(ctx context.Context) {
+				mockService.DeleteUserMock.Expect(ctx, "user123").Return(nil)
+			},
+			wantCode: codes.OK,
+		},
+		{
+			name:      "no claims in context",
+			mockSetup: func(ctx context.Context) {},
+			wantCode:  codes.Unauthenticated,
+		},
+		{
+			name:      "client token",
+			claims:    &models.Claims{ClientID: "client123", PrincipalType: models.PrincipalClient},
+			mockSetup: func(ctx context.Context) {},
+			wantCode:  codes.PermissionDenied,
+		},
+		{
+			name:   "user not found",
+			claims: &models.Claims{ID: "user123"},
+			mockSetup: func(ctx context.Context) {
+				mockService.DeleteUserMock.Expect(ctx, "user123").Return(apperrors.ErrUserNotFoundByID)
+			},
+			wantCode: codes.NotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.claims != nil {
+				ctx = context.WithValue(ctx, interceptor.UserContextKey, tt.claims)
+			}
+			tt.mockSetup(ctx)
+
+			_, err := h.DeleteUser(ctx, &authv1.DeleteUserRequest{})
+
+			require.Equal(t, tt.wantCode, status.Code(err))
+		})
+	}
+}
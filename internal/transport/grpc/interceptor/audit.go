@@ -0,0 +1,58 @@
+package interceptor
+
+import (
+	"context"
+	"net"
+
+	"github.com/alonsoF100/authorization-service/internal/audit"
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+const maxRequestIDLength = 128
+
+// AuditSource is the gRPC counterpart of middleware.RequestID, it attaches
+// the caller address, user agent and the "x-request-id" metadata, or a new
+// id, to the context. It has to run before Auth, which adds the actor.
+func AuditSource() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		var requestID string
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			if values := md.Get("x-request-id"); len(values) == 1 && len(values[0]) <= maxRequestIDLength {
+				requestID = values[0]
+			}
+		}
+		if requestID == "" {
+			requestID = uuid.New().String()
+		}
+
+		userAgent, ip := CallerInfo(ctx)
+		ctx = audit.WithSource(ctx, audit.Source{
+			IP:        ip,
+			UserAgent: userAgent,
+			RequestID: requestID,
+		})
+
+		return handler(ctx, req)
+	}
+}
+
+// CallerInfo returns the user agent and address of the caller
+func CallerInfo(ctx context.Context) (userAgent, ip string) {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get("user-agent"); len(values) > 0 {
+			userAgent = values[0]
+		}
+	}
+
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		ip = p.Addr.String()
+		if host, _, err := net.SplitHostPort(ip); err == nil {
+			ip = host
+		}
+	}
+
+	return userAgent, ip
+}
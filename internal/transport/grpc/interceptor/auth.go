@@ -0,0 +1,106 @@
+package interceptor
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+
+	"github.com/alonsoF100/authorization-service/internal/apperrors"
+	"github.com/alonsoF100/authorization-service/internal/audit"
+	"github.com/alonsoF100/authorization-service/internal/models"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type TokenValidator interface {
+	ValidateJWT(ctx context.Context, token string) (*models.Claims, error)
+	CheckAllowedIP(ctx context.Context, claims *models.Claims, ip string) error
+}
+
+type contextKey string
+
+const UserContextKey contextKey = "user"
+
+// Auth is the gRPC counterpart of middleware.Auth. Every unary method
+// except publicMethods requires a bearer token in the "authorization"
+// metadata, the claims are stored in the context.
+func Auth(tokenValidator TokenValidator, publicMethods ...string) grpc.UnaryServerInterceptor {
+	public := make(map[string]bool, len(publicMethods))
+	for _, method := range publicMethods {
+		public[method] = true
+	}
+
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		const op = "interceptor/auth.go/Auth"
+
+		if public[info.FullMethod] {
+			return handler(ctx, req)
+		}
+
+		token := ExtractToken(ctx)
+		if token == "" {
+			slog.Info("Authentication failed: missing authorization metadata",
+				slog.String("op", op),
+				slog.String("method", info.FullMethod),
+			)
+			return nil, status.Error(codes.Unauthenticated, "missing authorization metadata")
+		}
+
+		claims, err := tokenValidator.ValidateJWT(ctx, token)
+		if err != nil {
+			slog.Info("Authentication failed: invalid token",
+				slog.String("op", op),
+				slog.String("method", info.FullMethod),
+				slog.String("error", err.Error()),
+			)
+			return nil, status.Error(codes.Unauthenticated, apperrors.ErrInvalidToken.Error())
+		}
+
+		_, ip := CallerInfo(ctx)
+		if err := tokenValidator.CheckAllowedIP(ctx, claims, ip); err != nil {
+			slog.Info("Authentication failed: address not allowed for user",
+				slog.String("op", op),
+				slog.String("method", info.FullMethod),
+				slog.String("ip", ip),
+				slog.String("error", err.Error()),
+			)
+			if errors.Is(err, apperrors.ErrIPNotAllowed) {
+				return nil, status.Error(codes.PermissionDenied, apperrors.ErrIPNotAllowed.Error())
+			}
+			return nil, status.Error(codes.Unauthenticated, apperrors.ErrInvalidToken.Error())
+		}
+
+		ctx = context.WithValue(ctx, UserContextKey, claims)
+		ctx = audit.WithActor(ctx, string(claims.Principal()), claims.ID)
+
+		return handler(ctx, req)
+	}
+}
+
+func ExtractToken(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	values := md.Get("authorization")
+	if len(values) != 1 {
+		return ""
+	}
+
+	parts := strings.Split(values[0], " ")
+	if len(parts) != 2 || parts[0] != "Bearer" {
+		return ""
+	}
+
+	return parts[1]
+}
+
+func GetUserFromContext(ctx context.Context) (*models.Claims, bool) {
+	claims, ok := ctx.Value(UserContextKey).(*models.Claims)
+
+	return claims, ok
+}
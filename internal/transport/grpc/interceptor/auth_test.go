@@ -0,0 +1,135 @@
+package interceptor_test
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/alonsoF100/authorization-service/internal/apperrors"
+	"github.com/alonsoF100/authorization-service/internal/models"
+	"github.com/alonsoF100/authorization-service/internal/transport/grpc/interceptor"
+	"github.com/gojuno/minimock/v3"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+func TestExtractToken(t *testing.T) {
+	tests := []struct {
+		name string
+		md   metadata.MD
+		want string
+	}{
+		{"valid Bearer token", metadata.Pairs("authorization", "Bearer token"), "token"},
+		{"no metadata", nil, ""},
+		{"no authorization", metadata.Pairs("x-request-id", "1"), ""},
+		{"Bearer without token", metadata.Pairs("authorization", "Bearer"), ""},
+		{"wrong prefix", metadata.Pairs("authorization", "Basic token"), ""},
+		{"too many parts", metadata.Pairs("authorization", "Bearer token extra"), ""},
+		{"several values", metadata.Pairs("authorization", "Bearer a", "authorization", "Bearer b"), ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.md != nil {
+				ctx = metadata.NewIncomingContext(ctx, tt.md)
+			}
+
+			require.Equal(t, tt.want, interceptor.ExtractToken(ctx))
+		})
+	}
+}
+
+func TestAuth(t *testing.T) {
+	mc := minimock.NewController(t)
+	mockValidator := interceptor.NewTokenValidatorMock(mc)
+
+	testClaims := &models.Claims{
+		ID:       uuid.New().String(),
+		Nickname: "alonso",
+		Email:    "alonso@mail.ru",
+	}
+
+	tests := []struct {
+		name           string
+		method         string
+		authorization  string
+		setupMocks     func()
+		expectedCode   codes.Code
+		expectedClaims *models.Claims
+	}{
+		{
+			name:          "successful authentication",
+			method:        "/test.Service/Protected",
+			authorization: "Bearer valid_token",
+			setupMocks: func() {
+				mockValidator.ValidateJWTMock.ExpectTokenParam2("valid_token").Return(testClaims, nil)
+				mockValidator.CheckAllowedIPMock.ExpectIpParam3("192.0.2.1").Return(nil)
+			},
+			expectedCode:   codes.OK,
+			expectedClaims: testClaims,
+		},
+		{
+			name:          "address not allowed for user",
+			method:        "/test.Service/Protected",
+			authorization: "Bearer valid_token",
+			setupMocks: func() {
+				mockValidator.ValidateJWTMock.ExpectTokenParam2("valid_token").Return(testClaims, nil)
+				mockValidator.CheckAllowedIPMock.ExpectIpParam3("192.0.2.1").Return(apperrors.ErrIPNotAllowed)
+			},
+			expectedCode: codes.PermissionDenied,
+		},
+		{
+			name:         "missing authorization",
+			method:       "/test.Service/Protected",
+			setupMocks:   func() {},
+			expectedCode: codes.Unauthenticated,
+		},
+		{
+			name:          "invalid token",
+			method:        "/test.Service/Protected",
+			authorization: "Bearer invalid_token",
+			setupMocks: func() {
+				mockValidator.ValidateJWTMock.ExpectTokenParam2("invalid_token").Return(nil, apperrors.ErrInvalidToken)
+			},
+			expectedCode: codes.Unauthenticated,
+		},
+		{
+			name:         "public method",
+			method:       "/test.Service/Public",
+			setupMocks:   func() {},
+			expectedCode: codes.OK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMocks()
+
+			ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("192.0.2.1"), Port: 1234}})
+			if tt.authorization != "" {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", tt.authorization))
+			}
+
+			var capturedClaims *models.Claims
+			handler := func(ctx context.Context, req any) (any, error) {
+				capturedClaims, _ = interceptor.GetUserFromContext(ctx)
+				return "ok", nil
+			}
+
+			auth := interceptor.Auth(mockValidator, "/test.Service/Public")
+			resp, err := auth(ctx, nil, &grpc.UnaryServerInfo{FullMethod: tt.method}, handler)
+
+			require.Equal(t, tt.expectedCode, status.Code(err))
+			if tt.expectedCode == codes.OK {
+				require.Equal(t, "ok", resp)
+			}
+			require.Equal(t, tt.expectedClaims, capturedClaims)
+		})
+	}
+}
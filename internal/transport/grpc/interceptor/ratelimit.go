@@ -0,0 +1,69 @@
+package interceptor
+
+import (
+	"context"
+	"log/slog"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/alonsoF100/authorization-service/internal/apperrors"
+	"github.com/alonsoF100/authorization-service/internal/config"
+	"github.com/alonsoF100/authorization-service/internal/ratelimit"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// RateLimitStore takes tokens from rate limit buckets, usually a
+// ratelimit.Store
+type RateLimitStore interface {
+	Take(ctx context.Context, key string, limit config.RateLimit) (ratelimit.Result, error)
+}
+
+// RateLimit is the gRPC counterpart of middleware.RateLimit with
+// middleware.ByIP. methods maps full method names to the limit they take
+// from, the buckets are shared with the HTTP routes of the same limit.
+// Other methods are not limited. The limit is read on every call, so it can
+// change on reload.
+func RateLimit(store RateLimitStore, limits func(name string) config.RateLimit, methods map[string]string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		const op = "interceptor/ratelimit.go/RateLimit"
+
+		name, ok := methods[info.FullMethod]
+		if !ok {
+			return handler(ctx, req)
+		}
+
+		limit := limits(name)
+		_, ip := CallerInfo(ctx)
+		if limit.Requests <= 0 || ip == "" {
+			return handler(ctx, req)
+		}
+
+		res, err := store.Take(ctx, name+":ip:"+ip, limit)
+		if err != nil {
+			slog.Warn("Rate limit store failed, request let through",
+				slog.String("op", op),
+				slog.String("limit", name),
+				slog.String("error", err.Error()),
+			)
+			return handler(ctx, req)
+		}
+
+		if !res.Allowed {
+			slog.Info("Rate limit exceeded",
+				slog.String("op", op),
+				slog.String("limit", name),
+				slog.String("method", info.FullMethod),
+				slog.String("ip", ip),
+			)
+			retryAfter := max(res.RetryAfter, time.Second)
+			_ = grpc.SetHeader(ctx, metadata.Pairs("retry-after", strconv.Itoa(int(math.Ceil(retryAfter.Seconds())))))
+			return nil, status.Error(codes.ResourceExhausted, apperrors.ErrTooManyRequests.Error())
+		}
+
+		return handler(ctx, req)
+	}
+}
@@ -0,0 +1,119 @@
+package server
+
+import (
+	"context"
+	"log/slog"
+	"net"
+
+	authv1 "github.com/alonsoF100/authorization-service/api/auth/v1"
+	"github.com/alonsoF100/authorization-service/internal/config"
+	"github.com/alonsoF100/authorization-service/internal/transport/grpc/extauthz"
+	"github.com/alonsoF100/authorization-service/internal/transport/grpc/handlers"
+	"github.com/alonsoF100/authorization-service/internal/transport/grpc/interceptor"
+	authv3 "github.com/envoyproxy/go-control-plane/envoy/service/auth/v3"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
+)
+
+// publicMethods can be called without a bearer token
+var publicMethods = []string{
+	authv1.AuthService_SignUp_FullMethodName,
+	authv1.AuthService_SignIn_FullMethodName,
+	authv1.AuthService_ValidateToken_FullMethodName,
+	authv3.Authorization_Check_FullMethodName,
+	healthpb.Health_Check_FullMethodName,
+	healthpb.Health_List_FullMethodName,
+}
+
+// rateLimitedMethods take from the bucket of the HTTP route they mirror
+var rateLimitedMethods = map[string]string{
+	authv1.AuthService_SignUp_FullMethodName: "register",
+	authv1.AuthService_SignIn_FullMethodName: "login",
+}
+
+type Server struct {
+	Server *grpc.Server
+	Health *health.Server
+	Cfg    *config.Config
+}
+
+// New builds the server, extAuthz is registered only when it is not nil
+func New(cfg *config.Config, handlers *handlers.Handler, extAuthz *extauthz.Server) *Server {
+	interceptors := []grpc.UnaryServerInterceptor{interceptor.AuditSource()}
+	if handlers.RateLimitStore != nil {
+		interceptors = append(interceptors, interceptor.RateLimit(handlers.RateLimitStore, func(name string) config.RateLimit {
+			current := cfg
+			if handlers.LiveConfig != nil {
+				if live := handlers.LiveConfig.Load(); live != nil {
+					current = live
+				}
+			}
+			return current.RateLimit.Limit(name)
+		}, rateLimitedMethods))
+	}
+	interceptors = append(interceptors, interceptor.Auth(handlers.AuthService, publicMethods...))
+
+	srv := grpc.NewServer(grpc.ChainUnaryInterceptor(interceptors...))
+
+	authv1.RegisterAuthServiceServer(srv, handlers)
+
+	healthServer := health.NewServer()
+	healthServer.SetServingStatus(authv1.AuthService_ServiceDesc.ServiceName, healthpb.HealthCheckResponse_SERVING)
+
+	if extAuthz != nil {
+		authv3.RegisterAuthorizationServer(srv, extAuthz)
+		healthServer.SetServingStatus(authv3.Authorization_ServiceDesc.ServiceName, healthpb.HealthCheckResponse_SERVING)
+	}
+
+	healthpb.RegisterHealthServer(srv, healthServer)
+
+	if cfg.GRPC.Reflection {
+		reflection.Register(srv)
+	}
+
+	return &Server{
+		Server: srv,
+		Health: healthServer,
+		Cfg:    cfg,
+	}
+}
+
+func (s *Server) Start() error {
+	lis, err := net.Listen("tcp", s.Cfg.GRPC.PortStr())
+	if err != nil {
+		return err
+	}
+
+	slog.Info("Starting gRPC server",
+		"address", s.Cfg.GRPC.PortStr(),
+		"reflection", s.Cfg.GRPC.Reflection,
+	)
+
+	return s.Serve(lis)
+}
+
+func (s *Server) Serve(lis net.Listener) error {
+	return s.Server.Serve(lis)
+}
+
+// Shutdown reports NOT_SERVING to health checks and waits for in-flight
+// calls, falling back to a hard stop when ctx expires.
+func (s *Server) Shutdown(ctx context.Context) error {
+	s.Health.Shutdown()
+
+	stopped := make(chan struct{})
+	go func() {
+		s.Server.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+		return nil
+	case <-ctx.Done():
+		s.Server.Stop()
+		return ctx.Err()
+	}
+}
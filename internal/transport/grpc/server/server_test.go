@@ -0,0 +1,171 @@
+package server_test
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	authv1 "github.com/alonsoF100/authorization-service/api/auth/v1"
+	"github.com/alonsoF100/authorization-service/internal/apperrors"
+	"github.com/alonsoF100/authorization-service/internal/config"
+	"github.com/alonsoF100/authorization-service/internal/models"
+	"github.com/alonsoF100/authorization-service/internal/ratelimit"
+	"github.com/alonsoF100/authorization-service/internal/transport/grpc/handlers"
+	"github.com/alonsoF100/authorization-service/internal/transport/grpc/server"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	reflectionpb "google.golang.org/grpc/reflection/grpc_reflection_v1"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+type authService struct{}
+
+func (authService) SignUp(ctx context.Context, nickname, email, password string) (*models.User, error) {
+	return nil, apperrors.ErrUserExist
+}
+
+func (authService) SignIn(ctx context.Context, email, password, userAgent, ip string) (string, error) {
+	return "", apperrors.ErrInvalidCredentials
+}
+
+func (authService) ValidateJWT(ctx context.Context, token string) (*models.Claims, error) {
+	if token != "valid" {
+		return nil, apperrors.ErrInvalidToken
+	}
+	return &models.Claims{ID: "user123"}, nil
+}
+
+func (authService) CheckAllowedIP(ctx context.Context, claims *models.Claims, ip string) error {
+	return nil
+}
+
+type userService struct{}
+
+func (userService) GetUser(ctx context.Context, userID string) (*models.User, error) {
+	return &models.User{ID: userID, Nickname: "alonso", Email: "alonso@mail.ru"}, nil
+}
+
+func (userService) DeleteUser(ctx context.Context, userID string) error {
+	return nil
+}
+
+func TestServer(t *testing.T) {
+	cfg := &config.Config{GRPC: config.GRPCConfig{Port: 50051, Reflection: true}}
+	srv := server.New(cfg, handlers.New(authService{}, userService{}), nil)
+
+	lis := bufconn.Listen(1024 * 1024)
+	served := make(chan error, 1)
+	go func() { served <- srv.Serve(lis) }()
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	ctx := context.Background()
+	client := authv1.NewAuthServiceClient(conn)
+	healthClient := healthpb.NewHealthClient(conn)
+
+	t.Run("health", func(t *testing.T) {
+		resp, err := healthClient.Check(ctx, &healthpb.HealthCheckRequest{Service: authv1.AuthService_ServiceDesc.ServiceName})
+		require.NoError(t, err)
+		require.Equal(t, healthpb.HealthCheckResponse_SERVING, resp.GetStatus())
+	})
+
+	t.Run("reflection", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		stream, err := reflectionpb.NewServerReflectionClient(conn).ServerReflectionInfo(ctx)
+		require.NoError(t, err)
+		require.NoError(t, stream.Send(&reflectionpb.ServerReflectionRequest{
+			MessageRequest: &reflectionpb.ServerReflectionRequest_ListServices{},
+		}))
+
+		resp, err := stream.Recv()
+		require.NoError(t, err)
+
+		var services []string
+		for _, service := range resp.GetListServicesResponse().GetService() {
+			services = append(services, service.GetName())
+		}
+		require.Contains(t, services, authv1.AuthService_ServiceDesc.ServiceName)
+	})
+
+	t.Run("public method", func(t *testing.T) {
+		_, err := client.SignUp(ctx, &authv1.SignUpRequest{Nickname: "alonso", Email: "alonso@mail.ru", Password: "password123"})
+		require.Equal(t, codes.AlreadyExists, status.Code(err))
+	})
+
+	t.Run("protected method without token", func(t *testing.T) {
+		_, err := client.GetUser(ctx, &authv1.GetUserRequest{})
+		require.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+
+	t.Run("protected method with token", func(t *testing.T) {
+		ctx := metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer valid")
+
+		resp, err := client.GetUser(ctx, &authv1.GetUserRequest{})
+		require.NoError(t, err)
+		require.Equal(t, "user123", resp.GetId())
+	})
+
+	t.Run("shutdown", func(t *testing.T) {
+		shutdownCtx, cancel := context.WithTimeout(ctx, time.Second)
+		defer cancel()
+
+		require.NoError(t, srv.Shutdown(shutdownCtx))
+		require.NoError(t, <-served)
+	})
+}
+
+func TestServerRateLimit(t *testing.T) {
+	cfg := &config.Config{GRPC: config.GRPCConfig{Port: 50051}}
+	cfg.RateLimit.Enabled = true
+	cfg.RateLimit.Login = config.RateLimit{Requests: 1, Period: time.Minute}
+
+	grpcHandlers := handlers.New(authService{}, userService{})
+	grpcHandlers.RateLimitStore = ratelimit.NewMemoryStore(1)
+	srv := server.New(cfg, grpcHandlers, nil)
+
+	lis := bufconn.Listen(1024 * 1024)
+	go srv.Serve(lis)
+	t.Cleanup(srv.Server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	ctx := context.Background()
+	client := authv1.NewAuthServiceClient(conn)
+	signIn := &authv1.SignInRequest{Email: "alonso@mail.ru", Password: "password123"}
+
+	_, err = client.SignIn(ctx, signIn)
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	var header metadata.MD
+	_, err = client.SignIn(ctx, signIn, grpc.Header(&header))
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+	require.NotEmpty(t, header.Get("retry-after"))
+
+	// the register limit is off
+	for range 2 {
+		_, err = client.SignUp(ctx, &authv1.SignUpRequest{Nickname: "alonso", Email: "alonso@mail.ru", Password: "password123"})
+		require.Equal(t, codes.AlreadyExists, status.Code(err))
+	}
+}
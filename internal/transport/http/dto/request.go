@@ -1,12 +1,36 @@
 package dto
 
+import "time"
+
 type SignUpRequest struct {
 	Nickname string `json:"nickname" validate:"required,min=3,max=50"`
 	Email    string `json:"email" validate:"required,email"`
-	Password string `json:"password" validate:"required,min=8,max=100"`
+	Password string `json:"password" validate:"required"`
 }
 
+// SignInRequest asks for the token in a cookie instead of the response body
+// with UseCookie, the server must have cookie mode enabled
 type SignInRequest struct {
-	Email    string `json:"email" validate:"required,email"`
-	Password string `json:"password" validate:"required,min=8,max=100"`
+	Email     string `json:"email" validate:"required,email"`
+	Password  string `json:"password" validate:"required"`
+	UseCookie bool   `json:"use_cookie"`
+}
+
+// CreatePersonalAccessTokenRequest has an optional expiry, a token without
+// one lives until it is deleted
+type CreatePersonalAccessTokenRequest struct {
+	Name      string     `json:"name" validate:"required,max=100"`
+	Scopes    []string   `json:"scopes" validate:"max=20,dive,required,max=64"`
+	ExpiresAt *time.Time `json:"expires_at" validate:"omitempty,gt"`
+}
+
+// SetAllowedIPsRequest replaces the allowed addresses and CIDR ranges of the
+// user, an empty list allows every address
+type SetAllowedIPsRequest struct {
+	AllowedIPs []string `json:"allowed_ips" validate:"max=50,dive,required,max=64"`
+}
+
+type CreateServiceAccountRequest struct {
+	Name  string   `json:"name" validate:"required,min=3,max=100"`
+	Roles []string `json:"roles" validate:"max=20,dive,required,max=50"`
 }
@@ -1,6 +1,7 @@
 package dto
 
 import (
+	"encoding/base64"
 	"time"
 
 	"github.com/alonsoF100/authorization-service/internal/models"
@@ -46,16 +47,425 @@ func NewSignInResponse(token string) SignInResponse {
 	}
 }
 
+// CookieSignInResponse replaces SignInResponse in cookie mode, the token
+// itself is only in the HttpOnly cookie
+type CookieSignInResponse struct {
+	CSRFToken string `json:"csrf_token"`
+}
+
+func NewCookieSignInResponse(csrfToken string) CookieSignInResponse {
+	return CookieSignInResponse{
+		CSRFToken: csrfToken,
+	}
+}
+
 type GetMeResponse struct {
-	ID       string `json:"id"`
-	Email    string `json:"email"`
-	Nickname string `json:"nickname"`
+	ID         string   `json:"id"`
+	Email      string   `json:"email"`
+	Nickname   string   `json:"nickname"`
+	AllowedIPs []string `json:"allowed_ips"`
 }
 
 func NewGetMeResponse(user *models.User) GetMeResponse {
 	return GetMeResponse{
-		ID:       user.ID,
-		Email:    user.Email,
-		Nickname: user.Nickname,
+		ID:         user.ID,
+		Email:      user.Email,
+		Nickname:   user.Nickname,
+		AllowedIPs: NewAllowedIPsResponse(user.AllowedIPs).AllowedIPs,
+	}
+}
+
+type AllowedIPsResponse struct {
+	AllowedIPs []string `json:"allowed_ips"`
+}
+
+// NewAllowedIPsResponse renders no restriction as [] instead of null
+func NewAllowedIPsResponse(allowedIPs []string) AllowedIPsResponse {
+	if allowedIPs == nil {
+		allowedIPs = []string{}
+	}
+
+	return AllowedIPsResponse{AllowedIPs: allowedIPs}
+}
+
+// OAuthErrorResponse is the error body defined by RFC 6749, OAuth clients
+// expect it instead of ErrorResponse
+type OAuthErrorResponse struct {
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description,omitempty"`
+}
+
+func NewOAuthErrorResponse(code, description string) OAuthErrorResponse {
+	return OAuthErrorResponse{
+		Error:            code,
+		ErrorDescription: description,
+	}
+}
+
+// IntrospectionResponse follows RFC 7662, an inactive token only has
+// active set to false
+type IntrospectionResponse struct {
+	Active    bool   `json:"active"`
+	Scope     string `json:"scope,omitempty"`
+	ClientID  string `json:"client_id,omitempty"`
+	Username  string `json:"username,omitempty"`
+	TokenType string `json:"token_type,omitempty"`
+	Exp       int64  `json:"exp,omitempty"`
+	Iat       int64  `json:"iat,omitempty"`
+	Sub       string `json:"sub,omitempty"`
+	Jti       string `json:"jti,omitempty"`
+}
+
+func NewIntrospectionResponse(claims *models.Claims) IntrospectionResponse {
+	response := IntrospectionResponse{
+		Active:    true,
+		Scope:     claims.Scope,
+		ClientID:  claims.ClientID,
+		Username:  claims.Nickname,
+		TokenType: "Bearer",
+		Sub:       claims.Subject,
+		Jti:       claims.RegisteredClaims.ID,
+	}
+	if claims.ExpiresAt != nil {
+		response.Exp = claims.ExpiresAt.Unix()
+	}
+	if claims.IssuedAt != nil {
+		response.Iat = claims.IssuedAt.Unix()
+	}
+
+	return response
+}
+
+type TokenResponse struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	ExpiresIn   int64  `json:"expires_in"`
+	Scope       string `json:"scope,omitempty"`
+	IDToken     string `json:"id_token,omitempty"`
+}
+
+func NewTokenResponse(token *models.Token) TokenResponse {
+	return TokenResponse{
+		AccessToken: token.AccessToken,
+		TokenType:   token.TokenType,
+		ExpiresIn:   int64(token.ExpiresIn.Seconds()),
+		Scope:       token.Scope,
+		IDToken:     token.IDToken,
 	}
 }
+
+// DiscoveryResponse is the OpenID provider metadata (OpenID Connect
+// Discovery 1.0 section 3), every endpoint lives under the issuer
+type DiscoveryResponse struct {
+	Issuer                            string   `json:"issuer"`
+	AuthorizationEndpoint             string   `json:"authorization_endpoint"`
+	TokenEndpoint                     string   `json:"token_endpoint"`
+	UserInfoEndpoint                  string   `json:"userinfo_endpoint"`
+	JWKSURI                           string   `json:"jwks_uri"`
+	EndSessionEndpoint                string   `json:"end_session_endpoint"`
+	IntrospectionEndpoint             string   `json:"introspection_endpoint"`
+	RevocationEndpoint                string   `json:"revocation_endpoint"`
+	ScopesSupported                   []string `json:"scopes_supported"`
+	ResponseTypesSupported            []string `json:"response_types_supported"`
+	GrantTypesSupported               []string `json:"grant_types_supported"`
+	SubjectTypesSupported             []string `json:"subject_types_supported"`
+	IDTokenSigningAlgValuesSupported  []string `json:"id_token_signing_alg_values_supported"`
+	TokenEndpointAuthMethodsSupported []string `json:"token_endpoint_auth_methods_supported"`
+	CodeChallengeMethodsSupported     []string `json:"code_challenge_methods_supported"`
+	ClaimsSupported                   []string `json:"claims_supported"`
+}
+
+func NewDiscoveryResponse(issuer string, grantTypes []string) DiscoveryResponse {
+	return DiscoveryResponse{
+		Issuer:                            issuer,
+		AuthorizationEndpoint:             issuer + "/oauth/authorize",
+		TokenEndpoint:                     issuer + "/oauth/token",
+		UserInfoEndpoint:                  issuer + "/oauth/userinfo",
+		JWKSURI:                           issuer + "/.well-known/jwks.json",
+		EndSessionEndpoint:                issuer + "/oauth/logout",
+		IntrospectionEndpoint:             issuer + "/oauth/introspect",
+		RevocationEndpoint:                issuer + "/oauth/revoke",
+		ScopesSupported:                   []string{models.ScopeOpenID, models.ScopeProfile, models.ScopeEmail},
+		ResponseTypesSupported:            []string{"code"},
+		GrantTypesSupported:               grantTypes,
+		SubjectTypesSupported:             []string{"public"},
+		IDTokenSigningAlgValuesSupported:  []string{"ES256"},
+		TokenEndpointAuthMethodsSupported: []string{"client_secret_basic", "client_secret_post"},
+		CodeChallengeMethodsSupported:     []string{"S256"},
+		ClaimsSupported: []string{
+			"iss", "sub", "aud", "exp", "iat", "auth_time", "nonce", "at_hash",
+			"nickname", "email", "email_verified",
+		},
+	}
+}
+
+// JWK is an EC public key (RFC 7517, RFC 7518 6.2)
+type JWK struct {
+	Kty string `json:"kty"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+	Kid string `json:"kid"`
+	Alg string `json:"alg"`
+	Use string `json:"use"`
+}
+
+type JWKSResponse struct {
+	Keys []JWK `json:"keys"`
+}
+
+func NewJWKSResponse(keys []models.PublicKey) JWKSResponse {
+	response := JWKSResponse{Keys: make([]JWK, 0, len(keys))}
+	for _, key := range keys {
+		// uncompressed point, 0x04 followed by the coordinates
+		point, err := key.Key.Bytes()
+		if err != nil {
+			continue
+		}
+		size := (len(point) - 1) / 2
+
+		response.Keys = append(response.Keys, JWK{
+			Kty: "EC",
+			Crv: key.Key.Curve.Params().Name,
+			X:   base64.RawURLEncoding.EncodeToString(point[1 : 1+size]),
+			Y:   base64.RawURLEncoding.EncodeToString(point[1+size:]),
+			Kid: key.ID,
+			Alg: key.Algorithm,
+			Use: "sig",
+		})
+	}
+
+	return response
+}
+
+type UserInfoResponse struct {
+	Sub           string `json:"sub"`
+	Nickname      string `json:"nickname,omitempty"`
+	Email         string `json:"email,omitempty"`
+	EmailVerified *bool  `json:"email_verified,omitempty"`
+}
+
+func NewUserInfoResponse(info *models.UserInfo) UserInfoResponse {
+	return UserInfoResponse{
+		Sub:           info.Subject,
+		Nickname:      info.Nickname,
+		Email:         info.Email,
+		EmailVerified: info.EmailVerified,
+	}
+}
+
+// PersonalAccessTokenResponse never contains the token itself, it is only
+// returned once on creation
+type PersonalAccessTokenResponse struct {
+	ID         string     `json:"id"`
+	Name       string     `json:"name"`
+	Scopes     []string   `json:"scopes"`
+	ExpiresAt  *time.Time `json:"expires_at,omitempty"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	LastUsedIP string     `json:"last_used_ip,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+func NewPersonalAccessTokenResponse(token *models.PersonalAccessToken) PersonalAccessTokenResponse {
+	scopes := token.Scopes
+	if scopes == nil {
+		scopes = []string{}
+	}
+
+	return PersonalAccessTokenResponse{
+		ID:         token.ID,
+		Name:       token.Name,
+		Scopes:     scopes,
+		ExpiresAt:  token.ExpiresAt,
+		LastUsedAt: token.LastUsedAt,
+		LastUsedIP: token.LastUsedIP,
+		CreatedAt:  token.CreatedAt,
+	}
+}
+
+type CreatePersonalAccessTokenResponse struct {
+	PersonalAccessTokenResponse
+	Token string `json:"token"`
+}
+
+func NewCreatePersonalAccessTokenResponse(token *models.PersonalAccessToken, plaintext string) CreatePersonalAccessTokenResponse {
+	return CreatePersonalAccessTokenResponse{
+		PersonalAccessTokenResponse: NewPersonalAccessTokenResponse(token),
+		Token:                       plaintext,
+	}
+}
+
+type ListPersonalAccessTokensResponse struct {
+	Tokens []PersonalAccessTokenResponse `json:"tokens"`
+}
+
+func NewListPersonalAccessTokensResponse(tokens []models.PersonalAccessToken) ListPersonalAccessTokensResponse {
+	response := ListPersonalAccessTokensResponse{Tokens: make([]PersonalAccessTokenResponse, 0, len(tokens))}
+	for i := range tokens {
+		response.Tokens = append(response.Tokens, NewPersonalAccessTokenResponse(&tokens[i]))
+	}
+
+	return response
+}
+
+type SessionResponse struct {
+	ID         string    `json:"id"`
+	DeviceName string    `json:"device_name"`
+	UserAgent  string    `json:"user_agent"`
+	IP         string    `json:"ip"`
+	CreatedAt  time.Time `json:"created_at"`
+	LastSeenAt time.Time `json:"last_seen_at"`
+	ExpiresAt  time.Time `json:"expires_at"`
+	// Current marks the session of the token the list was requested with
+	Current bool `json:"current"`
+}
+
+type ListSessionsResponse struct {
+	Sessions []SessionResponse `json:"sessions"`
+}
+
+func NewListSessionsResponse(sessions []models.Session, currentID string) ListSessionsResponse {
+	response := ListSessionsResponse{Sessions: make([]SessionResponse, 0, len(sessions))}
+	for _, session := range sessions {
+		response.Sessions = append(response.Sessions, SessionResponse{
+			ID:         session.ID,
+			DeviceName: session.DeviceName,
+			UserAgent:  session.UserAgent,
+			IP:         session.IP,
+			CreatedAt:  session.CreatedAt,
+			LastSeenAt: session.LastSeenAt,
+			ExpiresAt:  session.ExpiresAt,
+			Current:    currentID != "" && session.ID == currentID,
+		})
+	}
+
+	return response
+}
+
+type ServiceAccountResponse struct {
+	ID        string    `json:"id"`
+	Name      string    `json:"name"`
+	OwnerID   string    `json:"owner_id,omitempty"`
+	Roles     []string  `json:"roles"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func NewServiceAccountResponse(account *models.ServiceAccount) ServiceAccountResponse {
+	roles := account.Roles
+	if roles == nil {
+		roles = []string{}
+	}
+
+	return ServiceAccountResponse{
+		ID:        account.ID,
+		Name:      account.Name,
+		OwnerID:   account.OwnerID,
+		Roles:     roles,
+		CreatedAt: account.CreatedAt,
+	}
+}
+
+type ListServiceAccountsResponse struct {
+	ServiceAccounts []ServiceAccountResponse `json:"service_accounts"`
+}
+
+func NewListServiceAccountsResponse(accounts []models.ServiceAccount) ListServiceAccountsResponse {
+	response := ListServiceAccountsResponse{ServiceAccounts: make([]ServiceAccountResponse, 0, len(accounts))}
+	for i := range accounts {
+		response.ServiceAccounts = append(response.ServiceAccounts, NewServiceAccountResponse(&accounts[i]))
+	}
+
+	return response
+}
+
+// APIKeyResponse never contains the key itself, it is only returned once on
+// creation
+type APIKeyResponse struct {
+	ID               string     `json:"id"`
+	ServiceAccountID string     `json:"service_account_id"`
+	LastUsedAt       *time.Time `json:"last_used_at,omitempty"`
+	CreatedAt        time.Time  `json:"created_at"`
+}
+
+func NewAPIKeyResponse(key *models.APIKey) APIKeyResponse {
+	return APIKeyResponse{
+		ID:               key.ID,
+		ServiceAccountID: key.ServiceAccountID,
+		LastUsedAt:       key.LastUsedAt,
+		CreatedAt:        key.CreatedAt,
+	}
+}
+
+type CreateAPIKeyResponse struct {
+	APIKeyResponse
+	Key string `json:"key"`
+}
+
+func NewCreateAPIKeyResponse(key *models.APIKey, plaintext string) CreateAPIKeyResponse {
+	return CreateAPIKeyResponse{
+		APIKeyResponse: NewAPIKeyResponse(key),
+		Key:            plaintext,
+	}
+}
+
+type ListAPIKeysResponse struct {
+	Keys []APIKeyResponse `json:"keys"`
+}
+
+func NewListAPIKeysResponse(keys []models.APIKey) ListAPIKeysResponse {
+	response := ListAPIKeysResponse{Keys: make([]APIKeyResponse, 0, len(keys))}
+	for i := range keys {
+		response.Keys = append(response.Keys, NewAPIKeyResponse(&keys[i]))
+	}
+
+	return response
+}
+
+type AuditEventResponse struct {
+	ID        int64             `json:"id"`
+	Type      string            `json:"type"`
+	Outcome   string            `json:"outcome"`
+	ActorType string            `json:"actor_type,omitempty"`
+	ActorID   string            `json:"actor_id,omitempty"`
+	TargetID  string            `json:"target_id,omitempty"`
+	IP        string            `json:"ip,omitempty"`
+	UserAgent string            `json:"user_agent,omitempty"`
+	RequestID string            `json:"request_id,omitempty"`
+	Details   map[string]string `json:"details,omitempty"`
+	CreatedAt time.Time         `json:"created_at"`
+	PrevHash  string            `json:"prev_hash,omitempty"`
+	Hash      string            `json:"hash,omitempty"`
+}
+
+// ListAuditEventsResponse pages newest first, NextBefore is the before
+// parameter of the next page and missing on an empty one
+type ListAuditEventsResponse struct {
+	Events     []AuditEventResponse `json:"events"`
+	NextBefore int64                `json:"next_before,omitempty"`
+}
+
+func NewListAuditEventsResponse(events []models.AuditEvent) ListAuditEventsResponse {
+	response := ListAuditEventsResponse{Events: make([]AuditEventResponse, 0, len(events))}
+	for _, event := range events {
+		response.Events = append(response.Events, AuditEventResponse{
+			ID:        event.ID,
+			Type:      event.Type,
+			Outcome:   event.Outcome,
+			ActorType: event.ActorType,
+			ActorID:   event.ActorID,
+			TargetID:  event.TargetID,
+			IP:        event.IP,
+			UserAgent: event.UserAgent,
+			RequestID: event.RequestID,
+			Details:   event.Details,
+			CreatedAt: event.CreatedAt,
+			PrevHash:  event.PrevHash,
+			Hash:      event.Hash,
+		})
+	}
+	if len(events) > 0 {
+		response.NextBefore = events[len(events)-1].ID
+	}
+
+	return response
+}
@@ -1,12 +1,17 @@
 package dto_test
 
 import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/base64"
 	"errors"
 	"testing"
 	"time"
 
 	"github.com/alonsoF100/authorization-service/internal/models"
 	"github.com/alonsoF100/authorization-service/internal/transport/http/dto"
+	"github.com/golang-jwt/jwt/v5"
 	"github.com/stretchr/testify/require"
 )
 
@@ -55,4 +60,86 @@ func TestNewGetMeResponse(t *testing.T) {
 	require.Equal(t, user.Nickname, response.Nickname)
 	require.Equal(t, user.Email, response.Email)
 	require.Equal(t, user.ID, response.ID)
+	require.Equal(t, []string{}, response.AllowedIPs)
+
+	user.AllowedIPs = []string{"203.0.113.0/24"}
+	require.Equal(t, user.AllowedIPs, dto.NewGetMeResponse(user).AllowedIPs)
+}
+
+func TestNewIntrospectionResponse(t *testing.T) {
+	now := time.Now()
+	claims := &models.Claims{
+		ID:       "33593c38-2a7a-4d94-b802-ed132a8fd4db",
+		Nickname: "alonso",
+		Scope:    "read write",
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        "jti",
+			Subject:   "33593c38-2a7a-4d94-b802-ed132a8fd4db",
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(time.Hour)),
+		},
+	}
+
+	response := dto.NewIntrospectionResponse(claims)
+
+	require.True(t, response.Active)
+	require.Equal(t, claims.Subject, response.Sub)
+	require.Equal(t, claims.Nickname, response.Username)
+	require.Equal(t, claims.Scope, response.Scope)
+	require.Equal(t, now.Unix(), response.Iat)
+	require.Equal(t, now.Add(time.Hour).Unix(), response.Exp)
+	require.Empty(t, response.ClientID)
+}
+
+func TestNewTokenResponse(t *testing.T) {
+	token := &models.Token{
+		AccessToken: "access",
+		TokenType:   "Bearer",
+		ExpiresIn:   24 * time.Hour,
+		Scope:       "openid profile",
+		IDToken:     "id",
+	}
+
+	response := dto.NewTokenResponse(token)
+
+	require.Equal(t, token.AccessToken, response.AccessToken)
+	require.Equal(t, token.TokenType, response.TokenType)
+	require.Equal(t, int64(86400), response.ExpiresIn)
+	require.Equal(t, token.Scope, response.Scope)
+	require.Equal(t, token.IDToken, response.IDToken)
+}
+
+func TestNewJWKSResponse(t *testing.T) {
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	response := dto.NewJWKSResponse([]models.PublicKey{
+		{ID: "kid123", Algorithm: "ES256", Key: &privateKey.PublicKey},
+	})
+	require.Len(t, response.Keys, 1)
+
+	jwk := response.Keys[0]
+	require.Equal(t, "EC", jwk.Kty)
+	require.Equal(t, "P-256", jwk.Crv)
+	require.Equal(t, "kid123", jwk.Kid)
+	require.Equal(t, "ES256", jwk.Alg)
+
+	x, err := base64.RawURLEncoding.DecodeString(jwk.X)
+	require.NoError(t, err)
+	y, err := base64.RawURLEncoding.DecodeString(jwk.Y)
+	require.NoError(t, err)
+
+	point, err := privateKey.PublicKey.Bytes()
+	require.NoError(t, err)
+	require.Equal(t, point[1:], append(x, y...))
+}
+
+func TestNewDiscoveryResponse(t *testing.T) {
+	response := dto.NewDiscoveryResponse("https://auth.example.com", []string{models.GrantAuthorizationCode})
+
+	require.Equal(t, "https://auth.example.com", response.Issuer)
+	require.Equal(t, "https://auth.example.com/oauth/authorize", response.AuthorizationEndpoint)
+	require.Equal(t, "https://auth.example.com/.well-known/jwks.json", response.JWKSURI)
+	require.Equal(t, []string{models.GrantAuthorizationCode}, response.GrantTypesSupported)
+	require.Contains(t, response.ScopesSupported, models.ScopeOpenID)
 }
@@ -0,0 +1,82 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/alonsoF100/authorization-service/internal/apperrors"
+	"github.com/alonsoF100/authorization-service/internal/transport/http/dto"
+	"github.com/alonsoF100/authorization-service/internal/transport/http/help"
+)
+
+/*
+pattern: /api/me/allowed-ips
+method: PUT
+info: barer token from header, JSON body with the addresses and CIDR ranges
+sign ins and personal access tokens of the user are limited to. An empty
+list lifts the restriction, a list without the address of the request is
+rejected
+
+succeed:
+
+	-status code: 200 ok
+	-response body: JSON with the normalized list
+
+failed:
+
+	-status code: 400 bad request, 401 unauthorized, 403 forbidden for
+	client, scoped and personal access tokens, 409 conflict when the list
+	locks out the current address, 500 internal server error
+	-response body: JSON with error message + timestamp
+*/
+func (h Handler) SetAllowedIPs(w http.ResponseWriter, r *http.Request) {
+	const op = "handlers/allowed_ip.go/SetAllowedIPs"
+
+	claims, ok := sessionClaims(w, r, op)
+	if !ok {
+		return
+	}
+
+	var req dto.SetAllowedIPsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		help.WriteJSON(w, http.StatusBadRequest, dto.NewErrorResponse(apperrors.ErrFailedToDecode))
+		slog.Warn("Failed to decode JSON",
+			slog.String("op", op),
+			slog.String("error", err.Error()),
+		)
+		return
+	}
+
+	if err := h.Validator.Struct(req); err != nil {
+		help.WriteJSON(w, http.StatusBadRequest, dto.NewErrorResponse(apperrors.ErrFailedToValidate))
+		slog.Warn("Failed to validate request",
+			slog.String("op", op),
+			slog.String("error", err.Error()),
+		)
+		return
+	}
+
+	allowedIPs, err := h.UserService.SetAllowedIPs(r.Context(), claims.ID, help.ClientIP(r), req.AllowedIPs)
+	if err != nil {
+		switch {
+		case errors.Is(err, apperrors.ErrInvalidIPRange):
+			help.WriteJSON(w, http.StatusBadRequest, dto.NewErrorResponse(apperrors.ErrInvalidIPRange))
+		case errors.Is(err, apperrors.ErrIPLockout):
+			help.WriteJSON(w, http.StatusConflict, dto.NewErrorResponse(apperrors.ErrIPLockout))
+		case errors.Is(err, apperrors.ErrUserNotFoundByID):
+			help.WriteJSON(w, http.StatusUnauthorized, dto.NewErrorResponse(apperrors.ErrInvalidCredentials))
+		default:
+			help.WriteJSON(w, http.StatusInternalServerError, dto.NewErrorResponse(apperrors.ErrServer))
+			slog.Debug("Intenal server error",
+				slog.String("op", op),
+				slog.String("user_id", claims.ID),
+				slog.String("error", err.Error()),
+			)
+		}
+		return
+	}
+
+	help.WriteJSON(w, http.StatusOK, dto.NewAllowedIPsResponse(allowedIPs))
+}
@@ -0,0 +1,139 @@
+package handlers_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/alonsoF100/authorization-service/internal/apperrors"
+	"github.com/alonsoF100/authorization-service/internal/models"
+	"github.com/alonsoF100/authorization-service/internal/transport/http/dto"
+	"github.com/alonsoF100/authorization-service/internal/transport/http/handlers"
+	"github.com/alonsoF100/authorization-service/internal/transport/http/middleware"
+	"github.com/go-playground/validator/v10"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetAllowedIPs(t *testing.T) {
+	mc := minimock.NewController(t)
+	mockService := handlers.NewUserServiceMock(mc)
+
+	h := handlers.Handler{
+		UserService: mockService,
+		Validator:   validator.New(),
+	}
+
+	tests := []struct {
+		name        string
+		body        string
+		viaPAT      bool
+		mockSetup   func(ctx context.Context)
+		wantStatus  int
+		wantError   string
+		wantAllowed []string
+	}{
+		{
+			name: "success",
+			body: `{"allowed_ips": ["192.0.2.1/24"]}`,
+			mockSetup: func(ctx context.Context) {
+				mockService.SetAllowedIPsMock.Expect(ctx, "user123", "192.0.2.1", []string{"192.0.2.1/24"}).Return([]string{"192.0.2.0/24"}, nil)
+			},
+			wantStatus:  http.StatusOK,
+			wantAllowed: []string{"192.0.2.0/24"},
+		},
+		{
+			name: "restriction lifted",
+			body: `{"allowed_ips": []}`,
+			mockSetup: func(ctx context.Context) {
+				mockService.SetAllowedIPsMock.Expect(ctx, "user123", "192.0.2.1", []string{}).Return([]string{}, nil)
+			},
+			wantStatus:  http.StatusOK,
+			wantAllowed: []string{},
+		},
+		{
+			name:       "personal access token",
+			body:       `{"allowed_ips": []}`,
+			viaPAT:     true,
+			mockSetup:  func(ctx context.Context) {},
+			wantStatus: http.StatusForbidden,
+			wantError:  apperrors.ErrSessionRequired.Error(),
+		},
+		{
+			name:       "malformed body",
+			body:       `{"allowed_ips": "192.0.2.1"}`,
+			mockSetup:  func(ctx context.Context) {},
+			wantStatus: http.StatusBadRequest,
+			wantError:  apperrors.ErrFailedToDecode.Error(),
+		},
+		{
+			name:       "empty entry",
+			body:       `{"allowed_ips": [""]}`,
+			mockSetup:  func(ctx context.Context) {},
+			wantStatus: http.StatusBadRequest,
+			wantError:  apperrors.ErrFailedToValidate.Error(),
+		},
+		{
+			name: "invalid range",
+			body: `{"allowed_ips": ["192.0.2.0/33"]}`,
+			mockSetup: func(ctx context.Context) {
+				mockService.SetAllowedIPsMock.Expect(ctx, "user123", "192.0.2.1", []string{"192.0.2.0/33"}).Return(nil, apperrors.ErrInvalidIPRange)
+			},
+			wantStatus: http.StatusBadRequest,
+			wantError:  apperrors.ErrInvalidIPRange.Error(),
+		},
+		{
+			name: "lockout",
+			body: `{"allowed_ips": ["203.0.113.0/24"]}`,
+			mockSetup: func(ctx context.Context) {
+				mockService.SetAllowedIPsMock.Expect(ctx, "user123", "192.0.2.1", []string{"203.0.113.0/24"}).Return(nil, apperrors.ErrIPLockout)
+			},
+			wantStatus: http.StatusConflict,
+			wantError:  apperrors.ErrIPLockout.Error(),
+		},
+		{
+			name: "service error",
+			body: `{"allowed_ips": ["192.0.2.1"]}`,
+			mockSetup: func(ctx context.Context) {
+				mockService.SetAllowedIPsMock.Expect(ctx, "user123", "192.0.2.1", []string{"192.0.2.1"}).Return(nil, errors.New("db error"))
+			},
+			wantStatus: http.StatusInternalServerError,
+			wantError:  apperrors.ErrServer.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			claims := &models.Claims{ID: "user123", SessionID: "session123"}
+			ctx := context.WithValue(context.Background(), middleware.UserContextKey, claims)
+			ctx = context.WithValue(ctx, middleware.PrincipalContextKey, claims.Principal())
+			ctx = context.WithValue(ctx, middleware.PersonalAccessTokenContextKey, tt.viaPAT)
+
+			tt.mockSetup(ctx)
+
+			req := httptest.NewRequest(http.MethodPut, "/api/me/allowed-ips", strings.NewReader(tt.body))
+			req = req.WithContext(ctx)
+
+			rr := httptest.NewRecorder()
+
+			h.SetAllowedIPs(rr, req)
+
+			require.Equal(t, tt.wantStatus, rr.Code)
+
+			if tt.wantError != "" {
+				var resp dto.ErrorResponse
+				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+				require.Equal(t, tt.wantError, resp.Error)
+				return
+			}
+
+			var resp dto.AllowedIPsResponse
+			require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+			require.Equal(t, tt.wantAllowed, resp.AllowedIPs)
+		})
+	}
+}
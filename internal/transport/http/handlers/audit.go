@@ -0,0 +1,156 @@
+package handlers
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/alonsoF100/authorization-service/internal/apperrors"
+	"github.com/alonsoF100/authorization-service/internal/models"
+	"github.com/alonsoF100/authorization-service/internal/transport/http/dto"
+	"github.com/alonsoF100/authorization-service/internal/transport/http/help"
+)
+
+/*
+pattern: /api/me/security-events
+method: GET
+info: barer token from header, optional type, before and limit query
+parameters. Returns the audit events targeting the user, like sign ins and
+failed sign in attempts, newest first
+
+succeed:
+
+	-status code: 200 ok
+	-response body: JSON with the events and the before parameter of the
+	next page
+
+failed:
+
+	-status code: 400 bad request, 401 unauthorized, 403 forbidden for
+	client, scoped and personal access tokens, 500 internal server error
+	-response body: JSON with error message + timestamp
+*/
+func (h Handler) ListSecurityEvents(w http.ResponseWriter, r *http.Request) {
+	const op = "handlers/audit.go/ListSecurityEvents"
+
+	claims, ok := sessionClaims(w, r, op)
+	if !ok {
+		return
+	}
+
+	query := r.URL.Query()
+	filter, err := auditFilter(url.Values{
+		"type":   query["type"],
+		"before": query["before"],
+		"limit":  query["limit"],
+	})
+	if err != nil {
+		help.WriteJSON(w, http.StatusBadRequest, dto.NewErrorResponse(apperrors.ErrFailedToValidate))
+		slog.Debug("Invalid security events query",
+			slog.String("op", op),
+			slog.String("error", err.Error()),
+		)
+		return
+	}
+	filter.TargetID = claims.ID
+
+	h.writeAuditEvents(w, r, op, filter)
+}
+
+/*
+pattern: /admin/audit
+method: GET
+info: barer token of an admin, optional type, outcome, actor_id, target_id,
+ip, since and until (RFC 3339), before and limit query parameters. Returns
+the matching audit events newest first
+
+succeed:
+
+	-status code: 200 ok
+	-response body: JSON with the events and the before parameter of the
+	next page
+
+failed:
+
+	-status code: 400 bad request, 401 unauthorized, 403 forbidden,
+	500 internal server error
+	-response body: JSON with error message + timestamp
+*/
+func (h Handler) ListAuditEvents(w http.ResponseWriter, r *http.Request) {
+	const op = "handlers/audit.go/ListAuditEvents"
+
+	filter, err := auditFilter(r.URL.Query())
+	if err != nil {
+		help.WriteJSON(w, http.StatusBadRequest, dto.NewErrorResponse(apperrors.ErrFailedToValidate))
+		slog.Debug("Invalid audit query",
+			slog.String("op", op),
+			slog.String("error", err.Error()),
+		)
+		return
+	}
+
+	h.writeAuditEvents(w, r, op, filter)
+}
+
+func (h Handler) writeAuditEvents(w http.ResponseWriter, r *http.Request, op string, filter models.AuditFilter) {
+	events, err := h.AuditService.ListAuditEvents(r.Context(), filter)
+	if err != nil {
+		help.WriteJSON(w, http.StatusInternalServerError, dto.NewErrorResponse(apperrors.ErrServer))
+		slog.Debug("Intenal server error",
+			slog.String("op", op),
+			slog.String("error", err.Error()),
+		)
+		return
+	}
+
+	help.WriteJSON(w, http.StatusOK, dto.NewListAuditEventsResponse(events))
+}
+
+// auditFilter reads the audit query parameters, missing ones match every
+// event
+func auditFilter(query url.Values) (models.AuditFilter, error) {
+	filter := models.AuditFilter{
+		Type:     query.Get("type"),
+		Outcome:  query.Get("outcome"),
+		ActorID:  query.Get("actor_id"),
+		TargetID: query.Get("target_id"),
+		IP:       query.Get("ip"),
+	}
+
+	var err error
+	if value := query.Get("before"); value != "" {
+		if filter.BeforeID, err = strconv.ParseInt(value, 10, 64); err != nil || filter.BeforeID <= 0 {
+			return filter, errors.New("before must be a positive event id")
+		}
+	}
+	if value := query.Get("limit"); value != "" {
+		if filter.Limit, err = strconv.Atoi(value); err != nil || filter.Limit <= 0 {
+			return filter, errors.New("limit must be a positive number")
+		}
+	}
+	if filter.Since, err = queryTime(query, "since"); err != nil {
+		return filter, err
+	}
+	if filter.Until, err = queryTime(query, "until"); err != nil {
+		return filter, err
+	}
+
+	return filter, nil
+}
+
+func queryTime(query url.Values, name string) (*time.Time, error) {
+	value := query.Get(name)
+	if value == "" {
+		return nil, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, errors.New(name + " must be an RFC 3339 time")
+	}
+
+	return &t, nil
+}
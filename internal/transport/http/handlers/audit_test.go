@@ -0,0 +1,192 @@
+package handlers_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/alonsoF100/authorization-service/internal/apperrors"
+	"github.com/alonsoF100/authorization-service/internal/models"
+	"github.com/alonsoF100/authorization-service/internal/transport/http/dto"
+	"github.com/alonsoF100/authorization-service/internal/transport/http/handlers"
+	"github.com/alonsoF100/authorization-service/internal/transport/http/middleware"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListSecurityEvents(t *testing.T) {
+	mc := minimock.NewController(t)
+	mockService := handlers.NewAuditServiceMock(mc)
+
+	h := handlers.Handler{
+		AuditService: mockService,
+	}
+
+	events := []models.AuditEvent{
+		{ID: 7, Type: models.AuditLogin, Outcome: models.AuditFailure, TargetID: "user123", IP: "192.0.2.1", CreatedAt: time.Now()},
+		{ID: 3, Type: models.AuditUserRegistered, Outcome: models.AuditSuccess, ActorID: "user123", TargetID: "user123", CreatedAt: time.Now()},
+	}
+
+	tests := []struct {
+		name       string
+		query      string
+		viaPAT     bool
+		mockSetup  func(ctx context.Context)
+		wantStatus int
+		wantError  string
+	}{
+		{
+			name:  "success",
+			query: "?type=auth.login&before=10&limit=2&target_id=other",
+			mockSetup: func(ctx context.Context) {
+				mockService.ListAuditEventsMock.
+					Expect(ctx, models.AuditFilter{Type: models.AuditLogin, TargetID: "user123", BeforeID: 10, Limit: 2}).
+					Return(events, nil)
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "invalid limit",
+			query:      "?limit=many",
+			mockSetup:  func(ctx context.Context) {},
+			wantStatus: http.StatusBadRequest,
+			wantError:  apperrors.ErrFailedToValidate.Error(),
+		},
+		{
+			name:       "personal access token",
+			viaPAT:     true,
+			mockSetup:  func(ctx context.Context) {},
+			wantStatus: http.StatusForbidden,
+			wantError:  apperrors.ErrSessionRequired.Error(),
+		},
+		{
+			name: "service error",
+			mockSetup: func(ctx context.Context) {
+				mockService.ListAuditEventsMock.
+					Expect(ctx, models.AuditFilter{TargetID: "user123"}).
+					Return(nil, errors.New("db error"))
+			},
+			wantStatus: http.StatusInternalServerError,
+			wantError:  apperrors.ErrServer.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			claims := &models.Claims{ID: "user123", SessionID: "session123"}
+			ctx := context.WithValue(context.Background(), middleware.UserContextKey, claims)
+			ctx = context.WithValue(ctx, middleware.PrincipalContextKey, claims.Principal())
+			ctx = context.WithValue(ctx, middleware.PersonalAccessTokenContextKey, tt.viaPAT)
+
+			tt.mockSetup(ctx)
+
+			req := httptest.NewRequest(http.MethodGet, "/api/me/security-events"+tt.query, nil)
+			req = req.WithContext(ctx)
+
+			rr := httptest.NewRecorder()
+
+			h.ListSecurityEvents(rr, req)
+
+			require.Equal(t, tt.wantStatus, rr.Code)
+
+			if tt.wantError != "" {
+				var resp dto.ErrorResponse
+				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+				require.Equal(t, tt.wantError, resp.Error)
+				return
+			}
+
+			var resp dto.ListAuditEventsResponse
+			require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+			require.Len(t, resp.Events, 2)
+			require.Equal(t, int64(7), resp.Events[0].ID)
+			require.Equal(t, models.AuditFailure, resp.Events[0].Outcome)
+			require.Equal(t, "192.0.2.1", resp.Events[0].IP)
+			require.Equal(t, int64(3), resp.NextBefore)
+		})
+	}
+}
+
+func TestListAuditEvents(t *testing.T) {
+	mc := minimock.NewController(t)
+	mockService := handlers.NewAuditServiceMock(mc)
+
+	h := handlers.Handler{
+		AuditService: mockService,
+	}
+
+	since := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	until := time.Date(2026, 10, 2, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		query      string
+		mockSetup  func(ctx context.Context)
+		wantStatus int
+		wantError  string
+	}{
+		{
+			name:  "every filter",
+			query: "?type=auth.login&outcome=failure&actor_id=admin123&target_id=user123&ip=192.0.2.1&since=2026-10-01T00:00:00Z&until=2026-10-02T00:00:00Z&before=99&limit=20",
+			mockSetup: func(ctx context.Context) {
+				mockService.ListAuditEventsMock.Expect(ctx, models.AuditFilter{
+					Type:     models.AuditLogin,
+					Outcome:  models.AuditFailure,
+					ActorID:  "admin123",
+					TargetID: "user123",
+					IP:       "192.0.2.1",
+					Since:    &since,
+					Until:    &until,
+					BeforeID: 99,
+					Limit:    20,
+				}).Return(nil, nil)
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "invalid time",
+			query:      "?since=yesterday",
+			mockSetup:  func(ctx context.Context) {},
+			wantStatus: http.StatusBadRequest,
+			wantError:  apperrors.ErrFailedToValidate.Error(),
+		},
+		{
+			name:       "invalid before",
+			query:      "?before=-1",
+			mockSetup:  func(ctx context.Context) {},
+			wantStatus: http.StatusBadRequest,
+			wantError:  apperrors.ErrFailedToValidate.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			tt.mockSetup(ctx)
+
+			req := httptest.NewRequest(http.MethodGet, "/admin/audit"+tt.query, nil)
+			rr := httptest.NewRecorder()
+
+			h.ListAuditEvents(rr, req)
+
+			require.Equal(t, tt.wantStatus, rr.Code)
+
+			if tt.wantError != "" {
+				var resp dto.ErrorResponse
+				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+				require.Equal(t, tt.wantError, resp.Error)
+				return
+			}
+
+			var resp dto.ListAuditEventsResponse
+			require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+			require.NotNil(t, resp.Events)
+			require.Empty(t, resp.Events)
+			require.Zero(t, resp.NextBefore)
+		})
+	}
+}
@@ -7,8 +7,10 @@ import (
 	"net/http"
 
 	"github.com/alonsoF100/authorization-service/internal/apperrors"
+	"github.com/alonsoF100/authorization-service/internal/config"
 	"github.com/alonsoF100/authorization-service/internal/transport/http/dto"
 	"github.com/alonsoF100/authorization-service/internal/transport/http/help"
+	"github.com/alonsoF100/authorization-service/internal/transport/http/middleware"
 )
 
 /*
@@ -76,6 +78,15 @@ func (h Handler) SignUp(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 
+		if errors.Is(err, apperrors.ErrWeakPassword) {
+			help.WriteJSON(w, http.StatusBadRequest, dto.NewErrorResponse(apperrors.ErrWeakPassword))
+			slog.Debug("Password outside the password policy",
+				slog.String("op", op),
+				slog.String("email", req.Email),
+			)
+			return
+		}
+
 		help.WriteJSON(w, http.StatusInternalServerError, dto.NewErrorResponse(apperrors.ErrServer))
 		slog.Debug("Intenal server error",
 			slog.String("op", op),
@@ -92,16 +103,18 @@ func (h Handler) SignUp(w http.ResponseWriter, r *http.Request) {
 /*
 pattern: /auth/login
 method: POST
-info: JSON in request body
+info: JSON in request body, with use_cookie the token is set as an HttpOnly
+cookie next to a CSRF cookie readable by scripts
 
 succeed:
 
 	-status code: 200 ok
-	-response body: JSON represented JWT token
+	-response body: JSON represented JWT token, or the CSRF token in cookie
+	mode
 
 failed:
 
-	-status code: 400 bad request, 401 unauthorized, 500 internal server error
+	-status code: 400 bad request, 401 unauthorized, 403 forbidden, 500 internal server error
 	-response body: JSON with error message + timestamp
 */
 func (h Handler) SignIn(w http.ResponseWriter, r *http.Request) {
@@ -128,10 +141,17 @@ func (h Handler) SignIn(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if req.UseCookie && !h.Cfg.Cookie.Enabled {
+		help.WriteJSON(w, http.StatusBadRequest, dto.NewErrorResponse(apperrors.ErrCookieModeDisabled))
+		return
+	}
+
 	token, err := h.AuthService.SignIn(
 		ctx,
 		req.Email,
 		req.Password,
+		r.UserAgent(),
+		help.ClientIP(r),
 	)
 	if err != nil {
 		if errors.Is(err, apperrors.ErrInvalidCredentials) {
@@ -139,11 +159,31 @@ func (h Handler) SignIn(w http.ResponseWriter, r *http.Request) {
 			slog.Debug("Authentication failed",
 				slog.String("op", op),
 				slog.String("email", req.Email),
+				slog.String("ip", help.ClientIP(r)),
 				slog.String("error", err.Error()),
 			)
 			return
 		}
 
+		if errors.Is(err, apperrors.ErrUserDisabled) {
+			help.WriteJSON(w, http.StatusForbidden, dto.NewErrorResponse(apperrors.ErrUserDisabled))
+			slog.Debug("Authentication failed: user disabled",
+				slog.String("op", op),
+				slog.String("email", req.Email),
+			)
+			return
+		}
+
+		if errors.Is(err, apperrors.ErrIPNotAllowed) {
+			help.WriteJSON(w, http.StatusForbidden, dto.NewErrorResponse(apperrors.ErrIPNotAllowed))
+			slog.Debug("Authentication failed: address not allowed",
+				slog.String("op", op),
+				slog.String("email", req.Email),
+				slog.String("ip", help.ClientIP(r)),
+			)
+			return
+		}
+
 		help.WriteJSON(w, http.StatusInternalServerError, dto.NewErrorResponse(apperrors.ErrServer))
 		slog.Debug("Intenal server error",
 			slog.String("op", op),
@@ -153,5 +193,94 @@ func (h Handler) SignIn(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if req.UseCookie {
+		csrfToken := middleware.CSRFToken(token)
+		h.setTokenCookies(w, token, csrfToken, int(h.Cfg.JWT.Expiry.Seconds()))
+		help.WriteJSON(w, http.StatusOK, dto.NewCookieSignInResponse(csrfToken))
+		return
+	}
+
 	help.WriteJSON(w, http.StatusOK, dto.NewSignInResponse(token))
 }
+
+/*
+pattern: /auth/logout
+method: POST
+info: token from header or cookie, the CSRF header is required in cookie
+mode. The session of the token is revoked and both cookies are expired.
+
+succeed:
+
+	-status code: 204 no content
+
+failed:
+
+	-status code: 401 unauthorized, 403 forbidden, 500 internal server error
+	-response body: JSON with error message + timestamp
+*/
+func (h Handler) SignOut(w http.ResponseWriter, r *http.Request) {
+	const op = "handlers/auth.go/SignOut"
+
+	claims, ok := middleware.GetUserFromContext(r.Context())
+	if !ok {
+		slog.Error("User claims not found in context",
+			slog.String("op", op))
+		help.WriteJSON(w, http.StatusUnauthorized, dto.NewErrorResponse(apperrors.ErrUnauthorized))
+		return
+	}
+
+	// tokens without a session, like personal access tokens, only lose
+	// their cookies
+	if claims.SessionID != "" {
+		err := h.UserService.DeleteSession(r.Context(), claims.ID, claims.SessionID)
+		if err != nil && !errors.Is(err, apperrors.ErrSessionNotFound) {
+			help.WriteJSON(w, http.StatusInternalServerError, dto.NewErrorResponse(apperrors.ErrServer))
+			slog.Debug("Intenal server error",
+				slog.String("op", op),
+				slog.String("user_id", claims.ID),
+				slog.String("error", err.Error()),
+			)
+			return
+		}
+	}
+
+	if h.Cfg.Cookie.Enabled {
+		h.setTokenCookies(w, "", "", -1)
+	}
+
+	help.WriteJSON(w, http.StatusNoContent, nil)
+}
+
+// setTokenCookies sets the token cookie of cookie mode and the CSRF cookie
+// that scripts of the app read to fill the CSRF header. A negative maxAge
+// expires both.
+func (h Handler) setTokenCookies(w http.ResponseWriter, token, csrfToken string, maxAge int) {
+	cfg := h.Cfg.Cookie
+	cookie := http.Cookie{
+		Name:     cfg.Name,
+		Value:    token,
+		Domain:   cfg.Domain,
+		Path:     cfg.Path,
+		MaxAge:   maxAge,
+		HttpOnly: true,
+		Secure:   cfg.Secure,
+		SameSite: sameSite(cfg.SameSite),
+	}
+	http.SetCookie(w, &cookie)
+
+	cookie.Name = cfg.CSRFCookie
+	cookie.Value = csrfToken
+	cookie.HttpOnly = false
+	http.SetCookie(w, &cookie)
+}
+
+func sameSite(mode string) http.SameSite {
+	switch mode {
+	case config.SameSiteStrict:
+		return http.SameSiteStrictMode
+	case config.SameSiteNone:
+		return http.SameSiteNoneMode
+	default:
+		return http.SameSiteLaxMode
+	}
+}
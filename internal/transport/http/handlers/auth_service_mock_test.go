@@ -19,13 +19,34 @@ type AuthServiceMock struct {
 	t          minimock.Tester
 	finishOnce sync.Once
 
-	funcSignIn          func(ctx context.Context, email string, password string) (s1 string, err error)
+	funcCheckAllowedIP          func(ctx context.Context, claims *models.Claims, ip string) (err error)
+	funcCheckAllowedIPOrigin    string
+	inspectFuncCheckAllowedIP   func(ctx context.Context, claims *models.Claims, ip string)
+	afterCheckAllowedIPCounter  uint64
+	beforeCheckAllowedIPCounter uint64
+	CheckAllowedIPMock          mAuthServiceMockCheckAllowedIP
+
+	funcPublicKeys          func() (pa1 []models.PublicKey)
+	funcPublicKeysOrigin    string
+	inspectFuncPublicKeys   func()
+	afterPublicKeysCounter  uint64
+	beforePublicKeysCounter uint64
+	PublicKeysMock          mAuthServiceMockPublicKeys
+
+	funcSignIn          func(ctx context.Context, email string, password string, userAgent string, ip string) (s1 string, err error)
 	funcSignInOrigin    string
-	inspectFuncSignIn   func(ctx context.Context, email string, password string)
+	inspectFuncSignIn   func(ctx context.Context, email string, password string, userAgent string, ip string)
 	afterSignInCounter  uint64
 	beforeSignInCounter uint64
 	SignInMock          mAuthServiceMockSignIn
 
+	funcSignInSession          func(ctx context.Context, email string, password string, userAgent string, ip string) (s1 string, err error)
+	funcSignInSessionOrigin    string
+	inspectFuncSignInSession   func(ctx context.Context, email string, password string, userAgent string, ip string)
+	afterSignInSessionCounter  uint64
+	beforeSignInSessionCounter uint64
+	SignInSessionMock          mAuthServiceMockSignInSession
+
 	funcSignUp          func(ctx context.Context, nickname string, email string, password string) (up1 *models.User, err error)
 	funcSignUpOrigin    string
 	inspectFuncSignUp   func(ctx context.Context, nickname string, email string, password string)
@@ -39,6 +60,13 @@ type AuthServiceMock struct {
 	afterValidateJWTCounter  uint64
 	beforeValidateJWTCounter uint64
 	ValidateJWTMock          mAuthServiceMockValidateJWT
+
+	funcValidateSessionToken          func(ctx context.Context, tokenString string) (cp1 *models.Claims, err error)
+	funcValidateSessionTokenOrigin    string
+	inspectFuncValidateSessionToken   func(ctx context.Context, tokenString string)
+	afterValidateSessionTokenCounter  uint64
+	beforeValidateSessionTokenCounter uint64
+	ValidateSessionTokenMock          mAuthServiceMockValidateSessionToken
 }
 
 // NewAuthServiceMock returns a mock for AuthService
@@ -49,70 +77,80 @@ func NewAuthServiceMock(t minimock.Tester) *AuthServiceMock {
 		controller.RegisterMocker(m)
 	}
 
+	m.CheckAllowedIPMock = mAuthServiceMockCheckAllowedIP{mock: m}
+	m.CheckAllowedIPMock.callArgs = []*AuthServiceMockCheckAllowedIPParams{}
+
+	m.PublicKeysMock = mAuthServiceMockPublicKeys{mock: m}
+
 	m.SignInMock = mAuthServiceMockSignIn{mock: m}
 	m.SignInMock.callArgs = []*AuthServiceMockSignInParams{}
 
+	m.SignInSessionMock = mAuthServiceMockSignInSession{mock: m}
+	m.SignInSessionMock.callArgs = []*AuthServiceMockSignInSessionParams{}
+
 	m.SignUpMock = mAuthServiceMockSignUp{mock: m}
 	m.SignUpMock.callArgs = []*AuthServiceMockSignUpParams{}
 
 	m.ValidateJWTMock = mAuthServiceMockValidateJWT{mock: m}
 	m.ValidateJWTMock.callArgs = []*AuthServiceMockValidateJWTParams{}
 
+	m.ValidateSessionTokenMock = mAuthServiceMockValidateSessionToken{mock: m}
+	m.ValidateSessionTokenMock.callArgs = []*AuthServiceMockValidateSessionTokenParams{}
+
 	t.Cleanup(m.MinimockFinish)
 
 	return m
 }
 
-type mAuthServiceMockSignIn struct {
+type mAuthServiceMockCheckAllowedIP struct {
 	optional           bool
 	mock               *AuthServiceMock
-	defaultExpectation *AuthServiceMockSignInExpectation
-	expectations       []*AuthServiceMockSignInExpectation
+	defaultExpectation *AuthServiceMockCheckAllowedIPExpectation
+	expectations       []*AuthServiceMockCheckAllowedIPExpectation
 
-	callArgs []*AuthServiceMockSignInParams
+	callArgs []*AuthServiceMockCheckAllowedIPParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// AuthServiceMockSignInExpectation specifies expectation struct of the AuthService.SignIn
-type AuthServiceMockSignInExpectation struct {
+// AuthServiceMockCheckAllowedIPExpectation specifies expectation struct of the AuthService.CheckAllowedIP
+type AuthServiceMockCheckAllowedIPExpectation struct {
 	mock               *AuthServiceMock
-	params             *AuthServiceMockSignInParams
-	paramPtrs          *AuthServiceMockSignInParamPtrs
-	expectationOrigins AuthServiceMockSignInExpectationOrigins
-	results            *AuthServiceMockSignInResults
+	params             *AuthServiceMockCheckAllowedIPParams
+	paramPtrs          *AuthServiceMockCheckAllowedIPParamPtrs
+	expectationOrigins AuthServiceMockCheckAllowedIPExpectationOrigins
+	results            *AuthServiceMockCheckAllowedIPResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// AuthServiceMockSignInParams contains parameters of the AuthService.SignIn
-type AuthServiceMockSignInParams struct {
-	ctx      context.Context
-	email    string
-	password string
+// AuthServiceMockCheckAllowedIPParams contains parameters of the AuthService.CheckAllowedIP
+type AuthServiceMockCheckAllowedIPParams struct {
+	ctx    context.Context
+	claims *models.Claims
+	ip     string
 }
 
-// AuthServiceMockSignInParamPtrs contains pointers to parameters of the AuthService.SignIn
-type AuthServiceMockSignInParamPtrs struct {
-	ctx      *context.Context
-	email    *string
-	password *string
+// AuthServiceMockCheckAllowedIPParamPtrs contains pointers to parameters of the AuthService.CheckAllowedIP
+type AuthServiceMockCheckAllowedIPParamPtrs struct {
+	ctx    *context.Context
+	claims **models.Claims
+	ip     *string
 }
 
-// AuthServiceMockSignInResults contains results of the AuthService.SignIn
-type AuthServiceMockSignInResults struct {
-	s1  string
+// AuthServiceMockCheckAllowedIPResults contains results of the AuthService.CheckAllowedIP
+type AuthServiceMockCheckAllowedIPResults struct {
 	err error
 }
 
-// AuthServiceMockSignInOrigins contains origins of expectations of the AuthService.SignIn
-type AuthServiceMockSignInExpectationOrigins struct {
-	origin         string
-	originCtx      string
-	originEmail    string
-	originPassword string
+// AuthServiceMockCheckAllowedIPOrigins contains origins of expectations of the AuthService.CheckAllowedIP
+type AuthServiceMockCheckAllowedIPExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originClaims string
+	originIp     string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -120,320 +158,1378 @@ type AuthServiceMockSignInExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmSignIn *mAuthServiceMockSignIn) Optional() *mAuthServiceMockSignIn {
-	mmSignIn.optional = true
-	return mmSignIn
+func (mmCheckAllowedIP *mAuthServiceMockCheckAllowedIP) Optional() *mAuthServiceMockCheckAllowedIP {
+	mmCheckAllowedIP.optional = true
+	return mmCheckAllowedIP
 }
 
-// Expect sets up expected params for AuthService.SignIn
-func (mmSignIn *mAuthServiceMockSignIn) Expect(ctx context.Context, email string, password string) *mAuthServiceMockSignIn {
-	if mmSignIn.mock.funcSignIn != nil {
-		mmSignIn.mock.t.Fatalf("AuthServiceMock.SignIn mock is already set by Set")
+// Expect sets up expected params for AuthService.CheckAllowedIP
+func (mmCheckAllowedIP *mAuthServiceMockCheckAllowedIP) Expect(ctx context.Context, claims *models.Claims, ip string) *mAuthServiceMockCheckAllowedIP {
+	if mmCheckAllowedIP.mock.funcCheckAllowedIP != nil {
+		mmCheckAllowedIP.mock.t.Fatalf("AuthServiceMock.CheckAllowedIP mock is already set by Set")
 	}
 
-	if mmSignIn.defaultExpectation == nil {
-		mmSignIn.defaultExpectation = &AuthServiceMockSignInExpectation{}
+	if mmCheckAllowedIP.defaultExpectation == nil {
+		mmCheckAllowedIP.defaultExpectation = &AuthServiceMockCheckAllowedIPExpectation{}
 	}
 
-	if mmSignIn.defaultExpectation.paramPtrs != nil {
-		mmSignIn.mock.t.Fatalf("AuthServiceMock.SignIn mock is already set by ExpectParams functions")
+	if mmCheckAllowedIP.defaultExpectation.paramPtrs != nil {
+		mmCheckAllowedIP.mock.t.Fatalf("AuthServiceMock.CheckAllowedIP mock is already set by ExpectParams functions")
 	}
 
-	mmSignIn.defaultExpectation.params = &AuthServiceMockSignInParams{ctx, email, password}
-	mmSignIn.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmSignIn.expectations {
-		if minimock.Equal(e.params, mmSignIn.defaultExpectation.params) {
-			mmSignIn.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSignIn.defaultExpectation.params)
+	mmCheckAllowedIP.defaultExpectation.params = &AuthServiceMockCheckAllowedIPParams{ctx, claims, ip}
+	mmCheckAllowedIP.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCheckAllowedIP.expectations {
+		if minimock.Equal(e.params, mmCheckAllowedIP.defaultExpectation.params) {
+			mmCheckAllowedIP.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCheckAllowedIP.defaultExpectation.params)
 		}
 	}
 
-	return mmSignIn
+	return mmCheckAllowedIP
 }
 
-// ExpectCtxParam1 sets up expected param ctx for AuthService.SignIn
-func (mmSignIn *mAuthServiceMockSignIn) ExpectCtxParam1(ctx context.Context) *mAuthServiceMockSignIn {
-	if mmSignIn.mock.funcSignIn != nil {
-		mmSignIn.mock.t.Fatalf("AuthServiceMock.SignIn mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for AuthService.CheckAllowedIP
+func (mmCheckAllowedIP *mAuthServiceMockCheckAllowedIP) ExpectCtxParam1(ctx context.Context) *mAuthServiceMockCheckAllowedIP {
+	if mmCheckAllowedIP.mock.funcCheckAllowedIP != nil {
+		mmCheckAllowedIP.mock.t.Fatalf("AuthServiceMock.CheckAllowedIP mock is already set by Set")
 	}
 
-	if mmSignIn.defaultExpectation == nil {
-		mmSignIn.defaultExpectation = &AuthServiceMockSignInExpectation{}
+	if mmCheckAllowedIP.defaultExpectation == nil {
+		mmCheckAllowedIP.defaultExpectation = &AuthServiceMockCheckAllowedIPExpectation{}
 	}
 
-	if mmSignIn.defaultExpectation.params != nil {
-		mmSignIn.mock.t.Fatalf("AuthServiceMock.SignIn mock is already set by Expect")
+	if mmCheckAllowedIP.defaultExpectation.params != nil {
+		mmCheckAllowedIP.mock.t.Fatalf("AuthServiceMock.CheckAllowedIP mock is already set by Expect")
 	}
 
-	if mmSignIn.defaultExpectation.paramPtrs == nil {
-		mmSignIn.defaultExpectation.paramPtrs = &AuthServiceMockSignInParamPtrs{}
+	if mmCheckAllowedIP.defaultExpectation.paramPtrs == nil {
+		mmCheckAllowedIP.defaultExpectation.paramPtrs = &AuthServiceMockCheckAllowedIPParamPtrs{}
 	}
-	mmSignIn.defaultExpectation.paramPtrs.ctx = &ctx
-	mmSignIn.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmCheckAllowedIP.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCheckAllowedIP.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmSignIn
+	return mmCheckAllowedIP
 }
 
-// ExpectEmailParam2 sets up expected param email for AuthService.SignIn
-func (mmSignIn *mAuthServiceMockSignIn) ExpectEmailParam2(email string) *mAuthServiceMockSignIn {
-	if mmSignIn.mock.funcSignIn != nil {
-		mmSignIn.mock.t.Fatalf("AuthServiceMock.SignIn mock is already set by Set")
+// ExpectClaimsParam2 sets up expected param claims for AuthService.CheckAllowedIP
+func (mmCheckAllowedIP *mAuthServiceMockCheckAllowedIP) ExpectClaimsParam2(claims *models.Claims) *mAuthServiceMockCheckAllowedIP {
+	if mmCheckAllowedIP.mock.funcCheckAllowedIP != nil {
+		mmCheckAllowedIP.mock.t.Fatalf("AuthServiceMock.CheckAllowedIP mock is already set by Set")
 	}
 
-	if mmSignIn.defaultExpectation == nil {
-		mmSignIn.defaultExpectation = &AuthServiceMockSignInExpectation{}
+	if mmCheckAllowedIP.defaultExpectation == nil {
+		mmCheckAllowedIP.defaultExpectation = &AuthServiceMockCheckAllowedIPExpectation{}
 	}
 
-	if mmSignIn.defaultExpectation.params != nil {
-		mmSignIn.mock.t.Fatalf("AuthServiceMock.SignIn mock is already set by Expect")
+	if mmCheckAllowedIP.defaultExpectation.params != nil {
+		mmCheckAllowedIP.mock.t.Fatalf("AuthServiceMock.CheckAllowedIP mock is already set by Expect")
 	}
 
-	if mmSignIn.defaultExpectation.paramPtrs == nil {
-		mmSignIn.defaultExpectation.paramPtrs = &AuthServiceMockSignInParamPtrs{}
+	if mmCheckAllowedIP.defaultExpectation.paramPtrs == nil {
+		mmCheckAllowedIP.defaultExpectation.paramPtrs = &AuthServiceMockCheckAllowedIPParamPtrs{}
 	}
-	mmSignIn.defaultExpectation.paramPtrs.email = &email
-	mmSignIn.defaultExpectation.expectationOrigins.originEmail = minimock.CallerInfo(1)
+	mmCheckAllowedIP.defaultExpectation.paramPtrs.claims = &claims
+	mmCheckAllowedIP.defaultExpectation.expectationOrigins.originClaims = minimock.CallerInfo(1)
 
-	return mmSignIn
+	return mmCheckAllowedIP
 }
 
-// ExpectPasswordParam3 sets up expected param password for AuthService.SignIn
-func (mmSignIn *mAuthServiceMockSignIn) ExpectPasswordParam3(password string) *mAuthServiceMockSignIn {
-	if mmSignIn.mock.funcSignIn != nil {
-		mmSignIn.mock.t.Fatalf("AuthServiceMock.SignIn mock is already set by Set")
+// ExpectIpParam3 sets up expected param ip for AuthService.CheckAllowedIP
+func (mmCheckAllowedIP *mAuthServiceMockCheckAllowedIP) ExpectIpParam3(ip string) *mAuthServiceMockCheckAllowedIP {
+	if mmCheckAllowedIP.mock.funcCheckAllowedIP != nil {
+		mmCheckAllowedIP.mock.t.Fatalf("AuthServiceMock.CheckAllowedIP mock is already set by Set")
 	}
 
-	if mmSignIn.defaultExpectation == nil {
-		mmSignIn.defaultExpectation = &AuthServiceMockSignInExpectation{}
+	if mmCheckAllowedIP.defaultExpectation == nil {
+		mmCheckAllowedIP.defaultExpectation = &AuthServiceMockCheckAllowedIPExpectation{}
 	}
 
-	if mmSignIn.defaultExpectation.params != nil {
-		mmSignIn.mock.t.Fatalf("AuthServiceMock.SignIn mock is already set by Expect")
+	if mmCheckAllowedIP.defaultExpectation.params != nil {
+		mmCheckAllowedIP.mock.t.Fatalf("AuthServiceMock.CheckAllowedIP mock is already set by Expect")
 	}
 
-	if mmSignIn.defaultExpectation.paramPtrs == nil {
-		mmSignIn.defaultExpectation.paramPtrs = &AuthServiceMockSignInParamPtrs{}
+	if mmCheckAllowedIP.defaultExpectation.paramPtrs == nil {
+		mmCheckAllowedIP.defaultExpectation.paramPtrs = &AuthServiceMockCheckAllowedIPParamPtrs{}
 	}
-	mmSignIn.defaultExpectation.paramPtrs.password = &password
-	mmSignIn.defaultExpectation.expectationOrigins.originPassword = minimock.CallerInfo(1)
+	mmCheckAllowedIP.defaultExpectation.paramPtrs.ip = &ip
+	mmCheckAllowedIP.defaultExpectation.expectationOrigins.originIp = minimock.CallerInfo(1)
 
-	return mmSignIn
+	return mmCheckAllowedIP
 }
 
-// Inspect accepts an inspector function that has same arguments as the AuthService.SignIn
-func (mmSignIn *mAuthServiceMockSignIn) Inspect(f func(ctx context.Context, email string, password string)) *mAuthServiceMockSignIn {
-	if mmSignIn.mock.inspectFuncSignIn != nil {
-		mmSignIn.mock.t.Fatalf("Inspect function is already set for AuthServiceMock.SignIn")
+// Inspect accepts an inspector function that has same arguments as the AuthService.CheckAllowedIP
+func (mmCheckAllowedIP *mAuthServiceMockCheckAllowedIP) Inspect(f func(ctx context.Context, claims *models.Claims, ip string)) *mAuthServiceMockCheckAllowedIP {
+	if mmCheckAllowedIP.mock.inspectFuncCheckAllowedIP != nil {
+		mmCheckAllowedIP.mock.t.Fatalf("Inspect function is already set for AuthServiceMock.CheckAllowedIP")
 	}
 
-	mmSignIn.mock.inspectFuncSignIn = f
+	mmCheckAllowedIP.mock.inspectFuncCheckAllowedIP = f
 
-	return mmSignIn
+	return mmCheckAllowedIP
 }
 
-// Return sets up results that will be returned by AuthService.SignIn
-func (mmSignIn *mAuthServiceMockSignIn) Return(s1 string, err error) *AuthServiceMock {
-	if mmSignIn.mock.funcSignIn != nil {
-		mmSignIn.mock.t.Fatalf("AuthServiceMock.SignIn mock is already set by Set")
+// Return sets up results that will be returned by AuthService.CheckAllowedIP
+func (mmCheckAllowedIP *mAuthServiceMockCheckAllowedIP) Return(err error) *AuthServiceMock {
+	if mmCheckAllowedIP.mock.funcCheckAllowedIP != nil {
+		mmCheckAllowedIP.mock.t.Fatalf("AuthServiceMock.CheckAllowedIP mock is already set by Set")
 	}
 
-	if mmSignIn.defaultExpectation == nil {
-		mmSignIn.defaultExpectation = &AuthServiceMockSignInExpectation{mock: mmSignIn.mock}
+	if mmCheckAllowedIP.defaultExpectation == nil {
+		mmCheckAllowedIP.defaultExpectation = &AuthServiceMockCheckAllowedIPExpectation{mock: mmCheckAllowedIP.mock}
 	}
-	mmSignIn.defaultExpectation.results = &AuthServiceMockSignInResults{s1, err}
-	mmSignIn.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmSignIn.mock
+	mmCheckAllowedIP.defaultExpectation.results = &AuthServiceMockCheckAllowedIPResults{err}
+	mmCheckAllowedIP.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCheckAllowedIP.mock
 }
 
-// Set uses given function f to mock the AuthService.SignIn method
-func (mmSignIn *mAuthServiceMockSignIn) Set(f func(ctx context.Context, email string, password string) (s1 string, err error)) *AuthServiceMock {
-	if mmSignIn.defaultExpectation != nil {
-		mmSignIn.mock.t.Fatalf("Default expectation is already set for the AuthService.SignIn method")
+// Set uses given function f to mock the AuthService.CheckAllowedIP method
+func (mmCheckAllowedIP *mAuthServiceMockCheckAllowedIP) Set(f func(ctx context.Context, claims *models.Claims, ip string) (err error)) *AuthServiceMock {
+	if mmCheckAllowedIP.defaultExpectation != nil {
+		mmCheckAllowedIP.mock.t.Fatalf("Default expectation is already set for the AuthService.CheckAllowedIP method")
 	}
 
-	if len(mmSignIn.expectations) > 0 {
-		mmSignIn.mock.t.Fatalf("Some expectations are already set for the AuthService.SignIn method")
+	if len(mmCheckAllowedIP.expectations) > 0 {
+		mmCheckAllowedIP.mock.t.Fatalf("Some expectations are already set for the AuthService.CheckAllowedIP method")
 	}
 
-	mmSignIn.mock.funcSignIn = f
-	mmSignIn.mock.funcSignInOrigin = minimock.CallerInfo(1)
-	return mmSignIn.mock
+	mmCheckAllowedIP.mock.funcCheckAllowedIP = f
+	mmCheckAllowedIP.mock.funcCheckAllowedIPOrigin = minimock.CallerInfo(1)
+	return mmCheckAllowedIP.mock
 }
 
-// When sets expectation for the AuthService.SignIn which will trigger the result defined by the following
+// When sets expectation for the AuthService.CheckAllowedIP which will trigger the result defined by the following
 // Then helper
-func (mmSignIn *mAuthServiceMockSignIn) When(ctx context.Context, email string, password string) *AuthServiceMockSignInExpectation {
-	if mmSignIn.mock.funcSignIn != nil {
-		mmSignIn.mock.t.Fatalf("AuthServiceMock.SignIn mock is already set by Set")
+func (mmCheckAllowedIP *mAuthServiceMockCheckAllowedIP) When(ctx context.Context, claims *models.Claims, ip string) *AuthServiceMockCheckAllowedIPExpectation {
+	if mmCheckAllowedIP.mock.funcCheckAllowedIP != nil {
+		mmCheckAllowedIP.mock.t.Fatalf("AuthServiceMock.CheckAllowedIP mock is already set by Set")
 	}
 
-	expectation := &AuthServiceMockSignInExpectation{
-		mock:               mmSignIn.mock,
-		params:             &AuthServiceMockSignInParams{ctx, email, password},
-		expectationOrigins: AuthServiceMockSignInExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &AuthServiceMockCheckAllowedIPExpectation{
+		mock:               mmCheckAllowedIP.mock,
+		params:             &AuthServiceMockCheckAllowedIPParams{ctx, claims, ip},
+		expectationOrigins: AuthServiceMockCheckAllowedIPExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCheckAllowedIP.expectations = append(mmCheckAllowedIP.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuthService.CheckAllowedIP return parameters for the expectation previously defined by the When method
+func (e *AuthServiceMockCheckAllowedIPExpectation) Then(err error) *AuthServiceMock {
+	e.results = &AuthServiceMockCheckAllowedIPResults{err}
+	return e.mock
+}
+
+// Times sets number of times AuthService.CheckAllowedIP should be invoked
+func (mmCheckAllowedIP *mAuthServiceMockCheckAllowedIP) Times(n uint64) *mAuthServiceMockCheckAllowedIP {
+	if n == 0 {
+		mmCheckAllowedIP.mock.t.Fatalf("Times of AuthServiceMock.CheckAllowedIP mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCheckAllowedIP.expectedInvocations, n)
+	mmCheckAllowedIP.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCheckAllowedIP
+}
+
+func (mmCheckAllowedIP *mAuthServiceMockCheckAllowedIP) invocationsDone() bool {
+	if len(mmCheckAllowedIP.expectations) == 0 && mmCheckAllowedIP.defaultExpectation == nil && mmCheckAllowedIP.mock.funcCheckAllowedIP == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCheckAllowedIP.mock.afterCheckAllowedIPCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCheckAllowedIP.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CheckAllowedIP implements AuthService
+func (mmCheckAllowedIP *AuthServiceMock) CheckAllowedIP(ctx context.Context, claims *models.Claims, ip string) (err error) {
+	mm_atomic.AddUint64(&mmCheckAllowedIP.beforeCheckAllowedIPCounter, 1)
+	defer mm_atomic.AddUint64(&mmCheckAllowedIP.afterCheckAllowedIPCounter, 1)
+
+	mmCheckAllowedIP.t.Helper()
+
+	if mmCheckAllowedIP.inspectFuncCheckAllowedIP != nil {
+		mmCheckAllowedIP.inspectFuncCheckAllowedIP(ctx, claims, ip)
+	}
+
+	mm_params := AuthServiceMockCheckAllowedIPParams{ctx, claims, ip}
+
+	// Record call args
+	mmCheckAllowedIP.CheckAllowedIPMock.mutex.Lock()
+	mmCheckAllowedIP.CheckAllowedIPMock.callArgs = append(mmCheckAllowedIP.CheckAllowedIPMock.callArgs, &mm_params)
+	mmCheckAllowedIP.CheckAllowedIPMock.mutex.Unlock()
+
+	for _, e := range mmCheckAllowedIP.CheckAllowedIPMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCheckAllowedIP.CheckAllowedIPMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCheckAllowedIP.CheckAllowedIPMock.defaultExpectation.Counter, 1)
+		mm_want := mmCheckAllowedIP.CheckAllowedIPMock.defaultExpectation.params
+		mm_want_ptrs := mmCheckAllowedIP.CheckAllowedIPMock.defaultExpectation.paramPtrs
+
+		mm_got := AuthServiceMockCheckAllowedIPParams{ctx, claims, ip}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCheckAllowedIP.t.Errorf("AuthServiceMock.CheckAllowedIP got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCheckAllowedIP.CheckAllowedIPMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.claims != nil && !minimock.Equal(*mm_want_ptrs.claims, mm_got.claims) {
+				mmCheckAllowedIP.t.Errorf("AuthServiceMock.CheckAllowedIP got unexpected parameter claims, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCheckAllowedIP.CheckAllowedIPMock.defaultExpectation.expectationOrigins.originClaims, *mm_want_ptrs.claims, mm_got.claims, minimock.Diff(*mm_want_ptrs.claims, mm_got.claims))
+			}
+
+			if mm_want_ptrs.ip != nil && !minimock.Equal(*mm_want_ptrs.ip, mm_got.ip) {
+				mmCheckAllowedIP.t.Errorf("AuthServiceMock.CheckAllowedIP got unexpected parameter ip, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCheckAllowedIP.CheckAllowedIPMock.defaultExpectation.expectationOrigins.originIp, *mm_want_ptrs.ip, mm_got.ip, minimock.Diff(*mm_want_ptrs.ip, mm_got.ip))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCheckAllowedIP.t.Errorf("AuthServiceMock.CheckAllowedIP got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCheckAllowedIP.CheckAllowedIPMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCheckAllowedIP.CheckAllowedIPMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCheckAllowedIP.t.Fatal("No results are set for the AuthServiceMock.CheckAllowedIP")
+		}
+		return (*mm_results).err
+	}
+	if mmCheckAllowedIP.funcCheckAllowedIP != nil {
+		return mmCheckAllowedIP.funcCheckAllowedIP(ctx, claims, ip)
+	}
+	mmCheckAllowedIP.t.Fatalf("Unexpected call to AuthServiceMock.CheckAllowedIP. %v %v %v", ctx, claims, ip)
+	return
+}
+
+// CheckAllowedIPAfterCounter returns a count of finished AuthServiceMock.CheckAllowedIP invocations
+func (mmCheckAllowedIP *AuthServiceMock) CheckAllowedIPAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCheckAllowedIP.afterCheckAllowedIPCounter)
+}
+
+// CheckAllowedIPBeforeCounter returns a count of AuthServiceMock.CheckAllowedIP invocations
+func (mmCheckAllowedIP *AuthServiceMock) CheckAllowedIPBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCheckAllowedIP.beforeCheckAllowedIPCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuthServiceMock.CheckAllowedIP.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCheckAllowedIP *mAuthServiceMockCheckAllowedIP) Calls() []*AuthServiceMockCheckAllowedIPParams {
+	mmCheckAllowedIP.mutex.RLock()
+
+	argCopy := make([]*AuthServiceMockCheckAllowedIPParams, len(mmCheckAllowedIP.callArgs))
+	copy(argCopy, mmCheckAllowedIP.callArgs)
+
+	mmCheckAllowedIP.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCheckAllowedIPDone returns true if the count of the CheckAllowedIP invocations corresponds
+// the number of defined expectations
+func (m *AuthServiceMock) MinimockCheckAllowedIPDone() bool {
+	if m.CheckAllowedIPMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CheckAllowedIPMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CheckAllowedIPMock.invocationsDone()
+}
+
+// MinimockCheckAllowedIPInspect logs each unmet expectation
+func (m *AuthServiceMock) MinimockCheckAllowedIPInspect() {
+	for _, e := range m.CheckAllowedIPMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuthServiceMock.CheckAllowedIP at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCheckAllowedIPCounter := mm_atomic.LoadUint64(&m.afterCheckAllowedIPCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CheckAllowedIPMock.defaultExpectation != nil && afterCheckAllowedIPCounter < 1 {
+		if m.CheckAllowedIPMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuthServiceMock.CheckAllowedIP at\n%s", m.CheckAllowedIPMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AuthServiceMock.CheckAllowedIP at\n%s with params: %#v", m.CheckAllowedIPMock.defaultExpectation.expectationOrigins.origin, *m.CheckAllowedIPMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCheckAllowedIP != nil && afterCheckAllowedIPCounter < 1 {
+		m.t.Errorf("Expected call to AuthServiceMock.CheckAllowedIP at\n%s", m.funcCheckAllowedIPOrigin)
+	}
+
+	if !m.CheckAllowedIPMock.invocationsDone() && afterCheckAllowedIPCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuthServiceMock.CheckAllowedIP at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CheckAllowedIPMock.expectedInvocations), m.CheckAllowedIPMock.expectedInvocationsOrigin, afterCheckAllowedIPCounter)
+	}
+}
+
+type mAuthServiceMockPublicKeys struct {
+	optional           bool
+	mock               *AuthServiceMock
+	defaultExpectation *AuthServiceMockPublicKeysExpectation
+	expectations       []*AuthServiceMockPublicKeysExpectation
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AuthServiceMockPublicKeysExpectation specifies expectation struct of the AuthService.PublicKeys
+type AuthServiceMockPublicKeysExpectation struct {
+	mock *AuthServiceMock
+
+	results      *AuthServiceMockPublicKeysResults
+	returnOrigin string
+	Counter      uint64
+}
+
+// AuthServiceMockPublicKeysResults contains results of the AuthService.PublicKeys
+type AuthServiceMockPublicKeysResults struct {
+	pa1 []models.PublicKey
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmPublicKeys *mAuthServiceMockPublicKeys) Optional() *mAuthServiceMockPublicKeys {
+	mmPublicKeys.optional = true
+	return mmPublicKeys
+}
+
+// Expect sets up expected params for AuthService.PublicKeys
+func (mmPublicKeys *mAuthServiceMockPublicKeys) Expect() *mAuthServiceMockPublicKeys {
+	if mmPublicKeys.mock.funcPublicKeys != nil {
+		mmPublicKeys.mock.t.Fatalf("AuthServiceMock.PublicKeys mock is already set by Set")
+	}
+
+	if mmPublicKeys.defaultExpectation == nil {
+		mmPublicKeys.defaultExpectation = &AuthServiceMockPublicKeysExpectation{}
+	}
+
+	return mmPublicKeys
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuthService.PublicKeys
+func (mmPublicKeys *mAuthServiceMockPublicKeys) Inspect(f func()) *mAuthServiceMockPublicKeys {
+	if mmPublicKeys.mock.inspectFuncPublicKeys != nil {
+		mmPublicKeys.mock.t.Fatalf("Inspect function is already set for AuthServiceMock.PublicKeys")
+	}
+
+	mmPublicKeys.mock.inspectFuncPublicKeys = f
+
+	return mmPublicKeys
+}
+
+// Return sets up results that will be returned by AuthService.PublicKeys
+func (mmPublicKeys *mAuthServiceMockPublicKeys) Return(pa1 []models.PublicKey) *AuthServiceMock {
+	if mmPublicKeys.mock.funcPublicKeys != nil {
+		mmPublicKeys.mock.t.Fatalf("AuthServiceMock.PublicKeys mock is already set by Set")
+	}
+
+	if mmPublicKeys.defaultExpectation == nil {
+		mmPublicKeys.defaultExpectation = &AuthServiceMockPublicKeysExpectation{mock: mmPublicKeys.mock}
+	}
+	mmPublicKeys.defaultExpectation.results = &AuthServiceMockPublicKeysResults{pa1}
+	mmPublicKeys.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmPublicKeys.mock
+}
+
+// Set uses given function f to mock the AuthService.PublicKeys method
+func (mmPublicKeys *mAuthServiceMockPublicKeys) Set(f func() (pa1 []models.PublicKey)) *AuthServiceMock {
+	if mmPublicKeys.defaultExpectation != nil {
+		mmPublicKeys.mock.t.Fatalf("Default expectation is already set for the AuthService.PublicKeys method")
+	}
+
+	if len(mmPublicKeys.expectations) > 0 {
+		mmPublicKeys.mock.t.Fatalf("Some expectations are already set for the AuthService.PublicKeys method")
+	}
+
+	mmPublicKeys.mock.funcPublicKeys = f
+	mmPublicKeys.mock.funcPublicKeysOrigin = minimock.CallerInfo(1)
+	return mmPublicKeys.mock
+}
+
+// Times sets number of times AuthService.PublicKeys should be invoked
+func (mmPublicKeys *mAuthServiceMockPublicKeys) Times(n uint64) *mAuthServiceMockPublicKeys {
+	if n == 0 {
+		mmPublicKeys.mock.t.Fatalf("Times of AuthServiceMock.PublicKeys mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmPublicKeys.expectedInvocations, n)
+	mmPublicKeys.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmPublicKeys
+}
+
+func (mmPublicKeys *mAuthServiceMockPublicKeys) invocationsDone() bool {
+	if len(mmPublicKeys.expectations) == 0 && mmPublicKeys.defaultExpectation == nil && mmPublicKeys.mock.funcPublicKeys == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmPublicKeys.mock.afterPublicKeysCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmPublicKeys.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// PublicKeys implements AuthService
+func (mmPublicKeys *AuthServiceMock) PublicKeys() (pa1 []models.PublicKey) {
+	mm_atomic.AddUint64(&mmPublicKeys.beforePublicKeysCounter, 1)
+	defer mm_atomic.AddUint64(&mmPublicKeys.afterPublicKeysCounter, 1)
+
+	mmPublicKeys.t.Helper()
+
+	if mmPublicKeys.inspectFuncPublicKeys != nil {
+		mmPublicKeys.inspectFuncPublicKeys()
+	}
+
+	if mmPublicKeys.PublicKeysMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmPublicKeys.PublicKeysMock.defaultExpectation.Counter, 1)
+
+		mm_results := mmPublicKeys.PublicKeysMock.defaultExpectation.results
+		if mm_results == nil {
+			mmPublicKeys.t.Fatal("No results are set for the AuthServiceMock.PublicKeys")
+		}
+		return (*mm_results).pa1
+	}
+	if mmPublicKeys.funcPublicKeys != nil {
+		return mmPublicKeys.funcPublicKeys()
+	}
+	mmPublicKeys.t.Fatalf("Unexpected call to AuthServiceMock.PublicKeys.")
+	return
+}
+
+// PublicKeysAfterCounter returns a count of finished AuthServiceMock.PublicKeys invocations
+func (mmPublicKeys *AuthServiceMock) PublicKeysAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPublicKeys.afterPublicKeysCounter)
+}
+
+// PublicKeysBeforeCounter returns a count of AuthServiceMock.PublicKeys invocations
+func (mmPublicKeys *AuthServiceMock) PublicKeysBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPublicKeys.beforePublicKeysCounter)
+}
+
+// MinimockPublicKeysDone returns true if the count of the PublicKeys invocations corresponds
+// the number of defined expectations
+func (m *AuthServiceMock) MinimockPublicKeysDone() bool {
+	if m.PublicKeysMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.PublicKeysMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.PublicKeysMock.invocationsDone()
+}
+
+// MinimockPublicKeysInspect logs each unmet expectation
+func (m *AuthServiceMock) MinimockPublicKeysInspect() {
+	for _, e := range m.PublicKeysMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Error("Expected call to AuthServiceMock.PublicKeys")
+		}
+	}
+
+	afterPublicKeysCounter := mm_atomic.LoadUint64(&m.afterPublicKeysCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.PublicKeysMock.defaultExpectation != nil && afterPublicKeysCounter < 1 {
+		m.t.Errorf("Expected call to AuthServiceMock.PublicKeys at\n%s", m.PublicKeysMock.defaultExpectation.returnOrigin)
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcPublicKeys != nil && afterPublicKeysCounter < 1 {
+		m.t.Errorf("Expected call to AuthServiceMock.PublicKeys at\n%s", m.funcPublicKeysOrigin)
+	}
+
+	if !m.PublicKeysMock.invocationsDone() && afterPublicKeysCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuthServiceMock.PublicKeys at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.PublicKeysMock.expectedInvocations), m.PublicKeysMock.expectedInvocationsOrigin, afterPublicKeysCounter)
+	}
+}
+
+type mAuthServiceMockSignIn struct {
+	optional           bool
+	mock               *AuthServiceMock
+	defaultExpectation *AuthServiceMockSignInExpectation
+	expectations       []*AuthServiceMockSignInExpectation
+
+	callArgs []*AuthServiceMockSignInParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AuthServiceMockSignInExpectation specifies expectation struct of the AuthService.SignIn
+type AuthServiceMockSignInExpectation struct {
+	mock               *AuthServiceMock
+	params             *AuthServiceMockSignInParams
+	paramPtrs          *AuthServiceMockSignInParamPtrs
+	expectationOrigins AuthServiceMockSignInExpectationOrigins
+	results            *AuthServiceMockSignInResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AuthServiceMockSignInParams contains parameters of the AuthService.SignIn
+type AuthServiceMockSignInParams struct {
+	ctx       context.Context
+	email     string
+	password  string
+	userAgent string
+	ip        string
+}
+
+// AuthServiceMockSignInParamPtrs contains pointers to parameters of the AuthService.SignIn
+type AuthServiceMockSignInParamPtrs struct {
+	ctx       *context.Context
+	email     *string
+	password  *string
+	userAgent *string
+	ip        *string
+}
+
+// AuthServiceMockSignInResults contains results of the AuthService.SignIn
+type AuthServiceMockSignInResults struct {
+	s1  string
+	err error
+}
+
+// AuthServiceMockSignInOrigins contains origins of expectations of the AuthService.SignIn
+type AuthServiceMockSignInExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originEmail     string
+	originPassword  string
+	originUserAgent string
+	originIp        string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSignIn *mAuthServiceMockSignIn) Optional() *mAuthServiceMockSignIn {
+	mmSignIn.optional = true
+	return mmSignIn
+}
+
+// Expect sets up expected params for AuthService.SignIn
+func (mmSignIn *mAuthServiceMockSignIn) Expect(ctx context.Context, email string, password string, userAgent string, ip string) *mAuthServiceMockSignIn {
+	if mmSignIn.mock.funcSignIn != nil {
+		mmSignIn.mock.t.Fatalf("AuthServiceMock.SignIn mock is already set by Set")
+	}
+
+	if mmSignIn.defaultExpectation == nil {
+		mmSignIn.defaultExpectation = &AuthServiceMockSignInExpectation{}
+	}
+
+	if mmSignIn.defaultExpectation.paramPtrs != nil {
+		mmSignIn.mock.t.Fatalf("AuthServiceMock.SignIn mock is already set by ExpectParams functions")
+	}
+
+	mmSignIn.defaultExpectation.params = &AuthServiceMockSignInParams{ctx, email, password, userAgent, ip}
+	mmSignIn.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSignIn.expectations {
+		if minimock.Equal(e.params, mmSignIn.defaultExpectation.params) {
+			mmSignIn.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSignIn.defaultExpectation.params)
+		}
+	}
+
+	return mmSignIn
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuthService.SignIn
+func (mmSignIn *mAuthServiceMockSignIn) ExpectCtxParam1(ctx context.Context) *mAuthServiceMockSignIn {
+	if mmSignIn.mock.funcSignIn != nil {
+		mmSignIn.mock.t.Fatalf("AuthServiceMock.SignIn mock is already set by Set")
+	}
+
+	if mmSignIn.defaultExpectation == nil {
+		mmSignIn.defaultExpectation = &AuthServiceMockSignInExpectation{}
+	}
+
+	if mmSignIn.defaultExpectation.params != nil {
+		mmSignIn.mock.t.Fatalf("AuthServiceMock.SignIn mock is already set by Expect")
+	}
+
+	if mmSignIn.defaultExpectation.paramPtrs == nil {
+		mmSignIn.defaultExpectation.paramPtrs = &AuthServiceMockSignInParamPtrs{}
+	}
+	mmSignIn.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSignIn.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSignIn
+}
+
+// ExpectEmailParam2 sets up expected param email for AuthService.SignIn
+func (mmSignIn *mAuthServiceMockSignIn) ExpectEmailParam2(email string) *mAuthServiceMockSignIn {
+	if mmSignIn.mock.funcSignIn != nil {
+		mmSignIn.mock.t.Fatalf("AuthServiceMock.SignIn mock is already set by Set")
+	}
+
+	if mmSignIn.defaultExpectation == nil {
+		mmSignIn.defaultExpectation = &AuthServiceMockSignInExpectation{}
+	}
+
+	if mmSignIn.defaultExpectation.params != nil {
+		mmSignIn.mock.t.Fatalf("AuthServiceMock.SignIn mock is already set by Expect")
+	}
+
+	if mmSignIn.defaultExpectation.paramPtrs == nil {
+		mmSignIn.defaultExpectation.paramPtrs = &AuthServiceMockSignInParamPtrs{}
+	}
+	mmSignIn.defaultExpectation.paramPtrs.email = &email
+	mmSignIn.defaultExpectation.expectationOrigins.originEmail = minimock.CallerInfo(1)
+
+	return mmSignIn
+}
+
+// ExpectPasswordParam3 sets up expected param password for AuthService.SignIn
+func (mmSignIn *mAuthServiceMockSignIn) ExpectPasswordParam3(password string) *mAuthServiceMockSignIn {
+	if mmSignIn.mock.funcSignIn != nil {
+		mmSignIn.mock.t.Fatalf("AuthServiceMock.SignIn mock is already set by Set")
+	}
+
+	if mmSignIn.defaultExpectation == nil {
+		mmSignIn.defaultExpectation = &AuthServiceMockSignInExpectation{}
+	}
+
+	if mmSignIn.defaultExpectation.params != nil {
+		mmSignIn.mock.t.Fatalf("AuthServiceMock.SignIn mock is already set by Expect")
+	}
+
+	if mmSignIn.defaultExpectation.paramPtrs == nil {
+		mmSignIn.defaultExpectation.paramPtrs = &AuthServiceMockSignInParamPtrs{}
+	}
+	mmSignIn.defaultExpectation.paramPtrs.password = &password
+	mmSignIn.defaultExpectation.expectationOrigins.originPassword = minimock.CallerInfo(1)
+
+	return mmSignIn
+}
+
+// ExpectUserAgentParam4 sets up expected param userAgent for AuthService.SignIn
+func (mmSignIn *mAuthServiceMockSignIn) ExpectUserAgentParam4(userAgent string) *mAuthServiceMockSignIn {
+	if mmSignIn.mock.funcSignIn != nil {
+		mmSignIn.mock.t.Fatalf("AuthServiceMock.SignIn mock is already set by Set")
+	}
+
+	if mmSignIn.defaultExpectation == nil {
+		mmSignIn.defaultExpectation = &AuthServiceMockSignInExpectation{}
+	}
+
+	if mmSignIn.defaultExpectation.params != nil {
+		mmSignIn.mock.t.Fatalf("AuthServiceMock.SignIn mock is already set by Expect")
+	}
+
+	if mmSignIn.defaultExpectation.paramPtrs == nil {
+		mmSignIn.defaultExpectation.paramPtrs = &AuthServiceMockSignInParamPtrs{}
+	}
+	mmSignIn.defaultExpectation.paramPtrs.userAgent = &userAgent
+	mmSignIn.defaultExpectation.expectationOrigins.originUserAgent = minimock.CallerInfo(1)
+
+	return mmSignIn
+}
+
+// ExpectIpParam5 sets up expected param ip for AuthService.SignIn
+func (mmSignIn *mAuthServiceMockSignIn) ExpectIpParam5(ip string) *mAuthServiceMockSignIn {
+	if mmSignIn.mock.funcSignIn != nil {
+		mmSignIn.mock.t.Fatalf("AuthServiceMock.SignIn mock is already set by Set")
+	}
+
+	if mmSignIn.defaultExpectation == nil {
+		mmSignIn.defaultExpectation = &AuthServiceMockSignInExpectation{}
+	}
+
+	if mmSignIn.defaultExpectation.params != nil {
+		mmSignIn.mock.t.Fatalf("AuthServiceMock.SignIn mock is already set by Expect")
+	}
+
+	if mmSignIn.defaultExpectation.paramPtrs == nil {
+		mmSignIn.defaultExpectation.paramPtrs = &AuthServiceMockSignInParamPtrs{}
+	}
+	mmSignIn.defaultExpectation.paramPtrs.ip = &ip
+	mmSignIn.defaultExpectation.expectationOrigins.originIp = minimock.CallerInfo(1)
+
+	return mmSignIn
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuthService.SignIn
+func (mmSignIn *mAuthServiceMockSignIn) Inspect(f func(ctx context.Context, email string, password string, userAgent string, ip string)) *mAuthServiceMockSignIn {
+	if mmSignIn.mock.inspectFuncSignIn != nil {
+		mmSignIn.mock.t.Fatalf("Inspect function is already set for AuthServiceMock.SignIn")
+	}
+
+	mmSignIn.mock.inspectFuncSignIn = f
+
+	return mmSignIn
+}
+
+// Return sets up results that will be returned by AuthService.SignIn
+func (mmSignIn *mAuthServiceMockSignIn) Return(s1 string, err error) *AuthServiceMock {
+	if mmSignIn.mock.funcSignIn != nil {
+		mmSignIn.mock.t.Fatalf("AuthServiceMock.SignIn mock is already set by Set")
+	}
+
+	if mmSignIn.defaultExpectation == nil {
+		mmSignIn.defaultExpectation = &AuthServiceMockSignInExpectation{mock: mmSignIn.mock}
+	}
+	mmSignIn.defaultExpectation.results = &AuthServiceMockSignInResults{s1, err}
+	mmSignIn.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSignIn.mock
+}
+
+// Set uses given function f to mock the AuthService.SignIn method
+func (mmSignIn *mAuthServiceMockSignIn) Set(f func(ctx context.Context, email string, password string, userAgent string, ip string) (s1 string, err error)) *AuthServiceMock {
+	if mmSignIn.defaultExpectation != nil {
+		mmSignIn.mock.t.Fatalf("Default expectation is already set for the AuthService.SignIn method")
+	}
+
+	if len(mmSignIn.expectations) > 0 {
+		mmSignIn.mock.t.Fatalf("Some expectations are already set for the AuthService.SignIn method")
+	}
+
+	mmSignIn.mock.funcSignIn = f
+	mmSignIn.mock.funcSignInOrigin = minimock.CallerInfo(1)
+	return mmSignIn.mock
+}
+
+// When sets expectation for the AuthService.SignIn which will trigger the result defined by the following
+// Then helper
+func (mmSignIn *mAuthServiceMockSignIn) When(ctx context.Context, email string, password string, userAgent string, ip string) *AuthServiceMockSignInExpectation {
+	if mmSignIn.mock.funcSignIn != nil {
+		mmSignIn.mock.t.Fatalf("AuthServiceMock.SignIn mock is already set by Set")
+	}
+
+	expectation := &AuthServiceMockSignInExpectation{
+		mock:               mmSignIn.mock,
+		params:             &AuthServiceMockSignInParams{ctx, email, password, userAgent, ip},
+		expectationOrigins: AuthServiceMockSignInExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSignIn.expectations = append(mmSignIn.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuthService.SignIn return parameters for the expectation previously defined by the When method
+func (e *AuthServiceMockSignInExpectation) Then(s1 string, err error) *AuthServiceMock {
+	e.results = &AuthServiceMockSignInResults{s1, err}
+	return e.mock
+}
+
+// Times sets number of times AuthService.SignIn should be invoked
+func (mmSignIn *mAuthServiceMockSignIn) Times(n uint64) *mAuthServiceMockSignIn {
+	if n == 0 {
+		mmSignIn.mock.t.Fatalf("Times of AuthServiceMock.SignIn mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSignIn.expectedInvocations, n)
+	mmSignIn.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSignIn
+}
+
+func (mmSignIn *mAuthServiceMockSignIn) invocationsDone() bool {
+	if len(mmSignIn.expectations) == 0 && mmSignIn.defaultExpectation == nil && mmSignIn.mock.funcSignIn == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSignIn.mock.afterSignInCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSignIn.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// SignIn implements AuthService
+func (mmSignIn *AuthServiceMock) SignIn(ctx context.Context, email string, password string, userAgent string, ip string) (s1 string, err error) {
+	mm_atomic.AddUint64(&mmSignIn.beforeSignInCounter, 1)
+	defer mm_atomic.AddUint64(&mmSignIn.afterSignInCounter, 1)
+
+	mmSignIn.t.Helper()
+
+	if mmSignIn.inspectFuncSignIn != nil {
+		mmSignIn.inspectFuncSignIn(ctx, email, password, userAgent, ip)
+	}
+
+	mm_params := AuthServiceMockSignInParams{ctx, email, password, userAgent, ip}
+
+	// Record call args
+	mmSignIn.SignInMock.mutex.Lock()
+	mmSignIn.SignInMock.callArgs = append(mmSignIn.SignInMock.callArgs, &mm_params)
+	mmSignIn.SignInMock.mutex.Unlock()
+
+	for _, e := range mmSignIn.SignInMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.s1, e.results.err
+		}
+	}
+
+	if mmSignIn.SignInMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSignIn.SignInMock.defaultExpectation.Counter, 1)
+		mm_want := mmSignIn.SignInMock.defaultExpectation.params
+		mm_want_ptrs := mmSignIn.SignInMock.defaultExpectation.paramPtrs
+
+		mm_got := AuthServiceMockSignInParams{ctx, email, password, userAgent, ip}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSignIn.t.Errorf("AuthServiceMock.SignIn got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSignIn.SignInMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.email != nil && !minimock.Equal(*mm_want_ptrs.email, mm_got.email) {
+				mmSignIn.t.Errorf("AuthServiceMock.SignIn got unexpected parameter email, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSignIn.SignInMock.defaultExpectation.expectationOrigins.originEmail, *mm_want_ptrs.email, mm_got.email, minimock.Diff(*mm_want_ptrs.email, mm_got.email))
+			}
+
+			if mm_want_ptrs.password != nil && !minimock.Equal(*mm_want_ptrs.password, mm_got.password) {
+				mmSignIn.t.Errorf("AuthServiceMock.SignIn got unexpected parameter password, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSignIn.SignInMock.defaultExpectation.expectationOrigins.originPassword, *mm_want_ptrs.password, mm_got.password, minimock.Diff(*mm_want_ptrs.password, mm_got.password))
+			}
+
+			if mm_want_ptrs.userAgent != nil && !minimock.Equal(*mm_want_ptrs.userAgent, mm_got.userAgent) {
+				mmSignIn.t.Errorf("AuthServiceMock.SignIn got unexpected parameter userAgent, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSignIn.SignInMock.defaultExpectation.expectationOrigins.originUserAgent, *mm_want_ptrs.userAgent, mm_got.userAgent, minimock.Diff(*mm_want_ptrs.userAgent, mm_got.userAgent))
+			}
+
+			if mm_want_ptrs.ip != nil && !minimock.Equal(*mm_want_ptrs.ip, mm_got.ip) {
+				mmSignIn.t.Errorf("AuthServiceMock.SignIn got unexpected parameter ip, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSignIn.SignInMock.defaultExpectation.expectationOrigins.originIp, *mm_want_ptrs.ip, mm_got.ip, minimock.Diff(*mm_want_ptrs.ip, mm_got.ip))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSignIn.t.Errorf("AuthServiceMock.SignIn got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSignIn.SignInMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSignIn.SignInMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSignIn.t.Fatal("No results are set for the AuthServiceMock.SignIn")
+		}
+		return (*mm_results).s1, (*mm_results).err
+	}
+	if mmSignIn.funcSignIn != nil {
+		return mmSignIn.funcSignIn(ctx, email, password, userAgent, ip)
+	}
+	mmSignIn.t.Fatalf("Unexpected call to AuthServiceMock.SignIn. %v %v %v %v %v", ctx, email, password, userAgent, ip)
+	return
+}
+
+// SignInAfterCounter returns a count of finished AuthServiceMock.SignIn invocations
+func (mmSignIn *AuthServiceMock) SignInAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSignIn.afterSignInCounter)
+}
+
+// SignInBeforeCounter returns a count of AuthServiceMock.SignIn invocations
+func (mmSignIn *AuthServiceMock) SignInBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSignIn.beforeSignInCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuthServiceMock.SignIn.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSignIn *mAuthServiceMockSignIn) Calls() []*AuthServiceMockSignInParams {
+	mmSignIn.mutex.RLock()
+
+	argCopy := make([]*AuthServiceMockSignInParams, len(mmSignIn.callArgs))
+	copy(argCopy, mmSignIn.callArgs)
+
+	mmSignIn.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSignInDone returns true if the count of the SignIn invocations corresponds
+// the number of defined expectations
+func (m *AuthServiceMock) MinimockSignInDone() bool {
+	if m.SignInMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SignInMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SignInMock.invocationsDone()
+}
+
+// MinimockSignInInspect logs each unmet expectation
+func (m *AuthServiceMock) MinimockSignInInspect() {
+	for _, e := range m.SignInMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuthServiceMock.SignIn at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSignInCounter := mm_atomic.LoadUint64(&m.afterSignInCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SignInMock.defaultExpectation != nil && afterSignInCounter < 1 {
+		if m.SignInMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuthServiceMock.SignIn at\n%s", m.SignInMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AuthServiceMock.SignIn at\n%s with params: %#v", m.SignInMock.defaultExpectation.expectationOrigins.origin, *m.SignInMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSignIn != nil && afterSignInCounter < 1 {
+		m.t.Errorf("Expected call to AuthServiceMock.SignIn at\n%s", m.funcSignInOrigin)
+	}
+
+	if !m.SignInMock.invocationsDone() && afterSignInCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuthServiceMock.SignIn at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SignInMock.expectedInvocations), m.SignInMock.expectedInvocationsOrigin, afterSignInCounter)
+	}
+}
+
+type mAuthServiceMockSignInSession struct {
+	optional           bool
+	mock               *AuthServiceMock
+	defaultExpectation *AuthServiceMockSignInSessionExpectation
+	expectations       []*AuthServiceMockSignInSessionExpectation
+
+	callArgs []*AuthServiceMockSignInSessionParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AuthServiceMockSignInSessionExpectation specifies expectation struct of the AuthService.SignInSession
+type AuthServiceMockSignInSessionExpectation struct {
+	mock               *AuthServiceMock
+	params             *AuthServiceMockSignInSessionParams
+	paramPtrs          *AuthServiceMockSignInSessionParamPtrs
+	expectationOrigins AuthServiceMockSignInSessionExpectationOrigins
+	results            *AuthServiceMockSignInSessionResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AuthServiceMockSignInSessionParams contains parameters of the AuthService.SignInSession
+type AuthServiceMockSignInSessionParams struct {
+	ctx       context.Context
+	email     string
+	password  string
+	userAgent string
+	ip        string
+}
+
+// AuthServiceMockSignInSessionParamPtrs contains pointers to parameters of the AuthService.SignInSession
+type AuthServiceMockSignInSessionParamPtrs struct {
+	ctx       *context.Context
+	email     *string
+	password  *string
+	userAgent *string
+	ip        *string
+}
+
+// AuthServiceMockSignInSessionResults contains results of the AuthService.SignInSession
+type AuthServiceMockSignInSessionResults struct {
+	s1  string
+	err error
+}
+
+// AuthServiceMockSignInSessionOrigins contains origins of expectations of the AuthService.SignInSession
+type AuthServiceMockSignInSessionExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originEmail     string
+	originPassword  string
+	originUserAgent string
+	originIp        string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSignInSession *mAuthServiceMockSignInSession) Optional() *mAuthServiceMockSignInSession {
+	mmSignInSession.optional = true
+	return mmSignInSession
+}
+
+// Expect sets up expected params for AuthService.SignInSession
+func (mmSignInSession *mAuthServiceMockSignInSession) Expect(ctx context.Context, email string, password string, userAgent string, ip string) *mAuthServiceMockSignInSession {
+	if mmSignInSession.mock.funcSignInSession != nil {
+		mmSignInSession.mock.t.Fatalf("AuthServiceMock.SignInSession mock is already set by Set")
+	}
+
+	if mmSignInSession.defaultExpectation == nil {
+		mmSignInSession.defaultExpectation = &AuthServiceMockSignInSessionExpectation{}
+	}
+
+	if mmSignInSession.defaultExpectation.paramPtrs != nil {
+		mmSignInSession.mock.t.Fatalf("AuthServiceMock.SignInSession mock is already set by ExpectParams functions")
+	}
+
+	mmSignInSession.defaultExpectation.params = &AuthServiceMockSignInSessionParams{ctx, email, password, userAgent, ip}
+	mmSignInSession.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSignInSession.expectations {
+		if minimock.Equal(e.params, mmSignInSession.defaultExpectation.params) {
+			mmSignInSession.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSignInSession.defaultExpectation.params)
+		}
+	}
+
+	return mmSignInSession
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuthService.SignInSession
+func (mmSignInSession *mAuthServiceMockSignInSession) ExpectCtxParam1(ctx context.Context) *mAuthServiceMockSignInSession {
+	if mmSignInSession.mock.funcSignInSession != nil {
+		mmSignInSession.mock.t.Fatalf("AuthServiceMock.SignInSession mock is already set by Set")
+	}
+
+	if mmSignInSession.defaultExpectation == nil {
+		mmSignInSession.defaultExpectation = &AuthServiceMockSignInSessionExpectation{}
+	}
+
+	if mmSignInSession.defaultExpectation.params != nil {
+		mmSignInSession.mock.t.Fatalf("AuthServiceMock.SignInSession mock is already set by Expect")
+	}
+
+	if mmSignInSession.defaultExpectation.paramPtrs == nil {
+		mmSignInSession.defaultExpectation.paramPtrs = &AuthServiceMockSignInSessionParamPtrs{}
+	}
+	mmSignInSession.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSignInSession.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSignInSession
+}
+
+// ExpectEmailParam2 sets up expected param email for AuthService.SignInSession
+func (mmSignInSession *mAuthServiceMockSignInSession) ExpectEmailParam2(email string) *mAuthServiceMockSignInSession {
+	if mmSignInSession.mock.funcSignInSession != nil {
+		mmSignInSession.mock.t.Fatalf("AuthServiceMock.SignInSession mock is already set by Set")
+	}
+
+	if mmSignInSession.defaultExpectation == nil {
+		mmSignInSession.defaultExpectation = &AuthServiceMockSignInSessionExpectation{}
+	}
+
+	if mmSignInSession.defaultExpectation.params != nil {
+		mmSignInSession.mock.t.Fatalf("AuthServiceMock.SignInSession mock is already set by Expect")
+	}
+
+	if mmSignInSession.defaultExpectation.paramPtrs == nil {
+		mmSignInSession.defaultExpectation.paramPtrs = &AuthServiceMockSignInSessionParamPtrs{}
+	}
+	mmSignInSession.defaultExpectation.paramPtrs.email = &email
+	mmSignInSession.defaultExpectation.expectationOrigins.originEmail = minimock.CallerInfo(1)
+
+	return mmSignInSession
+}
+
+// ExpectPasswordParam3 sets up expected param password for AuthService.SignInSession
+func (mmSignInSession *mAuthServiceMockSignInSession) ExpectPasswordParam3(password string) *mAuthServiceMockSignInSession {
+	if mmSignInSession.mock.funcSignInSession != nil {
+		mmSignInSession.mock.t.Fatalf("AuthServiceMock.SignInSession mock is already set by Set")
+	}
+
+	if mmSignInSession.defaultExpectation == nil {
+		mmSignInSession.defaultExpectation = &AuthServiceMockSignInSessionExpectation{}
+	}
+
+	if mmSignInSession.defaultExpectation.params != nil {
+		mmSignInSession.mock.t.Fatalf("AuthServiceMock.SignInSession mock is already set by Expect")
+	}
+
+	if mmSignInSession.defaultExpectation.paramPtrs == nil {
+		mmSignInSession.defaultExpectation.paramPtrs = &AuthServiceMockSignInSessionParamPtrs{}
+	}
+	mmSignInSession.defaultExpectation.paramPtrs.password = &password
+	mmSignInSession.defaultExpectation.expectationOrigins.originPassword = minimock.CallerInfo(1)
+
+	return mmSignInSession
+}
+
+// ExpectUserAgentParam4 sets up expected param userAgent for AuthService.SignInSession
+func (mmSignInSession *mAuthServiceMockSignInSession) ExpectUserAgentParam4(userAgent string) *mAuthServiceMockSignInSession {
+	if mmSignInSession.mock.funcSignInSession != nil {
+		mmSignInSession.mock.t.Fatalf("AuthServiceMock.SignInSession mock is already set by Set")
+	}
+
+	if mmSignInSession.defaultExpectation == nil {
+		mmSignInSession.defaultExpectation = &AuthServiceMockSignInSessionExpectation{}
+	}
+
+	if mmSignInSession.defaultExpectation.params != nil {
+		mmSignInSession.mock.t.Fatalf("AuthServiceMock.SignInSession mock is already set by Expect")
+	}
+
+	if mmSignInSession.defaultExpectation.paramPtrs == nil {
+		mmSignInSession.defaultExpectation.paramPtrs = &AuthServiceMockSignInSessionParamPtrs{}
+	}
+	mmSignInSession.defaultExpectation.paramPtrs.userAgent = &userAgent
+	mmSignInSession.defaultExpectation.expectationOrigins.originUserAgent = minimock.CallerInfo(1)
+
+	return mmSignInSession
+}
+
+// ExpectIpParam5 sets up expected param ip for AuthService.SignInSession
+func (mmSignInSession *mAuthServiceMockSignInSession) ExpectIpParam5(ip string) *mAuthServiceMockSignInSession {
+	if mmSignInSession.mock.funcSignInSession != nil {
+		mmSignInSession.mock.t.Fatalf("AuthServiceMock.SignInSession mock is already set by Set")
+	}
+
+	if mmSignInSession.defaultExpectation == nil {
+		mmSignInSession.defaultExpectation = &AuthServiceMockSignInSessionExpectation{}
+	}
+
+	if mmSignInSession.defaultExpectation.params != nil {
+		mmSignInSession.mock.t.Fatalf("AuthServiceMock.SignInSession mock is already set by Expect")
+	}
+
+	if mmSignInSession.defaultExpectation.paramPtrs == nil {
+		mmSignInSession.defaultExpectation.paramPtrs = &AuthServiceMockSignInSessionParamPtrs{}
+	}
+	mmSignInSession.defaultExpectation.paramPtrs.ip = &ip
+	mmSignInSession.defaultExpectation.expectationOrigins.originIp = minimock.CallerInfo(1)
+
+	return mmSignInSession
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuthService.SignInSession
+func (mmSignInSession *mAuthServiceMockSignInSession) Inspect(f func(ctx context.Context, email string, password string, userAgent string, ip string)) *mAuthServiceMockSignInSession {
+	if mmSignInSession.mock.inspectFuncSignInSession != nil {
+		mmSignInSession.mock.t.Fatalf("Inspect function is already set for AuthServiceMock.SignInSession")
+	}
+
+	mmSignInSession.mock.inspectFuncSignInSession = f
+
+	return mmSignInSession
+}
+
+// Return sets up results that will be returned by AuthService.SignInSession
+func (mmSignInSession *mAuthServiceMockSignInSession) Return(s1 string, err error) *AuthServiceMock {
+	if mmSignInSession.mock.funcSignInSession != nil {
+		mmSignInSession.mock.t.Fatalf("AuthServiceMock.SignInSession mock is already set by Set")
+	}
+
+	if mmSignInSession.defaultExpectation == nil {
+		mmSignInSession.defaultExpectation = &AuthServiceMockSignInSessionExpectation{mock: mmSignInSession.mock}
+	}
+	mmSignInSession.defaultExpectation.results = &AuthServiceMockSignInSessionResults{s1, err}
+	mmSignInSession.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSignInSession.mock
+}
+
+// Set uses given function f to mock the AuthService.SignInSession method
+func (mmSignInSession *mAuthServiceMockSignInSession) Set(f func(ctx context.Context, email string, password string, userAgent string, ip string) (s1 string, err error)) *AuthServiceMock {
+	if mmSignInSession.defaultExpectation != nil {
+		mmSignInSession.mock.t.Fatalf("Default expectation is already set for the AuthService.SignInSession method")
+	}
+
+	if len(mmSignInSession.expectations) > 0 {
+		mmSignInSession.mock.t.Fatalf("Some expectations are already set for the AuthService.SignInSession method")
+	}
+
+	mmSignInSession.mock.funcSignInSession = f
+	mmSignInSession.mock.funcSignInSessionOrigin = minimock.CallerInfo(1)
+	return mmSignInSession.mock
+}
+
+// When sets expectation for the AuthService.SignInSession which will trigger the result defined by the following
+// Then helper
+func (mmSignInSession *mAuthServiceMockSignInSession) When(ctx context.Context, email string, password string, userAgent string, ip string) *AuthServiceMockSignInSessionExpectation {
+	if mmSignInSession.mock.funcSignInSession != nil {
+		mmSignInSession.mock.t.Fatalf("AuthServiceMock.SignInSession mock is already set by Set")
 	}
-	mmSignIn.expectations = append(mmSignIn.expectations, expectation)
+
+	expectation := &AuthServiceMockSignInSessionExpectation{
+		mock:               mmSignInSession.mock,
+		params:             &AuthServiceMockSignInSessionParams{ctx, email, password, userAgent, ip},
+		expectationOrigins: AuthServiceMockSignInSessionExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSignInSession.expectations = append(mmSignInSession.expectations, expectation)
 	return expectation
 }
 
-// Then sets up AuthService.SignIn return parameters for the expectation previously defined by the When method
-func (e *AuthServiceMockSignInExpectation) Then(s1 string, err error) *AuthServiceMock {
-	e.results = &AuthServiceMockSignInResults{s1, err}
+// Then sets up AuthService.SignInSession return parameters for the expectation previously defined by the When method
+func (e *AuthServiceMockSignInSessionExpectation) Then(s1 string, err error) *AuthServiceMock {
+	e.results = &AuthServiceMockSignInSessionResults{s1, err}
 	return e.mock
 }
 
-// Times sets number of times AuthService.SignIn should be invoked
-func (mmSignIn *mAuthServiceMockSignIn) Times(n uint64) *mAuthServiceMockSignIn {
+// Times sets number of times AuthService.SignInSession should be invoked
+func (mmSignInSession *mAuthServiceMockSignInSession) Times(n uint64) *mAuthServiceMockSignInSession {
 	if n == 0 {
-		mmSignIn.mock.t.Fatalf("Times of AuthServiceMock.SignIn mock can not be zero")
+		mmSignInSession.mock.t.Fatalf("Times of AuthServiceMock.SignInSession mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmSignIn.expectedInvocations, n)
-	mmSignIn.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmSignIn
+	mm_atomic.StoreUint64(&mmSignInSession.expectedInvocations, n)
+	mmSignInSession.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSignInSession
 }
 
-func (mmSignIn *mAuthServiceMockSignIn) invocationsDone() bool {
-	if len(mmSignIn.expectations) == 0 && mmSignIn.defaultExpectation == nil && mmSignIn.mock.funcSignIn == nil {
+func (mmSignInSession *mAuthServiceMockSignInSession) invocationsDone() bool {
+	if len(mmSignInSession.expectations) == 0 && mmSignInSession.defaultExpectation == nil && mmSignInSession.mock.funcSignInSession == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmSignIn.mock.afterSignInCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmSignIn.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmSignInSession.mock.afterSignInSessionCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSignInSession.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// SignIn implements AuthService
-func (mmSignIn *AuthServiceMock) SignIn(ctx context.Context, email string, password string) (s1 string, err error) {
-	mm_atomic.AddUint64(&mmSignIn.beforeSignInCounter, 1)
-	defer mm_atomic.AddUint64(&mmSignIn.afterSignInCounter, 1)
+// SignInSession implements AuthService
+func (mmSignInSession *AuthServiceMock) SignInSession(ctx context.Context, email string, password string, userAgent string, ip string) (s1 string, err error) {
+	mm_atomic.AddUint64(&mmSignInSession.beforeSignInSessionCounter, 1)
+	defer mm_atomic.AddUint64(&mmSignInSession.afterSignInSessionCounter, 1)
 
-	mmSignIn.t.Helper()
+	mmSignInSession.t.Helper()
 
-	if mmSignIn.inspectFuncSignIn != nil {
-		mmSignIn.inspectFuncSignIn(ctx, email, password)
+	if mmSignInSession.inspectFuncSignInSession != nil {
+		mmSignInSession.inspectFuncSignInSession(ctx, email, password, userAgent, ip)
 	}
 
-	mm_params := AuthServiceMockSignInParams{ctx, email, password}
+	mm_params := AuthServiceMockSignInSessionParams{ctx, email, password, userAgent, ip}
 
 	// Record call args
-	mmSignIn.SignInMock.mutex.Lock()
-	mmSignIn.SignInMock.callArgs = append(mmSignIn.SignInMock.callArgs, &mm_params)
-	mmSignIn.SignInMock.mutex.Unlock()
+	mmSignInSession.SignInSessionMock.mutex.Lock()
+	mmSignInSession.SignInSessionMock.callArgs = append(mmSignInSession.SignInSessionMock.callArgs, &mm_params)
+	mmSignInSession.SignInSessionMock.mutex.Unlock()
 
-	for _, e := range mmSignIn.SignInMock.expectations {
+	for _, e := range mmSignInSession.SignInSessionMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
 			return e.results.s1, e.results.err
 		}
 	}
 
-	if mmSignIn.SignInMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmSignIn.SignInMock.defaultExpectation.Counter, 1)
-		mm_want := mmSignIn.SignInMock.defaultExpectation.params
-		mm_want_ptrs := mmSignIn.SignInMock.defaultExpectation.paramPtrs
+	if mmSignInSession.SignInSessionMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSignInSession.SignInSessionMock.defaultExpectation.Counter, 1)
+		mm_want := mmSignInSession.SignInSessionMock.defaultExpectation.params
+		mm_want_ptrs := mmSignInSession.SignInSessionMock.defaultExpectation.paramPtrs
 
-		mm_got := AuthServiceMockSignInParams{ctx, email, password}
+		mm_got := AuthServiceMockSignInSessionParams{ctx, email, password, userAgent, ip}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmSignIn.t.Errorf("AuthServiceMock.SignIn got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmSignIn.SignInMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmSignInSession.t.Errorf("AuthServiceMock.SignInSession got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSignInSession.SignInSessionMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
 			if mm_want_ptrs.email != nil && !minimock.Equal(*mm_want_ptrs.email, mm_got.email) {
-				mmSignIn.t.Errorf("AuthServiceMock.SignIn got unexpected parameter email, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmSignIn.SignInMock.defaultExpectation.expectationOrigins.originEmail, *mm_want_ptrs.email, mm_got.email, minimock.Diff(*mm_want_ptrs.email, mm_got.email))
+				mmSignInSession.t.Errorf("AuthServiceMock.SignInSession got unexpected parameter email, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSignInSession.SignInSessionMock.defaultExpectation.expectationOrigins.originEmail, *mm_want_ptrs.email, mm_got.email, minimock.Diff(*mm_want_ptrs.email, mm_got.email))
 			}
 
 			if mm_want_ptrs.password != nil && !minimock.Equal(*mm_want_ptrs.password, mm_got.password) {
-				mmSignIn.t.Errorf("AuthServiceMock.SignIn got unexpected parameter password, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmSignIn.SignInMock.defaultExpectation.expectationOrigins.originPassword, *mm_want_ptrs.password, mm_got.password, minimock.Diff(*mm_want_ptrs.password, mm_got.password))
+				mmSignInSession.t.Errorf("AuthServiceMock.SignInSession got unexpected parameter password, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSignInSession.SignInSessionMock.defaultExpectation.expectationOrigins.originPassword, *mm_want_ptrs.password, mm_got.password, minimock.Diff(*mm_want_ptrs.password, mm_got.password))
+			}
+
+			if mm_want_ptrs.userAgent != nil && !minimock.Equal(*mm_want_ptrs.userAgent, mm_got.userAgent) {
+				mmSignInSession.t.Errorf("AuthServiceMock.SignInSession got unexpected parameter userAgent, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSignInSession.SignInSessionMock.defaultExpectation.expectationOrigins.originUserAgent, *mm_want_ptrs.userAgent, mm_got.userAgent, minimock.Diff(*mm_want_ptrs.userAgent, mm_got.userAgent))
+			}
+
+			if mm_want_ptrs.ip != nil && !minimock.Equal(*mm_want_ptrs.ip, mm_got.ip) {
+				mmSignInSession.t.Errorf("AuthServiceMock.SignInSession got unexpected parameter ip, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSignInSession.SignInSessionMock.defaultExpectation.expectationOrigins.originIp, *mm_want_ptrs.ip, mm_got.ip, minimock.Diff(*mm_want_ptrs.ip, mm_got.ip))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmSignIn.t.Errorf("AuthServiceMock.SignIn got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmSignIn.SignInMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmSignInSession.t.Errorf("AuthServiceMock.SignInSession got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSignInSession.SignInSessionMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmSignIn.SignInMock.defaultExpectation.results
+		mm_results := mmSignInSession.SignInSessionMock.defaultExpectation.results
 		if mm_results == nil {
-			mmSignIn.t.Fatal("No results are set for the AuthServiceMock.SignIn")
+			mmSignInSession.t.Fatal("No results are set for the AuthServiceMock.SignInSession")
 		}
 		return (*mm_results).s1, (*mm_results).err
 	}
-	if mmSignIn.funcSignIn != nil {
-		return mmSignIn.funcSignIn(ctx, email, password)
+	if mmSignInSession.funcSignInSession != nil {
+		return mmSignInSession.funcSignInSession(ctx, email, password, userAgent, ip)
 	}
-	mmSignIn.t.Fatalf("Unexpected call to AuthServiceMock.SignIn. %v %v %v", ctx, email, password)
+	mmSignInSession.t.Fatalf("Unexpected call to AuthServiceMock.SignInSession. %v %v %v %v %v", ctx, email, password, userAgent, ip)
 	return
 }
 
-// SignInAfterCounter returns a count of finished AuthServiceMock.SignIn invocations
-func (mmSignIn *AuthServiceMock) SignInAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmSignIn.afterSignInCounter)
+// SignInSessionAfterCounter returns a count of finished AuthServiceMock.SignInSession invocations
+func (mmSignInSession *AuthServiceMock) SignInSessionAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSignInSession.afterSignInSessionCounter)
 }
 
-// SignInBeforeCounter returns a count of AuthServiceMock.SignIn invocations
-func (mmSignIn *AuthServiceMock) SignInBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmSignIn.beforeSignInCounter)
+// SignInSessionBeforeCounter returns a count of AuthServiceMock.SignInSession invocations
+func (mmSignInSession *AuthServiceMock) SignInSessionBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSignInSession.beforeSignInSessionCounter)
 }
 
-// Calls returns a list of arguments used in each call to AuthServiceMock.SignIn.
+// Calls returns a list of arguments used in each call to AuthServiceMock.SignInSession.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmSignIn *mAuthServiceMockSignIn) Calls() []*AuthServiceMockSignInParams {
-	mmSignIn.mutex.RLock()
+func (mmSignInSession *mAuthServiceMockSignInSession) Calls() []*AuthServiceMockSignInSessionParams {
+	mmSignInSession.mutex.RLock()
 
-	argCopy := make([]*AuthServiceMockSignInParams, len(mmSignIn.callArgs))
-	copy(argCopy, mmSignIn.callArgs)
+	argCopy := make([]*AuthServiceMockSignInSessionParams, len(mmSignInSession.callArgs))
+	copy(argCopy, mmSignInSession.callArgs)
 
-	mmSignIn.mutex.RUnlock()
+	mmSignInSession.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockSignInDone returns true if the count of the SignIn invocations corresponds
+// MinimockSignInSessionDone returns true if the count of the SignInSession invocations corresponds
 // the number of defined expectations
-func (m *AuthServiceMock) MinimockSignInDone() bool {
-	if m.SignInMock.optional {
+func (m *AuthServiceMock) MinimockSignInSessionDone() bool {
+	if m.SignInSessionMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.SignInMock.expectations {
+	for _, e := range m.SignInSessionMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.SignInMock.invocationsDone()
+	return m.SignInSessionMock.invocationsDone()
 }
 
-// MinimockSignInInspect logs each unmet expectation
-func (m *AuthServiceMock) MinimockSignInInspect() {
-	for _, e := range m.SignInMock.expectations {
+// MinimockSignInSessionInspect logs each unmet expectation
+func (m *AuthServiceMock) MinimockSignInSessionInspect() {
+	for _, e := range m.SignInSessionMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to AuthServiceMock.SignIn at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to AuthServiceMock.SignInSession at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterSignInCounter := mm_atomic.LoadUint64(&m.afterSignInCounter)
+	afterSignInSessionCounter := mm_atomic.LoadUint64(&m.afterSignInSessionCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.SignInMock.defaultExpectation != nil && afterSignInCounter < 1 {
-		if m.SignInMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to AuthServiceMock.SignIn at\n%s", m.SignInMock.defaultExpectation.returnOrigin)
+	if m.SignInSessionMock.defaultExpectation != nil && afterSignInSessionCounter < 1 {
+		if m.SignInSessionMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuthServiceMock.SignInSession at\n%s", m.SignInSessionMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to AuthServiceMock.SignIn at\n%s with params: %#v", m.SignInMock.defaultExpectation.expectationOrigins.origin, *m.SignInMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to AuthServiceMock.SignInSession at\n%s with params: %#v", m.SignInSessionMock.defaultExpectation.expectationOrigins.origin, *m.SignInSessionMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcSignIn != nil && afterSignInCounter < 1 {
-		m.t.Errorf("Expected call to AuthServiceMock.SignIn at\n%s", m.funcSignInOrigin)
+	if m.funcSignInSession != nil && afterSignInSessionCounter < 1 {
+		m.t.Errorf("Expected call to AuthServiceMock.SignInSession at\n%s", m.funcSignInSessionOrigin)
 	}
 
-	if !m.SignInMock.invocationsDone() && afterSignInCounter > 0 {
-		m.t.Errorf("Expected %d calls to AuthServiceMock.SignIn at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.SignInMock.expectedInvocations), m.SignInMock.expectedInvocationsOrigin, afterSignInCounter)
+	if !m.SignInSessionMock.invocationsDone() && afterSignInSessionCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuthServiceMock.SignInSession at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SignInSessionMock.expectedInvocations), m.SignInSessionMock.expectedInvocationsOrigin, afterSignInSessionCounter)
 	}
 }
 
@@ -1185,15 +2281,366 @@ func (m *AuthServiceMock) MinimockValidateJWTInspect() {
 	}
 }
 
+type mAuthServiceMockValidateSessionToken struct {
+	optional           bool
+	mock               *AuthServiceMock
+	defaultExpectation *AuthServiceMockValidateSessionTokenExpectation
+	expectations       []*AuthServiceMockValidateSessionTokenExpectation
+
+	callArgs []*AuthServiceMockValidateSessionTokenParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AuthServiceMockValidateSessionTokenExpectation specifies expectation struct of the AuthService.ValidateSessionToken
+type AuthServiceMockValidateSessionTokenExpectation struct {
+	mock               *AuthServiceMock
+	params             *AuthServiceMockValidateSessionTokenParams
+	paramPtrs          *AuthServiceMockValidateSessionTokenParamPtrs
+	expectationOrigins AuthServiceMockValidateSessionTokenExpectationOrigins
+	results            *AuthServiceMockValidateSessionTokenResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AuthServiceMockValidateSessionTokenParams contains parameters of the AuthService.ValidateSessionToken
+type AuthServiceMockValidateSessionTokenParams struct {
+	ctx         context.Context
+	tokenString string
+}
+
+// AuthServiceMockValidateSessionTokenParamPtrs contains pointers to parameters of the AuthService.ValidateSessionToken
+type AuthServiceMockValidateSessionTokenParamPtrs struct {
+	ctx         *context.Context
+	tokenString *string
+}
+
+// AuthServiceMockValidateSessionTokenResults contains results of the AuthService.ValidateSessionToken
+type AuthServiceMockValidateSessionTokenResults struct {
+	cp1 *models.Claims
+	err error
+}
+
+// AuthServiceMockValidateSessionTokenOrigins contains origins of expectations of the AuthService.ValidateSessionToken
+type AuthServiceMockValidateSessionTokenExpectationOrigins struct {
+	origin            string
+	originCtx         string
+	originTokenString string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmValidateSessionToken *mAuthServiceMockValidateSessionToken) Optional() *mAuthServiceMockValidateSessionToken {
+	mmValidateSessionToken.optional = true
+	return mmValidateSessionToken
+}
+
+// Expect sets up expected params for AuthService.ValidateSessionToken
+func (mmValidateSessionToken *mAuthServiceMockValidateSessionToken) Expect(ctx context.Context, tokenString string) *mAuthServiceMockValidateSessionToken {
+	if mmValidateSessionToken.mock.funcValidateSessionToken != nil {
+		mmValidateSessionToken.mock.t.Fatalf("AuthServiceMock.ValidateSessionToken mock is already set by Set")
+	}
+
+	if mmValidateSessionToken.defaultExpectation == nil {
+		mmValidateSessionToken.defaultExpectation = &AuthServiceMockValidateSessionTokenExpectation{}
+	}
+
+	if mmValidateSessionToken.defaultExpectation.paramPtrs != nil {
+		mmValidateSessionToken.mock.t.Fatalf("AuthServiceMock.ValidateSessionToken mock is already set by ExpectParams functions")
+	}
+
+	mmValidateSessionToken.defaultExpectation.params = &AuthServiceMockValidateSessionTokenParams{ctx, tokenString}
+	mmValidateSessionToken.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmValidateSessionToken.expectations {
+		if minimock.Equal(e.params, mmValidateSessionToken.defaultExpectation.params) {
+			mmValidateSessionToken.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmValidateSessionToken.defaultExpectation.params)
+		}
+	}
+
+	return mmValidateSessionToken
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuthService.ValidateSessionToken
+func (mmValidateSessionToken *mAuthServiceMockValidateSessionToken) ExpectCtxParam1(ctx context.Context) *mAuthServiceMockValidateSessionToken {
+	if mmValidateSessionToken.mock.funcValidateSessionToken != nil {
+		mmValidateSessionToken.mock.t.Fatalf("AuthServiceMock.ValidateSessionToken mock is already set by Set")
+	}
+
+	if mmValidateSessionToken.defaultExpectation == nil {
+		mmValidateSessionToken.defaultExpectation = &AuthServiceMockValidateSessionTokenExpectation{}
+	}
+
+	if mmValidateSessionToken.defaultExpectation.params != nil {
+		mmValidateSessionToken.mock.t.Fatalf("AuthServiceMock.ValidateSessionToken mock is already set by Expect")
+	}
+
+	if mmValidateSessionToken.defaultExpectation.paramPtrs == nil {
+		mmValidateSessionToken.defaultExpectation.paramPtrs = &AuthServiceMockValidateSessionTokenParamPtrs{}
+	}
+	mmValidateSessionToken.defaultExpectation.paramPtrs.ctx = &ctx
+	mmValidateSessionToken.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmValidateSessionToken
+}
+
+// ExpectTokenStringParam2 sets up expected param tokenString for AuthService.ValidateSessionToken
+func (mmValidateSessionToken *mAuthServiceMockValidateSessionToken) ExpectTokenStringParam2(tokenString string) *mAuthServiceMockValidateSessionToken {
+	if mmValidateSessionToken.mock.funcValidateSessionToken != nil {
+		mmValidateSessionToken.mock.t.Fatalf("AuthServiceMock.ValidateSessionToken mock is already set by Set")
+	}
+
+	if mmValidateSessionToken.defaultExpectation == nil {
+		mmValidateSessionToken.defaultExpectation = &AuthServiceMockValidateSessionTokenExpectation{}
+	}
+
+	if mmValidateSessionToken.defaultExpectation.params != nil {
+		mmValidateSessionToken.mock.t.Fatalf("AuthServiceMock.ValidateSessionToken mock is already set by Expect")
+	}
+
+	if mmValidateSessionToken.defaultExpectation.paramPtrs == nil {
+		mmValidateSessionToken.defaultExpectation.paramPtrs = &AuthServiceMockValidateSessionTokenParamPtrs{}
+	}
+	mmValidateSessionToken.defaultExpectation.paramPtrs.tokenString = &tokenString
+	mmValidateSessionToken.defaultExpectation.expectationOrigins.originTokenString = minimock.CallerInfo(1)
+
+	return mmValidateSessionToken
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuthService.ValidateSessionToken
+func (mmValidateSessionToken *mAuthServiceMockValidateSessionToken) Inspect(f func(ctx context.Context, tokenString string)) *mAuthServiceMockValidateSessionToken {
+	if mmValidateSessionToken.mock.inspectFuncValidateSessionToken != nil {
+		mmValidateSessionToken.mock.t.Fatalf("Inspect function is already set for AuthServiceMock.ValidateSessionToken")
+	}
+
+	mmValidateSessionToken.mock.inspectFuncValidateSessionToken = f
+
+	return mmValidateSessionToken
+}
+
+// Return sets up results that will be returned by AuthService.ValidateSessionToken
+func (mmValidateSessionToken *mAuthServiceMockValidateSessionToken) Return(cp1 *models.Claims, err error) *AuthServiceMock {
+	if mmValidateSessionToken.mock.funcValidateSessionToken != nil {
+		mmValidateSessionToken.mock.t.Fatalf("AuthServiceMock.ValidateSessionToken mock is already set by Set")
+	}
+
+	if mmValidateSessionToken.defaultExpectation == nil {
+		mmValidateSessionToken.defaultExpectation = &AuthServiceMockValidateSessionTokenExpectation{mock: mmValidateSessionToken.mock}
+	}
+	mmValidateSessionToken.defaultExpectation.results = &AuthServiceMockValidateSessionTokenResults{cp1, err}
+	mmValidateSessionToken.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmValidateSessionToken.mock
+}
+
+// Set uses given function f to mock the AuthService.ValidateSessionToken method
+func (mmValidateSessionToken *mAuthServiceMockValidateSessionToken) Set(f func(ctx context.Context, tokenString string) (cp1 *models.Claims, err error)) *AuthServiceMock {
+	if mmValidateSessionToken.defaultExpectation != nil {
+		mmValidateSessionToken.mock.t.Fatalf("Default expectation is already set for the AuthService.ValidateSessionToken method")
+	}
+
+	if len(mmValidateSessionToken.expectations) > 0 {
+		mmValidateSessionToken.mock.t.Fatalf("Some expectations are already set for the AuthService.ValidateSessionToken method")
+	}
+
+	mmValidateSessionToken.mock.funcValidateSessionToken = f
+	mmValidateSessionToken.mock.funcValidateSessionTokenOrigin = minimock.CallerInfo(1)
+	return mmValidateSessionToken.mock
+}
+
+// When sets expectation for the AuthService.ValidateSessionToken which will trigger the result defined by the following
+// Then helper
+func (mmValidateSessionToken *mAuthServiceMockValidateSessionToken) When(ctx context.Context, tokenString string) *AuthServiceMockValidateSessionTokenExpectation {
+	if mmValidateSessionToken.mock.funcValidateSessionToken != nil {
+		mmValidateSessionToken.mock.t.Fatalf("AuthServiceMock.ValidateSessionToken mock is already set by Set")
+	}
+
+	expectation := &AuthServiceMockValidateSessionTokenExpectation{
+		mock:               mmValidateSessionToken.mock,
+		params:             &AuthServiceMockValidateSessionTokenParams{ctx, tokenString},
+		expectationOrigins: AuthServiceMockValidateSessionTokenExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmValidateSessionToken.expectations = append(mmValidateSessionToken.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuthService.ValidateSessionToken return parameters for the expectation previously defined by the When method
+func (e *AuthServiceMockValidateSessionTokenExpectation) Then(cp1 *models.Claims, err error) *AuthServiceMock {
+	e.results = &AuthServiceMockValidateSessionTokenResults{cp1, err}
+	return e.mock
+}
+
+// Times sets number of times AuthService.ValidateSessionToken should be invoked
+func (mmValidateSessionToken *mAuthServiceMockValidateSessionToken) Times(n uint64) *mAuthServiceMockValidateSessionToken {
+	if n == 0 {
+		mmValidateSessionToken.mock.t.Fatalf("Times of AuthServiceMock.ValidateSessionToken mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmValidateSessionToken.expectedInvocations, n)
+	mmValidateSessionToken.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmValidateSessionToken
+}
+
+func (mmValidateSessionToken *mAuthServiceMockValidateSessionToken) invocationsDone() bool {
+	if len(mmValidateSessionToken.expectations) == 0 && mmValidateSessionToken.defaultExpectation == nil && mmValidateSessionToken.mock.funcValidateSessionToken == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmValidateSessionToken.mock.afterValidateSessionTokenCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmValidateSessionToken.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ValidateSessionToken implements AuthService
+func (mmValidateSessionToken *AuthServiceMock) ValidateSessionToken(ctx context.Context, tokenString string) (cp1 *models.Claims, err error) {
+	mm_atomic.AddUint64(&mmValidateSessionToken.beforeValidateSessionTokenCounter, 1)
+	defer mm_atomic.AddUint64(&mmValidateSessionToken.afterValidateSessionTokenCounter, 1)
+
+	mmValidateSessionToken.t.Helper()
+
+	if mmValidateSessionToken.inspectFuncValidateSessionToken != nil {
+		mmValidateSessionToken.inspectFuncValidateSessionToken(ctx, tokenString)
+	}
+
+	mm_params := AuthServiceMockValidateSessionTokenParams{ctx, tokenString}
+
+	// Record call args
+	mmValidateSessionToken.ValidateSessionTokenMock.mutex.Lock()
+	mmValidateSessionToken.ValidateSessionTokenMock.callArgs = append(mmValidateSessionToken.ValidateSessionTokenMock.callArgs, &mm_params)
+	mmValidateSessionToken.ValidateSessionTokenMock.mutex.Unlock()
+
+	for _, e := range mmValidateSessionToken.ValidateSessionTokenMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.cp1, e.results.err
+		}
+	}
+
+	if mmValidateSessionToken.ValidateSessionTokenMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmValidateSessionToken.ValidateSessionTokenMock.defaultExpectation.Counter, 1)
+		mm_want := mmValidateSessionToken.ValidateSessionTokenMock.defaultExpectation.params
+		mm_want_ptrs := mmValidateSessionToken.ValidateSessionTokenMock.defaultExpectation.paramPtrs
+
+		mm_got := AuthServiceMockValidateSessionTokenParams{ctx, tokenString}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmValidateSessionToken.t.Errorf("AuthServiceMock.ValidateSessionToken got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmValidateSessionToken.ValidateSessionTokenMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.tokenString != nil && !minimock.Equal(*mm_want_ptrs.tokenString, mm_got.tokenString) {
+				mmValidateSessionToken.t.Errorf("AuthServiceMock.ValidateSessionToken got unexpected parameter tokenString, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmValidateSessionToken.ValidateSessionTokenMock.defaultExpectation.expectationOrigins.originTokenString, *mm_want_ptrs.tokenString, mm_got.tokenString, minimock.Diff(*mm_want_ptrs.tokenString, mm_got.tokenString))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmValidateSessionToken.t.Errorf("AuthServiceMock.ValidateSessionToken got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmValidateSessionToken.ValidateSessionTokenMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmValidateSessionToken.ValidateSessionTokenMock.defaultExpectation.results
+		if mm_results == nil {
+			mmValidateSessionToken.t.Fatal("No results are set for the AuthServiceMock.ValidateSessionToken")
+		}
+		return (*mm_results).cp1, (*mm_results).err
+	}
+	if mmValidateSessionToken.funcValidateSessionToken != nil {
+		return mmValidateSessionToken.funcValidateSessionToken(ctx, tokenString)
+	}
+	mmValidateSessionToken.t.Fatalf("Unexpected call to AuthServiceMock.ValidateSessionToken. %v %v", ctx, tokenString)
+	return
+}
+
+// ValidateSessionTokenAfterCounter returns a count of finished AuthServiceMock.ValidateSessionToken invocations
+func (mmValidateSessionToken *AuthServiceMock) ValidateSessionTokenAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmValidateSessionToken.afterValidateSessionTokenCounter)
+}
+
+// ValidateSessionTokenBeforeCounter returns a count of AuthServiceMock.ValidateSessionToken invocations
+func (mmValidateSessionToken *AuthServiceMock) ValidateSessionTokenBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmValidateSessionToken.beforeValidateSessionTokenCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuthServiceMock.ValidateSessionToken.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmValidateSessionToken *mAuthServiceMockValidateSessionToken) Calls() []*AuthServiceMockValidateSessionTokenParams {
+	mmValidateSessionToken.mutex.RLock()
+
+	argCopy := make([]*AuthServiceMockValidateSessionTokenParams, len(mmValidateSessionToken.callArgs))
+	copy(argCopy, mmValidateSessionToken.callArgs)
+
+	mmValidateSessionToken.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockValidateSessionTokenDone returns true if the count of the ValidateSessionToken invocations corresponds
+// the number of defined expectations
+func (m *AuthServiceMock) MinimockValidateSessionTokenDone() bool {
+	if m.ValidateSessionTokenMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ValidateSessionTokenMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ValidateSessionTokenMock.invocationsDone()
+}
+
+// MinimockValidateSessionTokenInspect logs each unmet expectation
+func (m *AuthServiceMock) MinimockValidateSessionTokenInspect() {
+	for _, e := range m.ValidateSessionTokenMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuthServiceMock.ValidateSessionToken at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterValidateSessionTokenCounter := mm_atomic.LoadUint64(&m.afterValidateSessionTokenCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ValidateSessionTokenMock.defaultExpectation != nil && afterValidateSessionTokenCounter < 1 {
+		if m.ValidateSessionTokenMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuthServiceMock.ValidateSessionToken at\n%s", m.ValidateSessionTokenMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AuthServiceMock.ValidateSessionToken at\n%s with params: %#v", m.ValidateSessionTokenMock.defaultExpectation.expectationOrigins.origin, *m.ValidateSessionTokenMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcValidateSessionToken != nil && afterValidateSessionTokenCounter < 1 {
+		m.t.Errorf("Expected call to AuthServiceMock.ValidateSessionToken at\n%s", m.funcValidateSessionTokenOrigin)
+	}
+
+	if !m.ValidateSessionTokenMock.invocationsDone() && afterValidateSessionTokenCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuthServiceMock.ValidateSessionToken at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ValidateSessionTokenMock.expectedInvocations), m.ValidateSessionTokenMock.expectedInvocationsOrigin, afterValidateSessionTokenCounter)
+	}
+}
+
 // MinimockFinish checks that all mocked methods have been called the expected number of times
 func (m *AuthServiceMock) MinimockFinish() {
 	m.finishOnce.Do(func() {
 		if !m.minimockDone() {
+			m.MinimockCheckAllowedIPInspect()
+
+			m.MinimockPublicKeysInspect()
+
 			m.MinimockSignInInspect()
 
+			m.MinimockSignInSessionInspect()
+
 			m.MinimockSignUpInspect()
 
 			m.MinimockValidateJWTInspect()
+
+			m.MinimockValidateSessionTokenInspect()
 		}
 	})
 }
@@ -1217,7 +2664,11 @@ func (m *AuthServiceMock) MinimockWait(timeout mm_time.Duration) {
 func (m *AuthServiceMock) minimockDone() bool {
 	done := true
 	return done &&
+		m.MinimockCheckAllowedIPDone() &&
+		m.MinimockPublicKeysDone() &&
 		m.MinimockSignInDone() &&
+		m.MinimockSignInSessionDone() &&
 		m.MinimockSignUpDone() &&
-		m.MinimockValidateJWTDone()
+		m.MinimockValidateJWTDone() &&
+		m.MinimockValidateSessionTokenDone()
 }
@@ -11,9 +11,11 @@ import (
 	"time"
 
 	"github.com/alonsoF100/authorization-service/internal/apperrors"
+	"github.com/alonsoF100/authorization-service/internal/config"
 	"github.com/alonsoF100/authorization-service/internal/models"
 	"github.com/alonsoF100/authorization-service/internal/transport/http/dto"
 	"github.com/alonsoF100/authorization-service/internal/transport/http/handlers"
+	"github.com/alonsoF100/authorization-service/internal/transport/http/middleware"
 	"github.com/go-playground/validator/v10"
 	"github.com/gojuno/minimock/v3"
 	"github.com/google/uuid"
@@ -75,11 +77,13 @@ func TestSignUp(t *testing.T) {
 			expectedError:  apperrors.ErrFailedToValidate,
 		},
 		{
-			name:           "failed validation - short password",
-			requestBody:    `{"nickname": "user", "email": "test@test.com", "password": "123"}`,
-			setupMocks:     func() {},
+			name:        "password outside the policy",
+			requestBody: `{"nickname": "user", "email": "test@test.com", "password": "123"}`,
+			setupMocks: func() {
+				mockService.SignUpMock.Expect(context.Background(), "user", "test@test.com", "123").Return(nil, apperrors.ErrWeakPassword)
+			},
 			expectedStatus: http.StatusBadRequest,
-			expectedError:  apperrors.ErrFailedToValidate,
+			expectedError:  apperrors.ErrWeakPassword,
 		},
 		{
 			name:        "user already exists by nickname",
@@ -243,8 +247,8 @@ func TestSignIn(t *testing.T) {
 			expectedError:  apperrors.ErrFailedToValidate,
 		},
 		{
-			name:           "failed validation - short password",
-			requestBody:    `{"email": "test@test.com", "password": "123"}`,
+			name:           "failed validation - missing password",
+			requestBody:    `{"email": "test@test.com"}`,
 			setupMocks:     func() {},
 			expectedStatus: http.StatusBadRequest,
 			expectedError:  apperrors.ErrFailedToValidate,
@@ -253,16 +257,34 @@ func TestSignIn(t *testing.T) {
 			name:        "invalid email or password",
 			requestBody: `{"email": "alonso@mail.gaz", "password": "alonso_the_week"}`,
 			setupMocks: func() {
-				mockService.SignInMock.Expect(context.Background(), "alonso@mail.gaz", "alonso_the_week").Return("", apperrors.ErrInvalidCredentials)
+				mockService.SignInMock.Expect(context.Background(), "alonso@mail.gaz", "alonso_the_week", "", "192.0.2.1").Return("", apperrors.ErrInvalidCredentials)
 			},
 			expectedStatus: http.StatusUnauthorized,
 			expectedError:  apperrors.ErrInvalidCredentials,
 		},
+		{
+			name:        "user disabled",
+			requestBody: `{"email": "alonso@mail.gaz", "password": "alonso_the_week"}`,
+			setupMocks: func() {
+				mockService.SignInMock.Expect(context.Background(), "alonso@mail.gaz", "alonso_the_week", "", "192.0.2.1").Return("", apperrors.ErrUserDisabled)
+			},
+			expectedStatus: http.StatusForbidden,
+			expectedError:  apperrors.ErrUserDisabled,
+		},
+		{
+			name:        "address not allowed",
+			requestBody: `{"email": "alonso@mail.gaz", "password": "alonso_the_week"}`,
+			setupMocks: func() {
+				mockService.SignInMock.Expect(context.Background(), "alonso@mail.gaz", "alonso_the_week", "", "192.0.2.1").Return("", apperrors.ErrIPNotAllowed)
+			},
+			expectedStatus: http.StatusForbidden,
+			expectedError:  apperrors.ErrIPNotAllowed,
+		},
 		{
 			name:        "server error",
 			requestBody: `{"email": "alonso@mail.gaz", "password": "alonso_the_week"}`,
 			setupMocks: func() {
-				mockService.SignInMock.Expect(context.Background(), "alonso@mail.gaz", "alonso_the_week").Return("", errors.New("some error"))
+				mockService.SignInMock.Expect(context.Background(), "alonso@mail.gaz", "alonso_the_week", "", "192.0.2.1").Return("", errors.New("some error"))
 			},
 			expectedStatus: http.StatusInternalServerError,
 			expectedError:  apperrors.ErrServer,
@@ -271,7 +293,7 @@ func TestSignIn(t *testing.T) {
 			name:        "success",
 			requestBody: `{"email": "alonso@mail.ru", "password": "alonso_the_great"}`,
 			setupMocks: func() {
-				mockService.SignInMock.Expect(context.Background(), "alonso@mail.ru", "alonso_the_great").Return("oh_yes_JWT", nil)
+				mockService.SignInMock.Expect(context.Background(), "alonso@mail.ru", "alonso_the_great", "", "192.0.2.1").Return("oh_yes_JWT", nil)
 			},
 			expectedStatus: http.StatusOK,
 			expectedError:  nil,
@@ -316,7 +338,7 @@ func TestSignInSuccesses(t *testing.T) {
 	email := "alonso@mail.ru"
 	password := "alonso_the_great"
 	expectedToken := "valid token"
-	mockService.SignInMock.Expect(context.Background(), email, password).Return(expectedToken, nil)
+	mockService.SignInMock.Expect(context.Background(), email, password, "", "192.0.2.1").Return(expectedToken, nil)
 
 	requestBody := `{"email": "alonso@mail.ru", "password": "alonso_the_great"}`
 
@@ -337,3 +359,182 @@ func TestSignInSuccesses(t *testing.T) {
 	require.Equal(t, expectedToken, successResp.JWT)
 	require.Equal(t, "Bearer", successResp.Type)
 }
+
+func TestSignInCookie(t *testing.T) {
+	cfg := &config.Config{
+		JWT: config.JWTConfig{Expiry: time.Hour},
+		Cookie: config.CookieConfig{
+			Enabled:    true,
+			Name:       "access_token",
+			Domain:     "example.com",
+			Path:       "/",
+			SameSite:   config.SameSiteStrict,
+			Secure:     true,
+			CSRFCookie: "csrf_token",
+			CSRFHeader: "X-CSRF-Token",
+		},
+	}
+	requestBody := `{"email": "alonso@mail.ru", "password": "alonso_the_great", "use_cookie": true}`
+
+	t.Run("token goes into the cookie", func(t *testing.T) {
+		mc := minimock.NewController(t)
+		mockService := handlers.NewAuthServiceMock(mc)
+		mockService.SignInMock.Expect(context.Background(), "alonso@mail.ru", "alonso_the_great", "", "192.0.2.1").Return("valid token", nil)
+
+		h := handlers.Handler{AuthService: mockService, Validator: validator.New(), Cfg: cfg}
+
+		req := httptest.NewRequest(http.MethodPost, "/auth/login", bytes.NewBufferString(requestBody))
+		rr := httptest.NewRecorder()
+
+		h.SignIn(rr, req)
+
+		require.Equal(t, http.StatusOK, rr.Code)
+		require.NotContains(t, rr.Body.String(), "valid token")
+
+		var resp dto.CookieSignInResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+		require.Equal(t, middleware.CSRFToken("valid token"), resp.CSRFToken)
+
+		cookies := rr.Result().Cookies()
+		require.Len(t, cookies, 2)
+
+		token, csrf := cookies[0], cookies[1]
+		require.Equal(t, "access_token", token.Name)
+		require.Equal(t, "valid token", token.Value)
+		require.True(t, token.HttpOnly)
+		require.True(t, token.Secure)
+		require.Equal(t, http.SameSiteStrictMode, token.SameSite)
+		require.Equal(t, "example.com", token.Domain)
+		require.Equal(t, "/", token.Path)
+		require.Equal(t, 3600, token.MaxAge)
+
+		require.Equal(t, "csrf_token", csrf.Name)
+		require.Equal(t, resp.CSRFToken, csrf.Value)
+		require.False(t, csrf.HttpOnly)
+		require.Equal(t, http.SameSiteStrictMode, csrf.SameSite)
+	})
+
+	t.Run("cookie mode disabled", func(t *testing.T) {
+		h := handlers.Handler{Validator: validator.New(), Cfg: &config.Config{}}
+
+		req := httptest.NewRequest(http.MethodPost, "/auth/login", bytes.NewBufferString(requestBody))
+		rr := httptest.NewRecorder()
+
+		h.SignIn(rr, req)
+
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+		require.Contains(t, rr.Body.String(), apperrors.ErrCookieModeDisabled.Error())
+		require.Empty(t, rr.Result().Cookies())
+	})
+}
+
+func TestSignOut(t *testing.T) {
+	cfg := &config.Config{
+		Cookie: config.CookieConfig{
+			Enabled:    true,
+			Name:       "access_token",
+			Domain:     "example.com",
+			Path:       "/",
+			SameSite:   config.SameSiteStrict,
+			Secure:     true,
+			CSRFCookie: "csrf_token",
+			CSRFHeader: "X-CSRF-Token",
+		},
+	}
+
+	tests := []struct {
+		name        string
+		claims      *models.Claims
+		mockSetup   func(ctx context.Context, mockService *handlers.UserServiceMock)
+		wantStatus  int
+		wantError   string
+		wantCookies bool
+	}{
+		{
+			name:   "session revoked",
+			claims: &models.Claims{ID: "user123", SessionID: "session123"},
+			mockSetup: func(ctx context.Context, mockService *handlers.UserServiceMock) {
+				mockService.DeleteSessionMock.Expect(ctx, "user123", "session123").Return(nil)
+			},
+			wantStatus:  http.StatusNoContent,
+			wantCookies: true,
+		},
+		{
+			name:   "session already revoked",
+			claims: &models.Claims{ID: "user123", SessionID: "session123"},
+			mockSetup: func(ctx context.Context, mockService *handlers.UserServiceMock) {
+				mockService.DeleteSessionMock.Expect(ctx, "user123", "session123").Return(apperrors.ErrSessionNotFound)
+			},
+			wantStatus:  http.StatusNoContent,
+			wantCookies: true,
+		},
+		{
+			name:        "token without session",
+			claims:      &models.Claims{ID: "user123"},
+			mockSetup:   func(ctx context.Context, mockService *handlers.UserServiceMock) {},
+			wantStatus:  http.StatusNoContent,
+			wantCookies: true,
+		},
+		{
+			name:   "service error",
+			claims: &models.Claims{ID: "user123", SessionID: "session123"},
+			mockSetup: func(ctx context.Context, mockService *handlers.UserServiceMock) {
+				mockService.DeleteSessionMock.Expect(ctx, "user123", "session123").Return(errors.New("db error"))
+			},
+			wantStatus: http.StatusInternalServerError,
+			wantError:  apperrors.ErrServer.Error(),
+		},
+		{
+			name:       "no claims",
+			mockSetup:  func(ctx context.Context, mockService *handlers.UserServiceMock) {},
+			wantStatus: http.StatusUnauthorized,
+			wantError:  apperrors.ErrUnauthorized.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := minimock.NewController(t)
+			mockService := handlers.NewUserServiceMock(mc)
+			h := handlers.Handler{UserService: mockService, Cfg: cfg}
+
+			ctx := context.Background()
+			if tt.claims != nil {
+				ctx = context.WithValue(ctx, middleware.UserContextKey, tt.claims)
+			}
+			tt.mockSetup(ctx, mockService)
+
+			req := httptest.NewRequest(http.MethodPost, "/auth/logout", nil).WithContext(ctx)
+			rr := httptest.NewRecorder()
+
+			h.SignOut(rr, req)
+
+			require.Equal(t, tt.wantStatus, rr.Code)
+			if tt.wantError != "" {
+				var resp dto.ErrorResponse
+				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+				require.Equal(t, tt.wantError, resp.Error)
+			}
+
+			cookies := rr.Result().Cookies()
+			if !tt.wantCookies {
+				require.Empty(t, cookies)
+				return
+			}
+
+			// both cookies are expired with the attributes they were set with
+			require.Len(t, cookies, 2)
+			require.Equal(t, "access_token", cookies[0].Name)
+			require.Equal(t, "csrf_token", cookies[1].Name)
+			for _, cookie := range cookies {
+				require.Empty(t, cookie.Value)
+				require.Equal(t, -1, cookie.MaxAge)
+				require.Equal(t, "example.com", cookie.Domain)
+				require.Equal(t, "/", cookie.Path)
+				require.Equal(t, http.SameSiteStrictMode, cookie.SameSite)
+				require.True(t, cookie.Secure)
+			}
+			require.Contains(t, rr.Header().Values("Set-Cookie")[0], "Max-Age=0")
+		})
+	}
+}
@@ -0,0 +1,392 @@
+package handlers
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"embed"
+	"errors"
+	"html/template"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/alonsoF100/authorization-service/internal/apperrors"
+	"github.com/alonsoF100/authorization-service/internal/models"
+	"github.com/alonsoF100/authorization-service/internal/transport/http/dto"
+	"github.com/alonsoF100/authorization-service/internal/transport/http/help"
+)
+
+//go:embed templates/*.html
+var templates embed.FS
+
+var authorizeTemplate = template.Must(template.ParseFS(templates, "templates/authorize.html"))
+
+// authorizeParams are carried from the authorization request through the
+// login and consent form
+var authorizeParams = []string{
+	"client_id",
+	"redirect_uri",
+	"response_type",
+	"scope",
+	"state",
+	"code_challenge",
+	"code_challenge_method",
+	"nonce",
+}
+
+type authorizePage struct {
+	ClientName string
+	Nickname   string
+	Scopes     []string
+	Params     map[string]string
+	CSRFToken  string
+	Error      string
+	Fatal      bool
+}
+
+/*
+pattern: /oauth/authorize
+method: GET, POST
+info: authorization endpoint (RFC 6749 4.1) for the code grant with
+mandatory PKCE S256 (RFC 7636), the openid scope and nonce parameter of
+OpenID Connect are passed on to the ID token, GET renders the login and consent page,
+POST signs the user in with email and password and records the decision
+
+succeed:
+
+	-status code: 302 found, redirect to the client with code and state
+	-status code: 200 ok, login and consent page
+
+failed:
+
+	-status code: 302 found, redirect to the client with error and state
+	-status code: 400 bad request, error page for an unknown client or
+	redirect uri, the user is never sent to an unregistered uri
+*/
+func (h Handler) Authorize(w http.ResponseWriter, r *http.Request) {
+	const op = "handlers/authorize.go/Authorize"
+
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("X-Frame-Options", "DENY")
+	w.Header().Set("Content-Security-Policy", "frame-ancestors 'none'")
+
+	if err := r.ParseForm(); err != nil {
+		h.renderAuthorize(w, http.StatusBadRequest, authorizePage{Error: "Malformed request.", Fatal: true})
+		return
+	}
+
+	params := make(map[string]string, len(authorizeParams))
+	for _, name := range authorizeParams {
+		params[name] = r.Form.Get(name)
+	}
+	redirectURI := params["redirect_uri"]
+	state := params["state"]
+
+	client, err := h.OAuthService.FindClient(r.Context(), params["client_id"])
+	if err != nil {
+		if !errors.Is(err, apperrors.ErrInvalidClient) {
+			slog.Error("Authorization failed",
+				slog.String("op", op),
+				slog.String("error", err.Error()),
+			)
+		}
+		h.renderAuthorize(w, http.StatusBadRequest, authorizePage{Error: "Unknown client.", Fatal: true})
+		return
+	}
+	if !client.AllowsRedirect(redirectURI) {
+		slog.Info("Authorization rejected: unregistered redirect uri",
+			slog.String("op", op),
+			slog.String("client_id", client.ID),
+			slog.String("redirect_uri", redirectURI),
+		)
+		h.renderAuthorize(w, http.StatusBadRequest, authorizePage{Error: apperrors.ErrInvalidRedirectURI.Error(), Fatal: true})
+		return
+	}
+
+	// from here on errors go back to the client
+	switch {
+	case params["response_type"] != "code":
+		redirectError(w, r, redirectURI, state, "unsupported_response_type", "only the code response type is supported")
+		return
+	case !client.AllowsGrant(models.GrantAuthorizationCode):
+		redirectError(w, r, redirectURI, state, "unauthorized_client", apperrors.ErrUnauthorizedClient.Error())
+		return
+	case params["code_challenge_method"] != "S256" || len(params["code_challenge"]) != 43:
+		redirectError(w, r, redirectURI, state, "invalid_request", "PKCE with the S256 method is required")
+		return
+	}
+
+	scopes := strings.Fields(params["scope"])
+	page := authorizePage{
+		ClientName: client.Name,
+		Scopes:     scopes,
+		Params:     params,
+	}
+
+	// every post answers a rendered form, a cross site post can't read the
+	// token of the form
+	if r.Method == http.MethodPost && !h.validAuthorizeCSRF(r) {
+		slog.Info("Authorization rejected: invalid csrf token",
+			slog.String("op", op),
+			slog.String("client_id", client.ID),
+		)
+		page.Error = apperrors.ErrInvalidCSRFToken.Error()
+		h.renderAuthorize(w, http.StatusForbidden, page)
+		return
+	}
+
+	if r.Method == http.MethodPost && r.PostForm.Get("decision") == "deny" {
+		slog.Info("Authorization denied by user",
+			slog.String("op", op),
+			slog.String("client_id", client.ID),
+		)
+		redirectError(w, r, redirectURI, state, "access_denied", "")
+		return
+	}
+
+	for _, scope := range scopes {
+		if !client.AllowsScope(scope) {
+			slog.Info("Authorization rejected: scope not allowed",
+				slog.String("op", op),
+				slog.String("client_id", client.ID),
+				slog.String("scope", scope),
+			)
+			redirectError(w, r, redirectURI, state, "invalid_scope", apperrors.ErrInvalidScope.Error())
+			return
+		}
+	}
+
+	claims := h.sessionClaims(r)
+	if r.Method == http.MethodPost && r.PostForm.Has("email") {
+		token, err := h.AuthService.SignInSession(r.Context(), r.PostForm.Get("email"), r.PostForm.Get("password"), r.UserAgent(), help.ClientIP(r))
+		if err != nil {
+			if !errors.Is(err, apperrors.ErrInvalidCredentials) && !errors.Is(err, apperrors.ErrUserDisabled) && !errors.Is(err, apperrors.ErrIPNotAllowed) {
+				slog.Error("Authorization failed",
+					slog.String("op", op),
+					slog.String("error", err.Error()),
+				)
+				page.Error = apperrors.ErrServer.Error()
+			} else {
+				page.Error = err.Error()
+			}
+			h.renderAuthorize(w, http.StatusOK, page)
+			return
+		}
+
+		claims, err = h.AuthService.ValidateSessionToken(r.Context(), token)
+		if err != nil {
+			slog.Error("Authorization failed",
+				slog.String("op", op),
+				slog.String("error", err.Error()),
+			)
+			page.Error = apperrors.ErrServer.Error()
+			h.renderAuthorize(w, http.StatusOK, page)
+			return
+		}
+		h.setSessionCookie(w, token)
+	}
+
+	if claims == nil {
+		h.renderAuthorize(w, http.StatusOK, page)
+		return
+	}
+	page.Nickname = claims.Nickname
+
+	approved := r.Method == http.MethodPost && r.PostForm.Get("decision") == "allow"
+	if approved {
+		err = h.OAuthService.GrantConsent(r.Context(), claims.ID, client.ID, scopes)
+	} else {
+		approved, err = h.OAuthService.HasConsent(r.Context(), claims.ID, client.ID, scopes)
+	}
+	if err != nil {
+		redirectError(w, r, redirectURI, state, "server_error", "")
+		return
+	}
+	if !approved {
+		h.renderAuthorize(w, http.StatusOK, page)
+		return
+	}
+
+	request := &models.AuthorizationCode{
+		ClientID:      client.ID,
+		UserID:        claims.ID,
+		RedirectURI:   redirectURI,
+		Scope:         strings.Join(scopes, " "),
+		CodeChallenge: params["code_challenge"],
+		Nonce:         params["nonce"],
+		SessionID:     claims.SessionID,
+	}
+	// the session token was issued when the user signed in
+	if claims.IssuedAt != nil {
+		request.AuthTime = claims.IssuedAt.Time
+	}
+
+	code, err := h.OAuthService.IssueAuthorizationCode(r.Context(), request)
+	if err != nil {
+		redirectError(w, r, redirectURI, state, "server_error", "")
+		return
+	}
+
+	redirectWithParams(w, r, redirectURI, url.Values{"code": {code}, "state": {state}})
+}
+
+/*
+pattern: /oauth/token
+method: POST
+info: token endpoint (RFC 6749 3.2), form encoded, the client authenticates
+with HTTP Basic or client_id and client_secret form parameters, supports the
+authorization_code grant with code, redirect_uri and code_verifier and the
+client_credentials grant with an optional scope
+
+succeed:
+
+	-status code: 200 ok
+	-response body: JSON with access_token, token_type, expires_in and scope,
+	id_token for the openid scope
+
+failed:
+
+	-status code: 400 bad request, 401 unauthorized, 500 internal server error
+	-response body: JSON with OAuth error code
+*/
+func (h Handler) Token(w http.ResponseWriter, r *http.Request) {
+	const op = "handlers/authorize.go/Token"
+
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("Pragma", "no-cache")
+
+	client, ok := h.authenticateClient(w, r)
+	if !ok {
+		return
+	}
+
+	var (
+		token *models.Token
+		err   error
+	)
+	switch grantType := r.PostForm.Get("grant_type"); grantType {
+	case models.GrantAuthorizationCode:
+		code := r.PostForm.Get("code")
+		redirectURI := r.PostForm.Get("redirect_uri")
+		codeVerifier := r.PostForm.Get("code_verifier")
+		if code == "" || redirectURI == "" || codeVerifier == "" {
+			help.WriteJSON(w, http.StatusBadRequest, dto.NewOAuthErrorResponse("invalid_request", "code, redirect_uri and code_verifier are required"))
+			return
+		}
+
+		token, err = h.OAuthService.ExchangeAuthorizationCode(r.Context(), client, code, redirectURI, codeVerifier)
+	case models.GrantClientCredentials:
+		token, err = h.OAuthService.ClientCredentials(r.Context(), client, r.PostForm.Get("scope"))
+	default:
+		help.WriteJSON(w, http.StatusBadRequest, dto.NewOAuthErrorResponse("unsupported_grant_type", ""))
+		return
+	}
+	if err != nil {
+		switch {
+		case errors.Is(err, apperrors.ErrInvalidGrant):
+			help.WriteJSON(w, http.StatusBadRequest, dto.NewOAuthErrorResponse("invalid_grant", err.Error()))
+		case errors.Is(err, apperrors.ErrUnauthorizedClient):
+			help.WriteJSON(w, http.StatusBadRequest, dto.NewOAuthErrorResponse("unauthorized_client", err.Error()))
+		case errors.Is(err, apperrors.ErrInvalidScope):
+			help.WriteJSON(w, http.StatusBadRequest, dto.NewOAuthErrorResponse("invalid_scope", err.Error()))
+		default:
+			slog.Error("Token request failed",
+				slog.String("op", op),
+				slog.String("client_id", client.ID),
+				slog.String("error", err.Error()),
+			)
+			help.WriteJSON(w, http.StatusInternalServerError, dto.NewOAuthErrorResponse("server_error", ""))
+		}
+		return
+	}
+
+	help.WriteJSON(w, http.StatusOK, dto.NewTokenResponse(token))
+}
+
+// sessionClaims returns the user signed in on the authorize page, nil if
+// there is none. The cookie holds a session token, the API does not take it.
+func (h Handler) sessionClaims(r *http.Request) *models.Claims {
+	cookie, err := r.Cookie(h.Cfg.OAuth.SessionCookie)
+	if err != nil || cookie.Value == "" {
+		return nil
+	}
+
+	claims, err := h.AuthService.ValidateSessionToken(r.Context(), cookie.Value)
+	if err != nil {
+		return nil
+	}
+
+	return claims
+}
+
+func (h Handler) setSessionCookie(w http.ResponseWriter, token string) {
+	http.SetCookie(w, &http.Cookie{
+		Name:     h.Cfg.OAuth.SessionCookie,
+		Value:    token,
+		Path:     "/oauth",
+		MaxAge:   int(h.Cfg.JWT.Expiry.Seconds()),
+		HttpOnly: true,
+		Secure:   h.Cfg.OAuth.SecureCookie,
+		SameSite: http.SameSiteLaxMode,
+	})
+}
+
+// validAuthorizeCSRF compares the token of the submitted form with the one
+// of the last rendered form
+func (h Handler) validAuthorizeCSRF(r *http.Request) bool {
+	cookie, err := r.Cookie(h.Cfg.OAuth.CSRFCookie)
+	if err != nil || cookie.Value == "" {
+		return false
+	}
+
+	return subtle.ConstantTimeCompare([]byte(r.PostForm.Get("csrf_token")), []byte(cookie.Value)) == 1
+}
+
+// renderAuthorize gives every rendered form a new CSRF token
+func (h Handler) renderAuthorize(w http.ResponseWriter, statusCode int, page authorizePage) {
+	if !page.Fatal {
+		page.CSRFToken = rand.Text()
+		http.SetCookie(w, &http.Cookie{
+			Name:     h.Cfg.OAuth.CSRFCookie,
+			Value:    page.CSRFToken,
+			Path:     "/oauth/authorize",
+			HttpOnly: true,
+			Secure:   h.Cfg.OAuth.SecureCookie,
+			SameSite: http.SameSiteStrictMode,
+		})
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.WriteHeader(statusCode)
+	if err := authorizeTemplate.Execute(w, page); err != nil {
+		slog.Debug("Failed to render authorize page", "error", err)
+	}
+}
+
+func redirectError(w http.ResponseWriter, r *http.Request, redirectURI, state, code, description string) {
+	params := url.Values{"error": {code}}
+	if description != "" {
+		params.Set("error_description", description)
+	}
+	if state != "" {
+		params.Set("state", state)
+	}
+
+	redirectWithParams(w, r, redirectURI, params)
+}
+
+// redirectWithParams keeps the query of the registered redirect uri
+func redirectWithParams(w http.ResponseWriter, r *http.Request, redirectURI string, params url.Values) {
+	target, _ := url.Parse(redirectURI)
+
+	query := target.Query()
+	for name, values := range params {
+		if len(values) > 0 && values[0] != "" {
+			query.Set(name, values[0])
+		}
+	}
+	target.RawQuery = query.Encode()
+
+	http.Redirect(w, r, target.String(), http.StatusFound)
+}
@@ -0,0 +1,420 @@
+package handlers_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alonsoF100/authorization-service/internal/apperrors"
+	"github.com/alonsoF100/authorization-service/internal/config"
+	"github.com/alonsoF100/authorization-service/internal/models"
+	"github.com/alonsoF100/authorization-service/internal/transport/http/dto"
+	"github.com/alonsoF100/authorization-service/internal/transport/http/handlers"
+	"github.com/gojuno/minimock/v3"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthorize(t *testing.T) {
+	ctx := context.Background()
+	cfg := &config.Config{
+		JWT:   config.JWTConfig{Expiry: time.Hour},
+		OAuth: config.OAuthConfig{SessionCookie: "auth_session", CSRFCookie: "auth_csrf", SecureCookie: true},
+	}
+	client := &models.Client{
+		ID:           "client123",
+		Name:         "Grafana",
+		RedirectURIs: []string{"https://app.example.com/callback?tenant=1"},
+		GrantTypes:   []string{models.GrantAuthorizationCode},
+	}
+	signedInAt := time.Now().Add(-time.Minute).Truncate(time.Second)
+	claims := &models.Claims{
+		ID:       "user123",
+		Nickname: "alonso",
+		RegisteredClaims: jwt.RegisteredClaims{
+			IssuedAt: jwt.NewNumericDate(signedInAt),
+		},
+	}
+	challenge := strings.Repeat("c", 43)
+
+	authorizeQuery := func() url.Values {
+		return url.Values{
+			"client_id":             {"client123"},
+			"redirect_uri":          {"https://app.example.com/callback?tenant=1"},
+			"response_type":         {"code"},
+			"scope":                 {"profile email"},
+			"state":                 {"xyz"},
+			"code_challenge":        {challenge},
+			"code_challenge_method": {"S256"},
+		}
+	}
+
+	tests := []struct {
+		name         string
+		method       string
+		modify       func(params url.Values)
+		session      bool
+		badCSRF      bool
+		mockSetup    func(oauth *handlers.OAuthServiceMock, auth *handlers.AuthServiceMock)
+		wantStatus   int
+		wantRedirect url.Values
+		wantBody     string
+		wantCookie   bool
+		wantForm     bool
+	}{
+		{
+			name:   "unknown client",
+			method: http.MethodGet,
+			modify: func(params url.Values) { params.Set("client_id", "missing") },
+			mockSetup: func(oauth *handlers.OAuthServiceMock, auth *handlers.AuthServiceMock) {
+				oauth.FindClientMock.Expect(ctx, "missing").Return(nil, apperrors.ErrInvalidClient)
+			},
+			wantStatus: http.StatusBadRequest,
+			wantBody:   "Unknown client.",
+		},
+		{
+			name:   "unregistered redirect uri",
+			method: http.MethodGet,
+			modify: func(params url.Values) { params.Set("redirect_uri", "https://evil.example.com") },
+			mockSetup: func(oauth *handlers.OAuthServiceMock, auth *handlers.AuthServiceMock) {
+				oauth.FindClientMock.Return(client, nil)
+			},
+			wantStatus: http.StatusBadRequest,
+			wantBody:   apperrors.ErrInvalidRedirectURI.Error(),
+		},
+		{
+			name:   "missing pkce",
+			method: http.MethodGet,
+			modify: func(params url.Values) { params.Del("code_challenge_method") },
+			mockSetup: func(oauth *handlers.OAuthServiceMock, auth *handlers.AuthServiceMock) {
+				oauth.FindClientMock.Return(client, nil)
+			},
+			wantStatus:   http.StatusFound,
+			wantRedirect: url.Values{"error": {"invalid_request"}, "state": {"xyz"}, "tenant": {"1"}},
+		},
+		{
+			name:   "scope not allowed for the client",
+			method: http.MethodGet,
+			modify: func(params url.Values) { params.Set("scope", "openid write") },
+			mockSetup: func(oauth *handlers.OAuthServiceMock, auth *handlers.AuthServiceMock) {
+				oauth.FindClientMock.Return(client, nil)
+			},
+			wantStatus:   http.StatusFound,
+			wantRedirect: url.Values{"error": {"invalid_scope"}, "state": {"xyz"}, "tenant": {"1"}},
+		},
+		{
+			name:   "login page",
+			method: http.MethodGet,
+			modify: func(params url.Values) {},
+			mockSetup: func(oauth *handlers.OAuthServiceMock, auth *handlers.AuthServiceMock) {
+				oauth.FindClientMock.Return(client, nil)
+			},
+			wantStatus: http.StatusOK,
+			wantBody:   "Grafana",
+			wantForm:   true,
+		},
+		{
+			name:    "consent page for a signed in user",
+			method:  http.MethodGet,
+			modify:  func(params url.Values) {},
+			session: true,
+			mockSetup: func(oauth *handlers.OAuthServiceMock, auth *handlers.AuthServiceMock) {
+				oauth.FindClientMock.Return(client, nil)
+				auth.ValidateSessionTokenMock.Expect(ctx, "session_token").Return(claims, nil)
+				oauth.HasConsentMock.Expect(ctx, "user123", "client123", []string{"profile", "email"}).Return(false, nil)
+			},
+			wantStatus: http.StatusOK,
+			wantBody:   "Hi, alonso",
+			wantForm:   true,
+		},
+		{
+			name:    "existing consent skips the page",
+			method:  http.MethodGet,
+			modify:  func(params url.Values) { params.Set("nonce", "n-0S6_WzA2Mj") },
+			session: true,
+			mockSetup: func(oauth *handlers.OAuthServiceMock, auth *handlers.AuthServiceMock) {
+				oauth.FindClientMock.Return(client, nil)
+				auth.ValidateSessionTokenMock.Return(claims, nil)
+				oauth.HasConsentMock.Return(true, nil)
+				oauth.IssueAuthorizationCodeMock.Expect(ctx, &models.AuthorizationCode{
+					ClientID:      "client123",
+					UserID:        "user123",
+					RedirectURI:   "https://app.example.com/callback?tenant=1",
+					Scope:         "profile email",
+					CodeChallenge: challenge,
+					Nonce:         "n-0S6_WzA2Mj",
+					AuthTime:      signedInAt,
+				}).Return("code123", nil)
+			},
+			wantStatus:   http.StatusFound,
+			wantRedirect: url.Values{"code": {"code123"}, "state": {"xyz"}, "tenant": {"1"}},
+		},
+		{
+			name:   "sign in and allow",
+			method: http.MethodPost,
+			modify: func(params url.Values) {
+				params.Set("email", "alonso@mail.ru")
+				params.Set("password", "password")
+				params.Set("decision", "allow")
+			},
+			mockSetup: func(oauth *handlers.OAuthServiceMock, auth *handlers.AuthServiceMock) {
+				oauth.FindClientMock.Return(client, nil)
+				auth.SignInSessionMock.Expect(ctx, "alonso@mail.ru", "password", "", "192.0.2.1").Return("new_token", nil)
+				auth.ValidateSessionTokenMock.Expect(ctx, "new_token").Return(claims, nil)
+				oauth.GrantConsentMock.Expect(ctx, "user123", "client123", []string{"profile", "email"}).Return(nil)
+				oauth.IssueAuthorizationCodeMock.Return("code123", nil)
+			},
+			wantStatus:   http.StatusFound,
+			wantRedirect: url.Values{"code": {"code123"}, "state": {"xyz"}, "tenant": {"1"}},
+			wantCookie:   true,
+		},
+		{
+			name:   "wrong password",
+			method: http.MethodPost,
+			modify: func(params url.Values) {
+				params.Set("email", "alonso@mail.ru")
+				params.Set("password", "wrong")
+				params.Set("decision", "allow")
+			},
+			mockSetup: func(oauth *handlers.OAuthServiceMock, auth *handlers.AuthServiceMock) {
+				oauth.FindClientMock.Return(client, nil)
+				auth.SignInSessionMock.Return("", apperrors.ErrInvalidCredentials)
+			},
+			wantStatus: http.StatusOK,
+			wantBody:   apperrors.ErrInvalidCredentials.Error(),
+			wantForm:   true,
+		},
+		{
+			name:   "sign in without the form token",
+			method: http.MethodPost,
+			modify: func(params url.Values) {
+				params.Set("email", "alonso@mail.ru")
+				params.Set("password", "password")
+				params.Set("decision", "allow")
+			},
+			badCSRF: true,
+			mockSetup: func(oauth *handlers.OAuthServiceMock, auth *handlers.AuthServiceMock) {
+				oauth.FindClientMock.Return(client, nil)
+			},
+			wantStatus: http.StatusForbidden,
+			wantBody:   apperrors.ErrInvalidCSRFToken.Error(),
+			wantForm:   true,
+		},
+		{
+			name:    "allow without the form token",
+			method:  http.MethodPost,
+			modify:  func(params url.Values) { params.Set("decision", "allow") },
+			session: true,
+			badCSRF: true,
+			mockSetup: func(oauth *handlers.OAuthServiceMock, auth *handlers.AuthServiceMock) {
+				oauth.FindClientMock.Return(client, nil)
+			},
+			wantStatus: http.StatusForbidden,
+			wantBody:   apperrors.ErrInvalidCSRFToken.Error(),
+			wantForm:   true,
+		},
+		{
+			name:   "deny",
+			method: http.MethodPost,
+			modify: func(params url.Values) { params.Set("decision", "deny") },
+			mockSetup: func(oauth *handlers.OAuthServiceMock, auth *handlers.AuthServiceMock) {
+				oauth.FindClientMock.Return(client, nil)
+			},
+			wantStatus:   http.StatusFound,
+			wantRedirect: url.Values{"error": {"access_denied"}, "state": {"xyz"}, "tenant": {"1"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := minimock.NewController(t)
+			mockOAuth := handlers.NewOAuthServiceMock(mc)
+			mockAuth := handlers.NewAuthServiceMock(mc)
+			tt.mockSetup(mockOAuth, mockAuth)
+			h := handlers.New(mockAuth, nil, mockOAuth, nil, nil, cfg)
+
+			params := authorizeQuery()
+			tt.modify(params)
+
+			var req *http.Request
+			if tt.method == http.MethodPost {
+				if !tt.badCSRF {
+					params.Set("csrf_token", "csrf123")
+				}
+				req = newFormRequest("/oauth/authorize", params)
+				req.AddCookie(&http.Cookie{Name: "auth_csrf", Value: "csrf123"})
+			} else {
+				req = httptest.NewRequest(http.MethodGet, "/oauth/authorize?"+params.Encode(), nil)
+			}
+			if tt.session {
+				req.AddCookie(&http.Cookie{Name: "auth_session", Value: "session_token"})
+			}
+			rr := httptest.NewRecorder()
+
+			h.Authorize(rr, req)
+
+			require.Equal(t, tt.wantStatus, rr.Code)
+			require.Equal(t, "DENY", rr.Header().Get("X-Frame-Options"))
+			if tt.wantBody != "" {
+				require.Contains(t, rr.Body.String(), tt.wantBody)
+			}
+
+			if tt.wantRedirect != nil {
+				location, err := url.Parse(rr.Header().Get("Location"))
+				require.NoError(t, err)
+				require.Equal(t, "app.example.com", location.Host)
+
+				query := location.Query()
+				query.Del("error_description")
+				require.Equal(t, tt.wantRedirect, query)
+			}
+
+			cookies := make(map[string]*http.Cookie)
+			for _, cookie := range rr.Result().Cookies() {
+				cookies[cookie.Name] = cookie
+			}
+
+			// every rendered form gets a new token
+			if csrf := cookies["auth_csrf"]; tt.wantForm {
+				require.NotNil(t, csrf)
+				require.NotEqual(t, "csrf123", csrf.Value)
+				require.Contains(t, rr.Body.String(), `name="csrf_token" value="`+csrf.Value+`"`)
+				require.True(t, csrf.HttpOnly)
+				require.True(t, csrf.Secure)
+				require.Equal(t, http.SameSiteStrictMode, csrf.SameSite)
+			} else {
+				require.Nil(t, csrf)
+			}
+
+			session := cookies["auth_session"]
+			if !tt.wantCookie {
+				require.Nil(t, session)
+				return
+			}
+			require.NotNil(t, session)
+			require.Equal(t, "new_token", session.Value)
+			require.True(t, session.HttpOnly)
+			require.True(t, session.Secure)
+			require.Equal(t, http.SameSiteLaxMode, session.SameSite)
+		})
+	}
+}
+
+func TestToken(t *testing.T) {
+	ctx := context.Background()
+	client := &models.Client{ID: "client123"}
+	codeForm := url.Values{
+		"grant_type":    {models.GrantAuthorizationCode},
+		"code":          {"code123"},
+		"redirect_uri":  {"https://app.example.com/callback"},
+		"code_verifier": {"verifier"},
+	}
+
+	tests := []struct {
+		name       string
+		form       url.Values
+		mockSetup  func(mock *handlers.OAuthServiceMock)
+		wantStatus int
+		wantBody   dto.TokenResponse
+		wantError  string
+	}{
+		{
+			name: "authorization code",
+			form: codeForm,
+			mockSetup: func(mock *handlers.OAuthServiceMock) {
+				mock.ExchangeAuthorizationCodeMock.
+					Expect(ctx, client, "code123", "https://app.example.com/callback", "verifier").
+					Return(&models.Token{AccessToken: "access", TokenType: "Bearer", ExpiresIn: time.Hour, Scope: "profile"}, nil)
+			},
+			wantStatus: http.StatusOK,
+			wantBody:   dto.TokenResponse{AccessToken: "access", TokenType: "Bearer", ExpiresIn: 3600, Scope: "profile"},
+		},
+		{
+			name: "openid scope",
+			form: codeForm,
+			mockSetup: func(mock *handlers.OAuthServiceMock) {
+				mock.ExchangeAuthorizationCodeMock.
+					Return(&models.Token{AccessToken: "access", TokenType: "Bearer", ExpiresIn: time.Hour, Scope: "openid", IDToken: "id"}, nil)
+			},
+			wantStatus: http.StatusOK,
+			wantBody:   dto.TokenResponse{AccessToken: "access", TokenType: "Bearer", ExpiresIn: 3600, Scope: "openid", IDToken: "id"},
+		},
+		{
+			name: "invalid grant",
+			form: codeForm,
+			mockSetup: func(mock *handlers.OAuthServiceMock) {
+				mock.ExchangeAuthorizationCodeMock.Return(nil, apperrors.ErrInvalidGrant)
+			},
+			wantStatus: http.StatusBadRequest,
+			wantError:  "invalid_grant",
+		},
+		{
+			name: "client credentials",
+			form: url.Values{"grant_type": {models.GrantClientCredentials}, "scope": {"orders:read"}},
+			mockSetup: func(mock *handlers.OAuthServiceMock) {
+				mock.ClientCredentialsMock.Expect(ctx, client, "orders:read").
+					Return(&models.Token{AccessToken: "access", TokenType: "Bearer", ExpiresIn: time.Hour, Scope: "orders:read"}, nil)
+			},
+			wantStatus: http.StatusOK,
+			wantBody:   dto.TokenResponse{AccessToken: "access", TokenType: "Bearer", ExpiresIn: 3600, Scope: "orders:read"},
+		},
+		{
+			name: "scope not granted to the client",
+			form: url.Values{"grant_type": {models.GrantClientCredentials}, "scope": {"admin"}},
+			mockSetup: func(mock *handlers.OAuthServiceMock) {
+				mock.ClientCredentialsMock.Return(nil, apperrors.ErrInvalidScope)
+			},
+			wantStatus: http.StatusBadRequest,
+			wantError:  "invalid_scope",
+		},
+		{
+			name:       "missing verifier",
+			form:       url.Values{"grant_type": {models.GrantAuthorizationCode}, "code": {"code123"}, "redirect_uri": {"https://app.example.com/callback"}},
+			mockSetup:  func(mock *handlers.OAuthServiceMock) {},
+			wantStatus: http.StatusBadRequest,
+			wantError:  "invalid_request",
+		},
+		{
+			name:       "unsupported grant type",
+			form:       url.Values{"grant_type": {"password"}},
+			mockSetup:  func(mock *handlers.OAuthServiceMock) {},
+			wantStatus: http.StatusBadRequest,
+			wantError:  "unsupported_grant_type",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := minimock.NewController(t)
+			mockService := handlers.NewOAuthServiceMock(mc)
+			mockService.AuthenticateClientMock.Expect(ctx, "client123", "secret").Return(client, nil)
+			tt.mockSetup(mockService)
+			h := handlers.New(nil, nil, mockService, nil, nil, nil)
+
+			req := newFormRequest("/oauth/token", tt.form)
+			req.SetBasicAuth("client123", "secret")
+			rr := httptest.NewRecorder()
+
+			h.Token(rr, req)
+
+			require.Equal(t, tt.wantStatus, rr.Code)
+			require.Equal(t, "no-store", rr.Header().Get("Cache-Control"))
+
+			if tt.wantError != "" {
+				var body dto.OAuthErrorResponse
+				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &body))
+				require.Equal(t, tt.wantError, body.Error)
+				return
+			}
+
+			var body dto.TokenResponse
+			require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &body))
+			require.Equal(t, tt.wantBody, body)
+		})
+	}
+}
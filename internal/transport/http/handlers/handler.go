@@ -2,32 +2,102 @@ package handlers
 
 import (
 	"context"
+	"sync/atomic"
+	"time"
 
+	"github.com/alonsoF100/authorization-service/internal/config"
 	"github.com/alonsoF100/authorization-service/internal/models"
+	"github.com/alonsoF100/authorization-service/internal/transport/http/middleware"
 	"github.com/go-playground/validator/v10"
 )
 
 type AuthService interface {
 	SignUp(ctx context.Context, nickname, email, password string) (*models.User, error)
-	SignIn(ctx context.Context, email, password string) (string, error)
+	SignIn(ctx context.Context, email, password, userAgent, ip string) (string, error)
+	SignInSession(ctx context.Context, email, password, userAgent, ip string) (string, error)
 	ValidateJWT(ctx context.Context, tokenString string) (*models.Claims, error)
+	ValidateSessionToken(ctx context.Context, tokenString string) (*models.Claims, error)
+	CheckAllowedIP(ctx context.Context, claims *models.Claims, ip string) error
+	PublicKeys() []models.PublicKey
 }
 
 type UserService interface {
 	GetUser(ctx context.Context, userID string) (*models.User, error)
 	DeleteUser(ctx context.Context, userID string) error
+	CreatePersonalAccessToken(ctx context.Context, userID, name string, scopes []string, expiresAt *time.Time) (*models.PersonalAccessToken, string, error)
+	ListPersonalAccessTokens(ctx context.Context, userID string) ([]models.PersonalAccessToken, error)
+	DeletePersonalAccessToken(ctx context.Context, userID, tokenID string) error
+	AuthenticatePersonalAccessToken(ctx context.Context, token, ip string) (*models.Claims, error)
+	ListSessions(ctx context.Context, userID string) ([]models.Session, error)
+	DeleteSession(ctx context.Context, userID, sessionID string) error
+	SetAllowedIPs(ctx context.Context, userID, ip string, allowedIPs []string) ([]string, error)
+}
+
+type OAuthService interface {
+	AuthenticateClient(ctx context.Context, clientID, clientSecret string) (*models.Client, error)
+	Introspect(ctx context.Context, token string) (*models.Claims, error)
+	Revoke(ctx context.Context, client *models.Client, token string) error
+	FindClient(ctx context.Context, clientID string) (*models.Client, error)
+	HasConsent(ctx context.Context, userID, clientID string, scopes []string) (bool, error)
+	GrantConsent(ctx context.Context, userID, clientID string, scopes []string) error
+	IssueAuthorizationCode(ctx context.Context, request *models.AuthorizationCode) (string, error)
+	ExchangeAuthorizationCode(ctx context.Context, client *models.Client, code, redirectURI, codeVerifier string) (*models.Token, error)
+	ClientCredentials(ctx context.Context, client *models.Client, scope string) (*models.Token, error)
+	UserInfo(ctx context.Context, accessToken string) (*models.UserInfo, error)
+	ValidateLogout(ctx context.Context, idTokenHint, clientID, postLogoutRedirectURI string) error
+	EndSession(ctx context.Context, sessionToken string) error
+}
+
+type ServiceAccountService interface {
+	CreateServiceAccount(ctx context.Context, ownerID, name string, roles []string) (*models.ServiceAccount, error)
+	ListServiceAccounts(ctx context.Context) ([]models.ServiceAccount, error)
+	GetServiceAccount(ctx context.Context, accountID string) (*models.ServiceAccount, error)
+	DeleteServiceAccount(ctx context.Context, accountID string) error
+	CreateAPIKey(ctx context.Context, accountID string) (*models.APIKey, string, error)
+	ListAPIKeys(ctx context.Context, accountID string) ([]models.APIKey, error)
+	DeleteAPIKey(ctx context.Context, accountID, keyID string) error
+	AuthenticateAPIKey(ctx context.Context, key string) (*models.Claims, error)
+}
+
+type AuditService interface {
+	ListAuditEvents(ctx context.Context, filter models.AuditFilter) ([]models.AuditEvent, error)
+}
+
+// TokenVerifier validates tokens for forward auth, usually a cached
+// AuthService
+type TokenVerifier interface {
+	ValidateJWT(ctx context.Context, tokenString string) (*models.Claims, error)
+	CheckAllowedIP(ctx context.Context, claims *models.Claims, ip string) error
 }
 
 type Handler struct {
-	AuthService AuthService
-	UserService UserService
-	Validator   *validator.Validate
+	AuthService  AuthService
+	UserService  UserService
+	OAuthService OAuthService
+	// ServiceAccountService also authenticates API keys
+	ServiceAccountService ServiceAccountService
+	Verifier              TokenVerifier
+	Validator             *validator.Validate
+	Cfg                   *config.Config
+	// LiveConfig holds the config as reloaded at runtime, CORS and rate
+	// limits are read from it. Nil keeps Cfg.
+	LiveConfig *atomic.Pointer[config.Config]
+	// RateLimitStore backs the rate limits, nil turns them off
+	RateLimitStore middleware.RateLimitStore
+	// IPFilter applies the ip_filter rules, nil turns them off
+	IPFilter middleware.IPChecker
+	// AuditService answers audit queries, nil removes those routes
+	AuditService AuditService
 }
 
-func New(authService AuthService, userService UserService) *Handler {
+func New(authService AuthService, userService UserService, oauthService OAuthService, serviceAccountService ServiceAccountService, verifier TokenVerifier, cfg *config.Config) *Handler {
 	return &Handler{
-		AuthService: authService,
-		UserService: userService,
-		Validator:   validator.New(),
+		AuthService:           authService,
+		UserService:           userService,
+		OAuthService:          oauthService,
+		ServiceAccountService: serviceAccountService,
+		Verifier:              verifier,
+		Validator:             validator.New(),
+		Cfg:                   cfg,
 	}
 }
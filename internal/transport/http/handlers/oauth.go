@@ -0,0 +1,168 @@
+package handlers
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/url"
+
+	"github.com/alonsoF100/authorization-service/internal/apperrors"
+	"github.com/alonsoF100/authorization-service/internal/models"
+	"github.com/alonsoF100/authorization-service/internal/transport/http/dto"
+	"github.com/alonsoF100/authorization-service/internal/transport/http/help"
+)
+
+/*
+pattern: /oauth/introspect
+method: POST
+info: token introspection (RFC 7662), form encoded token parameter, the
+client authenticates with HTTP Basic or client_id and client_secret form
+parameters
+
+succeed:
+
+	-status code: 200 ok
+	-response body: JSON with active, sub, exp, iat, scope, client_id and
+	username, only active false for an inactive token
+
+failed:
+
+	-status code: 400 bad request, 401 unauthorized, 500 internal server error
+	-response body: JSON with OAuth error code
+*/
+func (h Handler) Introspect(w http.ResponseWriter, r *http.Request) {
+	const op = "handlers/oauth.go/Introspect"
+
+	w.Header().Set("Cache-Control", "no-store")
+
+	client, ok := h.authenticateClient(w, r)
+	if !ok {
+		return
+	}
+
+	token := r.PostForm.Get("token")
+	if token == "" {
+		help.WriteJSON(w, http.StatusBadRequest, dto.NewOAuthErrorResponse("invalid_request", "token is required"))
+		return
+	}
+
+	claims, err := h.OAuthService.Introspect(r.Context(), token)
+	if err != nil {
+		if errors.Is(err, apperrors.ErrInvalidToken) {
+			help.WriteJSON(w, http.StatusOK, dto.IntrospectionResponse{Active: false})
+			return
+		}
+
+		slog.Error("Introspection failed",
+			slog.String("op", op),
+			slog.String("client_id", client.ID),
+			slog.String("error", err.Error()),
+		)
+		help.WriteJSON(w, http.StatusInternalServerError, dto.NewOAuthErrorResponse("server_error", ""))
+		return
+	}
+
+	slog.Debug("Token introspected",
+		slog.String("op", op),
+		slog.String("client_id", client.ID),
+		slog.String("sub", claims.Subject),
+	)
+
+	help.WriteJSON(w, http.StatusOK, dto.NewIntrospectionResponse(claims))
+}
+
+/*
+pattern: /oauth/revoke
+method: POST
+info: token revocation (RFC 7009), form encoded token parameter, the client
+authenticates like for introspection, a client only revokes tokens issued
+to it, unknown and invalid tokens and those of others are not an error
+
+succeed:
+
+	-status code: 200 ok
+
+failed:
+
+	-status code: 400 bad request, 401 unauthorized, 500 internal server error
+	-response body: JSON with OAuth error code
+*/
+func (h Handler) Revoke(w http.ResponseWriter, r *http.Request) {
+	const op = "handlers/oauth.go/Revoke"
+
+	client, ok := h.authenticateClient(w, r)
+	if !ok {
+		return
+	}
+
+	token := r.PostForm.Get("token")
+	if token == "" {
+		help.WriteJSON(w, http.StatusBadRequest, dto.NewOAuthErrorResponse("invalid_request", "token is required"))
+		return
+	}
+
+	if err := h.OAuthService.Revoke(r.Context(), client, token); err != nil {
+		slog.Error("Revocation failed",
+			slog.String("op", op),
+			slog.String("client_id", client.ID),
+			slog.String("error", err.Error()),
+		)
+		help.WriteJSON(w, http.StatusInternalServerError, dto.NewOAuthErrorResponse("server_error", ""))
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// authenticateClient parses the form and authenticates the client, on
+// failure the response is already written
+func (h Handler) authenticateClient(w http.ResponseWriter, r *http.Request) (*models.Client, bool) {
+	const op = "handlers/oauth.go/authenticateClient"
+
+	if err := r.ParseForm(); err != nil {
+		help.WriteJSON(w, http.StatusBadRequest, dto.NewOAuthErrorResponse("invalid_request", "malformed form body"))
+		return nil, false
+	}
+
+	clientID, clientSecret, ok := basicAuth(r)
+	if !ok {
+		clientID = r.PostForm.Get("client_id")
+		clientSecret = r.PostForm.Get("client_secret")
+	}
+
+	client, err := h.OAuthService.AuthenticateClient(r.Context(), clientID, clientSecret)
+	if err != nil {
+		if errors.Is(err, apperrors.ErrInvalidClient) {
+			w.Header().Set("WWW-Authenticate", `Basic realm="oauth"`)
+			help.WriteJSON(w, http.StatusUnauthorized, dto.NewOAuthErrorResponse("invalid_client", ""))
+			return nil, false
+		}
+
+		slog.Error("Client authentication failed",
+			slog.String("op", op),
+			slog.String("error", err.Error()),
+		)
+		help.WriteJSON(w, http.StatusInternalServerError, dto.NewOAuthErrorResponse("server_error", ""))
+		return nil, false
+	}
+
+	return client, true
+}
+
+// basicAuth reads client_secret_basic credentials, RFC 6749 form encodes
+// them before the base64 step
+func basicAuth(r *http.Request) (string, string, bool) {
+	clientID, clientSecret, ok := r.BasicAuth()
+	if !ok {
+		return "", "", false
+	}
+
+	if decoded, err := url.QueryUnescape(clientID); err == nil {
+		clientID = decoded
+	}
+	if decoded, err := url.QueryUnescape(clientSecret); err == nil {
+		clientSecret = decoded
+	}
+
+	return clientID, clientSecret, true
+}
@@ -0,0 +1,4702 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package handlers
+
+//go:generate minimock -i github.com/alonsoF100/authorization-service/internal/transport/http/handlers.OAuthService -o oauth_service_mock_test.go -n OAuthServiceMock -p handlers
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/alonsoF100/authorization-service/internal/models"
+	"github.com/gojuno/minimock/v3"
+)
+
+// OAuthServiceMock implements OAuthService
+type OAuthServiceMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcAuthenticateClient          func(ctx context.Context, clientID string, clientSecret string) (cp1 *models.Client, err error)
+	funcAuthenticateClientOrigin    string
+	inspectFuncAuthenticateClient   func(ctx context.Context, clientID string, clientSecret string)
+	afterAuthenticateClientCounter  uint64
+	beforeAuthenticateClientCounter uint64
+	AuthenticateClientMock          mOAuthServiceMockAuthenticateClient
+
+	funcClientCredentials          func(ctx context.Context, client *models.Client, scope string) (tp1 *models.Token, err error)
+	funcClientCredentialsOrigin    string
+	inspectFuncClientCredentials   func(ctx context.Context, client *models.Client, scope string)
+	afterClientCredentialsCounter  uint64
+	beforeClientCredentialsCounter uint64
+	ClientCredentialsMock          mOAuthServiceMockClientCredentials
+
+	funcEndSession          func(ctx context.Context, sessionToken string) (err error)
+	funcEndSessionOrigin    string
+	inspectFuncEndSession   func(ctx context.Context, sessionToken string)
+	afterEndSessionCounter  uint64
+	beforeEndSessionCounter uint64
+	EndSessionMock          mOAuthServiceMockEndSession
+
+	funcExchangeAuthorizationCode          func(ctx context.Context, client *models.Client, code string, redirectURI string, codeVerifier string) (tp1 *models.Token, err error)
+	funcExchangeAuthorizationCodeOrigin    string
+	inspectFuncExchangeAuthorizationCode   func(ctx context.Context, client *models.Client, code string, redirectURI string, codeVerifier string)
+	afterExchangeAuthorizationCodeCounter  uint64
+	beforeExchangeAuthorizationCodeCounter uint64
+	ExchangeAuthorizationCodeMock          mOAuthServiceMockExchangeAuthorizationCode
+
+	funcFindClient          func(ctx context.Context, clientID string) (cp1 *models.Client, err error)
+	funcFindClientOrigin    string
+	inspectFuncFindClient   func(ctx context.Context, clientID string)
+	afterFindClientCounter  uint64
+	beforeFindClientCounter uint64
+	FindClientMock          mOAuthServiceMockFindClient
+
+	funcGrantConsent          func(ctx context.Context, userID string, clientID string, scopes []string) (err error)
+	funcGrantConsentOrigin    string
+	inspectFuncGrantConsent   func(ctx context.Context, userID string, clientID string, scopes []string)
+	afterGrantConsentCounter  uint64
+	beforeGrantConsentCounter uint64
+	GrantConsentMock          mOAuthServiceMockGrantConsent
+
+	funcHasConsent          func(ctx context.Context, userID string, clientID string, scopes []string) (b1 bool, err error)
+	funcHasConsentOrigin    string
+	inspectFuncHasConsent   func(ctx context.Context, userID string, clientID string, scopes []string)
+	afterHasConsentCounter  uint64
+	beforeHasConsentCounter uint64
+	HasConsentMock          mOAuthServiceMockHasConsent
+
+	funcIntrospect          func(ctx context.Context, token string) (cp1 *models.Claims, err error)
+	funcIntrospectOrigin    string
+	inspectFuncIntrospect   func(ctx context.Context, token string)
+	afterIntrospectCounter  uint64
+	beforeIntrospectCounter uint64
+	IntrospectMock          mOAuthServiceMockIntrospect
+
+	funcIssueAuthorizationCode          func(ctx context.Context, request *models.AuthorizationCode) (s1 string, err error)
+	funcIssueAuthorizationCodeOrigin    string
+	inspectFuncIssueAuthorizationCode   func(ctx context.Context, request *models.AuthorizationCode)
+	afterIssueAuthorizationCodeCounter  uint64
+	beforeIssueAuthorizationCodeCounter uint64
+	IssueAuthorizationCodeMock          mOAuthServiceMockIssueAuthorizationCode
+
+	funcRevoke          func(ctx context.Context, client *models.Client, token string) (err error)
+	funcRevokeOrigin    string
+	inspectFuncRevoke   func(ctx context.Context, client *models.Client, token string)
+	afterRevokeCounter  uint64
+	beforeRevokeCounter uint64
+	RevokeMock          mOAuthServiceMockRevoke
+
+	funcUserInfo          func(ctx context.Context, accessToken string) (up1 *models.UserInfo, err error)
+	funcUserInfoOrigin    string
+	inspectFuncUserInfo   func(ctx context.Context, accessToken string)
+	afterUserInfoCounter  uint64
+	beforeUserInfoCounter uint64
+	UserInfoMock          mOAuthServiceMockUserInfo
+
+	funcValidateLogout          func(ctx context.Context, idTokenHint string, clientID string, postLogoutRedirectURI string) (err error)
+	funcValidateLogoutOrigin    string
+	inspectFuncValidateLogout   func(ctx context.Context, idTokenHint string, clientID string, postLogoutRedirectURI string)
+	afterValidateLogoutCounter  uint64
+	beforeValidateLogoutCounter uint64
+	ValidateLogoutMock          mOAuthServiceMockValidateLogout
+}
+
+// NewOAuthServiceMock returns a mock for OAuthService
+func NewOAuthServiceMock(t minimock.Tester) *OAuthServiceMock {
+	m := &OAuthServiceMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.AuthenticateClientMock = mOAuthServiceMockAuthenticateClient{mock: m}
+	m.AuthenticateClientMock.callArgs = []*OAuthServiceMockAuthenticateClientParams{}
+
+	m.ClientCredentialsMock = mOAuthServiceMockClientCredentials{mock: m}
+	m.ClientCredentialsMock.callArgs = []*OAuthServiceMockClientCredentialsParams{}
+
+	m.EndSessionMock = mOAuthServiceMockEndSession{mock: m}
+	m.EndSessionMock.callArgs = []*OAuthServiceMockEndSessionParams{}
+
+	m.ExchangeAuthorizationCodeMock = mOAuthServiceMockExchangeAuthorizationCode{mock: m}
+	m.ExchangeAuthorizationCodeMock.callArgs = []*OAuthServiceMockExchangeAuthorizationCodeParams{}
+
+	m.FindClientMock = mOAuthServiceMockFindClient{mock: m}
+	m.FindClientMock.callArgs = []*OAuthServiceMockFindClientParams{}
+
+	m.GrantConsentMock = mOAuthServiceMockGrantConsent{mock: m}
+	m.GrantConsentMock.callArgs = []*OAuthServiceMockGrantConsentParams{}
+
+	m.HasConsentMock = mOAuthServiceMockHasConsent{mock: m}
+	m.HasConsentMock.callArgs = []*OAuthServiceMockHasConsentParams{}
+
+	m.IntrospectMock = mOAuthServiceMockIntrospect{mock: m}
+	m.IntrospectMock.callArgs = []*OAuthServiceMockIntrospectParams{}
+
+	m.IssueAuthorizationCodeMock = mOAuthServiceMockIssueAuthorizationCode{mock: m}
+	m.IssueAuthorizationCodeMock.callArgs = []*OAuthServiceMockIssueAuthorizationCodeParams{}
+
+	m.RevokeMock = mOAuthServiceMockRevoke{mock: m}
+	m.RevokeMock.callArgs = []*OAuthServiceMockRevokeParams{}
+
+	m.UserInfoMock = mOAuthServiceMockUserInfo{mock: m}
+	m.UserInfoMock.callArgs = []*OAuthServiceMockUserInfoParams{}
+
+	m.ValidateLogoutMock = mOAuthServiceMockValidateLogout{mock: m}
+	m.ValidateLogoutMock.callArgs = []*OAuthServiceMockValidateLogoutParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mOAuthServiceMockAuthenticateClient struct {
+	optional           bool
+	mock               *OAuthServiceMock
+	defaultExpectation *OAuthServiceMockAuthenticateClientExpectation
+	expectations       []*OAuthServiceMockAuthenticateClientExpectation
+
+	callArgs []*OAuthServiceMockAuthenticateClientParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OAuthServiceMockAuthenticateClientExpectation specifies expectation struct of the OAuthService.AuthenticateClient
+type OAuthServiceMockAuthenticateClientExpectation struct {
+	mock               *OAuthServiceMock
+	params             *OAuthServiceMockAuthenticateClientParams
+	paramPtrs          *OAuthServiceMockAuthenticateClientParamPtrs
+	expectationOrigins OAuthServiceMockAuthenticateClientExpectationOrigins
+	results            *OAuthServiceMockAuthenticateClientResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// OAuthServiceMockAuthenticateClientParams contains parameters of the OAuthService.AuthenticateClient
+type OAuthServiceMockAuthenticateClientParams struct {
+	ctx          context.Context
+	clientID     string
+	clientSecret string
+}
+
+// OAuthServiceMockAuthenticateClientParamPtrs contains pointers to parameters of the OAuthService.AuthenticateClient
+type OAuthServiceMockAuthenticateClientParamPtrs struct {
+	ctx          *context.Context
+	clientID     *string
+	clientSecret *string
+}
+
+// OAuthServiceMockAuthenticateClientResults contains results of the OAuthService.AuthenticateClient
+type OAuthServiceMockAuthenticateClientResults struct {
+	cp1 *models.Client
+	err error
+}
+
+// OAuthServiceMockAuthenticateClientOrigins contains origins of expectations of the OAuthService.AuthenticateClient
+type OAuthServiceMockAuthenticateClientExpectationOrigins struct {
+	origin             string
+	originCtx          string
+	originClientID     string
+	originClientSecret string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmAuthenticateClient *mOAuthServiceMockAuthenticateClient) Optional() *mOAuthServiceMockAuthenticateClient {
+	mmAuthenticateClient.optional = true
+	return mmAuthenticateClient
+}
+
+// Expect sets up expected params for OAuthService.AuthenticateClient
+func (mmAuthenticateClient *mOAuthServiceMockAuthenticateClient) Expect(ctx context.Context, clientID string, clientSecret string) *mOAuthServiceMockAuthenticateClient {
+	if mmAuthenticateClient.mock.funcAuthenticateClient != nil {
+		mmAuthenticateClient.mock.t.Fatalf("OAuthServiceMock.AuthenticateClient mock is already set by Set")
+	}
+
+	if mmAuthenticateClient.defaultExpectation == nil {
+		mmAuthenticateClient.defaultExpectation = &OAuthServiceMockAuthenticateClientExpectation{}
+	}
+
+	if mmAuthenticateClient.defaultExpectation.paramPtrs != nil {
+		mmAuthenticateClient.mock.t.Fatalf("OAuthServiceMock.AuthenticateClient mock is already set by ExpectParams functions")
+	}
+
+	mmAuthenticateClient.defaultExpectation.params = &OAuthServiceMockAuthenticateClientParams{ctx, clientID, clientSecret}
+	mmAuthenticateClient.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmAuthenticateClient.expectations {
+		if minimock.Equal(e.params, mmAuthenticateClient.defaultExpectation.params) {
+			mmAuthenticateClient.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAuthenticateClient.defaultExpectation.params)
+		}
+	}
+
+	return mmAuthenticateClient
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OAuthService.AuthenticateClient
+func (mmAuthenticateClient *mOAuthServiceMockAuthenticateClient) ExpectCtxParam1(ctx context.Context) *mOAuthServiceMockAuthenticateClient {
+	if mmAuthenticateClient.mock.funcAuthenticateClient != nil {
+		mmAuthenticateClient.mock.t.Fatalf("OAuthServiceMock.AuthenticateClient mock is already set by Set")
+	}
+
+	if mmAuthenticateClient.defaultExpectation == nil {
+		mmAuthenticateClient.defaultExpectation = &OAuthServiceMockAuthenticateClientExpectation{}
+	}
+
+	if mmAuthenticateClient.defaultExpectation.params != nil {
+		mmAuthenticateClient.mock.t.Fatalf("OAuthServiceMock.AuthenticateClient mock is already set by Expect")
+	}
+
+	if mmAuthenticateClient.defaultExpectation.paramPtrs == nil {
+		mmAuthenticateClient.defaultExpectation.paramPtrs = &OAuthServiceMockAuthenticateClientParamPtrs{}
+	}
+	mmAuthenticateClient.defaultExpectation.paramPtrs.ctx = &ctx
+	mmAuthenticateClient.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmAuthenticateClient
+}
+
+// ExpectClientIDParam2 sets up expected param clientID for OAuthService.AuthenticateClient
+func (mmAuthenticateClient *mOAuthServiceMockAuthenticateClient) ExpectClientIDParam2(clientID string) *mOAuthServiceMockAuthenticateClient {
+	if mmAuthenticateClient.mock.funcAuthenticateClient != nil {
+		mmAuthenticateClient.mock.t.Fatalf("OAuthServiceMock.AuthenticateClient mock is already set by Set")
+	}
+
+	if mmAuthenticateClient.defaultExpectation == nil {
+		mmAuthenticateClient.defaultExpectation = &OAuthServiceMockAuthenticateClientExpectation{}
+	}
+
+	if mmAuthenticateClient.defaultExpectation.params != nil {
+		mmAuthenticateClient.mock.t.Fatalf("OAuthServiceMock.AuthenticateClient mock is already set by Expect")
+	}
+
+	if mmAuthenticateClient.defaultExpectation.paramPtrs == nil {
+		mmAuthenticateClient.defaultExpectation.paramPtrs = &OAuthServiceMockAuthenticateClientParamPtrs{}
+	}
+	mmAuthenticateClient.defaultExpectation.paramPtrs.clientID = &clientID
+	mmAuthenticateClient.defaultExpectation.expectationOrigins.originClientID = minimock.CallerInfo(1)
+
+	return mmAuthenticateClient
+}
+
+// ExpectClientSecretParam3 sets up expected param clientSecret for OAuthService.AuthenticateClient
+func (mmAuthenticateClient *mOAuthServiceMockAuthenticateClient) ExpectClientSecretParam3(clientSecret string) *mOAuthServiceMockAuthenticateClient {
+	if mmAuthenticateClient.mock.funcAuthenticateClient != nil {
+		mmAuthenticateClient.mock.t.Fatalf("OAuthServiceMock.AuthenticateClient mock is already set by Set")
+	}
+
+	if mmAuthenticateClient.defaultExpectation == nil {
+		mmAuthenticateClient.defaultExpectation = &OAuthServiceMockAuthenticateClientExpectation{}
+	}
+
+	if mmAuthenticateClient.defaultExpectation.params != nil {
+		mmAuthenticateClient.mock.t.Fatalf("OAuthServiceMock.AuthenticateClient mock is already set by Expect")
+	}
+
+	if mmAuthenticateClient.defaultExpectation.paramPtrs == nil {
+		mmAuthenticateClient.defaultExpectation.paramPtrs = &OAuthServiceMockAuthenticateClientParamPtrs{}
+	}
+	mmAuthenticateClient.defaultExpectation.paramPtrs.clientSecret = &clientSecret
+	mmAuthenticateClient.defaultExpectation.expectationOrigins.originClientSecret = minimock.CallerInfo(1)
+
+	return mmAuthenticateClient
+}
+
+// Inspect accepts an inspector function that has same arguments as the OAuthService.AuthenticateClient
+func (mmAuthenticateClient *mOAuthServiceMockAuthenticateClient) Inspect(f func(ctx context.Context, clientID string, clientSecret string)) *mOAuthServiceMockAuthenticateClient {
+	if mmAuthenticateClient.mock.inspectFuncAuthenticateClient != nil {
+		mmAuthenticateClient.mock.t.Fatalf("Inspect function is already set for OAuthServiceMock.AuthenticateClient")
+	}
+
+	mmAuthenticateClient.mock.inspectFuncAuthenticateClient = f
+
+	return mmAuthenticateClient
+}
+
+// Return sets up results that will be returned by OAuthService.AuthenticateClient
+func (mmAuthenticateClient *mOAuthServiceMockAuthenticateClient) Return(cp1 *models.Client, err error) *OAuthServiceMock {
+	if mmAuthenticateClient.mock.funcAuthenticateClient != nil {
+		mmAuthenticateClient.mock.t.Fatalf("OAuthServiceMock.AuthenticateClient mock is already set by Set")
+	}
+
+	if mmAuthenticateClient.defaultExpectation == nil {
+		mmAuthenticateClient.defaultExpectation = &OAuthServiceMockAuthenticateClientExpectation{mock: mmAuthenticateClient.mock}
+	}
+	mmAuthenticateClient.defaultExpectation.results = &OAuthServiceMockAuthenticateClientResults{cp1, err}
+	mmAuthenticateClient.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmAuthenticateClient.mock
+}
+
+// Set uses given function f to mock the OAuthService.AuthenticateClient method
+func (mmAuthenticateClient *mOAuthServiceMockAuthenticateClient) Set(f func(ctx context.Context, clientID string, clientSecret string) (cp1 *models.Client, err error)) *OAuthServiceMock {
+	if mmAuthenticateClient.defaultExpectation != nil {
+		mmAuthenticateClient.mock.t.Fatalf("Default expectation is already set for the OAuthService.AuthenticateClient method")
+	}
+
+	if len(mmAuthenticateClient.expectations) > 0 {
+		mmAuthenticateClient.mock.t.Fatalf("Some expectations are already set for the OAuthService.AuthenticateClient method")
+	}
+
+	mmAuthenticateClient.mock.funcAuthenticateClient = f
+	mmAuthenticateClient.mock.funcAuthenticateClientOrigin = minimock.CallerInfo(1)
+	return mmAuthenticateClient.mock
+}
+
+// When sets expectation for the OAuthService.AuthenticateClient which will trigger the result defined by the following
+// Then helper
+func (mmAuthenticateClient *mOAuthServiceMockAuthenticateClient) When(ctx context.Context, clientID string, clientSecret string) *OAuthServiceMockAuthenticateClientExpectation {
+	if mmAuthenticateClient.mock.funcAuthenticateClient != nil {
+		mmAuthenticateClient.mock.t.Fatalf("OAuthServiceMock.AuthenticateClient mock is already set by Set")
+	}
+
+	expectation := &OAuthServiceMockAuthenticateClientExpectation{
+		mock:               mmAuthenticateClient.mock,
+		params:             &OAuthServiceMockAuthenticateClientParams{ctx, clientID, clientSecret},
+		expectationOrigins: OAuthServiceMockAuthenticateClientExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmAuthenticateClient.expectations = append(mmAuthenticateClient.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OAuthService.AuthenticateClient return parameters for the expectation previously defined by the When method
+func (e *OAuthServiceMockAuthenticateClientExpectation) Then(cp1 *models.Client, err error) *OAuthServiceMock {
+	e.results = &OAuthServiceMockAuthenticateClientResults{cp1, err}
+	return e.mock
+}
+
+// Times sets number of times OAuthService.AuthenticateClient should be invoked
+func (mmAuthenticateClient *mOAuthServiceMockAuthenticateClient) Times(n uint64) *mOAuthServiceMockAuthenticateClient {
+	if n == 0 {
+		mmAuthenticateClient.mock.t.Fatalf("Times of OAuthServiceMock.AuthenticateClient mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmAuthenticateClient.expectedInvocations, n)
+	mmAuthenticateClient.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmAuthenticateClient
+}
+
+func (mmAuthenticateClient *mOAuthServiceMockAuthenticateClient) invocationsDone() bool {
+	if len(mmAuthenticateClient.expectations) == 0 && mmAuthenticateClient.defaultExpectation == nil && mmAuthenticateClient.mock.funcAuthenticateClient == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmAuthenticateClient.mock.afterAuthenticateClientCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmAuthenticateClient.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// AuthenticateClient implements OAuthService
+func (mmAuthenticateClient *OAuthServiceMock) AuthenticateClient(ctx context.Context, clientID string, clientSecret string) (cp1 *models.Client, err error) {
+	mm_atomic.AddUint64(&mmAuthenticateClient.beforeAuthenticateClientCounter, 1)
+	defer mm_atomic.AddUint64(&mmAuthenticateClient.afterAuthenticateClientCounter, 1)
+
+	mmAuthenticateClient.t.Helper()
+
+	if mmAuthenticateClient.inspectFuncAuthenticateClient != nil {
+		mmAuthenticateClient.inspectFuncAuthenticateClient(ctx, clientID, clientSecret)
+	}
+
+	mm_params := OAuthServiceMockAuthenticateClientParams{ctx, clientID, clientSecret}
+
+	// Record call args
+	mmAuthenticateClient.AuthenticateClientMock.mutex.Lock()
+	mmAuthenticateClient.AuthenticateClientMock.callArgs = append(mmAuthenticateClient.AuthenticateClientMock.callArgs, &mm_params)
+	mmAuthenticateClient.AuthenticateClientMock.mutex.Unlock()
+
+	for _, e := range mmAuthenticateClient.AuthenticateClientMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.cp1, e.results.err
+		}
+	}
+
+	if mmAuthenticateClient.AuthenticateClientMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAuthenticateClient.AuthenticateClientMock.defaultExpectation.Counter, 1)
+		mm_want := mmAuthenticateClient.AuthenticateClientMock.defaultExpectation.params
+		mm_want_ptrs := mmAuthenticateClient.AuthenticateClientMock.defaultExpectation.paramPtrs
+
+		mm_got := OAuthServiceMockAuthenticateClientParams{ctx, clientID, clientSecret}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmAuthenticateClient.t.Errorf("OAuthServiceMock.AuthenticateClient got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAuthenticateClient.AuthenticateClientMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.clientID != nil && !minimock.Equal(*mm_want_ptrs.clientID, mm_got.clientID) {
+				mmAuthenticateClient.t.Errorf("OAuthServiceMock.AuthenticateClient got unexpected parameter clientID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAuthenticateClient.AuthenticateClientMock.defaultExpectation.expectationOrigins.originClientID, *mm_want_ptrs.clientID, mm_got.clientID, minimock.Diff(*mm_want_ptrs.clientID, mm_got.clientID))
+			}
+
+			if mm_want_ptrs.clientSecret != nil && !minimock.Equal(*mm_want_ptrs.clientSecret, mm_got.clientSecret) {
+				mmAuthenticateClient.t.Errorf("OAuthServiceMock.AuthenticateClient got unexpected parameter clientSecret, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAuthenticateClient.AuthenticateClientMock.defaultExpectation.expectationOrigins.originClientSecret, *mm_want_ptrs.clientSecret, mm_got.clientSecret, minimock.Diff(*mm_want_ptrs.clientSecret, mm_got.clientSecret))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmAuthenticateClient.t.Errorf("OAuthServiceMock.AuthenticateClient got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmAuthenticateClient.AuthenticateClientMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmAuthenticateClient.AuthenticateClientMock.defaultExpectation.results
+		if mm_results == nil {
+			mmAuthenticateClient.t.Fatal("No results are set for the OAuthServiceMock.AuthenticateClient")
+		}
+		return (*mm_results).cp1, (*mm_results).err
+	}
+	if mmAuthenticateClient.funcAuthenticateClient != nil {
+		return mmAuthenticateClient.funcAuthenticateClient(ctx, clientID, clientSecret)
+	}
+	mmAuthenticateClient.t.Fatalf("Unexpected call to OAuthServiceMock.AuthenticateClient. %v %v %v", ctx, clientID, clientSecret)
+	return
+}
+
+// AuthenticateClientAfterCounter returns a count of finished OAuthServiceMock.AuthenticateClient invocations
+func (mmAuthenticateClient *OAuthServiceMock) AuthenticateClientAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAuthenticateClient.afterAuthenticateClientCounter)
+}
+
+// AuthenticateClientBeforeCounter returns a count of OAuthServiceMock.AuthenticateClient invocations
+func (mmAuthenticateClient *OAuthServiceMock) AuthenticateClientBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAuthenticateClient.beforeAuthenticateClientCounter)
+}
+
+// Calls returns a list of arguments used in each call to OAuthServiceMock.AuthenticateClient.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmAuthenticateClient *mOAuthServiceMockAuthenticateClient) Calls() []*OAuthServiceMockAuthenticateClientParams {
+	mmAuthenticateClient.mutex.RLock()
+
+	argCopy := make([]*OAuthServiceMockAuthenticateClientParams, len(mmAuthenticateClient.callArgs))
+	copy(argCopy, mmAuthenticateClient.callArgs)
+
+	mmAuthenticateClient.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockAuthenticateClientDone returns true if the count of the AuthenticateClient invocations corresponds
+// the number of defined expectations
+func (m *OAuthServiceMock) MinimockAuthenticateClientDone() bool {
+	if m.AuthenticateClientMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.AuthenticateClientMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.AuthenticateClientMock.invocationsDone()
+}
+
+// MinimockAuthenticateClientInspect logs each unmet expectation
+func (m *OAuthServiceMock) MinimockAuthenticateClientInspect() {
+	for _, e := range m.AuthenticateClientMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OAuthServiceMock.AuthenticateClient at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterAuthenticateClientCounter := mm_atomic.LoadUint64(&m.afterAuthenticateClientCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.AuthenticateClientMock.defaultExpectation != nil && afterAuthenticateClientCounter < 1 {
+		if m.AuthenticateClientMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OAuthServiceMock.AuthenticateClient at\n%s", m.AuthenticateClientMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OAuthServiceMock.AuthenticateClient at\n%s with params: %#v", m.AuthenticateClientMock.defaultExpectation.expectationOrigins.origin, *m.AuthenticateClientMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcAuthenticateClient != nil && afterAuthenticateClientCounter < 1 {
+		m.t.Errorf("Expected call to OAuthServiceMock.AuthenticateClient at\n%s", m.funcAuthenticateClientOrigin)
+	}
+
+	if !m.AuthenticateClientMock.invocationsDone() && afterAuthenticateClientCounter > 0 {
+		m.t.Errorf("Expected %d calls to OAuthServiceMock.AuthenticateClient at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.AuthenticateClientMock.expectedInvocations), m.AuthenticateClientMock.expectedInvocationsOrigin, afterAuthenticateClientCounter)
+	}
+}
+
+type mOAuthServiceMockClientCredentials struct {
+	optional           bool
+	mock               *OAuthServiceMock
+	defaultExpectation *OAuthServiceMockClientCredentialsExpectation
+	expectations       []*OAuthServiceMockClientCredentialsExpectation
+
+	callArgs []*OAuthServiceMockClientCredentialsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OAuthServiceMockClientCredentialsExpectation specifies expectation struct of the OAuthService.ClientCredentials
+type OAuthServiceMockClientCredentialsExpectation struct {
+	mock               *OAuthServiceMock
+	params             *OAuthServiceMockClientCredentialsParams
+	paramPtrs          *OAuthServiceMockClientCredentialsParamPtrs
+	expectationOrigins OAuthServiceMockClientCredentialsExpectationOrigins
+	results            *OAuthServiceMockClientCredentialsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// OAuthServiceMockClientCredentialsParams contains parameters of the OAuthService.ClientCredentials
+type OAuthServiceMockClientCredentialsParams struct {
+	ctx    context.Context
+	client *models.Client
+	scope  string
+}
+
+// OAuthServiceMockClientCredentialsParamPtrs contains pointers to parameters of the OAuthService.ClientCredentials
+type OAuthServiceMockClientCredentialsParamPtrs struct {
+	ctx    *context.Context
+	client **models.Client
+	scope  *string
+}
+
+// OAuthServiceMockClientCredentialsResults contains results of the OAuthService.ClientCredentials
+type OAuthServiceMockClientCredentialsResults struct {
+	tp1 *models.Token
+	err error
+}
+
+// OAuthServiceMockClientCredentialsOrigins contains origins of expectations of the OAuthService.ClientCredentials
+type OAuthServiceMockClientCredentialsExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originClient string
+	originScope  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmClientCredentials *mOAuthServiceMockClientCredentials) Optional() *mOAuthServiceMockClientCredentials {
+	mmClientCredentials.optional = true
+	return mmClientCredentials
+}
+
+// Expect sets up expected params for OAuthService.ClientCredentials
+func (mmClientCredentials *mOAuthServiceMockClientCredentials) Expect(ctx context.Context, client *models.Client, scope string) *mOAuthServiceMockClientCredentials {
+	if mmClientCredentials.mock.funcClientCredentials != nil {
+		mmClientCredentials.mock.t.Fatalf("OAuthServiceMock.ClientCredentials mock is already set by Set")
+	}
+
+	if mmClientCredentials.defaultExpectation == nil {
+		mmClientCredentials.defaultExpectation = &OAuthServiceMockClientCredentialsExpectation{}
+	}
+
+	if mmClientCredentials.defaultExpectation.paramPtrs != nil {
+		mmClientCredentials.mock.t.Fatalf("OAuthServiceMock.ClientCredentials mock is already set by ExpectParams functions")
+	}
+
+	mmClientCredentials.defaultExpectation.params = &OAuthServiceMockClientCredentialsParams{ctx, client, scope}
+	mmClientCredentials.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmClientCredentials.expectations {
+		if minimock.Equal(e.params, mmClientCredentials.defaultExpectation.params) {
+			mmClientCredentials.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmClientCredentials.defaultExpectation.params)
+		}
+	}
+
+	return mmClientCredentials
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OAuthService.ClientCredentials
+func (mmClientCredentials *mOAuthServiceMockClientCredentials) ExpectCtxParam1(ctx context.Context) *mOAuthServiceMockClientCredentials {
+	if mmClientCredentials.mock.funcClientCredentials != nil {
+		mmClientCredentials.mock.t.Fatalf("OAuthServiceMock.ClientCredentials mock is already set by Set")
+	}
+
+	if mmClientCredentials.defaultExpectation == nil {
+		mmClientCredentials.defaultExpectation = &OAuthServiceMockClientCredentialsExpectation{}
+	}
+
+	if mmClientCredentials.defaultExpectation.params != nil {
+		mmClientCredentials.mock.t.Fatalf("OAuthServiceMock.ClientCredentials mock is already set by Expect")
+	}
+
+	if mmClientCredentials.defaultExpectation.paramPtrs == nil {
+		mmClientCredentials.defaultExpectation.paramPtrs = &OAuthServiceMockClientCredentialsParamPtrs{}
+	}
+	mmClientCredentials.defaultExpectation.paramPtrs.ctx = &ctx
+	mmClientCredentials.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmClientCredentials
+}
+
+// ExpectClientParam2 sets up expected param client for OAuthService.ClientCredentials
+func (mmClientCredentials *mOAuthServiceMockClientCredentials) ExpectClientParam2(client *models.Client) *mOAuthServiceMockClientCredentials {
+	if mmClientCredentials.mock.funcClientCredentials != nil {
+		mmClientCredentials.mock.t.Fatalf("OAuthServiceMock.ClientCredentials mock is already set by Set")
+	}
+
+	if mmClientCredentials.defaultExpectation == nil {
+		mmClientCredentials.defaultExpectation = &OAuthServiceMockClientCredentialsExpectation{}
+	}
+
+	if mmClientCredentials.defaultExpectation.params != nil {
+		mmClientCredentials.mock.t.Fatalf("OAuthServiceMock.ClientCredentials mock is already set by Expect")
+	}
+
+	if mmClientCredentials.defaultExpectation.paramPtrs == nil {
+		mmClientCredentials.defaultExpectation.paramPtrs = &OAuthServiceMockClientCredentialsParamPtrs{}
+	}
+	mmClientCredentials.defaultExpectation.paramPtrs.client = &client
+	mmClientCredentials.defaultExpectation.expectationOrigins.originClient = minimock.CallerInfo(1)
+
+	return mmClientCredentials
+}
+
+// ExpectScopeParam3 sets up expected param scope for OAuthService.ClientCredentials
+func (mmClientCredentials *mOAuthServiceMockClientCredentials) ExpectScopeParam3(scope string) *mOAuthServiceMockClientCredentials {
+	if mmClientCredentials.mock.funcClientCredentials != nil {
+		mmClientCredentials.mock.t.Fatalf("OAuthServiceMock.ClientCredentials mock is already set by Set")
+	}
+
+	if mmClientCredentials.defaultExpectation == nil {
+		mmClientCredentials.defaultExpectation = &OAuthServiceMockClientCredentialsExpectation{}
+	}
+
+	if mmClientCredentials.defaultExpectation.params != nil {
+		mmClientCredentials.mock.t.Fatalf("OAuthServiceMock.ClientCredentials mock is already set by Expect")
+	}
+
+	if mmClientCredentials.defaultExpectation.paramPtrs == nil {
+		mmClientCredentials.defaultExpectation.paramPtrs = &OAuthServiceMockClientCredentialsParamPtrs{}
+	}
+	mmClientCredentials.defaultExpectation.paramPtrs.scope = &scope
+	mmClientCredentials.defaultExpectation.expectationOrigins.originScope = minimock.CallerInfo(1)
+
+	return mmClientCredentials
+}
+
+// Inspect accepts an inspector function that has same arguments as the OAuthService.ClientCredentials
+func (mmClientCredentials *mOAuthServiceMockClientCredentials) Inspect(f func(ctx context.Context, client *models.Client, scope string)) *mOAuthServiceMockClientCredentials {
+	if mmClientCredentials.mock.inspectFuncClientCredentials != nil {
+		mmClientCredentials.mock.t.Fatalf("Inspect function is already set for OAuthServiceMock.ClientCredentials")
+	}
+
+	mmClientCredentials.mock.inspectFuncClientCredentials = f
+
+	return mmClientCredentials
+}
+
+// Return sets up results that will be returned by OAuthService.ClientCredentials
+func (mmClientCredentials *mOAuthServiceMockClientCredentials) Return(tp1 *models.Token, err error) *OAuthServiceMock {
+	if mmClientCredentials.mock.funcClientCredentials != nil {
+		mmClientCredentials.mock.t.Fatalf("OAuthServiceMock.ClientCredentials mock is already set by Set")
+	}
+
+	if mmClientCredentials.defaultExpectation == nil {
+		mmClientCredentials.defaultExpectation = &OAuthServiceMockClientCredentialsExpectation{mock: mmClientCredentials.mock}
+	}
+	mmClientCredentials.defaultExpectation.results = &OAuthServiceMockClientCredentialsResults{tp1, err}
+	mmClientCredentials.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmClientCredentials.mock
+}
+
+// Set uses given function f to mock the OAuthService.ClientCredentials method
+func (mmClientCredentials *mOAuthServiceMockClientCredentials) Set(f func(ctx context.Context, client *models.Client, scope string) (tp1 *models.Token, err error)) *OAuthServiceMock {
+	if mmClientCredentials.defaultExpectation != nil {
+		mmClientCredentials.mock.t.Fatalf("Default expectation is already set for the OAuthService.ClientCredentials method")
+	}
+
+	if len(mmClientCredentials.expectations) > 0 {
+		mmClientCredentials.mock.t.Fatalf("Some expectations are already set for the OAuthService.ClientCredentials method")
+	}
+
+	mmClientCredentials.mock.funcClientCredentials = f
+	mmClientCredentials.mock.funcClientCredentialsOrigin = minimock.CallerInfo(1)
+	return mmClientCredentials.mock
+}
+
+// When sets expectation for the OAuthService.ClientCredentials which will trigger the result defined by the following
+// Then helper
+func (mmClientCredentials *mOAuthServiceMockClientCredentials) When(ctx context.Context, client *models.Client, scope string) *OAuthServiceMockClientCredentialsExpectation {
+	if mmClientCredentials.mock.funcClientCredentials != nil {
+		mmClientCredentials.mock.t.Fatalf("OAuthServiceMock.ClientCredentials mock is already set by Set")
+	}
+
+	expectation := &OAuthServiceMockClientCredentialsExpectation{
+		mock:               mmClientCredentials.mock,
+		params:             &OAuthServiceMockClientCredentialsParams{ctx, client, scope},
+		expectationOrigins: OAuthServiceMockClientCredentialsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmClientCredentials.expectations = append(mmClientCredentials.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OAuthService.ClientCredentials return parameters for the expectation previously defined by the When method
+func (e *OAuthServiceMockClientCredentialsExpectation) Then(tp1 *models.Token, err error) *OAuthServiceMock {
+	e.results = &OAuthServiceMockClientCredentialsResults{tp1, err}
+	return e.mock
+}
+
+// Times sets number of times OAuthService.ClientCredentials should be invoked
+func (mmClientCredentials *mOAuthServiceMockClientCredentials) Times(n uint64) *mOAuthServiceMockClientCredentials {
+	if n == 0 {
+		mmClientCredentials.mock.t.Fatalf("Times of OAuthServiceMock.ClientCredentials mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmClientCredentials.expectedInvocations, n)
+	mmClientCredentials.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmClientCredentials
+}
+
+func (mmClientCredentials *mOAuthServiceMockClientCredentials) invocationsDone() bool {
+	if len(mmClientCredentials.expectations) == 0 && mmClientCredentials.defaultExpectation == nil && mmClientCredentials.mock.funcClientCredentials == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmClientCredentials.mock.afterClientCredentialsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmClientCredentials.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ClientCredentials implements OAuthService
+func (mmClientCredentials *OAuthServiceMock) ClientCredentials(ctx context.Context, client *models.Client, scope string) (tp1 *models.Token, err error) {
+	mm_atomic.AddUint64(&mmClientCredentials.beforeClientCredentialsCounter, 1)
+	defer mm_atomic.AddUint64(&mmClientCredentials.afterClientCredentialsCounter, 1)
+
+	mmClientCredentials.t.Helper()
+
+	if mmClientCredentials.inspectFuncClientCredentials != nil {
+		mmClientCredentials.inspectFuncClientCredentials(ctx, client, scope)
+	}
+
+	mm_params := OAuthServiceMockClientCredentialsParams{ctx, client, scope}
+
+	// Record call args
+	mmClientCredentials.ClientCredentialsMock.mutex.Lock()
+	mmClientCredentials.ClientCredentialsMock.callArgs = append(mmClientCredentials.ClientCredentialsMock.callArgs, &mm_params)
+	mmClientCredentials.ClientCredentialsMock.mutex.Unlock()
+
+	for _, e := range mmClientCredentials.ClientCredentialsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.tp1, e.results.err
+		}
+	}
+
+	if mmClientCredentials.ClientCredentialsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmClientCredentials.ClientCredentialsMock.defaultExpectation.Counter, 1)
+		mm_want := mmClientCredentials.ClientCredentialsMock.defaultExpectation.params
+		mm_want_ptrs := mmClientCredentials.ClientCredentialsMock.defaultExpectation.paramPtrs
+
+		mm_got := OAuthServiceMockClientCredentialsParams{ctx, client, scope}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmClientCredentials.t.Errorf("OAuthServiceMock.ClientCredentials got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmClientCredentials.ClientCredentialsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.client != nil && !minimock.Equal(*mm_want_ptrs.client, mm_got.client) {
+				mmClientCredentials.t.Errorf("OAuthServiceMock.ClientCredentials got unexpected parameter client, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmClientCredentials.ClientCredentialsMock.defaultExpectation.expectationOrigins.originClient, *mm_want_ptrs.client, mm_got.client, minimock.Diff(*mm_want_ptrs.client, mm_got.client))
+			}
+
+			if mm_want_ptrs.scope != nil && !minimock.Equal(*mm_want_ptrs.scope, mm_got.scope) {
+				mmClientCredentials.t.Errorf("OAuthServiceMock.ClientCredentials got unexpected parameter scope, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmClientCredentials.ClientCredentialsMock.defaultExpectation.expectationOrigins.originScope, *mm_want_ptrs.scope, mm_got.scope, minimock.Diff(*mm_want_ptrs.scope, mm_got.scope))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmClientCredentials.t.Errorf("OAuthServiceMock.ClientCredentials got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmClientCredentials.ClientCredentialsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmClientCredentials.ClientCredentialsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmClientCredentials.t.Fatal("No results are set for the OAuthServiceMock.ClientCredentials")
+		}
+		return (*mm_results).tp1, (*mm_results).err
+	}
+	if mmClientCredentials.funcClientCredentials != nil {
+		return mmClientCredentials.funcClientCredentials(ctx, client, scope)
+	}
+	mmClientCredentials.t.Fatalf("Unexpected call to OAuthServiceMock.ClientCredentials. %v %v %v", ctx, client, scope)
+	return
+}
+
+// ClientCredentialsAfterCounter returns a count of finished OAuthServiceMock.ClientCredentials invocations
+func (mmClientCredentials *OAuthServiceMock) ClientCredentialsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmClientCredentials.afterClientCredentialsCounter)
+}
+
+// ClientCredentialsBeforeCounter returns a count of OAuthServiceMock.ClientCredentials invocations
+func (mmClientCredentials *OAuthServiceMock) ClientCredentialsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmClientCredentials.beforeClientCredentialsCounter)
+}
+
+// Calls returns a list of arguments used in each call to OAuthServiceMock.ClientCredentials.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmClientCredentials *mOAuthServiceMockClientCredentials) Calls() []*OAuthServiceMockClientCredentialsParams {
+	mmClientCredentials.mutex.RLock()
+
+	argCopy := make([]*OAuthServiceMockClientCredentialsParams, len(mmClientCredentials.callArgs))
+	copy(argCopy, mmClientCredentials.callArgs)
+
+	mmClientCredentials.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockClientCredentialsDone returns true if the count of the ClientCredentials invocations corresponds
+// the number of defined expectations
+func (m *OAuthServiceMock) MinimockClientCredentialsDone() bool {
+	if m.ClientCredentialsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ClientCredentialsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ClientCredentialsMock.invocationsDone()
+}
+
+// MinimockClientCredentialsInspect logs each unmet expectation
+func (m *OAuthServiceMock) MinimockClientCredentialsInspect() {
+	for _, e := range m.ClientCredentialsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OAuthServiceMock.ClientCredentials at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterClientCredentialsCounter := mm_atomic.LoadUint64(&m.afterClientCredentialsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ClientCredentialsMock.defaultExpectation != nil && afterClientCredentialsCounter < 1 {
+		if m.ClientCredentialsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OAuthServiceMock.ClientCredentials at\n%s", m.ClientCredentialsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OAuthServiceMock.ClientCredentials at\n%s with params: %#v", m.ClientCredentialsMock.defaultExpectation.expectationOrigins.origin, *m.ClientCredentialsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcClientCredentials != nil && afterClientCredentialsCounter < 1 {
+		m.t.Errorf("Expected call to OAuthServiceMock.ClientCredentials at\n%s", m.funcClientCredentialsOrigin)
+	}
+
+	if !m.ClientCredentialsMock.invocationsDone() && afterClientCredentialsCounter > 0 {
+		m.t.Errorf("Expected %d calls to OAuthServiceMock.ClientCredentials at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ClientCredentialsMock.expectedInvocations), m.ClientCredentialsMock.expectedInvocationsOrigin, afterClientCredentialsCounter)
+	}
+}
+
+type mOAuthServiceMockEndSession struct {
+	optional           bool
+	mock               *OAuthServiceMock
+	defaultExpectation *OAuthServiceMockEndSessionExpectation
+	expectations       []*OAuthServiceMockEndSessionExpectation
+
+	callArgs []*OAuthServiceMockEndSessionParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OAuthServiceMockEndSessionExpectation specifies expectation struct of the OAuthService.EndSession
+type OAuthServiceMockEndSessionExpectation struct {
+	mock               *OAuthServiceMock
+	params             *OAuthServiceMockEndSessionParams
+	paramPtrs          *OAuthServiceMockEndSessionParamPtrs
+	expectationOrigins OAuthServiceMockEndSessionExpectationOrigins
+	results            *OAuthServiceMockEndSessionResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// OAuthServiceMockEndSessionParams contains parameters of the OAuthService.EndSession
+type OAuthServiceMockEndSessionParams struct {
+	ctx          context.Context
+	sessionToken string
+}
+
+// OAuthServiceMockEndSessionParamPtrs contains pointers to parameters of the OAuthService.EndSession
+type OAuthServiceMockEndSessionParamPtrs struct {
+	ctx          *context.Context
+	sessionToken *string
+}
+
+// OAuthServiceMockEndSessionResults contains results of the OAuthService.EndSession
+type OAuthServiceMockEndSessionResults struct {
+	err error
+}
+
+// OAuthServiceMockEndSessionOrigins contains origins of expectations of the OAuthService.EndSession
+type OAuthServiceMockEndSessionExpectationOrigins struct {
+	origin             string
+	originCtx          string
+	originSessionToken string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmEndSession *mOAuthServiceMockEndSession) Optional() *mOAuthServiceMockEndSession {
+	mmEndSession.optional = true
+	return mmEndSession
+}
+
+// Expect sets up expected params for OAuthService.EndSession
+func (mmEndSession *mOAuthServiceMockEndSession) Expect(ctx context.Context, sessionToken string) *mOAuthServiceMockEndSession {
+	if mmEndSession.mock.funcEndSession != nil {
+		mmEndSession.mock.t.Fatalf("OAuthServiceMock.EndSession mock is already set by Set")
+	}
+
+	if mmEndSession.defaultExpectation == nil {
+		mmEndSession.defaultExpectation = &OAuthServiceMockEndSessionExpectation{}
+	}
+
+	if mmEndSession.defaultExpectation.paramPtrs != nil {
+		mmEndSession.mock.t.Fatalf("OAuthServiceMock.EndSession mock is already set by ExpectParams functions")
+	}
+
+	mmEndSession.defaultExpectation.params = &OAuthServiceMockEndSessionParams{ctx, sessionToken}
+	mmEndSession.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmEndSession.expectations {
+		if minimock.Equal(e.params, mmEndSession.defaultExpectation.params) {
+			mmEndSession.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmEndSession.defaultExpectation.params)
+		}
+	}
+
+	return mmEndSession
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OAuthService.EndSession
+func (mmEndSession *mOAuthServiceMockEndSession) ExpectCtxParam1(ctx context.Context) *mOAuthServiceMockEndSession {
+	if mmEndSession.mock.funcEndSession != nil {
+		mmEndSession.mock.t.Fatalf("OAuthServiceMock.EndSession mock is already set by Set")
+	}
+
+	if mmEndSession.defaultExpectation == nil {
+		mmEndSession.defaultExpectation = &OAuthServiceMockEndSessionExpectation{}
+	}
+
+	if mmEndSession.defaultExpectation.params != nil {
+		mmEndSession.mock.t.Fatalf("OAuthServiceMock.EndSession mock is already set by Expect")
+	}
+
+	if mmEndSession.defaultExpectation.paramPtrs == nil {
+		mmEndSession.defaultExpectation.paramPtrs = &OAuthServiceMockEndSessionParamPtrs{}
+	}
+	mmEndSession.defaultExpectation.paramPtrs.ctx = &ctx
+	mmEndSession.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmEndSession
+}
+
+// ExpectSessionTokenParam2 sets up expected param sessionToken for OAuthService.EndSession
+func (mmEndSession *mOAuthServiceMockEndSession) ExpectSessionTokenParam2(sessionToken string) *mOAuthServiceMockEndSession {
+	if mmEndSession.mock.funcEndSession != nil {
+		mmEndSession.mock.t.Fatalf("OAuthServiceMock.EndSession mock is already set by Set")
+	}
+
+	if mmEndSession.defaultExpectation == nil {
+		mmEndSession.defaultExpectation = &OAuthServiceMockEndSessionExpectation{}
+	}
+
+	if mmEndSession.defaultExpectation.params != nil {
+		mmEndSession.mock.t.Fatalf("OAuthServiceMock.EndSession mock is already set by Expect")
+	}
+
+	if mmEndSession.defaultExpectation.paramPtrs == nil {
+		mmEndSession.defaultExpectation.paramPtrs = &OAuthServiceMockEndSessionParamPtrs{}
+	}
+	mmEndSession.defaultExpectation.paramPtrs.sessionToken = &sessionToken
+	mmEndSession.defaultExpectation.expectationOrigins.originSessionToken = minimock.CallerInfo(1)
+
+	return mmEndSession
+}
+
+// Inspect accepts an inspector function that has same arguments as the OAuthService.EndSession
+func (mmEndSession *mOAuthServiceMockEndSession) Inspect(f func(ctx context.Context, sessionToken string)) *mOAuthServiceMockEndSession {
+	if mmEndSession.mock.inspectFuncEndSession != nil {
+		mmEndSession.mock.t.Fatalf("Inspect function is already set for OAuthServiceMock.EndSession")
+	}
+
+	mmEndSession.mock.inspectFuncEndSession = f
+
+	return mmEndSession
+}
+
+// Return sets up results that will be returned by OAuthService.EndSession
+func (mmEndSession *mOAuthServiceMockEndSession) Return(err error) *OAuthServiceMock {
+	if mmEndSession.mock.funcEndSession != nil {
+		mmEndSession.mock.t.Fatalf("OAuthServiceMock.EndSession mock is already set by Set")
+	}
+
+	if mmEndSession.defaultExpectation == nil {
+		mmEndSession.defaultExpectation = &OAuthServiceMockEndSessionExpectation{mock: mmEndSession.mock}
+	}
+	mmEndSession.defaultExpectation.results = &OAuthServiceMockEndSessionResults{err}
+	mmEndSession.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmEndSession.mock
+}
+
+// Set uses given function f to mock the OAuthService.EndSession method
+func (mmEndSession *mOAuthServiceMockEndSession) Set(f func(ctx context.Context, sessionToken string) (err error)) *OAuthServiceMock {
+	if mmEndSession.defaultExpectation != nil {
+		mmEndSession.mock.t.Fatalf("Default expectation is already set for the OAuthService.EndSession method")
+	}
+
+	if len(mmEndSession.expectations) > 0 {
+		mmEndSession.mock.t.Fatalf("Some expectations are already set for the OAuthService.EndSession method")
+	}
+
+	mmEndSession.mock.funcEndSession = f
+	mmEndSession.mock.funcEndSessionOrigin = minimock.CallerInfo(1)
+	return mmEndSession.mock
+}
+
+// When sets expectation for the OAuthService.EndSession which will trigger the result defined by the following
+// Then helper
+func (mmEndSession *mOAuthServiceMockEndSession) When(ctx context.Context, sessionToken string) *OAuthServiceMockEndSessionExpectation {
+	if mmEndSession.mock.funcEndSession != nil {
+		mmEndSession.mock.t.Fatalf("OAuthServiceMock.EndSession mock is already set by Set")
+	}
+
+	expectation := &OAuthServiceMockEndSessionExpectation{
+		mock:               mmEndSession.mock,
+		params:             &OAuthServiceMockEndSessionParams{ctx, sessionToken},
+		expectationOrigins: OAuthServiceMockEndSessionExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmEndSession.expectations = append(mmEndSession.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OAuthService.EndSession return parameters for the expectation previously defined by the When method
+func (e *OAuthServiceMockEndSessionExpectation) Then(err error) *OAuthServiceMock {
+	e.results = &OAuthServiceMockEndSessionResults{err}
+	return e.mock
+}
+
+// Times sets number of times OAuthService.EndSession should be invoked
+func (mmEndSession *mOAuthServiceMockEndSession) Times(n uint64) *mOAuthServiceMockEndSession {
+	if n == 0 {
+		mmEndSession.mock.t.Fatalf("Times of OAuthServiceMock.EndSession mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmEndSession.expectedInvocations, n)
+	mmEndSession.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmEndSession
+}
+
+func (mmEndSession *mOAuthServiceMockEndSession) invocationsDone() bool {
+	if len(mmEndSession.expectations) == 0 && mmEndSession.defaultExpectation == nil && mmEndSession.mock.funcEndSession == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmEndSession.mock.afterEndSessionCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmEndSession.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// EndSession implements OAuthService
+func (mmEndSession *OAuthServiceMock) EndSession(ctx context.Context, sessionToken string) (err error) {
+	mm_atomic.AddUint64(&mmEndSession.beforeEndSessionCounter, 1)
+	defer mm_atomic.AddUint64(&mmEndSession.afterEndSessionCounter, 1)
+
+	mmEndSession.t.Helper()
+
+	if mmEndSession.inspectFuncEndSession != nil {
+		mmEndSession.inspectFuncEndSession(ctx, sessionToken)
+	}
+
+	mm_params := OAuthServiceMockEndSessionParams{ctx, sessionToken}
+
+	// Record call args
+	mmEndSession.EndSessionMock.mutex.Lock()
+	mmEndSession.EndSessionMock.callArgs = append(mmEndSession.EndSessionMock.callArgs, &mm_params)
+	mmEndSession.EndSessionMock.mutex.Unlock()
+
+	for _, e := range mmEndSession.EndSessionMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmEndSession.EndSessionMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmEndSession.EndSessionMock.defaultExpectation.Counter, 1)
+		mm_want := mmEndSession.EndSessionMock.defaultExpectation.params
+		mm_want_ptrs := mmEndSession.EndSessionMock.defaultExpectation.paramPtrs
+
+		mm_got := OAuthServiceMockEndSessionParams{ctx, sessionToken}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmEndSession.t.Errorf("OAuthServiceMock.EndSession got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmEndSession.EndSessionMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.sessionToken != nil && !minimock.Equal(*mm_want_ptrs.sessionToken, mm_got.sessionToken) {
+				mmEndSession.t.Errorf("OAuthServiceMock.EndSession got unexpected parameter sessionToken, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmEndSession.EndSessionMock.defaultExpectation.expectationOrigins.originSessionToken, *mm_want_ptrs.sessionToken, mm_got.sessionToken, minimock.Diff(*mm_want_ptrs.sessionToken, mm_got.sessionToken))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmEndSession.t.Errorf("OAuthServiceMock.EndSession got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmEndSession.EndSessionMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmEndSession.EndSessionMock.defaultExpectation.results
+		if mm_results == nil {
+			mmEndSession.t.Fatal("No results are set for the OAuthServiceMock.EndSession")
+		}
+		return (*mm_results).err
+	}
+	if mmEndSession.funcEndSession != nil {
+		return mmEndSession.funcEndSession(ctx, sessionToken)
+	}
+	mmEndSession.t.Fatalf("Unexpected call to OAuthServiceMock.EndSession. %v %v", ctx, sessionToken)
+	return
+}
+
+// EndSessionAfterCounter returns a count of finished OAuthServiceMock.EndSession invocations
+func (mmEndSession *OAuthServiceMock) EndSessionAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmEndSession.afterEndSessionCounter)
+}
+
+// EndSessionBeforeCounter returns a count of OAuthServiceMock.EndSession invocations
+func (mmEndSession *OAuthServiceMock) EndSessionBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmEndSession.beforeEndSessionCounter)
+}
+
+// Calls returns a list of arguments used in each call to OAuthServiceMock.EndSession.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmEndSession *mOAuthServiceMockEndSession) Calls() []*OAuthServiceMockEndSessionParams {
+	mmEndSession.mutex.RLock()
+
+	argCopy := make([]*OAuthServiceMockEndSessionParams, len(mmEndSession.callArgs))
+	copy(argCopy, mmEndSession.callArgs)
+
+	mmEndSession.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockEndSessionDone returns true if the count of the EndSession invocations corresponds
+// the number of defined expectations
+func (m *OAuthServiceMock) MinimockEndSessionDone() bool {
+	if m.EndSessionMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.EndSessionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.EndSessionMock.invocationsDone()
+}
+
+// MinimockEndSessionInspect logs each unmet expectation
+func (m *OAuthServiceMock) MinimockEndSessionInspect() {
+	for _, e := range m.EndSessionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OAuthServiceMock.EndSession at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterEndSessionCounter := mm_atomic.LoadUint64(&m.afterEndSessionCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.EndSessionMock.defaultExpectation != nil && afterEndSessionCounter < 1 {
+		if m.EndSessionMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OAuthServiceMock.EndSession at\n%s", m.EndSessionMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OAuthServiceMock.EndSession at\n%s with params: %#v", m.EndSessionMock.defaultExpectation.expectationOrigins.origin, *m.EndSessionMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcEndSession != nil && afterEndSessionCounter < 1 {
+		m.t.Errorf("Expected call to OAuthServiceMock.EndSession at\n%s", m.funcEndSessionOrigin)
+	}
+
+	if !m.EndSessionMock.invocationsDone() && afterEndSessionCounter > 0 {
+		m.t.Errorf("Expected %d calls to OAuthServiceMock.EndSession at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.EndSessionMock.expectedInvocations), m.EndSessionMock.expectedInvocationsOrigin, afterEndSessionCounter)
+	}
+}
+
+type mOAuthServiceMockExchangeAuthorizationCode struct {
+	optional           bool
+	mock               *OAuthServiceMock
+	defaultExpectation *OAuthServiceMockExchangeAuthorizationCodeExpectation
+	expectations       []*OAuthServiceMockExchangeAuthorizationCodeExpectation
+
+	callArgs []*OAuthServiceMockExchangeAuthorizationCodeParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OAuthServiceMockExchangeAuthorizationCodeExpectation specifies expectation struct of the OAuthService.ExchangeAuthorizationCode
+type OAuthServiceMockExchangeAuthorizationCodeExpectation struct {
+	mock               *OAuthServiceMock
+	params             *OAuthServiceMockExchangeAuthorizationCodeParams
+	paramPtrs          *OAuthServiceMockExchangeAuthorizationCodeParamPtrs
+	expectationOrigins OAuthServiceMockExchangeAuthorizationCodeExpectationOrigins
+	results            *OAuthServiceMockExchangeAuthorizationCodeResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// OAuthServiceMockExchangeAuthorizationCodeParams contains parameters of the OAuthService.ExchangeAuthorizationCode
+type OAuthServiceMockExchangeAuthorizationCodeParams struct {
+	ctx          context.Context
+	client       *models.Client
+	code         string
+	redirectURI  string
+	codeVerifier string
+}
+
+// OAuthServiceMockExchangeAuthorizationCodeParamPtrs contains pointers to parameters of the OAuthService.ExchangeAuthorizationCode
+type OAuthServiceMockExchangeAuthorizationCodeParamPtrs struct {
+	ctx          *context.Context
+	client       **models.Client
+	code         *string
+	redirectURI  *string
+	codeVerifier *string
+}
+
+// OAuthServiceMockExchangeAuthorizationCodeResults contains results of the OAuthService.ExchangeAuthorizationCode
+type OAuthServiceMockExchangeAuthorizationCodeResults struct {
+	tp1 *models.Token
+	err error
+}
+
+// OAuthServiceMockExchangeAuthorizationCodeOrigins contains origins of expectations of the OAuthService.ExchangeAuthorizationCode
+type OAuthServiceMockExchangeAuthorizationCodeExpectationOrigins struct {
+	origin             string
+	originCtx          string
+	originClient       string
+	originCode         string
+	originRedirectURI  string
+	originCodeVerifier string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmExchangeAuthorizationCode *mOAuthServiceMockExchangeAuthorizationCode) Optional() *mOAuthServiceMockExchangeAuthorizationCode {
+	mmExchangeAuthorizationCode.optional = true
+	return mmExchangeAuthorizationCode
+}
+
+// Expect sets up expected params for OAuthService.ExchangeAuthorizationCode
+func (mmExchangeAuthorizationCode *mOAuthServiceMockExchangeAuthorizationCode) Expect(ctx context.Context, client *models.Client, code string, redirectURI string, codeVerifier string) *mOAuthServiceMockExchangeAuthorizationCode {
+	if mmExchangeAuthorizationCode.mock.funcExchangeAuthorizationCode != nil {
+		mmExchangeAuthorizationCode.mock.t.Fatalf("OAuthServiceMock.ExchangeAuthorizationCode mock is already set by Set")
+	}
+
+	if mmExchangeAuthorizationCode.defaultExpectation == nil {
+		mmExchangeAuthorizationCode.defaultExpectation = &OAuthServiceMockExchangeAuthorizationCodeExpectation{}
+	}
+
+	if mmExchangeAuthorizationCode.defaultExpectation.paramPtrs != nil {
+		mmExchangeAuthorizationCode.mock.t.Fatalf("OAuthServiceMock.ExchangeAuthorizationCode mock is already set by ExpectParams functions")
+	}
+
+	mmExchangeAuthorizationCode.defaultExpectation.params = &OAuthServiceMockExchangeAuthorizationCodeParams{ctx, client, code, redirectURI, codeVerifier}
+	mmExchangeAuthorizationCode.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmExchangeAuthorizationCode.expectations {
+		if minimock.Equal(e.params, mmExchangeAuthorizationCode.defaultExpectation.params) {
+			mmExchangeAuthorizationCode.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmExchangeAuthorizationCode.defaultExpectation.params)
+		}
+	}
+
+	return mmExchangeAuthorizationCode
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OAuthService.ExchangeAuthorizationCode
+func (mmExchangeAuthorizationCode *mOAuthServiceMockExchangeAuthorizationCode) ExpectCtxParam1(ctx context.Context) *mOAuthServiceMockExchangeAuthorizationCode {
+	if mmExchangeAuthorizationCode.mock.funcExchangeAuthorizationCode != nil {
+		mmExchangeAuthorizationCode.mock.t.Fatalf("OAuthServiceMock.ExchangeAuthorizationCode mock is already set by Set")
+	}
+
+	if mmExchangeAuthorizationCode.defaultExpectation == nil {
+		mmExchangeAuthorizationCode.defaultExpectation = &OAuthServiceMockExchangeAuthorizationCodeExpectation{}
+	}
+
+	if mmExchangeAuthorizationCode.defaultExpectation.params != nil {
+		mmExchangeAuthorizationCode.mock.t.Fatalf("OAuthServiceMock.ExchangeAuthorizationCode mock is already set by Expect")
+	}
+
+	if mmExchangeAuthorizationCode.defaultExpectation.paramPtrs == nil {
+		mmExchangeAuthorizationCode.defaultExpectation.paramPtrs = &OAuthServiceMockExchangeAuthorizationCodeParamPtrs{}
+	}
+	mmExchangeAuthorizationCode.defaultExpectation.paramPtrs.ctx = &ctx
+	mmExchangeAuthorizationCode.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmExchangeAuthorizationCode
+}
+
+// ExpectClientParam2 sets up expected param client for OAuthService.ExchangeAuthorizationCode
+func (mmExchangeAuthorizationCode *mOAuthServiceMockExchangeAuthorizationCode) ExpectClientParam2(client *models.Client) *mOAuthServiceMockExchangeAuthorizationCode {
+	if mmExchangeAuthorizationCode.mock.funcExchangeAuthorizationCode != nil {
+		mmExchangeAuthorizationCode.mock.t.Fatalf("OAuthServiceMock.ExchangeAuthorizationCode mock is already set by Set")
+	}
+
+	if mmExchangeAuthorizationCode.defaultExpectation == nil {
+		mmExchangeAuthorizationCode.defaultExpectation = &OAuthServiceMockExchangeAuthorizationCodeExpectation{}
+	}
+
+	if mmExchangeAuthorizationCode.defaultExpectation.params != nil {
+		mmExchangeAuthorizationCode.mock.t.Fatalf("OAuthServiceMock.ExchangeAuthorizationCode mock is already set by Expect")
+	}
+
+	if mmExchangeAuthorizationCode.defaultExpectation.paramPtrs == nil {
+		mmExchangeAuthorizationCode.defaultExpectation.paramPtrs = &OAuthServiceMockExchangeAuthorizationCodeParamPtrs{}
+	}
+	mmExchangeAuthorizationCode.defaultExpectation.paramPtrs.client = &client
+	mmExchangeAuthorizationCode.defaultExpectation.expectationOrigins.originClient = minimock.CallerInfo(1)
+
+	return mmExchangeAuthorizationCode
+}
+
+// ExpectCodeParam3 sets up expected param code for OAuthService.ExchangeAuthorizationCode
+func (mmExchangeAuthorizationCode *mOAuthServiceMockExchangeAuthorizationCode) ExpectCodeParam3(code string) *mOAuthServiceMockExchangeAuthorizationCode {
+	if mmExchangeAuthorizationCode.mock.funcExchangeAuthorizationCode != nil {
+		mmExchangeAuthorizationCode.mock.t.Fatalf("OAuthServiceMock.ExchangeAuthorizationCode mock is already set by Set")
+	}
+
+	if mmExchangeAuthorizationCode.defaultExpectation == nil {
+		mmExchangeAuthorizationCode.defaultExpectation = &OAuthServiceMockExchangeAuthorizationCodeExpectation{}
+	}
+
+	if mmExchangeAuthorizationCode.defaultExpectation.params != nil {
+		mmExchangeAuthorizationCode.mock.t.Fatalf("OAuthServiceMock.ExchangeAuthorizationCode mock is already set by Expect")
+	}
+
+	if mmExchangeAuthorizationCode.defaultExpectation.paramPtrs == nil {
+		mmExchangeAuthorizationCode.defaultExpectation.paramPtrs = &OAuthServiceMockExchangeAuthorizationCodeParamPtrs{}
+	}
+	mmExchangeAuthorizationCode.defaultExpectation.paramPtrs.code = &code
+	mmExchangeAuthorizationCode.defaultExpectation.expectationOrigins.originCode = minimock.CallerInfo(1)
+
+	return mmExchangeAuthorizationCode
+}
+
+// ExpectRedirectURIParam4 sets up expected param redirectURI for OAuthService.ExchangeAuthorizationCode
+func (mmExchangeAuthorizationCode *mOAuthServiceMockExchangeAuthorizationCode) ExpectRedirectURIParam4(redirectURI string) *mOAuthServiceMockExchangeAuthorizationCode {
+	if mmExchangeAuthorizationCode.mock.funcExchangeAuthorizationCode != nil {
+		mmExchangeAuthorizationCode.mock.t.Fatalf("OAuthServiceMock.ExchangeAuthorizationCode mock is already set by Set")
+	}
+
+	if mmExchangeAuthorizationCode.defaultExpectation == nil {
+		mmExchangeAuthorizationCode.defaultExpectation = &OAuthServiceMockExchangeAuthorizationCodeExpectation{}
+	}
+
+	if mmExchangeAuthorizationCode.defaultExpectation.params != nil {
+		mmExchangeAuthorizationCode.mock.t.Fatalf("OAuthServiceMock.ExchangeAuthorizationCode mock is already set by Expect")
+	}
+
+	if mmExchangeAuthorizationCode.defaultExpectation.paramPtrs == nil {
+		mmExchangeAuthorizationCode.defaultExpectation.paramPtrs = &OAuthServiceMockExchangeAuthorizationCodeParamPtrs{}
+	}
+	mmExchangeAuthorizationCode.defaultExpectation.paramPtrs.redirectURI = &redirectURI
+	mmExchangeAuthorizationCode.defaultExpectation.expectationOrigins.originRedirectURI = minimock.CallerInfo(1)
+
+	return mmExchangeAuthorizationCode
+}
+
+// ExpectCodeVerifierParam5 sets up expected param codeVerifier for OAuthService.ExchangeAuthorizationCode
+func (mmExchangeAuthorizationCode *mOAuthServiceMockExchangeAuthorizationCode) ExpectCodeVerifierParam5(codeVerifier string) *mOAuthServiceMockExchangeAuthorizationCode {
+	if mmExchangeAuthorizationCode.mock.funcExchangeAuthorizationCode != nil {
+		mmExchangeAuthorizationCode.mock.t.Fatalf("OAuthServiceMock.ExchangeAuthorizationCode mock is already set by Set")
+	}
+
+	if mmExchangeAuthorizationCode.defaultExpectation == nil {
+		mmExchangeAuthorizationCode.defaultExpectation = &OAuthServiceMockExchangeAuthorizationCodeExpectation{}
+	}
+
+	if mmExchangeAuthorizationCode.defaultExpectation.params != nil {
+		mmExchangeAuthorizationCode.mock.t.Fatalf("OAuthServiceMock.ExchangeAuthorizationCode mock is already set by Expect")
+	}
+
+	if mmExchangeAuthorizationCode.defaultExpectation.paramPtrs == nil {
+		mmExchangeAuthorizationCode.defaultExpectation.paramPtrs = &OAuthServiceMockExchangeAuthorizationCodeParamPtrs{}
+	}
+	mmExchangeAuthorizationCode.defaultExpectation.paramPtrs.codeVerifier = &codeVerifier
+	mmExchangeAuthorizationCode.defaultExpectation.expectationOrigins.originCodeVerifier = minimock.CallerInfo(1)
+
+	return mmExchangeAuthorizationCode
+}
+
+// Inspect accepts an inspector function that has same arguments as the OAuthService.ExchangeAuthorizationCode
+func (mmExchangeAuthorizationCode *mOAuthServiceMockExchangeAuthorizationCode) Inspect(f func(ctx context.Context, client *models.Client, code string, redirectURI string, codeVerifier string)) *mOAuthServiceMockExchangeAuthorizationCode {
+	if mmExchangeAuthorizationCode.mock.inspectFuncExchangeAuthorizationCode != nil {
+		mmExchangeAuthorizationCode.mock.t.Fatalf("Inspect function is already set for OAuthServiceMock.ExchangeAuthorizationCode")
+	}
+
+	mmExchangeAuthorizationCode.mock.inspectFuncExchangeAuthorizationCode = f
+
+	return mmExchangeAuthorizationCode
+}
+
+// Return sets up results that will be returned by OAuthService.ExchangeAuthorizationCode
+func (mmExchangeAuthorizationCode *mOAuthServiceMockExchangeAuthorizationCode) Return(tp1 *models.Token, err error) *OAuthServiceMock {
+	if mmExchangeAuthorizationCode.mock.funcExchangeAuthorizationCode != nil {
+		mmExchangeAuthorizationCode.mock.t.Fatalf("OAuthServiceMock.ExchangeAuthorizationCode mock is already set by Set")
+	}
+
+	if mmExchangeAuthorizationCode.defaultExpectation == nil {
+		mmExchangeAuthorizationCode.defaultExpectation = &OAuthServiceMockExchangeAuthorizationCodeExpectation{mock: mmExchangeAuthorizationCode.mock}
+	}
+	mmExchangeAuthorizationCode.defaultExpectation.results = &OAuthServiceMockExchangeAuthorizationCodeResults{tp1, err}
+	mmExchangeAuthorizationCode.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmExchangeAuthorizationCode.mock
+}
+
+// Set uses given function f to mock the OAuthService.ExchangeAuthorizationCode method
+func (mmExchangeAuthorizationCode *mOAuthServiceMockExchangeAuthorizationCode) Set(f func(ctx context.Context, client *models.Client, code string, redirectURI string, codeVerifier string) (tp1 *models.Token, err error)) *OAuthServiceMock {
+	if mmExchangeAuthorizationCode.defaultExpectation != nil {
+		mmExchangeAuthorizationCode.mock.t.Fatalf("Default expectation is already set for the OAuthService.ExchangeAuthorizationCode method")
+	}
+
+	if len(mmExchangeAuthorizationCode.expectations) > 0 {
+		mmExchangeAuthorizationCode.mock.t.Fatalf("Some expectations are already set for the OAuthService.ExchangeAuthorizationCode method")
+	}
+
+	mmExchangeAuthorizationCode.mock.funcExchangeAuthorizationCode = f
+	mmExchangeAuthorizationCode.mock.funcExchangeAuthorizationCodeOrigin = minimock.CallerInfo(1)
+	return mmExchangeAuthorizationCode.mock
+}
+
+// When sets expectation for the OAuthService.ExchangeAuthorizationCode which will trigger the result defined by the following
+// Then helper
+func (mmExchangeAuthorizationCode *mOAuthServiceMockExchangeAuthorizationCode) When(ctx context.Context, client *models.Client, code string, redirectURI string, codeVerifier string) *OAuthServiceMockExchangeAuthorizationCodeExpectation {
+	if mmExchangeAuthorizationCode.mock.funcExchangeAuthorizationCode != nil {
+		mmExchangeAuthorizationCode.mock.t.Fatalf("OAuthServiceMock.ExchangeAuthorizationCode mock is already set by Set")
+	}
+
+	expectation := &OAuthServiceMockExchangeAuthorizationCodeExpectation{
+		mock:               mmExchangeAuthorizationCode.mock,
+		params:             &OAuthServiceMockExchangeAuthorizationCodeParams{ctx, client, code, redirectURI, codeVerifier},
+		expectationOrigins: OAuthServiceMockExchangeAuthorizationCodeExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmExchangeAuthorizationCode.expectations = append(mmExchangeAuthorizationCode.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OAuthService.ExchangeAuthorizationCode return parameters for the expectation previously defined by the When method
+func (e *OAuthServiceMockExchangeAuthorizationCodeExpectation) Then(tp1 *models.Token, err error) *OAuthServiceMock {
+	e.results = &OAuthServiceMockExchangeAuthorizationCodeResults{tp1, err}
+	return e.mock
+}
+
+// Times sets number of times OAuthService.ExchangeAuthorizationCode should be invoked
+func (mmExchangeAuthorizationCode *mOAuthServiceMockExchangeAuthorizationCode) Times(n uint64) *mOAuthServiceMockExchangeAuthorizationCode {
+	if n == 0 {
+		mmExchangeAuthorizationCode.mock.t.Fatalf("Times of OAuthServiceMock.ExchangeAuthorizationCode mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmExchangeAuthorizationCode.expectedInvocations, n)
+	mmExchangeAuthorizationCode.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmExchangeAuthorizationCode
+}
+
+func (mmExchangeAuthorizationCode *mOAuthServiceMockExchangeAuthorizationCode) invocationsDone() bool {
+	if len(mmExchangeAuthorizationCode.expectations) == 0 && mmExchangeAuthorizationCode.defaultExpectation == nil && mmExchangeAuthorizationCode.mock.funcExchangeAuthorizationCode == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmExchangeAuthorizationCode.mock.afterExchangeAuthorizationCodeCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmExchangeAuthorizationCode.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ExchangeAuthorizationCode implements OAuthService
+func (mmExchangeAuthorizationCode *OAuthServiceMock) ExchangeAuthorizationCode(ctx context.Context, client *models.Client, code string, redirectURI string, codeVerifier string) (tp1 *models.Token, err error) {
+	mm_atomic.AddUint64(&mmExchangeAuthorizationCode.beforeExchangeAuthorizationCodeCounter, 1)
+	defer mm_atomic.AddUint64(&mmExchangeAuthorizationCode.afterExchangeAuthorizationCodeCounter, 1)
+
+	mmExchangeAuthorizationCode.t.Helper()
+
+	if mmExchangeAuthorizationCode.inspectFuncExchangeAuthorizationCode != nil {
+		mmExchangeAuthorizationCode.inspectFuncExchangeAuthorizationCode(ctx, client, code, redirectURI, codeVerifier)
+	}
+
+	mm_params := OAuthServiceMockExchangeAuthorizationCodeParams{ctx, client, code, redirectURI, codeVerifier}
+
+	// Record call args
+	mmExchangeAuthorizationCode.ExchangeAuthorizationCodeMock.mutex.Lock()
+	mmExchangeAuthorizationCode.ExchangeAuthorizationCodeMock.callArgs = append(mmExchangeAuthorizationCode.ExchangeAuthorizationCodeMock.callArgs, &mm_params)
+	mmExchangeAuthorizationCode.ExchangeAuthorizationCodeMock.mutex.Unlock()
+
+	for _, e := range mmExchangeAuthorizationCode.ExchangeAuthorizationCodeMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.tp1, e.results.err
+		}
+	}
+
+	if mmExchangeAuthorizationCode.ExchangeAuthorizationCodeMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmExchangeAuthorizationCode.ExchangeAuthorizationCodeMock.defaultExpectation.Counter, 1)
+		mm_want := mmExchangeAuthorizationCode.ExchangeAuthorizationCodeMock.defaultExpectation.params
+		mm_want_ptrs := mmExchangeAuthorizationCode.ExchangeAuthorizationCodeMock.defaultExpectation.paramPtrs
+
+		mm_got := OAuthServiceMockExchangeAuthorizationCodeParams{ctx, client, code, redirectURI, codeVerifier}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmExchangeAuthorizationCode.t.Errorf("OAuthServiceMock.ExchangeAuthorizationCode got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmExchangeAuthorizationCode.ExchangeAuthorizationCodeMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.client != nil && !minimock.Equal(*mm_want_ptrs.client, mm_got.client) {
+				mmExchangeAuthorizationCode.t.Errorf("OAuthServiceMock.ExchangeAuthorizationCode got unexpected parameter client, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmExchangeAuthorizationCode.ExchangeAuthorizationCodeMock.defaultExpectation.expectationOrigins.originClient, *mm_want_ptrs.client, mm_got.client, minimock.Diff(*mm_want_ptrs.client, mm_got.client))
+			}
+
+			if mm_want_ptrs.code != nil && !minimock.Equal(*mm_want_ptrs.code, mm_got.code) {
+				mmExchangeAuthorizationCode.t.Errorf("OAuthServiceMock.ExchangeAuthorizationCode got unexpected parameter code, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmExchangeAuthorizationCode.ExchangeAuthorizationCodeMock.defaultExpectation.expectationOrigins.originCode, *mm_want_ptrs.code, mm_got.code, minimock.Diff(*mm_want_ptrs.code, mm_got.code))
+			}
+
+			if mm_want_ptrs.redirectURI != nil && !minimock.Equal(*mm_want_ptrs.redirectURI, mm_got.redirectURI) {
+				mmExchangeAuthorizationCode.t.Errorf("OAuthServiceMock.ExchangeAuthorizationCode got unexpected parameter redirectURI, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmExchangeAuthorizationCode.ExchangeAuthorizationCodeMock.defaultExpectation.expectationOrigins.originRedirectURI, *mm_want_ptrs.redirectURI, mm_got.redirectURI, minimock.Diff(*mm_want_ptrs.redirectURI, mm_got.redirectURI))
+			}
+
+			if mm_want_ptrs.codeVerifier != nil && !minimock.Equal(*mm_want_ptrs.codeVerifier, mm_got.codeVerifier) {
+				mmExchangeAuthorizationCode.t.Errorf("OAuthServiceMock.ExchangeAuthorizationCode got unexpected parameter codeVerifier, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmExchangeAuthorizationCode.ExchangeAuthorizationCodeMock.defaultExpectation.expectationOrigins.originCodeVerifier, *mm_want_ptrs.codeVerifier, mm_got.codeVerifier, minimock.Diff(*mm_want_ptrs.codeVerifier, mm_got.codeVerifier))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmExchangeAuthorizationCode.t.Errorf("OAuthServiceMock.ExchangeAuthorizationCode got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmExchangeAuthorizationCode.ExchangeAuthorizationCodeMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmExchangeAuthorizationCode.ExchangeAuthorizationCodeMock.defaultExpectation.results
+		if mm_results == nil {
+			mmExchangeAuthorizationCode.t.Fatal("No results are set for the OAuthServiceMock.ExchangeAuthorizationCode")
+		}
+		return (*mm_results).tp1, (*mm_results).err
+	}
+	if mmExchangeAuthorizationCode.funcExchangeAuthorizationCode != nil {
+		return mmExchangeAuthorizationCode.funcExchangeAuthorizationCode(ctx, client, code, redirectURI, codeVerifier)
+	}
+	mmExchangeAuthorizationCode.t.Fatalf("Unexpected call to OAuthServiceMock.ExchangeAuthorizationCode. %v %v %v %v %v", ctx, client, code, redirectURI, codeVerifier)
+	return
+}
+
+// ExchangeAuthorizationCodeAfterCounter returns a count of finished OAuthServiceMock.ExchangeAuthorizationCode invocations
+func (mmExchangeAuthorizationCode *OAuthServiceMock) ExchangeAuthorizationCodeAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmExchangeAuthorizationCode.afterExchangeAuthorizationCodeCounter)
+}
+
+// ExchangeAuthorizationCodeBeforeCounter returns a count of OAuthServiceMock.ExchangeAuthorizationCode invocations
+func (mmExchangeAuthorizationCode *OAuthServiceMock) ExchangeAuthorizationCodeBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmExchangeAuthorizationCode.beforeExchangeAuthorizationCodeCounter)
+}
+
+// Calls returns a list of arguments used in each call to OAuthServiceMock.ExchangeAuthorizationCode.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmExchangeAuthorizationCode *mOAuthServiceMockExchangeAuthorizationCode) Calls() []*OAuthServiceMockExchangeAuthorizationCodeParams {
+	mmExchangeAuthorizationCode.mutex.RLock()
+
+	argCopy := make([]*OAuthServiceMockExchangeAuthorizationCodeParams, len(mmExchangeAuthorizationCode.callArgs))
+	copy(argCopy, mmExchangeAuthorizationCode.callArgs)
+
+	mmExchangeAuthorizationCode.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockExchangeAuthorizationCodeDone returns true if the count of the ExchangeAuthorizationCode invocations corresponds
+// the number of defined expectations
+func (m *OAuthServiceMock) MinimockExchangeAuthorizationCodeDone() bool {
+	if m.ExchangeAuthorizationCodeMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ExchangeAuthorizationCodeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ExchangeAuthorizationCodeMock.invocationsDone()
+}
+
+// MinimockExchangeAuthorizationCodeInspect logs each unmet expectation
+func (m *OAuthServiceMock) MinimockExchangeAuthorizationCodeInspect() {
+	for _, e := range m.ExchangeAuthorizationCodeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OAuthServiceMock.ExchangeAuthorizationCode at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterExchangeAuthorizationCodeCounter := mm_atomic.LoadUint64(&m.afterExchangeAuthorizationCodeCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ExchangeAuthorizationCodeMock.defaultExpectation != nil && afterExchangeAuthorizationCodeCounter < 1 {
+		if m.ExchangeAuthorizationCodeMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OAuthServiceMock.ExchangeAuthorizationCode at\n%s", m.ExchangeAuthorizationCodeMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OAuthServiceMock.ExchangeAuthorizationCode at\n%s with params: %#v", m.ExchangeAuthorizationCodeMock.defaultExpectation.expectationOrigins.origin, *m.ExchangeAuthorizationCodeMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcExchangeAuthorizationCode != nil && afterExchangeAuthorizationCodeCounter < 1 {
+		m.t.Errorf("Expected call to OAuthServiceMock.ExchangeAuthorizationCode at\n%s", m.funcExchangeAuthorizationCodeOrigin)
+	}
+
+	if !m.ExchangeAuthorizationCodeMock.invocationsDone() && afterExchangeAuthorizationCodeCounter > 0 {
+		m.t.Errorf("Expected %d calls to OAuthServiceMock.ExchangeAuthorizationCode at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ExchangeAuthorizationCodeMock.expectedInvocations), m.ExchangeAuthorizationCodeMock.expectedInvocationsOrigin, afterExchangeAuthorizationCodeCounter)
+	}
+}
+
+type mOAuthServiceMockFindClient struct {
+	optional           bool
+	mock               *OAuthServiceMock
+	defaultExpectation *OAuthServiceMockFindClientExpectation
+	expectations       []*OAuthServiceMockFindClientExpectation
+
+	callArgs []*OAuthServiceMockFindClientParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OAuthServiceMockFindClientExpectation specifies expectation struct of the OAuthService.FindClient
+type OAuthServiceMockFindClientExpectation struct {
+	mock               *OAuthServiceMock
+	params             *OAuthServiceMockFindClientParams
+	paramPtrs          *OAuthServiceMockFindClientParamPtrs
+	expectationOrigins OAuthServiceMockFindClientExpectationOrigins
+	results            *OAuthServiceMockFindClientResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// OAuthServiceMockFindClientParams contains parameters of the OAuthService.FindClient
+type OAuthServiceMockFindClientParams struct {
+	ctx      context.Context
+	clientID string
+}
+
+// OAuthServiceMockFindClientParamPtrs contains pointers to parameters of the OAuthService.FindClient
+type OAuthServiceMockFindClientParamPtrs struct {
+	ctx      *context.Context
+	clientID *string
+}
+
+// OAuthServiceMockFindClientResults contains results of the OAuthService.FindClient
+type OAuthServiceMockFindClientResults struct {
+	cp1 *models.Client
+	err error
+}
+
+// OAuthServiceMockFindClientOrigins contains origins of expectations of the OAuthService.FindClient
+type OAuthServiceMockFindClientExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originClientID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmFindClient *mOAuthServiceMockFindClient) Optional() *mOAuthServiceMockFindClient {
+	mmFindClient.optional = true
+	return mmFindClient
+}
+
+// Expect sets up expected params for OAuthService.FindClient
+func (mmFindClient *mOAuthServiceMockFindClient) Expect(ctx context.Context, clientID string) *mOAuthServiceMockFindClient {
+	if mmFindClient.mock.funcFindClient != nil {
+		mmFindClient.mock.t.Fatalf("OAuthServiceMock.FindClient mock is already set by Set")
+	}
+
+	if mmFindClient.defaultExpectation == nil {
+		mmFindClient.defaultExpectation = &OAuthServiceMockFindClientExpectation{}
+	}
+
+	if mmFindClient.defaultExpectation.paramPtrs != nil {
+		mmFindClient.mock.t.Fatalf("OAuthServiceMock.FindClient mock is already set by ExpectParams functions")
+	}
+
+	mmFindClient.defaultExpectation.params = &OAuthServiceMockFindClientParams{ctx, clientID}
+	mmFindClient.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmFindClient.expectations {
+		if minimock.Equal(e.params, mmFindClient.defaultExpectation.params) {
+			mmFindClient.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmFindClient.defaultExpectation.params)
+		}
+	}
+
+	return mmFindClient
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OAuthService.FindClient
+func (mmFindClient *mOAuthServiceMockFindClient) ExpectCtxParam1(ctx context.Context) *mOAuthServiceMockFindClient {
+	if mmFindClient.mock.funcFindClient != nil {
+		mmFindClient.mock.t.Fatalf("OAuthServiceMock.FindClient mock is already set by Set")
+	}
+
+	if mmFindClient.defaultExpectation == nil {
+		mmFindClient.defaultExpectation = &OAuthServiceMockFindClientExpectation{}
+	}
+
+	if mmFindClient.defaultExpectation.params != nil {
+		mmFindClient.mock.t.Fatalf("OAuthServiceMock.FindClient mock is already set by Expect")
+	}
+
+	if mmFindClient.defaultExpectation.paramPtrs == nil {
+		mmFindClient.defaultExpectation.paramPtrs = &OAuthServiceMockFindClientParamPtrs{}
+	}
+	mmFindClient.defaultExpectation.paramPtrs.ctx = &ctx
+	mmFindClient.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmFindClient
+}
+
+// ExpectClientIDParam2 sets up expected param clientID for OAuthService.FindClient
+func (mmFindClient *mOAuthServiceMockFindClient) ExpectClientIDParam2(clientID string) *mOAuthServiceMockFindClient {
+	if mmFindClient.mock.funcFindClient != nil {
+		mmFindClient.mock.t.Fatalf("OAuthServiceMock.FindClient mock is already set by Set")
+	}
+
+	if mmFindClient.defaultExpectation == nil {
+		mmFindClient.defaultExpectation = &OAuthServiceMockFindClientExpectation{}
+	}
+
+	if mmFindClient.defaultExpectation.params != nil {
+		mmFindClient.mock.t.Fatalf("OAuthServiceMock.FindClient mock is already set by Expect")
+	}
+
+	if mmFindClient.defaultExpectation.paramPtrs == nil {
+		mmFindClient.defaultExpectation.paramPtrs = &OAuthServiceMockFindClientParamPtrs{}
+	}
+	mmFindClient.defaultExpectation.paramPtrs.clientID = &clientID
+	mmFindClient.defaultExpectation.expectationOrigins.originClientID = minimock.CallerInfo(1)
+
+	return mmFindClient
+}
+
+// Inspect accepts an inspector function that has same arguments as the OAuthService.FindClient
+func (mmFindClient *mOAuthServiceMockFindClient) Inspect(f func(ctx context.Context, clientID string)) *mOAuthServiceMockFindClient {
+	if mmFindClient.mock.inspectFuncFindClient != nil {
+		mmFindClient.mock.t.Fatalf("Inspect function is already set for OAuthServiceMock.FindClient")
+	}
+
+	mmFindClient.mock.inspectFuncFindClient = f
+
+	return mmFindClient
+}
+
+// Return sets up results that will be returned by OAuthService.FindClient
+func (mmFindClient *mOAuthServiceMockFindClient) Return(cp1 *models.Client, err error) *OAuthServiceMock {
+	if mmFindClient.mock.funcFindClient != nil {
+		mmFindClient.mock.t.Fatalf("OAuthServiceMock.FindClient mock is already set by Set")
+	}
+
+	if mmFindClient.defaultExpectation == nil {
+		mmFindClient.defaultExpectation = &OAuthServiceMockFindClientExpectation{mock: mmFindClient.mock}
+	}
+	mmFindClient.defaultExpectation.results = &OAuthServiceMockFindClientResults{cp1, err}
+	mmFindClient.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmFindClient.mock
+}
+
+// Set uses given function f to mock the OAuthService.FindClient method
+func (mmFindClient *mOAuthServiceMockFindClient) Set(f func(ctx context.Context, clientID string) (cp1 *models.Client, err error)) *OAuthServiceMock {
+	if mmFindClient.defaultExpectation != nil {
+		mmFindClient.mock.t.Fatalf("Default expectation is already set for the OAuthService.FindClient method")
+	}
+
+	if len(mmFindClient.expectations) > 0 {
+		mmFindClient.mock.t.Fatalf("Some expectations are already set for the OAuthService.FindClient method")
+	}
+
+	mmFindClient.mock.funcFindClient = f
+	mmFindClient.mock.funcFindClientOrigin = minimock.CallerInfo(1)
+	return mmFindClient.mock
+}
+
+// When sets expectation for the OAuthService.FindClient which will trigger the result defined by the following
+// Then helper
+func (mmFindClient *mOAuthServiceMockFindClient) When(ctx context.Context, clientID string) *OAuthServiceMockFindClientExpectation {
+	if mmFindClient.mock.funcFindClient != nil {
+		mmFindClient.mock.t.Fatalf("OAuthServiceMock.FindClient mock is already set by Set")
+	}
+
+	expectation := &OAuthServiceMockFindClientExpectation{
+		mock:               mmFindClient.mock,
+		params:             &OAuthServiceMockFindClientParams{ctx, clientID},
+		expectationOrigins: OAuthServiceMockFindClientExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmFindClient.expectations = append(mmFindClient.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OAuthService.FindClient return parameters for the expectation previously defined by the When method
+func (e *OAuthServiceMockFindClientExpectation) Then(cp1 *models.Client, err error) *OAuthServiceMock {
+	e.results = &OAuthServiceMockFindClientResults{cp1, err}
+	return e.mock
+}
+
+// Times sets number of times OAuthService.FindClient should be invoked
+func (mmFindClient *mOAuthServiceMockFindClient) Times(n uint64) *mOAuthServiceMockFindClient {
+	if n == 0 {
+		mmFindClient.mock.t.Fatalf("Times of OAuthServiceMock.FindClient mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmFindClient.expectedInvocations, n)
+	mmFindClient.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmFindClient
+}
+
+func (mmFindClient *mOAuthServiceMockFindClient) invocationsDone() bool {
+	if len(mmFindClient.expectations) == 0 && mmFindClient.defaultExpectation == nil && mmFindClient.mock.funcFindClient == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmFindClient.mock.afterFindClientCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmFindClient.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// FindClient implements OAuthService
+func (mmFindClient *OAuthServiceMock) FindClient(ctx context.Context, clientID string) (cp1 *models.Client, err error) {
+	mm_atomic.AddUint64(&mmFindClient.beforeFindClientCounter, 1)
+	defer mm_atomic.AddUint64(&mmFindClient.afterFindClientCounter, 1)
+
+	mmFindClient.t.Helper()
+
+	if mmFindClient.inspectFuncFindClient != nil {
+		mmFindClient.inspectFuncFindClient(ctx, clientID)
+	}
+
+	mm_params := OAuthServiceMockFindClientParams{ctx, clientID}
+
+	// Record call args
+	mmFindClient.FindClientMock.mutex.Lock()
+	mmFindClient.FindClientMock.callArgs = append(mmFindClient.FindClientMock.callArgs, &mm_params)
+	mmFindClient.FindClientMock.mutex.Unlock()
+
+	for _, e := range mmFindClient.FindClientMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.cp1, e.results.err
+		}
+	}
+
+	if mmFindClient.FindClientMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmFindClient.FindClientMock.defaultExpectation.Counter, 1)
+		mm_want := mmFindClient.FindClientMock.defaultExpectation.params
+		mm_want_ptrs := mmFindClient.FindClientMock.defaultExpectation.paramPtrs
+
+		mm_got := OAuthServiceMockFindClientParams{ctx, clientID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmFindClient.t.Errorf("OAuthServiceMock.FindClient got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindClient.FindClientMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.clientID != nil && !minimock.Equal(*mm_want_ptrs.clientID, mm_got.clientID) {
+				mmFindClient.t.Errorf("OAuthServiceMock.FindClient got unexpected parameter clientID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindClient.FindClientMock.defaultExpectation.expectationOrigins.originClientID, *mm_want_ptrs.clientID, mm_got.clientID, minimock.Diff(*mm_want_ptrs.clientID, mm_got.clientID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmFindClient.t.Errorf("OAuthServiceMock.FindClient got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmFindClient.FindClientMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmFindClient.FindClientMock.defaultExpectation.results
+		if mm_results == nil {
+			mmFindClient.t.Fatal("No results are set for the OAuthServiceMock.FindClient")
+		}
+		return (*mm_results).cp1, (*mm_results).err
+	}
+	if mmFindClient.funcFindClient != nil {
+		return mmFindClient.funcFindClient(ctx, clientID)
+	}
+	mmFindClient.t.Fatalf("Unexpected call to OAuthServiceMock.FindClient. %v %v", ctx, clientID)
+	return
+}
+
+// FindClientAfterCounter returns a count of finished OAuthServiceMock.FindClient invocations
+func (mmFindClient *OAuthServiceMock) FindClientAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFindClient.afterFindClientCounter)
+}
+
+// FindClientBeforeCounter returns a count of OAuthServiceMock.FindClient invocations
+func (mmFindClient *OAuthServiceMock) FindClientBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFindClient.beforeFindClientCounter)
+}
+
+// Calls returns a list of arguments used in each call to OAuthServiceMock.FindClient.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmFindClient *mOAuthServiceMockFindClient) Calls() []*OAuthServiceMockFindClientParams {
+	mmFindClient.mutex.RLock()
+
+	argCopy := make([]*OAuthServiceMockFindClientParams, len(mmFindClient.callArgs))
+	copy(argCopy, mmFindClient.callArgs)
+
+	mmFindClient.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockFindClientDone returns true if the count of the FindClient invocations corresponds
+// the number of defined expectations
+func (m *OAuthServiceMock) MinimockFindClientDone() bool {
+	if m.FindClientMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.FindClientMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.FindClientMock.invocationsDone()
+}
+
+// MinimockFindClientInspect logs each unmet expectation
+func (m *OAuthServiceMock) MinimockFindClientInspect() {
+	for _, e := range m.FindClientMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OAuthServiceMock.FindClient at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterFindClientCounter := mm_atomic.LoadUint64(&m.afterFindClientCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.FindClientMock.defaultExpectation != nil && afterFindClientCounter < 1 {
+		if m.FindClientMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OAuthServiceMock.FindClient at\n%s", m.FindClientMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OAuthServiceMock.FindClient at\n%s with params: %#v", m.FindClientMock.defaultExpectation.expectationOrigins.origin, *m.FindClientMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcFindClient != nil && afterFindClientCounter < 1 {
+		m.t.Errorf("Expected call to OAuthServiceMock.FindClient at\n%s", m.funcFindClientOrigin)
+	}
+
+	if !m.FindClientMock.invocationsDone() && afterFindClientCounter > 0 {
+		m.t.Errorf("Expected %d calls to OAuthServiceMock.FindClient at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.FindClientMock.expectedInvocations), m.FindClientMock.expectedInvocationsOrigin, afterFindClientCounter)
+	}
+}
+
+type mOAuthServiceMockGrantConsent struct {
+	optional           bool
+	mock               *OAuthServiceMock
+	defaultExpectation *OAuthServiceMockGrantConsentExpectation
+	expectations       []*OAuthServiceMockGrantConsentExpectation
+
+	callArgs []*OAuthServiceMockGrantConsentParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OAuthServiceMockGrantConsentExpectation specifies expectation struct of the OAuthService.GrantConsent
+type OAuthServiceMockGrantConsentExpectation struct {
+	mock               *OAuthServiceMock
+	params             *OAuthServiceMockGrantConsentParams
+	paramPtrs          *OAuthServiceMockGrantConsentParamPtrs
+	expectationOrigins OAuthServiceMockGrantConsentExpectationOrigins
+	results            *OAuthServiceMockGrantConsentResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// OAuthServiceMockGrantConsentParams contains parameters of the OAuthService.GrantConsent
+type OAuthServiceMockGrantConsentParams struct {
+	ctx      context.Context
+	userID   string
+	clientID string
+	scopes   []string
+}
+
+// OAuthServiceMockGrantConsentParamPtrs contains pointers to parameters of the OAuthService.GrantConsent
+type OAuthServiceMockGrantConsentParamPtrs struct {
+	ctx      *context.Context
+	userID   *string
+	clientID *string
+	scopes   *[]string
+}
+
+// OAuthServiceMockGrantConsentResults contains results of the OAuthService.GrantConsent
+type OAuthServiceMockGrantConsentResults struct {
+	err error
+}
+
+// OAuthServiceMockGrantConsentOrigins contains origins of expectations of the OAuthService.GrantConsent
+type OAuthServiceMockGrantConsentExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originUserID   string
+	originClientID string
+	originScopes   string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGrantConsent *mOAuthServiceMockGrantConsent) Optional() *mOAuthServiceMockGrantConsent {
+	mmGrantConsent.optional = true
+	return mmGrantConsent
+}
+
+// Expect sets up expected params for OAuthService.GrantConsent
+func (mmGrantConsent *mOAuthServiceMockGrantConsent) Expect(ctx context.Context, userID string, clientID string, scopes []string) *mOAuthServiceMockGrantConsent {
+	if mmGrantConsent.mock.funcGrantConsent != nil {
+		mmGrantConsent.mock.t.Fatalf("OAuthServiceMock.GrantConsent mock is already set by Set")
+	}
+
+	if mmGrantConsent.defaultExpectation == nil {
+		mmGrantConsent.defaultExpectation = &OAuthServiceMockGrantConsentExpectation{}
+	}
+
+	if mmGrantConsent.defaultExpectation.paramPtrs != nil {
+		mmGrantConsent.mock.t.Fatalf("OAuthServiceMock.GrantConsent mock is already set by ExpectParams functions")
+	}
+
+	mmGrantConsent.defaultExpectation.params = &OAuthServiceMockGrantConsentParams{ctx, userID, clientID, scopes}
+	mmGrantConsent.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGrantConsent.expectations {
+		if minimock.Equal(e.params, mmGrantConsent.defaultExpectation.params) {
+			mmGrantConsent.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGrantConsent.defaultExpectation.params)
+		}
+	}
+
+	return mmGrantConsent
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OAuthService.GrantConsent
+func (mmGrantConsent *mOAuthServiceMockGrantConsent) ExpectCtxParam1(ctx context.Context) *mOAuthServiceMockGrantConsent {
+	if mmGrantConsent.mock.funcGrantConsent != nil {
+		mmGrantConsent.mock.t.Fatalf("OAuthServiceMock.GrantConsent mock is already set by Set")
+	}
+
+	if mmGrantConsent.defaultExpectation == nil {
+		mmGrantConsent.defaultExpectation = &OAuthServiceMockGrantConsentExpectation{}
+	}
+
+	if mmGrantConsent.defaultExpectation.params != nil {
+		mmGrantConsent.mock.t.Fatalf("OAuthServiceMock.GrantConsent mock is already set by Expect")
+	}
+
+	if mmGrantConsent.defaultExpectation.paramPtrs == nil {
+		mmGrantConsent.defaultExpectation.paramPtrs = &OAuthServiceMockGrantConsentParamPtrs{}
+	}
+	mmGrantConsent.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGrantConsent.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGrantConsent
+}
+
+// ExpectUserIDParam2 sets up expected param userID for OAuthService.GrantConsent
+func (mmGrantConsent *mOAuthServiceMockGrantConsent) ExpectUserIDParam2(userID string) *mOAuthServiceMockGrantConsent {
+	if mmGrantConsent.mock.funcGrantConsent != nil {
+		mmGrantConsent.mock.t.Fatalf("OAuthServiceMock.GrantConsent mock is already set by Set")
+	}
+
+	if mmGrantConsent.defaultExpectation == nil {
+		mmGrantConsent.defaultExpectation = &OAuthServiceMockGrantConsentExpectation{}
+	}
+
+	if mmGrantConsent.defaultExpectation.params != nil {
+		mmGrantConsent.mock.t.Fatalf("OAuthServiceMock.GrantConsent mock is already set by Expect")
+	}
+
+	if mmGrantConsent.defaultExpectation.paramPtrs == nil {
+		mmGrantConsent.defaultExpectation.paramPtrs = &OAuthServiceMockGrantConsentParamPtrs{}
+	}
+	mmGrantConsent.defaultExpectation.paramPtrs.userID = &userID
+	mmGrantConsent.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmGrantConsent
+}
+
+// ExpectClientIDParam3 sets up expected param clientID for OAuthService.GrantConsent
+func (mmGrantConsent *mOAuthServiceMockGrantConsent) ExpectClientIDParam3(clientID string) *mOAuthServiceMockGrantConsent {
+	if mmGrantConsent.mock.funcGrantConsent != nil {
+		mmGrantConsent.mock.t.Fatalf("OAuthServiceMock.GrantConsent mock is already set by Set")
+	}
+
+	if mmGrantConsent.defaultExpectation == nil {
+		mmGrantConsent.defaultExpectation = &OAuthServiceMockGrantConsentExpectation{}
+	}
+
+	if mmGrantConsent.defaultExpectation.params != nil {
+		mmGrantConsent.mock.t.Fatalf("OAuthServiceMock.GrantConsent mock is already set by Expect")
+	}
+
+	if mmGrantConsent.defaultExpectation.paramPtrs == nil {
+		mmGrantConsent.defaultExpectation.paramPtrs = &OAuthServiceMockGrantConsentParamPtrs{}
+	}
+	mmGrantConsent.defaultExpectation.paramPtrs.clientID = &clientID
+	mmGrantConsent.defaultExpectation.expectationOrigins.originClientID = minimock.CallerInfo(1)
+
+	return mmGrantConsent
+}
+
+// ExpectScopesParam4 sets up expected param scopes for OAuthService.GrantConsent
+func (mmGrantConsent *mOAuthServiceMockGrantConsent) ExpectScopesParam4(scopes []string) *mOAuthServiceMockGrantConsent {
+	if mmGrantConsent.mock.funcGrantConsent != nil {
+		mmGrantConsent.mock.t.Fatalf("OAuthServiceMock.GrantConsent mock is already set by Set")
+	}
+
+	if mmGrantConsent.defaultExpectation == nil {
+		mmGrantConsent.defaultExpectation = &OAuthServiceMockGrantConsentExpectation{}
+	}
+
+	if mmGrantConsent.defaultExpectation.params != nil {
+		mmGrantConsent.mock.t.Fatalf("OAuthServiceMock.GrantConsent mock is already set by Expect")
+	}
+
+	if mmGrantConsent.defaultExpectation.paramPtrs == nil {
+		mmGrantConsent.defaultExpectation.paramPtrs = &OAuthServiceMockGrantConsentParamPtrs{}
+	}
+	mmGrantConsent.defaultExpectation.paramPtrs.scopes = &scopes
+	mmGrantConsent.defaultExpectation.expectationOrigins.originScopes = minimock.CallerInfo(1)
+
+	return mmGrantConsent
+}
+
+// Inspect accepts an inspector function that has same arguments as the OAuthService.GrantConsent
+func (mmGrantConsent *mOAuthServiceMockGrantConsent) Inspect(f func(ctx context.Context, userID string, clientID string, scopes []string)) *mOAuthServiceMockGrantConsent {
+	if mmGrantConsent.mock.inspectFuncGrantConsent != nil {
+		mmGrantConsent.mock.t.Fatalf("Inspect function is already set for OAuthServiceMock.GrantConsent")
+	}
+
+	mmGrantConsent.mock.inspectFuncGrantConsent = f
+
+	return mmGrantConsent
+}
+
+// Return sets up results that will be returned by OAuthService.GrantConsent
+func (mmGrantConsent *mOAuthServiceMockGrantConsent) Return(err error) *OAuthServiceMock {
+	if mmGrantConsent.mock.funcGrantConsent != nil {
+		mmGrantConsent.mock.t.Fatalf("OAuthServiceMock.GrantConsent mock is already set by Set")
+	}
+
+	if mmGrantConsent.defaultExpectation == nil {
+		mmGrantConsent.defaultExpectation = &OAuthServiceMockGrantConsentExpectation{mock: mmGrantConsent.mock}
+	}
+	mmGrantConsent.defaultExpectation.results = &OAuthServiceMockGrantConsentResults{err}
+	mmGrantConsent.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGrantConsent.mock
+}
+
+// Set uses given function f to mock the OAuthService.GrantConsent method
+func (mmGrantConsent *mOAuthServiceMockGrantConsent) Set(f func(ctx context.Context, userID string, clientID string, scopes []string) (err error)) *OAuthServiceMock {
+	if mmGrantConsent.defaultExpectation != nil {
+		mmGrantConsent.mock.t.Fatalf("Default expectation is already set for the OAuthService.GrantConsent method")
+	}
+
+	if len(mmGrantConsent.expectations) > 0 {
+		mmGrantConsent.mock.t.Fatalf("Some expectations are already set for the OAuthService.GrantConsent method")
+	}
+
+	mmGrantConsent.mock.funcGrantConsent = f
+	mmGrantConsent.mock.funcGrantConsentOrigin = minimock.CallerInfo(1)
+	return mmGrantConsent.mock
+}
+
+// When sets expectation for the OAuthService.GrantConsent which will trigger the result defined by the following
+// Then helper
+func (mmGrantConsent *mOAuthServiceMockGrantConsent) When(ctx context.Context, userID string, clientID string, scopes []string) *OAuthServiceMockGrantConsentExpectation {
+	if mmGrantConsent.mock.funcGrantConsent != nil {
+		mmGrantConsent.mock.t.Fatalf("OAuthServiceMock.GrantConsent mock is already set by Set")
+	}
+
+	expectation := &OAuthServiceMockGrantConsentExpectation{
+		mock:               mmGrantConsent.mock,
+		params:             &OAuthServiceMockGrantConsentParams{ctx, userID, clientID, scopes},
+		expectationOrigins: OAuthServiceMockGrantConsentExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGrantConsent.expectations = append(mmGrantConsent.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OAuthService.GrantConsent return parameters for the expectation previously defined by the When method
+func (e *OAuthServiceMockGrantConsentExpectation) Then(err error) *OAuthServiceMock {
+	e.results = &OAuthServiceMockGrantConsentResults{err}
+	return e.mock
+}
+
+// Times sets number of times OAuthService.GrantConsent should be invoked
+func (mmGrantConsent *mOAuthServiceMockGrantConsent) Times(n uint64) *mOAuthServiceMockGrantConsent {
+	if n == 0 {
+		mmGrantConsent.mock.t.Fatalf("Times of OAuthServiceMock.GrantConsent mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGrantConsent.expectedInvocations, n)
+	mmGrantConsent.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGrantConsent
+}
+
+func (mmGrantConsent *mOAuthServiceMockGrantConsent) invocationsDone() bool {
+	if len(mmGrantConsent.expectations) == 0 && mmGrantConsent.defaultExpectation == nil && mmGrantConsent.mock.funcGrantConsent == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGrantConsent.mock.afterGrantConsentCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGrantConsent.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GrantConsent implements OAuthService
+func (mmGrantConsent *OAuthServiceMock) GrantConsent(ctx context.Context, userID string, clientID string, scopes []string) (err error) {
+	mm_atomic.AddUint64(&mmGrantConsent.beforeGrantConsentCounter, 1)
+	defer mm_atomic.AddUint64(&mmGrantConsent.afterGrantConsentCounter, 1)
+
+	mmGrantConsent.t.Helper()
+
+	if mmGrantConsent.inspectFuncGrantConsent != nil {
+		mmGrantConsent.inspectFuncGrantConsent(ctx, userID, clientID, scopes)
+	}
+
+	mm_params := OAuthServiceMockGrantConsentParams{ctx, userID, clientID, scopes}
+
+	// Record call args
+	mmGrantConsent.GrantConsentMock.mutex.Lock()
+	mmGrantConsent.GrantConsentMock.callArgs = append(mmGrantConsent.GrantConsentMock.callArgs, &mm_params)
+	mmGrantConsent.GrantConsentMock.mutex.Unlock()
+
+	for _, e := range mmGrantConsent.GrantConsentMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmGrantConsent.GrantConsentMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGrantConsent.GrantConsentMock.defaultExpectation.Counter, 1)
+		mm_want := mmGrantConsent.GrantConsentMock.defaultExpectation.params
+		mm_want_ptrs := mmGrantConsent.GrantConsentMock.defaultExpectation.paramPtrs
+
+		mm_got := OAuthServiceMockGrantConsentParams{ctx, userID, clientID, scopes}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGrantConsent.t.Errorf("OAuthServiceMock.GrantConsent got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGrantConsent.GrantConsentMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmGrantConsent.t.Errorf("OAuthServiceMock.GrantConsent got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGrantConsent.GrantConsentMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.clientID != nil && !minimock.Equal(*mm_want_ptrs.clientID, mm_got.clientID) {
+				mmGrantConsent.t.Errorf("OAuthServiceMock.GrantConsent got unexpected parameter clientID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGrantConsent.GrantConsentMock.defaultExpectation.expectationOrigins.originClientID, *mm_want_ptrs.clientID, mm_got.clientID, minimock.Diff(*mm_want_ptrs.clientID, mm_got.clientID))
+			}
+
+			if mm_want_ptrs.scopes != nil && !minimock.Equal(*mm_want_ptrs.scopes, mm_got.scopes) {
+				mmGrantConsent.t.Errorf("OAuthServiceMock.GrantConsent got unexpected parameter scopes, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGrantConsent.GrantConsentMock.defaultExpectation.expectationOrigins.originScopes, *mm_want_ptrs.scopes, mm_got.scopes, minimock.Diff(*mm_want_ptrs.scopes, mm_got.scopes))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGrantConsent.t.Errorf("OAuthServiceMock.GrantConsent got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGrantConsent.GrantConsentMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGrantConsent.GrantConsentMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGrantConsent.t.Fatal("No results are set for the OAuthServiceMock.GrantConsent")
+		}
+		return (*mm_results).err
+	}
+	if mmGrantConsent.funcGrantConsent != nil {
+		return mmGrantConsent.funcGrantConsent(ctx, userID, clientID, scopes)
+	}
+	mmGrantConsent.t.Fatalf("Unexpected call to OAuthServiceMock.GrantConsent. %v %v %v %v", ctx, userID, clientID, scopes)
+	return
+}
+
+// GrantConsentAfterCounter returns a count of finished OAuthServiceMock.GrantConsent invocations
+func (mmGrantConsent *OAuthServiceMock) GrantConsentAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGrantConsent.afterGrantConsentCounter)
+}
+
+// GrantConsentBeforeCounter returns a count of OAuthServiceMock.GrantConsent invocations
+func (mmGrantConsent *OAuthServiceMock) GrantConsentBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGrantConsent.beforeGrantConsentCounter)
+}
+
+// Calls returns a list of arguments used in each call to OAuthServiceMock.GrantConsent.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGrantConsent *mOAuthServiceMockGrantConsent) Calls() []*OAuthServiceMockGrantConsentParams {
+	mmGrantConsent.mutex.RLock()
+
+	argCopy := make([]*OAuthServiceMockGrantConsentParams, len(mmGrantConsent.callArgs))
+	copy(argCopy, mmGrantConsent.callArgs)
+
+	mmGrantConsent.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGrantConsentDone returns true if the count of the GrantConsent invocations corresponds
+// the number of defined expectations
+func (m *OAuthServiceMock) MinimockGrantConsentDone() bool {
+	if m.GrantConsentMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GrantConsentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GrantConsentMock.invocationsDone()
+}
+
+// MinimockGrantConsentInspect logs each unmet expectation
+func (m *OAuthServiceMock) MinimockGrantConsentInspect() {
+	for _, e := range m.GrantConsentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OAuthServiceMock.GrantConsent at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGrantConsentCounter := mm_atomic.LoadUint64(&m.afterGrantConsentCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GrantConsentMock.defaultExpectation != nil && afterGrantConsentCounter < 1 {
+		if m.GrantConsentMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OAuthServiceMock.GrantConsent at\n%s", m.GrantConsentMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OAuthServiceMock.GrantConsent at\n%s with params: %#v", m.GrantConsentMock.defaultExpectation.expectationOrigins.origin, *m.GrantConsentMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGrantConsent != nil && afterGrantConsentCounter < 1 {
+		m.t.Errorf("Expected call to OAuthServiceMock.GrantConsent at\n%s", m.funcGrantConsentOrigin)
+	}
+
+	if !m.GrantConsentMock.invocationsDone() && afterGrantConsentCounter > 0 {
+		m.t.Errorf("Expected %d calls to OAuthServiceMock.GrantConsent at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GrantConsentMock.expectedInvocations), m.GrantConsentMock.expectedInvocationsOrigin, afterGrantConsentCounter)
+	}
+}
+
+type mOAuthServiceMockHasConsent struct {
+	optional           bool
+	mock               *OAuthServiceMock
+	defaultExpectation *OAuthServiceMockHasConsentExpectation
+	expectations       []*OAuthServiceMockHasConsentExpectation
+
+	callArgs []*OAuthServiceMockHasConsentParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OAuthServiceMockHasConsentExpectation specifies expectation struct of the OAuthService.HasConsent
+type OAuthServiceMockHasConsentExpectation struct {
+	mock               *OAuthServiceMock
+	params             *OAuthServiceMockHasConsentParams
+	paramPtrs          *OAuthServiceMockHasConsentParamPtrs
+	expectationOrigins OAuthServiceMockHasConsentExpectationOrigins
+	results            *OAuthServiceMockHasConsentResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// OAuthServiceMockHasConsentParams contains parameters of the OAuthService.HasConsent
+type OAuthServiceMockHasConsentParams struct {
+	ctx      context.Context
+	userID   string
+	clientID string
+	scopes   []string
+}
+
+// OAuthServiceMockHasConsentParamPtrs contains pointers to parameters of the OAuthService.HasConsent
+type OAuthServiceMockHasConsentParamPtrs struct {
+	ctx      *context.Context
+	userID   *string
+	clientID *string
+	scopes   *[]string
+}
+
+// OAuthServiceMockHasConsentResults contains results of the OAuthService.HasConsent
+type OAuthServiceMockHasConsentResults struct {
+	b1  bool
+	err error
+}
+
+// OAuthServiceMockHasConsentOrigins contains origins of expectations of the OAuthService.HasConsent
+type OAuthServiceMockHasConsentExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originUserID   string
+	originClientID string
+	originScopes   string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmHasConsent *mOAuthServiceMockHasConsent) Optional() *mOAuthServiceMockHasConsent {
+	mmHasConsent.optional = true
+	return mmHasConsent
+}
+
+// Expect sets up expected params for OAuthService.HasConsent
+func (mmHasConsent *mOAuthServiceMockHasConsent) Expect(ctx context.Context, userID string, clientID string, scopes []string) *mOAuthServiceMockHasConsent {
+	if mmHasConsent.mock.funcHasConsent != nil {
+		mmHasConsent.mock.t.Fatalf("OAuthServiceMock.HasConsent mock is already set by Set")
+	}
+
+	if mmHasConsent.defaultExpectation == nil {
+		mmHasConsent.defaultExpectation = &OAuthServiceMockHasConsentExpectation{}
+	}
+
+	if mmHasConsent.defaultExpectation.paramPtrs != nil {
+		mmHasConsent.mock.t.Fatalf("OAuthServiceMock.HasConsent mock is already set by ExpectParams functions")
+	}
+
+	mmHasConsent.defaultExpectation.params = &OAuthServiceMockHasConsentParams{ctx, userID, clientID, scopes}
+	mmHasConsent.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmHasConsent.expectations {
+		if minimock.Equal(e.params, mmHasConsent.defaultExpectation.params) {
+			mmHasConsent.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmHasConsent.defaultExpectation.params)
+		}
+	}
+
+	return mmHasConsent
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OAuthService.HasConsent
+func (mmHasConsent *mOAuthServiceMockHasConsent) ExpectCtxParam1(ctx context.Context) *mOAuthServiceMockHasConsent {
+	if mmHasConsent.mock.funcHasConsent != nil {
+		mmHasConsent.mock.t.Fatalf("OAuthServiceMock.HasConsent mock is already set by Set")
+	}
+
+	if mmHasConsent.defaultExpectation == nil {
+		mmHasConsent.defaultExpectation = &OAuthServiceMockHasConsentExpectation{}
+	}
+
+	if mmHasConsent.defaultExpectation.params != nil {
+		mmHasConsent.mock.t.Fatalf("OAuthServiceMock.HasConsent mock is already set by Expect")
+	}
+
+	if mmHasConsent.defaultExpectation.paramPtrs == nil {
+		mmHasConsent.defaultExpectation.paramPtrs = &OAuthServiceMockHasConsentParamPtrs{}
+	}
+	mmHasConsent.defaultExpectation.paramPtrs.ctx = &ctx
+	mmHasConsent.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmHasConsent
+}
+
+// ExpectUserIDParam2 sets up expected param userID for OAuthService.HasConsent
+func (mmHasConsent *mOAuthServiceMockHasConsent) ExpectUserIDParam2(userID string) *mOAuthServiceMockHasConsent {
+	if mmHasConsent.mock.funcHasConsent != nil {
+		mmHasConsent.mock.t.Fatalf("OAuthServiceMock.HasConsent mock is already set by Set")
+	}
+
+	if mmHasConsent.defaultExpectation == nil {
+		mmHasConsent.defaultExpectation = &OAuthServiceMockHasConsentExpectation{}
+	}
+
+	if mmHasConsent.defaultExpectation.params != nil {
+		mmHasConsent.mock.t.Fatalf("OAuthServiceMock.HasConsent mock is already set by Expect")
+	}
+
+	if mmHasConsent.defaultExpectation.paramPtrs == nil {
+		mmHasConsent.defaultExpectation.paramPtrs = &OAuthServiceMockHasConsentParamPtrs{}
+	}
+	mmHasConsent.defaultExpectation.paramPtrs.userID = &userID
+	mmHasConsent.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmHasConsent
+}
+
+// ExpectClientIDParam3 sets up expected param clientID for OAuthService.HasConsent
+func (mmHasConsent *mOAuthServiceMockHasConsent) ExpectClientIDParam3(clientID string) *mOAuthServiceMockHasConsent {
+	if mmHasConsent.mock.funcHasConsent != nil {
+		mmHasConsent.mock.t.Fatalf("OAuthServiceMock.HasConsent mock is already set by Set")
+	}
+
+	if mmHasConsent.defaultExpectation == nil {
+		mmHasConsent.defaultExpectation = &OAuthServiceMockHasConsentExpectation{}
+	}
+
+	if mmHasConsent.defaultExpectation.params != nil {
+		mmHasConsent.mock.t.Fatalf("OAuthServiceMock.HasConsent mock is already set by Expect")
+	}
+
+	if mmHasConsent.defaultExpectation.paramPtrs == nil {
+		mmHasConsent.defaultExpectation.paramPtrs = &OAuthServiceMockHasConsentParamPtrs{}
+	}
+	mmHasConsent.defaultExpectation.paramPtrs.clientID = &clientID
+	mmHasConsent.defaultExpectation.expectationOrigins.originClientID = minimock.CallerInfo(1)
+
+	return mmHasConsent
+}
+
+// ExpectScopesParam4 sets up expected param scopes for OAuthService.HasConsent
+func (mmHasConsent *mOAuthServiceMockHasConsent) ExpectScopesParam4(scopes []string) *mOAuthServiceMockHasConsent {
+	if mmHasConsent.mock.funcHasConsent != nil {
+		mmHasConsent.mock.t.Fatalf("OAuthServiceMock.HasConsent mock is already set by Set")
+	}
+
+	if mmHasConsent.defaultExpectation == nil {
+		mmHasConsent.defaultExpectation = &OAuthServiceMockHasConsentExpectation{}
+	}
+
+	if mmHasConsent.defaultExpectation.params != nil {
+		mmHasConsent.mock.t.Fatalf("OAuthServiceMock.HasConsent mock is already set by Expect")
+	}
+
+	if mmHasConsent.defaultExpectation.paramPtrs == nil {
+		mmHasConsent.defaultExpectation.paramPtrs = &OAuthServiceMockHasConsentParamPtrs{}
+	}
+	mmHasConsent.defaultExpectation.paramPtrs.scopes = &scopes
+	mmHasConsent.defaultExpectation.expectationOrigins.originScopes = minimock.CallerInfo(1)
+
+	return mmHasConsent
+}
+
+// Inspect accepts an inspector function that has same arguments as the OAuthService.HasConsent
+func (mmHasConsent *mOAuthServiceMockHasConsent) Inspect(f func(ctx context.Context, userID string, clientID string, scopes []string)) *mOAuthServiceMockHasConsent {
+	if mmHasConsent.mock.inspectFuncHasConsent != nil {
+		mmHasConsent.mock.t.Fatalf("Inspect function is already set for OAuthServiceMock.HasConsent")
+	}
+
+	mmHasConsent.mock.inspectFuncHasConsent = f
+
+	return mmHasConsent
+}
+
+// Return sets up results that will be returned by OAuthService.HasConsent
+func (mmHasConsent *mOAuthServiceMockHasConsent) Return(b1 bool, err error) *OAuthServiceMock {
+	if mmHasConsent.mock.funcHasConsent != nil {
+		mmHasConsent.mock.t.Fatalf("OAuthServiceMock.HasConsent mock is already set by Set")
+	}
+
+	if mmHasConsent.defaultExpectation == nil {
+		mmHasConsent.defaultExpectation = &OAuthServiceMockHasConsentExpectation{mock: mmHasConsent.mock}
+	}
+	mmHasConsent.defaultExpectation.results = &OAuthServiceMockHasConsentResults{b1, err}
+	mmHasConsent.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmHasConsent.mock
+}
+
+// Set uses given function f to mock the OAuthService.HasConsent method
+func (mmHasConsent *mOAuthServiceMockHasConsent) Set(f func(ctx context.Context, userID string, clientID string, scopes []string) (b1 bool, err error)) *OAuthServiceMock {
+	if mmHasConsent.defaultExpectation != nil {
+		mmHasConsent.mock.t.Fatalf("Default expectation is already set for the OAuthService.HasConsent method")
+	}
+
+	if len(mmHasConsent.expectations) > 0 {
+		mmHasConsent.mock.t.Fatalf("Some expectations are already set for the OAuthService.HasConsent method")
+	}
+
+	mmHasConsent.mock.funcHasConsent = f
+	mmHasConsent.mock.funcHasConsentOrigin = minimock.CallerInfo(1)
+	return mmHasConsent.mock
+}
+
+// When sets expectation for the OAuthService.HasConsent which will trigger the result defined by the following
+// Then helper
+func (mmHasConsent *mOAuthServiceMockHasConsent) When(ctx context.Context, userID string, clientID string, scopes []string) *OAuthServiceMockHasConsentExpectation {
+	if mmHasConsent.mock.funcHasConsent != nil {
+		mmHasConsent.mock.t.Fatalf("OAuthServiceMock.HasConsent mock is already set by Set")
+	}
+
+	expectation := &OAuthServiceMockHasConsentExpectation{
+		mock:               mmHasConsent.mock,
+		params:             &OAuthServiceMockHasConsentParams{ctx, userID, clientID, scopes},
+		expectationOrigins: OAuthServiceMockHasConsentExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmHasConsent.expectations = append(mmHasConsent.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OAuthService.HasConsent return parameters for the expectation previously defined by the When method
+func (e *OAuthServiceMockHasConsentExpectation) Then(b1 bool, err error) *OAuthServiceMock {
+	e.results = &OAuthServiceMockHasConsentResults{b1, err}
+	return e.mock
+}
+
+// Times sets number of times OAuthService.HasConsent should be invoked
+func (mmHasConsent *mOAuthServiceMockHasConsent) Times(n uint64) *mOAuthServiceMockHasConsent {
+	if n == 0 {
+		mmHasConsent.mock.t.Fatalf("Times of OAuthServiceMock.HasConsent mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmHasConsent.expectedInvocations, n)
+	mmHasConsent.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmHasConsent
+}
+
+func (mmHasConsent *mOAuthServiceMockHasConsent) invocationsDone() bool {
+	if len(mmHasConsent.expectations) == 0 && mmHasConsent.defaultExpectation == nil && mmHasConsent.mock.funcHasConsent == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmHasConsent.mock.afterHasConsentCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmHasConsent.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// HasConsent implements OAuthService
+func (mmHasConsent *OAuthServiceMock) HasConsent(ctx context.Context, userID string, clientID string, scopes []string) (b1 bool, err error) {
+	mm_atomic.AddUint64(&mmHasConsent.beforeHasConsentCounter, 1)
+	defer mm_atomic.AddUint64(&mmHasConsent.afterHasConsentCounter, 1)
+
+	mmHasConsent.t.Helper()
+
+	if mmHasConsent.inspectFuncHasConsent != nil {
+		mmHasConsent.inspectFuncHasConsent(ctx, userID, clientID, scopes)
+	}
+
+	mm_params := OAuthServiceMockHasConsentParams{ctx, userID, clientID, scopes}
+
+	// Record call args
+	mmHasConsent.HasConsentMock.mutex.Lock()
+	mmHasConsent.HasConsentMock.callArgs = append(mmHasConsent.HasConsentMock.callArgs, &mm_params)
+	mmHasConsent.HasConsentMock.mutex.Unlock()
+
+	for _, e := range mmHasConsent.HasConsentMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.b1, e.results.err
+		}
+	}
+
+	if mmHasConsent.HasConsentMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmHasConsent.HasConsentMock.defaultExpectation.Counter, 1)
+		mm_want := mmHasConsent.HasConsentMock.defaultExpectation.params
+		mm_want_ptrs := mmHasConsent.HasConsentMock.defaultExpectation.paramPtrs
+
+		mm_got := OAuthServiceMockHasConsentParams{ctx, userID, clientID, scopes}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmHasConsent.t.Errorf("OAuthServiceMock.HasConsent got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmHasConsent.HasConsentMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmHasConsent.t.Errorf("OAuthServiceMock.HasConsent got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmHasConsent.HasConsentMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.clientID != nil && !minimock.Equal(*mm_want_ptrs.clientID, mm_got.clientID) {
+				mmHasConsent.t.Errorf("OAuthServiceMock.HasConsent got unexpected parameter clientID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmHasConsent.HasConsentMock.defaultExpectation.expectationOrigins.originClientID, *mm_want_ptrs.clientID, mm_got.clientID, minimock.Diff(*mm_want_ptrs.clientID, mm_got.clientID))
+			}
+
+			if mm_want_ptrs.scopes != nil && !minimock.Equal(*mm_want_ptrs.scopes, mm_got.scopes) {
+				mmHasConsent.t.Errorf("OAuthServiceMock.HasConsent got unexpected parameter scopes, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmHasConsent.HasConsentMock.defaultExpectation.expectationOrigins.originScopes, *mm_want_ptrs.scopes, mm_got.scopes, minimock.Diff(*mm_want_ptrs.scopes, mm_got.scopes))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmHasConsent.t.Errorf("OAuthServiceMock.HasConsent got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmHasConsent.HasConsentMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmHasConsent.HasConsentMock.defaultExpectation.results
+		if mm_results == nil {
+			mmHasConsent.t.Fatal("No results are set for the OAuthServiceMock.HasConsent")
+		}
+		return (*mm_results).b1, (*mm_results).err
+	}
+	if mmHasConsent.funcHasConsent != nil {
+		return mmHasConsent.funcHasConsent(ctx, userID, clientID, scopes)
+	}
+	mmHasConsent.t.Fatalf("Unexpected call to OAuthServiceMock.HasConsent. %v %v %v %v", ctx, userID, clientID, scopes)
+	return
+}
+
+// HasConsentAfterCounter returns a count of finished OAuthServiceMock.HasConsent invocations
+func (mmHasConsent *OAuthServiceMock) HasConsentAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmHasConsent.afterHasConsentCounter)
+}
+
+// HasConsentBeforeCounter returns a count of OAuthServiceMock.HasConsent invocations
+func (mmHasConsent *OAuthServiceMock) HasConsentBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmHasConsent.beforeHasConsentCounter)
+}
+
+// Calls returns a list of arguments used in each call to OAuthServiceMock.HasConsent.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmHasConsent *mOAuthServiceMockHasConsent) Calls() []*OAuthServiceMockHasConsentParams {
+	mmHasConsent.mutex.RLock()
+
+	argCopy := make([]*OAuthServiceMockHasConsentParams, len(mmHasConsent.callArgs))
+	copy(argCopy, mmHasConsent.callArgs)
+
+	mmHasConsent.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockHasConsentDone returns true if the count of the HasConsent invocations corresponds
+// the number of defined expectations
+func (m *OAuthServiceMock) MinimockHasConsentDone() bool {
+	if m.HasConsentMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.HasConsentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.HasConsentMock.invocationsDone()
+}
+
+// MinimockHasConsentInspect logs each unmet expectation
+func (m *OAuthServiceMock) MinimockHasConsentInspect() {
+	for _, e := range m.HasConsentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OAuthServiceMock.HasConsent at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterHasConsentCounter := mm_atomic.LoadUint64(&m.afterHasConsentCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.HasConsentMock.defaultExpectation != nil && afterHasConsentCounter < 1 {
+		if m.HasConsentMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OAuthServiceMock.HasConsent at\n%s", m.HasConsentMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OAuthServiceMock.HasConsent at\n%s with params: %#v", m.HasConsentMock.defaultExpectation.expectationOrigins.origin, *m.HasConsentMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcHasConsent != nil && afterHasConsentCounter < 1 {
+		m.t.Errorf("Expected call to OAuthServiceMock.HasConsent at\n%s", m.funcHasConsentOrigin)
+	}
+
+	if !m.HasConsentMock.invocationsDone() && afterHasConsentCounter > 0 {
+		m.t.Errorf("Expected %d calls to OAuthServiceMock.HasConsent at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.HasConsentMock.expectedInvocations), m.HasConsentMock.expectedInvocationsOrigin, afterHasConsentCounter)
+	}
+}
+
+type mOAuthServiceMockIntrospect struct {
+	optional           bool
+	mock               *OAuthServiceMock
+	defaultExpectation *OAuthServiceMockIntrospectExpectation
+	expectations       []*OAuthServiceMockIntrospectExpectation
+
+	callArgs []*OAuthServiceMockIntrospectParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OAuthServiceMockIntrospectExpectation specifies expectation struct of the OAuthService.Introspect
+type OAuthServiceMockIntrospectExpectation struct {
+	mock               *OAuthServiceMock
+	params             *OAuthServiceMockIntrospectParams
+	paramPtrs          *OAuthServiceMockIntrospectParamPtrs
+	expectationOrigins OAuthServiceMockIntrospectExpectationOrigins
+	results            *OAuthServiceMockIntrospectResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// OAuthServiceMockIntrospectParams contains parameters of the OAuthService.Introspect
+type OAuthServiceMockIntrospectParams struct {
+	ctx   context.Context
+	token string
+}
+
+// OAuthServiceMockIntrospectParamPtrs contains pointers to parameters of the OAuthService.Introspect
+type OAuthServiceMockIntrospectParamPtrs struct {
+	ctx   *context.Context
+	token *string
+}
+
+// OAuthServiceMockIntrospectResults contains results of the OAuthService.Introspect
+type OAuthServiceMockIntrospectResults struct {
+	cp1 *models.Claims
+	err error
+}
+
+// OAuthServiceMockIntrospectOrigins contains origins of expectations of the OAuthService.Introspect
+type OAuthServiceMockIntrospectExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originToken string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmIntrospect *mOAuthServiceMockIntrospect) Optional() *mOAuthServiceMockIntrospect {
+	mmIntrospect.optional = true
+	return mmIntrospect
+}
+
+// Expect sets up expected params for OAuthService.Introspect
+func (mmIntrospect *mOAuthServiceMockIntrospect) Expect(ctx context.Context, token string) *mOAuthServiceMockIntrospect {
+	if mmIntrospect.mock.funcIntrospect != nil {
+		mmIntrospect.mock.t.Fatalf("OAuthServiceMock.Introspect mock is already set by Set")
+	}
+
+	if mmIntrospect.defaultExpectation == nil {
+		mmIntrospect.defaultExpectation = &OAuthServiceMockIntrospectExpectation{}
+	}
+
+	if mmIntrospect.defaultExpectation.paramPtrs != nil {
+		mmIntrospect.mock.t.Fatalf("OAuthServiceMock.Introspect mock is already set by ExpectParams functions")
+	}
+
+	mmIntrospect.defaultExpectation.params = &OAuthServiceMockIntrospectParams{ctx, token}
+	mmIntrospect.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmIntrospect.expectations {
+		if minimock.Equal(e.params, mmIntrospect.defaultExpectation.params) {
+			mmIntrospect.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmIntrospect.defaultExpectation.params)
+		}
+	}
+
+	return mmIntrospect
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OAuthService.Introspect
+func (mmIntrospect *mOAuthServiceMockIntrospect) ExpectCtxParam1(ctx context.Context) *mOAuthServiceMockIntrospect {
+	if mmIntrospect.mock.funcIntrospect != nil {
+		mmIntrospect.mock.t.Fatalf("OAuthServiceMock.Introspect mock is already set by Set")
+	}
+
+	if mmIntrospect.defaultExpectation == nil {
+		mmIntrospect.defaultExpectation = &OAuthServiceMockIntrospectExpectation{}
+	}
+
+	if mmIntrospect.defaultExpectation.params != nil {
+		mmIntrospect.mock.t.Fatalf("OAuthServiceMock.Introspect mock is already set by Expect")
+	}
+
+	if mmIntrospect.defaultExpectation.paramPtrs == nil {
+		mmIntrospect.defaultExpectation.paramPtrs = &OAuthServiceMockIntrospectParamPtrs{}
+	}
+	mmIntrospect.defaultExpectation.paramPtrs.ctx = &ctx
+	mmIntrospect.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmIntrospect
+}
+
+// ExpectTokenParam2 sets up expected param token for OAuthService.Introspect
+func (mmIntrospect *mOAuthServiceMockIntrospect) ExpectTokenParam2(token string) *mOAuthServiceMockIntrospect {
+	if mmIntrospect.mock.funcIntrospect != nil {
+		mmIntrospect.mock.t.Fatalf("OAuthServiceMock.Introspect mock is already set by Set")
+	}
+
+	if mmIntrospect.defaultExpectation == nil {
+		mmIntrospect.defaultExpectation = &OAuthServiceMockIntrospectExpectation{}
+	}
+
+	if mmIntrospect.defaultExpectation.params != nil {
+		mmIntrospect.mock.t.Fatalf("OAuthServiceMock.Introspect mock is already set by Expect")
+	}
+
+	if mmIntrospect.defaultExpectation.paramPtrs == nil {
+		mmIntrospect.defaultExpectation.paramPtrs = &OAuthServiceMockIntrospectParamPtrs{}
+	}
+	mmIntrospect.defaultExpectation.paramPtrs.token = &token
+	mmIntrospect.defaultExpectation.expectationOrigins.originToken = minimock.CallerInfo(1)
+
+	return mmIntrospect
+}
+
+// Inspect accepts an inspector function that has same arguments as the OAuthService.Introspect
+func (mmIntrospect *mOAuthServiceMockIntrospect) Inspect(f func(ctx context.Context, token string)) *mOAuthServiceMockIntrospect {
+	if mmIntrospect.mock.inspectFuncIntrospect != nil {
+		mmIntrospect.mock.t.Fatalf("Inspect function is already set for OAuthServiceMock.Introspect")
+	}
+
+	mmIntrospect.mock.inspectFuncIntrospect = f
+
+	return mmIntrospect
+}
+
+// Return sets up results that will be returned by OAuthService.Introspect
+func (mmIntrospect *mOAuthServiceMockIntrospect) Return(cp1 *models.Claims, err error) *OAuthServiceMock {
+	if mmIntrospect.mock.funcIntrospect != nil {
+		mmIntrospect.mock.t.Fatalf("OAuthServiceMock.Introspect mock is already set by Set")
+	}
+
+	if mmIntrospect.defaultExpectation == nil {
+		mmIntrospect.defaultExpectation = &OAuthServiceMockIntrospectExpectation{mock: mmIntrospect.mock}
+	}
+	mmIntrospect.defaultExpectation.results = &OAuthServiceMockIntrospectResults{cp1, err}
+	mmIntrospect.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmIntrospect.mock
+}
+
+// Set uses given function f to mock the OAuthService.Introspect method
+func (mmIntrospect *mOAuthServiceMockIntrospect) Set(f func(ctx context.Context, token string) (cp1 *models.Claims, err error)) *OAuthServiceMock {
+	if mmIntrospect.defaultExpectation != nil {
+		mmIntrospect.mock.t.Fatalf("Default expectation is already set for the OAuthService.Introspect method")
+	}
+
+	if len(mmIntrospect.expectations) > 0 {
+		mmIntrospect.mock.t.Fatalf("Some expectations are already set for the OAuthService.Introspect method")
+	}
+
+	mmIntrospect.mock.funcIntrospect = f
+	mmIntrospect.mock.funcIntrospectOrigin = minimock.CallerInfo(1)
+	return mmIntrospect.mock
+}
+
+// When sets expectation for the OAuthService.Introspect which will trigger the result defined by the following
+// Then helper
+func (mmIntrospect *mOAuthServiceMockIntrospect) When(ctx context.Context, token string) *OAuthServiceMockIntrospectExpectation {
+	if mmIntrospect.mock.funcIntrospect != nil {
+		mmIntrospect.mock.t.Fatalf("OAuthServiceMock.Introspect mock is already set by Set")
+	}
+
+	expectation := &OAuthServiceMockIntrospectExpectation{
+		mock:               mmIntrospect.mock,
+		params:             &OAuthServiceMockIntrospectParams{ctx, token},
+		expectationOrigins: OAuthServiceMockIntrospectExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmIntrospect.expectations = append(mmIntrospect.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OAuthService.Introspect return parameters for the expectation previously defined by the When method
+func (e *OAuthServiceMockIntrospectExpectation) Then(cp1 *models.Claims, err error) *OAuthServiceMock {
+	e.results = &OAuthServiceMockIntrospectResults{cp1, err}
+	return e.mock
+}
+
+// Times sets number of times OAuthService.Introspect should be invoked
+func (mmIntrospect *mOAuthServiceMockIntrospect) Times(n uint64) *mOAuthServiceMockIntrospect {
+	if n == 0 {
+		mmIntrospect.mock.t.Fatalf("Times of OAuthServiceMock.Introspect mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmIntrospect.expectedInvocations, n)
+	mmIntrospect.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmIntrospect
+}
+
+func (mmIntrospect *mOAuthServiceMockIntrospect) invocationsDone() bool {
+	if len(mmIntrospect.expectations) == 0 && mmIntrospect.defaultExpectation == nil && mmIntrospect.mock.funcIntrospect == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmIntrospect.mock.afterIntrospectCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmIntrospect.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Introspect implements OAuthService
+func (mmIntrospect *OAuthServiceMock) Introspect(ctx context.Context, token string) (cp1 *models.Claims, err error) {
+	mm_atomic.AddUint64(&mmIntrospect.beforeIntrospectCounter, 1)
+	defer mm_atomic.AddUint64(&mmIntrospect.afterIntrospectCounter, 1)
+
+	mmIntrospect.t.Helper()
+
+	if mmIntrospect.inspectFuncIntrospect != nil {
+		mmIntrospect.inspectFuncIntrospect(ctx, token)
+	}
+
+	mm_params := OAuthServiceMockIntrospectParams{ctx, token}
+
+	// Record call args
+	mmIntrospect.IntrospectMock.mutex.Lock()
+	mmIntrospect.IntrospectMock.callArgs = append(mmIntrospect.IntrospectMock.callArgs, &mm_params)
+	mmIntrospect.IntrospectMock.mutex.Unlock()
+
+	for _, e := range mmIntrospect.IntrospectMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.cp1, e.results.err
+		}
+	}
+
+	if mmIntrospect.IntrospectMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmIntrospect.IntrospectMock.defaultExpectation.Counter, 1)
+		mm_want := mmIntrospect.IntrospectMock.defaultExpectation.params
+		mm_want_ptrs := mmIntrospect.IntrospectMock.defaultExpectation.paramPtrs
+
+		mm_got := OAuthServiceMockIntrospectParams{ctx, token}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmIntrospect.t.Errorf("OAuthServiceMock.Introspect got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmIntrospect.IntrospectMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.token != nil && !minimock.Equal(*mm_want_ptrs.token, mm_got.token) {
+				mmIntrospect.t.Errorf("OAuthServiceMock.Introspect got unexpected parameter token, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmIntrospect.IntrospectMock.defaultExpectation.expectationOrigins.originToken, *mm_want_ptrs.token, mm_got.token, minimock.Diff(*mm_want_ptrs.token, mm_got.token))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmIntrospect.t.Errorf("OAuthServiceMock.Introspect got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmIntrospect.IntrospectMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmIntrospect.IntrospectMock.defaultExpectation.results
+		if mm_results == nil {
+			mmIntrospect.t.Fatal("No results are set for the OAuthServiceMock.Introspect")
+		}
+		return (*mm_results).cp1, (*mm_results).err
+	}
+	if mmIntrospect.funcIntrospect != nil {
+		return mmIntrospect.funcIntrospect(ctx, token)
+	}
+	mmIntrospect.t.Fatalf("Unexpected call to OAuthServiceMock.Introspect. %v %v", ctx, token)
+	return
+}
+
+// IntrospectAfterCounter returns a count of finished OAuthServiceMock.Introspect invocations
+func (mmIntrospect *OAuthServiceMock) IntrospectAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmIntrospect.afterIntrospectCounter)
+}
+
+// IntrospectBeforeCounter returns a count of OAuthServiceMock.Introspect invocations
+func (mmIntrospect *OAuthServiceMock) IntrospectBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmIntrospect.beforeIntrospectCounter)
+}
+
+// Calls returns a list of arguments used in each call to OAuthServiceMock.Introspect.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmIntrospect *mOAuthServiceMockIntrospect) Calls() []*OAuthServiceMockIntrospectParams {
+	mmIntrospect.mutex.RLock()
+
+	argCopy := make([]*OAuthServiceMockIntrospectParams, len(mmIntrospect.callArgs))
+	copy(argCopy, mmIntrospect.callArgs)
+
+	mmIntrospect.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockIntrospectDone returns true if the count of the Introspect invocations corresponds
+// the number of defined expectations
+func (m *OAuthServiceMock) MinimockIntrospectDone() bool {
+	if m.IntrospectMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.IntrospectMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.IntrospectMock.invocationsDone()
+}
+
+// MinimockIntrospectInspect logs each unmet expectation
+func (m *OAuthServiceMock) MinimockIntrospectInspect() {
+	for _, e := range m.IntrospectMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OAuthServiceMock.Introspect at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterIntrospectCounter := mm_atomic.LoadUint64(&m.afterIntrospectCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.IntrospectMock.defaultExpectation != nil && afterIntrospectCounter < 1 {
+		if m.IntrospectMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OAuthServiceMock.Introspect at\n%s", m.IntrospectMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OAuthServiceMock.Introspect at\n%s with params: %#v", m.IntrospectMock.defaultExpectation.expectationOrigins.origin, *m.IntrospectMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcIntrospect != nil && afterIntrospectCounter < 1 {
+		m.t.Errorf("Expected call to OAuthServiceMock.Introspect at\n%s", m.funcIntrospectOrigin)
+	}
+
+	if !m.IntrospectMock.invocationsDone() && afterIntrospectCounter > 0 {
+		m.t.Errorf("Expected %d calls to OAuthServiceMock.Introspect at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.IntrospectMock.expectedInvocations), m.IntrospectMock.expectedInvocationsOrigin, afterIntrospectCounter)
+	}
+}
+
+type mOAuthServiceMockIssueAuthorizationCode struct {
+	optional           bool
+	mock               *OAuthServiceMock
+	defaultExpectation *OAuthServiceMockIssueAuthorizationCodeExpectation
+	expectations       []*OAuthServiceMockIssueAuthorizationCodeExpectation
+
+	callArgs []*OAuthServiceMockIssueAuthorizationCodeParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OAuthServiceMockIssueAuthorizationCodeExpectation specifies expectation struct of the OAuthService.IssueAuthorizationCode
+type OAuthServiceMockIssueAuthorizationCodeExpectation struct {
+	mock               *OAuthServiceMock
+	params             *OAuthServiceMockIssueAuthorizationCodeParams
+	paramPtrs          *OAuthServiceMockIssueAuthorizationCodeParamPtrs
+	expectationOrigins OAuthServiceMockIssueAuthorizationCodeExpectationOrigins
+	results            *OAuthServiceMockIssueAuthorizationCodeResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// OAuthServiceMockIssueAuthorizationCodeParams contains parameters of the OAuthService.IssueAuthorizationCode
+type OAuthServiceMockIssueAuthorizationCodeParams struct {
+	ctx     context.Context
+	request *models.AuthorizationCode
+}
+
+// OAuthServiceMockIssueAuthorizationCodeParamPtrs contains pointers to parameters of the OAuthService.IssueAuthorizationCode
+type OAuthServiceMockIssueAuthorizationCodeParamPtrs struct {
+	ctx     *context.Context
+	request **models.AuthorizationCode
+}
+
+// OAuthServiceMockIssueAuthorizationCodeResults contains results of the OAuthService.IssueAuthorizationCode
+type OAuthServiceMockIssueAuthorizationCodeResults struct {
+	s1  string
+	err error
+}
+
+// OAuthServiceMockIssueAuthorizationCodeOrigins contains origins of expectations of the OAuthService.IssueAuthorizationCode
+type OAuthServiceMockIssueAuthorizationCodeExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originRequest string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmIssueAuthorizationCode *mOAuthServiceMockIssueAuthorizationCode) Optional() *mOAuthServiceMockIssueAuthorizationCode {
+	mmIssueAuthorizationCode.optional = true
+	return mmIssueAuthorizationCode
+}
+
+// Expect sets up expected params for OAuthService.IssueAuthorizationCode
+func (mmIssueAuthorizationCode *mOAuthServiceMockIssueAuthorizationCode) Expect(ctx context.Context, request *models.AuthorizationCode) *mOAuthServiceMockIssueAuthorizationCode {
+	if mmIssueAuthorizationCode.mock.funcIssueAuthorizationCode != nil {
+		mmIssueAuthorizationCode.mock.t.Fatalf("OAuthServiceMock.IssueAuthorizationCode mock is already set by Set")
+	}
+
+	if mmIssueAuthorizationCode.defaultExpectation == nil {
+		mmIssueAuthorizationCode.defaultExpectation = &OAuthServiceMockIssueAuthorizationCodeExpectation{}
+	}
+
+	if mmIssueAuthorizationCode.defaultExpectation.paramPtrs != nil {
+		mmIssueAuthorizationCode.mock.t.Fatalf("OAuthServiceMock.IssueAuthorizationCode mock is already set by ExpectParams functions")
+	}
+
+	mmIssueAuthorizationCode.defaultExpectation.params = &OAuthServiceMockIssueAuthorizationCodeParams{ctx, request}
+	mmIssueAuthorizationCode.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmIssueAuthorizationCode.expectations {
+		if minimock.Equal(e.params, mmIssueAuthorizationCode.defaultExpectation.params) {
+			mmIssueAuthorizationCode.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmIssueAuthorizationCode.defaultExpectation.params)
+		}
+	}
+
+	return mmIssueAuthorizationCode
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OAuthService.IssueAuthorizationCode
+func (mmIssueAuthorizationCode *mOAuthServiceMockIssueAuthorizationCode) ExpectCtxParam1(ctx context.Context) *mOAuthServiceMockIssueAuthorizationCode {
+	if mmIssueAuthorizationCode.mock.funcIssueAuthorizationCode != nil {
+		mmIssueAuthorizationCode.mock.t.Fatalf("OAuthServiceMock.IssueAuthorizationCode mock is already set by Set")
+	}
+
+	if mmIssueAuthorizationCode.defaultExpectation == nil {
+		mmIssueAuthorizationCode.defaultExpectation = &OAuthServiceMockIssueAuthorizationCodeExpectation{}
+	}
+
+	if mmIssueAuthorizationCode.defaultExpectation.params != nil {
+		mmIssueAuthorizationCode.mock.t.Fatalf("OAuthServiceMock.IssueAuthorizationCode mock is already set by Expect")
+	}
+
+	if mmIssueAuthorizationCode.defaultExpectation.paramPtrs == nil {
+		mmIssueAuthorizationCode.defaultExpectation.paramPtrs = &OAuthServiceMockIssueAuthorizationCodeParamPtrs{}
+	}
+	mmIssueAuthorizationCode.defaultExpectation.paramPtrs.ctx = &ctx
+	mmIssueAuthorizationCode.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmIssueAuthorizationCode
+}
+
+// ExpectRequestParam2 sets up expected param request for OAuthService.IssueAuthorizationCode
+func (mmIssueAuthorizationCode *mOAuthServiceMockIssueAuthorizationCode) ExpectRequestParam2(request *models.AuthorizationCode) *mOAuthServiceMockIssueAuthorizationCode {
+	if mmIssueAuthorizationCode.mock.funcIssueAuthorizationCode != nil {
+		mmIssueAuthorizationCode.mock.t.Fatalf("OAuthServiceMock.IssueAuthorizationCode mock is already set by Set")
+	}
+
+	if mmIssueAuthorizationCode.defaultExpectation == nil {
+		mmIssueAuthorizationCode.defaultExpectation = &OAuthServiceMockIssueAuthorizationCodeExpectation{}
+	}
+
+	if mmIssueAuthorizationCode.defaultExpectation.params != nil {
+		mmIssueAuthorizationCode.mock.t.Fatalf("OAuthServiceMock.IssueAuthorizationCode mock is already set by Expect")
+	}
+
+	if mmIssueAuthorizationCode.defaultExpectation.paramPtrs == nil {
+		mmIssueAuthorizationCode.defaultExpectation.paramPtrs = &OAuthServiceMockIssueAuthorizationCodeParamPtrs{}
+	}
+	mmIssueAuthorizationCode.defaultExpectation.paramPtrs.request = &request
+	mmIssueAuthorizationCode.defaultExpectation.expectationOrigins.originRequest = minimock.CallerInfo(1)
+
+	return mmIssueAuthorizationCode
+}
+
+// Inspect accepts an inspector function that has same arguments as the OAuthService.IssueAuthorizationCode
+func (mmIssueAuthorizationCode *mOAuthServiceMockIssueAuthorizationCode) Inspect(f func(ctx context.Context, request *models.AuthorizationCode)) *mOAuthServiceMockIssueAuthorizationCode {
+	if mmIssueAuthorizationCode.mock.inspectFuncIssueAuthorizationCode != nil {
+		mmIssueAuthorizationCode.mock.t.Fatalf("Inspect function is already set for OAuthServiceMock.IssueAuthorizationCode")
+	}
+
+	mmIssueAuthorizationCode.mock.inspectFuncIssueAuthorizationCode = f
+
+	return mmIssueAuthorizationCode
+}
+
+// Return sets up results that will be returned by OAuthService.IssueAuthorizationCode
+func (mmIssueAuthorizationCode *mOAuthServiceMockIssueAuthorizationCode) Return(s1 string, err error) *OAuthServiceMock {
+	if mmIssueAuthorizationCode.mock.funcIssueAuthorizationCode != nil {
+		mmIssueAuthorizationCode.mock.t.Fatalf("OAuthServiceMock.IssueAuthorizationCode mock is already set by Set")
+	}
+
+	if mmIssueAuthorizationCode.defaultExpectation == nil {
+		mmIssueAuthorizationCode.defaultExpectation = &OAuthServiceMockIssueAuthorizationCodeExpectation{mock: mmIssueAuthorizationCode.mock}
+	}
+	mmIssueAuthorizationCode.defaultExpectation.results = &OAuthServiceMockIssueAuthorizationCodeResults{s1, err}
+	mmIssueAuthorizationCode.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmIssueAuthorizationCode.mock
+}
+
+// Set uses given function f to mock the OAuthService.IssueAuthorizationCode method
+func (mmIssueAuthorizationCode *mOAuthServiceMockIssueAuthorizationCode) Set(f func(ctx context.Context, request *models.AuthorizationCode) (s1 string, err error)) *OAuthServiceMock {
+	if mmIssueAuthorizationCode.defaultExpectation != nil {
+		mmIssueAuthorizationCode.mock.t.Fatalf("Default expectation is already set for the OAuthService.IssueAuthorizationCode method")
+	}
+
+	if len(mmIssueAuthorizationCode.expectations) > 0 {
+		mmIssueAuthorizationCode.mock.t.Fatalf("Some expectations are already set for the OAuthService.IssueAuthorizationCode method")
+	}
+
+	mmIssueAuthorizationCode.mock.funcIssueAuthorizationCode = f
+	mmIssueAuthorizationCode.mock.funcIssueAuthorizationCodeOrigin = minimock.CallerInfo(1)
+	return mmIssueAuthorizationCode.mock
+}
+
+// When sets expectation for the OAuthService.IssueAuthorizationCode which will trigger the result defined by the following
+// Then helper
+func (mmIssueAuthorizationCode *mOAuthServiceMockIssueAuthorizationCode) When(ctx context.Context, request *models.AuthorizationCode) *OAuthServiceMockIssueAuthorizationCodeExpectation {
+	if mmIssueAuthorizationCode.mock.funcIssueAuthorizationCode != nil {
+		mmIssueAuthorizationCode.mock.t.Fatalf("OAuthServiceMock.IssueAuthorizationCode mock is already set by Set")
+	}
+
+	expectation := &OAuthServiceMockIssueAuthorizationCodeExpectation{
+		mock:               mmIssueAuthorizationCode.mock,
+		params:             &OAuthServiceMockIssueAuthorizationCodeParams{ctx, request},
+		expectationOrigins: OAuthServiceMockIssueAuthorizationCodeExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmIssueAuthorizationCode.expectations = append(mmIssueAuthorizationCode.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OAuthService.IssueAuthorizationCode return parameters for the expectation previously defined by the When method
+func (e *OAuthServiceMockIssueAuthorizationCodeExpectation) Then(s1 string, err error) *OAuthServiceMock {
+	e.results = &OAuthServiceMockIssueAuthorizationCodeResults{s1, err}
+	return e.mock
+}
+
+// Times sets number of times OAuthService.IssueAuthorizationCode should be invoked
+func (mmIssueAuthorizationCode *mOAuthServiceMockIssueAuthorizationCode) Times(n uint64) *mOAuthServiceMockIssueAuthorizationCode {
+	if n == 0 {
+		mmIssueAuthorizationCode.mock.t.Fatalf("Times of OAuthServiceMock.IssueAuthorizationCode mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmIssueAuthorizationCode.expectedInvocations, n)
+	mmIssueAuthorizationCode.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmIssueAuthorizationCode
+}
+
+func (mmIssueAuthorizationCode *mOAuthServiceMockIssueAuthorizationCode) invocationsDone() bool {
+	if len(mmIssueAuthorizationCode.expectations) == 0 && mmIssueAuthorizationCode.defaultExpectation == nil && mmIssueAuthorizationCode.mock.funcIssueAuthorizationCode == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmIssueAuthorizationCode.mock.afterIssueAuthorizationCodeCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmIssueAuthorizationCode.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// IssueAuthorizationCode implements OAuthService
+func (mmIssueAuthorizationCode *OAuthServiceMock) IssueAuthorizationCode(ctx context.Context, request *models.AuthorizationCode) (s1 string, err error) {
+	mm_atomic.AddUint64(&mmIssueAuthorizationCode.beforeIssueAuthorizationCodeCounter, 1)
+	defer mm_atomic.AddUint64(&mmIssueAuthorizationCode.afterIssueAuthorizationCodeCounter, 1)
+
+	mmIssueAuthorizationCode.t.Helper()
+
+	if mmIssueAuthorizationCode.inspectFuncIssueAuthorizationCode != nil {
+		mmIssueAuthorizationCode.inspectFuncIssueAuthorizationCode(ctx, request)
+	}
+
+	mm_params := OAuthServiceMockIssueAuthorizationCodeParams{ctx, request}
+
+	// Record call args
+	mmIssueAuthorizationCode.IssueAuthorizationCodeMock.mutex.Lock()
+	mmIssueAuthorizationCode.IssueAuthorizationCodeMock.callArgs = append(mmIssueAuthorizationCode.IssueAuthorizationCodeMock.callArgs, &mm_params)
+	mmIssueAuthorizationCode.IssueAuthorizationCodeMock.mutex.Unlock()
+
+	for _, e := range mmIssueAuthorizationCode.IssueAuthorizationCodeMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.s1, e.results.err
+		}
+	}
+
+	if mmIssueAuthorizationCode.IssueAuthorizationCodeMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmIssueAuthorizationCode.IssueAuthorizationCodeMock.defaultExpectation.Counter, 1)
+		mm_want := mmIssueAuthorizationCode.IssueAuthorizationCodeMock.defaultExpectation.params
+		mm_want_ptrs := mmIssueAuthorizationCode.IssueAuthorizationCodeMock.defaultExpectation.paramPtrs
+
+		mm_got := OAuthServiceMockIssueAuthorizationCodeParams{ctx, request}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmIssueAuthorizationCode.t.Errorf("OAuthServiceMock.IssueAuthorizationCode got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmIssueAuthorizationCode.IssueAuthorizationCodeMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.request != nil && !minimock.Equal(*mm_want_ptrs.request, mm_got.request) {
+				mmIssueAuthorizationCode.t.Errorf("OAuthServiceMock.IssueAuthorizationCode got unexpected parameter request, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmIssueAuthorizationCode.IssueAuthorizationCodeMock.defaultExpectation.expectationOrigins.originRequest, *mm_want_ptrs.request, mm_got.request, minimock.Diff(*mm_want_ptrs.request, mm_got.request))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmIssueAuthorizationCode.t.Errorf("OAuthServiceMock.IssueAuthorizationCode got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmIssueAuthorizationCode.IssueAuthorizationCodeMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmIssueAuthorizationCode.IssueAuthorizationCodeMock.defaultExpectation.results
+		if mm_results == nil {
+			mmIssueAuthorizationCode.t.Fatal("No results are set for the OAuthServiceMock.IssueAuthorizationCode")
+		}
+		return (*mm_results).s1, (*mm_results).err
+	}
+	if mmIssueAuthorizationCode.funcIssueAuthorizationCode != nil {
+		return mmIssueAuthorizationCode.funcIssueAuthorizationCode(ctx, request)
+	}
+	mmIssueAuthorizationCode.t.Fatalf("Unexpected call to OAuthServiceMock.IssueAuthorizationCode. %v %v", ctx, request)
+	return
+}
+
+// IssueAuthorizationCodeAfterCounter returns a count of finished OAuthServiceMock.IssueAuthorizationCode invocations
+func (mmIssueAuthorizationCode *OAuthServiceMock) IssueAuthorizationCodeAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmIssueAuthorizationCode.afterIssueAuthorizationCodeCounter)
+}
+
+// IssueAuthorizationCodeBeforeCounter returns a count of OAuthServiceMock.IssueAuthorizationCode invocations
+func (mmIssueAuthorizationCode *OAuthServiceMock) IssueAuthorizationCodeBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmIssueAuthorizationCode.beforeIssueAuthorizationCodeCounter)
+}
+
+// Calls returns a list of arguments used in each call to OAuthServiceMock.IssueAuthorizationCode.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmIssueAuthorizationCode *mOAuthServiceMockIssueAuthorizationCode) Calls() []*OAuthServiceMockIssueAuthorizationCodeParams {
+	mmIssueAuthorizationCode.mutex.RLock()
+
+	argCopy := make([]*OAuthServiceMockIssueAuthorizationCodeParams, len(mmIssueAuthorizationCode.callArgs))
+	copy(argCopy, mmIssueAuthorizationCode.callArgs)
+
+	mmIssueAuthorizationCode.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockIssueAuthorizationCodeDone returns true if the count of the IssueAuthorizationCode invocations corresponds
+// the number of defined expectations
+func (m *OAuthServiceMock) MinimockIssueAuthorizationCodeDone() bool {
+	if m.IssueAuthorizationCodeMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.IssueAuthorizationCodeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.IssueAuthorizationCodeMock.invocationsDone()
+}
+
+// MinimockIssueAuthorizationCodeInspect logs each unmet expectation
+func (m *OAuthServiceMock) MinimockIssueAuthorizationCodeInspect() {
+	for _, e := range m.IssueAuthorizationCodeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OAuthServiceMock.IssueAuthorizationCode at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterIssueAuthorizationCodeCounter := mm_atomic.LoadUint64(&m.afterIssueAuthorizationCodeCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.IssueAuthorizationCodeMock.defaultExpectation != nil && afterIssueAuthorizationCodeCounter < 1 {
+		if m.IssueAuthorizationCodeMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OAuthServiceMock.IssueAuthorizationCode at\n%s", m.IssueAuthorizationCodeMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OAuthServiceMock.IssueAuthorizationCode at\n%s with params: %#v", m.IssueAuthorizationCodeMock.defaultExpectation.expectationOrigins.origin, *m.IssueAuthorizationCodeMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcIssueAuthorizationCode != nil && afterIssueAuthorizationCodeCounter < 1 {
+		m.t.Errorf("Expected call to OAuthServiceMock.IssueAuthorizationCode at\n%s", m.funcIssueAuthorizationCodeOrigin)
+	}
+
+	if !m.IssueAuthorizationCodeMock.invocationsDone() && afterIssueAuthorizationCodeCounter > 0 {
+		m.t.Errorf("Expected %d calls to OAuthServiceMock.IssueAuthorizationCode at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.IssueAuthorizationCodeMock.expectedInvocations), m.IssueAuthorizationCodeMock.expectedInvocationsOrigin, afterIssueAuthorizationCodeCounter)
+	}
+}
+
+type mOAuthServiceMockRevoke struct {
+	optional           bool
+	mock               *OAuthServiceMock
+	defaultExpectation *OAuthServiceMockRevokeExpectation
+	expectations       []*OAuthServiceMockRevokeExpectation
+
+	callArgs []*OAuthServiceMockRevokeParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OAuthServiceMockRevokeExpectation specifies expectation struct of the OAuthService.Revoke
+type OAuthServiceMockRevokeExpectation struct {
+	mock               *OAuthServiceMock
+	params             *OAuthServiceMockRevokeParams
+	paramPtrs          *OAuthServiceMockRevokeParamPtrs
+	expectationOrigins OAuthServiceMockRevokeExpectationOrigins
+	results            *OAuthServiceMockRevokeResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// OAuthServiceMockRevokeParams contains parameters of the OAuthService.Revoke
+type OAuthServiceMockRevokeParams struct {
+	ctx    context.Context
+	client *models.Client
+	token  string
+}
+
+// OAuthServiceMockRevokeParamPtrs contains pointers to parameters of the OAuthService.Revoke
+type OAuthServiceMockRevokeParamPtrs struct {
+	ctx    *context.Context
+	client **models.Client
+	token  *string
+}
+
+// OAuthServiceMockRevokeResults contains results of the OAuthService.Revoke
+type OAuthServiceMockRevokeResults struct {
+	err error
+}
+
+// OAuthServiceMockRevokeOrigins contains origins of expectations of the OAuthService.Revoke
+type OAuthServiceMockRevokeExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originClient string
+	originToken  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRevoke *mOAuthServiceMockRevoke) Optional() *mOAuthServiceMockRevoke {
+	mmRevoke.optional = true
+	return mmRevoke
+}
+
+// Expect sets up expected params for OAuthService.Revoke
+func (mmRevoke *mOAuthServiceMockRevoke) Expect(ctx context.Context, client *models.Client, token string) *mOAuthServiceMockRevoke {
+	if mmRevoke.mock.funcRevoke != nil {
+		mmRevoke.mock.t.Fatalf("OAuthServiceMock.Revoke mock is already set by Set")
+	}
+
+	if mmRevoke.defaultExpectation == nil {
+		mmRevoke.defaultExpectation = &OAuthServiceMockRevokeExpectation{}
+	}
+
+	if mmRevoke.defaultExpectation.paramPtrs != nil {
+		mmRevoke.mock.t.Fatalf("OAuthServiceMock.Revoke mock is already set by ExpectParams functions")
+	}
+
+	mmRevoke.defaultExpectation.params = &OAuthServiceMockRevokeParams{ctx, client, token}
+	mmRevoke.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRevoke.expectations {
+		if minimock.Equal(e.params, mmRevoke.defaultExpectation.params) {
+			mmRevoke.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRevoke.defaultExpectation.params)
+		}
+	}
+
+	return mmRevoke
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OAuthService.Revoke
+func (mmRevoke *mOAuthServiceMockRevoke) ExpectCtxParam1(ctx context.Context) *mOAuthServiceMockRevoke {
+	if mmRevoke.mock.funcRevoke != nil {
+		mmRevoke.mock.t.Fatalf("OAuthServiceMock.Revoke mock is already set by Set")
+	}
+
+	if mmRevoke.defaultExpectation == nil {
+		mmRevoke.defaultExpectation = &OAuthServiceMockRevokeExpectation{}
+	}
+
+	if mmRevoke.defaultExpectation.params != nil {
+		mmRevoke.mock.t.Fatalf("OAuthServiceMock.Revoke mock is already set by Expect")
+	}
+
+	if mmRevoke.defaultExpectation.paramPtrs == nil {
+		mmRevoke.defaultExpectation.paramPtrs = &OAuthServiceMockRevokeParamPtrs{}
+	}
+	mmRevoke.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRevoke.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRevoke
+}
+
+// ExpectClientParam2 sets up expected param client for OAuthService.Revoke
+func (mmRevoke *mOAuthServiceMockRevoke) ExpectClientParam2(client *models.Client) *mOAuthServiceMockRevoke {
+	if mmRevoke.mock.funcRevoke != nil {
+		mmRevoke.mock.t.Fatalf("OAuthServiceMock.Revoke mock is already set by Set")
+	}
+
+	if mmRevoke.defaultExpectation == nil {
+		mmRevoke.defaultExpectation = &OAuthServiceMockRevokeExpectation{}
+	}
+
+	if mmRevoke.defaultExpectation.params != nil {
+		mmRevoke.mock.t.Fatalf("OAuthServiceMock.Revoke mock is already set by Expect")
+	}
+
+	if mmRevoke.defaultExpectation.paramPtrs == nil {
+		mmRevoke.defaultExpectation.paramPtrs = &OAuthServiceMockRevokeParamPtrs{}
+	}
+	mmRevoke.defaultExpectation.paramPtrs.client = &client
+	mmRevoke.defaultExpectation.expectationOrigins.originClient = minimock.CallerInfo(1)
+
+	return mmRevoke
+}
+
+// ExpectTokenParam3 sets up expected param token for OAuthService.Revoke
+func (mmRevoke *mOAuthServiceMockRevoke) ExpectTokenParam3(token string) *mOAuthServiceMockRevoke {
+	if mmRevoke.mock.funcRevoke != nil {
+		mmRevoke.mock.t.Fatalf("OAuthServiceMock.Revoke mock is already set by Set")
+	}
+
+	if mmRevoke.defaultExpectation == nil {
+		mmRevoke.defaultExpectation = &OAuthServiceMockRevokeExpectation{}
+	}
+
+	if mmRevoke.defaultExpectation.params != nil {
+		mmRevoke.mock.t.Fatalf("OAuthServiceMock.Revoke mock is already set by Expect")
+	}
+
+	if mmRevoke.defaultExpectation.paramPtrs == nil {
+		mmRevoke.defaultExpectation.paramPtrs = &OAuthServiceMockRevokeParamPtrs{}
+	}
+	mmRevoke.defaultExpectation.paramPtrs.token = &token
+	mmRevoke.defaultExpectation.expectationOrigins.originToken = minimock.CallerInfo(1)
+
+	return mmRevoke
+}
+
+// Inspect accepts an inspector function that has same arguments as the OAuthService.Revoke
+func (mmRevoke *mOAuthServiceMockRevoke) Inspect(f func(ctx context.Context, client *models.Client, token string)) *mOAuthServiceMockRevoke {
+	if mmRevoke.mock.inspectFuncRevoke != nil {
+		mmRevoke.mock.t.Fatalf("Inspect function is already set for OAuthServiceMock.Revoke")
+	}
+
+	mmRevoke.mock.inspectFuncRevoke = f
+
+	return mmRevoke
+}
+
+// Return sets up results that will be returned by OAuthService.Revoke
+func (mmRevoke *mOAuthServiceMockRevoke) Return(err error) *OAuthServiceMock {
+	if mmRevoke.mock.funcRevoke != nil {
+		mmRevoke.mock.t.Fatalf("OAuthServiceMock.Revoke mock is already set by Set")
+	}
+
+	if mmRevoke.defaultExpectation == nil {
+		mmRevoke.defaultExpectation = &OAuthServiceMockRevokeExpectation{mock: mmRevoke.mock}
+	}
+	mmRevoke.defaultExpectation.results = &OAuthServiceMockRevokeResults{err}
+	mmRevoke.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRevoke.mock
+}
+
+// Set uses given function f to mock the OAuthService.Revoke method
+func (mmRevoke *mOAuthServiceMockRevoke) Set(f func(ctx context.Context, client *models.Client, token string) (err error)) *OAuthServiceMock {
+	if mmRevoke.defaultExpectation != nil {
+		mmRevoke.mock.t.Fatalf("Default expectation is already set for the OAuthService.Revoke method")
+	}
+
+	if len(mmRevoke.expectations) > 0 {
+		mmRevoke.mock.t.Fatalf("Some expectations are already set for the OAuthService.Revoke method")
+	}
+
+	mmRevoke.mock.funcRevoke = f
+	mmRevoke.mock.funcRevokeOrigin = minimock.CallerInfo(1)
+	return mmRevoke.mock
+}
+
+// When sets expectation for the OAuthService.Revoke which will trigger the result defined by the following
+// Then helper
+func (mmRevoke *mOAuthServiceMockRevoke) When(ctx context.Context, client *models.Client, token string) *OAuthServiceMockRevokeExpectation {
+	if mmRevoke.mock.funcRevoke != nil {
+		mmRevoke.mock.t.Fatalf("OAuthServiceMock.Revoke mock is already set by Set")
+	}
+
+	expectation := &OAuthServiceMockRevokeExpectation{
+		mock:               mmRevoke.mock,
+		params:             &OAuthServiceMockRevokeParams{ctx, client, token},
+		expectationOrigins: OAuthServiceMockRevokeExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmRevoke.expectations = append(mmRevoke.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OAuthService.Revoke return parameters for the expectation previously defined by the When method
+func (e *OAuthServiceMockRevokeExpectation) Then(err error) *OAuthServiceMock {
+	e.results = &OAuthServiceMockRevokeResults{err}
+	return e.mock
+}
+
+// Times sets number of times OAuthService.Revoke should be invoked
+func (mmRevoke *mOAuthServiceMockRevoke) Times(n uint64) *mOAuthServiceMockRevoke {
+	if n == 0 {
+		mmRevoke.mock.t.Fatalf("Times of OAuthServiceMock.Revoke mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRevoke.expectedInvocations, n)
+	mmRevoke.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRevoke
+}
+
+func (mmRevoke *mOAuthServiceMockRevoke) invocationsDone() bool {
+	if len(mmRevoke.expectations) == 0 && mmRevoke.defaultExpectation == nil && mmRevoke.mock.funcRevoke == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRevoke.mock.afterRevokeCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRevoke.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Revoke implements OAuthService
+func (mmRevoke *OAuthServiceMock) Revoke(ctx context.Context, client *models.Client, token string) (err error) {
+	mm_atomic.AddUint64(&mmRevoke.beforeRevokeCounter, 1)
+	defer mm_atomic.AddUint64(&mmRevoke.afterRevokeCounter, 1)
+
+	mmRevoke.t.Helper()
+
+	if mmRevoke.inspectFuncRevoke != nil {
+		mmRevoke.inspectFuncRevoke(ctx, client, token)
+	}
+
+	mm_params := OAuthServiceMockRevokeParams{ctx, client, token}
+
+	// Record call args
+	mmRevoke.RevokeMock.mutex.Lock()
+	mmRevoke.RevokeMock.callArgs = append(mmRevoke.RevokeMock.callArgs, &mm_params)
+	mmRevoke.RevokeMock.mutex.Unlock()
+
+	for _, e := range mmRevoke.RevokeMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmRevoke.RevokeMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRevoke.RevokeMock.defaultExpectation.Counter, 1)
+		mm_want := mmRevoke.RevokeMock.defaultExpectation.params
+		mm_want_ptrs := mmRevoke.RevokeMock.defaultExpectation.paramPtrs
+
+		mm_got := OAuthServiceMockRevokeParams{ctx, client, token}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRevoke.t.Errorf("OAuthServiceMock.Revoke got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRevoke.RevokeMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.client != nil && !minimock.Equal(*mm_want_ptrs.client, mm_got.client) {
+				mmRevoke.t.Errorf("OAuthServiceMock.Revoke got unexpected parameter client, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRevoke.RevokeMock.defaultExpectation.expectationOrigins.originClient, *mm_want_ptrs.client, mm_got.client, minimock.Diff(*mm_want_ptrs.client, mm_got.client))
+			}
+
+			if mm_want_ptrs.token != nil && !minimock.Equal(*mm_want_ptrs.token, mm_got.token) {
+				mmRevoke.t.Errorf("OAuthServiceMock.Revoke got unexpected parameter token, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRevoke.RevokeMock.defaultExpectation.expectationOrigins.originToken, *mm_want_ptrs.token, mm_got.token, minimock.Diff(*mm_want_ptrs.token, mm_got.token))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRevoke.t.Errorf("OAuthServiceMock.Revoke got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRevoke.RevokeMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRevoke.RevokeMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRevoke.t.Fatal("No results are set for the OAuthServiceMock.Revoke")
+		}
+		return (*mm_results).err
+	}
+	if mmRevoke.funcRevoke != nil {
+		return mmRevoke.funcRevoke(ctx, client, token)
+	}
+	mmRevoke.t.Fatalf("Unexpected call to OAuthServiceMock.Revoke. %v %v %v", ctx, client, token)
+	return
+}
+
+// RevokeAfterCounter returns a count of finished OAuthServiceMock.Revoke invocations
+func (mmRevoke *OAuthServiceMock) RevokeAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRevoke.afterRevokeCounter)
+}
+
+// RevokeBeforeCounter returns a count of OAuthServiceMock.Revoke invocations
+func (mmRevoke *OAuthServiceMock) RevokeBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRevoke.beforeRevokeCounter)
+}
+
+// Calls returns a list of arguments used in each call to OAuthServiceMock.Revoke.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRevoke *mOAuthServiceMockRevoke) Calls() []*OAuthServiceMockRevokeParams {
+	mmRevoke.mutex.RLock()
+
+	argCopy := make([]*OAuthServiceMockRevokeParams, len(mmRevoke.callArgs))
+	copy(argCopy, mmRevoke.callArgs)
+
+	mmRevoke.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRevokeDone returns true if the count of the Revoke invocations corresponds
+// the number of defined expectations
+func (m *OAuthServiceMock) MinimockRevokeDone() bool {
+	if m.RevokeMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RevokeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RevokeMock.invocationsDone()
+}
+
+// MinimockRevokeInspect logs each unmet expectation
+func (m *OAuthServiceMock) MinimockRevokeInspect() {
+	for _, e := range m.RevokeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OAuthServiceMock.Revoke at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterRevokeCounter := mm_atomic.LoadUint64(&m.afterRevokeCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RevokeMock.defaultExpectation != nil && afterRevokeCounter < 1 {
+		if m.RevokeMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OAuthServiceMock.Revoke at\n%s", m.RevokeMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OAuthServiceMock.Revoke at\n%s with params: %#v", m.RevokeMock.defaultExpectation.expectationOrigins.origin, *m.RevokeMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRevoke != nil && afterRevokeCounter < 1 {
+		m.t.Errorf("Expected call to OAuthServiceMock.Revoke at\n%s", m.funcRevokeOrigin)
+	}
+
+	if !m.RevokeMock.invocationsDone() && afterRevokeCounter > 0 {
+		m.t.Errorf("Expected %d calls to OAuthServiceMock.Revoke at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RevokeMock.expectedInvocations), m.RevokeMock.expectedInvocationsOrigin, afterRevokeCounter)
+	}
+}
+
+type mOAuthServiceMockUserInfo struct {
+	optional           bool
+	mock               *OAuthServiceMock
+	defaultExpectation *OAuthServiceMockUserInfoExpectation
+	expectations       []*OAuthServiceMockUserInfoExpectation
+
+	callArgs []*OAuthServiceMockUserInfoParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OAuthServiceMockUserInfoExpectation specifies expectation struct of the OAuthService.UserInfo
+type OAuthServiceMockUserInfoExpectation struct {
+	mock               *OAuthServiceMock
+	params             *OAuthServiceMockUserInfoParams
+	paramPtrs          *OAuthServiceMockUserInfoParamPtrs
+	expectationOrigins OAuthServiceMockUserInfoExpectationOrigins
+	results            *OAuthServiceMockUserInfoResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// OAuthServiceMockUserInfoParams contains parameters of the OAuthService.UserInfo
+type OAuthServiceMockUserInfoParams struct {
+	ctx         context.Context
+	accessToken string
+}
+
+// OAuthServiceMockUserInfoParamPtrs contains pointers to parameters of the OAuthService.UserInfo
+type OAuthServiceMockUserInfoParamPtrs struct {
+	ctx         *context.Context
+	accessToken *string
+}
+
+// OAuthServiceMockUserInfoResults contains results of the OAuthService.UserInfo
+type OAuthServiceMockUserInfoResults struct {
+	up1 *models.UserInfo
+	err error
+}
+
+// OAuthServiceMockUserInfoOrigins contains origins of expectations of the OAuthService.UserInfo
+type OAuthServiceMockUserInfoExpectationOrigins struct {
+	origin            string
+	originCtx         string
+	originAccessToken string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUserInfo *mOAuthServiceMockUserInfo) Optional() *mOAuthServiceMockUserInfo {
+	mmUserInfo.optional = true
+	return mmUserInfo
+}
+
+// Expect sets up expected params for OAuthService.UserInfo
+func (mmUserInfo *mOAuthServiceMockUserInfo) Expect(ctx context.Context, accessToken string) *mOAuthServiceMockUserInfo {
+	if mmUserInfo.mock.funcUserInfo != nil {
+		mmUserInfo.mock.t.Fatalf("OAuthServiceMock.UserInfo mock is already set by Set")
+	}
+
+	if mmUserInfo.defaultExpectation == nil {
+		mmUserInfo.defaultExpectation = &OAuthServiceMockUserInfoExpectation{}
+	}
+
+	if mmUserInfo.defaultExpectation.paramPtrs != nil {
+		mmUserInfo.mock.t.Fatalf("OAuthServiceMock.UserInfo mock is already set by ExpectParams functions")
+	}
+
+	mmUserInfo.defaultExpectation.params = &OAuthServiceMockUserInfoParams{ctx, accessToken}
+	mmUserInfo.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUserInfo.expectations {
+		if minimock.Equal(e.params, mmUserInfo.defaultExpectation.params) {
+			mmUserInfo.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUserInfo.defaultExpectation.params)
+		}
+	}
+
+	return mmUserInfo
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OAuthService.UserInfo
+func (mmUserInfo *mOAuthServiceMockUserInfo) ExpectCtxParam1(ctx context.Context) *mOAuthServiceMockUserInfo {
+	if mmUserInfo.mock.funcUserInfo != nil {
+		mmUserInfo.mock.t.Fatalf("OAuthServiceMock.UserInfo mock is already set by Set")
+	}
+
+	if mmUserInfo.defaultExpectation == nil {
+		mmUserInfo.defaultExpectation = &OAuthServiceMockUserInfoExpectation{}
+	}
+
+	if mmUserInfo.defaultExpectation.params != nil {
+		mmUserInfo.mock.t.Fatalf("OAuthServiceMock.UserInfo mock is already set by Expect")
+	}
+
+	if mmUserInfo.defaultExpectation.paramPtrs == nil {
+		mmUserInfo.defaultExpectation.paramPtrs = &OAuthServiceMockUserInfoParamPtrs{}
+	}
+	mmUserInfo.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUserInfo.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmUserInfo
+}
+
+// ExpectAccessTokenParam2 sets up expected param accessToken for OAuthService.UserInfo
+func (mmUserInfo *mOAuthServiceMockUserInfo) ExpectAccessTokenParam2(accessToken string) *mOAuthServiceMockUserInfo {
+	if mmUserInfo.mock.funcUserInfo != nil {
+		mmUserInfo.mock.t.Fatalf("OAuthServiceMock.UserInfo mock is already set by Set")
+	}
+
+	if mmUserInfo.defaultExpectation == nil {
+		mmUserInfo.defaultExpectation = &OAuthServiceMockUserInfoExpectation{}
+	}
+
+	if mmUserInfo.defaultExpectation.params != nil {
+		mmUserInfo.mock.t.Fatalf("OAuthServiceMock.UserInfo mock is already set by Expect")
+	}
+
+	if mmUserInfo.defaultExpectation.paramPtrs == nil {
+		mmUserInfo.defaultExpectation.paramPtrs = &OAuthServiceMockUserInfoParamPtrs{}
+	}
+	mmUserInfo.defaultExpectation.paramPtrs.accessToken = &accessToken
+	mmUserInfo.defaultExpectation.expectationOrigins.originAccessToken = minimock.CallerInfo(1)
+
+	return mmUserInfo
+}
+
+// Inspect accepts an inspector function that has same arguments as the OAuthService.UserInfo
+func (mmUserInfo *mOAuthServiceMockUserInfo) Inspect(f func(ctx context.Context, accessToken string)) *mOAuthServiceMockUserInfo {
+	if mmUserInfo.mock.inspectFuncUserInfo != nil {
+		mmUserInfo.mock.t.Fatalf("Inspect function is already set for OAuthServiceMock.UserInfo")
+	}
+
+	mmUserInfo.mock.inspectFuncUserInfo = f
+
+	return mmUserInfo
+}
+
+// Return sets up results that will be returned by OAuthService.UserInfo
+func (mmUserInfo *mOAuthServiceMockUserInfo) Return(up1 *models.UserInfo, err error) *OAuthServiceMock {
+	if mmUserInfo.mock.funcUserInfo != nil {
+		mmUserInfo.mock.t.Fatalf("OAuthServiceMock.UserInfo mock is already set by Set")
+	}
+
+	if mmUserInfo.defaultExpectation == nil {
+		mmUserInfo.defaultExpectation = &OAuthServiceMockUserInfoExpectation{mock: mmUserInfo.mock}
+	}
+	mmUserInfo.defaultExpectation.results = &OAuthServiceMockUserInfoResults{up1, err}
+	mmUserInfo.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmUserInfo.mock
+}
+
+// Set uses given function f to mock the OAuthService.UserInfo method
+func (mmUserInfo *mOAuthServiceMockUserInfo) Set(f func(ctx context.Context, accessToken string) (up1 *models.UserInfo, err error)) *OAuthServiceMock {
+	if mmUserInfo.defaultExpectation != nil {
+		mmUserInfo.mock.t.Fatalf("Default expectation is already set for the OAuthService.UserInfo method")
+	}
+
+	if len(mmUserInfo.expectations) > 0 {
+		mmUserInfo.mock.t.Fatalf("Some expectations are already set for the OAuthService.UserInfo method")
+	}
+
+	mmUserInfo.mock.funcUserInfo = f
+	mmUserInfo.mock.funcUserInfoOrigin = minimock.CallerInfo(1)
+	return mmUserInfo.mock
+}
+
+// When sets expectation for the OAuthService.UserInfo which will trigger the result defined by the following
+// Then helper
+func (mmUserInfo *mOAuthServiceMockUserInfo) When(ctx context.Context, accessToken string) *OAuthServiceMockUserInfoExpectation {
+	if mmUserInfo.mock.funcUserInfo != nil {
+		mmUserInfo.mock.t.Fatalf("OAuthServiceMock.UserInfo mock is already set by Set")
+	}
+
+	expectation := &OAuthServiceMockUserInfoExpectation{
+		mock:               mmUserInfo.mock,
+		params:             &OAuthServiceMockUserInfoParams{ctx, accessToken},
+		expectationOrigins: OAuthServiceMockUserInfoExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmUserInfo.expectations = append(mmUserInfo.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OAuthService.UserInfo return parameters for the expectation previously defined by the When method
+func (e *OAuthServiceMockUserInfoExpectation) Then(up1 *models.UserInfo, err error) *OAuthServiceMock {
+	e.results = &OAuthServiceMockUserInfoResults{up1, err}
+	return e.mock
+}
+
+// Times sets number of times OAuthService.UserInfo should be invoked
+func (mmUserInfo *mOAuthServiceMockUserInfo) Times(n uint64) *mOAuthServiceMockUserInfo {
+	if n == 0 {
+		mmUserInfo.mock.t.Fatalf("Times of OAuthServiceMock.UserInfo mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmUserInfo.expectedInvocations, n)
+	mmUserInfo.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmUserInfo
+}
+
+func (mmUserInfo *mOAuthServiceMockUserInfo) invocationsDone() bool {
+	if len(mmUserInfo.expectations) == 0 && mmUserInfo.defaultExpectation == nil && mmUserInfo.mock.funcUserInfo == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmUserInfo.mock.afterUserInfoCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUserInfo.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// UserInfo implements OAuthService
+func (mmUserInfo *OAuthServiceMock) UserInfo(ctx context.Context, accessToken string) (up1 *models.UserInfo, err error) {
+	mm_atomic.AddUint64(&mmUserInfo.beforeUserInfoCounter, 1)
+	defer mm_atomic.AddUint64(&mmUserInfo.afterUserInfoCounter, 1)
+
+	mmUserInfo.t.Helper()
+
+	if mmUserInfo.inspectFuncUserInfo != nil {
+		mmUserInfo.inspectFuncUserInfo(ctx, accessToken)
+	}
+
+	mm_params := OAuthServiceMockUserInfoParams{ctx, accessToken}
+
+	// Record call args
+	mmUserInfo.UserInfoMock.mutex.Lock()
+	mmUserInfo.UserInfoMock.callArgs = append(mmUserInfo.UserInfoMock.callArgs, &mm_params)
+	mmUserInfo.UserInfoMock.mutex.Unlock()
+
+	for _, e := range mmUserInfo.UserInfoMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.up1, e.results.err
+		}
+	}
+
+	if mmUserInfo.UserInfoMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUserInfo.UserInfoMock.defaultExpectation.Counter, 1)
+		mm_want := mmUserInfo.UserInfoMock.defaultExpectation.params
+		mm_want_ptrs := mmUserInfo.UserInfoMock.defaultExpectation.paramPtrs
+
+		mm_got := OAuthServiceMockUserInfoParams{ctx, accessToken}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmUserInfo.t.Errorf("OAuthServiceMock.UserInfo got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUserInfo.UserInfoMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.accessToken != nil && !minimock.Equal(*mm_want_ptrs.accessToken, mm_got.accessToken) {
+				mmUserInfo.t.Errorf("OAuthServiceMock.UserInfo got unexpected parameter accessToken, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUserInfo.UserInfoMock.defaultExpectation.expectationOrigins.originAccessToken, *mm_want_ptrs.accessToken, mm_got.accessToken, minimock.Diff(*mm_want_ptrs.accessToken, mm_got.accessToken))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUserInfo.t.Errorf("OAuthServiceMock.UserInfo got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmUserInfo.UserInfoMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUserInfo.UserInfoMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUserInfo.t.Fatal("No results are set for the OAuthServiceMock.UserInfo")
+		}
+		return (*mm_results).up1, (*mm_results).err
+	}
+	if mmUserInfo.funcUserInfo != nil {
+		return mmUserInfo.funcUserInfo(ctx, accessToken)
+	}
+	mmUserInfo.t.Fatalf("Unexpected call to OAuthServiceMock.UserInfo. %v %v", ctx, accessToken)
+	return
+}
+
+// UserInfoAfterCounter returns a count of finished OAuthServiceMock.UserInfo invocations
+func (mmUserInfo *OAuthServiceMock) UserInfoAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUserInfo.afterUserInfoCounter)
+}
+
+// UserInfoBeforeCounter returns a count of OAuthServiceMock.UserInfo invocations
+func (mmUserInfo *OAuthServiceMock) UserInfoBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUserInfo.beforeUserInfoCounter)
+}
+
+// Calls returns a list of arguments used in each call to OAuthServiceMock.UserInfo.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUserInfo *mOAuthServiceMockUserInfo) Calls() []*OAuthServiceMockUserInfoParams {
+	mmUserInfo.mutex.RLock()
+
+	argCopy := make([]*OAuthServiceMockUserInfoParams, len(mmUserInfo.callArgs))
+	copy(argCopy, mmUserInfo.callArgs)
+
+	mmUserInfo.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUserInfoDone returns true if the count of the UserInfo invocations corresponds
+// the number of defined expectations
+func (m *OAuthServiceMock) MinimockUserInfoDone() bool {
+	if m.UserInfoMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.UserInfoMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.UserInfoMock.invocationsDone()
+}
+
+// MinimockUserInfoInspect logs each unmet expectation
+func (m *OAuthServiceMock) MinimockUserInfoInspect() {
+	for _, e := range m.UserInfoMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OAuthServiceMock.UserInfo at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterUserInfoCounter := mm_atomic.LoadUint64(&m.afterUserInfoCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UserInfoMock.defaultExpectation != nil && afterUserInfoCounter < 1 {
+		if m.UserInfoMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OAuthServiceMock.UserInfo at\n%s", m.UserInfoMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OAuthServiceMock.UserInfo at\n%s with params: %#v", m.UserInfoMock.defaultExpectation.expectationOrigins.origin, *m.UserInfoMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUserInfo != nil && afterUserInfoCounter < 1 {
+		m.t.Errorf("Expected call to OAuthServiceMock.UserInfo at\n%s", m.funcUserInfoOrigin)
+	}
+
+	if !m.UserInfoMock.invocationsDone() && afterUserInfoCounter > 0 {
+		m.t.Errorf("Expected %d calls to OAuthServiceMock.UserInfo at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.UserInfoMock.expectedInvocations), m.UserInfoMock.expectedInvocationsOrigin, afterUserInfoCounter)
+	}
+}
+
+type mOAuthServiceMockValidateLogout struct {
+	optional           bool
+	mock               *OAuthServiceMock
+	defaultExpectation *OAuthServiceMockValidateLogoutExpectation
+	expectations       []*OAuthServiceMockValidateLogoutExpectation
+
+	callArgs []*OAuthServiceMockValidateLogoutParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OAuthServiceMockValidateLogoutExpectation specifies expectation struct of the OAuthService.ValidateLogout
+type OAuthServiceMockValidateLogoutExpectation struct {
+	mock               *OAuthServiceMock
+	params             *OAuthServiceMockValidateLogoutParams
+	paramPtrs          *OAuthServiceMockValidateLogoutParamPtrs
+	expectationOrigins OAuthServiceMockValidateLogoutExpectationOrigins
+	results            *OAuthServiceMockValidateLogoutResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// OAuthServiceMockValidateLogoutParams contains parameters of the OAuthService.ValidateLogout
+type OAuthServiceMockValidateLogoutParams struct {
+	ctx                   context.Context
+	idTokenHint           string
+	clientID              string
+	postLogoutRedirectURI string
+}
+
+// OAuthServiceMockValidateLogoutParamPtrs contains pointers to parameters of the OAuthService.ValidateLogout
+type OAuthServiceMockValidateLogoutParamPtrs struct {
+	ctx                   *context.Context
+	idTokenHint           *string
+	clientID              *string
+	postLogoutRedirectURI *string
+}
+
+// OAuthServiceMockValidateLogoutResults contains results of the OAuthService.ValidateLogout
+type OAuthServiceMockValidateLogoutResults struct {
+	err error
+}
+
+// OAuthServiceMockValidateLogoutOrigins contains origins of expectations of the OAuthService.ValidateLogout
+type OAuthServiceMockValidateLogoutExpectationOrigins struct {
+	origin                      string
+	originCtx                   string
+	originIdTokenHint           string
+	originClientID              string
+	originPostLogoutRedirectURI string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmValidateLogout *mOAuthServiceMockValidateLogout) Optional() *mOAuthServiceMockValidateLogout {
+	mmValidateLogout.optional = true
+	return mmValidateLogout
+}
+
+// Expect sets up expected params for OAuthService.ValidateLogout
+func (mmValidateLogout *mOAuthServiceMockValidateLogout) Expect(ctx context.Context, idTokenHint string, clientID string, postLogoutRedirectURI string) *mOAuthServiceMockValidateLogout {
+	if mmValidateLogout.mock.funcValidateLogout != nil {
+		mmValidateLogout.mock.t.Fatalf("OAuthServiceMock.ValidateLogout mock is already set by Set")
+	}
+
+	if mmValidateLogout.defaultExpectation == nil {
+		mmValidateLogout.defaultExpectation = &OAuthServiceMockValidateLogoutExpectation{}
+	}
+
+	if mmValidateLogout.defaultExpectation.paramPtrs != nil {
+		mmValidateLogout.mock.t.Fatalf("OAuthServiceMock.ValidateLogout mock is already set by ExpectParams functions")
+	}
+
+	mmValidateLogout.defaultExpectation.params = &OAuthServiceMockValidateLogoutParams{ctx, idTokenHint, clientID, postLogoutRedirectURI}
+	mmValidateLogout.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmValidateLogout.expectations {
+		if minimock.Equal(e.params, mmValidateLogout.defaultExpectation.params) {
+			mmValidateLogout.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmValidateLogout.defaultExpectation.params)
+		}
+	}
+
+	return mmValidateLogout
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OAuthService.ValidateLogout
+func (mmValidateLogout *mOAuthServiceMockValidateLogout) ExpectCtxParam1(ctx context.Context) *mOAuthServiceMockValidateLogout {
+	if mmValidateLogout.mock.funcValidateLogout != nil {
+		mmValidateLogout.mock.t.Fatalf("OAuthServiceMock.ValidateLogout mock is already set by Set")
+	}
+
+	if mmValidateLogout.defaultExpectation == nil {
+		mmValidateLogout.defaultExpectation = &OAuthServiceMockValidateLogoutExpectation{}
+	}
+
+	if mmValidateLogout.defaultExpectation.params != nil {
+		mmValidateLogout.mock.t.Fatalf("OAuthServiceMock.ValidateLogout mock is already set by Expect")
+	}
+
+	if mmValidateLogout.defaultExpectation.paramPtrs == nil {
+		mmValidateLogout.defaultExpectation.paramPtrs = &OAuthServiceMockValidateLogoutParamPtrs{}
+	}
+	mmValidateLogout.defaultExpectation.paramPtrs.ctx = &ctx
+	mmValidateLogout.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmValidateLogout
+}
+
+// ExpectIdTokenHintParam2 sets up expected param idTokenHint for OAuthService.ValidateLogout
+func (mmValidateLogout *mOAuthServiceMockValidateLogout) ExpectIdTokenHintParam2(idTokenHint string) *mOAuthServiceMockValidateLogout {
+	if mmValidateLogout.mock.funcValidateLogout != nil {
+		mmValidateLogout.mock.t.Fatalf("OAuthServiceMock.ValidateLogout mock is already set by Set")
+	}
+
+	if mmValidateLogout.defaultExpectation == nil {
+		mmValidateLogout.defaultExpectation = &OAuthServiceMockValidateLogoutExpectation{}
+	}
+
+	if mmValidateLogout.defaultExpectation.params != nil {
+		mmValidateLogout.mock.t.Fatalf("OAuthServiceMock.ValidateLogout mock is already set by Expect")
+	}
+
+	if mmValidateLogout.defaultExpectation.paramPtrs == nil {
+		mmValidateLogout.defaultExpectation.paramPtrs = &OAuthServiceMockValidateLogoutParamPtrs{}
+	}
+	mmValidateLogout.defaultExpectation.paramPtrs.idTokenHint = &idTokenHint
+	mmValidateLogout.defaultExpectation.expectationOrigins.originIdTokenHint = minimock.CallerInfo(1)
+
+	return mmValidateLogout
+}
+
+// ExpectClientIDParam3 sets up expected param clientID for OAuthService.ValidateLogout
+func (mmValidateLogout *mOAuthServiceMockValidateLogout) ExpectClientIDParam3(clientID string) *mOAuthServiceMockValidateLogout {
+	if mmValidateLogout.mock.funcValidateLogout != nil {
+		mmValidateLogout.mock.t.Fatalf("OAuthServiceMock.ValidateLogout mock is already set by Set")
+	}
+
+	if mmValidateLogout.defaultExpectation == nil {
+		mmValidateLogout.defaultExpectation = &OAuthServiceMockValidateLogoutExpectation{}
+	}
+
+	if mmValidateLogout.defaultExpectation.params != nil {
+		mmValidateLogout.mock.t.Fatalf("OAuthServiceMock.ValidateLogout mock is already set by Expect")
+	}
+
+	if mmValidateLogout.defaultExpectation.paramPtrs == nil {
+		mmValidateLogout.defaultExpectation.paramPtrs = &OAuthServiceMockValidateLogoutParamPtrs{}
+	}
+	mmValidateLogout.defaultExpectation.paramPtrs.clientID = &clientID
+	mmValidateLogout.defaultExpectation.expectationOrigins.originClientID = minimock.CallerInfo(1)
+
+	return mmValidateLogout
+}
+
+// ExpectPostLogoutRedirectURIParam4 sets up expected param postLogoutRedirectURI for OAuthService.ValidateLogout
+func (mmValidateLogout *mOAuthServiceMockValidateLogout) ExpectPostLogoutRedirectURIParam4(postLogoutRedirectURI string) *mOAuthServiceMockValidateLogout {
+	if mmValidateLogout.mock.funcValidateLogout != nil {
+		mmValidateLogout.mock.t.Fatalf("OAuthServiceMock.ValidateLogout mock is already set by Set")
+	}
+
+	if mmValidateLogout.defaultExpectation == nil {
+		mmValidateLogout.defaultExpectation = &OAuthServiceMockValidateLogoutExpectation{}
+	}
+
+	if mmValidateLogout.defaultExpectation.params != nil {
+		mmValidateLogout.mock.t.Fatalf("OAuthServiceMock.ValidateLogout mock is already set by Expect")
+	}
+
+	if mmValidateLogout.defaultExpectation.paramPtrs == nil {
+		mmValidateLogout.defaultExpectation.paramPtrs = &OAuthServiceMockValidateLogoutParamPtrs{}
+	}
+	mmValidateLogout.defaultExpectation.paramPtrs.postLogoutRedirectURI = &postLogoutRedirectURI
+	mmValidateLogout.defaultExpectation.expectationOrigins.originPostLogoutRedirectURI = minimock.CallerInfo(1)
+
+	return mmValidateLogout
+}
+
+// Inspect accepts an inspector function that has same arguments as the OAuthService.ValidateLogout
+func (mmValidateLogout *mOAuthServiceMockValidateLogout) Inspect(f func(ctx context.Context, idTokenHint string, clientID string, postLogoutRedirectURI string)) *mOAuthServiceMockValidateLogout {
+	if mmValidateLogout.mock.inspectFuncValidateLogout != nil {
+		mmValidateLogout.mock.t.Fatalf("Inspect function is already set for OAuthServiceMock.ValidateLogout")
+	}
+
+	mmValidateLogout.mock.inspectFuncValidateLogout = f
+
+	return mmValidateLogout
+}
+
+// Return sets up results that will be returned by OAuthService.ValidateLogout
+func (mmValidateLogout *mOAuthServiceMockValidateLogout) Return(err error) *OAuthServiceMock {
+	if mmValidateLogout.mock.funcValidateLogout != nil {
+		mmValidateLogout.mock.t.Fatalf("OAuthServiceMock.ValidateLogout mock is already set by Set")
+	}
+
+	if mmValidateLogout.defaultExpectation == nil {
+		mmValidateLogout.defaultExpectation = &OAuthServiceMockValidateLogoutExpectation{mock: mmValidateLogout.mock}
+	}
+	mmValidateLogout.defaultExpectation.results = &OAuthServiceMockValidateLogoutResults{err}
+	mmValidateLogout.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmValidateLogout.mock
+}
+
+// Set uses given function f to mock the OAuthService.ValidateLogout method
+func (mmValidateLogout *mOAuthServiceMockValidateLogout) Set(f func(ctx context.Context, idTokenHint string, clientID string, postLogoutRedirectURI string) (err error)) *OAuthServiceMock {
+	if mmValidateLogout.defaultExpectation != nil {
+		mmValidateLogout.mock.t.Fatalf("Default expectation is already set for the OAuthService.ValidateLogout method")
+	}
+
+	if len(mmValidateLogout.expectations) > 0 {
+		mmValidateLogout.mock.t.Fatalf("Some expectations are already set for the OAuthService.ValidateLogout method")
+	}
+
+	mmValidateLogout.mock.funcValidateLogout = f
+	mmValidateLogout.mock.funcValidateLogoutOrigin = minimock.CallerInfo(1)
+	return mmValidateLogout.mock
+}
+
+// When sets expectation for the OAuthService.ValidateLogout which will trigger the result defined by the following
+// Then helper
+func (mmValidateLogout *mOAuthServiceMockValidateLogout) When(ctx context.Context, idTokenHint string, clientID string, postLogoutRedirectURI string) *OAuthServiceMockValidateLogoutExpectation {
+	if mmValidateLogout.mock.funcValidateLogout != nil {
+		mmValidateLogout.mock.t.Fatalf("OAuthServiceMock.ValidateLogout mock is already set by Set")
+	}
+
+	expectation := &OAuthServiceMockValidateLogoutExpectation{
+		mock:               mmValidateLogout.mock,
+		params:             &OAuthServiceMockValidateLogoutParams{ctx, idTokenHint, clientID, postLogoutRedirectURI},
+		expectationOrigins: OAuthServiceMockValidateLogoutExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmValidateLogout.expectations = append(mmValidateLogout.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OAuthService.ValidateLogout return parameters for the expectation previously defined by the When method
+func (e *OAuthServiceMockValidateLogoutExpectation) Then(err error) *OAuthServiceMock {
+	e.results = &OAuthServiceMockValidateLogoutResults{err}
+	return e.mock
+}
+
+// Times sets number of times OAuthService.ValidateLogout should be invoked
+func (mmValidateLogout *mOAuthServiceMockValidateLogout) Times(n uint64) *mOAuthServiceMockValidateLogout {
+	if n == 0 {
+		mmValidateLogout.mock.t.Fatalf("Times of OAuthServiceMock.ValidateLogout mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmValidateLogout.expectedInvocations, n)
+	mmValidateLogout.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmValidateLogout
+}
+
+func (mmValidateLogout *mOAuthServiceMockValidateLogout) invocationsDone() bool {
+	if len(mmValidateLogout.expectations) == 0 && mmValidateLogout.defaultExpectation == nil && mmValidateLogout.mock.funcValidateLogout == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmValidateLogout.mock.afterValidateLogoutCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmValidateLogout.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ValidateLogout implements OAuthService
+func (mmValidateLogout *OAuthServiceMock) ValidateLogout(ctx context.Context, idTokenHint string, clientID string, postLogoutRedirectURI string) (err error) {
+	mm_atomic.AddUint64(&mmValidateLogout.beforeValidateLogoutCounter, 1)
+	defer mm_atomic.AddUint64(&mmValidateLogout.afterValidateLogoutCounter, 1)
+
+	mmValidateLogout.t.Helper()
+
+	if mmValidateLogout.inspectFuncValidateLogout != nil {
+		mmValidateLogout.inspectFuncValidateLogout(ctx, idTokenHint, clientID, postLogoutRedirectURI)
+	}
+
+	mm_params := OAuthServiceMockValidateLogoutParams{ctx, idTokenHint, clientID, postLogoutRedirectURI}
+
+	// Record call args
+	mmValidateLogout.ValidateLogoutMock.mutex.Lock()
+	mmValidateLogout.ValidateLogoutMock.callArgs = append(mmValidateLogout.ValidateLogoutMock.callArgs, &mm_params)
+	mmValidateLogout.ValidateLogoutMock.mutex.Unlock()
+
+	for _, e := range mmValidateLogout.ValidateLogoutMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmValidateLogout.ValidateLogoutMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmValidateLogout.ValidateLogoutMock.defaultExpectation.Counter, 1)
+		mm_want := mmValidateLogout.ValidateLogoutMock.defaultExpectation.params
+		mm_want_ptrs := mmValidateLogout.ValidateLogoutMock.defaultExpectation.paramPtrs
+
+		mm_got := OAuthServiceMockValidateLogoutParams{ctx, idTokenHint, clientID, postLogoutRedirectURI}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmValidateLogout.t.Errorf("OAuthServiceMock.ValidateLogout got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmValidateLogout.ValidateLogoutMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.idTokenHint != nil && !minimock.Equal(*mm_want_ptrs.idTokenHint, mm_got.idTokenHint) {
+				mmValidateLogout.t.Errorf("OAuthServiceMock.ValidateLogout got unexpected parameter idTokenHint, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmValidateLogout.ValidateLogoutMock.defaultExpectation.expectationOrigins.originIdTokenHint, *mm_want_ptrs.idTokenHint, mm_got.idTokenHint, minimock.Diff(*mm_want_ptrs.idTokenHint, mm_got.idTokenHint))
+			}
+
+			if mm_want_ptrs.clientID != nil && !minimock.Equal(*mm_want_ptrs.clientID, mm_got.clientID) {
+				mmValidateLogout.t.Errorf("OAuthServiceMock.ValidateLogout got unexpected parameter clientID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmValidateLogout.ValidateLogoutMock.defaultExpectation.expectationOrigins.originClientID, *mm_want_ptrs.clientID, mm_got.clientID, minimock.Diff(*mm_want_ptrs.clientID, mm_got.clientID))
+			}
+
+			if mm_want_ptrs.postLogoutRedirectURI != nil && !minimock.Equal(*mm_want_ptrs.postLogoutRedirectURI, mm_got.postLogoutRedirectURI) {
+				mmValidateLogout.t.Errorf("OAuthServiceMock.ValidateLogout got unexpected parameter postLogoutRedirectURI, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmValidateLogout.ValidateLogoutMock.defaultExpectation.expectationOrigins.originPostLogoutRedirectURI, *mm_want_ptrs.postLogoutRedirectURI, mm_got.postLogoutRedirectURI, minimock.Diff(*mm_want_ptrs.postLogoutRedirectURI, mm_got.postLogoutRedirectURI))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmValidateLogout.t.Errorf("OAuthServiceMock.ValidateLogout got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmValidateLogout.ValidateLogoutMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmValidateLogout.ValidateLogoutMock.defaultExpectation.results
+		if mm_results == nil {
+			mmValidateLogout.t.Fatal("No results are set for the OAuthServiceMock.ValidateLogout")
+		}
+		return (*mm_results).err
+	}
+	if mmValidateLogout.funcValidateLogout != nil {
+		return mmValidateLogout.funcValidateLogout(ctx, idTokenHint, clientID, postLogoutRedirectURI)
+	}
+	mmValidateLogout.t.Fatalf("Unexpected call to OAuthServiceMock.ValidateLogout. %v %v %v %v", ctx, idTokenHint, clientID, postLogoutRedirectURI)
+	return
+}
+
+// ValidateLogoutAfterCounter returns a count of finished OAuthServiceMock.ValidateLogout invocations
+func (mmValidateLogout *OAuthServiceMock) ValidateLogoutAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmValidateLogout.afterValidateLogoutCounter)
+}
+
+// ValidateLogoutBeforeCounter returns a count of OAuthServiceMock.ValidateLogout invocations
+func (mmValidateLogout *OAuthServiceMock) ValidateLogoutBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmValidateLogout.beforeValidateLogoutCounter)
+}
+
+// Calls returns a list of arguments used in each call to OAuthServiceMock.ValidateLogout.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmValidateLogout *mOAuthServiceMockValidateLogout) Calls() []*OAuthServiceMockValidateLogoutParams {
+	mmValidateLogout.mutex.RLock()
+
+	argCopy := make([]*OAuthServiceMockValidateLogoutParams, len(mmValidateLogout.callArgs))
+	copy(argCopy, mmValidateLogout.callArgs)
+
+	mmValidateLogout.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockValidateLogoutDone returns true if the count of the ValidateLogout invocations corresponds
+// the number of defined expectations
+func (m *OAuthServiceMock) MinimockValidateLogoutDone() bool {
+	if m.ValidateLogoutMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ValidateLogoutMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ValidateLogoutMock.invocationsDone()
+}
+
+// MinimockValidateLogoutInspect logs each unmet expectation
+func (m *OAuthServiceMock) MinimockValidateLogoutInspect() {
+	for _, e := range m.ValidateLogoutMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OAuthServiceMock.ValidateLogout at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterValidateLogoutCounter := mm_atomic.LoadUint64(&m.afterValidateLogoutCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ValidateLogoutMock.defaultExpectation != nil && afterValidateLogoutCounter < 1 {
+		if m.ValidateLogoutMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OAuthServiceMock.ValidateLogout at\n%s", m.ValidateLogoutMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OAuthServiceMock.ValidateLogout at\n%s with params: %#v", m.ValidateLogoutMock.defaultExpectation.expectationOrigins.origin, *m.ValidateLogoutMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcValidateLogout != nil && afterValidateLogoutCounter < 1 {
+		m.t.Errorf("Expected call to OAuthServiceMock.ValidateLogout at\n%s", m.funcValidateLogoutOrigin)
+	}
+
+	if !m.ValidateLogoutMock.invocationsDone() && afterValidateLogoutCounter > 0 {
+		m.t.Errorf("Expected %d calls to OAuthServiceMock.ValidateLogout at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ValidateLogoutMock.expectedInvocations), m.ValidateLogoutMock.expectedInvocationsOrigin, afterValidateLogoutCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *OAuthServiceMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockAuthenticateClientInspect()
+
+			m.MinimockClientCredentialsInspect()
+
+			m.MinimockEndSessionInspect()
+
+			m.MinimockExchangeAuthorizationCodeInspect()
+
+			m.MinimockFindClientInspect()
+
+			m.MinimockGrantConsentInspect()
+
+			m.MinimockHasConsentInspect()
+
+			m.MinimockIntrospectInspect()
+
+			m.MinimockIssueAuthorizationCodeInspect()
+
+			m.MinimockRevokeInspect()
+
+			m.MinimockUserInfoInspect()
+
+			m.MinimockValidateLogoutInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *OAuthServiceMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *OAuthServiceMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockAuthenticateClientDone() &&
+		m.MinimockClientCredentialsDone() &&
+		m.MinimockEndSessionDone() &&
+		m.MinimockExchangeAuthorizationCodeDone() &&
+		m.MinimockFindClientDone() &&
+		m.MinimockGrantConsentDone() &&
+		m.MinimockHasConsentDone() &&
+		m.MinimockIntrospectDone() &&
+		m.MinimockIssueAuthorizationCodeDone() &&
+		m.MinimockRevokeDone() &&
+		m.MinimockUserInfoDone() &&
+		m.MinimockValidateLogoutDone()
+}
@@ -0,0 +1,174 @@
+package handlers_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alonsoF100/authorization-service/internal/apperrors"
+	"github.com/alonsoF100/authorization-service/internal/models"
+	"github.com/alonsoF100/authorization-service/internal/transport/http/dto"
+	"github.com/alonsoF100/authorization-service/internal/transport/http/handlers"
+	"github.com/gojuno/minimock/v3"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+)
+
+func newFormRequest(target string, form url.Values) *http.Request {
+	req := httptest.NewRequest(http.MethodPost, target, strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	return req
+}
+
+func TestIntrospect(t *testing.T) {
+	ctx := context.Background()
+	client := &models.Client{ID: "client123"}
+	now := time.Now()
+	claims := &models.Claims{
+		ID:       "user123",
+		Nickname: "alonso",
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   "user123",
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(time.Hour)),
+		},
+	}
+
+	tests := []struct {
+		name       string
+		setup      func(req *http.Request)
+		form       url.Values
+		mockSetup  func(mock *handlers.OAuthServiceMock)
+		wantStatus int
+		wantBody   map[string]any
+	}{
+		{
+			name:  "active token with basic auth",
+			setup: func(req *http.Request) { req.SetBasicAuth("client123", "secret") },
+			form:  url.Values{"token": {"token"}},
+			mockSetup: func(mock *handlers.OAuthServiceMock) {
+				mock.AuthenticateClientMock.Expect(ctx, "client123", "secret").Return(client, nil)
+				mock.IntrospectMock.Expect(ctx, "token").Return(claims, nil)
+			},
+			wantStatus: http.StatusOK,
+			wantBody: map[string]any{
+				"active":     true,
+				"sub":        "user123",
+				"username":   "alonso",
+				"token_type": "Bearer",
+				"iat":        float64(now.Unix()),
+				"exp":        float64(now.Add(time.Hour).Unix()),
+			},
+		},
+		{
+			name:  "inactive token with form credentials",
+			setup: func(req *http.Request) {},
+			form:  url.Values{"token": {"token"}, "client_id": {"client123"}, "client_secret": {"secret"}},
+			mockSetup: func(mock *handlers.OAuthServiceMock) {
+				mock.AuthenticateClientMock.Expect(ctx, "client123", "secret").Return(client, nil)
+				mock.IntrospectMock.Expect(ctx, "token").Return(nil, apperrors.ErrInvalidToken)
+			},
+			wantStatus: http.StatusOK,
+			wantBody:   map[string]any{"active": false},
+		},
+		{
+			name:  "invalid client",
+			setup: func(req *http.Request) { req.SetBasicAuth("client123", "wrong") },
+			form:  url.Values{"token": {"token"}},
+			mockSetup: func(mock *handlers.OAuthServiceMock) {
+				mock.AuthenticateClientMock.Return(nil, apperrors.ErrInvalidClient)
+			},
+			wantStatus: http.StatusUnauthorized,
+			wantBody:   map[string]any{"error": "invalid_client"},
+		},
+		{
+			name:  "missing token",
+			setup: func(req *http.Request) { req.SetBasicAuth("client123", "secret") },
+			form:  url.Values{},
+			mockSetup: func(mock *handlers.OAuthServiceMock) {
+				mock.AuthenticateClientMock.Return(client, nil)
+			},
+			wantStatus: http.StatusBadRequest,
+			wantBody:   map[string]any{"error": "invalid_request", "error_description": "token is required"},
+		},
+		{
+			name:  "service error",
+			setup: func(req *http.Request) { req.SetBasicAuth("client123", "secret") },
+			form:  url.Values{"token": {"token"}},
+			mockSetup: func(mock *handlers.OAuthServiceMock) {
+				mock.AuthenticateClientMock.Return(client, nil)
+				mock.IntrospectMock.Return(nil, errors.New("database error"))
+			},
+			wantStatus: http.StatusInternalServerError,
+			wantBody:   map[string]any{"error": "server_error"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := minimock.NewController(t)
+			mockService := handlers.NewOAuthServiceMock(mc)
+			tt.mockSetup(mockService)
+			h := handlers.New(nil, nil, mockService, nil, nil, nil)
+
+			req := newFormRequest("/oauth/introspect", tt.form)
+			tt.setup(req)
+			rr := httptest.NewRecorder()
+
+			h.Introspect(rr, req)
+
+			require.Equal(t, tt.wantStatus, rr.Code)
+			require.Equal(t, "no-store", rr.Header().Get("Cache-Control"))
+			if tt.wantStatus == http.StatusUnauthorized {
+				require.NotEmpty(t, rr.Header().Get("WWW-Authenticate"))
+			}
+
+			var body map[string]any
+			require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &body))
+			require.Equal(t, tt.wantBody, body)
+		})
+	}
+}
+
+func TestRevoke(t *testing.T) {
+	ctx := context.Background()
+	client := &models.Client{ID: "client123"}
+
+	t.Run("revokes the token", func(t *testing.T) {
+		mc := minimock.NewController(t)
+		mockService := handlers.NewOAuthServiceMock(mc)
+		mockService.AuthenticateClientMock.Expect(ctx, "client123", "secret").Return(client, nil)
+		mockService.RevokeMock.Expect(ctx, client, "token").Return(nil)
+		h := handlers.New(nil, nil, mockService, nil, nil, nil)
+
+		req := newFormRequest("/oauth/revoke", url.Values{"token": {"token"}})
+		req.SetBasicAuth("client123", "secret")
+		rr := httptest.NewRecorder()
+
+		h.Revoke(rr, req)
+
+		require.Equal(t, http.StatusOK, rr.Code)
+	})
+
+	t.Run("invalid client", func(t *testing.T) {
+		mc := minimock.NewController(t)
+		mockService := handlers.NewOAuthServiceMock(mc)
+		mockService.AuthenticateClientMock.Expect(ctx, "", "").Return(nil, apperrors.ErrInvalidClient)
+		h := handlers.New(nil, nil, mockService, nil, nil, nil)
+
+		rr := httptest.NewRecorder()
+		h.Revoke(rr, newFormRequest("/oauth/revoke", url.Values{"token": {"token"}}))
+
+		require.Equal(t, http.StatusUnauthorized, rr.Code)
+
+		var body dto.OAuthErrorResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &body))
+		require.Equal(t, "invalid_client", body.Error)
+	})
+}
@@ -0,0 +1,219 @@
+package handlers
+
+import (
+	"errors"
+	"html/template"
+	"log/slog"
+	"net/http"
+	"net/url"
+
+	"github.com/alonsoF100/authorization-service/internal/apperrors"
+	"github.com/alonsoF100/authorization-service/internal/models"
+	"github.com/alonsoF100/authorization-service/internal/transport/http/dto"
+	"github.com/alonsoF100/authorization-service/internal/transport/http/help"
+	"github.com/alonsoF100/authorization-service/internal/transport/http/middleware"
+)
+
+var logoutTemplate = template.Must(template.ParseFS(templates, "templates/logout.html"))
+
+// logoutParams are carried through the confirmation form
+var logoutParams = []string{
+	"id_token_hint",
+	"client_id",
+	"post_logout_redirect_uri",
+	"state",
+}
+
+type logoutPage struct {
+	Params map[string]string
+	Error  string
+	Done   bool
+}
+
+/*
+pattern: /.well-known/openid-configuration
+method: GET
+info: OpenID provider metadata (OpenID Connect Discovery 1.0), endpoints are
+built from oauth.issuer
+
+succeed:
+
+	-status code: 200 ok
+	-response body: JSON provider metadata
+*/
+func (h Handler) Discovery(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Cache-Control", "public, max-age=3600")
+
+	help.WriteJSON(w, http.StatusOK, dto.NewDiscoveryResponse(
+		h.Cfg.OAuth.Issuer,
+		[]string{models.GrantAuthorizationCode, models.GrantClientCredentials},
+	))
+}
+
+/*
+pattern: /.well-known/jwks.json
+method: GET
+info: public keys that verify ID tokens and ES256 access tokens (RFC 7517),
+retired keys are left out
+
+succeed:
+
+	-status code: 200 ok
+	-response body: JSON key set, empty without a signing key
+*/
+func (h Handler) JWKS(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Cache-Control", "public, max-age=300")
+
+	help.WriteJSON(w, http.StatusOK, dto.NewJWKSResponse(h.AuthService.PublicKeys()))
+}
+
+/*
+pattern: /oauth/userinfo
+method: GET, POST
+info: OpenID Connect userinfo endpoint, bearer access token with the openid
+scope, profile adds nickname, email adds email and email_verified
+
+succeed:
+
+	-status code: 200 ok
+	-response body: JSON with sub and the claims of the granted scopes
+
+failed:
+
+	-status code: 401 unauthorized, 403 forbidden, 500 internal server error
+	-response headers: WWW-Authenticate with the bearer error (RFC 6750)
+	-response body: JSON with OAuth error code
+*/
+func (h Handler) UserInfo(w http.ResponseWriter, r *http.Request) {
+	const op = "handlers/oidc.go/UserInfo"
+
+	w.Header().Set("Cache-Control", "no-store")
+
+	token := middleware.ExtractToken(r)
+	if token == "" {
+		w.Header().Set("WWW-Authenticate", `Bearer realm="oauth"`)
+		help.WriteJSON(w, http.StatusUnauthorized, dto.NewOAuthErrorResponse("invalid_request", "bearer token is required"))
+		return
+	}
+
+	info, err := h.OAuthService.UserInfo(r.Context(), token)
+	if err != nil {
+		switch {
+		case errors.Is(err, apperrors.ErrInvalidToken):
+			w.Header().Set("WWW-Authenticate", `Bearer realm="oauth", error="invalid_token"`)
+			help.WriteJSON(w, http.StatusUnauthorized, dto.NewOAuthErrorResponse("invalid_token", ""))
+		case errors.Is(err, apperrors.ErrMissingScope):
+			w.Header().Set("WWW-Authenticate", `Bearer realm="oauth", error="insufficient_scope", scope="openid"`)
+			help.WriteJSON(w, http.StatusForbidden, dto.NewOAuthErrorResponse("insufficient_scope", err.Error()))
+		default:
+			slog.Error("Userinfo request failed",
+				slog.String("op", op),
+				slog.String("error", err.Error()),
+			)
+			help.WriteJSON(w, http.StatusInternalServerError, dto.NewOAuthErrorResponse("server_error", ""))
+		}
+		return
+	}
+
+	help.WriteJSON(w, http.StatusOK, dto.NewUserInfoResponse(info))
+}
+
+/*
+pattern: /oauth/logout
+method: GET, POST
+info: RP-initiated logout (OpenID Connect RP-Initiated Logout 1.0), ends
+the session of the authorize page. With an id_token_hint the user is
+signed out right away, otherwise GET asks for confirmation first. The
+post_logout_redirect_uri must be registered for the client named by
+client_id or the hint.
+
+succeed:
+
+	-status code: 302 found, redirect to post_logout_redirect_uri with state
+	-status code: 200 ok, confirmation or signed out page
+
+failed:
+
+	-status code: 400 bad request, invalid hint or redirect uri
+	-status code: 500 internal server error
+*/
+func (h Handler) Logout(w http.ResponseWriter, r *http.Request) {
+	const op = "handlers/oidc.go/Logout"
+
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("X-Frame-Options", "DENY")
+	w.Header().Set("Content-Security-Policy", "frame-ancestors 'none'")
+
+	if err := r.ParseForm(); err != nil {
+		renderLogout(w, http.StatusBadRequest, logoutPage{Error: "Malformed request."})
+		return
+	}
+
+	params := make(map[string]string, len(logoutParams))
+	for _, name := range logoutParams {
+		params[name] = r.Form.Get(name)
+	}
+	redirectURI := params["post_logout_redirect_uri"]
+
+	err := h.OAuthService.ValidateLogout(r.Context(), params["id_token_hint"], params["client_id"], redirectURI)
+	if err != nil {
+		switch {
+		case errors.Is(err, apperrors.ErrInvalidToken),
+			errors.Is(err, apperrors.ErrInvalidClient),
+			errors.Is(err, apperrors.ErrInvalidRedirectURI):
+			renderLogout(w, http.StatusBadRequest, logoutPage{Error: err.Error()})
+		default:
+			slog.Error("Logout failed",
+				slog.String("op", op),
+				slog.String("error", err.Error()),
+			)
+			renderLogout(w, http.StatusInternalServerError, logoutPage{Error: apperrors.ErrServer.Error()})
+		}
+		return
+	}
+
+	// without a hint any site could sign the user out with a link
+	if r.Method == http.MethodGet && params["id_token_hint"] == "" {
+		renderLogout(w, http.StatusOK, logoutPage{Params: params})
+		return
+	}
+
+	if cookie, err := r.Cookie(h.Cfg.OAuth.SessionCookie); err == nil && cookie.Value != "" {
+		if err := h.OAuthService.EndSession(r.Context(), cookie.Value); err != nil {
+			slog.Error("Logout failed",
+				slog.String("op", op),
+				slog.String("error", err.Error()),
+			)
+			renderLogout(w, http.StatusInternalServerError, logoutPage{Error: apperrors.ErrServer.Error()})
+			return
+		}
+	}
+	h.clearSessionCookie(w)
+
+	if redirectURI != "" {
+		redirectWithParams(w, r, redirectURI, url.Values{"state": {params["state"]}})
+		return
+	}
+
+	renderLogout(w, http.StatusOK, logoutPage{Done: true})
+}
+
+func (h Handler) clearSessionCookie(w http.ResponseWriter) {
+	http.SetCookie(w, &http.Cookie{
+		Name:     h.Cfg.OAuth.SessionCookie,
+		Value:    "",
+		Path:     "/oauth",
+		MaxAge:   -1,
+		HttpOnly: true,
+		Secure:   h.Cfg.OAuth.SecureCookie,
+		SameSite: http.SameSiteLaxMode,
+	})
+}
+
+func renderLogout(w http.ResponseWriter, statusCode int, page logoutPage) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.WriteHeader(statusCode)
+	if err := logoutTemplate.Execute(w, page); err != nil {
+		slog.Debug("Failed to render logout page", "error", err)
+	}
+}
@@ -0,0 +1,246 @@
+package handlers_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/alonsoF100/authorization-service/internal/apperrors"
+	"github.com/alonsoF100/authorization-service/internal/config"
+	"github.com/alonsoF100/authorization-service/internal/models"
+	"github.com/alonsoF100/authorization-service/internal/transport/http/dto"
+	"github.com/alonsoF100/authorization-service/internal/transport/http/handlers"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiscovery(t *testing.T) {
+	cfg := &config.Config{OAuth: config.OAuthConfig{Issuer: "https://auth.example.com"}}
+	h := handlers.New(nil, nil, nil, nil, nil, cfg)
+
+	rr := httptest.NewRecorder()
+	h.Discovery(rr, httptest.NewRequest(http.MethodGet, "/.well-known/openid-configuration", nil))
+
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	var body dto.DiscoveryResponse
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &body))
+	require.Equal(t, "https://auth.example.com", body.Issuer)
+	require.Equal(t, "https://auth.example.com/oauth/userinfo", body.UserInfoEndpoint)
+	require.Equal(t, "https://auth.example.com/oauth/logout", body.EndSessionEndpoint)
+	require.Equal(t, []string{"ES256"}, body.IDTokenSigningAlgValuesSupported)
+}
+
+func TestJWKS(t *testing.T) {
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	mc := minimock.NewController(t)
+	mockAuth := handlers.NewAuthServiceMock(mc)
+	mockAuth.PublicKeysMock.Return([]models.PublicKey{{ID: "kid123", Algorithm: "ES256", Key: &privateKey.PublicKey}})
+	h := handlers.New(mockAuth, nil, nil, nil, nil, nil)
+
+	rr := httptest.NewRecorder()
+	h.JWKS(rr, httptest.NewRequest(http.MethodGet, "/.well-known/jwks.json", nil))
+
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	var body dto.JWKSResponse
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &body))
+	require.Len(t, body.Keys, 1)
+	require.Equal(t, "kid123", body.Keys[0].Kid)
+}
+
+func TestUserInfo(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name           string
+		header         string
+		mockSetup      func(mock *handlers.OAuthServiceMock)
+		wantStatus     int
+		wantError      string
+		wantAuthHeader string
+	}{
+		{
+			name:   "profile claims",
+			header: "Bearer access",
+			mockSetup: func(mock *handlers.OAuthServiceMock) {
+				mock.UserInfoMock.Expect(ctx, "access").Return(&models.UserInfo{Subject: "user123", Nickname: "alonso"}, nil)
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:           "missing token",
+			mockSetup:      func(mock *handlers.OAuthServiceMock) {},
+			wantStatus:     http.StatusUnauthorized,
+			wantError:      "invalid_request",
+			wantAuthHeader: `Bearer realm="oauth"`,
+		},
+		{
+			name:   "invalid token",
+			header: "Bearer revoked",
+			mockSetup: func(mock *handlers.OAuthServiceMock) {
+				mock.UserInfoMock.Return(nil, apperrors.ErrInvalidToken)
+			},
+			wantStatus:     http.StatusUnauthorized,
+			wantError:      "invalid_token",
+			wantAuthHeader: `Bearer realm="oauth", error="invalid_token"`,
+		},
+		{
+			name:   "without openid scope",
+			header: "Bearer access",
+			mockSetup: func(mock *handlers.OAuthServiceMock) {
+				mock.UserInfoMock.Return(nil, apperrors.ErrMissingScope)
+			},
+			wantStatus:     http.StatusForbidden,
+			wantError:      "insufficient_scope",
+			wantAuthHeader: `Bearer realm="oauth", error="insufficient_scope", scope="openid"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := minimock.NewController(t)
+			mockService := handlers.NewOAuthServiceMock(mc)
+			tt.mockSetup(mockService)
+			h := handlers.New(nil, nil, mockService, nil, nil, nil)
+
+			req := httptest.NewRequest(http.MethodGet, "/oauth/userinfo", nil)
+			if tt.header != "" {
+				req.Header.Set("Authorization", tt.header)
+			}
+			rr := httptest.NewRecorder()
+
+			h.UserInfo(rr, req)
+
+			require.Equal(t, tt.wantStatus, rr.Code)
+			require.Equal(t, tt.wantAuthHeader, rr.Header().Get("WWW-Authenticate"))
+
+			if tt.wantError != "" {
+				var body dto.OAuthErrorResponse
+				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &body))
+				require.Equal(t, tt.wantError, body.Error)
+				return
+			}
+
+			var body dto.UserInfoResponse
+			require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &body))
+			require.Equal(t, dto.UserInfoResponse{Sub: "user123", Nickname: "alonso"}, body)
+		})
+	}
+}
+
+func TestLogout(t *testing.T) {
+	ctx := context.Background()
+	cfg := &config.Config{
+		JWT:   config.JWTConfig{Expiry: time.Hour},
+		OAuth: config.OAuthConfig{SessionCookie: "auth_session", SecureCookie: true},
+	}
+
+	tests := []struct {
+		name         string
+		method       string
+		params       url.Values
+		mockSetup    func(mock *handlers.OAuthServiceMock)
+		wantStatus   int
+		wantBody     string
+		wantRedirect string
+		wantCleared  bool
+	}{
+		{
+			name:   "hint and redirect",
+			method: http.MethodGet,
+			params: url.Values{
+				"id_token_hint":            {"id_token"},
+				"post_logout_redirect_uri": {"https://app.example.com"},
+				"state":                    {"xyz"},
+			},
+			mockSetup: func(mock *handlers.OAuthServiceMock) {
+				mock.ValidateLogoutMock.Expect(ctx, "id_token", "", "https://app.example.com").Return(nil)
+				mock.EndSessionMock.Expect(ctx, "session_token").Return(nil)
+			},
+			wantStatus:   http.StatusFound,
+			wantRedirect: "https://app.example.com?state=xyz",
+			wantCleared:  true,
+		},
+		{
+			name:   "confirmation without hint",
+			method: http.MethodGet,
+			params: url.Values{},
+			mockSetup: func(mock *handlers.OAuthServiceMock) {
+				mock.ValidateLogoutMock.Return(nil)
+			},
+			wantStatus: http.StatusOK,
+			wantBody:   "Sign out?",
+		},
+		{
+			name:   "confirmed",
+			method: http.MethodPost,
+			params: url.Values{},
+			mockSetup: func(mock *handlers.OAuthServiceMock) {
+				mock.ValidateLogoutMock.Return(nil)
+				mock.EndSessionMock.Return(nil)
+			},
+			wantStatus:  http.StatusOK,
+			wantBody:    "You are signed out",
+			wantCleared: true,
+		},
+		{
+			name:   "unregistered redirect uri",
+			method: http.MethodGet,
+			params: url.Values{
+				"client_id":                {"client123"},
+				"post_logout_redirect_uri": {"https://evil.example.com"},
+			},
+			mockSetup: func(mock *handlers.OAuthServiceMock) {
+				mock.ValidateLogoutMock.Return(apperrors.ErrInvalidRedirectURI)
+			},
+			wantStatus: http.StatusBadRequest,
+			wantBody:   apperrors.ErrInvalidRedirectURI.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := minimock.NewController(t)
+			mockService := handlers.NewOAuthServiceMock(mc)
+			tt.mockSetup(mockService)
+			h := handlers.New(nil, nil, mockService, nil, nil, cfg)
+
+			var req *http.Request
+			if tt.method == http.MethodPost {
+				req = newFormRequest("/oauth/logout", tt.params)
+			} else {
+				req = httptest.NewRequest(http.MethodGet, "/oauth/logout?"+tt.params.Encode(), nil)
+			}
+			req.AddCookie(&http.Cookie{Name: "auth_session", Value: "session_token"})
+			rr := httptest.NewRecorder()
+
+			h.Logout(rr, req)
+
+			require.Equal(t, tt.wantStatus, rr.Code)
+			require.Equal(t, tt.wantRedirect, rr.Header().Get("Location"))
+			if tt.wantBody != "" {
+				require.Contains(t, rr.Body.String(), tt.wantBody)
+			}
+
+			cookies := rr.Result().Cookies()
+			if !tt.wantCleared {
+				require.Empty(t, cookies)
+				return
+			}
+			require.Len(t, cookies, 1)
+			require.Equal(t, "auth_session", cookies[0].Name)
+			require.Empty(t, cookies[0].Value)
+			require.Negative(t, cookies[0].MaxAge)
+		})
+	}
+}
@@ -0,0 +1,190 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/alonsoF100/authorization-service/internal/apperrors"
+	"github.com/alonsoF100/authorization-service/internal/models"
+	"github.com/alonsoF100/authorization-service/internal/transport/http/dto"
+	"github.com/alonsoF100/authorization-service/internal/transport/http/help"
+	"github.com/alonsoF100/authorization-service/internal/transport/http/middleware"
+)
+
+/*
+pattern: /api/me/tokens
+method: POST
+info: barer token from header, JSON with name, scopes and optional
+expires_at. The token may only use the /api routes of its read and write
+scopes, and the /admin routes with the admin scope.
+
+succeed:
+
+	-status code: 201 created
+	-response body: JSON represented token with the plaintext, it is not
+	shown again
+
+failed:
+
+	-status code: 400 bad request, 401 unauthorized, 403 forbidden for client,
+	scoped and personal access tokens, 500 internal server error
+	-response body: JSON with error message + timestamp
+*/
+func (h Handler) CreatePersonalAccessToken(w http.ResponseWriter, r *http.Request) {
+	const op = "handlers/personal_access_token.go/CreatePersonalAccessToken"
+
+	claims, ok := sessionClaims(w, r, op)
+	if !ok {
+		return
+	}
+	ctx := r.Context()
+
+	var req dto.CreatePersonalAccessTokenRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		help.WriteJSON(w, http.StatusBadRequest, dto.NewErrorResponse(apperrors.ErrFailedToDecode))
+		slog.Warn("Failed to decode JSON",
+			slog.String("op", op),
+			slog.String("error", err.Error()),
+		)
+		return
+	}
+
+	if err := h.Validator.Struct(req); err != nil {
+		help.WriteJSON(w, http.StatusBadRequest, dto.NewErrorResponse(apperrors.ErrFailedToValidate))
+		slog.Warn("Failed to validate request",
+			slog.String("op", op),
+			slog.String("error", err.Error()),
+		)
+		return
+	}
+
+	token, plaintext, err := h.UserService.CreatePersonalAccessToken(ctx, claims.ID, req.Name, req.Scopes, req.ExpiresAt)
+	if err != nil {
+		if errors.Is(err, apperrors.ErrMalformedScope) {
+			help.WriteJSON(w, http.StatusBadRequest, dto.NewErrorResponse(apperrors.ErrMalformedScope))
+			return
+		}
+
+		help.WriteJSON(w, http.StatusInternalServerError, dto.NewErrorResponse(apperrors.ErrServer))
+		slog.Debug("Intenal server error",
+			slog.String("op", op),
+			slog.String("user_id", claims.ID),
+			slog.String("error", err.Error()),
+		)
+		return
+	}
+
+	help.WriteJSON(w, http.StatusCreated, dto.NewCreatePersonalAccessTokenResponse(token, plaintext))
+}
+
+/*
+pattern: /api/me/tokens
+method: GET
+info: barer token from header
+
+succeed:
+
+	-status code: 200 ok
+	-response body: JSON with the tokens of the user, without their plaintext
+
+failed:
+
+	-status code: 401 unauthorized, 403 forbidden for client, scoped and
+	personal access tokens, 500 internal server error
+	-response body: JSON with error message + timestamp
+*/
+func (h Handler) ListPersonalAccessTokens(w http.ResponseWriter, r *http.Request) {
+	const op = "handlers/personal_access_token.go/ListPersonalAccessTokens"
+
+	claims, ok := sessionClaims(w, r, op)
+	if !ok {
+		return
+	}
+
+	tokens, err := h.UserService.ListPersonalAccessTokens(r.Context(), claims.ID)
+	if err != nil {
+		help.WriteJSON(w, http.StatusInternalServerError, dto.NewErrorResponse(apperrors.ErrServer))
+		slog.Debug("Intenal server error",
+			slog.String("op", op),
+			slog.String("user_id", claims.ID),
+			slog.String("error", err.Error()),
+		)
+		return
+	}
+
+	help.WriteJSON(w, http.StatusOK, dto.NewListPersonalAccessTokensResponse(tokens))
+}
+
+/*
+pattern: /api/me/tokens/{id}
+method: DELETE
+info: barer token from header, token id from path
+
+succeed:
+
+	-status code: 204 no content
+
+failed:
+
+	-status code: 401 unauthorized, 403 forbidden for client, scoped and
+	personal access tokens, 404 not found, 500 internal server error
+	-response body: JSON with error message + timestamp
+*/
+func (h Handler) DeletePersonalAccessToken(w http.ResponseWriter, r *http.Request) {
+	const op = "handlers/personal_access_token.go/DeletePersonalAccessToken"
+
+	claims, ok := sessionClaims(w, r, op)
+	if !ok {
+		return
+	}
+
+	err := h.UserService.DeletePersonalAccessToken(r.Context(), claims.ID, r.PathValue("id"))
+	if err != nil {
+		if errors.Is(err, apperrors.ErrTokenNotFound) {
+			help.WriteJSON(w, http.StatusNotFound, dto.NewErrorResponse(apperrors.ErrTokenNotFound))
+			return
+		}
+
+		help.WriteJSON(w, http.StatusInternalServerError, dto.NewErrorResponse(apperrors.ErrServer))
+		slog.Debug("Intenal server error",
+			slog.String("op", op),
+			slog.String("user_id", claims.ID),
+			slog.String("error", err.Error()),
+		)
+		return
+	}
+
+	help.WriteJSON(w, http.StatusNoContent, nil)
+}
+
+// sessionClaims only lets tokens from a user sign in manage personal access
+// tokens and sessions. Machine tokens belong to no user, and a scoped or
+// personal access token could otherwise mint a token with more scopes or a
+// longer life than its own.
+func sessionClaims(w http.ResponseWriter, r *http.Request, op string) (*models.Claims, bool) {
+	claims, ok := middleware.GetUserFromContext(r.Context())
+	if !ok {
+		slog.Error("User claims not found in context",
+			slog.String("op", op))
+		help.WriteJSON(w, http.StatusUnauthorized, dto.NewErrorResponse(apperrors.ErrUnauthorized))
+		return nil, false
+	}
+
+	if principal, ok := middleware.GetPrincipalFromContext(r.Context()); ok && principal != models.PrincipalUser {
+		help.WriteJSON(w, http.StatusForbidden, dto.NewErrorResponse(apperrors.ErrClientPrincipal))
+		return nil, false
+	}
+
+	if middleware.IsPersonalAccessToken(r.Context()) || claims.Scope != "" || claims.ClientID != "" {
+		slog.Debug("Token can not manage personal access tokens",
+			slog.String("op", op),
+			slog.String("user_id", claims.ID),
+		)
+		help.WriteJSON(w, http.StatusForbidden, dto.NewErrorResponse(apperrors.ErrSessionRequired))
+		return nil, false
+	}
+
+	return claims, true
+}
@@ -0,0 +1,213 @@
+package handlers_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alonsoF100/authorization-service/internal/apperrors"
+	"github.com/alonsoF100/authorization-service/internal/models"
+	"github.com/alonsoF100/authorization-service/internal/transport/http/dto"
+	"github.com/alonsoF100/authorization-service/internal/transport/http/handlers"
+	"github.com/alonsoF100/authorization-service/internal/transport/http/middleware"
+	"github.com/go-playground/validator/v10"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreatePersonalAccessToken(t *testing.T) {
+	mc := minimock.NewController(t)
+	mockService := handlers.NewUserServiceMock(mc)
+
+	h := handlers.Handler{
+		UserService: mockService,
+		Validator:   validator.New(),
+	}
+
+	session := &models.Claims{ID: "user123"}
+	token := &models.PersonalAccessToken{
+		ID:        "token123",
+		UserID:    "user123",
+		Name:      "ci",
+		Scopes:    []string{"read"},
+		CreatedAt: time.Now(),
+	}
+
+	tests := []struct {
+		name       string
+		claims     *models.Claims
+		viaPAT     bool
+		body       string
+		mockSetup  func(ctx context.Context)
+		wantStatus int
+		wantError  string
+	}{
+		{
+			name:   "success",
+			claims: session,
+			body:   `{"name":"ci","scopes":["read"]}`,
+			mockSetup: func(ctx context.Context) {
+				mockService.CreatePersonalAccessTokenMock.Expect(ctx, "user123", "ci", []string{"read"}, nil).
+					Return(token, models.PersonalAccessTokenPrefix+"secret", nil)
+			},
+			wantStatus: http.StatusCreated,
+		},
+		{
+			name:       "personal access token",
+			claims:     session,
+			viaPAT:     true,
+			body:       `{"name":"ci"}`,
+			mockSetup:  func(ctx context.Context) {},
+			wantStatus: http.StatusForbidden,
+			wantError:  apperrors.ErrSessionRequired.Error(),
+		},
+		{
+			name:       "scoped token",
+			claims:     &models.Claims{ID: "user123", ClientID: "client123", Scope: "profile"},
+			body:       `{"name":"ci"}`,
+			mockSetup:  func(ctx context.Context) {},
+			wantStatus: http.StatusForbidden,
+			wantError:  apperrors.ErrSessionRequired.Error(),
+		},
+		{
+			name:       "client token",
+			claims:     &models.Claims{ClientID: "client123", PrincipalType: models.PrincipalClient},
+			body:       `{"name":"ci"}`,
+			mockSetup:  func(ctx context.Context) {},
+			wantStatus: http.StatusForbidden,
+			wantError:  apperrors.ErrClientPrincipal.Error(),
+		},
+		{
+			name:       "missing name",
+			claims:     session,
+			body:       `{"scopes":["read"]}`,
+			mockSetup:  func(ctx context.Context) {},
+			wantStatus: http.StatusBadRequest,
+			wantError:  apperrors.ErrFailedToValidate.Error(),
+		},
+		{
+			name:       "expiry in the past",
+			claims:     session,
+			body:       `{"name":"ci","expires_at":"2020-01-01T00:00:00Z"}`,
+			mockSetup:  func(ctx context.Context) {},
+			wantStatus: http.StatusBadRequest,
+			wantError:  apperrors.ErrFailedToValidate.Error(),
+		},
+		{
+			name:   "malformed scope",
+			claims: session,
+			body:   `{"name":"ci","scopes":["read write"]}`,
+			mockSetup: func(ctx context.Context) {
+				mockService.CreatePersonalAccessTokenMock.Expect(ctx, "user123", "ci", []string{"read write"}, nil).
+					Return(nil, "", apperrors.ErrMalformedScope)
+			},
+			wantStatus: http.StatusBadRequest,
+			wantError:  apperrors.ErrMalformedScope.Error(),
+		},
+		{
+			name:   "service error",
+			claims: session,
+			body:   `{"name":"ci"}`,
+			mockSetup: func(ctx context.Context) {
+				mockService.CreatePersonalAccessTokenMock.Expect(ctx, "user123", "ci", nil, nil).
+					Return(nil, "", errors.New("db error"))
+			},
+			wantStatus: http.StatusInternalServerError,
+			wantError:  apperrors.ErrServer.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.WithValue(context.Background(), middleware.UserContextKey, tt.claims)
+			ctx = context.WithValue(ctx, middleware.PrincipalContextKey, tt.claims.Principal())
+			ctx = context.WithValue(ctx, middleware.PersonalAccessTokenContextKey, tt.viaPAT)
+
+			tt.mockSetup(ctx)
+
+			req := httptest.NewRequest(http.MethodPost, "/api/me/tokens", strings.NewReader(tt.body))
+			req = req.WithContext(ctx)
+
+			rr := httptest.NewRecorder()
+
+			h.CreatePersonalAccessToken(rr, req)
+
+			require.Equal(t, tt.wantStatus, rr.Code)
+
+			if tt.wantError != "" {
+				var resp dto.ErrorResponse
+				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+				require.Equal(t, tt.wantError, resp.Error)
+				return
+			}
+
+			var resp dto.CreatePersonalAccessTokenResponse
+			require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+			require.Equal(t, token.ID, resp.ID)
+			require.Equal(t, models.PersonalAccessTokenPrefix+"secret", resp.Token)
+		})
+	}
+}
+
+func TestDeletePersonalAccessToken(t *testing.T) {
+	mc := minimock.NewController(t)
+	mockService := handlers.NewUserServiceMock(mc)
+
+	h := handlers.Handler{
+		UserService: mockService,
+	}
+
+	tests := []struct {
+		name       string
+		mockSetup  func(ctx context.Context)
+		wantStatus int
+		wantError  string
+	}{
+		{
+			name: "success",
+			mockSetup: func(ctx context.Context) {
+				mockService.DeletePersonalAccessTokenMock.Expect(ctx, "user123", "token123").Return(nil)
+			},
+			wantStatus: http.StatusNoContent,
+		},
+		{
+			name: "not found",
+			mockSetup: func(ctx context.Context) {
+				mockService.DeletePersonalAccessTokenMock.Expect(ctx, "user123", "token123").Return(apperrors.ErrTokenNotFound)
+			},
+			wantStatus: http.StatusNotFound,
+			wantError:  apperrors.ErrTokenNotFound.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			claims := &models.Claims{ID: "user123"}
+			ctx := context.WithValue(context.Background(), middleware.UserContextKey, claims)
+			ctx = context.WithValue(ctx, middleware.PrincipalContextKey, claims.Principal())
+
+			tt.mockSetup(ctx)
+
+			req := httptest.NewRequest(http.MethodDelete, "/api/me/tokens/token123", nil)
+			req.SetPathValue("id", "token123")
+			req = req.WithContext(ctx)
+
+			rr := httptest.NewRecorder()
+
+			h.DeletePersonalAccessToken(rr, req)
+
+			require.Equal(t, tt.wantStatus, rr.Code)
+
+			if tt.wantError != "" {
+				var resp dto.ErrorResponse
+				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+				require.Equal(t, tt.wantError, resp.Error)
+			}
+		})
+	}
+}
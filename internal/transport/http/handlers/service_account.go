@@ -0,0 +1,263 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/alonsoF100/authorization-service/internal/apperrors"
+	"github.com/alonsoF100/authorization-service/internal/models"
+	"github.com/alonsoF100/authorization-service/internal/transport/http/dto"
+	"github.com/alonsoF100/authorization-service/internal/transport/http/help"
+	"github.com/alonsoF100/authorization-service/internal/transport/http/middleware"
+)
+
+/*
+pattern: /admin/service-accounts
+method: POST
+info: admin credentials from header, JSON with name and roles
+
+succeed:
+
+	-status code: 201 created
+	-response body: JSON represented service account
+
+failed:
+
+	-status code: 400 bad request, 401 unauthorized, 403 forbidden, 409
+	conflict, 500 internal server error
+	-response body: JSON with error message + timestamp
+*/
+func (h Handler) CreateServiceAccount(w http.ResponseWriter, r *http.Request) {
+	const op = "handlers/service_account.go/CreateServiceAccount"
+
+	claims, ok := middleware.GetUserFromContext(r.Context())
+	if !ok {
+		slog.Error("User claims not found in context",
+			slog.String("op", op))
+		help.WriteJSON(w, http.StatusUnauthorized, dto.NewErrorResponse(apperrors.ErrUnauthorized))
+		return
+	}
+	ctx := r.Context()
+
+	var req dto.CreateServiceAccountRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		help.WriteJSON(w, http.StatusBadRequest, dto.NewErrorResponse(apperrors.ErrFailedToDecode))
+		slog.Warn("Failed to decode JSON",
+			slog.String("op", op),
+			slog.String("error", err.Error()),
+		)
+		return
+	}
+
+	if err := h.Validator.Struct(req); err != nil {
+		help.WriteJSON(w, http.StatusBadRequest, dto.NewErrorResponse(apperrors.ErrFailedToValidate))
+		slog.Warn("Failed to validate request",
+			slog.String("op", op),
+			slog.String("error", err.Error()),
+		)
+		return
+	}
+
+	// a service account holding the admin role creates accounts without an
+	// owner, owners are users
+	var ownerID string
+	if claims.Principal() == models.PrincipalUser {
+		ownerID = claims.ID
+	}
+
+	account, err := h.ServiceAccountService.CreateServiceAccount(ctx, ownerID, req.Name, req.Roles)
+	if err != nil {
+		if errors.Is(err, apperrors.ErrServiceAccountExist) {
+			help.WriteJSON(w, http.StatusConflict, dto.NewErrorResponse(apperrors.ErrServiceAccountExist))
+			return
+		}
+
+		h.writeServiceAccountError(w, op, err)
+		return
+	}
+
+	help.WriteJSON(w, http.StatusCreated, dto.NewServiceAccountResponse(account))
+}
+
+/*
+pattern: /admin/service-accounts
+method: GET
+info: admin credentials from header
+
+succeed:
+
+	-status code: 200 ok
+	-response body: JSON with every service account
+
+failed:
+
+	-status code: 401 unauthorized, 403 forbidden, 500 internal server error
+	-response body: JSON with error message + timestamp
+*/
+func (h Handler) ListServiceAccounts(w http.ResponseWriter, r *http.Request) {
+	const op = "handlers/service_account.go/ListServiceAccounts"
+
+	accounts, err := h.ServiceAccountService.ListServiceAccounts(r.Context())
+	if err != nil {
+		h.writeServiceAccountError(w, op, err)
+		return
+	}
+
+	help.WriteJSON(w, http.StatusOK, dto.NewListServiceAccountsResponse(accounts))
+}
+
+/*
+pattern: /admin/service-accounts/{id}
+method: GET
+info: admin credentials from header, service account id from path
+
+succeed:
+
+	-status code: 200 ok
+	-response body: JSON represented service account
+
+failed:
+
+	-status code: 401 unauthorized, 403 forbidden, 404 not found, 500
+	internal server error
+	-response body: JSON with error message + timestamp
+*/
+func (h Handler) GetServiceAccount(w http.ResponseWriter, r *http.Request) {
+	const op = "handlers/service_account.go/GetServiceAccount"
+
+	account, err := h.ServiceAccountService.GetServiceAccount(r.Context(), r.PathValue("id"))
+	if err != nil {
+		h.writeServiceAccountError(w, op, err)
+		return
+	}
+
+	help.WriteJSON(w, http.StatusOK, dto.NewServiceAccountResponse(account))
+}
+
+/*
+pattern: /admin/service-accounts/{id}
+method: DELETE
+info: admin credentials from header, service account id from path, the keys
+of the account are deleted with it
+
+succeed:
+
+	-status code: 204 no content
+
+failed:
+
+	-status code: 401 unauthorized, 403 forbidden, 404 not found, 500
+	internal server error
+	-response body: JSON with error message + timestamp
+*/
+func (h Handler) DeleteServiceAccount(w http.ResponseWriter, r *http.Request) {
+	const op = "handlers/service_account.go/DeleteServiceAccount"
+
+	if err := h.ServiceAccountService.DeleteServiceAccount(r.Context(), r.PathValue("id")); err != nil {
+		h.writeServiceAccountError(w, op, err)
+		return
+	}
+
+	help.WriteJSON(w, http.StatusNoContent, nil)
+}
+
+/*
+pattern: /admin/service-accounts/{id}/keys
+method: POST
+info: admin credentials from header, service account id from path. Keys are
+rotated by creating a new key and deleting the old one.
+
+succeed:
+
+	-status code: 201 created
+	-response body: JSON represented key with the plaintext, it is not shown
+	again
+
+failed:
+
+	-status code: 401 unauthorized, 403 forbidden, 404 not found, 500
+	internal server error
+	-response body: JSON with error message + timestamp
+*/
+func (h Handler) CreateAPIKey(w http.ResponseWriter, r *http.Request) {
+	const op = "handlers/service_account.go/CreateAPIKey"
+
+	key, plaintext, err := h.ServiceAccountService.CreateAPIKey(r.Context(), r.PathValue("id"))
+	if err != nil {
+		h.writeServiceAccountError(w, op, err)
+		return
+	}
+
+	help.WriteJSON(w, http.StatusCreated, dto.NewCreateAPIKeyResponse(key, plaintext))
+}
+
+/*
+pattern: /admin/service-accounts/{id}/keys
+method: GET
+info: admin credentials from header, service account id from path
+
+succeed:
+
+	-status code: 200 ok
+	-response body: JSON with the keys of the account, without their plaintext
+
+failed:
+
+	-status code: 401 unauthorized, 403 forbidden, 404 not found, 500
+	internal server error
+	-response body: JSON with error message + timestamp
+*/
+func (h Handler) ListAPIKeys(w http.ResponseWriter, r *http.Request) {
+	const op = "handlers/service_account.go/ListAPIKeys"
+
+	keys, err := h.ServiceAccountService.ListAPIKeys(r.Context(), r.PathValue("id"))
+	if err != nil {
+		h.writeServiceAccountError(w, op, err)
+		return
+	}
+
+	help.WriteJSON(w, http.StatusOK, dto.NewListAPIKeysResponse(keys))
+}
+
+/*
+pattern: /admin/service-accounts/{id}/keys/{keyID}
+method: DELETE
+info: admin credentials from header, service account and key id from path
+
+succeed:
+
+	-status code: 204 no content
+
+failed:
+
+	-status code: 401 unauthorized, 403 forbidden, 404 not found, 500
+	internal server error
+	-response body: JSON with error message + timestamp
+*/
+func (h Handler) DeleteAPIKey(w http.ResponseWriter, r *http.Request) {
+	const op = "handlers/service_account.go/DeleteAPIKey"
+
+	if err := h.ServiceAccountService.DeleteAPIKey(r.Context(), r.PathValue("id"), r.PathValue("keyID")); err != nil {
+		h.writeServiceAccountError(w, op, err)
+		return
+	}
+
+	help.WriteJSON(w, http.StatusNoContent, nil)
+}
+
+func (h Handler) writeServiceAccountError(w http.ResponseWriter, op string, err error) {
+	switch {
+	case errors.Is(err, apperrors.ErrServiceAccountNotFound):
+		help.WriteJSON(w, http.StatusNotFound, dto.NewErrorResponse(apperrors.ErrServiceAccountNotFound))
+	case errors.Is(err, apperrors.ErrAPIKeyNotFound):
+		help.WriteJSON(w, http.StatusNotFound, dto.NewErrorResponse(apperrors.ErrAPIKeyNotFound))
+	default:
+		help.WriteJSON(w, http.StatusInternalServerError, dto.NewErrorResponse(apperrors.ErrServer))
+		slog.Debug("Intenal server error",
+			slog.String("op", op),
+			slog.String("error", err.Error()),
+		)
+	}
+}
@@ -0,0 +1,2971 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package handlers
+
+//go:generate minimock -i github.com/alonsoF100/authorization-service/internal/transport/http/handlers.ServiceAccountService -o service_account_service_mock_test.go -n ServiceAccountServiceMock -p handlers
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/alonsoF100/authorization-service/internal/models"
+	"github.com/gojuno/minimock/v3"
+)
+
+// ServiceAccountServiceMock implements ServiceAccountService
+type ServiceAccountServiceMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcAuthenticateAPIKey          func(ctx context.Context, key string) (cp1 *models.Claims, err error)
+	funcAuthenticateAPIKeyOrigin    string
+	inspectFuncAuthenticateAPIKey   func(ctx context.Context, key string)
+	afterAuthenticateAPIKeyCounter  uint64
+	beforeAuthenticateAPIKeyCounter uint64
+	AuthenticateAPIKeyMock          mServiceAccountServiceMockAuthenticateAPIKey
+
+	funcCreateAPIKey          func(ctx context.Context, accountID string) (ap1 *models.APIKey, s1 string, err error)
+	funcCreateAPIKeyOrigin    string
+	inspectFuncCreateAPIKey   func(ctx context.Context, accountID string)
+	afterCreateAPIKeyCounter  uint64
+	beforeCreateAPIKeyCounter uint64
+	CreateAPIKeyMock          mServiceAccountServiceMockCreateAPIKey
+
+	funcCreateServiceAccount          func(ctx context.Context, ownerID string, name string, roles []string) (sp1 *models.ServiceAccount, err error)
+	funcCreateServiceAccountOrigin    string
+	inspectFuncCreateServiceAccount   func(ctx context.Context, ownerID string, name string, roles []string)
+	afterCreateServiceAccountCounter  uint64
+	beforeCreateServiceAccountCounter uint64
+	CreateServiceAccountMock          mServiceAccountServiceMockCreateServiceAccount
+
+	funcDeleteAPIKey          func(ctx context.Context, accountID string, keyID string) (err error)
+	funcDeleteAPIKeyOrigin    string
+	inspectFuncDeleteAPIKey   func(ctx context.Context, accountID string, keyID string)
+	afterDeleteAPIKeyCounter  uint64
+	beforeDeleteAPIKeyCounter uint64
+	DeleteAPIKeyMock          mServiceAccountServiceMockDeleteAPIKey
+
+	funcDeleteServiceAccount          func(ctx context.Context, accountID string) (err error)
+	funcDeleteServiceAccountOrigin    string
+	inspectFuncDeleteServiceAccount   func(ctx context.Context, accountID string)
+	afterDeleteServiceAccountCounter  uint64
+	beforeDeleteServiceAccountCounter uint64
+	DeleteServiceAccountMock          mServiceAccountServiceMockDeleteServiceAccount
+
+	funcGetServiceAccount          func(ctx context.Context, accountID string) (sp1 *models.ServiceAccount, err error)
+	funcGetServiceAccountOrigin    string
+	inspectFuncGetServiceAccount   func(ctx context.Context, accountID string)
+	afterGetServiceAccountCounter  uint64
+	beforeGetServiceAccountCounter uint64
+	GetServiceAccountMock          mServiceAccountServiceMockGetServiceAccount
+
+	funcListAPIKeys          func(ctx context.Context, accountID string) (aa1 []models.APIKey, err error)
+	funcListAPIKeysOrigin    string
+	inspectFuncListAPIKeys   func(ctx context.Context, accountID string)
+	afterListAPIKeysCounter  uint64
+	beforeListAPIKeysCounter uint64
+	ListAPIKeysMock          mServiceAccountServiceMockListAPIKeys
+
+	funcListServiceAccounts          func(ctx context.Context) (sa1 []models.ServiceAccount, err error)
+	funcListServiceAccountsOrigin    string
+	inspectFuncListServiceAccounts   func(ctx context.Context)
+	afterListServiceAccountsCounter  uint64
+	beforeListServiceAccountsCounter uint64
+	ListServiceAccountsMock          mServiceAccountServiceMockListServiceAccounts
+}
+
+// NewServiceAccountServiceMock returns a mock for ServiceAccountService
+func NewServiceAccountServiceMock(t minimock.Tester) *ServiceAccountServiceMock {
+	m := &ServiceAccountServiceMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.AuthenticateAPIKeyMock = mServiceAccountServiceMockAuthenticateAPIKey{mock: m}
+	m.AuthenticateAPIKeyMock.callArgs = []*ServiceAccountServiceMockAuthenticateAPIKeyParams{}
+
+	m.CreateAPIKeyMock = mServiceAccountServiceMockCreateAPIKey{mock: m}
+	m.CreateAPIKeyMock.callArgs = []*ServiceAccountServiceMockCreateAPIKeyParams{}
+
+	m.CreateServiceAccountMock = mServiceAccountServiceMockCreateServiceAccount{mock: m}
+	m.CreateServiceAccountMock.callArgs = []*ServiceAccountServiceMockCreateServiceAccountParams{}
+
+	m.DeleteAPIKeyMock = mServiceAccountServiceMockDeleteAPIKey{mock: m}
+	m.DeleteAPIKeyMock.callArgs = []*ServiceAccountServiceMockDeleteAPIKeyParams{}
+
+	m.DeleteServiceAccountMock = mServiceAccountServiceMockDeleteServiceAccount{mock: m}
+	m.DeleteServiceAccountMock.callArgs = []*ServiceAccountServiceMockDeleteServiceAccountParams{}
+
+	m.GetServiceAccountMock = mServiceAccountServiceMockGetServiceAccount{mock: m}
+	m.GetServiceAccountMock.callArgs = []*ServiceAccountServiceMockGetServiceAccountParams{}
+
+	m.ListAPIKeysMock = mServiceAccountServiceMockListAPIKeys{mock: m}
+	m.ListAPIKeysMock.callArgs = []*ServiceAccountServiceMockListAPIKeysParams{}
+
+	m.ListServiceAccountsMock = mServiceAccountServiceMockListServiceAccounts{mock: m}
+	m.ListServiceAccountsMock.callArgs = []*ServiceAccountServiceMockListServiceAccountsParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mServiceAccountServiceMockAuthenticateAPIKey struct {
+	optional           bool
+	mock               *ServiceAccountServiceMock
+	defaultExpectation *ServiceAccountServiceMockAuthenticateAPIKeyExpectation
+	expectations       []*ServiceAccountServiceMockAuthenticateAPIKeyExpectation
+
+	callArgs []*ServiceAccountServiceMockAuthenticateAPIKeyParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ServiceAccountServiceMockAuthenticateAPIKeyExpectation specifies expectation struct of the ServiceAccountService.AuthenticateAPIKey
+type ServiceAccountServiceMockAuthenticateAPIKeyExpectation struct {
+	mock               *ServiceAccountServiceMock
+	params             *ServiceAccountServiceMockAuthenticateAPIKeyParams
+	paramPtrs          *ServiceAccountServiceMockAuthenticateAPIKeyParamPtrs
+	expectationOrigins ServiceAccountServiceMockAuthenticateAPIKeyExpectationOrigins
+	results            *ServiceAccountServiceMockAuthenticateAPIKeyResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ServiceAccountServiceMockAuthenticateAPIKeyParams contains parameters of the ServiceAccountService.AuthenticateAPIKey
+type ServiceAccountServiceMockAuthenticateAPIKeyParams struct {
+	ctx context.Context
+	key string
+}
+
+// ServiceAccountServiceMockAuthenticateAPIKeyParamPtrs contains pointers to parameters of the ServiceAccountService.AuthenticateAPIKey
+type ServiceAccountServiceMockAuthenticateAPIKeyParamPtrs struct {
+	ctx *context.Context
+	key *string
+}
+
+// ServiceAccountServiceMockAuthenticateAPIKeyResults contains results of the ServiceAccountService.AuthenticateAPIKey
+type ServiceAccountServiceMockAuthenticateAPIKeyResults struct {
+	cp1 *models.Claims
+	err error
+}
+
+// ServiceAccountServiceMockAuthenticateAPIKeyOrigins contains origins of expectations of the ServiceAccountService.AuthenticateAPIKey
+type ServiceAccountServiceMockAuthenticateAPIKeyExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originKey string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmAuthenticateAPIKey *mServiceAccountServiceMockAuthenticateAPIKey) Optional() *mServiceAccountServiceMockAuthenticateAPIKey {
+	mmAuthenticateAPIKey.optional = true
+	return mmAuthenticateAPIKey
+}
+
+// Expect sets up expected params for ServiceAccountService.AuthenticateAPIKey
+func (mmAuthenticateAPIKey *mServiceAccountServiceMockAuthenticateAPIKey) Expect(ctx context.Context, key string) *mServiceAccountServiceMockAuthenticateAPIKey {
+	if mmAuthenticateAPIKey.mock.funcAuthenticateAPIKey != nil {
+		mmAuthenticateAPIKey.mock.t.Fatalf("ServiceAccountServiceMock.AuthenticateAPIKey mock is already set by Set")
+	}
+
+	if mmAuthenticateAPIKey.defaultExpectation == nil {
+		mmAuthenticateAPIKey.defaultExpectation = &ServiceAccountServiceMockAuthenticateAPIKeyExpectation{}
+	}
+
+	if mmAuthenticateAPIKey.defaultExpectation.paramPtrs != nil {
+		mmAuthenticateAPIKey.mock.t.Fatalf("ServiceAccountServiceMock.AuthenticateAPIKey mock is already set by ExpectParams functions")
+	}
+
+	mmAuthenticateAPIKey.defaultExpectation.params = &ServiceAccountServiceMockAuthenticateAPIKeyParams{ctx, key}
+	mmAuthenticateAPIKey.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmAuthenticateAPIKey.expectations {
+		if minimock.Equal(e.params, mmAuthenticateAPIKey.defaultExpectation.params) {
+			mmAuthenticateAPIKey.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAuthenticateAPIKey.defaultExpectation.params)
+		}
+	}
+
+	return mmAuthenticateAPIKey
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ServiceAccountService.AuthenticateAPIKey
+func (mmAuthenticateAPIKey *mServiceAccountServiceMockAuthenticateAPIKey) ExpectCtxParam1(ctx context.Context) *mServiceAccountServiceMockAuthenticateAPIKey {
+	if mmAuthenticateAPIKey.mock.funcAuthenticateAPIKey != nil {
+		mmAuthenticateAPIKey.mock.t.Fatalf("ServiceAccountServiceMock.AuthenticateAPIKey mock is already set by Set")
+	}
+
+	if mmAuthenticateAPIKey.defaultExpectation == nil {
+		mmAuthenticateAPIKey.defaultExpectation = &ServiceAccountServiceMockAuthenticateAPIKeyExpectation{}
+	}
+
+	if mmAuthenticateAPIKey.defaultExpectation.params != nil {
+		mmAuthenticateAPIKey.mock.t.Fatalf("ServiceAccountServiceMock.AuthenticateAPIKey mock is already set by Expect")
+	}
+
+	if mmAuthenticateAPIKey.defaultExpectation.paramPtrs == nil {
+		mmAuthenticateAPIKey.defaultExpectation.paramPtrs = &ServiceAccountServiceMockAuthenticateAPIKeyParamPtrs{}
+	}
+	mmAuthenticateAPIKey.defaultExpectation.paramPtrs.ctx = &ctx
+	mmAuthenticateAPIKey.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmAuthenticateAPIKey
+}
+
+// ExpectKeyParam2 sets up expected param key for ServiceAccountService.AuthenticateAPIKey
+func (mmAuthenticateAPIKey *mServiceAccountServiceMockAuthenticateAPIKey) ExpectKeyParam2(key string) *mServiceAccountServiceMockAuthenticateAPIKey {
+	if mmAuthenticateAPIKey.mock.funcAuthenticateAPIKey != nil {
+		mmAuthenticateAPIKey.mock.t.Fatalf("ServiceAccountServiceMock.AuthenticateAPIKey mock is already set by Set")
+	}
+
+	if mmAuthenticateAPIKey.defaultExpectation == nil {
+		mmAuthenticateAPIKey.defaultExpectation = &ServiceAccountServiceMockAuthenticateAPIKeyExpectation{}
+	}
+
+	if mmAuthenticateAPIKey.defaultExpectation.params != nil {
+		mmAuthenticateAPIKey.mock.t.Fatalf("ServiceAccountServiceMock.AuthenticateAPIKey mock is already set by Expect")
+	}
+
+	if mmAuthenticateAPIKey.defaultExpectation.paramPtrs == nil {
+		mmAuthenticateAPIKey.defaultExpectation.paramPtrs = &ServiceAccountServiceMockAuthenticateAPIKeyParamPtrs{}
+	}
+	mmAuthenticateAPIKey.defaultExpectation.paramPtrs.key = &key
+	mmAuthenticateAPIKey.defaultExpectation.expectationOrigins.originKey = minimock.CallerInfo(1)
+
+	return mmAuthenticateAPIKey
+}
+
+// Inspect accepts an inspector function that has same arguments as the ServiceAccountService.AuthenticateAPIKey
+func (mmAuthenticateAPIKey *mServiceAccountServiceMockAuthenticateAPIKey) Inspect(f func(ctx context.Context, key string)) *mServiceAccountServiceMockAuthenticateAPIKey {
+	if mmAuthenticateAPIKey.mock.inspectFuncAuthenticateAPIKey != nil {
+		mmAuthenticateAPIKey.mock.t.Fatalf("Inspect function is already set for ServiceAccountServiceMock.AuthenticateAPIKey")
+	}
+
+	mmAuthenticateAPIKey.mock.inspectFuncAuthenticateAPIKey = f
+
+	return mmAuthenticateAPIKey
+}
+
+// Return sets up results that will be returned by ServiceAccountService.AuthenticateAPIKey
+func (mmAuthenticateAPIKey *mServiceAccountServiceMockAuthenticateAPIKey) Return(cp1 *models.Claims, err error) *ServiceAccountServiceMock {
+	if mmAuthenticateAPIKey.mock.funcAuthenticateAPIKey != nil {
+		mmAuthenticateAPIKey.mock.t.Fatalf("ServiceAccountServiceMock.AuthenticateAPIKey mock is already set by Set")
+	}
+
+	if mmAuthenticateAPIKey.defaultExpectation == nil {
+		mmAuthenticateAPIKey.defaultExpectation = &ServiceAccountServiceMockAuthenticateAPIKeyExpectation{mock: mmAuthenticateAPIKey.mock}
+	}
+	mmAuthenticateAPIKey.defaultExpectation.results = &ServiceAccountServiceMockAuthenticateAPIKeyResults{cp1, err}
+	mmAuthenticateAPIKey.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmAuthenticateAPIKey.mock
+}
+
+// Set uses given function f to mock the ServiceAccountService.AuthenticateAPIKey method
+func (mmAuthenticateAPIKey *mServiceAccountServiceMockAuthenticateAPIKey) Set(f func(ctx context.Context, key string) (cp1 *models.Claims, err error)) *ServiceAccountServiceMock {
+	if mmAuthenticateAPIKey.defaultExpectation != nil {
+		mmAuthenticateAPIKey.mock.t.Fatalf("Default expectation is already set for the ServiceAccountService.AuthenticateAPIKey method")
+	}
+
+	if len(mmAuthenticateAPIKey.expectations) > 0 {
+		mmAuthenticateAPIKey.mock.t.Fatalf("Some expectations are already set for the ServiceAccountService.AuthenticateAPIKey method")
+	}
+
+	mmAuthenticateAPIKey.mock.funcAuthenticateAPIKey = f
+	mmAuthenticateAPIKey.mock.funcAuthenticateAPIKeyOrigin = minimock.CallerInfo(1)
+	return mmAuthenticateAPIKey.mock
+}
+
+// When sets expectation for the ServiceAccountService.AuthenticateAPIKey which will trigger the result defined by the following
+// Then helper
+func (mmAuthenticateAPIKey *mServiceAccountServiceMockAuthenticateAPIKey) When(ctx context.Context, key string) *ServiceAccountServiceMockAuthenticateAPIKeyExpectation {
+	if mmAuthenticateAPIKey.mock.funcAuthenticateAPIKey != nil {
+		mmAuthenticateAPIKey.mock.t.Fatalf("ServiceAccountServiceMock.AuthenticateAPIKey mock is already set by Set")
+	}
+
+	expectation := &ServiceAccountServiceMockAuthenticateAPIKeyExpectation{
+		mock:               mmAuthenticateAPIKey.mock,
+		params:             &ServiceAccountServiceMockAuthenticateAPIKeyParams{ctx, key},
+		expectationOrigins: ServiceAccountServiceMockAuthenticateAPIKeyExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmAuthenticateAPIKey.expectations = append(mmAuthenticateAPIKey.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ServiceAccountService.AuthenticateAPIKey return parameters for the expectation previously defined by the When method
+func (e *ServiceAccountServiceMockAuthenticateAPIKeyExpectation) Then(cp1 *models.Claims, err error) *ServiceAccountServiceMock {
+	e.results = &ServiceAccountServiceMockAuthenticateAPIKeyResults{cp1, err}
+	return e.mock
+}
+
+// Times sets number of times ServiceAccountService.AuthenticateAPIKey should be invoked
+func (mmAuthenticateAPIKey *mServiceAccountServiceMockAuthenticateAPIKey) Times(n uint64) *mServiceAccountServiceMockAuthenticateAPIKey {
+	if n == 0 {
+		mmAuthenticateAPIKey.mock.t.Fatalf("Times of ServiceAccountServiceMock.AuthenticateAPIKey mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmAuthenticateAPIKey.expectedInvocations, n)
+	mmAuthenticateAPIKey.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmAuthenticateAPIKey
+}
+
+func (mmAuthenticateAPIKey *mServiceAccountServiceMockAuthenticateAPIKey) invocationsDone() bool {
+	if len(mmAuthenticateAPIKey.expectations) == 0 && mmAuthenticateAPIKey.defaultExpectation == nil && mmAuthenticateAPIKey.mock.funcAuthenticateAPIKey == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmAuthenticateAPIKey.mock.afterAuthenticateAPIKeyCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmAuthenticateAPIKey.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// AuthenticateAPIKey implements ServiceAccountService
+func (mmAuthenticateAPIKey *ServiceAccountServiceMock) AuthenticateAPIKey(ctx context.Context, key string) (cp1 *models.Claims, err error) {
+	mm_atomic.AddUint64(&mmAuthenticateAPIKey.beforeAuthenticateAPIKeyCounter, 1)
+	defer mm_atomic.AddUint64(&mmAuthenticateAPIKey.afterAuthenticateAPIKeyCounter, 1)
+
+	mmAuthenticateAPIKey.t.Helper()
+
+	if mmAuthenticateAPIKey.inspectFuncAuthenticateAPIKey != nil {
+		mmAuthenticateAPIKey.inspectFuncAuthenticateAPIKey(ctx, key)
+	}
+
+	mm_params := ServiceAccountServiceMockAuthenticateAPIKeyParams{ctx, key}
+
+	// Record call args
+	mmAuthenticateAPIKey.AuthenticateAPIKeyMock.mutex.Lock()
+	mmAuthenticateAPIKey.AuthenticateAPIKeyMock.callArgs = append(mmAuthenticateAPIKey.AuthenticateAPIKeyMock.callArgs, &mm_params)
+	mmAuthenticateAPIKey.AuthenticateAPIKeyMock.mutex.Unlock()
+
+	for _, e := range mmAuthenticateAPIKey.AuthenticateAPIKeyMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.cp1, e.results.err
+		}
+	}
+
+	if mmAuthenticateAPIKey.AuthenticateAPIKeyMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAuthenticateAPIKey.AuthenticateAPIKeyMock.defaultExpectation.Counter, 1)
+		mm_want := mmAuthenticateAPIKey.AuthenticateAPIKeyMock.defaultExpectation.params
+		mm_want_ptrs := mmAuthenticateAPIKey.AuthenticateAPIKeyMock.defaultExpectation.paramPtrs
+
+		mm_got := ServiceAccountServiceMockAuthenticateAPIKeyParams{ctx, key}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmAuthenticateAPIKey.t.Errorf("ServiceAccountServiceMock.AuthenticateAPIKey got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAuthenticateAPIKey.AuthenticateAPIKeyMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.key != nil && !minimock.Equal(*mm_want_ptrs.key, mm_got.key) {
+				mmAuthenticateAPIKey.t.Errorf("ServiceAccountServiceMock.AuthenticateAPIKey got unexpected parameter key, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAuthenticateAPIKey.AuthenticateAPIKeyMock.defaultExpectation.expectationOrigins.originKey, *mm_want_ptrs.key, mm_got.key, minimock.Diff(*mm_want_ptrs.key, mm_got.key))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmAuthenticateAPIKey.t.Errorf("ServiceAccountServiceMock.AuthenticateAPIKey got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmAuthenticateAPIKey.AuthenticateAPIKeyMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmAuthenticateAPIKey.AuthenticateAPIKeyMock.defaultExpectation.results
+		if mm_results == nil {
+			mmAuthenticateAPIKey.t.Fatal("No results are set for the ServiceAccountServiceMock.AuthenticateAPIKey")
+		}
+		return (*mm_results).cp1, (*mm_results).err
+	}
+	if mmAuthenticateAPIKey.funcAuthenticateAPIKey != nil {
+		return mmAuthenticateAPIKey.funcAuthenticateAPIKey(ctx, key)
+	}
+	mmAuthenticateAPIKey.t.Fatalf("Unexpected call to ServiceAccountServiceMock.AuthenticateAPIKey. %v %v", ctx, key)
+	return
+}
+
+// AuthenticateAPIKeyAfterCounter returns a count of finished ServiceAccountServiceMock.AuthenticateAPIKey invocations
+func (mmAuthenticateAPIKey *ServiceAccountServiceMock) AuthenticateAPIKeyAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAuthenticateAPIKey.afterAuthenticateAPIKeyCounter)
+}
+
+// AuthenticateAPIKeyBeforeCounter returns a count of ServiceAccountServiceMock.AuthenticateAPIKey invocations
+func (mmAuthenticateAPIKey *ServiceAccountServiceMock) AuthenticateAPIKeyBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAuthenticateAPIKey.beforeAuthenticateAPIKeyCounter)
+}
+
+// Calls returns a list of arguments used in each call to ServiceAccountServiceMock.AuthenticateAPIKey.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmAuthenticateAPIKey *mServiceAccountServiceMockAuthenticateAPIKey) Calls() []*ServiceAccountServiceMockAuthenticateAPIKeyParams {
+	mmAuthenticateAPIKey.mutex.RLock()
+
+	argCopy := make([]*ServiceAccountServiceMockAuthenticateAPIKeyParams, len(mmAuthenticateAPIKey.callArgs))
+	copy(argCopy, mmAuthenticateAPIKey.callArgs)
+
+	mmAuthenticateAPIKey.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockAuthenticateAPIKeyDone returns true if the count of the AuthenticateAPIKey invocations corresponds
+// the number of defined expectations
+func (m *ServiceAccountServiceMock) MinimockAuthenticateAPIKeyDone() bool {
+	if m.AuthenticateAPIKeyMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.AuthenticateAPIKeyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.AuthenticateAPIKeyMock.invocationsDone()
+}
+
+// MinimockAuthenticateAPIKeyInspect logs each unmet expectation
+func (m *ServiceAccountServiceMock) MinimockAuthenticateAPIKeyInspect() {
+	for _, e := range m.AuthenticateAPIKeyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ServiceAccountServiceMock.AuthenticateAPIKey at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterAuthenticateAPIKeyCounter := mm_atomic.LoadUint64(&m.afterAuthenticateAPIKeyCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.AuthenticateAPIKeyMock.defaultExpectation != nil && afterAuthenticateAPIKeyCounter < 1 {
+		if m.AuthenticateAPIKeyMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ServiceAccountServiceMock.AuthenticateAPIKey at\n%s", m.AuthenticateAPIKeyMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ServiceAccountServiceMock.AuthenticateAPIKey at\n%s with params: %#v", m.AuthenticateAPIKeyMock.defaultExpectation.expectationOrigins.origin, *m.AuthenticateAPIKeyMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcAuthenticateAPIKey != nil && afterAuthenticateAPIKeyCounter < 1 {
+		m.t.Errorf("Expected call to ServiceAccountServiceMock.AuthenticateAPIKey at\n%s", m.funcAuthenticateAPIKeyOrigin)
+	}
+
+	if !m.AuthenticateAPIKeyMock.invocationsDone() && afterAuthenticateAPIKeyCounter > 0 {
+		m.t.Errorf("Expected %d calls to ServiceAccountServiceMock.AuthenticateAPIKey at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.AuthenticateAPIKeyMock.expectedInvocations), m.AuthenticateAPIKeyMock.expectedInvocationsOrigin, afterAuthenticateAPIKeyCounter)
+	}
+}
+
+type mServiceAccountServiceMockCreateAPIKey struct {
+	optional           bool
+	mock               *ServiceAccountServiceMock
+	defaultExpectation *ServiceAccountServiceMockCreateAPIKeyExpectation
+	expectations       []*ServiceAccountServiceMockCreateAPIKeyExpectation
+
+	callArgs []*ServiceAccountServiceMockCreateAPIKeyParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ServiceAccountServiceMockCreateAPIKeyExpectation specifies expectation struct of the ServiceAccountService.CreateAPIKey
+type ServiceAccountServiceMockCreateAPIKeyExpectation struct {
+	mock               *ServiceAccountServiceMock
+	params             *ServiceAccountServiceMockCreateAPIKeyParams
+	paramPtrs          *ServiceAccountServiceMockCreateAPIKeyParamPtrs
+	expectationOrigins ServiceAccountServiceMockCreateAPIKeyExpectationOrigins
+	results            *ServiceAccountServiceMockCreateAPIKeyResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ServiceAccountServiceMockCreateAPIKeyParams contains parameters of the ServiceAccountService.CreateAPIKey
+type ServiceAccountServiceMockCreateAPIKeyParams struct {
+	ctx       context.Context
+	accountID string
+}
+
+// ServiceAccountServiceMockCreateAPIKeyParamPtrs contains pointers to parameters of the ServiceAccountService.CreateAPIKey
+type ServiceAccountServiceMockCreateAPIKeyParamPtrs struct {
+	ctx       *context.Context
+	accountID *string
+}
+
+// ServiceAccountServiceMockCreateAPIKeyResults contains results of the ServiceAccountService.CreateAPIKey
+type ServiceAccountServiceMockCreateAPIKeyResults struct {
+	ap1 *models.APIKey
+	s1  string
+	err error
+}
+
+// ServiceAccountServiceMockCreateAPIKeyOrigins contains origins of expectations of the ServiceAccountService.CreateAPIKey
+type ServiceAccountServiceMockCreateAPIKeyExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originAccountID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateAPIKey *mServiceAccountServiceMockCreateAPIKey) Optional() *mServiceAccountServiceMockCreateAPIKey {
+	mmCreateAPIKey.optional = true
+	return mmCreateAPIKey
+}
+
+// Expect sets up expected params for ServiceAccountService.CreateAPIKey
+func (mmCreateAPIKey *mServiceAccountServiceMockCreateAPIKey) Expect(ctx context.Context, accountID string) *mServiceAccountServiceMockCreateAPIKey {
+	if mmCreateAPIKey.mock.funcCreateAPIKey != nil {
+		mmCreateAPIKey.mock.t.Fatalf("ServiceAccountServiceMock.CreateAPIKey mock is already set by Set")
+	}
+
+	if mmCreateAPIKey.defaultExpectation == nil {
+		mmCreateAPIKey.defaultExpectation = &ServiceAccountServiceMockCreateAPIKeyExpectation{}
+	}
+
+	if mmCreateAPIKey.defaultExpectation.paramPtrs != nil {
+		mmCreateAPIKey.mock.t.Fatalf("ServiceAccountServiceMock.CreateAPIKey mock is already set by ExpectParams functions")
+	}
+
+	mmCreateAPIKey.defaultExpectation.params = &ServiceAccountServiceMockCreateAPIKeyParams{ctx, accountID}
+	mmCreateAPIKey.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreateAPIKey.expectations {
+		if minimock.Equal(e.params, mmCreateAPIKey.defaultExpectation.params) {
+			mmCreateAPIKey.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateAPIKey.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateAPIKey
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ServiceAccountService.CreateAPIKey
+func (mmCreateAPIKey *mServiceAccountServiceMockCreateAPIKey) ExpectCtxParam1(ctx context.Context) *mServiceAccountServiceMockCreateAPIKey {
+	if mmCreateAPIKey.mock.funcCreateAPIKey != nil {
+		mmCreateAPIKey.mock.t.Fatalf("ServiceAccountServiceMock.CreateAPIKey mock is already set by Set")
+	}
+
+	if mmCreateAPIKey.defaultExpectation == nil {
+		mmCreateAPIKey.defaultExpectation = &ServiceAccountServiceMockCreateAPIKeyExpectation{}
+	}
+
+	if mmCreateAPIKey.defaultExpectation.params != nil {
+		mmCreateAPIKey.mock.t.Fatalf("ServiceAccountServiceMock.CreateAPIKey mock is already set by Expect")
+	}
+
+	if mmCreateAPIKey.defaultExpectation.paramPtrs == nil {
+		mmCreateAPIKey.defaultExpectation.paramPtrs = &ServiceAccountServiceMockCreateAPIKeyParamPtrs{}
+	}
+	mmCreateAPIKey.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreateAPIKey.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreateAPIKey
+}
+
+// ExpectAccountIDParam2 sets up expected param accountID for ServiceAccountService.CreateAPIKey
+func (mmCreateAPIKey *mServiceAccountServiceMockCreateAPIKey) ExpectAccountIDParam2(accountID string) *mServiceAccountServiceMockCreateAPIKey {
+	if mmCreateAPIKey.mock.funcCreateAPIKey != nil {
+		mmCreateAPIKey.mock.t.Fatalf("ServiceAccountServiceMock.CreateAPIKey mock is already set by Set")
+	}
+
+	if mmCreateAPIKey.defaultExpectation == nil {
+		mmCreateAPIKey.defaultExpectation = &ServiceAccountServiceMockCreateAPIKeyExpectation{}
+	}
+
+	if mmCreateAPIKey.defaultExpectation.params != nil {
+		mmCreateAPIKey.mock.t.Fatalf("ServiceAccountServiceMock.CreateAPIKey mock is already set by Expect")
+	}
+
+	if mmCreateAPIKey.defaultExpectation.paramPtrs == nil {
+		mmCreateAPIKey.defaultExpectation.paramPtrs = &ServiceAccountServiceMockCreateAPIKeyParamPtrs{}
+	}
+	mmCreateAPIKey.defaultExpectation.paramPtrs.accountID = &accountID
+	mmCreateAPIKey.defaultExpectation.expectationOrigins.originAccountID = minimock.CallerInfo(1)
+
+	return mmCreateAPIKey
+}
+
+// Inspect accepts an inspector function that has same arguments as the ServiceAccountService.CreateAPIKey
+func (mmCreateAPIKey *mServiceAccountServiceMockCreateAPIKey) Inspect(f func(ctx context.Context, accountID string)) *mServiceAccountServiceMockCreateAPIKey {
+	if mmCreateAPIKey.mock.inspectFuncCreateAPIKey != nil {
+		mmCreateAPIKey.mock.t.Fatalf("Inspect function is already set for ServiceAccountServiceMock.CreateAPIKey")
+	}
+
+	mmCreateAPIKey.mock.inspectFuncCreateAPIKey = f
+
+	return mmCreateAPIKey
+}
+
+// Return sets up results that will be returned by ServiceAccountService.CreateAPIKey
+func (mmCreateAPIKey *mServiceAccountServiceMockCreateAPIKey) Return(ap1 *models.APIKey, s1 string, err error) *ServiceAccountServiceMock {
+	if mmCreateAPIKey.mock.funcCreateAPIKey != nil {
+		mmCreateAPIKey.mock.t.Fatalf("ServiceAccountServiceMock.CreateAPIKey mock is already set by Set")
+	}
+
+	if mmCreateAPIKey.defaultExpectation == nil {
+		mmCreateAPIKey.defaultExpectation = &ServiceAccountServiceMockCreateAPIKeyExpectation{mock: mmCreateAPIKey.mock}
+	}
+	mmCreateAPIKey.defaultExpectation.results = &ServiceAccountServiceMockCreateAPIKeyResults{ap1, s1, err}
+	mmCreateAPIKey.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreateAPIKey.mock
+}
+
+// Set uses given function f to mock the ServiceAccountService.CreateAPIKey method
+func (mmCreateAPIKey *mServiceAccountServiceMockCreateAPIKey) Set(f func(ctx context.Context, accountID string) (ap1 *models.APIKey, s1 string, err error)) *ServiceAccountServiceMock {
+	if mmCreateAPIKey.defaultExpectation != nil {
+		mmCreateAPIKey.mock.t.Fatalf("Default expectation is already set for the ServiceAccountService.CreateAPIKey method")
+	}
+
+	if len(mmCreateAPIKey.expectations) > 0 {
+		mmCreateAPIKey.mock.t.Fatalf("Some expectations are already set for the ServiceAccountService.CreateAPIKey method")
+	}
+
+	mmCreateAPIKey.mock.funcCreateAPIKey = f
+	mmCreateAPIKey.mock.funcCreateAPIKeyOrigin = minimock.CallerInfo(1)
+	return mmCreateAPIKey.mock
+}
+
+// When sets expectation for the ServiceAccountService.CreateAPIKey which will trigger the result defined by the following
+// Then helper
+func (mmCreateAPIKey *mServiceAccountServiceMockCreateAPIKey) When(ctx context.Context, accountID string) *ServiceAccountServiceMockCreateAPIKeyExpectation {
+	if mmCreateAPIKey.mock.funcCreateAPIKey != nil {
+		mmCreateAPIKey.mock.t.Fatalf("ServiceAccountServiceMock.CreateAPIKey mock is already set by Set")
+	}
+
+	expectation := &ServiceAccountServiceMockCreateAPIKeyExpectation{
+		mock:               mmCreateAPIKey.mock,
+		params:             &ServiceAccountServiceMockCreateAPIKeyParams{ctx, accountID},
+		expectationOrigins: ServiceAccountServiceMockCreateAPIKeyExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreateAPIKey.expectations = append(mmCreateAPIKey.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ServiceAccountService.CreateAPIKey return parameters for the expectation previously defined by the When method
+func (e *ServiceAccountServiceMockCreateAPIKeyExpectation) Then(ap1 *models.APIKey, s1 string, err error) *ServiceAccountServiceMock {
+	e.results = &ServiceAccountServiceMockCreateAPIKeyResults{ap1, s1, err}
+	return e.mock
+}
+
+// Times sets number of times ServiceAccountService.CreateAPIKey should be invoked
+func (mmCreateAPIKey *mServiceAccountServiceMockCreateAPIKey) Times(n uint64) *mServiceAccountServiceMockCreateAPIKey {
+	if n == 0 {
+		mmCreateAPIKey.mock.t.Fatalf("Times of ServiceAccountServiceMock.CreateAPIKey mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateAPIKey.expectedInvocations, n)
+	mmCreateAPIKey.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreateAPIKey
+}
+
+func (mmCreateAPIKey *mServiceAccountServiceMockCreateAPIKey) invocationsDone() bool {
+	if len(mmCreateAPIKey.expectations) == 0 && mmCreateAPIKey.defaultExpectation == nil && mmCreateAPIKey.mock.funcCreateAPIKey == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateAPIKey.mock.afterCreateAPIKeyCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateAPIKey.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateAPIKey implements ServiceAccountService
+func (mmCreateAPIKey *ServiceAccountServiceMock) CreateAPIKey(ctx context.Context, accountID string) (ap1 *models.APIKey, s1 string, err error) {
+	mm_atomic.AddUint64(&mmCreateAPIKey.beforeCreateAPIKeyCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateAPIKey.afterCreateAPIKeyCounter, 1)
+
+	mmCreateAPIKey.t.Helper()
+
+	if mmCreateAPIKey.inspectFuncCreateAPIKey != nil {
+		mmCreateAPIKey.inspectFuncCreateAPIKey(ctx, accountID)
+	}
+
+	mm_params := ServiceAccountServiceMockCreateAPIKeyParams{ctx, accountID}
+
+	// Record call args
+	mmCreateAPIKey.CreateAPIKeyMock.mutex.Lock()
+	mmCreateAPIKey.CreateAPIKeyMock.callArgs = append(mmCreateAPIKey.CreateAPIKeyMock.callArgs, &mm_params)
+	mmCreateAPIKey.CreateAPIKeyMock.mutex.Unlock()
+
+	for _, e := range mmCreateAPIKey.CreateAPIKeyMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ap1, e.results.s1, e.results.err
+		}
+	}
+
+	if mmCreateAPIKey.CreateAPIKeyMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateAPIKey.CreateAPIKeyMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateAPIKey.CreateAPIKeyMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateAPIKey.CreateAPIKeyMock.defaultExpectation.paramPtrs
+
+		mm_got := ServiceAccountServiceMockCreateAPIKeyParams{ctx, accountID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateAPIKey.t.Errorf("ServiceAccountServiceMock.CreateAPIKey got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateAPIKey.CreateAPIKeyMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.accountID != nil && !minimock.Equal(*mm_want_ptrs.accountID, mm_got.accountID) {
+				mmCreateAPIKey.t.Errorf("ServiceAccountServiceMock.CreateAPIKey got unexpected parameter accountID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateAPIKey.CreateAPIKeyMock.defaultExpectation.expectationOrigins.originAccountID, *mm_want_ptrs.accountID, mm_got.accountID, minimock.Diff(*mm_want_ptrs.accountID, mm_got.accountID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateAPIKey.t.Errorf("ServiceAccountServiceMock.CreateAPIKey got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreateAPIKey.CreateAPIKeyMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateAPIKey.CreateAPIKeyMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateAPIKey.t.Fatal("No results are set for the ServiceAccountServiceMock.CreateAPIKey")
+		}
+		return (*mm_results).ap1, (*mm_results).s1, (*mm_results).err
+	}
+	if mmCreateAPIKey.funcCreateAPIKey != nil {
+		return mmCreateAPIKey.funcCreateAPIKey(ctx, accountID)
+	}
+	mmCreateAPIKey.t.Fatalf("Unexpected call to ServiceAccountServiceMock.CreateAPIKey. %v %v", ctx, accountID)
+	return
+}
+
+// CreateAPIKeyAfterCounter returns a count of finished ServiceAccountServiceMock.CreateAPIKey invocations
+func (mmCreateAPIKey *ServiceAccountServiceMock) CreateAPIKeyAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateAPIKey.afterCreateAPIKeyCounter)
+}
+
+// CreateAPIKeyBeforeCounter returns a count of ServiceAccountServiceMock.CreateAPIKey invocations
+func (mmCreateAPIKey *ServiceAccountServiceMock) CreateAPIKeyBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateAPIKey.beforeCreateAPIKeyCounter)
+}
+
+// Calls returns a list of arguments used in each call to ServiceAccountServiceMock.CreateAPIKey.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateAPIKey *mServiceAccountServiceMockCreateAPIKey) Calls() []*ServiceAccountServiceMockCreateAPIKeyParams {
+	mmCreateAPIKey.mutex.RLock()
+
+	argCopy := make([]*ServiceAccountServiceMockCreateAPIKeyParams, len(mmCreateAPIKey.callArgs))
+	copy(argCopy, mmCreateAPIKey.callArgs)
+
+	mmCreateAPIKey.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateAPIKeyDone returns true if the count of the CreateAPIKey invocations corresponds
+// the number of defined expectations
+func (m *ServiceAccountServiceMock) MinimockCreateAPIKeyDone() bool {
+	if m.CreateAPIKeyMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateAPIKeyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateAPIKeyMock.invocationsDone()
+}
+
+// MinimockCreateAPIKeyInspect logs each unmet expectation
+func (m *ServiceAccountServiceMock) MinimockCreateAPIKeyInspect() {
+	for _, e := range m.CreateAPIKeyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ServiceAccountServiceMock.CreateAPIKey at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateAPIKeyCounter := mm_atomic.LoadUint64(&m.afterCreateAPIKeyCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateAPIKeyMock.defaultExpectation != nil && afterCreateAPIKeyCounter < 1 {
+		if m.CreateAPIKeyMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ServiceAccountServiceMock.CreateAPIKey at\n%s", m.CreateAPIKeyMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ServiceAccountServiceMock.CreateAPIKey at\n%s with params: %#v", m.CreateAPIKeyMock.defaultExpectation.expectationOrigins.origin, *m.CreateAPIKeyMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateAPIKey != nil && afterCreateAPIKeyCounter < 1 {
+		m.t.Errorf("Expected call to ServiceAccountServiceMock.CreateAPIKey at\n%s", m.funcCreateAPIKeyOrigin)
+	}
+
+	if !m.CreateAPIKeyMock.invocationsDone() && afterCreateAPIKeyCounter > 0 {
+		m.t.Errorf("Expected %d calls to ServiceAccountServiceMock.CreateAPIKey at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateAPIKeyMock.expectedInvocations), m.CreateAPIKeyMock.expectedInvocationsOrigin, afterCreateAPIKeyCounter)
+	}
+}
+
+type mServiceAccountServiceMockCreateServiceAccount struct {
+	optional           bool
+	mock               *ServiceAccountServiceMock
+	defaultExpectation *ServiceAccountServiceMockCreateServiceAccountExpectation
+	expectations       []*ServiceAccountServiceMockCreateServiceAccountExpectation
+
+	callArgs []*ServiceAccountServiceMockCreateServiceAccountParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ServiceAccountServiceMockCreateServiceAccountExpectation specifies expectation struct of the ServiceAccountService.CreateServiceAccount
+type ServiceAccountServiceMockCreateServiceAccountExpectation struct {
+	mock               *ServiceAccountServiceMock
+	params             *ServiceAccountServiceMockCreateServiceAccountParams
+	paramPtrs          *ServiceAccountServiceMockCreateServiceAccountParamPtrs
+	expectationOrigins ServiceAccountServiceMockCreateServiceAccountExpectationOrigins
+	results            *ServiceAccountServiceMockCreateServiceAccountResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ServiceAccountServiceMockCreateServiceAccountParams contains parameters of the ServiceAccountService.CreateServiceAccount
+type ServiceAccountServiceMockCreateServiceAccountParams struct {
+	ctx     context.Context
+	ownerID string
+	name    string
+	roles   []string
+}
+
+// ServiceAccountServiceMockCreateServiceAccountParamPtrs contains pointers to parameters of the ServiceAccountService.CreateServiceAccount
+type ServiceAccountServiceMockCreateServiceAccountParamPtrs struct {
+	ctx     *context.Context
+	ownerID *string
+	name    *string
+	roles   *[]string
+}
+
+// ServiceAccountServiceMockCreateServiceAccountResults contains results of the ServiceAccountService.CreateServiceAccount
+type ServiceAccountServiceMockCreateServiceAccountResults struct {
+	sp1 *models.ServiceAccount
+	err error
+}
+
+// ServiceAccountServiceMockCreateServiceAccountOrigins contains origins of expectations of the ServiceAccountService.CreateServiceAccount
+type ServiceAccountServiceMockCreateServiceAccountExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originOwnerID string
+	originName    string
+	originRoles   string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateServiceAccount *mServiceAccountServiceMockCreateServiceAccount) Optional() *mServiceAccountServiceMockCreateServiceAccount {
+	mmCreateServiceAccount.optional = true
+	return mmCreateServiceAccount
+}
+
+// Expect sets up expected params for ServiceAccountService.CreateServiceAccount
+func (mmCreateServiceAccount *mServiceAccountServiceMockCreateServiceAccount) Expect(ctx context.Context, ownerID string, name string, roles []string) *mServiceAccountServiceMockCreateServiceAccount {
+	if mmCreateServiceAccount.mock.funcCreateServiceAccount != nil {
+		mmCreateServiceAccount.mock.t.Fatalf("ServiceAccountServiceMock.CreateServiceAccount mock is already set by Set")
+	}
+
+	if mmCreateServiceAccount.defaultExpectation == nil {
+		mmCreateServiceAccount.defaultExpectation = &ServiceAccountServiceMockCreateServiceAccountExpectation{}
+	}
+
+	if mmCreateServiceAccount.defaultExpectation.paramPtrs != nil {
+		mmCreateServiceAccount.mock.t.Fatalf("ServiceAccountServiceMock.CreateServiceAccount mock is already set by ExpectParams functions")
+	}
+
+	mmCreateServiceAccount.defaultExpectation.params = &ServiceAccountServiceMockCreateServiceAccountParams{ctx, ownerID, name, roles}
+	mmCreateServiceAccount.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreateServiceAccount.expectations {
+		if minimock.Equal(e.params, mmCreateServiceAccount.defaultExpectation.params) {
+			mmCreateServiceAccount.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateServiceAccount.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateServiceAccount
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ServiceAccountService.CreateServiceAccount
+func (mmCreateServiceAccount *mServiceAccountServiceMockCreateServiceAccount) ExpectCtxParam1(ctx context.Context) *mServiceAccountServiceMockCreateServiceAccount {
+	if mmCreateServiceAccount.mock.funcCreateServiceAccount != nil {
+		mmCreateServiceAccount.mock.t.Fatalf("ServiceAccountServiceMock.CreateServiceAccount mock is already set by Set")
+	}
+
+	if mmCreateServiceAccount.defaultExpectation == nil {
+		mmCreateServiceAccount.defaultExpectation = &ServiceAccountServiceMockCreateServiceAccountExpectation{}
+	}
+
+	if mmCreateServiceAccount.defaultExpectation.params != nil {
+		mmCreateServiceAccount.mock.t.Fatalf("ServiceAccountServiceMock.CreateServiceAccount mock is already set by Expect")
+	}
+
+	if mmCreateServiceAccount.defaultExpectation.paramPtrs == nil {
+		mmCreateServiceAccount.defaultExpectation.paramPtrs = &ServiceAccountServiceMockCreateServiceAccountParamPtrs{}
+	}
+	mmCreateServiceAccount.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreateServiceAccount.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreateServiceAccount
+}
+
+// ExpectOwnerIDParam2 sets up expected param ownerID for ServiceAccountService.CreateServiceAccount
+func (mmCreateServiceAccount *mServiceAccountServiceMockCreateServiceAccount) ExpectOwnerIDParam2(ownerID string) *mServiceAccountServiceMockCreateServiceAccount {
+	if mmCreateServiceAccount.mock.funcCreateServiceAccount != nil {
+		mmCreateServiceAccount.mock.t.Fatalf("ServiceAccountServiceMock.CreateServiceAccount mock is already set by Set")
+	}
+
+	if mmCreateServiceAccount.defaultExpectation == nil {
+		mmCreateServiceAccount.defaultExpectation = &ServiceAccountServiceMockCreateServiceAccountExpectation{}
+	}
+
+	if mmCreateServiceAccount.defaultExpectation.params != nil {
+		mmCreateServiceAccount.mock.t.Fatalf("ServiceAccountServiceMock.CreateServiceAccount mock is already set by Expect")
+	}
+
+	if mmCreateServiceAccount.defaultExpectation.paramPtrs == nil {
+		mmCreateServiceAccount.defaultExpectation.paramPtrs = &ServiceAccountServiceMockCreateServiceAccountParamPtrs{}
+	}
+	mmCreateServiceAccount.defaultExpectation.paramPtrs.ownerID = &ownerID
+	mmCreateServiceAccount.defaultExpectation.expectationOrigins.originOwnerID = minimock.CallerInfo(1)
+
+	return mmCreateServiceAccount
+}
+
+// ExpectNameParam3 sets up expected param name for ServiceAccountService.CreateServiceAccount
+func (mmCreateServiceAccount *mServiceAccountServiceMockCreateServiceAccount) ExpectNameParam3(name string) *mServiceAccountServiceMockCreateServiceAccount {
+	if mmCreateServiceAccount.mock.funcCreateServiceAccount != nil {
+		mmCreateServiceAccount.mock.t.Fatalf("ServiceAccountServiceMock.CreateServiceAccount mock is already set by Set")
+	}
+
+	if mmCreateServiceAccount.defaultExpectation == nil {
+		mmCreateServiceAccount.defaultExpectation = &ServiceAccountServiceMockCreateServiceAccountExpectation{}
+	}
+
+	if mmCreateServiceAccount.defaultExpectation.params != nil {
+		mmCreateServiceAccount.mock.t.Fatalf("ServiceAccountServiceMock.CreateServiceAccount mock is already set by Expect")
+	}
+
+	if mmCreateServiceAccount.defaultExpectation.paramPtrs == nil {
+		mmCreateServiceAccount.defaultExpectation.paramPtrs = &ServiceAccountServiceMockCreateServiceAccountParamPtrs{}
+	}
+	mmCreateServiceAccount.defaultExpectation.paramPtrs.name = &name
+	mmCreateServiceAccount.defaultExpectation.expectationOrigins.originName = minimock.CallerInfo(1)
+
+	return mmCreateServiceAccount
+}
+
+// ExpectRolesParam4 sets up expected param roles for ServiceAccountService.CreateServiceAccount
+func (mmCreateServiceAccount *mServiceAccountServiceMockCreateServiceAccount) ExpectRolesParam4(roles []string) *mServiceAccountServiceMockCreateServiceAccount {
+	if mmCreateServiceAccount.mock.funcCreateServiceAccount != nil {
+		mmCreateServiceAccount.mock.t.Fatalf("ServiceAccountServiceMock.CreateServiceAccount mock is already set by Set")
+	}
+
+	if mmCreateServiceAccount.defaultExpectation == nil {
+		mmCreateServiceAccount.defaultExpectation = &ServiceAccountServiceMockCreateServiceAccountExpectation{}
+	}
+
+	if mmCreateServiceAccount.defaultExpectation.params != nil {
+		mmCreateServiceAccount.mock.t.Fatalf("ServiceAccountServiceMock.CreateServiceAccount mock is already set by Expect")
+	}
+
+	if mmCreateServiceAccount.defaultExpectation.paramPtrs == nil {
+		mmCreateServiceAccount.defaultExpectation.paramPtrs = &ServiceAccountServiceMockCreateServiceAccountParamPtrs{}
+	}
+	mmCreateServiceAccount.defaultExpectation.paramPtrs.roles = &roles
+	mmCreateServiceAccount.defaultExpectation.expectationOrigins.originRoles = minimock.CallerInfo(1)
+
+	return mmCreateServiceAccount
+}
+
+// Inspect accepts an inspector function that has same arguments as the ServiceAccountService.CreateServiceAccount
+func (mmCreateServiceAccount *mServiceAccountServiceMockCreateServiceAccount) Inspect(f func(ctx context.Context, ownerID string, name string, roles []string)) *mServiceAccountServiceMockCreateServiceAccount {
+	if mmCreateServiceAccount.mock.inspectFuncCreateServiceAccount != nil {
+		mmCreateServiceAccount.mock.t.Fatalf("Inspect function is already set for ServiceAccountServiceMock.CreateServiceAccount")
+	}
+
+	mmCreateServiceAccount.mock.inspectFuncCreateServiceAccount = f
+
+	return mmCreateServiceAccount
+}
+
+// Return sets up results that will be returned by ServiceAccountService.CreateServiceAccount
+func (mmCreateServiceAccount *mServiceAccountServiceMockCreateServiceAccount) Return(sp1 *models.ServiceAccount, err error) *ServiceAccountServiceMock {
+	if mmCreateServiceAccount.mock.funcCreateServiceAccount != nil {
+		mmCreateServiceAccount.mock.t.Fatalf("ServiceAccountServiceMock.CreateServiceAccount mock is already set by Set")
+	}
+
+	if mmCreateServiceAccount.defaultExpectation == nil {
+		mmCreateServiceAccount.defaultExpectation = &ServiceAccountServiceMockCreateServiceAccountExpectation{mock: mmCreateServiceAccount.mock}
+	}
+	mmCreateServiceAccount.defaultExpectation.results = &ServiceAccountServiceMockCreateServiceAccountResults{sp1, err}
+	mmCreateServiceAccount.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreateServiceAccount.mock
+}
+
+// Set uses given function f to mock the ServiceAccountService.CreateServiceAccount method
+func (mmCreateServiceAccount *mServiceAccountServiceMockCreateServiceAccount) Set(f func(ctx context.Context, ownerID string, name string, roles []string) (sp1 *models.ServiceAccount, err error)) *ServiceAccountServiceMock {
+	if mmCreateServiceAccount.defaultExpectation != nil {
+		mmCreateServiceAccount.mock.t.Fatalf("Default expectation is already set for the ServiceAccountService.CreateServiceAccount method")
+	}
+
+	if len(mmCreateServiceAccount.expectations) > 0 {
+		mmCreateServiceAccount.mock.t.Fatalf("Some expectations are already set for the ServiceAccountService.CreateServiceAccount method")
+	}
+
+	mmCreateServiceAccount.mock.funcCreateServiceAccount = f
+	mmCreateServiceAccount.mock.funcCreateServiceAccountOrigin = minimock.CallerInfo(1)
+	return mmCreateServiceAccount.mock
+}
+
+// When sets expectation for the ServiceAccountService.CreateServiceAccount which will trigger the result defined by the following
+// Then helper
+func (mmCreateServiceAccount *mServiceAccountServiceMockCreateServiceAccount) When(ctx context.Context, ownerID string, name string, roles []string) *ServiceAccountServiceMockCreateServiceAccountExpectation {
+	if mmCreateServiceAccount.mock.funcCreateServiceAccount != nil {
+		mmCreateServiceAccount.mock.t.Fatalf("ServiceAccountServiceMock.CreateServiceAccount mock is already set by Set")
+	}
+
+	expectation := &ServiceAccountServiceMockCreateServiceAccountExpectation{
+		mock:               mmCreateServiceAccount.mock,
+		params:             &ServiceAccountServiceMockCreateServiceAccountParams{ctx, ownerID, name, roles},
+		expectationOrigins: ServiceAccountServiceMockCreateServiceAccountExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreateServiceAccount.expectations = append(mmCreateServiceAccount.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ServiceAccountService.CreateServiceAccount return parameters for the expectation previously defined by the When method
+func (e *ServiceAccountServiceMockCreateServiceAccountExpectation) Then(sp1 *models.ServiceAccount, err error) *ServiceAccountServiceMock {
+	e.results = &ServiceAccountServiceMockCreateServiceAccountResults{sp1, err}
+	return e.mock
+}
+
+// Times sets number of times ServiceAccountService.CreateServiceAccount should be invoked
+func (mmCreateServiceAccount *mServiceAccountServiceMockCreateServiceAccount) Times(n uint64) *mServiceAccountServiceMockCreateServiceAccount {
+	if n == 0 {
+		mmCreateServiceAccount.mock.t.Fatalf("Times of ServiceAccountServiceMock.CreateServiceAccount mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateServiceAccount.expectedInvocations, n)
+	mmCreateServiceAccount.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreateServiceAccount
+}
+
+func (mmCreateServiceAccount *mServiceAccountServiceMockCreateServiceAccount) invocationsDone() bool {
+	if len(mmCreateServiceAccount.expectations) == 0 && mmCreateServiceAccount.defaultExpectation == nil && mmCreateServiceAccount.mock.funcCreateServiceAccount == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateServiceAccount.mock.afterCreateServiceAccountCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateServiceAccount.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateServiceAccount implements ServiceAccountService
+func (mmCreateServiceAccount *ServiceAccountServiceMock) CreateServiceAccount(ctx context.Context, ownerID string, name string, roles []string) (sp1 *models.ServiceAccount, err error) {
+	mm_atomic.AddUint64(&mmCreateServiceAccount.beforeCreateServiceAccountCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateServiceAccount.afterCreateServiceAccountCounter, 1)
+
+	mmCreateServiceAccount.t.Helper()
+
+	if mmCreateServiceAccount.inspectFuncCreateServiceAccount != nil {
+		mmCreateServiceAccount.inspectFuncCreateServiceAccount(ctx, ownerID, name, roles)
+	}
+
+	mm_params := ServiceAccountServiceMockCreateServiceAccountParams{ctx, ownerID, name, roles}
+
+	// Record call args
+	mmCreateServiceAccount.CreateServiceAccountMock.mutex.Lock()
+	mmCreateServiceAccount.CreateServiceAccountMock.callArgs = append(mmCreateServiceAccount.CreateServiceAccountMock.callArgs, &mm_params)
+	mmCreateServiceAccount.CreateServiceAccountMock.mutex.Unlock()
+
+	for _, e := range mmCreateServiceAccount.CreateServiceAccountMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.sp1, e.results.err
+		}
+	}
+
+	if mmCreateServiceAccount.CreateServiceAccountMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateServiceAccount.CreateServiceAccountMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateServiceAccount.CreateServiceAccountMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateServiceAccount.CreateServiceAccountMock.defaultExpectation.paramPtrs
+
+		mm_got := ServiceAccountServiceMockCreateServiceAccountParams{ctx, ownerID, name, roles}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateServiceAccount.t.Errorf("ServiceAccountServiceMock.CreateServiceAccount got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateServiceAccount.CreateServiceAccountMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.ownerID != nil && !minimock.Equal(*mm_want_ptrs.ownerID, mm_got.ownerID) {
+				mmCreateServiceAccount.t.Errorf("ServiceAccountServiceMock.CreateServiceAccount got unexpected parameter ownerID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateServiceAccount.CreateServiceAccountMock.defaultExpectation.expectationOrigins.originOwnerID, *mm_want_ptrs.ownerID, mm_got.ownerID, minimock.Diff(*mm_want_ptrs.ownerID, mm_got.ownerID))
+			}
+
+			if mm_want_ptrs.name != nil && !minimock.Equal(*mm_want_ptrs.name, mm_got.name) {
+				mmCreateServiceAccount.t.Errorf("ServiceAccountServiceMock.CreateServiceAccount got unexpected parameter name, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateServiceAccount.CreateServiceAccountMock.defaultExpectation.expectationOrigins.originName, *mm_want_ptrs.name, mm_got.name, minimock.Diff(*mm_want_ptrs.name, mm_got.name))
+			}
+
+			if mm_want_ptrs.roles != nil && !minimock.Equal(*mm_want_ptrs.roles, mm_got.roles) {
+				mmCreateServiceAccount.t.Errorf("ServiceAccountServiceMock.CreateServiceAccount got unexpected parameter roles, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateServiceAccount.CreateServiceAccountMock.defaultExpectation.expectationOrigins.originRoles, *mm_want_ptrs.roles, mm_got.roles, minimock.Diff(*mm_want_ptrs.roles, mm_got.roles))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateServiceAccount.t.Errorf("ServiceAccountServiceMock.CreateServiceAccount got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreateServiceAccount.CreateServiceAccountMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateServiceAccount.CreateServiceAccountMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateServiceAccount.t.Fatal("No results are set for the ServiceAccountServiceMock.CreateServiceAccount")
+		}
+		return (*mm_results).sp1, (*mm_results).err
+	}
+	if mmCreateServiceAccount.funcCreateServiceAccount != nil {
+		return mmCreateServiceAccount.funcCreateServiceAccount(ctx, ownerID, name, roles)
+	}
+	mmCreateServiceAccount.t.Fatalf("Unexpected call to ServiceAccountServiceMock.CreateServiceAccount. %v %v %v %v", ctx, ownerID, name, roles)
+	return
+}
+
+// CreateServiceAccountAfterCounter returns a count of finished ServiceAccountServiceMock.CreateServiceAccount invocations
+func (mmCreateServiceAccount *ServiceAccountServiceMock) CreateServiceAccountAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateServiceAccount.afterCreateServiceAccountCounter)
+}
+
+// CreateServiceAccountBeforeCounter returns a count of ServiceAccountServiceMock.CreateServiceAccount invocations
+func (mmCreateServiceAccount *ServiceAccountServiceMock) CreateServiceAccountBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateServiceAccount.beforeCreateServiceAccountCounter)
+}
+
+// Calls returns a list of arguments used in each call to ServiceAccountServiceMock.CreateServiceAccount.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateServiceAccount *mServiceAccountServiceMockCreateServiceAccount) Calls() []*ServiceAccountServiceMockCreateServiceAccountParams {
+	mmCreateServiceAccount.mutex.RLock()
+
+	argCopy := make([]*ServiceAccountServiceMockCreateServiceAccountParams, len(mmCreateServiceAccount.callArgs))
+	copy(argCopy, mmCreateServiceAccount.callArgs)
+
+	mmCreateServiceAccount.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateServiceAccountDone returns true if the count of the CreateServiceAccount invocations corresponds
+// the number of defined expectations
+func (m *ServiceAccountServiceMock) MinimockCreateServiceAccountDone() bool {
+	if m.CreateServiceAccountMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateServiceAccountMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateServiceAccountMock.invocationsDone()
+}
+
+// MinimockCreateServiceAccountInspect logs each unmet expectation
+func (m *ServiceAccountServiceMock) MinimockCreateServiceAccountInspect() {
+	for _, e := range m.CreateServiceAccountMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ServiceAccountServiceMock.CreateServiceAccount at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateServiceAccountCounter := mm_atomic.LoadUint64(&m.afterCreateServiceAccountCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateServiceAccountMock.defaultExpectation != nil && afterCreateServiceAccountCounter < 1 {
+		if m.CreateServiceAccountMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ServiceAccountServiceMock.CreateServiceAccount at\n%s", m.CreateServiceAccountMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ServiceAccountServiceMock.CreateServiceAccount at\n%s with params: %#v", m.CreateServiceAccountMock.defaultExpectation.expectationOrigins.origin, *m.CreateServiceAccountMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateServiceAccount != nil && afterCreateServiceAccountCounter < 1 {
+		m.t.Errorf("Expected call to ServiceAccountServiceMock.CreateServiceAccount at\n%s", m.funcCreateServiceAccountOrigin)
+	}
+
+	if !m.CreateServiceAccountMock.invocationsDone() && afterCreateServiceAccountCounter > 0 {
+		m.t.Errorf("Expected %d calls to ServiceAccountServiceMock.CreateServiceAccount at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateServiceAccountMock.expectedInvocations), m.CreateServiceAccountMock.expectedInvocationsOrigin, afterCreateServiceAccountCounter)
+	}
+}
+
+type mServiceAccountServiceMockDeleteAPIKey struct {
+	optional           bool
+	mock               *ServiceAccountServiceMock
+	defaultExpectation *ServiceAccountServiceMockDeleteAPIKeyExpectation
+	expectations       []*ServiceAccountServiceMockDeleteAPIKeyExpectation
+
+	callArgs []*ServiceAccountServiceMockDeleteAPIKeyParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ServiceAccountServiceMockDeleteAPIKeyExpectation specifies expectation struct of the ServiceAccountService.DeleteAPIKey
+type ServiceAccountServiceMockDeleteAPIKeyExpectation struct {
+	mock               *ServiceAccountServiceMock
+	params             *ServiceAccountServiceMockDeleteAPIKeyParams
+	paramPtrs          *ServiceAccountServiceMockDeleteAPIKeyParamPtrs
+	expectationOrigins ServiceAccountServiceMockDeleteAPIKeyExpectationOrigins
+	results            *ServiceAccountServiceMockDeleteAPIKeyResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ServiceAccountServiceMockDeleteAPIKeyParams contains parameters of the ServiceAccountService.DeleteAPIKey
+type ServiceAccountServiceMockDeleteAPIKeyParams struct {
+	ctx       context.Context
+	accountID string
+	keyID     string
+}
+
+// ServiceAccountServiceMockDeleteAPIKeyParamPtrs contains pointers to parameters of the ServiceAccountService.DeleteAPIKey
+type ServiceAccountServiceMockDeleteAPIKeyParamPtrs struct {
+	ctx       *context.Context
+	accountID *string
+	keyID     *string
+}
+
+// ServiceAccountServiceMockDeleteAPIKeyResults contains results of the ServiceAccountService.DeleteAPIKey
+type ServiceAccountServiceMockDeleteAPIKeyResults struct {
+	err error
+}
+
+// ServiceAccountServiceMockDeleteAPIKeyOrigins contains origins of expectations of the ServiceAccountService.DeleteAPIKey
+type ServiceAccountServiceMockDeleteAPIKeyExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originAccountID string
+	originKeyID     string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteAPIKey *mServiceAccountServiceMockDeleteAPIKey) Optional() *mServiceAccountServiceMockDeleteAPIKey {
+	mmDeleteAPIKey.optional = true
+	return mmDeleteAPIKey
+}
+
+// Expect sets up expected params for ServiceAccountService.DeleteAPIKey
+func (mmDeleteAPIKey *mServiceAccountServiceMockDeleteAPIKey) Expect(ctx context.Context, accountID string, keyID string) *mServiceAccountServiceMockDeleteAPIKey {
+	if mmDeleteAPIKey.mock.funcDeleteAPIKey != nil {
+		mmDeleteAPIKey.mock.t.Fatalf("ServiceAccountServiceMock.DeleteAPIKey mock is already set by Set")
+	}
+
+	if mmDeleteAPIKey.defaultExpectation == nil {
+		mmDeleteAPIKey.defaultExpectation = &ServiceAccountServiceMockDeleteAPIKeyExpectation{}
+	}
+
+	if mmDeleteAPIKey.defaultExpectation.paramPtrs != nil {
+		mmDeleteAPIKey.mock.t.Fatalf("ServiceAccountServiceMock.DeleteAPIKey mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteAPIKey.defaultExpectation.params = &ServiceAccountServiceMockDeleteAPIKeyParams{ctx, accountID, keyID}
+	mmDeleteAPIKey.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteAPIKey.expectations {
+		if minimock.Equal(e.params, mmDeleteAPIKey.defaultExpectation.params) {
+			mmDeleteAPIKey.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteAPIKey.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteAPIKey
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ServiceAccountService.DeleteAPIKey
+func (mmDeleteAPIKey *mServiceAccountServiceMockDeleteAPIKey) ExpectCtxParam1(ctx context.Context) *mServiceAccountServiceMockDeleteAPIKey {
+	if mmDeleteAPIKey.mock.funcDeleteAPIKey != nil {
+		mmDeleteAPIKey.mock.t.Fatalf("ServiceAccountServiceMock.DeleteAPIKey mock is already set by Set")
+	}
+
+	if mmDeleteAPIKey.defaultExpectation == nil {
+		mmDeleteAPIKey.defaultExpectation = &ServiceAccountServiceMockDeleteAPIKeyExpectation{}
+	}
+
+	if mmDeleteAPIKey.defaultExpectation.params != nil {
+		mmDeleteAPIKey.mock.t.Fatalf("ServiceAccountServiceMock.DeleteAPIKey mock is already set by Expect")
+	}
+
+	if mmDeleteAPIKey.defaultExpectation.paramPtrs == nil {
+		mmDeleteAPIKey.defaultExpectation.paramPtrs = &ServiceAccountServiceMockDeleteAPIKeyParamPtrs{}
+	}
+	mmDeleteAPIKey.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteAPIKey.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteAPIKey
+}
+
+// ExpectAccountIDParam2 sets up expected param accountID for ServiceAccountService.DeleteAPIKey
+func (mmDeleteAPIKey *mServiceAccountServiceMockDeleteAPIKey) ExpectAccountIDParam2(accountID string) *mServiceAccountServiceMockDeleteAPIKey {
+	if mmDeleteAPIKey.mock.funcDeleteAPIKey != nil {
+		mmDeleteAPIKey.mock.t.Fatalf("ServiceAccountServiceMock.DeleteAPIKey mock is already set by Set")
+	}
+
+	if mmDeleteAPIKey.defaultExpectation == nil {
+		mmDeleteAPIKey.defaultExpectation = &ServiceAccountServiceMockDeleteAPIKeyExpectation{}
+	}
+
+	if mmDeleteAPIKey.defaultExpectation.params != nil {
+		mmDeleteAPIKey.mock.t.Fatalf("ServiceAccountServiceMock.DeleteAPIKey mock is already set by Expect")
+	}
+
+	if mmDeleteAPIKey.defaultExpectation.paramPtrs == nil {
+		mmDeleteAPIKey.defaultExpectation.paramPtrs = &ServiceAccountServiceMockDeleteAPIKeyParamPtrs{}
+	}
+	mmDeleteAPIKey.defaultExpectation.paramPtrs.accountID = &accountID
+	mmDeleteAPIKey.defaultExpectation.expectationOrigins.originAccountID = minimock.CallerInfo(1)
+
+	return mmDeleteAPIKey
+}
+
+// ExpectKeyIDParam3 sets up expected param keyID for ServiceAccountService.DeleteAPIKey
+func (mmDeleteAPIKey *mServiceAccountServiceMockDeleteAPIKey) ExpectKeyIDParam3(keyID string) *mServiceAccountServiceMockDeleteAPIKey {
+	if mmDeleteAPIKey.mock.funcDeleteAPIKey != nil {
+		mmDeleteAPIKey.mock.t.Fatalf("ServiceAccountServiceMock.DeleteAPIKey mock is already set by Set")
+	}
+
+	if mmDeleteAPIKey.defaultExpectation == nil {
+		mmDeleteAPIKey.defaultExpectation = &ServiceAccountServiceMockDeleteAPIKeyExpectation{}
+	}
+
+	if mmDeleteAPIKey.defaultExpectation.params != nil {
+		mmDeleteAPIKey.mock.t.Fatalf("ServiceAccountServiceMock.DeleteAPIKey mock is already set by Expect")
+	}
+
+	if mmDeleteAPIKey.defaultExpectation.paramPtrs == nil {
+		mmDeleteAPIKey.defaultExpectation.paramPtrs = &ServiceAccountServiceMockDeleteAPIKeyParamPtrs{}
+	}
+	mmDeleteAPIKey.defaultExpectation.paramPtrs.keyID = &keyID
+	mmDeleteAPIKey.defaultExpectation.expectationOrigins.originKeyID = minimock.CallerInfo(1)
+
+	return mmDeleteAPIKey
+}
+
+// Inspect accepts an inspector function that has same arguments as the ServiceAccountService.DeleteAPIKey
+func (mmDeleteAPIKey *mServiceAccountServiceMockDeleteAPIKey) Inspect(f func(ctx context.Context, accountID string, keyID string)) *mServiceAccountServiceMockDeleteAPIKey {
+	if mmDeleteAPIKey.mock.inspectFuncDeleteAPIKey != nil {
+		mmDeleteAPIKey.mock.t.Fatalf("Inspect function is already set for ServiceAccountServiceMock.DeleteAPIKey")
+	}
+
+	mmDeleteAPIKey.mock.inspectFuncDeleteAPIKey = f
+
+	return mmDeleteAPIKey
+}
+
+// Return sets up results that will be returned by ServiceAccountService.DeleteAPIKey
+func (mmDeleteAPIKey *mServiceAccountServiceMockDeleteAPIKey) Return(err error) *ServiceAccountServiceMock {
+	if mmDeleteAPIKey.mock.funcDeleteAPIKey != nil {
+		mmDeleteAPIKey.mock.t.Fatalf("ServiceAccountServiceMock.DeleteAPIKey mock is already set by Set")
+	}
+
+	if mmDeleteAPIKey.defaultExpectation == nil {
+		mmDeleteAPIKey.defaultExpectation = &ServiceAccountServiceMockDeleteAPIKeyExpectation{mock: mmDeleteAPIKey.mock}
+	}
+	mmDeleteAPIKey.defaultExpectation.results = &ServiceAccountServiceMockDeleteAPIKeyResults{err}
+	mmDeleteAPIKey.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteAPIKey.mock
+}
+
+// Set uses given function f to mock the ServiceAccountService.DeleteAPIKey method
+func (mmDeleteAPIKey *mServiceAccountServiceMockDeleteAPIKey) Set(f func(ctx context.Context, accountID string, keyID string) (err error)) *ServiceAccountServiceMock {
+	if mmDeleteAPIKey.defaultExpectation != nil {
+		mmDeleteAPIKey.mock.t.Fatalf("Default expectation is already set for the ServiceAccountService.DeleteAPIKey method")
+	}
+
+	if len(mmDeleteAPIKey.expectations) > 0 {
+		mmDeleteAPIKey.mock.t.Fatalf("Some expectations are already set for the ServiceAccountService.DeleteAPIKey method")
+	}
+
+	mmDeleteAPIKey.mock.funcDeleteAPIKey = f
+	mmDeleteAPIKey.mock.funcDeleteAPIKeyOrigin = minimock.CallerInfo(1)
+	return mmDeleteAPIKey.mock
+}
+
+// When sets expectation for the ServiceAccountService.DeleteAPIKey which will trigger the result defined by the following
+// Then helper
+func (mmDeleteAPIKey *mServiceAccountServiceMockDeleteAPIKey) When(ctx context.Context, accountID string, keyID string) *ServiceAccountServiceMockDeleteAPIKeyExpectation {
+	if mmDeleteAPIKey.mock.funcDeleteAPIKey != nil {
+		mmDeleteAPIKey.mock.t.Fatalf("ServiceAccountServiceMock.DeleteAPIKey mock is already set by Set")
+	}
+
+	expectation := &ServiceAccountServiceMockDeleteAPIKeyExpectation{
+		mock:               mmDeleteAPIKey.mock,
+		params:             &ServiceAccountServiceMockDeleteAPIKeyParams{ctx, accountID, keyID},
+		expectationOrigins: ServiceAccountServiceMockDeleteAPIKeyExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteAPIKey.expectations = append(mmDeleteAPIKey.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ServiceAccountService.DeleteAPIKey return parameters for the expectation previously defined by the When method
+func (e *ServiceAccountServiceMockDeleteAPIKeyExpectation) Then(err error) *ServiceAccountServiceMock {
+	e.results = &ServiceAccountServiceMockDeleteAPIKeyResults{err}
+	return e.mock
+}
+
+// Times sets number of times ServiceAccountService.DeleteAPIKey should be invoked
+func (mmDeleteAPIKey *mServiceAccountServiceMockDeleteAPIKey) Times(n uint64) *mServiceAccountServiceMockDeleteAPIKey {
+	if n == 0 {
+		mmDeleteAPIKey.mock.t.Fatalf("Times of ServiceAccountServiceMock.DeleteAPIKey mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteAPIKey.expectedInvocations, n)
+	mmDeleteAPIKey.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteAPIKey
+}
+
+func (mmDeleteAPIKey *mServiceAccountServiceMockDeleteAPIKey) invocationsDone() bool {
+	if len(mmDeleteAPIKey.expectations) == 0 && mmDeleteAPIKey.defaultExpectation == nil && mmDeleteAPIKey.mock.funcDeleteAPIKey == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteAPIKey.mock.afterDeleteAPIKeyCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteAPIKey.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteAPIKey implements ServiceAccountService
+func (mmDeleteAPIKey *ServiceAccountServiceMock) DeleteAPIKey(ctx context.Context, accountID string, keyID string) (err error) {
+	mm_atomic.AddUint64(&mmDeleteAPIKey.beforeDeleteAPIKeyCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteAPIKey.afterDeleteAPIKeyCounter, 1)
+
+	mmDeleteAPIKey.t.Helper()
+
+	if mmDeleteAPIKey.inspectFuncDeleteAPIKey != nil {
+		mmDeleteAPIKey.inspectFuncDeleteAPIKey(ctx, accountID, keyID)
+	}
+
+	mm_params := ServiceAccountServiceMockDeleteAPIKeyParams{ctx, accountID, keyID}
+
+	// Record call args
+	mmDeleteAPIKey.DeleteAPIKeyMock.mutex.Lock()
+	mmDeleteAPIKey.DeleteAPIKeyMock.callArgs = append(mmDeleteAPIKey.DeleteAPIKeyMock.callArgs, &mm_params)
+	mmDeleteAPIKey.DeleteAPIKeyMock.mutex.Unlock()
+
+	for _, e := range mmDeleteAPIKey.DeleteAPIKeyMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteAPIKey.DeleteAPIKeyMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteAPIKey.DeleteAPIKeyMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteAPIKey.DeleteAPIKeyMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteAPIKey.DeleteAPIKeyMock.defaultExpectation.paramPtrs
+
+		mm_got := ServiceAccountServiceMockDeleteAPIKeyParams{ctx, accountID, keyID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteAPIKey.t.Errorf("ServiceAccountServiceMock.DeleteAPIKey got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteAPIKey.DeleteAPIKeyMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.accountID != nil && !minimock.Equal(*mm_want_ptrs.accountID, mm_got.accountID) {
+				mmDeleteAPIKey.t.Errorf("ServiceAccountServiceMock.DeleteAPIKey got unexpected parameter accountID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteAPIKey.DeleteAPIKeyMock.defaultExpectation.expectationOrigins.originAccountID, *mm_want_ptrs.accountID, mm_got.accountID, minimock.Diff(*mm_want_ptrs.accountID, mm_got.accountID))
+			}
+
+			if mm_want_ptrs.keyID != nil && !minimock.Equal(*mm_want_ptrs.keyID, mm_got.keyID) {
+				mmDeleteAPIKey.t.Errorf("ServiceAccountServiceMock.DeleteAPIKey got unexpected parameter keyID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteAPIKey.DeleteAPIKeyMock.defaultExpectation.expectationOrigins.originKeyID, *mm_want_ptrs.keyID, mm_got.keyID, minimock.Diff(*mm_want_ptrs.keyID, mm_got.keyID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteAPIKey.t.Errorf("ServiceAccountServiceMock.DeleteAPIKey got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteAPIKey.DeleteAPIKeyMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteAPIKey.DeleteAPIKeyMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteAPIKey.t.Fatal("No results are set for the ServiceAccountServiceMock.DeleteAPIKey")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteAPIKey.funcDeleteAPIKey != nil {
+		return mmDeleteAPIKey.funcDeleteAPIKey(ctx, accountID, keyID)
+	}
+	mmDeleteAPIKey.t.Fatalf("Unexpected call to ServiceAccountServiceMock.DeleteAPIKey. %v %v %v", ctx, accountID, keyID)
+	return
+}
+
+// DeleteAPIKeyAfterCounter returns a count of finished ServiceAccountServiceMock.DeleteAPIKey invocations
+func (mmDeleteAPIKey *ServiceAccountServiceMock) DeleteAPIKeyAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteAPIKey.afterDeleteAPIKeyCounter)
+}
+
+// DeleteAPIKeyBeforeCounter returns a count of ServiceAccountServiceMock.DeleteAPIKey invocations
+func (mmDeleteAPIKey *ServiceAccountServiceMock) DeleteAPIKeyBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteAPIKey.beforeDeleteAPIKeyCounter)
+}
+
+// Calls returns a list of arguments used in each call to ServiceAccountServiceMock.DeleteAPIKey.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteAPIKey *mServiceAccountServiceMockDeleteAPIKey) Calls() []*ServiceAccountServiceMockDeleteAPIKeyParams {
+	mmDeleteAPIKey.mutex.RLock()
+
+	argCopy := make([]*ServiceAccountServiceMockDeleteAPIKeyParams, len(mmDeleteAPIKey.callArgs))
+	copy(argCopy, mmDeleteAPIKey.callArgs)
+
+	mmDeleteAPIKey.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteAPIKeyDone returns true if the count of the DeleteAPIKey invocations corresponds
+// the number of defined expectations
+func (m *ServiceAccountServiceMock) MinimockDeleteAPIKeyDone() bool {
+	if m.DeleteAPIKeyMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteAPIKeyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteAPIKeyMock.invocationsDone()
+}
+
+// MinimockDeleteAPIKeyInspect logs each unmet expectation
+func (m *ServiceAccountServiceMock) MinimockDeleteAPIKeyInspect() {
+	for _, e := range m.DeleteAPIKeyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ServiceAccountServiceMock.DeleteAPIKey at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteAPIKeyCounter := mm_atomic.LoadUint64(&m.afterDeleteAPIKeyCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteAPIKeyMock.defaultExpectation != nil && afterDeleteAPIKeyCounter < 1 {
+		if m.DeleteAPIKeyMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ServiceAccountServiceMock.DeleteAPIKey at\n%s", m.DeleteAPIKeyMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ServiceAccountServiceMock.DeleteAPIKey at\n%s with params: %#v", m.DeleteAPIKeyMock.defaultExpectation.expectationOrigins.origin, *m.DeleteAPIKeyMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteAPIKey != nil && afterDeleteAPIKeyCounter < 1 {
+		m.t.Errorf("Expected call to ServiceAccountServiceMock.DeleteAPIKey at\n%s", m.funcDeleteAPIKeyOrigin)
+	}
+
+	if !m.DeleteAPIKeyMock.invocationsDone() && afterDeleteAPIKeyCounter > 0 {
+		m.t.Errorf("Expected %d calls to ServiceAccountServiceMock.DeleteAPIKey at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteAPIKeyMock.expectedInvocations), m.DeleteAPIKeyMock.expectedInvocationsOrigin, afterDeleteAPIKeyCounter)
+	}
+}
+
+type mServiceAccountServiceMockDeleteServiceAccount struct {
+	optional           bool
+	mock               *ServiceAccountServiceMock
+	defaultExpectation *ServiceAccountServiceMockDeleteServiceAccountExpectation
+	expectations       []*ServiceAccountServiceMockDeleteServiceAccountExpectation
+
+	callArgs []*ServiceAccountServiceMockDeleteServiceAccountParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ServiceAccountServiceMockDeleteServiceAccountExpectation specifies expectation struct of the ServiceAccountService.DeleteServiceAccount
+type ServiceAccountServiceMockDeleteServiceAccountExpectation struct {
+	mock               *ServiceAccountServiceMock
+	params             *ServiceAccountServiceMockDeleteServiceAccountParams
+	paramPtrs          *ServiceAccountServiceMockDeleteServiceAccountParamPtrs
+	expectationOrigins ServiceAccountServiceMockDeleteServiceAccountExpectationOrigins
+	results            *ServiceAccountServiceMockDeleteServiceAccountResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ServiceAccountServiceMockDeleteServiceAccountParams contains parameters of the ServiceAccountService.DeleteServiceAccount
+type ServiceAccountServiceMockDeleteServiceAccountParams struct {
+	ctx       context.Context
+	accountID string
+}
+
+// ServiceAccountServiceMockDeleteServiceAccountParamPtrs contains pointers to parameters of the ServiceAccountService.DeleteServiceAccount
+type ServiceAccountServiceMockDeleteServiceAccountParamPtrs struct {
+	ctx       *context.Context
+	accountID *string
+}
+
+// ServiceAccountServiceMockDeleteServiceAccountResults contains results of the ServiceAccountService.DeleteServiceAccount
+type ServiceAccountServiceMockDeleteServiceAccountResults struct {
+	err error
+}
+
+// ServiceAccountServiceMockDeleteServiceAccountOrigins contains origins of expectations of the ServiceAccountService.DeleteServiceAccount
+type ServiceAccountServiceMockDeleteServiceAccountExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originAccountID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteServiceAccount *mServiceAccountServiceMockDeleteServiceAccount) Optional() *mServiceAccountServiceMockDeleteServiceAccount {
+	mmDeleteServiceAccount.optional = true
+	return mmDeleteServiceAccount
+}
+
+// Expect sets up expected params for ServiceAccountService.DeleteServiceAccount
+func (mmDeleteServiceAccount *mServiceAccountServiceMockDeleteServiceAccount) Expect(ctx context.Context, accountID string) *mServiceAccountServiceMockDeleteServiceAccount {
+	if mmDeleteServiceAccount.mock.funcDeleteServiceAccount != nil {
+		mmDeleteServiceAccount.mock.t.Fatalf("ServiceAccountServiceMock.DeleteServiceAccount mock is already set by Set")
+	}
+
+	if mmDeleteServiceAccount.defaultExpectation == nil {
+		mmDeleteServiceAccount.defaultExpectation = &ServiceAccountServiceMockDeleteServiceAccountExpectation{}
+	}
+
+	if mmDeleteServiceAccount.defaultExpectation.paramPtrs != nil {
+		mmDeleteServiceAccount.mock.t.Fatalf("ServiceAccountServiceMock.DeleteServiceAccount mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteServiceAccount.defaultExpectation.params = &ServiceAccountServiceMockDeleteServiceAccountParams{ctx, accountID}
+	mmDeleteServiceAccount.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteServiceAccount.expectations {
+		if minimock.Equal(e.params, mmDeleteServiceAccount.defaultExpectation.params) {
+			mmDeleteServiceAccount.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteServiceAccount.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteServiceAccount
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ServiceAccountService.DeleteServiceAccount
+func (mmDeleteServiceAccount *mServiceAccountServiceMockDeleteServiceAccount) ExpectCtxParam1(ctx context.Context) *mServiceAccountServiceMockDeleteServiceAccount {
+	if mmDeleteServiceAccount.mock.funcDeleteServiceAccount != nil {
+		mmDeleteServiceAccount.mock.t.Fatalf("ServiceAccountServiceMock.DeleteServiceAccount mock is already set by Set")
+	}
+
+	if mmDeleteServiceAccount.defaultExpectation == nil {
+		mmDeleteServiceAccount.defaultExpectation = &ServiceAccountServiceMockDeleteServiceAccountExpectation{}
+	}
+
+	if mmDeleteServiceAccount.defaultExpectation.params != nil {
+		mmDeleteServiceAccount.mock.t.Fatalf("ServiceAccountServiceMock.DeleteServiceAccount mock is already set by Expect")
+	}
+
+	if mmDeleteServiceAccount.defaultExpectation.paramPtrs == nil {
+		mmDeleteServiceAccount.defaultExpectation.paramPtrs = &ServiceAccountServiceMockDeleteServiceAccountParamPtrs{}
+	}
+	mmDeleteServiceAccount.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteServiceAccount.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteServiceAccount
+}
+
+// ExpectAccountIDParam2 sets up expected param accountID for ServiceAccountService.DeleteServiceAccount
+func (mmDeleteServiceAccount *mServiceAccountServiceMockDeleteServiceAccount) ExpectAccountIDParam2(accountID string) *mServiceAccountServiceMockDeleteServiceAccount {
+	if mmDeleteServiceAccount.mock.funcDeleteServiceAccount != nil {
+		mmDeleteServiceAccount.mock.t.Fatalf("ServiceAccountServiceMock.DeleteServiceAccount mock is already set by Set")
+	}
+
+	if mmDeleteServiceAccount.defaultExpectation == nil {
+		mmDeleteServiceAccount.defaultExpectation = &ServiceAccountServiceMockDeleteServiceAccountExpectation{}
+	}
+
+	if mmDeleteServiceAccount.defaultExpectation.params != nil {
+		mmDeleteServiceAccount.mock.t.Fatalf("ServiceAccountServiceMock.DeleteServiceAccount mock is already set by Expect")
+	}
+
+	if mmDeleteServiceAccount.defaultExpectation.paramPtrs == nil {
+		mmDeleteServiceAccount.defaultExpectation.paramPtrs = &ServiceAccountServiceMockDeleteServiceAccountParamPtrs{}
+	}
+	mmDeleteServiceAccount.defaultExpectation.paramPtrs.accountID = &accountID
+	mmDeleteServiceAccount.defaultExpectation.expectationOrigins.originAccountID = minimock.CallerInfo(1)
+
+	return mmDeleteServiceAccount
+}
+
+// Inspect accepts an inspector function that has same arguments as the ServiceAccountService.DeleteServiceAccount
+func (mmDeleteServiceAccount *mServiceAccountServiceMockDeleteServiceAccount) Inspect(f func(ctx context.Context, accountID string)) *mServiceAccountServiceMockDeleteServiceAccount {
+	if mmDeleteServiceAccount.mock.inspectFuncDeleteServiceAccount != nil {
+		mmDeleteServiceAccount.mock.t.Fatalf("Inspect function is already set for ServiceAccountServiceMock.DeleteServiceAccount")
+	}
+
+	mmDeleteServiceAccount.mock.inspectFuncDeleteServiceAccount = f
+
+	return mmDeleteServiceAccount
+}
+
+// Return sets up results that will be returned by ServiceAccountService.DeleteServiceAccount
+func (mmDeleteServiceAccount *mServiceAccountServiceMockDeleteServiceAccount) Return(err error) *ServiceAccountServiceMock {
+	if mmDeleteServiceAccount.mock.funcDeleteServiceAccount != nil {
+		mmDeleteServiceAccount.mock.t.Fatalf("ServiceAccountServiceMock.DeleteServiceAccount mock is already set by Set")
+	}
+
+	if mmDeleteServiceAccount.defaultExpectation == nil {
+		mmDeleteServiceAccount.defaultExpectation = &ServiceAccountServiceMockDeleteServiceAccountExpectation{mock: mmDeleteServiceAccount.mock}
+	}
+	mmDeleteServiceAccount.defaultExpectation.results = &ServiceAccountServiceMockDeleteServiceAccountResults{err}
+	mmDeleteServiceAccount.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteServiceAccount.mock
+}
+
+// Set uses given function f to mock the ServiceAccountService.DeleteServiceAccount method
+func (mmDeleteServiceAccount *mServiceAccountServiceMockDeleteServiceAccount) Set(f func(ctx context.Context, accountID string) (err error)) *ServiceAccountServiceMock {
+	if mmDeleteServiceAccount.defaultExpectation != nil {
+		mmDeleteServiceAccount.mock.t.Fatalf("Default expectation is already set for the ServiceAccountService.DeleteServiceAccount method")
+	}
+
+	if len(mmDeleteServiceAccount.expectations) > 0 {
+		mmDeleteServiceAccount.mock.t.Fatalf("Some expectations are already set for the ServiceAccountService.DeleteServiceAccount method")
+	}
+
+	mmDeleteServiceAccount.mock.funcDeleteServiceAccount = f
+	mmDeleteServiceAccount.mock.funcDeleteServiceAccountOrigin = minimock.CallerInfo(1)
+	return mmDeleteServiceAccount.mock
+}
+
+// When sets expectation for the ServiceAccountService.DeleteServiceAccount which will trigger the result defined by the following
+// Then helper
+func (mmDeleteServiceAccount *mServiceAccountServiceMockDeleteServiceAccount) When(ctx context.Context, accountID string) *ServiceAccountServiceMockDeleteServiceAccountExpectation {
+	if mmDeleteServiceAccount.mock.funcDeleteServiceAccount != nil {
+		mmDeleteServiceAccount.mock.t.Fatalf("ServiceAccountServiceMock.DeleteServiceAccount mock is already set by Set")
+	}
+
+	expectation := &ServiceAccountServiceMockDeleteServiceAccountExpectation{
+		mock:               mmDeleteServiceAccount.mock,
+		params:             &ServiceAccountServiceMockDeleteServiceAccountParams{ctx, accountID},
+		expectationOrigins: ServiceAccountServiceMockDeleteServiceAccountExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteServiceAccount.expectations = append(mmDeleteServiceAccount.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ServiceAccountService.DeleteServiceAccount return parameters for the expectation previously defined by the When method
+func (e *ServiceAccountServiceMockDeleteServiceAccountExpectation) Then(err error) *ServiceAccountServiceMock {
+	e.results = &ServiceAccountServiceMockDeleteServiceAccountResults{err}
+	return e.mock
+}
+
+// Times sets number of times ServiceAccountService.DeleteServiceAccount should be invoked
+func (mmDeleteServiceAccount *mServiceAccountServiceMockDeleteServiceAccount) Times(n uint64) *mServiceAccountServiceMockDeleteServiceAccount {
+	if n == 0 {
+		mmDeleteServiceAccount.mock.t.Fatalf("Times of ServiceAccountServiceMock.DeleteServiceAccount mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteServiceAccount.expectedInvocations, n)
+	mmDeleteServiceAccount.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteServiceAccount
+}
+
+func (mmDeleteServiceAccount *mServiceAccountServiceMockDeleteServiceAccount) invocationsDone() bool {
+	if len(mmDeleteServiceAccount.expectations) == 0 && mmDeleteServiceAccount.defaultExpectation == nil && mmDeleteServiceAccount.mock.funcDeleteServiceAccount == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteServiceAccount.mock.afterDeleteServiceAccountCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteServiceAccount.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteServiceAccount implements ServiceAccountService
+func (mmDeleteServiceAccount *ServiceAccountServiceMock) DeleteServiceAccount(ctx context.Context, accountID string) (err error) {
+	mm_atomic.AddUint64(&mmDeleteServiceAccount.beforeDeleteServiceAccountCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteServiceAccount.afterDeleteServiceAccountCounter, 1)
+
+	mmDeleteServiceAccount.t.Helper()
+
+	if mmDeleteServiceAccount.inspectFuncDeleteServiceAccount != nil {
+		mmDeleteServiceAccount.inspectFuncDeleteServiceAccount(ctx, accountID)
+	}
+
+	mm_params := ServiceAccountServiceMockDeleteServiceAccountParams{ctx, accountID}
+
+	// Record call args
+	mmDeleteServiceAccount.DeleteServiceAccountMock.mutex.Lock()
+	mmDeleteServiceAccount.DeleteServiceAccountMock.callArgs = append(mmDeleteServiceAccount.DeleteServiceAccountMock.callArgs, &mm_params)
+	mmDeleteServiceAccount.DeleteServiceAccountMock.mutex.Unlock()
+
+	for _, e := range mmDeleteServiceAccount.DeleteServiceAccountMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteServiceAccount.DeleteServiceAccountMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteServiceAccount.DeleteServiceAccountMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteServiceAccount.DeleteServiceAccountMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteServiceAccount.DeleteServiceAccountMock.defaultExpectation.paramPtrs
+
+		mm_got := ServiceAccountServiceMockDeleteServiceAccountParams{ctx, accountID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteServiceAccount.t.Errorf("ServiceAccountServiceMock.DeleteServiceAccount got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteServiceAccount.DeleteServiceAccountMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.accountID != nil && !minimock.Equal(*mm_want_ptrs.accountID, mm_got.accountID) {
+				mmDeleteServiceAccount.t.Errorf("ServiceAccountServiceMock.DeleteServiceAccount got unexpected parameter accountID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteServiceAccount.DeleteServiceAccountMock.defaultExpectation.expectationOrigins.originAccountID, *mm_want_ptrs.accountID, mm_got.accountID, minimock.Diff(*mm_want_ptrs.accountID, mm_got.accountID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteServiceAccount.t.Errorf("ServiceAccountServiceMock.DeleteServiceAccount got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteServiceAccount.DeleteServiceAccountMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteServiceAccount.DeleteServiceAccountMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteServiceAccount.t.Fatal("No results are set for the ServiceAccountServiceMock.DeleteServiceAccount")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteServiceAccount.funcDeleteServiceAccount != nil {
+		return mmDeleteServiceAccount.funcDeleteServiceAccount(ctx, accountID)
+	}
+	mmDeleteServiceAccount.t.Fatalf("Unexpected call to ServiceAccountServiceMock.DeleteServiceAccount. %v %v", ctx, accountID)
+	return
+}
+
+// DeleteServiceAccountAfterCounter returns a count of finished ServiceAccountServiceMock.DeleteServiceAccount invocations
+func (mmDeleteServiceAccount *ServiceAccountServiceMock) DeleteServiceAccountAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteServiceAccount.afterDeleteServiceAccountCounter)
+}
+
+// DeleteServiceAccountBeforeCounter returns a count of ServiceAccountServiceMock.DeleteServiceAccount invocations
+func (mmDeleteServiceAccount *ServiceAccountServiceMock) DeleteServiceAccountBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteServiceAccount.beforeDeleteServiceAccountCounter)
+}
+
+// Calls returns a list of arguments used in each call to ServiceAccountServiceMock.DeleteServiceAccount.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteServiceAccount *mServiceAccountServiceMockDeleteServiceAccount) Calls() []*ServiceAccountServiceMockDeleteServiceAccountParams {
+	mmDeleteServiceAccount.mutex.RLock()
+
+	argCopy := make([]*ServiceAccountServiceMockDeleteServiceAccountParams, len(mmDeleteServiceAccount.callArgs))
+	copy(argCopy, mmDeleteServiceAccount.callArgs)
+
+	mmDeleteServiceAccount.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteServiceAccountDone returns true if the count of the DeleteServiceAccount invocations corresponds
+// the number of defined expectations
+func (m *ServiceAccountServiceMock) MinimockDeleteServiceAccountDone() bool {
+	if m.DeleteServiceAccountMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteServiceAccountMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteServiceAccountMock.invocationsDone()
+}
+
+// MinimockDeleteServiceAccountInspect logs each unmet expectation
+func (m *ServiceAccountServiceMock) MinimockDeleteServiceAccountInspect() {
+	for _, e := range m.DeleteServiceAccountMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ServiceAccountServiceMock.DeleteServiceAccount at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteServiceAccountCounter := mm_atomic.LoadUint64(&m.afterDeleteServiceAccountCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteServiceAccountMock.defaultExpectation != nil && afterDeleteServiceAccountCounter < 1 {
+		if m.DeleteServiceAccountMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ServiceAccountServiceMock.DeleteServiceAccount at\n%s", m.DeleteServiceAccountMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ServiceAccountServiceMock.DeleteServiceAccount at\n%s with params: %#v", m.DeleteServiceAccountMock.defaultExpectation.expectationOrigins.origin, *m.DeleteServiceAccountMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteServiceAccount != nil && afterDeleteServiceAccountCounter < 1 {
+		m.t.Errorf("Expected call to ServiceAccountServiceMock.DeleteServiceAccount at\n%s", m.funcDeleteServiceAccountOrigin)
+	}
+
+	if !m.DeleteServiceAccountMock.invocationsDone() && afterDeleteServiceAccountCounter > 0 {
+		m.t.Errorf("Expected %d calls to ServiceAccountServiceMock.DeleteServiceAccount at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteServiceAccountMock.expectedInvocations), m.DeleteServiceAccountMock.expectedInvocationsOrigin, afterDeleteServiceAccountCounter)
+	}
+}
+
+type mServiceAccountServiceMockGetServiceAccount struct {
+	optional           bool
+	mock               *ServiceAccountServiceMock
+	defaultExpectation *ServiceAccountServiceMockGetServiceAccountExpectation
+	expectations       []*ServiceAccountServiceMockGetServiceAccountExpectation
+
+	callArgs []*ServiceAccountServiceMockGetServiceAccountParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ServiceAccountServiceMockGetServiceAccountExpectation specifies expectation struct of the ServiceAccountService.GetServiceAccount
+type ServiceAccountServiceMockGetServiceAccountExpectation struct {
+	mock               *ServiceAccountServiceMock
+	params             *ServiceAccountServiceMockGetServiceAccountParams
+	paramPtrs          *ServiceAccountServiceMockGetServiceAccountParamPtrs
+	expectationOrigins ServiceAccountServiceMockGetServiceAccountExpectationOrigins
+	results            *ServiceAccountServiceMockGetServiceAccountResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ServiceAccountServiceMockGetServiceAccountParams contains parameters of the ServiceAccountService.GetServiceAccount
+type ServiceAccountServiceMockGetServiceAccountParams struct {
+	ctx       context.Context
+	accountID string
+}
+
+// ServiceAccountServiceMockGetServiceAccountParamPtrs contains pointers to parameters of the ServiceAccountService.GetServiceAccount
+type ServiceAccountServiceMockGetServiceAccountParamPtrs struct {
+	ctx       *context.Context
+	accountID *string
+}
+
+// ServiceAccountServiceMockGetServiceAccountResults contains results of the ServiceAccountService.GetServiceAccount
+type ServiceAccountServiceMockGetServiceAccountResults struct {
+	sp1 *models.ServiceAccount
+	err error
+}
+
+// ServiceAccountServiceMockGetServiceAccountOrigins contains origins of expectations of the ServiceAccountService.GetServiceAccount
+type ServiceAccountServiceMockGetServiceAccountExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originAccountID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetServiceAccount *mServiceAccountServiceMockGetServiceAccount) Optional() *mServiceAccountServiceMockGetServiceAccount {
+	mmGetServiceAccount.optional = true
+	return mmGetServiceAccount
+}
+
+// Expect sets up expected params for ServiceAccountService.GetServiceAccount
+func (mmGetServiceAccount *mServiceAccountServiceMockGetServiceAccount) Expect(ctx context.Context, accountID string) *mServiceAccountServiceMockGetServiceAccount {
+	if mmGetServiceAccount.mock.funcGetServiceAccount != nil {
+		mmGetServiceAccount.mock.t.Fatalf("ServiceAccountServiceMock.GetServiceAccount mock is already set by Set")
+	}
+
+	if mmGetServiceAccount.defaultExpectation == nil {
+		mmGetServiceAccount.defaultExpectation = &ServiceAccountServiceMockGetServiceAccountExpectation{}
+	}
+
+	if mmGetServiceAccount.defaultExpectation.paramPtrs != nil {
+		mmGetServiceAccount.mock.t.Fatalf("ServiceAccountServiceMock.GetServiceAccount mock is already set by ExpectParams functions")
+	}
+
+	mmGetServiceAccount.defaultExpectation.params = &ServiceAccountServiceMockGetServiceAccountParams{ctx, accountID}
+	mmGetServiceAccount.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetServiceAccount.expectations {
+		if minimock.Equal(e.params, mmGetServiceAccount.defaultExpectation.params) {
+			mmGetServiceAccount.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetServiceAccount.defaultExpectation.params)
+		}
+	}
+
+	return mmGetServiceAccount
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ServiceAccountService.GetServiceAccount
+func (mmGetServiceAccount *mServiceAccountServiceMockGetServiceAccount) ExpectCtxParam1(ctx context.Context) *mServiceAccountServiceMockGetServiceAccount {
+	if mmGetServiceAccount.mock.funcGetServiceAccount != nil {
+		mmGetServiceAccount.mock.t.Fatalf("ServiceAccountServiceMock.GetServiceAccount mock is already set by Set")
+	}
+
+	if mmGetServiceAccount.defaultExpectation == nil {
+		mmGetServiceAccount.defaultExpectation = &ServiceAccountServiceMockGetServiceAccountExpectation{}
+	}
+
+	if mmGetServiceAccount.defaultExpectation.params != nil {
+		mmGetServiceAccount.mock.t.Fatalf("ServiceAccountServiceMock.GetServiceAccount mock is already set by Expect")
+	}
+
+	if mmGetServiceAccount.defaultExpectation.paramPtrs == nil {
+		mmGetServiceAccount.defaultExpectation.paramPtrs = &ServiceAccountServiceMockGetServiceAccountParamPtrs{}
+	}
+	mmGetServiceAccount.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetServiceAccount.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetServiceAccount
+}
+
+// ExpectAccountIDParam2 sets up expected param accountID for ServiceAccountService.GetServiceAccount
+func (mmGetServiceAccount *mServiceAccountServiceMockGetServiceAccount) ExpectAccountIDParam2(accountID string) *mServiceAccountServiceMockGetServiceAccount {
+	if mmGetServiceAccount.mock.funcGetServiceAccount != nil {
+		mmGetServiceAccount.mock.t.Fatalf("ServiceAccountServiceMock.GetServiceAccount mock is already set by Set")
+	}
+
+	if mmGetServiceAccount.defaultExpectation == nil {
+		mmGetServiceAccount.defaultExpectation = &ServiceAccountServiceMockGetServiceAccountExpectation{}
+	}
+
+	if mmGetServiceAccount.defaultExpectation.params != nil {
+		mmGetServiceAccount.mock.t.Fatalf("ServiceAccountServiceMock.GetServiceAccount mock is already set by Expect")
+	}
+
+	if mmGetServiceAccount.defaultExpectation.paramPtrs == nil {
+		mmGetServiceAccount.defaultExpectation.paramPtrs = &ServiceAccountServiceMockGetServiceAccountParamPtrs{}
+	}
+	mmGetServiceAccount.defaultExpectation.paramPtrs.accountID = &accountID
+	mmGetServiceAccount.defaultExpectation.expectationOrigins.originAccountID = minimock.CallerInfo(1)
+
+	return mmGetServiceAccount
+}
+
+// Inspect accepts an inspector function that has same arguments as the ServiceAccountService.GetServiceAccount
+func (mmGetServiceAccount *mServiceAccountServiceMockGetServiceAccount) Inspect(f func(ctx context.Context, accountID string)) *mServiceAccountServiceMockGetServiceAccount {
+	if mmGetServiceAccount.mock.inspectFuncGetServiceAccount != nil {
+		mmGetServiceAccount.mock.t.Fatalf("Inspect function is already set for ServiceAccountServiceMock.GetServiceAccount")
+	}
+
+	mmGetServiceAccount.mock.inspectFuncGetServiceAccount = f
+
+	return mmGetServiceAccount
+}
+
+// Return sets up results that will be returned by ServiceAccountService.GetServiceAccount
+func (mmGetServiceAccount *mServiceAccountServiceMockGetServiceAccount) Return(sp1 *models.ServiceAccount, err error) *ServiceAccountServiceMock {
+	if mmGetServiceAccount.mock.funcGetServiceAccount != nil {
+		mmGetServiceAccount.mock.t.Fatalf("ServiceAccountServiceMock.GetServiceAccount mock is already set by Set")
+	}
+
+	if mmGetServiceAccount.defaultExpectation == nil {
+		mmGetServiceAccount.defaultExpectation = &ServiceAccountServiceMockGetServiceAccountExpectation{mock: mmGetServiceAccount.mock}
+	}
+	mmGetServiceAccount.defaultExpectation.results = &ServiceAccountServiceMockGetServiceAccountResults{sp1, err}
+	mmGetServiceAccount.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetServiceAccount.mock
+}
+
+// Set uses given function f to mock the ServiceAccountService.GetServiceAccount method
+func (mmGetServiceAccount *mServiceAccountServiceMockGetServiceAccount) Set(f func(ctx context.Context, accountID string) (sp1 *models.ServiceAccount, err error)) *ServiceAccountServiceMock {
+	if mmGetServiceAccount.defaultExpectation != nil {
+		mmGetServiceAccount.mock.t.Fatalf("Default expectation is already set for the ServiceAccountService.GetServiceAccount method")
+	}
+
+	if len(mmGetServiceAccount.expectations) > 0 {
+		mmGetServiceAccount.mock.t.Fatalf("Some expectations are already set for the ServiceAccountService.GetServiceAccount method")
+	}
+
+	mmGetServiceAccount.mock.funcGetServiceAccount = f
+	mmGetServiceAccount.mock.funcGetServiceAccountOrigin = minimock.CallerInfo(1)
+	return mmGetServiceAccount.mock
+}
+
+// When sets expectation for the ServiceAccountService.GetServiceAccount which will trigger the result defined by the following
+// Then helper
+func (mmGetServiceAccount *mServiceAccountServiceMockGetServiceAccount) When(ctx context.Context, accountID string) *ServiceAccountServiceMockGetServiceAccountExpectation {
+	if mmGetServiceAccount.mock.funcGetServiceAccount != nil {
+		mmGetServiceAccount.mock.t.Fatalf("ServiceAccountServiceMock.GetServiceAccount mock is already set by Set")
+	}
+
+	expectation := &ServiceAccountServiceMockGetServiceAccountExpectation{
+		mock:               mmGetServiceAccount.mock,
+		params:             &ServiceAccountServiceMockGetServiceAccountParams{ctx, accountID},
+		expectationOrigins: ServiceAccountServiceMockGetServiceAccountExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetServiceAccount.expectations = append(mmGetServiceAccount.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ServiceAccountService.GetServiceAccount return parameters for the expectation previously defined by the When method
+func (e *ServiceAccountServiceMockGetServiceAccountExpectation) Then(sp1 *models.ServiceAccount, err error) *ServiceAccountServiceMock {
+	e.results = &ServiceAccountServiceMockGetServiceAccountResults{sp1, err}
+	return e.mock
+}
+
+// Times sets number of times ServiceAccountService.GetServiceAccount should be invoked
+func (mmGetServiceAccount *mServiceAccountServiceMockGetServiceAccount) Times(n uint64) *mServiceAccountServiceMockGetServiceAccount {
+	if n == 0 {
+		mmGetServiceAccount.mock.t.Fatalf("Times of ServiceAccountServiceMock.GetServiceAccount mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetServiceAccount.expectedInvocations, n)
+	mmGetServiceAccount.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetServiceAccount
+}
+
+func (mmGetServiceAccount *mServiceAccountServiceMockGetServiceAccount) invocationsDone() bool {
+	if len(mmGetServiceAccount.expectations) == 0 && mmGetServiceAccount.defaultExpectation == nil && mmGetServiceAccount.mock.funcGetServiceAccount == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetServiceAccount.mock.afterGetServiceAccountCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetServiceAccount.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetServiceAccount implements ServiceAccountService
+func (mmGetServiceAccount *ServiceAccountServiceMock) GetServiceAccount(ctx context.Context, accountID string) (sp1 *models.ServiceAccount, err error) {
+	mm_atomic.AddUint64(&mmGetServiceAccount.beforeGetServiceAccountCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetServiceAccount.afterGetServiceAccountCounter, 1)
+
+	mmGetServiceAccount.t.Helper()
+
+	if mmGetServiceAccount.inspectFuncGetServiceAccount != nil {
+		mmGetServiceAccount.inspectFuncGetServiceAccount(ctx, accountID)
+	}
+
+	mm_params := ServiceAccountServiceMockGetServiceAccountParams{ctx, accountID}
+
+	// Record call args
+	mmGetServiceAccount.GetServiceAccountMock.mutex.Lock()
+	mmGetServiceAccount.GetServiceAccountMock.callArgs = append(mmGetServiceAccount.GetServiceAccountMock.callArgs, &mm_params)
+	mmGetServiceAccount.GetServiceAccountMock.mutex.Unlock()
+
+	for _, e := range mmGetServiceAccount.GetServiceAccountMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.sp1, e.results.err
+		}
+	}
+
+	if mmGetServiceAccount.GetServiceAccountMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetServiceAccount.GetServiceAccountMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetServiceAccount.GetServiceAccountMock.defaultExpectation.params
+		mm_want_ptrs := mmGetServiceAccount.GetServiceAccountMock.defaultExpectation.paramPtrs
+
+		mm_got := ServiceAccountServiceMockGetServiceAccountParams{ctx, accountID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetServiceAccount.t.Errorf("ServiceAccountServiceMock.GetServiceAccount got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetServiceAccount.GetServiceAccountMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.accountID != nil && !minimock.Equal(*mm_want_ptrs.accountID, mm_got.accountID) {
+				mmGetServiceAccount.t.Errorf("ServiceAccountServiceMock.GetServiceAccount got unexpected parameter accountID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetServiceAccount.GetServiceAccountMock.defaultExpectation.expectationOrigins.originAccountID, *mm_want_ptrs.accountID, mm_got.accountID, minimock.Diff(*mm_want_ptrs.accountID, mm_got.accountID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetServiceAccount.t.Errorf("ServiceAccountServiceMock.GetServiceAccount got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetServiceAccount.GetServiceAccountMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetServiceAccount.GetServiceAccountMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetServiceAccount.t.Fatal("No results are set for the ServiceAccountServiceMock.GetServiceAccount")
+		}
+		return (*mm_results).sp1, (*mm_results).err
+	}
+	if mmGetServiceAccount.funcGetServiceAccount != nil {
+		return mmGetServiceAccount.funcGetServiceAccount(ctx, accountID)
+	}
+	mmGetServiceAccount.t.Fatalf("Unexpected call to ServiceAccountServiceMock.GetServiceAccount. %v %v", ctx, accountID)
+	return
+}
+
+// GetServiceAccountAfterCounter returns a count of finished ServiceAccountServiceMock.GetServiceAccount invocations
+func (mmGetServiceAccount *ServiceAccountServiceMock) GetServiceAccountAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetServiceAccount.afterGetServiceAccountCounter)
+}
+
+// GetServiceAccountBeforeCounter returns a count of ServiceAccountServiceMock.GetServiceAccount invocations
+func (mmGetServiceAccount *ServiceAccountServiceMock) GetServiceAccountBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetServiceAccount.beforeGetServiceAccountCounter)
+}
+
+// Calls returns a list of arguments used in each call to ServiceAccountServiceMock.GetServiceAccount.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetServiceAccount *mServiceAccountServiceMockGetServiceAccount) Calls() []*ServiceAccountServiceMockGetServiceAccountParams {
+	mmGetServiceAccount.mutex.RLock()
+
+	argCopy := make([]*ServiceAccountServiceMockGetServiceAccountParams, len(mmGetServiceAccount.callArgs))
+	copy(argCopy, mmGetServiceAccount.callArgs)
+
+	mmGetServiceAccount.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetServiceAccountDone returns true if the count of the GetServiceAccount invocations corresponds
+// the number of defined expectations
+func (m *ServiceAccountServiceMock) MinimockGetServiceAccountDone() bool {
+	if m.GetServiceAccountMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetServiceAccountMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetServiceAccountMock.invocationsDone()
+}
+
+// MinimockGetServiceAccountInspect logs each unmet expectation
+func (m *ServiceAccountServiceMock) MinimockGetServiceAccountInspect() {
+	for _, e := range m.GetServiceAccountMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ServiceAccountServiceMock.GetServiceAccount at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetServiceAccountCounter := mm_atomic.LoadUint64(&m.afterGetServiceAccountCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetServiceAccountMock.defaultExpectation != nil && afterGetServiceAccountCounter < 1 {
+		if m.GetServiceAccountMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ServiceAccountServiceMock.GetServiceAccount at\n%s", m.GetServiceAccountMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ServiceAccountServiceMock.GetServiceAccount at\n%s with params: %#v", m.GetServiceAccountMock.defaultExpectation.expectationOrigins.origin, *m.GetServiceAccountMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetServiceAccount != nil && afterGetServiceAccountCounter < 1 {
+		m.t.Errorf("Expected call to ServiceAccountServiceMock.GetServiceAccount at\n%s", m.funcGetServiceAccountOrigin)
+	}
+
+	if !m.GetServiceAccountMock.invocationsDone() && afterGetServiceAccountCounter > 0 {
+		m.t.Errorf("Expected %d calls to ServiceAccountServiceMock.GetServiceAccount at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetServiceAccountMock.expectedInvocations), m.GetServiceAccountMock.expectedInvocationsOrigin, afterGetServiceAccountCounter)
+	}
+}
+
+type mServiceAccountServiceMockListAPIKeys struct {
+	optional           bool
+	mock               *ServiceAccountServiceMock
+	defaultExpectation *ServiceAccountServiceMockListAPIKeysExpectation
+	expectations       []*ServiceAccountServiceMockListAPIKeysExpectation
+
+	callArgs []*ServiceAccountServiceMockListAPIKeysParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ServiceAccountServiceMockListAPIKeysExpectation specifies expectation struct of the ServiceAccountService.ListAPIKeys
+type ServiceAccountServiceMockListAPIKeysExpectation struct {
+	mock               *ServiceAccountServiceMock
+	params             *ServiceAccountServiceMockListAPIKeysParams
+	paramPtrs          *ServiceAccountServiceMockListAPIKeysParamPtrs
+	expectationOrigins ServiceAccountServiceMockListAPIKeysExpectationOrigins
+	results            *ServiceAccountServiceMockListAPIKeysResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ServiceAccountServiceMockListAPIKeysParams contains parameters of the ServiceAccountService.ListAPIKeys
+type ServiceAccountServiceMockListAPIKeysParams struct {
+	ctx       context.Context
+	accountID string
+}
+
+// ServiceAccountServiceMockListAPIKeysParamPtrs contains pointers to parameters of the ServiceAccountService.ListAPIKeys
+type ServiceAccountServiceMockListAPIKeysParamPtrs struct {
+	ctx       *context.Context
+	accountID *string
+}
+
+// ServiceAccountServiceMockListAPIKeysResults contains results of the ServiceAccountService.ListAPIKeys
+type ServiceAccountServiceMockListAPIKeysResults struct {
+	aa1 []models.APIKey
+	err error
+}
+
+// ServiceAccountServiceMockListAPIKeysOrigins contains origins of expectations of the ServiceAccountService.ListAPIKeys
+type ServiceAccountServiceMockListAPIKeysExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originAccountID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListAPIKeys *mServiceAccountServiceMockListAPIKeys) Optional() *mServiceAccountServiceMockListAPIKeys {
+	mmListAPIKeys.optional = true
+	return mmListAPIKeys
+}
+
+// Expect sets up expected params for ServiceAccountService.ListAPIKeys
+func (mmListAPIKeys *mServiceAccountServiceMockListAPIKeys) Expect(ctx context.Context, accountID string) *mServiceAccountServiceMockListAPIKeys {
+	if mmListAPIKeys.mock.funcListAPIKeys != nil {
+		mmListAPIKeys.mock.t.Fatalf("ServiceAccountServiceMock.ListAPIKeys mock is already set by Set")
+	}
+
+	if mmListAPIKeys.defaultExpectation == nil {
+		mmListAPIKeys.defaultExpectation = &ServiceAccountServiceMockListAPIKeysExpectation{}
+	}
+
+	if mmListAPIKeys.defaultExpectation.paramPtrs != nil {
+		mmListAPIKeys.mock.t.Fatalf("ServiceAccountServiceMock.ListAPIKeys mock is already set by ExpectParams functions")
+	}
+
+	mmListAPIKeys.defaultExpectation.params = &ServiceAccountServiceMockListAPIKeysParams{ctx, accountID}
+	mmListAPIKeys.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListAPIKeys.expectations {
+		if minimock.Equal(e.params, mmListAPIKeys.defaultExpectation.params) {
+			mmListAPIKeys.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListAPIKeys.defaultExpectation.params)
+		}
+	}
+
+	return mmListAPIKeys
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ServiceAccountService.ListAPIKeys
+func (mmListAPIKeys *mServiceAccountServiceMockListAPIKeys) ExpectCtxParam1(ctx context.Context) *mServiceAccountServiceMockListAPIKeys {
+	if mmListAPIKeys.mock.funcListAPIKeys != nil {
+		mmListAPIKeys.mock.t.Fatalf("ServiceAccountServiceMock.ListAPIKeys mock is already set by Set")
+	}
+
+	if mmListAPIKeys.defaultExpectation == nil {
+		mmListAPIKeys.defaultExpectation = &ServiceAccountServiceMockListAPIKeysExpectation{}
+	}
+
+	if mmListAPIKeys.defaultExpectation.params != nil {
+		mmListAPIKeys.mock.t.Fatalf("ServiceAccountServiceMock.ListAPIKeys mock is already set by Expect")
+	}
+
+	if mmListAPIKeys.defaultExpectation.paramPtrs == nil {
+		mmListAPIKeys.defaultExpectation.paramPtrs = &ServiceAccountServiceMockListAPIKeysParamPtrs{}
+	}
+	mmListAPIKeys.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListAPIKeys.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListAPIKeys
+}
+
+// ExpectAccountIDParam2 sets up expected param accountID for ServiceAccountService.ListAPIKeys
+func (mmListAPIKeys *mServiceAccountServiceMockListAPIKeys) ExpectAccountIDParam2(accountID string) *mServiceAccountServiceMockListAPIKeys {
+	if mmListAPIKeys.mock.funcListAPIKeys != nil {
+		mmListAPIKeys.mock.t.Fatalf("ServiceAccountServiceMock.ListAPIKeys mock is already set by Set")
+	}
+
+	if mmListAPIKeys.defaultExpectation == nil {
+		mmListAPIKeys.defaultExpectation = &ServiceAccountServiceMockListAPIKeysExpectation{}
+	}
+
+	if mmListAPIKeys.defaultExpectation.params != nil {
+		mmListAPIKeys.mock.t.Fatalf("ServiceAccountServiceMock.ListAPIKeys mock is already set by Expect")
+	}
+
+	if mmListAPIKeys.defaultExpectation.paramPtrs == nil {
+		mmListAPIKeys.defaultExpectation.paramPtrs = &ServiceAccountServiceMockListAPIKeysParamPtrs{}
+	}
+	mmListAPIKeys.defaultExpectation.paramPtrs.accountID = &accountID
+	mmListAPIKeys.defaultExpectation.expectationOrigins.originAccountID = minimock.CallerInfo(1)
+
+	return mmListAPIKeys
+}
+
+// Inspect accepts an inspector function that has same arguments as the ServiceAccountService.ListAPIKeys
+func (mmListAPIKeys *mServiceAccountServiceMockListAPIKeys) Inspect(f func(ctx context.Context, accountID string)) *mServiceAccountServiceMockListAPIKeys {
+	if mmListAPIKeys.mock.inspectFuncListAPIKeys != nil {
+		mmListAPIKeys.mock.t.Fatalf("Inspect function is already set for ServiceAccountServiceMock.ListAPIKeys")
+	}
+
+	mmListAPIKeys.mock.inspectFuncListAPIKeys = f
+
+	return mmListAPIKeys
+}
+
+// Return sets up results that will be returned by ServiceAccountService.ListAPIKeys
+func (mmListAPIKeys *mServiceAccountServiceMockListAPIKeys) Return(aa1 []models.APIKey, err error) *ServiceAccountServiceMock {
+	if mmListAPIKeys.mock.funcListAPIKeys != nil {
+		mmListAPIKeys.mock.t.Fatalf("ServiceAccountServiceMock.ListAPIKeys mock is already set by Set")
+	}
+
+	if mmListAPIKeys.defaultExpectation == nil {
+		mmListAPIKeys.defaultExpectation = &ServiceAccountServiceMockListAPIKeysExpectation{mock: mmListAPIKeys.mock}
+	}
+	mmListAPIKeys.defaultExpectation.results = &ServiceAccountServiceMockListAPIKeysResults{aa1, err}
+	mmListAPIKeys.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListAPIKeys.mock
+}
+
+// Set uses given function f to mock the ServiceAccountService.ListAPIKeys method
+func (mmListAPIKeys *mServiceAccountServiceMockListAPIKeys) Set(f func(ctx context.Context, accountID string) (aa1 []models.APIKey, err error)) *ServiceAccountServiceMock {
+	if mmListAPIKeys.defaultExpectation != nil {
+		mmListAPIKeys.mock.t.Fatalf("Default expectation is already set for the ServiceAccountService.ListAPIKeys method")
+	}
+
+	if len(mmListAPIKeys.expectations) > 0 {
+		mmListAPIKeys.mock.t.Fatalf("Some expectations are already set for the ServiceAccountService.ListAPIKeys method")
+	}
+
+	mmListAPIKeys.mock.funcListAPIKeys = f
+	mmListAPIKeys.mock.funcListAPIKeysOrigin = minimock.CallerInfo(1)
+	return mmListAPIKeys.mock
+}
+
+// When sets expectation for the ServiceAccountService.ListAPIKeys which will trigger the result defined by the following
+// Then helper
+func (mmListAPIKeys *mServiceAccountServiceMockListAPIKeys) When(ctx context.Context, accountID string) *ServiceAccountServiceMockListAPIKeysExpectation {
+	if mmListAPIKeys.mock.funcListAPIKeys != nil {
+		mmListAPIKeys.mock.t.Fatalf("ServiceAccountServiceMock.ListAPIKeys mock is already set by Set")
+	}
+
+	expectation := &ServiceAccountServiceMockListAPIKeysExpectation{
+		mock:               mmListAPIKeys.mock,
+		params:             &ServiceAccountServiceMockListAPIKeysParams{ctx, accountID},
+		expectationOrigins: ServiceAccountServiceMockListAPIKeysExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListAPIKeys.expectations = append(mmListAPIKeys.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ServiceAccountService.ListAPIKeys return parameters for the expectation previously defined by the When method
+func (e *ServiceAccountServiceMockListAPIKeysExpectation) Then(aa1 []models.APIKey, err error) *ServiceAccountServiceMock {
+	e.results = &ServiceAccountServiceMockListAPIKeysResults{aa1, err}
+	return e.mock
+}
+
+// Times sets number of times ServiceAccountService.ListAPIKeys should be invoked
+func (mmListAPIKeys *mServiceAccountServiceMockListAPIKeys) Times(n uint64) *mServiceAccountServiceMockListAPIKeys {
+	if n == 0 {
+		mmListAPIKeys.mock.t.Fatalf("Times of ServiceAccountServiceMock.ListAPIKeys mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListAPIKeys.expectedInvocations, n)
+	mmListAPIKeys.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListAPIKeys
+}
+
+func (mmListAPIKeys *mServiceAccountServiceMockListAPIKeys) invocationsDone() bool {
+	if len(mmListAPIKeys.expectations) == 0 && mmListAPIKeys.defaultExpectation == nil && mmListAPIKeys.mock.funcListAPIKeys == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListAPIKeys.mock.afterListAPIKeysCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListAPIKeys.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListAPIKeys implements ServiceAccountService
+func (mmListAPIKeys *ServiceAccountServiceMock) ListAPIKeys(ctx context.Context, accountID string) (aa1 []models.APIKey, err error) {
+	mm_atomic.AddUint64(&mmListAPIKeys.beforeListAPIKeysCounter, 1)
+	defer mm_atomic.AddUint64(&mmListAPIKeys.afterListAPIKeysCounter, 1)
+
+	mmListAPIKeys.t.Helper()
+
+	if mmListAPIKeys.inspectFuncListAPIKeys != nil {
+		mmListAPIKeys.inspectFuncListAPIKeys(ctx, accountID)
+	}
+
+	mm_params := ServiceAccountServiceMockListAPIKeysParams{ctx, accountID}
+
+	// Record call args
+	mmListAPIKeys.ListAPIKeysMock.mutex.Lock()
+	mmListAPIKeys.ListAPIKeysMock.callArgs = append(mmListAPIKeys.ListAPIKeysMock.callArgs, &mm_params)
+	mmListAPIKeys.ListAPIKeysMock.mutex.Unlock()
+
+	for _, e := range mmListAPIKeys.ListAPIKeysMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.aa1, e.results.err
+		}
+	}
+
+	if mmListAPIKeys.ListAPIKeysMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListAPIKeys.ListAPIKeysMock.defaultExpectation.Counter, 1)
+		mm_want := mmListAPIKeys.ListAPIKeysMock.defaultExpectation.params
+		mm_want_ptrs := mmListAPIKeys.ListAPIKeysMock.defaultExpectation.paramPtrs
+
+		mm_got := ServiceAccountServiceMockListAPIKeysParams{ctx, accountID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListAPIKeys.t.Errorf("ServiceAccountServiceMock.ListAPIKeys got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListAPIKeys.ListAPIKeysMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.accountID != nil && !minimock.Equal(*mm_want_ptrs.accountID, mm_got.accountID) {
+				mmListAPIKeys.t.Errorf("ServiceAccountServiceMock.ListAPIKeys got unexpected parameter accountID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListAPIKeys.ListAPIKeysMock.defaultExpectation.expectationOrigins.originAccountID, *mm_want_ptrs.accountID, mm_got.accountID, minimock.Diff(*mm_want_ptrs.accountID, mm_got.accountID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListAPIKeys.t.Errorf("ServiceAccountServiceMock.ListAPIKeys got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListAPIKeys.ListAPIKeysMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListAPIKeys.ListAPIKeysMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListAPIKeys.t.Fatal("No results are set for the ServiceAccountServiceMock.ListAPIKeys")
+		}
+		return (*mm_results).aa1, (*mm_results).err
+	}
+	if mmListAPIKeys.funcListAPIKeys != nil {
+		return mmListAPIKeys.funcListAPIKeys(ctx, accountID)
+	}
+	mmListAPIKeys.t.Fatalf("Unexpected call to ServiceAccountServiceMock.ListAPIKeys. %v %v", ctx, accountID)
+	return
+}
+
+// ListAPIKeysAfterCounter returns a count of finished ServiceAccountServiceMock.ListAPIKeys invocations
+func (mmListAPIKeys *ServiceAccountServiceMock) ListAPIKeysAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListAPIKeys.afterListAPIKeysCounter)
+}
+
+// ListAPIKeysBeforeCounter returns a count of ServiceAccountServiceMock.ListAPIKeys invocations
+func (mmListAPIKeys *ServiceAccountServiceMock) ListAPIKeysBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListAPIKeys.beforeListAPIKeysCounter)
+}
+
+// Calls returns a list of arguments used in each call to ServiceAccountServiceMock.ListAPIKeys.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListAPIKeys *mServiceAccountServiceMockListAPIKeys) Calls() []*ServiceAccountServiceMockListAPIKeysParams {
+	mmListAPIKeys.mutex.RLock()
+
+	argCopy := make([]*ServiceAccountServiceMockListAPIKeysParams, len(mmListAPIKeys.callArgs))
+	copy(argCopy, mmListAPIKeys.callArgs)
+
+	mmListAPIKeys.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListAPIKeysDone returns true if the count of the ListAPIKeys invocations corresponds
+// the number of defined expectations
+func (m *ServiceAccountServiceMock) MinimockListAPIKeysDone() bool {
+	if m.ListAPIKeysMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListAPIKeysMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListAPIKeysMock.invocationsDone()
+}
+
+// MinimockListAPIKeysInspect logs each unmet expectation
+func (m *ServiceAccountServiceMock) MinimockListAPIKeysInspect() {
+	for _, e := range m.ListAPIKeysMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ServiceAccountServiceMock.ListAPIKeys at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListAPIKeysCounter := mm_atomic.LoadUint64(&m.afterListAPIKeysCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListAPIKeysMock.defaultExpectation != nil && afterListAPIKeysCounter < 1 {
+		if m.ListAPIKeysMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ServiceAccountServiceMock.ListAPIKeys at\n%s", m.ListAPIKeysMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ServiceAccountServiceMock.ListAPIKeys at\n%s with params: %#v", m.ListAPIKeysMock.defaultExpectation.expectationOrigins.origin, *m.ListAPIKeysMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListAPIKeys != nil && afterListAPIKeysCounter < 1 {
+		m.t.Errorf("Expected call to ServiceAccountServiceMock.ListAPIKeys at\n%s", m.funcListAPIKeysOrigin)
+	}
+
+	if !m.ListAPIKeysMock.invocationsDone() && afterListAPIKeysCounter > 0 {
+		m.t.Errorf("Expected %d calls to ServiceAccountServiceMock.ListAPIKeys at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListAPIKeysMock.expectedInvocations), m.ListAPIKeysMock.expectedInvocationsOrigin, afterListAPIKeysCounter)
+	}
+}
+
+type mServiceAccountServiceMockListServiceAccounts struct {
+	optional           bool
+	mock               *ServiceAccountServiceMock
+	defaultExpectation *ServiceAccountServiceMockListServiceAccountsExpectation
+	expectations       []*ServiceAccountServiceMockListServiceAccountsExpectation
+
+	callArgs []*ServiceAccountServiceMockListServiceAccountsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ServiceAccountServiceMockListServiceAccountsExpectation specifies expectation struct of the ServiceAccountService.ListServiceAccounts
+type ServiceAccountServiceMockListServiceAccountsExpectation struct {
+	mock               *ServiceAccountServiceMock
+	params             *ServiceAccountServiceMockListServiceAccountsParams
+	paramPtrs          *ServiceAccountServiceMockListServiceAccountsParamPtrs
+	expectationOrigins ServiceAccountServiceMockListServiceAccountsExpectationOrigins
+	results            *ServiceAccountServiceMockListServiceAccountsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ServiceAccountServiceMockListServiceAccountsParams contains parameters of the ServiceAccountService.ListServiceAccounts
+type ServiceAccountServiceMockListServiceAccountsParams struct {
+	ctx context.Context
+}
+
+// ServiceAccountServiceMockListServiceAccountsParamPtrs contains pointers to parameters of the ServiceAccountService.ListServiceAccounts
+type ServiceAccountServiceMockListServiceAccountsParamPtrs struct {
+	ctx *context.Context
+}
+
+// ServiceAccountServiceMockListServiceAccountsResults contains results of the ServiceAccountService.ListServiceAccounts
+type ServiceAccountServiceMockListServiceAccountsResults struct {
+	sa1 []models.ServiceAccount
+	err error
+}
+
+// ServiceAccountServiceMockListServiceAccountsOrigins contains origins of expectations of the ServiceAccountService.ListServiceAccounts
+type ServiceAccountServiceMockListServiceAccountsExpectationOrigins struct {
+	origin    string
+	originCtx string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListServiceAccounts *mServiceAccountServiceMockListServiceAccounts) Optional() *mServiceAccountServiceMockListServiceAccounts {
+	mmListServiceAccounts.optional = true
+	return mmListServiceAccounts
+}
+
+// Expect sets up expected params for ServiceAccountService.ListServiceAccounts
+func (mmListServiceAccounts *mServiceAccountServiceMockListServiceAccounts) Expect(ctx context.Context) *mServiceAccountServiceMockListServiceAccounts {
+	if mmListServiceAccounts.mock.funcListServiceAccounts != nil {
+		mmListServiceAccounts.mock.t.Fatalf("ServiceAccountServiceMock.ListServiceAccounts mock is already set by Set")
+	}
+
+	if mmListServiceAccounts.defaultExpectation == nil {
+		mmListServiceAccounts.defaultExpectation = &ServiceAccountServiceMockListServiceAccountsExpectation{}
+	}
+
+	if mmListServiceAccounts.defaultExpectation.paramPtrs != nil {
+		mmListServiceAccounts.mock.t.Fatalf("ServiceAccountServiceMock.ListServiceAccounts mock is already set by ExpectParams functions")
+	}
+
+	mmListServiceAccounts.defaultExpectation.params = &ServiceAccountServiceMockListServiceAccountsParams{ctx}
+	mmListServiceAccounts.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListServiceAccounts.expectations {
+		if minimock.Equal(e.params, mmListServiceAccounts.defaultExpectation.params) {
+			mmListServiceAccounts.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListServiceAccounts.defaultExpectation.params)
+		}
+	}
+
+	return mmListServiceAccounts
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ServiceAccountService.ListServiceAccounts
+func (mmListServiceAccounts *mServiceAccountServiceMockListServiceAccounts) ExpectCtxParam1(ctx context.Context) *mServiceAccountServiceMockListServiceAccounts {
+	if mmListServiceAccounts.mock.funcListServiceAccounts != nil {
+		mmListServiceAccounts.mock.t.Fatalf("ServiceAccountServiceMock.ListServiceAccounts mock is already set by Set")
+	}
+
+	if mmListServiceAccounts.defaultExpectation == nil {
+		mmListServiceAccounts.defaultExpectation = &ServiceAccountServiceMockListServiceAccountsExpectation{}
+	}
+
+	if mmListServiceAccounts.defaultExpectation.params != nil {
+		mmListServiceAccounts.mock.t.Fatalf("ServiceAccountServiceMock.ListServiceAccounts mock is already set by Expect")
+	}
+
+	if mmListServiceAccounts.defaultExpectation.paramPtrs == nil {
+		mmListServiceAccounts.defaultExpectation.paramPtrs = &ServiceAccountServiceMockListServiceAccountsParamPtrs{}
+	}
+	mmListServiceAccounts.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListServiceAccounts.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListServiceAccounts
+}
+
+// Inspect accepts an inspector function that has same arguments as the ServiceAccountService.ListServiceAccounts
+func (mmListServiceAccounts *mServiceAccountServiceMockListServiceAccounts) Inspect(f func(ctx context.Context)) *mServiceAccountServiceMockListServiceAccounts {
+	if mmListServiceAccounts.mock.inspectFuncListServiceAccounts != nil {
+		mmListServiceAccounts.mock.t.Fatalf("Inspect function is already set for ServiceAccountServiceMock.ListServiceAccounts")
+	}
+
+	mmListServiceAccounts.mock.inspectFuncListServiceAccounts = f
+
+	return mmListServiceAccounts
+}
+
+// Return sets up results that will be returned by ServiceAccountService.ListServiceAccounts
+func (mmListServiceAccounts *mServiceAccountServiceMockListServiceAccounts) Return(sa1 []models.ServiceAccount, err error) *ServiceAccountServiceMock {
+	if mmListServiceAccounts.mock.funcListServiceAccounts != nil {
+		mmListServiceAccounts.mock.t.Fatalf("ServiceAccountServiceMock.ListServiceAccounts mock is already set by Set")
+	}
+
+	if mmListServiceAccounts.defaultExpectation == nil {
+		mmListServiceAccounts.defaultExpectation = &ServiceAccountServiceMockListServiceAccountsExpectation{mock: mmListServiceAccounts.mock}
+	}
+	mmListServiceAccounts.defaultExpectation.results = &ServiceAccountServiceMockListServiceAccountsResults{sa1, err}
+	mmListServiceAccounts.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListServiceAccounts.mock
+}
+
+// Set uses given function f to mock the ServiceAccountService.ListServiceAccounts method
+func (mmListServiceAccounts *mServiceAccountServiceMockListServiceAccounts) Set(f func(ctx context.Context) (sa1 []models.ServiceAccount, err error)) *ServiceAccountServiceMock {
+	if mmListServiceAccounts.defaultExpectation != nil {
+		mmListServiceAccounts.mock.t.Fatalf("Default expectation is already set for the ServiceAccountService.ListServiceAccounts method")
+	}
+
+	if len(mmListServiceAccounts.expectations) > 0 {
+		mmListServiceAccounts.mock.t.Fatalf("Some expectations are already set for the ServiceAccountService.ListServiceAccounts method")
+	}
+
+	mmListServiceAccounts.mock.funcListServiceAccounts = f
+	mmListServiceAccounts.mock.funcListServiceAccountsOrigin = minimock.CallerInfo(1)
+	return mmListServiceAccounts.mock
+}
+
+// When sets expectation for the ServiceAccountService.ListServiceAccounts which will trigger the result defined by the following
+// Then helper
+func (mmListServiceAccounts *mServiceAccountServiceMockListServiceAccounts) When(ctx context.Context) *ServiceAccountServiceMockListServiceAccountsExpectation {
+	if mmListServiceAccounts.mock.funcListServiceAccounts != nil {
+		mmListServiceAccounts.mock.t.Fatalf("ServiceAccountServiceMock.ListServiceAccounts mock is already set by Set")
+	}
+
+	expectation := &ServiceAccountServiceMockListServiceAccountsExpectation{
+		mock:               mmListServiceAccounts.mock,
+		params:             &ServiceAccountServiceMockListServiceAccountsParams{ctx},
+		expectationOrigins: ServiceAccountServiceMockListServiceAccountsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListServiceAccounts.expectations = append(mmListServiceAccounts.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ServiceAccountService.ListServiceAccounts return parameters for the expectation previously defined by the When method
+func (e *ServiceAccountServiceMockListServiceAccountsExpectation) Then(sa1 []models.ServiceAccount, err error) *ServiceAccountServiceMock {
+	e.results = &ServiceAccountServiceMockListServiceAccountsResults{sa1, err}
+	return e.mock
+}
+
+// Times sets number of times ServiceAccountService.ListServiceAccounts should be invoked
+func (mmListServiceAccounts *mServiceAccountServiceMockListServiceAccounts) Times(n uint64) *mServiceAccountServiceMockListServiceAccounts {
+	if n == 0 {
+		mmListServiceAccounts.mock.t.Fatalf("Times of ServiceAccountServiceMock.ListServiceAccounts mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListServiceAccounts.expectedInvocations, n)
+	mmListServiceAccounts.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListServiceAccounts
+}
+
+func (mmListServiceAccounts *mServiceAccountServiceMockListServiceAccounts) invocationsDone() bool {
+	if len(mmListServiceAccounts.expectations) == 0 && mmListServiceAccounts.defaultExpectation == nil && mmListServiceAccounts.mock.funcListServiceAccounts == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListServiceAccounts.mock.afterListServiceAccountsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListServiceAccounts.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListServiceAccounts implements ServiceAccountService
+func (mmListServiceAccounts *ServiceAccountServiceMock) ListServiceAccounts(ctx context.Context) (sa1 []models.ServiceAccount, err error) {
+	mm_atomic.AddUint64(&mmListServiceAccounts.beforeListServiceAccountsCounter, 1)
+	defer mm_atomic.AddUint64(&mmListServiceAccounts.afterListServiceAccountsCounter, 1)
+
+	mmListServiceAccounts.t.Helper()
+
+	if mmListServiceAccounts.inspectFuncListServiceAccounts != nil {
+		mmListServiceAccounts.inspectFuncListServiceAccounts(ctx)
+	}
+
+	mm_params := ServiceAccountServiceMockListServiceAccountsParams{ctx}
+
+	// Record call args
+	mmListServiceAccounts.ListServiceAccountsMock.mutex.Lock()
+	mmListServiceAccounts.ListServiceAccountsMock.callArgs = append(mmListServiceAccounts.ListServiceAccountsMock.callArgs, &mm_params)
+	mmListServiceAccounts.ListServiceAccountsMock.mutex.Unlock()
+
+	for _, e := range mmListServiceAccounts.ListServiceAccountsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.sa1, e.results.err
+		}
+	}
+
+	if mmListServiceAccounts.ListServiceAccountsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListServiceAccounts.ListServiceAccountsMock.defaultExpectation.Counter, 1)
+		mm_want := mmListServiceAccounts.ListServiceAccountsMock.defaultExpectation.params
+		mm_want_ptrs := mmListServiceAccounts.ListServiceAccountsMock.defaultExpectation.paramPtrs
+
+		mm_got := ServiceAccountServiceMockListServiceAccountsParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListServiceAccounts.t.Errorf("ServiceAccountServiceMock.ListServiceAccounts got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListServiceAccounts.ListServiceAccountsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListServiceAccounts.t.Errorf("ServiceAccountServiceMock.ListServiceAccounts got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListServiceAccounts.ListServiceAccountsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListServiceAccounts.ListServiceAccountsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListServiceAccounts.t.Fatal("No results are set for the ServiceAccountServiceMock.ListServiceAccounts")
+		}
+		return (*mm_results).sa1, (*mm_results).err
+	}
+	if mmListServiceAccounts.funcListServiceAccounts != nil {
+		return mmListServiceAccounts.funcListServiceAccounts(ctx)
+	}
+	mmListServiceAccounts.t.Fatalf("Unexpected call to ServiceAccountServiceMock.ListServiceAccounts. %v", ctx)
+	return
+}
+
+// ListServiceAccountsAfterCounter returns a count of finished ServiceAccountServiceMock.ListServiceAccounts invocations
+func (mmListServiceAccounts *ServiceAccountServiceMock) ListServiceAccountsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListServiceAccounts.afterListServiceAccountsCounter)
+}
+
+// ListServiceAccountsBeforeCounter returns a count of ServiceAccountServiceMock.ListServiceAccounts invocations
+func (mmListServiceAccounts *ServiceAccountServiceMock) ListServiceAccountsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListServiceAccounts.beforeListServiceAccountsCounter)
+}
+
+// Calls returns a list of arguments used in each call to ServiceAccountServiceMock.ListServiceAccounts.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListServiceAccounts *mServiceAccountServiceMockListServiceAccounts) Calls() []*ServiceAccountServiceMockListServiceAccountsParams {
+	mmListServiceAccounts.mutex.RLock()
+
+	argCopy := make([]*ServiceAccountServiceMockListServiceAccountsParams, len(mmListServiceAccounts.callArgs))
+	copy(argCopy, mmListServiceAccounts.callArgs)
+
+	mmListServiceAccounts.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListServiceAccountsDone returns true if the count of the ListServiceAccounts invocations corresponds
+// the number of defined expectations
+func (m *ServiceAccountServiceMock) MinimockListServiceAccountsDone() bool {
+	if m.ListServiceAccountsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListServiceAccountsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListServiceAccountsMock.invocationsDone()
+}
+
+// MinimockListServiceAccountsInspect logs each unmet expectation
+func (m *ServiceAccountServiceMock) MinimockListServiceAccountsInspect() {
+	for _, e := range m.ListServiceAccountsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ServiceAccountServiceMock.ListServiceAccounts at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListServiceAccountsCounter := mm_atomic.LoadUint64(&m.afterListServiceAccountsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListServiceAccountsMock.defaultExpectation != nil && afterListServiceAccountsCounter < 1 {
+		if m.ListServiceAccountsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ServiceAccountServiceMock.ListServiceAccounts at\n%s", m.ListServiceAccountsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ServiceAccountServiceMock.ListServiceAccounts at\n%s with params: %#v", m.ListServiceAccountsMock.defaultExpectation.expectationOrigins.origin, *m.ListServiceAccountsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListServiceAccounts != nil && afterListServiceAccountsCounter < 1 {
+		m.t.Errorf("Expected call to ServiceAccountServiceMock.ListServiceAccounts at\n%s", m.funcListServiceAccountsOrigin)
+	}
+
+	if !m.ListServiceAccountsMock.invocationsDone() && afterListServiceAccountsCounter > 0 {
+		m.t.Errorf("Expected %d calls to ServiceAccountServiceMock.ListServiceAccounts at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListServiceAccountsMock.expectedInvocations), m.ListServiceAccountsMock.expectedInvocationsOrigin, afterListServiceAccountsCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *ServiceAccountServiceMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockAuthenticateAPIKeyInspect()
+
+			m.MinimockCreateAPIKeyInspect()
+
+			m.MinimockCreateServiceAccountInspect()
+
+			m.MinimockDeleteAPIKeyInspect()
+
+			m.MinimockDeleteServiceAccountInspect()
+
+			m.MinimockGetServiceAccountInspect()
+
+			m.MinimockListAPIKeysInspect()
+
+			m.MinimockListServiceAccountsInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *ServiceAccountServiceMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *ServiceAccountServiceMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockAuthenticateAPIKeyDone() &&
+		m.MinimockCreateAPIKeyDone() &&
+		m.MinimockCreateServiceAccountDone() &&
+		m.MinimockDeleteAPIKeyDone() &&
+		m.MinimockDeleteServiceAccountDone() &&
+		m.MinimockGetServiceAccountDone() &&
+		m.MinimockListAPIKeysDone() &&
+		m.MinimockListServiceAccountsDone()
+}
@@ -0,0 +1,218 @@
+package handlers_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alonsoF100/authorization-service/internal/apperrors"
+	"github.com/alonsoF100/authorization-service/internal/models"
+	"github.com/alonsoF100/authorization-service/internal/transport/http/dto"
+	"github.com/alonsoF100/authorization-service/internal/transport/http/handlers"
+	"github.com/alonsoF100/authorization-service/internal/transport/http/middleware"
+	"github.com/go-playground/validator/v10"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateServiceAccount(t *testing.T) {
+	mc := minimock.NewController(t)
+	mockService := handlers.NewServiceAccountServiceMock(mc)
+
+	h := handlers.Handler{
+		ServiceAccountService: mockService,
+		Validator:             validator.New(),
+	}
+
+	admin := &models.Claims{ID: "admin123", Roles: []string{models.RoleAdmin}}
+	account := &models.ServiceAccount{
+		ID:        "account123",
+		Name:      "deployer",
+		OwnerID:   "admin123",
+		Roles:     []string{"deploy"},
+		CreatedAt: time.Now(),
+	}
+
+	tests := []struct {
+		name       string
+		claims     *models.Claims
+		body       string
+		mockSetup  func(ctx context.Context)
+		wantStatus int
+		wantError  string
+	}{
+		{
+			name:   "success",
+			claims: admin,
+			body:   `{"name":"deployer","roles":["deploy"]}`,
+			mockSetup: func(ctx context.Context) {
+				mockService.CreateServiceAccountMock.Expect(ctx, "admin123", "deployer", []string{"deploy"}).
+					Return(account, nil)
+			},
+			wantStatus: http.StatusCreated,
+		},
+		{
+			name: "service account admin creates an unowned account",
+			claims: &models.Claims{
+				ID:            "account456",
+				Roles:         []string{models.RoleAdmin},
+				PrincipalType: models.PrincipalServiceAccount,
+			},
+			body: `{"name":"deployer","roles":["deploy"]}`,
+			mockSetup: func(ctx context.Context) {
+				mockService.CreateServiceAccountMock.Expect(ctx, "", "deployer", []string{"deploy"}).
+					Return(account, nil)
+			},
+			wantStatus: http.StatusCreated,
+		},
+		{
+			name:       "short name",
+			claims:     admin,
+			body:       `{"name":"ci"}`,
+			mockSetup:  func(ctx context.Context) {},
+			wantStatus: http.StatusBadRequest,
+			wantError:  apperrors.ErrFailedToValidate.Error(),
+		},
+		{
+			name:   "duplicate name",
+			claims: admin,
+			body:   `{"name":"deployer"}`,
+			mockSetup: func(ctx context.Context) {
+				mockService.CreateServiceAccountMock.Expect(ctx, "admin123", "deployer", nil).
+					Return(nil, apperrors.ErrServiceAccountExist)
+			},
+			wantStatus: http.StatusConflict,
+			wantError:  apperrors.ErrServiceAccountExist.Error(),
+		},
+		{
+			name:   "service error",
+			claims: admin,
+			body:   `{"name":"deployer"}`,
+			mockSetup: func(ctx context.Context) {
+				mockService.CreateServiceAccountMock.Expect(ctx, "admin123", "deployer", nil).
+					Return(nil, errors.New("db error"))
+			},
+			wantStatus: http.StatusInternalServerError,
+			wantError:  apperrors.ErrServer.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.WithValue(context.Background(), middleware.UserContextKey, tt.claims)
+
+			tt.mockSetup(ctx)
+
+			req := httptest.NewRequest(http.MethodPost, "/admin/service-accounts", strings.NewReader(tt.body))
+			req = req.WithContext(ctx)
+
+			rr := httptest.NewRecorder()
+
+			h.CreateServiceAccount(rr, req)
+
+			require.Equal(t, tt.wantStatus, rr.Code)
+
+			if tt.wantError != "" {
+				var resp dto.ErrorResponse
+				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+				require.Equal(t, tt.wantError, resp.Error)
+				return
+			}
+
+			var resp dto.ServiceAccountResponse
+			require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+			require.Equal(t, account.ID, resp.ID)
+		})
+	}
+}
+
+func TestCreateAPIKey(t *testing.T) {
+	mc := minimock.NewController(t)
+	mockService := handlers.NewServiceAccountServiceMock(mc)
+
+	h := handlers.Handler{
+		ServiceAccountService: mockService,
+	}
+
+	key := &models.APIKey{ID: "key123", ServiceAccountID: "account123", CreatedAt: time.Now()}
+
+	tests := []struct {
+		name       string
+		mockSetup  func(ctx context.Context)
+		wantStatus int
+		wantError  string
+	}{
+		{
+			name: "success",
+			mockSetup: func(ctx context.Context) {
+				mockService.CreateAPIKeyMock.Expect(ctx, "account123").
+					Return(key, models.APIKeyPrefix+"key123.secret", nil)
+			},
+			wantStatus: http.StatusCreated,
+		},
+		{
+			name: "account not found",
+			mockSetup: func(ctx context.Context) {
+				mockService.CreateAPIKeyMock.Expect(ctx, "account123").
+					Return(nil, "", apperrors.ErrServiceAccountNotFound)
+			},
+			wantStatus: http.StatusNotFound,
+			wantError:  apperrors.ErrServiceAccountNotFound.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			tt.mockSetup(ctx)
+
+			req := httptest.NewRequest(http.MethodPost, "/admin/service-accounts/account123/keys", nil)
+			req.SetPathValue("id", "account123")
+
+			rr := httptest.NewRecorder()
+
+			h.CreateAPIKey(rr, req)
+
+			require.Equal(t, tt.wantStatus, rr.Code)
+
+			if tt.wantError != "" {
+				var resp dto.ErrorResponse
+				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+				require.Equal(t, tt.wantError, resp.Error)
+				return
+			}
+
+			var resp dto.CreateAPIKeyResponse
+			require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+			require.Equal(t, key.ID, resp.ID)
+			require.Equal(t, models.APIKeyPrefix+"key123.secret", resp.Key)
+		})
+	}
+}
+
+func TestDeleteAPIKey(t *testing.T) {
+	mc := minimock.NewController(t)
+	mockService := handlers.NewServiceAccountServiceMock(mc)
+
+	h := handlers.Handler{
+		ServiceAccountService: mockService,
+	}
+
+	mockService.DeleteAPIKeyMock.Expect(context.Background(), "account123", "key123").
+		Return(apperrors.ErrAPIKeyNotFound)
+
+	req := httptest.NewRequest(http.MethodDelete, "/admin/service-accounts/account123/keys/key123", nil)
+	req.SetPathValue("id", "account123")
+	req.SetPathValue("keyID", "key123")
+
+	rr := httptest.NewRecorder()
+
+	h.DeleteAPIKey(rr, req)
+
+	require.Equal(t, http.StatusNotFound, rr.Code)
+}
@@ -0,0 +1,93 @@
+package handlers
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/alonsoF100/authorization-service/internal/apperrors"
+	"github.com/alonsoF100/authorization-service/internal/transport/http/dto"
+	"github.com/alonsoF100/authorization-service/internal/transport/http/help"
+)
+
+/*
+pattern: /api/me/sessions
+method: GET
+info: barer token from header
+
+succeed:
+
+	-status code: 200 ok
+	-response body: JSON with the active sessions of the user, the session
+	of the token is marked as current
+
+failed:
+
+	-status code: 401 unauthorized, 403 forbidden for client, scoped and
+	personal access tokens, 500 internal server error
+	-response body: JSON with error message + timestamp
+*/
+func (h Handler) ListSessions(w http.ResponseWriter, r *http.Request) {
+	const op = "handlers/session.go/ListSessions"
+
+	claims, ok := sessionClaims(w, r, op)
+	if !ok {
+		return
+	}
+
+	sessions, err := h.UserService.ListSessions(r.Context(), claims.ID)
+	if err != nil {
+		help.WriteJSON(w, http.StatusInternalServerError, dto.NewErrorResponse(apperrors.ErrServer))
+		slog.Debug("Intenal server error",
+			slog.String("op", op),
+			slog.String("user_id", claims.ID),
+			slog.String("error", err.Error()),
+		)
+		return
+	}
+
+	help.WriteJSON(w, http.StatusOK, dto.NewListSessionsResponse(sessions, claims.SessionID))
+}
+
+/*
+pattern: /api/me/sessions/{id}
+method: DELETE
+info: barer token from header, session id from path. Tokens of the session
+stop working, revoking the current session signs out
+
+succeed:
+
+	-status code: 204 no content
+
+failed:
+
+	-status code: 401 unauthorized, 403 forbidden for client, scoped and
+	personal access tokens, 404 not found, 500 internal server error
+	-response body: JSON with error message + timestamp
+*/
+func (h Handler) DeleteSession(w http.ResponseWriter, r *http.Request) {
+	const op = "handlers/session.go/DeleteSession"
+
+	claims, ok := sessionClaims(w, r, op)
+	if !ok {
+		return
+	}
+
+	err := h.UserService.DeleteSession(r.Context(), claims.ID, r.PathValue("id"))
+	if err != nil {
+		if errors.Is(err, apperrors.ErrSessionNotFound) {
+			help.WriteJSON(w, http.StatusNotFound, dto.NewErrorResponse(apperrors.ErrSessionNotFound))
+			return
+		}
+
+		help.WriteJSON(w, http.StatusInternalServerError, dto.NewErrorResponse(apperrors.ErrServer))
+		slog.Debug("Intenal server error",
+			slog.String("op", op),
+			slog.String("user_id", claims.ID),
+			slog.String("error", err.Error()),
+		)
+		return
+	}
+
+	help.WriteJSON(w, http.StatusNoContent, nil)
+}
@@ -0,0 +1,182 @@
+package handlers_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/alonsoF100/authorization-service/internal/apperrors"
+	"github.com/alonsoF100/authorization-service/internal/models"
+	"github.com/alonsoF100/authorization-service/internal/transport/http/dto"
+	"github.com/alonsoF100/authorization-service/internal/transport/http/handlers"
+	"github.com/alonsoF100/authorization-service/internal/transport/http/middleware"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListSessions(t *testing.T) {
+	mc := minimock.NewController(t)
+	mockService := handlers.NewUserServiceMock(mc)
+
+	h := handlers.Handler{
+		UserService: mockService,
+	}
+
+	now := time.Now()
+	sessions := []models.Session{
+		{ID: "session2", UserID: "user123", DeviceName: "curl", CreatedAt: now, LastSeenAt: now, ExpiresAt: now.Add(time.Hour)},
+		{ID: "session1", UserID: "user123", DeviceName: "Firefox on Linux", IP: "192.0.2.1", CreatedAt: now, LastSeenAt: now, ExpiresAt: now.Add(time.Hour)},
+	}
+
+	tests := []struct {
+		name        string
+		claims      *models.Claims
+		viaPAT      bool
+		mockSetup   func(ctx context.Context)
+		wantStatus  int
+		wantError   string
+		wantCurrent []bool
+	}{
+		{
+			name:   "current session is marked",
+			claims: &models.Claims{ID: "user123", SessionID: "session1"},
+			mockSetup: func(ctx context.Context) {
+				mockService.ListSessionsMock.Expect(ctx, "user123").Return(sessions, nil)
+			},
+			wantStatus:  http.StatusOK,
+			wantCurrent: []bool{false, true},
+		},
+		{
+			name:   "token without session",
+			claims: &models.Claims{ID: "user123"},
+			mockSetup: func(ctx context.Context) {
+				mockService.ListSessionsMock.Expect(ctx, "user123").Return(sessions, nil)
+			},
+			wantStatus:  http.StatusOK,
+			wantCurrent: []bool{false, false},
+		},
+		{
+			name:       "personal access token",
+			claims:     &models.Claims{ID: "user123"},
+			viaPAT:     true,
+			mockSetup:  func(ctx context.Context) {},
+			wantStatus: http.StatusForbidden,
+			wantError:  apperrors.ErrSessionRequired.Error(),
+		},
+		{
+			name:   "service error",
+			claims: &models.Claims{ID: "user123"},
+			mockSetup: func(ctx context.Context) {
+				mockService.ListSessionsMock.Expect(ctx, "user123").Return(nil, errors.New("db error"))
+			},
+			wantStatus: http.StatusInternalServerError,
+			wantError:  apperrors.ErrServer.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.WithValue(context.Background(), middleware.UserContextKey, tt.claims)
+			ctx = context.WithValue(ctx, middleware.PrincipalContextKey, tt.claims.Principal())
+			ctx = context.WithValue(ctx, middleware.PersonalAccessTokenContextKey, tt.viaPAT)
+
+			tt.mockSetup(ctx)
+
+			req := httptest.NewRequest(http.MethodGet, "/api/me/sessions", nil)
+			req = req.WithContext(ctx)
+
+			rr := httptest.NewRecorder()
+
+			h.ListSessions(rr, req)
+
+			require.Equal(t, tt.wantStatus, rr.Code)
+
+			if tt.wantError != "" {
+				var resp dto.ErrorResponse
+				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+				require.Equal(t, tt.wantError, resp.Error)
+				return
+			}
+
+			var resp dto.ListSessionsResponse
+			require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+			require.Len(t, resp.Sessions, len(tt.wantCurrent))
+			for i, current := range tt.wantCurrent {
+				require.Equal(t, sessions[i].ID, resp.Sessions[i].ID)
+				require.Equal(t, current, resp.Sessions[i].Current)
+			}
+			require.Equal(t, "Firefox on Linux", resp.Sessions[1].DeviceName)
+			require.Equal(t, "192.0.2.1", resp.Sessions[1].IP)
+		})
+	}
+}
+
+func TestDeleteSession(t *testing.T) {
+	mc := minimock.NewController(t)
+	mockService := handlers.NewUserServiceMock(mc)
+
+	h := handlers.Handler{
+		UserService: mockService,
+	}
+
+	tests := []struct {
+		name       string
+		mockSetup  func(ctx context.Context)
+		wantStatus int
+		wantError  string
+	}{
+		{
+			name: "success",
+			mockSetup: func(ctx context.Context) {
+				mockService.DeleteSessionMock.Expect(ctx, "user123", "session123").Return(nil)
+			},
+			wantStatus: http.StatusNoContent,
+		},
+		{
+			name: "not found",
+			mockSetup: func(ctx context.Context) {
+				mockService.DeleteSessionMock.Expect(ctx, "user123", "session123").Return(apperrors.ErrSessionNotFound)
+			},
+			wantStatus: http.StatusNotFound,
+			wantError:  apperrors.ErrSessionNotFound.Error(),
+		},
+		{
+			name: "service error",
+			mockSetup: func(ctx context.Context) {
+				mockService.DeleteSessionMock.Expect(ctx, "user123", "session123").Return(errors.New("db error"))
+			},
+			wantStatus: http.StatusInternalServerError,
+			wantError:  apperrors.ErrServer.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			claims := &models.Claims{ID: "user123", SessionID: "session456"}
+			ctx := context.WithValue(context.Background(), middleware.UserContextKey, claims)
+			ctx = context.WithValue(ctx, middleware.PrincipalContextKey, claims.Principal())
+
+			tt.mockSetup(ctx)
+
+			req := httptest.NewRequest(http.MethodDelete, "/api/me/sessions/session123", nil)
+			req.SetPathValue("id", "session123")
+			req = req.WithContext(ctx)
+
+			rr := httptest.NewRecorder()
+
+			h.DeleteSession(rr, req)
+
+			require.Equal(t, tt.wantStatus, rr.Code)
+
+			if tt.wantError != "" {
+				var resp dto.ErrorResponse
+				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+				require.Equal(t, tt.wantError, resp.Error)
+			}
+		})
+	}
+}
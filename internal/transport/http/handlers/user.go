@@ -6,6 +6,7 @@ import (
 	"net/http"
 
 	"github.com/alonsoF100/authorization-service/internal/apperrors"
+	"github.com/alonsoF100/authorization-service/internal/models"
 	"github.com/alonsoF100/authorization-service/internal/transport/http/dto"
 	"github.com/alonsoF100/authorization-service/internal/transport/http/help"
 	"github.com/alonsoF100/authorization-service/internal/transport/http/middleware"
@@ -23,7 +24,8 @@ succeed:
 
 failed:
 
-	-status code: 401 unauthorized, 404 not found, 500 internal server error
+	-status code: 401 unauthorized, 403 forbidden for client and service
+	account tokens, 404 not found, 500 internal server error
 	-response body: JSON with error message + timestamp
 */
 func (h Handler) GetMe(w http.ResponseWriter, r *http.Request) {
@@ -38,6 +40,15 @@ func (h Handler) GetMe(w http.ResponseWriter, r *http.Request) {
 	}
 	ctx := r.Context()
 
+	if principal, ok := middleware.GetPrincipalFromContext(ctx); ok && principal != models.PrincipalUser {
+		slog.Debug("Client token rejected",
+			slog.String("op", op),
+			slog.String("client_id", claims.ClientID),
+		)
+		help.WriteJSON(w, http.StatusForbidden, dto.NewErrorResponse(apperrors.ErrClientPrincipal))
+		return
+	}
+
 	user, err := h.UserService.GetUser(ctx, claims.ID)
 	if err != nil {
 		if errors.Is(err, apperrors.ErrUserNotFoundByID) {
@@ -73,7 +84,8 @@ succeed:
 
 failed:
 
-	-status code: 401 unauthorized, 404 not found, 500 internal server error
+	-status code: 401 unauthorized, 403 forbidden for client and service
+	account tokens, 404 not found, 500 internal server error
 	-response body: JSON with error message + timestamp
 */
 func (h Handler) DeleteMe(w http.ResponseWriter, r *http.Request) {
@@ -88,6 +100,15 @@ func (h Handler) DeleteMe(w http.ResponseWriter, r *http.Request) {
 	}
 	ctx := r.Context()
 
+	if principal, ok := middleware.GetPrincipalFromContext(ctx); ok && principal != models.PrincipalUser {
+		slog.Debug("Client token rejected",
+			slog.String("op", op),
+			slog.String("client_id", claims.ClientID),
+		)
+		help.WriteJSON(w, http.StatusForbidden, dto.NewErrorResponse(apperrors.ErrClientPrincipal))
+		return
+	}
+
 	err := h.UserService.DeleteUser(ctx, claims.ID)
 	if err != nil {
 		if errors.Is(err, apperrors.ErrUserNotFoundByID) {
@@ -8,6 +8,7 @@ import (
 	"context"
 	"sync"
 	mm_atomic "sync/atomic"
+	"time"
 	mm_time "time"
 
 	"github.com/alonsoF100/authorization-service/internal/models"
@@ -19,6 +20,34 @@ type UserServiceMock struct {
 	t          minimock.Tester
 	finishOnce sync.Once
 
+	funcAuthenticatePersonalAccessToken          func(ctx context.Context, token string, ip string) (cp1 *models.Claims, err error)
+	funcAuthenticatePersonalAccessTokenOrigin    string
+	inspectFuncAuthenticatePersonalAccessToken   func(ctx context.Context, token string, ip string)
+	afterAuthenticatePersonalAccessTokenCounter  uint64
+	beforeAuthenticatePersonalAccessTokenCounter uint64
+	AuthenticatePersonalAccessTokenMock          mUserServiceMockAuthenticatePersonalAccessToken
+
+	funcCreatePersonalAccessToken          func(ctx context.Context, userID string, name string, scopes []string, expiresAt *time.Time) (pp1 *models.PersonalAccessToken, s1 string, err error)
+	funcCreatePersonalAccessTokenOrigin    string
+	inspectFuncCreatePersonalAccessToken   func(ctx context.Context, userID string, name string, scopes []string, expiresAt *time.Time)
+	afterCreatePersonalAccessTokenCounter  uint64
+	beforeCreatePersonalAccessTokenCounter uint64
+	CreatePersonalAccessTokenMock          mUserServiceMockCreatePersonalAccessToken
+
+	funcDeletePersonalAccessToken          func(ctx context.Context, userID string, tokenID string) (err error)
+	funcDeletePersonalAccessTokenOrigin    string
+	inspectFuncDeletePersonalAccessToken   func(ctx context.Context, userID string, tokenID string)
+	afterDeletePersonalAccessTokenCounter  uint64
+	beforeDeletePersonalAccessTokenCounter uint64
+	DeletePersonalAccessTokenMock          mUserServiceMockDeletePersonalAccessToken
+
+	funcDeleteSession          func(ctx context.Context, userID string, sessionID string) (err error)
+	funcDeleteSessionOrigin    string
+	inspectFuncDeleteSession   func(ctx context.Context, userID string, sessionID string)
+	afterDeleteSessionCounter  uint64
+	beforeDeleteSessionCounter uint64
+	DeleteSessionMock          mUserServiceMockDeleteSession
+
 	funcDeleteUser          func(ctx context.Context, userID string) (err error)
 	funcDeleteUserOrigin    string
 	inspectFuncDeleteUser   func(ctx context.Context, userID string)
@@ -32,6 +61,27 @@ type UserServiceMock struct {
 	afterGetUserCounter  uint64
 	beforeGetUserCounter uint64
 	GetUserMock          mUserServiceMockGetUser
+
+	funcListPersonalAccessTokens          func(ctx context.Context, userID string) (pa1 []models.PersonalAccessToken, err error)
+	funcListPersonalAccessTokensOrigin    string
+	inspectFuncListPersonalAccessTokens   func(ctx context.Context, userID string)
+	afterListPersonalAccessTokensCounter  uint64
+	beforeListPersonalAccessTokensCounter uint64
+	ListPersonalAccessTokensMock          mUserServiceMockListPersonalAccessTokens
+
+	funcListSessions          func(ctx context.Context, userID string) (sa1 []models.Session, err error)
+	funcListSessionsOrigin    string
+	inspectFuncListSessions   func(ctx context.Context, userID string)
+	afterListSessionsCounter  uint64
+	beforeListSessionsCounter uint64
+	ListSessionsMock          mUserServiceMockListSessions
+
+	funcSetAllowedIPs          func(ctx context.Context, userID string, ip string, allowedIPs []string) (sa1 []string, err error)
+	funcSetAllowedIPsOrigin    string
+	inspectFuncSetAllowedIPs   func(ctx context.Context, userID string, ip string, allowedIPs []string)
+	afterSetAllowedIPsCounter  uint64
+	beforeSetAllowedIPsCounter uint64
+	SetAllowedIPsMock          mUserServiceMockSetAllowedIPs
 }
 
 // NewUserServiceMock returns a mock for UserService
@@ -42,63 +92,88 @@ func NewUserServiceMock(t minimock.Tester) *UserServiceMock {
 		controller.RegisterMocker(m)
 	}
 
+	m.AuthenticatePersonalAccessTokenMock = mUserServiceMockAuthenticatePersonalAccessToken{mock: m}
+	m.AuthenticatePersonalAccessTokenMock.callArgs = []*UserServiceMockAuthenticatePersonalAccessTokenParams{}
+
+	m.CreatePersonalAccessTokenMock = mUserServiceMockCreatePersonalAccessToken{mock: m}
+	m.CreatePersonalAccessTokenMock.callArgs = []*UserServiceMockCreatePersonalAccessTokenParams{}
+
+	m.DeletePersonalAccessTokenMock = mUserServiceMockDeletePersonalAccessToken{mock: m}
+	m.DeletePersonalAccessTokenMock.callArgs = []*UserServiceMockDeletePersonalAccessTokenParams{}
+
+	m.DeleteSessionMock = mUserServiceMockDeleteSession{mock: m}
+	m.DeleteSessionMock.callArgs = []*UserServiceMockDeleteSessionParams{}
+
 	m.DeleteUserMock = mUserServiceMockDeleteUser{mock: m}
 	m.DeleteUserMock.callArgs = []*UserServiceMockDeleteUserParams{}
 
 	m.GetUserMock = mUserServiceMockGetUser{mock: m}
 	m.GetUserMock.callArgs = []*UserServiceMockGetUserParams{}
 
+	m.ListPersonalAccessTokensMock = mUserServiceMockListPersonalAccessTokens{mock: m}
+	m.ListPersonalAccessTokensMock.callArgs = []*UserServiceMockListPersonalAccessTokensParams{}
+
+	m.ListSessionsMock = mUserServiceMockListSessions{mock: m}
+	m.ListSessionsMock.callArgs = []*UserServiceMockListSessionsParams{}
+
+	m.SetAllowedIPsMock = mUserServiceMockSetAllowedIPs{mock: m}
+	m.SetAllowedIPsMock.callArgs = []*UserServiceMockSetAllowedIPsParams{}
+
 	t.Cleanup(m.MinimockFinish)
 
 	return m
 }
 
-type mUserServiceMockDeleteUser struct {
+type mUserServiceMockAuthenticatePersonalAccessToken struct {
 	optional           bool
 	mock               *UserServiceMock
-	defaultExpectation *UserServiceMockDeleteUserExpectation
-	expectations       []*UserServiceMockDeleteUserExpectation
+	defaultExpectation *UserServiceMockAuthenticatePersonalAccessTokenExpectation
+	expectations       []*UserServiceMockAuthenticatePersonalAccessTokenExpectation
 
-	callArgs []*UserServiceMockDeleteUserParams
+	callArgs []*UserServiceMockAuthenticatePersonalAccessTokenParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// UserServiceMockDeleteUserExpectation specifies expectation struct of the UserService.DeleteUser
-type UserServiceMockDeleteUserExpectation struct {
+// UserServiceMockAuthenticatePersonalAccessTokenExpectation specifies expectation struct of the UserService.AuthenticatePersonalAccessToken
+type UserServiceMockAuthenticatePersonalAccessTokenExpectation struct {
 	mock               *UserServiceMock
-	params             *UserServiceMockDeleteUserParams
-	paramPtrs          *UserServiceMockDeleteUserParamPtrs
-	expectationOrigins UserServiceMockDeleteUserExpectationOrigins
-	results            *UserServiceMockDeleteUserResults
+	params             *UserServiceMockAuthenticatePersonalAccessTokenParams
+	paramPtrs          *UserServiceMockAuthenticatePersonalAccessTokenParamPtrs
+	expectationOrigins UserServiceMockAuthenticatePersonalAccessTokenExpectationOrigins
+	results            *UserServiceMockAuthenticatePersonalAccessTokenResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// UserServiceMockDeleteUserParams contains parameters of the UserService.DeleteUser
-type UserServiceMockDeleteUserParams struct {
-	ctx    context.Context
-	userID string
+// UserServiceMockAuthenticatePersonalAccessTokenParams contains parameters of the UserService.AuthenticatePersonalAccessToken
+type UserServiceMockAuthenticatePersonalAccessTokenParams struct {
+	ctx   context.Context
+	token string
+	ip    string
 }
 
-// UserServiceMockDeleteUserParamPtrs contains pointers to parameters of the UserService.DeleteUser
-type UserServiceMockDeleteUserParamPtrs struct {
-	ctx    *context.Context
-	userID *string
+// UserServiceMockAuthenticatePersonalAccessTokenParamPtrs contains pointers to parameters of the UserService.AuthenticatePersonalAccessToken
+type UserServiceMockAuthenticatePersonalAccessTokenParamPtrs struct {
+	ctx   *context.Context
+	token *string
+	ip    *string
 }
 
-// UserServiceMockDeleteUserResults contains results of the UserService.DeleteUser
-type UserServiceMockDeleteUserResults struct {
+// UserServiceMockAuthenticatePersonalAccessTokenResults contains results of the UserService.AuthenticatePersonalAccessToken
+type UserServiceMockAuthenticatePersonalAccessTokenResults struct {
+	cp1 *models.Claims
 	err error
 }
 
-// UserServiceMockDeleteUserOrigins contains origins of expectations of the UserService.DeleteUser
-type UserServiceMockDeleteUserExpectationOrigins struct {
-	origin       string
-	originCtx    string
-	originUserID string
+// UserServiceMockAuthenticatePersonalAccessTokenOrigins contains origins of expectations of the UserService.AuthenticatePersonalAccessToken
+type UserServiceMockAuthenticatePersonalAccessTokenExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originToken string
+	originIp    string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -106,342 +181,380 @@ type UserServiceMockDeleteUserExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmDeleteUser *mUserServiceMockDeleteUser) Optional() *mUserServiceMockDeleteUser {
-	mmDeleteUser.optional = true
-	return mmDeleteUser
+func (mmAuthenticatePersonalAccessToken *mUserServiceMockAuthenticatePersonalAccessToken) Optional() *mUserServiceMockAuthenticatePersonalAccessToken {
+	mmAuthenticatePersonalAccessToken.optional = true
+	return mmAuthenticatePersonalAccessToken
 }
 
-// Expect sets up expected params for UserService.DeleteUser
-func (mmDeleteUser *mUserServiceMockDeleteUser) Expect(ctx context.Context, userID string) *mUserServiceMockDeleteUser {
-	if mmDeleteUser.mock.funcDeleteUser != nil {
-		mmDeleteUser.mock.t.Fatalf("UserServiceMock.DeleteUser mock is already set by Set")
+// Expect sets up expected params for UserService.AuthenticatePersonalAccessToken
+func (mmAuthenticatePersonalAccessToken *mUserServiceMockAuthenticatePersonalAccessToken) Expect(ctx context.Context, token string, ip string) *mUserServiceMockAuthenticatePersonalAccessToken {
+	if mmAuthenticatePersonalAccessToken.mock.funcAuthenticatePersonalAccessToken != nil {
+		mmAuthenticatePersonalAccessToken.mock.t.Fatalf("UserServiceMock.AuthenticatePersonalAccessToken mock is already set by Set")
 	}
 
-	if mmDeleteUser.defaultExpectation == nil {
-		mmDeleteUser.defaultExpectation = &UserServiceMockDeleteUserExpectation{}
+	if mmAuthenticatePersonalAccessToken.defaultExpectation == nil {
+		mmAuthenticatePersonalAccessToken.defaultExpectation = &UserServiceMockAuthenticatePersonalAccessTokenExpectation{}
 	}
 
-	if mmDeleteUser.defaultExpectation.paramPtrs != nil {
-		mmDeleteUser.mock.t.Fatalf("UserServiceMock.DeleteUser mock is already set by ExpectParams functions")
+	if mmAuthenticatePersonalAccessToken.defaultExpectation.paramPtrs != nil {
+		mmAuthenticatePersonalAccessToken.mock.t.Fatalf("UserServiceMock.AuthenticatePersonalAccessToken mock is already set by ExpectParams functions")
 	}
 
-	mmDeleteUser.defaultExpectation.params = &UserServiceMockDeleteUserParams{ctx, userID}
-	mmDeleteUser.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmDeleteUser.expectations {
-		if minimock.Equal(e.params, mmDeleteUser.defaultExpectation.params) {
-			mmDeleteUser.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteUser.defaultExpectation.params)
+	mmAuthenticatePersonalAccessToken.defaultExpectation.params = &UserServiceMockAuthenticatePersonalAccessTokenParams{ctx, token, ip}
+	mmAuthenticatePersonalAccessToken.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmAuthenticatePersonalAccessToken.expectations {
+		if minimock.Equal(e.params, mmAuthenticatePersonalAccessToken.defaultExpectation.params) {
+			mmAuthenticatePersonalAccessToken.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAuthenticatePersonalAccessToken.defaultExpectation.params)
 		}
 	}
 
-	return mmDeleteUser
+	return mmAuthenticatePersonalAccessToken
 }
 
-// ExpectCtxParam1 sets up expected param ctx for UserService.DeleteUser
-func (mmDeleteUser *mUserServiceMockDeleteUser) ExpectCtxParam1(ctx context.Context) *mUserServiceMockDeleteUser {
-	if mmDeleteUser.mock.funcDeleteUser != nil {
-		mmDeleteUser.mock.t.Fatalf("UserServiceMock.DeleteUser mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for UserService.AuthenticatePersonalAccessToken
+func (mmAuthenticatePersonalAccessToken *mUserServiceMockAuthenticatePersonalAccessToken) ExpectCtxParam1(ctx context.Context) *mUserServiceMockAuthenticatePersonalAccessToken {
+	if mmAuthenticatePersonalAccessToken.mock.funcAuthenticatePersonalAccessToken != nil {
+		mmAuthenticatePersonalAccessToken.mock.t.Fatalf("UserServiceMock.AuthenticatePersonalAccessToken mock is already set by Set")
 	}
 
-	if mmDeleteUser.defaultExpectation == nil {
-		mmDeleteUser.defaultExpectation = &UserServiceMockDeleteUserExpectation{}
+	if mmAuthenticatePersonalAccessToken.defaultExpectation == nil {
+		mmAuthenticatePersonalAccessToken.defaultExpectation = &UserServiceMockAuthenticatePersonalAccessTokenExpectation{}
 	}
 
-	if mmDeleteUser.defaultExpectation.params != nil {
-		mmDeleteUser.mock.t.Fatalf("UserServiceMock.DeleteUser mock is already set by Expect")
+	if mmAuthenticatePersonalAccessToken.defaultExpectation.params != nil {
+		mmAuthenticatePersonalAccessToken.mock.t.Fatalf("UserServiceMock.AuthenticatePersonalAccessToken mock is already set by Expect")
 	}
 
-	if mmDeleteUser.defaultExpectation.paramPtrs == nil {
-		mmDeleteUser.defaultExpectation.paramPtrs = &UserServiceMockDeleteUserParamPtrs{}
+	if mmAuthenticatePersonalAccessToken.defaultExpectation.paramPtrs == nil {
+		mmAuthenticatePersonalAccessToken.defaultExpectation.paramPtrs = &UserServiceMockAuthenticatePersonalAccessTokenParamPtrs{}
 	}
-	mmDeleteUser.defaultExpectation.paramPtrs.ctx = &ctx
-	mmDeleteUser.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmAuthenticatePersonalAccessToken.defaultExpectation.paramPtrs.ctx = &ctx
+	mmAuthenticatePersonalAccessToken.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmDeleteUser
+	return mmAuthenticatePersonalAccessToken
 }
 
-// ExpectUserIDParam2 sets up expected param userID for UserService.DeleteUser
-func (mmDeleteUser *mUserServiceMockDeleteUser) ExpectUserIDParam2(userID string) *mUserServiceMockDeleteUser {
-	if mmDeleteUser.mock.funcDeleteUser != nil {
-		mmDeleteUser.mock.t.Fatalf("UserServiceMock.DeleteUser mock is already set by Set")
+// ExpectTokenParam2 sets up expected param token for UserService.AuthenticatePersonalAccessToken
+func (mmAuthenticatePersonalAccessToken *mUserServiceMockAuthenticatePersonalAccessToken) ExpectTokenParam2(token string) *mUserServiceMockAuthenticatePersonalAccessToken {
+	if mmAuthenticatePersonalAccessToken.mock.funcAuthenticatePersonalAccessToken != nil {
+		mmAuthenticatePersonalAccessToken.mock.t.Fatalf("UserServiceMock.AuthenticatePersonalAccessToken mock is already set by Set")
 	}
 
-	if mmDeleteUser.defaultExpectation == nil {
-		mmDeleteUser.defaultExpectation = &UserServiceMockDeleteUserExpectation{}
+	if mmAuthenticatePersonalAccessToken.defaultExpectation == nil {
+		mmAuthenticatePersonalAccessToken.defaultExpectation = &UserServiceMockAuthenticatePersonalAccessTokenExpectation{}
 	}
 
-	if mmDeleteUser.defaultExpectation.params != nil {
-		mmDeleteUser.mock.t.Fatalf("UserServiceMock.DeleteUser mock is already set by Expect")
+	if mmAuthenticatePersonalAccessToken.defaultExpectation.params != nil {
+		mmAuthenticatePersonalAccessToken.mock.t.Fatalf("UserServiceMock.AuthenticatePersonalAccessToken mock is already set by Expect")
 	}
 
-	if mmDeleteUser.defaultExpectation.paramPtrs == nil {
-		mmDeleteUser.defaultExpectation.paramPtrs = &UserServiceMockDeleteUserParamPtrs{}
+	if mmAuthenticatePersonalAccessToken.defaultExpectation.paramPtrs == nil {
+		mmAuthenticatePersonalAccessToken.defaultExpectation.paramPtrs = &UserServiceMockAuthenticatePersonalAccessTokenParamPtrs{}
 	}
-	mmDeleteUser.defaultExpectation.paramPtrs.userID = &userID
-	mmDeleteUser.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+	mmAuthenticatePersonalAccessToken.defaultExpectation.paramPtrs.token = &token
+	mmAuthenticatePersonalAccessToken.defaultExpectation.expectationOrigins.originToken = minimock.CallerInfo(1)
 
-	return mmDeleteUser
+	return mmAuthenticatePersonalAccessToken
 }
 
-// Inspect accepts an inspector function that has same arguments as the UserService.DeleteUser
-func (mmDeleteUser *mUserServiceMockDeleteUser) Inspect(f func(ctx context.Context, userID string)) *mUserServiceMockDeleteUser {
-	if mmDeleteUser.mock.inspectFuncDeleteUser != nil {
-		mmDeleteUser.mock.t.Fatalf("Inspect function is already set for UserServiceMock.DeleteUser")
+// ExpectIpParam3 sets up expected param ip for UserService.AuthenticatePersonalAccessToken
+func (mmAuthenticatePersonalAccessToken *mUserServiceMockAuthenticatePersonalAccessToken) ExpectIpParam3(ip string) *mUserServiceMockAuthenticatePersonalAccessToken {
+	if mmAuthenticatePersonalAccessToken.mock.funcAuthenticatePersonalAccessToken != nil {
+		mmAuthenticatePersonalAccessToken.mock.t.Fatalf("UserServiceMock.AuthenticatePersonalAccessToken mock is already set by Set")
 	}
 
-	mmDeleteUser.mock.inspectFuncDeleteUser = f
+	if mmAuthenticatePersonalAccessToken.defaultExpectation == nil {
+		mmAuthenticatePersonalAccessToken.defaultExpectation = &UserServiceMockAuthenticatePersonalAccessTokenExpectation{}
+	}
 
-	return mmDeleteUser
+	if mmAuthenticatePersonalAccessToken.defaultExpectation.params != nil {
+		mmAuthenticatePersonalAccessToken.mock.t.Fatalf("UserServiceMock.AuthenticatePersonalAccessToken mock is already set by Expect")
+	}
+
+	if mmAuthenticatePersonalAccessToken.defaultExpectation.paramPtrs == nil {
+		mmAuthenticatePersonalAccessToken.defaultExpectation.paramPtrs = &UserServiceMockAuthenticatePersonalAccessTokenParamPtrs{}
+	}
+	mmAuthenticatePersonalAccessToken.defaultExpectation.paramPtrs.ip = &ip
+	mmAuthenticatePersonalAccessToken.defaultExpectation.expectationOrigins.originIp = minimock.CallerInfo(1)
+
+	return mmAuthenticatePersonalAccessToken
 }
 
-// Return sets up results that will be returned by UserService.DeleteUser
-func (mmDeleteUser *mUserServiceMockDeleteUser) Return(err error) *UserServiceMock {
-	if mmDeleteUser.mock.funcDeleteUser != nil {
-		mmDeleteUser.mock.t.Fatalf("UserServiceMock.DeleteUser mock is already set by Set")
+// Inspect accepts an inspector function that has same arguments as the UserService.AuthenticatePersonalAccessToken
+func (mmAuthenticatePersonalAccessToken *mUserServiceMockAuthenticatePersonalAccessToken) Inspect(f func(ctx context.Context, token string, ip string)) *mUserServiceMockAuthenticatePersonalAccessToken {
+	if mmAuthenticatePersonalAccessToken.mock.inspectFuncAuthenticatePersonalAccessToken != nil {
+		mmAuthenticatePersonalAccessToken.mock.t.Fatalf("Inspect function is already set for UserServiceMock.AuthenticatePersonalAccessToken")
 	}
 
-	if mmDeleteUser.defaultExpectation == nil {
-		mmDeleteUser.defaultExpectation = &UserServiceMockDeleteUserExpectation{mock: mmDeleteUser.mock}
+	mmAuthenticatePersonalAccessToken.mock.inspectFuncAuthenticatePersonalAccessToken = f
+
+	return mmAuthenticatePersonalAccessToken
+}
+
+// Return sets up results that will be returned by UserService.AuthenticatePersonalAccessToken
+func (mmAuthenticatePersonalAccessToken *mUserServiceMockAuthenticatePersonalAccessToken) Return(cp1 *models.Claims, err error) *UserServiceMock {
+	if mmAuthenticatePersonalAccessToken.mock.funcAuthenticatePersonalAccessToken != nil {
+		mmAuthenticatePersonalAccessToken.mock.t.Fatalf("UserServiceMock.AuthenticatePersonalAccessToken mock is already set by Set")
 	}
-	mmDeleteUser.defaultExpectation.results = &UserServiceMockDeleteUserResults{err}
-	mmDeleteUser.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmDeleteUser.mock
+
+	if mmAuthenticatePersonalAccessToken.defaultExpectation == nil {
+		mmAuthenticatePersonalAccessToken.defaultExpectation = &UserServiceMockAuthenticatePersonalAccessTokenExpectation{mock: mmAuthenticatePersonalAccessToken.mock}
+	}
+	mmAuthenticatePersonalAccessToken.defaultExpectation.results = &UserServiceMockAuthenticatePersonalAccessTokenResults{cp1, err}
+	mmAuthenticatePersonalAccessToken.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmAuthenticatePersonalAccessToken.mock
 }
 
-// Set uses given function f to mock the UserService.DeleteUser method
-func (mmDeleteUser *mUserServiceMockDeleteUser) Set(f func(ctx context.Context, userID string) (err error)) *UserServiceMock {
-	if mmDeleteUser.defaultExpectation != nil {
-		mmDeleteUser.mock.t.Fatalf("Default expectation is already set for the UserService.DeleteUser method")
+// Set uses given function f to mock the UserService.AuthenticatePersonalAccessToken method
+func (mmAuthenticatePersonalAccessToken *mUserServiceMockAuthenticatePersonalAccessToken) Set(f func(ctx context.Context, token string, ip string) (cp1 *models.Claims, err error)) *UserServiceMock {
+	if mmAuthenticatePersonalAccessToken.defaultExpectation != nil {
+		mmAuthenticatePersonalAccessToken.mock.t.Fatalf("Default expectation is already set for the UserService.AuthenticatePersonalAccessToken method")
 	}
 
-	if len(mmDeleteUser.expectations) > 0 {
-		mmDeleteUser.mock.t.Fatalf("Some expectations are already set for the UserService.DeleteUser method")
+	if len(mmAuthenticatePersonalAccessToken.expectations) > 0 {
+		mmAuthenticatePersonalAccessToken.mock.t.Fatalf("Some expectations are already set for the UserService.AuthenticatePersonalAccessToken method")
 	}
 
-	mmDeleteUser.mock.funcDeleteUser = f
-	mmDeleteUser.mock.funcDeleteUserOrigin = minimock.CallerInfo(1)
-	return mmDeleteUser.mock
+	mmAuthenticatePersonalAccessToken.mock.funcAuthenticatePersonalAccessToken = f
+	mmAuthenticatePersonalAccessToken.mock.funcAuthenticatePersonalAccessTokenOrigin = minimock.CallerInfo(1)
+	return mmAuthenticatePersonalAccessToken.mock
 }
 
-// When sets expectation for the UserService.DeleteUser which will trigger the result defined by the following
+// When sets expectation for the UserService.AuthenticatePersonalAccessToken which will trigger the result defined by the following
 // Then helper
-func (mmDeleteUser *mUserServiceMockDeleteUser) When(ctx context.Context, userID string) *UserServiceMockDeleteUserExpectation {
-	if mmDeleteUser.mock.funcDeleteUser != nil {
-		mmDeleteUser.mock.t.Fatalf("UserServiceMock.DeleteUser mock is already set by Set")
+func (mmAuthenticatePersonalAccessToken *mUserServiceMockAuthenticatePersonalAccessToken) When(ctx context.Context, token string, ip string) *UserServiceMockAuthenticatePersonalAccessTokenExpectation {
+	if mmAuthenticatePersonalAccessToken.mock.funcAuthenticatePersonalAccessToken != nil {
+		mmAuthenticatePersonalAccessToken.mock.t.Fatalf("UserServiceMock.AuthenticatePersonalAccessToken mock is already set by Set")
 	}
 
-	expectation := &UserServiceMockDeleteUserExpectation{
-		mock:               mmDeleteUser.mock,
-		params:             &UserServiceMockDeleteUserParams{ctx, userID},
-		expectationOrigins: UserServiceMockDeleteUserExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &UserServiceMockAuthenticatePersonalAccessTokenExpectation{
+		mock:               mmAuthenticatePersonalAccessToken.mock,
+		params:             &UserServiceMockAuthenticatePersonalAccessTokenParams{ctx, token, ip},
+		expectationOrigins: UserServiceMockAuthenticatePersonalAccessTokenExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmDeleteUser.expectations = append(mmDeleteUser.expectations, expectation)
+	mmAuthenticatePersonalAccessToken.expectations = append(mmAuthenticatePersonalAccessToken.expectations, expectation)
 	return expectation
 }
 
-// Then sets up UserService.DeleteUser return parameters for the expectation previously defined by the When method
-func (e *UserServiceMockDeleteUserExpectation) Then(err error) *UserServiceMock {
-	e.results = &UserServiceMockDeleteUserResults{err}
+// Then sets up UserService.AuthenticatePersonalAccessToken return parameters for the expectation previously defined by the When method
+func (e *UserServiceMockAuthenticatePersonalAccessTokenExpectation) Then(cp1 *models.Claims, err error) *UserServiceMock {
+	e.results = &UserServiceMockAuthenticatePersonalAccessTokenResults{cp1, err}
 	return e.mock
 }
 
-// Times sets number of times UserService.DeleteUser should be invoked
-func (mmDeleteUser *mUserServiceMockDeleteUser) Times(n uint64) *mUserServiceMockDeleteUser {
+// Times sets number of times UserService.AuthenticatePersonalAccessToken should be invoked
+func (mmAuthenticatePersonalAccessToken *mUserServiceMockAuthenticatePersonalAccessToken) Times(n uint64) *mUserServiceMockAuthenticatePersonalAccessToken {
 	if n == 0 {
-		mmDeleteUser.mock.t.Fatalf("Times of UserServiceMock.DeleteUser mock can not be zero")
+		mmAuthenticatePersonalAccessToken.mock.t.Fatalf("Times of UserServiceMock.AuthenticatePersonalAccessToken mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmDeleteUser.expectedInvocations, n)
-	mmDeleteUser.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmDeleteUser
+	mm_atomic.StoreUint64(&mmAuthenticatePersonalAccessToken.expectedInvocations, n)
+	mmAuthenticatePersonalAccessToken.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmAuthenticatePersonalAccessToken
 }
 
-func (mmDeleteUser *mUserServiceMockDeleteUser) invocationsDone() bool {
-	if len(mmDeleteUser.expectations) == 0 && mmDeleteUser.defaultExpectation == nil && mmDeleteUser.mock.funcDeleteUser == nil {
+func (mmAuthenticatePersonalAccessToken *mUserServiceMockAuthenticatePersonalAccessToken) invocationsDone() bool {
+	if len(mmAuthenticatePersonalAccessToken.expectations) == 0 && mmAuthenticatePersonalAccessToken.defaultExpectation == nil && mmAuthenticatePersonalAccessToken.mock.funcAuthenticatePersonalAccessToken == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmDeleteUser.mock.afterDeleteUserCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteUser.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmAuthenticatePersonalAccessToken.mock.afterAuthenticatePersonalAccessTokenCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmAuthenticatePersonalAccessToken.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// DeleteUser implements UserService
-func (mmDeleteUser *UserServiceMock) DeleteUser(ctx context.Context, userID string) (err error) {
-	mm_atomic.AddUint64(&mmDeleteUser.beforeDeleteUserCounter, 1)
-	defer mm_atomic.AddUint64(&mmDeleteUser.afterDeleteUserCounter, 1)
+// AuthenticatePersonalAccessToken implements UserService
+func (mmAuthenticatePersonalAccessToken *UserServiceMock) AuthenticatePersonalAccessToken(ctx context.Context, token string, ip string) (cp1 *models.Claims, err error) {
+	mm_atomic.AddUint64(&mmAuthenticatePersonalAccessToken.beforeAuthenticatePersonalAccessTokenCounter, 1)
+	defer mm_atomic.AddUint64(&mmAuthenticatePersonalAccessToken.afterAuthenticatePersonalAccessTokenCounter, 1)
 
-	mmDeleteUser.t.Helper()
+	mmAuthenticatePersonalAccessToken.t.Helper()
 
-	if mmDeleteUser.inspectFuncDeleteUser != nil {
-		mmDeleteUser.inspectFuncDeleteUser(ctx, userID)
+	if mmAuthenticatePersonalAccessToken.inspectFuncAuthenticatePersonalAccessToken != nil {
+		mmAuthenticatePersonalAccessToken.inspectFuncAuthenticatePersonalAccessToken(ctx, token, ip)
 	}
 
-	mm_params := UserServiceMockDeleteUserParams{ctx, userID}
+	mm_params := UserServiceMockAuthenticatePersonalAccessTokenParams{ctx, token, ip}
 
 	// Record call args
-	mmDeleteUser.DeleteUserMock.mutex.Lock()
-	mmDeleteUser.DeleteUserMock.callArgs = append(mmDeleteUser.DeleteUserMock.callArgs, &mm_params)
-	mmDeleteUser.DeleteUserMock.mutex.Unlock()
+	mmAuthenticatePersonalAccessToken.AuthenticatePersonalAccessTokenMock.mutex.Lock()
+	mmAuthenticatePersonalAccessToken.AuthenticatePersonalAccessTokenMock.callArgs = append(mmAuthenticatePersonalAccessToken.AuthenticatePersonalAccessTokenMock.callArgs, &mm_params)
+	mmAuthenticatePersonalAccessToken.AuthenticatePersonalAccessTokenMock.mutex.Unlock()
 
-	for _, e := range mmDeleteUser.DeleteUserMock.expectations {
+	for _, e := range mmAuthenticatePersonalAccessToken.AuthenticatePersonalAccessTokenMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.err
+			return e.results.cp1, e.results.err
 		}
 	}
 
-	if mmDeleteUser.DeleteUserMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmDeleteUser.DeleteUserMock.defaultExpectation.Counter, 1)
-		mm_want := mmDeleteUser.DeleteUserMock.defaultExpectation.params
-		mm_want_ptrs := mmDeleteUser.DeleteUserMock.defaultExpectation.paramPtrs
+	if mmAuthenticatePersonalAccessToken.AuthenticatePersonalAccessTokenMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAuthenticatePersonalAccessToken.AuthenticatePersonalAccessTokenMock.defaultExpectation.Counter, 1)
+		mm_want := mmAuthenticatePersonalAccessToken.AuthenticatePersonalAccessTokenMock.defaultExpectation.params
+		mm_want_ptrs := mmAuthenticatePersonalAccessToken.AuthenticatePersonalAccessTokenMock.defaultExpectation.paramPtrs
 
-		mm_got := UserServiceMockDeleteUserParams{ctx, userID}
+		mm_got := UserServiceMockAuthenticatePersonalAccessTokenParams{ctx, token, ip}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmDeleteUser.t.Errorf("UserServiceMock.DeleteUser got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmDeleteUser.DeleteUserMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmAuthenticatePersonalAccessToken.t.Errorf("UserServiceMock.AuthenticatePersonalAccessToken got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAuthenticatePersonalAccessToken.AuthenticatePersonalAccessTokenMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
-				mmDeleteUser.t.Errorf("UserServiceMock.DeleteUser got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmDeleteUser.DeleteUserMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			if mm_want_ptrs.token != nil && !minimock.Equal(*mm_want_ptrs.token, mm_got.token) {
+				mmAuthenticatePersonalAccessToken.t.Errorf("UserServiceMock.AuthenticatePersonalAccessToken got unexpected parameter token, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAuthenticatePersonalAccessToken.AuthenticatePersonalAccessTokenMock.defaultExpectation.expectationOrigins.originToken, *mm_want_ptrs.token, mm_got.token, minimock.Diff(*mm_want_ptrs.token, mm_got.token))
+			}
+
+			if mm_want_ptrs.ip != nil && !minimock.Equal(*mm_want_ptrs.ip, mm_got.ip) {
+				mmAuthenticatePersonalAccessToken.t.Errorf("UserServiceMock.AuthenticatePersonalAccessToken got unexpected parameter ip, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAuthenticatePersonalAccessToken.AuthenticatePersonalAccessTokenMock.defaultExpectation.expectationOrigins.originIp, *mm_want_ptrs.ip, mm_got.ip, minimock.Diff(*mm_want_ptrs.ip, mm_got.ip))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmDeleteUser.t.Errorf("UserServiceMock.DeleteUser got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmDeleteUser.DeleteUserMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmAuthenticatePersonalAccessToken.t.Errorf("UserServiceMock.AuthenticatePersonalAccessToken got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmAuthenticatePersonalAccessToken.AuthenticatePersonalAccessTokenMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmDeleteUser.DeleteUserMock.defaultExpectation.results
+		mm_results := mmAuthenticatePersonalAccessToken.AuthenticatePersonalAccessTokenMock.defaultExpectation.results
 		if mm_results == nil {
-			mmDeleteUser.t.Fatal("No results are set for the UserServiceMock.DeleteUser")
+			mmAuthenticatePersonalAccessToken.t.Fatal("No results are set for the UserServiceMock.AuthenticatePersonalAccessToken")
 		}
-		return (*mm_results).err
+		return (*mm_results).cp1, (*mm_results).err
 	}
-	if mmDeleteUser.funcDeleteUser != nil {
-		return mmDeleteUser.funcDeleteUser(ctx, userID)
+	if mmAuthenticatePersonalAccessToken.funcAuthenticatePersonalAccessToken != nil {
+		return mmAuthenticatePersonalAccessToken.funcAuthenticatePersonalAccessToken(ctx, token, ip)
 	}
-	mmDeleteUser.t.Fatalf("Unexpected call to UserServiceMock.DeleteUser. %v %v", ctx, userID)
+	mmAuthenticatePersonalAccessToken.t.Fatalf("Unexpected call to UserServiceMock.AuthenticatePersonalAccessToken. %v %v %v", ctx, token, ip)
 	return
 }
 
-// DeleteUserAfterCounter returns a count of finished UserServiceMock.DeleteUser invocations
-func (mmDeleteUser *UserServiceMock) DeleteUserAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmDeleteUser.afterDeleteUserCounter)
+// AuthenticatePersonalAccessTokenAfterCounter returns a count of finished UserServiceMock.AuthenticatePersonalAccessToken invocations
+func (mmAuthenticatePersonalAccessToken *UserServiceMock) AuthenticatePersonalAccessTokenAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAuthenticatePersonalAccessToken.afterAuthenticatePersonalAccessTokenCounter)
 }
 
-// DeleteUserBeforeCounter returns a count of UserServiceMock.DeleteUser invocations
-func (mmDeleteUser *UserServiceMock) DeleteUserBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmDeleteUser.beforeDeleteUserCounter)
+// AuthenticatePersonalAccessTokenBeforeCounter returns a count of UserServiceMock.AuthenticatePersonalAccessToken invocations
+func (mmAuthenticatePersonalAccessToken *UserServiceMock) AuthenticatePersonalAccessTokenBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAuthenticatePersonalAccessToken.beforeAuthenticatePersonalAccessTokenCounter)
 }
 
-// Calls returns a list of arguments used in each call to UserServiceMock.DeleteUser.
+// Calls returns a list of arguments used in each call to UserServiceMock.AuthenticatePersonalAccessToken.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmDeleteUser *mUserServiceMockDeleteUser) Calls() []*UserServiceMockDeleteUserParams {
-	mmDeleteUser.mutex.RLock()
+func (mmAuthenticatePersonalAccessToken *mUserServiceMockAuthenticatePersonalAccessToken) Calls() []*UserServiceMockAuthenticatePersonalAccessTokenParams {
+	mmAuthenticatePersonalAccessToken.mutex.RLock()
 
-	argCopy := make([]*UserServiceMockDeleteUserParams, len(mmDeleteUser.callArgs))
-	copy(argCopy, mmDeleteUser.callArgs)
+	argCopy := make([]*UserServiceMockAuthenticatePersonalAccessTokenParams, len(mmAuthenticatePersonalAccessToken.callArgs))
+	copy(argCopy, mmAuthenticatePersonalAccessToken.callArgs)
 
-	mmDeleteUser.mutex.RUnlock()
+	mmAuthenticatePersonalAccessToken.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockDeleteUserDone returns true if the count of the DeleteUser invocations corresponds
+// MinimockAuthenticatePersonalAccessTokenDone returns true if the count of the AuthenticatePersonalAccessToken invocations corresponds
 // the number of defined expectations
-func (m *UserServiceMock) MinimockDeleteUserDone() bool {
-	if m.DeleteUserMock.optional {
+func (m *UserServiceMock) MinimockAuthenticatePersonalAccessTokenDone() bool {
+	if m.AuthenticatePersonalAccessTokenMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.DeleteUserMock.expectations {
+	for _, e := range m.AuthenticatePersonalAccessTokenMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.DeleteUserMock.invocationsDone()
+	return m.AuthenticatePersonalAccessTokenMock.invocationsDone()
 }
 
-// MinimockDeleteUserInspect logs each unmet expectation
-func (m *UserServiceMock) MinimockDeleteUserInspect() {
-	for _, e := range m.DeleteUserMock.expectations {
+// MinimockAuthenticatePersonalAccessTokenInspect logs each unmet expectation
+func (m *UserServiceMock) MinimockAuthenticatePersonalAccessTokenInspect() {
+	for _, e := range m.AuthenticatePersonalAccessTokenMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to UserServiceMock.DeleteUser at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to UserServiceMock.AuthenticatePersonalAccessToken at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterDeleteUserCounter := mm_atomic.LoadUint64(&m.afterDeleteUserCounter)
+	afterAuthenticatePersonalAccessTokenCounter := mm_atomic.LoadUint64(&m.afterAuthenticatePersonalAccessTokenCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.DeleteUserMock.defaultExpectation != nil && afterDeleteUserCounter < 1 {
-		if m.DeleteUserMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to UserServiceMock.DeleteUser at\n%s", m.DeleteUserMock.defaultExpectation.returnOrigin)
+	if m.AuthenticatePersonalAccessTokenMock.defaultExpectation != nil && afterAuthenticatePersonalAccessTokenCounter < 1 {
+		if m.AuthenticatePersonalAccessTokenMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UserServiceMock.AuthenticatePersonalAccessToken at\n%s", m.AuthenticatePersonalAccessTokenMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to UserServiceMock.DeleteUser at\n%s with params: %#v", m.DeleteUserMock.defaultExpectation.expectationOrigins.origin, *m.DeleteUserMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to UserServiceMock.AuthenticatePersonalAccessToken at\n%s with params: %#v", m.AuthenticatePersonalAccessTokenMock.defaultExpectation.expectationOrigins.origin, *m.AuthenticatePersonalAccessTokenMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcDeleteUser != nil && afterDeleteUserCounter < 1 {
-		m.t.Errorf("Expected call to UserServiceMock.DeleteUser at\n%s", m.funcDeleteUserOrigin)
+	if m.funcAuthenticatePersonalAccessToken != nil && afterAuthenticatePersonalAccessTokenCounter < 1 {
+		m.t.Errorf("Expected call to UserServiceMock.AuthenticatePersonalAccessToken at\n%s", m.funcAuthenticatePersonalAccessTokenOrigin)
 	}
 
-	if !m.DeleteUserMock.invocationsDone() && afterDeleteUserCounter > 0 {
-		m.t.Errorf("Expected %d calls to UserServiceMock.DeleteUser at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.DeleteUserMock.expectedInvocations), m.DeleteUserMock.expectedInvocationsOrigin, afterDeleteUserCounter)
+	if !m.AuthenticatePersonalAccessTokenMock.invocationsDone() && afterAuthenticatePersonalAccessTokenCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserServiceMock.AuthenticatePersonalAccessToken at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.AuthenticatePersonalAccessTokenMock.expectedInvocations), m.AuthenticatePersonalAccessTokenMock.expectedInvocationsOrigin, afterAuthenticatePersonalAccessTokenCounter)
 	}
 }
 
-type mUserServiceMockGetUser struct {
+type mUserServiceMockCreatePersonalAccessToken struct {
 	optional           bool
 	mock               *UserServiceMock
-	defaultExpectation *UserServiceMockGetUserExpectation
-	expectations       []*UserServiceMockGetUserExpectation
+	defaultExpectation *UserServiceMockCreatePersonalAccessTokenExpectation
+	expectations       []*UserServiceMockCreatePersonalAccessTokenExpectation
 
-	callArgs []*UserServiceMockGetUserParams
+	callArgs []*UserServiceMockCreatePersonalAccessTokenParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// UserServiceMockGetUserExpectation specifies expectation struct of the UserService.GetUser
-type UserServiceMockGetUserExpectation struct {
+// UserServiceMockCreatePersonalAccessTokenExpectation specifies expectation struct of the UserService.CreatePersonalAccessToken
+type UserServiceMockCreatePersonalAccessTokenExpectation struct {
 	mock               *UserServiceMock
-	params             *UserServiceMockGetUserParams
-	paramPtrs          *UserServiceMockGetUserParamPtrs
-	expectationOrigins UserServiceMockGetUserExpectationOrigins
-	results            *UserServiceMockGetUserResults
+	params             *UserServiceMockCreatePersonalAccessTokenParams
+	paramPtrs          *UserServiceMockCreatePersonalAccessTokenParamPtrs
+	expectationOrigins UserServiceMockCreatePersonalAccessTokenExpectationOrigins
+	results            *UserServiceMockCreatePersonalAccessTokenResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// UserServiceMockGetUserParams contains parameters of the UserService.GetUser
-type UserServiceMockGetUserParams struct {
-	ctx    context.Context
-	userID string
+// UserServiceMockCreatePersonalAccessTokenParams contains parameters of the UserService.CreatePersonalAccessToken
+type UserServiceMockCreatePersonalAccessTokenParams struct {
+	ctx       context.Context
+	userID    string
+	name      string
+	scopes    []string
+	expiresAt *time.Time
 }
 
-// UserServiceMockGetUserParamPtrs contains pointers to parameters of the UserService.GetUser
-type UserServiceMockGetUserParamPtrs struct {
-	ctx    *context.Context
-	userID *string
+// UserServiceMockCreatePersonalAccessTokenParamPtrs contains pointers to parameters of the UserService.CreatePersonalAccessToken
+type UserServiceMockCreatePersonalAccessTokenParamPtrs struct {
+	ctx       *context.Context
+	userID    *string
+	name      *string
+	scopes    *[]string
+	expiresAt **time.Time
 }
 
-// UserServiceMockGetUserResults contains results of the UserService.GetUser
-type UserServiceMockGetUserResults struct {
-	up1 *models.User
+// UserServiceMockCreatePersonalAccessTokenResults contains results of the UserService.CreatePersonalAccessToken
+type UserServiceMockCreatePersonalAccessTokenResults struct {
+	pp1 *models.PersonalAccessToken
+	s1  string
 	err error
 }
 
-// UserServiceMockGetUserOrigins contains origins of expectations of the UserService.GetUser
-type UserServiceMockGetUserExpectationOrigins struct {
-	origin       string
-	originCtx    string
-	originUserID string
+// UserServiceMockCreatePersonalAccessTokenOrigins contains origins of expectations of the UserService.CreatePersonalAccessToken
+type UserServiceMockCreatePersonalAccessTokenExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originUserID    string
+	originName      string
+	originScopes    string
+	originExpiresAt string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -449,292 +562,2898 @@ type UserServiceMockGetUserExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmGetUser *mUserServiceMockGetUser) Optional() *mUserServiceMockGetUser {
-	mmGetUser.optional = true
-	return mmGetUser
+func (mmCreatePersonalAccessToken *mUserServiceMockCreatePersonalAccessToken) Optional() *mUserServiceMockCreatePersonalAccessToken {
+	mmCreatePersonalAccessToken.optional = true
+	return mmCreatePersonalAccessToken
 }
 
-// Expect sets up expected params for UserService.GetUser
-func (mmGetUser *mUserServiceMockGetUser) Expect(ctx context.Context, userID string) *mUserServiceMockGetUser {
-	if mmGetUser.mock.funcGetUser != nil {
-		mmGetUser.mock.t.Fatalf("UserServiceMock.GetUser mock is already set by Set")
+// Expect sets up expected params for UserService.CreatePersonalAccessToken
+func (mmCreatePersonalAccessToken *mUserServiceMockCreatePersonalAccessToken) Expect(ctx context.Context, userID string, name string, scopes []string, expiresAt *time.Time) *mUserServiceMockCreatePersonalAccessToken {
+	if mmCreatePersonalAccessToken.mock.funcCreatePersonalAccessToken != nil {
+		mmCreatePersonalAccessToken.mock.t.Fatalf("UserServiceMock.CreatePersonalAccessToken mock is already set by Set")
 	}
 
-	if mmGetUser.defaultExpectation == nil {
-		mmGetUser.defaultExpectation = &UserServiceMockGetUserExpectation{}
+	if mmCreatePersonalAccessToken.defaultExpectation == nil {
+		mmCreatePersonalAccessToken.defaultExpectation = &UserServiceMockCreatePersonalAccessTokenExpectation{}
 	}
 
-	if mmGetUser.defaultExpectation.paramPtrs != nil {
-		mmGetUser.mock.t.Fatalf("UserServiceMock.GetUser mock is already set by ExpectParams functions")
+	if mmCreatePersonalAccessToken.defaultExpectation.paramPtrs != nil {
+		mmCreatePersonalAccessToken.mock.t.Fatalf("UserServiceMock.CreatePersonalAccessToken mock is already set by ExpectParams functions")
 	}
 
-	mmGetUser.defaultExpectation.params = &UserServiceMockGetUserParams{ctx, userID}
-	mmGetUser.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmGetUser.expectations {
-		if minimock.Equal(e.params, mmGetUser.defaultExpectation.params) {
-			mmGetUser.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetUser.defaultExpectation.params)
+	mmCreatePersonalAccessToken.defaultExpectation.params = &UserServiceMockCreatePersonalAccessTokenParams{ctx, userID, name, scopes, expiresAt}
+	mmCreatePersonalAccessToken.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreatePersonalAccessToken.expectations {
+		if minimock.Equal(e.params, mmCreatePersonalAccessToken.defaultExpectation.params) {
+			mmCreatePersonalAccessToken.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreatePersonalAccessToken.defaultExpectation.params)
 		}
 	}
 
-	return mmGetUser
+	return mmCreatePersonalAccessToken
 }
 
-// ExpectCtxParam1 sets up expected param ctx for UserService.GetUser
-func (mmGetUser *mUserServiceMockGetUser) ExpectCtxParam1(ctx context.Context) *mUserServiceMockGetUser {
-	if mmGetUser.mock.funcGetUser != nil {
-		mmGetUser.mock.t.Fatalf("UserServiceMock.GetUser mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for UserService.CreatePersonalAccessToken
+func (mmCreatePersonalAccessToken *mUserServiceMockCreatePersonalAccessToken) ExpectCtxParam1(ctx context.Context) *mUserServiceMockCreatePersonalAccessToken {
+	if mmCreatePersonalAccessToken.mock.funcCreatePersonalAccessToken != nil {
+		mmCreatePersonalAccessToken.mock.t.Fatalf("UserServiceMock.CreatePersonalAccessToken mock is already set by Set")
 	}
 
-	if mmGetUser.defaultExpectation == nil {
-		mmGetUser.defaultExpectation = &UserServiceMockGetUserExpectation{}
+	if mmCreatePersonalAccessToken.defaultExpectation == nil {
+		mmCreatePersonalAccessToken.defaultExpectation = &UserServiceMockCreatePersonalAccessTokenExpectation{}
 	}
 
-	if mmGetUser.defaultExpectation.params != nil {
-		mmGetUser.mock.t.Fatalf("UserServiceMock.GetUser mock is already set by Expect")
+	if mmCreatePersonalAccessToken.defaultExpectation.params != nil {
+		mmCreatePersonalAccessToken.mock.t.Fatalf("UserServiceMock.CreatePersonalAccessToken mock is already set by Expect")
 	}
 
-	if mmGetUser.defaultExpectation.paramPtrs == nil {
-		mmGetUser.defaultExpectation.paramPtrs = &UserServiceMockGetUserParamPtrs{}
+	if mmCreatePersonalAccessToken.defaultExpectation.paramPtrs == nil {
+		mmCreatePersonalAccessToken.defaultExpectation.paramPtrs = &UserServiceMockCreatePersonalAccessTokenParamPtrs{}
 	}
-	mmGetUser.defaultExpectation.paramPtrs.ctx = &ctx
-	mmGetUser.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmCreatePersonalAccessToken.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreatePersonalAccessToken.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmGetUser
+	return mmCreatePersonalAccessToken
 }
 
-// ExpectUserIDParam2 sets up expected param userID for UserService.GetUser
-func (mmGetUser *mUserServiceMockGetUser) ExpectUserIDParam2(userID string) *mUserServiceMockGetUser {
-	if mmGetUser.mock.funcGetUser != nil {
+// ExpectUserIDParam2 sets up expected param userID for UserService.CreatePersonalAccessToken
+func (mmCreatePersonalAccessToken *mUserServiceMockCreatePersonalAccessToken) ExpectUserIDParam2(userID string) *mUserServiceMockCreatePersonalAccessToken {
+	if mmCreatePersonalAccessToken.mock.funcCreatePersonalAccessToken != nil {
+		mmCreatePersonalAccessToken.mock.t.Fatalf("UserServiceMock.CreatePersonalAccessToken mock is already set by Set")
+	}
+
+	if mmCreatePersonalAccessToken.defaultExpectation == nil {
+		mmCreatePersonalAccessToken.defaultExpectation = &UserServiceMockCreatePersonalAccessTokenExpectation{}
+	}
+
+	if mmCreatePersonalAccessToken.defaultExpectation.params != nil {
+		mmCreatePersonalAccessToken.mock.t.Fatalf("UserServiceMock.CreatePersonalAccessToken mock is already set by Expect")
+	}
+
+	if mmCreatePersonalAccessToken.defaultExpectation.paramPtrs == nil {
+		mmCreatePersonalAccessToken.defaultExpectation.paramPtrs = &UserServiceMockCreatePersonalAccessTokenParamPtrs{}
+	}
+	mmCreatePersonalAccessToken.defaultExpectation.paramPtrs.userID = &userID
+	mmCreatePersonalAccessToken.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmCreatePersonalAccessToken
+}
+
+// ExpectNameParam3 sets up expected param name for UserService.CreatePersonalAccessToken
+func (mmCreatePersonalAccessToken *mUserServiceMockCreatePersonalAccessToken) ExpectNameParam3(name string) *mUserServiceMockCreatePersonalAccessToken {
+	if mmCreatePersonalAccessToken.mock.funcCreatePersonalAccessToken != nil {
+		mmCreatePersonalAccessToken.mock.t.Fatalf("UserServiceMock.CreatePersonalAccessToken mock is already set by Set")
+	}
+
+	if mmCreatePersonalAccessToken.defaultExpectation == nil {
+		mmCreatePersonalAccessToken.defaultExpectation = &UserServiceMockCreatePersonalAccessTokenExpectation{}
+	}
+
+	if mmCreatePersonalAccessToken.defaultExpectation.params != nil {
+		mmCreatePersonalAccessToken.mock.t.Fatalf("UserServiceMock.CreatePersonalAccessToken mock is already set by Expect")
+	}
+
+	if mmCreatePersonalAccessToken.defaultExpectation.paramPtrs == nil {
+		mmCreatePersonalAccessToken.defaultExpectation.paramPtrs = &UserServiceMockCreatePersonalAccessTokenParamPtrs{}
+	}
+	mmCreatePersonalAccessToken.defaultExpectation.paramPtrs.name = &name
+	mmCreatePersonalAccessToken.defaultExpectation.expectationOrigins.originName = minimock.CallerInfo(1)
+
+	return mmCreatePersonalAccessToken
+}
+
+// ExpectScopesParam4 sets up expected param scopes for UserService.CreatePersonalAccessToken
+func (mmCreatePersonalAccessToken *mUserServiceMockCreatePersonalAccessToken) ExpectScopesParam4(scopes []string) *mUserServiceMockCreatePersonalAccessToken {
+	if mmCreatePersonalAccessToken.mock.funcCreatePersonalAccessToken != nil {
+		mmCreatePersonalAccessToken.mock.t.Fatalf("UserServiceMock.CreatePersonalAccessToken mock is already set by Set")
+	}
+
+	if mmCreatePersonalAccessToken.defaultExpectation == nil {
+		mmCreatePersonalAccessToken.defaultExpectation = &UserServiceMockCreatePersonalAccessTokenExpectation{}
+	}
+
+	if mmCreatePersonalAccessToken.defaultExpectation.params != nil {
+		mmCreatePersonalAccessToken.mock.t.Fatalf("UserServiceMock.CreatePersonalAccessToken mock is already set by Expect")
+	}
+
+	if mmCreatePersonalAccessToken.defaultExpectation.paramPtrs == nil {
+		mmCreatePersonalAccessToken.defaultExpectation.paramPtrs = &UserServiceMockCreatePersonalAccessTokenParamPtrs{}
+	}
+	mmCreatePersonalAccessToken.defaultExpectation.paramPtrs.scopes = &scopes
+	mmCreatePersonalAccessToken.defaultExpectation.expectationOrigins.originScopes = minimock.CallerInfo(1)
+
+	return mmCreatePersonalAccessToken
+}
+
+// ExpectExpiresAtParam5 sets up expected param expiresAt for UserService.CreatePersonalAccessToken
+func (mmCreatePersonalAccessToken *mUserServiceMockCreatePersonalAccessToken) ExpectExpiresAtParam5(expiresAt *time.Time) *mUserServiceMockCreatePersonalAccessToken {
+	if mmCreatePersonalAccessToken.mock.funcCreatePersonalAccessToken != nil {
+		mmCreatePersonalAccessToken.mock.t.Fatalf("UserServiceMock.CreatePersonalAccessToken mock is already set by Set")
+	}
+
+	if mmCreatePersonalAccessToken.defaultExpectation == nil {
+		mmCreatePersonalAccessToken.defaultExpectation = &UserServiceMockCreatePersonalAccessTokenExpectation{}
+	}
+
+	if mmCreatePersonalAccessToken.defaultExpectation.params != nil {
+		mmCreatePersonalAccessToken.mock.t.Fatalf("UserServiceMock.CreatePersonalAccessToken mock is already set by Expect")
+	}
+
+	if mmCreatePersonalAccessToken.defaultExpectation.paramPtrs == nil {
+		mmCreatePersonalAccessToken.defaultExpectation.paramPtrs = &UserServiceMockCreatePersonalAccessTokenParamPtrs{}
+	}
+	mmCreatePersonalAccessToken.defaultExpectation.paramPtrs.expiresAt = &expiresAt
+	mmCreatePersonalAccessToken.defaultExpectation.expectationOrigins.originExpiresAt = minimock.CallerInfo(1)
+
+	return mmCreatePersonalAccessToken
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserService.CreatePersonalAccessToken
+func (mmCreatePersonalAccessToken *mUserServiceMockCreatePersonalAccessToken) Inspect(f func(ctx context.Context, userID string, name string, scopes []string, expiresAt *time.Time)) *mUserServiceMockCreatePersonalAccessToken {
+	if mmCreatePersonalAccessToken.mock.inspectFuncCreatePersonalAccessToken != nil {
+		mmCreatePersonalAccessToken.mock.t.Fatalf("Inspect function is already set for UserServiceMock.CreatePersonalAccessToken")
+	}
+
+	mmCreatePersonalAccessToken.mock.inspectFuncCreatePersonalAccessToken = f
+
+	return mmCreatePersonalAccessToken
+}
+
+// Return sets up results that will be returned by UserService.CreatePersonalAccessToken
+func (mmCreatePersonalAccessToken *mUserServiceMockCreatePersonalAccessToken) Return(pp1 *models.PersonalAccessToken, s1 string, err error) *UserServiceMock {
+	if mmCreatePersonalAccessToken.mock.funcCreatePersonalAccessToken != nil {
+		mmCreatePersonalAccessToken.mock.t.Fatalf("UserServiceMock.CreatePersonalAccessToken mock is already set by Set")
+	}
+
+	if mmCreatePersonalAccessToken.defaultExpectation == nil {
+		mmCreatePersonalAccessToken.defaultExpectation = &UserServiceMockCreatePersonalAccessTokenExpectation{mock: mmCreatePersonalAccessToken.mock}
+	}
+	mmCreatePersonalAccessToken.defaultExpectation.results = &UserServiceMockCreatePersonalAccessTokenResults{pp1, s1, err}
+	mmCreatePersonalAccessToken.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreatePersonalAccessToken.mock
+}
+
+// Set uses given function f to mock the UserService.CreatePersonalAccessToken method
+func (mmCreatePersonalAccessToken *mUserServiceMockCreatePersonalAccessToken) Set(f func(ctx context.Context, userID string, name string, scopes []string, expiresAt *time.Time) (pp1 *models.PersonalAccessToken, s1 string, err error)) *UserServiceMock {
+	if mmCreatePersonalAccessToken.defaultExpectation != nil {
+		mmCreatePersonalAccessToken.mock.t.Fatalf("Default expectation is already set for the UserService.CreatePersonalAccessToken method")
+	}
+
+	if len(mmCreatePersonalAccessToken.expectations) > 0 {
+		mmCreatePersonalAccessToken.mock.t.Fatalf("Some expectations are already set for the UserService.CreatePersonalAccessToken method")
+	}
+
+	mmCreatePersonalAccessToken.mock.funcCreatePersonalAccessToken = f
+	mmCreatePersonalAccessToken.mock.funcCreatePersonalAccessTokenOrigin = minimock.CallerInfo(1)
+	return mmCreatePersonalAccessToken.mock
+}
+
+// When sets expectation for the UserService.CreatePersonalAccessToken which will trigger the result defined by the following
+// Then helper
+func (mmCreatePersonalAccessToken *mUserServiceMockCreatePersonalAccessToken) When(ctx context.Context, userID string, name string, scopes []string, expiresAt *time.Time) *UserServiceMockCreatePersonalAccessTokenExpectation {
+	if mmCreatePersonalAccessToken.mock.funcCreatePersonalAccessToken != nil {
+		mmCreatePersonalAccessToken.mock.t.Fatalf("UserServiceMock.CreatePersonalAccessToken mock is already set by Set")
+	}
+
+	expectation := &UserServiceMockCreatePersonalAccessTokenExpectation{
+		mock:               mmCreatePersonalAccessToken.mock,
+		params:             &UserServiceMockCreatePersonalAccessTokenParams{ctx, userID, name, scopes, expiresAt},
+		expectationOrigins: UserServiceMockCreatePersonalAccessTokenExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreatePersonalAccessToken.expectations = append(mmCreatePersonalAccessToken.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserService.CreatePersonalAccessToken return parameters for the expectation previously defined by the When method
+func (e *UserServiceMockCreatePersonalAccessTokenExpectation) Then(pp1 *models.PersonalAccessToken, s1 string, err error) *UserServiceMock {
+	e.results = &UserServiceMockCreatePersonalAccessTokenResults{pp1, s1, err}
+	return e.mock
+}
+
+// Times sets number of times UserService.CreatePersonalAccessToken should be invoked
+func (mmCreatePersonalAccessToken *mUserServiceMockCreatePersonalAccessToken) Times(n uint64) *mUserServiceMockCreatePersonalAccessToken {
+	if n == 0 {
+		mmCreatePersonalAccessToken.mock.t.Fatalf("Times of UserServiceMock.CreatePersonalAccessToken mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreatePersonalAccessToken.expectedInvocations, n)
+	mmCreatePersonalAccessToken.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreatePersonalAccessToken
+}
+
+func (mmCreatePersonalAccessToken *mUserServiceMockCreatePersonalAccessToken) invocationsDone() bool {
+	if len(mmCreatePersonalAccessToken.expectations) == 0 && mmCreatePersonalAccessToken.defaultExpectation == nil && mmCreatePersonalAccessToken.mock.funcCreatePersonalAccessToken == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreatePersonalAccessToken.mock.afterCreatePersonalAccessTokenCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreatePersonalAccessToken.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreatePersonalAccessToken implements UserService
+func (mmCreatePersonalAccessToken *UserServiceMock) CreatePersonalAccessToken(ctx context.Context, userID string, name string, scopes []string, expiresAt *time.Time) (pp1 *models.PersonalAccessToken, s1 string, err error) {
+	mm_atomic.AddUint64(&mmCreatePersonalAccessToken.beforeCreatePersonalAccessTokenCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreatePersonalAccessToken.afterCreatePersonalAccessTokenCounter, 1)
+
+	mmCreatePersonalAccessToken.t.Helper()
+
+	if mmCreatePersonalAccessToken.inspectFuncCreatePersonalAccessToken != nil {
+		mmCreatePersonalAccessToken.inspectFuncCreatePersonalAccessToken(ctx, userID, name, scopes, expiresAt)
+	}
+
+	mm_params := UserServiceMockCreatePersonalAccessTokenParams{ctx, userID, name, scopes, expiresAt}
+
+	// Record call args
+	mmCreatePersonalAccessToken.CreatePersonalAccessTokenMock.mutex.Lock()
+	mmCreatePersonalAccessToken.CreatePersonalAccessTokenMock.callArgs = append(mmCreatePersonalAccessToken.CreatePersonalAccessTokenMock.callArgs, &mm_params)
+	mmCreatePersonalAccessToken.CreatePersonalAccessTokenMock.mutex.Unlock()
+
+	for _, e := range mmCreatePersonalAccessToken.CreatePersonalAccessTokenMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.pp1, e.results.s1, e.results.err
+		}
+	}
+
+	if mmCreatePersonalAccessToken.CreatePersonalAccessTokenMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreatePersonalAccessToken.CreatePersonalAccessTokenMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreatePersonalAccessToken.CreatePersonalAccessTokenMock.defaultExpectation.params
+		mm_want_ptrs := mmCreatePersonalAccessToken.CreatePersonalAccessTokenMock.defaultExpectation.paramPtrs
+
+		mm_got := UserServiceMockCreatePersonalAccessTokenParams{ctx, userID, name, scopes, expiresAt}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreatePersonalAccessToken.t.Errorf("UserServiceMock.CreatePersonalAccessToken got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreatePersonalAccessToken.CreatePersonalAccessTokenMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmCreatePersonalAccessToken.t.Errorf("UserServiceMock.CreatePersonalAccessToken got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreatePersonalAccessToken.CreatePersonalAccessTokenMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.name != nil && !minimock.Equal(*mm_want_ptrs.name, mm_got.name) {
+				mmCreatePersonalAccessToken.t.Errorf("UserServiceMock.CreatePersonalAccessToken got unexpected parameter name, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreatePersonalAccessToken.CreatePersonalAccessTokenMock.defaultExpectation.expectationOrigins.originName, *mm_want_ptrs.name, mm_got.name, minimock.Diff(*mm_want_ptrs.name, mm_got.name))
+			}
+
+			if mm_want_ptrs.scopes != nil && !minimock.Equal(*mm_want_ptrs.scopes, mm_got.scopes) {
+				mmCreatePersonalAccessToken.t.Errorf("UserServiceMock.CreatePersonalAccessToken got unexpected parameter scopes, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreatePersonalAccessToken.CreatePersonalAccessTokenMock.defaultExpectation.expectationOrigins.originScopes, *mm_want_ptrs.scopes, mm_got.scopes, minimock.Diff(*mm_want_ptrs.scopes, mm_got.scopes))
+			}
+
+			if mm_want_ptrs.expiresAt != nil && !minimock.Equal(*mm_want_ptrs.expiresAt, mm_got.expiresAt) {
+				mmCreatePersonalAccessToken.t.Errorf("UserServiceMock.CreatePersonalAccessToken got unexpected parameter expiresAt, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreatePersonalAccessToken.CreatePersonalAccessTokenMock.defaultExpectation.expectationOrigins.originExpiresAt, *mm_want_ptrs.expiresAt, mm_got.expiresAt, minimock.Diff(*mm_want_ptrs.expiresAt, mm_got.expiresAt))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreatePersonalAccessToken.t.Errorf("UserServiceMock.CreatePersonalAccessToken got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreatePersonalAccessToken.CreatePersonalAccessTokenMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreatePersonalAccessToken.CreatePersonalAccessTokenMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreatePersonalAccessToken.t.Fatal("No results are set for the UserServiceMock.CreatePersonalAccessToken")
+		}
+		return (*mm_results).pp1, (*mm_results).s1, (*mm_results).err
+	}
+	if mmCreatePersonalAccessToken.funcCreatePersonalAccessToken != nil {
+		return mmCreatePersonalAccessToken.funcCreatePersonalAccessToken(ctx, userID, name, scopes, expiresAt)
+	}
+	mmCreatePersonalAccessToken.t.Fatalf("Unexpected call to UserServiceMock.CreatePersonalAccessToken. %v %v %v %v %v", ctx, userID, name, scopes, expiresAt)
+	return
+}
+
+// CreatePersonalAccessTokenAfterCounter returns a count of finished UserServiceMock.CreatePersonalAccessToken invocations
+func (mmCreatePersonalAccessToken *UserServiceMock) CreatePersonalAccessTokenAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreatePersonalAccessToken.afterCreatePersonalAccessTokenCounter)
+}
+
+// CreatePersonalAccessTokenBeforeCounter returns a count of UserServiceMock.CreatePersonalAccessToken invocations
+func (mmCreatePersonalAccessToken *UserServiceMock) CreatePersonalAccessTokenBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreatePersonalAccessToken.beforeCreatePersonalAccessTokenCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserServiceMock.CreatePersonalAccessToken.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreatePersonalAccessToken *mUserServiceMockCreatePersonalAccessToken) Calls() []*UserServiceMockCreatePersonalAccessTokenParams {
+	mmCreatePersonalAccessToken.mutex.RLock()
+
+	argCopy := make([]*UserServiceMockCreatePersonalAccessTokenParams, len(mmCreatePersonalAccessToken.callArgs))
+	copy(argCopy, mmCreatePersonalAccessToken.callArgs)
+
+	mmCreatePersonalAccessToken.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreatePersonalAccessTokenDone returns true if the count of the CreatePersonalAccessToken invocations corresponds
+// the number of defined expectations
+func (m *UserServiceMock) MinimockCreatePersonalAccessTokenDone() bool {
+	if m.CreatePersonalAccessTokenMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreatePersonalAccessTokenMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreatePersonalAccessTokenMock.invocationsDone()
+}
+
+// MinimockCreatePersonalAccessTokenInspect logs each unmet expectation
+func (m *UserServiceMock) MinimockCreatePersonalAccessTokenInspect() {
+	for _, e := range m.CreatePersonalAccessTokenMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserServiceMock.CreatePersonalAccessToken at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreatePersonalAccessTokenCounter := mm_atomic.LoadUint64(&m.afterCreatePersonalAccessTokenCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreatePersonalAccessTokenMock.defaultExpectation != nil && afterCreatePersonalAccessTokenCounter < 1 {
+		if m.CreatePersonalAccessTokenMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UserServiceMock.CreatePersonalAccessToken at\n%s", m.CreatePersonalAccessTokenMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UserServiceMock.CreatePersonalAccessToken at\n%s with params: %#v", m.CreatePersonalAccessTokenMock.defaultExpectation.expectationOrigins.origin, *m.CreatePersonalAccessTokenMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreatePersonalAccessToken != nil && afterCreatePersonalAccessTokenCounter < 1 {
+		m.t.Errorf("Expected call to UserServiceMock.CreatePersonalAccessToken at\n%s", m.funcCreatePersonalAccessTokenOrigin)
+	}
+
+	if !m.CreatePersonalAccessTokenMock.invocationsDone() && afterCreatePersonalAccessTokenCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserServiceMock.CreatePersonalAccessToken at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreatePersonalAccessTokenMock.expectedInvocations), m.CreatePersonalAccessTokenMock.expectedInvocationsOrigin, afterCreatePersonalAccessTokenCounter)
+	}
+}
+
+type mUserServiceMockDeletePersonalAccessToken struct {
+	optional           bool
+	mock               *UserServiceMock
+	defaultExpectation *UserServiceMockDeletePersonalAccessTokenExpectation
+	expectations       []*UserServiceMockDeletePersonalAccessTokenExpectation
+
+	callArgs []*UserServiceMockDeletePersonalAccessTokenParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UserServiceMockDeletePersonalAccessTokenExpectation specifies expectation struct of the UserService.DeletePersonalAccessToken
+type UserServiceMockDeletePersonalAccessTokenExpectation struct {
+	mock               *UserServiceMock
+	params             *UserServiceMockDeletePersonalAccessTokenParams
+	paramPtrs          *UserServiceMockDeletePersonalAccessTokenParamPtrs
+	expectationOrigins UserServiceMockDeletePersonalAccessTokenExpectationOrigins
+	results            *UserServiceMockDeletePersonalAccessTokenResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UserServiceMockDeletePersonalAccessTokenParams contains parameters of the UserService.DeletePersonalAccessToken
+type UserServiceMockDeletePersonalAccessTokenParams struct {
+	ctx     context.Context
+	userID  string
+	tokenID string
+}
+
+// UserServiceMockDeletePersonalAccessTokenParamPtrs contains pointers to parameters of the UserService.DeletePersonalAccessToken
+type UserServiceMockDeletePersonalAccessTokenParamPtrs struct {
+	ctx     *context.Context
+	userID  *string
+	tokenID *string
+}
+
+// UserServiceMockDeletePersonalAccessTokenResults contains results of the UserService.DeletePersonalAccessToken
+type UserServiceMockDeletePersonalAccessTokenResults struct {
+	err error
+}
+
+// UserServiceMockDeletePersonalAccessTokenOrigins contains origins of expectations of the UserService.DeletePersonalAccessToken
+type UserServiceMockDeletePersonalAccessTokenExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originUserID  string
+	originTokenID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeletePersonalAccessToken *mUserServiceMockDeletePersonalAccessToken) Optional() *mUserServiceMockDeletePersonalAccessToken {
+	mmDeletePersonalAccessToken.optional = true
+	return mmDeletePersonalAccessToken
+}
+
+// Expect sets up expected params for UserService.DeletePersonalAccessToken
+func (mmDeletePersonalAccessToken *mUserServiceMockDeletePersonalAccessToken) Expect(ctx context.Context, userID string, tokenID string) *mUserServiceMockDeletePersonalAccessToken {
+	if mmDeletePersonalAccessToken.mock.funcDeletePersonalAccessToken != nil {
+		mmDeletePersonalAccessToken.mock.t.Fatalf("UserServiceMock.DeletePersonalAccessToken mock is already set by Set")
+	}
+
+	if mmDeletePersonalAccessToken.defaultExpectation == nil {
+		mmDeletePersonalAccessToken.defaultExpectation = &UserServiceMockDeletePersonalAccessTokenExpectation{}
+	}
+
+	if mmDeletePersonalAccessToken.defaultExpectation.paramPtrs != nil {
+		mmDeletePersonalAccessToken.mock.t.Fatalf("UserServiceMock.DeletePersonalAccessToken mock is already set by ExpectParams functions")
+	}
+
+	mmDeletePersonalAccessToken.defaultExpectation.params = &UserServiceMockDeletePersonalAccessTokenParams{ctx, userID, tokenID}
+	mmDeletePersonalAccessToken.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeletePersonalAccessToken.expectations {
+		if minimock.Equal(e.params, mmDeletePersonalAccessToken.defaultExpectation.params) {
+			mmDeletePersonalAccessToken.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeletePersonalAccessToken.defaultExpectation.params)
+		}
+	}
+
+	return mmDeletePersonalAccessToken
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UserService.DeletePersonalAccessToken
+func (mmDeletePersonalAccessToken *mUserServiceMockDeletePersonalAccessToken) ExpectCtxParam1(ctx context.Context) *mUserServiceMockDeletePersonalAccessToken {
+	if mmDeletePersonalAccessToken.mock.funcDeletePersonalAccessToken != nil {
+		mmDeletePersonalAccessToken.mock.t.Fatalf("UserServiceMock.DeletePersonalAccessToken mock is already set by Set")
+	}
+
+	if mmDeletePersonalAccessToken.defaultExpectation == nil {
+		mmDeletePersonalAccessToken.defaultExpectation = &UserServiceMockDeletePersonalAccessTokenExpectation{}
+	}
+
+	if mmDeletePersonalAccessToken.defaultExpectation.params != nil {
+		mmDeletePersonalAccessToken.mock.t.Fatalf("UserServiceMock.DeletePersonalAccessToken mock is already set by Expect")
+	}
+
+	if mmDeletePersonalAccessToken.defaultExpectation.paramPtrs == nil {
+		mmDeletePersonalAccessToken.defaultExpectation.paramPtrs = &UserServiceMockDeletePersonalAccessTokenParamPtrs{}
+	}
+	mmDeletePersonalAccessToken.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeletePersonalAccessToken.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeletePersonalAccessToken
+}
+
+// ExpectUserIDParam2 sets up expected param userID for UserService.DeletePersonalAccessToken
+func (mmDeletePersonalAccessToken *mUserServiceMockDeletePersonalAccessToken) ExpectUserIDParam2(userID string) *mUserServiceMockDeletePersonalAccessToken {
+	if mmDeletePersonalAccessToken.mock.funcDeletePersonalAccessToken != nil {
+		mmDeletePersonalAccessToken.mock.t.Fatalf("UserServiceMock.DeletePersonalAccessToken mock is already set by Set")
+	}
+
+	if mmDeletePersonalAccessToken.defaultExpectation == nil {
+		mmDeletePersonalAccessToken.defaultExpectation = &UserServiceMockDeletePersonalAccessTokenExpectation{}
+	}
+
+	if mmDeletePersonalAccessToken.defaultExpectation.params != nil {
+		mmDeletePersonalAccessToken.mock.t.Fatalf("UserServiceMock.DeletePersonalAccessToken mock is already set by Expect")
+	}
+
+	if mmDeletePersonalAccessToken.defaultExpectation.paramPtrs == nil {
+		mmDeletePersonalAccessToken.defaultExpectation.paramPtrs = &UserServiceMockDeletePersonalAccessTokenParamPtrs{}
+	}
+	mmDeletePersonalAccessToken.defaultExpectation.paramPtrs.userID = &userID
+	mmDeletePersonalAccessToken.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmDeletePersonalAccessToken
+}
+
+// ExpectTokenIDParam3 sets up expected param tokenID for UserService.DeletePersonalAccessToken
+func (mmDeletePersonalAccessToken *mUserServiceMockDeletePersonalAccessToken) ExpectTokenIDParam3(tokenID string) *mUserServiceMockDeletePersonalAccessToken {
+	if mmDeletePersonalAccessToken.mock.funcDeletePersonalAccessToken != nil {
+		mmDeletePersonalAccessToken.mock.t.Fatalf("UserServiceMock.DeletePersonalAccessToken mock is already set by Set")
+	}
+
+	if mmDeletePersonalAccessToken.defaultExpectation == nil {
+		mmDeletePersonalAccessToken.defaultExpectation = &UserServiceMockDeletePersonalAccessTokenExpectation{}
+	}
+
+	if mmDeletePersonalAccessToken.defaultExpectation.params != nil {
+		mmDeletePersonalAccessToken.mock.t.Fatalf("UserServiceMock.DeletePersonalAccessToken mock is already set by Expect")
+	}
+
+	if mmDeletePersonalAccessToken.defaultExpectation.paramPtrs == nil {
+		mmDeletePersonalAccessToken.defaultExpectation.paramPtrs = &UserServiceMockDeletePersonalAccessTokenParamPtrs{}
+	}
+	mmDeletePersonalAccessToken.defaultExpectation.paramPtrs.tokenID = &tokenID
+	mmDeletePersonalAccessToken.defaultExpectation.expectationOrigins.originTokenID = minimock.CallerInfo(1)
+
+	return mmDeletePersonalAccessToken
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserService.DeletePersonalAccessToken
+func (mmDeletePersonalAccessToken *mUserServiceMockDeletePersonalAccessToken) Inspect(f func(ctx context.Context, userID string, tokenID string)) *mUserServiceMockDeletePersonalAccessToken {
+	if mmDeletePersonalAccessToken.mock.inspectFuncDeletePersonalAccessToken != nil {
+		mmDeletePersonalAccessToken.mock.t.Fatalf("Inspect function is already set for UserServiceMock.DeletePersonalAccessToken")
+	}
+
+	mmDeletePersonalAccessToken.mock.inspectFuncDeletePersonalAccessToken = f
+
+	return mmDeletePersonalAccessToken
+}
+
+// Return sets up results that will be returned by UserService.DeletePersonalAccessToken
+func (mmDeletePersonalAccessToken *mUserServiceMockDeletePersonalAccessToken) Return(err error) *UserServiceMock {
+	if mmDeletePersonalAccessToken.mock.funcDeletePersonalAccessToken != nil {
+		mmDeletePersonalAccessToken.mock.t.Fatalf("UserServiceMock.DeletePersonalAccessToken mock is already set by Set")
+	}
+
+	if mmDeletePersonalAccessToken.defaultExpectation == nil {
+		mmDeletePersonalAccessToken.defaultExpectation = &UserServiceMockDeletePersonalAccessTokenExpectation{mock: mmDeletePersonalAccessToken.mock}
+	}
+	mmDeletePersonalAccessToken.defaultExpectation.results = &UserServiceMockDeletePersonalAccessTokenResults{err}
+	mmDeletePersonalAccessToken.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeletePersonalAccessToken.mock
+}
+
+// Set uses given function f to mock the UserService.DeletePersonalAccessToken method
+func (mmDeletePersonalAccessToken *mUserServiceMockDeletePersonalAccessToken) Set(f func(ctx context.Context, userID string, tokenID string) (err error)) *UserServiceMock {
+	if mmDeletePersonalAccessToken.defaultExpectation != nil {
+		mmDeletePersonalAccessToken.mock.t.Fatalf("Default expectation is already set for the UserService.DeletePersonalAccessToken method")
+	}
+
+	if len(mmDeletePersonalAccessToken.expectations) > 0 {
+		mmDeletePersonalAccessToken.mock.t.Fatalf("Some expectations are already set for the UserService.DeletePersonalAccessToken method")
+	}
+
+	mmDeletePersonalAccessToken.mock.funcDeletePersonalAccessToken = f
+	mmDeletePersonalAccessToken.mock.funcDeletePersonalAccessTokenOrigin = minimock.CallerInfo(1)
+	return mmDeletePersonalAccessToken.mock
+}
+
+// When sets expectation for the UserService.DeletePersonalAccessToken which will trigger the result defined by the following
+// Then helper
+func (mmDeletePersonalAccessToken *mUserServiceMockDeletePersonalAccessToken) When(ctx context.Context, userID string, tokenID string) *UserServiceMockDeletePersonalAccessTokenExpectation {
+	if mmDeletePersonalAccessToken.mock.funcDeletePersonalAccessToken != nil {
+		mmDeletePersonalAccessToken.mock.t.Fatalf("UserServiceMock.DeletePersonalAccessToken mock is already set by Set")
+	}
+
+	expectation := &UserServiceMockDeletePersonalAccessTokenExpectation{
+		mock:               mmDeletePersonalAccessToken.mock,
+		params:             &UserServiceMockDeletePersonalAccessTokenParams{ctx, userID, tokenID},
+		expectationOrigins: UserServiceMockDeletePersonalAccessTokenExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeletePersonalAccessToken.expectations = append(mmDeletePersonalAccessToken.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserService.DeletePersonalAccessToken return parameters for the expectation previously defined by the When method
+func (e *UserServiceMockDeletePersonalAccessTokenExpectation) Then(err error) *UserServiceMock {
+	e.results = &UserServiceMockDeletePersonalAccessTokenResults{err}
+	return e.mock
+}
+
+// Times sets number of times UserService.DeletePersonalAccessToken should be invoked
+func (mmDeletePersonalAccessToken *mUserServiceMockDeletePersonalAccessToken) Times(n uint64) *mUserServiceMockDeletePersonalAccessToken {
+	if n == 0 {
+		mmDeletePersonalAccessToken.mock.t.Fatalf("Times of UserServiceMock.DeletePersonalAccessToken mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeletePersonalAccessToken.expectedInvocations, n)
+	mmDeletePersonalAccessToken.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeletePersonalAccessToken
+}
+
+func (mmDeletePersonalAccessToken *mUserServiceMockDeletePersonalAccessToken) invocationsDone() bool {
+	if len(mmDeletePersonalAccessToken.expectations) == 0 && mmDeletePersonalAccessToken.defaultExpectation == nil && mmDeletePersonalAccessToken.mock.funcDeletePersonalAccessToken == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeletePersonalAccessToken.mock.afterDeletePersonalAccessTokenCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeletePersonalAccessToken.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeletePersonalAccessToken implements UserService
+func (mmDeletePersonalAccessToken *UserServiceMock) DeletePersonalAccessToken(ctx context.Context, userID string, tokenID string) (err error) {
+	mm_atomic.AddUint64(&mmDeletePersonalAccessToken.beforeDeletePersonalAccessTokenCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeletePersonalAccessToken.afterDeletePersonalAccessTokenCounter, 1)
+
+	mmDeletePersonalAccessToken.t.Helper()
+
+	if mmDeletePersonalAccessToken.inspectFuncDeletePersonalAccessToken != nil {
+		mmDeletePersonalAccessToken.inspectFuncDeletePersonalAccessToken(ctx, userID, tokenID)
+	}
+
+	mm_params := UserServiceMockDeletePersonalAccessTokenParams{ctx, userID, tokenID}
+
+	// Record call args
+	mmDeletePersonalAccessToken.DeletePersonalAccessTokenMock.mutex.Lock()
+	mmDeletePersonalAccessToken.DeletePersonalAccessTokenMock.callArgs = append(mmDeletePersonalAccessToken.DeletePersonalAccessTokenMock.callArgs, &mm_params)
+	mmDeletePersonalAccessToken.DeletePersonalAccessTokenMock.mutex.Unlock()
+
+	for _, e := range mmDeletePersonalAccessToken.DeletePersonalAccessTokenMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeletePersonalAccessToken.DeletePersonalAccessTokenMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeletePersonalAccessToken.DeletePersonalAccessTokenMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeletePersonalAccessToken.DeletePersonalAccessTokenMock.defaultExpectation.params
+		mm_want_ptrs := mmDeletePersonalAccessToken.DeletePersonalAccessTokenMock.defaultExpectation.paramPtrs
+
+		mm_got := UserServiceMockDeletePersonalAccessTokenParams{ctx, userID, tokenID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeletePersonalAccessToken.t.Errorf("UserServiceMock.DeletePersonalAccessToken got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeletePersonalAccessToken.DeletePersonalAccessTokenMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmDeletePersonalAccessToken.t.Errorf("UserServiceMock.DeletePersonalAccessToken got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeletePersonalAccessToken.DeletePersonalAccessTokenMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.tokenID != nil && !minimock.Equal(*mm_want_ptrs.tokenID, mm_got.tokenID) {
+				mmDeletePersonalAccessToken.t.Errorf("UserServiceMock.DeletePersonalAccessToken got unexpected parameter tokenID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeletePersonalAccessToken.DeletePersonalAccessTokenMock.defaultExpectation.expectationOrigins.originTokenID, *mm_want_ptrs.tokenID, mm_got.tokenID, minimock.Diff(*mm_want_ptrs.tokenID, mm_got.tokenID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeletePersonalAccessToken.t.Errorf("UserServiceMock.DeletePersonalAccessToken got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeletePersonalAccessToken.DeletePersonalAccessTokenMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeletePersonalAccessToken.DeletePersonalAccessTokenMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeletePersonalAccessToken.t.Fatal("No results are set for the UserServiceMock.DeletePersonalAccessToken")
+		}
+		return (*mm_results).err
+	}
+	if mmDeletePersonalAccessToken.funcDeletePersonalAccessToken != nil {
+		return mmDeletePersonalAccessToken.funcDeletePersonalAccessToken(ctx, userID, tokenID)
+	}
+	mmDeletePersonalAccessToken.t.Fatalf("Unexpected call to UserServiceMock.DeletePersonalAccessToken. %v %v %v", ctx, userID, tokenID)
+	return
+}
+
+// DeletePersonalAccessTokenAfterCounter returns a count of finished UserServiceMock.DeletePersonalAccessToken invocations
+func (mmDeletePersonalAccessToken *UserServiceMock) DeletePersonalAccessTokenAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeletePersonalAccessToken.afterDeletePersonalAccessTokenCounter)
+}
+
+// DeletePersonalAccessTokenBeforeCounter returns a count of UserServiceMock.DeletePersonalAccessToken invocations
+func (mmDeletePersonalAccessToken *UserServiceMock) DeletePersonalAccessTokenBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeletePersonalAccessToken.beforeDeletePersonalAccessTokenCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserServiceMock.DeletePersonalAccessToken.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeletePersonalAccessToken *mUserServiceMockDeletePersonalAccessToken) Calls() []*UserServiceMockDeletePersonalAccessTokenParams {
+	mmDeletePersonalAccessToken.mutex.RLock()
+
+	argCopy := make([]*UserServiceMockDeletePersonalAccessTokenParams, len(mmDeletePersonalAccessToken.callArgs))
+	copy(argCopy, mmDeletePersonalAccessToken.callArgs)
+
+	mmDeletePersonalAccessToken.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeletePersonalAccessTokenDone returns true if the count of the DeletePersonalAccessToken invocations corresponds
+// the number of defined expectations
+func (m *UserServiceMock) MinimockDeletePersonalAccessTokenDone() bool {
+	if m.DeletePersonalAccessTokenMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeletePersonalAccessTokenMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeletePersonalAccessTokenMock.invocationsDone()
+}
+
+// MinimockDeletePersonalAccessTokenInspect logs each unmet expectation
+func (m *UserServiceMock) MinimockDeletePersonalAccessTokenInspect() {
+	for _, e := range m.DeletePersonalAccessTokenMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserServiceMock.DeletePersonalAccessToken at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeletePersonalAccessTokenCounter := mm_atomic.LoadUint64(&m.afterDeletePersonalAccessTokenCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeletePersonalAccessTokenMock.defaultExpectation != nil && afterDeletePersonalAccessTokenCounter < 1 {
+		if m.DeletePersonalAccessTokenMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UserServiceMock.DeletePersonalAccessToken at\n%s", m.DeletePersonalAccessTokenMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UserServiceMock.DeletePersonalAccessToken at\n%s with params: %#v", m.DeletePersonalAccessTokenMock.defaultExpectation.expectationOrigins.origin, *m.DeletePersonalAccessTokenMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeletePersonalAccessToken != nil && afterDeletePersonalAccessTokenCounter < 1 {
+		m.t.Errorf("Expected call to UserServiceMock.DeletePersonalAccessToken at\n%s", m.funcDeletePersonalAccessTokenOrigin)
+	}
+
+	if !m.DeletePersonalAccessTokenMock.invocationsDone() && afterDeletePersonalAccessTokenCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserServiceMock.DeletePersonalAccessToken at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeletePersonalAccessTokenMock.expectedInvocations), m.DeletePersonalAccessTokenMock.expectedInvocationsOrigin, afterDeletePersonalAccessTokenCounter)
+	}
+}
+
+type mUserServiceMockDeleteSession struct {
+	optional           bool
+	mock               *UserServiceMock
+	defaultExpectation *UserServiceMockDeleteSessionExpectation
+	expectations       []*UserServiceMockDeleteSessionExpectation
+
+	callArgs []*UserServiceMockDeleteSessionParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UserServiceMockDeleteSessionExpectation specifies expectation struct of the UserService.DeleteSession
+type UserServiceMockDeleteSessionExpectation struct {
+	mock               *UserServiceMock
+	params             *UserServiceMockDeleteSessionParams
+	paramPtrs          *UserServiceMockDeleteSessionParamPtrs
+	expectationOrigins UserServiceMockDeleteSessionExpectationOrigins
+	results            *UserServiceMockDeleteSessionResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UserServiceMockDeleteSessionParams contains parameters of the UserService.DeleteSession
+type UserServiceMockDeleteSessionParams struct {
+	ctx       context.Context
+	userID    string
+	sessionID string
+}
+
+// UserServiceMockDeleteSessionParamPtrs contains pointers to parameters of the UserService.DeleteSession
+type UserServiceMockDeleteSessionParamPtrs struct {
+	ctx       *context.Context
+	userID    *string
+	sessionID *string
+}
+
+// UserServiceMockDeleteSessionResults contains results of the UserService.DeleteSession
+type UserServiceMockDeleteSessionResults struct {
+	err error
+}
+
+// UserServiceMockDeleteSessionOrigins contains origins of expectations of the UserService.DeleteSession
+type UserServiceMockDeleteSessionExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originUserID    string
+	originSessionID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteSession *mUserServiceMockDeleteSession) Optional() *mUserServiceMockDeleteSession {
+	mmDeleteSession.optional = true
+	return mmDeleteSession
+}
+
+// Expect sets up expected params for UserService.DeleteSession
+func (mmDeleteSession *mUserServiceMockDeleteSession) Expect(ctx context.Context, userID string, sessionID string) *mUserServiceMockDeleteSession {
+	if mmDeleteSession.mock.funcDeleteSession != nil {
+		mmDeleteSession.mock.t.Fatalf("UserServiceMock.DeleteSession mock is already set by Set")
+	}
+
+	if mmDeleteSession.defaultExpectation == nil {
+		mmDeleteSession.defaultExpectation = &UserServiceMockDeleteSessionExpectation{}
+	}
+
+	if mmDeleteSession.defaultExpectation.paramPtrs != nil {
+		mmDeleteSession.mock.t.Fatalf("UserServiceMock.DeleteSession mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteSession.defaultExpectation.params = &UserServiceMockDeleteSessionParams{ctx, userID, sessionID}
+	mmDeleteSession.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteSession.expectations {
+		if minimock.Equal(e.params, mmDeleteSession.defaultExpectation.params) {
+			mmDeleteSession.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteSession.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteSession
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UserService.DeleteSession
+func (mmDeleteSession *mUserServiceMockDeleteSession) ExpectCtxParam1(ctx context.Context) *mUserServiceMockDeleteSession {
+	if mmDeleteSession.mock.funcDeleteSession != nil {
+		mmDeleteSession.mock.t.Fatalf("UserServiceMock.DeleteSession mock is already set by Set")
+	}
+
+	if mmDeleteSession.defaultExpectation == nil {
+		mmDeleteSession.defaultExpectation = &UserServiceMockDeleteSessionExpectation{}
+	}
+
+	if mmDeleteSession.defaultExpectation.params != nil {
+		mmDeleteSession.mock.t.Fatalf("UserServiceMock.DeleteSession mock is already set by Expect")
+	}
+
+	if mmDeleteSession.defaultExpectation.paramPtrs == nil {
+		mmDeleteSession.defaultExpectation.paramPtrs = &UserServiceMockDeleteSessionParamPtrs{}
+	}
+	mmDeleteSession.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteSession.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteSession
+}
+
+// ExpectUserIDParam2 sets up expected param userID for UserService.DeleteSession
+func (mmDeleteSession *mUserServiceMockDeleteSession) ExpectUserIDParam2(userID string) *mUserServiceMockDeleteSession {
+	if mmDeleteSession.mock.funcDeleteSession != nil {
+		mmDeleteSession.mock.t.Fatalf("UserServiceMock.DeleteSession mock is already set by Set")
+	}
+
+	if mmDeleteSession.defaultExpectation == nil {
+		mmDeleteSession.defaultExpectation = &UserServiceMockDeleteSessionExpectation{}
+	}
+
+	if mmDeleteSession.defaultExpectation.params != nil {
+		mmDeleteSession.mock.t.Fatalf("UserServiceMock.DeleteSession mock is already set by Expect")
+	}
+
+	if mmDeleteSession.defaultExpectation.paramPtrs == nil {
+		mmDeleteSession.defaultExpectation.paramPtrs = &UserServiceMockDeleteSessionParamPtrs{}
+	}
+	mmDeleteSession.defaultExpectation.paramPtrs.userID = &userID
+	mmDeleteSession.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmDeleteSession
+}
+
+// ExpectSessionIDParam3 sets up expected param sessionID for UserService.DeleteSession
+func (mmDeleteSession *mUserServiceMockDeleteSession) ExpectSessionIDParam3(sessionID string) *mUserServiceMockDeleteSession {
+	if mmDeleteSession.mock.funcDeleteSession != nil {
+		mmDeleteSession.mock.t.Fatalf("UserServiceMock.DeleteSession mock is already set by Set")
+	}
+
+	if mmDeleteSession.defaultExpectation == nil {
+		mmDeleteSession.defaultExpectation = &UserServiceMockDeleteSessionExpectation{}
+	}
+
+	if mmDeleteSession.defaultExpectation.params != nil {
+		mmDeleteSession.mock.t.Fatalf("UserServiceMock.DeleteSession mock is already set by Expect")
+	}
+
+	if mmDeleteSession.defaultExpectation.paramPtrs == nil {
+		mmDeleteSession.defaultExpectation.paramPtrs = &UserServiceMockDeleteSessionParamPtrs{}
+	}
+	mmDeleteSession.defaultExpectation.paramPtrs.sessionID = &sessionID
+	mmDeleteSession.defaultExpectation.expectationOrigins.originSessionID = minimock.CallerInfo(1)
+
+	return mmDeleteSession
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserService.DeleteSession
+func (mmDeleteSession *mUserServiceMockDeleteSession) Inspect(f func(ctx context.Context, userID string, sessionID string)) *mUserServiceMockDeleteSession {
+	if mmDeleteSession.mock.inspectFuncDeleteSession != nil {
+		mmDeleteSession.mock.t.Fatalf("Inspect function is already set for UserServiceMock.DeleteSession")
+	}
+
+	mmDeleteSession.mock.inspectFuncDeleteSession = f
+
+	return mmDeleteSession
+}
+
+// Return sets up results that will be returned by UserService.DeleteSession
+func (mmDeleteSession *mUserServiceMockDeleteSession) Return(err error) *UserServiceMock {
+	if mmDeleteSession.mock.funcDeleteSession != nil {
+		mmDeleteSession.mock.t.Fatalf("UserServiceMock.DeleteSession mock is already set by Set")
+	}
+
+	if mmDeleteSession.defaultExpectation == nil {
+		mmDeleteSession.defaultExpectation = &UserServiceMockDeleteSessionExpectation{mock: mmDeleteSession.mock}
+	}
+	mmDeleteSession.defaultExpectation.results = &UserServiceMockDeleteSessionResults{err}
+	mmDeleteSession.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteSession.mock
+}
+
+// Set uses given function f to mock the UserService.DeleteSession method
+func (mmDeleteSession *mUserServiceMockDeleteSession) Set(f func(ctx context.Context, userID string, sessionID string) (err error)) *UserServiceMock {
+	if mmDeleteSession.defaultExpectation != nil {
+		mmDeleteSession.mock.t.Fatalf("Default expectation is already set for the UserService.DeleteSession method")
+	}
+
+	if len(mmDeleteSession.expectations) > 0 {
+		mmDeleteSession.mock.t.Fatalf("Some expectations are already set for the UserService.DeleteSession method")
+	}
+
+	mmDeleteSession.mock.funcDeleteSession = f
+	mmDeleteSession.mock.funcDeleteSessionOrigin = minimock.CallerInfo(1)
+	return mmDeleteSession.mock
+}
+
+// When sets expectation for the UserService.DeleteSession which will trigger the result defined by the following
+// Then helper
+func (mmDeleteSession *mUserServiceMockDeleteSession) When(ctx context.Context, userID string, sessionID string) *UserServiceMockDeleteSessionExpectation {
+	if mmDeleteSession.mock.funcDeleteSession != nil {
+		mmDeleteSession.mock.t.Fatalf("UserServiceMock.DeleteSession mock is already set by Set")
+	}
+
+	expectation := &UserServiceMockDeleteSessionExpectation{
+		mock:               mmDeleteSession.mock,
+		params:             &UserServiceMockDeleteSessionParams{ctx, userID, sessionID},
+		expectationOrigins: UserServiceMockDeleteSessionExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteSession.expectations = append(mmDeleteSession.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserService.DeleteSession return parameters for the expectation previously defined by the When method
+func (e *UserServiceMockDeleteSessionExpectation) Then(err error) *UserServiceMock {
+	e.results = &UserServiceMockDeleteSessionResults{err}
+	return e.mock
+}
+
+// Times sets number of times UserService.DeleteSession should be invoked
+func (mmDeleteSession *mUserServiceMockDeleteSession) Times(n uint64) *mUserServiceMockDeleteSession {
+	if n == 0 {
+		mmDeleteSession.mock.t.Fatalf("Times of UserServiceMock.DeleteSession mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteSession.expectedInvocations, n)
+	mmDeleteSession.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteSession
+}
+
+func (mmDeleteSession *mUserServiceMockDeleteSession) invocationsDone() bool {
+	if len(mmDeleteSession.expectations) == 0 && mmDeleteSession.defaultExpectation == nil && mmDeleteSession.mock.funcDeleteSession == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteSession.mock.afterDeleteSessionCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteSession.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteSession implements UserService
+func (mmDeleteSession *UserServiceMock) DeleteSession(ctx context.Context, userID string, sessionID string) (err error) {
+	mm_atomic.AddUint64(&mmDeleteSession.beforeDeleteSessionCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteSession.afterDeleteSessionCounter, 1)
+
+	mmDeleteSession.t.Helper()
+
+	if mmDeleteSession.inspectFuncDeleteSession != nil {
+		mmDeleteSession.inspectFuncDeleteSession(ctx, userID, sessionID)
+	}
+
+	mm_params := UserServiceMockDeleteSessionParams{ctx, userID, sessionID}
+
+	// Record call args
+	mmDeleteSession.DeleteSessionMock.mutex.Lock()
+	mmDeleteSession.DeleteSessionMock.callArgs = append(mmDeleteSession.DeleteSessionMock.callArgs, &mm_params)
+	mmDeleteSession.DeleteSessionMock.mutex.Unlock()
+
+	for _, e := range mmDeleteSession.DeleteSessionMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteSession.DeleteSessionMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteSession.DeleteSessionMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteSession.DeleteSessionMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteSession.DeleteSessionMock.defaultExpectation.paramPtrs
+
+		mm_got := UserServiceMockDeleteSessionParams{ctx, userID, sessionID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteSession.t.Errorf("UserServiceMock.DeleteSession got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteSession.DeleteSessionMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmDeleteSession.t.Errorf("UserServiceMock.DeleteSession got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteSession.DeleteSessionMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.sessionID != nil && !minimock.Equal(*mm_want_ptrs.sessionID, mm_got.sessionID) {
+				mmDeleteSession.t.Errorf("UserServiceMock.DeleteSession got unexpected parameter sessionID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteSession.DeleteSessionMock.defaultExpectation.expectationOrigins.originSessionID, *mm_want_ptrs.sessionID, mm_got.sessionID, minimock.Diff(*mm_want_ptrs.sessionID, mm_got.sessionID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteSession.t.Errorf("UserServiceMock.DeleteSession got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteSession.DeleteSessionMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteSession.DeleteSessionMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteSession.t.Fatal("No results are set for the UserServiceMock.DeleteSession")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteSession.funcDeleteSession != nil {
+		return mmDeleteSession.funcDeleteSession(ctx, userID, sessionID)
+	}
+	mmDeleteSession.t.Fatalf("Unexpected call to UserServiceMock.DeleteSession. %v %v %v", ctx, userID, sessionID)
+	return
+}
+
+// DeleteSessionAfterCounter returns a count of finished UserServiceMock.DeleteSession invocations
+func (mmDeleteSession *UserServiceMock) DeleteSessionAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteSession.afterDeleteSessionCounter)
+}
+
+// DeleteSessionBeforeCounter returns a count of UserServiceMock.DeleteSession invocations
+func (mmDeleteSession *UserServiceMock) DeleteSessionBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteSession.beforeDeleteSessionCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserServiceMock.DeleteSession.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteSession *mUserServiceMockDeleteSession) Calls() []*UserServiceMockDeleteSessionParams {
+	mmDeleteSession.mutex.RLock()
+
+	argCopy := make([]*UserServiceMockDeleteSessionParams, len(mmDeleteSession.callArgs))
+	copy(argCopy, mmDeleteSession.callArgs)
+
+	mmDeleteSession.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteSessionDone returns true if the count of the DeleteSession invocations corresponds
+// the number of defined expectations
+func (m *UserServiceMock) MinimockDeleteSessionDone() bool {
+	if m.DeleteSessionMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteSessionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteSessionMock.invocationsDone()
+}
+
+// MinimockDeleteSessionInspect logs each unmet expectation
+func (m *UserServiceMock) MinimockDeleteSessionInspect() {
+	for _, e := range m.DeleteSessionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserServiceMock.DeleteSession at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteSessionCounter := mm_atomic.LoadUint64(&m.afterDeleteSessionCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteSessionMock.defaultExpectation != nil && afterDeleteSessionCounter < 1 {
+		if m.DeleteSessionMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UserServiceMock.DeleteSession at\n%s", m.DeleteSessionMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UserServiceMock.DeleteSession at\n%s with params: %#v", m.DeleteSessionMock.defaultExpectation.expectationOrigins.origin, *m.DeleteSessionMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteSession != nil && afterDeleteSessionCounter < 1 {
+		m.t.Errorf("Expected call to UserServiceMock.DeleteSession at\n%s", m.funcDeleteSessionOrigin)
+	}
+
+	if !m.DeleteSessionMock.invocationsDone() && afterDeleteSessionCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserServiceMock.DeleteSession at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteSessionMock.expectedInvocations), m.DeleteSessionMock.expectedInvocationsOrigin, afterDeleteSessionCounter)
+	}
+}
+
+type mUserServiceMockDeleteUser struct {
+	optional           bool
+	mock               *UserServiceMock
+	defaultExpectation *UserServiceMockDeleteUserExpectation
+	expectations       []*UserServiceMockDeleteUserExpectation
+
+	callArgs []*UserServiceMockDeleteUserParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UserServiceMockDeleteUserExpectation specifies expectation struct of the UserService.DeleteUser
+type UserServiceMockDeleteUserExpectation struct {
+	mock               *UserServiceMock
+	params             *UserServiceMockDeleteUserParams
+	paramPtrs          *UserServiceMockDeleteUserParamPtrs
+	expectationOrigins UserServiceMockDeleteUserExpectationOrigins
+	results            *UserServiceMockDeleteUserResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UserServiceMockDeleteUserParams contains parameters of the UserService.DeleteUser
+type UserServiceMockDeleteUserParams struct {
+	ctx    context.Context
+	userID string
+}
+
+// UserServiceMockDeleteUserParamPtrs contains pointers to parameters of the UserService.DeleteUser
+type UserServiceMockDeleteUserParamPtrs struct {
+	ctx    *context.Context
+	userID *string
+}
+
+// UserServiceMockDeleteUserResults contains results of the UserService.DeleteUser
+type UserServiceMockDeleteUserResults struct {
+	err error
+}
+
+// UserServiceMockDeleteUserOrigins contains origins of expectations of the UserService.DeleteUser
+type UserServiceMockDeleteUserExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteUser *mUserServiceMockDeleteUser) Optional() *mUserServiceMockDeleteUser {
+	mmDeleteUser.optional = true
+	return mmDeleteUser
+}
+
+// Expect sets up expected params for UserService.DeleteUser
+func (mmDeleteUser *mUserServiceMockDeleteUser) Expect(ctx context.Context, userID string) *mUserServiceMockDeleteUser {
+	if mmDeleteUser.mock.funcDeleteUser != nil {
+		mmDeleteUser.mock.t.Fatalf("UserServiceMock.DeleteUser mock is already set by Set")
+	}
+
+	if mmDeleteUser.defaultExpectation == nil {
+		mmDeleteUser.defaultExpectation = &UserServiceMockDeleteUserExpectation{}
+	}
+
+	if mmDeleteUser.defaultExpectation.paramPtrs != nil {
+		mmDeleteUser.mock.t.Fatalf("UserServiceMock.DeleteUser mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteUser.defaultExpectation.params = &UserServiceMockDeleteUserParams{ctx, userID}
+	mmDeleteUser.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteUser.expectations {
+		if minimock.Equal(e.params, mmDeleteUser.defaultExpectation.params) {
+			mmDeleteUser.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteUser.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteUser
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UserService.DeleteUser
+func (mmDeleteUser *mUserServiceMockDeleteUser) ExpectCtxParam1(ctx context.Context) *mUserServiceMockDeleteUser {
+	if mmDeleteUser.mock.funcDeleteUser != nil {
+		mmDeleteUser.mock.t.Fatalf("UserServiceMock.DeleteUser mock is already set by Set")
+	}
+
+	if mmDeleteUser.defaultExpectation == nil {
+		mmDeleteUser.defaultExpectation = &UserServiceMockDeleteUserExpectation{}
+	}
+
+	if mmDeleteUser.defaultExpectation.params != nil {
+		mmDeleteUser.mock.t.Fatalf("UserServiceMock.DeleteUser mock is already set by Expect")
+	}
+
+	if mmDeleteUser.defaultExpectation.paramPtrs == nil {
+		mmDeleteUser.defaultExpectation.paramPtrs = &UserServiceMockDeleteUserParamPtrs{}
+	}
+	mmDeleteUser.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteUser.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteUser
+}
+
+// ExpectUserIDParam2 sets up expected param userID for UserService.DeleteUser
+func (mmDeleteUser *mUserServiceMockDeleteUser) ExpectUserIDParam2(userID string) *mUserServiceMockDeleteUser {
+	if mmDeleteUser.mock.funcDeleteUser != nil {
+		mmDeleteUser.mock.t.Fatalf("UserServiceMock.DeleteUser mock is already set by Set")
+	}
+
+	if mmDeleteUser.defaultExpectation == nil {
+		mmDeleteUser.defaultExpectation = &UserServiceMockDeleteUserExpectation{}
+	}
+
+	if mmDeleteUser.defaultExpectation.params != nil {
+		mmDeleteUser.mock.t.Fatalf("UserServiceMock.DeleteUser mock is already set by Expect")
+	}
+
+	if mmDeleteUser.defaultExpectation.paramPtrs == nil {
+		mmDeleteUser.defaultExpectation.paramPtrs = &UserServiceMockDeleteUserParamPtrs{}
+	}
+	mmDeleteUser.defaultExpectation.paramPtrs.userID = &userID
+	mmDeleteUser.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmDeleteUser
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserService.DeleteUser
+func (mmDeleteUser *mUserServiceMockDeleteUser) Inspect(f func(ctx context.Context, userID string)) *mUserServiceMockDeleteUser {
+	if mmDeleteUser.mock.inspectFuncDeleteUser != nil {
+		mmDeleteUser.mock.t.Fatalf("Inspect function is already set for UserServiceMock.DeleteUser")
+	}
+
+	mmDeleteUser.mock.inspectFuncDeleteUser = f
+
+	return mmDeleteUser
+}
+
+// Return sets up results that will be returned by UserService.DeleteUser
+func (mmDeleteUser *mUserServiceMockDeleteUser) Return(err error) *UserServiceMock {
+	if mmDeleteUser.mock.funcDeleteUser != nil {
+		mmDeleteUser.mock.t.Fatalf("UserServiceMock.DeleteUser mock is already set by Set")
+	}
+
+	if mmDeleteUser.defaultExpectation == nil {
+		mmDeleteUser.defaultExpectation = &UserServiceMockDeleteUserExpectation{mock: mmDeleteUser.mock}
+	}
+	mmDeleteUser.defaultExpectation.results = &UserServiceMockDeleteUserResults{err}
+	mmDeleteUser.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteUser.mock
+}
+
+// Set uses given function f to mock the UserService.DeleteUser method
+func (mmDeleteUser *mUserServiceMockDeleteUser) Set(f func(ctx context.Context, userID string) (err error)) *UserServiceMock {
+	if mmDeleteUser.defaultExpectation != nil {
+		mmDeleteUser.mock.t.Fatalf("Default expectation is already set for the UserService.DeleteUser method")
+	}
+
+	if len(mmDeleteUser.expectations) > 0 {
+		mmDeleteUser.mock.t.Fatalf("Some expectations are already set for the UserService.DeleteUser method")
+	}
+
+	mmDeleteUser.mock.funcDeleteUser = f
+	mmDeleteUser.mock.funcDeleteUserOrigin = minimock.CallerInfo(1)
+	return mmDeleteUser.mock
+}
+
+// When sets expectation for the UserService.DeleteUser which will trigger the result defined by the following
+// Then helper
+func (mmDeleteUser *mUserServiceMockDeleteUser) When(ctx context.Context, userID string) *UserServiceMockDeleteUserExpectation {
+	if mmDeleteUser.mock.funcDeleteUser != nil {
+		mmDeleteUser.mock.t.Fatalf("UserServiceMock.DeleteUser mock is already set by Set")
+	}
+
+	expectation := &UserServiceMockDeleteUserExpectation{
+		mock:               mmDeleteUser.mock,
+		params:             &UserServiceMockDeleteUserParams{ctx, userID},
+		expectationOrigins: UserServiceMockDeleteUserExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteUser.expectations = append(mmDeleteUser.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserService.DeleteUser return parameters for the expectation previously defined by the When method
+func (e *UserServiceMockDeleteUserExpectation) Then(err error) *UserServiceMock {
+	e.results = &UserServiceMockDeleteUserResults{err}
+	return e.mock
+}
+
+// Times sets number of times UserService.DeleteUser should be invoked
+func (mmDeleteUser *mUserServiceMockDeleteUser) Times(n uint64) *mUserServiceMockDeleteUser {
+	if n == 0 {
+		mmDeleteUser.mock.t.Fatalf("Times of UserServiceMock.DeleteUser mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteUser.expectedInvocations, n)
+	mmDeleteUser.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteUser
+}
+
+func (mmDeleteUser *mUserServiceMockDeleteUser) invocationsDone() bool {
+	if len(mmDeleteUser.expectations) == 0 && mmDeleteUser.defaultExpectation == nil && mmDeleteUser.mock.funcDeleteUser == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteUser.mock.afterDeleteUserCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteUser.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteUser implements UserService
+func (mmDeleteUser *UserServiceMock) DeleteUser(ctx context.Context, userID string) (err error) {
+	mm_atomic.AddUint64(&mmDeleteUser.beforeDeleteUserCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteUser.afterDeleteUserCounter, 1)
+
+	mmDeleteUser.t.Helper()
+
+	if mmDeleteUser.inspectFuncDeleteUser != nil {
+		mmDeleteUser.inspectFuncDeleteUser(ctx, userID)
+	}
+
+	mm_params := UserServiceMockDeleteUserParams{ctx, userID}
+
+	// Record call args
+	mmDeleteUser.DeleteUserMock.mutex.Lock()
+	mmDeleteUser.DeleteUserMock.callArgs = append(mmDeleteUser.DeleteUserMock.callArgs, &mm_params)
+	mmDeleteUser.DeleteUserMock.mutex.Unlock()
+
+	for _, e := range mmDeleteUser.DeleteUserMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteUser.DeleteUserMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteUser.DeleteUserMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteUser.DeleteUserMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteUser.DeleteUserMock.defaultExpectation.paramPtrs
+
+		mm_got := UserServiceMockDeleteUserParams{ctx, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteUser.t.Errorf("UserServiceMock.DeleteUser got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteUser.DeleteUserMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmDeleteUser.t.Errorf("UserServiceMock.DeleteUser got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteUser.DeleteUserMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteUser.t.Errorf("UserServiceMock.DeleteUser got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteUser.DeleteUserMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteUser.DeleteUserMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteUser.t.Fatal("No results are set for the UserServiceMock.DeleteUser")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteUser.funcDeleteUser != nil {
+		return mmDeleteUser.funcDeleteUser(ctx, userID)
+	}
+	mmDeleteUser.t.Fatalf("Unexpected call to UserServiceMock.DeleteUser. %v %v", ctx, userID)
+	return
+}
+
+// DeleteUserAfterCounter returns a count of finished UserServiceMock.DeleteUser invocations
+func (mmDeleteUser *UserServiceMock) DeleteUserAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteUser.afterDeleteUserCounter)
+}
+
+// DeleteUserBeforeCounter returns a count of UserServiceMock.DeleteUser invocations
+func (mmDeleteUser *UserServiceMock) DeleteUserBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteUser.beforeDeleteUserCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserServiceMock.DeleteUser.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteUser *mUserServiceMockDeleteUser) Calls() []*UserServiceMockDeleteUserParams {
+	mmDeleteUser.mutex.RLock()
+
+	argCopy := make([]*UserServiceMockDeleteUserParams, len(mmDeleteUser.callArgs))
+	copy(argCopy, mmDeleteUser.callArgs)
+
+	mmDeleteUser.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteUserDone returns true if the count of the DeleteUser invocations corresponds
+// the number of defined expectations
+func (m *UserServiceMock) MinimockDeleteUserDone() bool {
+	if m.DeleteUserMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteUserMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteUserMock.invocationsDone()
+}
+
+// MinimockDeleteUserInspect logs each unmet expectation
+func (m *UserServiceMock) MinimockDeleteUserInspect() {
+	for _, e := range m.DeleteUserMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserServiceMock.DeleteUser at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteUserCounter := mm_atomic.LoadUint64(&m.afterDeleteUserCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteUserMock.defaultExpectation != nil && afterDeleteUserCounter < 1 {
+		if m.DeleteUserMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UserServiceMock.DeleteUser at\n%s", m.DeleteUserMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UserServiceMock.DeleteUser at\n%s with params: %#v", m.DeleteUserMock.defaultExpectation.expectationOrigins.origin, *m.DeleteUserMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteUser != nil && afterDeleteUserCounter < 1 {
+		m.t.Errorf("Expected call to UserServiceMock.DeleteUser at\n%s", m.funcDeleteUserOrigin)
+	}
+
+	if !m.DeleteUserMock.invocationsDone() && afterDeleteUserCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserServiceMock.DeleteUser at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteUserMock.expectedInvocations), m.DeleteUserMock.expectedInvocationsOrigin, afterDeleteUserCounter)
+	}
+}
+
+type mUserServiceMockGetUser struct {
+	optional           bool
+	mock               *UserServiceMock
+	defaultExpectation *UserServiceMockGetUserExpectation
+	expectations       []*UserServiceMockGetUserExpectation
+
+	callArgs []*UserServiceMockGetUserParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UserServiceMockGetUserExpectation specifies expectation struct of the UserService.GetUser
+type UserServiceMockGetUserExpectation struct {
+	mock               *UserServiceMock
+	params             *UserServiceMockGetUserParams
+	paramPtrs          *UserServiceMockGetUserParamPtrs
+	expectationOrigins UserServiceMockGetUserExpectationOrigins
+	results            *UserServiceMockGetUserResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UserServiceMockGetUserParams contains parameters of the UserService.GetUser
+type UserServiceMockGetUserParams struct {
+	ctx    context.Context
+	userID string
+}
+
+// UserServiceMockGetUserParamPtrs contains pointers to parameters of the UserService.GetUser
+type UserServiceMockGetUserParamPtrs struct {
+	ctx    *context.Context
+	userID *string
+}
+
+// UserServiceMockGetUserResults contains results of the UserService.GetUser
+type UserServiceMockGetUserResults struct {
+	up1 *models.User
+	err error
+}
+
+// UserServiceMockGetUserOrigins contains origins of expectations of the UserService.GetUser
+type UserServiceMockGetUserExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetUser *mUserServiceMockGetUser) Optional() *mUserServiceMockGetUser {
+	mmGetUser.optional = true
+	return mmGetUser
+}
+
+// Expect sets up expected params for UserService.GetUser
+func (mmGetUser *mUserServiceMockGetUser) Expect(ctx context.Context, userID string) *mUserServiceMockGetUser {
+	if mmGetUser.mock.funcGetUser != nil {
+		mmGetUser.mock.t.Fatalf("UserServiceMock.GetUser mock is already set by Set")
+	}
+
+	if mmGetUser.defaultExpectation == nil {
+		mmGetUser.defaultExpectation = &UserServiceMockGetUserExpectation{}
+	}
+
+	if mmGetUser.defaultExpectation.paramPtrs != nil {
+		mmGetUser.mock.t.Fatalf("UserServiceMock.GetUser mock is already set by ExpectParams functions")
+	}
+
+	mmGetUser.defaultExpectation.params = &UserServiceMockGetUserParams{ctx, userID}
+	mmGetUser.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetUser.expectations {
+		if minimock.Equal(e.params, mmGetUser.defaultExpectation.params) {
+			mmGetUser.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetUser.defaultExpectation.params)
+		}
+	}
+
+	return mmGetUser
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UserService.GetUser
+func (mmGetUser *mUserServiceMockGetUser) ExpectCtxParam1(ctx context.Context) *mUserServiceMockGetUser {
+	if mmGetUser.mock.funcGetUser != nil {
+		mmGetUser.mock.t.Fatalf("UserServiceMock.GetUser mock is already set by Set")
+	}
+
+	if mmGetUser.defaultExpectation == nil {
+		mmGetUser.defaultExpectation = &UserServiceMockGetUserExpectation{}
+	}
+
+	if mmGetUser.defaultExpectation.params != nil {
+		mmGetUser.mock.t.Fatalf("UserServiceMock.GetUser mock is already set by Expect")
+	}
+
+	if mmGetUser.defaultExpectation.paramPtrs == nil {
+		mmGetUser.defaultExpectation.paramPtrs = &UserServiceMockGetUserParamPtrs{}
+	}
+	mmGetUser.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetUser.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetUser
+}
+
+// ExpectUserIDParam2 sets up expected param userID for UserService.GetUser
+func (mmGetUser *mUserServiceMockGetUser) ExpectUserIDParam2(userID string) *mUserServiceMockGetUser {
+	if mmGetUser.mock.funcGetUser != nil {
+		mmGetUser.mock.t.Fatalf("UserServiceMock.GetUser mock is already set by Set")
+	}
+
+	if mmGetUser.defaultExpectation == nil {
+		mmGetUser.defaultExpectation = &UserServiceMockGetUserExpectation{}
+	}
+
+	if mmGetUser.defaultExpectation.params != nil {
+		mmGetUser.mock.t.Fatalf("UserServiceMock.GetUser mock is already set by Expect")
+	}
+
+	if mmGetUser.defaultExpectation.paramPtrs == nil {
+		mmGetUser.defaultExpectation.paramPtrs = &UserServiceMockGetUserParamPtrs{}
+	}
+	mmGetUser.defaultExpectation.paramPtrs.userID = &userID
+	mmGetUser.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmGetUser
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserService.GetUser
+func (mmGetUser *mUserServiceMockGetUser) Inspect(f func(ctx context.Context, userID string)) *mUserServiceMockGetUser {
+	if mmGetUser.mock.inspectFuncGetUser != nil {
+		mmGetUser.mock.t.Fatalf("Inspect function is already set for UserServiceMock.GetUser")
+	}
+
+	mmGetUser.mock.inspectFuncGetUser = f
+
+	return mmGetUser
+}
+
+// Return sets up results that will be returned by UserService.GetUser
+func (mmGetUser *mUserServiceMockGetUser) Return(up1 *models.User, err error) *UserServiceMock {
+	if mmGetUser.mock.funcGetUser != nil {
+		mmGetUser.mock.t.Fatalf("UserServiceMock.GetUser mock is already set by Set")
+	}
+
+	if mmGetUser.defaultExpectation == nil {
+		mmGetUser.defaultExpectation = &UserServiceMockGetUserExpectation{mock: mmGetUser.mock}
+	}
+	mmGetUser.defaultExpectation.results = &UserServiceMockGetUserResults{up1, err}
+	mmGetUser.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetUser.mock
+}
+
+// Set uses given function f to mock the UserService.GetUser method
+func (mmGetUser *mUserServiceMockGetUser) Set(f func(ctx context.Context, userID string) (up1 *models.User, err error)) *UserServiceMock {
+	if mmGetUser.defaultExpectation != nil {
+		mmGetUser.mock.t.Fatalf("Default expectation is already set for the UserService.GetUser method")
+	}
+
+	if len(mmGetUser.expectations) > 0 {
+		mmGetUser.mock.t.Fatalf("Some expectations are already set for the UserService.GetUser method")
+	}
+
+	mmGetUser.mock.funcGetUser = f
+	mmGetUser.mock.funcGetUserOrigin = minimock.CallerInfo(1)
+	return mmGetUser.mock
+}
+
+// When sets expectation for the UserService.GetUser which will trigger the result defined by the following
+// Then helper
+func (mmGetUser *mUserServiceMockGetUser) When(ctx context.Context, userID string) *UserServiceMockGetUserExpectation {
+	if mmGetUser.mock.funcGetUser != nil {
 		mmGetUser.mock.t.Fatalf("UserServiceMock.GetUser mock is already set by Set")
 	}
 
-	if mmGetUser.defaultExpectation == nil {
-		mmGetUser.defaultExpectation = &UserServiceMockGetUserExpectation{}
+	expectation := &UserServiceMockGetUserExpectation{
+		mock:               mmGetUser.mock,
+		params:             &UserServiceMockGetUserParams{ctx, userID},
+		expectationOrigins: UserServiceMockGetUserExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetUser.expectations = append(mmGetUser.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserService.GetUser return parameters for the expectation previously defined by the When method
+func (e *UserServiceMockGetUserExpectation) Then(up1 *models.User, err error) *UserServiceMock {
+	e.results = &UserServiceMockGetUserResults{up1, err}
+	return e.mock
+}
+
+// Times sets number of times UserService.GetUser should be invoked
+func (mmGetUser *mUserServiceMockGetUser) Times(n uint64) *mUserServiceMockGetUser {
+	if n == 0 {
+		mmGetUser.mock.t.Fatalf("Times of UserServiceMock.GetUser mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetUser.expectedInvocations, n)
+	mmGetUser.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetUser
+}
+
+func (mmGetUser *mUserServiceMockGetUser) invocationsDone() bool {
+	if len(mmGetUser.expectations) == 0 && mmGetUser.defaultExpectation == nil && mmGetUser.mock.funcGetUser == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetUser.mock.afterGetUserCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetUser.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetUser implements UserService
+func (mmGetUser *UserServiceMock) GetUser(ctx context.Context, userID string) (up1 *models.User, err error) {
+	mm_atomic.AddUint64(&mmGetUser.beforeGetUserCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetUser.afterGetUserCounter, 1)
+
+	mmGetUser.t.Helper()
+
+	if mmGetUser.inspectFuncGetUser != nil {
+		mmGetUser.inspectFuncGetUser(ctx, userID)
+	}
+
+	mm_params := UserServiceMockGetUserParams{ctx, userID}
+
+	// Record call args
+	mmGetUser.GetUserMock.mutex.Lock()
+	mmGetUser.GetUserMock.callArgs = append(mmGetUser.GetUserMock.callArgs, &mm_params)
+	mmGetUser.GetUserMock.mutex.Unlock()
+
+	for _, e := range mmGetUser.GetUserMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.up1, e.results.err
+		}
+	}
+
+	if mmGetUser.GetUserMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetUser.GetUserMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetUser.GetUserMock.defaultExpectation.params
+		mm_want_ptrs := mmGetUser.GetUserMock.defaultExpectation.paramPtrs
+
+		mm_got := UserServiceMockGetUserParams{ctx, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetUser.t.Errorf("UserServiceMock.GetUser got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetUser.GetUserMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmGetUser.t.Errorf("UserServiceMock.GetUser got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetUser.GetUserMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetUser.t.Errorf("UserServiceMock.GetUser got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetUser.GetUserMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetUser.GetUserMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetUser.t.Fatal("No results are set for the UserServiceMock.GetUser")
+		}
+		return (*mm_results).up1, (*mm_results).err
+	}
+	if mmGetUser.funcGetUser != nil {
+		return mmGetUser.funcGetUser(ctx, userID)
+	}
+	mmGetUser.t.Fatalf("Unexpected call to UserServiceMock.GetUser. %v %v", ctx, userID)
+	return
+}
+
+// GetUserAfterCounter returns a count of finished UserServiceMock.GetUser invocations
+func (mmGetUser *UserServiceMock) GetUserAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetUser.afterGetUserCounter)
+}
+
+// GetUserBeforeCounter returns a count of UserServiceMock.GetUser invocations
+func (mmGetUser *UserServiceMock) GetUserBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetUser.beforeGetUserCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserServiceMock.GetUser.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetUser *mUserServiceMockGetUser) Calls() []*UserServiceMockGetUserParams {
+	mmGetUser.mutex.RLock()
+
+	argCopy := make([]*UserServiceMockGetUserParams, len(mmGetUser.callArgs))
+	copy(argCopy, mmGetUser.callArgs)
+
+	mmGetUser.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetUserDone returns true if the count of the GetUser invocations corresponds
+// the number of defined expectations
+func (m *UserServiceMock) MinimockGetUserDone() bool {
+	if m.GetUserMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetUserMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetUserMock.invocationsDone()
+}
+
+// MinimockGetUserInspect logs each unmet expectation
+func (m *UserServiceMock) MinimockGetUserInspect() {
+	for _, e := range m.GetUserMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserServiceMock.GetUser at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetUserCounter := mm_atomic.LoadUint64(&m.afterGetUserCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetUserMock.defaultExpectation != nil && afterGetUserCounter < 1 {
+		if m.GetUserMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UserServiceMock.GetUser at\n%s", m.GetUserMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UserServiceMock.GetUser at\n%s with params: %#v", m.GetUserMock.defaultExpectation.expectationOrigins.origin, *m.GetUserMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetUser != nil && afterGetUserCounter < 1 {
+		m.t.Errorf("Expected call to UserServiceMock.GetUser at\n%s", m.funcGetUserOrigin)
+	}
+
+	if !m.GetUserMock.invocationsDone() && afterGetUserCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserServiceMock.GetUser at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetUserMock.expectedInvocations), m.GetUserMock.expectedInvocationsOrigin, afterGetUserCounter)
+	}
+}
+
+type mUserServiceMockListPersonalAccessTokens struct {
+	optional           bool
+	mock               *UserServiceMock
+	defaultExpectation *UserServiceMockListPersonalAccessTokensExpectation
+	expectations       []*UserServiceMockListPersonalAccessTokensExpectation
+
+	callArgs []*UserServiceMockListPersonalAccessTokensParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UserServiceMockListPersonalAccessTokensExpectation specifies expectation struct of the UserService.ListPersonalAccessTokens
+type UserServiceMockListPersonalAccessTokensExpectation struct {
+	mock               *UserServiceMock
+	params             *UserServiceMockListPersonalAccessTokensParams
+	paramPtrs          *UserServiceMockListPersonalAccessTokensParamPtrs
+	expectationOrigins UserServiceMockListPersonalAccessTokensExpectationOrigins
+	results            *UserServiceMockListPersonalAccessTokensResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UserServiceMockListPersonalAccessTokensParams contains parameters of the UserService.ListPersonalAccessTokens
+type UserServiceMockListPersonalAccessTokensParams struct {
+	ctx    context.Context
+	userID string
+}
+
+// UserServiceMockListPersonalAccessTokensParamPtrs contains pointers to parameters of the UserService.ListPersonalAccessTokens
+type UserServiceMockListPersonalAccessTokensParamPtrs struct {
+	ctx    *context.Context
+	userID *string
+}
+
+// UserServiceMockListPersonalAccessTokensResults contains results of the UserService.ListPersonalAccessTokens
+type UserServiceMockListPersonalAccessTokensResults struct {
+	pa1 []models.PersonalAccessToken
+	err error
+}
+
+// UserServiceMockListPersonalAccessTokensOrigins contains origins of expectations of the UserService.ListPersonalAccessTokens
+type UserServiceMockListPersonalAccessTokensExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListPersonalAccessTokens *mUserServiceMockListPersonalAccessTokens) Optional() *mUserServiceMockListPersonalAccessTokens {
+	mmListPersonalAccessTokens.optional = true
+	return mmListPersonalAccessTokens
+}
+
+// Expect sets up expected params for UserService.ListPersonalAccessTokens
+func (mmListPersonalAccessTokens *mUserServiceMockListPersonalAccessTokens) Expect(ctx context.Context, userID string) *mUserServiceMockListPersonalAccessTokens {
+	if mmListPersonalAccessTokens.mock.funcListPersonalAccessTokens != nil {
+		mmListPersonalAccessTokens.mock.t.Fatalf("UserServiceMock.ListPersonalAccessTokens mock is already set by Set")
+	}
+
+	if mmListPersonalAccessTokens.defaultExpectation == nil {
+		mmListPersonalAccessTokens.defaultExpectation = &UserServiceMockListPersonalAccessTokensExpectation{}
+	}
+
+	if mmListPersonalAccessTokens.defaultExpectation.paramPtrs != nil {
+		mmListPersonalAccessTokens.mock.t.Fatalf("UserServiceMock.ListPersonalAccessTokens mock is already set by ExpectParams functions")
+	}
+
+	mmListPersonalAccessTokens.defaultExpectation.params = &UserServiceMockListPersonalAccessTokensParams{ctx, userID}
+	mmListPersonalAccessTokens.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListPersonalAccessTokens.expectations {
+		if minimock.Equal(e.params, mmListPersonalAccessTokens.defaultExpectation.params) {
+			mmListPersonalAccessTokens.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListPersonalAccessTokens.defaultExpectation.params)
+		}
+	}
+
+	return mmListPersonalAccessTokens
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UserService.ListPersonalAccessTokens
+func (mmListPersonalAccessTokens *mUserServiceMockListPersonalAccessTokens) ExpectCtxParam1(ctx context.Context) *mUserServiceMockListPersonalAccessTokens {
+	if mmListPersonalAccessTokens.mock.funcListPersonalAccessTokens != nil {
+		mmListPersonalAccessTokens.mock.t.Fatalf("UserServiceMock.ListPersonalAccessTokens mock is already set by Set")
+	}
+
+	if mmListPersonalAccessTokens.defaultExpectation == nil {
+		mmListPersonalAccessTokens.defaultExpectation = &UserServiceMockListPersonalAccessTokensExpectation{}
+	}
+
+	if mmListPersonalAccessTokens.defaultExpectation.params != nil {
+		mmListPersonalAccessTokens.mock.t.Fatalf("UserServiceMock.ListPersonalAccessTokens mock is already set by Expect")
+	}
+
+	if mmListPersonalAccessTokens.defaultExpectation.paramPtrs == nil {
+		mmListPersonalAccessTokens.defaultExpectation.paramPtrs = &UserServiceMockListPersonalAccessTokensParamPtrs{}
+	}
+	mmListPersonalAccessTokens.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListPersonalAccessTokens.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListPersonalAccessTokens
+}
+
+// ExpectUserIDParam2 sets up expected param userID for UserService.ListPersonalAccessTokens
+func (mmListPersonalAccessTokens *mUserServiceMockListPersonalAccessTokens) ExpectUserIDParam2(userID string) *mUserServiceMockListPersonalAccessTokens {
+	if mmListPersonalAccessTokens.mock.funcListPersonalAccessTokens != nil {
+		mmListPersonalAccessTokens.mock.t.Fatalf("UserServiceMock.ListPersonalAccessTokens mock is already set by Set")
+	}
+
+	if mmListPersonalAccessTokens.defaultExpectation == nil {
+		mmListPersonalAccessTokens.defaultExpectation = &UserServiceMockListPersonalAccessTokensExpectation{}
+	}
+
+	if mmListPersonalAccessTokens.defaultExpectation.params != nil {
+		mmListPersonalAccessTokens.mock.t.Fatalf("UserServiceMock.ListPersonalAccessTokens mock is already set by Expect")
+	}
+
+	if mmListPersonalAccessTokens.defaultExpectation.paramPtrs == nil {
+		mmListPersonalAccessTokens.defaultExpectation.paramPtrs = &UserServiceMockListPersonalAccessTokensParamPtrs{}
+	}
+	mmListPersonalAccessTokens.defaultExpectation.paramPtrs.userID = &userID
+	mmListPersonalAccessTokens.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmListPersonalAccessTokens
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserService.ListPersonalAccessTokens
+func (mmListPersonalAccessTokens *mUserServiceMockListPersonalAccessTokens) Inspect(f func(ctx context.Context, userID string)) *mUserServiceMockListPersonalAccessTokens {
+	if mmListPersonalAccessTokens.mock.inspectFuncListPersonalAccessTokens != nil {
+		mmListPersonalAccessTokens.mock.t.Fatalf("Inspect function is already set for UserServiceMock.ListPersonalAccessTokens")
+	}
+
+	mmListPersonalAccessTokens.mock.inspectFuncListPersonalAccessTokens = f
+
+	return mmListPersonalAccessTokens
+}
+
+// Return sets up results that will be returned by UserService.ListPersonalAccessTokens
+func (mmListPersonalAccessTokens *mUserServiceMockListPersonalAccessTokens) Return(pa1 []models.PersonalAccessToken, err error) *UserServiceMock {
+	if mmListPersonalAccessTokens.mock.funcListPersonalAccessTokens != nil {
+		mmListPersonalAccessTokens.mock.t.Fatalf("UserServiceMock.ListPersonalAccessTokens mock is already set by Set")
+	}
+
+	if mmListPersonalAccessTokens.defaultExpectation == nil {
+		mmListPersonalAccessTokens.defaultExpectation = &UserServiceMockListPersonalAccessTokensExpectation{mock: mmListPersonalAccessTokens.mock}
+	}
+	mmListPersonalAccessTokens.defaultExpectation.results = &UserServiceMockListPersonalAccessTokensResults{pa1, err}
+	mmListPersonalAccessTokens.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListPersonalAccessTokens.mock
+}
+
+// Set uses given function f to mock the UserService.ListPersonalAccessTokens method
+func (mmListPersonalAccessTokens *mUserServiceMockListPersonalAccessTokens) Set(f func(ctx context.Context, userID string) (pa1 []models.PersonalAccessToken, err error)) *UserServiceMock {
+	if mmListPersonalAccessTokens.defaultExpectation != nil {
+		mmListPersonalAccessTokens.mock.t.Fatalf("Default expectation is already set for the UserService.ListPersonalAccessTokens method")
+	}
+
+	if len(mmListPersonalAccessTokens.expectations) > 0 {
+		mmListPersonalAccessTokens.mock.t.Fatalf("Some expectations are already set for the UserService.ListPersonalAccessTokens method")
+	}
+
+	mmListPersonalAccessTokens.mock.funcListPersonalAccessTokens = f
+	mmListPersonalAccessTokens.mock.funcListPersonalAccessTokensOrigin = minimock.CallerInfo(1)
+	return mmListPersonalAccessTokens.mock
+}
+
+// When sets expectation for the UserService.ListPersonalAccessTokens which will trigger the result defined by the following
+// Then helper
+func (mmListPersonalAccessTokens *mUserServiceMockListPersonalAccessTokens) When(ctx context.Context, userID string) *UserServiceMockListPersonalAccessTokensExpectation {
+	if mmListPersonalAccessTokens.mock.funcListPersonalAccessTokens != nil {
+		mmListPersonalAccessTokens.mock.t.Fatalf("UserServiceMock.ListPersonalAccessTokens mock is already set by Set")
+	}
+
+	expectation := &UserServiceMockListPersonalAccessTokensExpectation{
+		mock:               mmListPersonalAccessTokens.mock,
+		params:             &UserServiceMockListPersonalAccessTokensParams{ctx, userID},
+		expectationOrigins: UserServiceMockListPersonalAccessTokensExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListPersonalAccessTokens.expectations = append(mmListPersonalAccessTokens.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserService.ListPersonalAccessTokens return parameters for the expectation previously defined by the When method
+func (e *UserServiceMockListPersonalAccessTokensExpectation) Then(pa1 []models.PersonalAccessToken, err error) *UserServiceMock {
+	e.results = &UserServiceMockListPersonalAccessTokensResults{pa1, err}
+	return e.mock
+}
+
+// Times sets number of times UserService.ListPersonalAccessTokens should be invoked
+func (mmListPersonalAccessTokens *mUserServiceMockListPersonalAccessTokens) Times(n uint64) *mUserServiceMockListPersonalAccessTokens {
+	if n == 0 {
+		mmListPersonalAccessTokens.mock.t.Fatalf("Times of UserServiceMock.ListPersonalAccessTokens mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListPersonalAccessTokens.expectedInvocations, n)
+	mmListPersonalAccessTokens.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListPersonalAccessTokens
+}
+
+func (mmListPersonalAccessTokens *mUserServiceMockListPersonalAccessTokens) invocationsDone() bool {
+	if len(mmListPersonalAccessTokens.expectations) == 0 && mmListPersonalAccessTokens.defaultExpectation == nil && mmListPersonalAccessTokens.mock.funcListPersonalAccessTokens == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListPersonalAccessTokens.mock.afterListPersonalAccessTokensCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListPersonalAccessTokens.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListPersonalAccessTokens implements UserService
+func (mmListPersonalAccessTokens *UserServiceMock) ListPersonalAccessTokens(ctx context.Context, userID string) (pa1 []models.PersonalAccessToken, err error) {
+	mm_atomic.AddUint64(&mmListPersonalAccessTokens.beforeListPersonalAccessTokensCounter, 1)
+	defer mm_atomic.AddUint64(&mmListPersonalAccessTokens.afterListPersonalAccessTokensCounter, 1)
+
+	mmListPersonalAccessTokens.t.Helper()
+
+	if mmListPersonalAccessTokens.inspectFuncListPersonalAccessTokens != nil {
+		mmListPersonalAccessTokens.inspectFuncListPersonalAccessTokens(ctx, userID)
+	}
+
+	mm_params := UserServiceMockListPersonalAccessTokensParams{ctx, userID}
+
+	// Record call args
+	mmListPersonalAccessTokens.ListPersonalAccessTokensMock.mutex.Lock()
+	mmListPersonalAccessTokens.ListPersonalAccessTokensMock.callArgs = append(mmListPersonalAccessTokens.ListPersonalAccessTokensMock.callArgs, &mm_params)
+	mmListPersonalAccessTokens.ListPersonalAccessTokensMock.mutex.Unlock()
+
+	for _, e := range mmListPersonalAccessTokens.ListPersonalAccessTokensMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.pa1, e.results.err
+		}
+	}
+
+	if mmListPersonalAccessTokens.ListPersonalAccessTokensMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListPersonalAccessTokens.ListPersonalAccessTokensMock.defaultExpectation.Counter, 1)
+		mm_want := mmListPersonalAccessTokens.ListPersonalAccessTokensMock.defaultExpectation.params
+		mm_want_ptrs := mmListPersonalAccessTokens.ListPersonalAccessTokensMock.defaultExpectation.paramPtrs
+
+		mm_got := UserServiceMockListPersonalAccessTokensParams{ctx, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListPersonalAccessTokens.t.Errorf("UserServiceMock.ListPersonalAccessTokens got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListPersonalAccessTokens.ListPersonalAccessTokensMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmListPersonalAccessTokens.t.Errorf("UserServiceMock.ListPersonalAccessTokens got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListPersonalAccessTokens.ListPersonalAccessTokensMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListPersonalAccessTokens.t.Errorf("UserServiceMock.ListPersonalAccessTokens got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListPersonalAccessTokens.ListPersonalAccessTokensMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListPersonalAccessTokens.ListPersonalAccessTokensMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListPersonalAccessTokens.t.Fatal("No results are set for the UserServiceMock.ListPersonalAccessTokens")
+		}
+		return (*mm_results).pa1, (*mm_results).err
+	}
+	if mmListPersonalAccessTokens.funcListPersonalAccessTokens != nil {
+		return mmListPersonalAccessTokens.funcListPersonalAccessTokens(ctx, userID)
+	}
+	mmListPersonalAccessTokens.t.Fatalf("Unexpected call to UserServiceMock.ListPersonalAccessTokens. %v %v", ctx, userID)
+	return
+}
+
+// ListPersonalAccessTokensAfterCounter returns a count of finished UserServiceMock.ListPersonalAccessTokens invocations
+func (mmListPersonalAccessTokens *UserServiceMock) ListPersonalAccessTokensAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListPersonalAccessTokens.afterListPersonalAccessTokensCounter)
+}
+
+// ListPersonalAccessTokensBeforeCounter returns a count of UserServiceMock.ListPersonalAccessTokens invocations
+func (mmListPersonalAccessTokens *UserServiceMock) ListPersonalAccessTokensBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListPersonalAccessTokens.beforeListPersonalAccessTokensCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserServiceMock.ListPersonalAccessTokens.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListPersonalAccessTokens *mUserServiceMockListPersonalAccessTokens) Calls() []*UserServiceMockListPersonalAccessTokensParams {
+	mmListPersonalAccessTokens.mutex.RLock()
+
+	argCopy := make([]*UserServiceMockListPersonalAccessTokensParams, len(mmListPersonalAccessTokens.callArgs))
+	copy(argCopy, mmListPersonalAccessTokens.callArgs)
+
+	mmListPersonalAccessTokens.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListPersonalAccessTokensDone returns true if the count of the ListPersonalAccessTokens invocations corresponds
+// the number of defined expectations
+func (m *UserServiceMock) MinimockListPersonalAccessTokensDone() bool {
+	if m.ListPersonalAccessTokensMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListPersonalAccessTokensMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListPersonalAccessTokensMock.invocationsDone()
+}
+
+// MinimockListPersonalAccessTokensInspect logs each unmet expectation
+func (m *UserServiceMock) MinimockListPersonalAccessTokensInspect() {
+	for _, e := range m.ListPersonalAccessTokensMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserServiceMock.ListPersonalAccessTokens at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListPersonalAccessTokensCounter := mm_atomic.LoadUint64(&m.afterListPersonalAccessTokensCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListPersonalAccessTokensMock.defaultExpectation != nil && afterListPersonalAccessTokensCounter < 1 {
+		if m.ListPersonalAccessTokensMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UserServiceMock.ListPersonalAccessTokens at\n%s", m.ListPersonalAccessTokensMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UserServiceMock.ListPersonalAccessTokens at\n%s with params: %#v", m.ListPersonalAccessTokensMock.defaultExpectation.expectationOrigins.origin, *m.ListPersonalAccessTokensMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListPersonalAccessTokens != nil && afterListPersonalAccessTokensCounter < 1 {
+		m.t.Errorf("Expected call to UserServiceMock.ListPersonalAccessTokens at\n%s", m.funcListPersonalAccessTokensOrigin)
+	}
+
+	if !m.ListPersonalAccessTokensMock.invocationsDone() && afterListPersonalAccessTokensCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserServiceMock.ListPersonalAccessTokens at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListPersonalAccessTokensMock.expectedInvocations), m.ListPersonalAccessTokensMock.expectedInvocationsOrigin, afterListPersonalAccessTokensCounter)
+	}
+}
+
+type mUserServiceMockListSessions struct {
+	optional           bool
+	mock               *UserServiceMock
+	defaultExpectation *UserServiceMockListSessionsExpectation
+	expectations       []*UserServiceMockListSessionsExpectation
+
+	callArgs []*UserServiceMockListSessionsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UserServiceMockListSessionsExpectation specifies expectation struct of the UserService.ListSessions
+type UserServiceMockListSessionsExpectation struct {
+	mock               *UserServiceMock
+	params             *UserServiceMockListSessionsParams
+	paramPtrs          *UserServiceMockListSessionsParamPtrs
+	expectationOrigins UserServiceMockListSessionsExpectationOrigins
+	results            *UserServiceMockListSessionsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UserServiceMockListSessionsParams contains parameters of the UserService.ListSessions
+type UserServiceMockListSessionsParams struct {
+	ctx    context.Context
+	userID string
+}
+
+// UserServiceMockListSessionsParamPtrs contains pointers to parameters of the UserService.ListSessions
+type UserServiceMockListSessionsParamPtrs struct {
+	ctx    *context.Context
+	userID *string
+}
+
+// UserServiceMockListSessionsResults contains results of the UserService.ListSessions
+type UserServiceMockListSessionsResults struct {
+	sa1 []models.Session
+	err error
+}
+
+// UserServiceMockListSessionsOrigins contains origins of expectations of the UserService.ListSessions
+type UserServiceMockListSessionsExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListSessions *mUserServiceMockListSessions) Optional() *mUserServiceMockListSessions {
+	mmListSessions.optional = true
+	return mmListSessions
+}
+
+// Expect sets up expected params for UserService.ListSessions
+func (mmListSessions *mUserServiceMockListSessions) Expect(ctx context.Context, userID string) *mUserServiceMockListSessions {
+	if mmListSessions.mock.funcListSessions != nil {
+		mmListSessions.mock.t.Fatalf("UserServiceMock.ListSessions mock is already set by Set")
+	}
+
+	if mmListSessions.defaultExpectation == nil {
+		mmListSessions.defaultExpectation = &UserServiceMockListSessionsExpectation{}
+	}
+
+	if mmListSessions.defaultExpectation.paramPtrs != nil {
+		mmListSessions.mock.t.Fatalf("UserServiceMock.ListSessions mock is already set by ExpectParams functions")
+	}
+
+	mmListSessions.defaultExpectation.params = &UserServiceMockListSessionsParams{ctx, userID}
+	mmListSessions.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListSessions.expectations {
+		if minimock.Equal(e.params, mmListSessions.defaultExpectation.params) {
+			mmListSessions.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListSessions.defaultExpectation.params)
+		}
+	}
+
+	return mmListSessions
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UserService.ListSessions
+func (mmListSessions *mUserServiceMockListSessions) ExpectCtxParam1(ctx context.Context) *mUserServiceMockListSessions {
+	if mmListSessions.mock.funcListSessions != nil {
+		mmListSessions.mock.t.Fatalf("UserServiceMock.ListSessions mock is already set by Set")
+	}
+
+	if mmListSessions.defaultExpectation == nil {
+		mmListSessions.defaultExpectation = &UserServiceMockListSessionsExpectation{}
+	}
+
+	if mmListSessions.defaultExpectation.params != nil {
+		mmListSessions.mock.t.Fatalf("UserServiceMock.ListSessions mock is already set by Expect")
+	}
+
+	if mmListSessions.defaultExpectation.paramPtrs == nil {
+		mmListSessions.defaultExpectation.paramPtrs = &UserServiceMockListSessionsParamPtrs{}
+	}
+	mmListSessions.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListSessions.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListSessions
+}
+
+// ExpectUserIDParam2 sets up expected param userID for UserService.ListSessions
+func (mmListSessions *mUserServiceMockListSessions) ExpectUserIDParam2(userID string) *mUserServiceMockListSessions {
+	if mmListSessions.mock.funcListSessions != nil {
+		mmListSessions.mock.t.Fatalf("UserServiceMock.ListSessions mock is already set by Set")
+	}
+
+	if mmListSessions.defaultExpectation == nil {
+		mmListSessions.defaultExpectation = &UserServiceMockListSessionsExpectation{}
+	}
+
+	if mmListSessions.defaultExpectation.params != nil {
+		mmListSessions.mock.t.Fatalf("UserServiceMock.ListSessions mock is already set by Expect")
+	}
+
+	if mmListSessions.defaultExpectation.paramPtrs == nil {
+		mmListSessions.defaultExpectation.paramPtrs = &UserServiceMockListSessionsParamPtrs{}
+	}
+	mmListSessions.defaultExpectation.paramPtrs.userID = &userID
+	mmListSessions.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmListSessions
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserService.ListSessions
+func (mmListSessions *mUserServiceMockListSessions) Inspect(f func(ctx context.Context, userID string)) *mUserServiceMockListSessions {
+	if mmListSessions.mock.inspectFuncListSessions != nil {
+		mmListSessions.mock.t.Fatalf("Inspect function is already set for UserServiceMock.ListSessions")
+	}
+
+	mmListSessions.mock.inspectFuncListSessions = f
+
+	return mmListSessions
+}
+
+// Return sets up results that will be returned by UserService.ListSessions
+func (mmListSessions *mUserServiceMockListSessions) Return(sa1 []models.Session, err error) *UserServiceMock {
+	if mmListSessions.mock.funcListSessions != nil {
+		mmListSessions.mock.t.Fatalf("UserServiceMock.ListSessions mock is already set by Set")
+	}
+
+	if mmListSessions.defaultExpectation == nil {
+		mmListSessions.defaultExpectation = &UserServiceMockListSessionsExpectation{mock: mmListSessions.mock}
+	}
+	mmListSessions.defaultExpectation.results = &UserServiceMockListSessionsResults{sa1, err}
+	mmListSessions.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListSessions.mock
+}
+
+// Set uses given function f to mock the UserService.ListSessions method
+func (mmListSessions *mUserServiceMockListSessions) Set(f func(ctx context.Context, userID string) (sa1 []models.Session, err error)) *UserServiceMock {
+	if mmListSessions.defaultExpectation != nil {
+		mmListSessions.mock.t.Fatalf("Default expectation is already set for the UserService.ListSessions method")
+	}
+
+	if len(mmListSessions.expectations) > 0 {
+		mmListSessions.mock.t.Fatalf("Some expectations are already set for the UserService.ListSessions method")
+	}
+
+	mmListSessions.mock.funcListSessions = f
+	mmListSessions.mock.funcListSessionsOrigin = minimock.CallerInfo(1)
+	return mmListSessions.mock
+}
+
+// When sets expectation for the UserService.ListSessions which will trigger the result defined by the following
+// Then helper
+func (mmListSessions *mUserServiceMockListSessions) When(ctx context.Context, userID string) *UserServiceMockListSessionsExpectation {
+	if mmListSessions.mock.funcListSessions != nil {
+		mmListSessions.mock.t.Fatalf("UserServiceMock.ListSessions mock is already set by Set")
+	}
+
+	expectation := &UserServiceMockListSessionsExpectation{
+		mock:               mmListSessions.mock,
+		params:             &UserServiceMockListSessionsParams{ctx, userID},
+		expectationOrigins: UserServiceMockListSessionsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListSessions.expectations = append(mmListSessions.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserService.ListSessions return parameters for the expectation previously defined by the When method
+func (e *UserServiceMockListSessionsExpectation) Then(sa1 []models.Session, err error) *UserServiceMock {
+	e.results = &UserServiceMockListSessionsResults{sa1, err}
+	return e.mock
+}
+
+// Times sets number of times UserService.ListSessions should be invoked
+func (mmListSessions *mUserServiceMockListSessions) Times(n uint64) *mUserServiceMockListSessions {
+	if n == 0 {
+		mmListSessions.mock.t.Fatalf("Times of UserServiceMock.ListSessions mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListSessions.expectedInvocations, n)
+	mmListSessions.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListSessions
+}
+
+func (mmListSessions *mUserServiceMockListSessions) invocationsDone() bool {
+	if len(mmListSessions.expectations) == 0 && mmListSessions.defaultExpectation == nil && mmListSessions.mock.funcListSessions == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListSessions.mock.afterListSessionsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListSessions.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListSessions implements UserService
+func (mmListSessions *UserServiceMock) ListSessions(ctx context.Context, userID string) (sa1 []models.Session, err error) {
+	mm_atomic.AddUint64(&mmListSessions.beforeListSessionsCounter, 1)
+	defer mm_atomic.AddUint64(&mmListSessions.afterListSessionsCounter, 1)
+
+	mmListSessions.t.Helper()
+
+	if mmListSessions.inspectFuncListSessions != nil {
+		mmListSessions.inspectFuncListSessions(ctx, userID)
+	}
+
+	mm_params := UserServiceMockListSessionsParams{ctx, userID}
+
+	// Record call args
+	mmListSessions.ListSessionsMock.mutex.Lock()
+	mmListSessions.ListSessionsMock.callArgs = append(mmListSessions.ListSessionsMock.callArgs, &mm_params)
+	mmListSessions.ListSessionsMock.mutex.Unlock()
+
+	for _, e := range mmListSessions.ListSessionsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.sa1, e.results.err
+		}
+	}
+
+	if mmListSessions.ListSessionsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListSessions.ListSessionsMock.defaultExpectation.Counter, 1)
+		mm_want := mmListSessions.ListSessionsMock.defaultExpectation.params
+		mm_want_ptrs := mmListSessions.ListSessionsMock.defaultExpectation.paramPtrs
+
+		mm_got := UserServiceMockListSessionsParams{ctx, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListSessions.t.Errorf("UserServiceMock.ListSessions got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListSessions.ListSessionsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmListSessions.t.Errorf("UserServiceMock.ListSessions got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListSessions.ListSessionsMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListSessions.t.Errorf("UserServiceMock.ListSessions got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListSessions.ListSessionsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListSessions.ListSessionsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListSessions.t.Fatal("No results are set for the UserServiceMock.ListSessions")
+		}
+		return (*mm_results).sa1, (*mm_results).err
+	}
+	if mmListSessions.funcListSessions != nil {
+		return mmListSessions.funcListSessions(ctx, userID)
+	}
+	mmListSessions.t.Fatalf("Unexpected call to UserServiceMock.ListSessions. %v %v", ctx, userID)
+	return
+}
+
+// ListSessionsAfterCounter returns a count of finished UserServiceMock.ListSessions invocations
+func (mmListSessions *UserServiceMock) ListSessionsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListSessions.afterListSessionsCounter)
+}
+
+// ListSessionsBeforeCounter returns a count of UserServiceMock.ListSessions invocations
+func (mmListSessions *UserServiceMock) ListSessionsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListSessions.beforeListSessionsCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserServiceMock.ListSessions.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListSessions *mUserServiceMockListSessions) Calls() []*UserServiceMockListSessionsParams {
+	mmListSessions.mutex.RLock()
+
+	argCopy := make([]*UserServiceMockListSessionsParams, len(mmListSessions.callArgs))
+	copy(argCopy, mmListSessions.callArgs)
+
+	mmListSessions.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListSessionsDone returns true if the count of the ListSessions invocations corresponds
+// the number of defined expectations
+func (m *UserServiceMock) MinimockListSessionsDone() bool {
+	if m.ListSessionsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
 	}
 
-	if mmGetUser.defaultExpectation.params != nil {
-		mmGetUser.mock.t.Fatalf("UserServiceMock.GetUser mock is already set by Expect")
+	for _, e := range m.ListSessionsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
 	}
 
-	if mmGetUser.defaultExpectation.paramPtrs == nil {
-		mmGetUser.defaultExpectation.paramPtrs = &UserServiceMockGetUserParamPtrs{}
+	return m.ListSessionsMock.invocationsDone()
+}
+
+// MinimockListSessionsInspect logs each unmet expectation
+func (m *UserServiceMock) MinimockListSessionsInspect() {
+	for _, e := range m.ListSessionsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserServiceMock.ListSessions at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
 	}
-	mmGetUser.defaultExpectation.paramPtrs.userID = &userID
-	mmGetUser.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
 
-	return mmGetUser
+	afterListSessionsCounter := mm_atomic.LoadUint64(&m.afterListSessionsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListSessionsMock.defaultExpectation != nil && afterListSessionsCounter < 1 {
+		if m.ListSessionsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UserServiceMock.ListSessions at\n%s", m.ListSessionsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UserServiceMock.ListSessions at\n%s with params: %#v", m.ListSessionsMock.defaultExpectation.expectationOrigins.origin, *m.ListSessionsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListSessions != nil && afterListSessionsCounter < 1 {
+		m.t.Errorf("Expected call to UserServiceMock.ListSessions at\n%s", m.funcListSessionsOrigin)
+	}
+
+	if !m.ListSessionsMock.invocationsDone() && afterListSessionsCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserServiceMock.ListSessions at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListSessionsMock.expectedInvocations), m.ListSessionsMock.expectedInvocationsOrigin, afterListSessionsCounter)
+	}
 }
 
-// Inspect accepts an inspector function that has same arguments as the UserService.GetUser
-func (mmGetUser *mUserServiceMockGetUser) Inspect(f func(ctx context.Context, userID string)) *mUserServiceMockGetUser {
-	if mmGetUser.mock.inspectFuncGetUser != nil {
-		mmGetUser.mock.t.Fatalf("Inspect function is already set for UserServiceMock.GetUser")
+type mUserServiceMockSetAllowedIPs struct {
+	optional           bool
+	mock               *UserServiceMock
+	defaultExpectation *UserServiceMockSetAllowedIPsExpectation
+	expectations       []*UserServiceMockSetAllowedIPsExpectation
+
+	callArgs []*UserServiceMockSetAllowedIPsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UserServiceMockSetAllowedIPsExpectation specifies expectation struct of the UserService.SetAllowedIPs
+type UserServiceMockSetAllowedIPsExpectation struct {
+	mock               *UserServiceMock
+	params             *UserServiceMockSetAllowedIPsParams
+	paramPtrs          *UserServiceMockSetAllowedIPsParamPtrs
+	expectationOrigins UserServiceMockSetAllowedIPsExpectationOrigins
+	results            *UserServiceMockSetAllowedIPsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UserServiceMockSetAllowedIPsParams contains parameters of the UserService.SetAllowedIPs
+type UserServiceMockSetAllowedIPsParams struct {
+	ctx        context.Context
+	userID     string
+	ip         string
+	allowedIPs []string
+}
+
+// UserServiceMockSetAllowedIPsParamPtrs contains pointers to parameters of the UserService.SetAllowedIPs
+type UserServiceMockSetAllowedIPsParamPtrs struct {
+	ctx        *context.Context
+	userID     *string
+	ip         *string
+	allowedIPs *[]string
+}
+
+// UserServiceMockSetAllowedIPsResults contains results of the UserService.SetAllowedIPs
+type UserServiceMockSetAllowedIPsResults struct {
+	sa1 []string
+	err error
+}
+
+// UserServiceMockSetAllowedIPsOrigins contains origins of expectations of the UserService.SetAllowedIPs
+type UserServiceMockSetAllowedIPsExpectationOrigins struct {
+	origin           string
+	originCtx        string
+	originUserID     string
+	originIp         string
+	originAllowedIPs string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSetAllowedIPs *mUserServiceMockSetAllowedIPs) Optional() *mUserServiceMockSetAllowedIPs {
+	mmSetAllowedIPs.optional = true
+	return mmSetAllowedIPs
+}
+
+// Expect sets up expected params for UserService.SetAllowedIPs
+func (mmSetAllowedIPs *mUserServiceMockSetAllowedIPs) Expect(ctx context.Context, userID string, ip string, allowedIPs []string) *mUserServiceMockSetAllowedIPs {
+	if mmSetAllowedIPs.mock.funcSetAllowedIPs != nil {
+		mmSetAllowedIPs.mock.t.Fatalf("UserServiceMock.SetAllowedIPs mock is already set by Set")
 	}
 
-	mmGetUser.mock.inspectFuncGetUser = f
+	if mmSetAllowedIPs.defaultExpectation == nil {
+		mmSetAllowedIPs.defaultExpectation = &UserServiceMockSetAllowedIPsExpectation{}
+	}
 
-	return mmGetUser
+	if mmSetAllowedIPs.defaultExpectation.paramPtrs != nil {
+		mmSetAllowedIPs.mock.t.Fatalf("UserServiceMock.SetAllowedIPs mock is already set by ExpectParams functions")
+	}
+
+	mmSetAllowedIPs.defaultExpectation.params = &UserServiceMockSetAllowedIPsParams{ctx, userID, ip, allowedIPs}
+	mmSetAllowedIPs.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSetAllowedIPs.expectations {
+		if minimock.Equal(e.params, mmSetAllowedIPs.defaultExpectation.params) {
+			mmSetAllowedIPs.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSetAllowedIPs.defaultExpectation.params)
+		}
+	}
+
+	return mmSetAllowedIPs
 }
 
-// Return sets up results that will be returned by UserService.GetUser
-func (mmGetUser *mUserServiceMockGetUser) Return(up1 *models.User, err error) *UserServiceMock {
-	if mmGetUser.mock.funcGetUser != nil {
-		mmGetUser.mock.t.Fatalf("UserServiceMock.GetUser mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for UserService.SetAllowedIPs
+func (mmSetAllowedIPs *mUserServiceMockSetAllowedIPs) ExpectCtxParam1(ctx context.Context) *mUserServiceMockSetAllowedIPs {
+	if mmSetAllowedIPs.mock.funcSetAllowedIPs != nil {
+		mmSetAllowedIPs.mock.t.Fatalf("UserServiceMock.SetAllowedIPs mock is already set by Set")
 	}
 
-	if mmGetUser.defaultExpectation == nil {
-		mmGetUser.defaultExpectation = &UserServiceMockGetUserExpectation{mock: mmGetUser.mock}
+	if mmSetAllowedIPs.defaultExpectation == nil {
+		mmSetAllowedIPs.defaultExpectation = &UserServiceMockSetAllowedIPsExpectation{}
 	}
-	mmGetUser.defaultExpectation.results = &UserServiceMockGetUserResults{up1, err}
-	mmGetUser.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmGetUser.mock
+
+	if mmSetAllowedIPs.defaultExpectation.params != nil {
+		mmSetAllowedIPs.mock.t.Fatalf("UserServiceMock.SetAllowedIPs mock is already set by Expect")
+	}
+
+	if mmSetAllowedIPs.defaultExpectation.paramPtrs == nil {
+		mmSetAllowedIPs.defaultExpectation.paramPtrs = &UserServiceMockSetAllowedIPsParamPtrs{}
+	}
+	mmSetAllowedIPs.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSetAllowedIPs.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSetAllowedIPs
 }
 
-// Set uses given function f to mock the UserService.GetUser method
-func (mmGetUser *mUserServiceMockGetUser) Set(f func(ctx context.Context, userID string) (up1 *models.User, err error)) *UserServiceMock {
-	if mmGetUser.defaultExpectation != nil {
-		mmGetUser.mock.t.Fatalf("Default expectation is already set for the UserService.GetUser method")
+// ExpectUserIDParam2 sets up expected param userID for UserService.SetAllowedIPs
+func (mmSetAllowedIPs *mUserServiceMockSetAllowedIPs) ExpectUserIDParam2(userID string) *mUserServiceMockSetAllowedIPs {
+	if mmSetAllowedIPs.mock.funcSetAllowedIPs != nil {
+		mmSetAllowedIPs.mock.t.Fatalf("UserServiceMock.SetAllowedIPs mock is already set by Set")
 	}
 
-	if len(mmGetUser.expectations) > 0 {
-		mmGetUser.mock.t.Fatalf("Some expectations are already set for the UserService.GetUser method")
+	if mmSetAllowedIPs.defaultExpectation == nil {
+		mmSetAllowedIPs.defaultExpectation = &UserServiceMockSetAllowedIPsExpectation{}
 	}
 
-	mmGetUser.mock.funcGetUser = f
-	mmGetUser.mock.funcGetUserOrigin = minimock.CallerInfo(1)
-	return mmGetUser.mock
+	if mmSetAllowedIPs.defaultExpectation.params != nil {
+		mmSetAllowedIPs.mock.t.Fatalf("UserServiceMock.SetAllowedIPs mock is already set by Expect")
+	}
+
+	if mmSetAllowedIPs.defaultExpectation.paramPtrs == nil {
+		mmSetAllowedIPs.defaultExpectation.paramPtrs = &UserServiceMockSetAllowedIPsParamPtrs{}
+	}
+	mmSetAllowedIPs.defaultExpectation.paramPtrs.userID = &userID
+	mmSetAllowedIPs.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmSetAllowedIPs
 }
 
-// When sets expectation for the UserService.GetUser which will trigger the result defined by the following
+// ExpectIpParam3 sets up expected param ip for UserService.SetAllowedIPs
+func (mmSetAllowedIPs *mUserServiceMockSetAllowedIPs) ExpectIpParam3(ip string) *mUserServiceMockSetAllowedIPs {
+	if mmSetAllowedIPs.mock.funcSetAllowedIPs != nil {
+		mmSetAllowedIPs.mock.t.Fatalf("UserServiceMock.SetAllowedIPs mock is already set by Set")
+	}
+
+	if mmSetAllowedIPs.defaultExpectation == nil {
+		mmSetAllowedIPs.defaultExpectation = &UserServiceMockSetAllowedIPsExpectation{}
+	}
+
+	if mmSetAllowedIPs.defaultExpectation.params != nil {
+		mmSetAllowedIPs.mock.t.Fatalf("UserServiceMock.SetAllowedIPs mock is already set by Expect")
+	}
+
+	if mmSetAllowedIPs.defaultExpectation.paramPtrs == nil {
+		mmSetAllowedIPs.defaultExpectation.paramPtrs = &UserServiceMockSetAllowedIPsParamPtrs{}
+	}
+	mmSetAllowedIPs.defaultExpectation.paramPtrs.ip = &ip
+	mmSetAllowedIPs.defaultExpectation.expectationOrigins.originIp = minimock.CallerInfo(1)
+
+	return mmSetAllowedIPs
+}
+
+// ExpectAllowedIPsParam4 sets up expected param allowedIPs for UserService.SetAllowedIPs
+func (mmSetAllowedIPs *mUserServiceMockSetAllowedIPs) ExpectAllowedIPsParam4(allowedIPs []string) *mUserServiceMockSetAllowedIPs {
+	if mmSetAllowedIPs.mock.funcSetAllowedIPs != nil {
+		mmSetAllowedIPs.mock.t.Fatalf("UserServiceMock.SetAllowedIPs mock is already set by Set")
+	}
+
+	if mmSetAllowedIPs.defaultExpectation == nil {
+		mmSetAllowedIPs.defaultExpectation = &UserServiceMockSetAllowedIPsExpectation{}
+	}
+
+	if mmSetAllowedIPs.defaultExpectation.params != nil {
+		mmSetAllowedIPs.mock.t.Fatalf("UserServiceMock.SetAllowedIPs mock is already set by Expect")
+	}
+
+	if mmSetAllowedIPs.defaultExpectation.paramPtrs == nil {
+		mmSetAllowedIPs.defaultExpectation.paramPtrs = &UserServiceMockSetAllowedIPsParamPtrs{}
+	}
+	mmSetAllowedIPs.defaultExpectation.paramPtrs.allowedIPs = &allowedIPs
+	mmSetAllowedIPs.defaultExpectation.expectationOrigins.originAllowedIPs = minimock.CallerInfo(1)
+
+	return mmSetAllowedIPs
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserService.SetAllowedIPs
+func (mmSetAllowedIPs *mUserServiceMockSetAllowedIPs) Inspect(f func(ctx context.Context, userID string, ip string, allowedIPs []string)) *mUserServiceMockSetAllowedIPs {
+	if mmSetAllowedIPs.mock.inspectFuncSetAllowedIPs != nil {
+		mmSetAllowedIPs.mock.t.Fatalf("Inspect function is already set for UserServiceMock.SetAllowedIPs")
+	}
+
+	mmSetAllowedIPs.mock.inspectFuncSetAllowedIPs = f
+
+	return mmSetAllowedIPs
+}
+
+// Return sets up results that will be returned by UserService.SetAllowedIPs
+func (mmSetAllowedIPs *mUserServiceMockSetAllowedIPs) Return(sa1 []string, err error) *UserServiceMock {
+	if mmSetAllowedIPs.mock.funcSetAllowedIPs != nil {
+		mmSetAllowedIPs.mock.t.Fatalf("UserServiceMock.SetAllowedIPs mock is already set by Set")
+	}
+
+	if mmSetAllowedIPs.defaultExpectation == nil {
+		mmSetAllowedIPs.defaultExpectation = &UserServiceMockSetAllowedIPsExpectation{mock: mmSetAllowedIPs.mock}
+	}
+	mmSetAllowedIPs.defaultExpectation.results = &UserServiceMockSetAllowedIPsResults{sa1, err}
+	mmSetAllowedIPs.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSetAllowedIPs.mock
+}
+
+// Set uses given function f to mock the UserService.SetAllowedIPs method
+func (mmSetAllowedIPs *mUserServiceMockSetAllowedIPs) Set(f func(ctx context.Context, userID string, ip string, allowedIPs []string) (sa1 []string, err error)) *UserServiceMock {
+	if mmSetAllowedIPs.defaultExpectation != nil {
+		mmSetAllowedIPs.mock.t.Fatalf("Default expectation is already set for the UserService.SetAllowedIPs method")
+	}
+
+	if len(mmSetAllowedIPs.expectations) > 0 {
+		mmSetAllowedIPs.mock.t.Fatalf("Some expectations are already set for the UserService.SetAllowedIPs method")
+	}
+
+	mmSetAllowedIPs.mock.funcSetAllowedIPs = f
+	mmSetAllowedIPs.mock.funcSetAllowedIPsOrigin = minimock.CallerInfo(1)
+	return mmSetAllowedIPs.mock
+}
+
+// When sets expectation for the UserService.SetAllowedIPs which will trigger the result defined by the following
 // Then helper
-func (mmGetUser *mUserServiceMockGetUser) When(ctx context.Context, userID string) *UserServiceMockGetUserExpectation {
-	if mmGetUser.mock.funcGetUser != nil {
-		mmGetUser.mock.t.Fatalf("UserServiceMock.GetUser mock is already set by Set")
+func (mmSetAllowedIPs *mUserServiceMockSetAllowedIPs) When(ctx context.Context, userID string, ip string, allowedIPs []string) *UserServiceMockSetAllowedIPsExpectation {
+	if mmSetAllowedIPs.mock.funcSetAllowedIPs != nil {
+		mmSetAllowedIPs.mock.t.Fatalf("UserServiceMock.SetAllowedIPs mock is already set by Set")
 	}
 
-	expectation := &UserServiceMockGetUserExpectation{
-		mock:               mmGetUser.mock,
-		params:             &UserServiceMockGetUserParams{ctx, userID},
-		expectationOrigins: UserServiceMockGetUserExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &UserServiceMockSetAllowedIPsExpectation{
+		mock:               mmSetAllowedIPs.mock,
+		params:             &UserServiceMockSetAllowedIPsParams{ctx, userID, ip, allowedIPs},
+		expectationOrigins: UserServiceMockSetAllowedIPsExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmGetUser.expectations = append(mmGetUser.expectations, expectation)
+	mmSetAllowedIPs.expectations = append(mmSetAllowedIPs.expectations, expectation)
 	return expectation
 }
 
-// Then sets up UserService.GetUser return parameters for the expectation previously defined by the When method
-func (e *UserServiceMockGetUserExpectation) Then(up1 *models.User, err error) *UserServiceMock {
-	e.results = &UserServiceMockGetUserResults{up1, err}
+// Then sets up UserService.SetAllowedIPs return parameters for the expectation previously defined by the When method
+func (e *UserServiceMockSetAllowedIPsExpectation) Then(sa1 []string, err error) *UserServiceMock {
+	e.results = &UserServiceMockSetAllowedIPsResults{sa1, err}
 	return e.mock
 }
 
-// Times sets number of times UserService.GetUser should be invoked
-func (mmGetUser *mUserServiceMockGetUser) Times(n uint64) *mUserServiceMockGetUser {
+// Times sets number of times UserService.SetAllowedIPs should be invoked
+func (mmSetAllowedIPs *mUserServiceMockSetAllowedIPs) Times(n uint64) *mUserServiceMockSetAllowedIPs {
 	if n == 0 {
-		mmGetUser.mock.t.Fatalf("Times of UserServiceMock.GetUser mock can not be zero")
+		mmSetAllowedIPs.mock.t.Fatalf("Times of UserServiceMock.SetAllowedIPs mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmGetUser.expectedInvocations, n)
-	mmGetUser.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmGetUser
+	mm_atomic.StoreUint64(&mmSetAllowedIPs.expectedInvocations, n)
+	mmSetAllowedIPs.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSetAllowedIPs
 }
 
-func (mmGetUser *mUserServiceMockGetUser) invocationsDone() bool {
-	if len(mmGetUser.expectations) == 0 && mmGetUser.defaultExpectation == nil && mmGetUser.mock.funcGetUser == nil {
+func (mmSetAllowedIPs *mUserServiceMockSetAllowedIPs) invocationsDone() bool {
+	if len(mmSetAllowedIPs.expectations) == 0 && mmSetAllowedIPs.defaultExpectation == nil && mmSetAllowedIPs.mock.funcSetAllowedIPs == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmGetUser.mock.afterGetUserCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmGetUser.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmSetAllowedIPs.mock.afterSetAllowedIPsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSetAllowedIPs.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// GetUser implements UserService
-func (mmGetUser *UserServiceMock) GetUser(ctx context.Context, userID string) (up1 *models.User, err error) {
-	mm_atomic.AddUint64(&mmGetUser.beforeGetUserCounter, 1)
-	defer mm_atomic.AddUint64(&mmGetUser.afterGetUserCounter, 1)
+// SetAllowedIPs implements UserService
+func (mmSetAllowedIPs *UserServiceMock) SetAllowedIPs(ctx context.Context, userID string, ip string, allowedIPs []string) (sa1 []string, err error) {
+	mm_atomic.AddUint64(&mmSetAllowedIPs.beforeSetAllowedIPsCounter, 1)
+	defer mm_atomic.AddUint64(&mmSetAllowedIPs.afterSetAllowedIPsCounter, 1)
 
-	mmGetUser.t.Helper()
+	mmSetAllowedIPs.t.Helper()
 
-	if mmGetUser.inspectFuncGetUser != nil {
-		mmGetUser.inspectFuncGetUser(ctx, userID)
+	if mmSetAllowedIPs.inspectFuncSetAllowedIPs != nil {
+		mmSetAllowedIPs.inspectFuncSetAllowedIPs(ctx, userID, ip, allowedIPs)
 	}
 
-	mm_params := UserServiceMockGetUserParams{ctx, userID}
+	mm_params := UserServiceMockSetAllowedIPsParams{ctx, userID, ip, allowedIPs}
 
 	// Record call args
-	mmGetUser.GetUserMock.mutex.Lock()
-	mmGetUser.GetUserMock.callArgs = append(mmGetUser.GetUserMock.callArgs, &mm_params)
-	mmGetUser.GetUserMock.mutex.Unlock()
+	mmSetAllowedIPs.SetAllowedIPsMock.mutex.Lock()
+	mmSetAllowedIPs.SetAllowedIPsMock.callArgs = append(mmSetAllowedIPs.SetAllowedIPsMock.callArgs, &mm_params)
+	mmSetAllowedIPs.SetAllowedIPsMock.mutex.Unlock()
 
-	for _, e := range mmGetUser.GetUserMock.expectations {
+	for _, e := range mmSetAllowedIPs.SetAllowedIPsMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.up1, e.results.err
+			return e.results.sa1, e.results.err
 		}
 	}
 
-	if mmGetUser.GetUserMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmGetUser.GetUserMock.defaultExpectation.Counter, 1)
-		mm_want := mmGetUser.GetUserMock.defaultExpectation.params
-		mm_want_ptrs := mmGetUser.GetUserMock.defaultExpectation.paramPtrs
+	if mmSetAllowedIPs.SetAllowedIPsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSetAllowedIPs.SetAllowedIPsMock.defaultExpectation.Counter, 1)
+		mm_want := mmSetAllowedIPs.SetAllowedIPsMock.defaultExpectation.params
+		mm_want_ptrs := mmSetAllowedIPs.SetAllowedIPsMock.defaultExpectation.paramPtrs
 
-		mm_got := UserServiceMockGetUserParams{ctx, userID}
+		mm_got := UserServiceMockSetAllowedIPsParams{ctx, userID, ip, allowedIPs}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmGetUser.t.Errorf("UserServiceMock.GetUser got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGetUser.GetUserMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmSetAllowedIPs.t.Errorf("UserServiceMock.SetAllowedIPs got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetAllowedIPs.SetAllowedIPsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
 			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
-				mmGetUser.t.Errorf("UserServiceMock.GetUser got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGetUser.GetUserMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+				mmSetAllowedIPs.t.Errorf("UserServiceMock.SetAllowedIPs got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetAllowedIPs.SetAllowedIPsMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.ip != nil && !minimock.Equal(*mm_want_ptrs.ip, mm_got.ip) {
+				mmSetAllowedIPs.t.Errorf("UserServiceMock.SetAllowedIPs got unexpected parameter ip, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetAllowedIPs.SetAllowedIPsMock.defaultExpectation.expectationOrigins.originIp, *mm_want_ptrs.ip, mm_got.ip, minimock.Diff(*mm_want_ptrs.ip, mm_got.ip))
+			}
+
+			if mm_want_ptrs.allowedIPs != nil && !minimock.Equal(*mm_want_ptrs.allowedIPs, mm_got.allowedIPs) {
+				mmSetAllowedIPs.t.Errorf("UserServiceMock.SetAllowedIPs got unexpected parameter allowedIPs, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetAllowedIPs.SetAllowedIPsMock.defaultExpectation.expectationOrigins.originAllowedIPs, *mm_want_ptrs.allowedIPs, mm_got.allowedIPs, minimock.Diff(*mm_want_ptrs.allowedIPs, mm_got.allowedIPs))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmGetUser.t.Errorf("UserServiceMock.GetUser got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmGetUser.GetUserMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmSetAllowedIPs.t.Errorf("UserServiceMock.SetAllowedIPs got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSetAllowedIPs.SetAllowedIPsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmGetUser.GetUserMock.defaultExpectation.results
+		mm_results := mmSetAllowedIPs.SetAllowedIPsMock.defaultExpectation.results
 		if mm_results == nil {
-			mmGetUser.t.Fatal("No results are set for the UserServiceMock.GetUser")
+			mmSetAllowedIPs.t.Fatal("No results are set for the UserServiceMock.SetAllowedIPs")
 		}
-		return (*mm_results).up1, (*mm_results).err
+		return (*mm_results).sa1, (*mm_results).err
 	}
-	if mmGetUser.funcGetUser != nil {
-		return mmGetUser.funcGetUser(ctx, userID)
+	if mmSetAllowedIPs.funcSetAllowedIPs != nil {
+		return mmSetAllowedIPs.funcSetAllowedIPs(ctx, userID, ip, allowedIPs)
 	}
-	mmGetUser.t.Fatalf("Unexpected call to UserServiceMock.GetUser. %v %v", ctx, userID)
+	mmSetAllowedIPs.t.Fatalf("Unexpected call to UserServiceMock.SetAllowedIPs. %v %v %v %v", ctx, userID, ip, allowedIPs)
 	return
 }
 
-// GetUserAfterCounter returns a count of finished UserServiceMock.GetUser invocations
-func (mmGetUser *UserServiceMock) GetUserAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetUser.afterGetUserCounter)
+// SetAllowedIPsAfterCounter returns a count of finished UserServiceMock.SetAllowedIPs invocations
+func (mmSetAllowedIPs *UserServiceMock) SetAllowedIPsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetAllowedIPs.afterSetAllowedIPsCounter)
 }
 
-// GetUserBeforeCounter returns a count of UserServiceMock.GetUser invocations
-func (mmGetUser *UserServiceMock) GetUserBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetUser.beforeGetUserCounter)
+// SetAllowedIPsBeforeCounter returns a count of UserServiceMock.SetAllowedIPs invocations
+func (mmSetAllowedIPs *UserServiceMock) SetAllowedIPsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetAllowedIPs.beforeSetAllowedIPsCounter)
 }
 
-// Calls returns a list of arguments used in each call to UserServiceMock.GetUser.
+// Calls returns a list of arguments used in each call to UserServiceMock.SetAllowedIPs.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmGetUser *mUserServiceMockGetUser) Calls() []*UserServiceMockGetUserParams {
-	mmGetUser.mutex.RLock()
+func (mmSetAllowedIPs *mUserServiceMockSetAllowedIPs) Calls() []*UserServiceMockSetAllowedIPsParams {
+	mmSetAllowedIPs.mutex.RLock()
 
-	argCopy := make([]*UserServiceMockGetUserParams, len(mmGetUser.callArgs))
-	copy(argCopy, mmGetUser.callArgs)
+	argCopy := make([]*UserServiceMockSetAllowedIPsParams, len(mmSetAllowedIPs.callArgs))
+	copy(argCopy, mmSetAllowedIPs.callArgs)
 
-	mmGetUser.mutex.RUnlock()
+	mmSetAllowedIPs.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockGetUserDone returns true if the count of the GetUser invocations corresponds
+// MinimockSetAllowedIPsDone returns true if the count of the SetAllowedIPs invocations corresponds
 // the number of defined expectations
-func (m *UserServiceMock) MinimockGetUserDone() bool {
-	if m.GetUserMock.optional {
+func (m *UserServiceMock) MinimockSetAllowedIPsDone() bool {
+	if m.SetAllowedIPsMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.GetUserMock.expectations {
+	for _, e := range m.SetAllowedIPsMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.GetUserMock.invocationsDone()
+	return m.SetAllowedIPsMock.invocationsDone()
 }
 
-// MinimockGetUserInspect logs each unmet expectation
-func (m *UserServiceMock) MinimockGetUserInspect() {
-	for _, e := range m.GetUserMock.expectations {
+// MinimockSetAllowedIPsInspect logs each unmet expectation
+func (m *UserServiceMock) MinimockSetAllowedIPsInspect() {
+	for _, e := range m.SetAllowedIPsMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to UserServiceMock.GetUser at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to UserServiceMock.SetAllowedIPs at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterGetUserCounter := mm_atomic.LoadUint64(&m.afterGetUserCounter)
+	afterSetAllowedIPsCounter := mm_atomic.LoadUint64(&m.afterSetAllowedIPsCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.GetUserMock.defaultExpectation != nil && afterGetUserCounter < 1 {
-		if m.GetUserMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to UserServiceMock.GetUser at\n%s", m.GetUserMock.defaultExpectation.returnOrigin)
+	if m.SetAllowedIPsMock.defaultExpectation != nil && afterSetAllowedIPsCounter < 1 {
+		if m.SetAllowedIPsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UserServiceMock.SetAllowedIPs at\n%s", m.SetAllowedIPsMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to UserServiceMock.GetUser at\n%s with params: %#v", m.GetUserMock.defaultExpectation.expectationOrigins.origin, *m.GetUserMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to UserServiceMock.SetAllowedIPs at\n%s with params: %#v", m.SetAllowedIPsMock.defaultExpectation.expectationOrigins.origin, *m.SetAllowedIPsMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcGetUser != nil && afterGetUserCounter < 1 {
-		m.t.Errorf("Expected call to UserServiceMock.GetUser at\n%s", m.funcGetUserOrigin)
+	if m.funcSetAllowedIPs != nil && afterSetAllowedIPsCounter < 1 {
+		m.t.Errorf("Expected call to UserServiceMock.SetAllowedIPs at\n%s", m.funcSetAllowedIPsOrigin)
 	}
 
-	if !m.GetUserMock.invocationsDone() && afterGetUserCounter > 0 {
-		m.t.Errorf("Expected %d calls to UserServiceMock.GetUser at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.GetUserMock.expectedInvocations), m.GetUserMock.expectedInvocationsOrigin, afterGetUserCounter)
+	if !m.SetAllowedIPsMock.invocationsDone() && afterSetAllowedIPsCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserServiceMock.SetAllowedIPs at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SetAllowedIPsMock.expectedInvocations), m.SetAllowedIPsMock.expectedInvocationsOrigin, afterSetAllowedIPsCounter)
 	}
 }
 
@@ -742,9 +3461,23 @@ func (m *UserServiceMock) MinimockGetUserInspect() {
 func (m *UserServiceMock) MinimockFinish() {
 	m.finishOnce.Do(func() {
 		if !m.minimockDone() {
+			m.MinimockAuthenticatePersonalAccessTokenInspect()
+
+			m.MinimockCreatePersonalAccessTokenInspect()
+
+			m.MinimockDeletePersonalAccessTokenInspect()
+
+			m.MinimockDeleteSessionInspect()
+
 			m.MinimockDeleteUserInspect()
 
 			m.MinimockGetUserInspect()
+
+			m.MinimockListPersonalAccessTokensInspect()
+
+			m.MinimockListSessionsInspect()
+
+			m.MinimockSetAllowedIPsInspect()
 		}
 	})
 }
@@ -768,6 +3501,13 @@ func (m *UserServiceMock) MinimockWait(timeout mm_time.Duration) {
 func (m *UserServiceMock) minimockDone() bool {
 	done := true
 	return done &&
+		m.MinimockAuthenticatePersonalAccessTokenDone() &&
+		m.MinimockCreatePersonalAccessTokenDone() &&
+		m.MinimockDeletePersonalAccessTokenDone() &&
+		m.MinimockDeleteSessionDone() &&
 		m.MinimockDeleteUserDone() &&
-		m.MinimockGetUserDone()
+		m.MinimockGetUserDone() &&
+		m.MinimockListPersonalAccessTokensDone() &&
+		m.MinimockListSessionsDone() &&
+		m.MinimockSetAllowedIPsDone()
 }
@@ -56,6 +56,26 @@ func TestGetMe(t *testing.T) {
 			wantStatus: http.StatusUnauthorized,
 			wantError:  apperrors.ErrUnauthorized.Error(),
 		},
+		{
+			name: "client token",
+			claims: &models.Claims{
+				ClientID:      "client123",
+				PrincipalType: models.PrincipalClient,
+			},
+			mockSetup:  func(ctx context.Context) {},
+			wantStatus: http.StatusForbidden,
+			wantError:  apperrors.ErrClientPrincipal.Error(),
+		},
+		{
+			name: "service account key",
+			claims: &models.Claims{
+				ID:            "account123",
+				PrincipalType: models.PrincipalServiceAccount,
+			},
+			mockSetup:  func(ctx context.Context) {},
+			wantStatus: http.StatusForbidden,
+			wantError:  apperrors.ErrClientPrincipal.Error(),
+		},
 		{
 			name: "user not found",
 			claims: &models.Claims{
@@ -87,6 +107,7 @@ func TestGetMe(t *testing.T) {
 			ctx := context.Background()
 			if tt.claims != nil {
 				ctx = context.WithValue(ctx, middleware.UserContextKey, tt.claims)
+				ctx = context.WithValue(ctx, middleware.PrincipalContextKey, tt.claims.Principal())
 			}
 
 			tt.mockSetup(ctx)
@@ -0,0 +1,117 @@
+package handlers
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/alonsoF100/authorization-service/internal/apperrors"
+	"github.com/alonsoF100/authorization-service/internal/transport/http/dto"
+	"github.com/alonsoF100/authorization-service/internal/transport/http/help"
+	"github.com/alonsoF100/authorization-service/internal/transport/http/middleware"
+)
+
+/*
+pattern: /auth/verify
+method: GET
+info: forward auth for nginx auth_request and Traefik ForwardAuth, barer
+token from header or the token cookie, required roles from the roles query
+parameter and the required roles header, comma separated
+
+succeed:
+
+	-status code: 200 ok
+	-response headers: user id, email, nickname and comma separated roles
+
+failed:
+
+	-status code: 401 unauthorized, 403 forbidden
+	-response body: JSON with error message + timestamp
+*/
+func (h Handler) Verify(w http.ResponseWriter, r *http.Request) {
+	const op = "handlers/verify.go/Verify"
+
+	cfg := h.Cfg.ForwardAuth
+
+	token := middleware.ExtractToken(r)
+	if token == "" && cfg.CookieName != "" {
+		if cookie, err := r.Cookie(cfg.CookieName); err == nil {
+			token = cookie.Value
+		}
+	}
+	if token == "" {
+		slog.Debug("Forward auth failed: missing token",
+			slog.String("op", op),
+		)
+		help.WriteJSON(w, http.StatusUnauthorized, dto.NewErrorResponse(middleware.ErrNoAuthHeader))
+		return
+	}
+
+	claims, err := h.Verifier.ValidateJWT(r.Context(), token)
+	if err != nil {
+		slog.Debug("Forward auth failed: invalid token",
+			slog.String("op", op),
+			slog.String("error", err.Error()),
+		)
+		help.WriteJSON(w, http.StatusUnauthorized, dto.NewErrorResponse(apperrors.ErrInvalidToken))
+		return
+	}
+
+	if err := h.Verifier.CheckAllowedIP(r.Context(), claims, help.ClientIP(r)); err != nil {
+		slog.Debug("Forward auth failed: address not allowed",
+			slog.String("op", op),
+			slog.String("user_id", claims.ID),
+			slog.String("error", err.Error()),
+		)
+		if errors.Is(err, apperrors.ErrIPNotAllowed) {
+			help.WriteJSON(w, http.StatusForbidden, dto.NewErrorResponse(apperrors.ErrIPNotAllowed))
+			return
+		}
+		help.WriteJSON(w, http.StatusUnauthorized, dto.NewErrorResponse(apperrors.ErrInvalidToken))
+		return
+	}
+
+	for _, role := range requiredRoles(r, cfg.RequiredRolesHeader) {
+		if !slices.Contains(claims.Roles, role) {
+			slog.Debug("Forward auth failed: missing role",
+				slog.String("op", op),
+				slog.String("user_id", claims.ID),
+				slog.String("role", role),
+			)
+			help.WriteJSON(w, http.StatusForbidden, dto.NewErrorResponse(apperrors.ErrMissingRole))
+			return
+		}
+	}
+
+	setHeader(w, cfg.Headers.UserID, claims.ID)
+	setHeader(w, cfg.Headers.Email, claims.Email)
+	setHeader(w, cfg.Headers.Nickname, claims.Nickname)
+	setHeader(w, cfg.Headers.Roles, strings.Join(claims.Roles, ","))
+	w.WriteHeader(http.StatusOK)
+}
+
+func requiredRoles(r *http.Request, header string) []string {
+	values := r.URL.Query()["roles"]
+	if header != "" {
+		values = append(values, r.Header.Values(header)...)
+	}
+
+	var roles []string
+	for _, value := range values {
+		for role := range strings.SplitSeq(value, ",") {
+			if role = strings.TrimSpace(role); role != "" {
+				roles = append(roles, role)
+			}
+		}
+	}
+
+	return roles
+}
+
+func setHeader(w http.ResponseWriter, name, value string) {
+	if name != "" {
+		w.Header().Set(name, value)
+	}
+}
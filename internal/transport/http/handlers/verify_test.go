@@ -0,0 +1,182 @@
+package handlers_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/alonsoF100/authorization-service/internal/apperrors"
+	"github.com/alonsoF100/authorization-service/internal/config"
+	"github.com/alonsoF100/authorization-service/internal/models"
+	"github.com/alonsoF100/authorization-service/internal/transport/http/dto"
+	"github.com/alonsoF100/authorization-service/internal/transport/http/handlers"
+	"github.com/alonsoF100/authorization-service/internal/transport/http/middleware"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerify(t *testing.T) {
+	mc := minimock.NewController(t)
+	mockVerifier := handlers.NewAuthServiceMock(mc)
+
+	cfg := &config.Config{
+		ForwardAuth: config.ForwardAuthConfig{
+			Headers: config.ForwardAuthHeaders{
+				UserID:   "X-User-Id",
+				Email:    "X-User-Email",
+				Nickname: "",
+				Roles:    "X-User-Roles",
+			},
+			RequiredRolesHeader: "X-Required-Roles",
+			CookieName:          "access_token",
+		},
+	}
+	h := handlers.New(mockVerifier, nil, nil, nil, mockVerifier, cfg)
+
+	claims := &models.Claims{
+		ID:       "user123",
+		Email:    "alonso@mail.ru",
+		Nickname: "alonso",
+		Roles:    []string{models.RoleAdmin, "support"},
+	}
+
+	tests := []struct {
+		name        string
+		url         string
+		setup       func(req *http.Request)
+		mockSetup   func()
+		wantStatus  int
+		wantError   error
+		wantHeaders map[string]string
+	}{
+		{
+			name: "bearer token",
+			url:  "/auth/verify",
+			setup: func(req *http.Request) {
+				req.Header.Set("Authorization", "Bearer token")
+			},
+			mockSetup: func() {
+				mockVerifier.ValidateJWTMock.Expect(context.Background(), "token").Return(claims, nil)
+				mockVerifier.CheckAllowedIPMock.Expect(context.Background(), claims, "192.0.2.1").Return(nil)
+			},
+			wantStatus: http.StatusOK,
+			wantHeaders: map[string]string{
+				"X-User-Id":       "user123",
+				"X-User-Email":    "alonso@mail.ru",
+				"X-User-Nickname": "",
+				"X-User-Roles":    "admin,support",
+			},
+		},
+		{
+			name: "token cookie",
+			url:  "/auth/verify",
+			setup: func(req *http.Request) {
+				req.AddCookie(&http.Cookie{Name: "access_token", Value: "cookie_token"})
+			},
+			mockSetup: func() {
+				mockVerifier.ValidateJWTMock.Expect(context.Background(), "cookie_token").Return(claims, nil)
+				mockVerifier.CheckAllowedIPMock.Expect(context.Background(), claims, "192.0.2.1").Return(nil)
+			},
+			wantStatus:  http.StatusOK,
+			wantHeaders: map[string]string{"X-User-Id": "user123"},
+		},
+		{
+			name:       "missing token",
+			url:        "/auth/verify",
+			setup:      func(req *http.Request) {},
+			mockSetup:  func() {},
+			wantStatus: http.StatusUnauthorized,
+			wantError:  middleware.ErrNoAuthHeader,
+		},
+		{
+			name: "invalid token",
+			url:  "/auth/verify",
+			setup: func(req *http.Request) {
+				req.Header.Set("Authorization", "Bearer invalid")
+			},
+			mockSetup: func() {
+				mockVerifier.ValidateJWTMock.Expect(context.Background(), "invalid").Return(nil, apperrors.ErrInvalidToken)
+			},
+			wantStatus: http.StatusUnauthorized,
+			wantError:  apperrors.ErrInvalidToken,
+		},
+		{
+			name: "address not allowed",
+			url:  "/auth/verify",
+			setup: func(req *http.Request) {
+				req.Header.Set("Authorization", "Bearer token")
+			},
+			mockSetup: func() {
+				mockVerifier.ValidateJWTMock.Expect(context.Background(), "token").Return(claims, nil)
+				mockVerifier.CheckAllowedIPMock.Expect(context.Background(), claims, "192.0.2.1").Return(apperrors.ErrIPNotAllowed)
+			},
+			wantStatus: http.StatusForbidden,
+			wantError:  apperrors.ErrIPNotAllowed,
+		},
+		{
+			name: "required roles present",
+			url:  "/auth/verify?roles=admin",
+			setup: func(req *http.Request) {
+				req.Header.Set("Authorization", "Bearer token")
+				req.Header.Set("X-Required-Roles", "support, admin")
+			},
+			mockSetup: func() {
+				mockVerifier.ValidateJWTMock.Expect(context.Background(), "token").Return(claims, nil)
+				mockVerifier.CheckAllowedIPMock.Expect(context.Background(), claims, "192.0.2.1").Return(nil)
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name: "required role from query missing",
+			url:  "/auth/verify?roles=admin,billing",
+			setup: func(req *http.Request) {
+				req.Header.Set("Authorization", "Bearer token")
+			},
+			mockSetup: func() {
+				mockVerifier.ValidateJWTMock.Expect(context.Background(), "token").Return(claims, nil)
+				mockVerifier.CheckAllowedIPMock.Expect(context.Background(), claims, "192.0.2.1").Return(nil)
+			},
+			wantStatus: http.StatusForbidden,
+			wantError:  apperrors.ErrMissingRole,
+		},
+		{
+			name: "required role from header missing",
+			url:  "/auth/verify",
+			setup: func(req *http.Request) {
+				req.Header.Set("Authorization", "Bearer token")
+				req.Header.Set("X-Required-Roles", "billing")
+			},
+			mockSetup: func() {
+				mockVerifier.ValidateJWTMock.Expect(context.Background(), "token").Return(claims, nil)
+				mockVerifier.CheckAllowedIPMock.Expect(context.Background(), claims, "192.0.2.1").Return(nil)
+			},
+			wantStatus: http.StatusForbidden,
+			wantError:  apperrors.ErrMissingRole,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockSetup()
+
+			req := httptest.NewRequest(http.MethodGet, tt.url, nil)
+			tt.setup(req)
+			rr := httptest.NewRecorder()
+
+			h.Verify(rr, req)
+
+			require.Equal(t, tt.wantStatus, rr.Code)
+			for name, value := range tt.wantHeaders {
+				require.Equal(t, value, rr.Header().Get(name), name)
+			}
+
+			if tt.wantError != nil {
+				var resp dto.ErrorResponse
+				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+				require.Equal(t, tt.wantError.Error(), resp.Error)
+			}
+		})
+	}
+}
@@ -1,8 +1,10 @@
 package help
 
 import (
+	"context"
 	"encoding/json"
 	"log/slog"
+	"net"
 	"net/http"
 )
 
@@ -13,3 +15,33 @@ func WriteJSON(w http.ResponseWriter, statusCode int, data any) {
 		slog.Debug("Failed to encode", "data", data, "error", err)
 	}
 }
+
+type contextKey string
+
+const clientIPContextKey contextKey = "client_ip"
+
+// WithClientIP stores the client address resolved by middleware.RealIP
+func WithClientIP(ctx context.Context, ip string) context.Context {
+	return context.WithValue(ctx, clientIPContextKey, ip)
+}
+
+// ClientIP returns the client address resolved by middleware.RealIP, the
+// peer address when the request didn't pass it
+func ClientIP(r *http.Request) string {
+	if ip, ok := r.Context().Value(clientIPContextKey).(string); ok {
+		return ip
+	}
+
+	return PeerIP(r)
+}
+
+// PeerIP returns the address of the peer without the port, behind a proxy
+// that is the proxy
+func PeerIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+
+	return host
+}
@@ -1,6 +1,7 @@
 package help_test
 
 import (
+	"net/http"
 	"net/http/httptest"
 	"testing"
 
@@ -69,3 +70,14 @@ func TestWriteJSON(t *testing.T) {
 		require.Equal(t, "application/json", w.Header().Get("Content-Type"))
 	})
 }
+
+func TestClientIP(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.RemoteAddr = "10.0.0.1:1234"
+
+	require.Equal(t, "10.0.0.1", help.ClientIP(req))
+
+	req = req.WithContext(help.WithClientIP(req.Context(), "198.51.100.7"))
+	require.Equal(t, "198.51.100.7", help.ClientIP(req))
+	require.Equal(t, "10.0.0.1", help.PeerIP(req))
+}
@@ -0,0 +1,418 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package middleware
+
+//go:generate minimock -i github.com/alonsoF100/authorization-service/internal/transport/http/middleware.APIKeyAuthenticator -o api_key_authenticator_mock_test.go -n APIKeyAuthenticatorMock -p middleware
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/alonsoF100/authorization-service/internal/models"
+	"github.com/gojuno/minimock/v3"
+)
+
+// APIKeyAuthenticatorMock implements APIKeyAuthenticator
+type APIKeyAuthenticatorMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcAuthenticateAPIKey          func(ctx context.Context, key string) (cp1 *models.Claims, err error)
+	funcAuthenticateAPIKeyOrigin    string
+	inspectFuncAuthenticateAPIKey   func(ctx context.Context, key string)
+	afterAuthenticateAPIKeyCounter  uint64
+	beforeAuthenticateAPIKeyCounter uint64
+	AuthenticateAPIKeyMock          mAPIKeyAuthenticatorMockAuthenticateAPIKey
+}
+
+// NewAPIKeyAuthenticatorMock returns a mock for APIKeyAuthenticator
+func NewAPIKeyAuthenticatorMock(t minimock.Tester) *APIKeyAuthenticatorMock {
+	m := &APIKeyAuthenticatorMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.AuthenticateAPIKeyMock = mAPIKeyAuthenticatorMockAuthenticateAPIKey{mock: m}
+	m.AuthenticateAPIKeyMock.callArgs = []*APIKeyAuthenticatorMockAuthenticateAPIKeyParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mAPIKeyAuthenticatorMockAuthenticateAPIKey struct {
+	optional           bool
+	mock               *APIKeyAuthenticatorMock
+	defaultExpectation *APIKeyAuthenticatorMockAuthenticateAPIKeyExpectation
+	expectations       []*APIKeyAuthenticatorMockAuthenticateAPIKeyExpectation
+
+	callArgs []*APIKeyAuthenticatorMockAuthenticateAPIKeyParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// APIKeyAuthenticatorMockAuthenticateAPIKeyExpectation specifies expectation struct of the APIKeyAuthenticator.AuthenticateAPIKey
+type APIKeyAuthenticatorMockAuthenticateAPIKeyExpectation struct {
+	mock               *APIKeyAuthenticatorMock
+	params             *APIKeyAuthenticatorMockAuthenticateAPIKeyParams
+	paramPtrs          *APIKeyAuthenticatorMockAuthenticateAPIKeyParamPtrs
+	expectationOrigins APIKeyAuthenticatorMockAuthenticateAPIKeyExpectationOrigins
+	results            *APIKeyAuthenticatorMockAuthenticateAPIKeyResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// APIKeyAuthenticatorMockAuthenticateAPIKeyParams contains parameters of the APIKeyAuthenticator.AuthenticateAPIKey
+type APIKeyAuthenticatorMockAuthenticateAPIKeyParams struct {
+	ctx context.Context
+	key string
+}
+
+// APIKeyAuthenticatorMockAuthenticateAPIKeyParamPtrs contains pointers to parameters of the APIKeyAuthenticator.AuthenticateAPIKey
+type APIKeyAuthenticatorMockAuthenticateAPIKeyParamPtrs struct {
+	ctx *context.Context
+	key *string
+}
+
+// APIKeyAuthenticatorMockAuthenticateAPIKeyResults contains results of the APIKeyAuthenticator.AuthenticateAPIKey
+type APIKeyAuthenticatorMockAuthenticateAPIKeyResults struct {
+	cp1 *models.Claims
+	err error
+}
+
+// APIKeyAuthenticatorMockAuthenticateAPIKeyOrigins contains origins of expectations of the APIKeyAuthenticator.AuthenticateAPIKey
+type APIKeyAuthenticatorMockAuthenticateAPIKeyExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originKey string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmAuthenticateAPIKey *mAPIKeyAuthenticatorMockAuthenticateAPIKey) Optional() *mAPIKeyAuthenticatorMockAuthenticateAPIKey {
+	mmAuthenticateAPIKey.optional = true
+	return mmAuthenticateAPIKey
+}
+
+// Expect sets up expected params for APIKeyAuthenticator.AuthenticateAPIKey
+func (mmAuthenticateAPIKey *mAPIKeyAuthenticatorMockAuthenticateAPIKey) Expect(ctx context.Context, key string) *mAPIKeyAuthenticatorMockAuthenticateAPIKey {
+	if mmAuthenticateAPIKey.mock.funcAuthenticateAPIKey != nil {
+		mmAuthenticateAPIKey.mock.t.Fatalf("APIKeyAuthenticatorMock.AuthenticateAPIKey mock is already set by Set")
+	}
+
+	if mmAuthenticateAPIKey.defaultExpectation == nil {
+		mmAuthenticateAPIKey.defaultExpectation = &APIKeyAuthenticatorMockAuthenticateAPIKeyExpectation{}
+	}
+
+	if mmAuthenticateAPIKey.defaultExpectation.paramPtrs != nil {
+		mmAuthenticateAPIKey.mock.t.Fatalf("APIKeyAuthenticatorMock.AuthenticateAPIKey mock is already set by ExpectParams functions")
+	}
+
+	mmAuthenticateAPIKey.defaultExpectation.params = &APIKeyAuthenticatorMockAuthenticateAPIKeyParams{ctx, key}
+	mmAuthenticateAPIKey.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmAuthenticateAPIKey.expectations {
+		if minimock.Equal(e.params, mmAuthenticateAPIKey.defaultExpectation.params) {
+			mmAuthenticateAPIKey.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAuthenticateAPIKey.defaultExpectation.params)
+		}
+	}
+
+	return mmAuthenticateAPIKey
+}
+
+// ExpectCtxParam1 sets up expected param ctx for APIKeyAuthenticator.AuthenticateAPIKey
+func (mmAuthenticateAPIKey *mAPIKeyAuthenticatorMockAuthenticateAPIKey) ExpectCtxParam1(ctx context.Context) *mAPIKeyAuthenticatorMockAuthenticateAPIKey {
+	if mmAuthenticateAPIKey.mock.funcAuthenticateAPIKey != nil {
+		mmAuthenticateAPIKey.mock.t.Fatalf("APIKeyAuthenticatorMock.AuthenticateAPIKey mock is already set by Set")
+	}
+
+	if mmAuthenticateAPIKey.defaultExpectation == nil {
+		mmAuthenticateAPIKey.defaultExpectation = &APIKeyAuthenticatorMockAuthenticateAPIKeyExpectation{}
+	}
+
+	if mmAuthenticateAPIKey.defaultExpectation.params != nil {
+		mmAuthenticateAPIKey.mock.t.Fatalf("APIKeyAuthenticatorMock.AuthenticateAPIKey mock is already set by Expect")
+	}
+
+	if mmAuthenticateAPIKey.defaultExpectation.paramPtrs == nil {
+		mmAuthenticateAPIKey.defaultExpectation.paramPtrs = &APIKeyAuthenticatorMockAuthenticateAPIKeyParamPtrs{}
+	}
+	mmAuthenticateAPIKey.defaultExpectation.paramPtrs.ctx = &ctx
+	mmAuthenticateAPIKey.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmAuthenticateAPIKey
+}
+
+// ExpectKeyParam2 sets up expected param key for APIKeyAuthenticator.AuthenticateAPIKey
+func (mmAuthenticateAPIKey *mAPIKeyAuthenticatorMockAuthenticateAPIKey) ExpectKeyParam2(key string) *mAPIKeyAuthenticatorMockAuthenticateAPIKey {
+	if mmAuthenticateAPIKey.mock.funcAuthenticateAPIKey != nil {
+		mmAuthenticateAPIKey.mock.t.Fatalf("APIKeyAuthenticatorMock.AuthenticateAPIKey mock is already set by Set")
+	}
+
+	if mmAuthenticateAPIKey.defaultExpectation == nil {
+		mmAuthenticateAPIKey.defaultExpectation = &APIKeyAuthenticatorMockAuthenticateAPIKeyExpectation{}
+	}
+
+	if mmAuthenticateAPIKey.defaultExpectation.params != nil {
+		mmAuthenticateAPIKey.mock.t.Fatalf("APIKeyAuthenticatorMock.AuthenticateAPIKey mock is already set by Expect")
+	}
+
+	if mmAuthenticateAPIKey.defaultExpectation.paramPtrs == nil {
+		mmAuthenticateAPIKey.defaultExpectation.paramPtrs = &APIKeyAuthenticatorMockAuthenticateAPIKeyParamPtrs{}
+	}
+	mmAuthenticateAPIKey.defaultExpectation.paramPtrs.key = &key
+	mmAuthenticateAPIKey.defaultExpectation.expectationOrigins.originKey = minimock.CallerInfo(1)
+
+	return mmAuthenticateAPIKey
+}
+
+// Inspect accepts an inspector function that has same arguments as the APIKeyAuthenticator.AuthenticateAPIKey
+func (mmAuthenticateAPIKey *mAPIKeyAuthenticatorMockAuthenticateAPIKey) Inspect(f func(ctx context.Context, key string)) *mAPIKeyAuthenticatorMockAuthenticateAPIKey {
+	if mmAuthenticateAPIKey.mock.inspectFuncAuthenticateAPIKey != nil {
+		mmAuthenticateAPIKey.mock.t.Fatalf("Inspect function is already set for APIKeyAuthenticatorMock.AuthenticateAPIKey")
+	}
+
+	mmAuthenticateAPIKey.mock.inspectFuncAuthenticateAPIKey = f
+
+	return mmAuthenticateAPIKey
+}
+
+// Return sets up results that will be returned by APIKeyAuthenticator.AuthenticateAPIKey
+func (mmAuthenticateAPIKey *mAPIKeyAuthenticatorMockAuthenticateAPIKey) Return(cp1 *models.Claims, err error) *APIKeyAuthenticatorMock {
+	if mmAuthenticateAPIKey.mock.funcAuthenticateAPIKey != nil {
+		mmAuthenticateAPIKey.mock.t.Fatalf("APIKeyAuthenticatorMock.AuthenticateAPIKey mock is already set by Set")
+	}
+
+	if mmAuthenticateAPIKey.defaultExpectation == nil {
+		mmAuthenticateAPIKey.defaultExpectation = &APIKeyAuthenticatorMockAuthenticateAPIKeyExpectation{mock: mmAuthenticateAPIKey.mock}
+	}
+	mmAuthenticateAPIKey.defaultExpectation.results = &APIKeyAuthenticatorMockAuthenticateAPIKeyResults{cp1, err}
+	mmAuthenticateAPIKey.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmAuthenticateAPIKey.mock
+}
+
+// Set uses given function f to mock the APIKeyAuthenticator.AuthenticateAPIKey method
+func (mmAuthenticateAPIKey *mAPIKeyAuthenticatorMockAuthenticateAPIKey) Set(f func(ctx context.Context, key string) (cp1 *models.Claims, err error)) *APIKeyAuthenticatorMock {
+	if mmAuthenticateAPIKey.defaultExpectation != nil {
+		mmAuthenticateAPIKey.mock.t.Fatalf("Default expectation is already set for the APIKeyAuthenticator.AuthenticateAPIKey method")
+	}
+
+	if len(mmAuthenticateAPIKey.expectations) > 0 {
+		mmAuthenticateAPIKey.mock.t.Fatalf("Some expectations are already set for the APIKeyAuthenticator.AuthenticateAPIKey method")
+	}
+
+	mmAuthenticateAPIKey.mock.funcAuthenticateAPIKey = f
+	mmAuthenticateAPIKey.mock.funcAuthenticateAPIKeyOrigin = minimock.CallerInfo(1)
+	return mmAuthenticateAPIKey.mock
+}
+
+// When sets expectation for the APIKeyAuthenticator.AuthenticateAPIKey which will trigger the result defined by the following
+// Then helper
+func (mmAuthenticateAPIKey *mAPIKeyAuthenticatorMockAuthenticateAPIKey) When(ctx context.Context, key string) *APIKeyAuthenticatorMockAuthenticateAPIKeyExpectation {
+	if mmAuthenticateAPIKey.mock.funcAuthenticateAPIKey != nil {
+		mmAuthenticateAPIKey.mock.t.Fatalf("APIKeyAuthenticatorMock.AuthenticateAPIKey mock is already set by Set")
+	}
+
+	expectation := &APIKeyAuthenticatorMockAuthenticateAPIKeyExpectation{
+		mock:               mmAuthenticateAPIKey.mock,
+		params:             &APIKeyAuthenticatorMockAuthenticateAPIKeyParams{ctx, key},
+		expectationOrigins: APIKeyAuthenticatorMockAuthenticateAPIKeyExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmAuthenticateAPIKey.expectations = append(mmAuthenticateAPIKey.expectations, expectation)
+	return expectation
+}
+
+// Then sets up APIKeyAuthenticator.AuthenticateAPIKey return parameters for the expectation previously defined by the When method
+func (e *APIKeyAuthenticatorMockAuthenticateAPIKeyExpectation) Then(cp1 *models.Claims, err error) *APIKeyAuthenticatorMock {
+	e.results = &APIKeyAuthenticatorMockAuthenticateAPIKeyResults{cp1, err}
+	return e.mock
+}
+
+// Times sets number of times APIKeyAuthenticator.AuthenticateAPIKey should be invoked
+func (mmAuthenticateAPIKey *mAPIKeyAuthenticatorMockAuthenticateAPIKey) Times(n uint64) *mAPIKeyAuthenticatorMockAuthenticateAPIKey {
+	if n == 0 {
+		mmAuthenticateAPIKey.mock.t.Fatalf("Times of APIKeyAuthenticatorMock.AuthenticateAPIKey mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmAuthenticateAPIKey.expectedInvocations, n)
+	mmAuthenticateAPIKey.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmAuthenticateAPIKey
+}
+
+func (mmAuthenticateAPIKey *mAPIKeyAuthenticatorMockAuthenticateAPIKey) invocationsDone() bool {
+	if len(mmAuthenticateAPIKey.expectations) == 0 && mmAuthenticateAPIKey.defaultExpectation == nil && mmAuthenticateAPIKey.mock.funcAuthenticateAPIKey == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmAuthenticateAPIKey.mock.afterAuthenticateAPIKeyCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmAuthenticateAPIKey.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// AuthenticateAPIKey implements APIKeyAuthenticator
+func (mmAuthenticateAPIKey *APIKeyAuthenticatorMock) AuthenticateAPIKey(ctx context.Context, key string) (cp1 *models.Claims, err error) {
+	mm_atomic.AddUint64(&mmAuthenticateAPIKey.beforeAuthenticateAPIKeyCounter, 1)
+	defer mm_atomic.AddUint64(&mmAuthenticateAPIKey.afterAuthenticateAPIKeyCounter, 1)
+
+	mmAuthenticateAPIKey.t.Helper()
+
+	if mmAuthenticateAPIKey.inspectFuncAuthenticateAPIKey != nil {
+		mmAuthenticateAPIKey.inspectFuncAuthenticateAPIKey(ctx, key)
+	}
+
+	mm_params := APIKeyAuthenticatorMockAuthenticateAPIKeyParams{ctx, key}
+
+	// Record call args
+	mmAuthenticateAPIKey.AuthenticateAPIKeyMock.mutex.Lock()
+	mmAuthenticateAPIKey.AuthenticateAPIKeyMock.callArgs = append(mmAuthenticateAPIKey.AuthenticateAPIKeyMock.callArgs, &mm_params)
+	mmAuthenticateAPIKey.AuthenticateAPIKeyMock.mutex.Unlock()
+
+	for _, e := range mmAuthenticateAPIKey.AuthenticateAPIKeyMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.cp1, e.results.err
+		}
+	}
+
+	if mmAuthenticateAPIKey.AuthenticateAPIKeyMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAuthenticateAPIKey.AuthenticateAPIKeyMock.defaultExpectation.Counter, 1)
+		mm_want := mmAuthenticateAPIKey.AuthenticateAPIKeyMock.defaultExpectation.params
+		mm_want_ptrs := mmAuthenticateAPIKey.AuthenticateAPIKeyMock.defaultExpectation.paramPtrs
+
+		mm_got := APIKeyAuthenticatorMockAuthenticateAPIKeyParams{ctx, key}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmAuthenticateAPIKey.t.Errorf("APIKeyAuthenticatorMock.AuthenticateAPIKey got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAuthenticateAPIKey.AuthenticateAPIKeyMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.key != nil && !minimock.Equal(*mm_want_ptrs.key, mm_got.key) {
+				mmAuthenticateAPIKey.t.Errorf("APIKeyAuthenticatorMock.AuthenticateAPIKey got unexpected parameter key, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAuthenticateAPIKey.AuthenticateAPIKeyMock.defaultExpectation.expectationOrigins.originKey, *mm_want_ptrs.key, mm_got.key, minimock.Diff(*mm_want_ptrs.key, mm_got.key))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmAuthenticateAPIKey.t.Errorf("APIKeyAuthenticatorMock.AuthenticateAPIKey got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmAuthenticateAPIKey.AuthenticateAPIKeyMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmAuthenticateAPIKey.AuthenticateAPIKeyMock.defaultExpectation.results
+		if mm_results == nil {
+			mmAuthenticateAPIKey.t.Fatal("No results are set for the APIKeyAuthenticatorMock.AuthenticateAPIKey")
+		}
+		return (*mm_results).cp1, (*mm_results).err
+	}
+	if mmAuthenticateAPIKey.funcAuthenticateAPIKey != nil {
+		return mmAuthenticateAPIKey.funcAuthenticateAPIKey(ctx, key)
+	}
+	mmAuthenticateAPIKey.t.Fatalf("Unexpected call to APIKeyAuthenticatorMock.AuthenticateAPIKey. %v %v", ctx, key)
+	return
+}
+
+// AuthenticateAPIKeyAfterCounter returns a count of finished APIKeyAuthenticatorMock.AuthenticateAPIKey invocations
+func (mmAuthenticateAPIKey *APIKeyAuthenticatorMock) AuthenticateAPIKeyAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAuthenticateAPIKey.afterAuthenticateAPIKeyCounter)
+}
+
+// AuthenticateAPIKeyBeforeCounter returns a count of APIKeyAuthenticatorMock.AuthenticateAPIKey invocations
+func (mmAuthenticateAPIKey *APIKeyAuthenticatorMock) AuthenticateAPIKeyBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAuthenticateAPIKey.beforeAuthenticateAPIKeyCounter)
+}
+
+// Calls returns a list of arguments used in each call to APIKeyAuthenticatorMock.AuthenticateAPIKey.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmAuthenticateAPIKey *mAPIKeyAuthenticatorMockAuthenticateAPIKey) Calls() []*APIKeyAuthenticatorMockAuthenticateAPIKeyParams {
+	mmAuthenticateAPIKey.mutex.RLock()
+
+	argCopy := make([]*APIKeyAuthenticatorMockAuthenticateAPIKeyParams, len(mmAuthenticateAPIKey.callArgs))
+	copy(argCopy, mmAuthenticateAPIKey.callArgs)
+
+	mmAuthenticateAPIKey.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockAuthenticateAPIKeyDone returns true if the count of the AuthenticateAPIKey invocations corresponds
+// the number of defined expectations
+func (m *APIKeyAuthenticatorMock) MinimockAuthenticateAPIKeyDone() bool {
+	if m.AuthenticateAPIKeyMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.AuthenticateAPIKeyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.AuthenticateAPIKeyMock.invocationsDone()
+}
+
+// MinimockAuthenticateAPIKeyInspect logs each unmet expectation
+func (m *APIKeyAuthenticatorMock) MinimockAuthenticateAPIKeyInspect() {
+	for _, e := range m.AuthenticateAPIKeyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to APIKeyAuthenticatorMock.AuthenticateAPIKey at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterAuthenticateAPIKeyCounter := mm_atomic.LoadUint64(&m.afterAuthenticateAPIKeyCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.AuthenticateAPIKeyMock.defaultExpectation != nil && afterAuthenticateAPIKeyCounter < 1 {
+		if m.AuthenticateAPIKeyMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to APIKeyAuthenticatorMock.AuthenticateAPIKey at\n%s", m.AuthenticateAPIKeyMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to APIKeyAuthenticatorMock.AuthenticateAPIKey at\n%s with params: %#v", m.AuthenticateAPIKeyMock.defaultExpectation.expectationOrigins.origin, *m.AuthenticateAPIKeyMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcAuthenticateAPIKey != nil && afterAuthenticateAPIKeyCounter < 1 {
+		m.t.Errorf("Expected call to APIKeyAuthenticatorMock.AuthenticateAPIKey at\n%s", m.funcAuthenticateAPIKeyOrigin)
+	}
+
+	if !m.AuthenticateAPIKeyMock.invocationsDone() && afterAuthenticateAPIKeyCounter > 0 {
+		m.t.Errorf("Expected %d calls to APIKeyAuthenticatorMock.AuthenticateAPIKey at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.AuthenticateAPIKeyMock.expectedInvocations), m.AuthenticateAPIKeyMock.expectedInvocationsOrigin, afterAuthenticateAPIKeyCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *APIKeyAuthenticatorMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockAuthenticateAPIKeyInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *APIKeyAuthenticatorMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *APIKeyAuthenticatorMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockAuthenticateAPIKeyDone()
+}
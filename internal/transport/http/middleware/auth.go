@@ -5,9 +5,12 @@ import (
 	"errors"
 	"log/slog"
 	"net/http"
+	"slices"
 	"strings"
 
 	"github.com/alonsoF100/authorization-service/internal/apperrors"
+	"github.com/alonsoF100/authorization-service/internal/audit"
+	"github.com/alonsoF100/authorization-service/internal/config"
 	"github.com/alonsoF100/authorization-service/internal/models"
 	"github.com/alonsoF100/authorization-service/internal/transport/http/dto"
 	"github.com/alonsoF100/authorization-service/internal/transport/http/help"
@@ -15,6 +18,15 @@ import (
 
 type TokenValidator interface {
 	ValidateJWT(ctx context.Context, token string) (*models.Claims, error)
+	// CheckAllowedIP rejects user tokens used outside the allowed ips of
+	// the user
+	CheckAllowedIP(ctx context.Context, claims *models.Claims, ip string) error
+}
+
+// PersonalAccessTokenAuthenticator resolves personal access tokens, usually
+// UserService
+type PersonalAccessTokenAuthenticator interface {
+	AuthenticatePersonalAccessToken(ctx context.Context, token, ip string) (*models.Claims, error)
 }
 
 var (
@@ -23,37 +35,120 @@ var (
 
 type contextKey string
 
-const UserContextKey contextKey = "user"
+const (
+	UserContextKey      contextKey = "user"
+	PrincipalContextKey contextKey = "principal"
+	// PersonalAccessTokenContextKey is set when the request authenticated
+	// with a personal access token instead of a JWT
+	PersonalAccessTokenContextKey contextKey = "personal_access_token"
+)
+
+// APIKeyAuthenticator resolves service account API keys, usually
+// ServiceAccountService
+type APIKeyAuthenticator interface {
+	AuthenticateAPIKey(ctx context.Context, key string) (*models.Claims, error)
+}
 
-func Auth(tokenValidator TokenValidator) func(http.Handler) http.Handler {
+// Auth accepts JWTs, personal access tokens told apart from JWTs by their
+// prefix and service account API keys. Every credential resolves to claims,
+// so handlers read the principal the same way. In cookie mode a JWT may also
+// come from the token cookie, then requests changing state need the CSRF
+// token.
+func Auth(tokenValidator TokenValidator, patAuthenticator PersonalAccessTokenAuthenticator, apiKeyAuthenticator APIKeyAuthenticator, cookie config.CookieConfig) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			const op = "middleware/auth.go/Auth"
 
+			if apiKey := ExtractAPIKey(r); apiKey != "" {
+				claims, err := apiKeyAuthenticator.AuthenticateAPIKey(r.Context(), apiKey)
+				if err != nil {
+					slog.Info("Authentication failed: invalid api key",
+						slog.String("op", op),
+						slog.String("path", r.URL.Path),
+						slog.String("method", r.Method),
+						slog.String("ip", help.ClientIP(r)),
+						slog.String("error", err.Error()),
+					)
+					help.WriteJSON(w, http.StatusUnauthorized, dto.NewErrorResponse(apperrors.ErrInvalidToken))
+					return
+				}
+
+				ctx := context.WithValue(r.Context(), UserContextKey, claims)
+				ctx = context.WithValue(ctx, PrincipalContextKey, claims.Principal())
+				ctx = audit.WithActor(ctx, string(claims.Principal()), claims.ID)
+				next.ServeHTTP(w, r.WithContext(ctx))
+				return
+			}
+
 			token := ExtractToken(r)
+			fromCookie := false
+			if token == "" && cookie.Enabled {
+				token = extractCookie(r, cookie.Name)
+				fromCookie = token != ""
+			}
 			if token == "" {
 				slog.Info("Authentication failed: missing authorization header",
 					slog.String("op", op),
 					slog.String("path", r.URL.Path),
 					slog.String("method", r.Method),
+					slog.String("ip", help.ClientIP(r)),
 				)
 				help.WriteJSON(w, http.StatusUnauthorized, dto.NewErrorResponse(ErrNoAuthHeader))
 				return
 			}
 
-			claims, err := tokenValidator.ValidateJWT(r.Context(), token)
+			var (
+				claims *models.Claims
+				err    error
+			)
+			// the cookie is only ever set to a JWT
+			isPAT := !fromCookie && strings.HasPrefix(token, models.PersonalAccessTokenPrefix)
+			if isPAT {
+				claims, err = patAuthenticator.AuthenticatePersonalAccessToken(r.Context(), token, help.ClientIP(r))
+			} else {
+				claims, err = tokenValidator.ValidateJWT(r.Context(), token)
+				if err == nil {
+					err = tokenValidator.CheckAllowedIP(r.Context(), claims, help.ClientIP(r))
+				}
+			}
+			if errors.Is(err, apperrors.ErrIPNotAllowed) {
+				slog.Info("Authentication failed: address not allowed for user",
+					slog.String("op", op),
+					slog.String("path", r.URL.Path),
+					slog.String("method", r.Method),
+					slog.String("ip", help.ClientIP(r)),
+				)
+				help.WriteJSON(w, http.StatusForbidden, dto.NewErrorResponse(apperrors.ErrIPNotAllowed))
+				return
+			}
 			if err != nil {
 				slog.Info("Authentication failed: invalid token",
 					slog.String("op", op),
 					slog.String("path", r.URL.Path),
 					slog.String("method", r.Method),
+					slog.String("ip", help.ClientIP(r)),
 					slog.String("error", err.Error()),
 				)
 				help.WriteJSON(w, http.StatusUnauthorized, dto.NewErrorResponse(apperrors.ErrInvalidToken))
 				return
 			}
 
+			if fromCookie && !safeMethod(r.Method) && !validCSRFToken(r, cookie.CSRFHeader, token) {
+				slog.Info("Authorization failed: invalid csrf token",
+					slog.String("op", op),
+					slog.String("path", r.URL.Path),
+					slog.String("method", r.Method),
+					slog.String("ip", help.ClientIP(r)),
+					slog.String("user_id", claims.ID),
+				)
+				help.WriteJSON(w, http.StatusForbidden, dto.NewErrorResponse(apperrors.ErrInvalidCSRFToken))
+				return
+			}
+
 			ctx := context.WithValue(r.Context(), UserContextKey, claims)
+			ctx = context.WithValue(ctx, PrincipalContextKey, claims.Principal())
+			ctx = audit.WithActor(ctx, string(claims.Principal()), claims.ID)
+			ctx = context.WithValue(ctx, PersonalAccessTokenContextKey, isPAT)
 			next.ServeHTTP(w, r.WithContext(ctx))
 		})
 	}
@@ -73,8 +168,99 @@ func ExtractToken(r *http.Request) string {
 	return parts[1]
 }
 
+func extractCookie(r *http.Request, name string) string {
+	cookie, err := r.Cookie(name)
+	if err != nil {
+		return ""
+	}
+
+	return cookie.Value
+}
+
+// ExtractAPIKey reads a service account API key from the X-API-Key header or
+// an Authorization header with the ApiKey scheme
+func ExtractAPIKey(r *http.Request) string {
+	if key := r.Header.Get("X-API-Key"); key != "" {
+		return key
+	}
+
+	scheme, key, ok := strings.Cut(r.Header.Get("Authorization"), " ")
+	if !ok || scheme != "ApiKey" || key == "" || strings.Contains(key, " ") {
+		return ""
+	}
+
+	return key
+}
+
+// RequireRole rejects principals lacking the role, it must run after Auth
+func RequireRole(role string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			const op = "middleware/auth.go/RequireRole"
+
+			claims, ok := GetUserFromContext(r.Context())
+			if !ok || !slices.Contains(claims.Roles, role) {
+				slog.Info("Authorization failed: missing role",
+					slog.String("op", op),
+					slog.String("path", r.URL.Path),
+					slog.String("role", role),
+				)
+				help.WriteJSON(w, http.StatusForbidden, dto.NewErrorResponse(apperrors.ErrMissingRole))
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// RequireScope rejects tokens limited by their scopes that lack the scope,
+// sign in tokens and API keys pass. Personal access tokens must carry the
+// scope. It must run after Auth.
+func RequireScope(scope string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			const op = "middleware/auth.go/RequireScope"
+
+			claims, ok := GetUserFromContext(r.Context())
+			// a personal access token without scopes is allowed nothing
+			allowed := ok && claims.AllowsScope(scope)
+			if ok && IsPersonalAccessToken(r.Context()) {
+				allowed = slices.Contains(claims.Scopes(), scope)
+			}
+			if !allowed {
+				slog.Info("Authorization failed: missing scope",
+					slog.String("op", op),
+					slog.String("path", r.URL.Path),
+					slog.String("scope", scope),
+				)
+				help.WriteJSON(w, http.StatusForbidden, dto.NewErrorResponse(apperrors.ErrMissingScope))
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
 func GetUserFromContext(ctx context.Context) (*models.Claims, bool) {
 	claims, ok := ctx.Value(UserContextKey).(*models.Claims)
 
 	return claims, ok
 }
+
+// GetPrincipalFromContext tells whether the request was authenticated with a
+// user token or a machine token issued to an OAuth client
+func GetPrincipalFromContext(ctx context.Context) (models.PrincipalType, bool) {
+	principal, ok := ctx.Value(PrincipalContextKey).(models.PrincipalType)
+
+	return principal, ok
+}
+
+// IsPersonalAccessToken tells whether the request authenticated with a
+// personal access token
+func IsPersonalAccessToken(ctx context.Context) bool {
+	isPAT, _ := ctx.Value(PersonalAccessTokenContextKey).(bool)
+
+	return isPAT
+}
@@ -6,9 +6,11 @@ import (
 	"errors"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/alonsoF100/authorization-service/internal/apperrors"
+	"github.com/alonsoF100/authorization-service/internal/config"
 	"github.com/alonsoF100/authorization-service/internal/models"
 	"github.com/alonsoF100/authorization-service/internal/transport/http/dto"
 	"github.com/alonsoF100/authorization-service/internal/transport/http/middleware"
@@ -78,6 +80,7 @@ func TestAuth(t *testing.T) {
 	mc := minimock.NewController(t)
 
 	mockValidator := middleware.NewTokenValidatorMock(mc)
+	mockPATAuthenticator := middleware.NewPersonalAccessTokenAuthenticatorMock(mc)
 
 	testClaims := &models.Claims{
 		ID:       uuid.New().String(),
@@ -101,11 +104,54 @@ func TestAuth(t *testing.T) {
 			setupMocks: func() {
 				mockValidator.ValidateJWTMock.Expect(context.Background(), "valid_token").
 					Return(testClaims, nil)
+				mockValidator.CheckAllowedIPMock.Expect(context.Background(), testClaims, "192.0.2.1").
+					Return(nil)
 			},
 			expectedStatus: http.StatusOK,
 			expectedError:  nil,
 			shouldCallNext: true,
 		},
+		{
+			name: "address not allowed for user",
+			setupRequest: func(req *http.Request) {
+				req.Header.Set("Authorization", "Bearer valid_token")
+			},
+			setupMocks: func() {
+				mockValidator.ValidateJWTMock.Expect(context.Background(), "valid_token").
+					Return(testClaims, nil)
+				mockValidator.CheckAllowedIPMock.Expect(context.Background(), testClaims, "192.0.2.1").
+					Return(apperrors.ErrIPNotAllowed)
+			},
+			expectedStatus: http.StatusForbidden,
+			expectedError:  apperrors.ErrIPNotAllowed,
+			shouldCallNext: false,
+		},
+		{
+			name: "successful authentication with personal access token",
+			setupRequest: func(req *http.Request) {
+				req.Header.Set("Authorization", "Bearer authpat_valid")
+			},
+			setupMocks: func() {
+				mockPATAuthenticator.AuthenticatePersonalAccessTokenMock.Expect(context.Background(), "authpat_valid", "192.0.2.1").
+					Return(testClaims, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedError:  nil,
+			shouldCallNext: true,
+		},
+		{
+			name: "invalid personal access token",
+			setupRequest: func(req *http.Request) {
+				req.Header.Set("Authorization", "Bearer authpat_revoked")
+			},
+			setupMocks: func() {
+				mockPATAuthenticator.AuthenticatePersonalAccessTokenMock.Expect(context.Background(), "authpat_revoked", "192.0.2.1").
+					Return(nil, apperrors.ErrInvalidToken)
+			},
+			expectedStatus: http.StatusUnauthorized,
+			expectedError:  apperrors.ErrInvalidToken,
+			shouldCallNext: false,
+		},
 		{
 			name:           "missing authorization header",
 			setupRequest:   func(req *http.Request) {},
@@ -184,11 +230,16 @@ func TestAuth(t *testing.T) {
 				claims := r.Context().Value(middleware.UserContextKey)
 				capturedClaims = claims
 
+				principal, ok := middleware.GetPrincipalFromContext(r.Context())
+				require.True(t, ok)
+				require.Equal(t, models.PrincipalUser, principal)
+				require.Equal(t, strings.HasPrefix(middleware.ExtractToken(r), models.PersonalAccessTokenPrefix), middleware.IsPersonalAccessToken(r.Context()))
+
 				w.WriteHeader(http.StatusOK)
 				w.Write([]byte("OK"))
 			})
 
-			authMiddleware := middleware.Auth(mockValidator)
+			authMiddleware := middleware.Auth(mockValidator, mockPATAuthenticator, nil, config.CookieConfig{})
 			handler := authMiddleware(nextHandler)
 
 			req := httptest.NewRequest(http.MethodGet, "/protected", nil)
@@ -302,3 +353,237 @@ func TestGetUserFromContext(t *testing.T) {
 		})
 	}
 }
+
+func TestExtractAPIKey(t *testing.T) {
+	tests := []struct {
+		name    string
+		headers map[string]string
+		want    string
+	}{
+		{
+			name:    "X-API-Key header",
+			headers: map[string]string{"X-API-Key": "authsa_key"},
+			want:    "authsa_key",
+		},
+		{
+			name:    "ApiKey authorization scheme",
+			headers: map[string]string{"Authorization": "ApiKey authsa_key"},
+			want:    "authsa_key",
+		},
+		{
+			name:    "X-API-Key wins over authorization",
+			headers: map[string]string{"X-API-Key": "authsa_header", "Authorization": "ApiKey authsa_scheme"},
+			want:    "authsa_header",
+		},
+		{
+			name:    "Bearer token",
+			headers: map[string]string{"Authorization": "Bearer token"},
+			want:    "",
+		},
+		{
+			name:    "ApiKey without key",
+			headers: map[string]string{"Authorization": "ApiKey "},
+			want:    "",
+		},
+		{
+			name:    "no headers",
+			headers: map[string]string{},
+			want:    "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/any-url", nil)
+			for name, value := range tt.headers {
+				req.Header.Set(name, value)
+			}
+
+			require.Equal(t, tt.want, middleware.ExtractAPIKey(req))
+		})
+	}
+}
+
+func TestAuthAPIKey(t *testing.T) {
+	mc := minimock.NewController(t)
+
+	mockAuthenticator := middleware.NewAPIKeyAuthenticatorMock(mc)
+
+	accountClaims := &models.Claims{
+		ID:            uuid.New().String(),
+		Nickname:      "deployer",
+		Roles:         []string{"deploy"},
+		PrincipalType: models.PrincipalServiceAccount,
+	}
+
+	tests := []struct {
+		name           string
+		setupRequest   func(req *http.Request)
+		setupMocks     func()
+		expectedStatus int
+	}{
+		{
+			name: "X-API-Key header",
+			setupRequest: func(req *http.Request) {
+				req.Header.Set("X-API-Key", "authsa_valid")
+			},
+			setupMocks: func() {
+				mockAuthenticator.AuthenticateAPIKeyMock.Expect(context.Background(), "authsa_valid").
+					Return(accountClaims, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "ApiKey authorization scheme",
+			setupRequest: func(req *http.Request) {
+				req.Header.Set("Authorization", "ApiKey authsa_valid")
+			},
+			setupMocks: func() {
+				mockAuthenticator.AuthenticateAPIKeyMock.Expect(context.Background(), "authsa_valid").
+					Return(accountClaims, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "invalid key",
+			setupRequest: func(req *http.Request) {
+				req.Header.Set("X-API-Key", "authsa_invalid")
+			},
+			setupMocks: func() {
+				mockAuthenticator.AuthenticateAPIKeyMock.Expect(context.Background(), "authsa_invalid").
+					Return(nil, apperrors.ErrInvalidToken)
+			},
+			expectedStatus: http.StatusUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMocks()
+
+			nextHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				claims, ok := middleware.GetUserFromContext(r.Context())
+				require.True(t, ok)
+				require.Equal(t, accountClaims, claims)
+
+				principal, ok := middleware.GetPrincipalFromContext(r.Context())
+				require.True(t, ok)
+				require.Equal(t, models.PrincipalServiceAccount, principal)
+
+				w.WriteHeader(http.StatusOK)
+			})
+
+			handler := middleware.Auth(nil, nil, mockAuthenticator, config.CookieConfig{})(nextHandler)
+
+			req := httptest.NewRequest(http.MethodGet, "/protected", nil)
+			tt.setupRequest(req)
+
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, req)
+
+			require.Equal(t, tt.expectedStatus, rr.Code)
+		})
+	}
+}
+
+func TestRequireRole(t *testing.T) {
+	tests := []struct {
+		name           string
+		claims         *models.Claims
+		expectedStatus int
+	}{
+		{
+			name:           "has role",
+			claims:         &models.Claims{ID: "user123", Roles: []string{"support", models.RoleAdmin}},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "lacks role",
+			claims:         &models.Claims{ID: "user123", Roles: []string{"support"}},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "no claims",
+			expectedStatus: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			nextHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			})
+
+			req := httptest.NewRequest(http.MethodGet, "/admin", nil)
+			if tt.claims != nil {
+				req = req.WithContext(context.WithValue(req.Context(), middleware.UserContextKey, tt.claims))
+			}
+
+			rr := httptest.NewRecorder()
+			middleware.RequireRole(models.RoleAdmin)(nextHandler).ServeHTTP(rr, req)
+
+			require.Equal(t, tt.expectedStatus, rr.Code)
+		})
+	}
+}
+
+func TestRequireScope(t *testing.T) {
+	tests := []struct {
+		name           string
+		claims         *models.Claims
+		pat            bool
+		expectedStatus int
+	}{
+		{
+			name:           "sign in token",
+			claims:         &models.Claims{ID: "user123"},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "client token with scope",
+			claims:         &models.Claims{ID: "user123", ClientID: "client", Scope: "openid write"},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "client token without scope",
+			claims:         &models.Claims{ID: "user123", ClientID: "client", Scope: "openid read"},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "personal access token with scope",
+			claims:         &models.Claims{ID: "user123", Scope: "read write"},
+			pat:            true,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "personal access token without scopes",
+			claims:         &models.Claims{ID: "user123"},
+			pat:            true,
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "no claims",
+			expectedStatus: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			nextHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			})
+
+			req := httptest.NewRequest(http.MethodDelete, "/api/me", nil)
+			if tt.claims != nil {
+				ctx := context.WithValue(req.Context(), middleware.UserContextKey, tt.claims)
+				ctx = context.WithValue(ctx, middleware.PersonalAccessTokenContextKey, tt.pat)
+				req = req.WithContext(ctx)
+			}
+
+			rr := httptest.NewRecorder()
+			middleware.RequireScope(models.ScopeWrite)(nextHandler).ServeHTTP(rr, req)
+
+			require.Equal(t, tt.expectedStatus, rr.Code)
+		})
+	}
+}
@@ -0,0 +1,129 @@
+package middleware
+
+import (
+	"log/slog"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/alonsoF100/authorization-service/internal/config"
+)
+
+// CORS answers preflight requests and adds the CORS headers for allowed
+// origins. Requests of other origins pass without the headers, so the
+// browser doesn't let the page read the response. The config is read on
+// every request, so it can change on reload.
+func CORS(cfg func() config.CORSConfig) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			const op = "middleware/cors.go/CORS"
+
+			policy := corsPolicy(cfg(), r.URL.Path)
+			if len(policy.AllowedOrigins) == 0 {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			header := w.Header()
+			header.Add("Vary", "Origin")
+
+			preflight := r.Method == http.MethodOptions && r.Header.Get("Access-Control-Request-Method") != ""
+			if preflight {
+				header.Add("Vary", "Access-Control-Request-Method")
+				header.Add("Vary", "Access-Control-Request-Headers")
+			}
+
+			origin := r.Header.Get("Origin")
+			if origin == "" || !allowedOrigin(policy.AllowedOrigins, origin) {
+				if origin != "" {
+					slog.Debug("CORS origin not allowed",
+						slog.String("op", op),
+						slog.String("origin", origin),
+						slog.String("path", r.URL.Path),
+					)
+				}
+				if preflight {
+					w.WriteHeader(http.StatusNoContent)
+					return
+				}
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			// credentials with * are rejected by the config validation
+			if slices.Contains(policy.AllowedOrigins, "*") {
+				header.Set("Access-Control-Allow-Origin", "*")
+			} else {
+				header.Set("Access-Control-Allow-Origin", origin)
+			}
+			if policy.AllowCredentials {
+				header.Set("Access-Control-Allow-Credentials", "true")
+			}
+
+			if !preflight {
+				if len(policy.ExposedHeaders) > 0 {
+					header.Set("Access-Control-Expose-Headers", strings.Join(policy.ExposedHeaders, ", "))
+				}
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			if len(policy.AllowedMethods) > 0 {
+				header.Set("Access-Control-Allow-Methods", strings.Join(policy.AllowedMethods, ", "))
+			}
+			// browsers don't honor a * header list on requests with
+			// credentials, so the requested headers are echoed instead
+			if slices.Contains(policy.AllowedHeaders, "*") {
+				if requested := r.Header.Get("Access-Control-Request-Headers"); requested != "" {
+					header.Set("Access-Control-Allow-Headers", requested)
+				}
+			} else if len(policy.AllowedHeaders) > 0 {
+				header.Set("Access-Control-Allow-Headers", strings.Join(policy.AllowedHeaders, ", "))
+			}
+			if policy.MaxAge > 0 {
+				header.Set("Access-Control-Max-Age", strconv.Itoa(int(policy.MaxAge.Seconds())))
+			}
+
+			w.WriteHeader(http.StatusNoContent)
+		})
+	}
+}
+
+// corsPolicy returns the policy of the route with the longest prefix of
+// path, the top level one when no route matches
+func corsPolicy(cfg config.CORSConfig, path string) config.CORSPolicy {
+	policy, matched := cfg.CORSPolicy, ""
+	for _, route := range cfg.Routes {
+		if config.PathHasPrefix(path, route.Prefix) && len(route.Prefix) > len(matched) {
+			policy, matched = route.CORSPolicy, route.Prefix
+		}
+	}
+
+	return policy
+}
+
+// allowedOrigin matches origins case-insensitively, a pattern like
+// https://*.example.com allows any subdomain but not example.com itself
+func allowedOrigin(allowed []string, origin string) bool {
+	origin = strings.ToLower(origin)
+
+	for _, pattern := range allowed {
+		pattern = strings.ToLower(pattern)
+		if pattern == "*" || pattern == origin {
+			return true
+		}
+
+		scheme, domain, ok := strings.Cut(pattern, "://*.")
+		if !ok {
+			continue
+		}
+		prefix, suffix := scheme+"://", "."+domain
+		if len(origin) > len(prefix)+len(suffix) && strings.HasPrefix(origin, prefix) && strings.HasSuffix(origin, suffix) &&
+			!strings.ContainsAny(origin[len(prefix):len(origin)-len(suffix)], "/:@") {
+			return true
+		}
+	}
+
+	return false
+}
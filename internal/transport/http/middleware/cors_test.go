@@ -0,0 +1,186 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/alonsoF100/authorization-service/internal/config"
+	"github.com/alonsoF100/authorization-service/internal/transport/http/middleware"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCORS(t *testing.T) {
+	cfg := config.CORSConfig{
+		CORSPolicy: config.CORSPolicy{
+			AllowedOrigins:   []string{"https://app.example.com", "https://*.example.org"},
+			AllowedMethods:   []string{"GET", "POST"},
+			AllowedHeaders:   []string{"Authorization", "Content-Type"},
+			ExposedHeaders:   []string{"X-Request-Id"},
+			AllowCredentials: true,
+			MaxAge:           10 * time.Minute,
+		},
+		Routes: []config.CORSRoute{
+			{Prefix: "/.well-known", CORSPolicy: config.CORSPolicy{
+				AllowedOrigins: []string{"*"},
+				AllowedMethods: []string{"GET"},
+				AllowedHeaders: []string{"*"},
+			}},
+			{Prefix: "/admin", CORSPolicy: config.CORSPolicy{}},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		method         string
+		path           string
+		headers        map[string]string
+		expectedStatus int
+		expectedNext   bool
+		expected       map[string]string
+	}{
+		{
+			name:           "preflight",
+			method:         http.MethodOptions,
+			path:           "/auth/login",
+			headers:        map[string]string{"Origin": "https://app.example.com", "Access-Control-Request-Method": "POST"},
+			expectedStatus: http.StatusNoContent,
+			expected: map[string]string{
+				"Access-Control-Allow-Origin":      "https://app.example.com",
+				"Access-Control-Allow-Credentials": "true",
+				"Access-Control-Allow-Methods":     "GET, POST",
+				"Access-Control-Allow-Headers":     "Authorization, Content-Type",
+				"Access-Control-Max-Age":           "600",
+				"Access-Control-Expose-Headers":    "",
+			},
+		},
+		{
+			name:           "actual request",
+			method:         http.MethodPost,
+			path:           "/auth/login",
+			headers:        map[string]string{"Origin": "https://app.example.com"},
+			expectedStatus: http.StatusOK,
+			expectedNext:   true,
+			expected: map[string]string{
+				"Access-Control-Allow-Origin":   "https://app.example.com",
+				"Access-Control-Expose-Headers": "X-Request-Id",
+				"Access-Control-Allow-Methods":  "",
+			},
+		},
+		{
+			name:           "wildcard subdomain",
+			method:         http.MethodGet,
+			path:           "/api/me",
+			headers:        map[string]string{"Origin": "https://eu.shop.example.org"},
+			expectedStatus: http.StatusOK,
+			expectedNext:   true,
+			expected:       map[string]string{"Access-Control-Allow-Origin": "https://eu.shop.example.org"},
+		},
+		{
+			name:           "wildcard doesn't match the domain itself",
+			method:         http.MethodGet,
+			path:           "/api/me",
+			headers:        map[string]string{"Origin": "https://example.org"},
+			expectedStatus: http.StatusOK,
+			expectedNext:   true,
+			expected:       map[string]string{"Access-Control-Allow-Origin": ""},
+		},
+		{
+			name:           "origin not allowed",
+			method:         http.MethodOptions,
+			path:           "/auth/login",
+			headers:        map[string]string{"Origin": "https://evil.example.com", "Access-Control-Request-Method": "POST"},
+			expectedStatus: http.StatusNoContent,
+			expected:       map[string]string{"Access-Control-Allow-Origin": "", "Access-Control-Allow-Methods": ""},
+		},
+		{
+			name:           "route override",
+			method:         http.MethodOptions,
+			path:           "/.well-known/jwks.json",
+			headers:        map[string]string{"Origin": "https://anyone.test", "Access-Control-Request-Method": "GET", "Access-Control-Request-Headers": "x-trace"},
+			expectedStatus: http.StatusNoContent,
+			expected: map[string]string{
+				"Access-Control-Allow-Origin":      "*",
+				"Access-Control-Allow-Credentials": "",
+				"Access-Control-Allow-Headers":     "x-trace",
+				"Access-Control-Max-Age":           "",
+			},
+		},
+		{
+			name:           "route without origins disables cors",
+			method:         http.MethodOptions,
+			path:           "/admin/service-accounts",
+			headers:        map[string]string{"Origin": "https://app.example.com", "Access-Control-Request-Method": "GET"},
+			expectedStatus: http.StatusOK,
+			expectedNext:   true,
+			expected:       map[string]string{"Access-Control-Allow-Origin": "", "Vary": ""},
+		},
+		{
+			name:           "route prefix matches whole segments",
+			method:         http.MethodGet,
+			path:           "/administrator",
+			headers:        map[string]string{"Origin": "https://app.example.com"},
+			expectedStatus: http.StatusOK,
+			expectedNext:   true,
+			expected:       map[string]string{"Access-Control-Allow-Origin": "https://app.example.com"},
+		},
+		{
+			name:           "same origin request",
+			method:         http.MethodGet,
+			path:           "/api/me",
+			expectedStatus: http.StatusOK,
+			expectedNext:   true,
+			expected:       map[string]string{"Access-Control-Allow-Origin": "", "Vary": "Origin"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var called bool
+			nextHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				called = true
+				w.WriteHeader(http.StatusOK)
+			})
+			handler := middleware.CORS(func() config.CORSConfig { return cfg })(nextHandler)
+
+			req := httptest.NewRequest(tt.method, tt.path, nil)
+			for name, value := range tt.headers {
+				req.Header.Set(name, value)
+			}
+
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, req)
+
+			require.Equal(t, tt.expectedStatus, rr.Code)
+			require.Equal(t, tt.expectedNext, called)
+			for name, value := range tt.expected {
+				require.Equal(t, value, rr.Header().Get(name), name)
+			}
+		})
+	}
+}
+
+func TestCORSReload(t *testing.T) {
+	var cfg atomic.Pointer[config.CORSConfig]
+	cfg.Store(&config.CORSConfig{})
+
+	nextHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	handler := middleware.CORS(func() config.CORSConfig { return *cfg.Load() })(nextHandler)
+
+	request := func() *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/api/me", nil)
+		req.Header.Set("Origin", "https://app.example.com")
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, req)
+		return rr
+	}
+
+	require.Empty(t, request().Header().Get("Access-Control-Allow-Origin"))
+
+	cfg.Store(&config.CORSConfig{CORSPolicy: config.CORSPolicy{AllowedOrigins: []string{"https://app.example.com"}}})
+	require.Equal(t, "https://app.example.com", request().Header().Get("Access-Control-Allow-Origin"))
+}
@@ -0,0 +1,34 @@
+package middleware
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"net/http"
+)
+
+// CSRFToken derives the CSRF token of a cookie token. Other sites can
+// neither read the HttpOnly cookie nor plant a matching pair of cookie and
+// header, so a request echoing the token comes from a page of the app.
+func CSRFToken(token string) string {
+	sum := sha256.Sum256([]byte("csrf:" + token))
+
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+func validCSRFToken(r *http.Request, header, token string) bool {
+	got := r.Header.Get(header)
+
+	return got != "" && subtle.ConstantTimeCompare([]byte(got), []byte(CSRFToken(token))) == 1
+}
+
+// safeMethod reports methods that must not change state, those skip the
+// CSRF check
+func safeMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,141 @@
+package middleware_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/alonsoF100/authorization-service/internal/apperrors"
+	"github.com/alonsoF100/authorization-service/internal/config"
+	"github.com/alonsoF100/authorization-service/internal/models"
+	"github.com/alonsoF100/authorization-service/internal/transport/http/middleware"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthCookie(t *testing.T) {
+	cookie := config.CookieConfig{
+		Enabled:    true,
+		Name:       "access_token",
+		CSRFCookie: "csrf_token",
+		CSRFHeader: "X-CSRF-Token",
+	}
+	claims := &models.Claims{ID: "user123", SessionID: "session123"}
+
+	tests := []struct {
+		name           string
+		cookie         config.CookieConfig
+		method         string
+		setupRequest   func(r *http.Request)
+		validates      string
+		validateErr    error
+		expectedStatus int
+	}{
+		{
+			name:   "cookie read",
+			cookie: cookie,
+			method: http.MethodGet,
+			setupRequest: func(r *http.Request) {
+				r.AddCookie(&http.Cookie{Name: "access_token", Value: "cookie_token"})
+			},
+			validates:      "cookie_token",
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:   "header wins over cookie",
+			cookie: cookie,
+			method: http.MethodDelete,
+			setupRequest: func(r *http.Request) {
+				r.Header.Set("Authorization", "Bearer header_token")
+				r.AddCookie(&http.Cookie{Name: "access_token", Value: "cookie_token"})
+			},
+			validates:      "header_token",
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:   "cookie with csrf token",
+			cookie: cookie,
+			method: http.MethodDelete,
+			setupRequest: func(r *http.Request) {
+				r.AddCookie(&http.Cookie{Name: "access_token", Value: "cookie_token"})
+				r.Header.Set("X-CSRF-Token", middleware.CSRFToken("cookie_token"))
+			},
+			validates:      "cookie_token",
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:   "cookie without csrf token",
+			cookie: cookie,
+			method: http.MethodDelete,
+			setupRequest: func(r *http.Request) {
+				r.AddCookie(&http.Cookie{Name: "access_token", Value: "cookie_token"})
+			},
+			validates:      "cookie_token",
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:   "csrf token of another cookie",
+			cookie: cookie,
+			method: http.MethodPost,
+			setupRequest: func(r *http.Request) {
+				r.AddCookie(&http.Cookie{Name: "access_token", Value: "cookie_token"})
+				r.Header.Set("X-CSRF-Token", middleware.CSRFToken("other_token"))
+			},
+			validates:      "cookie_token",
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:   "invalid cookie",
+			cookie: cookie,
+			method: http.MethodGet,
+			setupRequest: func(r *http.Request) {
+				r.AddCookie(&http.Cookie{Name: "access_token", Value: "cookie_token"})
+			},
+			validates:      "cookie_token",
+			validateErr:    apperrors.ErrInvalidToken,
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:   "cookie mode disabled",
+			method: http.MethodGet,
+			setupRequest: func(r *http.Request) {
+				r.AddCookie(&http.Cookie{Name: "access_token", Value: "cookie_token"})
+			},
+			expectedStatus: http.StatusUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := minimock.NewController(t)
+			mockValidator := middleware.NewTokenValidatorMock(mc)
+			if tt.validates != "" {
+				mockValidator.ValidateJWTMock.Set(func(_ context.Context, token string) (*models.Claims, error) {
+					require.Equal(t, tt.validates, token)
+					if tt.validateErr != nil {
+						return nil, tt.validateErr
+					}
+					return claims, nil
+				})
+				mockValidator.CheckAllowedIPMock.Optional().Return(nil)
+			}
+
+			nextHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			})
+			handler := middleware.Auth(mockValidator, nil, nil, tt.cookie)(nextHandler)
+
+			req := httptest.NewRequest(tt.method, "/api/me", nil)
+			tt.setupRequest(req)
+
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, req)
+
+			require.Equal(t, tt.expectedStatus, rr.Code)
+			if tt.expectedStatus == http.StatusForbidden {
+				require.Contains(t, rr.Body.String(), apperrors.ErrInvalidCSRFToken.Error())
+			}
+		})
+	}
+}
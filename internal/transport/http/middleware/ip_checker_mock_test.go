@@ -0,0 +1,416 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package middleware
+
+//go:generate minimock -i github.com/alonsoF100/authorization-service/internal/transport/http/middleware.IPChecker -o ip_checker_mock_test.go -n IPCheckerMock -p middleware
+
+import (
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// IPCheckerMock implements IPChecker
+type IPCheckerMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcCheck          func(path string, ip string) (allowed bool, country string)
+	funcCheckOrigin    string
+	inspectFuncCheck   func(path string, ip string)
+	afterCheckCounter  uint64
+	beforeCheckCounter uint64
+	CheckMock          mIPCheckerMockCheck
+}
+
+// NewIPCheckerMock returns a mock for IPChecker
+func NewIPCheckerMock(t minimock.Tester) *IPCheckerMock {
+	m := &IPCheckerMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CheckMock = mIPCheckerMockCheck{mock: m}
+	m.CheckMock.callArgs = []*IPCheckerMockCheckParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mIPCheckerMockCheck struct {
+	optional           bool
+	mock               *IPCheckerMock
+	defaultExpectation *IPCheckerMockCheckExpectation
+	expectations       []*IPCheckerMockCheckExpectation
+
+	callArgs []*IPCheckerMockCheckParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// IPCheckerMockCheckExpectation specifies expectation struct of the IPChecker.Check
+type IPCheckerMockCheckExpectation struct {
+	mock               *IPCheckerMock
+	params             *IPCheckerMockCheckParams
+	paramPtrs          *IPCheckerMockCheckParamPtrs
+	expectationOrigins IPCheckerMockCheckExpectationOrigins
+	results            *IPCheckerMockCheckResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// IPCheckerMockCheckParams contains parameters of the IPChecker.Check
+type IPCheckerMockCheckParams struct {
+	path string
+	ip   string
+}
+
+// IPCheckerMockCheckParamPtrs contains pointers to parameters of the IPChecker.Check
+type IPCheckerMockCheckParamPtrs struct {
+	path *string
+	ip   *string
+}
+
+// IPCheckerMockCheckResults contains results of the IPChecker.Check
+type IPCheckerMockCheckResults struct {
+	allowed bool
+	country string
+}
+
+// IPCheckerMockCheckOrigins contains origins of expectations of the IPChecker.Check
+type IPCheckerMockCheckExpectationOrigins struct {
+	origin     string
+	originPath string
+	originIp   string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCheck *mIPCheckerMockCheck) Optional() *mIPCheckerMockCheck {
+	mmCheck.optional = true
+	return mmCheck
+}
+
+// Expect sets up expected params for IPChecker.Check
+func (mmCheck *mIPCheckerMockCheck) Expect(path string, ip string) *mIPCheckerMockCheck {
+	if mmCheck.mock.funcCheck != nil {
+		mmCheck.mock.t.Fatalf("IPCheckerMock.Check mock is already set by Set")
+	}
+
+	if mmCheck.defaultExpectation == nil {
+		mmCheck.defaultExpectation = &IPCheckerMockCheckExpectation{}
+	}
+
+	if mmCheck.defaultExpectation.paramPtrs != nil {
+		mmCheck.mock.t.Fatalf("IPCheckerMock.Check mock is already set by ExpectParams functions")
+	}
+
+	mmCheck.defaultExpectation.params = &IPCheckerMockCheckParams{path, ip}
+	mmCheck.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCheck.expectations {
+		if minimock.Equal(e.params, mmCheck.defaultExpectation.params) {
+			mmCheck.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCheck.defaultExpectation.params)
+		}
+	}
+
+	return mmCheck
+}
+
+// ExpectPathParam1 sets up expected param path for IPChecker.Check
+func (mmCheck *mIPCheckerMockCheck) ExpectPathParam1(path string) *mIPCheckerMockCheck {
+	if mmCheck.mock.funcCheck != nil {
+		mmCheck.mock.t.Fatalf("IPCheckerMock.Check mock is already set by Set")
+	}
+
+	if mmCheck.defaultExpectation == nil {
+		mmCheck.defaultExpectation = &IPCheckerMockCheckExpectation{}
+	}
+
+	if mmCheck.defaultExpectation.params != nil {
+		mmCheck.mock.t.Fatalf("IPCheckerMock.Check mock is already set by Expect")
+	}
+
+	if mmCheck.defaultExpectation.paramPtrs == nil {
+		mmCheck.defaultExpectation.paramPtrs = &IPCheckerMockCheckParamPtrs{}
+	}
+	mmCheck.defaultExpectation.paramPtrs.path = &path
+	mmCheck.defaultExpectation.expectationOrigins.originPath = minimock.CallerInfo(1)
+
+	return mmCheck
+}
+
+// ExpectIpParam2 sets up expected param ip for IPChecker.Check
+func (mmCheck *mIPCheckerMockCheck) ExpectIpParam2(ip string) *mIPCheckerMockCheck {
+	if mmCheck.mock.funcCheck != nil {
+		mmCheck.mock.t.Fatalf("IPCheckerMock.Check mock is already set by Set")
+	}
+
+	if mmCheck.defaultExpectation == nil {
+		mmCheck.defaultExpectation = &IPCheckerMockCheckExpectation{}
+	}
+
+	if mmCheck.defaultExpectation.params != nil {
+		mmCheck.mock.t.Fatalf("IPCheckerMock.Check mock is already set by Expect")
+	}
+
+	if mmCheck.defaultExpectation.paramPtrs == nil {
+		mmCheck.defaultExpectation.paramPtrs = &IPCheckerMockCheckParamPtrs{}
+	}
+	mmCheck.defaultExpectation.paramPtrs.ip = &ip
+	mmCheck.defaultExpectation.expectationOrigins.originIp = minimock.CallerInfo(1)
+
+	return mmCheck
+}
+
+// Inspect accepts an inspector function that has same arguments as the IPChecker.Check
+func (mmCheck *mIPCheckerMockCheck) Inspect(f func(path string, ip string)) *mIPCheckerMockCheck {
+	if mmCheck.mock.inspectFuncCheck != nil {
+		mmCheck.mock.t.Fatalf("Inspect function is already set for IPCheckerMock.Check")
+	}
+
+	mmCheck.mock.inspectFuncCheck = f
+
+	return mmCheck
+}
+
+// Return sets up results that will be returned by IPChecker.Check
+func (mmCheck *mIPCheckerMockCheck) Return(allowed bool, country string) *IPCheckerMock {
+	if mmCheck.mock.funcCheck != nil {
+		mmCheck.mock.t.Fatalf("IPCheckerMock.Check mock is already set by Set")
+	}
+
+	if mmCheck.defaultExpectation == nil {
+		mmCheck.defaultExpectation = &IPCheckerMockCheckExpectation{mock: mmCheck.mock}
+	}
+	mmCheck.defaultExpectation.results = &IPCheckerMockCheckResults{allowed, country}
+	mmCheck.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCheck.mock
+}
+
+// Set uses given function f to mock the IPChecker.Check method
+func (mmCheck *mIPCheckerMockCheck) Set(f func(path string, ip string) (allowed bool, country string)) *IPCheckerMock {
+	if mmCheck.defaultExpectation != nil {
+		mmCheck.mock.t.Fatalf("Default expectation is already set for the IPChecker.Check method")
+	}
+
+	if len(mmCheck.expectations) > 0 {
+		mmCheck.mock.t.Fatalf("Some expectations are already set for the IPChecker.Check method")
+	}
+
+	mmCheck.mock.funcCheck = f
+	mmCheck.mock.funcCheckOrigin = minimock.CallerInfo(1)
+	return mmCheck.mock
+}
+
+// When sets expectation for the IPChecker.Check which will trigger the result defined by the following
+// Then helper
+func (mmCheck *mIPCheckerMockCheck) When(path string, ip string) *IPCheckerMockCheckExpectation {
+	if mmCheck.mock.funcCheck != nil {
+		mmCheck.mock.t.Fatalf("IPCheckerMock.Check mock is already set by Set")
+	}
+
+	expectation := &IPCheckerMockCheckExpectation{
+		mock:               mmCheck.mock,
+		params:             &IPCheckerMockCheckParams{path, ip},
+		expectationOrigins: IPCheckerMockCheckExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCheck.expectations = append(mmCheck.expectations, expectation)
+	return expectation
+}
+
+// Then sets up IPChecker.Check return parameters for the expectation previously defined by the When method
+func (e *IPCheckerMockCheckExpectation) Then(allowed bool, country string) *IPCheckerMock {
+	e.results = &IPCheckerMockCheckResults{allowed, country}
+	return e.mock
+}
+
+// Times sets number of times IPChecker.Check should be invoked
+func (mmCheck *mIPCheckerMockCheck) Times(n uint64) *mIPCheckerMockCheck {
+	if n == 0 {
+		mmCheck.mock.t.Fatalf("Times of IPCheckerMock.Check mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCheck.expectedInvocations, n)
+	mmCheck.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCheck
+}
+
+func (mmCheck *mIPCheckerMockCheck) invocationsDone() bool {
+	if len(mmCheck.expectations) == 0 && mmCheck.defaultExpectation == nil && mmCheck.mock.funcCheck == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCheck.mock.afterCheckCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCheck.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Check implements IPChecker
+func (mmCheck *IPCheckerMock) Check(path string, ip string) (allowed bool, country string) {
+	mm_atomic.AddUint64(&mmCheck.beforeCheckCounter, 1)
+	defer mm_atomic.AddUint64(&mmCheck.afterCheckCounter, 1)
+
+	mmCheck.t.Helper()
+
+	if mmCheck.inspectFuncCheck != nil {
+		mmCheck.inspectFuncCheck(path, ip)
+	}
+
+	mm_params := IPCheckerMockCheckParams{path, ip}
+
+	// Record call args
+	mmCheck.CheckMock.mutex.Lock()
+	mmCheck.CheckMock.callArgs = append(mmCheck.CheckMock.callArgs, &mm_params)
+	mmCheck.CheckMock.mutex.Unlock()
+
+	for _, e := range mmCheck.CheckMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.allowed, e.results.country
+		}
+	}
+
+	if mmCheck.CheckMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCheck.CheckMock.defaultExpectation.Counter, 1)
+		mm_want := mmCheck.CheckMock.defaultExpectation.params
+		mm_want_ptrs := mmCheck.CheckMock.defaultExpectation.paramPtrs
+
+		mm_got := IPCheckerMockCheckParams{path, ip}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.path != nil && !minimock.Equal(*mm_want_ptrs.path, mm_got.path) {
+				mmCheck.t.Errorf("IPCheckerMock.Check got unexpected parameter path, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCheck.CheckMock.defaultExpectation.expectationOrigins.originPath, *mm_want_ptrs.path, mm_got.path, minimock.Diff(*mm_want_ptrs.path, mm_got.path))
+			}
+
+			if mm_want_ptrs.ip != nil && !minimock.Equal(*mm_want_ptrs.ip, mm_got.ip) {
+				mmCheck.t.Errorf("IPCheckerMock.Check got unexpected parameter ip, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCheck.CheckMock.defaultExpectation.expectationOrigins.originIp, *mm_want_ptrs.ip, mm_got.ip, minimock.Diff(*mm_want_ptrs.ip, mm_got.ip))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCheck.t.Errorf("IPCheckerMock.Check got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCheck.CheckMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCheck.CheckMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCheck.t.Fatal("No results are set for the IPCheckerMock.Check")
+		}
+		return (*mm_results).allowed, (*mm_results).country
+	}
+	if mmCheck.funcCheck != nil {
+		return mmCheck.funcCheck(path, ip)
+	}
+	mmCheck.t.Fatalf("Unexpected call to IPCheckerMock.Check. %v %v", path, ip)
+	return
+}
+
+// CheckAfterCounter returns a count of finished IPCheckerMock.Check invocations
+func (mmCheck *IPCheckerMock) CheckAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCheck.afterCheckCounter)
+}
+
+// CheckBeforeCounter returns a count of IPCheckerMock.Check invocations
+func (mmCheck *IPCheckerMock) CheckBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCheck.beforeCheckCounter)
+}
+
+// Calls returns a list of arguments used in each call to IPCheckerMock.Check.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCheck *mIPCheckerMockCheck) Calls() []*IPCheckerMockCheckParams {
+	mmCheck.mutex.RLock()
+
+	argCopy := make([]*IPCheckerMockCheckParams, len(mmCheck.callArgs))
+	copy(argCopy, mmCheck.callArgs)
+
+	mmCheck.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCheckDone returns true if the count of the Check invocations corresponds
+// the number of defined expectations
+func (m *IPCheckerMock) MinimockCheckDone() bool {
+	if m.CheckMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CheckMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CheckMock.invocationsDone()
+}
+
+// MinimockCheckInspect logs each unmet expectation
+func (m *IPCheckerMock) MinimockCheckInspect() {
+	for _, e := range m.CheckMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to IPCheckerMock.Check at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCheckCounter := mm_atomic.LoadUint64(&m.afterCheckCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CheckMock.defaultExpectation != nil && afterCheckCounter < 1 {
+		if m.CheckMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to IPCheckerMock.Check at\n%s", m.CheckMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to IPCheckerMock.Check at\n%s with params: %#v", m.CheckMock.defaultExpectation.expectationOrigins.origin, *m.CheckMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCheck != nil && afterCheckCounter < 1 {
+		m.t.Errorf("Expected call to IPCheckerMock.Check at\n%s", m.funcCheckOrigin)
+	}
+
+	if !m.CheckMock.invocationsDone() && afterCheckCounter > 0 {
+		m.t.Errorf("Expected %d calls to IPCheckerMock.Check at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CheckMock.expectedInvocations), m.CheckMock.expectedInvocationsOrigin, afterCheckCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *IPCheckerMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCheckInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *IPCheckerMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *IPCheckerMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCheckDone()
+}
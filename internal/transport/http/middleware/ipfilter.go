@@ -0,0 +1,40 @@
+package middleware
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/alonsoF100/authorization-service/internal/apperrors"
+	"github.com/alonsoF100/authorization-service/internal/transport/http/dto"
+	"github.com/alonsoF100/authorization-service/internal/transport/http/help"
+)
+
+// IPChecker decides which client addresses may request a path, usually an
+// ipfilter.Filter
+type IPChecker interface {
+	Check(path, ip string) (allowed bool, country string)
+}
+
+// IPFilter rejects clients the checker doesn't allow with 403, it must run
+// after RealIP
+func IPFilter(checker IPChecker) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			const op = "middleware/ipfilter.go/IPFilter"
+
+			ip := help.ClientIP(r)
+			if allowed, country := checker.Check(r.URL.Path, ip); !allowed {
+				slog.Info("Request from a blocked address",
+					slog.String("op", op),
+					slog.String("path", r.URL.Path),
+					slog.String("ip", ip),
+					slog.String("country", country),
+				)
+				help.WriteJSON(w, http.StatusForbidden, dto.NewErrorResponse(apperrors.ErrIPNotAllowed))
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
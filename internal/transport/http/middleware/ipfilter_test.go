@@ -0,0 +1,50 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/alonsoF100/authorization-service/internal/apperrors"
+	"github.com/alonsoF100/authorization-service/internal/transport/http/help"
+	"github.com/alonsoF100/authorization-service/internal/transport/http/middleware"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIPFilter(t *testing.T) {
+	tests := []struct {
+		name           string
+		allowed        bool
+		expectedStatus int
+	}{
+		{"allowed", true, http.StatusOK},
+		{"blocked", false, http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := minimock.NewController(t)
+			mockChecker := middleware.NewIPCheckerMock(mc)
+			// the resolved client address is checked, not the proxy
+			mockChecker.CheckMock.Expect("/admin/service-accounts", "198.51.100.7").Return(tt.allowed, "DE")
+
+			nextHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			})
+			handler := middleware.IPFilter(mockChecker)(nextHandler)
+
+			req := httptest.NewRequest(http.MethodGet, "/admin/service-accounts", nil)
+			req.RemoteAddr = "10.0.0.1:1234"
+			req = req.WithContext(help.WithClientIP(req.Context(), "198.51.100.7"))
+
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, req)
+
+			require.Equal(t, tt.expectedStatus, rr.Code)
+			if !tt.allowed {
+				require.Contains(t, rr.Body.String(), apperrors.ErrIPNotAllowed.Error())
+			}
+		})
+	}
+}
@@ -0,0 +1,449 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package middleware
+
+//go:generate minimock -i github.com/alonsoF100/authorization-service/internal/transport/http/middleware.PersonalAccessTokenAuthenticator -o personal_access_token_authenticator_mock_test.go -n PersonalAccessTokenAuthenticatorMock -p middleware
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/alonsoF100/authorization-service/internal/models"
+	"github.com/gojuno/minimock/v3"
+)
+
+// PersonalAccessTokenAuthenticatorMock implements PersonalAccessTokenAuthenticator
+type PersonalAccessTokenAuthenticatorMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcAuthenticatePersonalAccessToken          func(ctx context.Context, token string, ip string) (cp1 *models.Claims, err error)
+	funcAuthenticatePersonalAccessTokenOrigin    string
+	inspectFuncAuthenticatePersonalAccessToken   func(ctx context.Context, token string, ip string)
+	afterAuthenticatePersonalAccessTokenCounter  uint64
+	beforeAuthenticatePersonalAccessTokenCounter uint64
+	AuthenticatePersonalAccessTokenMock          mPersonalAccessTokenAuthenticatorMockAuthenticatePersonalAccessToken
+}
+
+// NewPersonalAccessTokenAuthenticatorMock returns a mock for PersonalAccessTokenAuthenticator
+func NewPersonalAccessTokenAuthenticatorMock(t minimock.Tester) *PersonalAccessTokenAuthenticatorMock {
+	m := &PersonalAccessTokenAuthenticatorMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.AuthenticatePersonalAccessTokenMock = mPersonalAccessTokenAuthenticatorMockAuthenticatePersonalAccessToken{mock: m}
+	m.AuthenticatePersonalAccessTokenMock.callArgs = []*PersonalAccessTokenAuthenticatorMockAuthenticatePersonalAccessTokenParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mPersonalAccessTokenAuthenticatorMockAuthenticatePersonalAccessToken struct {
+	optional           bool
+	mock               *PersonalAccessTokenAuthenticatorMock
+	defaultExpectation *PersonalAccessTokenAuthenticatorMockAuthenticatePersonalAccessTokenExpectation
+	expectations       []*PersonalAccessTokenAuthenticatorMockAuthenticatePersonalAccessTokenExpectation
+
+	callArgs []*PersonalAccessTokenAuthenticatorMockAuthenticatePersonalAccessTokenParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// PersonalAccessTokenAuthenticatorMockAuthenticatePersonalAccessTokenExpectation specifies expectation struct of the PersonalAccessTokenAuthenticator.AuthenticatePersonalAccessToken
+type PersonalAccessTokenAuthenticatorMockAuthenticatePersonalAccessTokenExpectation struct {
+	mock               *PersonalAccessTokenAuthenticatorMock
+	params             *PersonalAccessTokenAuthenticatorMockAuthenticatePersonalAccessTokenParams
+	paramPtrs          *PersonalAccessTokenAuthenticatorMockAuthenticatePersonalAccessTokenParamPtrs
+	expectationOrigins PersonalAccessTokenAuthenticatorMockAuthenticatePersonalAccessTokenExpectationOrigins
+	results            *PersonalAccessTokenAuthenticatorMockAuthenticatePersonalAccessTokenResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// PersonalAccessTokenAuthenticatorMockAuthenticatePersonalAccessTokenParams contains parameters of the PersonalAccessTokenAuthenticator.AuthenticatePersonalAccessToken
+type PersonalAccessTokenAuthenticatorMockAuthenticatePersonalAccessTokenParams struct {
+	ctx   context.Context
+	token string
+	ip    string
+}
+
+// PersonalAccessTokenAuthenticatorMockAuthenticatePersonalAccessTokenParamPtrs contains pointers to parameters of the PersonalAccessTokenAuthenticator.AuthenticatePersonalAccessToken
+type PersonalAccessTokenAuthenticatorMockAuthenticatePersonalAccessTokenParamPtrs struct {
+	ctx   *context.Context
+	token *string
+	ip    *string
+}
+
+// PersonalAccessTokenAuthenticatorMockAuthenticatePersonalAccessTokenResults contains results of the PersonalAccessTokenAuthenticator.AuthenticatePersonalAccessToken
+type PersonalAccessTokenAuthenticatorMockAuthenticatePersonalAccessTokenResults struct {
+	cp1 *models.Claims
+	err error
+}
+
+// PersonalAccessTokenAuthenticatorMockAuthenticatePersonalAccessTokenOrigins contains origins of expectations of the PersonalAccessTokenAuthenticator.AuthenticatePersonalAccessToken
+type PersonalAccessTokenAuthenticatorMockAuthenticatePersonalAccessTokenExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originToken string
+	originIp    string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmAuthenticatePersonalAccessToken *mPersonalAccessTokenAuthenticatorMockAuthenticatePersonalAccessToken) Optional() *mPersonalAccessTokenAuthenticatorMockAuthenticatePersonalAccessToken {
+	mmAuthenticatePersonalAccessToken.optional = true
+	return mmAuthenticatePersonalAccessToken
+}
+
+// Expect sets up expected params for PersonalAccessTokenAuthenticator.AuthenticatePersonalAccessToken
+func (mmAuthenticatePersonalAccessToken *mPersonalAccessTokenAuthenticatorMockAuthenticatePersonalAccessToken) Expect(ctx context.Context, token string, ip string) *mPersonalAccessTokenAuthenticatorMockAuthenticatePersonalAccessToken {
+	if mmAuthenticatePersonalAccessToken.mock.funcAuthenticatePersonalAccessToken != nil {
+		mmAuthenticatePersonalAccessToken.mock.t.Fatalf("PersonalAccessTokenAuthenticatorMock.AuthenticatePersonalAccessToken mock is already set by Set")
+	}
+
+	if mmAuthenticatePersonalAccessToken.defaultExpectation == nil {
+		mmAuthenticatePersonalAccessToken.defaultExpectation = &PersonalAccessTokenAuthenticatorMockAuthenticatePersonalAccessTokenExpectation{}
+	}
+
+	if mmAuthenticatePersonalAccessToken.defaultExpectation.paramPtrs != nil {
+		mmAuthenticatePersonalAccessToken.mock.t.Fatalf("PersonalAccessTokenAuthenticatorMock.AuthenticatePersonalAccessToken mock is already set by ExpectParams functions")
+	}
+
+	mmAuthenticatePersonalAccessToken.defaultExpectation.params = &PersonalAccessTokenAuthenticatorMockAuthenticatePersonalAccessTokenParams{ctx, token, ip}
+	mmAuthenticatePersonalAccessToken.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmAuthenticatePersonalAccessToken.expectations {
+		if minimock.Equal(e.params, mmAuthenticatePersonalAccessToken.defaultExpectation.params) {
+			mmAuthenticatePersonalAccessToken.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAuthenticatePersonalAccessToken.defaultExpectation.params)
+		}
+	}
+
+	return mmAuthenticatePersonalAccessToken
+}
+
+// ExpectCtxParam1 sets up expected param ctx for PersonalAccessTokenAuthenticator.AuthenticatePersonalAccessToken
+func (mmAuthenticatePersonalAccessToken *mPersonalAccessTokenAuthenticatorMockAuthenticatePersonalAccessToken) ExpectCtxParam1(ctx context.Context) *mPersonalAccessTokenAuthenticatorMockAuthenticatePersonalAccessToken {
+	if mmAuthenticatePersonalAccessToken.mock.funcAuthenticatePersonalAccessToken != nil {
+		mmAuthenticatePersonalAccessToken.mock.t.Fatalf("PersonalAccessTokenAuthenticatorMock.AuthenticatePersonalAccessToken mock is already set by Set")
+	}
+
+	if mmAuthenticatePersonalAccessToken.defaultExpectation == nil {
+		mmAuthenticatePersonalAccessToken.defaultExpectation = &PersonalAccessTokenAuthenticatorMockAuthenticatePersonalAccessTokenExpectation{}
+	}
+
+	if mmAuthenticatePersonalAccessToken.defaultExpectation.params != nil {
+		mmAuthenticatePersonalAccessToken.mock.t.Fatalf("PersonalAccessTokenAuthenticatorMock.AuthenticatePersonalAccessToken mock is already set by Expect")
+	}
+
+	if mmAuthenticatePersonalAccessToken.defaultExpectation.paramPtrs == nil {
+		mmAuthenticatePersonalAccessToken.defaultExpectation.paramPtrs = &PersonalAccessTokenAuthenticatorMockAuthenticatePersonalAccessTokenParamPtrs{}
+	}
+	mmAuthenticatePersonalAccessToken.defaultExpectation.paramPtrs.ctx = &ctx
+	mmAuthenticatePersonalAccessToken.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmAuthenticatePersonalAccessToken
+}
+
+// ExpectTokenParam2 sets up expected param token for PersonalAccessTokenAuthenticator.AuthenticatePersonalAccessToken
+func (mmAuthenticatePersonalAccessToken *mPersonalAccessTokenAuthenticatorMockAuthenticatePersonalAccessToken) ExpectTokenParam2(token string) *mPersonalAccessTokenAuthenticatorMockAuthenticatePersonalAccessToken {
+	if mmAuthenticatePersonalAccessToken.mock.funcAuthenticatePersonalAccessToken != nil {
+		mmAuthenticatePersonalAccessToken.mock.t.Fatalf("PersonalAccessTokenAuthenticatorMock.AuthenticatePersonalAccessToken mock is already set by Set")
+	}
+
+	if mmAuthenticatePersonalAccessToken.defaultExpectation == nil {
+		mmAuthenticatePersonalAccessToken.defaultExpectation = &PersonalAccessTokenAuthenticatorMockAuthenticatePersonalAccessTokenExpectation{}
+	}
+
+	if mmAuthenticatePersonalAccessToken.defaultExpectation.params != nil {
+		mmAuthenticatePersonalAccessToken.mock.t.Fatalf("PersonalAccessTokenAuthenticatorMock.AuthenticatePersonalAccessToken mock is already set by Expect")
+	}
+
+	if mmAuthenticatePersonalAccessToken.defaultExpectation.paramPtrs == nil {
+		mmAuthenticatePersonalAccessToken.defaultExpectation.paramPtrs = &PersonalAccessTokenAuthenticatorMockAuthenticatePersonalAccessTokenParamPtrs{}
+	}
+	mmAuthenticatePersonalAccessToken.defaultExpectation.paramPtrs.token = &token
+	mmAuthenticatePersonalAccessToken.defaultExpectation.expectationOrigins.originToken = minimock.CallerInfo(1)
+
+	return mmAuthenticatePersonalAccessToken
+}
+
+// ExpectIpParam3 sets up expected param ip for PersonalAccessTokenAuthenticator.AuthenticatePersonalAccessToken
+func (mmAuthenticatePersonalAccessToken *mPersonalAccessTokenAuthenticatorMockAuthenticatePersonalAccessToken) ExpectIpParam3(ip string) *mPersonalAccessTokenAuthenticatorMockAuthenticatePersonalAccessToken {
+	if mmAuthenticatePersonalAccessToken.mock.funcAuthenticatePersonalAccessToken != nil {
+		mmAuthenticatePersonalAccessToken.mock.t.Fatalf("PersonalAccessTokenAuthenticatorMock.AuthenticatePersonalAccessToken mock is already set by Set")
+	}
+
+	if mmAuthenticatePersonalAccessToken.defaultExpectation == nil {
+		mmAuthenticatePersonalAccessToken.defaultExpectation = &PersonalAccessTokenAuthenticatorMockAuthenticatePersonalAccessTokenExpectation{}
+	}
+
+	if mmAuthenticatePersonalAccessToken.defaultExpectation.params != nil {
+		mmAuthenticatePersonalAccessToken.mock.t.Fatalf("PersonalAccessTokenAuthenticatorMock.AuthenticatePersonalAccessToken mock is already set by Expect")
+	}
+
+	if mmAuthenticatePersonalAccessToken.defaultExpectation.paramPtrs == nil {
+		mmAuthenticatePersonalAccessToken.defaultExpectation.paramPtrs = &PersonalAccessTokenAuthenticatorMockAuthenticatePersonalAccessTokenParamPtrs{}
+	}
+	mmAuthenticatePersonalAccessToken.defaultExpectation.paramPtrs.ip = &ip
+	mmAuthenticatePersonalAccessToken.defaultExpectation.expectationOrigins.originIp = minimock.CallerInfo(1)
+
+	return mmAuthenticatePersonalAccessToken
+}
+
+// Inspect accepts an inspector function that has same arguments as the PersonalAccessTokenAuthenticator.AuthenticatePersonalAccessToken
+func (mmAuthenticatePersonalAccessToken *mPersonalAccessTokenAuthenticatorMockAuthenticatePersonalAccessToken) Inspect(f func(ctx context.Context, token string, ip string)) *mPersonalAccessTokenAuthenticatorMockAuthenticatePersonalAccessToken {
+	if mmAuthenticatePersonalAccessToken.mock.inspectFuncAuthenticatePersonalAccessToken != nil {
+		mmAuthenticatePersonalAccessToken.mock.t.Fatalf("Inspect function is already set for PersonalAccessTokenAuthenticatorMock.AuthenticatePersonalAccessToken")
+	}
+
+	mmAuthenticatePersonalAccessToken.mock.inspectFuncAuthenticatePersonalAccessToken = f
+
+	return mmAuthenticatePersonalAccessToken
+}
+
+// Return sets up results that will be returned by PersonalAccessTokenAuthenticator.AuthenticatePersonalAccessToken
+func (mmAuthenticatePersonalAccessToken *mPersonalAccessTokenAuthenticatorMockAuthenticatePersonalAccessToken) Return(cp1 *models.Claims, err error) *PersonalAccessTokenAuthenticatorMock {
+	if mmAuthenticatePersonalAccessToken.mock.funcAuthenticatePersonalAccessToken != nil {
+		mmAuthenticatePersonalAccessToken.mock.t.Fatalf("PersonalAccessTokenAuthenticatorMock.AuthenticatePersonalAccessToken mock is already set by Set")
+	}
+
+	if mmAuthenticatePersonalAccessToken.defaultExpectation == nil {
+		mmAuthenticatePersonalAccessToken.defaultExpectation = &PersonalAccessTokenAuthenticatorMockAuthenticatePersonalAccessTokenExpectation{mock: mmAuthenticatePersonalAccessToken.mock}
+	}
+	mmAuthenticatePersonalAccessToken.defaultExpectation.results = &PersonalAccessTokenAuthenticatorMockAuthenticatePersonalAccessTokenResults{cp1, err}
+	mmAuthenticatePersonalAccessToken.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmAuthenticatePersonalAccessToken.mock
+}
+
+// Set uses given function f to mock the PersonalAccessTokenAuthenticator.AuthenticatePersonalAccessToken method
+func (mmAuthenticatePersonalAccessToken *mPersonalAccessTokenAuthenticatorMockAuthenticatePersonalAccessToken) Set(f func(ctx context.Context, token string, ip string) (cp1 *models.Claims, err error)) *PersonalAccessTokenAuthenticatorMock {
+	if mmAuthenticatePersonalAccessToken.defaultExpectation != nil {
+		mmAuthenticatePersonalAccessToken.mock.t.Fatalf("Default expectation is already set for the PersonalAccessTokenAuthenticator.AuthenticatePersonalAccessToken method")
+	}
+
+	if len(mmAuthenticatePersonalAccessToken.expectations) > 0 {
+		mmAuthenticatePersonalAccessToken.mock.t.Fatalf("Some expectations are already set for the PersonalAccessTokenAuthenticator.AuthenticatePersonalAccessToken method")
+	}
+
+	mmAuthenticatePersonalAccessToken.mock.funcAuthenticatePersonalAccessToken = f
+	mmAuthenticatePersonalAccessToken.mock.funcAuthenticatePersonalAccessTokenOrigin = minimock.CallerInfo(1)
+	return mmAuthenticatePersonalAccessToken.mock
+}
+
+// When sets expectation for the PersonalAccessTokenAuthenticator.AuthenticatePersonalAccessToken which will trigger the result defined by the following
+// Then helper
+func (mmAuthenticatePersonalAccessToken *mPersonalAccessTokenAuthenticatorMockAuthenticatePersonalAccessToken) When(ctx context.Context, token string, ip string) *PersonalAccessTokenAuthenticatorMockAuthenticatePersonalAccessTokenExpectation {
+	if mmAuthenticatePersonalAccessToken.mock.funcAuthenticatePersonalAccessToken != nil {
+		mmAuthenticatePersonalAccessToken.mock.t.Fatalf("PersonalAccessTokenAuthenticatorMock.AuthenticatePersonalAccessToken mock is already set by Set")
+	}
+
+	expectation := &PersonalAccessTokenAuthenticatorMockAuthenticatePersonalAccessTokenExpectation{
+		mock:               mmAuthenticatePersonalAccessToken.mock,
+		params:             &PersonalAccessTokenAuthenticatorMockAuthenticatePersonalAccessTokenParams{ctx, token, ip},
+		expectationOrigins: PersonalAccessTokenAuthenticatorMockAuthenticatePersonalAccessTokenExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmAuthenticatePersonalAccessToken.expectations = append(mmAuthenticatePersonalAccessToken.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PersonalAccessTokenAuthenticator.AuthenticatePersonalAccessToken return parameters for the expectation previously defined by the When method
+func (e *PersonalAccessTokenAuthenticatorMockAuthenticatePersonalAccessTokenExpectation) Then(cp1 *models.Claims, err error) *PersonalAccessTokenAuthenticatorMock {
+	e.results = &PersonalAccessTokenAuthenticatorMockAuthenticatePersonalAccessTokenResults{cp1, err}
+	return e.mock
+}
+
+// Times sets number of times PersonalAccessTokenAuthenticator.AuthenticatePersonalAccessToken should be invoked
+func (mmAuthenticatePersonalAccessToken *mPersonalAccessTokenAuthenticatorMockAuthenticatePersonalAccessToken) Times(n uint64) *mPersonalAccessTokenAuthenticatorMockAuthenticatePersonalAccessToken {
+	if n == 0 {
+		mmAuthenticatePersonalAccessToken.mock.t.Fatalf("Times of PersonalAccessTokenAuthenticatorMock.AuthenticatePersonalAccessToken mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmAuthenticatePersonalAccessToken.expectedInvocations, n)
+	mmAuthenticatePersonalAccessToken.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmAuthenticatePersonalAccessToken
+}
+
+func (mmAuthenticatePersonalAccessToken *mPersonalAccessTokenAuthenticatorMockAuthenticatePersonalAccessToken) invocationsDone() bool {
+	if len(mmAuthenticatePersonalAccessToken.expectations) == 0 && mmAuthenticatePersonalAccessToken.defaultExpectation == nil && mmAuthenticatePersonalAccessToken.mock.funcAuthenticatePersonalAccessToken == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmAuthenticatePersonalAccessToken.mock.afterAuthenticatePersonalAccessTokenCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmAuthenticatePersonalAccessToken.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// AuthenticatePersonalAccessToken implements PersonalAccessTokenAuthenticator
+func (mmAuthenticatePersonalAccessToken *PersonalAccessTokenAuthenticatorMock) AuthenticatePersonalAccessToken(ctx context.Context, token string, ip string) (cp1 *models.Claims, err error) {
+	mm_atomic.AddUint64(&mmAuthenticatePersonalAccessToken.beforeAuthenticatePersonalAccessTokenCounter, 1)
+	defer mm_atomic.AddUint64(&mmAuthenticatePersonalAccessToken.afterAuthenticatePersonalAccessTokenCounter, 1)
+
+	mmAuthenticatePersonalAccessToken.t.Helper()
+
+	if mmAuthenticatePersonalAccessToken.inspectFuncAuthenticatePersonalAccessToken != nil {
+		mmAuthenticatePersonalAccessToken.inspectFuncAuthenticatePersonalAccessToken(ctx, token, ip)
+	}
+
+	mm_params := PersonalAccessTokenAuthenticatorMockAuthenticatePersonalAccessTokenParams{ctx, token, ip}
+
+	// Record call args
+	mmAuthenticatePersonalAccessToken.AuthenticatePersonalAccessTokenMock.mutex.Lock()
+	mmAuthenticatePersonalAccessToken.AuthenticatePersonalAccessTokenMock.callArgs = append(mmAuthenticatePersonalAccessToken.AuthenticatePersonalAccessTokenMock.callArgs, &mm_params)
+	mmAuthenticatePersonalAccessToken.AuthenticatePersonalAccessTokenMock.mutex.Unlock()
+
+	for _, e := range mmAuthenticatePersonalAccessToken.AuthenticatePersonalAccessTokenMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.cp1, e.results.err
+		}
+	}
+
+	if mmAuthenticatePersonalAccessToken.AuthenticatePersonalAccessTokenMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAuthenticatePersonalAccessToken.AuthenticatePersonalAccessTokenMock.defaultExpectation.Counter, 1)
+		mm_want := mmAuthenticatePersonalAccessToken.AuthenticatePersonalAccessTokenMock.defaultExpectation.params
+		mm_want_ptrs := mmAuthenticatePersonalAccessToken.AuthenticatePersonalAccessTokenMock.defaultExpectation.paramPtrs
+
+		mm_got := PersonalAccessTokenAuthenticatorMockAuthenticatePersonalAccessTokenParams{ctx, token, ip}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmAuthenticatePersonalAccessToken.t.Errorf("PersonalAccessTokenAuthenticatorMock.AuthenticatePersonalAccessToken got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAuthenticatePersonalAccessToken.AuthenticatePersonalAccessTokenMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.token != nil && !minimock.Equal(*mm_want_ptrs.token, mm_got.token) {
+				mmAuthenticatePersonalAccessToken.t.Errorf("PersonalAccessTokenAuthenticatorMock.AuthenticatePersonalAccessToken got unexpected parameter token, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAuthenticatePersonalAccessToken.AuthenticatePersonalAccessTokenMock.defaultExpectation.expectationOrigins.originToken, *mm_want_ptrs.token, mm_got.token, minimock.Diff(*mm_want_ptrs.token, mm_got.token))
+			}
+
+			if mm_want_ptrs.ip != nil && !minimock.Equal(*mm_want_ptrs.ip, mm_got.ip) {
+				mmAuthenticatePersonalAccessToken.t.Errorf("PersonalAccessTokenAuthenticatorMock.AuthenticatePersonalAccessToken got unexpected parameter ip, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAuthenticatePersonalAccessToken.AuthenticatePersonalAccessTokenMock.defaultExpectation.expectationOrigins.originIp, *mm_want_ptrs.ip, mm_got.ip, minimock.Diff(*mm_want_ptrs.ip, mm_got.ip))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmAuthenticatePersonalAccessToken.t.Errorf("PersonalAccessTokenAuthenticatorMock.AuthenticatePersonalAccessToken got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmAuthenticatePersonalAccessToken.AuthenticatePersonalAccessTokenMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmAuthenticatePersonalAccessToken.AuthenticatePersonalAccessTokenMock.defaultExpectation.results
+		if mm_results == nil {
+			mmAuthenticatePersonalAccessToken.t.Fatal("No results are set for the PersonalAccessTokenAuthenticatorMock.AuthenticatePersonalAccessToken")
+		}
+		return (*mm_results).cp1, (*mm_results).err
+	}
+	if mmAuthenticatePersonalAccessToken.funcAuthenticatePersonalAccessToken != nil {
+		return mmAuthenticatePersonalAccessToken.funcAuthenticatePersonalAccessToken(ctx, token, ip)
+	}
+	mmAuthenticatePersonalAccessToken.t.Fatalf("Unexpected call to PersonalAccessTokenAuthenticatorMock.AuthenticatePersonalAccessToken. %v %v %v", ctx, token, ip)
+	return
+}
+
+// AuthenticatePersonalAccessTokenAfterCounter returns a count of finished PersonalAccessTokenAuthenticatorMock.AuthenticatePersonalAccessToken invocations
+func (mmAuthenticatePersonalAccessToken *PersonalAccessTokenAuthenticatorMock) AuthenticatePersonalAccessTokenAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAuthenticatePersonalAccessToken.afterAuthenticatePersonalAccessTokenCounter)
+}
+
+// AuthenticatePersonalAccessTokenBeforeCounter returns a count of PersonalAccessTokenAuthenticatorMock.AuthenticatePersonalAccessToken invocations
+func (mmAuthenticatePersonalAccessToken *PersonalAccessTokenAuthenticatorMock) AuthenticatePersonalAccessTokenBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAuthenticatePersonalAccessToken.beforeAuthenticatePersonalAccessTokenCounter)
+}
+
+// Calls returns a list of arguments used in each call to PersonalAccessTokenAuthenticatorMock.AuthenticatePersonalAccessToken.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmAuthenticatePersonalAccessToken *mPersonalAccessTokenAuthenticatorMockAuthenticatePersonalAccessToken) Calls() []*PersonalAccessTokenAuthenticatorMockAuthenticatePersonalAccessTokenParams {
+	mmAuthenticatePersonalAccessToken.mutex.RLock()
+
+	argCopy := make([]*PersonalAccessTokenAuthenticatorMockAuthenticatePersonalAccessTokenParams, len(mmAuthenticatePersonalAccessToken.callArgs))
+	copy(argCopy, mmAuthenticatePersonalAccessToken.callArgs)
+
+	mmAuthenticatePersonalAccessToken.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockAuthenticatePersonalAccessTokenDone returns true if the count of the AuthenticatePersonalAccessToken invocations corresponds
+// the number of defined expectations
+func (m *PersonalAccessTokenAuthenticatorMock) MinimockAuthenticatePersonalAccessTokenDone() bool {
+	if m.AuthenticatePersonalAccessTokenMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.AuthenticatePersonalAccessTokenMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.AuthenticatePersonalAccessTokenMock.invocationsDone()
+}
+
+// MinimockAuthenticatePersonalAccessTokenInspect logs each unmet expectation
+func (m *PersonalAccessTokenAuthenticatorMock) MinimockAuthenticatePersonalAccessTokenInspect() {
+	for _, e := range m.AuthenticatePersonalAccessTokenMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PersonalAccessTokenAuthenticatorMock.AuthenticatePersonalAccessToken at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterAuthenticatePersonalAccessTokenCounter := mm_atomic.LoadUint64(&m.afterAuthenticatePersonalAccessTokenCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.AuthenticatePersonalAccessTokenMock.defaultExpectation != nil && afterAuthenticatePersonalAccessTokenCounter < 1 {
+		if m.AuthenticatePersonalAccessTokenMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to PersonalAccessTokenAuthenticatorMock.AuthenticatePersonalAccessToken at\n%s", m.AuthenticatePersonalAccessTokenMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to PersonalAccessTokenAuthenticatorMock.AuthenticatePersonalAccessToken at\n%s with params: %#v", m.AuthenticatePersonalAccessTokenMock.defaultExpectation.expectationOrigins.origin, *m.AuthenticatePersonalAccessTokenMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcAuthenticatePersonalAccessToken != nil && afterAuthenticatePersonalAccessTokenCounter < 1 {
+		m.t.Errorf("Expected call to PersonalAccessTokenAuthenticatorMock.AuthenticatePersonalAccessToken at\n%s", m.funcAuthenticatePersonalAccessTokenOrigin)
+	}
+
+	if !m.AuthenticatePersonalAccessTokenMock.invocationsDone() && afterAuthenticatePersonalAccessTokenCounter > 0 {
+		m.t.Errorf("Expected %d calls to PersonalAccessTokenAuthenticatorMock.AuthenticatePersonalAccessToken at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.AuthenticatePersonalAccessTokenMock.expectedInvocations), m.AuthenticatePersonalAccessTokenMock.expectedInvocationsOrigin, afterAuthenticatePersonalAccessTokenCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *PersonalAccessTokenAuthenticatorMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockAuthenticatePersonalAccessTokenInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *PersonalAccessTokenAuthenticatorMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *PersonalAccessTokenAuthenticatorMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockAuthenticatePersonalAccessTokenDone()
+}
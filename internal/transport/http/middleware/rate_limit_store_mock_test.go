@@ -0,0 +1,450 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package middleware
+
+//go:generate minimock -i github.com/alonsoF100/authorization-service/internal/transport/http/middleware.RateLimitStore -o rate_limit_store_mock_test.go -n RateLimitStoreMock -p middleware
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/alonsoF100/authorization-service/internal/config"
+	"github.com/alonsoF100/authorization-service/internal/ratelimit"
+	"github.com/gojuno/minimock/v3"
+)
+
+// RateLimitStoreMock implements RateLimitStore
+type RateLimitStoreMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcTake          func(ctx context.Context, key string, limit config.RateLimit) (r1 ratelimit.Result, err error)
+	funcTakeOrigin    string
+	inspectFuncTake   func(ctx context.Context, key string, limit config.RateLimit)
+	afterTakeCounter  uint64
+	beforeTakeCounter uint64
+	TakeMock          mRateLimitStoreMockTake
+}
+
+// NewRateLimitStoreMock returns a mock for RateLimitStore
+func NewRateLimitStoreMock(t minimock.Tester) *RateLimitStoreMock {
+	m := &RateLimitStoreMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.TakeMock = mRateLimitStoreMockTake{mock: m}
+	m.TakeMock.callArgs = []*RateLimitStoreMockTakeParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mRateLimitStoreMockTake struct {
+	optional           bool
+	mock               *RateLimitStoreMock
+	defaultExpectation *RateLimitStoreMockTakeExpectation
+	expectations       []*RateLimitStoreMockTakeExpectation
+
+	callArgs []*RateLimitStoreMockTakeParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RateLimitStoreMockTakeExpectation specifies expectation struct of the RateLimitStore.Take
+type RateLimitStoreMockTakeExpectation struct {
+	mock               *RateLimitStoreMock
+	params             *RateLimitStoreMockTakeParams
+	paramPtrs          *RateLimitStoreMockTakeParamPtrs
+	expectationOrigins RateLimitStoreMockTakeExpectationOrigins
+	results            *RateLimitStoreMockTakeResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RateLimitStoreMockTakeParams contains parameters of the RateLimitStore.Take
+type RateLimitStoreMockTakeParams struct {
+	ctx   context.Context
+	key   string
+	limit config.RateLimit
+}
+
+// RateLimitStoreMockTakeParamPtrs contains pointers to parameters of the RateLimitStore.Take
+type RateLimitStoreMockTakeParamPtrs struct {
+	ctx   *context.Context
+	key   *string
+	limit *config.RateLimit
+}
+
+// RateLimitStoreMockTakeResults contains results of the RateLimitStore.Take
+type RateLimitStoreMockTakeResults struct {
+	r1  ratelimit.Result
+	err error
+}
+
+// RateLimitStoreMockTakeOrigins contains origins of expectations of the RateLimitStore.Take
+type RateLimitStoreMockTakeExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originKey   string
+	originLimit string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmTake *mRateLimitStoreMockTake) Optional() *mRateLimitStoreMockTake {
+	mmTake.optional = true
+	return mmTake
+}
+
+// Expect sets up expected params for RateLimitStore.Take
+func (mmTake *mRateLimitStoreMockTake) Expect(ctx context.Context, key string, limit config.RateLimit) *mRateLimitStoreMockTake {
+	if mmTake.mock.funcTake != nil {
+		mmTake.mock.t.Fatalf("RateLimitStoreMock.Take mock is already set by Set")
+	}
+
+	if mmTake.defaultExpectation == nil {
+		mmTake.defaultExpectation = &RateLimitStoreMockTakeExpectation{}
+	}
+
+	if mmTake.defaultExpectation.paramPtrs != nil {
+		mmTake.mock.t.Fatalf("RateLimitStoreMock.Take mock is already set by ExpectParams functions")
+	}
+
+	mmTake.defaultExpectation.params = &RateLimitStoreMockTakeParams{ctx, key, limit}
+	mmTake.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmTake.expectations {
+		if minimock.Equal(e.params, mmTake.defaultExpectation.params) {
+			mmTake.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmTake.defaultExpectation.params)
+		}
+	}
+
+	return mmTake
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RateLimitStore.Take
+func (mmTake *mRateLimitStoreMockTake) ExpectCtxParam1(ctx context.Context) *mRateLimitStoreMockTake {
+	if mmTake.mock.funcTake != nil {
+		mmTake.mock.t.Fatalf("RateLimitStoreMock.Take mock is already set by Set")
+	}
+
+	if mmTake.defaultExpectation == nil {
+		mmTake.defaultExpectation = &RateLimitStoreMockTakeExpectation{}
+	}
+
+	if mmTake.defaultExpectation.params != nil {
+		mmTake.mock.t.Fatalf("RateLimitStoreMock.Take mock is already set by Expect")
+	}
+
+	if mmTake.defaultExpectation.paramPtrs == nil {
+		mmTake.defaultExpectation.paramPtrs = &RateLimitStoreMockTakeParamPtrs{}
+	}
+	mmTake.defaultExpectation.paramPtrs.ctx = &ctx
+	mmTake.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmTake
+}
+
+// ExpectKeyParam2 sets up expected param key for RateLimitStore.Take
+func (mmTake *mRateLimitStoreMockTake) ExpectKeyParam2(key string) *mRateLimitStoreMockTake {
+	if mmTake.mock.funcTake != nil {
+		mmTake.mock.t.Fatalf("RateLimitStoreMock.Take mock is already set by Set")
+	}
+
+	if mmTake.defaultExpectation == nil {
+		mmTake.defaultExpectation = &RateLimitStoreMockTakeExpectation{}
+	}
+
+	if mmTake.defaultExpectation.params != nil {
+		mmTake.mock.t.Fatalf("RateLimitStoreMock.Take mock is already set by Expect")
+	}
+
+	if mmTake.defaultExpectation.paramPtrs == nil {
+		mmTake.defaultExpectation.paramPtrs = &RateLimitStoreMockTakeParamPtrs{}
+	}
+	mmTake.defaultExpectation.paramPtrs.key = &key
+	mmTake.defaultExpectation.expectationOrigins.originKey = minimock.CallerInfo(1)
+
+	return mmTake
+}
+
+// ExpectLimitParam3 sets up expected param limit for RateLimitStore.Take
+func (mmTake *mRateLimitStoreMockTake) ExpectLimitParam3(limit config.RateLimit) *mRateLimitStoreMockTake {
+	if mmTake.mock.funcTake != nil {
+		mmTake.mock.t.Fatalf("RateLimitStoreMock.Take mock is already set by Set")
+	}
+
+	if mmTake.defaultExpectation == nil {
+		mmTake.defaultExpectation = &RateLimitStoreMockTakeExpectation{}
+	}
+
+	if mmTake.defaultExpectation.params != nil {
+		mmTake.mock.t.Fatalf("RateLimitStoreMock.Take mock is already set by Expect")
+	}
+
+	if mmTake.defaultExpectation.paramPtrs == nil {
+		mmTake.defaultExpectation.paramPtrs = &RateLimitStoreMockTakeParamPtrs{}
+	}
+	mmTake.defaultExpectation.paramPtrs.limit = &limit
+	mmTake.defaultExpectation.expectationOrigins.originLimit = minimock.CallerInfo(1)
+
+	return mmTake
+}
+
+// Inspect accepts an inspector function that has same arguments as the RateLimitStore.Take
+func (mmTake *mRateLimitStoreMockTake) Inspect(f func(ctx context.Context, key string, limit config.RateLimit)) *mRateLimitStoreMockTake {
+	if mmTake.mock.inspectFuncTake != nil {
+		mmTake.mock.t.Fatalf("Inspect function is already set for RateLimitStoreMock.Take")
+	}
+
+	mmTake.mock.inspectFuncTake = f
+
+	return mmTake
+}
+
+// Return sets up results that will be returned by RateLimitStore.Take
+func (mmTake *mRateLimitStoreMockTake) Return(r1 ratelimit.Result, err error) *RateLimitStoreMock {
+	if mmTake.mock.funcTake != nil {
+		mmTake.mock.t.Fatalf("RateLimitStoreMock.Take mock is already set by Set")
+	}
+
+	if mmTake.defaultExpectation == nil {
+		mmTake.defaultExpectation = &RateLimitStoreMockTakeExpectation{mock: mmTake.mock}
+	}
+	mmTake.defaultExpectation.results = &RateLimitStoreMockTakeResults{r1, err}
+	mmTake.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmTake.mock
+}
+
+// Set uses given function f to mock the RateLimitStore.Take method
+func (mmTake *mRateLimitStoreMockTake) Set(f func(ctx context.Context, key string, limit config.RateLimit) (r1 ratelimit.Result, err error)) *RateLimitStoreMock {
+	if mmTake.defaultExpectation != nil {
+		mmTake.mock.t.Fatalf("Default expectation is already set for the RateLimitStore.Take method")
+	}
+
+	if len(mmTake.expectations) > 0 {
+		mmTake.mock.t.Fatalf("Some expectations are already set for the RateLimitStore.Take method")
+	}
+
+	mmTake.mock.funcTake = f
+	mmTake.mock.funcTakeOrigin = minimock.CallerInfo(1)
+	return mmTake.mock
+}
+
+// When sets expectation for the RateLimitStore.Take which will trigger the result defined by the following
+// Then helper
+func (mmTake *mRateLimitStoreMockTake) When(ctx context.Context, key string, limit config.RateLimit) *RateLimitStoreMockTakeExpectation {
+	if mmTake.mock.funcTake != nil {
+		mmTake.mock.t.Fatalf("RateLimitStoreMock.Take mock is already set by Set")
+	}
+
+	expectation := &RateLimitStoreMockTakeExpectation{
+		mock:               mmTake.mock,
+		params:             &RateLimitStoreMockTakeParams{ctx, key, limit},
+		expectationOrigins: RateLimitStoreMockTakeExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmTake.expectations = append(mmTake.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RateLimitStore.Take return parameters for the expectation previously defined by the When method
+func (e *RateLimitStoreMockTakeExpectation) Then(r1 ratelimit.Result, err error) *RateLimitStoreMock {
+	e.results = &RateLimitStoreMockTakeResults{r1, err}
+	return e.mock
+}
+
+// Times sets number of times RateLimitStore.Take should be invoked
+func (mmTake *mRateLimitStoreMockTake) Times(n uint64) *mRateLimitStoreMockTake {
+	if n == 0 {
+		mmTake.mock.t.Fatalf("Times of RateLimitStoreMock.Take mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmTake.expectedInvocations, n)
+	mmTake.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmTake
+}
+
+func (mmTake *mRateLimitStoreMockTake) invocationsDone() bool {
+	if len(mmTake.expectations) == 0 && mmTake.defaultExpectation == nil && mmTake.mock.funcTake == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmTake.mock.afterTakeCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmTake.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Take implements RateLimitStore
+func (mmTake *RateLimitStoreMock) Take(ctx context.Context, key string, limit config.RateLimit) (r1 ratelimit.Result, err error) {
+	mm_atomic.AddUint64(&mmTake.beforeTakeCounter, 1)
+	defer mm_atomic.AddUint64(&mmTake.afterTakeCounter, 1)
+
+	mmTake.t.Helper()
+
+	if mmTake.inspectFuncTake != nil {
+		mmTake.inspectFuncTake(ctx, key, limit)
+	}
+
+	mm_params := RateLimitStoreMockTakeParams{ctx, key, limit}
+
+	// Record call args
+	mmTake.TakeMock.mutex.Lock()
+	mmTake.TakeMock.callArgs = append(mmTake.TakeMock.callArgs, &mm_params)
+	mmTake.TakeMock.mutex.Unlock()
+
+	for _, e := range mmTake.TakeMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.r1, e.results.err
+		}
+	}
+
+	if mmTake.TakeMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmTake.TakeMock.defaultExpectation.Counter, 1)
+		mm_want := mmTake.TakeMock.defaultExpectation.params
+		mm_want_ptrs := mmTake.TakeMock.defaultExpectation.paramPtrs
+
+		mm_got := RateLimitStoreMockTakeParams{ctx, key, limit}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmTake.t.Errorf("RateLimitStoreMock.Take got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmTake.TakeMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.key != nil && !minimock.Equal(*mm_want_ptrs.key, mm_got.key) {
+				mmTake.t.Errorf("RateLimitStoreMock.Take got unexpected parameter key, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmTake.TakeMock.defaultExpectation.expectationOrigins.originKey, *mm_want_ptrs.key, mm_got.key, minimock.Diff(*mm_want_ptrs.key, mm_got.key))
+			}
+
+			if mm_want_ptrs.limit != nil && !minimock.Equal(*mm_want_ptrs.limit, mm_got.limit) {
+				mmTake.t.Errorf("RateLimitStoreMock.Take got unexpected parameter limit, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmTake.TakeMock.defaultExpectation.expectationOrigins.originLimit, *mm_want_ptrs.limit, mm_got.limit, minimock.Diff(*mm_want_ptrs.limit, mm_got.limit))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmTake.t.Errorf("RateLimitStoreMock.Take got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmTake.TakeMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmTake.TakeMock.defaultExpectation.results
+		if mm_results == nil {
+			mmTake.t.Fatal("No results are set for the RateLimitStoreMock.Take")
+		}
+		return (*mm_results).r1, (*mm_results).err
+	}
+	if mmTake.funcTake != nil {
+		return mmTake.funcTake(ctx, key, limit)
+	}
+	mmTake.t.Fatalf("Unexpected call to RateLimitStoreMock.Take. %v %v %v", ctx, key, limit)
+	return
+}
+
+// TakeAfterCounter returns a count of finished RateLimitStoreMock.Take invocations
+func (mmTake *RateLimitStoreMock) TakeAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmTake.afterTakeCounter)
+}
+
+// TakeBeforeCounter returns a count of RateLimitStoreMock.Take invocations
+func (mmTake *RateLimitStoreMock) TakeBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmTake.beforeTakeCounter)
+}
+
+// Calls returns a list of arguments used in each call to RateLimitStoreMock.Take.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmTake *mRateLimitStoreMockTake) Calls() []*RateLimitStoreMockTakeParams {
+	mmTake.mutex.RLock()
+
+	argCopy := make([]*RateLimitStoreMockTakeParams, len(mmTake.callArgs))
+	copy(argCopy, mmTake.callArgs)
+
+	mmTake.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockTakeDone returns true if the count of the Take invocations corresponds
+// the number of defined expectations
+func (m *RateLimitStoreMock) MinimockTakeDone() bool {
+	if m.TakeMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.TakeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.TakeMock.invocationsDone()
+}
+
+// MinimockTakeInspect logs each unmet expectation
+func (m *RateLimitStoreMock) MinimockTakeInspect() {
+	for _, e := range m.TakeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RateLimitStoreMock.Take at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterTakeCounter := mm_atomic.LoadUint64(&m.afterTakeCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.TakeMock.defaultExpectation != nil && afterTakeCounter < 1 {
+		if m.TakeMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RateLimitStoreMock.Take at\n%s", m.TakeMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RateLimitStoreMock.Take at\n%s with params: %#v", m.TakeMock.defaultExpectation.expectationOrigins.origin, *m.TakeMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcTake != nil && afterTakeCounter < 1 {
+		m.t.Errorf("Expected call to RateLimitStoreMock.Take at\n%s", m.funcTakeOrigin)
+	}
+
+	if !m.TakeMock.invocationsDone() && afterTakeCounter > 0 {
+		m.t.Errorf("Expected %d calls to RateLimitStoreMock.Take at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.TakeMock.expectedInvocations), m.TakeMock.expectedInvocationsOrigin, afterTakeCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *RateLimitStoreMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockTakeInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *RateLimitStoreMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *RateLimitStoreMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockTakeDone()
+}
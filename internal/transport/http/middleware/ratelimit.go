@@ -0,0 +1,144 @@
+package middleware
+
+import (
+	"context"
+	"log/slog"
+	"math"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/alonsoF100/authorization-service/internal/apperrors"
+	"github.com/alonsoF100/authorization-service/internal/config"
+	"github.com/alonsoF100/authorization-service/internal/models"
+	"github.com/alonsoF100/authorization-service/internal/ratelimit"
+	"github.com/alonsoF100/authorization-service/internal/transport/http/dto"
+	"github.com/alonsoF100/authorization-service/internal/transport/http/help"
+)
+
+// RateLimitStore takes tokens from rate limit buckets, usually a
+// ratelimit.Store
+type RateLimitStore interface {
+	Take(ctx context.Context, key string, limit config.RateLimit) (ratelimit.Result, error)
+}
+
+// RateLimitKey names the bucket of a request, "" skips the limit
+type RateLimitKey func(r *http.Request) string
+
+// RateLimit takes a token from the bucket of the request and answers 429
+// when it is empty. Responses carry the RateLimit-* headers, a failing
+// store lets requests through. The limit is read on every request, so it
+// can change on reload.
+func RateLimit(store RateLimitStore, name string, limits func() config.RateLimit, key RateLimitKey) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			const op = "middleware/ratelimit.go/RateLimit"
+
+			limit := limits()
+			if limit.Requests <= 0 {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			bucket := key(r)
+			if bucket == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			res, err := store.Take(r.Context(), name+":"+bucket, limit)
+			if err != nil {
+				slog.Warn("Rate limit store failed, request let through",
+					slog.String("op", op),
+					slog.String("limit", name),
+					slog.String("error", err.Error()),
+				)
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			policy := strconv.Itoa(limit.Requests) + ";w=" + strconv.Itoa(int(limit.Period.Seconds()))
+			if limit.Burst > 0 {
+				policy += ";burst=" + strconv.Itoa(limit.Burst)
+			}
+
+			header := w.Header()
+			header.Set("RateLimit-Policy", policy)
+			header.Set("RateLimit-Limit", strconv.Itoa(res.Limit))
+			header.Set("RateLimit-Remaining", strconv.Itoa(res.Remaining))
+			header.Set("RateLimit-Reset", seconds(res.Reset))
+
+			if !res.Allowed {
+				slog.Info("Rate limit exceeded",
+					slog.String("op", op),
+					slog.String("limit", name),
+					slog.String("path", r.URL.Path),
+					slog.String("ip", help.ClientIP(r)),
+				)
+				header.Set("Retry-After", seconds(max(res.RetryAfter, time.Second)))
+				help.WriteJSON(w, http.StatusTooManyRequests, dto.NewErrorResponse(apperrors.ErrTooManyRequests))
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// seconds rounds up, a client waiting the rounded down time would be
+// limited again
+func seconds(d time.Duration) string {
+	return strconv.Itoa(int(math.Ceil(d.Seconds())))
+}
+
+// ByIP limits per client address, see RealIP
+func ByIP() RateLimitKey {
+	return func(r *http.Request) string {
+		return "ip:" + help.ClientIP(r)
+	}
+}
+
+// ByPrincipal limits per authenticated principal, it must run after Auth.
+// Client tokens carry no user id, they are limited per client.
+func ByPrincipal() RateLimitKey {
+	return func(r *http.Request) string {
+		claims, ok := GetUserFromContext(r.Context())
+		if !ok {
+			return ""
+		}
+
+		id := claims.ID
+		if claims.Principal() == models.PrincipalClient {
+			id = claims.ClientID
+		}
+
+		return string(claims.Principal()) + ":" + id
+	}
+}
+
+// ByClient limits per OAuth client and address, the client is taken from
+// HTTP Basic or the client_id form field. The client is not authenticated
+// yet, so the address keeps anyone from draining the bucket of a known
+// client. Requests without a client fall back to the address.
+func ByClient() RateLimitKey {
+	byIP := ByIP()
+
+	return func(r *http.Request) string {
+		clientID, _, ok := r.BasicAuth()
+		if ok {
+			// form encoded like the handler reads it, so one client
+			// can't spread over several buckets
+			if decoded, err := url.QueryUnescape(clientID); err == nil {
+				clientID = decoded
+			}
+		} else if r.ParseForm() == nil {
+			clientID = r.PostForm.Get("client_id")
+		}
+		if clientID == "" {
+			return byIP(r)
+		}
+
+		return "client:" + help.ClientIP(r) + ":" + clientID
+	}
+}
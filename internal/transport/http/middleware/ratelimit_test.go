@@ -0,0 +1,210 @@
+package middleware_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/alonsoF100/authorization-service/internal/apperrors"
+	"github.com/alonsoF100/authorization-service/internal/config"
+	"github.com/alonsoF100/authorization-service/internal/models"
+	"github.com/alonsoF100/authorization-service/internal/ratelimit"
+	"github.com/alonsoF100/authorization-service/internal/transport/http/help"
+	"github.com/alonsoF100/authorization-service/internal/transport/http/middleware"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimit(t *testing.T) {
+	limit := config.RateLimit{Requests: 10, Period: time.Minute, Burst: 5}
+
+	tests := []struct {
+		name           string
+		result         ratelimit.Result
+		err            error
+		expectedStatus int
+		expected       map[string]string
+	}{
+		{
+			name:           "allowed",
+			result:         ratelimit.Result{Allowed: true, Limit: 5, Remaining: 3, Reset: 11500 * time.Millisecond},
+			expectedStatus: http.StatusOK,
+			expected: map[string]string{
+				"RateLimit-Policy":    "10;w=60;burst=5",
+				"RateLimit-Limit":     "5",
+				"RateLimit-Remaining": "3",
+				"RateLimit-Reset":     "12",
+				"Retry-After":         "",
+			},
+		},
+		{
+			name:           "limited",
+			result:         ratelimit.Result{Limit: 5, Reset: 30 * time.Second, RetryAfter: 5500 * time.Millisecond},
+			expectedStatus: http.StatusTooManyRequests,
+			expected: map[string]string{
+				"RateLimit-Remaining": "0",
+				"RateLimit-Reset":     "30",
+				"Retry-After":         "6",
+			},
+		},
+		{
+			name:           "store failure lets the request through",
+			err:            errors.New("connection refused"),
+			expectedStatus: http.StatusOK,
+			expected:       map[string]string{"RateLimit-Limit": ""},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := minimock.NewController(t)
+			mockStore := middleware.NewRateLimitStoreMock(mc)
+			mockStore.TakeMock.Expect(minimock.AnyContext, "login:ip:192.0.2.1", limit).Return(tt.result, tt.err)
+
+			nextHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			})
+			handler := middleware.RateLimit(mockStore, "login", func() config.RateLimit { return limit }, middleware.ByIP())(nextHandler)
+
+			req := httptest.NewRequest(http.MethodPost, "/auth/login", nil)
+			req.RemoteAddr = "192.0.2.1:1234"
+
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, req)
+
+			require.Equal(t, tt.expectedStatus, rr.Code)
+			for name, value := range tt.expected {
+				require.Equal(t, value, rr.Header().Get(name), name)
+			}
+			if tt.expectedStatus == http.StatusTooManyRequests {
+				require.Contains(t, rr.Body.String(), apperrors.ErrTooManyRequests.Error())
+			}
+		})
+	}
+
+	t.Run("limit off", func(t *testing.T) {
+		mc := minimock.NewController(t)
+		mockStore := middleware.NewRateLimitStoreMock(mc)
+
+		nextHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		})
+		handler := middleware.RateLimit(mockStore, "login", func() config.RateLimit { return config.RateLimit{} }, middleware.ByIP())(nextHandler)
+
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, httptest.NewRequest(http.MethodPost, "/auth/login", nil))
+
+		require.Equal(t, http.StatusOK, rr.Code)
+	})
+
+	t.Run("limit reloaded", func(t *testing.T) {
+		mc := minimock.NewController(t)
+		mockStore := middleware.NewRateLimitStoreMock(mc)
+		reloaded := config.RateLimit{Requests: 1, Period: time.Second}
+		mockStore.TakeMock.Expect(minimock.AnyContext, "login:ip:192.0.2.1", reloaded).Return(ratelimit.Result{Limit: 1}, nil)
+
+		var current atomic.Pointer[config.RateLimit]
+		current.Store(&config.RateLimit{})
+		nextHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		})
+		handler := middleware.RateLimit(mockStore, "login", func() config.RateLimit { return *current.Load() }, middleware.ByIP())(nextHandler)
+
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, httptest.NewRequest(http.MethodPost, "/auth/login", nil))
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		current.Store(&reloaded)
+		rr = httptest.NewRecorder()
+		handler.ServeHTTP(rr, httptest.NewRequest(http.MethodPost, "/auth/login", nil))
+		require.Equal(t, http.StatusTooManyRequests, rr.Code)
+		require.Equal(t, "1;w=1", rr.Header().Get("RateLimit-Policy"))
+	})
+}
+
+func TestRateLimitKeys(t *testing.T) {
+	tests := []struct {
+		name     string
+		key      middleware.RateLimitKey
+		request  func() *http.Request
+		expected string
+	}{
+		{
+			name: "resolved client ip",
+			key:  middleware.ByIP(),
+			request: func() *http.Request {
+				req := httptest.NewRequest(http.MethodPost, "/auth/login", nil)
+				req.RemoteAddr = "10.0.0.1:1234"
+				return req.WithContext(help.WithClientIP(req.Context(), "198.51.100.7"))
+			},
+			expected: "ip:198.51.100.7",
+		},
+		{
+			name: "principal",
+			key:  middleware.ByPrincipal(),
+			request: func() *http.Request {
+				req := httptest.NewRequest(http.MethodGet, "/api/me", nil)
+				return req.WithContext(context.WithValue(req.Context(), middleware.UserContextKey, &models.Claims{ID: "user123"}))
+			},
+			expected: "user:user123",
+		},
+		{
+			name: "client principal",
+			key:  middleware.ByPrincipal(),
+			request: func() *http.Request {
+				req := httptest.NewRequest(http.MethodGet, "/api/me", nil)
+				claims := &models.Claims{ClientID: "client123", PrincipalType: models.PrincipalClient}
+				return req.WithContext(context.WithValue(req.Context(), middleware.UserContextKey, claims))
+			},
+			expected: "client:client123",
+		},
+		{
+			name: "no principal",
+			key:  middleware.ByPrincipal(),
+			request: func() *http.Request {
+				return httptest.NewRequest(http.MethodGet, "/api/me", nil)
+			},
+		},
+		{
+			name: "client from basic auth",
+			key:  middleware.ByClient(),
+			request: func() *http.Request {
+				req := httptest.NewRequest(http.MethodPost, "/oauth/token", nil)
+				req.SetBasicAuth("my%20client", "secret")
+				return req
+			},
+			expected: "client:192.0.2.1:my client",
+		},
+		{
+			name: "client from form",
+			key:  middleware.ByClient(),
+			request: func() *http.Request {
+				req := httptest.NewRequest(http.MethodPost, "/oauth/token", strings.NewReader("grant_type=client_credentials&client_id=my-client"))
+				req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+				return req
+			},
+			expected: "client:192.0.2.1:my-client",
+		},
+		{
+			name: "no client",
+			key:  middleware.ByClient(),
+			request: func() *http.Request {
+				req := httptest.NewRequest(http.MethodPost, "/oauth/token", nil)
+				req.RemoteAddr = "192.0.2.1:1234"
+				return req
+			},
+			expected: "ip:192.0.2.1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, tt.key(tt.request()))
+		})
+	}
+}
@@ -0,0 +1,108 @@
+package middleware
+
+import (
+	"net"
+	"net/http"
+	"net/netip"
+	"strings"
+
+	"github.com/alonsoF100/authorization-service/internal/transport/http/help"
+)
+
+// RealIP resolves the client address once per request and stores it for
+// help.ClientIP. Forwarding headers are only believed when the peer is a
+// trusted proxy, Forwarded wins over X-Forwarded-For and X-Real-IP.
+func RealIP(trusted []netip.Prefix) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ip := resolveIP(r, trusted)
+			next.ServeHTTP(w, r.WithContext(help.WithClientIP(r.Context(), ip)))
+		})
+	}
+}
+
+func resolveIP(r *http.Request, trusted []netip.Prefix) string {
+	peer := help.PeerIP(r)
+	if !trustedAddr(peer, trusted) {
+		return peer
+	}
+
+	if forwarded := r.Header.Values("Forwarded"); len(forwarded) > 0 {
+		return walkHops(forwardedFor(forwarded), peer, trusted)
+	}
+	if forwardedFor := r.Header.Values("X-Forwarded-For"); len(forwardedFor) > 0 {
+		var hops []string
+		for _, value := range forwardedFor {
+			for hop := range strings.SplitSeq(value, ",") {
+				hops = append(hops, strings.TrimSpace(hop))
+			}
+		}
+		return walkHops(hops, peer, trusted)
+	}
+	if realIP := r.Header.Get("X-Real-IP"); realIP != "" {
+		return walkHops([]string{strings.TrimSpace(realIP)}, peer, trusted)
+	}
+
+	return peer
+}
+
+// walkHops reads the hops right to left and stops at the first one that
+// isn't a trusted proxy, entries further left could be sent by the client
+// itself. An unreadable hop, like an obfuscated one, ends the walk at the
+// last known address.
+func walkHops(hops []string, peer string, trusted []netip.Prefix) string {
+	ip := peer
+	for i := len(hops) - 1; i >= 0; i-- {
+		addr, err := netip.ParseAddr(hops[i])
+		if err != nil {
+			break
+		}
+
+		ip = addr.Unmap().String()
+		if !trustedAddr(ip, trusted) {
+			break
+		}
+	}
+
+	return ip
+}
+
+// forwardedFor returns the for= node of every element of RFC 7239
+// Forwarded headers without quotes and port, "" for elements without one
+func forwardedFor(values []string) []string {
+	var hops []string
+	for _, value := range values {
+		for element := range strings.SplitSeq(value, ",") {
+			var node string
+			for pair := range strings.SplitSeq(element, ";") {
+				name, val, ok := strings.Cut(strings.TrimSpace(pair), "=")
+				if ok && strings.EqualFold(name, "for") {
+					node = strings.Trim(val, `"`)
+				}
+			}
+
+			if host, _, err := net.SplitHostPort(node); err == nil {
+				node = host
+			}
+			hops = append(hops, strings.Trim(node, "[]"))
+		}
+	}
+
+	return hops
+}
+
+func trustedAddr(ip string, trusted []netip.Prefix) bool {
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return false
+	}
+	addr = addr.Unmap()
+
+	for _, prefix := range trusted {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,56 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+
+	"github.com/alonsoF100/authorization-service/internal/transport/http/help"
+	"github.com/alonsoF100/authorization-service/internal/transport/http/middleware"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRealIP(t *testing.T) {
+	trusted := []netip.Prefix{netip.MustParsePrefix("10.0.0.0/8"), netip.MustParsePrefix("fd00::/8")}
+
+	tests := []struct {
+		name       string
+		remoteAddr string
+		headers    map[string][]string
+		expected   string
+	}{
+		{"direct client", "192.0.2.1:1234", nil, "192.0.2.1"},
+		{"untrusted peer can't forward", "192.0.2.1:1234", map[string][]string{"X-Forwarded-For": {"198.51.100.7"}}, "192.0.2.1"},
+		{"x-forwarded-for", "10.0.0.1:1234", map[string][]string{"X-Forwarded-For": {"198.51.100.7"}}, "198.51.100.7"},
+		{"spoofed entries are skipped", "10.0.0.1:1234", map[string][]string{"X-Forwarded-For": {"203.0.113.9, 198.51.100.7"}}, "198.51.100.7"},
+		{"chain of proxies", "10.0.0.1:1234", map[string][]string{"X-Forwarded-For": {"198.51.100.7", "10.0.0.2"}}, "198.51.100.7"},
+		{"garbage stops the walk", "10.0.0.1:1234", map[string][]string{"X-Forwarded-For": {"198.51.100.7, unknown"}}, "10.0.0.1"},
+		{"forwarded", "10.0.0.1:1234", map[string][]string{"Forwarded": {`for=203.0.113.9, for="[2001:db8::7]:4711";proto=https, for=10.0.0.2`}}, "2001:db8::7"},
+		{"forwarded wins", "10.0.0.1:1234", map[string][]string{"Forwarded": {"for=198.51.100.7"}, "X-Forwarded-For": {"203.0.113.9"}}, "198.51.100.7"},
+		{"obfuscated forwarded node", "10.0.0.1:1234", map[string][]string{"Forwarded": {"for=_hidden"}}, "10.0.0.1"},
+		{"x-real-ip", "[fd00::1]:1234", map[string][]string{"X-Real-IP": {"198.51.100.7"}}, "198.51.100.7"},
+		{"trusted proxy without headers", "10.0.0.1:1234", nil, "10.0.0.1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got string
+			nextHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				got = help.ClientIP(r)
+			})
+
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			req.RemoteAddr = tt.remoteAddr
+			for name, values := range tt.headers {
+				for _, value := range values {
+					req.Header.Add(name, value)
+				}
+			}
+
+			middleware.RealIP(trusted)(nextHandler).ServeHTTP(httptest.NewRecorder(), req)
+
+			require.Equal(t, tt.expected, got)
+		})
+	}
+}
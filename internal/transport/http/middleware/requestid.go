@@ -0,0 +1,53 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/alonsoF100/authorization-service/internal/audit"
+	"github.com/alonsoF100/authorization-service/internal/transport/http/help"
+	"github.com/google/uuid"
+)
+
+const (
+	RequestIDHeader = "X-Request-ID"
+
+	maxRequestIDLength = 128
+)
+
+// RequestID keeps the X-Request-ID set by a proxy or the client and creates
+// one otherwise, the response carries it back. Together with the client
+// address and user agent it becomes the audit source of the request, so it
+// has to run after RealIP.
+func RequestID() func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requestID := r.Header.Get(RequestIDHeader)
+			if !validRequestID(requestID) {
+				requestID = uuid.New().String()
+			}
+			w.Header().Set(RequestIDHeader, requestID)
+
+			ctx := audit.WithSource(r.Context(), audit.Source{
+				IP:        help.ClientIP(r),
+				UserAgent: r.UserAgent(),
+				RequestID: requestID,
+			})
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// validRequestID accepts printable ASCII, the id ends up in logs and the
+// audit trail
+func validRequestID(requestID string) bool {
+	if requestID == "" || len(requestID) > maxRequestIDLength {
+		return false
+	}
+	for _, c := range []byte(requestID) {
+		if c < 0x21 || c > 0x7e {
+			return false
+		}
+	}
+
+	return true
+}
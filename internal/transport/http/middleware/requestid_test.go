@@ -0,0 +1,56 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/alonsoF100/authorization-service/internal/audit"
+	"github.com/alonsoF100/authorization-service/internal/transport/http/middleware"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequestID(t *testing.T) {
+	tests := []struct {
+		name      string
+		requestID string
+		kept      bool
+	}{
+		{"incoming id is kept", "req-123", true},
+		{"missing id is created", "", false},
+		{"id with spaces is replaced", "req 123", false},
+		{"too long id is replaced", strings.Repeat("a", 129), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var source audit.Source
+			nextHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				source = audit.SourceFrom(r.Context())
+			})
+
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			req.RemoteAddr = "192.0.2.1:1234"
+			req.Header.Set("User-Agent", "curl/8.5.0")
+			if tt.requestID != "" {
+				req.Header.Set(middleware.RequestIDHeader, tt.requestID)
+			}
+			rr := httptest.NewRecorder()
+
+			middleware.RequestID()(nextHandler).ServeHTTP(rr, req)
+
+			requestID := rr.Header().Get(middleware.RequestIDHeader)
+			if tt.kept {
+				require.Equal(t, tt.requestID, requestID)
+			} else {
+				require.NoError(t, uuid.Validate(requestID))
+			}
+			require.Equal(t, requestID, source.RequestID)
+			require.Equal(t, "192.0.2.1", source.IP)
+			require.Equal(t, "curl/8.5.0", source.UserAgent)
+			require.Empty(t, source.ActorID)
+		})
+	}
+}
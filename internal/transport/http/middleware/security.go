@@ -0,0 +1,54 @@
+package middleware
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/alonsoF100/authorization-service/internal/config"
+)
+
+// SecurityHeaders sets the headers of the policy matching the path before
+// the handler runs, a handler can still replace them, like the discovery
+// document does with its own Cache-Control
+func SecurityHeaders(cfg config.SecurityConfig) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			policy := securityPolicy(cfg, r.URL.Path)
+			header := w.Header()
+
+			if policy.HSTSMaxAge > 0 {
+				hsts := "max-age=" + strconv.Itoa(int(policy.HSTSMaxAge.Seconds()))
+				if policy.HSTSIncludeSubdomains {
+					hsts += "; includeSubDomains"
+				}
+				header.Set("Strict-Transport-Security", hsts)
+			}
+			if policy.NoSniff {
+				header.Set("X-Content-Type-Options", "nosniff")
+			}
+			if policy.ReferrerPolicy != "" {
+				header.Set("Referrer-Policy", policy.ReferrerPolicy)
+			}
+			if policy.FrameAncestors != "" {
+				header.Set("Content-Security-Policy", "frame-ancestors "+policy.FrameAncestors)
+			}
+			if policy.NoStore {
+				header.Set("Cache-Control", "no-store")
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// securityPolicy picks the policy like corsPolicy
+func securityPolicy(cfg config.SecurityConfig, path string) config.SecurityPolicy {
+	policy, matched := cfg.SecurityPolicy, ""
+	for _, route := range cfg.Routes {
+		if config.PathHasPrefix(path, route.Prefix) && len(route.Prefix) > len(matched) {
+			policy, matched = route.SecurityPolicy, route.Prefix
+		}
+	}
+
+	return policy
+}
@@ -0,0 +1,89 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/alonsoF100/authorization-service/internal/config"
+	"github.com/alonsoF100/authorization-service/internal/transport/http/middleware"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSecurityHeaders(t *testing.T) {
+	cfg := config.SecurityConfig{
+		SecurityPolicy: config.SecurityPolicy{
+			HSTSMaxAge:            365 * 24 * time.Hour,
+			HSTSIncludeSubdomains: true,
+			NoSniff:               true,
+			ReferrerPolicy:        "no-referrer",
+			FrameAncestors:        "'none'",
+			NoStore:               true,
+		},
+		Routes: []config.SecurityRoute{
+			{Prefix: "/.well-known", SecurityPolicy: config.SecurityPolicy{NoSniff: true}},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		path     string
+		handler  http.HandlerFunc
+		expected map[string]string
+	}{
+		{
+			name: "default policy",
+			path: "/auth/login",
+			expected: map[string]string{
+				"Strict-Transport-Security": "max-age=31536000; includeSubDomains",
+				"X-Content-Type-Options":    "nosniff",
+				"Referrer-Policy":           "no-referrer",
+				"Content-Security-Policy":   "frame-ancestors 'none'",
+				"Cache-Control":             "no-store",
+			},
+		},
+		{
+			name: "route override",
+			path: "/.well-known/jwks.json",
+			expected: map[string]string{
+				"Strict-Transport-Security": "",
+				"X-Content-Type-Options":    "nosniff",
+				"Referrer-Policy":           "",
+				"Content-Security-Policy":   "",
+				"Cache-Control":             "",
+			},
+		},
+		{
+			name: "handler replaces a header",
+			path: "/api/me",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Cache-Control", "public, max-age=300")
+			},
+			expected: map[string]string{
+				"Cache-Control":          "public, max-age=300",
+				"X-Content-Type-Options": "nosniff",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			nextHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if tt.handler != nil {
+					tt.handler(w, r)
+				}
+				w.WriteHeader(http.StatusOK)
+			})
+			handler := middleware.SecurityHeaders(cfg)(nextHandler)
+
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, tt.path, nil))
+
+			require.Equal(t, http.StatusOK, rr.Code)
+			for name, value := range tt.expected {
+				require.Equal(t, value, rr.Header().Get(name), name)
+			}
+		})
+	}
+}
@@ -19,6 +19,13 @@ type TokenValidatorMock struct {
 	t          minimock.Tester
 	finishOnce sync.Once
 
+	funcCheckAllowedIP          func(ctx context.Context, claims *models.Claims, ip string) (err error)
+	funcCheckAllowedIPOrigin    string
+	inspectFuncCheckAllowedIP   func(ctx context.Context, claims *models.Claims, ip string)
+	afterCheckAllowedIPCounter  uint64
+	beforeCheckAllowedIPCounter uint64
+	CheckAllowedIPMock          mTokenValidatorMockCheckAllowedIP
+
 	funcValidateJWT          func(ctx context.Context, token string) (cp1 *models.Claims, err error)
 	funcValidateJWTOrigin    string
 	inspectFuncValidateJWT   func(ctx context.Context, token string)
@@ -35,6 +42,9 @@ func NewTokenValidatorMock(t minimock.Tester) *TokenValidatorMock {
 		controller.RegisterMocker(m)
 	}
 
+	m.CheckAllowedIPMock = mTokenValidatorMockCheckAllowedIP{mock: m}
+	m.CheckAllowedIPMock.callArgs = []*TokenValidatorMockCheckAllowedIPParams{}
+
 	m.ValidateJWTMock = mTokenValidatorMockValidateJWT{mock: m}
 	m.ValidateJWTMock.callArgs = []*TokenValidatorMockValidateJWTParams{}
 
@@ -43,6 +53,379 @@ func NewTokenValidatorMock(t minimock.Tester) *TokenValidatorMock {
 	return m
 }
 
+type mTokenValidatorMockCheckAllowedIP struct {
+	optional           bool
+	mock               *TokenValidatorMock
+	defaultExpectation *TokenValidatorMockCheckAllowedIPExpectation
+	expectations       []*TokenValidatorMockCheckAllowedIPExpectation
+
+	callArgs []*TokenValidatorMockCheckAllowedIPParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// TokenValidatorMockCheckAllowedIPExpectation specifies expectation struct of the TokenValidator.CheckAllowedIP
+type TokenValidatorMockCheckAllowedIPExpectation struct {
+	mock               *TokenValidatorMock
+	params             *TokenValidatorMockCheckAllowedIPParams
+	paramPtrs          *TokenValidatorMockCheckAllowedIPParamPtrs
+	expectationOrigins TokenValidatorMockCheckAllowedIPExpectationOrigins
+	results            *TokenValidatorMockCheckAllowedIPResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// TokenValidatorMockCheckAllowedIPParams contains parameters of the TokenValidator.CheckAllowedIP
+type TokenValidatorMockCheckAllowedIPParams struct {
+	ctx    context.Context
+	claims *models.Claims
+	ip     string
+}
+
+// TokenValidatorMockCheckAllowedIPParamPtrs contains pointers to parameters of the TokenValidator.CheckAllowedIP
+type TokenValidatorMockCheckAllowedIPParamPtrs struct {
+	ctx    *context.Context
+	claims **models.Claims
+	ip     *string
+}
+
+// TokenValidatorMockCheckAllowedIPResults contains results of the TokenValidator.CheckAllowedIP
+type TokenValidatorMockCheckAllowedIPResults struct {
+	err error
+}
+
+// TokenValidatorMockCheckAllowedIPOrigins contains origins of expectations of the TokenValidator.CheckAllowedIP
+type TokenValidatorMockCheckAllowedIPExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originClaims string
+	originIp     string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCheckAllowedIP *mTokenValidatorMockCheckAllowedIP) Optional() *mTokenValidatorMockCheckAllowedIP {
+	mmCheckAllowedIP.optional = true
+	return mmCheckAllowedIP
+}
+
+// Expect sets up expected params for TokenValidator.CheckAllowedIP
+func (mmCheckAllowedIP *mTokenValidatorMockCheckAllowedIP) Expect(ctx context.Context, claims *models.Claims, ip string) *mTokenValidatorMockCheckAllowedIP {
+	if mmCheckAllowedIP.mock.funcCheckAllowedIP != nil {
+		mmCheckAllowedIP.mock.t.Fatalf("TokenValidatorMock.CheckAllowedIP mock is already set by Set")
+	}
+
+	if mmCheckAllowedIP.defaultExpectation == nil {
+		mmCheckAllowedIP.defaultExpectation = &TokenValidatorMockCheckAllowedIPExpectation{}
+	}
+
+	if mmCheckAllowedIP.defaultExpectation.paramPtrs != nil {
+		mmCheckAllowedIP.mock.t.Fatalf("TokenValidatorMock.CheckAllowedIP mock is already set by ExpectParams functions")
+	}
+
+	mmCheckAllowedIP.defaultExpectation.params = &TokenValidatorMockCheckAllowedIPParams{ctx, claims, ip}
+	mmCheckAllowedIP.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCheckAllowedIP.expectations {
+		if minimock.Equal(e.params, mmCheckAllowedIP.defaultExpectation.params) {
+			mmCheckAllowedIP.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCheckAllowedIP.defaultExpectation.params)
+		}
+	}
+
+	return mmCheckAllowedIP
+}
+
+// ExpectCtxParam1 sets up expected param ctx for TokenValidator.CheckAllowedIP
+func (mmCheckAllowedIP *mTokenValidatorMockCheckAllowedIP) ExpectCtxParam1(ctx context.Context) *mTokenValidatorMockCheckAllowedIP {
+	if mmCheckAllowedIP.mock.funcCheckAllowedIP != nil {
+		mmCheckAllowedIP.mock.t.Fatalf("TokenValidatorMock.CheckAllowedIP mock is already set by Set")
+	}
+
+	if mmCheckAllowedIP.defaultExpectation == nil {
+		mmCheckAllowedIP.defaultExpectation = &TokenValidatorMockCheckAllowedIPExpectation{}
+	}
+
+	if mmCheckAllowedIP.defaultExpectation.params != nil {
+		mmCheckAllowedIP.mock.t.Fatalf("TokenValidatorMock.CheckAllowedIP mock is already set by Expect")
+	}
+
+	if mmCheckAllowedIP.defaultExpectation.paramPtrs == nil {
+		mmCheckAllowedIP.defaultExpectation.paramPtrs = &TokenValidatorMockCheckAllowedIPParamPtrs{}
+	}
+	mmCheckAllowedIP.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCheckAllowedIP.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCheckAllowedIP
+}
+
+// ExpectClaimsParam2 sets up expected param claims for TokenValidator.CheckAllowedIP
+func (mmCheckAllowedIP *mTokenValidatorMockCheckAllowedIP) ExpectClaimsParam2(claims *models.Claims) *mTokenValidatorMockCheckAllowedIP {
+	if mmCheckAllowedIP.mock.funcCheckAllowedIP != nil {
+		mmCheckAllowedIP.mock.t.Fatalf("TokenValidatorMock.CheckAllowedIP mock is already set by Set")
+	}
+
+	if mmCheckAllowedIP.defaultExpectation == nil {
+		mmCheckAllowedIP.defaultExpectation = &TokenValidatorMockCheckAllowedIPExpectation{}
+	}
+
+	if mmCheckAllowedIP.defaultExpectation.params != nil {
+		mmCheckAllowedIP.mock.t.Fatalf("TokenValidatorMock.CheckAllowedIP mock is already set by Expect")
+	}
+
+	if mmCheckAllowedIP.defaultExpectation.paramPtrs == nil {
+		mmCheckAllowedIP.defaultExpectation.paramPtrs = &TokenValidatorMockCheckAllowedIPParamPtrs{}
+	}
+	mmCheckAllowedIP.defaultExpectation.paramPtrs.claims = &claims
+	mmCheckAllowedIP.defaultExpectation.expectationOrigins.originClaims = minimock.CallerInfo(1)
+
+	return mmCheckAllowedIP
+}
+
+// ExpectIpParam3 sets up expected param ip for TokenValidator.CheckAllowedIP
+func (mmCheckAllowedIP *mTokenValidatorMockCheckAllowedIP) ExpectIpParam3(ip string) *mTokenValidatorMockCheckAllowedIP {
+	if mmCheckAllowedIP.mock.funcCheckAllowedIP != nil {
+		mmCheckAllowedIP.mock.t.Fatalf("TokenValidatorMock.CheckAllowedIP mock is already set by Set")
+	}
+
+	if mmCheckAllowedIP.defaultExpectation == nil {
+		mmCheckAllowedIP.defaultExpectation = &TokenValidatorMockCheckAllowedIPExpectation{}
+	}
+
+	if mmCheckAllowedIP.defaultExpectation.params != nil {
+		mmCheckAllowedIP.mock.t.Fatalf("TokenValidatorMock.CheckAllowedIP mock is already set by Expect")
+	}
+
+	if mmCheckAllowedIP.defaultExpectation.paramPtrs == nil {
+		mmCheckAllowedIP.defaultExpectation.paramPtrs = &TokenValidatorMockCheckAllowedIPParamPtrs{}
+	}
+	mmCheckAllowedIP.defaultExpectation.paramPtrs.ip = &ip
+	mmCheckAllowedIP.defaultExpectation.expectationOrigins.originIp = minimock.CallerInfo(1)
+
+	return mmCheckAllowedIP
+}
+
+// Inspect accepts an inspector function that has same arguments as the TokenValidator.CheckAllowedIP
+func (mmCheckAllowedIP *mTokenValidatorMockCheckAllowedIP) Inspect(f func(ctx context.Context, claims *models.Claims, ip string)) *mTokenValidatorMockCheckAllowedIP {
+	if mmCheckAllowedIP.mock.inspectFuncCheckAllowedIP != nil {
+		mmCheckAllowedIP.mock.t.Fatalf("Inspect function is already set for TokenValidatorMock.CheckAllowedIP")
+	}
+
+	mmCheckAllowedIP.mock.inspectFuncCheckAllowedIP = f
+
+	return mmCheckAllowedIP
+}
+
+// Return sets up results that will be returned by TokenValidator.CheckAllowedIP
+func (mmCheckAllowedIP *mTokenValidatorMockCheckAllowedIP) Return(err error) *TokenValidatorMock {
+	if mmCheckAllowedIP.mock.funcCheckAllowedIP != nil {
+		mmCheckAllowedIP.mock.t.Fatalf("TokenValidatorMock.CheckAllowedIP mock is already set by Set")
+	}
+
+	if mmCheckAllowedIP.defaultExpectation == nil {
+		mmCheckAllowedIP.defaultExpectation = &TokenValidatorMockCheckAllowedIPExpectation{mock: mmCheckAllowedIP.mock}
+	}
+	mmCheckAllowedIP.defaultExpectation.results = &TokenValidatorMockCheckAllowedIPResults{err}
+	mmCheckAllowedIP.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCheckAllowedIP.mock
+}
+
+// Set uses given function f to mock the TokenValidator.CheckAllowedIP method
+func (mmCheckAllowedIP *mTokenValidatorMockCheckAllowedIP) Set(f func(ctx context.Context, claims *models.Claims, ip string) (err error)) *TokenValidatorMock {
+	if mmCheckAllowedIP.defaultExpectation != nil {
+		mmCheckAllowedIP.mock.t.Fatalf("Default expectation is already set for the TokenValidator.CheckAllowedIP method")
+	}
+
+	if len(mmCheckAllowedIP.expectations) > 0 {
+		mmCheckAllowedIP.mock.t.Fatalf("Some expectations are already set for the TokenValidator.CheckAllowedIP method")
+	}
+
+	mmCheckAllowedIP.mock.funcCheckAllowedIP = f
+	mmCheckAllowedIP.mock.funcCheckAllowedIPOrigin = minimock.CallerInfo(1)
+	return mmCheckAllowedIP.mock
+}
+
+// When sets expectation for the TokenValidator.CheckAllowedIP which will trigger the result defined by the following
+// Then helper
+func (mmCheckAllowedIP *mTokenValidatorMockCheckAllowedIP) When(ctx context.Context, claims *models.Claims, ip string) *TokenValidatorMockCheckAllowedIPExpectation {
+	if mmCheckAllowedIP.mock.funcCheckAllowedIP != nil {
+		mmCheckAllowedIP.mock.t.Fatalf("TokenValidatorMock.CheckAllowedIP mock is already set by Set")
+	}
+
+	expectation := &TokenValidatorMockCheckAllowedIPExpectation{
+		mock:               mmCheckAllowedIP.mock,
+		params:             &TokenValidatorMockCheckAllowedIPParams{ctx, claims, ip},
+		expectationOrigins: TokenValidatorMockCheckAllowedIPExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCheckAllowedIP.expectations = append(mmCheckAllowedIP.expectations, expectation)
+	return expectation
+}
+
+// Then sets up TokenValidator.CheckAllowedIP return parameters for the expectation previously defined by the When method
+func (e *TokenValidatorMockCheckAllowedIPExpectation) Then(err error) *TokenValidatorMock {
+	e.results = &TokenValidatorMockCheckAllowedIPResults{err}
+	return e.mock
+}
+
+// Times sets number of times TokenValidator.CheckAllowedIP should be invoked
+func (mmCheckAllowedIP *mTokenValidatorMockCheckAllowedIP) Times(n uint64) *mTokenValidatorMockCheckAllowedIP {
+	if n == 0 {
+		mmCheckAllowedIP.mock.t.Fatalf("Times of TokenValidatorMock.CheckAllowedIP mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCheckAllowedIP.expectedInvocations, n)
+	mmCheckAllowedIP.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCheckAllowedIP
+}
+
+func (mmCheckAllowedIP *mTokenValidatorMockCheckAllowedIP) invocationsDone() bool {
+	if len(mmCheckAllowedIP.expectations) == 0 && mmCheckAllowedIP.defaultExpectation == nil && mmCheckAllowedIP.mock.funcCheckAllowedIP == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCheckAllowedIP.mock.afterCheckAllowedIPCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCheckAllowedIP.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CheckAllowedIP implements TokenValidator
+func (mmCheckAllowedIP *TokenValidatorMock) CheckAllowedIP(ctx context.Context, claims *models.Claims, ip string) (err error) {
+	mm_atomic.AddUint64(&mmCheckAllowedIP.beforeCheckAllowedIPCounter, 1)
+	defer mm_atomic.AddUint64(&mmCheckAllowedIP.afterCheckAllowedIPCounter, 1)
+
+	mmCheckAllowedIP.t.Helper()
+
+	if mmCheckAllowedIP.inspectFuncCheckAllowedIP != nil {
+		mmCheckAllowedIP.inspectFuncCheckAllowedIP(ctx, claims, ip)
+	}
+
+	mm_params := TokenValidatorMockCheckAllowedIPParams{ctx, claims, ip}
+
+	// Record call args
+	mmCheckAllowedIP.CheckAllowedIPMock.mutex.Lock()
+	mmCheckAllowedIP.CheckAllowedIPMock.callArgs = append(mmCheckAllowedIP.CheckAllowedIPMock.callArgs, &mm_params)
+	mmCheckAllowedIP.CheckAllowedIPMock.mutex.Unlock()
+
+	for _, e := range mmCheckAllowedIP.CheckAllowedIPMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCheckAllowedIP.CheckAllowedIPMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCheckAllowedIP.CheckAllowedIPMock.defaultExpectation.Counter, 1)
+		mm_want := mmCheckAllowedIP.CheckAllowedIPMock.defaultExpectation.params
+		mm_want_ptrs := mmCheckAllowedIP.CheckAllowedIPMock.defaultExpectation.paramPtrs
+
+		mm_got := TokenValidatorMockCheckAllowedIPParams{ctx, claims, ip}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCheckAllowedIP.t.Errorf("TokenValidatorMock.CheckAllowedIP got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCheckAllowedIP.CheckAllowedIPMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.claims != nil && !minimock.Equal(*mm_want_ptrs.claims, mm_got.claims) {
+				mmCheckAllowedIP.t.Errorf("TokenValidatorMock.CheckAllowedIP got unexpected parameter claims, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCheckAllowedIP.CheckAllowedIPMock.defaultExpectation.expectationOrigins.originClaims, *mm_want_ptrs.claims, mm_got.claims, minimock.Diff(*mm_want_ptrs.claims, mm_got.claims))
+			}
+
+			if mm_want_ptrs.ip != nil && !minimock.Equal(*mm_want_ptrs.ip, mm_got.ip) {
+				mmCheckAllowedIP.t.Errorf("TokenValidatorMock.CheckAllowedIP got unexpected parameter ip, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCheckAllowedIP.CheckAllowedIPMock.defaultExpectation.expectationOrigins.originIp, *mm_want_ptrs.ip, mm_got.ip, minimock.Diff(*mm_want_ptrs.ip, mm_got.ip))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCheckAllowedIP.t.Errorf("TokenValidatorMock.CheckAllowedIP got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCheckAllowedIP.CheckAllowedIPMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCheckAllowedIP.CheckAllowedIPMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCheckAllowedIP.t.Fatal("No results are set for the TokenValidatorMock.CheckAllowedIP")
+		}
+		return (*mm_results).err
+	}
+	if mmCheckAllowedIP.funcCheckAllowedIP != nil {
+		return mmCheckAllowedIP.funcCheckAllowedIP(ctx, claims, ip)
+	}
+	mmCheckAllowedIP.t.Fatalf("Unexpected call to TokenValidatorMock.CheckAllowedIP. %v %v %v", ctx, claims, ip)
+	return
+}
+
+// CheckAllowedIPAfterCounter returns a count of finished TokenValidatorMock.CheckAllowedIP invocations
+func (mmCheckAllowedIP *TokenValidatorMock) CheckAllowedIPAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCheckAllowedIP.afterCheckAllowedIPCounter)
+}
+
+// CheckAllowedIPBeforeCounter returns a count of TokenValidatorMock.CheckAllowedIP invocations
+func (mmCheckAllowedIP *TokenValidatorMock) CheckAllowedIPBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCheckAllowedIP.beforeCheckAllowedIPCounter)
+}
+
+// Calls returns a list of arguments used in each call to TokenValidatorMock.CheckAllowedIP.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCheckAllowedIP *mTokenValidatorMockCheckAllowedIP) Calls() []*TokenValidatorMockCheckAllowedIPParams {
+	mmCheckAllowedIP.mutex.RLock()
+
+	argCopy := make([]*TokenValidatorMockCheckAllowedIPParams, len(mmCheckAllowedIP.callArgs))
+	copy(argCopy, mmCheckAllowedIP.callArgs)
+
+	mmCheckAllowedIP.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCheckAllowedIPDone returns true if the count of the CheckAllowedIP invocations corresponds
+// the number of defined expectations
+func (m *TokenValidatorMock) MinimockCheckAllowedIPDone() bool {
+	if m.CheckAllowedIPMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CheckAllowedIPMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CheckAllowedIPMock.invocationsDone()
+}
+
+// MinimockCheckAllowedIPInspect logs each unmet expectation
+func (m *TokenValidatorMock) MinimockCheckAllowedIPInspect() {
+	for _, e := range m.CheckAllowedIPMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to TokenValidatorMock.CheckAllowedIP at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCheckAllowedIPCounter := mm_atomic.LoadUint64(&m.afterCheckAllowedIPCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CheckAllowedIPMock.defaultExpectation != nil && afterCheckAllowedIPCounter < 1 {
+		if m.CheckAllowedIPMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to TokenValidatorMock.CheckAllowedIP at\n%s", m.CheckAllowedIPMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to TokenValidatorMock.CheckAllowedIP at\n%s with params: %#v", m.CheckAllowedIPMock.defaultExpectation.expectationOrigins.origin, *m.CheckAllowedIPMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCheckAllowedIP != nil && afterCheckAllowedIPCounter < 1 {
+		m.t.Errorf("Expected call to TokenValidatorMock.CheckAllowedIP at\n%s", m.funcCheckAllowedIPOrigin)
+	}
+
+	if !m.CheckAllowedIPMock.invocationsDone() && afterCheckAllowedIPCounter > 0 {
+		m.t.Errorf("Expected %d calls to TokenValidatorMock.CheckAllowedIP at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CheckAllowedIPMock.expectedInvocations), m.CheckAllowedIPMock.expectedInvocationsOrigin, afterCheckAllowedIPCounter)
+	}
+}
+
 type mTokenValidatorMockValidateJWT struct {
 	optional           bool
 	mock               *TokenValidatorMock
@@ -390,6 +773,8 @@ func (m *TokenValidatorMock) MinimockValidateJWTInspect() {
 func (m *TokenValidatorMock) MinimockFinish() {
 	m.finishOnce.Do(func() {
 		if !m.minimockDone() {
+			m.MinimockCheckAllowedIPInspect()
+
 			m.MinimockValidateJWTInspect()
 		}
 	})
@@ -414,5 +799,6 @@ func (m *TokenValidatorMock) MinimockWait(timeout mm_time.Duration) {
 func (m *TokenValidatorMock) minimockDone() bool {
 	done := true
 	return done &&
+		m.MinimockCheckAllowedIPDone() &&
 		m.MinimockValidateJWTDone()
 }
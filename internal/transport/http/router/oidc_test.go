@@ -0,0 +1,327 @@
+package router_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/cookiejar"
+	"net/http/httptest"
+	"net/url"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alonsoF100/authorization-service/internal/config"
+	"github.com/alonsoF100/authorization-service/internal/models"
+	"github.com/alonsoF100/authorization-service/internal/repository/memory"
+	"github.com/alonsoF100/authorization-service/internal/service"
+	"github.com/alonsoF100/authorization-service/internal/transport/http/dto"
+	"github.com/alonsoF100/authorization-service/internal/transport/http/handlers"
+	"github.com/alonsoF100/authorization-service/internal/transport/http/router"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+)
+
+// relyingParty is a minimal OpenID Connect client. It only knows the
+// issuer and its credentials, everything else comes from discovery.
+type relyingParty struct {
+	t            *testing.T
+	server       *httptest.Server
+	issuer       string
+	clientID     string
+	clientSecret string
+	metadata     dto.DiscoveryResponse
+
+	state    string
+	nonce    string
+	verifier string
+	idToken  string
+}
+
+// loginResult is what the relying party learned about the user
+type loginResult struct {
+	IDToken  models.IDTokenClaims `json:"id_token"`
+	UserInfo dto.UserInfoResponse `json:"userinfo"`
+}
+
+func newRelyingParty(t *testing.T) *relyingParty {
+	rp := &relyingParty{
+		t:        t,
+		state:    "af0ifjsldkj",
+		nonce:    "n-0S6_WzA2Mj",
+		verifier: "dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk",
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/login", rp.login)
+	mux.HandleFunc("/callback", rp.callback)
+	mux.HandleFunc("/logged-out", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("state") != rp.state {
+			http.Error(w, "state mismatch", http.StatusBadRequest)
+		}
+	})
+	rp.server = httptest.NewServer(mux)
+	t.Cleanup(rp.server.Close)
+
+	return rp
+}
+
+func (rp *relyingParty) discover() {
+	resp, err := http.Get(rp.issuer + "/.well-known/openid-configuration")
+	require.NoError(rp.t, err)
+	defer resp.Body.Close()
+
+	require.Equal(rp.t, http.StatusOK, resp.StatusCode)
+	require.NoError(rp.t, json.NewDecoder(resp.Body).Decode(&rp.metadata))
+	require.Equal(rp.t, rp.issuer, rp.metadata.Issuer)
+}
+
+func (rp *relyingParty) login(w http.ResponseWriter, r *http.Request) {
+	challenge := sha256.Sum256([]byte(rp.verifier))
+	params := url.Values{
+		"client_id":             {rp.clientID},
+		"redirect_uri":          {rp.server.URL + "/callback"},
+		"response_type":         {"code"},
+		"scope":                 {"openid profile email"},
+		"state":                 {rp.state},
+		"nonce":                 {rp.nonce},
+		"code_challenge":        {base64.RawURLEncoding.EncodeToString(challenge[:])},
+		"code_challenge_method": {"S256"},
+	}
+
+	http.Redirect(w, r, rp.metadata.AuthorizationEndpoint+"?"+params.Encode(), http.StatusFound)
+}
+
+func (rp *relyingParty) callback(w http.ResponseWriter, r *http.Request) {
+	result, err := rp.finishLogin(r.URL.Query())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	_ = json.NewEncoder(w).Encode(result)
+}
+
+func (rp *relyingParty) finishLogin(query url.Values) (*loginResult, error) {
+	if query.Get("state") != rp.state {
+		return nil, errors.New("state mismatch")
+	}
+
+	form := url.Values{
+		"grant_type":    {models.GrantAuthorizationCode},
+		"code":          {query.Get("code")},
+		"redirect_uri":  {rp.server.URL + "/callback"},
+		"code_verifier": {rp.verifier},
+	}
+	req, _ := http.NewRequest(http.MethodPost, rp.metadata.TokenEndpoint, strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.SetBasicAuth(rp.clientID, rp.clientSecret)
+
+	var token dto.TokenResponse
+	if err := doJSON(req, &token); err != nil {
+		return nil, err
+	}
+
+	var result loginResult
+	_, err := jwt.ParseWithClaims(token.IDToken, &result.IDToken, rp.verificationKey,
+		jwt.WithValidMethods(rp.metadata.IDTokenSigningAlgValuesSupported),
+		jwt.WithIssuer(rp.issuer),
+		jwt.WithAudience(rp.clientID),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if result.IDToken.Nonce != rp.nonce {
+		return nil, errors.New("nonce mismatch")
+	}
+	atHash := sha256.Sum256([]byte(token.AccessToken))
+	if result.IDToken.AtHash != base64.RawURLEncoding.EncodeToString(atHash[:16]) {
+		return nil, errors.New("at_hash mismatch")
+	}
+	rp.idToken = token.IDToken
+
+	req, _ = http.NewRequest(http.MethodGet, rp.metadata.UserInfoEndpoint, nil)
+	req.Header.Set("Authorization", "Bearer "+token.AccessToken)
+	if err := doJSON(req, &result.UserInfo); err != nil {
+		return nil, err
+	}
+	if result.UserInfo.Sub != result.IDToken.Subject {
+		return nil, errors.New("userinfo subject mismatch")
+	}
+
+	return &result, nil
+}
+
+// verificationKey looks the kid of the token up in the published key set
+func (rp *relyingParty) verificationKey(token *jwt.Token) (any, error) {
+	req, _ := http.NewRequest(http.MethodGet, rp.metadata.JWKSURI, nil)
+
+	var keySet dto.JWKSResponse
+	if err := doJSON(req, &keySet); err != nil {
+		return nil, err
+	}
+
+	for _, key := range keySet.Keys {
+		if key.Kid != token.Header["kid"] {
+			continue
+		}
+		x, err := base64.RawURLEncoding.DecodeString(key.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := base64.RawURLEncoding.DecodeString(key.Y)
+		if err != nil {
+			return nil, err
+		}
+		return ecdsa.ParseUncompressedPublicKey(elliptic.P256(), append(append([]byte{4}, x...), y...))
+	}
+
+	return nil, errors.New("unknown kid")
+}
+
+func doJSON(req *http.Request, target any) error {
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return errors.New(req.URL.Path + ": " + resp.Status)
+	}
+
+	return json.NewDecoder(resp.Body).Decode(target)
+}
+
+func TestOpenIDConnectFlow(t *testing.T) {
+	ctx := context.Background()
+	cfg := &config.Config{
+		JWT:   config.JWTConfig{Expiry: time.Hour},
+		OAuth: config.OAuthConfig{CodeTTL: time.Minute, SessionCookie: "auth_session", CSRFCookie: "auth_csrf"},
+	}
+
+	repo := memory.New()
+	keyService := service.NewKeyService(repo)
+	_, err := keyService.Rotate(ctx)
+	require.NoError(t, err)
+
+	authService := service.NewAuthService(repo, keyService, nil, cfg)
+	oauthService := service.NewOAuthService(repo, authService, authService, nil, cfg)
+	h := handlers.New(authService, service.NewUserService(repo, nil), oauthService, service.NewServiceAccountService(repo, nil), authService, cfg)
+
+	provider := httptest.NewServer(router.New(h).Setup())
+	defer provider.Close()
+	cfg.OAuth.Issuer = provider.URL
+
+	user, err := authService.SignUp(ctx, "alonsoF100", "alonso@yandex.ru", "alonso_the_great")
+	require.NoError(t, err)
+
+	rp := newRelyingParty(t)
+	client, secret, err := oauthService.CreateClient(
+		ctx,
+		"relying party",
+		[]string{rp.server.URL + "/callback"},
+		[]string{rp.server.URL + "/logged-out"},
+		[]string{models.GrantAuthorizationCode},
+		nil,
+	)
+	require.NoError(t, err)
+	rp.issuer, rp.clientID, rp.clientSecret = provider.URL, client.ID, secret
+	rp.discover()
+
+	jar, err := cookiejar.New(nil)
+	require.NoError(t, err)
+	browser := &http.Client{Jar: jar}
+
+	// the relying party sends the browser to the login page
+	resp, err := browser.Get(rp.server.URL + "/login")
+	require.NoError(t, err)
+	page, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	authorizeURL := resp.Request.URL
+
+	// a form without its token is refused
+	form := authorizeURL.Query()
+	form.Set("email", "alonso@yandex.ru")
+	form.Set("password", "alonso_the_great")
+	form.Set("decision", "allow")
+	resp, err = browser.PostForm(authorizeURL.String(), form)
+	require.NoError(t, err)
+	page, err = io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusForbidden, resp.StatusCode)
+
+	// sign in and consent, the code comes back to the relying party
+	csrfToken := regexp.MustCompile(`name="csrf_token" value="([^"]+)"`).FindSubmatch(page)
+	require.NotNil(t, csrfToken)
+	form.Set("csrf_token", string(csrfToken[1]))
+	resp, err = browser.PostForm(authorizeURL.String(), form)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var result loginResult
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&result))
+	resp.Body.Close()
+
+	require.Equal(t, user.ID, result.IDToken.Subject)
+	require.NotNil(t, result.IDToken.AuthTime)
+	require.Equal(t, "alonsoF100", result.IDToken.Nickname)
+	require.Equal(t, dto.UserInfoResponse{
+		Sub:           user.ID,
+		Nickname:      "alonsoF100",
+		Email:         "alonso@yandex.ru",
+		EmailVerified: result.UserInfo.EmailVerified,
+	}, result.UserInfo)
+	require.False(t, *result.UserInfo.EmailVerified)
+
+	// the session cookie of the authorize page is no API token
+	var sessionToken string
+	for _, cookie := range jar.Cookies(&url.URL{Scheme: "http", Host: authorizeURL.Host, Path: "/oauth"}) {
+		if cookie.Name == "auth_session" {
+			sessionToken = cookie.Value
+		}
+	}
+	require.NotEmpty(t, sessionToken)
+	req, _ := http.NewRequest(http.MethodGet, provider.URL+"/api/me", nil)
+	req.Header.Set("Authorization", "Bearer "+sessionToken)
+	resp, err = http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+
+	// RP-initiated logout ends the session and returns to the relying party
+	logout := url.Values{
+		"id_token_hint":            {rp.idToken},
+		"post_logout_redirect_uri": {rp.server.URL + "/logged-out"},
+		"state":                    {rp.state},
+	}
+	resp, err = browser.Get(rp.metadata.EndSessionEndpoint + "?" + logout.Encode())
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, rp.server.URL+"/logged-out", resp.Request.URL.Scheme+"://"+resp.Request.URL.Host+resp.Request.URL.Path)
+
+	// consent is kept but the user has to sign in again
+	resp, err = browser.Get(authorizeURL.String())
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, provider.URL+"/oauth/authorize", resp.Request.URL.Scheme+"://"+resp.Request.URL.Host+resp.Request.URL.Path)
+
+	// a logout redirect that is not registered is refused
+	logout.Set("post_logout_redirect_uri", "https://evil.example.com")
+	resp, err = browser.Get(rp.metadata.EndSessionEndpoint + "?" + logout.Encode())
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
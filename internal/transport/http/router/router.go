@@ -1,6 +1,10 @@
 package router
 
 import (
+	"net/http"
+
+	"github.com/alonsoF100/authorization-service/internal/config"
+	"github.com/alonsoF100/authorization-service/internal/models"
 	"github.com/alonsoF100/authorization-service/internal/transport/http/handlers"
 	"github.com/alonsoF100/authorization-service/internal/transport/http/middleware"
 	"github.com/go-chi/chi/v5"
@@ -19,19 +23,118 @@ func New(handlers *handlers.Handler) *Router {
 func (rt Router) Setup() *chi.Mux {
 	r := chi.NewRouter()
 
+	r.Use(middleware.RealIP(rt.handlers.Cfg.Server.TrustedPrefixes()))
+	r.Use(middleware.RequestID())
+	if rt.handlers.IPFilter != nil {
+		r.Use(middleware.IPFilter(rt.handlers.IPFilter))
+	}
+	// before routing, so preflight requests get an answer instead of 405
+	r.Use(middleware.SecurityHeaders(rt.handlers.Cfg.Security))
+	r.Use(middleware.CORS(func() config.CORSConfig { return rt.config().CORS }))
+
+	loginLimit := rt.rateLimit("login", middleware.ByIP())
+
 	// Public routes
 	r.Route("/auth", func(r chi.Router) {
-		r.Post("/register", rt.handlers.SignUp)
-		r.Post("/login", rt.handlers.SignIn)
+		r.With(rt.rateLimit("register", middleware.ByIP())).Post("/register", rt.handlers.SignUp)
+		r.With(loginLimit).Post("/login", rt.handlers.SignIn)
+		r.Get("/verify", rt.handlers.Verify)
+		r.With(middleware.Auth(rt.handlers.AuthService, rt.handlers.UserService, rt.handlers.ServiceAccountService, rt.handlers.Cfg.Cookie)).
+			Post("/logout", rt.handlers.SignOut)
+	})
+
+	// OpenID provider metadata
+	r.Route("/.well-known", func(r chi.Router) {
+		r.Get("/openid-configuration", rt.handlers.Discovery)
+		r.Get("/jwks.json", rt.handlers.JWKS)
+	})
+
+	// OAuth authorization server, token, introspect and revoke need client
+	// credentials, userinfo a bearer token
+	r.Route("/oauth", func(r chi.Router) {
+		tokenLimit := rt.rateLimit("token", middleware.ByClient())
+		// userinfo has no client, its bearer token is checked by the handler
+		userInfoLimit := rt.rateLimit("api", middleware.ByIP())
+
+		r.Get("/authorize", rt.handlers.Authorize)
+		// the sign in form shares the bucket of /auth/login
+		r.With(loginLimit).Post("/authorize", rt.handlers.Authorize)
+		r.With(tokenLimit).Post("/token", rt.handlers.Token)
+		r.With(tokenLimit).Post("/introspect", rt.handlers.Introspect)
+		r.With(tokenLimit).Post("/revoke", rt.handlers.Revoke)
+		r.With(userInfoLimit).Get("/userinfo", rt.handlers.UserInfo)
+		r.With(userInfoLimit).Post("/userinfo", rt.handlers.UserInfo)
+		r.Get("/logout", rt.handlers.Logout)
+		r.Post("/logout", rt.handlers.Logout)
 	})
 
 	// Protected routes
 	r.Route("/api", func(r chi.Router) {
-		r.Use(middleware.Auth(rt.handlers.AuthService))
+		r.Use(middleware.Auth(rt.handlers.AuthService, rt.handlers.UserService, rt.handlers.ServiceAccountService, rt.handlers.Cfg.Cookie))
+		r.Use(rt.rateLimit("api", middleware.ByPrincipal()))
+
+		// tokens limited by scopes need read to look and write to change
+		r.Group(func(r chi.Router) {
+			r.Use(middleware.RequireScope(models.ScopeRead))
+
+			r.Get("/me", rt.handlers.GetMe)
+			r.Get("/me/tokens", rt.handlers.ListPersonalAccessTokens)
+			r.Get("/me/sessions", rt.handlers.ListSessions)
+			if rt.handlers.AuditService != nil {
+				r.Get("/me/security-events", rt.handlers.ListSecurityEvents)
+			}
+		})
+		r.Group(func(r chi.Router) {
+			r.Use(middleware.RequireScope(models.ScopeWrite))
 
-		r.Get("/me", rt.handlers.GetMe)
-		r.Delete("/me", rt.handlers.DeleteMe)
+			r.Delete("/me", rt.handlers.DeleteMe)
+			r.Post("/me/tokens", rt.handlers.CreatePersonalAccessToken)
+			r.Delete("/me/tokens/{id}", rt.handlers.DeletePersonalAccessToken)
+			r.Delete("/me/sessions/{id}", rt.handlers.DeleteSession)
+			r.Put("/me/allowed-ips", rt.handlers.SetAllowedIPs)
+		})
+	})
+
+	// Admin routes
+	r.Route("/admin", func(r chi.Router) {
+		r.Use(middleware.Auth(rt.handlers.AuthService, rt.handlers.UserService, rt.handlers.ServiceAccountService, rt.handlers.Cfg.Cookie))
+		r.Use(middleware.RequireScope(models.ScopeAdmin))
+		r.Use(middleware.RequireRole(models.RoleAdmin))
+
+		r.Post("/service-accounts", rt.handlers.CreateServiceAccount)
+		r.Get("/service-accounts", rt.handlers.ListServiceAccounts)
+		r.Get("/service-accounts/{id}", rt.handlers.GetServiceAccount)
+		r.Delete("/service-accounts/{id}", rt.handlers.DeleteServiceAccount)
+		r.Post("/service-accounts/{id}/keys", rt.handlers.CreateAPIKey)
+		r.Get("/service-accounts/{id}/keys", rt.handlers.ListAPIKeys)
+		r.Delete("/service-accounts/{id}/keys/{keyID}", rt.handlers.DeleteAPIKey)
+		if rt.handlers.AuditService != nil {
+			r.Get("/audit", rt.handlers.ListAuditEvents)
+		}
 	})
 
 	return r
 }
+
+// rateLimit limits a route when rate limiting is on. The store is only
+// created at start, so turning the limits on needs a restart.
+func (rt Router) rateLimit(name string, key middleware.RateLimitKey) func(http.Handler) http.Handler {
+	if rt.handlers.RateLimitStore == nil {
+		return func(next http.Handler) http.Handler { return next }
+	}
+
+	return middleware.RateLimit(rt.handlers.RateLimitStore, name, func() config.RateLimit {
+		return rt.config().RateLimit.Limit(name)
+	}, key)
+}
+
+// config returns the reloaded config when there is one
+func (rt Router) config() *config.Config {
+	if rt.handlers.LiveConfig != nil {
+		if cfg := rt.handlers.LiveConfig.Load(); cfg != nil {
+			return cfg
+		}
+	}
+
+	return rt.handlers.Cfg
+}
@@ -1,21 +1,30 @@
 package router_test
 
 import (
+	"context"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
+	"github.com/alonsoF100/authorization-service/internal/config"
+	"github.com/alonsoF100/authorization-service/internal/ratelimit"
+	"github.com/alonsoF100/authorization-service/internal/repository/memory"
 	"github.com/alonsoF100/authorization-service/internal/service"
 	"github.com/alonsoF100/authorization-service/internal/transport/http/handlers"
 	"github.com/alonsoF100/authorization-service/internal/transport/http/router"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestRouter_Basic(t *testing.T) {
 	h := &handlers.Handler{
-		AuthService: service.NewAuthService(nil, nil),
-		UserService: service.NewUserService(nil),
-		Validator:   nil,
+		AuthService:  service.NewAuthService(nil, nil, nil, nil),
+		UserService:  service.NewUserService(nil, nil),
+		OAuthService: service.NewOAuthService(nil, nil, nil, nil, nil),
+		Verifier:     service.NewAuthService(nil, nil, nil, nil),
+		Validator:    nil,
+		Cfg:          &config.Config{},
 	}
 
 	rt := router.New(h)
@@ -28,8 +37,29 @@ func TestRouter_Basic(t *testing.T) {
 	}{
 		{"POST", "/auth/register", 400},
 		{"POST", "/auth/login", 400},
+		{"GET", "/auth/verify", 401},
+		{"POST", "/auth/logout", 401},
+		{"POST", "/oauth/introspect", 401},
+		{"POST", "/oauth/revoke", 401},
+		{"POST", "/oauth/token", 401},
+		{"GET", "/oauth/userinfo", 401},
+		{"GET", "/oauth/logout", 200},
+		{"GET", "/.well-known/openid-configuration", 200},
+		{"GET", "/.well-known/jwks.json", 200},
 		{"GET", "/api/me", 401},
 		{"DELETE", "/api/me", 401},
+		{"POST", "/api/me/tokens", 401},
+		{"GET", "/api/me/tokens", 401},
+		{"DELETE", "/api/me/tokens/1", 401},
+		{"GET", "/api/me/sessions", 401},
+		{"DELETE", "/api/me/sessions/1", 401},
+		{"POST", "/admin/service-accounts", 401},
+		{"GET", "/admin/service-accounts", 401},
+		{"GET", "/admin/service-accounts/1", 401},
+		{"DELETE", "/admin/service-accounts/1", 401},
+		{"POST", "/admin/service-accounts/1/keys", 401},
+		{"GET", "/admin/service-accounts/1/keys", 401},
+		{"DELETE", "/admin/service-accounts/1/keys/1", 401},
 	}
 
 	for _, tc := range testCases {
@@ -57,3 +87,134 @@ func TestRouter_Basic(t *testing.T) {
 		assert.Equal(t, http.StatusNotFound, rr.Code)
 	})
 }
+
+func TestRouter_Preflight(t *testing.T) {
+	cfg := &config.Config{}
+	cfg.CORS.AllowedOrigins = []string{"https://app.example.com"}
+	cfg.CORS.AllowedMethods = []string{"POST"}
+	cfg.Security.NoSniff = true
+
+	r := router.New(&handlers.Handler{Cfg: cfg}).Setup()
+
+	req := httptest.NewRequest(http.MethodOptions, "/auth/login", nil)
+	req.Header.Set("Origin", "https://app.example.com")
+	req.Header.Set("Access-Control-Request-Method", "POST")
+
+	rr := httptest.NewRecorder()
+	r.ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusNoContent, rr.Code)
+	assert.Equal(t, "https://app.example.com", rr.Header().Get("Access-Control-Allow-Origin"))
+	assert.Equal(t, "POST", rr.Header().Get("Access-Control-Allow-Methods"))
+	assert.Equal(t, "nosniff", rr.Header().Get("X-Content-Type-Options"))
+}
+
+func TestRouter_RateLimit(t *testing.T) {
+	cfg := &config.Config{}
+	cfg.RateLimit.Enabled = true
+	cfg.RateLimit.Login = config.RateLimit{Requests: 1, Period: time.Minute}
+
+	h := &handlers.Handler{
+		AuthService:    service.NewAuthService(nil, nil, nil, nil),
+		Cfg:            cfg,
+		RateLimitStore: ratelimit.NewMemoryStore(1),
+	}
+	r := router.New(h).Setup()
+
+	login := func(remoteAddr string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/auth/login", nil)
+		req.RemoteAddr = remoteAddr
+		rr := httptest.NewRecorder()
+		r.ServeHTTP(rr, req)
+		return rr
+	}
+
+	assert.Equal(t, http.StatusBadRequest, login("192.0.2.1:1234").Code)
+	rr := login("192.0.2.1:1234")
+	assert.Equal(t, http.StatusTooManyRequests, rr.Code)
+	assert.NotEmpty(t, rr.Header().Get("Retry-After"))
+	assert.Equal(t, http.StatusBadRequest, login("192.0.2.2:1234").Code)
+
+	// the register limit is off
+	req := httptest.NewRequest(http.MethodPost, "/auth/register", nil)
+	rr = httptest.NewRecorder()
+	r.ServeHTTP(rr, req)
+	assert.Empty(t, rr.Header().Get("RateLimit-Limit"))
+}
+
+func TestRouter_RateLimitOAuth(t *testing.T) {
+	cfg := &config.Config{}
+	cfg.RateLimit.Enabled = true
+	cfg.RateLimit.Token = config.RateLimit{Requests: 1, Period: time.Minute}
+	cfg.RateLimit.API = config.RateLimit{Requests: 1, Period: time.Minute}
+
+	repo := memory.New()
+	authService := service.NewAuthService(repo, nil, nil, cfg)
+	h := &handlers.Handler{
+		AuthService:    authService,
+		OAuthService:   service.NewOAuthService(repo, authService, authService, nil, cfg),
+		Cfg:            cfg,
+		RateLimitStore: ratelimit.NewMemoryStore(1),
+	}
+	r := router.New(h).Setup()
+
+	for _, path := range []string{"/oauth/introspect", "/oauth/revoke", "/oauth/userinfo"} {
+		t.Run(path, func(t *testing.T) {
+			send := func() int {
+				req := httptest.NewRequest(http.MethodPost, path, nil)
+				req.RemoteAddr = "192.0.2.1:1234"
+				req.SetBasicAuth("client"+path, "secret")
+				rr := httptest.NewRecorder()
+				r.ServeHTTP(rr, req)
+				return rr.Code
+			}
+
+			assert.Equal(t, http.StatusUnauthorized, send())
+			assert.Equal(t, http.StatusTooManyRequests, send())
+		})
+	}
+}
+
+func TestRouter_DisabledUser(t *testing.T) {
+	ctx := context.Background()
+	cfg := &config.Config{
+		JWT: config.JWTConfig{
+			SecretKey: "someSecretsomeSecretsomeSecretsomeSecret",
+			Expiry:    time.Hour,
+		},
+	}
+
+	repo := memory.New()
+	authService := service.NewAuthService(repo, nil, nil, cfg)
+	userService := service.NewUserService(repo, nil)
+	h := handlers.New(authService, userService, service.NewOAuthService(repo, authService, authService, nil, cfg), service.NewServiceAccountService(repo, nil), authService, cfg)
+	r := router.New(h).Setup()
+
+	user, err := authService.SignUp(ctx, "alonso", "alonso@yandex.ru", "alonso_the_great")
+	require.NoError(t, err)
+	signedIn, err := authService.SignIn(ctx, "alonso@yandex.ru", "alonso_the_great", "curl/8.0", "192.0.2.1")
+	require.NoError(t, err)
+	// a token without a session only the user check stops
+	sessionless, err := authService.GenerateJWT(user)
+	require.NoError(t, err)
+
+	status := func(path, token string) int {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		rr := httptest.NewRecorder()
+		r.ServeHTTP(rr, req)
+		return rr.Code
+	}
+
+	for _, token := range []string{signedIn, sessionless} {
+		require.Equal(t, http.StatusOK, status("/api/me", token))
+		require.Equal(t, http.StatusOK, status("/auth/verify", token))
+	}
+
+	require.NoError(t, userService.DisableUser(ctx, user.ID))
+
+	for _, token := range []string{signedIn, sessionless} {
+		require.Equal(t, http.StatusUnauthorized, status("/api/me", token))
+		require.Equal(t, http.StatusUnauthorized, status("/auth/verify", token))
+	}
+}
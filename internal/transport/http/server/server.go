@@ -1,6 +1,7 @@
 package server
 
 import (
+	"context"
 	"log/slog"
 	"net/http"
 
@@ -49,3 +50,7 @@ func (s *Server) Start() error {
 
 	return s.Server.ListenAndServe()
 }
+
+func (s *Server) Shutdown(ctx context.Context) error {
+	return s.Server.Shutdown(ctx)
+}
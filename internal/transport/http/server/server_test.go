@@ -26,7 +26,7 @@ func TestNew(t *testing.T) {
 		},
 	}
 
-	mockHandler := &handlers.Handler{}
+	mockHandler := &handlers.Handler{Cfg: cfg}
 
 	logger := logger.Setup(cfg)
 	srv := server.New(cfg, mockHandler, logger)
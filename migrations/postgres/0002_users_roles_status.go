@@ -0,0 +1,30 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/pressly/goose/v3"
+)
+
+func init() {
+	goose.AddMigrationContext(upUsersRolesStatus, downUsersRolesStatus)
+}
+
+func upUsersRolesStatus(ctx context.Context, tx *sql.Tx) error {
+	_, err := tx.ExecContext(ctx, `
+		ALTER TABLE users
+			ADD COLUMN roles TEXT[] NOT NULL DEFAULT '{}',
+			ADD COLUMN disabled_at TIMESTAMP NULL;
+	`)
+	return err
+}
+
+func downUsersRolesStatus(ctx context.Context, tx *sql.Tx) error {
+	_, err := tx.ExecContext(ctx, `
+		ALTER TABLE users
+			DROP COLUMN IF EXISTS roles,
+			DROP COLUMN IF EXISTS disabled_at;
+	`)
+	return err
+}
@@ -0,0 +1,30 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/pressly/goose/v3"
+)
+
+func init() {
+	goose.AddMigrationContext(upCreateSigningKeys, downCreateSigningKeys)
+}
+
+func upCreateSigningKeys(ctx context.Context, tx *sql.Tx) error {
+	_, err := tx.ExecContext(ctx, `
+		CREATE TABLE signing_keys (
+			id VARCHAR(64) PRIMARY KEY,
+			algorithm VARCHAR(16) NOT NULL,
+			private_key TEXT NOT NULL,
+			created_at TIMESTAMP NOT NULL,
+			retired_at TIMESTAMP NULL
+		);
+	`)
+	return err
+}
+
+func downCreateSigningKeys(ctx context.Context, tx *sql.Tx) error {
+	_, err := tx.ExecContext(ctx, "DROP TABLE IF EXISTS signing_keys;")
+	return err
+}
@@ -0,0 +1,39 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/pressly/goose/v3"
+)
+
+func init() {
+	goose.AddMigrationContext(upCreateOAuth, downCreateOAuth)
+}
+
+func upCreateOAuth(ctx context.Context, tx *sql.Tx) error {
+	_, err := tx.ExecContext(ctx, `
+		CREATE TABLE oauth_clients (
+			id VARCHAR(64) PRIMARY KEY,
+			name VARCHAR(255) NOT NULL,
+			secret_hash VARCHAR(255) NOT NULL,
+			created_at TIMESTAMP NOT NULL
+		);
+
+		CREATE TABLE revoked_tokens (
+			jti VARCHAR(64) PRIMARY KEY,
+			expires_at TIMESTAMP NOT NULL
+		);
+
+		CREATE INDEX revoked_tokens_expires_at_idx ON revoked_tokens (expires_at);
+	`)
+	return err
+}
+
+func downCreateOAuth(ctx context.Context, tx *sql.Tx) error {
+	_, err := tx.ExecContext(ctx, `
+		DROP TABLE IF EXISTS revoked_tokens;
+		DROP TABLE IF EXISTS oauth_clients;
+	`)
+	return err
+}
@@ -0,0 +1,52 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/pressly/goose/v3"
+)
+
+func init() {
+	goose.AddMigrationContext(upOAuthAuthorizationCode, downOAuthAuthorizationCode)
+}
+
+func upOAuthAuthorizationCode(ctx context.Context, tx *sql.Tx) error {
+	_, err := tx.ExecContext(ctx, `
+		ALTER TABLE oauth_clients
+			ADD COLUMN redirect_uris TEXT[] NOT NULL DEFAULT '{}',
+			ADD COLUMN grant_types TEXT[] NOT NULL DEFAULT '{}';
+
+		CREATE TABLE authorization_codes (
+			code_hash VARCHAR(64) PRIMARY KEY,
+			client_id VARCHAR(64) NOT NULL REFERENCES oauth_clients (id) ON DELETE CASCADE,
+			user_id UUID NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+			redirect_uri TEXT NOT NULL,
+			scope TEXT NOT NULL,
+			code_challenge VARCHAR(128) NOT NULL,
+			expires_at TIMESTAMP NOT NULL,
+			created_at TIMESTAMP NOT NULL
+		);
+
+		CREATE TABLE oauth_consents (
+			user_id UUID NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+			client_id VARCHAR(64) NOT NULL REFERENCES oauth_clients (id) ON DELETE CASCADE,
+			scopes TEXT[] NOT NULL DEFAULT '{}',
+			granted_at TIMESTAMP NOT NULL,
+			PRIMARY KEY (user_id, client_id)
+		);
+	`)
+	return err
+}
+
+func downOAuthAuthorizationCode(ctx context.Context, tx *sql.Tx) error {
+	_, err := tx.ExecContext(ctx, `
+		DROP TABLE IF EXISTS oauth_consents;
+		DROP TABLE IF EXISTS authorization_codes;
+
+		ALTER TABLE oauth_clients
+			DROP COLUMN IF EXISTS redirect_uris,
+			DROP COLUMN IF EXISTS grant_types;
+	`)
+	return err
+}
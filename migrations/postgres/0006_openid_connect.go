@@ -0,0 +1,36 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/pressly/goose/v3"
+)
+
+func init() {
+	goose.AddMigrationContext(upOpenIDConnect, downOpenIDConnect)
+}
+
+func upOpenIDConnect(ctx context.Context, tx *sql.Tx) error {
+	_, err := tx.ExecContext(ctx, `
+		ALTER TABLE oauth_clients
+			ADD COLUMN post_logout_redirect_uris TEXT[] NOT NULL DEFAULT '{}';
+
+		ALTER TABLE authorization_codes
+			ADD COLUMN nonce TEXT NOT NULL DEFAULT '',
+			ADD COLUMN auth_time TIMESTAMP NULL;
+	`)
+	return err
+}
+
+func downOpenIDConnect(ctx context.Context, tx *sql.Tx) error {
+	_, err := tx.ExecContext(ctx, `
+		ALTER TABLE authorization_codes
+			DROP COLUMN IF EXISTS nonce,
+			DROP COLUMN IF EXISTS auth_time;
+
+		ALTER TABLE oauth_clients
+			DROP COLUMN IF EXISTS post_logout_redirect_uris;
+	`)
+	return err
+}
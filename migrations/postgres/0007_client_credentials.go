@@ -0,0 +1,28 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/pressly/goose/v3"
+)
+
+func init() {
+	goose.AddMigrationContext(upClientCredentials, downClientCredentials)
+}
+
+func upClientCredentials(ctx context.Context, tx *sql.Tx) error {
+	_, err := tx.ExecContext(ctx, `
+		ALTER TABLE oauth_clients
+			ADD COLUMN scopes TEXT[] NOT NULL DEFAULT '{}';
+	`)
+	return err
+}
+
+func downClientCredentials(ctx context.Context, tx *sql.Tx) error {
+	_, err := tx.ExecContext(ctx, `
+		ALTER TABLE oauth_clients
+			DROP COLUMN IF EXISTS scopes;
+	`)
+	return err
+}
@@ -0,0 +1,38 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/pressly/goose/v3"
+)
+
+func init() {
+	goose.AddMigrationContext(upPersonalAccessTokens, downPersonalAccessTokens)
+}
+
+func upPersonalAccessTokens(ctx context.Context, tx *sql.Tx) error {
+	_, err := tx.ExecContext(ctx, `
+		CREATE TABLE personal_access_tokens (
+			id UUID PRIMARY KEY,
+			user_id UUID NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+			name VARCHAR(100) NOT NULL,
+			token_hash VARCHAR(64) NOT NULL UNIQUE,
+			scopes TEXT[] NOT NULL DEFAULT '{}',
+			expires_at TIMESTAMP,
+			last_used_at TIMESTAMP,
+			last_used_ip VARCHAR(45) NOT NULL DEFAULT '',
+			created_at TIMESTAMP NOT NULL
+		);
+
+		CREATE INDEX personal_access_tokens_user_id_idx ON personal_access_tokens (user_id);
+	`)
+	return err
+}
+
+func downPersonalAccessTokens(ctx context.Context, tx *sql.Tx) error {
+	_, err := tx.ExecContext(ctx, `
+		DROP TABLE IF EXISTS personal_access_tokens;
+	`)
+	return err
+}
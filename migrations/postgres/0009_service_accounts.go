@@ -0,0 +1,44 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/pressly/goose/v3"
+)
+
+func init() {
+	goose.AddMigrationContext(upServiceAccounts, downServiceAccounts)
+}
+
+func upServiceAccounts(ctx context.Context, tx *sql.Tx) error {
+	_, err := tx.ExecContext(ctx, `
+		CREATE TABLE service_accounts (
+			id UUID PRIMARY KEY,
+			name VARCHAR(100) NOT NULL,
+			owner_id UUID REFERENCES users (id) ON DELETE SET NULL,
+			roles TEXT[] NOT NULL DEFAULT '{}',
+			created_at TIMESTAMP NOT NULL,
+			CONSTRAINT unique_service_account_name UNIQUE (name)
+		);
+
+		CREATE TABLE api_keys (
+			id UUID PRIMARY KEY,
+			service_account_id UUID NOT NULL REFERENCES service_accounts (id) ON DELETE CASCADE,
+			key_hash VARCHAR(64) NOT NULL,
+			last_used_at TIMESTAMP,
+			created_at TIMESTAMP NOT NULL
+		);
+
+		CREATE INDEX api_keys_service_account_id_idx ON api_keys (service_account_id);
+	`)
+	return err
+}
+
+func downServiceAccounts(ctx context.Context, tx *sql.Tx) error {
+	_, err := tx.ExecContext(ctx, `
+		DROP TABLE IF EXISTS api_keys;
+		DROP TABLE IF EXISTS service_accounts;
+	`)
+	return err
+}
@@ -0,0 +1,38 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/pressly/goose/v3"
+)
+
+func init() {
+	goose.AddMigrationContext(upSessions, downSessions)
+}
+
+func upSessions(ctx context.Context, tx *sql.Tx) error {
+	_, err := tx.ExecContext(ctx, `
+		CREATE TABLE sessions (
+			id UUID PRIMARY KEY,
+			user_id UUID NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+			device_name VARCHAR(100) NOT NULL DEFAULT '',
+			user_agent TEXT NOT NULL DEFAULT '',
+			ip VARCHAR(45) NOT NULL DEFAULT '',
+			created_at TIMESTAMP NOT NULL,
+			last_seen_at TIMESTAMP NOT NULL,
+			expires_at TIMESTAMP NOT NULL
+		);
+
+		CREATE INDEX sessions_user_id_idx ON sessions (user_id);
+		CREATE INDEX sessions_expires_at_idx ON sessions (expires_at);
+	`)
+	return err
+}
+
+func downSessions(ctx context.Context, tx *sql.Tx) error {
+	_, err := tx.ExecContext(ctx, `
+		DROP TABLE IF EXISTS sessions;
+	`)
+	return err
+}
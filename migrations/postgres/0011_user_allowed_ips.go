@@ -0,0 +1,28 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/pressly/goose/v3"
+)
+
+func init() {
+	goose.AddMigrationContext(upUserAllowedIPs, downUserAllowedIPs)
+}
+
+func upUserAllowedIPs(ctx context.Context, tx *sql.Tx) error {
+	_, err := tx.ExecContext(ctx, `
+		ALTER TABLE users
+			ADD COLUMN allowed_ips TEXT[] NOT NULL DEFAULT '{}';
+	`)
+	return err
+}
+
+func downUserAllowedIPs(ctx context.Context, tx *sql.Tx) error {
+	_, err := tx.ExecContext(ctx, `
+		ALTER TABLE users
+			DROP COLUMN IF EXISTS allowed_ips;
+	`)
+	return err
+}
@@ -0,0 +1,56 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/pressly/goose/v3"
+)
+
+func init() {
+	goose.AddMigrationContext(upAuditEvents, downAuditEvents)
+}
+
+// audit_events has no foreign keys, the trail outlives the users and
+// objects it mentions. The trigger keeps it append-only.
+func upAuditEvents(ctx context.Context, tx *sql.Tx) error {
+	_, err := tx.ExecContext(ctx, `
+		CREATE TABLE audit_events (
+			id BIGSERIAL PRIMARY KEY,
+			type VARCHAR(64) NOT NULL,
+			outcome VARCHAR(16) NOT NULL,
+			actor_type VARCHAR(32) NOT NULL DEFAULT '',
+			actor_id TEXT NOT NULL DEFAULT '',
+			target_id TEXT NOT NULL DEFAULT '',
+			ip VARCHAR(45) NOT NULL DEFAULT '',
+			user_agent TEXT NOT NULL DEFAULT '',
+			request_id VARCHAR(128) NOT NULL DEFAULT '',
+			details JSONB NOT NULL DEFAULT '{}',
+			created_at TIMESTAMP NOT NULL
+		);
+
+		CREATE INDEX audit_events_type_idx ON audit_events (type, id);
+		CREATE INDEX audit_events_actor_id_idx ON audit_events (actor_id, id);
+		CREATE INDEX audit_events_target_id_idx ON audit_events (target_id, id);
+		CREATE INDEX audit_events_created_at_idx ON audit_events (created_at);
+
+		CREATE FUNCTION audit_events_append_only() RETURNS trigger AS $$
+		BEGIN
+			RAISE EXCEPTION 'audit_events is append-only';
+		END;
+		$$ LANGUAGE plpgsql;
+
+		CREATE TRIGGER audit_events_append_only
+			BEFORE UPDATE OR DELETE ON audit_events
+			FOR EACH ROW EXECUTE FUNCTION audit_events_append_only();
+	`)
+	return err
+}
+
+func downAuditEvents(ctx context.Context, tx *sql.Tx) error {
+	_, err := tx.ExecContext(ctx, `
+		DROP TABLE IF EXISTS audit_events;
+		DROP FUNCTION IF EXISTS audit_events_append_only();
+	`)
+	return err
+}
@@ -0,0 +1,58 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/pressly/goose/v3"
+)
+
+func init() {
+	goose.AddMigrationContext(upAuditHashChain, downAuditHashChain)
+}
+
+// Events recorded before the chain keep NULL hashes. A previous hash is
+// followed by one event at most, so concurrent writers can't fork the
+// chain.
+func upAuditHashChain(ctx context.Context, tx *sql.Tx) error {
+	_, err := tx.ExecContext(ctx, `
+		ALTER TABLE audit_events
+			ADD COLUMN prev_hash VARCHAR(64),
+			ADD COLUMN hash VARCHAR(64),
+			ADD CONSTRAINT unique_audit_events_prev_hash UNIQUE (prev_hash);
+
+		CREATE TABLE audit_checkpoints (
+			id BIGSERIAL PRIMARY KEY,
+			event_id BIGINT NOT NULL,
+			hash VARCHAR(64) NOT NULL,
+			key_id VARCHAR(64) NOT NULL,
+			signature TEXT NOT NULL,
+			created_at TIMESTAMP NOT NULL
+		);
+
+		CREATE INDEX audit_checkpoints_event_id_idx ON audit_checkpoints (event_id);
+
+		CREATE FUNCTION audit_checkpoints_append_only() RETURNS trigger AS $$
+		BEGIN
+			RAISE EXCEPTION 'audit_checkpoints is append-only';
+		END;
+		$$ LANGUAGE plpgsql;
+
+		CREATE TRIGGER audit_checkpoints_append_only
+			BEFORE UPDATE OR DELETE ON audit_checkpoints
+			FOR EACH ROW EXECUTE FUNCTION audit_checkpoints_append_only();
+	`)
+	return err
+}
+
+func downAuditHashChain(ctx context.Context, tx *sql.Tx) error {
+	_, err := tx.ExecContext(ctx, `
+		DROP TABLE IF EXISTS audit_checkpoints;
+		DROP FUNCTION IF EXISTS audit_checkpoints_append_only();
+
+		ALTER TABLE audit_events
+			DROP COLUMN IF EXISTS prev_hash,
+			DROP COLUMN IF EXISTS hash;
+	`)
+	return err
+}
@@ -0,0 +1,40 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/pressly/goose/v3"
+)
+
+func init() {
+	goose.AddMigrationContext(upOutboxEvents, downOutboxEvents)
+}
+
+// Events are written in the transaction of the change they describe and
+// published by the relay. The partial index finds the oldest pending event
+// of each user, events of one user are published in id order.
+func upOutboxEvents(ctx context.Context, tx *sql.Tx) error {
+	_, err := tx.ExecContext(ctx, `
+		CREATE TABLE outbox_events (
+			id BIGSERIAL PRIMARY KEY,
+			type VARCHAR(64) NOT NULL,
+			aggregate_id TEXT NOT NULL,
+			payload JSONB NOT NULL,
+			created_at TIMESTAMP NOT NULL,
+			attempts INTEGER NOT NULL DEFAULT 0,
+			next_attempt_at TIMESTAMP NOT NULL,
+			last_error TEXT NOT NULL DEFAULT '',
+			published_at TIMESTAMP
+		);
+
+		CREATE INDEX outbox_events_pending_idx ON outbox_events (aggregate_id, id) WHERE published_at IS NULL;
+		CREATE INDEX outbox_events_published_at_idx ON outbox_events (published_at) WHERE published_at IS NOT NULL;
+	`)
+	return err
+}
+
+func downOutboxEvents(ctx context.Context, tx *sql.Tx) error {
+	_, err := tx.ExecContext(ctx, `DROP TABLE IF EXISTS outbox_events;`)
+	return err
+}
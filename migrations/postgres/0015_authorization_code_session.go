@@ -0,0 +1,28 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/pressly/goose/v3"
+)
+
+func init() {
+	goose.AddMigrationContext(upAuthorizationCodeSession, downAuthorizationCodeSession)
+}
+
+func upAuthorizationCodeSession(ctx context.Context, tx *sql.Tx) error {
+	_, err := tx.ExecContext(ctx, `
+		ALTER TABLE authorization_codes
+			ADD COLUMN session_id TEXT NOT NULL DEFAULT '';
+	`)
+	return err
+}
+
+func downAuthorizationCodeSession(ctx context.Context, tx *sql.Tx) error {
+	_, err := tx.ExecContext(ctx, `
+		ALTER TABLE authorization_codes
+			DROP COLUMN IF EXISTS session_id;
+	`)
+	return err
+}
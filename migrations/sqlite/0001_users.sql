@@ -0,0 +1,14 @@
+-- +goose Up
+CREATE TABLE users (
+	id TEXT PRIMARY KEY,
+	nickname TEXT NOT NULL,
+	email TEXT NOT NULL,
+	password TEXT NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	updated_at TIMESTAMP NOT NULL,
+	CONSTRAINT unique_email UNIQUE (email),
+	CONSTRAINT unique_nickname UNIQUE (nickname)
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS users;
@@ -0,0 +1,8 @@
+-- +goose Up
+-- roles is a JSON array of strings
+ALTER TABLE users ADD COLUMN roles TEXT NOT NULL DEFAULT '[]';
+ALTER TABLE users ADD COLUMN disabled_at TIMESTAMP NULL;
+
+-- +goose Down
+ALTER TABLE users DROP COLUMN disabled_at;
+ALTER TABLE users DROP COLUMN roles;
@@ -0,0 +1,11 @@
+-- +goose Up
+CREATE TABLE signing_keys (
+	id TEXT PRIMARY KEY,
+	algorithm TEXT NOT NULL,
+	private_key TEXT NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	retired_at TIMESTAMP NULL
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS signing_keys;
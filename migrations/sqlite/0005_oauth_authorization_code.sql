@@ -0,0 +1,30 @@
+-- +goose Up
+-- redirect_uris and grant_types are JSON arrays of strings
+ALTER TABLE oauth_clients ADD COLUMN redirect_uris TEXT NOT NULL DEFAULT '[]';
+ALTER TABLE oauth_clients ADD COLUMN grant_types TEXT NOT NULL DEFAULT '[]';
+
+CREATE TABLE authorization_codes (
+	code_hash TEXT PRIMARY KEY,
+	client_id TEXT NOT NULL REFERENCES oauth_clients (id) ON DELETE CASCADE,
+	user_id TEXT NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+	redirect_uri TEXT NOT NULL,
+	scope TEXT NOT NULL,
+	code_challenge TEXT NOT NULL,
+	expires_at TIMESTAMP NOT NULL,
+	created_at TIMESTAMP NOT NULL
+);
+
+-- scopes is a JSON array of strings
+CREATE TABLE oauth_consents (
+	user_id TEXT NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+	client_id TEXT NOT NULL REFERENCES oauth_clients (id) ON DELETE CASCADE,
+	scopes TEXT NOT NULL DEFAULT '[]',
+	granted_at TIMESTAMP NOT NULL,
+	PRIMARY KEY (user_id, client_id)
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS oauth_consents;
+DROP TABLE IF EXISTS authorization_codes;
+ALTER TABLE oauth_clients DROP COLUMN grant_types;
+ALTER TABLE oauth_clients DROP COLUMN redirect_uris;
@@ -0,0 +1,11 @@
+-- +goose Up
+-- post_logout_redirect_uris is a JSON array of strings
+ALTER TABLE oauth_clients ADD COLUMN post_logout_redirect_uris TEXT NOT NULL DEFAULT '[]';
+
+ALTER TABLE authorization_codes ADD COLUMN nonce TEXT NOT NULL DEFAULT '';
+ALTER TABLE authorization_codes ADD COLUMN auth_time TIMESTAMP NULL;
+
+-- +goose Down
+ALTER TABLE authorization_codes DROP COLUMN auth_time;
+ALTER TABLE authorization_codes DROP COLUMN nonce;
+ALTER TABLE oauth_clients DROP COLUMN post_logout_redirect_uris;
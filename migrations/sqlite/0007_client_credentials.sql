@@ -0,0 +1,6 @@
+-- +goose Up
+-- scopes is a JSON array of strings
+ALTER TABLE oauth_clients ADD COLUMN scopes TEXT NOT NULL DEFAULT '[]';
+
+-- +goose Down
+ALTER TABLE oauth_clients DROP COLUMN scopes;
@@ -0,0 +1,18 @@
+-- +goose Up
+-- scopes is a JSON array of strings
+CREATE TABLE personal_access_tokens (
+	id TEXT PRIMARY KEY,
+	user_id TEXT NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+	name TEXT NOT NULL,
+	token_hash TEXT NOT NULL UNIQUE,
+	scopes TEXT NOT NULL DEFAULT '[]',
+	expires_at TIMESTAMP,
+	last_used_at TIMESTAMP,
+	last_used_ip TEXT NOT NULL DEFAULT '',
+	created_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX personal_access_tokens_user_id_idx ON personal_access_tokens (user_id);
+
+-- +goose Down
+DROP TABLE IF EXISTS personal_access_tokens;
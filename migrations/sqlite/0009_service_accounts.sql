@@ -0,0 +1,23 @@
+-- +goose Up
+-- roles is a JSON array of strings
+CREATE TABLE service_accounts (
+	id TEXT PRIMARY KEY,
+	name TEXT NOT NULL UNIQUE,
+	owner_id TEXT REFERENCES users (id) ON DELETE SET NULL,
+	roles TEXT NOT NULL DEFAULT '[]',
+	created_at TIMESTAMP NOT NULL
+);
+
+CREATE TABLE api_keys (
+	id TEXT PRIMARY KEY,
+	service_account_id TEXT NOT NULL REFERENCES service_accounts (id) ON DELETE CASCADE,
+	key_hash TEXT NOT NULL,
+	last_used_at TIMESTAMP,
+	created_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX api_keys_service_account_id_idx ON api_keys (service_account_id);
+
+-- +goose Down
+DROP TABLE IF EXISTS api_keys;
+DROP TABLE IF EXISTS service_accounts;
@@ -0,0 +1,6 @@
+-- +goose Up
+-- allowed_ips is a JSON array of addresses and CIDR ranges
+ALTER TABLE users ADD COLUMN allowed_ips TEXT NOT NULL DEFAULT '[]';
+
+-- +goose Down
+ALTER TABLE users DROP COLUMN allowed_ips;
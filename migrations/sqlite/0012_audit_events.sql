@@ -0,0 +1,39 @@
+-- +goose Up
+-- audit_events has no foreign keys, the trail outlives the users and objects
+-- it mentions. details is a JSON object of strings, the triggers keep the
+-- table append-only.
+CREATE TABLE audit_events (
+	id INTEGER PRIMARY KEY AUTOINCREMENT,
+	type TEXT NOT NULL,
+	outcome TEXT NOT NULL,
+	actor_type TEXT NOT NULL DEFAULT '',
+	actor_id TEXT NOT NULL DEFAULT '',
+	target_id TEXT NOT NULL DEFAULT '',
+	ip TEXT NOT NULL DEFAULT '',
+	user_agent TEXT NOT NULL DEFAULT '',
+	request_id TEXT NOT NULL DEFAULT '',
+	details TEXT NOT NULL DEFAULT '{}',
+	created_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX audit_events_type_idx ON audit_events (type, id);
+CREATE INDEX audit_events_actor_id_idx ON audit_events (actor_id, id);
+CREATE INDEX audit_events_target_id_idx ON audit_events (target_id, id);
+CREATE INDEX audit_events_created_at_idx ON audit_events (created_at);
+
+-- +goose StatementBegin
+CREATE TRIGGER audit_events_no_update BEFORE UPDATE ON audit_events
+BEGIN
+	SELECT RAISE(ABORT, 'audit_events is append-only');
+END;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TRIGGER audit_events_no_delete BEFORE DELETE ON audit_events
+BEGIN
+	SELECT RAISE(ABORT, 'audit_events is append-only');
+END;
+-- +goose StatementEnd
+
+-- +goose Down
+DROP TABLE IF EXISTS audit_events;
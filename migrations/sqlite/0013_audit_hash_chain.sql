@@ -0,0 +1,38 @@
+-- +goose Up
+-- Events recorded before the chain keep NULL hashes. A previous hash is
+-- followed by one event at most, so concurrent writers can't fork the chain.
+ALTER TABLE audit_events ADD COLUMN prev_hash TEXT;
+ALTER TABLE audit_events ADD COLUMN hash TEXT;
+
+CREATE UNIQUE INDEX unique_audit_events_prev_hash ON audit_events (prev_hash);
+
+CREATE TABLE audit_checkpoints (
+	id INTEGER PRIMARY KEY AUTOINCREMENT,
+	event_id INTEGER NOT NULL,
+	hash TEXT NOT NULL,
+	key_id TEXT NOT NULL,
+	signature TEXT NOT NULL,
+	created_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX audit_checkpoints_event_id_idx ON audit_checkpoints (event_id);
+
+-- +goose StatementBegin
+CREATE TRIGGER audit_checkpoints_no_update BEFORE UPDATE ON audit_checkpoints
+BEGIN
+	SELECT RAISE(ABORT, 'audit_checkpoints is append-only');
+END;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TRIGGER audit_checkpoints_no_delete BEFORE DELETE ON audit_checkpoints
+BEGIN
+	SELECT RAISE(ABORT, 'audit_checkpoints is append-only');
+END;
+-- +goose StatementEnd
+
+-- +goose Down
+DROP TABLE IF EXISTS audit_checkpoints;
+DROP INDEX IF EXISTS unique_audit_events_prev_hash;
+ALTER TABLE audit_events DROP COLUMN hash;
+ALTER TABLE audit_events DROP COLUMN prev_hash;
@@ -0,0 +1,21 @@
+-- +goose Up
+-- Events are written in the transaction of the change they describe and
+-- published by the relay. The partial index finds the oldest pending event
+-- of each user, events of one user are published in id order.
+CREATE TABLE outbox_events (
+	id INTEGER PRIMARY KEY AUTOINCREMENT,
+	type TEXT NOT NULL,
+	aggregate_id TEXT NOT NULL,
+	payload TEXT NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	attempts INTEGER NOT NULL DEFAULT 0,
+	next_attempt_at TIMESTAMP NOT NULL,
+	last_error TEXT NOT NULL DEFAULT '',
+	published_at TIMESTAMP
+);
+
+CREATE INDEX outbox_events_pending_idx ON outbox_events (aggregate_id, id) WHERE published_at IS NULL;
+CREATE INDEX outbox_events_published_at_idx ON outbox_events (published_at) WHERE published_at IS NOT NULL;
+
+-- +goose Down
+DROP TABLE IF EXISTS outbox_events;
@@ -0,0 +1,7 @@
+-- +goose Up
+-- session_id binds the tokens of a code to the sign in session, empty for
+-- codes issued before
+ALTER TABLE authorization_codes ADD COLUMN session_id TEXT NOT NULL DEFAULT '';
+
+-- +goose Down
+ALTER TABLE authorization_codes DROP COLUMN session_id;
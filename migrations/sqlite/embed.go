@@ -0,0 +1,9 @@
+package migrations
+
+import "embed"
+
+// FS holds the SQLite migrations, they are embedded so the binary can
+// migrate a database without the source tree.
+//
+//go:embed *.sql
+var FS embed.FS